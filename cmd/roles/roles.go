@@ -56,6 +56,8 @@ func init() {
 	Registry.MustRegister(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
 	Registry.MustRegister(prometheus.NewGoCollector())
 	metrics.RegisterEtcdMetrics(Registry)
+	metrics.RegisterMsgStream(Registry)
+	metrics.RegisterRocksmq(Registry)
 }
 
 func stopRocksmq() {
@@ -0,0 +1,164 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/backup"
+	dcc "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
+	icc "github.com/milvus-io/milvus/internal/distributed/indexcoord/client"
+	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+var (
+	cmd         = flag.String("cmd", "", "backup or restore")
+	name        = flag.String("name", "", "Name of the backup")
+	base        = flag.String("base", "", "Backup the new backup is incremental to, the segments it saved are not copied again")
+	collections = flag.String("collections", "", "Comma separated names of the collections, all the collections if empty")
+	bucket      = flag.String("bucket", "", "Bucket of the backups, the bucket of the cluster if empty")
+	root        = flag.String("root", "backup", "Root path of the backups in the bucket")
+	rbac        = flag.Bool("rbac", false, "Back up or restore the users, roles and privileges")
+	flush       = flag.Bool("flush", false, "Flush the collections once the backup timestamp is taken, the rows of the growing segments are not backed up without it")
+	rename      = flag.String("rename", "", "Comma separated old:new names of the restored collections")
+	suffix      = flag.String("suffix", "", "Suffix of the restored collections not renamed")
+)
+
+func splitNames(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+func parseRename(s string) map[string]string {
+	mapping := make(map[string]string)
+	for _, pair := range splitNames(s) {
+		names := strings.SplitN(pair, ":", 2)
+		if len(names) != 2 || names[0] == "" || names[1] == "" {
+			log.Fatal("invalid rename, must be old:new", zap.String("rename", pair))
+		}
+		mapping[names[0]] = names[1]
+	}
+	return mapping
+}
+
+func main() {
+	flag.Parse()
+	if (*cmd != "backup" && *cmd != "restore") || *name == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	paramtable.Init()
+	params := paramtable.Get()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	etcdCli, err := etcd.GetEtcdClient(&params.EtcdCfg)
+	if err != nil {
+		log.Fatal("failed to connect to etcd", zap.Error(err))
+	}
+	metaRoot := params.EtcdCfg.MetaRootPath.GetValue()
+
+	rootCoord, err := rcc.NewClient(ctx, metaRoot, etcdCli)
+	if err != nil {
+		log.Fatal("failed to create root coord client", zap.Error(err))
+	}
+	if err := rootCoord.Init(); err != nil {
+		log.Fatal("failed to init root coord client", zap.Error(err))
+	}
+	if err := rootCoord.Start(); err != nil {
+		log.Fatal("failed to start root coord client", zap.Error(err))
+	}
+	dataCoord, err := dcc.NewClient(ctx, metaRoot, etcdCli)
+	if err != nil {
+		log.Fatal("failed to create data coord client", zap.Error(err))
+	}
+	if err := dataCoord.Init(); err != nil {
+		log.Fatal("failed to init data coord client", zap.Error(err))
+	}
+	if err := dataCoord.Start(); err != nil {
+		log.Fatal("failed to start data coord client", zap.Error(err))
+	}
+	indexCoord, err := icc.NewClient(ctx, metaRoot, etcdCli)
+	if err != nil {
+		log.Fatal("failed to create index coord client", zap.Error(err))
+	}
+	if err := indexCoord.Init(); err != nil {
+		log.Fatal("failed to init index coord client", zap.Error(err))
+	}
+	if err := indexCoord.Start(); err != nil {
+		log.Fatal("failed to start index coord client", zap.Error(err))
+	}
+
+	clusterStorage, err := storage.NewChunkManagerFactoryWithParam(params).NewPersistentStorageChunkManager(ctx)
+	if err != nil {
+		log.Fatal("failed to create chunk manager of cluster", zap.Error(err))
+	}
+	backupOpts := []storage.Option{storage.RootPath(*root)}
+	if *bucket != "" {
+		backupOpts = append(backupOpts, storage.BucketName(*bucket))
+	}
+	backupStorage, err := storage.NewChunkManagerFactoryWithParam(params, backupOpts...).NewPersistentStorageChunkManager(ctx)
+	if err != nil {
+		log.Fatal("failed to create chunk manager of backups", zap.Error(err))
+	}
+
+	manager := backup.NewManager(backup.Config{
+		RootCoord:     rootCoord,
+		DataCoord:     dataCoord,
+		IndexCoord:    indexCoord,
+		Storage:       clusterStorage,
+		BackupStorage: backupStorage,
+	})
+	switch *cmd {
+	case "backup":
+		info, err := manager.Backup(ctx, backup.BackupOptions{
+			Name:        *name,
+			Base:        *base,
+			Collections: splitNames(*collections),
+			Flush:       *flush,
+			WithRBAC:    *rbac,
+		})
+		if err != nil {
+			log.Fatal("backup failed", zap.String("name", *name), zap.Error(err))
+		}
+		log.Info("backup done", zap.String("name", info.Name), zap.Uint64("backup ts", info.BackupTs))
+	case "restore":
+		err := manager.Restore(ctx, backup.RestoreOptions{
+			Name:        *name,
+			Collections: splitNames(*collections),
+			Rename:      parseRename(*rename),
+			Suffix:      *suffix,
+			WithRBAC:    *rbac,
+		})
+		if err != nil {
+			log.Fatal("restore failed", zap.String("name", *name), zap.Error(err))
+		}
+		log.Info("restore done", zap.String("name", *name))
+	}
+}
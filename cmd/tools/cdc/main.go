@@ -0,0 +1,185 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/cdc"
+	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+var (
+	collection     = flag.String("collection", "", "Name of the collection to capture the changes of")
+	checkpointPath = flag.String("checkpoint", "", "File to resume the capture from, it's rewritten after each written pack of events")
+	startTs        = flag.Uint64("start_ts", 0, "Only capture the changes after the hybrid timestamp, ignored when resuming from a checkpoint")
+	outputPath     = flag.String("output", "", "File the events are appended to as json lines, defaults to stdout")
+)
+
+func loadCheckpoint(filePath string) (*cdc.Checkpoint, error) {
+	data, err := os.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return cdc.UnmarshalCheckpoint(data)
+}
+
+// saveCheckpoint replaces the checkpoint file by renaming, so a crash never leaves a partial checkpoint
+func saveCheckpoint(filePath string, cp *cdc.Checkpoint) error {
+	data, err := cp.Marshal()
+	if err != nil {
+		return err
+	}
+	tmpPath := filePath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, filePath)
+}
+
+func openOutput(filePath string) (io.WriteCloser, error) {
+	if filePath == "" {
+		return os.Stdout, nil
+	}
+	return os.OpenFile(filePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+}
+
+func describeCollection(ctx context.Context, rootCoord *rcc.Client, name string) (*milvuspb.DescribeCollectionResponse, error) {
+	resp, err := rootCoord.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_DescribeCollection)),
+		CollectionName: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, fmt.Errorf("failed to describe collection %s, reason: %s", name, resp.GetStatus().GetReason())
+	}
+	return resp, nil
+}
+
+func main() {
+	flag.Parse()
+	if *collection == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	paramtable.Init()
+	params := paramtable.Get()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	etcdCli, err := etcd.GetEtcdClient(&params.EtcdCfg)
+	if err != nil {
+		log.Fatal("failed to connect to etcd", zap.Error(err))
+	}
+	rootCoord, err := rcc.NewClient(ctx, params.EtcdCfg.MetaRootPath.GetValue(), etcdCli)
+	if err != nil {
+		log.Fatal("failed to create root coord client", zap.Error(err))
+	}
+	if err := rootCoord.Init(); err != nil {
+		log.Fatal("failed to init root coord client", zap.Error(err))
+	}
+	if err := rootCoord.Start(); err != nil {
+		log.Fatal("failed to start root coord client", zap.Error(err))
+	}
+	defer rootCoord.Stop()
+
+	coll, err := describeCollection(ctx, rootCoord, *collection)
+	if err != nil {
+		log.Fatal("failed to describe collection", zap.Error(err))
+	}
+
+	opts := []cdc.Option{cdc.WithStartTs(*startTs)}
+	if *checkpointPath != "" {
+		cp, err := loadCheckpoint(*checkpointPath)
+		if err != nil {
+			log.Fatal("failed to load checkpoint", zap.String("path", *checkpointPath), zap.Error(err))
+		}
+		if cp != nil {
+			opts = append(opts, cdc.WithCheckpoint(cp))
+		}
+	}
+
+	output, err := openOutput(*outputPath)
+	if err != nil {
+		log.Fatal("failed to open output", zap.String("path", *outputPath), zap.Error(err))
+	}
+	defer output.Close()
+	writer := bufio.NewWriter(output)
+	encoder := json.NewEncoder(writer)
+
+	factory := dependency.NewFactory(false)
+	factory.Init(params)
+	stream, err := cdc.NewStream(ctx, factory, coll, opts...)
+	if err != nil {
+		log.Fatal("failed to create cdc stream", zap.Error(err))
+	}
+	defer stream.Close()
+
+	sc := make(chan os.Signal, 1)
+	signal.Notify(sc, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
+	go func() {
+		sig := <-sc
+		log.Info("cdc is stopping", zap.String("signal", sig.String()))
+		stream.Close()
+	}()
+
+	log.Info("cdc is capturing changes", zap.String("collection", *collection), zap.Int64("collectionID", coll.GetCollectionID()))
+	// the checkpoint is saved only after the events of its pack are flushed, events may be written
+	// again after a crash but never lost
+	for pack := range stream.Chan() {
+		for _, event := range pack.Events {
+			if err := encoder.Encode(event); err != nil {
+				log.Fatal("failed to write event", zap.Error(err))
+			}
+		}
+		if err := writer.Flush(); err != nil {
+			log.Fatal("failed to flush events", zap.Error(err))
+		}
+		if *checkpointPath != "" {
+			if err := saveCheckpoint(*checkpointPath, pack.Checkpoint); err != nil {
+				log.Fatal("failed to save checkpoint", zap.String("path", *checkpointPath), zap.Error(err))
+			}
+		}
+	}
+	if err := stream.Err(); err != nil {
+		log.Fatal("cdc stream failed", zap.Error(err))
+	}
+}
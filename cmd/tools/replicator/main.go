@@ -0,0 +1,197 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"path"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	dcc "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
+	icc "github.com/milvus-io/milvus/internal/distributed/indexcoord/client"
+	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/management"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/replication"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+var (
+	sourceYaml  = flag.String("source", "", "Milvus yaml of the source cluster, the target cluster is configured as a Milvus component")
+	collections = flag.String("collections", "", "Comma separated names of the collections to replicate")
+)
+
+// sourceConfig is the part of the source cluster configuration needed to replicate it
+type sourceConfig struct {
+	etcd   paramtable.EtcdConfig
+	mq     paramtable.MQConfig
+	pulsar paramtable.PulsarConfig
+	kafka  paramtable.KafkaConfig
+	natsmq paramtable.NatsmqConfig
+}
+
+func loadSourceConfig(yaml string) *sourceConfig {
+	base := paramtable.NewBaseTableFromYamlOnly(yaml)
+	cfg := &sourceConfig{}
+	cfg.etcd.Init(base)
+	cfg.mq.Init(base)
+	cfg.pulsar.Init(base)
+	cfg.kafka.Init(base)
+	cfg.natsmq.Init(base)
+	return cfg
+}
+
+// msgStreamFactory follows the priority of dependency.DefaultFactory, rocksmq is local to the source cluster
+func (cfg *sourceConfig) msgStreamFactory() msgstream.Factory {
+	mqType := cfg.mq.Type.GetValue()
+	switch {
+	case mqType == util.MQTypePulsar || (mqType == "" && cfg.pulsar.Address.GetValue() != ""):
+		return msgstream.NewPmsFactory(&cfg.pulsar)
+	case mqType == util.MQTypeKafka || (mqType == "" && cfg.kafka.Address.GetValue() != ""):
+		return msgstream.NewKmsFactory(&cfg.kafka)
+	case mqType == util.MQTypeNatsmq || (mqType == "" && cfg.natsmq.Address.GetValue() != ""):
+		return msgstream.NewNmsFactory(&cfg.natsmq)
+	}
+	log.Fatal("the mq of the source cluster can't be replicated, must be Pulsar, Kafka or NATS", zap.String("mq type", mqType))
+	return nil
+}
+
+func newCluster(ctx context.Context, metaRoot string, etcdCli *clientv3.Client, factory msgstream.Factory, withDataCoord bool) *replication.Cluster {
+	rootCoord, err := rcc.NewClient(ctx, metaRoot, etcdCli)
+	if err != nil {
+		log.Fatal("failed to create root coord client", zap.Error(err))
+	}
+	if err := rootCoord.Init(); err != nil {
+		log.Fatal("failed to init root coord client", zap.Error(err))
+	}
+	if err := rootCoord.Start(); err != nil {
+		log.Fatal("failed to start root coord client", zap.Error(err))
+	}
+	indexCoord, err := icc.NewClient(ctx, metaRoot, etcdCli)
+	if err != nil {
+		log.Fatal("failed to create index coord client", zap.Error(err))
+	}
+	if err := indexCoord.Init(); err != nil {
+		log.Fatal("failed to init index coord client", zap.Error(err))
+	}
+	if err := indexCoord.Start(); err != nil {
+		log.Fatal("failed to start index coord client", zap.Error(err))
+	}
+	cluster := &replication.Cluster{
+		RootCoord:  rootCoord,
+		IndexCoord: indexCoord,
+		Factory:    factory,
+	}
+	if !withDataCoord {
+		return cluster
+	}
+
+	dataCoord, err := dcc.NewClient(ctx, metaRoot, etcdCli)
+	if err != nil {
+		log.Fatal("failed to create data coord client", zap.Error(err))
+	}
+	if err := dataCoord.Init(); err != nil {
+		log.Fatal("failed to init data coord client", zap.Error(err))
+	}
+	if err := dataCoord.Start(); err != nil {
+		log.Fatal("failed to start data coord client", zap.Error(err))
+	}
+	cluster.DataCoord = dataCoord
+	return cluster
+}
+
+func main() {
+	flag.Parse()
+	if *sourceYaml == "" || *collections == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	paramtable.Init()
+	params := paramtable.Get()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	source := loadSourceConfig(*sourceYaml)
+	sourceEtcdCli, err := etcd.GetEtcdClient(&source.etcd)
+	if err != nil {
+		log.Fatal("failed to connect to etcd of source cluster", zap.Error(err))
+	}
+	sourceCluster := newCluster(ctx, source.etcd.MetaRootPath.GetValue(), sourceEtcdCli, source.msgStreamFactory(), false)
+
+	targetEtcdCli, err := etcd.GetEtcdClient(&params.EtcdCfg)
+	if err != nil {
+		log.Fatal("failed to connect to etcd of target cluster", zap.Error(err))
+	}
+	factory := dependency.NewFactory(false)
+	factory.Init(params)
+	targetCluster := newCluster(ctx, params.EtcdCfg.MetaRootPath.GetValue(), targetEtcdCli, factory, true)
+
+	// the RootCoord of the target cluster holds the time ticks of the dml channels for the replicator sessions
+	session := sessionutil.NewSession(ctx, params.EtcdCfg.MetaRootPath.GetValue(), targetEtcdCli)
+	session.Init(typeutil.ReplicatorRole, funcutil.GetLocalIP(), false, false)
+	session.Register()
+
+	replicator, err := replication.NewReplicator(replication.Config{
+		Source:      sourceCluster,
+		Target:      targetCluster,
+		MetaKV:      etcdkv.NewEtcdKV(targetEtcdCli, params.EtcdCfg.MetaRootPath.GetValue()),
+		ConfigKV:    etcdkv.NewEtcdKV(targetEtcdCli, path.Join(params.EtcdCfg.RootPath.GetValue(), "config")),
+		Collections: strings.Split(*collections, ","),
+		Session:     session,
+	})
+	if err != nil {
+		session.Revoke(time.Second)
+		log.Fatal("failed to create replicator", zap.Error(err))
+	}
+
+	registry := prometheus.NewRegistry()
+	metrics.RegisterReplicator(registry)
+	metrics.RegisterMsgStream(registry)
+	metrics.Register(registry)
+	replicator.RegisterHTTPHandlers()
+	management.ServeHTTP()
+
+	if err := replicator.Start(ctx); err != nil {
+		session.Revoke(time.Second)
+		log.Fatal("failed to start replicator", zap.Error(err))
+	}
+
+	sc := make(chan os.Signal, 1)
+	signal.Notify(sc, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
+	sig := <-sc
+	log.Info("replicator is stopping", zap.String("signal", sig.String()))
+	replicator.Stop()
+}
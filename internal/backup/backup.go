@@ -0,0 +1,612 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// BackupOptions are the options of a backup.
+type BackupOptions struct {
+	// Name of the backup, it must not exist yet
+	Name string
+	// Base is the backup this one is incremental to, the segments saved by it are not copied again
+	Base string
+	// Collections to back up, all the collections if empty
+	Collections []string
+	// Flush seals and flushes the growing segments once the backup timestamp is taken,
+	// without it the rows of the segments still growing are not backed up
+	Flush bool
+	// WithRBAC saves the users, roles and privileges
+	WithRBAC bool
+}
+
+// Get reads the manifest of a backup.
+func (m *Manager) Get(ctx context.Context, name string) (*Info, error) {
+	file := path.Join(m.backupDir(name), manifestFile)
+	exist, err := m.BackupStorage.Exist(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, fmt.Errorf("%w: %s", ErrBackupNotFound, name)
+	}
+	data, err := m.BackupStorage.Read(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	info := &Info{}
+	if err := json.Unmarshal(data, info); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest of backup %s, err: %w", name, err)
+	}
+	return info, nil
+}
+
+// Backup captures the metadata at a newly allocated timestamp and copies the binlogs of the segments flushed at it.
+// Segments flushed after the timestamp may hold newer rows, they are filtered out by the restore.
+// The segments compacted after the timestamp are backed up instead of the compaction results, their binlogs
+// are kept by the datacoord gc for dataCoord.gc.dropTolerance, which bounds the duration of a backup.
+func (m *Manager) Backup(ctx context.Context, opts BackupOptions) (*Info, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf("backup name is empty")
+	}
+	if _, err := m.Get(ctx, opts.Name); err == nil {
+		return nil, fmt.Errorf("backup %s already exists", opts.Name)
+	} else if !errors.Is(err, ErrBackupNotFound) {
+		return nil, err
+	}
+	var base *Info
+	if opts.Base != "" {
+		var err error
+		if base, err = m.Get(ctx, opts.Base); err != nil {
+			return nil, fmt.Errorf("failed to read base backup, err: %w", err)
+		}
+	}
+
+	names := opts.Collections
+	if len(names) == 0 {
+		var err error
+		if names, err = m.showCollections(ctx); err != nil {
+			return nil, err
+		}
+	}
+	ts, err := m.allocTimestamp(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to allocate backup timestamp, err: %w", err)
+	}
+	// the segments sealed by the flush hold all the rows inserted before the timestamp
+	if opts.Flush {
+		for _, name := range names {
+			if err := m.flush(ctx, name); err != nil {
+				return nil, fmt.Errorf("failed to flush collection %s, err: %w", name, err)
+			}
+		}
+	}
+
+	info := &Info{
+		Name:       opts.Name,
+		Base:       opts.Base,
+		BackupTs:   ts,
+		CreateTime: time.Now().Unix(),
+	}
+	log.Info("backup started", zap.String("name", opts.Name), zap.String("base", opts.Base),
+		zap.Uint64("backup ts", info.BackupTs), zap.Strings("collections", names))
+
+	for _, name := range names {
+		coll, err := m.backupCollection(ctx, info, base, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to back up collection %s, err: %w", name, err)
+		}
+		info.Collections = append(info.Collections, coll)
+	}
+	if opts.WithRBAC {
+		if info.RBAC, err = m.backupRBAC(ctx); err != nil {
+			return nil, fmt.Errorf("failed to back up rbac, err: %w", err)
+		}
+	}
+
+	data, err := json.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.BackupStorage.Write(ctx, path.Join(m.backupDir(info.Name), manifestFile), data); err != nil {
+		return nil, fmt.Errorf("failed to write manifest, err: %w", err)
+	}
+	log.Info("backup finished", zap.String("name", info.Name), zap.Int("collections", len(info.Collections)))
+	return info, nil
+}
+
+func (m *Manager) allocTimestamp(ctx context.Context) (Timestamp, error) {
+	resp, err := m.RootCoord.AllocTimestamp(ctx, &rootcoordpb.AllocTimestampRequest{
+		Base:  commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_RequestTSO)),
+		Count: 1,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetTimestamp(), statusError(resp.GetStatus())
+}
+
+func (m *Manager) showCollections(ctx context.Context) ([]string, error) {
+	resp, err := m.RootCoord.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
+		Base: commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_ShowCollections)),
+	})
+	if err == nil {
+		err = statusError(resp.GetStatus())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collections, err: %w", err)
+	}
+	return resp.GetCollectionNames(), nil
+}
+
+func (m *Manager) describeCollection(ctx context.Context, name string, ts Timestamp) (*milvuspb.DescribeCollectionResponse, error) {
+	resp, err := m.RootCoord.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_DescribeCollection)),
+		CollectionName: name,
+		TimeStamp:      ts,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, statusError(resp.GetStatus())
+}
+
+func (m *Manager) showPartitions(ctx context.Context, collectionID UniqueID) (*milvuspb.ShowPartitionsResponse, error) {
+	resp, err := m.RootCoord.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
+		Base:         commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_ShowPartitions)),
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, statusError(resp.GetStatus())
+}
+
+// flush seals the growing segments of the collection and waits until they are flushed
+func (m *Manager) flush(ctx context.Context, name string) error {
+	coll, err := m.describeCollection(ctx, name, 0)
+	if err != nil {
+		return err
+	}
+	resp, err := m.DataCoord.Flush(ctx, &datapb.FlushRequest{
+		Base:         commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_Flush)),
+		CollectionID: coll.GetCollectionID(),
+	})
+	if err == nil {
+		err = statusError(resp.GetStatus())
+	}
+	if err != nil {
+		return err
+	}
+	segmentIDs := append(resp.GetSegmentIDs(), resp.GetFlushSegmentIDs()...)
+	if len(segmentIDs) == 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(m.PollInterval)
+	defer ticker.Stop()
+	for {
+		state, err := m.DataCoord.GetFlushState(ctx, &milvuspb.GetFlushStateRequest{SegmentIDs: segmentIDs})
+		if err == nil {
+			err = statusError(state.GetStatus())
+		}
+		if err != nil {
+			return err
+		}
+		if state.GetFlushed() {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Manager) backupCollection(ctx context.Context, info, base *Info, name string) (*CollectionInfo, error) {
+	desc, err := m.describeCollection(ctx, name, info.BackupTs)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := proto.Marshal(desc.GetSchema())
+	if err != nil {
+		return nil, err
+	}
+	coll := &CollectionInfo{
+		ID:               desc.GetCollectionID(),
+		Name:             name,
+		Schema:           schema,
+		ShardsNum:        desc.GetShardsNum(),
+		ConsistencyLevel: desc.GetConsistencyLevel(),
+		Aliases:          desc.GetAliases(),
+	}
+	if coll.Indexes, err = m.backupIndexes(ctx, desc); err != nil {
+		return nil, err
+	}
+
+	partitions, err := m.showPartitions(ctx, coll.ID)
+	if err != nil {
+		return nil, err
+	}
+	partitionSegments, err := m.listSegments(ctx, coll.ID, info.BackupTs)
+	if err != nil {
+		return nil, err
+	}
+	baseSegments := make(map[UniqueID]*SegmentInfo)
+	if base != nil {
+		for _, baseColl := range base.Collections {
+			if baseColl.ID != coll.ID {
+				continue
+			}
+			for _, partition := range baseColl.Partitions {
+				for _, segment := range partition.Segments {
+					baseSegments[segment.ID] = segment
+				}
+			}
+		}
+	}
+	for i, partitionID := range partitions.GetPartitionIDs() {
+		segments := partitionSegments[partitionID]
+		delete(partitionSegments, partitionID)
+		// ShowPartitions lists the partitions of now, the ones created after the backup timestamp are left out
+		if i < len(partitions.GetCreatedTimestamps()) && partitions.GetCreatedTimestamps()[i] > info.BackupTs {
+			continue
+		}
+		partition := &PartitionInfo{ID: partitionID, Name: partitions.GetPartitionNames()[i]}
+		if partition.Segments, err = m.backupSegments(ctx, info.Name, coll.ID, partitionID, segments, baseSegments); err != nil {
+			return nil, err
+		}
+		coll.Partitions = append(coll.Partitions, partition)
+	}
+	// the partitions dropped after the backup timestamp are not listed, their segments are still there at the backup
+	// timestamp but the partitions can't be restored without their names
+	if len(partitionSegments) > 0 {
+		dropped := make([]UniqueID, 0, len(partitionSegments))
+		for partitionID := range partitionSegments {
+			dropped = append(dropped, partitionID)
+		}
+		sort.Slice(dropped, func(i, j int) bool { return dropped[i] < dropped[j] })
+		return nil, fmt.Errorf("partitions %v of collection %s were dropped after the backup timestamp %d", dropped, name, info.BackupTs)
+	}
+	return coll, nil
+}
+
+func (m *Manager) backupIndexes(ctx context.Context, desc *milvuspb.DescribeCollectionResponse) ([]*IndexInfo, error) {
+	resp, err := m.IndexCoord.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{CollectionID: desc.GetCollectionID()})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() == commonpb.ErrorCode_IndexNotExist {
+		return nil, nil
+	}
+	if err := statusError(resp.GetStatus()); err != nil {
+		return nil, err
+	}
+	fieldNames := make(map[UniqueID]string)
+	for _, field := range desc.GetSchema().GetFields() {
+		fieldNames[field.GetFieldID()] = field.GetName()
+	}
+	indexes := make([]*IndexInfo, 0, len(resp.GetIndexInfos()))
+	for _, index := range resp.GetIndexInfos() {
+		indexes = append(indexes, &IndexInfo{
+			FieldName:       fieldNames[index.GetFieldID()],
+			IndexName:       index.GetIndexName(),
+			TypeParams:      index.GetTypeParams(),
+			IndexParams:     index.GetIndexParams(),
+			UserIndexParams: index.GetUserIndexParams(),
+			IsAutoIndex:     index.GetIsAutoIndex(),
+		})
+	}
+	return indexes, nil
+}
+
+// segmentsAt returns the segments flushed at ts among the flushed and the dropped segments.
+// A segment dropped after ts is kept, and the segments compacted from it are left out since they were created after ts.
+func segmentsAt(infos []*datapb.SegmentInfo, ts Timestamp) []*datapb.SegmentInfo {
+	// DroppedAt is the wall time in nanoseconds
+	physical := uint64(tsoutil.PhysicalTime(ts).UnixNano())
+	droppedAfter := make(map[UniqueID]struct{})
+	for _, info := range infos {
+		if info.GetState() == commonpb.SegmentState_Dropped && info.GetDroppedAt() > physical {
+			droppedAfter[info.GetID()] = struct{}{}
+		}
+	}
+
+	segments := make([]*datapb.SegmentInfo, 0, len(infos))
+	for _, info := range infos {
+		switch info.GetState() {
+		case commonpb.SegmentState_Flushed:
+		case commonpb.SegmentState_Dropped:
+			if _, ok := droppedAfter[info.GetID()]; !ok {
+				continue
+			}
+		default:
+			continue
+		}
+		compactedAfter := false
+		for _, from := range info.GetCompactionFrom() {
+			if _, ok := droppedAfter[from]; ok {
+				compactedAfter = true
+				break
+			}
+		}
+		if !compactedAfter {
+			segments = append(segments, info)
+		}
+	}
+	return segments
+}
+
+// listSegments returns the segments of the collection flushed at the backup timestamp by partition,
+// including the ones of the partitions dropped since then
+func (m *Manager) listSegments(ctx context.Context, collectionID UniqueID, ts Timestamp) (map[UniqueID][]*datapb.SegmentInfo, error) {
+	flushed, err := m.DataCoord.GetFlushedSegments(ctx, &datapb.GetFlushedSegmentsRequest{
+		CollectionID:     collectionID,
+		PartitionID:      common.InvalidPartitionID,
+		IncludeUnhealthy: true,
+	})
+	if err == nil {
+		err = statusError(flushed.GetStatus())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list flushed segments of collection %d, err: %w", collectionID, err)
+	}
+	if len(flushed.GetSegments()) == 0 {
+		return nil, nil
+	}
+	resp, err := m.DataCoord.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+		Base:             commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_SegmentInfo)),
+		SegmentIDs:       flushed.GetSegments(),
+		IncludeUnHealthy: true,
+	})
+	if err == nil {
+		err = statusError(resp.GetStatus())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get segments of collection %d, err: %w", collectionID, err)
+	}
+
+	partitionSegments := make(map[UniqueID][]*datapb.SegmentInfo)
+	for _, info := range segmentsAt(resp.GetInfos(), ts) {
+		partitionSegments[info.GetPartitionID()] = append(partitionSegments[info.GetPartitionID()], info)
+	}
+	return partitionSegments, nil
+}
+
+// backupSegments copies the binlogs of the segments of a partition flushed at the backup timestamp,
+// the files already saved for the same segment by the base backup are reused
+func (m *Manager) backupSegments(ctx context.Context, name string, collectionID, partitionID UniqueID,
+	infos []*datapb.SegmentInfo, baseSegments map[UniqueID]*SegmentInfo) ([]*SegmentInfo, error) {
+	if len(infos) == 0 {
+		return nil, nil
+	}
+	segments := make([]*SegmentInfo, 0, len(infos))
+	for _, info := range infos {
+		segment := &SegmentInfo{ID: info.GetID(), NumRows: info.GetNumOfRows()}
+		baseFiles := make(map[string]*LogFile)
+		if baseSegment, ok := baseSegments[info.GetID()]; ok {
+			for _, file := range append(baseSegment.InsertLogs, baseSegment.DeltaLogs...) {
+				baseFiles[fileKey(file.FieldID, file.LogID)] = file
+			}
+		}
+		segmentDir := path.Join(strconv.FormatInt(collectionID, 10), strconv.FormatInt(partitionID, 10), strconv.FormatInt(info.GetID(), 10))
+
+		for _, fieldBinlog := range info.GetBinlogs() {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				file, err := m.copyBinlog(ctx, name, baseFiles, fieldBinlog.GetFieldID(), binlog,
+					path.Join(insertLogDir, segmentDir, strconv.FormatInt(fieldBinlog.GetFieldID(), 10)))
+				if err != nil {
+					return nil, err
+				}
+				segment.InsertLogs = append(segment.InsertLogs, file)
+			}
+		}
+		for _, fieldBinlog := range info.GetDeltalogs() {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				file, err := m.copyBinlog(ctx, name, baseFiles, 0, binlog, path.Join(deltaLogDir, segmentDir))
+				if err != nil {
+					return nil, err
+				}
+				segment.DeltaLogs = append(segment.DeltaLogs, file)
+			}
+		}
+		segments = append(segments, segment)
+	}
+	return segments, nil
+}
+
+// copyBinlog copies a binlog into dir of the backup unless the base backup holds it
+func (m *Manager) copyBinlog(ctx context.Context, name string, baseFiles map[string]*LogFile,
+	fieldID UniqueID, binlog *datapb.Binlog, dir string) (*LogFile, error) {
+	logID := binlog.GetLogID()
+	if logID == 0 {
+		var err error
+		if logID, err = strconv.ParseInt(path.Base(binlog.GetLogPath()), 10, 64); err != nil {
+			return nil, fmt.Errorf("failed to parse log id of binlog %s, err: %w", binlog.GetLogPath(), err)
+		}
+	}
+	if file, ok := baseFiles[fileKey(fieldID, logID)]; ok {
+		return file, nil
+	}
+
+	file := &LogFile{
+		FieldID: fieldID,
+		LogID:   logID,
+		Backup:  name,
+		Path:    path.Join(dir, strconv.FormatInt(logID, 10)),
+	}
+	var err error
+	if file.Size, err = copyFile(ctx, m.Storage, binlog.GetLogPath(), m.BackupStorage, path.Join(m.backupDir(name), file.Path)); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// copyChunkSize is the size of the ranges a file is copied by
+var copyChunkSize int64 = 16 << 20
+
+// copyFile copies a binlog from src to dst by ranges of copyChunkSize instead of reading it whole into memory,
+// it returns the size of the binlog
+func copyFile(ctx context.Context, src ChunkManager, srcPath string, dst ChunkManager, dstPath string) (int64, error) {
+	size, err := src.Size(ctx, srcPath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read binlog %s, err: %w", srcPath, err)
+	}
+	reader := &rangeReader{ctx: ctx, storage: src, filePath: srcPath, size: size}
+	if err := dst.WriteFrom(ctx, dstPath, reader, size); err != nil {
+		// the write fails if the source can't be read
+		if reader.err != nil {
+			return 0, fmt.Errorf("failed to read binlog %s, err: %w", srcPath, reader.err)
+		}
+		return 0, fmt.Errorf("failed to write binlog %s, err: %w", dstPath, err)
+	}
+	return size, nil
+}
+
+// rangeReader reads a file sequentially, a range of copyChunkSize at a time
+type rangeReader struct {
+	ctx      context.Context
+	storage  ChunkManager
+	filePath string
+	size     int64
+	offset   int64
+	buf      []byte
+	err      error // the error of reading a range
+}
+
+// Read implements io.Reader
+func (r *rangeReader) Read(p []byte) (int, error) {
+	if len(r.buf) == 0 {
+		if r.offset >= r.size {
+			return 0, io.EOF
+		}
+		length := r.size - r.offset
+		if length > copyChunkSize {
+			length = copyChunkSize
+		}
+		data, err := r.storage.ReadAt(r.ctx, r.filePath, r.offset, length)
+		if err == nil && int64(len(data)) != length {
+			err = fmt.Errorf("read %d bytes at offset %d, expected %d", len(data), r.offset, length)
+		}
+		if err != nil {
+			r.err = err
+			return 0, err
+		}
+		r.offset += length
+		r.buf = data
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func fileKey(fieldID, logID UniqueID) string {
+	return fmt.Sprintf("%d/%d", fieldID, logID)
+}
+
+func (m *Manager) backupRBAC(ctx context.Context) (*RBACInfo, error) {
+	rbac := &RBACInfo{}
+	roles, err := m.RootCoord.SelectRole(ctx, &milvuspb.SelectRoleRequest{
+		Base:            commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_SelectRole)),
+		IncludeUserInfo: true,
+	})
+	if err == nil {
+		err = statusError(roles.GetStatus())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles, err: %w", err)
+	}
+	userRoles := make(map[string][]string)
+	for _, result := range roles.GetResults() {
+		role := result.GetRole().GetName()
+		rbac.Roles = append(rbac.Roles, role)
+		for _, user := range result.GetUsers() {
+			userRoles[user.GetName()] = append(userRoles[user.GetName()], role)
+		}
+
+		grants, err := m.RootCoord.SelectGrant(ctx, &milvuspb.SelectGrantRequest{
+			Base:   commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_SelectGrant)),
+			Entity: &milvuspb.GrantEntity{Role: &milvuspb.RoleEntity{Name: role}},
+		})
+		if err == nil {
+			err = statusError(grants.GetStatus())
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list grants of role %s, err: %w", role, err)
+		}
+		for _, entity := range grants.GetEntities() {
+			rbac.Grants = append(rbac.Grants, &GrantInfo{
+				Role:       role,
+				Object:     entity.GetObject().GetName(),
+				ObjectName: entity.GetObjectName(),
+				Privilege:  entity.GetGrantor().GetPrivilege().GetName(),
+				Grantor:    entity.GetGrantor().GetUser().GetName(),
+			})
+		}
+	}
+
+	users, err := m.RootCoord.ListCredUsers(ctx, &milvuspb.ListCredUsersRequest{
+		Base: commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_ListCredUsernames)),
+	})
+	if err == nil {
+		err = statusError(users.GetStatus())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users, err: %w", err)
+	}
+	for _, name := range users.GetUsernames() {
+		cred, err := m.RootCoord.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{
+			Base:     commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_GetCredential)),
+			Username: name,
+		})
+		if err == nil {
+			err = statusError(cred.GetStatus())
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get credential of user %s, err: %w", name, err)
+		}
+		rbac.Users = append(rbac.Users, &UserInfo{
+			Name:              name,
+			EncryptedPassword: cred.GetPassword(),
+			Roles:             userRoles[name],
+		})
+	}
+	return rbac, nil
+}
@@ -0,0 +1,289 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// testCluster is a cluster with its backup storage
+type testCluster struct {
+	rc      *mockRootCoord
+	dc      *mockDataCoord
+	ic      *mockIndexCoord
+	storage *mockChunkManager
+	backups *mockChunkManager
+	manager *Manager
+}
+
+func newTestCluster(nextID UniqueID, backups *mockChunkManager) *testCluster {
+	storage := newMockChunkManager("files")
+	c := &testCluster{
+		rc:      newMockRootCoord(nextID, storage),
+		dc:      newMockDataCoord(),
+		ic:      newMockIndexCoord(),
+		storage: storage,
+		backups: backups,
+	}
+	c.manager = NewManager(Config{
+		RootCoord:     c.rc,
+		DataCoord:     c.dc,
+		IndexCoord:    c.ic,
+		Storage:       storage,
+		BackupStorage: backups,
+		PollInterval:  time.Millisecond,
+	})
+	return c
+}
+
+// addSegment writes the binlogs of a segment with a log per field and a delta log
+func (c *testCluster) addSegment(coll *mockCollection, partition string, segmentID UniqueID, state commonpb.SegmentState) {
+	partitionID := coll.partitions[partition]
+	segment := &datapb.SegmentInfo{
+		ID:           segmentID,
+		CollectionID: coll.id,
+		PartitionID:  partitionID,
+		NumOfRows:    10,
+		State:        state,
+	}
+	for _, field := range coll.schema.GetFields() {
+		logID := segmentID*10 + field.GetFieldID()%10
+		logPath := fmt.Sprintf("files/insert_log/%d/%d/%d/%d/%d", coll.id, partitionID, segmentID, field.GetFieldID(), logID)
+		c.storage.Write(context.Background(), logPath, []byte(logPath))
+		segment.Binlogs = append(segment.Binlogs, &datapb.FieldBinlog{
+			FieldID: field.GetFieldID(),
+			Binlogs: []*datapb.Binlog{{EntriesNum: 10, LogID: logID, LogPath: logPath}},
+		})
+	}
+	deltaPath := fmt.Sprintf("files/delta_log/%d/%d/%d/%d", coll.id, partitionID, segmentID, segmentID*10+9)
+	c.storage.Write(context.Background(), deltaPath, []byte(deltaPath))
+	segment.Deltalogs = []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{EntriesNum: 1, LogPath: deltaPath}}}}
+	c.dc.addSegment(segment)
+}
+
+// setup adds a collection with two partitions, an index, an alias, and a user with a role and a grant
+func (c *testCluster) setup() *mockCollection {
+	coll := c.rc.addCollection("coll")
+	coll.aliases = []string{"alias"}
+	c.rc.addPartition("coll", "part")
+	c.addSegment(coll, "_default", 1, commonpb.SegmentState_Flushed)
+	c.addSegment(coll, "part", 2, commonpb.SegmentState_Flushed)
+	c.addSegment(coll, "part", 3, commonpb.SegmentState_Growing)
+	c.ic.indexes[coll.id] = []*indexpb.IndexInfo{{
+		FieldID:     101,
+		IndexName:   "vec_index",
+		IndexParams: []*commonpb.KeyValuePair{{Key: "index_type", Value: "IVF_FLAT"}},
+	}}
+
+	c.rc.users["user"] = "encrypted-user"
+	c.rc.roles["role"] = []string{"user"}
+	c.rc.grants = append(c.rc.grants, &milvuspb.GrantEntity{
+		Role:       &milvuspb.RoleEntity{Name: "role"},
+		Object:     &milvuspb.ObjectEntity{Name: commonpb.ObjectType_Collection.String()},
+		ObjectName: "coll",
+		Grantor: &milvuspb.GrantorEntity{
+			User:      &milvuspb.UserEntity{Name: "root"},
+			Privilege: &milvuspb.PrivilegeEntity{Name: "Search"},
+		},
+	})
+	return coll
+}
+
+func segmentsOf(coll *CollectionInfo) map[UniqueID]*SegmentInfo {
+	segments := make(map[UniqueID]*SegmentInfo)
+	for _, partition := range coll.Partitions {
+		for _, segment := range partition.Segments {
+			segments[segment.ID] = segment
+		}
+	}
+	return segments
+}
+
+func TestManager_Backup(t *testing.T) {
+	ctx := context.Background()
+	c := newTestCluster(100, newMockChunkManager("backups"))
+	coll := c.setup()
+	// the binlogs are copied by several ranges
+	defer func(size int64) { copyChunkSize = size }(copyChunkSize)
+	copyChunkSize = 16
+
+	_, err := c.manager.Get(ctx, "b1")
+	assert.True(t, errors.Is(err, ErrBackupNotFound))
+
+	info, err := c.manager.Backup(ctx, BackupOptions{Name: "b1", Flush: true, WithRBAC: true})
+	require.NoError(t, err)
+	assert.Equal(t, []UniqueID{coll.id}, c.dc.flushed)
+	assert.NotZero(t, info.BackupTs)
+	require.Equal(t, 1, len(info.Collections))
+
+	collInfo := info.Collections[0]
+	assert.Equal(t, "coll", collInfo.Name)
+	assert.Equal(t, int32(2), collInfo.ShardsNum)
+	assert.Equal(t, []string{"alias"}, collInfo.Aliases)
+	require.Equal(t, 1, len(collInfo.Indexes))
+	assert.Equal(t, "vec", collInfo.Indexes[0].FieldName)
+	assert.Equal(t, 2, len(collInfo.Partitions))
+
+	// the growing segment is flushed before the backup
+	segments := segmentsOf(collInfo)
+	assert.Equal(t, 3, len(segments))
+	for _, segment := range segments {
+		assert.Equal(t, 2, len(segment.InsertLogs))
+		assert.Equal(t, 1, len(segment.DeltaLogs))
+		for _, file := range append(segment.InsertLogs, segment.DeltaLogs...) {
+			assert.Equal(t, "b1", file.Backup)
+			data, err := c.backups.Read(ctx, path.Join("backups", "b1", file.Path))
+			require.NoError(t, err)
+			assert.Equal(t, file.Size, int64(len(data)))
+			// the content of a binlog is its path
+			assert.Equal(t, path.Join("files", file.Path), string(data))
+		}
+	}
+	assert.Greater(t, c.storage.reads, 3*3)
+	assert.Equal(t, UniqueID(19), segments[1].DeltaLogs[0].LogID)
+
+	require.NotNil(t, info.RBAC)
+	assert.ElementsMatch(t, []string{"admin", "public", "role"}, info.RBAC.Roles)
+	assert.Equal(t, 2, len(info.RBAC.Users))
+	assert.Equal(t, []*GrantInfo{{Role: "role", Object: "Collection", ObjectName: "coll", Privilege: "Search", Grantor: "root"}},
+		info.RBAC.Grants)
+
+	got, err := c.manager.Get(ctx, "b1")
+	require.NoError(t, err)
+	assert.Equal(t, info, got)
+
+	_, err = c.manager.Backup(ctx, BackupOptions{Name: "b1"})
+	assert.Error(t, err)
+	_, err = c.manager.Backup(ctx, BackupOptions{Name: "b2", Collections: []string{"missing"}})
+	assert.Error(t, err)
+	_, err = c.manager.Get(ctx, "b2")
+	assert.True(t, errors.Is(err, ErrBackupNotFound))
+}
+
+func TestManager_BackupIncremental(t *testing.T) {
+	ctx := context.Background()
+	c := newTestCluster(100, newMockChunkManager("backups"))
+	coll := c.setup()
+
+	_, err := c.manager.Backup(ctx, BackupOptions{Name: "b1"})
+	require.NoError(t, err)
+	_, err = c.manager.Backup(ctx, BackupOptions{Name: "b2", Base: "missing"})
+	assert.Error(t, err)
+
+	// segment 1 is compacted into segment 4 and segment 5 is flushed after the first backup
+	c.dc.segments[1].State = commonpb.SegmentState_Dropped
+	c.addSegment(coll, "_default", 4, commonpb.SegmentState_Flushed)
+	c.addSegment(coll, "part", 5, commonpb.SegmentState_Flushed)
+
+	info, err := c.manager.Backup(ctx, BackupOptions{Name: "b2", Base: "b1", Collections: []string{"coll"}})
+	require.NoError(t, err)
+	assert.Equal(t, "b1", info.Base)
+	assert.Nil(t, info.RBAC)
+
+	segments := segmentsOf(info.Collections[0])
+	assert.Equal(t, 3, len(segments))
+	assert.NotContains(t, segments, UniqueID(1))
+	for id, backup := range map[UniqueID]string{2: "b1", 4: "b2", 5: "b2"} {
+		for _, file := range append(segments[id].InsertLogs, segments[id].DeltaLogs...) {
+			assert.Equal(t, backup, file.Backup)
+		}
+	}
+
+	// only the new segments are copied by the incremental backup
+	files, _, err := c.backups.ListWithPrefix(ctx, "backups/b2/", true)
+	require.NoError(t, err)
+	assert.Equal(t, 2*3+1, len(files))
+}
+
+func TestManager_BackupAtTimestamp(t *testing.T) {
+	ctx := context.Background()
+	c := newTestCluster(100, newMockChunkManager("backups"))
+	coll := c.setup()
+
+	// the backup ts is the next one allocated
+	backupTs := tsoutil.ComposeTS(c.rc.physical+1, 1)
+	droppedAt := uint64(tsoutil.PhysicalTime(backupTs).UnixNano())
+	// segment 1 was compacted into segment 4 before the backup ts
+	c.dc.segments[1].State = commonpb.SegmentState_Dropped
+	c.dc.segments[1].DroppedAt = droppedAt - 1
+	c.addSegment(coll, "_default", 4, commonpb.SegmentState_Flushed)
+	c.dc.segments[4].CompactionFrom = []UniqueID{1}
+	// segment 2 is compacted into segment 5 after the backup ts, and segment 5 into segment 6
+	c.dc.segments[2].State = commonpb.SegmentState_Dropped
+	c.dc.segments[2].DroppedAt = droppedAt + 1
+	c.addSegment(coll, "part", 5, commonpb.SegmentState_Dropped)
+	c.dc.segments[5].CompactionFrom = []UniqueID{2}
+	c.dc.segments[5].DroppedAt = droppedAt + 2
+	c.addSegment(coll, "part", 6, commonpb.SegmentState_Flushed)
+	c.dc.segments[6].CompactionFrom = []UniqueID{5}
+	// partition late is created after the backup ts
+	c.rc.addPartition("coll", "late")
+	coll.partitionsTs = map[string]Timestamp{"late": backupTs + 1}
+	c.addSegment(coll, "late", 7, commonpb.SegmentState_Flushed)
+
+	info, err := c.manager.Backup(ctx, BackupOptions{Name: "b1"})
+	require.NoError(t, err)
+	assert.Equal(t, backupTs, info.BackupTs)
+	assert.Equal(t, 2, len(info.Collections[0].Partitions))
+
+	segments := segmentsOf(info.Collections[0])
+	assert.Equal(t, 2, len(segments))
+	assert.Contains(t, segments, UniqueID(2))
+	assert.Contains(t, segments, UniqueID(4))
+
+	// partition part is dropped after the backup ts, it can't be restored without its name
+	delete(coll.partitions, "part")
+	_, err = c.manager.Backup(ctx, BackupOptions{Name: "b2"})
+	assert.Error(t, err)
+	_, err = c.manager.Get(ctx, "b2")
+	assert.True(t, errors.Is(err, ErrBackupNotFound))
+}
+
+func TestManager_BackupFailure(t *testing.T) {
+	ctx := context.Background()
+	c := newTestCluster(100, newMockChunkManager("backups"))
+	c.setup()
+
+	_, err := c.manager.Backup(ctx, BackupOptions{})
+	assert.Error(t, err)
+
+	c.ic.err = errors.New("mock error")
+	_, err = c.manager.Backup(ctx, BackupOptions{Name: "b1"})
+	assert.Error(t, err)
+	c.ic.err = nil
+
+	// a missing binlog fails the backup without writing the manifest
+	c.storage.RemoveWithPrefix(ctx, "files/delta_log")
+	_, err = c.manager.Backup(ctx, BackupOptions{Name: "b1"})
+	assert.Error(t, err)
+	_, err = c.manager.Get(ctx, "b1")
+	assert.True(t, errors.Is(err, ErrBackupNotFound))
+}
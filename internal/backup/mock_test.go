@@ -0,0 +1,580 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+func successStatus() *commonpb.Status {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+}
+
+func failStatus(reason string) *commonpb.Status {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: reason}
+}
+
+// mockChunkManager keeps the files in memory
+type mockChunkManager struct {
+	mut      sync.Mutex
+	rootPath string
+	files    map[string][]byte
+	// reads is the number of ranges read
+	reads int
+}
+
+func newMockChunkManager(rootPath string) *mockChunkManager {
+	return &mockChunkManager{rootPath: rootPath, files: make(map[string][]byte)}
+}
+
+func (cm *mockChunkManager) RootPath() string {
+	return cm.rootPath
+}
+
+func (cm *mockChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	cm.mut.Lock()
+	defer cm.mut.Unlock()
+	data, ok := cm.files[filePath]
+	if !ok {
+		return nil, fmt.Errorf("file %s not found", filePath)
+	}
+	return data, nil
+}
+
+func (cm *mockChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
+	cm.mut.Lock()
+	defer cm.mut.Unlock()
+	data, ok := cm.files[filePath]
+	if !ok {
+		return 0, fmt.Errorf("file %s not found", filePath)
+	}
+	return int64(len(data)), nil
+}
+
+func (cm *mockChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	cm.mut.Lock()
+	defer cm.mut.Unlock()
+	data, ok := cm.files[filePath]
+	if !ok {
+		return nil, fmt.Errorf("file %s not found", filePath)
+	}
+	if off < 0 || length < 0 || off+length > int64(len(data)) {
+		return nil, io.EOF
+	}
+	cm.reads++
+	return data[off : off+length], nil
+}
+
+func (cm *mockChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	cm.mut.Lock()
+	defer cm.mut.Unlock()
+	cm.files[filePath] = content
+	return nil
+}
+
+func (cm *mockChunkManager) WriteFrom(ctx context.Context, filePath string, reader io.Reader, size int64) error {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	if int64(len(content)) != size {
+		return fmt.Errorf("read %d bytes for %s, expected %d", len(content), filePath, size)
+	}
+	return cm.Write(ctx, filePath, content)
+}
+
+func (cm *mockChunkManager) Exist(ctx context.Context, filePath string) (bool, error) {
+	cm.mut.Lock()
+	defer cm.mut.Unlock()
+	_, ok := cm.files[filePath]
+	return ok, nil
+}
+
+func (cm *mockChunkManager) ListWithPrefix(ctx context.Context, prefix string, recursive bool) ([]string, []time.Time, error) {
+	cm.mut.Lock()
+	defer cm.mut.Unlock()
+	var keys []string
+	var modTimes []time.Time
+	for key := range cm.files {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+			modTimes = append(modTimes, time.Time{})
+		}
+	}
+	sort.Strings(keys)
+	return keys, modTimes, nil
+}
+
+func (cm *mockChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	cm.mut.Lock()
+	defer cm.mut.Unlock()
+	for key := range cm.files {
+		if strings.HasPrefix(key, prefix) {
+			delete(cm.files, key)
+		}
+	}
+	return nil
+}
+
+type mockCollection struct {
+	id         UniqueID
+	schema     *schemapb.CollectionSchema
+	shards     int32
+	partitions map[string]UniqueID
+	// partitionsTs are the created timestamps of the partitions, 0 if missing
+	partitionsTs map[string]Timestamp
+	aliases      []string
+}
+
+type mockImport struct {
+	req *milvuspb.ImportRequest
+	// files are the staged binlogs found in the cluster storage when the import is requested
+	files []string
+}
+
+// mockRootCoord keeps the collections and the rbac of a cluster in memory
+type mockRootCoord struct {
+	mut         sync.Mutex
+	nextID      UniqueID
+	physical    int64
+	storage     *mockChunkManager
+	collections map[string]*mockCollection
+	imports     []*mockImport
+	importState commonpb.ImportState
+
+	users  map[string]string
+	roles  map[string][]string
+	grants []*milvuspb.GrantEntity
+}
+
+func newMockRootCoord(nextID UniqueID, storage *mockChunkManager) *mockRootCoord {
+	return &mockRootCoord{
+		nextID:      nextID,
+		physical:    1000,
+		storage:     storage,
+		collections: make(map[string]*mockCollection),
+		importState: commonpb.ImportState_ImportPersisted,
+		users:       map[string]string{"root": "encrypted-root"},
+		roles:       map[string][]string{"admin": {"root"}, "public": nil},
+	}
+}
+
+func (rc *mockRootCoord) allocID() UniqueID {
+	rc.nextID++
+	return rc.nextID
+}
+
+// addCollection adds a collection with a pk and a vector field and the default partition
+func (rc *mockRootCoord) addCollection(name string) *mockCollection {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	coll := &mockCollection{
+		id: rc.allocID(),
+		schema: &schemapb.CollectionSchema{
+			Name: name,
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector,
+					TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "4"}}},
+			},
+		},
+		shards:     2,
+		partitions: map[string]UniqueID{"_default": rc.allocID()},
+	}
+	rc.collections[name] = coll
+	return coll
+}
+
+func (rc *mockRootCoord) addPartition(collection, partition string) UniqueID {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	id := rc.allocID()
+	rc.collections[collection].partitions[partition] = id
+	return id
+}
+
+func (rc *mockRootCoord) AllocTimestamp(ctx context.Context, req *rootcoordpb.AllocTimestampRequest) (*rootcoordpb.AllocTimestampResponse, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	rc.physical++
+	return &rootcoordpb.AllocTimestampResponse{Status: successStatus(), Timestamp: tsoutil.ComposeTS(rc.physical, 1), Count: 1}, nil
+}
+
+func (rc *mockRootCoord) ShowCollections(ctx context.Context, req *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	resp := &milvuspb.ShowCollectionsResponse{Status: successStatus()}
+	for name := range rc.collections {
+		resp.CollectionNames = append(resp.CollectionNames, name)
+	}
+	sort.Strings(resp.CollectionNames)
+	return resp, nil
+}
+
+func (rc *mockRootCoord) DescribeCollection(ctx context.Context, req *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	coll, ok := rc.collections[req.GetCollectionName()]
+	if !ok {
+		return &milvuspb.DescribeCollectionResponse{Status: failStatus("collection not found")}, nil
+	}
+	return &milvuspb.DescribeCollectionResponse{
+		Status:           successStatus(),
+		Schema:           proto.Clone(coll.schema).(*schemapb.CollectionSchema),
+		CollectionID:     coll.id,
+		ShardsNum:        coll.shards,
+		Aliases:          coll.aliases,
+		ConsistencyLevel: commonpb.ConsistencyLevel_Bounded,
+	}, nil
+}
+
+func (rc *mockRootCoord) CreateCollection(ctx context.Context, req *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	if _, ok := rc.collections[req.GetCollectionName()]; ok {
+		return failStatus("collection already exists"), nil
+	}
+	schema := &schemapb.CollectionSchema{}
+	if err := proto.Unmarshal(req.GetSchema(), schema); err != nil {
+		return nil, err
+	}
+	if schema.GetName() != req.GetCollectionName() {
+		return failStatus("collection name doesn't match the schema"), nil
+	}
+	// the new collection numbers its fields from 200 so the tests can check the field mapping
+	for i, field := range schema.GetFields() {
+		field.FieldID = int64(200 + i)
+	}
+	rc.collections[req.GetCollectionName()] = &mockCollection{
+		id:         rc.allocID(),
+		schema:     schema,
+		shards:     req.GetShardsNum(),
+		partitions: map[string]UniqueID{"_default": rc.allocID()},
+	}
+	return successStatus(), nil
+}
+
+func (rc *mockRootCoord) ShowPartitions(ctx context.Context, req *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	for _, coll := range rc.collections {
+		if coll.id != req.GetCollectionID() {
+			continue
+		}
+		resp := &milvuspb.ShowPartitionsResponse{Status: successStatus()}
+		for name := range coll.partitions {
+			resp.PartitionNames = append(resp.PartitionNames, name)
+		}
+		sort.Strings(resp.PartitionNames)
+		for _, name := range resp.PartitionNames {
+			resp.PartitionIDs = append(resp.PartitionIDs, coll.partitions[name])
+			resp.CreatedTimestamps = append(resp.CreatedTimestamps, coll.partitionsTs[name])
+		}
+		return resp, nil
+	}
+	return &milvuspb.ShowPartitionsResponse{Status: failStatus("collection not found")}, nil
+}
+
+func (rc *mockRootCoord) CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	coll, ok := rc.collections[req.GetCollectionName()]
+	if !ok {
+		return failStatus("collection not found"), nil
+	}
+	coll.partitions[req.GetPartitionName()] = rc.allocID()
+	return successStatus(), nil
+}
+
+func (rc *mockRootCoord) CreateAlias(ctx context.Context, req *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	coll, ok := rc.collections[req.GetCollectionName()]
+	if !ok {
+		return failStatus("collection not found"), nil
+	}
+	coll.aliases = append(coll.aliases, req.GetAlias())
+	return successStatus(), nil
+}
+
+func (rc *mockRootCoord) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
+	files, _, _ := rc.storage.ListWithPrefix(ctx, req.GetFiles()[0], true)
+	if len(req.GetFiles()) > 1 && req.GetFiles()[1] != "" {
+		deltaFiles, _, _ := rc.storage.ListWithPrefix(ctx, req.GetFiles()[1], true)
+		files = append(files, deltaFiles...)
+	}
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	rc.imports = append(rc.imports, &mockImport{req: req, files: files})
+	return &milvuspb.ImportResponse{Status: successStatus(), Tasks: []int64{rc.allocID()}}, nil
+}
+
+func (rc *mockRootCoord) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	resp := &milvuspb.GetImportStateResponse{Status: successStatus(), State: rc.importState, Id: req.GetTask()}
+	if rc.importState == commonpb.ImportState_ImportFailed {
+		resp.Infos = []*commonpb.KeyValuePair{{Key: importFailedReason, Value: "mock failure"}}
+	}
+	return resp, nil
+}
+
+func (rc *mockRootCoord) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	resp := &milvuspb.ListCredUsersResponse{Status: successStatus()}
+	for name := range rc.users {
+		resp.Usernames = append(resp.Usernames, name)
+	}
+	sort.Strings(resp.Usernames)
+	return resp, nil
+}
+
+func (rc *mockRootCoord) GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	password, ok := rc.users[req.GetUsername()]
+	if !ok {
+		return &rootcoordpb.GetCredentialResponse{Status: failStatus("user not found")}, nil
+	}
+	return &rootcoordpb.GetCredentialResponse{Status: successStatus(), Username: req.GetUsername(), Password: password}, nil
+}
+
+func (rc *mockRootCoord) CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	if _, ok := rc.users[req.GetUsername()]; ok {
+		return failStatus("user already exists"), nil
+	}
+	rc.users[req.GetUsername()] = req.GetEncryptedPassword()
+	return successStatus(), nil
+}
+
+func (rc *mockRootCoord) SelectRole(ctx context.Context, req *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	var names []string
+	for name := range rc.roles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	resp := &milvuspb.SelectRoleResponse{Status: successStatus()}
+	for _, name := range names {
+		result := &milvuspb.RoleResult{Role: &milvuspb.RoleEntity{Name: name}}
+		if req.GetIncludeUserInfo() {
+			for _, user := range rc.roles[name] {
+				result.Users = append(result.Users, &milvuspb.UserEntity{Name: user})
+			}
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	return resp, nil
+}
+
+func (rc *mockRootCoord) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	if _, ok := rc.roles[req.GetEntity().GetName()]; ok {
+		return failStatus("role already exists"), nil
+	}
+	rc.roles[req.GetEntity().GetName()] = nil
+	return successStatus(), nil
+}
+
+func (rc *mockRootCoord) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	users, ok := rc.roles[req.GetRoleName()]
+	if !ok {
+		return failStatus("role not found"), nil
+	}
+	if _, ok := rc.users[req.GetUsername()]; !ok {
+		return failStatus("user not found"), nil
+	}
+	for _, user := range users {
+		if user == req.GetUsername() {
+			return successStatus(), nil
+		}
+	}
+	rc.roles[req.GetRoleName()] = append(users, req.GetUsername())
+	return successStatus(), nil
+}
+
+func (rc *mockRootCoord) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	resp := &milvuspb.SelectGrantResponse{Status: successStatus()}
+	for _, grant := range rc.grants {
+		if grant.GetRole().GetName() == req.GetEntity().GetRole().GetName() {
+			resp.Entities = append(resp.Entities, grant)
+		}
+	}
+	return resp, nil
+}
+
+func (rc *mockRootCoord) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	if _, ok := rc.roles[req.GetEntity().GetRole().GetName()]; !ok {
+		return failStatus("role not found"), nil
+	}
+	if _, ok := rc.users[req.GetEntity().GetGrantor().GetUser().GetName()]; !ok {
+		return failStatus("grantor not found"), nil
+	}
+	rc.grants = append(rc.grants, req.GetEntity())
+	return successStatus(), nil
+}
+
+// mockDataCoord keeps the segments of a cluster in memory
+type mockDataCoord struct {
+	mut      sync.Mutex
+	segments map[UniqueID]*datapb.SegmentInfo
+	flushed  []UniqueID
+	// growing segments are flushed by the Flush call
+	growing []UniqueID
+}
+
+func newMockDataCoord() *mockDataCoord {
+	return &mockDataCoord{segments: make(map[UniqueID]*datapb.SegmentInfo)}
+}
+
+func (dc *mockDataCoord) addSegment(segment *datapb.SegmentInfo) {
+	dc.mut.Lock()
+	defer dc.mut.Unlock()
+	dc.segments[segment.GetID()] = segment
+	if segment.GetState() == commonpb.SegmentState_Growing {
+		dc.growing = append(dc.growing, segment.GetID())
+	}
+}
+
+func (dc *mockDataCoord) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
+	dc.mut.Lock()
+	defer dc.mut.Unlock()
+	dc.flushed = append(dc.flushed, req.GetCollectionID())
+	resp := &datapb.FlushResponse{Status: successStatus(), CollectionID: req.GetCollectionID()}
+	for _, id := range dc.growing {
+		segment := dc.segments[id]
+		if segment.GetCollectionID() == req.GetCollectionID() {
+			segment.State = commonpb.SegmentState_Flushed
+			resp.SegmentIDs = append(resp.SegmentIDs, id)
+		}
+	}
+	return resp, nil
+}
+
+func (dc *mockDataCoord) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+	dc.mut.Lock()
+	defer dc.mut.Unlock()
+	for _, id := range req.GetSegmentIDs() {
+		if dc.segments[id].GetState() != commonpb.SegmentState_Flushed {
+			return &milvuspb.GetFlushStateResponse{Status: successStatus()}, nil
+		}
+	}
+	return &milvuspb.GetFlushStateResponse{Status: successStatus(), Flushed: true}, nil
+}
+
+func (dc *mockDataCoord) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
+	dc.mut.Lock()
+	defer dc.mut.Unlock()
+	resp := &datapb.GetFlushedSegmentsResponse{Status: successStatus()}
+	for id, segment := range dc.segments {
+		// a negative partition id lists all the partitions
+		if segment.GetCollectionID() != req.GetCollectionID() ||
+			(req.GetPartitionID() >= 0 && segment.GetPartitionID() != req.GetPartitionID()) {
+			continue
+		}
+		if segment.GetState() == commonpb.SegmentState_Flushed ||
+			(req.GetIncludeUnhealthy() && segment.GetState() == commonpb.SegmentState_Dropped) {
+			resp.Segments = append(resp.Segments, id)
+		}
+	}
+	sort.Slice(resp.Segments, func(i, j int) bool { return resp.Segments[i] < resp.Segments[j] })
+	return resp, nil
+}
+
+func (dc *mockDataCoord) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+	dc.mut.Lock()
+	defer dc.mut.Unlock()
+	resp := &datapb.GetSegmentInfoResponse{Status: successStatus()}
+	for _, id := range req.GetSegmentIDs() {
+		segment, ok := dc.segments[id]
+		if !ok {
+			return &datapb.GetSegmentInfoResponse{Status: failStatus("segment not found")}, nil
+		}
+		resp.Infos = append(resp.Infos, segment)
+	}
+	return resp, nil
+}
+
+// mockIndexCoord keeps the indexes of a cluster in memory
+type mockIndexCoord struct {
+	mut     sync.Mutex
+	indexes map[UniqueID][]*indexpb.IndexInfo
+	created []*indexpb.CreateIndexRequest
+	err     error
+}
+
+func newMockIndexCoord() *mockIndexCoord {
+	return &mockIndexCoord{indexes: make(map[UniqueID][]*indexpb.IndexInfo)}
+}
+
+func (ic *mockIndexCoord) DescribeIndex(ctx context.Context, req *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+	ic.mut.Lock()
+	defer ic.mut.Unlock()
+	if ic.err != nil {
+		return nil, ic.err
+	}
+	indexes, ok := ic.indexes[req.GetCollectionID()]
+	if !ok {
+		return &indexpb.DescribeIndexResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_IndexNotExist}}, nil
+	}
+	return &indexpb.DescribeIndexResponse{Status: successStatus(), IndexInfos: indexes}, nil
+}
+
+func (ic *mockIndexCoord) CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
+	ic.mut.Lock()
+	defer ic.mut.Unlock()
+	if req.GetTimestamp() == 0 {
+		return nil, errors.New("create index without timestamp")
+	}
+	ic.created = append(ic.created, req)
+	ic.indexes[req.GetCollectionID()] = append(ic.indexes[req.GetCollectionID()], &indexpb.IndexInfo{
+		FieldID:     req.GetFieldID(),
+		IndexName:   req.GetIndexName(),
+		TypeParams:  req.GetTypeParams(),
+		IndexParams: req.GetIndexParams(),
+	})
+	return successStatus(), nil
+}
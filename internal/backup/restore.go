@@ -0,0 +1,431 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// RestoreOptions are the options of a restore.
+type RestoreOptions struct {
+	// Name of the backup to restore
+	Name string
+	// Collections to restore, all the collections of the backup if empty
+	Collections []string
+	// Rename maps the name of a collection in the backup to the name it is restored as
+	Rename map[string]string
+	// Suffix is appended to the names of the collections not renamed
+	Suffix string
+	// WithRBAC recreates the users, roles and privileges of the backup
+	WithRBAC bool
+}
+
+// targetName returns the name a collection is restored as
+func (opts RestoreOptions) targetName(name string) string {
+	if target, ok := opts.Rename[name]; ok {
+		return target
+	}
+	return name + opts.Suffix
+}
+
+// Restore recreates the collections of a backup and bulk loads their binlogs.
+// The restored collections must not exist, the binlogs are filtered by the backup timestamp.
+func (m *Manager) Restore(ctx context.Context, opts RestoreOptions) error {
+	info, err := m.Get(ctx, opts.Name)
+	if err != nil {
+		return err
+	}
+	collections := make(map[string]*CollectionInfo, len(info.Collections))
+	for _, coll := range info.Collections {
+		collections[coll.Name] = coll
+	}
+	names := opts.Collections
+	if len(names) == 0 {
+		for _, coll := range info.Collections {
+			names = append(names, coll.Name)
+		}
+	}
+
+	existing, err := m.showCollections(ctx)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if _, ok := collections[name]; !ok {
+			return fmt.Errorf("collection %s not found in backup %s", name, opts.Name)
+		}
+		if funcutil.SliceContain(existing, opts.targetName(name)) {
+			return fmt.Errorf("collection %s already exists", opts.targetName(name))
+		}
+	}
+
+	log.Info("restore started", zap.String("name", info.Name), zap.Uint64("backup ts", info.BackupTs),
+		zap.Strings("collections", names))
+	if opts.WithRBAC && info.RBAC != nil {
+		if err := m.restoreRBAC(ctx, info.RBAC); err != nil {
+			return fmt.Errorf("failed to restore rbac, err: %w", err)
+		}
+	}
+	for _, name := range names {
+		if err := m.restoreCollection(ctx, info, collections[name], opts.targetName(name)); err != nil {
+			return fmt.Errorf("failed to restore collection %s, err: %w", name, err)
+		}
+	}
+	log.Info("restore finished", zap.String("name", info.Name))
+	return nil
+}
+
+func (m *Manager) restoreCollection(ctx context.Context, info *Info, coll *CollectionInfo, target string) error {
+	schema := &schemapb.CollectionSchema{}
+	if err := proto.Unmarshal(coll.Schema, schema); err != nil {
+		return err
+	}
+	schema.Name = target
+	schemaBytes, err := proto.Marshal(schema)
+	if err != nil {
+		return err
+	}
+	status, err := m.RootCoord.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		Base:             commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_CreateCollection)),
+		CollectionName:   target,
+		Schema:           schemaBytes,
+		ShardsNum:        coll.ShardsNum,
+		ConsistencyLevel: coll.ConsistencyLevel,
+	})
+	if err == nil {
+		err = statusError(status)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create collection %s, err: %w", target, err)
+	}
+
+	desc, err := m.describeCollection(ctx, target, 0)
+	if err != nil {
+		return err
+	}
+	if err := m.restorePartitions(ctx, desc.GetCollectionID(), target, coll.Partitions); err != nil {
+		return err
+	}
+
+	// the restored fields are matched by name, the system fields keep their ids
+	fieldIDs := make(map[string]UniqueID)
+	for _, field := range desc.GetSchema().GetFields() {
+		fieldIDs[field.GetName()] = field.GetFieldID()
+	}
+	fieldMapping := make(map[UniqueID]UniqueID)
+	for _, field := range schema.GetFields() {
+		if id, ok := fieldIDs[field.GetName()]; ok {
+			fieldMapping[field.GetFieldID()] = id
+		}
+	}
+	for _, index := range coll.Indexes {
+		if err := m.restoreIndex(ctx, desc.GetCollectionID(), fieldIDs, index); err != nil {
+			return fmt.Errorf("failed to create index %s, err: %w", index.IndexName, err)
+		}
+	}
+	// aliases are unique in the cluster, a renamed collection can't take them over
+	if target == coll.Name {
+		for _, alias := range coll.Aliases {
+			status, err := m.RootCoord.CreateAlias(ctx, &milvuspb.CreateAliasRequest{
+				Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_CreateAlias)),
+				CollectionName: target,
+				Alias:          alias,
+			})
+			if err == nil {
+				err = statusError(status)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to create alias %s, err: %w", alias, err)
+			}
+		}
+	}
+
+	for _, partition := range coll.Partitions {
+		if len(partition.Segments) == 0 {
+			continue
+		}
+		if err := m.restorePartition(ctx, info, target, partition, fieldMapping); err != nil {
+			return fmt.Errorf("failed to restore partition %s, err: %w", partition.Name, err)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) restorePartitions(ctx context.Context, collectionID UniqueID, target string, partitions []*PartitionInfo) error {
+	resp, err := m.showPartitions(ctx, collectionID)
+	if err != nil {
+		return err
+	}
+	for _, partition := range partitions {
+		if funcutil.SliceContain(resp.GetPartitionNames(), partition.Name) {
+			continue
+		}
+		status, err := m.RootCoord.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+			Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_CreatePartition)),
+			CollectionName: target,
+			PartitionName:  partition.Name,
+		})
+		if err == nil {
+			err = statusError(status)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create partition %s, err: %w", partition.Name, err)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) restoreIndex(ctx context.Context, collectionID UniqueID, fieldIDs map[string]UniqueID, index *IndexInfo) error {
+	fieldID, ok := fieldIDs[index.FieldName]
+	if !ok {
+		return fmt.Errorf("field %s not found", index.FieldName)
+	}
+	ts, err := m.allocTimestamp(ctx)
+	if err != nil {
+		return err
+	}
+	status, err := m.IndexCoord.CreateIndex(ctx, &indexpb.CreateIndexRequest{
+		CollectionID:    collectionID,
+		FieldID:         fieldID,
+		IndexName:       index.IndexName,
+		TypeParams:      index.TypeParams,
+		IndexParams:     index.IndexParams,
+		Timestamp:       ts,
+		IsAutoIndex:     index.IsAutoIndex,
+		UserIndexParams: index.UserIndexParams,
+	})
+	if err != nil {
+		return err
+	}
+	return statusError(status)
+}
+
+// restorePartition stages the binlogs of a partition in the cluster storage with the layout expected
+// by the binlog import, then bulk loads them and waits until the import is persisted
+func (m *Manager) restorePartition(ctx context.Context, info *Info, target string, partition *PartitionInfo,
+	fieldMapping map[UniqueID]UniqueID) error {
+	stagingDir := path.Join(m.Storage.RootPath(), restoreStagingDir, info.Name, target, strconv.FormatInt(partition.ID, 10))
+	defer func() {
+		if err := m.Storage.RemoveWithPrefix(context.Background(), stagingDir); err != nil {
+			log.Warn("failed to remove the staged binlogs", zap.String("dir", stagingDir), zap.Error(err))
+		}
+	}()
+
+	insertDir := path.Join(stagingDir, insertLogDir)
+	deltaDir := path.Join(stagingDir, deltaLogDir)
+	hasDelta := false
+	for _, segment := range partition.Segments {
+		segmentID := strconv.FormatInt(segment.ID, 10)
+		for _, file := range segment.InsertLogs {
+			fieldID, ok := fieldMapping[file.FieldID]
+			if !ok {
+				fieldID = file.FieldID
+			}
+			dst := path.Join(insertDir, segmentID, strconv.FormatInt(fieldID, 10), strconv.FormatInt(file.LogID, 10))
+			if err := m.stageBinlog(ctx, file, dst); err != nil {
+				return err
+			}
+		}
+		for _, file := range segment.DeltaLogs {
+			dst := path.Join(deltaDir, segmentID, strconv.FormatInt(file.LogID, 10))
+			if err := m.stageBinlog(ctx, file, dst); err != nil {
+				return err
+			}
+			hasDelta = true
+		}
+	}
+	if !hasDelta {
+		deltaDir = ""
+	}
+
+	// the import filters the rows by the backup timestamp
+	resp, err := m.RootCoord.Import(ctx, &milvuspb.ImportRequest{
+		CollectionName: target,
+		PartitionName:  partition.Name,
+		Files:          []string{insertDir, deltaDir},
+		Options: []*commonpb.KeyValuePair{
+			{Key: importBackupOption, Value: "true"},
+			{Key: importEndTsOption, Value: strconv.FormatUint(info.BackupTs, 10)},
+		},
+	})
+	if err == nil {
+		err = statusError(resp.GetStatus())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to import binlogs, err: %w", err)
+	}
+	log.Info("restore import started", zap.String("collection", target), zap.String("partition", partition.Name),
+		zap.Int64s("tasks", resp.GetTasks()))
+	for _, task := range resp.GetTasks() {
+		if err := m.waitImport(ctx, task); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Manager) stageBinlog(ctx context.Context, file *LogFile, dst string) error {
+	src := path.Join(m.backupDir(file.Backup), file.Path)
+	_, err := copyFile(ctx, m.BackupStorage, src, m.Storage, dst)
+	return err
+}
+
+// waitImport polls an import task until its data is persisted
+func (m *Manager) waitImport(ctx context.Context, task int64) error {
+	ticker := time.NewTicker(m.PollInterval)
+	defer ticker.Stop()
+	for {
+		resp, err := m.RootCoord.GetImportState(ctx, &milvuspb.GetImportStateRequest{Task: task})
+		if err == nil {
+			err = statusError(resp.GetStatus())
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get state of import task %d, err: %w", task, err)
+		}
+		switch resp.GetState() {
+		case commonpb.ImportState_ImportPersisted, commonpb.ImportState_ImportCompleted:
+			log.Info("restore import done", zap.Int64("task", task), zap.Int64("rows", resp.GetRowCount()))
+			return nil
+		case commonpb.ImportState_ImportFailed, commonpb.ImportState_ImportFailedAndCleaned:
+			reason, _ := funcutil.GetAttrByKeyFromRepeatedKV(importFailedReason, resp.GetInfos())
+			return fmt.Errorf("import task %d failed, reason: %s", task, reason)
+		case typeutil.ImportCancelled, typeutil.ImportCancelledAndCleaned:
+			return fmt.Errorf("import task %d has been cancelled", task)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// restoreRBAC creates the roles and users missing in the cluster, then binds the roles and grants the privileges
+func (m *Manager) restoreRBAC(ctx context.Context, rbac *RBACInfo) error {
+	roles, err := m.RootCoord.SelectRole(ctx, &milvuspb.SelectRoleRequest{
+		Base: commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_SelectRole)),
+	})
+	if err == nil {
+		err = statusError(roles.GetStatus())
+	}
+	if err != nil {
+		return err
+	}
+	existingRoles := make(map[string]struct{})
+	for _, result := range roles.GetResults() {
+		existingRoles[result.GetRole().GetName()] = struct{}{}
+	}
+	for _, role := range rbac.Roles {
+		if _, ok := existingRoles[role]; ok || funcutil.SliceContain(util.DefaultRoles, role) {
+			continue
+		}
+		status, err := m.RootCoord.CreateRole(ctx, &milvuspb.CreateRoleRequest{
+			Base:   commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_CreateRole)),
+			Entity: &milvuspb.RoleEntity{Name: role},
+		})
+		if err == nil {
+			err = statusError(status)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create role %s, err: %w", role, err)
+		}
+	}
+
+	users, err := m.RootCoord.ListCredUsers(ctx, &milvuspb.ListCredUsersRequest{
+		Base: commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_ListCredUsernames)),
+	})
+	if err == nil {
+		err = statusError(users.GetStatus())
+	}
+	if err != nil {
+		return err
+	}
+	for _, user := range rbac.Users {
+		if !funcutil.SliceContain(users.GetUsernames(), user.Name) {
+			status, err := m.RootCoord.CreateCredential(ctx, &internalpb.CredentialInfo{
+				Username:          user.Name,
+				EncryptedPassword: user.EncryptedPassword,
+			})
+			if err == nil {
+				err = statusError(status)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to create user %s, err: %w", user.Name, err)
+			}
+		}
+		for _, role := range user.Roles {
+			status, err := m.RootCoord.OperateUserRole(ctx, &milvuspb.OperateUserRoleRequest{
+				Base:     commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_OperateUserRole)),
+				Username: user.Name,
+				RoleName: role,
+				Type:     milvuspb.OperateUserRoleType_AddUserToRole,
+			})
+			if err == nil {
+				err = statusError(status)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to bind user %s to role %s, err: %w", user.Name, role, err)
+			}
+		}
+	}
+
+	for _, grant := range rbac.Grants {
+		grantor := grant.Grantor
+		if grantor == "" {
+			grantor = util.UserRoot
+		}
+		status, err := m.RootCoord.OperatePrivilege(ctx, &milvuspb.OperatePrivilegeRequest{
+			Base: commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_OperatePrivilege)),
+			Entity: &milvuspb.GrantEntity{
+				Role:       &milvuspb.RoleEntity{Name: grant.Role},
+				Object:     &milvuspb.ObjectEntity{Name: grant.Object},
+				ObjectName: grant.ObjectName,
+				Grantor: &milvuspb.GrantorEntity{
+					User:      &milvuspb.UserEntity{Name: grantor},
+					Privilege: &milvuspb.PrivilegeEntity{Name: grant.Privilege},
+				},
+			},
+			Type: milvuspb.OperatePrivilegeType_Grant,
+		})
+		if err == nil {
+			err = statusError(status)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to grant %s on %s %s to role %s, err: %w",
+				grant.Privilege, grant.Object, grant.ObjectName, grant.Role, err)
+		}
+	}
+	return nil
+}
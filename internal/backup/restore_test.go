@@ -0,0 +1,140 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func isBackupImport(options []*commonpb.KeyValuePair) bool {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(importBackupOption, options)
+	return err == nil && value == "true"
+}
+
+func TestManager_Restore(t *testing.T) {
+	ctx := context.Background()
+	backups := newMockChunkManager("backups")
+	src := newTestCluster(100, backups)
+	coll := src.setup()
+	_, err := src.manager.Backup(ctx, BackupOptions{Name: "b1", WithRBAC: true})
+	require.NoError(t, err)
+	src.addSegment(coll, "part", 4, commonpb.SegmentState_Flushed)
+	info, err := src.manager.Backup(ctx, BackupOptions{Name: "b2", Base: "b1", WithRBAC: true})
+	require.NoError(t, err)
+
+	dst := newTestCluster(1000, backups)
+	err = dst.manager.Restore(ctx, RestoreOptions{Name: "b2", Rename: map[string]string{"coll": "restored"}, WithRBAC: true})
+	require.NoError(t, err)
+
+	restored, ok := dst.rc.collections["restored"]
+	require.True(t, ok)
+	assert.Equal(t, int32(2), restored.shards)
+	assert.Contains(t, restored.partitions, "part")
+	assert.Empty(t, restored.aliases)
+	require.Equal(t, 1, len(dst.ic.created))
+	assert.Equal(t, restored.id, dst.ic.created[0].GetCollectionID())
+	assert.Equal(t, int64(201), dst.ic.created[0].GetFieldID())
+	assert.Equal(t, "vec_index", dst.ic.created[0].GetIndexName())
+
+	// a partition is imported at a time, the binlogs of both backups are staged with the restored field ids
+	require.Equal(t, 2, len(dst.rc.imports))
+	for _, imp := range dst.rc.imports {
+		assert.Equal(t, "restored", imp.req.GetCollectionName())
+		endTs, err := funcutil.GetAttrByKeyFromRepeatedKV(importEndTsOption, imp.req.GetOptions())
+		require.NoError(t, err)
+		assert.Equal(t, strconv.FormatUint(info.BackupTs, 10), endTs)
+		assert.True(t, isBackupImport(imp.req.GetOptions()))
+	}
+	partImport := dst.rc.imports[1]
+	assert.Equal(t, "part", partImport.req.GetPartitionName())
+	insertDir := partImport.req.GetFiles()[0]
+	assert.Equal(t, fmt.Sprintf("files/backup_restore/b2/restored/%d/insert_log", coll.partitions["part"]), insertDir)
+	assert.Contains(t, partImport.files, fmt.Sprintf("%s/2/200/20", insertDir))
+	assert.Contains(t, partImport.files, fmt.Sprintf("%s/4/201/41", insertDir))
+	// segment 3 is growing and not in the backups
+	assert.Equal(t, 2*(2+1), len(partImport.files))
+	staged, _, err := dst.storage.ListWithPrefix(ctx, "files/backup_restore", true)
+	require.NoError(t, err)
+	assert.Empty(t, staged)
+
+	assert.Equal(t, "encrypted-user", dst.rc.users["user"])
+	assert.Equal(t, []string{"user"}, dst.rc.roles["role"])
+	require.Equal(t, 1, len(dst.rc.grants))
+	assert.Equal(t, "coll", dst.rc.grants[0].GetObjectName())
+
+	// restoring again doesn't overwrite the collection, the suffix gives a new name and keeps no alias
+	err = dst.manager.Restore(ctx, RestoreOptions{Name: "b2", Rename: map[string]string{"coll": "restored"}})
+	assert.Error(t, err)
+	err = dst.manager.Restore(ctx, RestoreOptions{Name: "b2", Collections: []string{"coll"}, Suffix: "_bak", WithRBAC: true})
+	require.NoError(t, err)
+	assert.Contains(t, dst.rc.collections, "coll_bak")
+	assert.Empty(t, dst.rc.collections["coll_bak"].aliases)
+}
+
+func TestManager_RestoreAlias(t *testing.T) {
+	ctx := context.Background()
+	backups := newMockChunkManager("backups")
+	src := newTestCluster(100, backups)
+	src.setup()
+	_, err := src.manager.Backup(ctx, BackupOptions{Name: "b1"})
+	require.NoError(t, err)
+
+	dst := newTestCluster(1000, backups)
+	require.NoError(t, dst.manager.Restore(ctx, RestoreOptions{Name: "b1"}))
+	assert.Equal(t, []string{"alias"}, dst.rc.collections["coll"].aliases)
+	assert.NotContains(t, dst.rc.users, "user")
+}
+
+func TestManager_RestoreFailure(t *testing.T) {
+	ctx := context.Background()
+	backups := newMockChunkManager("backups")
+	src := newTestCluster(100, backups)
+	src.setup()
+	_, err := src.manager.Backup(ctx, BackupOptions{Name: "b1"})
+	require.NoError(t, err)
+
+	dst := newTestCluster(1000, backups)
+	assert.Error(t, dst.manager.Restore(ctx, RestoreOptions{Name: "missing"}))
+	assert.Error(t, dst.manager.Restore(ctx, RestoreOptions{Name: "b1", Collections: []string{"missing"}}))
+
+	dst.rc.importState = commonpb.ImportState_ImportFailed
+	err = dst.manager.Restore(ctx, RestoreOptions{Name: "b1"})
+	assert.ErrorContains(t, err, "mock failure")
+	staged, _, err := dst.storage.ListWithPrefix(ctx, "files/backup_restore", true)
+	require.NoError(t, err)
+	assert.Empty(t, staged)
+
+	dst.rc.importState = typeutil.ImportCancelled
+	err = dst.manager.Restore(ctx, RestoreOptions{Name: "b1", Suffix: "_1"})
+	assert.ErrorContains(t, err, "cancelled")
+
+	// the staged binlogs are read from the backup holding them
+	dst.rc.importState = commonpb.ImportState_ImportPersisted
+	backups.RemoveWithPrefix(ctx, "backups/b1/insert_log")
+	err = dst.manager.Restore(ctx, RestoreOptions{Name: "b1", Suffix: "_2"})
+	assert.ErrorContains(t, err, "failed to read binlog")
+}
@@ -0,0 +1,226 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// UniqueID is an alias for short
+type UniqueID = typeutil.UniqueID
+
+// Timestamp is an alias for short
+type Timestamp = typeutil.Timestamp
+
+// RootCoord is the part of types.RootCoord used to capture and recreate the metadata and to bulk load the binlogs
+type RootCoord interface {
+	AllocTimestamp(ctx context.Context, req *rootcoordpb.AllocTimestampRequest) (*rootcoordpb.AllocTimestampResponse, error)
+	ShowCollections(ctx context.Context, req *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error)
+	DescribeCollection(ctx context.Context, req *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error)
+	CreateCollection(ctx context.Context, req *milvuspb.CreateCollectionRequest) (*commonpb.Status, error)
+	ShowPartitions(ctx context.Context, req *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error)
+	CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error)
+	CreateAlias(ctx context.Context, req *milvuspb.CreateAliasRequest) (*commonpb.Status, error)
+	Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error)
+	GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error)
+
+	ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error)
+	GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error)
+	CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error)
+	SelectRole(ctx context.Context, req *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error)
+	CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error)
+	OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error)
+	SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
+	OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)
+}
+
+// DataCoord is the part of types.DataCoord used to flush the collections and list their binlogs
+type DataCoord interface {
+	Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error)
+	GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)
+	GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error)
+	GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error)
+}
+
+// IndexCoord is the part of types.IndexCoord used to capture and recreate the indexes
+type IndexCoord interface {
+	DescribeIndex(ctx context.Context, req *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error)
+	CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error)
+}
+
+// ChunkManager is the part of storage.ChunkManager used to copy the binlogs
+type ChunkManager interface {
+	RootPath() string
+	Size(ctx context.Context, filePath string) (int64, error)
+	Read(ctx context.Context, filePath string) ([]byte, error)
+	ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error)
+	Write(ctx context.Context, filePath string, content []byte) error
+	WriteFrom(ctx context.Context, filePath string, reader io.Reader, size int64) error
+	Exist(ctx context.Context, filePath string) (bool, error)
+	ListWithPrefix(ctx context.Context, prefix string, recursive bool) ([]string, []time.Time, error)
+	RemoveWithPrefix(ctx context.Context, prefix string) error
+}
+
+const (
+	// manifestFile is the name of the backup manifest, it is written last so a backup without it is incomplete
+	manifestFile = "backup.json"
+	insertLogDir = "insert_log"
+	deltaLogDir  = "delta_log"
+	// restoreStagingDir is where the binlogs are staged in the cluster storage to be bulk loaded
+	restoreStagingDir = "backup_restore"
+
+	// the import options understood by importutil, the binlog import is enabled by the backup flag
+	importBackupOption = "backup"
+	importEndTsOption  = "end_hybrid_ts"
+	// importFailedReason is the key of the failure reason in the import state infos
+	importFailedReason = "failed_reason"
+)
+
+// ErrBackupNotFound is returned when the manifest of a backup doesn't exist.
+var ErrBackupNotFound = errors.New("backup not found")
+
+// Info is the manifest of a backup.
+// An incremental backup lists every segment of the collections, the files already saved by
+// its base backup are not copied again and refer to the backup holding them.
+type Info struct {
+	Name        string            `json:"name"`
+	Base        string            `json:"base,omitempty"`
+	BackupTs    Timestamp         `json:"backup_ts"`
+	CreateTime  int64             `json:"create_time"`
+	Collections []*CollectionInfo `json:"collections"`
+	RBAC        *RBACInfo         `json:"rbac,omitempty"`
+}
+
+// CollectionInfo is the backup of a collection.
+type CollectionInfo struct {
+	ID               UniqueID                  `json:"id"`
+	Name             string                    `json:"name"`
+	Schema           []byte                    `json:"schema"`
+	ShardsNum        int32                     `json:"shards_num"`
+	ConsistencyLevel commonpb.ConsistencyLevel `json:"consistency_level"`
+	Aliases          []string                  `json:"aliases,omitempty"`
+	Partitions       []*PartitionInfo          `json:"partitions"`
+	Indexes          []*IndexInfo              `json:"indexes,omitempty"`
+}
+
+// PartitionInfo is the backup of a partition.
+type PartitionInfo struct {
+	ID       UniqueID       `json:"id"`
+	Name     string         `json:"name"`
+	Segments []*SegmentInfo `json:"segments,omitempty"`
+}
+
+// SegmentInfo lists the binlogs of a flushed segment.
+type SegmentInfo struct {
+	ID         UniqueID   `json:"id"`
+	NumRows    int64      `json:"num_rows"`
+	InsertLogs []*LogFile `json:"insert_logs"`
+	DeltaLogs  []*LogFile `json:"delta_logs,omitempty"`
+}
+
+// LogFile is a binlog saved by a backup.
+type LogFile struct {
+	FieldID UniqueID `json:"field_id,omitempty"`
+	LogID   UniqueID `json:"log_id"`
+	Size    int64    `json:"size"`
+	// Backup is the name of the backup holding the file
+	Backup string `json:"backup"`
+	// Path is relative to the directory of the backup holding the file
+	Path string `json:"path"`
+}
+
+// IndexInfo is the backup of an index, the field is referred by name.
+type IndexInfo struct {
+	FieldName       string                   `json:"field_name"`
+	IndexName       string                   `json:"index_name"`
+	TypeParams      []*commonpb.KeyValuePair `json:"type_params,omitempty"`
+	IndexParams     []*commonpb.KeyValuePair `json:"index_params,omitempty"`
+	UserIndexParams []*commonpb.KeyValuePair `json:"user_index_params,omitempty"`
+	IsAutoIndex     bool                     `json:"is_auto_index,omitempty"`
+}
+
+// RBACInfo is the backup of the users, roles and privileges.
+type RBACInfo struct {
+	Users  []*UserInfo  `json:"users,omitempty"`
+	Roles  []string     `json:"roles,omitempty"`
+	Grants []*GrantInfo `json:"grants,omitempty"`
+}
+
+// UserInfo is a user with its encrypted password and roles.
+type UserInfo struct {
+	Name              string   `json:"name"`
+	EncryptedPassword string   `json:"encrypted_password"`
+	Roles             []string `json:"roles,omitempty"`
+}
+
+// GrantInfo is a privilege granted to a role.
+type GrantInfo struct {
+	Role       string `json:"role"`
+	Object     string `json:"object"`
+	ObjectName string `json:"object_name"`
+	Privilege  string `json:"privilege"`
+	Grantor    string `json:"grantor,omitempty"`
+}
+
+// Config holds the clients of the cluster and the storages.
+type Config struct {
+	RootCoord  RootCoord
+	DataCoord  DataCoord
+	IndexCoord IndexCoord
+	// Storage is the storage of the cluster where the binlogs are
+	Storage ChunkManager
+	// BackupStorage is where the backups are saved, each backup is a directory under its root path
+	BackupStorage ChunkManager
+	// PollInterval is the interval to check the flush and import progress, a second by default
+	PollInterval time.Duration
+}
+
+// Manager backs up collections to the backup storage and restores them.
+type Manager struct {
+	Config
+}
+
+// NewManager creates a Manager.
+func NewManager(cfg Config) *Manager {
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = time.Second
+	}
+	return &Manager{Config: cfg}
+}
+
+func (m *Manager) backupDir(name string) string {
+	return path.Join(m.BackupStorage.RootPath(), name)
+}
+
+func statusError(status *commonpb.Status) error {
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(status.GetReason())
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+// Checkpoint records how far a Stream has delivered the changes of a collection.
+// Positions are the last time tick positions of every virtual channel whose messages were all delivered,
+// Timestamp is the time tick up to which all events were delivered, events at or before it are not delivered again.
+type Checkpoint struct {
+	CollectionID UniqueID                           `json:"collection_id"`
+	Timestamp    Timestamp                          `json:"timestamp"`
+	Positions    map[string]*internalpb.MsgPosition `json:"positions"`
+}
+
+// Marshal serializes the checkpoint so that it can be persisted by the consumer
+func (cp *Checkpoint) Marshal() ([]byte, error) {
+	return json.Marshal(cp)
+}
+
+// UnmarshalCheckpoint deserializes a checkpoint serialized by Checkpoint.Marshal
+func UnmarshalCheckpoint(data []byte) (*Checkpoint, error) {
+	cp := &Checkpoint{}
+	if err := json.Unmarshal(data, cp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cdc checkpoint, err: %w", err)
+	}
+	return cp, nil
+}
+
+// Clone returns a deep copy of the checkpoint
+func (cp *Checkpoint) Clone() *Checkpoint {
+	positions := make(map[string]*internalpb.MsgPosition, len(cp.Positions))
+	for channel, pos := range cp.Positions {
+		positions[channel] = proto.Clone(pos).(*internalpb.MsgPosition)
+	}
+	return &Checkpoint{
+		CollectionID: cp.CollectionID,
+		Timestamp:    cp.Timestamp,
+		Positions:    positions,
+	}
+}
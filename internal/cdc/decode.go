@@ -0,0 +1,199 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// eventDecoder converts the messages of a collection's dml channels into events
+type eventDecoder struct {
+	collectionID UniqueID
+	schema       *schemapb.CollectionSchema
+	// keepMsg passes the messages in the events instead of decoding insert and delete messages
+	keepMsg bool
+}
+
+// decode returns the event of a message, or nil if the message does not belong to the collection and channel
+func (d *eventDecoder) decode(msg msgstream.TsMsg, vchannel string) (*Event, error) {
+	event, err := d.decodeMsg(msg, vchannel)
+	if event != nil && d.keepMsg {
+		event.Msg = msg
+	}
+	return event, err
+}
+
+func (d *eventDecoder) decodeMsg(msg msgstream.TsMsg, vchannel string) (*Event, error) {
+	switch msg.Type() {
+	case commonpb.MsgType_Insert:
+		insertMsg := msg.(*msgstream.InsertMsg)
+		if insertMsg.GetCollectionID() != d.collectionID || insertMsg.GetShardName() != vchannel {
+			return nil, nil
+		}
+		var rows []Row
+		if !d.keepMsg {
+			var err error
+			if rows, err = d.decodeInsert(insertMsg); err != nil {
+				return nil, err
+			}
+		}
+		return &Event{
+			Type:          EventInsert,
+			CollectionID:  insertMsg.GetCollectionID(),
+			PartitionID:   insertMsg.GetPartitionID(),
+			PartitionName: insertMsg.GetPartitionName(),
+			Channel:       vchannel,
+			Timestamp:     insertMsg.EndTs(),
+			Rows:          rows,
+		}, nil
+
+	case commonpb.MsgType_Delete:
+		deleteMsg := msg.(*msgstream.DeleteMsg)
+		if deleteMsg.GetCollectionID() != d.collectionID || deleteMsg.GetShardName() != vchannel {
+			return nil, nil
+		}
+		var pks []interface{}
+		if !d.keepMsg {
+			pks = decodeDelete(deleteMsg)
+		}
+		return &Event{
+			Type:          EventDelete,
+			CollectionID:  deleteMsg.GetCollectionID(),
+			PartitionID:   deleteMsg.GetPartitionID(),
+			PartitionName: deleteMsg.GetPartitionName(),
+			Channel:       vchannel,
+			Timestamp:     deleteMsg.EndTs(),
+			PrimaryKeys:   pks,
+		}, nil
+
+	case commonpb.MsgType_CreateCollection:
+		createMsg := msg.(*msgstream.CreateCollectionMsg)
+		if createMsg.GetCollectionID() != d.collectionID {
+			return nil, nil
+		}
+		schema := &schemapb.CollectionSchema{}
+		if err := proto.Unmarshal(createMsg.GetSchema(), schema); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal schema of collection %d, err: %w", createMsg.GetCollectionID(), err)
+		}
+		return &Event{
+			Type:         EventCreateCollection,
+			CollectionID: createMsg.GetCollectionID(),
+			Timestamp:    createMsg.EndTs(),
+			Schema:       schema,
+		}, nil
+
+	case commonpb.MsgType_DropCollection:
+		dropMsg := msg.(*msgstream.DropCollectionMsg)
+		if dropMsg.GetCollectionID() != d.collectionID {
+			return nil, nil
+		}
+		return &Event{
+			Type:         EventDropCollection,
+			CollectionID: dropMsg.GetCollectionID(),
+			Timestamp:    dropMsg.EndTs(),
+		}, nil
+
+	case commonpb.MsgType_CreatePartition:
+		createMsg := msg.(*msgstream.CreatePartitionMsg)
+		if createMsg.GetCollectionID() != d.collectionID {
+			return nil, nil
+		}
+		return &Event{
+			Type:          EventCreatePartition,
+			CollectionID:  createMsg.GetCollectionID(),
+			PartitionID:   createMsg.GetPartitionID(),
+			PartitionName: createMsg.GetPartitionName(),
+			Timestamp:     createMsg.EndTs(),
+		}, nil
+
+	case commonpb.MsgType_DropPartition:
+		dropMsg := msg.(*msgstream.DropPartitionMsg)
+		if dropMsg.GetCollectionID() != d.collectionID {
+			return nil, nil
+		}
+		return &Event{
+			Type:          EventDropPartition,
+			CollectionID:  dropMsg.GetCollectionID(),
+			PartitionID:   dropMsg.GetPartitionID(),
+			PartitionName: dropMsg.GetPartitionName(),
+			Timestamp:     dropMsg.EndTs(),
+		}, nil
+	}
+	return nil, nil
+}
+
+// decodeInsert converts both row based and column based insert messages into rows of user fields
+func (d *eventDecoder) decodeInsert(msg *msgstream.InsertMsg) ([]Row, error) {
+	insertData, err := storage.InsertMsgToInsertData(msg, d.schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode insert message of collection %d, err: %w", d.collectionID, err)
+	}
+
+	numRows := int(msg.NRows())
+	rows := make([]Row, numRows)
+	for i := range rows {
+		rows[i] = make(Row, len(d.schema.GetFields()))
+	}
+	for _, field := range d.schema.GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID {
+			continue
+		}
+		fieldData, ok := insertData.Data[field.GetFieldID()]
+		if !ok {
+			return nil, fmt.Errorf("field %s is missing in insert message of collection %d", field.GetName(), d.collectionID)
+		}
+		if fieldData.RowNum() != numRows {
+			return nil, fmt.Errorf("the num_rows(%d) of field %s is not equal to passed NumRows(%d)",
+				fieldData.RowNum(), field.GetName(), numRows)
+		}
+		validData := fieldData.GetValidData()
+		for i := 0; i < numRows; i++ {
+			if len(validData) > 0 && !validData[i] {
+				rows[i][field.GetName()] = nil
+				continue
+			}
+			rows[i][field.GetName()] = fieldData.GetRow(i)
+		}
+	}
+	return rows, nil
+}
+
+// decodeDelete returns the deleted primary keys, messages of old versions only carry int64 primary keys
+func decodeDelete(msg *msgstream.DeleteMsg) []interface{} {
+	if msg.GetPrimaryKeys() == nil {
+		pks := make([]interface{}, 0, len(msg.GetInt64PrimaryKeys()))
+		for _, pk := range msg.GetInt64PrimaryKeys() {
+			pks = append(pks, pk)
+		}
+		return pks
+	}
+	size := typeutil.GetSizeOfIDs(msg.GetPrimaryKeys())
+	pks := make([]interface{}, 0, size)
+	for i := 0; i < size; i++ {
+		pks = append(pks, typeutil.GetPK(msg.GetPrimaryKeys(), int64(i)))
+	}
+	return pks
+}
@@ -0,0 +1,113 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// UniqueID is an alias for short
+type UniqueID = typeutil.UniqueID
+
+// Timestamp is an alias for short
+type Timestamp = typeutil.Timestamp
+
+// EventType is the kind of change carried by an Event
+type EventType int32
+
+const (
+	EventInsert EventType = iota + 1
+	EventDelete
+	EventCreateCollection
+	EventDropCollection
+	EventCreatePartition
+	EventDropPartition
+)
+
+var eventTypeNames = map[EventType]string{
+	EventInsert:           "Insert",
+	EventDelete:           "Delete",
+	EventCreateCollection: "CreateCollection",
+	EventDropCollection:   "DropCollection",
+	EventCreatePartition:  "CreatePartition",
+	EventDropPartition:    "DropPartition",
+}
+
+func (t EventType) String() string {
+	if name, ok := eventTypeNames[t]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+// MarshalText encodes the event type by its name
+func (t EventType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText decodes the event type from its name
+func (t *EventType) UnmarshalText(text []byte) error {
+	for eventType, name := range eventTypeNames {
+		if name == string(text) {
+			*t = eventType
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown cdc event type %s", string(text))
+}
+
+// IsDDL returns true if the event changes collection or partition definitions rather than entities
+func (t EventType) IsDDL() bool {
+	return t != EventInsert && t != EventDelete
+}
+
+// Row is a decoded entity, keyed by field name. A null value of a nullable field is nil.
+type Row map[string]interface{}
+
+// Event is a single change of the subscribed collection, it's encoded as a json object by the cdc tool
+type Event struct {
+	Type          EventType `json:"type"`
+	CollectionID  UniqueID  `json:"collection_id"`
+	PartitionID   UniqueID  `json:"partition_id,omitempty"`
+	PartitionName string    `json:"partition_name,omitempty"`
+	// Channel is the virtual channel the change was read from, it is empty for DDL events
+	// since they are broadcast to all channels of the collection.
+	Channel   string    `json:"channel,omitempty"`
+	Timestamp Timestamp `json:"timestamp"`
+
+	// Rows holds the inserted entities of an EventInsert
+	Rows []Row `json:"rows,omitempty"`
+	// PrimaryKeys holds the deleted primary keys of an EventDelete, either int64 or string
+	PrimaryKeys []interface{} `json:"primary_keys,omitempty"`
+	// Schema holds the collection schema of an EventCreateCollection
+	Schema *schemapb.CollectionSchema `json:"schema,omitempty"`
+
+	// Msg is the message the event was decoded from, only kept by a Stream created WithMessages
+	Msg msgstream.TsMsg `json:"-"`
+}
+
+// EventPack is a batch of events delivered by a Stream, ordered by timestamp.
+// Once all events of the pack are handled, Checkpoint can be persisted and passed
+// to WithCheckpoint to resume the stream right after this pack.
+type EventPack struct {
+	Events     []*Event
+	Checkpoint *Checkpoint
+}
@@ -0,0 +1,460 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// Params is the param table used to name the subscriptions of streams
+var Params *paramtable.ComponentParam = paramtable.Get()
+
+const defaultBufferSize = 16
+
+// Option customizes a Stream
+type Option func(*Stream)
+
+// WithCheckpoint resumes the stream right after the pack the checkpoint was delivered with
+func WithCheckpoint(cp *Checkpoint) Option {
+	return func(s *Stream) {
+		s.checkpoint = cp.Clone()
+	}
+}
+
+// WithStartTs only delivers the events after the timestamp.
+// Without a checkpoint, the channels are read from the creation of the collection and earlier events are skipped.
+func WithStartTs(ts Timestamp) Option {
+	return func(s *Stream) {
+		s.startTs = ts
+	}
+}
+
+// WithSubscription names the subscription of each virtual channel ${subName}-${vchannel}, the subscriptions
+// are kept after Close, so that the messages are retained for a consumer resuming from its checkpoint.
+// By default, subscriptions are unique to the stream and removed by Close.
+func WithSubscription(subName string) Option {
+	return func(s *Stream) {
+		s.subName = subName
+	}
+}
+
+// WithMessages keeps the message of each event in Event.Msg instead of decoding its rows and primary keys,
+// for consumers replaying the messages
+func WithMessages() Option {
+	return func(s *Stream) {
+		s.decoder.keepMsg = true
+	}
+}
+
+// WithBufferSize sets the number of event packs buffered before the consumer reads them
+func WithBufferSize(size int) Option {
+	return func(s *Stream) {
+		s.bufferSize = size
+	}
+}
+
+// channelReader consumes the pchannel of a virtual channel of the collection
+type channelReader struct {
+	index    int
+	vchannel string
+	pchannel string
+	subName  string
+	stream   msgstream.MsgStream
+}
+
+// channelPack holds the events of a channel between two time ticks
+type channelPack struct {
+	index       int
+	events      []*Event
+	endTs       Timestamp
+	endPosition *internalpb.MsgPosition
+}
+
+// Stream delivers the changes of a collection in timestamp order.
+// All virtual channels of the collection are consumed, events are merged and only delivered once
+// every channel has passed their timestamp, so that an EventPack never has to be reordered by the consumer.
+type Stream struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+
+	factory    msgstream.Factory
+	decoder    *eventDecoder
+	readers    []*channelReader
+	checkpoint *Checkpoint
+	startTs    Timestamp
+	bufferSize int
+	subName    string
+
+	packs chan *channelPack
+	out   chan *EventPack
+
+	errMut sync.RWMutex
+	err    error
+}
+
+// NewStream subscribes to the virtual channels of the collection described by coll
+func NewStream(ctx context.Context, factory msgstream.Factory, coll *milvuspb.DescribeCollectionResponse, opts ...Option) (*Stream, error) {
+	if coll.GetSchema() == nil {
+		return nil, fmt.Errorf("schema of collection %d is missing", coll.GetCollectionID())
+	}
+	if len(coll.GetVirtualChannelNames()) == 0 {
+		return nil, fmt.Errorf("collection %d has no virtual channel", coll.GetCollectionID())
+	}
+
+	ctx1, cancel := context.WithCancel(ctx)
+	s := &Stream{
+		ctx:        ctx1,
+		cancel:     cancel,
+		factory:    factory,
+		bufferSize: defaultBufferSize,
+		decoder: &eventDecoder{
+			collectionID: coll.GetCollectionID(),
+			schema:       coll.GetSchema(),
+		},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if s.checkpoint == nil {
+		s.checkpoint = &Checkpoint{
+			CollectionID: coll.GetCollectionID(),
+			Positions:    make(map[string]*internalpb.MsgPosition),
+		}
+	}
+	if s.checkpoint.CollectionID != coll.GetCollectionID() {
+		cancel()
+		return nil, fmt.Errorf("checkpoint of collection %d can't be used to follow collection %d",
+			s.checkpoint.CollectionID, coll.GetCollectionID())
+	}
+	if s.checkpoint.Positions == nil {
+		s.checkpoint.Positions = make(map[string]*internalpb.MsgPosition)
+	}
+	if s.checkpoint.Timestamp > s.startTs {
+		s.startTs = s.checkpoint.Timestamp
+	}
+
+	startPositions := make(map[string][]byte, len(coll.GetStartPositions()))
+	for _, pos := range coll.GetStartPositions() {
+		startPositions[pos.GetKey()] = pos.GetData()
+	}
+	for idx, vchannel := range coll.GetVirtualChannelNames() {
+		reader, err := s.subscribe(idx, vchannel, startPositions)
+		if err != nil {
+			s.Close()
+			return nil, err
+		}
+		s.readers = append(s.readers, reader)
+	}
+
+	s.packs = make(chan *channelPack, len(s.readers))
+	s.out = make(chan *EventPack, s.bufferSize)
+	for _, reader := range s.readers {
+		s.wg.Add(1)
+		go s.consume(reader)
+	}
+	s.wg.Add(1)
+	go s.merge()
+
+	log.Info("cdc stream started",
+		zap.Int64("collection ID", coll.GetCollectionID()),
+		zap.Strings("vchannels", coll.GetVirtualChannelNames()),
+		zap.Uint64("start ts", s.startTs))
+	return s, nil
+}
+
+// subscribe seeks the channel to the checkpoint, or to the creation of the collection if the channel has no checkpoint
+func (s *Stream) subscribe(idx int, vchannel string, startPositions map[string][]byte) (*channelReader, error) {
+	stream, err := s.factory.NewTtMsgStream(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// subName should be unique, since several streams may follow the same collection
+	pchannel := funcutil.ToPhysicalChannel(vchannel)
+	subName := fmt.Sprintf("%s-%d-%s-%s", Params.CommonCfg.CDCSubName, s.decoder.collectionID, vchannel, funcutil.RandomString(8))
+	if s.subName != "" {
+		subName = fmt.Sprintf("%s-%s", s.subName, vchannel)
+	}
+	reader := &channelReader{
+		index:    idx,
+		vchannel: vchannel,
+		pchannel: pchannel,
+		subName:  subName,
+		stream:   stream,
+	}
+
+	var seekPos *internalpb.MsgPosition
+	if pos, ok := s.checkpoint.Positions[vchannel]; ok {
+		seekPos = proto.Clone(pos).(*internalpb.MsgPosition)
+		// MsgStream needs a physical channel name
+		seekPos.ChannelName = pchannel
+	} else if msgID, ok := startPositions[pchannel]; ok {
+		seekPos = &internalpb.MsgPosition{
+			ChannelName: pchannel,
+			MsgID:       msgID,
+		}
+	}
+
+	if seekPos == nil {
+		stream.AsConsumer([]string{pchannel}, subName, mqwrapper.SubscriptionPositionEarliest)
+		log.Info("cdc stream consumes channel from earliest",
+			zap.String("vchannel", vchannel),
+			zap.String("subName", subName))
+		return reader, nil
+	}
+
+	stream.AsConsumer([]string{pchannel}, subName, mqwrapper.SubscriptionPositionUnknown)
+	if err := stream.Seek([]*internalpb.MsgPosition{seekPos}); err != nil {
+		stream.Close()
+		return nil, fmt.Errorf("failed to seek channel %s, err: %w", vchannel, err)
+	}
+	log.Info("cdc stream seeks channel",
+		zap.String("vchannel", vchannel),
+		zap.String("subName", subName),
+		zap.ByteString("seek msgID", seekPos.GetMsgID()),
+		zap.Uint64("seek ts", seekPos.GetTimestamp()))
+	return reader, nil
+}
+
+// consume decodes the msg packs of a channel and passes them to merge
+func (s *Stream) consume(reader *channelReader) {
+	defer s.wg.Done()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case msgPack, ok := <-reader.stream.Chan():
+			if !ok {
+				s.fail(fmt.Errorf("msgstream of channel %s is closed", reader.vchannel))
+				return
+			}
+			if msgPack == nil {
+				continue
+			}
+			pack, err := s.decodePack(reader, msgPack)
+			if err != nil {
+				s.fail(err)
+				return
+			}
+			select {
+			case s.packs <- pack:
+			case <-s.ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+func (s *Stream) decodePack(reader *channelReader, msgPack *msgstream.MsgPack) (*channelPack, error) {
+	pack := &channelPack{
+		index: reader.index,
+		endTs: msgPack.EndTs,
+	}
+	for _, pos := range msgPack.EndPositions {
+		if pos.GetChannelName() == reader.pchannel {
+			pack.endPosition = proto.Clone(pos).(*internalpb.MsgPosition)
+		}
+	}
+	for _, msg := range msgPack.Msgs {
+		event, err := s.decoder.decode(msg, reader.vchannel)
+		if err != nil {
+			return nil, err
+		}
+		if event != nil {
+			pack.events = append(pack.events, event)
+		}
+	}
+	// messages between two time ticks may arrive out of timestamp order
+	sort.SliceStable(pack.events, func(i, j int) bool {
+		return pack.events[i].Timestamp < pack.events[j].Timestamp
+	})
+	return pack, nil
+}
+
+// merge delivers the events up to the smallest time tick received from all channels
+func (s *Stream) merge() {
+	defer s.wg.Done()
+	defer close(s.out)
+
+	pending := make([][]*channelPack, len(s.readers))
+	watermarks := make([]Timestamp, len(s.readers))
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case pack := <-s.packs:
+			pending[pack.index] = append(pending[pack.index], pack)
+			watermarks[pack.index] = pack.endTs
+
+			eventPack := s.collect(pending, watermarks)
+			if eventPack == nil {
+				continue
+			}
+			select {
+			case s.out <- eventPack:
+			case <-s.ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// collect takes the events up to the watermark out of the pending packs and advances the checkpoint.
+// It returns nil if the watermark hasn't moved since last time.
+func (s *Stream) collect(pending [][]*channelPack, watermarks []Timestamp) *EventPack {
+	var watermark Timestamp
+	for idx, ts := range watermarks {
+		if ts == 0 {
+			// some channel hasn't received any time tick yet
+			return nil
+		}
+		if idx == 0 || ts < watermark {
+			watermark = ts
+		}
+	}
+	if watermark <= s.checkpoint.Timestamp {
+		return nil
+	}
+
+	var events []*Event
+	for idx, packs := range pending {
+		done := 0
+		for _, pack := range packs {
+			n := sort.Search(len(pack.events), func(i int) bool {
+				return pack.events[i].Timestamp > watermark
+			})
+			events = append(events, pack.events[:n]...)
+			pack.events = pack.events[n:]
+			if pack.endTs > watermark {
+				break
+			}
+			if pack.endPosition != nil {
+				s.checkpoint.Positions[s.readers[idx].vchannel] = pack.endPosition
+			}
+			done++
+		}
+		pending[idx] = packs[done:]
+	}
+
+	// events of the same timestamp keep the order of channels
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Timestamp < events[j].Timestamp
+	})
+
+	type ddlKey struct {
+		eventType EventType
+		ts        Timestamp
+	}
+	// DDL messages are broadcast to every physical channel of the collection
+	ddls := make(map[ddlKey]struct{})
+	delivered := make([]*Event, 0, len(events))
+	for _, event := range events {
+		if event.Timestamp <= s.startTs {
+			continue
+		}
+		if event.Type.IsDDL() {
+			key := ddlKey{eventType: event.Type, ts: event.Timestamp}
+			if _, ok := ddls[key]; ok {
+				continue
+			}
+			ddls[key] = struct{}{}
+		}
+		delivered = append(delivered, event)
+	}
+
+	s.checkpoint.Timestamp = watermark
+	return &EventPack{
+		Events:     delivered,
+		Checkpoint: s.checkpoint.Clone(),
+	}
+}
+
+// Chan returns the channel of event packs, it's closed after Close is called or the stream fails
+func (s *Stream) Chan() <-chan *EventPack {
+	return s.out
+}
+
+// LatestMsgIDs returns the ID of the latest message of the physical channel of each virtual channel,
+// a consumer has caught up with the channel once the position of the channel in its checkpoint reaches the ID
+func (s *Stream) LatestMsgIDs() (map[string]msgstream.MessageID, error) {
+	msgIDs := make(map[string]msgstream.MessageID, len(s.readers))
+	for _, reader := range s.readers {
+		msgID, err := reader.stream.GetLatestMsgID(reader.pchannel)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get latest message ID of channel %s, err: %w", reader.vchannel, err)
+		}
+		msgIDs[reader.vchannel] = msgID
+	}
+	return msgIDs, nil
+}
+
+// Err returns the error which stopped the stream, or nil if the stream is running or closed by Close
+func (s *Stream) Err() error {
+	s.errMut.RLock()
+	defer s.errMut.RUnlock()
+	return s.err
+}
+
+func (s *Stream) fail(err error) {
+	s.errMut.Lock()
+	defer s.errMut.Unlock()
+	if s.err == nil && !errors.Is(s.ctx.Err(), context.Canceled) {
+		log.Warn("cdc stream failed", zap.Int64("collection ID", s.decoder.collectionID), zap.Error(err))
+		s.err = err
+	}
+	s.cancel()
+}
+
+// Close stops consuming and removes the subscriptions of the stream
+func (s *Stream) Close() {
+	s.closeOnce.Do(func() {
+		s.cancel()
+		s.wg.Wait()
+		for _, reader := range s.readers {
+			reader.stream.Close()
+			if s.subName != "" {
+				continue
+			}
+			err := s.factory.NewMsgStreamDisposer(context.Background())([]string{reader.pchannel}, reader.subName)
+			if err != nil {
+				log.Warn("failed to remove cdc subscription",
+					zap.String("vchannel", reader.vchannel),
+					zap.String("subName", reader.subName),
+					zap.Error(err))
+			}
+		}
+		log.Info("cdc stream closed", zap.Int64("collection ID", s.decoder.collectionID))
+	})
+}
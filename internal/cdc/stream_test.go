@@ -0,0 +1,481 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+)
+
+const testCollectionID = UniqueID(1000)
+
+type fakeMsgStream struct {
+	msgstream.MsgStream
+	ch       chan *msgstream.MsgPack
+	subName  string
+	seekPos  []*internalpb.MsgPosition
+	position mqwrapper.SubscriptionInitialPosition
+	seekErr  error
+	closed   bool
+}
+
+func newFakeMsgStream() *fakeMsgStream {
+	return &fakeMsgStream{ch: make(chan *msgstream.MsgPack, 16)}
+}
+
+func (ms *fakeMsgStream) AsConsumer(channels []string, subName string, position mqwrapper.SubscriptionInitialPosition) {
+	ms.subName = subName
+	ms.position = position
+}
+
+func (ms *fakeMsgStream) Seek(offset []*internalpb.MsgPosition) error {
+	ms.seekPos = offset
+	return ms.seekErr
+}
+
+func (ms *fakeMsgStream) Chan() <-chan *msgstream.MsgPack {
+	return ms.ch
+}
+
+func (ms *fakeMsgStream) Close() {
+	ms.closed = true
+}
+
+type fakeFactory struct {
+	msgstream.Factory
+	mut      sync.Mutex
+	streams  []*fakeMsgStream
+	next     int
+	disposed []string
+}
+
+func (f *fakeFactory) NewTtMsgStream(ctx context.Context) (msgstream.MsgStream, error) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	if f.next >= len(f.streams) {
+		return nil, errors.New("no more stream")
+	}
+	f.next++
+	return f.streams[f.next-1], nil
+}
+
+func (f *fakeFactory) NewMsgStreamDisposer(ctx context.Context) func([]string, string) error {
+	return func(channels []string, subName string) error {
+		f.mut.Lock()
+		defer f.mut.Unlock()
+		f.disposed = append(f.disposed, channels...)
+		return nil
+	}
+}
+
+func testSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 0, Name: "RowID", DataType: schemapb.DataType_Int64},
+			{FieldID: 1, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "title", DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "64"}}},
+		},
+	}
+}
+
+func testCollection() *milvuspb.DescribeCollectionResponse {
+	return &milvuspb.DescribeCollectionResponse{
+		Schema:               testSchema(),
+		CollectionID:         testCollectionID,
+		VirtualChannelNames:  []string{"dml_0_1000v0", "dml_1_1000v1"},
+		PhysicalChannelNames: []string{"dml_0", "dml_1"},
+		StartPositions: []*commonpb.KeyDataPair{
+			{Key: "dml_0", Data: []byte{1}},
+			{Key: "dml_1", Data: []byte{2}},
+		},
+	}
+}
+
+func genInsertMsg(vchannel string, ts Timestamp, pks []int64) *msgstream.InsertMsg {
+	titles := make([]string, 0, len(pks))
+	rowIDs := make([]int64, 0, len(pks))
+	timestamps := make([]uint64, 0, len(pks))
+	for _, pk := range pks {
+		titles = append(titles, fmt.Sprintf("title_%d", pk))
+		rowIDs = append(rowIDs, pk)
+		timestamps = append(timestamps, ts)
+	}
+	return &msgstream.InsertMsg{
+		BaseMsg: msgstream.BaseMsg{BeginTimestamp: ts, EndTimestamp: ts},
+		InsertRequest: internalpb.InsertRequest{
+			Base:         commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_Insert)),
+			CollectionID: testCollectionID,
+			PartitionID:  1,
+			ShardName:    vchannel,
+			Timestamps:   timestamps,
+			RowIDs:       rowIDs,
+			NumRows:      uint64(len(pks)),
+			Version:      internalpb.InsertDataVersion_ColumnBased,
+			FieldsData: []*schemapb.FieldData{
+				{
+					FieldId:   100,
+					FieldName: "pk",
+					Type:      schemapb.DataType_Int64,
+					Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+					}},
+				},
+				{
+					FieldId:   101,
+					FieldName: "title",
+					Type:      schemapb.DataType_VarChar,
+					Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: titles}},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func genDeleteMsg(vchannel string, ts Timestamp, pks []int64) *msgstream.DeleteMsg {
+	return &msgstream.DeleteMsg{
+		BaseMsg: msgstream.BaseMsg{BeginTimestamp: ts, EndTimestamp: ts},
+		DeleteRequest: internalpb.DeleteRequest{
+			Base:         commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_Delete)),
+			CollectionID: testCollectionID,
+			PartitionID:  1,
+			ShardName:    vchannel,
+			NumRows:      int64(len(pks)),
+			PrimaryKeys:  &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+		},
+	}
+}
+
+func genDropPartitionMsg(ts Timestamp) *msgstream.DropPartitionMsg {
+	return &msgstream.DropPartitionMsg{
+		BaseMsg: msgstream.BaseMsg{BeginTimestamp: ts, EndTimestamp: ts},
+		DropPartitionRequest: internalpb.DropPartitionRequest{
+			Base:          commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_DropPartition)),
+			CollectionID:  testCollectionID,
+			PartitionID:   1,
+			PartitionName: "p1",
+		},
+	}
+}
+
+func genMsgPack(pchannel string, beginTs, endTs Timestamp, msgs ...msgstream.TsMsg) *msgstream.MsgPack {
+	return &msgstream.MsgPack{
+		BeginTs: beginTs,
+		EndTs:   endTs,
+		Msgs:    msgs,
+		EndPositions: []*internalpb.MsgPosition{{
+			ChannelName: pchannel,
+			MsgID:       []byte(fmt.Sprintf("%s-%d", pchannel, endTs)),
+			Timestamp:   endTs,
+		}},
+	}
+}
+
+func receivePack(t *testing.T, s *Stream) *EventPack {
+	select {
+	case pack, ok := <-s.Chan():
+		require.True(t, ok)
+		return pack
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for event pack")
+	}
+	return nil
+}
+
+// receiveUntil returns the events delivered until the checkpoint reaches ts
+func receiveUntil(t *testing.T, s *Stream, ts Timestamp) ([]*Event, *Checkpoint) {
+	var events []*Event
+	for {
+		pack := receivePack(t, s)
+		events = append(events, pack.Events...)
+		if pack.Checkpoint.Timestamp >= ts {
+			return events, pack.Checkpoint
+		}
+	}
+}
+
+func TestStream_Merge(t *testing.T) {
+	ms0, ms1 := newFakeMsgStream(), newFakeMsgStream()
+	factory := &fakeFactory{streams: []*fakeMsgStream{ms0, ms1}}
+	coll := testCollection()
+
+	s, err := NewStream(context.Background(), factory, coll)
+	require.NoError(t, err)
+
+	assert.Equal(t, mqwrapper.SubscriptionPositionUnknown, ms0.position)
+	assert.Equal(t, []*internalpb.MsgPosition{{ChannelName: "dml_0", MsgID: []byte{1}}}, ms0.seekPos)
+	assert.Equal(t, []*internalpb.MsgPosition{{ChannelName: "dml_1", MsgID: []byte{2}}}, ms1.seekPos)
+
+	vchan0, vchan1 := coll.VirtualChannelNames[0], coll.VirtualChannelNames[1]
+	ms0.ch <- genMsgPack("dml_0", 0, 10,
+		genInsertMsg(vchan0, 8, []int64{3}),
+		genInsertMsg(vchan0, 5, []int64{1, 2}),
+		// another shard on the same pchannel
+		genInsertMsg("dml_0_1001v0", 6, []int64{100}))
+	ms0.ch <- genMsgPack("dml_0", 10, 20, genDropPartitionMsg(15), genDeleteMsg(vchan0, 18, []int64{1}))
+	ms1.ch <- genMsgPack("dml_1", 0, 20,
+		genInsertMsg(vchan1, 12, []int64{4}),
+		genDropPartitionMsg(15))
+
+	// the first tick of dml_0 may be delivered alone, depending on when dml_1 arrives
+	events, cp := receiveUntil(t, s, 20)
+	require.Equal(t, 5, len(events))
+	assert.Equal(t, EventInsert, events[0].Type)
+	assert.Equal(t, Timestamp(5), events[0].Timestamp)
+	assert.Equal(t, []Row{{"pk": int64(1), "title": "title_1"}, {"pk": int64(2), "title": "title_2"}}, events[0].Rows)
+	assert.Equal(t, vchan0, events[0].Channel)
+	assert.Equal(t, Timestamp(8), events[1].Timestamp)
+	assert.Equal(t, Timestamp(12), events[2].Timestamp)
+	assert.Equal(t, vchan1, events[2].Channel)
+	assert.Equal(t, EventDropPartition, events[3].Type)
+	assert.Equal(t, "p1", events[3].PartitionName)
+	assert.Equal(t, EventDelete, events[4].Type)
+	assert.Equal(t, []interface{}{int64(1)}, events[4].PrimaryKeys)
+
+	assert.Equal(t, Timestamp(20), cp.Timestamp)
+	assert.Equal(t, testCollectionID, cp.CollectionID)
+	assert.Equal(t, []byte("dml_0-20"), cp.Positions[vchan0].GetMsgID())
+	assert.Equal(t, []byte("dml_1-20"), cp.Positions[vchan1].GetMsgID())
+
+	s.Close()
+	_, ok := <-s.Chan()
+	assert.False(t, ok)
+	assert.NoError(t, s.Err())
+	assert.True(t, ms0.closed)
+	assert.True(t, ms1.closed)
+	assert.ElementsMatch(t, []string{"dml_0", "dml_1"}, factory.disposed)
+}
+
+func TestStream_PartialPack(t *testing.T) {
+	ms0, ms1 := newFakeMsgStream(), newFakeMsgStream()
+	coll := testCollection()
+	s, err := NewStream(context.Background(), &fakeFactory{streams: []*fakeMsgStream{ms0, ms1}}, coll)
+	require.NoError(t, err)
+	defer s.Close()
+
+	vchan0, vchan1 := coll.VirtualChannelNames[0], coll.VirtualChannelNames[1]
+	ms1.ch <- genMsgPack("dml_1", 0, 20, genInsertMsg(vchan1, 7, []int64{1}), genInsertMsg(vchan1, 15, []int64{2}))
+	ms0.ch <- genMsgPack("dml_0", 0, 10, genInsertMsg(vchan0, 9, []int64{3}))
+
+	// only the events up to the slowest channel are delivered
+	pack := receivePack(t, s)
+	require.Equal(t, 2, len(pack.Events))
+	assert.Equal(t, Timestamp(7), pack.Events[0].Timestamp)
+	assert.Equal(t, Timestamp(9), pack.Events[1].Timestamp)
+	assert.Equal(t, Timestamp(10), pack.Checkpoint.Timestamp)
+	assert.Contains(t, pack.Checkpoint.Positions, vchan0)
+	assert.NotContains(t, pack.Checkpoint.Positions, vchan1)
+
+	ms0.ch <- genMsgPack("dml_0", 10, 20)
+	pack = receivePack(t, s)
+	require.Equal(t, 1, len(pack.Events))
+	assert.Equal(t, Timestamp(15), pack.Events[0].Timestamp)
+	assert.Equal(t, Timestamp(20), pack.Checkpoint.Timestamp)
+	assert.Contains(t, pack.Checkpoint.Positions, vchan1)
+}
+
+func TestStream_Resume(t *testing.T) {
+	coll := testCollection()
+	vchan0, vchan1 := coll.VirtualChannelNames[0], coll.VirtualChannelNames[1]
+	cp := &Checkpoint{
+		CollectionID: testCollectionID,
+		Timestamp:    10,
+		Positions: map[string]*internalpb.MsgPosition{
+			vchan0: {ChannelName: "dml_0", MsgID: []byte("dml_0-10"), Timestamp: 10},
+		},
+	}
+	data, err := cp.Marshal()
+	require.NoError(t, err)
+	cp, err = UnmarshalCheckpoint(data)
+	require.NoError(t, err)
+
+	ms0, ms1 := newFakeMsgStream(), newFakeMsgStream()
+	s, err := NewStream(context.Background(), &fakeFactory{streams: []*fakeMsgStream{ms0, ms1}}, coll, WithCheckpoint(cp))
+	require.NoError(t, err)
+	defer s.Close()
+
+	assert.Equal(t, []byte("dml_0-10"), ms0.seekPos[0].GetMsgID())
+	assert.Equal(t, Timestamp(10), ms0.seekPos[0].GetTimestamp())
+	// the channel without position is read from the creation of the collection
+	assert.Equal(t, []byte{2}, ms1.seekPos[0].GetMsgID())
+
+	ms0.ch <- genMsgPack("dml_0", 10, 20, genInsertMsg(vchan0, 12, []int64{1}))
+	ms1.ch <- genMsgPack("dml_1", 0, 20, genInsertMsg(vchan1, 9, []int64{2}), genInsertMsg(vchan1, 11, []int64{3}))
+	pack := receivePack(t, s)
+	require.Equal(t, 2, len(pack.Events))
+	assert.Equal(t, Timestamp(11), pack.Events[0].Timestamp)
+	assert.Equal(t, Timestamp(12), pack.Events[1].Timestamp)
+}
+
+func TestStream_StartTs(t *testing.T) {
+	coll := testCollection()
+	coll.VirtualChannelNames = coll.VirtualChannelNames[:1]
+	ms0 := newFakeMsgStream()
+	s, err := NewStream(context.Background(), &fakeFactory{streams: []*fakeMsgStream{ms0}}, coll, WithStartTs(6), WithBufferSize(1))
+	require.NoError(t, err)
+	defer s.Close()
+
+	vchan0 := coll.VirtualChannelNames[0]
+	ms0.ch <- genMsgPack("dml_0", 0, 10, genInsertMsg(vchan0, 5, []int64{1}), genInsertMsg(vchan0, 7, []int64{2}))
+	pack := receivePack(t, s)
+	require.Equal(t, 1, len(pack.Events))
+	assert.Equal(t, Timestamp(7), pack.Events[0].Timestamp)
+}
+
+func TestStream_Messages(t *testing.T) {
+	coll := testCollection()
+	coll.VirtualChannelNames = coll.VirtualChannelNames[:1]
+	ms0 := newFakeMsgStream()
+	factory := &fakeFactory{streams: []*fakeMsgStream{ms0}}
+	s, err := NewStream(context.Background(), factory, coll, WithMessages(), WithSubscription("sub"))
+	require.NoError(t, err)
+
+	vchan0 := coll.VirtualChannelNames[0]
+	assert.Equal(t, "sub-"+vchan0, ms0.subName)
+	insertMsg, deleteMsg := genInsertMsg(vchan0, 5, []int64{1}), genDeleteMsg(vchan0, 7, []int64{1})
+	ms0.ch <- genMsgPack("dml_0", 0, 10, insertMsg, deleteMsg)
+	pack := receivePack(t, s)
+	require.Equal(t, 2, len(pack.Events))
+	assert.Same(t, insertMsg, pack.Events[0].Msg)
+	assert.Nil(t, pack.Events[0].Rows)
+	assert.Same(t, deleteMsg, pack.Events[1].Msg)
+	assert.Nil(t, pack.Events[1].PrimaryKeys)
+
+	// named subscriptions are kept for resuming
+	s.Close()
+	assert.True(t, ms0.closed)
+	assert.Empty(t, factory.disposed)
+}
+
+func TestStream_Fail(t *testing.T) {
+	coll := testCollection()
+	coll.VirtualChannelNames = coll.VirtualChannelNames[:1]
+	ms0 := newFakeMsgStream()
+	s, err := NewStream(context.Background(), &fakeFactory{streams: []*fakeMsgStream{ms0}}, coll)
+	require.NoError(t, err)
+	defer s.Close()
+
+	msg := genInsertMsg(coll.VirtualChannelNames[0], 5, []int64{1})
+	msg.FieldsData = msg.FieldsData[:1]
+	ms0.ch <- genMsgPack("dml_0", 0, 10, msg)
+	select {
+	case _, ok := <-s.Chan():
+		assert.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for stream to fail")
+	}
+	assert.Error(t, s.Err())
+}
+
+func TestNewStream_Invalid(t *testing.T) {
+	coll := testCollection()
+	coll.Schema = nil
+	_, err := NewStream(context.Background(), &fakeFactory{}, coll)
+	assert.Error(t, err)
+
+	coll = testCollection()
+	coll.VirtualChannelNames = nil
+	_, err = NewStream(context.Background(), &fakeFactory{}, coll)
+	assert.Error(t, err)
+
+	_, err = NewStream(context.Background(), &fakeFactory{}, testCollection(), WithCheckpoint(&Checkpoint{CollectionID: 1}))
+	assert.Error(t, err)
+
+	// the first channel is closed if the second one can't be subscribed
+	ms0 := newFakeMsgStream()
+	_, err = NewStream(context.Background(), &fakeFactory{streams: []*fakeMsgStream{ms0}}, testCollection())
+	assert.Error(t, err)
+	assert.True(t, ms0.closed)
+
+	ms0, ms1 := newFakeMsgStream(), newFakeMsgStream()
+	ms1.seekErr = errors.New("mock")
+	_, err = NewStream(context.Background(), &fakeFactory{streams: []*fakeMsgStream{ms0, ms1}}, testCollection())
+	assert.Error(t, err)
+	assert.True(t, ms1.closed)
+}
+
+func TestCheckpoint(t *testing.T) {
+	cp := &Checkpoint{
+		CollectionID: testCollectionID,
+		Timestamp:    100,
+		Positions: map[string]*internalpb.MsgPosition{
+			"dml_0_1000v0": {ChannelName: "dml_0", MsgID: []byte{1, 2, 3}, Timestamp: 100},
+		},
+	}
+	data, err := cp.Marshal()
+	require.NoError(t, err)
+	cp2, err := UnmarshalCheckpoint(data)
+	require.NoError(t, err)
+	assert.Equal(t, cp.CollectionID, cp2.CollectionID)
+	assert.Equal(t, cp.Timestamp, cp2.Timestamp)
+	assert.True(t, proto.Equal(cp.Positions["dml_0_1000v0"], cp2.Positions["dml_0_1000v0"]))
+
+	cp3 := cp.Clone()
+	cp3.Positions["dml_0_1000v0"].Timestamp = 200
+	assert.Equal(t, Timestamp(100), cp.Positions["dml_0_1000v0"].Timestamp)
+
+	_, err = UnmarshalCheckpoint([]byte("{"))
+	assert.Error(t, err)
+}
+
+func TestEventType(t *testing.T) {
+	assert.Equal(t, "Insert", EventInsert.String())
+	assert.Equal(t, "Unknown", EventType(0).String())
+	assert.False(t, EventDelete.IsDDL())
+	assert.True(t, EventDropPartition.IsDDL())
+}
+
+func TestEventJSON(t *testing.T) {
+	event := &Event{
+		Type:         EventInsert,
+		CollectionID: 1,
+		PartitionID:  2,
+		Channel:      "ch_v0",
+		Timestamp:    100,
+		Rows:         []Row{{"pk": int64(1), "vec": []float32{0.5, 1}}},
+	}
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"Insert","collection_id":1,"partition_id":2,"channel":"ch_v0","timestamp":100,"rows":[{"pk":1,"vec":[0.5,1]}]}`, string(data))
+
+	decoded := &Event{}
+	require.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, EventInsert, decoded.Type)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"type":"Upsert"}`), decoded))
+}
@@ -82,8 +82,19 @@ const (
 	DimKey         = "dim"
 )
 
+// Field type params key
+const (
+	// NullableKey marks a scalar field which allows null values.
+	NullableKey = "nullable"
+	// DefaultValueKey is the value used when a scalar field is absent from the inserted data.
+	DefaultValueKey = "default_value"
+)
+
 //  Collection properties key
 
 const (
 	CollectionTTLConfigKey = "collection.ttl.seconds"
+	// CollectionAddFieldKey carries the json encoded schema of the field appended to the collection by AlterCollection,
+	// the field must be a nullable or default-valued scalar field.
+	CollectionAddFieldKey = "collection.add_field"
 )
@@ -38,12 +38,16 @@ type EtcdSource struct {
 }
 
 func NewEtcdSource(remoteInfo *EtcdInfo) (*EtcdSource, error) {
-	etcdCli, err := clientv3.New(clientv3.Config{
-		Endpoints:   remoteInfo.Endpoints,
-		DialTimeout: 5 * time.Second,
-	})
-	if err != nil {
-		return nil, err
+	etcdCli := remoteInfo.Client
+	if etcdCli == nil {
+		var err error
+		etcdCli, err = clientv3.New(clientv3.Config{
+			Endpoints:   remoteInfo.Endpoints,
+			DialTimeout: 5 * time.Second,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 	es := &EtcdSource{
 		etcdCli:       etcdCli,
@@ -15,7 +15,11 @@
 // limitations under the License.
 package config
 
-import "time"
+import (
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
 
 const (
 	HighPriority   = 1
@@ -41,6 +45,8 @@ type EventHandler interface {
 type EtcdInfo struct {
 	Endpoints []string
 	KeyPrefix string
+	// Client is used instead of dialing Endpoints when set, e.g. the in-process meta store client
+	Client *clientv3.Client
 
 	//Pull Configuration interval, unit is second
 	RefreshInterval time.Duration
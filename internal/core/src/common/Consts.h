@@ -25,6 +25,10 @@ const milvus::PkType INVALID_PK;  // of std::monostate if not set.
 // TODO: default field start id, could get from config.yaml
 const int64_t START_USER_FIELDID = 100;
 const char MAX_LENGTH[] = "max_length";
+const char NULLABLE[] = "nullable";
+// field number of `repeated bool valid_data` in schema.FieldData, it's not in the bundled milvus-proto yet,
+// the validity of nullable fields is carried in unknown fields with this number (typeutil.GetFieldValidData)
+const int FIELD_VALID_DATA_NUMBER = 6;
 
 // const fieldID (rowID and timestamp)
 const milvus::FieldId RowFieldID = milvus::FieldId(0);
@@ -45,6 +45,9 @@ datatype_sizeof(DataType data_type, int dim = 1) {
             return sizeof(double);
         case DataType::VECTOR_FLOAT:
             return sizeof(float) * dim;
+        case DataType::VECTOR_FLOAT16:
+        case DataType::VECTOR_BFLOAT16:
+            return sizeof(uint16_t) * dim;
         case DataType::VECTOR_BINARY: {
             Assert(dim % 8 == 0);
             return dim / 8;
@@ -77,6 +80,10 @@ datatype_name(DataType data_type) {
             return "varChar";
         case DataType::VECTOR_FLOAT:
             return "vector_float";
+        case DataType::VECTOR_FLOAT16:
+            return "vector_float16";
+        case DataType::VECTOR_BFLOAT16:
+            return "vector_bfloat16";
         case DataType::VECTOR_BINARY: {
             return "vector_binary";
         }
@@ -89,7 +96,8 @@ datatype_name(DataType data_type) {
 
 inline bool
 datatype_is_vector(DataType datatype) {
-    return datatype == DataType::VECTOR_BINARY || datatype == DataType::VECTOR_FLOAT;
+    return datatype == DataType::VECTOR_BINARY || datatype == DataType::VECTOR_FLOAT ||
+           datatype == DataType::VECTOR_FLOAT16 || datatype == DataType::VECTOR_BFLOAT16;
 }
 
 inline bool
@@ -200,6 +208,17 @@ class FieldMeta {
         return type_;
     }
 
+    bool
+    is_nullable() const {
+        return nullable_;
+    }
+
+    void
+    set_nullable(bool nullable) {
+        Assert(!nullable || !is_vector());
+        nullable_ = nullable;
+    }
+
     int64_t
     get_sizeof() const {
         if (is_vector()) {
@@ -224,6 +243,7 @@ class FieldMeta {
     DataType type_ = DataType::NONE;
     std::optional<VectorInfo> vector_info_;
     std::optional<StringInfo> string_info_;
+    bool nullable_ = false;
 };
 
 }  // namespace milvus
@@ -31,6 +31,7 @@ struct LoadFieldDataInfo {
     //    const void* blob = nullptr;
     const milvus::DataArray* field_data;
     int64_t row_count = -1;
+    const bool* valid_data = nullptr;
 };
 
 struct LoadDeletedRecordInfo {
@@ -62,6 +62,8 @@ struct SearchResult {
 
     // set output fields data when fill target entity
     std::map<FieldId, std::unique_ptr<milvus::DataArray>> output_fields_data_;
+    // validity of the nullable output fields, false means null
+    std::map<FieldId, FixedVector<bool>> output_fields_valid_data_;
 
     // used for reduce, filter invalid pk, get real topks count
     std::vector<size_t> topk_per_nq_prefix_sum_;
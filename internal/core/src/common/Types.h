@@ -61,6 +61,9 @@ enum class DataType {
 
     VECTOR_BINARY = 100,
     VECTOR_FLOAT = 101,
+    VECTOR_FLOAT16 = 102,
+    VECTOR_BFLOAT16 = 103,
+    VECTOR_SPARSE_FLOAT = 104,
 };
 
 using Timestamp = uint64_t;  // TODO: use TiKV-like timestamp
@@ -74,6 +74,8 @@ typedef struct CLoadFieldDataInfo {
     const uint8_t* blob;
     uint64_t blob_size;
     int64_t row_count;
+    // validity of nullable field, NULL if the field is not nullable
+    const bool* valid_data;
 } CLoadFieldDataInfo;
 
 typedef struct CLoadDeletedRecordInfo {
@@ -188,6 +188,21 @@ struct BinaryRangeExpr : Expr {
     accept(ExprVisitor&) override;
 };
 
+struct NullExpr : Expr {
+    enum class OpType { Invalid = 0, IsNull = 1, IsNotNull = 2 };
+    const FieldId field_id_;
+    const DataType data_type_;
+    const OpType op_type_;
+
+    NullExpr(const FieldId field_id, const DataType data_type, const OpType op_type)
+        : field_id_(field_id), data_type_(data_type), op_type_(op_type) {
+    }
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
 struct CompareExpr : Expr {
     FieldId left_field_id_;
     FieldId right_field_id_;
@@ -17,6 +17,7 @@
 #include "PlanProto.h"
 #include "generated/ExtractInfoExprVisitor.h"
 #include "generated/ExtractInfoPlanNodeVisitor.h"
+#include "generated/VerifyExprVisitor.h"
 #include "common/VectorTrait.h"
 
 namespace milvus::query {
@@ -318,6 +319,19 @@ ProtoParser::ParseCompareExpr(const proto::plan::CompareExpr& expr_pb) {
     }();
 }
 
+ExprPtr
+ProtoParser::ParseNullExpr(const proto::plan::NullExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto data_type = schema[field_id].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+    auto op_type = static_cast<NullExpr::OpType>(expr_pb.op());
+    auto expr = std::make_unique<NullExpr>(field_id, data_type, op_type);
+    VerifyExprVisitor verifier;
+    expr->accept(verifier);
+    return expr;
+}
+
 ExprPtr
 ProtoParser::ParseTermExpr(const proto::plan::TermExpr& expr_pb) {
     auto& columnInfo = expr_pb.column_info();
@@ -436,6 +450,9 @@ ProtoParser::ParseExpr(const proto::plan::Expr& expr_pb) {
         case ppe::kBinaryArithOpEvalRangeExpr: {
             return ParseBinaryArithOpEvalRangeExpr(expr_pb.binary_arith_op_eval_range_expr());
         }
+        case ppe::kNullExpr: {
+            return ParseNullExpr(expr_pb.null_expr());
+        }
         default:
             PanicInfo("unsupported expr proto node");
     }
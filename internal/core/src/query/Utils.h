@@ -11,7 +11,11 @@
 
 #pragma once
 
+#include <algorithm>
+#include <cctype>
+#include <regex>
 #include <string>
+#include <unordered_set>
 #include "query/Expr.h"
 #include "common/Utils.h"
 
@@ -35,4 +39,98 @@ Match<std::string>(const std::string& str, const std::string& val, OpType op) {
             PanicInfo("not supported");
     }
 }
+
+// split text into lower-cased ASCII alphanumeric tokens, used by text match.
+// The proxy validates text match operands with the same rule (planparserv2 tokenizeText).
+inline std::vector<std::string>
+Tokenize(const std::string& text) {
+    std::vector<std::string> tokens;
+    std::string token;
+    for (auto c : text) {
+        if (std::isalnum(static_cast<unsigned char>(c))) {
+            token.push_back(static_cast<char>(std::tolower(static_cast<unsigned char>(c))));
+        } else if (!token.empty()) {
+            tokens.emplace_back(std::move(token));
+            token.clear();
+        }
+    }
+    if (!token.empty()) {
+        tokens.emplace_back(std::move(token));
+    }
+    return tokens;
+}
+
+// StringMatcher holds the compiled operand of regex match and text match,
+// so that it's compiled only once for the whole segment.
+template <typename T>
+class StringMatcher {
+ public:
+    StringMatcher(const T& val, OpType op) {
+        PanicInfo("not supported");
+    }
+
+    bool
+    operator()(const T& x) const {
+        PanicInfo("not supported");
+    }
+};
+
+template <>
+class StringMatcher<std::string> {
+ public:
+    StringMatcher(const std::string& val, OpType op) : op_(op) {
+        switch (op) {
+            case OpType::RegexMatch: {
+                // the case-insensitive flag generated by ilike is not part of ECMAScript syntax.
+                static const std::string icase_flag = "(?i)";
+                auto flags = std::regex::ECMAScript | std::regex::optimize;
+#if defined(__GLIBCXX__)
+                // the default executor of libstdc++ backtracks recursively on every character,
+                // long values overflow the stack. The proxy rejects back references, so the
+                // breadth-first executor enabled by this flag is always used.
+                flags |= std::regex_constants::__polynomial;
+#endif
+                auto pattern = val;
+                if (pattern.compare(0, icase_flag.length(), icase_flag) == 0) {
+                    flags |= std::regex::icase;
+                    pattern = pattern.substr(icase_flag.length());
+                }
+                try {
+                    regex_ = std::regex(pattern, flags);
+                } catch (const std::regex_error& e) {
+                    PanicInfo(std::string("invalid regular expression: ") + e.what());
+                }
+                break;
+            }
+            case OpType::TextMatch: {
+                for (auto& token : Tokenize(val)) {
+                    tokens_.insert(token);
+                }
+                break;
+            }
+            default:
+                PanicInfo("not supported");
+        }
+    }
+
+    bool
+    operator()(const std::string& x) const {
+        switch (op_) {
+            case OpType::RegexMatch:
+                return std::regex_search(x, regex_);
+            case OpType::TextMatch: {
+                auto tokens = Tokenize(x);
+                return std::any_of(tokens.begin(), tokens.end(),
+                                   [this](const std::string& token) { return tokens_.count(token) > 0; });
+            }
+            default:
+                PanicInfo("not supported");
+        }
+    }
+
+ private:
+    OpType op_;
+    std::regex regex_;
+    std::unordered_set<std::string> tokens_;
+};
 }  // namespace milvus::query
@@ -44,6 +44,9 @@ class VerifyExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(NullExpr& expr) override;
+
  public:
 };
 }  // namespace milvus::query
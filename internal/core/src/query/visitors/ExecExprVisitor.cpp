@@ -277,6 +277,16 @@ ExecExprVisitor::ExecUnaryRangeVisitorDispatcher(UnaryRangeExpr& expr_raw) -> Bi
             auto elem_func = [val, op](T x) { return Match(x, val, op); };
             return ExecRangeVisitorImpl<T>(expr.field_id_, index_func, elem_func);
         }
+        case OpType::RegexMatch:
+        case OpType::TextMatch: {
+            auto matcher = std::make_shared<StringMatcher<T>>(val, op);
+            auto index_func = [matcher](Index* index, size_t offset) {
+                auto x = index->Reverse_Lookup(offset);
+                return (*matcher)(x);
+            };
+            auto elem_func = [matcher](T x) { return (*matcher)(x); };
+            return ExecDataRangeVisitorImpl<T>(expr.field_id_, index_func, elem_func);
+        }
         // TODO: PostfixMatch
         default: {
             PanicInfo("unsupported range node");
@@ -474,6 +484,8 @@ ExecExprVisitor::visit(UnaryRangeExpr& expr) {
         default:
             PanicInfo("unsupported");
     }
+    // comparison with null is never satisfied
+    segment_.mask_with_valid_data(expr.field_id_, res);
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
     bitset_opt_ = std::move(res);
 }
@@ -512,6 +524,7 @@ ExecExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
         default:
             PanicInfo("unsupported");
     }
+    segment_.mask_with_valid_data(expr.field_id_, res);
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
     bitset_opt_ = std::move(res);
 }
@@ -558,6 +571,7 @@ ExecExprVisitor::visit(BinaryRangeExpr& expr) {
         default:
             PanicInfo("unsupported");
     }
+    segment_.mask_with_valid_data(expr.field_id_, res);
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
     bitset_opt_ = std::move(res);
 }
@@ -745,6 +759,8 @@ ExecExprVisitor::visit(CompareExpr& expr) {
             PanicInfo("unsupported optype");
         }
     }
+    segment_.mask_with_valid_data(expr.left_field_id_, res);
+    segment_.mask_with_valid_data(expr.right_field_id_, res);
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
     bitset_opt_ = std::move(res);
 }
@@ -881,6 +897,19 @@ ExecExprVisitor::visit(TermExpr& expr) {
         default:
             PanicInfo("unsupported");
     }
+    segment_.mask_with_valid_data(expr.field_id_, res);
+    AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    bitset_opt_ = std::move(res);
+}
+
+void
+ExecExprVisitor::visit(NullExpr& expr) {
+    BitsetType res(row_count_);
+    res.set();
+    segment_.mask_with_valid_data(expr.field_id_, res);
+    if (expr.op_type_ == NullExpr::OpType::IsNull) {
+        res.flip();
+    }
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
     bitset_opt_ = std::move(res);
 }
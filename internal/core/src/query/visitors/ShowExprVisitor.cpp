@@ -248,6 +248,19 @@ ShowExprVisitor::visit(CompareExpr& expr) {
     json_opt_ = res;
 }
 
+void
+ShowExprVisitor::visit(NullExpr& expr) {
+    using proto::plan::NullExpr_NullOp;
+    using proto::plan::NullExpr_NullOp_Name;
+    AssertInfo(!json_opt_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
+
+    Json res{{"expr_type", "Null"},
+             {"field_id", expr.field_id_.get()},
+             {"data_type", datatype_name(expr.data_type_)},
+             {"op", NullExpr_NullOp_Name(static_cast<NullExpr_NullOp>(expr.op_type_))}};
+    json_opt_ = res;
+}
+
 template <typename T>
 static Json
 BinaryArithOpEvalRangeExtract(const BinaryArithOpEvalRangeExpr& expr_raw) {
@@ -47,4 +47,11 @@ VerifyExprVisitor::visit(CompareExpr& expr) {
     // TODO
 }
 
+void
+VerifyExprVisitor::visit(NullExpr& expr) {
+    AssertInfo(expr.op_type_ == NullExpr::OpType::IsNull || expr.op_type_ == NullExpr::OpType::IsNotNull,
+               "invalid op of null expression");
+    AssertInfo(!datatype_is_vector(expr.data_type_), "null expression on vector field is unsupported");
+}
+
 }  // namespace milvus::query
@@ -20,6 +20,12 @@ Collection::Collection(const std::string& collection_proto) : schema_proto_(coll
     parse();
 }
 
+void
+Collection::update_schema(const std::string& collection_proto) {
+    schema_proto_ = collection_proto;
+    parse();
+}
+
 void
 Collection::parse() {
     // if (schema_proto_.empty()) {
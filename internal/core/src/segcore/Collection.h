@@ -25,6 +25,10 @@ class Collection {
     void
     parse();
 
+    // segments created afterwards use the new schema, while existing segments keep the old one
+    void
+    update_schema(const std::string& collection_proto);
+
  public:
     SchemaPtr&
     get_schema() {
@@ -162,6 +162,22 @@ class IndexingRecord {
         //    }).detach();
     }
 
+    // add the indexing of appended field, and build it on the chunks acked so far,
+    // caller must guarantee no insertion happens at the same time
+    template <bool is_sealed>
+    void
+    AppendField(const FieldMeta& field_meta, const InsertRecord<is_sealed>& record) {
+        AssertInfo(!field_meta.is_vector(), "vector field can't be appended");
+        auto field_id = field_meta.get_id();
+        std::unique_lock lck(mutex_);
+        AssertInfo(!field_indexings_.count(field_id), "field indexing already exists");
+        auto indexing = CreateIndex(field_meta, segcore_config_);
+        if (resource_ack_ > 0) {
+            indexing->BuildIndexRange(0, resource_ack_, record.get_field_data_base(field_id));
+        }
+        field_indexings_.emplace(field_id, std::move(indexing));
+    }
+
     // concurrent
     int64_t
     get_finished_ack() const {
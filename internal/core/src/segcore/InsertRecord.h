@@ -18,6 +18,7 @@
 #include <unordered_map>
 #include <utility>
 
+#include "common/Consts.h"
 #include "common/Schema.h"
 #include "segcore/AckResponder.h"
 #include "segcore/ConcurrentVector.h"
@@ -164,53 +165,62 @@ struct InsertRecord {
                     }
                 }
             }
-            if (field_meta.is_vector()) {
-                if (field_meta.get_data_type() == DataType::VECTOR_FLOAT) {
-                    this->append_field_data<FloatVector>(field_id, field_meta.get_dim(), size_per_chunk);
-                    continue;
-                } else if (field_meta.get_data_type() == DataType::VECTOR_BINARY) {
-                    this->append_field_data<BinaryVector>(field_id, field_meta.get_dim(), size_per_chunk);
-                    continue;
-                } else {
-                    PanicInfo("unsupported");
-                }
+            append_field(field_id, field_meta, size_per_chunk);
+        }
+    }
+
+    // append the column of field, and its validity if the field is nullable
+    void
+    append_field(FieldId field_id, const FieldMeta& field_meta, int64_t size_per_chunk) {
+        if (field_meta.is_vector()) {
+            if (field_meta.get_data_type() == DataType::VECTOR_FLOAT) {
+                this->append_field_data<FloatVector>(field_id, field_meta.get_dim(), size_per_chunk);
+                return;
+            } else if (field_meta.get_data_type() == DataType::VECTOR_BINARY) {
+                this->append_field_data<BinaryVector>(field_id, field_meta.get_dim(), size_per_chunk);
+                return;
+            } else {
+                PanicInfo("unsupported");
             }
-            switch (field_meta.get_data_type()) {
-                case DataType::BOOL: {
-                    this->append_field_data<bool>(field_id, size_per_chunk);
-                    break;
-                }
-                case DataType::INT8: {
-                    this->append_field_data<int8_t>(field_id, size_per_chunk);
-                    break;
-                }
-                case DataType::INT16: {
-                    this->append_field_data<int16_t>(field_id, size_per_chunk);
-                    break;
-                }
-                case DataType::INT32: {
-                    this->append_field_data<int32_t>(field_id, size_per_chunk);
-                    break;
-                }
-                case DataType::INT64: {
-                    this->append_field_data<int64_t>(field_id, size_per_chunk);
-                    break;
-                }
-                case DataType::FLOAT: {
-                    this->append_field_data<float>(field_id, size_per_chunk);
-                    break;
-                }
-                case DataType::DOUBLE: {
-                    this->append_field_data<double>(field_id, size_per_chunk);
-                    break;
-                }
-                case DataType::VARCHAR: {
-                    this->append_field_data<std::string>(field_id, size_per_chunk);
-                    break;
-                }
-                default: {
-                    PanicInfo("unsupported");
-                }
+        }
+        if (field_meta.is_nullable()) {
+            valid_data_.emplace(field_id, std::make_unique<ConcurrentVector<bool>>(size_per_chunk));
+        }
+        switch (field_meta.get_data_type()) {
+            case DataType::BOOL: {
+                this->append_field_data<bool>(field_id, size_per_chunk);
+                break;
+            }
+            case DataType::INT8: {
+                this->append_field_data<int8_t>(field_id, size_per_chunk);
+                break;
+            }
+            case DataType::INT16: {
+                this->append_field_data<int16_t>(field_id, size_per_chunk);
+                break;
+            }
+            case DataType::INT32: {
+                this->append_field_data<int32_t>(field_id, size_per_chunk);
+                break;
+            }
+            case DataType::INT64: {
+                this->append_field_data<int64_t>(field_id, size_per_chunk);
+                break;
+            }
+            case DataType::FLOAT: {
+                this->append_field_data<float>(field_id, size_per_chunk);
+                break;
+            }
+            case DataType::DOUBLE: {
+                this->append_field_data<double>(field_id, size_per_chunk);
+                break;
+            }
+            case DataType::VARCHAR: {
+                this->append_field_data<std::string>(field_id, size_per_chunk);
+                break;
+            }
+            default: {
+                PanicInfo("unsupported");
             }
         }
     }
@@ -308,9 +318,50 @@ struct InsertRecord {
         fields_data_.erase(field_id);
     }
 
+    // get validity of nullable field, return nullptr if the field is not nullable
+    ConcurrentVector<bool>*
+    get_valid_data(FieldId field_id) const {
+        auto iter = valid_data_.find(field_id);
+        if (iter == valid_data_.end()) {
+            return nullptr;
+        }
+        return iter->second.get();
+    }
+
+    // fill the validity of rows at seg_offsets, returns false if the field has no validity loaded
+    bool
+    bulk_subscript_valid_data(FieldId field_id, const int64_t* seg_offsets, int64_t count, bool* output) const {
+        auto valid_data = get_valid_data(field_id);
+        if (valid_data == nullptr || valid_data->num_chunk() == 0) {
+            return false;
+        }
+        for (int64_t i = 0; i < count; ++i) {
+            auto offset = seg_offsets[i];
+            output[i] = offset != INVALID_SEG_OFFSET && (*valid_data)[offset];
+        }
+        return true;
+    }
+
+    // clear the bits of rows whose value is null, bitset covers rows from 0
+    void
+    mask_with_valid_data(FieldId field_id, BitsetType& bitset) const {
+        auto valid_data = get_valid_data(field_id);
+        // validity is not loaded if sealed segment only holds the index of the field
+        if (valid_data == nullptr || valid_data->num_chunk() == 0) {
+            return;
+        }
+        for (int64_t offset = 0; offset < bitset.size(); ++offset) {
+            if (bitset[offset] && !(*valid_data)[offset]) {
+                bitset[offset] = false;
+            }
+        }
+    }
+
  private:
     //    std::vector<std::unique_ptr<VectorBase>> fields_data_;
     std::unordered_map<FieldId, std::unique_ptr<VectorBase>> fields_data_;
+    // validity of nullable fields, false means null
+    std::unordered_map<FieldId, std::unique_ptr<ConcurrentVector<bool>>> valid_data_;
     mutable std::shared_mutex shared_mutex_;
 };
 
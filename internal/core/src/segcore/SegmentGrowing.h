@@ -38,6 +38,10 @@ class SegmentGrowing : public SegmentInternalInterface {
            const Timestamp* timestamps,
            const InsertData* insert_data) = 0;
 
+    // append the fields in schema but not in the segment, default_data carries the values of existing rows
+    virtual void
+    AddFields(SchemaPtr schema, const InsertData* default_data) = 0;
+
     // virtual int64_t
     // PreDelete(int64_t size) = 0;
 
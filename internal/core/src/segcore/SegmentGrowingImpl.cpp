@@ -80,6 +80,24 @@ SegmentGrowingImpl::Insert(int64_t reserved_offset,
         insert_record_.get_field_data_base(field_id)->set_data_raw(reserved_offset, size,
                                                                    &insert_data->fields_data(data_offset), field_meta);
     }
+    std::unordered_map<FieldId, const bool*> field_id_to_valid_data;
+    for (auto& valid_data : insert_data->valid_data()) {
+        AssertInfo(valid_data.valid_data_size() == size, "valid data count not equal to insert size");
+        field_id_to_valid_data.emplace(FieldId(valid_data.field_id()), valid_data.valid_data().data());
+    }
+    for (auto [field_id, field_meta] : schema_->get_fields()) {
+        auto field_valid_data = insert_record_.get_valid_data(field_id);
+        if (field_valid_data == nullptr) {
+            continue;
+        }
+        // rows of nullable field without validity are all valid
+        if (field_id_to_valid_data.count(field_id)) {
+            field_valid_data->set_data_raw(reserved_offset, field_id_to_valid_data[field_id], size);
+        } else {
+            FixedVector<bool> all_valid(size, true);
+            field_valid_data->set_data_raw(reserved_offset, all_valid.data(), size);
+        }
+    }
 
     // step 4: set pks to offset
     auto field_id = schema_->get_primary_field_id().value_or(FieldId(-1));
@@ -98,6 +116,45 @@ SegmentGrowingImpl::Insert(int64_t reserved_offset,
     }
 }
 
+void
+SegmentGrowingImpl::AddFields(SchemaPtr schema, const InsertData* default_data) {
+    auto size = insert_record_.ack_responder_.GetAck();
+    AssertInfo(insert_record_.reserved == size, "fields can't be added when insertion is in progress");
+    AssertInfo(default_data->num_rows() == size, "default data count not equal to row count");
+    std::unordered_map<FieldId, const DataArray*> field_id_to_data;
+    for (auto& field : default_data->fields_data()) {
+        field_id_to_data.emplace(FieldId(field.field_id()), &field);
+    }
+    std::unordered_map<FieldId, const bool*> field_id_to_valid_data;
+    for (auto& valid_data : default_data->valid_data()) {
+        AssertInfo(valid_data.valid_data_size() == size, "valid data count not equal to row count");
+        field_id_to_valid_data.emplace(FieldId(valid_data.field_id()), valid_data.valid_data().data());
+    }
+
+    for (auto& [field_id, field_meta] : schema->get_fields()) {
+        if (schema_->get_fields().count(field_id)) {
+            continue;
+        }
+        AssertInfo(!field_meta.is_vector(), "vector field can't be added");
+        insert_record_.append_field(field_id, field_meta, segcore_config_.get_chunk_rows());
+        if (size > 0) {
+            AssertInfo(field_id_to_data.count(field_id), "Cannot find field_id");
+            insert_record_.get_field_data_base(field_id)->set_data_raw(0, size, field_id_to_data[field_id], field_meta);
+            if (auto field_valid_data = insert_record_.get_valid_data(field_id); field_valid_data != nullptr) {
+                if (field_id_to_valid_data.count(field_id)) {
+                    field_valid_data->set_data_raw(0, field_id_to_valid_data[field_id], size);
+                } else {
+                    FixedVector<bool> all_valid(size, true);
+                    field_valid_data->set_data_raw(0, all_valid.data(), size);
+                }
+            }
+        }
+        indexing_record_.AppendField(field_meta, insert_record_);
+    }
+    old_schemas_.push_back(std::move(schema_));
+    schema_ = std::move(schema);
+}
+
 Status
 SegmentGrowingImpl::Delete(int64_t reserved_begin, int64_t size, const IdArray* ids, const Timestamp* timestamps_raw) {
     auto field_id = schema_->get_primary_field_id().value_or(FieldId(-1));
@@ -48,6 +48,9 @@ class SegmentGrowingImpl : public SegmentGrowing {
            const Timestamp* timestamps,
            const InsertData* insert_data) override;
 
+    void
+    AddFields(SchemaPtr schema, const InsertData* default_data) override;
+
     int64_t
     PreDelete(int64_t size) override;
 
@@ -185,6 +188,16 @@ class SegmentGrowingImpl : public SegmentGrowing {
     void
     mask_with_delete(BitsetType& bitset, int64_t ins_barrier, Timestamp timestamp) const override;
 
+    void
+    mask_with_valid_data(FieldId field_id, BitsetType& bitset) const override {
+        insert_record_.mask_with_valid_data(field_id, bitset);
+    }
+
+    bool
+    bulk_subscript_valid_data(FieldId field_id, const int64_t* seg_offsets, int64_t count, bool* output) const override {
+        return insert_record_.bulk_subscript_valid_data(field_id, seg_offsets, count, output);
+    }
+
     std::pair<std::unique_ptr<IdArray>, std::vector<SegOffset>>
     search_ids(const IdArray& id_array, Timestamp timestamp) const override;
 
@@ -219,6 +232,8 @@ class SegmentGrowingImpl : public SegmentGrowing {
  private:
     SegcoreConfig segcore_config_;
     SchemaPtr schema_;
+    // field indexings refer to the field metas of the schemas replaced by AddFields
+    std::vector<SchemaPtr> old_schemas_;
 
     // small indexes for every chunk
     IndexingRecord indexing_record_;
@@ -49,6 +49,12 @@ SegmentInternalInterface::FillTargetEntry(const query::Plan* plan, SearchResult&
     for (auto field_id : plan->target_entries_) {
         auto field_data = bulk_subscript(field_id, results.seg_offsets_.data(), size);
         results.output_fields_data_[field_id] = std::move(field_data);
+        if (plan->schema_[field_id].is_nullable()) {
+            FixedVector<bool> valid_data(size);
+            if (bulk_subscript_valid_data(field_id, results.seg_offsets_.data(), size, valid_data.data())) {
+                results.output_fields_valid_data_[field_id] = std::move(valid_data);
+            }
+        }
     }
 }
 
@@ -103,6 +109,13 @@ SegmentInternalInterface::Retrieve(const query::RetrievePlan* plan, Timestamp ti
 
         auto col =
             bulk_subscript(field_id, retrieve_results.result_offsets_.data(), retrieve_results.result_offsets_.size());
+        if (field_meta.is_nullable()) {
+            auto size = retrieve_results.result_offsets_.size();
+            FixedVector<bool> valid_data(size);
+            if (bulk_subscript_valid_data(field_id, retrieve_results.result_offsets_.data(), size, valid_data.data())) {
+                SetFieldValidData(col.get(), valid_data.data(), size);
+            }
+        }
         auto col_data = col.release();
         fields_data->AddAllocated(col_data);
         if (pk_field_id.has_value() && pk_field_id.value() == field_id) {
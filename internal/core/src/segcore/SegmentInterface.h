@@ -141,6 +141,14 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual void
     mask_with_delete(BitsetType& bitset, int64_t ins_barrier, Timestamp timestamp) const = 0;
 
+    // clear the bits of rows whose value of the nullable field is null
+    virtual void
+    mask_with_valid_data(FieldId field_id, BitsetType& bitset) const = 0;
+
+    // fill the validity of the rows of a nullable field, returns false if the segment holds no validity of the field
+    virtual bool
+    bulk_subscript_valid_data(FieldId field_id, const int64_t* seg_offsets, int64_t count, bool* output) const = 0;
+
     // count of chunk that has index available
     virtual int64_t
     num_chunk_index(FieldId field_id) const = 0;
@@ -33,6 +33,9 @@ class SegmentSealed : public SegmentInternalInterface {
     DropIndex(const FieldId field_id) = 0;
     virtual void
     DropFieldData(const FieldId field_id) = 0;
+    // append the fields in schema but not in the segment, the data of them is loaded afterwards
+    virtual void
+    AddFields(SchemaPtr schema) = 0;
 };
 
 using SegmentSealedPtr = std::unique_ptr<SegmentSealed>;
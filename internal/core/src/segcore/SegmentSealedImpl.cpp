@@ -200,6 +200,16 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
         field_data->fill_chunk_data(size, info.field_data, field_meta);
         AssertInfo(field_data->num_chunk() == 1, "num chunk not equal to 1 for sealed segment");
 
+        // rows of nullable field without validity are all valid
+        if (auto valid_data = insert_record_.get_valid_data(field_id); valid_data != nullptr) {
+            if (info.valid_data != nullptr) {
+                valid_data->fill_chunk_data(info.valid_data, size);
+            } else {
+                FixedVector<bool> all_valid(size, true);
+                valid_data->fill_chunk_data(all_valid.data(), size);
+            }
+        }
+
         // set pks to offset
         if (schema_->get_primary_field_id() == field_id) {
             AssertInfo(field_id.get() != -1, "Primary key is -1");
@@ -374,6 +384,21 @@ SegmentSealedImpl::DropFieldData(const FieldId field_id) {
     }
 }
 
+void
+SegmentSealedImpl::AddFields(SchemaPtr schema) {
+    std::unique_lock lck(mutex_);
+    for (auto& [field_id, field_meta] : schema->get_fields()) {
+        if (schema_->get_fields().count(field_id)) {
+            continue;
+        }
+        AssertInfo(!field_meta.is_vector(), "vector field can't be added");
+        insert_record_.append_field(field_id, field_meta, MAX_ROW_COUNT);
+    }
+    field_data_ready_bitset_.resize(schema->size());
+    index_ready_bitset_.resize(schema->size());
+    schema_ = std::move(schema);
+}
+
 void
 SegmentSealedImpl::DropIndex(const FieldId field_id) {
     AssertInfo(!SystemProperty::Instance().IsSystem(field_id),
@@ -46,6 +46,8 @@ class SegmentSealedImpl : public SegmentSealed {
     DropIndex(const FieldId field_id) override;
     void
     DropFieldData(const FieldId field_id) override;
+    void
+    AddFields(SchemaPtr schema) override;
     bool
     HasIndex(FieldId field_id) const override;
     bool
@@ -152,6 +154,16 @@ class SegmentSealedImpl : public SegmentSealed {
     void
     mask_with_delete(BitsetType& bitset, int64_t ins_barrier, Timestamp timestamp) const override;
 
+    void
+    mask_with_valid_data(FieldId field_id, BitsetType& bitset) const override {
+        insert_record_.mask_with_valid_data(field_id, bitset);
+    }
+
+    bool
+    bulk_subscript_valid_data(FieldId field_id, const int64_t* seg_offsets, int64_t count, bool* output) const override {
+        return insert_record_.bulk_subscript_valid_data(field_id, seg_offsets, count, output);
+    }
+
     bool
     is_system_field_ready() const {
         return system_ready_count_ == 2;
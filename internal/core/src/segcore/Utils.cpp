@@ -11,6 +11,7 @@
 
 #include "segcore/Utils.h"
 #include "index/ScalarIndex.h"
+#include <google/protobuf/unknown_field_set.h>
 
 namespace milvus::segcore {
 
@@ -176,6 +177,18 @@ CreateDataArrayFrom(const void* data_raw, int64_t count, const FieldMeta& field_
     return CreateVectorDataArrayFrom(data_raw, count, field_meta);
 }
 
+void
+SetFieldValidData(DataArray* data_array, const bool* valid_data, int64_t count) {
+    // packed repeated bool, every value is a single byte varint
+    std::string packed(count, '\0');
+    for (int64_t i = 0; i < count; ++i) {
+        packed[i] = valid_data[i] ? 1 : 0;
+    }
+    auto unknown_fields = data_array->GetReflection()->MutableUnknownFields(data_array);
+    unknown_fields->DeleteByNumber(FIELD_VALID_DATA_NUMBER);
+    unknown_fields->AddLengthDelimited(FIELD_VALID_DATA_NUMBER, packed);
+}
+
 // TODO remove merge dataArray, instead fill target entity when get data slice
 std::unique_ptr<DataArray>
 MergeDataArray(std::vector<std::pair<milvus::SearchResult*, int64_t>>& result_offsets, const FieldMeta& field_meta) {
@@ -184,6 +197,19 @@ MergeDataArray(std::vector<std::pair<milvus::SearchResult*, int64_t>>& result_of
     data_array->set_field_id(field_meta.get_id().get());
     data_array->set_type(milvus::proto::schema::DataType(field_meta.get_data_type()));
 
+    // rows of the segments holding no validity of the nullable field are valid
+    FixedVector<bool> valid_data;
+    bool has_valid_data = false;
+    for (auto& result_pair : result_offsets) {
+        auto& valid_map = result_pair.first->output_fields_valid_data_;
+        auto iter = valid_map.find(field_meta.get_id());
+        has_valid_data = has_valid_data || iter != valid_map.end();
+        valid_data.push_back(iter == valid_map.end() || iter->second[result_pair.second]);
+    }
+    if (has_valid_data) {
+        SetFieldValidData(data_array.get(), valid_data.data(), valid_data.size());
+    }
+
     for (auto& result_pair : result_offsets) {
         auto src_field_data = result_pair.first->output_fields_data_[field_meta.get_id()].get();
         auto src_offset = result_pair.second;
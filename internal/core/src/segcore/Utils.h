@@ -45,6 +45,10 @@ CreateVectorDataArrayFrom(const void* data_raw, int64_t count, const FieldMeta&
 std::unique_ptr<DataArray>
 CreateDataArrayFrom(const void* data_raw, int64_t count, const FieldMeta& field_meta);
 
+// attach the validity of a nullable field to the data array, see FIELD_VALID_DATA_NUMBER
+void
+SetFieldValidData(DataArray* data_array, const bool* valid_data, int64_t count);
+
 // TODO remove merge dataArray, instead fill target entity when get data slice
 std::unique_ptr<DataArray>
 MergeDataArray(std::vector<std::pair<milvus::SearchResult*, int64_t>>& result_offsets, const FieldMeta& field_meta);
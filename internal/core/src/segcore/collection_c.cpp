@@ -24,6 +24,12 @@ NewCollection(const char* schema_proto_blob) {
     return (void*)collection.release();
 }
 
+void
+UpdateCollectionSchema(CCollection collection, const char* schema_proto_blob) {
+    auto col = (milvus::segcore::Collection*)collection;
+    col->update_schema(std::string(schema_proto_blob));
+}
+
 void
 DeleteCollection(CCollection collection) {
     auto col = (milvus::segcore::Collection*)collection;
@@ -20,6 +20,9 @@ typedef void* CCollection;
 CCollection
 NewCollection(const char* schema_proto_blob);
 
+void
+UpdateCollectionSchema(CCollection collection, const char* schema_proto_blob);
+
 void
 DeleteCollection(CCollection collection);
 
@@ -170,6 +170,25 @@ PreInsert(CSegmentInterface c_segment, int64_t size, int64_t* offset) {
     }
 }
 
+CStatus
+AddGrowingSegmentFields(CSegmentInterface c_segment,
+                        CCollection c_collection,
+                        const uint8_t* data_info,
+                        const uint64_t data_info_len) {
+    try {
+        auto segment = (milvus::segcore::SegmentGrowing*)c_segment;
+        auto col = (milvus::segcore::Collection*)c_collection;
+        auto default_data = std::make_unique<milvus::InsertData>();
+        auto suc = default_data->ParseFromArray(data_info, data_info_len);
+        AssertInfo(suc, "failed to parse insert data from records");
+
+        segment->AddFields(col->get_schema(), default_data.get());
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
 CStatus
 Delete(CSegmentInterface c_segment,
        int64_t reserved_offset,
@@ -206,8 +225,8 @@ LoadFieldData(CSegmentInterface c_segment, CLoadFieldDataInfo load_field_data_in
         auto field_data = std::make_unique<milvus::DataArray>();
         auto suc = field_data->ParseFromArray(load_field_data_info.blob, load_field_data_info.blob_size);
         AssertInfo(suc, "unmarshal field data string failed");
-        auto load_info =
-            LoadFieldDataInfo{load_field_data_info.field_id, field_data.get(), load_field_data_info.row_count};
+        auto load_info = LoadFieldDataInfo{load_field_data_info.field_id, field_data.get(),
+                                           load_field_data_info.row_count, load_field_data_info.valid_data};
         segment->LoadFieldData(load_info);
         return milvus::SuccessCStatus();
     } catch (std::exception& e) {
@@ -271,3 +290,17 @@ DropSealedSegmentIndex(CSegmentInterface c_segment, int64_t field_id) {
         return milvus::FailureCStatus(UnexpectedError, e.what());
     }
 }
+
+CStatus
+AddSealedSegmentFields(CSegmentInterface c_segment, CCollection c_collection) {
+    try {
+        auto segment_interface = reinterpret_cast<milvus::segcore::SegmentInterface*>(c_segment);
+        auto segment = dynamic_cast<milvus::segcore::SegmentSealed*>(segment_interface);
+        AssertInfo(segment != nullptr, "segment conversion failed");
+        auto col = (milvus::segcore::Collection*)c_collection;
+        segment->AddFields(col->get_schema());
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
@@ -75,6 +75,12 @@ Insert(CSegmentInterface c_segment,
 CStatus
 PreInsert(CSegmentInterface c_segment, int64_t size, int64_t* offset);
 
+CStatus
+AddGrowingSegmentFields(CSegmentInterface c_segment,
+                        CCollection c_collection,
+                        const uint8_t* data_info,
+                        const uint64_t data_info_len);
+
 //////////////////////////////    interfaces for sealed segment    //////////////////////////////
 CStatus
 LoadFieldData(CSegmentInterface c_segment, CLoadFieldDataInfo load_field_data_info);
@@ -91,6 +97,9 @@ DropFieldData(CSegmentInterface c_segment, int64_t field_id);
 CStatus
 DropSealedSegmentIndex(CSegmentInterface c_segment, int64_t field_id);
 
+CStatus
+AddSealedSegmentFields(CSegmentInterface c_segment, CCollection c_collection);
+
 //////////////////////////////    interfaces for SegmentInterface    //////////////////////////////
 CStatus
 Delete(CSegmentInterface c_segment,
@@ -69,6 +69,17 @@ FieldData::get_one_string_payload(int idx, char** cstr, int* str_size) const {
     *str_size = length;
 }
 
+void
+FieldData::get_one_binary_payload(int idx, uint8_t** data, int* length) const {
+    AssertInfo(array_ != nullptr, "null arrow array");
+    AssertInfo(array_->type()->id() == arrow::Type::type::BINARY, "inconsistent data type");
+    auto array = std::dynamic_pointer_cast<arrow::BinaryArray>(array_);
+    AssertInfo(idx < array->length(), "index out of range array.length");
+    arrow::BinaryArray::offset_type value_length;
+    *data = const_cast<uint8_t*>(array->GetValue(idx, &value_length));
+    *length = value_length;
+}
+
 std::unique_ptr<Payload>
 FieldData::get_payload() const {
     AssertInfo(array_ != nullptr, "null arrow array");
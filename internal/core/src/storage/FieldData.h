@@ -51,6 +51,9 @@ class FieldData {
     void
     get_one_string_payload(int idx, char** cstr, int* str_size) const;
 
+    void
+    get_one_binary_payload(int idx, uint8_t** data, int* length) const;
+
     // get the bytes stream of the arrow array data
     std::unique_ptr<Payload>
     get_payload() const;
@@ -57,6 +57,12 @@ PayloadReader::get_one_string_Payload(int idx, char** cstr, int* str_size) const
     return field_data_->get_one_string_payload(idx, cstr, str_size);
 }
 
+void
+PayloadReader::get_one_binary_payload(int idx, uint8_t** data, int* length) const {
+    AssertInfo(field_data_ != nullptr, "empty payload");
+    return field_data_->get_one_binary_payload(idx, data, length);
+}
+
 std::unique_ptr<Payload>
 PayloadReader::get_payload() const {
     AssertInfo(field_data_ != nullptr, "empty payload");
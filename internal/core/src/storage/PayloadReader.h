@@ -41,6 +41,9 @@ class PayloadReader {
     void
     get_one_string_Payload(int idx, char** cstr, int* str_size) const;
 
+    void
+    get_one_binary_payload(int idx, uint8_t** data, int* length) const;
+
     std::unique_ptr<Payload>
     get_payload() const;
 
@@ -35,6 +35,10 @@ class PayloadWriter {
     void
     add_one_string_payload(const char* str, int str_size);
 
+    // add one variable length row of sparse float vector
+    void
+    add_one_binary_payload(const uint8_t* data, int length);
+
     void
     finish();
 
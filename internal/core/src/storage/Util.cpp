@@ -100,7 +100,9 @@ AddPayloadToArrowBuilder(std::shared_ptr<arrow::ArrayBuilder> builder, const Pay
             break;
         }
         case DataType::VECTOR_BINARY:
-        case DataType::VECTOR_FLOAT: {
+        case DataType::VECTOR_FLOAT:
+        case DataType::VECTOR_FLOAT16:
+        case DataType::VECTOR_BFLOAT16: {
             add_vector_payload(builder, const_cast<uint8_t*>(raw_data), length);
             break;
         }
@@ -123,6 +125,20 @@ AddOneStringToArrowBuilder(std::shared_ptr<arrow::ArrayBuilder> builder, const c
     AssertInfo(ast.ok(), "append value to arrow builder failed");
 }
 
+void
+AddOneBinaryToArrowBuilder(std::shared_ptr<arrow::ArrayBuilder> builder, const uint8_t* data, int length) {
+    AssertInfo(builder != nullptr, "empty arrow builder");
+    auto binary_builder = std::dynamic_pointer_cast<arrow::BinaryBuilder>(builder);
+    AssertInfo(binary_builder != nullptr, "mismatch arrow builder type");
+    arrow::Status ast;
+    if (length < 0) {
+        ast = binary_builder->AppendNull();
+    } else {
+        ast = binary_builder->Append(data, length);
+    }
+    AssertInfo(ast.ok(), "append value to arrow builder failed");
+}
+
 std::shared_ptr<arrow::ArrayBuilder>
 CreateArrowBuilder(DataType data_type) {
     switch (static_cast<DataType>(data_type)) {
@@ -151,6 +167,9 @@ CreateArrowBuilder(DataType data_type) {
         case DataType::STRING: {
             return std::make_shared<arrow::StringBuilder>();
         }
+        case DataType::VECTOR_SPARSE_FLOAT: {
+            return std::make_shared<arrow::BinaryBuilder>();
+        }
         default: {
             PanicInfo("unsupported numeric data type");
         }
@@ -168,6 +187,11 @@ CreateArrowBuilder(DataType data_type, int dim) {
             AssertInfo(dim % 8 == 0 && dim > 0, "invalid dim value");
             return std::make_shared<arrow::FixedSizeBinaryBuilder>(arrow::fixed_size_binary(dim / 8));
         }
+        case DataType::VECTOR_FLOAT16:
+        case DataType::VECTOR_BFLOAT16: {
+            AssertInfo(dim > 0, "invalid dim value");
+            return std::make_shared<arrow::FixedSizeBinaryBuilder>(arrow::fixed_size_binary(dim * sizeof(uint16_t)));
+        }
         default: {
             PanicInfo("unsupported vector data type");
         }
@@ -202,6 +226,9 @@ CreateArrowSchema(DataType data_type) {
         case DataType::STRING: {
             return arrow::schema({arrow::field("val", arrow::utf8())});
         }
+        case DataType::VECTOR_SPARSE_FLOAT: {
+            return arrow::schema({arrow::field("val", arrow::binary())});
+        }
         default: {
             PanicInfo("unsupported numeric data type");
         }
@@ -219,6 +246,11 @@ CreateArrowSchema(DataType data_type, int dim) {
             AssertInfo(dim % 8 == 0 && dim > 0, "invalid dim value");
             return arrow::schema({arrow::field("val", arrow::fixed_size_binary(dim / 8))});
         }
+        case DataType::VECTOR_FLOAT16:
+        case DataType::VECTOR_BFLOAT16: {
+            AssertInfo(dim > 0, "invalid dim value");
+            return arrow::schema({arrow::field("val", arrow::fixed_size_binary(dim * sizeof(uint16_t)))});
+        }
         default: {
             PanicInfo("unsupported vector data type");
         }
@@ -251,6 +283,11 @@ GetPayloadSize(const Payload* payload) {
             Assert(payload->dimension.has_value());
             return payload->rows * payload->dimension.value();
         }
+        case DataType::VECTOR_FLOAT16:
+        case DataType::VECTOR_BFLOAT16: {
+            Assert(payload->dimension.has_value());
+            return payload->rows * payload->dimension.value() * sizeof(uint16_t);
+        }
         default:
             PanicInfo("unsupported data type");
     }
@@ -294,7 +331,9 @@ GetRawValuesFromArrowArray(std::shared_ptr<arrow::Array> data, DataType data_typ
             auto array = std::dynamic_pointer_cast<arrow::FixedSizeBinaryArray>(data);
             return reinterpret_cast<const uint8_t*>(array->raw_values());
         }
-        case DataType::VECTOR_BINARY: {
+        case DataType::VECTOR_BINARY:
+        case DataType::VECTOR_FLOAT16:
+        case DataType::VECTOR_BFLOAT16: {
             AssertInfo(data->type()->id() == arrow::Type::type::FIXED_SIZE_BINARY, "inconsistent data type");
             auto array = std::dynamic_pointer_cast<arrow::FixedSizeBinaryArray>(data);
             return reinterpret_cast<const uint8_t*>(array->raw_values());
@@ -317,6 +356,12 @@ GetDimensionFromArrowArray(std::shared_ptr<arrow::Array> data, DataType data_typ
             auto array = std::dynamic_pointer_cast<arrow::FixedSizeBinaryArray>(data);
             return array->byte_width() * 8;
         }
+        case DataType::VECTOR_FLOAT16:
+        case DataType::VECTOR_BFLOAT16: {
+            AssertInfo(data->type()->id() == arrow::Type::type::FIXED_SIZE_BINARY, "inconsistent data type");
+            auto array = std::dynamic_pointer_cast<arrow::FixedSizeBinaryArray>(data);
+            return array->byte_width() / sizeof(uint16_t);
+        }
         default:
             PanicInfo("unsupported data type");
     }
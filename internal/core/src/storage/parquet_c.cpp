@@ -138,6 +138,41 @@ AddFloatVectorToPayload(CPayloadWriter payloadWriter, float* values, int dimensi
     }
 }
 
+extern "C" CStatus
+AddFloat16VectorToPayload(CPayloadWriter payloadWriter, uint8_t* values, int dimension, int length) {
+    try {
+        auto p = reinterpret_cast<PayloadWriter*>(payloadWriter);
+        auto raw_data_info = Payload{milvus::DataType::VECTOR_FLOAT16, values, length, dimension};
+        p->add_payload(raw_data_info);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
+extern "C" CStatus
+AddBFloat16VectorToPayload(CPayloadWriter payloadWriter, uint8_t* values, int dimension, int length) {
+    try {
+        auto p = reinterpret_cast<PayloadWriter*>(payloadWriter);
+        auto raw_data_info = Payload{milvus::DataType::VECTOR_BFLOAT16, values, length, dimension};
+        p->add_payload(raw_data_info);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
+extern "C" CStatus
+AddOneSparseFloatVectorToPayload(CPayloadWriter payloadWriter, uint8_t* values, int length) {
+    try {
+        auto p = reinterpret_cast<PayloadWriter*>(payloadWriter);
+        p->add_one_binary_payload(values, length);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
 extern "C" CStatus
 FinishPayloadWriter(CPayloadWriter payloadWriter) {
     try {
@@ -193,7 +228,10 @@ NewPayloadReader(int columnType, uint8_t* buffer, int64_t buf_size) {
         case milvus::DataType::STRING:
         case milvus::DataType::VARCHAR:
         case milvus::DataType::VECTOR_BINARY:
-        case milvus::DataType::VECTOR_FLOAT: {
+        case milvus::DataType::VECTOR_FLOAT:
+        case milvus::DataType::VECTOR_FLOAT16:
+        case milvus::DataType::VECTOR_BFLOAT16:
+        case milvus::DataType::VECTOR_SPARSE_FLOAT: {
             break;
         }
         default: {
@@ -340,6 +378,45 @@ GetFloatVectorFromPayload(CPayloadReader payloadReader, float** values, int* dim
     }
 }
 
+extern "C" CStatus
+GetFloat16VectorFromPayload(CPayloadReader payloadReader, uint8_t** values, int* dimension, int* length) {
+    try {
+        auto p = reinterpret_cast<PayloadReader*>(payloadReader);
+        auto ret = p->get_payload();
+        *values = const_cast<uint8_t*>(ret->raw_data);
+        *length = ret->rows;
+        *dimension = ret->dimension.value();
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
+extern "C" CStatus
+GetBFloat16VectorFromPayload(CPayloadReader payloadReader, uint8_t** values, int* dimension, int* length) {
+    try {
+        auto p = reinterpret_cast<PayloadReader*>(payloadReader);
+        auto ret = p->get_payload();
+        *values = const_cast<uint8_t*>(ret->raw_data);
+        *length = ret->rows;
+        *dimension = ret->dimension.value();
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
+extern "C" CStatus
+GetOneSparseFloatVectorFromPayload(CPayloadReader payloadReader, int idx, uint8_t** values, int* length) {
+    try {
+        auto p = reinterpret_cast<PayloadReader*>(payloadReader);
+        p->get_one_binary_payload(idx, values, length);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
 extern "C" int
 GetPayloadLengthFromReader(CPayloadReader payloadReader) {
     auto p = reinterpret_cast<PayloadReader*>(payloadReader);
@@ -56,6 +56,12 @@ CStatus
 AddBinaryVectorToPayload(CPayloadWriter payloadWriter, uint8_t* values, int dimension, int length);
 CStatus
 AddFloatVectorToPayload(CPayloadWriter payloadWriter, float* values, int dimension, int length);
+CStatus
+AddFloat16VectorToPayload(CPayloadWriter payloadWriter, uint8_t* values, int dimension, int length);
+CStatus
+AddBFloat16VectorToPayload(CPayloadWriter payloadWriter, uint8_t* values, int dimension, int length);
+CStatus
+AddOneSparseFloatVectorToPayload(CPayloadWriter payloadWriter, uint8_t* values, int length);
 
 CStatus
 FinishPayloadWriter(CPayloadWriter payloadWriter);
@@ -90,6 +96,12 @@ CStatus
 GetBinaryVectorFromPayload(CPayloadReader payloadReader, uint8_t** values, int* dimension, int* length);
 CStatus
 GetFloatVectorFromPayload(CPayloadReader payloadReader, float** values, int* dimension, int* length);
+CStatus
+GetFloat16VectorFromPayload(CPayloadReader payloadReader, uint8_t** values, int* dimension, int* length);
+CStatus
+GetBFloat16VectorFromPayload(CPayloadReader payloadReader, uint8_t** values, int* dimension, int* length);
+CStatus
+GetOneSparseFloatVectorFromPayload(CPayloadReader payloadReader, int idx, uint8_t** values, int* length);
 
 int
 GetPayloadLengthFromReader(CPayloadReader payloadReader);
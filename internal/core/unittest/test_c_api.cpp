@@ -225,6 +225,33 @@ TEST(CApiTest, GetCollectionNameTest) {
     DeleteCollection(collection);
 }
 
+TEST(CApiTest, UpdateCollectionSchemaTest) {
+    auto collection = NewCollection(get_default_schema_config());
+    auto old_segment = NewSegment(collection, Growing, -1);
+
+    auto schema_config = std::string(get_default_schema_config()) + R"(
+                                fields: <
+                                  fieldID: 102
+                                  name: "tag"
+                                  data_type: Int64
+                                  type_params: <
+                                    key: "nullable"
+                                    value: "true"
+                                  >
+                                >)";
+    UpdateCollectionSchema(collection, schema_config.c_str());
+    auto col = (milvus::segcore::Collection*)collection;
+    ASSERT_EQ(col->get_schema()->size(), 3);
+    ASSERT_TRUE((*col->get_schema())[milvus::FieldId(102)].is_nullable());
+
+    // segment created before the update keeps the old schema
+    auto seg = (milvus::segcore::SegmentInterface*)old_segment;
+    ASSERT_EQ(seg->get_schema().size(), 2);
+
+    DeleteSegment(old_segment);
+    DeleteCollection(collection);
+}
+
 TEST(CApiTest, SegmentTest) {
     auto collection = NewCollection(get_default_schema_config());
     auto segment = NewSegment(collection, Growing, -1);
@@ -302,6 +329,76 @@ TEST(CApiTest, InsertTest) {
     DeleteSegment(segment);
 }
 
+TEST(CApiTest, AddSegmentFieldsTest) {
+    auto collection = NewCollection(get_default_schema_config());
+    auto col = (milvus::segcore::Collection*)collection;
+    auto growing_segment = NewSegment(collection, Growing, -1);
+    auto sealed_segment = NewSegment(collection, Sealed, -1);
+
+    int N = 100;
+    auto dataset = DataGen(col->get_schema(), N);
+    int64_t offset;
+    PreInsert(growing_segment, N, &offset);
+    auto insert_data = serialize(dataset.raw_);
+    auto status = Insert(growing_segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
+                         insert_data.data(), insert_data.size());
+    ASSERT_EQ(status.error_code, Success);
+
+    auto schema_config = std::string(get_default_schema_config()) + R"(
+                                fields: <
+                                  fieldID: 102
+                                  name: "tag"
+                                  data_type: Int64
+                                  type_params: <
+                                    key: "nullable"
+                                    value: "true"
+                                  >
+                                >)";
+    UpdateCollectionSchema(collection, schema_config.c_str());
+
+    // existing rows of the growing segment are null
+    milvus::InsertData default_data;
+    default_data.set_num_rows(N);
+    auto field_data = default_data.add_fields_data();
+    field_data->set_field_id(102);
+    field_data->set_type(milvus::proto::schema::DataType::Int64);
+    auto valid_data = default_data.add_valid_data();
+    valid_data->set_field_id(102);
+    for (int i = 0; i < N; ++i) {
+        field_data->mutable_scalars()->mutable_long_data()->add_data(0);
+        valid_data->add_valid_data(false);
+    }
+    auto default_blob = serialize(&default_data);
+    status = AddGrowingSegmentFields(growing_segment, collection, default_blob.data(), default_blob.size());
+    ASSERT_EQ(status.error_code, Success);
+
+    auto growing = (milvus::segcore::SegmentInternalInterface*)growing_segment;
+    ASSERT_EQ(growing->get_schema().size(), 3);
+    std::vector<int64_t> seg_offsets{0, N - 1};
+    bool output[2] = {true, true};
+    ASSERT_TRUE(growing->bulk_subscript_valid_data(milvus::FieldId(102), seg_offsets.data(), 2, output));
+    ASSERT_FALSE(output[0]);
+    ASSERT_FALSE(output[1]);
+
+    // rows inserted afterwards carry the added field
+    auto new_dataset = DataGen(col->get_schema(), N, 43, N);
+    PreInsert(growing_segment, N, &offset);
+    auto new_insert_data = serialize(new_dataset.raw_);
+    status = Insert(growing_segment, offset, N, new_dataset.row_ids_.data(), new_dataset.timestamps_.data(),
+                    new_insert_data.data(), new_insert_data.size());
+    ASSERT_EQ(status.error_code, Success);
+    ASSERT_EQ(GetRowCount(growing_segment), 2 * N);
+
+    status = AddSealedSegmentFields(sealed_segment, collection);
+    ASSERT_EQ(status.error_code, Success);
+    auto sealed = (milvus::segcore::SegmentInterface*)sealed_segment;
+    ASSERT_EQ(sealed->get_schema().size(), 3);
+
+    DeleteSegment(growing_segment);
+    DeleteSegment(sealed_segment);
+    DeleteCollection(collection);
+}
+
 TEST(CApiTest, DeleteTest) {
     auto collection = NewCollection(get_default_schema_config());
     auto segment = NewSegment(collection, Growing, -1);
@@ -14,10 +14,12 @@
 #include <regex>
 
 #include "query/Expr.h"
+#include "query/ExprImpl.h"
 #include "query/Plan.h"
 #include "query/PlanNode.h"
 #include "query/generated/ShowPlanNodeVisitor.h"
 #include "query/generated/ExecExprVisitor.h"
+#include "query/generated/VerifyExprVisitor.h"
 #include "segcore/SegmentGrowingImpl.h"
 #include "test_utils/DataGen.h"
 #include "index/IndexFactory.h"
@@ -585,6 +587,73 @@ TEST(Expr, TestCompare) {
     }
 }
 
+TEST(Expr, TestNullable) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto schema = std::make_shared<Schema>();
+    schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, knowhere::metric::L2);
+    auto i64_fid = schema->AddDebugField("id", DataType::INT64);
+    auto age_fid = FieldId(300);
+    auto age_meta = FieldMeta(FieldName("age"), age_fid, DataType::INT64);
+    age_meta.set_nullable(true);
+    schema->AddField(std::move(age_meta));
+    schema->set_primary_field_id(i64_fid);
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 1000;
+    auto raw_data = DataGen(schema, N);
+    auto valid_data = raw_data.raw_->add_valid_data();
+    valid_data->set_field_id(age_fid.get());
+    for (int i = 0; i < N; ++i) {
+        valid_data->add_valid_data(i % 3 != 0);
+    }
+    seg->PreInsert(N);
+    seg->Insert(0, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+
+    NullExpr is_null(age_fid, DataType::INT64, NullExpr::OpType::IsNull);
+    auto is_null_res = visitor.call_child(is_null);
+    NullExpr is_not_null(age_fid, DataType::INT64, NullExpr::OpType::IsNotNull);
+    auto is_not_null_res = visitor.call_child(is_not_null);
+    // every row satisfies the range except null ones
+    UnaryRangeExprImpl<int64_t> range(age_fid, DataType::INT64, OpType::GreaterEqual,
+                                      std::numeric_limits<int64_t>::min());
+    auto range_res = visitor.call_child(range);
+    NullExpr id_is_null(i64_fid, DataType::INT64, NullExpr::OpType::IsNull);
+    auto id_is_null_res = visitor.call_child(id_is_null);
+
+    for (int i = 0; i < N; ++i) {
+        ASSERT_EQ(is_null_res[i], i % 3 == 0) << i;
+        ASSERT_EQ(is_not_null_res[i], i % 3 != 0) << i;
+        ASSERT_EQ(range_res[i], i % 3 != 0) << i;
+        ASSERT_FALSE(id_is_null_res[i]) << i;
+    }
+
+    VerifyExprVisitor verifier;
+    is_null.accept(verifier);
+    NullExpr vec_is_null(schema->get_field_id(FieldName("fakevec")), DataType::VECTOR_FLOAT, NullExpr::OpType::IsNull);
+    ASSERT_ANY_THROW(vec_is_null.accept(verifier));
+
+    // the validity of retrieved rows is carried by the data array
+    auto plan = std::make_unique<RetrievePlan>(*schema);
+    plan->plan_node_ = std::make_unique<RetrievePlanNode>();
+    plan->plan_node_->predicate_ = std::make_unique<NullExpr>(age_fid, DataType::INT64, NullExpr::OpType::IsNull);
+    plan->field_ids_ = std::vector<FieldId>{i64_fid, age_fid};
+    auto retrieve_results = seg->Retrieve(plan.get(), MAX_TIMESTAMP);
+    ASSERT_EQ(retrieve_results->fields_data_size(), 2);
+    auto& id_data = retrieve_results->fields_data(0);
+    ASSERT_EQ(id_data.GetReflection()->GetUnknownFields(id_data).field_count(), 0);
+    auto& age_data = retrieve_results->fields_data(1);
+    auto& unknown_fields = age_data.GetReflection()->GetUnknownFields(age_data);
+    ASSERT_EQ(unknown_fields.field_count(), 1);
+    ASSERT_EQ(unknown_fields.field(0).number(), FIELD_VALID_DATA_NUMBER);
+    auto num_null = (N + 2) / 3;
+    ASSERT_EQ(age_data.scalars().long_data().data_size(), num_null);
+    ASSERT_EQ(unknown_fields.field(0).length_delimited(), std::string(num_null, '\0'));
+}
+
 TEST(Expr, TestCompareWithScalarIndex) {
     using namespace milvus::query;
     using namespace milvus::segcore;
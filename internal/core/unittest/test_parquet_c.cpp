@@ -212,6 +212,53 @@ TEST(storage, stringarray) {
     ReleasePayloadReader(reader);
 }
 
+TEST(storage, sparse_float_vector) {
+    auto payload = NewPayloadWriter(int(milvus::DataType::VECTOR_SPARSE_FLOAT));
+    // each element is a pair of uint32 index and float32 value
+    uint32_t row0[] = {1, 0x3f800000, 7, 0x40000000};
+    uint32_t row1[] = {3, 0x40400000};
+    auto st = AddOneSparseFloatVectorToPayload(payload, (uint8_t*)row0, sizeof(row0));
+    ASSERT_EQ(st.error_code, ErrorCode::Success);
+    st = AddOneSparseFloatVectorToPayload(payload, (uint8_t*)row1, sizeof(row1));
+    ASSERT_EQ(st.error_code, ErrorCode::Success);
+    st = AddOneSparseFloatVectorToPayload(payload, nullptr, 0);
+    ASSERT_EQ(st.error_code, ErrorCode::Success);
+
+    st = FinishPayloadWriter(payload);
+    ASSERT_EQ(st.error_code, ErrorCode::Success);
+    auto cb = GetPayloadBufferFromWriter(payload);
+    ASSERT_GT(cb.length, 0);
+    ASSERT_NE(cb.data, nullptr);
+    auto nums = GetPayloadLengthFromWriter(payload);
+    ASSERT_EQ(nums, 3);
+
+    auto reader = NewPayloadReader(int(milvus::DataType::VECTOR_SPARSE_FLOAT), (uint8_t*)cb.data, cb.length);
+    int length = GetPayloadLengthFromReader(reader);
+    ASSERT_EQ(length, 3);
+    uint8_t* v0;
+    int s0;
+    st = GetOneSparseFloatVectorFromPayload(reader, 0, &v0, &s0);
+    ASSERT_EQ(st.error_code, ErrorCode::Success);
+    ASSERT_EQ(s0, sizeof(row0));
+    ASSERT_EQ(memcmp(v0, row0, sizeof(row0)), 0);
+
+    uint8_t* v1;
+    int s1;
+    st = GetOneSparseFloatVectorFromPayload(reader, 1, &v1, &s1);
+    ASSERT_EQ(st.error_code, ErrorCode::Success);
+    ASSERT_EQ(s1, sizeof(row1));
+    ASSERT_EQ(memcmp(v1, row1, sizeof(row1)), 0);
+
+    uint8_t* v2;
+    int s2;
+    st = GetOneSparseFloatVectorFromPayload(reader, 2, &v2, &s2);
+    ASSERT_EQ(st.error_code, ErrorCode::Success);
+    ASSERT_EQ(s2, 0);
+
+    ReleasePayloadWriter(payload);
+    ReleasePayloadReader(reader);
+}
+
 TEST(storage, binary_vector) {
     int DIM = 16;
     auto payload = NewVectorPayloadWriter(int(milvus::DataType::VECTOR_BINARY), DIM);
@@ -247,6 +294,49 @@ TEST(storage, binary_vector) {
     ReleasePayloadReader(reader);
 }
 
+TEST(storage, float16_vector) {
+    int DIM = 4;
+    for (auto data_type : {milvus::DataType::VECTOR_FLOAT16, milvus::DataType::VECTOR_BFLOAT16}) {
+        auto payload = NewVectorPayloadWriter(int(data_type), DIM);
+        // two rows, each element takes two bytes
+        uint8_t data[] = {0x00, 0x3C, 0x00, 0x40, 0x00, 0x42, 0x00, 0x44, 0x00, 0xBC, 0x00, 0xC0, 0x00, 0xC2, 0x00, 0xC4};
+
+        CStatus st;
+        if (data_type == milvus::DataType::VECTOR_FLOAT16) {
+            st = AddFloat16VectorToPayload(payload, data, DIM, 2);
+        } else {
+            st = AddBFloat16VectorToPayload(payload, data, DIM, 2);
+        }
+        ASSERT_EQ(st.error_code, ErrorCode::Success);
+        st = FinishPayloadWriter(payload);
+        ASSERT_EQ(st.error_code, ErrorCode::Success);
+        auto cb = GetPayloadBufferFromWriter(payload);
+        ASSERT_GT(cb.length, 0);
+        ASSERT_NE(cb.data, nullptr);
+        auto nums = GetPayloadLengthFromWriter(payload);
+        ASSERT_EQ(nums, 2);
+
+        auto reader = NewPayloadReader(int(data_type), (uint8_t*)cb.data, cb.length);
+        uint8_t* values;
+        int length;
+        int dim;
+
+        if (data_type == milvus::DataType::VECTOR_FLOAT16) {
+            st = GetFloat16VectorFromPayload(reader, &values, &dim, &length);
+        } else {
+            st = GetBFloat16VectorFromPayload(reader, &values, &dim, &length);
+        }
+        ASSERT_EQ(st.error_code, ErrorCode::Success);
+        ASSERT_NE(values, nullptr);
+        ASSERT_EQ(dim, DIM);
+        ASSERT_EQ(length, 2);
+        ASSERT_EQ(memcmp(values, data, sizeof(data)), 0);
+
+        ReleasePayloadWriter(payload);
+        ReleasePayloadReader(reader);
+    }
+}
+
 TEST(storage, binary_vector_empty) {
     int DIM = 16;
     auto payload = NewVectorPayloadWriter(int(milvus::DataType::VECTOR_BINARY), DIM);
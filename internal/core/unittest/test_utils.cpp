@@ -35,6 +35,33 @@ TEST(Util, StringMatch) {
     ASSERT_FALSE(PostfixMatch("dontmatch", "postfix"));
 }
 
+TEST(Util, RegexAndTextMatch) {
+    using namespace milvus;
+    using namespace milvus::query;
+
+    ASSERT_ANY_THROW(StringMatcher<int64_t>(1, OpType::RegexMatch));
+    ASSERT_ANY_THROW(StringMatcher<std::string>("[0-9", OpType::RegexMatch));
+    ASSERT_ANY_THROW(StringMatcher<std::string>("prefix", OpType::PrefixMatch));
+
+    StringMatcher<std::string> regex_matcher("[0-9]+ms$", OpType::RegexMatch);
+    ASSERT_TRUE(regex_matcher("timeout after 300ms"));
+    ASSERT_FALSE(regex_matcher("timeout after 300s"));
+
+    StringMatcher<std::string> ilike_matcher("(?i)^error", OpType::RegexMatch);
+    ASSERT_TRUE(ilike_matcher("ERROR: disk full"));
+    ASSERT_FALSE(ilike_matcher("warn: error"));
+
+    // searching a long value must not overflow the stack
+    StringMatcher<std::string> infix_matcher("(?i)^.*x.*$", OpType::RegexMatch);
+    ASSERT_TRUE(infix_matcher(std::string(1 << 20, 'a') + "X"));
+
+    StringMatcher<std::string> text_matcher("Connection refused", OpType::TextMatch);
+    ASSERT_TRUE(text_matcher("dial tcp: connection reset"));
+    ASSERT_TRUE(text_matcher("REFUSED by peer"));
+    ASSERT_FALSE(text_matcher("connections are refusing"));
+    ASSERT_TRUE(Tokenize("!!!").empty());
+}
+
 TEST(Util, GetDeleteBitmap) {
     using namespace milvus;
     using namespace milvus::query;
@@ -120,6 +120,16 @@ func (c *Cluster) Import(ctx context.Context, nodeID int64, it *datapb.ImportTas
 	c.sessionManager.Import(ctx, nodeID, it)
 }
 
+// Export sends export requests to DataNodes whose ID==nodeID, the callback is called after the export finishes.
+func (c *Cluster) Export(ctx context.Context, nodeID int64, req *datapb.ExportTaskRequest, callback func()) {
+	c.sessionManager.Export(ctx, nodeID, req, callback)
+}
+
+// CancelImport sends the cancel request of an import task to the DataNode whose ID==nodeID.
+func (c *Cluster) CancelImport(ctx context.Context, nodeID int64, req *datapb.CancelImportTaskRequest) error {
+	return c.sessionManager.CancelImport(ctx, nodeID, req)
+}
+
 // ReCollectSegmentStats triggers a ReCollectSegmentStats call from session manager.
 func (c *Cluster) ReCollectSegmentStats(ctx context.Context, nodeID int64) {
 	c.sessionManager.ReCollectSegmentStats(ctx, nodeID)
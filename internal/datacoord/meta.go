@@ -65,9 +65,14 @@ type collectionInfo struct {
 
 // NewMeta creates meta from provided `kv.TxnKV`
 func newMeta(ctx context.Context, kv kv.TxnKV, chunkManagerRootPath string, chunkManager storage.ChunkManager) (*meta, error) {
+	return newMetaWithCatalog(ctx, &datacoord.Catalog{Txn: kv, ChunkManagerRootPath: chunkManagerRootPath}, chunkManager)
+}
+
+// newMetaWithCatalog creates meta from provided `metastore.DataCoordCatalog`
+func newMetaWithCatalog(ctx context.Context, catalog metastore.DataCoordCatalog, chunkManager storage.ChunkManager) (*meta, error) {
 	mt := &meta{
 		ctx:          ctx,
-		catalog:      &datacoord.Catalog{Txn: kv, ChunkManagerRootPath: chunkManagerRootPath},
+		catalog:      catalog,
 		collections:  make(map[UniqueID]*collectionInfo),
 		segments:     NewSegmentsInfo(),
 		channelCPs:   make(map[string]*internalpb.MsgPosition),
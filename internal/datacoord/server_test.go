@@ -58,6 +58,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 )
 
 func TestMain(m *testing.M) {
@@ -3162,6 +3163,214 @@ func TestDataCoord_Import(t *testing.T) {
 	})
 }
 
+func TestDataCoord_Export(t *testing.T) {
+	t.Run("normal case", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		svr.sessionManager.AddSession(&NodeInfo{
+			NodeID:  0,
+			Address: "localhost:8080",
+		})
+		segments := []*datapb.SegmentInfo{
+			{ID: 1, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Flushed},
+			{ID: 2, CollectionID: 100, PartitionID: 20, State: commonpb.SegmentState_Flushed},
+			{ID: 3, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Growing},
+			{ID: 4, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Flushed, IsImporting: true},
+			{ID: 5, CollectionID: 200, PartitionID: 10, State: commonpb.SegmentState_Flushed},
+		}
+		for _, segment := range segments {
+			err := svr.meta.AddSegment(NewSegmentInfo(segment))
+			assert.Nil(t, err)
+		}
+
+		req := &datapb.ExportTaskRequest{
+			ExportTask: &datapb.ExportTask{
+				TaskId:       1,
+				CollectionId: 100,
+				PartitionIds: []int64{10},
+				Timestamp:    tsoutil.ComposeTSByTime(time.Now(), 0),
+			},
+		}
+		resp, err := svr.Export(svr.ctx, req)
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 0, resp.GetDatanodeId())
+		assert.EqualValues(t, 1, resp.GetTotalSegments())
+		assert.Equal(t, 1, len(req.GetSegments()))
+		assert.EqualValues(t, 1, req.GetSegments()[0].GetID())
+
+		// the segment lock is released after the export is finished by the datanode
+		assert.Eventually(t, func() bool {
+			return !svr.segReferManager.HasSegmentLock(1)
+		}, 10*time.Second, 100*time.Millisecond)
+
+		req = &datapb.ExportTaskRequest{
+			ExportTask: &datapb.ExportTask{
+				TaskId:       2,
+				CollectionId: 100,
+				Timestamp:    tsoutil.ComposeTSByTime(time.Now(), 0),
+			},
+		}
+		resp, err = svr.Export(svr.ctx, req)
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 2, resp.GetTotalSegments())
+		closeTestServer(t, svr)
+	})
+
+	t.Run("wait for segments to be flushed", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.sessionManager.AddSession(&NodeInfo{
+			NodeID:  0,
+			Address: "localhost:8080",
+		})
+		ts := tsoutil.ComposeTSByTime(time.Now(), 0)
+		segments := []*datapb.SegmentInfo{
+			{ID: 1, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Flushed},
+			{ID: 2, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Growing,
+				StartPosition: &internalpb.MsgPosition{Timestamp: ts - 1}},
+			// started after the export timestamp
+			{ID: 3, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Growing,
+				StartPosition: &internalpb.MsgPosition{Timestamp: ts + 1}},
+			// allocated after the task
+			{ID: 20, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Growing},
+		}
+		for _, segment := range segments {
+			err := svr.meta.AddSegment(NewSegmentInfo(segment))
+			assert.Nil(t, err)
+		}
+
+		req := &datapb.ExportTaskRequest{
+			ExportTask: &datapb.ExportTask{
+				TaskId:       10,
+				CollectionId: 100,
+				Timestamp:    ts,
+			},
+		}
+		resp, err := svr.Export(svr.ctx, req)
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, commonpb.SegmentState_Sealed, svr.meta.GetSegment(2).GetState())
+		assert.Equal(t, commonpb.SegmentState_Growing, svr.meta.GetSegment(3).GetState())
+		assert.Equal(t, commonpb.SegmentState_Growing, svr.meta.GetSegment(20).GetState())
+
+		err = svr.meta.SetState(2, commonpb.SegmentState_Flushed)
+		assert.Nil(t, err)
+		resp, err = svr.Export(svr.ctx, req)
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 2, resp.GetTotalSegments())
+	})
+
+	t.Run("timestamp before time travel", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.sessionManager.AddSession(&NodeInfo{
+			NodeID:  0,
+			Address: "localhost:8080",
+		})
+
+		ts := tsoutil.ComposeTSByTime(time.Now().Add(-time.Duration(Params.CommonCfg.RetentionDuration+60)*time.Second), 0)
+		resp, err := svr.Export(svr.ctx, &datapb.ExportTaskRequest{
+			ExportTask: &datapb.ExportTask{
+				TaskId:       1,
+				CollectionId: 100,
+				Timestamp:    ts,
+			},
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("no free node", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		svr.sessionManager.AddSession(&NodeInfo{
+			NodeID:  0,
+			Address: "localhost:8080",
+		})
+
+		resp, err := svr.Export(svr.ctx, &datapb.ExportTaskRequest{
+			ExportTask: &datapb.ExportTask{
+				CollectionId: 100,
+				Timestamp:    tsoutil.ComposeTSByTime(time.Now(), 0),
+			},
+			WorkingNodes: []int64{0},
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		closeTestServer(t, svr)
+	})
+
+	t.Run("no datanode available", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		resp, err := svr.Export(svr.ctx, &datapb.ExportTaskRequest{
+			ExportTask: &datapb.ExportTask{
+				CollectionId: 100,
+				Timestamp:    tsoutil.ComposeTSByTime(time.Now(), 0),
+			},
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		closeTestServer(t, svr)
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+
+		resp, err := svr.Export(svr.ctx, &datapb.ExportTaskRequest{
+			ExportTask: &datapb.ExportTask{
+				CollectionId: 100,
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(paramtable.GetNodeID()), resp.GetStatus().GetReason())
+	})
+}
+
+func TestDataCoord_CancelImport(t *testing.T) {
+	t.Run("normal case", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.sessionManager.AddSession(&NodeInfo{
+			NodeID:  0,
+			Address: "localhost:8080",
+		})
+
+		status, err := svr.CancelImport(svr.ctx, &datapb.CancelImportTaskRequest{
+			TaskId:     1,
+			DatanodeId: 0,
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("datanode not found", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		status, err := svr.CancelImport(svr.ctx, &datapb.CancelImportTaskRequest{
+			TaskId:     1,
+			DatanodeId: 1,
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+
+		status, err := svr.CancelImport(svr.ctx, &datapb.CancelImportTaskRequest{
+			TaskId: 1,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(paramtable.GetNodeID()), status.GetReason())
+	})
+}
+
 func TestDataCoord_SaveImportSegment(t *testing.T) {
 	t.Run("test add segment", func(t *testing.T) {
 		svr := newTestServer(t, nil)
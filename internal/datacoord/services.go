@@ -1155,6 +1155,149 @@ func (s *Server) Import(ctx context.Context, itr *datapb.ImportTaskRequest) (*da
 	return resp, nil
 }
 
+// Export distributes the export task to an idle DataNode together with the flushed segments of the collection,
+// the reference lock of the segments is held until the DataNode finishes the export.
+// The task is rejected and stays pending in RootCoord until the segments which may hold rows visible at the export
+// timestamp are flushed, such segments are sealed here. An export timestamp older than the time travel point of
+// compaction is refused with ErrorCode_IllegalArgument, as the rows deleted after it may have been compacted away.
+func (s *Server) Export(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+	log.Info("DataCoord receives export request", zap.Any("export task", req.GetExportTask()),
+		zap.Int64s("working dataNodes", req.GetWorkingNodes()))
+	resp := &datapb.ExportTaskResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Error("failed to export for closed DataCoord service")
+		resp.Status.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
+		return resp, nil
+	}
+
+	task := req.GetExportTask()
+	compactTime, err := GetCompactTime(ctx, s.allocator)
+	if err != nil {
+		log.Warn("failed to get compact time for export", zap.Int64("task ID", task.GetTaskId()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	if task.GetTimestamp() < compactTime.travelTime {
+		resp.Status.ErrorCode = commonpb.ErrorCode_IllegalArgument
+		resp.Status.Reason = fmt.Sprintf("export timestamp %d is earlier than the time travel point %d of compaction",
+			task.GetTimestamp(), compactTime.travelTime)
+		log.Warn("export task is refused", zap.Int64("task ID", task.GetTaskId()), zap.String("reason", resp.Status.Reason))
+		return resp, nil
+	}
+
+	partitionIDs := typeutil.NewUniqueSet(task.GetPartitionIds()...)
+	inTask := func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == task.GetCollectionId() &&
+			(partitionIDs.Len() == 0 || partitionIDs.Contain(segment.GetPartitionID())) &&
+			!segment.GetIsImporting()
+	}
+
+	// Segments allocated before the task may hold rows inserted before the export timestamp, IDs come from the same
+	// allocator as task IDs. Seal and wait for them to be flushed, the flushed segments are exported.
+	unflushed := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return inTask(segment) && segment.GetID() < task.GetTaskId() &&
+			(segment.GetStartPosition() == nil || segment.GetStartPosition().GetTimestamp() <= task.GetTimestamp()) &&
+			(segment.GetState() == commonpb.SegmentState_Growing ||
+				segment.GetState() == commonpb.SegmentState_Sealed ||
+				segment.GetState() == commonpb.SegmentState_Flushing)
+	})
+	if len(unflushed) > 0 {
+		toSeal := make([]UniqueID, 0, len(unflushed))
+		for _, segment := range unflushed {
+			if segment.GetState() == commonpb.SegmentState_Growing {
+				toSeal = append(toSeal, segment.GetID())
+			}
+		}
+		if len(toSeal) > 0 {
+			if _, err := s.segmentManager.SealAllSegments(ctx, task.GetCollectionId(), toSeal); err != nil {
+				log.Warn("failed to seal segments for export", zap.Int64s("segIDs", toSeal), zap.Error(err))
+			}
+		}
+		resp.Status.Reason = fmt.Sprintf("waiting for %d segments to be flushed", len(unflushed))
+		log.Info("export task waits for segments to be flushed", zap.Int64("task ID", task.GetTaskId()),
+			zap.Int64s("sealed segments", toSeal), zap.Int("unflushed segments", len(unflushed)))
+		return resp, nil
+	}
+
+	nodes := s.sessionManager.getLiveNodeIDs()
+	if len(nodes) == 0 {
+		log.Error("export failed as all DataNodes are offline")
+		resp.Status.Reason = "all DataNodes are offline"
+		return resp, nil
+	}
+
+	avaNodes := getDiff(nodes, req.GetWorkingNodes())
+	if len(avaNodes) == 0 {
+		// No dataNode is available, reject the export request.
+		msg := "all DataNodes are busy working, the task has been rejected and wait for idle datanode"
+		log.Info(msg, zap.Int64("task ID", req.GetExportTask().GetTaskId()))
+		resp.Status.Reason = msg
+		return resp, nil
+	}
+	nodeID := avaNodes[rand.Intn(len(avaNodes))]
+
+	segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return inTask(segment) && segment.GetState() == commonpb.SegmentState_Flushed
+	})
+	segmentIDs := make([]UniqueID, 0, len(segments))
+	req.Segments = make([]*datapb.SegmentInfo, 0, len(segments))
+	for _, segment := range segments {
+		segmentIDs = append(segmentIDs, segment.GetID())
+		req.Segments = append(req.Segments, segment.SegmentInfo)
+	}
+
+	// lock the segments to keep their binlogs from being garbage collected during the export, even if they are compacted
+	if err := s.segReferManager.AddSegmentsLock(task.GetTaskId(), segmentIDs, nodeID); err != nil {
+		log.Warn("Add reference lock on segments failed", zap.Int64s("segIDs", segmentIDs), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	log.Info("picking a free dataNode to export",
+		zap.Int64("task ID", task.GetTaskId()),
+		zap.Int64("dataNode ID", nodeID),
+		zap.Int64s("segment IDs", segmentIDs))
+	s.cluster.Export(s.ctx, nodeID, req, func() {
+		if err := retry.Do(s.ctx, func() error {
+			return s.segReferManager.ReleaseSegmentsLock(task.GetTaskId(), nodeID)
+		}, retry.Attempts(100)); err != nil {
+			log.Error("failed to release reference lock of exported segments", zap.Int64("task ID", task.GetTaskId()),
+				zap.Int64("dataNode ID", nodeID), zap.Error(err))
+		}
+	})
+
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.DatanodeId = nodeID
+	resp.TotalSegments = int64(len(segmentIDs))
+	return resp, nil
+}
+
+// CancelImport forwards the cancel request of an import task to the DataNode which processes the task.
+func (s *Server) CancelImport(ctx context.Context, req *datapb.CancelImportTaskRequest) (*commonpb.Status, error) {
+	log.Info("DataCoord receives cancel import request", zap.Int64("task ID", req.GetTaskId()),
+		zap.Int64("dataNode ID", req.GetDatanodeId()))
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+	if s.isClosed() {
+		log.Error("failed to cancel import for closed DataCoord service")
+		resp.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
+		return resp, nil
+	}
+
+	if err := s.cluster.CancelImport(ctx, req.GetDatanodeId(), req); err != nil {
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
 // UpdateSegmentStatistics updates a segment's stats.
 func (s *Server) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	resp := &commonpb.Status{
@@ -1426,6 +1569,10 @@ func (s *Server) BroadcastAlteredCollection(ctx context.Context, req *datapb.Alt
 	}
 
 	clonedColl.Properties = properties
+	// fields may be appended to the collection
+	if req.GetSchema() != nil {
+		clonedColl.Schema = req.GetSchema()
+	}
 	s.meta.AddCollection(clonedColl)
 	return &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
@@ -7,6 +7,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -53,4 +54,21 @@ func TestBroadcastAlteredCollection(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, s.meta.collections[1].Properties)
 	})
+
+	t.Run("test update schema", func(t *testing.T) {
+		s := &Server{meta: &meta{collections: map[UniqueID]*collectionInfo{
+			1: {ID: 1, Schema: &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{{FieldID: 100}}}},
+		}}}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+		ctx := context.Background()
+		req := &datapb.AlterCollectionRequest{
+			CollectionID: 1,
+			Schema:       &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{{FieldID: 100}, {FieldID: 101}}},
+		}
+
+		resp, err := s.BroadcastAlteredCollection(ctx, req)
+		assert.NotNil(t, resp)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(s.meta.collections[1].Schema.GetFields()))
+	})
 }
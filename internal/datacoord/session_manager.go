@@ -36,6 +36,8 @@ const (
 	flushTimeout = 15 * time.Second
 	// TODO: evaluate and update import timeout.
 	importTimeout     = 3 * time.Hour
+	exportTimeout     = 3 * time.Hour
+	cancelTimeout     = 30 * time.Second
 	reCollectTimeout  = 5 * time.Second
 	addSegmentTimeout = 30 * time.Second
 )
@@ -206,6 +208,53 @@ func (c *SessionManager) execImport(ctx context.Context, nodeID int64, itr *data
 	log.Info("success to import", zap.Int64("node", nodeID), zap.Any("import task", itr))
 }
 
+// Export is a grpc interface. It will send request to DataNode with provided `nodeID` asynchronously,
+// the callback is called after the DataNode finishes the export, no matter it succeeds or not.
+func (c *SessionManager) Export(ctx context.Context, nodeID int64, req *datapb.ExportTaskRequest, callback func()) {
+	go func() {
+		defer callback()
+		c.execExport(ctx, nodeID, req)
+	}()
+}
+
+// execExport gets the corresponding DataNode with its ID and calls its Export method.
+func (c *SessionManager) execExport(ctx context.Context, nodeID int64, req *datapb.ExportTaskRequest) {
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		log.Warn("failed to get client for export", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, exportTimeout)
+	defer cancel()
+	resp, err := cli.Export(ctx, req)
+	if err := VerifyResponse(resp, err); err != nil {
+		log.Warn("failed to export", zap.Int64("node", nodeID), zap.Error(err))
+		return
+	}
+
+	log.Info("success to export", zap.Int64("node", nodeID), zap.Int64("task ID", req.GetExportTask().GetTaskId()))
+}
+
+// CancelImport is a grpc interface. It will send request to DataNode with provided `nodeID` synchronously.
+func (c *SessionManager) CancelImport(ctx context.Context, nodeID int64, req *datapb.CancelImportTaskRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, cancelTimeout)
+	defer cancel()
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		log.Warn("failed to get client for cancel import", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return err
+	}
+
+	resp, err := cli.CancelImport(ctx, req)
+	if err := VerifyResponse(resp, err); err != nil {
+		log.Warn("failed to cancel import", zap.Int64("node", nodeID), zap.Int64("task ID", req.GetTaskId()), zap.Error(err))
+		return err
+	}
+
+	log.Info("success to cancel import", zap.Int64("node", nodeID), zap.Int64("task ID", req.GetTaskId()))
+	return nil
+}
+
 // ReCollectSegmentStats collects segment stats info from DataNodes, after DataCoord reboots.
 func (c *SessionManager) ReCollectSegmentStats(ctx context.Context, nodeID int64) {
 	go c.execReCollectSegmentStats(ctx, nodeID)
@@ -52,6 +52,7 @@ var (
 type Channel interface {
 	getCollectionID() UniqueID
 	getCollectionSchema(collectionID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error)
+	refreshCollectionSchema(collectionID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error)
 	getCollectionAndPartitionID(segID UniqueID) (collID, partitionID UniqueID, err error)
 	getChannelName(segID UniqueID) string
 
@@ -519,6 +520,27 @@ func (c *ChannelMeta) getCollectionSchema(collID UniqueID, ts Timestamp) (*schem
 	return c.collSchema, nil
 }
 
+// refreshCollectionSchema fetches the collection schema at specified timestamp from rootcoord,
+// and replaces the cached one, which is required when fields are added to the collection.
+func (c *ChannelMeta) refreshCollectionSchema(collID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error) {
+	if !c.validCollection(collID) {
+		return nil, fmt.Errorf("mismatch collection, want %d, actual %d", c.collectionID, collID)
+	}
+
+	sch, err := c.metaService.getCollectionSchema(context.Background(), collID, ts)
+	if err != nil {
+		return nil, err
+	}
+
+	c.schemaMut.Lock()
+	defer c.schemaMut.Unlock()
+	// never go back to an older schema
+	if len(sch.GetFields()) >= len(c.collSchema.GetFields()) {
+		c.collSchema = sch
+	}
+	return c.collSchema, nil
+}
+
 func (c *ChannelMeta) validCollection(collID UniqueID) bool {
 	return collID == c.collectionID
 }
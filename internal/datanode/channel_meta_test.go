@@ -462,6 +462,26 @@ func TestChannelMeta_InterfaceMethod(t *testing.T) {
 		rc.setCollectionID(1)
 	})
 
+	t.Run("Test_refreshCollectionSchema", func(t *testing.T) {
+		channel := newChannel("a", 1, &schemapb.CollectionSchema{Name: "old"}, rc, cm)
+
+		_, err := channel.refreshCollectionSchema(2, Timestamp(0))
+		assert.Error(t, err)
+
+		rc.setCollectionID(-1)
+		_, err = channel.refreshCollectionSchema(1, Timestamp(0))
+		assert.Error(t, err)
+
+		rc.setCollectionID(1)
+		s, err := channel.refreshCollectionSchema(1, Timestamp(0))
+		assert.NoError(t, err)
+		assert.NotEqual(t, "old", s.GetName())
+
+		got, err := channel.getCollectionSchema(1, Timestamp(0))
+		assert.NoError(t, err)
+		assert.Equal(t, s, got)
+	})
+
 	t.Run("Test listAllSegmentIDs", func(t *testing.T) {
 		s1 := Segment{segmentID: 1}
 		s2 := Segment{segmentID: 2}
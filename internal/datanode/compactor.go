@@ -202,6 +202,7 @@ func (t *compactionTask) uploadSingleInsertLog(
 	partID UniqueID,
 	meta *etcdpb.CollectionMeta,
 	fID2Content map[UniqueID][]interface{},
+	fID2Valid map[UniqueID][]bool,
 	fID2Type map[UniqueID]schemapb.DataType) (map[UniqueID]*datapb.FieldBinlog, map[UniqueID]*datapb.FieldBinlog, error) {
 	iData := &InsertData{
 		Data: make(map[storage.FieldID]storage.FieldData)}
@@ -218,6 +219,9 @@ func (t *compactionTask) uploadSingleInsertLog(
 			log.Warn("transfer interface to FieldData wrong", zap.Error(err))
 			return nil, nil, err
 		}
+		if validData, ok := fID2Valid[fID]; ok {
+			storage.SetValidData(fData, validData)
+		}
 		iData.Data[fID] = fData
 	}
 
@@ -253,6 +257,11 @@ func (t *compactionTask) merge(
 
 		fID2Type    = make(map[UniqueID]schemapb.DataType)
 		fID2Content = make(map[UniqueID][]interface{})
+		fID2Valid   = make(map[UniqueID][]bool)
+
+		// single row default data of nullable or default-valued fields,
+		// which are absent from segments flushed before the fields are added
+		fID2Default = make(map[UniqueID]storage.FieldData)
 
 		insertField2Path = make(map[UniqueID]*datapb.FieldBinlog)
 		insertPaths      = make([]*datapb.FieldBinlog, 0)
@@ -296,6 +305,14 @@ func (t *compactionTask) merge(
 	// get pkID, pkType, dim
 	for _, fs := range meta.GetSchema().GetFields() {
 		fID2Type[fs.GetFieldID()] = fs.GetDataType()
+		if fs.GetFieldID() >= common.StartOfUserFieldID && typeutil.IsFieldOptional(fs) {
+			defaultData, err := storage.GenDefaultFieldData(fs, 1)
+			if err != nil {
+				log.Warn("failed to generate default value", zap.Int64("fieldID", fs.GetFieldID()), zap.Error(err))
+				return nil, nil, 0, err
+			}
+			fID2Default[fs.GetFieldID()] = defaultData
+		}
 		if fs.GetIsPrimaryKey() && fs.GetFieldID() >= 100 && typeutil.IsPrimaryFieldType(fs.GetDataType()) {
 			pkID = fs.GetFieldID()
 			pkType = fs.GetDataType()
@@ -362,6 +379,21 @@ func (t *compactionTask) merge(
 				return nil, nil, 0, errors.New("unexpected error")
 			}
 
+			for fID, defaultData := range fID2Default {
+				vInter, ok := row[fID]
+				valid := vInter != nil
+				if !ok {
+					vInter = defaultData.GetRow(0)
+					valid = defaultData.GetValidData() == nil || defaultData.GetValidData()[0]
+				} else if !valid {
+					vInter = defaultData.GetRow(0)
+				}
+				row[fID] = vInter
+				if defaultData.GetValidData() != nil {
+					fID2Valid[fID] = append(fID2Valid[fID], valid)
+				}
+			}
+
 			for fID, vInter := range row {
 				if _, ok := fID2Content[fID]; !ok {
 					fID2Content[fID] = make([]interface{}, 0)
@@ -373,7 +405,7 @@ func (t *compactionTask) merge(
 
 			if currentRows == maxRowsPerBinlog {
 				uploadInsertStart := time.Now()
-				inPaths, statsPaths, err := t.uploadSingleInsertLog(ctxTimeout, targetSegID, partID, meta, fID2Content, fID2Type)
+				inPaths, statsPaths, err := t.uploadSingleInsertLog(ctxTimeout, targetSegID, partID, meta, fID2Content, fID2Valid, fID2Type)
 				if err != nil {
 					return nil, nil, 0, err
 				}
@@ -382,6 +414,7 @@ func (t *compactionTask) merge(
 				addStatFieldPath(statsPaths)
 
 				fID2Content = make(map[int64][]interface{})
+				fID2Valid = make(map[int64][]bool)
 				currentRows = 0
 				numRows += int64(maxRowsPerBinlog)
 				numBinlogs++
@@ -390,7 +423,7 @@ func (t *compactionTask) merge(
 	}
 	if currentRows != 0 {
 		uploadInsertStart := time.Now()
-		inPaths, statsPaths, err := t.uploadSingleInsertLog(ctxTimeout, targetSegID, partID, meta, fID2Content, fID2Type)
+		inPaths, statsPaths, err := t.uploadSingleInsertLog(ctxTimeout, targetSegID, partID, meta, fID2Content, fID2Valid, fID2Type)
 		if err != nil {
 			return nil, nil, 0, err
 		}
@@ -850,6 +883,40 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 		data.Dim = len(data.Data) * 8 / int(numRows)
 		rst = data
 
+	case typeutil.Float16VectorType, typeutil.BFloat16VectorType:
+		var buf []byte
+		for _, c := range content {
+			r, ok := c.([]byte)
+			if !ok {
+				return nil, errTransferType
+			}
+			buf = append(buf, r...)
+		}
+
+		dim := len(buf) / 2 / int(numRows)
+		if schemaDataType == typeutil.Float16VectorType {
+			rst = &storage.Float16VectorFieldData{NumRows: numOfRows, Data: buf, Dim: dim}
+		} else {
+			rst = &storage.BFloat16VectorFieldData{NumRows: numOfRows, Data: buf, Dim: dim}
+		}
+
+	case typeutil.SparseFloatVectorType:
+		var data = &storage.SparseFloatVectorFieldData{
+			NumRows: numOfRows,
+			Data:    make([][]byte, 0, len(content)),
+		}
+
+		for _, c := range content {
+			r, ok := c.([]byte)
+			if !ok {
+				return nil, errTransferType
+			}
+			data.Data = append(data.Data, r)
+		}
+
+		data.Dim = typeutil.SparseFloatRowsDim(data.Data)
+		rst = data
+
 	default:
 		return nil, errUnknownDataType
 	}
@@ -25,9 +25,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -302,6 +305,52 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 			assert.Equal(t, 1, len(inPaths[0].GetBinlogs()))
 			assert.Equal(t, 1, len(statsPaths))
 		})
+		t.Run("Merge with added field", func(t *testing.T) {
+			alloc := NewAllocatorFactory(1)
+			mockbIO := &binlogIO{cm, alloc}
+			Params.CommonCfg.EntityExpirationTTL = 0
+			iData := genInsertDataWithExpiredTS()
+
+			var allPaths [][]string
+			inpath, _, err := mockbIO.uploadInsertLog(context.Background(), 1, 0, iData, meta)
+			assert.NoError(t, err)
+			for idx := 0; idx < len(inpath[0].GetBinlogs()); idx++ {
+				var ps []string
+				for _, path := range inpath {
+					ps = append(ps, path.GetBinlogs()[idx].GetLogPath())
+				}
+				allPaths = append(allPaths, ps)
+			}
+
+			// the segment is flushed before the nullable field is added
+			newMeta := proto.Clone(meta).(*etcdpb.CollectionMeta)
+			newMeta.Schema.Fields = append(newMeta.Schema.Fields, &schemapb.FieldSchema{
+				FieldID:    200,
+				Name:       "added",
+				DataType:   schemapb.DataType_Int64,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+			})
+
+			ct := &compactionTask{Channel: channel, downloader: mockbIO, uploader: mockbIO}
+			inPaths, _, numOfRow, err := ct.merge(context.Background(), allPaths, 2, 0, newMeta, map[interface{}]Timestamp{})
+			assert.NoError(t, err)
+			assert.Equal(t, int64(2), numOfRow)
+			assert.Equal(t, 13, len(inPaths))
+
+			var addedPaths []string
+			for _, path := range inPaths {
+				if path.GetFieldID() == 200 {
+					for _, binlog := range path.GetBinlogs() {
+						addedPaths = append(addedPaths, binlog.GetLogPath())
+					}
+				}
+			}
+			blobs, err := mockbIO.download(context.Background(), addedPaths)
+			assert.NoError(t, err)
+			_, _, addedData, err := storage.NewInsertCodec(newMeta).Deserialize(blobs)
+			assert.NoError(t, err)
+			assert.Equal(t, []bool{false, false}, addedData.Data[200].GetValidData())
+		})
 		t.Run("Merge without expiration2", func(t *testing.T) {
 			alloc := NewAllocatorFactory(1)
 			mockbIO := &binlogIO{cm, alloc}
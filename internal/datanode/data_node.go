@@ -117,6 +117,7 @@ type DataNode struct {
 	stateCode        atomic.Value // commonpb.StateCode_Initializing
 	flowgraphManager *flowgraphManager
 	eventManagerMap  sync.Map // vchannel name -> channelEventManager
+	importWrappers   sync.Map // import task id -> *importutil.ImportWrapper
 
 	clearSignal        chan string // vchannel name
 	segmentCache       *Cache
@@ -972,6 +973,7 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 		zap.Int64("collection ID", req.GetImportTask().GetCollectionId()),
 		zap.Int64("partition ID", req.GetImportTask().GetPartitionId()),
 		zap.Strings("channel names", req.GetImportTask().GetChannelNames()),
+		zap.Int("checkpoint count", len(req.GetImportTask().GetCheckpoints())),
 		zap.Int64s("working dataNodes", req.WorkingNodes))
 	defer func() {
 		log.Info("DataNode finish import request", zap.Int64("task ID", req.GetImportTask().GetTaskId()))
@@ -1090,15 +1092,32 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 	importWrapper.SetCallbackFunctions(assignSegmentFunc(node, req),
 		createBinLogsFunc(node, req, colInfo.GetSchema(), ts),
 		saveSegmentFunc(node, req, importResult, ts))
+	// register the wrapper so that the task can be canceled by CancelImport
+	node.importWrappers.Store(req.GetImportTask().GetTaskId(), importWrapper)
+	defer node.importWrappers.Delete(req.GetImportTask().GetTaskId())
 	// todo: pass tsStart and tsStart after import_wrapper support
 	tsStart, tsEnd, err := importutil.ParseTSFromOptions(req.GetImportTask().GetInfos())
 	isBackup := importutil.IsBackup(req.GetImportTask().GetInfos())
 	if err != nil {
 		return returnFailFunc(err)
 	}
-	log.Info("import time range", zap.Uint64("start_ts", tsStart), zap.Uint64("end_ts", tsEnd))
+	csvOptions, err := importutil.ParseCSVOptions(req.GetImportTask().GetInfos())
+	if err != nil {
+		return returnFailFunc(err)
+	}
+	dryRun := importutil.IsDryRun(req.GetImportTask().GetInfos())
+	maxBadRows, err := importutil.ParseDryRunMaxBadRows(req.GetImportTask().GetInfos())
+	if err != nil {
+		return returnFailFunc(err)
+	}
+	numpyFields, err := importutil.ParseNumpyFieldMapping(req.GetImportTask().GetInfos())
+	if err != nil {
+		return returnFailFunc(err)
+	}
+	log.Info("import time range", zap.Uint64("start_ts", tsStart), zap.Uint64("end_ts", tsEnd), zap.Bool("dry_run", dryRun))
 	err = importWrapper.Import(req.GetImportTask().GetFiles(),
-		importutil.ImportOptions{OnlyValidate: false, TsStartPoint: tsStart, TsEndPoint: tsEnd, IsBackup: isBackup})
+		importutil.ImportOptions{OnlyValidate: false, TsStartPoint: tsStart, TsEndPoint: tsEnd, IsBackup: isBackup, CSV: csvOptions,
+			DryRun: dryRun, MaxBadRows: maxBadRows, Checkpoints: req.GetImportTask().GetCheckpoints(), NumpyFields: numpyFields})
 	if err != nil {
 		return returnFailFunc(err)
 	}
@@ -1109,6 +1128,136 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 	return resp, nil
 }
 
+// CancelImport stops the import worker of a task, the worker reports a failed state to RootCoord
+// and the segments generated by the task are cleaned up by RootCoord.
+func (node *DataNode) CancelImport(ctx context.Context, req *datapb.CancelImportTaskRequest) (*commonpb.Status, error) {
+	log.Info("DataNode receive cancel import request", zap.Int64("task ID", req.GetTaskId()))
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+	if !node.isHealthy() {
+		status.Reason = msgDataNodeIsUnhealthy(paramtable.GetNodeID())
+		return status, nil
+	}
+
+	v, ok := node.importWrappers.Load(req.GetTaskId())
+	if !ok {
+		log.Warn("import task not found in DataNode", zap.Int64("task ID", req.GetTaskId()))
+		status.Reason = fmt.Sprintf("import task %d is not running on DataNode %d", req.GetTaskId(), paramtable.GetNodeID())
+		return status, nil
+	}
+	if err := v.(*importutil.ImportWrapper).Cancel(); err != nil {
+		status.Reason = err.Error()
+		return status, nil
+	}
+
+	status.ErrorCode = commonpb.ErrorCode_Success
+	return status, nil
+}
+
+// Export writes the rows of flushed segments visible at the export timestamp into files(json, numpy or parquet)
+// on MinIO/S3 storage, the delta logs of segments are applied to skip the deleted rows.
+func (node *DataNode) Export(ctx context.Context, req *datapb.ExportTaskRequest) (*commonpb.Status, error) {
+	log.Info("DataNode receive export request",
+		zap.Int64("task ID", req.GetExportTask().GetTaskId()),
+		zap.Int64("collection ID", req.GetExportTask().GetCollectionId()),
+		zap.Int64s("partition IDs", req.GetExportTask().GetPartitionIds()),
+		zap.Uint64("timestamp", req.GetExportTask().GetTimestamp()),
+		zap.String("file type", req.GetExportTask().GetFileType()),
+		zap.Int("segment count", len(req.GetSegments())),
+		zap.Int64s("working dataNodes", req.WorkingNodes))
+	defer func() {
+		log.Info("DataNode finish export request", zap.Int64("task ID", req.GetExportTask().GetTaskId()))
+	}()
+
+	exportResult := &rootcoordpb.ExportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:        req.GetExportTask().GetTaskId(),
+		DatanodeId:    paramtable.GetNodeID(),
+		State:         rootcoordpb.ExportState_ExportStarted,
+		Segments:      make([]int64, 0),
+		TotalSegments: int64(len(req.GetSegments())),
+		Files:         make([]string, 0),
+		RowCount:      0,
+	}
+
+	// The export may outlast the request, the task context ignores cancellation from parental context and is
+	// canceled once the task finishes or the DataNode stops, there is no fixed deadline for a large collection.
+	newCtx, cancel := context.WithCancel(node.ctx)
+	defer cancel()
+	// func to report export state to RootCoord.
+	reportFunc := func(res *rootcoordpb.ExportResult) error {
+		status, err := node.rootCoord.ReportExport(newCtx, res)
+		if err != nil {
+			log.Error("fail to report export state to RootCoord", zap.Error(err))
+			return err
+		}
+		if status != nil && status.ErrorCode != commonpb.ErrorCode_Success {
+			return errors.New(status.GetReason())
+		}
+		return nil
+	}
+
+	if !node.isHealthy() {
+		log.Warn("DataNode export failed",
+			zap.Int64("collection ID", req.GetExportTask().GetCollectionId()),
+			zap.Int64("task ID", req.GetExportTask().GetTaskId()),
+			zap.Error(errDataNodeIsUnhealthy(paramtable.GetNodeID())))
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    msgDataNodeIsUnhealthy(paramtable.GetNodeID()),
+		}, nil
+	}
+
+	returnFailFunc := func(inputErr error) (*commonpb.Status, error) {
+		log.Warn("DataNode failed to export segments",
+			zap.Int64("task ID", req.GetExportTask().GetTaskId()),
+			zap.Error(inputErr))
+		exportResult.State = rootcoordpb.ExportState_ExportFailed
+		exportResult.Infos = append(exportResult.Infos, &commonpb.KeyValuePair{Key: "failed_reason", Value: inputErr.Error()})
+		if reportErr := reportFunc(exportResult); reportErr != nil {
+			log.Warn("fail to report export state to RootCoord", zap.Error(reportErr))
+		}
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    inputErr.Error(),
+		}, nil
+	}
+
+	// get collection schema at the export timestamp
+	metaService := newMetaService(node.rootCoord, req.GetExportTask().GetCollectionId())
+	schema, err := metaService.getCollectionSchema(newCtx, req.GetExportTask().GetCollectionId(), req.GetExportTask().GetTimestamp())
+	if err != nil {
+		return returnFailFunc(err)
+	}
+
+	chunkManager, err := node.getExportChunkManager(newCtx, req.GetExportTask().GetBucket())
+	if err != nil {
+		return returnFailFunc(err)
+	}
+	writer, err := importutil.NewExportWriter(newCtx, schema, chunkManager, req.GetExportTask().GetFileType(),
+		req.GetExportTask().GetInfos())
+	if err != nil {
+		return returnFailFunc(err)
+	}
+
+	task := newExportTask(newCtx, &binlogIO{node.chunkManager, nil}, req.GetExportTask(), schema, writer, exportResult, reportFunc)
+	if err = task.export(req.GetSegments()); err != nil {
+		return returnFailFunc(err)
+	}
+
+	exportResult.State = rootcoordpb.ExportState_ExportCompleted
+	if err = reportFunc(exportResult); err != nil {
+		log.Warn("fail to report export state to RootCoord", zap.Error(err))
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 // AddImportSegment adds the import segment to the current DataNode.
 func (node *DataNode) AddImportSegment(ctx context.Context, req *datapb.AddImportSegmentRequest) (*datapb.AddImportSegmentResponse, error) {
 	log.Info("adding segment to DataNode flow graph",
@@ -599,6 +599,28 @@ func TestDataNode(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, stat.GetErrorCode())
 	})
 
+	t.Run("Test CancelImport", func(t *testing.T) {
+		req := &datapb.CancelImportTaskRequest{
+			TaskId: 1,
+		}
+		stat, err := node.CancelImport(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, stat.GetErrorCode())
+
+		importWrapper := importutil.NewImportWrapper(ctx, &schemapb.CollectionSchema{}, 2, 1, nil, nil, nil, nil)
+		node.importWrappers.Store(req.GetTaskId(), importWrapper)
+		defer node.importWrappers.Delete(req.GetTaskId())
+		stat, err = node.CancelImport(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, stat.GetErrorCode())
+
+		node.stateCode.Store(commonpb.StateCode_Abnormal)
+		defer node.stateCode.Store(commonpb.StateCode_Healthy)
+		stat, err = node.CancelImport(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, stat.GetErrorCode())
+	})
+
 	t.Run("Test Import error", func(t *testing.T) {
 		node.rootCoord = &RootCoordFactory{collectionID: -1}
 		req := &datapb.ImportTaskRequest{
@@ -0,0 +1,263 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// exportTask reads the rows of flushed segments which are visible at the export timestamp,
+// that is the rows inserted before the timestamp and not deleted before the timestamp,
+// and writes them into the target files by an export writer.
+type exportTask struct {
+	downloader
+
+	ctx        context.Context
+	task       *datapb.ExportTask
+	schema     *schemapb.CollectionSchema
+	writer     *importutil.ExportWriter
+	result     *rootcoordpb.ExportResult
+	reportFunc func(res *rootcoordpb.ExportResult) error
+}
+
+func newExportTask(ctx context.Context, dl downloader, task *datapb.ExportTask, schema *schemapb.CollectionSchema,
+	writer *importutil.ExportWriter, result *rootcoordpb.ExportResult,
+	reportFunc func(res *rootcoordpb.ExportResult) error) *exportTask {
+	return &exportTask{
+		downloader: dl,
+		ctx:        ctx,
+		task:       task,
+		schema:     schema,
+		writer:     writer,
+		result:     result,
+		reportFunc: reportFunc,
+	}
+}
+
+// export exports the segments one by one, the progress is reported after each segment is exported
+func (t *exportTask) export(segments []*datapb.SegmentInfo) error {
+	for _, segment := range segments {
+		files, rowCount, err := t.exportSegment(segment)
+		if err != nil {
+			return err
+		}
+
+		t.result.Segments = append(t.result.Segments, segment.GetID())
+		t.result.Files = append(t.result.Files, files...)
+		t.result.RowCount += rowCount
+		if err := t.reportFunc(t.result); err != nil {
+			log.Warn("fail to report export state to RootCoord", zap.Int64("task ID", t.task.GetTaskId()), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// mergeDeltalogs returns the latest delete timestamp of each primary key, deletions after the export
+// timestamp are ignored
+func (t *exportTask) mergeDeltalogs(segment *datapb.SegmentInfo) (map[interface{}]Timestamp, error) {
+	pk2ts := make(map[interface{}]Timestamp)
+	paths := make([]string, 0)
+	for _, d := range segment.GetDeltalogs() {
+		for _, l := range d.GetBinlogs() {
+			paths = append(paths, l.GetLogPath())
+		}
+	}
+	if len(paths) == 0 {
+		return pk2ts, nil
+	}
+
+	blobs, err := t.download(t.ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+	_, _, dData, err := storage.NewDeleteCodec().Deserialize(blobs)
+	if err != nil {
+		return nil, err
+	}
+	for i := int64(0); i < dData.RowCount; i++ {
+		pk, ts := dData.Pks[i].GetValue(), dData.Tss[i]
+		if ts > t.task.GetTimestamp() {
+			continue
+		}
+		if old, ok := pk2ts[pk]; !ok || ts > old {
+			pk2ts[pk] = ts
+		}
+	}
+	return pk2ts, nil
+}
+
+// exportSegment writes a file(or a directory for numpy files) for each group of insert logs of the segment,
+// the files are put under <path>/<segment ID>/, returns the written files and the exported row count.
+func (t *exportTask) exportSegment(segment *datapb.SegmentInfo) ([]string, int64, error) {
+	log := log.With(zap.Int64("task ID", t.task.GetTaskId()), zap.Int64("segment ID", segment.GetID()))
+	delta, err := t.mergeDeltalogs(segment)
+	if err != nil {
+		log.Warn("failed to merge deltalogs of segment", zap.Error(err))
+		return nil, 0, err
+	}
+
+	var (
+		pkID   UniqueID
+		pkType schemapb.DataType
+
+		fID2Type = make(map[UniqueID]schemapb.DataType)
+
+		// single row default data of nullable or default-valued fields,
+		// which are absent from segments flushed before the fields are added
+		fID2Default = make(map[UniqueID]storage.FieldData)
+	)
+	for _, fs := range t.schema.GetFields() {
+		if fs.GetFieldID() < common.StartOfUserFieldID {
+			continue
+		}
+		fID2Type[fs.GetFieldID()] = fs.GetDataType()
+		if typeutil.IsFieldOptional(fs) {
+			defaultData, err := storage.GenDefaultFieldData(fs, 1)
+			if err != nil {
+				return nil, 0, err
+			}
+			fID2Default[fs.GetFieldID()] = defaultData
+		}
+		if fs.GetIsPrimaryKey() {
+			pkID = fs.GetFieldID()
+			pkType = fs.GetDataType()
+		}
+	}
+
+	// Get the number of field binlog files from non-empty segment
+	var binlogNum int
+	for _, b := range segment.GetBinlogs() {
+		if b != nil {
+			binlogNum = len(b.GetBinlogs())
+			break
+		}
+	}
+
+	files := make([]string, 0)
+	rowCount := int64(0)
+	for idx := 0; idx < binlogNum; idx++ {
+		var ps []string
+		for _, f := range segment.GetBinlogs() {
+			ps = append(ps, f.GetBinlogs()[idx].GetLogPath())
+		}
+		data, err := t.download(t.ctx, ps)
+		if err != nil {
+			log.Warn("download insertlogs wrong", zap.Error(err))
+			return nil, 0, err
+		}
+		iter, err := storage.NewInsertBinlogIterator(data, pkID, pkType)
+		if err != nil {
+			log.Warn("new insert binlogs Itr wrong", zap.Error(err))
+			return nil, 0, err
+		}
+
+		fID2Content := make(map[UniqueID][]interface{})
+		fID2Valid := make(map[UniqueID][]bool)
+		numRows := 0
+		for iter.HasNext() {
+			vInter, _ := iter.Next()
+			v, ok := vInter.(*storage.Value)
+			if !ok {
+				return nil, 0, errTransferType
+			}
+
+			// rows inserted after the export timestamp or deleted before the timestamp are invisible
+			ts := Timestamp(v.Timestamp)
+			if ts > t.task.GetTimestamp() {
+				continue
+			}
+			if deleteTs, ok := delta[v.PK.GetValue()]; ok && ts <= deleteTs {
+				continue
+			}
+
+			row, ok := v.Value.(map[UniqueID]interface{})
+			if !ok {
+				return nil, 0, errTransferType
+			}
+			for fID := range fID2Type {
+				vInter, ok := row[fID]
+				valid := vInter != nil
+				if defaultData, optional := fID2Default[fID]; optional {
+					if !ok {
+						vInter = defaultData.GetRow(0)
+						valid = defaultData.GetValidData() == nil || defaultData.GetValidData()[0]
+					} else if !valid {
+						vInter = defaultData.GetRow(0)
+					}
+					if defaultData.GetValidData() != nil {
+						fID2Valid[fID] = append(fID2Valid[fID], valid)
+					}
+				} else if !valid {
+					return nil, 0, fmt.Errorf("the field %d is missed in insert logs of segment %d", fID, segment.GetID())
+				}
+				fID2Content[fID] = append(fID2Content[fID], vInter)
+			}
+			numRows++
+		}
+		if numRows == 0 {
+			continue
+		}
+
+		fieldsData := make(map[storage.FieldID]storage.FieldData)
+		for fID, content := range fID2Content {
+			fData, err := interface2FieldData(fID2Type[fID], content, int64(numRows))
+			if err != nil {
+				log.Warn("transfer interface to FieldData wrong", zap.Error(err))
+				return nil, 0, err
+			}
+			if validData, ok := fID2Valid[fID]; ok {
+				storage.SetValidData(fData, validData)
+			}
+			fieldsData[fID] = fData
+		}
+
+		filePath := path.Join(t.task.GetPath(), strconv.FormatInt(segment.GetID(), 10), strconv.Itoa(idx))
+		written, err := t.writer.Write(filePath, fieldsData)
+		if err != nil {
+			return nil, 0, err
+		}
+		files = append(files, written...)
+		rowCount += int64(numRows)
+	}
+
+	log.Info("segment exported", zap.Int64("row count", rowCount), zap.Int("file count", len(files)))
+	return files, rowCount, nil
+}
+
+// getExportChunkManager returns the chunk manager of the target bucket, the chunk manager of
+// DataNode is returned if the target bucket is empty or the bucket of milvus storage.
+func (node *DataNode) getExportChunkManager(ctx context.Context, bucket string) (storage.ChunkManager, error) {
+	if bucket == "" || bucket == Params.MinioCfg.BucketName.GetValue() || Params.CommonCfg.StorageType == "local" {
+		return node.chunkManager, nil
+	}
+	factory := storage.NewChunkManagerFactoryWithParam(Params, storage.BucketName(bucket))
+	return factory.NewPersistentStorageChunkManager(ctx)
+}
@@ -0,0 +1,136 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+)
+
+var exportTestDir = "/tmp/milvus_test/export"
+
+func TestExportTask(t *testing.T) {
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(exportTestDir))
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	// a segment of 2 rows, the primary keys are 1 and 2, the timestamps are 3 and 4
+	var segID UniqueID = 100
+	meta := NewMetaFactory().GetCollectionMeta(1, "test", schemapb.DataType_Int64)
+	iblobs, err := getInsertBlobs(segID, genInsertData(), meta)
+	require.NoError(t, err)
+	segment := &datapb.SegmentInfo{ID: segID}
+	for _, blob := range iblobs {
+		logPath := path.Join(cm.RootPath(), "insert_log", strconv.FormatInt(segID, 10), blob.Key)
+		require.NoError(t, cm.Write(ctx, logPath, blob.Value))
+		fieldID, err := strconv.ParseInt(blob.Key, 10, 64)
+		require.NoError(t, err)
+		segment.Binlogs = append(segment.Binlogs, &datapb.FieldBinlog{
+			FieldID: fieldID,
+			Binlogs: []*datapb.Binlog{{LogPath: logPath}},
+		})
+	}
+
+	// the primary key 1 is deleted at timestamp 10
+	dblobs, err := getInt64DeltaBlobs(segID, []UniqueID{1}, []Timestamp{10})
+	require.NoError(t, err)
+	deltaPath := path.Join(cm.RootPath(), "delta_log", strconv.FormatInt(segID, 10))
+	require.NoError(t, cm.Write(ctx, deltaPath, dblobs[0].Value))
+	segment.Deltalogs = []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogPath: deltaPath}}}}
+
+	pkName := ""
+	for _, field := range meta.GetSchema().GetFields() {
+		if field.GetIsPrimaryKey() {
+			pkName = field.GetName()
+		}
+	}
+
+	exportFunc := func(ts Timestamp, reportErr error) (*rootcoordpb.ExportResult, []map[string]interface{}) {
+		task := &datapb.ExportTask{
+			TaskId:    1,
+			Timestamp: ts,
+			FileType:  importutil.JSONFileType,
+			Path:      path.Join(cm.RootPath(), "export", strconv.FormatUint(ts, 10)),
+		}
+		writer, err := importutil.NewExportWriter(ctx, meta.GetSchema(), cm, task.GetFileType(), nil)
+		require.NoError(t, err)
+		result := &rootcoordpb.ExportResult{TaskId: 1, TotalSegments: 1}
+		reported := 0
+		et := newExportTask(ctx, &binlogIO{cm, nil}, task, meta.GetSchema(), writer, result,
+			func(res *rootcoordpb.ExportResult) error {
+				reported++
+				return reportErr
+			})
+		err = et.export([]*datapb.SegmentInfo{segment})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, reported)
+		assert.Equal(t, []int64{segID}, result.GetSegments())
+
+		rows := make([]map[string]interface{}, 0)
+		for _, file := range result.GetFiles() {
+			content, err := cm.Read(ctx, file)
+			require.NoError(t, err)
+			parsed := struct {
+				Rows []map[string]interface{} `json:"rows"`
+			}{}
+			require.NoError(t, json.Unmarshal(content, &parsed))
+			rows = append(rows, parsed.Rows...)
+		}
+		return result, rows
+	}
+
+	t.Run("deleted row is skipped", func(t *testing.T) {
+		result, rows := exportFunc(100, nil)
+		assert.Equal(t, int64(1), result.GetRowCount())
+		assert.Equal(t, []string{path.Join(cm.RootPath(), "export", "100", "100", "0.json")}, result.GetFiles())
+		require.Equal(t, 1, len(rows))
+		assert.Equal(t, float64(2), rows[0][pkName])
+	})
+
+	t.Run("rows are visible before deletion", func(t *testing.T) {
+		result, rows := exportFunc(5, nil)
+		assert.Equal(t, int64(2), result.GetRowCount())
+		assert.Equal(t, 2, len(rows))
+	})
+
+	t.Run("rows inserted after the timestamp are skipped", func(t *testing.T) {
+		result, rows := exportFunc(3, errors.New("mock report error"))
+		assert.Equal(t, int64(1), result.GetRowCount())
+		require.Equal(t, 1, len(rows))
+		assert.Equal(t, float64(1), rows[0][pkName])
+	})
+
+	t.Run("no visible row", func(t *testing.T) {
+		result, rows := exportFunc(1, nil)
+		assert.Equal(t, int64(0), result.GetRowCount())
+		assert.Equal(t, 0, len(result.GetFiles()))
+		assert.Equal(t, 0, len(rows))
+	})
+}
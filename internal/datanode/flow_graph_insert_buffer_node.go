@@ -29,6 +29,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
@@ -496,6 +497,20 @@ func (ibNode *insertBufferNode) updateSegmentStates(insertMsgs []*msgstream.Inse
 	return
 }
 
+// hasUnknownFields returns true if the insert msg carries fields absent from the collection schema.
+func hasUnknownFields(collSchema *schemapb.CollectionSchema, msg *msgstream.InsertMsg) bool {
+	fieldIDs := make(map[UniqueID]struct{}, len(collSchema.GetFields()))
+	for _, field := range collSchema.GetFields() {
+		fieldIDs[field.GetFieldID()] = struct{}{}
+	}
+	for _, fieldData := range msg.GetFieldsData() {
+		if _, ok := fieldIDs[fieldData.GetFieldId()]; !ok {
+			return true
+		}
+	}
+	return false
+}
+
 /* #nosec G103 */
 // bufferInsertMsg put InsertMsg into buffer
 // 	1.1 fetch related schema from channel meta
@@ -514,6 +529,14 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, startP
 		log.Warn("Get schema wrong:", zap.Error(err))
 		return err
 	}
+	if hasUnknownFields(collSchema, msg) {
+		// fields are added to the collection after the schema is cached
+		collSchema, err = ibNode.channel.refreshCollectionSchema(collectionID, msg.EndTs())
+		if err != nil {
+			log.Warn("refresh schema wrong:", zap.Error(err))
+			return err
+		}
+	}
 
 	// load or store insertBuffer
 	var buffer *BufferData
@@ -539,6 +562,10 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, startP
 		ibNode.channel.updateSegmentPKRange(currentSegID, addedPfData)
 	}
 
+	// the buffered rows are inserted before fields are added
+	if err := storage.FillMissingFields(collSchema, buffer.buffer); err != nil {
+		return err
+	}
 	// Maybe there are large write zoom if frequent insert requests are met.
 	buffer.buffer = storage.MergeInsertData(buffer.buffer, addedBuffer)
 
@@ -355,6 +355,9 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 	if err != nil {
 		return nil, err
 	}
+	if err := storage.FillMissingFields(meta.GetSchema(), data.buffer); err != nil {
+		return nil, err
+	}
 	// get memory size of buffer data
 	fieldMemorySize := make(map[int64]int)
 	for fieldID, fieldData := range data.buffer.Data {
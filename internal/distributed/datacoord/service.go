@@ -364,6 +364,16 @@ func (s *Server) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*da
 	return s.dataCoord.Import(ctx, req)
 }
 
+// Export rows of flushed segments of a collection into files(json, numpy, parquet) on MinIO/S3 storage
+func (s *Server) Export(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+	return s.dataCoord.Export(ctx, req)
+}
+
+// CancelImport is the dataCoord service caller of CancelImport.
+func (s *Server) CancelImport(ctx context.Context, req *datapb.CancelImportTaskRequest) (*commonpb.Status, error) {
+	return s.dataCoord.CancelImport(ctx, req)
+}
+
 // UpdateSegmentStatistics is the dataCoord service caller of UpdateSegmentStatistics.
 func (s *Server) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	return s.dataCoord.UpdateSegmentStatistics(ctx, req)
@@ -62,6 +62,7 @@ type MockDataCoord struct {
 	dropVChanResp             *datapb.DropVirtualChannelResponse
 	setSegmentStateResp       *datapb.SetSegmentStateResponse
 	importResp                *datapb.ImportTaskResponse
+	exportResp                *datapb.ExportTaskResponse
 	updateSegStatResp         *commonpb.Status
 	updateChanPos             *commonpb.Status
 	acquireSegLockResp        *commonpb.Status
@@ -201,6 +202,14 @@ func (m *MockDataCoord) Import(ctx context.Context, req *datapb.ImportTaskReques
 	return m.importResp, m.err
 }
 
+func (m *MockDataCoord) Export(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+	return m.exportResp, m.err
+}
+
+func (m *MockDataCoord) CancelImport(ctx context.Context, req *datapb.CancelImportTaskRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
 func (m *MockDataCoord) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	return m.updateSegStatResp, m.err
 }
@@ -474,6 +483,26 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("export", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			exportResp: &datapb.ExportTaskResponse{
+				Status: &commonpb.Status{},
+			},
+		}
+		resp, err := server.Export(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("cancel import", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			status: &commonpb.Status{},
+		}
+		resp, err := server.CancelImport(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
 	t.Run("update seg stat", func(t *testing.T) {
 		server.dataCoord = &MockDataCoord{
 			updateSegStatResp: &commonpb.Status{
@@ -370,6 +370,14 @@ func (s *Server) Import(ctx context.Context, request *datapb.ImportTaskRequest)
 	return s.datanode.Import(ctx, request)
 }
 
+func (s *Server) Export(ctx context.Context, request *datapb.ExportTaskRequest) (*commonpb.Status, error) {
+	return s.datanode.Export(ctx, request)
+}
+
+func (s *Server) CancelImport(ctx context.Context, request *datapb.CancelImportTaskRequest) (*commonpb.Status, error) {
+	return s.datanode.CancelImport(ctx, request)
+}
+
 func (s *Server) ResendSegmentStats(ctx context.Context, request *datapb.ResendSegmentStatsRequest) (*datapb.ResendSegmentStatsResponse, error) {
 	return s.datanode.ResendSegmentStats(ctx, request)
 }
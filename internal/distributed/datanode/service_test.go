@@ -131,6 +131,14 @@ func (m *MockDataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest
 	return m.status, m.err
 }
 
+func (m *MockDataNode) Export(ctx context.Context, req *datapb.ExportTaskRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
+func (m *MockDataNode) CancelImport(ctx context.Context, req *datapb.CancelImportTaskRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
 func (m *MockDataNode) ResendSegmentStats(ctx context.Context, req *datapb.ResendSegmentStatsRequest) (*datapb.ResendSegmentStatsResponse, error) {
 	return m.resendResp, m.err
 }
@@ -297,6 +305,24 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("Export", func(t *testing.T) {
+		server.datanode = &MockDataNode{
+			status: &commonpb.Status{},
+		}
+		resp, err := server.Export(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("CancelImport", func(t *testing.T) {
+		server.datanode = &MockDataNode{
+			status: &commonpb.Status{},
+		}
+		resp, err := server.CancelImport(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
 	t.Run("ResendSegmentStats", func(t *testing.T) {
 		server.datanode = &MockDataNode{
 			resendResp: &datapb.ResendSegmentStatsResponse{},
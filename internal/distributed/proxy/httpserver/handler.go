@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/types"
 )
 
@@ -75,6 +76,10 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.GET("/import/state", wrapHandler(h.handleGetImportState))
 	router.GET("/import/tasks", wrapHandler(h.handleListImportTasks))
 
+	router.POST("/export", wrapHandler(h.handleExport))
+	router.GET("/export/state", wrapHandler(h.handleGetExportState))
+	router.GET("/export/tasks", wrapHandler(h.handleListExportTasks))
+
 	router.POST("/credential", wrapHandler(h.handleCreateCredential))
 	router.PATCH("/credential", wrapHandler(h.handleUpdateCredential))
 	router.DELETE("/credential", wrapHandler(h.handleDeleteCredential))
@@ -516,6 +521,33 @@ func (h *Handlers) handleListImportTasks(c *gin.Context) (interface{}, error) {
 	return h.proxy.ListImportTasks(c, &req)
 }
 
+func (h *Handlers) handleExport(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.ExportRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.Export(c, &req)
+}
+
+func (h *Handlers) handleGetExportState(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.GetExportStateRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.GetExportState(c, &req)
+}
+
+func (h *Handlers) handleListExportTasks(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.ListExportTasksRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.ListExportTasks(c, &req)
+}
+
 func (h *Handlers) handleCreateCredential(c *gin.Context) (interface{}, error) {
 	req := milvuspb.CreateCredentialRequest{}
 	err := shouldBind(c, &req)
@@ -15,6 +15,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -239,6 +240,18 @@ func (m *mockProxyComponent) ListImportTasks(ctx context.Context, request *milvu
 	return &milvuspb.ListImportTasksResponse{Status: testStatus}, nil
 }
 
+func (m *mockProxyComponent) Export(ctx context.Context, request *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	return &rootcoordpb.ExportResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) GetExportState(ctx context.Context, request *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	return &rootcoordpb.GetExportStateResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) ListExportTasks(ctx context.Context, request *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
+	return &rootcoordpb.ListExportTasksResponse{Status: testStatus}, nil
+}
+
 func (m *mockProxyComponent) CreateCredential(ctx context.Context, request *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
 	return testStatus, nil
 }
@@ -494,6 +507,18 @@ func TestHandlers(t *testing.T) {
 			http.MethodGet, "/import/tasks", emptyBody,
 			http.StatusOK, &milvuspb.ListImportTasksResponse{Status: testStatus},
 		},
+		{
+			http.MethodPost, "/export", emptyBody,
+			http.StatusOK, &rootcoordpb.ExportResponse{Status: testStatus},
+		},
+		{
+			http.MethodGet, "/export/state", emptyBody,
+			http.StatusOK, &rootcoordpb.GetExportStateResponse{Status: testStatus},
+		},
+		{
+			http.MethodGet, "/export/tasks", emptyBody,
+			http.StatusOK, &rootcoordpb.ListExportTasksResponse{Status: testStatus},
+		},
 		{
 			http.MethodPost, "/credential", emptyBody,
 			http.StatusOK, testStatus,
@@ -217,6 +217,26 @@ func (m *MockRootCoord) ReportImport(ctx context.Context, req *rootcoordpb.Impor
 	return nil, nil
 }
 
+func (m *MockRootCoord) CancelImport(ctx context.Context, req *rootcoordpb.CancelImportRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) Export(ctx context.Context, req *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ReportExport(ctx context.Context, req *rootcoordpb.ExportResult) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockRootCoord) CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -455,6 +475,10 @@ func (m *MockQueryCoord) CheckHealth(ctx context.Context, req *milvuspb.CheckHea
 	}, nil
 }
 
+func (m *MockQueryCoord) UpdateCollectionSchema(ctx context.Context, req *querypb.UpdateCollectionSchemaRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type MockDataCoord struct {
 	MockBase
@@ -589,6 +613,14 @@ func (m *MockDataCoord) Import(ctx context.Context, req *datapb.ImportTaskReques
 	return nil, nil
 }
 
+func (m *MockDataCoord) Export(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) CancelImport(ctx context.Context, req *datapb.CancelImportTaskRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockDataCoord) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -862,6 +894,18 @@ func (m *MockProxy) ListImportTasks(ctx context.Context, in *milvuspb.ListImport
 	return nil, nil
 }
 
+func (m *MockProxy) Export(ctx context.Context, req *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
 	return nil, nil
 }
@@ -408,3 +408,8 @@ func (s *Server) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeade
 func (s *Server) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	return s.queryCoord.CheckHealth(ctx, req)
 }
+
+// UpdateCollectionSchema pushes the schema with appended fields to the QueryNodes which load the collection.
+func (s *Server) UpdateCollectionSchema(ctx context.Context, req *querypb.UpdateCollectionSchemaRequest) (*commonpb.Status, error) {
+	return s.queryCoord.UpdateCollectionSchema(ctx, req)
+}
@@ -161,6 +161,10 @@ func (m *MockQueryCoord) CheckHealth(ctx context.Context, req *milvuspb.CheckHea
 	}, m.err
 }
 
+func (m *MockQueryCoord) UpdateCollectionSchema(ctx context.Context, req *querypb.UpdateCollectionSchemaRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type MockRootCoord struct {
 	types.RootCoord
@@ -402,6 +406,12 @@ func Test_NewServer(t *testing.T) {
 		assert.Equal(t, true, ret.IsHealthy)
 	})
 
+	t.Run("UpdateCollectionSchema", func(t *testing.T) {
+		resp, err := server.UpdateCollectionSchema(ctx, &querypb.UpdateCollectionSchemaRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	err = server.Stop()
 	assert.Nil(t, err)
 }
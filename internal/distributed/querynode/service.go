@@ -330,3 +330,8 @@ func (s *Server) GetDataDistribution(ctx context.Context, req *querypb.GetDataDi
 func (s *Server) SyncDistribution(ctx context.Context, req *querypb.SyncDistributionRequest) (*commonpb.Status, error) {
 	return s.querynode.SyncDistribution(ctx, req)
 }
+
+// UpdateCollectionSchema updates the schema of the loaded collection with the appended fields.
+func (s *Server) UpdateCollectionSchema(ctx context.Context, req *querypb.UpdateCollectionSchemaRequest) (*commonpb.Status, error) {
+	return s.querynode.UpdateCollectionSchema(ctx, req)
+}
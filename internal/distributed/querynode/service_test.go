@@ -153,6 +153,9 @@ func (m *MockQueryNode) GetDataDistribution(context.Context, *querypb.GetDataDis
 func (m *MockQueryNode) SyncDistribution(context.Context, *querypb.SyncDistributionRequest) (*commonpb.Status, error) {
 	return m.status, m.err
 }
+func (m *MockQueryNode) UpdateCollectionSchema(context.Context, *querypb.UpdateCollectionSchemaRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
 
 type MockRootCoord struct {
 	types.RootCoord
@@ -341,6 +344,13 @@ func Test_NewServer(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
 	})
 
+	t.Run("UpdateCollectionSchema", func(t *testing.T) {
+		req := &querypb.UpdateCollectionSchemaRequest{}
+		resp, err := server.UpdateCollectionSchema(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
 	t.Run("ShowConfigurtaions", func(t *testing.T) {
 		req := &internalpb.ShowConfigurationsRequest{
 			Pattern: "Cache",
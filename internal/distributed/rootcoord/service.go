@@ -425,6 +425,31 @@ func (s *Server) ReportImport(ctx context.Context, in *rootcoordpb.ImportResult)
 	return s.rootCoord.ReportImport(ctx, in)
 }
 
+// Export writes rows of a collection into files(json, numpy, parquet) on MinIO/S3 storage
+func (s *Server) Export(ctx context.Context, in *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	return s.rootCoord.Export(ctx, in)
+}
+
+// CancelImport cancels an import task
+func (s *Server) CancelImport(ctx context.Context, in *rootcoordpb.CancelImportRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CancelImport(ctx, in)
+}
+
+// GetExportState returns the state and progress of an export task
+func (s *Server) GetExportState(ctx context.Context, in *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	return s.rootCoord.GetExportState(ctx, in)
+}
+
+// ListExportTasks returns the states of the latest export tasks
+func (s *Server) ListExportTasks(ctx context.Context, in *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
+	return s.rootCoord.ListExportTasks(ctx, in)
+}
+
+// ReportExport reports export task state to rootcoord
+func (s *Server) ReportExport(ctx context.Context, in *rootcoordpb.ExportResult) (*commonpb.Status, error) {
+	return s.rootCoord.ReportExport(ctx, in)
+}
+
 func (s *Server) CreateCredential(ctx context.Context, request *internalpb.CredentialInfo) (*commonpb.Status, error) {
 	return s.rootCoord.CreateCredential(ctx, request)
 }
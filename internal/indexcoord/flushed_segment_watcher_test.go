@@ -26,8 +26,13 @@ import (
 	"github.com/golang/protobuf/proto"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	dbdatacoord "github.com/milvus-io/milvus/internal/metastore/db/datacoord"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel/mocks"
 	"github.com/milvus-io/milvus/internal/metastore/kv/indexcoord"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -90,6 +95,82 @@ func Test_flushSegmentWatcher(t *testing.T) {
 	fsw.Stop()
 }
 
+type noopTransaction struct{}
+
+func (*noopTransaction) Transaction(ctx context.Context, fn func(txCtx context.Context) error) error {
+	return fn(ctx)
+}
+
+func Test_flushSegmentWatcher_SQLCatalog(t *testing.T) {
+	// the datacoord SQL catalog notifies the flushed segments in etcd like the kv catalog
+	segmentDb := &mocks.ISegmentDb{}
+	segmentDb.On("Upsert", mock.Anything).Return(nil)
+	binlogDb := &mocks.IBinlogDb{}
+	binlogDb.On("Delete", mock.Anything, mock.Anything).Return(nil)
+	metaDomain := &mocks.IMetaDomain{}
+	metaDomain.On("SegmentDb", mock.Anything).Return(segmentDb)
+	metaDomain.On("BinlogDb", mock.Anything).Return(binlogDb)
+	flushKV := memkv.NewMemoryKV()
+	catalog := dbdatacoord.NewTableCatalog(&noopTransaction{}, metaDomain, flushKV)
+
+	segment := &datapb.SegmentInfo{ID: segID, CollectionID: collID, PartitionID: partID, NumOfRows: 1000}
+	segment.State = commonpb.SegmentState_Flushing
+	flushing := proto.Clone(segment).(*datapb.SegmentInfo)
+	segment.State = commonpb.SegmentState_Flushed
+	err := catalog.AlterSegment(context.Background(), segment, flushing)
+	require.NoError(t, err)
+
+	meta := &metaTable{
+		segmentIndexLock: sync.RWMutex{},
+		indexLock:        sync.RWMutex{},
+		collectionIndexes: map[UniqueID]map[UniqueID]*model.Index{
+			collID: {
+				indexID: {CollectionID: collID, FieldID: fieldID, IndexID: indexID, IndexName: indexName, CreateTime: 1},
+			},
+		},
+		segmentIndexes: map[UniqueID]map[UniqueID]*model.SegmentIndex{
+			segID: {
+				indexID: {SegmentID: segID, CollectionID: collID, PartitionID: partID, NumRows: 1000,
+					IndexID: indexID, BuildID: buildID, IndexState: commonpb.IndexState_Unissued},
+			},
+		},
+		buildID2SegmentIndex: map[UniqueID]*model.SegmentIndex{},
+	}
+	handoff := &handoff{
+		segments:   map[UniqueID]*datapb.SegmentInfo{},
+		meta:       meta,
+		notifyChan: make(chan struct{}, 1),
+	}
+	ic := &IndexCoord{
+		dataCoordClient: &DataCoordMock{
+			CallGetSegmentInfo: func(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+				return &datapb.GetSegmentInfoResponse{
+					Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+					Infos:  []*datapb.SegmentInfo{segment},
+				}, nil
+			},
+		},
+		rootCoordClient: NewRootCoordMock(),
+		metaTable:       meta,
+	}
+	fsw, err := newFlushSegmentWatcher(context.Background(),
+		&mockETCDKV{
+			loadWithRevision: func(key string) ([]string, []string, int64, error) {
+				keys, values, err := flushKV.LoadWithPrefix(key)
+				return keys, values, 1, err
+			},
+		}, meta, &indexBuilder{tasks: map[int64]indexTaskState{}, meta: meta}, handoff, ic)
+	require.NoError(t, err)
+	require.Equal(t, 1, fsw.Len())
+
+	// the index tasks of the segment are constructed
+	fsw.internalProcess(segID)
+	assert.Equal(t, indexTaskInit, fsw.getInternalTask(segID).state)
+	fsw.internalProcess(segID)
+	assert.Equal(t, indexTaskInProgress, fsw.getInternalTask(segID).state)
+	assert.False(t, handoff.taskDone(segID))
+}
+
 func Test_flushSegmentWatcher_newFlushSegmentWatcher(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		fsw, err := newFlushSegmentWatcher(context.Background(),
@@ -0,0 +1,297 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package boltkv
+
+import (
+	"context"
+	"io"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+const (
+	// expireInterval is how often expired leases are looked for.
+	expireInterval = 500 * time.Millisecond
+	// maxLeaseTTL matches the etcd server limit.
+	maxLeaseTTL = 9000000000
+)
+
+type lease struct {
+	ttl    int64
+	expiry time.Time
+	keys   map[string]struct{}
+}
+
+// remaining returns the remaining TTL in seconds rounded up.
+func (l *lease) remaining(now time.Time) int64 {
+	left := l.expiry.Sub(now)
+	if left <= 0 {
+		return 0
+	}
+	return int64(math.Ceil(left.Seconds()))
+}
+
+// lessor tracks the live leases and the keys attached to them.
+type lessor struct {
+	mu     sync.RWMutex
+	leases map[int64]*lease
+}
+
+func newLessor() *lessor {
+	return &lessor{leases: make(map[int64]*lease)}
+}
+
+func (le *lessor) grant(id, ttl int64, now time.Time) {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+	le.leases[id] = &lease{
+		ttl:    ttl,
+		expiry: now.Add(time.Duration(ttl) * time.Second),
+		keys:   make(map[string]struct{}),
+	}
+}
+
+func (le *lessor) exists(id int64) bool {
+	le.mu.RLock()
+	defer le.mu.RUnlock()
+	_, ok := le.leases[id]
+	return ok
+}
+
+// renew refreshes the lease expiry and returns its TTL, or 0 if it does not exist.
+func (le *lessor) renew(id int64, now time.Time) int64 {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+	l, ok := le.leases[id]
+	if !ok {
+		return 0
+	}
+	l.expiry = now.Add(time.Duration(l.ttl) * time.Second)
+	return l.ttl
+}
+
+func (le *lessor) revoke(id int64) {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+	delete(le.leases, id)
+}
+
+func (le *lessor) attach(id int64, key string) {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+	if l, ok := le.leases[id]; ok {
+		l.keys[key] = struct{}{}
+	}
+}
+
+func (le *lessor) detach(id int64, key string) {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+	if l, ok := le.leases[id]; ok {
+		delete(l.keys, key)
+	}
+}
+
+// keys returns the keys attached to the lease in order.
+func (le *lessor) keys(id int64) ([]string, bool) {
+	le.mu.RLock()
+	defer le.mu.RUnlock()
+	l, ok := le.leases[id]
+	if !ok {
+		return nil, false
+	}
+	keys := make([]string, 0, len(l.keys))
+	for key := range l.keys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys, true
+}
+
+func (le *lessor) get(id int64) (ttl, remaining int64, ok bool) {
+	le.mu.RLock()
+	defer le.mu.RUnlock()
+	l, ok := le.leases[id]
+	if !ok {
+		return 0, 0, false
+	}
+	return l.ttl, l.remaining(time.Now()), true
+}
+
+func (le *lessor) list() []int64 {
+	le.mu.RLock()
+	defer le.mu.RUnlock()
+	ids := make([]int64, 0, len(le.leases))
+	for id := range le.leases {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func (le *lessor) expired(now time.Time) []int64 {
+	le.mu.RLock()
+	defer le.mu.RUnlock()
+	var ids []int64
+	for id, l := range le.leases {
+		if !now.Before(l.expiry) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// nextID returns an unused lease id.
+func (le *lessor) nextID() int64 {
+	le.mu.RLock()
+	defer le.mu.RUnlock()
+	id := time.Now().UnixNano() & math.MaxInt64
+	for {
+		if _, ok := le.leases[id]; !ok && id != 0 {
+			return id
+		}
+		id = (id + 1) & math.MaxInt64
+	}
+}
+
+// LeaseGrant implements etcdserverpb.LeaseServer.
+func (s *Store) LeaseGrant(ctx context.Context, r *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error) {
+	if r.TTL > maxLeaseTTL {
+		return nil, rpctypes.ErrGRPCLeaseTTLTooLarge
+	}
+	ttl := r.TTL
+	if ttl < 1 {
+		ttl = 1
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := r.ID
+	if id == 0 {
+		id = s.leases.nextID()
+	} else if s.leases.exists(id) {
+		return nil, rpctypes.ErrGRPCLeaseExist
+	}
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(leaseBucket).Put(encodeInt64(id), encodeInt64(ttl))
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.leases.grant(id, ttl, time.Now())
+	return &pb.LeaseGrantResponse{Header: s.header(), ID: id, TTL: ttl}, nil
+}
+
+// LeaseRevoke implements etcdserverpb.LeaseServer, the keys attached to the lease are deleted.
+func (s *Store) LeaseRevoke(ctx context.Context, r *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error) {
+	resp := &pb.LeaseRevokeResponse{}
+	err := s.update(func(wt *writeTxn) error {
+		keys, ok := s.leases.keys(r.ID)
+		if !ok {
+			return rpctypes.ErrGRPCLeaseNotFound
+		}
+		for _, key := range keys {
+			if _, err := wt.deleteRange(&pb.DeleteRangeRequest{Key: []byte(key)}); err != nil {
+				return err
+			}
+		}
+		wt.revokedLeases = append(wt.revokedLeases, r.ID)
+		resp.Header = wt.header
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// LeaseKeepAlive implements etcdserverpb.LeaseServer.
+func (s *Store) LeaseKeepAlive(stream pb.Lease_LeaseKeepAliveServer) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		select {
+		case <-s.closed:
+			return ErrStoreClosed
+		default:
+		}
+		ttl := s.leases.renew(req.ID, time.Now())
+		if err := stream.Send(&pb.LeaseKeepAliveResponse{Header: s.header(), ID: req.ID, TTL: ttl}); err != nil {
+			return err
+		}
+	}
+}
+
+// LeaseTimeToLive implements etcdserverpb.LeaseServer.
+func (s *Store) LeaseTimeToLive(ctx context.Context, r *pb.LeaseTimeToLiveRequest) (*pb.LeaseTimeToLiveResponse, error) {
+	resp := &pb.LeaseTimeToLiveResponse{Header: s.header(), ID: r.ID, TTL: -1}
+	ttl, remaining, ok := s.leases.get(r.ID)
+	if !ok {
+		return resp, nil
+	}
+	resp.TTL, resp.GrantedTTL = remaining, ttl
+	if r.Keys {
+		keys, _ := s.leases.keys(r.ID)
+		for _, key := range keys {
+			resp.Keys = append(resp.Keys, []byte(key))
+		}
+	}
+	return resp, nil
+}
+
+// LeaseLeases implements etcdserverpb.LeaseServer.
+func (s *Store) LeaseLeases(ctx context.Context, r *pb.LeaseLeasesRequest) (*pb.LeaseLeasesResponse, error) {
+	resp := &pb.LeaseLeasesResponse{Header: s.header()}
+	for _, id := range s.leases.list() {
+		resp.Leases = append(resp.Leases, &pb.LeaseStatus{ID: id})
+	}
+	return resp, nil
+}
+
+// expireLoop revokes the leases that were not kept alive in time.
+func (s *Store) expireLoop() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(expireInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.closed:
+			return
+		case now := <-ticker.C:
+			for _, id := range s.leases.expired(now) {
+				_, err := s.LeaseRevoke(context.Background(), &pb.LeaseRevokeRequest{ID: id})
+				if err != nil && err != rpctypes.ErrGRPCLeaseNotFound {
+					log.Warn("failed to revoke expired lease", zap.Int64("leaseID", id), zap.Error(err))
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,160 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package boltkv_test
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	boltkv "github.com/milvus-io/milvus/internal/kv/bolt"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func newMetaKv(t *testing.T) kv.MetaKv {
+	s, err := boltkv.Open(path.Join(t.TempDir(), boltkv.FileName))
+	require.NoError(t, err)
+	cli := boltkv.NewClient(s)
+	metaKv := etcdkv.NewEtcdKV(cli, "by-dev/meta")
+	t.Cleanup(func() {
+		metaKv.Close()
+		s.Close()
+	})
+	return metaKv
+}
+
+func TestMetaKv_SaveAndLoad(t *testing.T) {
+	metaKv := newMetaKv(t)
+
+	require.NoError(t, metaKv.Save("abc", "123"))
+	require.NoError(t, metaKv.MultiSave(map[string]string{"abd": "456", "bcd": "789"}))
+
+	value, err := metaKv.Load("abc")
+	assert.NoError(t, err)
+	assert.Equal(t, "123", value)
+	_, err = metaKv.Load("missing")
+	assert.Error(t, err)
+
+	keys, values, err := metaKv.LoadWithPrefix("ab")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"by-dev/meta/abc", "by-dev/meta/abd"}, keys)
+	assert.ElementsMatch(t, []string{"123", "456"}, values)
+
+	_, _, revision, err := metaKv.LoadWithRevision("ab")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), revision)
+
+	require.NoError(t, metaKv.MultiSaveAndRemoveWithPrefix(map[string]string{"cde": "0"}, []string{"ab"}))
+	keys, _, err = metaKv.LoadWithPrefix("")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"by-dev/meta/bcd", "by-dev/meta/cde"}, keys)
+
+	require.NoError(t, metaKv.MultiSaveAndRemove(map[string]string{"def": "1"}, []string{"bcd"}))
+	keys, _, versions, err := metaKv.LoadWithPrefix2("")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"by-dev/meta/cde", "by-dev/meta/def"}, keys)
+	assert.Equal(t, []int64{1, 1}, versions)
+}
+
+func TestMetaKv_CompareAndSwap(t *testing.T) {
+	metaKv := newMetaKv(t)
+
+	ok, err := metaKv.CompareVersionAndSwap("key", 0, "v1")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = metaKv.CompareVersionAndSwap("key", 0, "v2")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = metaKv.CompareValueAndSwap("key", "v1", "v3")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = metaKv.CompareValueAndSwap("key", "v1", "v4")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	value, err := metaKv.Load("key")
+	assert.NoError(t, err)
+	assert.Equal(t, "v3", value)
+}
+
+func TestMetaKv_Lease(t *testing.T) {
+	metaKv := newMetaKv(t)
+
+	id, err := metaKv.Grant(1)
+	require.NoError(t, err)
+	require.NoError(t, metaKv.SaveWithLease("session", "alive", id))
+	ch, err := metaKv.KeepAlive(id)
+	require.NoError(t, err)
+	<-ch
+
+	// the key outlives its TTL while kept alive
+	time.Sleep(1500 * time.Millisecond)
+	require.NoError(t, metaKv.SaveWithIgnoreLease("session", "still alive"))
+	value, err := metaKv.Load("session")
+	assert.NoError(t, err)
+	assert.Equal(t, "still alive", value)
+
+	expiring, err := metaKv.Grant(1)
+	require.NoError(t, err)
+	require.NoError(t, metaKv.SaveWithLease("expiring", "v", expiring))
+	assert.Eventually(t, func() bool {
+		_, err := metaKv.Load("expiring")
+		return err != nil
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+func TestMetaKv_Watch(t *testing.T) {
+	metaKv := newMetaKv(t)
+
+	require.NoError(t, metaKv.Save("watch/a", "1"))
+	_, _, revision, err := metaKv.LoadWithRevision("watch/")
+	require.NoError(t, err)
+
+	prefixCh := metaKv.WatchWithPrefix("watch/")
+	keyCh := metaKv.Watch("watch/a")
+	historyCh := metaKv.WatchWithRevision("watch/", revision)
+	assert.True(t, (<-prefixCh).Created)
+	assert.True(t, (<-keyCh).Created)
+
+	require.NoError(t, metaKv.Save("watch/b", "2"))
+	require.NoError(t, metaKv.Remove("watch/a"))
+
+	resp := <-prefixCh
+	require.Len(t, resp.Events, 1)
+	assert.Equal(t, "by-dev/meta/watch/b", string(resp.Events[0].Kv.Key))
+	resp = <-prefixCh
+	assert.Equal(t, mvccpb.DELETE, resp.Events[0].Type)
+
+	resp = <-keyCh
+	require.Len(t, resp.Events, 1)
+	assert.Equal(t, mvccpb.DELETE, resp.Events[0].Type)
+
+	var events []*clientv3.Event
+	for len(events) < 3 {
+		resp = <-historyCh
+		events = append(events, resp.Events...)
+	}
+	assert.Equal(t, "1", string(events[0].Kv.Value))
+	assert.Equal(t, "2", string(events[1].Kv.Value))
+	assert.Equal(t, mvccpb.DELETE, events[2].Type)
+}
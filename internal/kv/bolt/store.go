@@ -0,0 +1,778 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package boltkv
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+)
+
+// FileName is the name of the store file in the embedded etcd data directory.
+const FileName = "milvus_meta.db"
+
+// DefaultHistoryRevisions is the number of revisions whose events are kept for
+// historical reads and watches before they get compacted.
+const DefaultHistoryRevisions = 10000
+
+var (
+	metaBucket  = []byte("meta")
+	kvBucket    = []byte("kv")
+	eventBucket = []byte("event")
+	leaseBucket = []byte("lease")
+
+	revisionKey        = []byte("revision")
+	compactRevisionKey = []byte("compact_revision")
+
+	// ErrStoreClosed is returned by streaming calls when the store is closed.
+	ErrStoreClosed = errors.New("boltkv: store closed")
+)
+
+// Store is an etcd compatible MVCC key-value store persisted in a single bbolt file.
+// It serves the etcd KV, Lease and Watch APIs in process so that standalone deployments
+// can run without an etcd server, see NewClient.
+type Store struct {
+	db   *bolt.DB
+	path string
+
+	historyRevisions int64
+
+	// mu serializes write transactions together with the lease bookkeeping and
+	// the event dispatching to watchers.
+	mu         sync.Mutex
+	rev        int64
+	compactRev int64
+	leases     *lessor
+	watchers   map[*watcher]struct{}
+
+	refs      int
+	closeOnce sync.Once
+	closed    chan struct{}
+	wg        sync.WaitGroup
+}
+
+// Option customizes a Store.
+type Option func(*Store)
+
+// WithHistoryRevisions sets the number of revisions kept for historical reads and watches.
+func WithHistoryRevisions(n int64) Option {
+	return func(s *Store) {
+		if n > 0 {
+			s.historyRevisions = n
+		}
+	}
+}
+
+// Open opens or creates the store file at path.
+func Open(path string, opts ...Option) (*Store, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+	s := &Store{
+		db:               db,
+		path:             path,
+		historyRevisions: DefaultHistoryRevisions,
+		leases:           newLessor(),
+		watchers:         make(map[*watcher]struct{}),
+		closed:           make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if err := s.recover(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	s.wg.Add(1)
+	go s.expireLoop()
+	return s, nil
+}
+
+var shared = struct {
+	sync.Mutex
+	stores map[string]*Store
+}{stores: make(map[string]*Store)}
+
+// OpenShared opens the store at path once per process, every caller shares the same
+// instance and must call Close when done with it.
+func OpenShared(path string, opts ...Option) (*Store, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	shared.Lock()
+	defer shared.Unlock()
+	if s, ok := shared.stores[abs]; ok {
+		s.refs++
+		return s, nil
+	}
+	s, err := Open(abs, opts...)
+	if err != nil {
+		return nil, err
+	}
+	s.refs = 1
+	shared.stores[abs] = s
+	return s, nil
+}
+
+// Close releases the store, the underlying file is closed once every shared reference is released.
+func (s *Store) Close() error {
+	shared.Lock()
+	if s.refs > 0 {
+		s.refs--
+		if s.refs > 0 {
+			shared.Unlock()
+			return nil
+		}
+		delete(shared.stores, s.path)
+	}
+	shared.Unlock()
+
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.closed)
+		s.wg.Wait()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		err = s.db.Close()
+	})
+	return err
+}
+
+// FilePath returns the path of the store file in the given data directory.
+func FilePath(dataDir string) string {
+	return filepath.Join(dataDir, FileName)
+}
+
+// Path returns the path of the store file.
+func (s *Store) Path() string {
+	return s.path
+}
+
+// Revision returns the current revision of the store.
+func (s *Store) Revision() int64 {
+	return atomic.LoadInt64(&s.rev)
+}
+
+// CompactRevision returns the revision up to which the history has been compacted.
+func (s *Store) CompactRevision() int64 {
+	return atomic.LoadInt64(&s.compactRev)
+}
+
+func (s *Store) header() *pb.ResponseHeader {
+	return &pb.ResponseHeader{Revision: s.Revision()}
+}
+
+func (s *Store) recover() error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{metaBucket, kvBucket, eventBucket, leaseBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		meta := tx.Bucket(metaBucket)
+		s.rev = decodeInt64(meta.Get(revisionKey))
+		s.compactRev = decodeInt64(meta.Get(compactRevisionKey))
+
+		now := time.Now()
+		err := tx.Bucket(leaseBucket).ForEach(func(k, v []byte) error {
+			s.leases.grant(decodeInt64(k), decodeInt64(v), now)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		return tx.Bucket(kvBucket).ForEach(func(k, v []byte) error {
+			kv, err := decodeKeyValue(v)
+			if err != nil {
+				return err
+			}
+			if kv.Lease != 0 {
+				s.leases.attach(kv.Lease, string(kv.Key))
+			}
+			return nil
+		})
+	})
+}
+
+// Range implements etcdserverpb.KVServer.
+func (s *Store) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeResponse, error) {
+	var resp *pb.RangeResponse
+	err := s.db.View(func(tx *bolt.Tx) error {
+		meta := tx.Bucket(metaBucket)
+		rev := decodeInt64(meta.Get(revisionKey))
+		var err error
+		resp, err = rangeKeys(tx, r, rev, decodeInt64(meta.Get(compactRevisionKey)))
+		if err != nil {
+			return err
+		}
+		resp.Header = &pb.ResponseHeader{Revision: rev}
+		return nil
+	})
+	return resp, err
+}
+
+// Put implements etcdserverpb.KVServer.
+func (s *Store) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
+	var resp *pb.PutResponse
+	err := s.update(func(wt *writeTxn) error {
+		var err error
+		resp, err = wt.put(r)
+		return err
+	})
+	return resp, err
+}
+
+// DeleteRange implements etcdserverpb.KVServer.
+func (s *Store) DeleteRange(ctx context.Context, r *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error) {
+	var resp *pb.DeleteRangeResponse
+	err := s.update(func(wt *writeTxn) error {
+		var err error
+		resp, err = wt.deleteRange(r)
+		return err
+	})
+	return resp, err
+}
+
+// Txn implements etcdserverpb.KVServer.
+func (s *Store) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse, error) {
+	var resp *pb.TxnResponse
+	err := s.update(func(wt *writeTxn) error {
+		var err error
+		resp, err = wt.txn(r)
+		return err
+	})
+	return resp, err
+}
+
+// Compact implements etcdserverpb.KVServer, it drops the history up to the given revision.
+func (s *Store) Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.CompactionResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r.Revision > s.rev {
+		return nil, rpctypes.ErrGRPCFutureRev
+	}
+	if r.Revision <= s.compactRev {
+		return nil, rpctypes.ErrGRPCCompacted
+	}
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		return compactHistory(tx, r.Revision)
+	})
+	if err != nil {
+		return nil, err
+	}
+	atomic.StoreInt64(&s.compactRev, r.Revision)
+	return &pb.CompactionResponse{Header: s.header()}, nil
+}
+
+// writeTxn is a write transaction, every change made through it is committed at the same revision.
+type writeTxn struct {
+	s      *Store
+	tx     *bolt.Tx
+	kv     *bolt.Bucket
+	rev    int64
+	header *pb.ResponseHeader
+	events []*mvccpb.Event
+
+	revokedLeases []int64
+}
+
+// update runs fn in a write transaction, bumps the revision if anything changed and
+// dispatches the resulting events to the lessor and the watchers.
+func (s *Store) update(fn func(wt *writeTxn) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var wt *writeTxn
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		wt = &writeTxn{
+			s:      s,
+			tx:     tx,
+			kv:     tx.Bucket(kvBucket),
+			rev:    s.rev + 1,
+			header: &pb.ResponseHeader{},
+		}
+		if err := fn(wt); err != nil {
+			return err
+		}
+		for _, id := range wt.revokedLeases {
+			if err := tx.Bucket(leaseBucket).Delete(encodeInt64(id)); err != nil {
+				return err
+			}
+		}
+		if len(wt.events) == 0 {
+			wt.header.Revision = s.rev
+			return nil
+		}
+		wt.header.Revision = wt.rev
+		if err := tx.Bucket(metaBucket).Put(revisionKey, encodeInt64(wt.rev)); err != nil {
+			return err
+		}
+		data, err := (&pb.WatchResponse{Events: wt.events}).Marshal()
+		if err != nil {
+			return err
+		}
+		if err := tx.Bucket(eventBucket).Put(encodeInt64(wt.rev), data); err != nil {
+			return err
+		}
+		if compactRev := wt.rev - s.historyRevisions; compactRev > s.compactRev {
+			return compactHistory(tx, compactRev)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(wt.events) > 0 {
+		atomic.StoreInt64(&s.rev, wt.rev)
+		if compactRev := wt.rev - s.historyRevisions; compactRev > s.compactRev {
+			atomic.StoreInt64(&s.compactRev, compactRev)
+		}
+		for _, ev := range wt.events {
+			if ev.PrevKv != nil && ev.PrevKv.Lease != 0 {
+				s.leases.detach(ev.PrevKv.Lease, string(ev.PrevKv.Key))
+			}
+			if ev.Type == mvccpb.PUT && ev.Kv.Lease != 0 {
+				s.leases.attach(ev.Kv.Lease, string(ev.Kv.Key))
+			}
+		}
+		s.notify(wt.rev, wt.events)
+	}
+	for _, id := range wt.revokedLeases {
+		s.leases.revoke(id)
+	}
+	return nil
+}
+
+func (wt *writeTxn) get(key []byte) (*mvccpb.KeyValue, error) {
+	data := wt.kv.Get(key)
+	if data == nil {
+		return nil, nil
+	}
+	return decodeKeyValue(data)
+}
+
+func (wt *writeTxn) put(r *pb.PutRequest) (*pb.PutResponse, error) {
+	if len(r.Key) == 0 {
+		return nil, rpctypes.ErrGRPCEmptyKey
+	}
+	prev, err := wt.get(r.Key)
+	if err != nil {
+		return nil, err
+	}
+	if (r.IgnoreValue || r.IgnoreLease) && prev == nil {
+		return nil, rpctypes.ErrGRPCKeyNotFound
+	}
+	value, lease := r.Value, r.Lease
+	if r.IgnoreValue {
+		value = prev.Value
+	}
+	if r.IgnoreLease {
+		lease = prev.Lease
+	} else if lease != 0 && !wt.s.leases.exists(lease) {
+		return nil, rpctypes.ErrGRPCLeaseNotFound
+	}
+
+	kv := &mvccpb.KeyValue{
+		Key:            r.Key,
+		Value:          value,
+		Lease:          lease,
+		CreateRevision: wt.rev,
+		ModRevision:    wt.rev,
+		Version:        1,
+	}
+	if prev != nil {
+		kv.CreateRevision = prev.CreateRevision
+		kv.Version = prev.Version + 1
+	}
+	data, err := kv.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	if err := wt.kv.Put(kv.Key, data); err != nil {
+		return nil, err
+	}
+	wt.events = append(wt.events, &mvccpb.Event{Type: mvccpb.PUT, Kv: kv, PrevKv: prev})
+
+	resp := &pb.PutResponse{Header: wt.header}
+	if r.PrevKv {
+		resp.PrevKv = prev
+	}
+	return resp, nil
+}
+
+func (wt *writeTxn) deleteRange(r *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error) {
+	if len(r.Key) == 0 {
+		return nil, rpctypes.ErrGRPCEmptyKey
+	}
+	kvs, err := scan(wt.tx, r.Key, r.RangeEnd)
+	if err != nil {
+		return nil, err
+	}
+	for _, kv := range kvs {
+		if err := wt.kv.Delete(kv.Key); err != nil {
+			return nil, err
+		}
+		wt.events = append(wt.events, &mvccpb.Event{
+			Type:   mvccpb.DELETE,
+			Kv:     &mvccpb.KeyValue{Key: kv.Key, ModRevision: wt.rev},
+			PrevKv: kv,
+		})
+	}
+
+	resp := &pb.DeleteRangeResponse{Header: wt.header, Deleted: int64(len(kvs))}
+	if r.PrevKv {
+		resp.PrevKvs = kvs
+	}
+	return resp, nil
+}
+
+func (wt *writeTxn) txn(r *pb.TxnRequest) (*pb.TxnResponse, error) {
+	succeeded := true
+	for _, c := range r.Compare {
+		ok, err := wt.compare(c)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			succeeded = false
+			break
+		}
+	}
+	ops := r.Success
+	if !succeeded {
+		ops = r.Failure
+	}
+
+	resp := &pb.TxnResponse{
+		Header:    wt.header,
+		Succeeded: succeeded,
+		Responses: make([]*pb.ResponseOp, 0, len(ops)),
+	}
+	for _, op := range ops {
+		var respOp *pb.ResponseOp
+		switch req := op.Request.(type) {
+		case *pb.RequestOp_RequestRange:
+			rr, err := rangeKeys(wt.tx, req.RequestRange, wt.rev-1, wt.s.compactRev)
+			if err != nil {
+				return nil, err
+			}
+			rr.Header = wt.header
+			respOp = &pb.ResponseOp{Response: &pb.ResponseOp_ResponseRange{ResponseRange: rr}}
+		case *pb.RequestOp_RequestPut:
+			pr, err := wt.put(req.RequestPut)
+			if err != nil {
+				return nil, err
+			}
+			respOp = &pb.ResponseOp{Response: &pb.ResponseOp_ResponsePut{ResponsePut: pr}}
+		case *pb.RequestOp_RequestDeleteRange:
+			dr, err := wt.deleteRange(req.RequestDeleteRange)
+			if err != nil {
+				return nil, err
+			}
+			respOp = &pb.ResponseOp{Response: &pb.ResponseOp_ResponseDeleteRange{ResponseDeleteRange: dr}}
+		case *pb.RequestOp_RequestTxn:
+			tr, err := wt.txn(req.RequestTxn)
+			if err != nil {
+				return nil, err
+			}
+			respOp = &pb.ResponseOp{Response: &pb.ResponseOp_ResponseTxn{ResponseTxn: tr}}
+		default:
+			return nil, rpctypes.ErrGRPCNotCapable
+		}
+		resp.Responses = append(resp.Responses, respOp)
+	}
+	return resp, nil
+}
+
+// compare evaluates c against every key in its range, like etcd a value comparison
+// on a missing key is always false while the other targets compare against zero values.
+func (wt *writeTxn) compare(c *pb.Compare) (bool, error) {
+	kvs, err := scan(wt.tx, c.Key, c.RangeEnd)
+	if err != nil {
+		return false, err
+	}
+	if len(kvs) == 0 {
+		if c.Target == pb.Compare_VALUE {
+			return false, nil
+		}
+		return compareKV(c, &mvccpb.KeyValue{}), nil
+	}
+	for _, kv := range kvs {
+		if !compareKV(c, kv) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func compareKV(c *pb.Compare, kv *mvccpb.KeyValue) bool {
+	var result int
+	switch c.Target {
+	case pb.Compare_VALUE:
+		result = bytes.Compare(kv.Value, c.GetValue())
+	case pb.Compare_VERSION:
+		result = compareInt64(kv.Version, c.GetVersion())
+	case pb.Compare_CREATE:
+		result = compareInt64(kv.CreateRevision, c.GetCreateRevision())
+	case pb.Compare_MOD:
+		result = compareInt64(kv.ModRevision, c.GetModRevision())
+	case pb.Compare_LEASE:
+		result = compareInt64(kv.Lease, c.GetLease())
+	}
+	switch c.Result {
+	case pb.Compare_EQUAL:
+		return result == 0
+	case pb.Compare_NOT_EQUAL:
+		return result != 0
+	case pb.Compare_GREATER:
+		return result > 0
+	case pb.Compare_LESS:
+		return result < 0
+	}
+	return false
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// rangeKeys serves a range request at revision rev, older revisions are rebuilt by
+// undoing the retained events. The returned response has no header.
+func rangeKeys(tx *bolt.Tx, r *pb.RangeRequest, rev, compactRev int64) (*pb.RangeResponse, error) {
+	if r.Revision > rev {
+		return nil, rpctypes.ErrGRPCFutureRev
+	}
+	if r.Revision > 0 && r.Revision < compactRev {
+		return nil, rpctypes.ErrGRPCCompacted
+	}
+	kvs, err := scan(tx, r.Key, r.RangeEnd)
+	if err != nil {
+		return nil, err
+	}
+	if r.Revision > 0 && r.Revision < rev {
+		kvs, err = rewind(tx, kvs, r.Key, r.RangeEnd, r.Revision)
+		if err != nil {
+			return nil, err
+		}
+	}
+	kvs = filterKeyValues(r, kvs)
+	sortKeyValues(r, kvs)
+
+	resp := &pb.RangeResponse{Count: int64(len(kvs))}
+	if r.Limit > 0 && int64(len(kvs)) > r.Limit {
+		kvs = kvs[:r.Limit]
+		resp.More = true
+	}
+	if r.CountOnly {
+		return resp, nil
+	}
+	if r.KeysOnly {
+		for i, kv := range kvs {
+			stripped := *kv
+			stripped.Value = nil
+			kvs[i] = &stripped
+		}
+	}
+	resp.Kvs = kvs
+	return resp, nil
+}
+
+// scan returns the current key values in [key, end) ordered by key.
+func scan(tx *bolt.Tx, key, end []byte) ([]*mvccpb.KeyValue, error) {
+	b := tx.Bucket(kvBucket)
+	if len(end) == 0 {
+		data := b.Get(key)
+		if data == nil {
+			return nil, nil
+		}
+		kv, err := decodeKeyValue(data)
+		if err != nil {
+			return nil, err
+		}
+		return []*mvccpb.KeyValue{kv}, nil
+	}
+
+	var kvs []*mvccpb.KeyValue
+	c := b.Cursor()
+	for k, v := c.Seek(key); k != nil && inRange(k, key, end); k, v = c.Next() {
+		kv, err := decodeKeyValue(v)
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, kv)
+	}
+	return kvs, nil
+}
+
+// rewind rolls kvs in [key, end) back to revision rev with the events after it.
+func rewind(tx *bolt.Tx, kvs []*mvccpb.KeyValue, key, end []byte, rev int64) ([]*mvccpb.KeyValue, error) {
+	state := make(map[string]*mvccpb.KeyValue, len(kvs))
+	for _, kv := range kvs {
+		state[string(kv.Key)] = kv
+	}
+	seen := make(map[string]struct{})
+	c := tx.Bucket(eventBucket).Cursor()
+	for k, v := c.Seek(encodeInt64(rev + 1)); k != nil; k, v = c.Next() {
+		events, err := decodeEvents(v)
+		if err != nil {
+			return nil, err
+		}
+		for _, ev := range events {
+			name := string(ev.Kv.Key)
+			if _, ok := seen[name]; ok || !inRange(ev.Kv.Key, key, end) {
+				continue
+			}
+			seen[name] = struct{}{}
+			if ev.PrevKv == nil {
+				delete(state, name)
+			} else {
+				state[name] = ev.PrevKv
+			}
+		}
+	}
+
+	ret := make([]*mvccpb.KeyValue, 0, len(state))
+	for _, kv := range state {
+		ret = append(ret, kv)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return bytes.Compare(ret[i].Key, ret[j].Key) < 0
+	})
+	return ret, nil
+}
+
+func filterKeyValues(r *pb.RangeRequest, kvs []*mvccpb.KeyValue) []*mvccpb.KeyValue {
+	if r.MinModRevision == 0 && r.MaxModRevision == 0 && r.MinCreateRevision == 0 && r.MaxCreateRevision == 0 {
+		return kvs
+	}
+	ret := kvs[:0]
+	for _, kv := range kvs {
+		if r.MinModRevision > 0 && kv.ModRevision < r.MinModRevision ||
+			r.MaxModRevision > 0 && kv.ModRevision > r.MaxModRevision ||
+			r.MinCreateRevision > 0 && kv.CreateRevision < r.MinCreateRevision ||
+			r.MaxCreateRevision > 0 && kv.CreateRevision > r.MaxCreateRevision {
+			continue
+		}
+		ret = append(ret, kv)
+	}
+	return ret
+}
+
+func sortKeyValues(r *pb.RangeRequest, kvs []*mvccpb.KeyValue) {
+	order := r.SortOrder
+	if order == pb.RangeRequest_NONE {
+		if r.SortTarget == pb.RangeRequest_KEY {
+			// already ordered by key
+			return
+		}
+		order = pb.RangeRequest_ASCEND
+	}
+	var less func(a, b *mvccpb.KeyValue) bool
+	switch r.SortTarget {
+	case pb.RangeRequest_VERSION:
+		less = func(a, b *mvccpb.KeyValue) bool { return a.Version < b.Version }
+	case pb.RangeRequest_CREATE:
+		less = func(a, b *mvccpb.KeyValue) bool { return a.CreateRevision < b.CreateRevision }
+	case pb.RangeRequest_MOD:
+		less = func(a, b *mvccpb.KeyValue) bool { return a.ModRevision < b.ModRevision }
+	case pb.RangeRequest_VALUE:
+		less = func(a, b *mvccpb.KeyValue) bool { return bytes.Compare(a.Value, b.Value) < 0 }
+	default:
+		less = func(a, b *mvccpb.KeyValue) bool { return bytes.Compare(a.Key, b.Key) < 0 }
+	}
+	if order == pb.RangeRequest_DESCEND {
+		sort.SliceStable(kvs, func(i, j int) bool { return less(kvs[j], kvs[i]) })
+		return
+	}
+	sort.SliceStable(kvs, func(i, j int) bool { return less(kvs[i], kvs[j]) })
+}
+
+// compactHistory drops the events up to and including rev.
+func compactHistory(tx *bolt.Tx, rev int64) error {
+	c := tx.Bucket(eventBucket).Cursor()
+	for k, _ := c.First(); k != nil && decodeInt64(k) <= rev; k, _ = c.Next() {
+		if err := c.Delete(); err != nil {
+			return err
+		}
+	}
+	return tx.Bucket(metaBucket).Put(compactRevisionKey, encodeInt64(rev))
+}
+
+// inRange reports whether key is in [start, end), an empty end matches start only and
+// "\x00" as end matches every key from start on.
+func inRange(key, start, end []byte) bool {
+	if len(end) == 0 {
+		return bytes.Equal(key, start)
+	}
+	if bytes.Compare(key, start) < 0 {
+		return false
+	}
+	return (len(end) == 1 && end[0] == 0) || bytes.Compare(key, end) < 0
+}
+
+func encodeInt64(v int64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, uint64(v))
+	return buf
+}
+
+func decodeInt64(buf []byte) int64 {
+	if len(buf) != 8 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(buf))
+}
+
+func decodeKeyValue(data []byte) (*mvccpb.KeyValue, error) {
+	kv := &mvccpb.KeyValue{}
+	if err := kv.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return kv, nil
+}
+
+func decodeEvents(data []byte) ([]*mvccpb.Event, error) {
+	resp := &pb.WatchResponse{}
+	if err := resp.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return resp.Events, nil
+}
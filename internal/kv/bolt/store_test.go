@@ -0,0 +1,354 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package boltkv
+
+import (
+	"context"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func openTestStore(t *testing.T, opts ...Option) (*Store, *clientv3.Client) {
+	s, err := Open(path.Join(t.TempDir(), "meta.db"), opts...)
+	require.NoError(t, err)
+	cli := NewClient(s)
+	t.Cleanup(func() {
+		cli.Close()
+		s.Close()
+	})
+	return s, cli
+}
+
+func TestStore_KV(t *testing.T) {
+	_, cli := openTestStore(t)
+	ctx := context.Background()
+
+	putResp, err := cli.Put(ctx, "a/1", "v1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), putResp.Header.Revision)
+	_, err = cli.Put(ctx, "a/2", "v2")
+	require.NoError(t, err)
+	_, err = cli.Put(ctx, "b/1", "v3")
+	require.NoError(t, err)
+	putResp, err = cli.Put(ctx, "a/1", "v4", clientv3.WithPrevKV())
+	require.NoError(t, err)
+	assert.Equal(t, "v1", string(putResp.PrevKv.Value))
+
+	resp, err := cli.Get(ctx, "a/1")
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 1)
+	assert.Equal(t, "v4", string(resp.Kvs[0].Value))
+	assert.Equal(t, int64(2), resp.Kvs[0].Version)
+	assert.Equal(t, int64(1), resp.Kvs[0].CreateRevision)
+	assert.Equal(t, int64(4), resp.Kvs[0].ModRevision)
+	assert.Equal(t, int64(4), resp.Header.Revision)
+
+	resp, err = cli.Get(ctx, "a/", clientv3.WithPrefix())
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 2)
+	assert.Equal(t, "a/1", string(resp.Kvs[0].Key))
+	assert.Equal(t, "a/2", string(resp.Kvs[1].Key))
+
+	resp, err = cli.Get(ctx, "a/", clientv3.WithPrefix(), clientv3.WithLimit(1),
+		clientv3.WithSort(clientv3.SortByKey, clientv3.SortDescend))
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 1)
+	assert.Equal(t, "a/2", string(resp.Kvs[0].Key))
+	assert.True(t, resp.More)
+	assert.Equal(t, int64(2), resp.Count)
+
+	resp, err = cli.Get(ctx, "a/", clientv3.WithFromKey(), clientv3.WithKeysOnly())
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 3)
+	assert.Empty(t, resp.Kvs[0].Value)
+
+	resp, err = cli.Get(ctx, "", clientv3.WithPrefix(), clientv3.WithCountOnly())
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), resp.Count)
+	assert.Empty(t, resp.Kvs)
+
+	// historical reads are rebuilt from the retained events
+	resp, err = cli.Get(ctx, "a/", clientv3.WithPrefix(), clientv3.WithRev(1))
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 1)
+	assert.Equal(t, "v1", string(resp.Kvs[0].Value))
+	_, err = cli.Get(ctx, "a/1", clientv3.WithRev(100))
+	assert.Equal(t, rpctypes.ErrFutureRev, err)
+
+	delResp, err := cli.Delete(ctx, "a/", clientv3.WithPrefix(), clientv3.WithPrevKV())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), delResp.Deleted)
+	assert.Len(t, delResp.PrevKvs, 2)
+	assert.Equal(t, int64(5), delResp.Header.Revision)
+
+	// deleting nothing does not bump the revision
+	delResp, err = cli.Delete(ctx, "a/", clientv3.WithPrefix())
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), delResp.Deleted)
+	assert.Equal(t, int64(5), delResp.Header.Revision)
+
+	resp, err = cli.Get(ctx, "a/1", clientv3.WithRev(4))
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 1)
+	assert.Equal(t, "v4", string(resp.Kvs[0].Value))
+
+	_, err = cli.Put(ctx, "", "v")
+	assert.Equal(t, rpctypes.ErrEmptyKey, err)
+	_, err = cli.Put(ctx, "missing", "", clientv3.WithIgnoreValue())
+	assert.Equal(t, rpctypes.ErrKeyNotFound, err)
+}
+
+func TestStore_Txn(t *testing.T) {
+	_, cli := openTestStore(t)
+	ctx := context.Background()
+
+	// create if absent
+	txnResp, err := cli.Txn(ctx).
+		If(clientv3.Compare(clientv3.Version("k"), "=", 0)).
+		Then(clientv3.OpPut("k", "v1"), clientv3.OpPut("k2", "v2")).
+		Commit()
+	require.NoError(t, err)
+	assert.True(t, txnResp.Succeeded)
+	assert.Equal(t, int64(1), txnResp.Header.Revision)
+
+	txnResp, err = cli.Txn(ctx).
+		If(clientv3.Compare(clientv3.Version("k"), "=", 0)).
+		Then(clientv3.OpPut("k", "v2")).
+		Else(clientv3.OpGet("k")).
+		Commit()
+	require.NoError(t, err)
+	assert.False(t, txnResp.Succeeded)
+	assert.Equal(t, int64(1), txnResp.Header.Revision)
+	kvs := txnResp.Responses[0].GetResponseRange().Kvs
+	require.Len(t, kvs, 1)
+	assert.Equal(t, "v1", string(kvs[0].Value))
+
+	// compare and swap on value
+	txnResp, err = cli.Txn(ctx).
+		If(clientv3.Compare(clientv3.Value("k"), "=", "v1")).
+		Then(clientv3.OpPut("k", "v3"), clientv3.OpDelete("k2")).
+		Commit()
+	require.NoError(t, err)
+	assert.True(t, txnResp.Succeeded)
+	assert.Equal(t, int64(2), txnResp.Header.Revision)
+
+	// a value compare on a missing key fails
+	txnResp, err = cli.Txn(ctx).
+		If(clientv3.Compare(clientv3.Value("k2"), "!=", "v2")).
+		Then(clientv3.OpPut("k2", "v4")).
+		Commit()
+	require.NoError(t, err)
+	assert.False(t, txnResp.Succeeded)
+
+	txnResp, err = cli.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision("k"), "<", 3),
+			clientv3.Compare(clientv3.CreateRevision("k"), "=", 1)).
+		Then(clientv3.OpPut("k", "v5")).
+		Commit()
+	require.NoError(t, err)
+	assert.True(t, txnResp.Succeeded)
+
+	resp, err := cli.Get(ctx, "k")
+	require.NoError(t, err)
+	assert.Equal(t, "v5", string(resp.Kvs[0].Value))
+	assert.Equal(t, int64(3), resp.Kvs[0].Version)
+}
+
+func TestStore_Lease(t *testing.T) {
+	s, cli := openTestStore(t)
+	ctx := context.Background()
+
+	_, err := cli.Put(ctx, "k", "v", clientv3.WithLease(clientv3.LeaseID(100)))
+	assert.Equal(t, rpctypes.ErrLeaseNotFound, err)
+
+	grant, err := cli.Grant(ctx, 1)
+	require.NoError(t, err)
+	_, err = cli.Put(ctx, "short", "v", clientv3.WithLease(grant.ID))
+	require.NoError(t, err)
+
+	kept, err := cli.Grant(ctx, 1)
+	require.NoError(t, err)
+	_, err = cli.Put(ctx, "kept", "v", clientv3.WithLease(kept.ID))
+	require.NoError(t, err)
+	keepCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ch, err := cli.KeepAlive(keepCtx, kept.ID)
+	require.NoError(t, err)
+	<-ch
+
+	ttl, err := cli.TimeToLive(ctx, kept.ID, clientv3.WithAttachedKeys())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), ttl.GrantedTTL)
+	require.Len(t, ttl.Keys, 1)
+	assert.Equal(t, "kept", string(ttl.Keys[0]))
+
+	assert.Eventually(t, func() bool {
+		resp, err := cli.Get(ctx, "short")
+		return err == nil && len(resp.Kvs) == 0
+	}, 5*time.Second, 100*time.Millisecond)
+	assert.False(t, s.leases.exists(int64(grant.ID)))
+
+	resp, err := cli.Get(ctx, "kept")
+	require.NoError(t, err)
+	assert.Len(t, resp.Kvs, 1)
+
+	_, err = cli.Revoke(ctx, kept.ID)
+	require.NoError(t, err)
+	resp, err = cli.Get(ctx, "kept")
+	require.NoError(t, err)
+	assert.Len(t, resp.Kvs, 0)
+	_, err = cli.Revoke(ctx, kept.ID)
+	assert.Equal(t, rpctypes.ErrLeaseNotFound, err)
+
+	leases, err := cli.Leases(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, leases.Leases)
+}
+
+func TestStore_Watch(t *testing.T) {
+	_, cli := openTestStore(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, err := cli.Put(ctx, "w/1", "v1")
+	require.NoError(t, err)
+
+	ch := cli.Watch(ctx, "w/", clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithCreatedNotify())
+	created := <-ch
+	assert.True(t, created.Created)
+
+	_, err = cli.Put(ctx, "w/1", "v2")
+	require.NoError(t, err)
+	_, err = cli.Put(ctx, "x/1", "v")
+	require.NoError(t, err)
+	_, err = cli.Delete(ctx, "w/1")
+	require.NoError(t, err)
+
+	resp := <-ch
+	require.Len(t, resp.Events, 1)
+	assert.Equal(t, mvccpb.PUT, resp.Events[0].Type)
+	assert.Equal(t, "v2", string(resp.Events[0].Kv.Value))
+	assert.Equal(t, "v1", string(resp.Events[0].PrevKv.Value))
+	resp = <-ch
+	require.Len(t, resp.Events, 1)
+	assert.Equal(t, mvccpb.DELETE, resp.Events[0].Type)
+	assert.Equal(t, int64(4), resp.Events[0].Kv.ModRevision)
+
+	// watching from a past revision replays the history first
+	ch = cli.Watch(ctx, "w/1", clientv3.WithRev(1))
+	resp = <-ch
+	require.Len(t, resp.Events, 1)
+	assert.Equal(t, "v1", string(resp.Events[0].Kv.Value))
+	assert.Nil(t, resp.Events[0].PrevKv)
+	resp = <-ch
+	assert.Equal(t, "v2", string(resp.Events[0].Kv.Value))
+	resp = <-ch
+	assert.Equal(t, mvccpb.DELETE, resp.Events[0].Type)
+
+	_, err = cli.Put(ctx, "w/1", "v3")
+	require.NoError(t, err)
+	resp = <-ch
+	assert.Equal(t, "v3", string(resp.Events[0].Kv.Value))
+}
+
+func TestStore_Compaction(t *testing.T) {
+	_, cli := openTestStore(t, WithHistoryRevisions(2))
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		_, err := cli.Put(ctx, "k", "v")
+		require.NoError(t, err)
+	}
+	_, err := cli.Get(ctx, "k", clientv3.WithRev(1))
+	assert.Equal(t, rpctypes.ErrCompacted, err)
+	resp, err := cli.Get(ctx, "k", clientv3.WithRev(3))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), resp.Kvs[0].ModRevision)
+
+	wctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	wresp := <-cli.Watch(wctx, "k", clientv3.WithRev(2))
+	assert.Equal(t, int64(3), wresp.CompactRevision)
+	assert.Equal(t, rpctypes.ErrCompacted, wresp.Err())
+
+	_, err = cli.Compact(ctx, 4)
+	require.NoError(t, err)
+	_, err = cli.Get(ctx, "k", clientv3.WithRev(3))
+	assert.Equal(t, rpctypes.ErrCompacted, err)
+}
+
+func TestStore_Restart(t *testing.T) {
+	file := path.Join(t.TempDir(), "meta.db")
+	s, err := Open(file)
+	require.NoError(t, err)
+	cli := NewClient(s)
+	ctx := context.Background()
+
+	grant, err := cli.Grant(ctx, 60)
+	require.NoError(t, err)
+	_, err = cli.Put(ctx, "k", "v1")
+	require.NoError(t, err)
+	_, err = cli.Put(ctx, "leased", "v", clientv3.WithLease(grant.ID))
+	require.NoError(t, err)
+	cli.Close()
+	require.NoError(t, s.Close())
+
+	s, err = Open(file)
+	require.NoError(t, err)
+	defer s.Close()
+	cli = NewClient(s)
+	defer cli.Close()
+
+	assert.Equal(t, int64(2), s.Revision())
+	resp, err := cli.Get(ctx, "k")
+	require.NoError(t, err)
+	assert.Equal(t, "v1", string(resp.Kvs[0].Value))
+
+	// leases survive the restart and still own their keys
+	_, err = cli.Revoke(ctx, grant.ID)
+	require.NoError(t, err)
+	resp, err = cli.Get(ctx, "leased")
+	require.NoError(t, err)
+	assert.Empty(t, resp.Kvs)
+}
+
+func TestOpenShared(t *testing.T) {
+	file := path.Join(t.TempDir(), "meta.db")
+	s1, err := OpenShared(file)
+	require.NoError(t, err)
+	s2, err := OpenShared(file)
+	require.NoError(t, err)
+	assert.Same(t, s1, s2)
+
+	require.NoError(t, s1.Close())
+	_, err = NewClient(s2).Put(context.Background(), "k", "v")
+	assert.NoError(t, err)
+	require.NoError(t, s2.Close())
+
+	s3, err := OpenShared(file)
+	require.NoError(t, err)
+	assert.NotSame(t, s1, s3)
+	assert.Equal(t, int64(1), s3.Revision())
+	require.NoError(t, s3.Close())
+}
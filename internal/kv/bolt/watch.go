@@ -0,0 +1,257 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package boltkv
+
+import (
+	"io"
+	"sync"
+
+	bolt "go.etcd.io/bbolt"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+// watcher is a single watch of a key range on a watch stream.
+type watcher struct {
+	id           int64
+	key, end     []byte
+	prevKv       bool
+	filterPut    bool
+	filterDelete bool
+	stream       *watchStream
+}
+
+// filter returns the events the watcher is interested in.
+func (w *watcher) filter(events []*mvccpb.Event) []*mvccpb.Event {
+	var ret []*mvccpb.Event
+	for _, ev := range events {
+		if !inRange(ev.Kv.Key, w.key, w.end) ||
+			(w.filterPut && ev.Type == mvccpb.PUT) ||
+			(w.filterDelete && ev.Type == mvccpb.DELETE) {
+			continue
+		}
+		if !w.prevKv {
+			ev = &mvccpb.Event{Type: ev.Type, Kv: ev.Kv}
+		}
+		ret = append(ret, ev)
+	}
+	return ret
+}
+
+func (w *watcher) send(rev int64, events []*mvccpb.Event) {
+	if events = w.filter(events); len(events) > 0 {
+		w.stream.enqueue(&pb.WatchResponse{
+			Header:  &pb.ResponseHeader{Revision: rev},
+			WatchId: w.id,
+			Events:  events,
+		})
+	}
+}
+
+// notify dispatches the events committed at rev, the caller must hold s.mu.
+func (s *Store) notify(rev int64, events []*mvccpb.Event) {
+	for w := range s.watchers {
+		w.send(rev, events)
+	}
+}
+
+// watchStream serves one etcd watch stream, responses are queued without bound so that
+// slow consumers never block writers.
+type watchStream struct {
+	store  *Store
+	stream pb.Watch_WatchServer
+
+	mu       sync.Mutex
+	nextID   int64
+	watchers map[int64]*watcher
+	pending  []*pb.WatchResponse
+	notifyCh chan struct{}
+}
+
+// Watch implements etcdserverpb.WatchServer.
+func (s *Store) Watch(stream pb.Watch_WatchServer) error {
+	ws := &watchStream{
+		store:    s,
+		stream:   stream,
+		watchers: make(map[int64]*watcher),
+		notifyCh: make(chan struct{}, 1),
+	}
+	defer ws.close()
+
+	recvErr := make(chan error, 1)
+	go func() {
+		recvErr <- ws.recvLoop()
+	}()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-s.closed:
+			return ErrStoreClosed
+		case err := <-recvErr:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		case <-ws.notifyCh:
+			for _, resp := range ws.drain() {
+				if err := stream.Send(resp); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func (ws *watchStream) recvLoop() error {
+	for {
+		req, err := ws.stream.Recv()
+		if err != nil {
+			return err
+		}
+		switch r := req.RequestUnion.(type) {
+		case *pb.WatchRequest_CreateRequest:
+			ws.create(r.CreateRequest)
+		case *pb.WatchRequest_CancelRequest:
+			ws.cancel(r.CancelRequest.WatchId)
+		case *pb.WatchRequest_ProgressRequest:
+			ws.enqueue(&pb.WatchResponse{Header: ws.store.header(), WatchId: -1})
+		}
+	}
+}
+
+// create registers a watcher, the events since the requested start revision are
+// replayed from the history before any live event is delivered.
+func (ws *watchStream) create(r *pb.WatchCreateRequest) {
+	s := ws.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws.mu.Lock()
+	id := r.WatchId
+	if id == 0 {
+		for {
+			if _, ok := ws.watchers[ws.nextID]; !ok {
+				break
+			}
+			ws.nextID++
+		}
+		id = ws.nextID
+		ws.nextID++
+	}
+	_, exists := ws.watchers[id]
+	ws.mu.Unlock()
+
+	header := s.header()
+	if exists {
+		ws.enqueue(&pb.WatchResponse{Header: header, WatchId: id, Created: true, Canceled: true,
+			CancelReason: "watcher id already exists"})
+		return
+	}
+
+	w := &watcher{
+		id:     id,
+		key:    r.Key,
+		end:    r.RangeEnd,
+		prevKv: r.PrevKv,
+		stream: ws,
+	}
+	for _, f := range r.Filters {
+		switch f {
+		case pb.WatchCreateRequest_NOPUT:
+			w.filterPut = true
+		case pb.WatchCreateRequest_NODELETE:
+			w.filterDelete = true
+		}
+	}
+	ws.enqueue(&pb.WatchResponse{Header: header, WatchId: id, Created: true})
+
+	if r.StartRevision > 0 && r.StartRevision <= s.compactRev {
+		ws.enqueue(&pb.WatchResponse{Header: header, WatchId: id, Canceled: true, CompactRevision: s.compactRev})
+		return
+	}
+	if r.StartRevision > 0 && r.StartRevision <= s.rev {
+		err := s.db.View(func(tx *bolt.Tx) error {
+			c := tx.Bucket(eventBucket).Cursor()
+			for k, v := c.Seek(encodeInt64(r.StartRevision)); k != nil; k, v = c.Next() {
+				events, err := decodeEvents(v)
+				if err != nil {
+					return err
+				}
+				w.send(decodeInt64(k), events)
+			}
+			return nil
+		})
+		if err != nil {
+			ws.enqueue(&pb.WatchResponse{Header: header, WatchId: id, Canceled: true, CancelReason: err.Error()})
+			return
+		}
+	}
+
+	ws.mu.Lock()
+	ws.watchers[id] = w
+	ws.mu.Unlock()
+	s.watchers[w] = struct{}{}
+}
+
+func (ws *watchStream) cancel(id int64) {
+	s := ws.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws.mu.Lock()
+	w, ok := ws.watchers[id]
+	delete(ws.watchers, id)
+	ws.mu.Unlock()
+	if !ok {
+		return
+	}
+	delete(s.watchers, w)
+	ws.enqueue(&pb.WatchResponse{Header: s.header(), WatchId: id, Canceled: true})
+}
+
+// close unregisters every watcher of the stream.
+func (ws *watchStream) close() {
+	s := ws.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	for id, w := range ws.watchers {
+		delete(s.watchers, w)
+		delete(ws.watchers, id)
+	}
+}
+
+func (ws *watchStream) enqueue(resp *pb.WatchResponse) {
+	ws.mu.Lock()
+	ws.pending = append(ws.pending, resp)
+	ws.mu.Unlock()
+	select {
+	case ws.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+func (ws *watchStream) drain() []*pb.WatchResponse {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	pending := ws.pending
+	ws.pending = nil
+	return pending
+}
@@ -85,6 +85,41 @@ func (kv *EtcdKV) LoadWithPrefix(key string) ([]string, []string, error) {
 	return keys, values, nil
 }
 
+// WalkKeysWithPrefix calls fn with the keys with the given key prefix, at most paginationSize keys at a time.
+// Only the keys are fetched, each page is a separate request.
+func (kv *EtcdKV) WalkKeysWithPrefix(prefix string, paginationSize int, fn func(keys []string) error) error {
+	start := time.Now()
+	prefix = path.Join(kv.rootPath, prefix)
+	end := clientv3.GetPrefixRangeEnd(prefix)
+	key := prefix
+	for {
+		ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
+		resp, err := kv.client.Get(ctx, key, clientv3.WithRange(end), clientv3.WithKeysOnly(),
+			clientv3.WithLimit(int64(paginationSize)), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+		cancel()
+		if err != nil {
+			return err
+		}
+		if len(resp.Kvs) == 0 {
+			break
+		}
+		keys := make([]string, 0, len(resp.Kvs))
+		for _, kv := range resp.Kvs {
+			keys = append(keys, string(kv.Key))
+		}
+		if err := fn(keys); err != nil {
+			return err
+		}
+		if !resp.More {
+			break
+		}
+		// continue right after the last key of the page
+		key = keys[len(keys)-1] + "\x00"
+	}
+	CheckElapseAndWarn(start, "Slow etcd operation walk keys with prefix", zap.String("prefix", prefix))
+	return nil
+}
+
 // LoadBytesWithPrefix returns all the keys and values with the given key prefix.
 func (kv *EtcdKV) LoadBytesWithPrefix(key string) ([]string, [][]byte, error) {
 	start := time.Now()
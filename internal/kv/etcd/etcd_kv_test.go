@@ -17,6 +17,7 @@
 package etcdkv_test
 
 import (
+	"errors"
 	"os"
 	"testing"
 	"time"
@@ -874,6 +875,46 @@ func TestEtcdKV_Load(te *testing.T) {
 		}
 	})
 
+	te.Run("EtcdKV WalkKeysWithPrefix", func(t *testing.T) {
+		rootPath := "/etcd/test/root/walk_keys"
+		etcdKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+		err = etcdKV.RemoveWithPrefix("")
+		require.NoError(t, err)
+
+		defer etcdKV.Close()
+		defer etcdKV.RemoveWithPrefix("")
+
+		for _, key := range []string{"abc", "abcd", "abce", "abd", "abe/f", "b"} {
+			err = etcdKV.Save(key, "value")
+			require.NoError(t, err)
+		}
+
+		var pages [][]string
+		err = etcdKV.WalkKeysWithPrefix("ab", 2, func(keys []string) error {
+			pages = append(pages, keys)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{
+			{etcdKV.GetPath("abc"), etcdKV.GetPath("abcd")},
+			{etcdKV.GetPath("abce"), etcdKV.GetPath("abd")},
+			{etcdKV.GetPath("abe/f")},
+		}, pages)
+
+		pages = nil
+		err = etcdKV.WalkKeysWithPrefix("c", 2, func(keys []string) error {
+			pages = append(pages, keys)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, pages)
+
+		err = etcdKV.WalkKeysWithPrefix("", 2, func(keys []string) error {
+			return errors.New("mock")
+		})
+		assert.Error(t, err)
+	})
+
 	te.Run("Etcd Lease Bytes", func(t *testing.T) {
 		rootPath := "/etcd/test/root/lease_bytes"
 		etcdKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
@@ -18,7 +18,9 @@ package etcdkv
 
 import (
 	"github.com/milvus-io/milvus/internal/kv"
+	boltkv "github.com/milvus-io/milvus/internal/kv/bolt"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"go.etcd.io/etcd/server/v3/embed"
@@ -31,6 +33,13 @@ func NewMetaKvFactory(rootPath string, etcdCfg *paramtable.EtcdConfig) (kv.MetaK
 	log.Info("start etcd with rootPath",
 		zap.String("rootpath", rootPath),
 		zap.Bool("isEmbed", etcdCfg.UseEmbedEtcd.GetAsBool()))
+	if etcdCfg.UseEmbedEtcd.GetAsBool() && etcdCfg.EmbedEngine.GetValue() == util.EmbedEtcdEngineBolt {
+		s, err := boltkv.OpenShared(boltkv.FilePath(etcdCfg.DataDir.GetValue()))
+		if err != nil {
+			return nil, err
+		}
+		return NewEtcdKV(boltkv.NewClient(s), rootPath), nil
+	}
 	if etcdCfg.UseEmbedEtcd.GetAsBool() {
 		path := etcdCfg.ConfigPath.GetValue()
 		var cfg *embed.Config
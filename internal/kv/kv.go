@@ -59,17 +59,27 @@ type TxnKV interface {
 	MultiSaveAndRemoveWithPrefix(saves map[string]string, removals []string) error
 }
 
+// KeysWalker walks the keys with the given prefix page by page, without loading their values.
+type KeysWalker interface {
+	WalkKeysWithPrefix(prefix string, paginationSize int, fn func(keys []string) error) error
+}
+
+// WatchKV watches the changes of keys, it is implemented by every etcd compatible backend.
+type WatchKV interface {
+	Watch(key string) clientv3.WatchChan
+	WatchWithPrefix(key string) clientv3.WatchChan
+	WatchWithRevision(key string, revision int64) clientv3.WatchChan
+}
+
 // MetaKv is TxnKV for metadata. It should save data with lease.
 type MetaKv interface {
 	TxnKV
+	WatchKV
 	GetPath(key string) string
 	LoadWithPrefix(key string) ([]string, []string, error)
 	LoadWithPrefix2(key string) ([]string, []string, []int64, error)
 	LoadWithRevisionAndVersions(key string) ([]string, []string, []int64, int64, error)
 	LoadWithRevision(key string) ([]string, []string, int64, error)
-	Watch(key string) clientv3.WatchChan
-	WatchWithPrefix(key string) clientv3.WatchChan
-	WatchWithRevision(key string, revision int64) clientv3.WatchChan
 	SaveWithLease(key, value string, id clientv3.LeaseID) error
 	SaveWithIgnoreLease(key, value string) error
 	Grant(ttl int64) (id clientv3.LeaseID, err error)
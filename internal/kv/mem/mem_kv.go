@@ -260,6 +260,32 @@ func (kv *MemoryKV) LoadWithPrefix(key string) ([]string, []string, error) {
 	return keys, values, nil
 }
 
+// WalkKeysWithPrefix calls fn with the keys with given prefix, at most paginationSize keys at a time.
+func (kv *MemoryKV) WalkKeysWithPrefix(prefix string, paginationSize int, fn func(keys []string) error) error {
+	kv.RLock()
+	var keys []string
+	kv.tree.AscendGreaterOrEqual(memoryKVItem{key: prefix}, func(i btree.Item) bool {
+		if !strings.HasPrefix(i.(memoryKVItem).key, prefix) {
+			return false
+		}
+		keys = append(keys, i.(memoryKVItem).key)
+		return true
+	})
+	kv.RUnlock()
+
+	for len(keys) > 0 {
+		n := paginationSize
+		if n <= 0 || n > len(keys) {
+			n = len(keys)
+		}
+		if err := fn(keys[:n]); err != nil {
+			return err
+		}
+		keys = keys[n:]
+	}
+	return nil
+}
+
 // LoadBytesWithPrefix returns all keys & values with given prefix.
 func (kv *MemoryKV) LoadBytesWithPrefix(key string) ([]string, [][]byte, error) {
 	kv.Lock()
@@ -17,6 +17,7 @@
 package memkv
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -68,6 +69,35 @@ func TestMemoryKV_LoadBytesRange(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestMemoryKV_WalkKeysWithPrefix(t *testing.T) {
+	mem := NewMemoryKV()
+	for _, key := range []string{"test1", "test1/a", "test1/b", "test1/c", "test2"} {
+		err := mem.Save(key, "value")
+		assert.NoError(t, err)
+	}
+
+	var pages [][]string
+	err := mem.WalkKeysWithPrefix("test1", 2, func(keys []string) error {
+		pages = append(pages, append([]string{}, keys...))
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"test1", "test1/a"}, {"test1/b", "test1/c"}}, pages)
+
+	pages = nil
+	err = mem.WalkKeysWithPrefix("test3", 2, func(keys []string) error {
+		pages = append(pages, keys)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, pages)
+
+	err = mem.WalkKeysWithPrefix("test", 2, func(keys []string) error {
+		return errors.New("mock")
+	})
+	assert.Error(t, err)
+}
+
 func TestMemoryKV_LoadBytesWithDefault(t *testing.T) {
 	mem := NewMemoryKV()
 
@@ -21,3 +21,32 @@ const HealthzRouterPath = "/healthz"
 
 // LogLevelRouterPath is path for Get and Update log level at runtime.
 const LogLevelRouterPath = "/log/level"
+
+// ReplicationStatusRouterPath is path for getting the status of the replicated channels.
+const ReplicationStatusRouterPath = "/replication/status"
+
+// ReplicationPauseRouterPath is path for pausing the replication.
+const ReplicationPauseRouterPath = "/replication/pause"
+
+// ReplicationResumeRouterPath is path for resuming the paused replication.
+const ReplicationResumeRouterPath = "/replication/resume"
+
+// ReplicationFailoverRouterPath is path for failing over to the target cluster, `?force=true` skips draining.
+const ReplicationFailoverRouterPath = "/replication/failover"
+
+// DeadLetterRouterPath is path for listing the quarantined messages, `?channel=` filters by physical channel.
+const DeadLetterRouterPath = "/msgstream/dead-letters"
+
+// DeadLetterReplayRouterPath is path for replaying a quarantined message to the subscription which quarantined it,
+// by `?channel=`, `subscription=` and hex `msg_id=`.
+const DeadLetterReplayRouterPath = "/msgstream/dead-letters/replay"
+
+// SnapshotGCRouterPath is path for triggering the garbage collection of the rootcoord meta snapshots.
+const SnapshotGCRouterPath = "/rootcoord/snapshot/gc"
+
+// RocksmqStatsRouterPath is path for getting the usage of the rocksmq topics, `?topic=` gets a single topic.
+const RocksmqStatsRouterPath = "/rocksmq/stats"
+
+// RocksmqRetentionRouterPath is path for getting (GET), overriding (POST with a json policy) and removing the override (DELETE)
+// of the retention policy of the rocksmq topic `?topic=`.
+const RocksmqRetentionRouterPath = "/rocksmq/retention"
@@ -61,6 +61,13 @@ func Register(h *HTTPHandler) {
 	}
 }
 
+// WriteError writes the reason of a failed management request as plain text with the status code
+func WriteError(w http.ResponseWriter, code int, reason string) {
+	w.Header().Set(healthz.ContentTypeHeader, healthz.ContentTypeText)
+	w.WriteHeader(code)
+	w.Write([]byte(reason))
+}
+
 func ServeHTTP() {
 	registerDefaults()
 	go func() {
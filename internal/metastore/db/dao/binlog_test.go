@@ -0,0 +1,118 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBinlog_List(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `binlogs` WHERE tenant_id = ? ORDER BY id").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "field_id", "segment_id", "log_type", "log_id", "log_path"}).
+				AddRow(tenantID, fieldID1, segmentID1, 0, 1, "path1").
+				AddRow(tenantID, fieldID1, segmentID1, 0, 2, "path2"))
+
+	// actual
+	res, err := binlogTestDb.List(tenantID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(res))
+	assert.Equal(t, "path2", res[1].LogPath)
+}
+
+func TestBinlog_List_Error(t *testing.T) {
+	mock.ExpectQuery("SELECT * FROM `binlogs` WHERE tenant_id = ? ORDER BY id").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	res, err := binlogTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestBinlog_Insert(t *testing.T) {
+	var binlogs = []*dbmodel.Binlog{
+		{
+			TenantID:     tenantID,
+			FieldID:      fieldID1,
+			SegmentID:    segmentID1,
+			CollectionID: collID1,
+			PartitionID:  partitionID1,
+			LogID:        1,
+			NumEntries:   NumRows,
+			LogPath:      "path1",
+			LogSize:      1024,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+	b := binlogs[0]
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `binlogs` (`tenant_id`,`field_id`,`segment_id`,`collection_id`,`partition_id`,`log_type`,`log_id`,`num_entries`,`timestamp_from`,`timestamp_to`,`log_path`,`log_size`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?)").
+		WithArgs(b.TenantID, b.FieldID, b.SegmentID, b.CollectionID, b.PartitionID, b.LogType, b.LogID, b.NumEntries, b.TimestampFrom, b.TimestampTo, b.LogPath, b.LogSize, b.CreatedAt, b.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := binlogTestDb.Insert(binlogs)
+	assert.NoError(t, err)
+}
+
+func TestBinlog_Insert_Error(t *testing.T) {
+	var binlogs = []*dbmodel.Binlog{
+		{
+			TenantID:  tenantID,
+			FieldID:   fieldID1,
+			SegmentID: segmentID1,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	}
+	b := binlogs[0]
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `binlogs` (`tenant_id`,`field_id`,`segment_id`,`collection_id`,`partition_id`,`log_type`,`log_id`,`num_entries`,`timestamp_from`,`timestamp_to`,`log_path`,`log_size`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?)").
+		WithArgs(b.TenantID, b.FieldID, b.SegmentID, b.CollectionID, b.PartitionID, b.LogType, b.LogID, b.NumEntries, b.TimestampFrom, b.TimestampTo, b.LogPath, b.LogSize, b.CreatedAt, b.UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := binlogTestDb.Insert(binlogs)
+	assert.Error(t, err)
+}
+
+func TestBinlog_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `binlogs` WHERE tenant_id = ? AND segment_id IN (?)").
+		WithArgs(tenantID, segmentID1).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	mock.ExpectCommit()
+
+	// actual
+	err := binlogTestDb.Delete(tenantID, []typeutil.UniqueID{segmentID1})
+	assert.NoError(t, err)
+}
+
+func TestBinlog_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `binlogs` WHERE tenant_id = ? AND segment_id IN (?)").
+		WithArgs(tenantID, segmentID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := binlogTestDb.Delete(tenantID, []typeutil.UniqueID{segmentID1})
+	assert.Error(t, err)
+}
@@ -0,0 +1,50 @@
+package dao
+
+import (
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type channelCheckpointDb struct {
+	db *gorm.DB
+}
+
+func (s *channelCheckpointDb) List(tenantID string) ([]*dbmodel.ChannelCheckpoint, error) {
+	var r []*dbmodel.ChannelCheckpoint
+
+	err := s.db.Model(&dbmodel.ChannelCheckpoint{}).Where("tenant_id = ?", tenantID).Find(&r).Error
+	if err != nil {
+		log.Error("list channel checkpoints failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *channelCheckpointDb) Upsert(in *dbmodel.ChannelCheckpoint) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, virtual_channel_name)
+		Columns:   conflictColumns("tenant_id", "virtual_channel_name"),
+		DoUpdates: clause.AssignmentColumns([]string{"position", "updated_at"}),
+	}).Create(in).Error
+
+	if err != nil {
+		log.Error("upsert channel checkpoint failed", zap.String("tenant", in.TenantID), zap.String("vChannel", in.VirtualChannelName), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *channelCheckpointDb) Delete(tenantID string, vChannel string) error {
+	err := s.db.Where("tenant_id = ? AND virtual_channel_name = ?", tenantID, vChannel).Delete(&dbmodel.ChannelCheckpoint{}).Error
+	if err != nil {
+		log.Error("delete channel checkpoint failed", zap.String("tenant", tenantID), zap.String("vChannel", vChannel), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
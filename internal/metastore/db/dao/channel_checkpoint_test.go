@@ -0,0 +1,104 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelCheckpoint_List(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `channel_checkpoints` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "virtual_channel_name", "position"}).
+				AddRow(tenantID, "ch1", "{}"))
+
+	// actual
+	res, err := channelCPTestDb.List(tenantID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, "ch1", res[0].VirtualChannelName)
+}
+
+func TestChannelCheckpoint_List_Error(t *testing.T) {
+	mock.ExpectQuery("SELECT * FROM `channel_checkpoints` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	res, err := channelCPTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestChannelCheckpoint_Upsert(t *testing.T) {
+	cp := &dbmodel.ChannelCheckpoint{
+		TenantID:           tenantID,
+		VirtualChannelName: "ch1",
+		Position:           "{}",
+		CreatedAt:          time.Now(),
+		UpdatedAt:          time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `channel_checkpoints` (`tenant_id`,`virtual_channel_name`,`position`,`created_at`,`updated_at`) VALUES (?,?,?,?,?) ON DUPLICATE KEY UPDATE `position`=VALUES(`position`),`updated_at`=VALUES(`updated_at`)").
+		WithArgs(cp.TenantID, cp.VirtualChannelName, cp.Position, cp.CreatedAt, cp.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := channelCPTestDb.Upsert(cp)
+	assert.NoError(t, err)
+}
+
+func TestChannelCheckpoint_Upsert_Error(t *testing.T) {
+	cp := &dbmodel.ChannelCheckpoint{
+		TenantID:           tenantID,
+		VirtualChannelName: "ch1",
+		Position:           "{}",
+		CreatedAt:          time.Now(),
+		UpdatedAt:          time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `channel_checkpoints` (`tenant_id`,`virtual_channel_name`,`position`,`created_at`,`updated_at`) VALUES (?,?,?,?,?) ON DUPLICATE KEY UPDATE `position`=VALUES(`position`),`updated_at`=VALUES(`updated_at`)").
+		WithArgs(cp.TenantID, cp.VirtualChannelName, cp.Position, cp.CreatedAt, cp.UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := channelCPTestDb.Upsert(cp)
+	assert.Error(t, err)
+}
+
+func TestChannelCheckpoint_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `channel_checkpoints` WHERE tenant_id = ? AND virtual_channel_name = ?").
+		WithArgs(tenantID, "ch1").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := channelCPTestDb.Delete(tenantID, "ch1")
+	assert.NoError(t, err)
+}
+
+func TestChannelCheckpoint_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `channel_checkpoints` WHERE tenant_id = ? AND virtual_channel_name = ?").
+		WithArgs(tenantID, "ch1").
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := channelCPTestDb.Delete(tenantID, "ch1")
+	assert.Error(t, err)
+}
@@ -81,6 +81,7 @@ func (s *collectionDb) GetCollectionIDByName(tenantID string, collectionName str
 func (s *collectionDb) Insert(in *dbmodel.Collection) error {
 	err := s.db.Clauses(clause.OnConflict{
 		// constraint UNIQUE (tenant_id, collection_id, ts)
+		Columns:   conflictColumns("tenant_id", "collection_id", "ts"),
 		DoNothing: true,
 	}).Create(&in).Error
 
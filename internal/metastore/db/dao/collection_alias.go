@@ -19,6 +19,7 @@ type collAliasDb struct {
 func (s *collAliasDb) Insert(in []*dbmodel.CollectionAlias) error {
 	err := s.db.Clauses(clause.OnConflict{
 		// constraint UNIQUE (tenant_id, collection_alias, ts)
+		Columns:   conflictColumns("tenant_id", "collection_alias", "ts"),
 		DoNothing: true,
 	}).Create(&in).Error
 
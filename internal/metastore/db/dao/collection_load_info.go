@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type collectionLoadInfoDb struct {
+	db *gorm.DB
+}
+
+func (s *collectionLoadInfoDb) List(tenantID string) ([]*dbmodel.CollectionLoadInfo, error) {
+	var r []*dbmodel.CollectionLoadInfo
+
+	err := s.db.Model(&dbmodel.CollectionLoadInfo{}).Where("tenant_id = ?", tenantID).Find(&r).Error
+	if err != nil {
+		log.Error("list collection load infos failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *collectionLoadInfoDb) Upsert(in *dbmodel.CollectionLoadInfo) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, collection_id)
+		Columns:   conflictColumns("tenant_id", "collection_id"),
+		DoUpdates: clause.AssignmentColumns([]string{"released_partitions", "replica_number", "status", "field_index_id", "updated_at"}),
+	}).Create(in).Error
+
+	if err != nil {
+		log.Error("upsert collection load info failed", zap.String("tenant", in.TenantID), zap.Int64("collID", in.CollectionID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *collectionLoadInfoDb) Delete(tenantID string, collectionID typeutil.UniqueID) error {
+	err := s.db.Where("tenant_id = ? AND collection_id = ?", tenantID, collectionID).Delete(&dbmodel.CollectionLoadInfo{}).Error
+	if err != nil {
+		log.Error("delete collection load info failed", zap.String("tenant", tenantID), zap.Int64("collID", collectionID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
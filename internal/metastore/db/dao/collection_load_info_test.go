@@ -0,0 +1,105 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectionLoadInfo_List(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `collection_load_infos` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "collection_id", "replica_number", "status"}).
+				AddRow(tenantID, collID1, 1, 2))
+
+	// actual
+	res, err := collLoadTestDb.List(tenantID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, collID1, res[0].CollectionID)
+	assert.Equal(t, int32(2), res[0].Status)
+}
+
+func TestCollectionLoadInfo_List_Error(t *testing.T) {
+	mock.ExpectQuery("SELECT * FROM `collection_load_infos` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	res, err := collLoadTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestCollectionLoadInfo_Upsert(t *testing.T) {
+	info := &dbmodel.CollectionLoadInfo{
+		TenantID:      tenantID,
+		CollectionID:  collID1,
+		ReplicaNumber: 1,
+		Status:        2,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `collection_load_infos` (`tenant_id`,`collection_id`,`released_partitions`,`replica_number`,`status`,`field_index_id`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `released_partitions`=VALUES(`released_partitions`),`replica_number`=VALUES(`replica_number`),`status`=VALUES(`status`),`field_index_id`=VALUES(`field_index_id`),`updated_at`=VALUES(`updated_at`)").
+		WithArgs(info.TenantID, info.CollectionID, info.ReleasedPartitions, info.ReplicaNumber, info.Status, info.FieldIndexID, info.CreatedAt, info.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := collLoadTestDb.Upsert(info)
+	assert.NoError(t, err)
+}
+
+func TestCollectionLoadInfo_Upsert_Error(t *testing.T) {
+	info := &dbmodel.CollectionLoadInfo{
+		TenantID:     tenantID,
+		CollectionID: collID1,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `collection_load_infos` (`tenant_id`,`collection_id`,`released_partitions`,`replica_number`,`status`,`field_index_id`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `released_partitions`=VALUES(`released_partitions`),`replica_number`=VALUES(`replica_number`),`status`=VALUES(`status`),`field_index_id`=VALUES(`field_index_id`),`updated_at`=VALUES(`updated_at`)").
+		WithArgs(info.TenantID, info.CollectionID, info.ReleasedPartitions, info.ReplicaNumber, info.Status, info.FieldIndexID, info.CreatedAt, info.UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := collLoadTestDb.Upsert(info)
+	assert.Error(t, err)
+}
+
+func TestCollectionLoadInfo_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `collection_load_infos` WHERE tenant_id = ? AND collection_id = ?").
+		WithArgs(tenantID, collID1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := collLoadTestDb.Delete(tenantID, collID1)
+	assert.NoError(t, err)
+}
+
+func TestCollectionLoadInfo_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `collection_load_infos` WHERE tenant_id = ? AND collection_id = ?").
+		WithArgs(tenantID, collID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := collLoadTestDb.Delete(tenantID, collID1)
+	assert.Error(t, err)
+}
@@ -15,9 +15,11 @@ import (
 	commonpb "github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
 	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
@@ -51,6 +53,13 @@ var (
 	userRoleTestDb  dbmodel.IUserRoleDb
 	grantTestDb     dbmodel.IGrantDb
 	grantIDTestDb   dbmodel.IGrantIDDb
+	segmentTestDb   dbmodel.ISegmentDb
+	binlogTestDb    dbmodel.IBinlogDb
+	channelCPTestDb dbmodel.IChannelCheckpointDb
+	droppedChTestDb dbmodel.IDroppedChannelDb
+	collLoadTestDb  dbmodel.ICollectionLoadInfoDb
+	partLoadTestDb  dbmodel.IPartitionLoadInfoDb
+	replicaTestDb   dbmodel.IReplicaDb
 
 	properties = []*commonpb.KeyValuePair{
 		{
@@ -63,6 +72,19 @@ var (
 
 // TestMain is the first function executed in current package, we will do some initial here
 func TestMain(m *testing.M) {
+	code := 0
+	// run the whole suite once per supported driver, expectations are written in mysql dialect
+	for _, driver := range []string{util.MetaDBDriverMysql, util.MetaDBDriverSqlite} {
+		setupTestDb(driver)
+		// m.Run entry for executing tests
+		if ret := m.Run(); ret != 0 {
+			code = ret
+		}
+	}
+	os.Exit(code)
+}
+
+func setupTestDb(driver string) {
 	var (
 		db  *sql.DB
 		err error
@@ -70,15 +92,27 @@ func TestMain(m *testing.M) {
 	)
 
 	// setting sql MUST exact match
-	db, mock, err = sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	db, mock, err = sqlmock.New(sqlmock.QueryMatcherOption(dialectQueryMatcher(driver)))
 	if err != nil {
 		panic(err)
 	}
 
-	DB, err := gorm.Open(mysql.New(mysql.Config{
-		Conn:                      db,
-		SkipInitializeWithVersion: true,
-	}), &gorm.Config{})
+	var dialector gorm.Dialector
+	switch driver {
+	case util.MetaDBDriverSqlite:
+		// stay below 3.35 so that gorm does not append RETURNING and inserts are executed like in mysql,
+		// the real sqlite engine is covered by sqlite_test.go
+		mock.ExpectQuery("select sqlite_version()").
+			WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow("3.34.0"))
+		dialector = sqlite.Dialector{Conn: db}
+	default:
+		dialector = mysql.New(mysql.Config{
+			Conn:                      db,
+			SkipInitializeWithVersion: true,
+		})
+	}
+
+	DB, err := gorm.Open(dialector, &gorm.Config{})
 	if err != nil {
 		panic(err)
 	}
@@ -98,9 +132,13 @@ func TestMain(m *testing.M) {
 	userRoleTestDb = NewMetaDomain().UserRoleDb(ctx)
 	grantTestDb = NewMetaDomain().GrantDb(ctx)
 	grantIDTestDb = NewMetaDomain().GrantIDDb(ctx)
-
-	// m.Run entry for executing tests
-	os.Exit(m.Run())
+	segmentTestDb = NewMetaDomain().SegmentDb(ctx)
+	binlogTestDb = NewMetaDomain().BinlogDb(ctx)
+	channelCPTestDb = NewMetaDomain().ChannelCheckpointDb(ctx)
+	droppedChTestDb = NewMetaDomain().DroppedChannelDb(ctx)
+	collLoadTestDb = NewMetaDomain().CollectionLoadInfoDb(ctx)
+	partLoadTestDb = NewMetaDomain().PartitionLoadInfoDb(ctx)
+	replicaTestDb = NewMetaDomain().ReplicaDb(ctx)
 }
 
 // Notice: sql must be exactly matched, we can use debug() to print the sql
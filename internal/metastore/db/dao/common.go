@@ -5,6 +5,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
 	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"gorm.io/gorm/clause"
 )
 
 type metaDomain struct{}
@@ -60,3 +61,41 @@ func (d *metaDomain) GrantDb(ctx context.Context) dbmodel.IGrantDb {
 func (d *metaDomain) GrantIDDb(ctx context.Context) dbmodel.IGrantIDDb {
 	return &grantIDDb{dbcore.GetDB(ctx)}
 }
+
+func (*metaDomain) SegmentDb(ctx context.Context) dbmodel.ISegmentDb {
+	return &segmentDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) BinlogDb(ctx context.Context) dbmodel.IBinlogDb {
+	return &binlogDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) ChannelCheckpointDb(ctx context.Context) dbmodel.IChannelCheckpointDb {
+	return &channelCheckpointDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) DroppedChannelDb(ctx context.Context) dbmodel.IDroppedChannelDb {
+	return &droppedChannelDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) CollectionLoadInfoDb(ctx context.Context) dbmodel.ICollectionLoadInfoDb {
+	return &collectionLoadInfoDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) PartitionLoadInfoDb(ctx context.Context) dbmodel.IPartitionLoadInfoDb {
+	return &partitionLoadInfoDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) ReplicaDb(ctx context.Context) dbmodel.IReplicaDb {
+	return &replicaDb{dbcore.GetDB(ctx)}
+}
+
+// conflictColumns names the unique constraint an upsert conflicts on. mysql ignores it and matches any unique key,
+// while sqlite requires it as the ON CONFLICT target.
+func conflictColumns(names ...string) []clause.Column {
+	columns := make([]clause.Column, 0, len(names))
+	for _, name := range names {
+		columns = append(columns, clause.Column{Name: name})
+	}
+	return columns
+}
@@ -0,0 +1,67 @@
+package dao
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+// conflictTargets are the unique keys the upserts conflict on, keep them in sync with the dao implementations.
+var conflictTargets = map[string][]string{
+	"collections":           {"tenant_id", "collection_id", "ts"},
+	"collection_aliases":    {"tenant_id", "collection_alias", "ts"},
+	"segments":              {"tenant_id", "segment_id"},
+	"segment_indexes":       {"tenant_id", "segment_id", "index_id"},
+	"channel_checkpoints":   {"tenant_id", "virtual_channel_name"},
+	"dropped_channels":      {"tenant_id", "channel_name"},
+	"collection_load_infos": {"tenant_id", "collection_id"},
+	"partition_load_infos":  {"tenant_id", "collection_id", "partition_id"},
+	"replicas":              {"tenant_id", "collection_id", "replica_id"},
+}
+
+var (
+	upsertRegex     = regexp.MustCompile("^INSERT INTO `(\\w+)` (.*) ON DUPLICATE KEY UPDATE (.*)$")
+	assignmentRegex = regexp.MustCompile("`(\\w+)`=VALUES\\(`(\\w+)`\\)")
+)
+
+// toDialect rewrites an expected sql written in mysql dialect to the one gorm renders for the given driver,
+// so that every test case only spells out the mysql statement.
+func toDialect(driver string, expectedSQL string) (string, error) {
+	if driver != util.MetaDBDriverSqlite {
+		return expectedSQL, nil
+	}
+
+	matches := upsertRegex.FindStringSubmatch(expectedSQL)
+	if matches == nil {
+		return expectedSQL, nil
+	}
+	table, insert, updates := matches[1], matches[2], matches[3]
+	columns, ok := conflictTargets[table]
+	if !ok {
+		return "", fmt.Errorf("no conflict target for table %s", table)
+	}
+
+	quoted := make([]string, 0, len(columns))
+	for _, column := range columns {
+		quoted = append(quoted, "`"+column+"`")
+	}
+	action := "DO NOTHING"
+	if updates != "`id`=`id`" {
+		action = "DO UPDATE SET " + assignmentRegex.ReplaceAllString(updates, "`$1`=`excluded`.`$2`")
+	}
+	return fmt.Sprintf("INSERT INTO `%s` %s ON CONFLICT (%s) %s", table, insert, strings.Join(quoted, ","), action), nil
+}
+
+// dialectQueryMatcher compares sql exactly, after translating the expectation to the driver's dialect.
+func dialectQueryMatcher(driver string) sqlmock.QueryMatcher {
+	return sqlmock.QueryMatcherFunc(func(expectedSQL, actualSQL string) error {
+		expect, err := toDialect(driver, expectedSQL)
+		if err != nil {
+			return err
+		}
+		return sqlmock.QueryMatcherEqual.Match(expect, actualSQL)
+	})
+}
@@ -0,0 +1,50 @@
+package dao
+
+import (
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type droppedChannelDb struct {
+	db *gorm.DB
+}
+
+func (s *droppedChannelDb) Has(tenantID string, channel string) (bool, error) {
+	var count int64
+
+	err := s.db.Model(&dbmodel.DroppedChannel{}).Where("tenant_id = ? AND channel_name = ?", tenantID, channel).Count(&count).Error
+	if err != nil {
+		log.Error("get dropped channel failed", zap.String("tenant", tenantID), zap.String("channel", channel), zap.Error(err))
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (s *droppedChannelDb) Insert(in *dbmodel.DroppedChannel) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, channel_name)
+		Columns:   conflictColumns("tenant_id", "channel_name"),
+		DoNothing: true,
+	}).Create(in).Error
+
+	if err != nil {
+		log.Error("insert dropped channel failed", zap.String("tenant", in.TenantID), zap.String("channel", in.ChannelName), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *droppedChannelDb) Delete(tenantID string, channel string) error {
+	err := s.db.Where("tenant_id = ? AND channel_name = ?", tenantID, channel).Delete(&dbmodel.DroppedChannel{}).Error
+	if err != nil {
+		log.Error("delete dropped channel failed", zap.String("tenant", tenantID), zap.String("channel", channel), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDroppedChannel_Has(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT count(*) FROM `dropped_channels` WHERE tenant_id = ? AND channel_name = ?").
+		WithArgs(tenantID, "ch1").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	// actual
+	has, err := droppedChTestDb.Has(tenantID, "ch1")
+	assert.NoError(t, err)
+	assert.True(t, has)
+}
+
+func TestDroppedChannel_Has_NotFound(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT count(*) FROM `dropped_channels` WHERE tenant_id = ? AND channel_name = ?").
+		WithArgs(tenantID, "ch1").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+	// actual
+	has, err := droppedChTestDb.Has(tenantID, "ch1")
+	assert.NoError(t, err)
+	assert.False(t, has)
+}
+
+func TestDroppedChannel_Has_Error(t *testing.T) {
+	mock.ExpectQuery("SELECT count(*) FROM `dropped_channels` WHERE tenant_id = ? AND channel_name = ?").
+		WithArgs(tenantID, "ch1").
+		WillReturnError(errors.New("test error"))
+
+	has, err := droppedChTestDb.Has(tenantID, "ch1")
+	assert.False(t, has)
+	assert.Error(t, err)
+}
+
+func TestDroppedChannel_Insert(t *testing.T) {
+	ch := &dbmodel.DroppedChannel{
+		TenantID:    tenantID,
+		ChannelName: "ch1",
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `dropped_channels` (`tenant_id`,`channel_name`,`created_at`,`updated_at`) VALUES (?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
+		WithArgs(ch.TenantID, ch.ChannelName, ch.CreatedAt, ch.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := droppedChTestDb.Insert(ch)
+	assert.NoError(t, err)
+}
+
+func TestDroppedChannel_Insert_Error(t *testing.T) {
+	ch := &dbmodel.DroppedChannel{
+		TenantID:    tenantID,
+		ChannelName: "ch1",
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `dropped_channels` (`tenant_id`,`channel_name`,`created_at`,`updated_at`) VALUES (?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
+		WithArgs(ch.TenantID, ch.ChannelName, ch.CreatedAt, ch.UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := droppedChTestDb.Insert(ch)
+	assert.Error(t, err)
+}
+
+func TestDroppedChannel_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `dropped_channels` WHERE tenant_id = ? AND channel_name = ?").
+		WithArgs(tenantID, "ch1").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := droppedChTestDb.Delete(tenantID, "ch1")
+	assert.NoError(t, err)
+}
+
+func TestDroppedChannel_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `dropped_channels` WHERE tenant_id = ? AND channel_name = ?").
+		WithArgs(tenantID, "ch1").
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := droppedChTestDb.Delete(tenantID, "ch1")
+	assert.Error(t, err)
+}
@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type partitionLoadInfoDb struct {
+	db *gorm.DB
+}
+
+func (s *partitionLoadInfoDb) List(tenantID string) ([]*dbmodel.PartitionLoadInfo, error) {
+	var r []*dbmodel.PartitionLoadInfo
+
+	err := s.db.Model(&dbmodel.PartitionLoadInfo{}).Where("tenant_id = ?", tenantID).Find(&r).Error
+	if err != nil {
+		log.Error("list partition load infos failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *partitionLoadInfoDb) Upsert(in []*dbmodel.PartitionLoadInfo) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, collection_id, partition_id)
+		Columns:   conflictColumns("tenant_id", "collection_id", "partition_id"),
+		DoUpdates: clause.AssignmentColumns([]string{"replica_number", "status", "field_index_id", "updated_at"}),
+	}).CreateInBatches(in, 100).Error
+
+	if err != nil {
+		log.Error("upsert partition load infos failed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *partitionLoadInfoDb) Delete(tenantID string, collectionID typeutil.UniqueID, partitionIDs []typeutil.UniqueID) error {
+	err := s.db.Where("tenant_id = ? AND collection_id = ? AND partition_id IN ?", tenantID, collectionID, partitionIDs).Delete(&dbmodel.PartitionLoadInfo{}).Error
+	if err != nil {
+		log.Error("delete partition load infos failed", zap.String("tenant", tenantID), zap.Int64("collID", collectionID), zap.Int64s("partitionIDs", partitionIDs), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartitionLoadInfo_List(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `partition_load_infos` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "collection_id", "partition_id", "replica_number", "status"}).
+				AddRow(tenantID, collID1, partitionID1, 1, 2))
+
+	// actual
+	res, err := partLoadTestDb.List(tenantID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, partitionID1, res[0].PartitionID)
+}
+
+func TestPartitionLoadInfo_List_Error(t *testing.T) {
+	mock.ExpectQuery("SELECT * FROM `partition_load_infos` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	res, err := partLoadTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestPartitionLoadInfo_Upsert(t *testing.T) {
+	var infos = []*dbmodel.PartitionLoadInfo{
+		{
+			TenantID:      tenantID,
+			CollectionID:  collID1,
+			PartitionID:   partitionID1,
+			ReplicaNumber: 1,
+			Status:        2,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		},
+	}
+	info := infos[0]
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `partition_load_infos` (`tenant_id`,`collection_id`,`partition_id`,`replica_number`,`status`,`field_index_id`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `replica_number`=VALUES(`replica_number`),`status`=VALUES(`status`),`field_index_id`=VALUES(`field_index_id`),`updated_at`=VALUES(`updated_at`)").
+		WithArgs(info.TenantID, info.CollectionID, info.PartitionID, info.ReplicaNumber, info.Status, info.FieldIndexID, info.CreatedAt, info.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := partLoadTestDb.Upsert(infos)
+	assert.NoError(t, err)
+}
+
+func TestPartitionLoadInfo_Upsert_Error(t *testing.T) {
+	var infos = []*dbmodel.PartitionLoadInfo{
+		{
+			TenantID:     tenantID,
+			CollectionID: collID1,
+			PartitionID:  partitionID1,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+	info := infos[0]
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `partition_load_infos` (`tenant_id`,`collection_id`,`partition_id`,`replica_number`,`status`,`field_index_id`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `replica_number`=VALUES(`replica_number`),`status`=VALUES(`status`),`field_index_id`=VALUES(`field_index_id`),`updated_at`=VALUES(`updated_at`)").
+		WithArgs(info.TenantID, info.CollectionID, info.PartitionID, info.ReplicaNumber, info.Status, info.FieldIndexID, info.CreatedAt, info.UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := partLoadTestDb.Upsert(infos)
+	assert.Error(t, err)
+}
+
+func TestPartitionLoadInfo_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `partition_load_infos` WHERE tenant_id = ? AND collection_id = ? AND partition_id IN (?)").
+		WithArgs(tenantID, collID1, partitionID1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := partLoadTestDb.Delete(tenantID, collID1, []typeutil.UniqueID{partitionID1})
+	assert.NoError(t, err)
+}
+
+func TestPartitionLoadInfo_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `partition_load_infos` WHERE tenant_id = ? AND collection_id = ? AND partition_id IN (?)").
+		WithArgs(tenantID, collID1, partitionID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := partLoadTestDb.Delete(tenantID, collID1, []typeutil.UniqueID{partitionID1})
+	assert.Error(t, err)
+}
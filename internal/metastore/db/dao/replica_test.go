@@ -0,0 +1,131 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplica_List(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `replicas` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "replica_id", "collection_id", "nodes"}).
+				AddRow(tenantID, 1, collID1, "[1,2]"))
+
+	// actual
+	res, err := replicaTestDb.List(tenantID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, "[1,2]", res[0].Nodes)
+}
+
+func TestReplica_List_Error(t *testing.T) {
+	mock.ExpectQuery("SELECT * FROM `replicas` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	res, err := replicaTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestReplica_Upsert(t *testing.T) {
+	replica := &dbmodel.Replica{
+		TenantID:     tenantID,
+		ReplicaID:    1,
+		CollectionID: collID1,
+		Nodes:        "[1,2]",
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `replicas` (`tenant_id`,`replica_id`,`collection_id`,`nodes`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `nodes`=VALUES(`nodes`),`updated_at`=VALUES(`updated_at`)").
+		WithArgs(replica.TenantID, replica.ReplicaID, replica.CollectionID, replica.Nodes, replica.CreatedAt, replica.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := replicaTestDb.Upsert(replica)
+	assert.NoError(t, err)
+}
+
+func TestReplica_Upsert_Error(t *testing.T) {
+	replica := &dbmodel.Replica{
+		TenantID:     tenantID,
+		ReplicaID:    1,
+		CollectionID: collID1,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `replicas` (`tenant_id`,`replica_id`,`collection_id`,`nodes`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `nodes`=VALUES(`nodes`),`updated_at`=VALUES(`updated_at`)").
+		WithArgs(replica.TenantID, replica.ReplicaID, replica.CollectionID, replica.Nodes, replica.CreatedAt, replica.UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := replicaTestDb.Upsert(replica)
+	assert.Error(t, err)
+}
+
+func TestReplica_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `replicas` WHERE tenant_id = ? AND collection_id = ? AND replica_id = ?").
+		WithArgs(tenantID, collID1, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := replicaTestDb.Delete(tenantID, collID1, 1)
+	assert.NoError(t, err)
+}
+
+func TestReplica_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `replicas` WHERE tenant_id = ? AND collection_id = ? AND replica_id = ?").
+		WithArgs(tenantID, collID1, 1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := replicaTestDb.Delete(tenantID, collID1, 1)
+	assert.Error(t, err)
+}
+
+func TestReplica_DeleteByCollectionID(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `replicas` WHERE tenant_id = ? AND collection_id = ?").
+		WithArgs(tenantID, collID1).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	mock.ExpectCommit()
+
+	// actual
+	err := replicaTestDb.DeleteByCollectionID(tenantID, collID1)
+	assert.NoError(t, err)
+}
+
+func TestReplica_DeleteByCollectionID_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `replicas` WHERE tenant_id = ? AND collection_id = ?").
+		WithArgs(tenantID, collID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := replicaTestDb.DeleteByCollectionID(tenantID, collID1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,53 @@
+package dao
+
+import (
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type segmentDb struct {
+	db *gorm.DB
+}
+
+func (s *segmentDb) List(tenantID string) ([]*dbmodel.Segment, error) {
+	var r []*dbmodel.Segment
+
+	err := s.db.Model(&dbmodel.Segment{}).Where("tenant_id = ?", tenantID).Find(&r).Error
+	if err != nil {
+		log.Error("list segments failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *segmentDb) Upsert(in []*dbmodel.Segment) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, segment_id)
+		Columns: conflictColumns("tenant_id", "segment_id"),
+		DoUpdates: clause.AssignmentColumns([]string{"collection_id", "partition_id", "num_rows", "max_row_num", "dm_channel",
+			"dml_position", "start_position", "compaction_from", "created_by_compaction", "segment_state", "last_expire_time",
+			"dropped_at", "is_importing", "is_fake", "updated_at"}),
+	}).CreateInBatches(in, 100).Error
+
+	if err != nil {
+		log.Error("upsert segments failed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *segmentDb) Delete(tenantID string, segmentIDs []typeutil.UniqueID) error {
+	err := s.db.Where("tenant_id = ? AND segment_id IN ?", tenantID, segmentIDs).Delete(&dbmodel.Segment{}).Error
+	if err != nil {
+		log.Error("delete segments failed", zap.String("tenant", tenantID), zap.Int64s("segmentIDs", segmentIDs), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
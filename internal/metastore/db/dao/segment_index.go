@@ -67,6 +67,7 @@ func (s *segmentIndexDb) Update(in *dbmodel.SegmentIndex) error {
 func (s *segmentIndexDb) Upsert(in []*dbmodel.SegmentIndex) error {
 	err := s.db.Clauses(clause.OnConflict{
 		// constraint UNIQUE (tenant_id, segment_id, index_id)
+		Columns:   conflictColumns("tenant_id", "segment_id", "index_id"),
 		DoUpdates: clause.AssignmentColumns([]string{"index_build_id", "enable_index", "create_time"}),
 	}).CreateInBatches(in, 100).Error
 
@@ -0,0 +1,117 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegment_List(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `segments` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "segment_id", "collection_id", "partition_id", "num_rows", "segment_state"}).
+				AddRow(tenantID, segmentID1, collID1, partitionID1, NumRows, 3))
+
+	// actual
+	res, err := segmentTestDb.List(tenantID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, segmentID1, res[0].SegmentID)
+	assert.Equal(t, int32(3), res[0].SegmentState)
+}
+
+func TestSegment_List_Error(t *testing.T) {
+	mock.ExpectQuery("SELECT * FROM `segments` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	res, err := segmentTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestSegment_Upsert(t *testing.T) {
+	var segments = []*dbmodel.Segment{
+		{
+			TenantID:     tenantID,
+			SegmentID:    segmentID1,
+			CollectionID: collID1,
+			PartitionID:  partitionID1,
+			NumRows:      NumRows,
+			DmChannel:    "ch1",
+			SegmentState: 3,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+	s := segments[0]
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `segments` (`tenant_id`,`segment_id`,`collection_id`,`partition_id`,`num_rows`,`max_row_num`,`dm_channel`,`dml_position`,`start_position`,`compaction_from`,`created_by_compaction`,`segment_state`,`last_expire_time`,`dropped_at`,`is_importing`,`is_fake`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `collection_id`=VALUES(`collection_id`),`partition_id`=VALUES(`partition_id`),`num_rows`=VALUES(`num_rows`),`max_row_num`=VALUES(`max_row_num`),`dm_channel`=VALUES(`dm_channel`),`dml_position`=VALUES(`dml_position`),`start_position`=VALUES(`start_position`),`compaction_from`=VALUES(`compaction_from`),`created_by_compaction`=VALUES(`created_by_compaction`),`segment_state`=VALUES(`segment_state`),`last_expire_time`=VALUES(`last_expire_time`),`dropped_at`=VALUES(`dropped_at`),`is_importing`=VALUES(`is_importing`),`is_fake`=VALUES(`is_fake`),`updated_at`=VALUES(`updated_at`)").
+		WithArgs(s.TenantID, s.SegmentID, s.CollectionID, s.PartitionID, s.NumRows, s.MaxRowNum, s.DmChannel, s.DmlPosition, s.StartPosition, s.CompactionFrom, s.CreatedByCompaction, s.SegmentState, s.LastExpireTime, s.DroppedAt, s.IsImporting, s.IsFake, s.CreatedAt, s.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := segmentTestDb.Upsert(segments)
+	assert.NoError(t, err)
+}
+
+func TestSegment_Upsert_Error(t *testing.T) {
+	var segments = []*dbmodel.Segment{
+		{
+			TenantID:     tenantID,
+			SegmentID:    segmentID1,
+			CollectionID: collID1,
+			PartitionID:  partitionID1,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+	s := segments[0]
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `segments` (`tenant_id`,`segment_id`,`collection_id`,`partition_id`,`num_rows`,`max_row_num`,`dm_channel`,`dml_position`,`start_position`,`compaction_from`,`created_by_compaction`,`segment_state`,`last_expire_time`,`dropped_at`,`is_importing`,`is_fake`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `collection_id`=VALUES(`collection_id`),`partition_id`=VALUES(`partition_id`),`num_rows`=VALUES(`num_rows`),`max_row_num`=VALUES(`max_row_num`),`dm_channel`=VALUES(`dm_channel`),`dml_position`=VALUES(`dml_position`),`start_position`=VALUES(`start_position`),`compaction_from`=VALUES(`compaction_from`),`created_by_compaction`=VALUES(`created_by_compaction`),`segment_state`=VALUES(`segment_state`),`last_expire_time`=VALUES(`last_expire_time`),`dropped_at`=VALUES(`dropped_at`),`is_importing`=VALUES(`is_importing`),`is_fake`=VALUES(`is_fake`),`updated_at`=VALUES(`updated_at`)").
+		WithArgs(s.TenantID, s.SegmentID, s.CollectionID, s.PartitionID, s.NumRows, s.MaxRowNum, s.DmChannel, s.DmlPosition, s.StartPosition, s.CompactionFrom, s.CreatedByCompaction, s.SegmentState, s.LastExpireTime, s.DroppedAt, s.IsImporting, s.IsFake, s.CreatedAt, s.UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := segmentTestDb.Upsert(segments)
+	assert.Error(t, err)
+}
+
+func TestSegment_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `segments` WHERE tenant_id = ? AND segment_id IN (?,?)").
+		WithArgs(tenantID, segmentID1, segmentID2).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	mock.ExpectCommit()
+
+	// actual
+	err := segmentTestDb.Delete(tenantID, []typeutil.UniqueID{segmentID1, segmentID2})
+	assert.NoError(t, err)
+}
+
+func TestSegment_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `segments` WHERE tenant_id = ? AND segment_id IN (?,?)").
+		WithArgs(tenantID, segmentID1, segmentID2).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := segmentTestDb.Delete(tenantID, []typeutil.UniqueID{segmentID1, segmentID2})
+	assert.Error(t, err)
+}
@@ -0,0 +1,455 @@
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/contextutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+)
+
+type Catalog struct {
+	metaDomain dbmodel.IMetaDomain
+	txImpl     dbmodel.ITransaction
+	// flushKV is the etcd kv the flushed segments are notified to IndexCoord in, like the kv catalog does
+	flushKV kv.BaseKV
+}
+
+func NewTableCatalog(txImpl dbmodel.ITransaction, metaDomain dbmodel.IMetaDomain, flushKV kv.BaseKV) *Catalog {
+	return &Catalog{
+		txImpl:     txImpl,
+		metaDomain: metaDomain,
+		flushKV:    flushKV,
+	}
+}
+
+func (tc *Catalog) ListSegments(ctx context.Context) ([]*datapb.SegmentInfo, error) {
+	tenantID := contextutil.TenantID(ctx)
+
+	segments, err := tc.metaDomain.SegmentDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	binlogs, err := tc.metaDomain.BinlogDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalSegments(segments, binlogs)
+}
+
+func (tc *Catalog) AddSegment(ctx context.Context, segment *datapb.SegmentInfo) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		return tc.saveSegments(txCtx, tenantID, []*datapb.SegmentInfo{segment}, true)
+	})
+}
+
+func (tc *Catalog) AlterSegments(ctx context.Context, newSegments []*datapb.SegmentInfo) error {
+	if len(newSegments) == 0 {
+		return nil
+	}
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		return tc.saveSegments(txCtx, tenantID, newSegments, true)
+	})
+}
+
+// AlterSegment saves the new segment with its binlogs. A newly flushed segment is also written to the
+// flushed-segment key of etcd, IndexCoord watches it to build the indexes of the segment.
+func (tc *Catalog) AlterSegment(ctx context.Context, newSegment *datapb.SegmentInfo, oldSegment *datapb.SegmentInfo) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		if err := tc.saveSegments(txCtx, tenantID, []*datapb.SegmentInfo{newSegment}, true); err != nil {
+			return err
+		}
+		// notified last, so the transaction is rolled back if it fails
+		if newSegment.GetState() == commonpb.SegmentState_Flushed && oldSegment.GetState() != commonpb.SegmentState_Flushed {
+			return tc.notifyFlushedSegment(newSegment, &datapb.SegmentInfo{ID: newSegment.GetID()})
+		}
+		return nil
+	})
+}
+
+// AlterSegmentsAndAddNewSegment updates the compacted segments and adds the compaction result in one transaction.
+// Binlogs of the compacted segments are kept so that GC can still find their data files.
+func (tc *Catalog) AlterSegmentsAndAddNewSegment(ctx context.Context, segments []*datapb.SegmentInfo, newSegment *datapb.SegmentInfo) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		if len(segments) > 0 {
+			if err := tc.saveSegments(txCtx, tenantID, segments, false); err != nil {
+				return err
+			}
+		}
+
+		if newSegment == nil {
+			return nil
+		}
+		if newSegment.GetNumOfRows() > 0 {
+			return tc.saveSegments(txCtx, tenantID, []*datapb.SegmentInfo{newSegment}, true)
+		}
+		// a faked segment (no rows) is only a notification for IndexCoord, it is not part of the datacoord meta
+		fakeSegment := proto.Clone(newSegment).(*datapb.SegmentInfo)
+		fakeSegment.IsFake = true
+		return tc.notifyFlushedSegment(newSegment, fakeSegment)
+	})
+}
+
+// notifyFlushedSegment writes value to the flushed-segment key of segment in etcd
+func (tc *Catalog) notifyFlushedSegment(segment *datapb.SegmentInfo, value *datapb.SegmentInfo) error {
+	bs, err := proto.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to marshal segment: %d, err: %w", value.GetID(), err)
+	}
+	key := fmt.Sprintf("%s/%d/%d/%d", util.FlushedSegmentPrefix, segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
+	return tc.flushKV.Save(key, string(bs))
+}
+
+// RevertAlterSegmentsAndAddNewSegment reverts the metastore operation of AlterSegmentsAndAddNewSegment
+func (tc *Catalog) RevertAlterSegmentsAndAddNewSegment(ctx context.Context, oldSegments []*datapb.SegmentInfo, removeSegment *datapb.SegmentInfo) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		if len(oldSegments) > 0 {
+			if err := tc.saveSegments(txCtx, tenantID, oldSegments, true); err != nil {
+				return err
+			}
+		}
+
+		if removeSegment != nil {
+			return tc.deleteSegments(txCtx, tenantID, []typeutil.UniqueID{removeSegment.GetID()})
+		}
+
+		return nil
+	})
+}
+
+func (tc *Catalog) SaveDroppedSegmentsInBatch(ctx context.Context, segments []*datapb.SegmentInfo) error {
+	if len(segments) == 0 {
+		return nil
+	}
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		return tc.saveSegments(txCtx, tenantID, segments, false)
+	})
+}
+
+func (tc *Catalog) DropSegment(ctx context.Context, segment *datapb.SegmentInfo) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		return tc.deleteSegments(txCtx, tenantID, []typeutil.UniqueID{segment.GetID()})
+	})
+}
+
+func (tc *Catalog) MarkChannelDeleted(ctx context.Context, channel string) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	err := tc.metaDomain.DroppedChannelDb(ctx).Insert(&dbmodel.DroppedChannel{
+		TenantID:    tenantID,
+		ChannelName: channel,
+	})
+	if err != nil {
+		log.Error("Failed to mark channel dropped", zap.String("channel", channel), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (tc *Catalog) IsChannelDropped(ctx context.Context, channel string) bool {
+	tenantID := contextutil.TenantID(ctx)
+
+	dropped, err := tc.metaDomain.DroppedChannelDb(ctx).Has(tenantID, channel)
+	if err != nil {
+		return false
+	}
+	return dropped
+}
+
+// DropChannel removes channel remove flag after whole procedure is finished
+func (tc *Catalog) DropChannel(ctx context.Context, channel string) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.DroppedChannelDb(ctx).Delete(tenantID, channel)
+}
+
+func (tc *Catalog) ListChannelCheckpoint(ctx context.Context) (map[string]*internalpb.MsgPosition, error) {
+	tenantID := contextutil.TenantID(ctx)
+
+	checkpoints, err := tc.metaDomain.ChannelCheckpointDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	channelCPs := make(map[string]*internalpb.MsgPosition, len(checkpoints))
+	for _, cp := range checkpoints {
+		pos, err := unmarshalPosition(cp.Position)
+		if err != nil {
+			log.Error("unmarshal channelCP failed when ListChannelCheckpoint", zap.String("vChannel", cp.VirtualChannelName), zap.Error(err))
+			return nil, err
+		}
+		channelCPs[cp.VirtualChannelName] = pos
+	}
+
+	return channelCPs, nil
+}
+
+func (tc *Catalog) SaveChannelCheckpoint(ctx context.Context, vChannel string, pos *internalpb.MsgPosition) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	position, err := marshalPosition(pos)
+	if err != nil {
+		return err
+	}
+
+	return tc.metaDomain.ChannelCheckpointDb(ctx).Upsert(&dbmodel.ChannelCheckpoint{
+		TenantID:           tenantID,
+		VirtualChannelName: vChannel,
+		Position:           position,
+	})
+}
+
+func (tc *Catalog) DropChannelCheckpoint(ctx context.Context, vChannel string) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.ChannelCheckpointDb(ctx).Delete(tenantID, vChannel)
+}
+
+// saveSegments upserts the segment rows, and replaces their binlog rows if withBinlogs is set.
+func (tc *Catalog) saveSegments(ctx context.Context, tenantID string, segments []*datapb.SegmentInfo, withBinlogs bool) error {
+	rows := make([]*dbmodel.Segment, 0, len(segments))
+	for _, segment := range segments {
+		row, err := marshalSegment(tenantID, segment)
+		if err != nil {
+			log.Error("marshal segment failed", zap.Int64("segmentID", segment.GetID()), zap.Int64("collID", segment.GetCollectionID()), zap.Error(err))
+			return err
+		}
+		rows = append(rows, row)
+	}
+
+	err := tc.metaDomain.SegmentDb(ctx).Upsert(rows)
+	if err != nil {
+		return err
+	}
+
+	if !withBinlogs {
+		return nil
+	}
+
+	segmentIDs := make([]typeutil.UniqueID, 0, len(segments))
+	var binlogs []*dbmodel.Binlog
+	for _, segment := range segments {
+		segmentIDs = append(segmentIDs, segment.GetID())
+		binlogs = append(binlogs, marshalBinlogs(tenantID, segment)...)
+	}
+
+	err = tc.metaDomain.BinlogDb(ctx).Delete(tenantID, segmentIDs)
+	if err != nil {
+		return err
+	}
+
+	if len(binlogs) == 0 {
+		return nil
+	}
+	return tc.metaDomain.BinlogDb(ctx).Insert(binlogs)
+}
+
+func (tc *Catalog) deleteSegments(ctx context.Context, tenantID string, segmentIDs []typeutil.UniqueID) error {
+	err := tc.metaDomain.SegmentDb(ctx).Delete(tenantID, segmentIDs)
+	if err != nil {
+		return err
+	}
+
+	return tc.metaDomain.BinlogDb(ctx).Delete(tenantID, segmentIDs)
+}
+
+func marshalPosition(pos *internalpb.MsgPosition) (string, error) {
+	if pos == nil {
+		return "", nil
+	}
+	b, err := json.Marshal(pos)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func unmarshalPosition(s string) (*internalpb.MsgPosition, error) {
+	if s == "" {
+		return nil, nil
+	}
+	pos := &internalpb.MsgPosition{}
+	if err := json.Unmarshal([]byte(s), pos); err != nil {
+		return nil, err
+	}
+	return pos, nil
+}
+
+func marshalSegment(tenantID string, segment *datapb.SegmentInfo) (*dbmodel.Segment, error) {
+	dmlPosition, err := marshalPosition(segment.GetDmlPosition())
+	if err != nil {
+		return nil, err
+	}
+
+	startPosition, err := marshalPosition(segment.GetStartPosition())
+	if err != nil {
+		return nil, err
+	}
+
+	var compactionFrom string
+	if len(segment.GetCompactionFrom()) > 0 {
+		b, err := json.Marshal(segment.GetCompactionFrom())
+		if err != nil {
+			return nil, err
+		}
+		compactionFrom = string(b)
+	}
+
+	return &dbmodel.Segment{
+		TenantID:            tenantID,
+		SegmentID:           segment.GetID(),
+		CollectionID:        segment.GetCollectionID(),
+		PartitionID:         segment.GetPartitionID(),
+		NumRows:             segment.GetNumOfRows(),
+		MaxRowNum:           segment.GetMaxRowNum(),
+		DmChannel:           segment.GetInsertChannel(),
+		DmlPosition:         dmlPosition,
+		StartPosition:       startPosition,
+		CompactionFrom:      compactionFrom,
+		CreatedByCompaction: segment.GetCreatedByCompaction(),
+		SegmentState:        int32(segment.GetState()),
+		LastExpireTime:      segment.GetLastExpireTime(),
+		DroppedAt:           segment.GetDroppedAt(),
+		IsImporting:         segment.GetIsImporting(),
+		IsFake:              segment.GetIsFake(),
+	}, nil
+}
+
+func marshalBinlogs(tenantID string, segment *datapb.SegmentInfo) []*dbmodel.Binlog {
+	var rows []*dbmodel.Binlog
+	appendLogs := func(logType storage.BinlogType, fieldBinlogs []*datapb.FieldBinlog) {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				rows = append(rows, &dbmodel.Binlog{
+					TenantID:      tenantID,
+					FieldID:       fieldBinlog.GetFieldID(),
+					SegmentID:     segment.GetID(),
+					CollectionID:  segment.GetCollectionID(),
+					PartitionID:   segment.GetPartitionID(),
+					LogType:       int32(logType),
+					LogID:         binlog.GetLogID(),
+					NumEntries:    binlog.GetEntriesNum(),
+					TimestampFrom: binlog.GetTimestampFrom(),
+					TimestampTo:   binlog.GetTimestampTo(),
+					LogPath:       binlog.GetLogPath(),
+					LogSize:       binlog.GetLogSize(),
+				})
+			}
+		}
+	}
+
+	appendLogs(storage.InsertBinlog, segment.GetBinlogs())
+	appendLogs(storage.DeleteBinlog, segment.GetDeltalogs())
+	appendLogs(storage.StatsBinlog, segment.GetStatslogs())
+	return rows
+}
+
+func unmarshalSegments(segments []*dbmodel.Segment, binlogs []*dbmodel.Binlog) ([]*datapb.SegmentInfo, error) {
+	result := make([]*datapb.SegmentInfo, 0, len(segments))
+	segmentMap := make(map[typeutil.UniqueID]*datapb.SegmentInfo, len(segments))
+	for _, s := range segments {
+		dmlPosition, err := unmarshalPosition(s.DmlPosition)
+		if err != nil {
+			log.Error("unmarshal segment dml position failed", zap.Int64("segmentID", s.SegmentID), zap.Int64("collID", s.CollectionID), zap.Error(err))
+			return nil, err
+		}
+
+		startPosition, err := unmarshalPosition(s.StartPosition)
+		if err != nil {
+			log.Error("unmarshal segment start position failed", zap.Int64("segmentID", s.SegmentID), zap.Int64("collID", s.CollectionID), zap.Error(err))
+			return nil, err
+		}
+
+		var compactionFrom []int64
+		if s.CompactionFrom != "" {
+			if err := json.Unmarshal([]byte(s.CompactionFrom), &compactionFrom); err != nil {
+				log.Error("unmarshal segment compaction from failed", zap.Int64("segmentID", s.SegmentID), zap.Int64("collID", s.CollectionID), zap.Error(err))
+				return nil, err
+			}
+		}
+
+		segment := &datapb.SegmentInfo{
+			ID:                  s.SegmentID,
+			CollectionID:        s.CollectionID,
+			PartitionID:         s.PartitionID,
+			InsertChannel:       s.DmChannel,
+			NumOfRows:           s.NumRows,
+			State:               commonpb.SegmentState(s.SegmentState),
+			MaxRowNum:           s.MaxRowNum,
+			LastExpireTime:      s.LastExpireTime,
+			StartPosition:       startPosition,
+			DmlPosition:         dmlPosition,
+			CreatedByCompaction: s.CreatedByCompaction,
+			CompactionFrom:      compactionFrom,
+			DroppedAt:           s.DroppedAt,
+			IsImporting:         s.IsImporting,
+			IsFake:              s.IsFake,
+		}
+		segmentMap[s.SegmentID] = segment
+		result = append(result, segment)
+	}
+
+	for _, b := range binlogs {
+		segment, ok := segmentMap[b.SegmentID]
+		if !ok {
+			continue
+		}
+
+		binlog := &datapb.Binlog{
+			EntriesNum:    b.NumEntries,
+			TimestampFrom: b.TimestampFrom,
+			TimestampTo:   b.TimestampTo,
+			LogPath:       b.LogPath,
+			LogSize:       b.LogSize,
+			LogID:         b.LogID,
+		}
+		switch storage.BinlogType(b.LogType) {
+		case storage.InsertBinlog:
+			segment.Binlogs = appendFieldBinlog(segment.Binlogs, b.FieldID, binlog)
+		case storage.DeleteBinlog:
+			segment.Deltalogs = appendFieldBinlog(segment.Deltalogs, b.FieldID, binlog)
+		case storage.StatsBinlog:
+			segment.Statslogs = appendFieldBinlog(segment.Statslogs, b.FieldID, binlog)
+		}
+	}
+
+	return result, nil
+}
+
+func appendFieldBinlog(fieldBinlogs []*datapb.FieldBinlog, fieldID typeutil.UniqueID, binlog *datapb.Binlog) []*datapb.FieldBinlog {
+	for _, fieldBinlog := range fieldBinlogs {
+		if fieldBinlog.GetFieldID() == fieldID {
+			fieldBinlog.Binlogs = append(fieldBinlog.Binlogs, binlog)
+			return fieldBinlogs
+		}
+	}
+	return append(fieldBinlogs, &datapb.FieldBinlog{FieldID: fieldID, Binlogs: []*datapb.Binlog{binlog}})
+}
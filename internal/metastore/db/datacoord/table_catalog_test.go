@@ -0,0 +1,438 @@
+package datacoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/contextutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	tenantID     = "test_tenant"
+	collID1      = typeutil.UniqueID(101)
+	partitionID1 = typeutil.UniqueID(500)
+	fieldID1     = typeutil.UniqueID(1000)
+	segmentID1   = typeutil.UniqueID(2000)
+	segmentID2   = typeutil.UniqueID(2001)
+	segmentID3   = typeutil.UniqueID(2002)
+
+	vChannel1 = "test_vchannel_1"
+)
+
+var (
+	ctx              context.Context
+	metaDomainMock   *mocks.IMetaDomain
+	segmentDbMock    *mocks.ISegmentDb
+	binlogDbMock     *mocks.IBinlogDb
+	channelCPDbMock  *mocks.IChannelCheckpointDb
+	droppedChDbMock  *mocks.IDroppedChannelDb
+	flushKV          *memkv.MemoryKV
+	mockCatalog      *Catalog
+	errTest          = errors.New("test error")
+	segmentWithLogs1 = &datapb.SegmentInfo{
+		ID:             segmentID1,
+		CollectionID:   collID1,
+		PartitionID:    partitionID1,
+		InsertChannel:  vChannel1,
+		NumOfRows:      100,
+		State:          commonpb.SegmentState_Flushed,
+		CompactionFrom: []int64{segmentID2, segmentID3},
+		DmlPosition: &internalpb.MsgPosition{
+			ChannelName: vChannel1,
+			MsgID:       []byte{1, 2, 3},
+			Timestamp:   1000,
+		},
+		Binlogs: []*datapb.FieldBinlog{
+			{
+				FieldID: fieldID1,
+				Binlogs: []*datapb.Binlog{{LogID: 1, EntriesNum: 50}, {LogID: 2, EntriesNum: 50}},
+			},
+		},
+		Deltalogs: []*datapb.FieldBinlog{
+			{
+				FieldID: fieldID1,
+				Binlogs: []*datapb.Binlog{{LogID: 3}},
+			},
+		},
+		Statslogs: []*datapb.FieldBinlog{
+			{
+				FieldID: fieldID1,
+				Binlogs: []*datapb.Binlog{{LogID: 4}},
+			},
+		},
+	}
+)
+
+var _ metastore.DataCoordCatalog = &Catalog{}
+
+// TestMain is the first function executed in current package, we will do some initial here
+func TestMain(m *testing.M) {
+	ctx = contextutil.WithTenantID(context.Background(), tenantID)
+
+	segmentDbMock = &mocks.ISegmentDb{}
+	binlogDbMock = &mocks.IBinlogDb{}
+	channelCPDbMock = &mocks.IChannelCheckpointDb{}
+	droppedChDbMock = &mocks.IDroppedChannelDb{}
+
+	metaDomainMock = &mocks.IMetaDomain{}
+	metaDomainMock.On("SegmentDb", ctx).Return(segmentDbMock)
+	metaDomainMock.On("BinlogDb", ctx).Return(binlogDbMock)
+	metaDomainMock.On("ChannelCheckpointDb", ctx).Return(channelCPDbMock)
+	metaDomainMock.On("DroppedChannelDb", ctx).Return(droppedChDbMock)
+
+	flushKV = memkv.NewMemoryKV()
+	mockCatalog = mockMetaCatalog(metaDomainMock)
+
+	// m.Run entry for executing tests
+	os.Exit(m.Run())
+}
+
+type NoopTransaction struct{}
+
+func (*NoopTransaction) Transaction(ctx context.Context, fn func(txctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func mockMetaCatalog(petDomain dbmodel.IMetaDomain) *Catalog {
+	return NewTableCatalog(&NoopTransaction{}, petDomain, flushKV)
+}
+
+func TestTableCatalog_AddSegment_ListSegments(t *testing.T) {
+	var (
+		savedSegments []*dbmodel.Segment
+		savedBinlogs  []*dbmodel.Binlog
+	)
+
+	// expectation
+	segmentDbMock.On("Upsert", mock.Anything).Run(func(args mock.Arguments) {
+		savedSegments = args.Get(0).([]*dbmodel.Segment)
+	}).Return(nil).Once()
+	binlogDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID1}).Return(nil).Once()
+	binlogDbMock.On("Insert", mock.Anything).Run(func(args mock.Arguments) {
+		savedBinlogs = args.Get(0).([]*dbmodel.Binlog)
+	}).Return(nil).Once()
+
+	// actual
+	gotErr := mockCatalog.AddSegment(ctx, segmentWithLogs1)
+	require.NoError(t, gotErr)
+	require.Equal(t, 1, len(savedSegments))
+	require.Equal(t, tenantID, savedSegments[0].TenantID)
+	require.Equal(t, int32(commonpb.SegmentState_Flushed), savedSegments[0].SegmentState)
+	require.Equal(t, 4, len(savedBinlogs))
+	require.Equal(t, int32(storage.DeleteBinlog), savedBinlogs[2].LogType)
+
+	// the saved rows are converted back into the same segment
+	segmentDbMock.On("List", tenantID).Return(savedSegments, nil).Once()
+	binlogDbMock.On("List", tenantID).Return(savedBinlogs, nil).Once()
+
+	segments, gotErr := mockCatalog.ListSegments(ctx)
+	require.NoError(t, gotErr)
+	require.Equal(t, 1, len(segments))
+	require.Equal(t, segmentWithLogs1.String(), segments[0].String())
+}
+
+func TestTableCatalog_AddSegment_UpsertError(t *testing.T) {
+	// expectation
+	segmentDbMock.On("Upsert", mock.Anything).Return(errTest).Once()
+
+	// actual
+	gotErr := mockCatalog.AddSegment(ctx, segmentWithLogs1)
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_AddSegment_InsertBinlogError(t *testing.T) {
+	// expectation
+	segmentDbMock.On("Upsert", mock.Anything).Return(nil).Once()
+	binlogDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID1}).Return(nil).Once()
+	binlogDbMock.On("Insert", mock.Anything).Return(errTest).Once()
+
+	// actual
+	gotErr := mockCatalog.AddSegment(ctx, segmentWithLogs1)
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_ListSegments_SelectSegmentError(t *testing.T) {
+	// expectation
+	segmentDbMock.On("List", tenantID).Return(nil, errTest).Once()
+
+	// actual
+	res, gotErr := mockCatalog.ListSegments(ctx)
+	require.Nil(t, res)
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_ListSegments_SelectBinlogError(t *testing.T) {
+	// expectation
+	segmentDbMock.On("List", tenantID).Return([]*dbmodel.Segment{{SegmentID: segmentID1}}, nil).Once()
+	binlogDbMock.On("List", tenantID).Return(nil, errTest).Once()
+
+	// actual
+	res, gotErr := mockCatalog.ListSegments(ctx)
+	require.Nil(t, res)
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_ListSegments_UnmarshalError(t *testing.T) {
+	// expectation
+	segmentDbMock.On("List", tenantID).Return([]*dbmodel.Segment{{SegmentID: segmentID1, DmlPosition: "invalid"}}, nil).Once()
+	binlogDbMock.On("List", tenantID).Return(nil, nil).Once()
+
+	// actual
+	res, gotErr := mockCatalog.ListSegments(ctx)
+	require.Nil(t, res)
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_AlterSegments(t *testing.T) {
+	// empty input is a no-op
+	gotErr := mockCatalog.AlterSegments(ctx, nil)
+	require.NoError(t, gotErr)
+
+	segment2 := &datapb.SegmentInfo{ID: segmentID2, CollectionID: collID1, PartitionID: partitionID1}
+
+	// expectation
+	segmentDbMock.On("Upsert", mock.MatchedBy(func(in []*dbmodel.Segment) bool {
+		return len(in) == 2
+	})).Return(nil).Once()
+	binlogDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID1, segmentID2}).Return(nil).Once()
+	binlogDbMock.On("Insert", mock.Anything).Return(nil).Once()
+
+	// actual
+	gotErr = mockCatalog.AlterSegments(ctx, []*datapb.SegmentInfo{segmentWithLogs1, segment2})
+	require.NoError(t, gotErr)
+}
+
+func TestTableCatalog_AlterSegment(t *testing.T) {
+	segment := &datapb.SegmentInfo{ID: segmentID2, CollectionID: collID1, PartitionID: partitionID1, State: commonpb.SegmentState_Flushed}
+
+	// expectation
+	segmentDbMock.On("Upsert", mock.Anything).Return(nil).Once()
+	binlogDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID2}).Return(nil).Once()
+
+	// actual
+	gotErr := mockCatalog.AlterSegment(ctx, segment, &datapb.SegmentInfo{ID: segmentID2, State: commonpb.SegmentState_Flushing})
+	require.NoError(t, gotErr)
+
+	// the flushed segment is notified to IndexCoord
+	flushKey := fmt.Sprintf("%s/%d/%d/%d", util.FlushedSegmentPrefix, collID1, partitionID1, segmentID2)
+	value, err := flushKV.Load(flushKey)
+	require.NoError(t, err)
+	notified := &datapb.SegmentInfo{}
+	require.NoError(t, proto.Unmarshal([]byte(value), notified))
+	require.Equal(t, segmentID2, notified.GetID())
+	require.NoError(t, flushKV.Remove(flushKey))
+
+	// already flushed
+	segmentDbMock.On("Upsert", mock.Anything).Return(nil).Once()
+	binlogDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID2}).Return(nil).Once()
+	gotErr = mockCatalog.AlterSegment(ctx, segment, segment)
+	require.NoError(t, gotErr)
+	_, err = flushKV.Load(flushKey)
+	require.Error(t, err)
+}
+
+func TestTableCatalog_AlterSegment_DeleteBinlogError(t *testing.T) {
+	// expectation
+	segmentDbMock.On("Upsert", mock.Anything).Return(nil).Once()
+	binlogDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID1}).Return(errTest).Once()
+
+	// actual
+	gotErr := mockCatalog.AlterSegment(ctx, segmentWithLogs1, segmentWithLogs1)
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_AlterSegmentsAndAddNewSegment(t *testing.T) {
+	dropped := &datapb.SegmentInfo{ID: segmentID2, CollectionID: collID1, State: commonpb.SegmentState_Dropped}
+
+	// expectation: compacted segments keep their binlogs, the new segment is saved with binlogs
+	segmentDbMock.On("Upsert", mock.MatchedBy(func(in []*dbmodel.Segment) bool {
+		return len(in) == 1 && in[0].SegmentID == segmentID2
+	})).Return(nil).Once()
+	segmentDbMock.On("Upsert", mock.MatchedBy(func(in []*dbmodel.Segment) bool {
+		return len(in) == 1 && in[0].SegmentID == segmentID1
+	})).Return(nil).Once()
+	binlogDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID1}).Return(nil).Once()
+	binlogDbMock.On("Insert", mock.Anything).Return(nil).Once()
+
+	// actual
+	gotErr := mockCatalog.AlterSegmentsAndAddNewSegment(ctx, []*datapb.SegmentInfo{dropped}, segmentWithLogs1)
+	require.NoError(t, gotErr)
+}
+
+func TestTableCatalog_AlterSegmentsAndAddNewSegment_FakeSegment(t *testing.T) {
+	dropped := &datapb.SegmentInfo{ID: segmentID2, CollectionID: collID1, State: commonpb.SegmentState_Dropped}
+	fake := &datapb.SegmentInfo{ID: segmentID3, CollectionID: collID1, NumOfRows: 0}
+
+	// expectation
+	segmentDbMock.On("Upsert", mock.MatchedBy(func(in []*dbmodel.Segment) bool {
+		return len(in) == 1 && in[0].SegmentID == segmentID2
+	})).Return(nil).Once()
+
+	// actual
+	gotErr := mockCatalog.AlterSegmentsAndAddNewSegment(ctx, []*datapb.SegmentInfo{dropped}, fake)
+	require.NoError(t, gotErr)
+
+	// the faked segment is only notified to IndexCoord
+	flushKey := fmt.Sprintf("%s/%d/%d/%d", util.FlushedSegmentPrefix, collID1, 0, segmentID3)
+	value, err := flushKV.Load(flushKey)
+	require.NoError(t, err)
+	notified := &datapb.SegmentInfo{}
+	require.NoError(t, proto.Unmarshal([]byte(value), notified))
+	require.True(t, notified.GetIsFake())
+	require.NoError(t, flushKV.Remove(flushKey))
+}
+
+func TestTableCatalog_AlterSegmentsAndAddNewSegment_UpsertError(t *testing.T) {
+	// expectation
+	segmentDbMock.On("Upsert", mock.Anything).Return(errTest).Once()
+
+	// actual
+	gotErr := mockCatalog.AlterSegmentsAndAddNewSegment(ctx, []*datapb.SegmentInfo{segmentWithLogs1}, nil)
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_RevertAlterSegmentsAndAddNewSegment(t *testing.T) {
+	removal := &datapb.SegmentInfo{ID: segmentID3, CollectionID: collID1}
+
+	// expectation
+	segmentDbMock.On("Upsert", mock.Anything).Return(nil).Once()
+	binlogDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID1}).Return(nil).Once()
+	binlogDbMock.On("Insert", mock.Anything).Return(nil).Once()
+	segmentDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID3}).Return(nil).Once()
+	binlogDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID3}).Return(nil).Once()
+
+	// actual
+	gotErr := mockCatalog.RevertAlterSegmentsAndAddNewSegment(ctx, []*datapb.SegmentInfo{segmentWithLogs1}, removal)
+	require.NoError(t, gotErr)
+}
+
+func TestTableCatalog_RevertAlterSegmentsAndAddNewSegment_DeleteError(t *testing.T) {
+	removal := &datapb.SegmentInfo{ID: segmentID3, CollectionID: collID1}
+
+	// expectation
+	segmentDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID3}).Return(errTest).Once()
+
+	// actual
+	gotErr := mockCatalog.RevertAlterSegmentsAndAddNewSegment(ctx, nil, removal)
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_SaveDroppedSegmentsInBatch(t *testing.T) {
+	// empty input is a no-op
+	gotErr := mockCatalog.SaveDroppedSegmentsInBatch(ctx, nil)
+	require.NoError(t, gotErr)
+
+	// expectation: binlogs are left untouched
+	segmentDbMock.On("Upsert", mock.Anything).Return(nil).Once()
+
+	// actual
+	gotErr = mockCatalog.SaveDroppedSegmentsInBatch(ctx, []*datapb.SegmentInfo{segmentWithLogs1})
+	require.NoError(t, gotErr)
+}
+
+func TestTableCatalog_DropSegment(t *testing.T) {
+	// expectation
+	segmentDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID1}).Return(nil).Once()
+	binlogDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID1}).Return(nil).Once()
+
+	// actual
+	gotErr := mockCatalog.DropSegment(ctx, segmentWithLogs1)
+	require.NoError(t, gotErr)
+}
+
+func TestTableCatalog_DropSegment_DeleteBinlogError(t *testing.T) {
+	// expectation
+	segmentDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID1}).Return(nil).Once()
+	binlogDbMock.On("Delete", tenantID, []typeutil.UniqueID{segmentID1}).Return(errTest).Once()
+
+	// actual
+	gotErr := mockCatalog.DropSegment(ctx, segmentWithLogs1)
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_ChannelDropped(t *testing.T) {
+	// expectation
+	droppedChDbMock.On("Insert", &dbmodel.DroppedChannel{TenantID: tenantID, ChannelName: vChannel1}).Return(nil).Once()
+	droppedChDbMock.On("Has", tenantID, vChannel1).Return(true, nil).Once()
+	droppedChDbMock.On("Delete", tenantID, vChannel1).Return(nil).Once()
+	droppedChDbMock.On("Has", tenantID, vChannel1).Return(false, errTest).Once()
+
+	// actual
+	gotErr := mockCatalog.MarkChannelDeleted(ctx, vChannel1)
+	require.NoError(t, gotErr)
+	require.True(t, mockCatalog.IsChannelDropped(ctx, vChannel1))
+
+	gotErr = mockCatalog.DropChannel(ctx, vChannel1)
+	require.NoError(t, gotErr)
+	require.False(t, mockCatalog.IsChannelDropped(ctx, vChannel1))
+}
+
+func TestTableCatalog_MarkChannelDeleted_InsertError(t *testing.T) {
+	// expectation
+	droppedChDbMock.On("Insert", mock.Anything).Return(errTest).Once()
+
+	// actual
+	gotErr := mockCatalog.MarkChannelDeleted(ctx, vChannel1)
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_ChannelCheckpoint(t *testing.T) {
+	pos := &internalpb.MsgPosition{
+		ChannelName: vChannel1,
+		MsgID:       []byte{1, 2, 3},
+		MsgGroup:    "test_group",
+		Timestamp:   1000,
+	}
+	var saved *dbmodel.ChannelCheckpoint
+
+	// expectation
+	channelCPDbMock.On("Upsert", mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(0).(*dbmodel.ChannelCheckpoint)
+	}).Return(nil).Once()
+
+	// actual
+	gotErr := mockCatalog.SaveChannelCheckpoint(ctx, vChannel1, pos)
+	require.NoError(t, gotErr)
+	require.Equal(t, vChannel1, saved.VirtualChannelName)
+
+	channelCPDbMock.On("List", tenantID).Return([]*dbmodel.ChannelCheckpoint{saved}, nil).Once()
+	cps, gotErr := mockCatalog.ListChannelCheckpoint(ctx)
+	require.NoError(t, gotErr)
+	require.Equal(t, 1, len(cps))
+	require.Equal(t, pos.String(), cps[vChannel1].String())
+
+	channelCPDbMock.On("Delete", tenantID, vChannel1).Return(nil).Once()
+	gotErr = mockCatalog.DropChannelCheckpoint(ctx, vChannel1)
+	require.NoError(t, gotErr)
+}
+
+func TestTableCatalog_ListChannelCheckpoint_Error(t *testing.T) {
+	// expectation
+	channelCPDbMock.On("List", tenantID).Return(nil, errTest).Once()
+
+	// actual
+	res, gotErr := mockCatalog.ListChannelCheckpoint(ctx)
+	require.Nil(t, res)
+	require.Error(t, gotErr)
+
+	channelCPDbMock.On("List", tenantID).Return([]*dbmodel.ChannelCheckpoint{{VirtualChannelName: vChannel1, Position: "invalid"}}, nil).Once()
+	res, gotErr = mockCatalog.ListChannelCheckpoint(ctx)
+	require.Nil(t, res)
+	require.Error(t, gotErr)
+}
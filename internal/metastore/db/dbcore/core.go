@@ -6,9 +6,11 @@ import (
 	"reflect"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"go.uber.org/zap"
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
@@ -18,20 +20,17 @@ var (
 )
 
 func Connect(cfg *paramtable.MetaDBConfig) error {
+	if cfg.Driver == util.MetaDBDriverSqlite {
+		return connectSqlite(cfg)
+	}
+	return connectMysql(cfg)
+}
+
+func connectMysql(cfg *paramtable.MetaDBConfig) error {
 	// load config
 	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local", cfg.Username, cfg.Password, cfg.Address, cfg.Port, cfg.DBName)
 
-	var ormLogger logger.Interface
-	if cfg.Base.Log.Level == "debug" {
-		ormLogger = logger.Default.LogMode(logger.Info)
-	} else {
-		ormLogger = logger.Default
-	}
-
-	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
-		Logger:          ormLogger,
-		CreateBatchSize: 100,
-	})
+	db, err := gorm.Open(mysql.Open(dsn), newGormConfig(cfg))
 	if err != nil {
 		log.Error("fail to connect db", zap.String("host", cfg.Address), zap.Int("port", cfg.Port), zap.String("database", cfg.DBName), zap.Error(err))
 		return err
@@ -52,6 +51,55 @@ func Connect(cfg *paramtable.MetaDBConfig) error {
 	return nil
 }
 
+// connectSqlite opens the sqlite database file and creates the meta tables if they do not exist yet.
+// Transactions take the write lock up front (_txlock=immediate) and wait for it instead of failing with
+// SQLITE_BUSY, since sqlite has no row-level locking.
+func connectSqlite(cfg *paramtable.MetaDBConfig) error {
+	dsn := fmt.Sprintf("file:%s?_busy_timeout=5000&_journal_mode=WAL&_txlock=immediate", cfg.SqlitePath)
+
+	gormCfg := newGormConfig(cfg)
+	// keep the same schema as scripts/sql/meta.sql, which declares no foreign keys
+	gormCfg.DisableForeignKeyConstraintWhenMigrating = true
+	db, err := gorm.Open(sqlite.Open(dsn), gormCfg)
+	if err != nil {
+		log.Error("fail to open sqlite db", zap.String("path", cfg.SqlitePath), zap.Error(err))
+		return err
+	}
+
+	idb, err := db.DB()
+	if err != nil {
+		log.Error("fail to create db instance", zap.String("path", cfg.SqlitePath), zap.Error(err))
+		return err
+	}
+	idb.SetMaxIdleConns(cfg.MaxIdleConns)
+	idb.SetMaxOpenConns(cfg.MaxOpenConns)
+
+	if err := AutoMigrate(db); err != nil {
+		log.Error("fail to migrate sqlite db", zap.String("path", cfg.SqlitePath), zap.Error(err))
+		return err
+	}
+
+	globalDB = db
+
+	log.Info("db connected success", zap.String("driver", cfg.Driver), zap.String("path", cfg.SqlitePath))
+
+	return nil
+}
+
+func newGormConfig(cfg *paramtable.MetaDBConfig) *gorm.Config {
+	var ormLogger logger.Interface
+	if cfg.Base.Log.Level == "debug" {
+		ormLogger = logger.Default.LogMode(logger.Info)
+	} else {
+		ormLogger = logger.Default
+	}
+
+	return &gorm.Config{
+		Logger:          ormLogger,
+		CreateBatchSize: 100,
+	}
+}
+
 // SetGlobalDB Only for test
 func SetGlobalDB(db *gorm.DB) {
 	globalDB = db
@@ -0,0 +1,41 @@
+package dbcore
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnect_Sqlite(t *testing.T) {
+	cfg := &paramtable.MetaDBConfig{
+		Base:         &paramtable.BaseTable{},
+		Driver:       util.MetaDBDriverSqlite,
+		SqlitePath:   filepath.Join(t.TempDir(), "meta.db"),
+		MaxOpenConns: 4,
+		MaxIdleConns: 2,
+	}
+	require.NoError(t, Connect(cfg))
+
+	ctx := context.TODO()
+	for _, table := range metaTables {
+		assert.True(t, GetDB(ctx).Migrator().HasTable(table))
+	}
+
+	// migrating an existing database is a no-op
+	require.NoError(t, Connect(cfg))
+
+	err := NewTxImpl().Transaction(ctx, func(txCtx context.Context) error {
+		return GetDB(txCtx).Create(&dbmodel.DroppedChannel{TenantID: "tenant", ChannelName: "ch"}).Error
+	})
+	require.NoError(t, err)
+
+	var count int64
+	require.NoError(t, GetDB(ctx).Model(&dbmodel.DroppedChannel{}).Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+}
@@ -0,0 +1,35 @@
+package dbcore
+
+import (
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"gorm.io/gorm"
+)
+
+// metaTables lists every table of the meta store, it MUST be kept in sync with scripts/sql/meta.sql.
+var metaTables = []interface{}{
+	&dbmodel.Collection{},
+	&dbmodel.CollectionAlias{},
+	&dbmodel.CollectionChannel{},
+	&dbmodel.Field{},
+	&dbmodel.Partition{},
+	&dbmodel.Index{},
+	&dbmodel.SegmentIndex{},
+	&dbmodel.Segment{},
+	&dbmodel.Binlog{},
+	&dbmodel.ChannelCheckpoint{},
+	&dbmodel.DroppedChannel{},
+	&dbmodel.CollectionLoadInfo{},
+	&dbmodel.PartitionLoadInfo{},
+	&dbmodel.Replica{},
+	&dbmodel.User{},
+	&dbmodel.Role{},
+	&dbmodel.UserRole{},
+	&dbmodel.Grant{},
+	&dbmodel.GrantID{},
+}
+
+// AutoMigrate creates the missing meta tables, columns and unique indexes.
+// It is used for drivers without a provisioning script such as sqlite, mysql deployments use scripts/sql/meta.sql.
+func AutoMigrate(db *gorm.DB) error {
+	return db.AutoMigrate(metaTables...)
+}
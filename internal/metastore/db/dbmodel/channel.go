@@ -0,0 +1,44 @@
+package dbmodel
+
+import (
+	"time"
+)
+
+type ChannelCheckpoint struct {
+	ID                 int64     `gorm:"id"`
+	TenantID           string    `gorm:"tenant_id;uniqueIndex:uk_tenant_id_virtual_channel_name,priority:1"`
+	VirtualChannelName string    `gorm:"virtual_channel_name;uniqueIndex:uk_tenant_id_virtual_channel_name,priority:2"`
+	Position           string    `gorm:"position"`
+	CreatedAt          time.Time `gorm:"created_at"`
+	UpdatedAt          time.Time `gorm:"updated_at"`
+}
+
+func (v ChannelCheckpoint) TableName() string {
+	return "channel_checkpoints"
+}
+
+//go:generate mockery --name=IChannelCheckpointDb
+type IChannelCheckpointDb interface {
+	List(tenantID string) ([]*ChannelCheckpoint, error)
+	Upsert(in *ChannelCheckpoint) error
+	Delete(tenantID string, vChannel string) error
+}
+
+type DroppedChannel struct {
+	ID          int64     `gorm:"id"`
+	TenantID    string    `gorm:"tenant_id;uniqueIndex:uk_tenant_id_channel_name,priority:1"`
+	ChannelName string    `gorm:"channel_name;uniqueIndex:uk_tenant_id_channel_name,priority:2"`
+	CreatedAt   time.Time `gorm:"created_at"`
+	UpdatedAt   time.Time `gorm:"updated_at"`
+}
+
+func (v DroppedChannel) TableName() string {
+	return "dropped_channels"
+}
+
+//go:generate mockery --name=IDroppedChannelDb
+type IDroppedChannelDb interface {
+	Has(tenantID string, channel string) (bool, error)
+	Insert(in *DroppedChannel) error
+	Delete(tenantID string, channel string) error
+}
@@ -14,8 +14,8 @@ import (
 
 type Collection struct {
 	ID               int64              `gorm:"id"`
-	TenantID         string             `gorm:"tenant_id"`
-	CollectionID     int64              `gorm:"collection_id"`
+	TenantID         string             `gorm:"tenant_id;uniqueIndex:uk_tenant_id_collection_id_ts,priority:1"`
+	CollectionID     int64              `gorm:"collection_id;uniqueIndex:uk_tenant_id_collection_id_ts,priority:2"`
 	CollectionName   string             `gorm:"collection_name"`
 	Description      string             `gorm:"description"`
 	AutoID           bool               `gorm:"auto_id"`
@@ -24,7 +24,7 @@ type Collection struct {
 	ConsistencyLevel int32              `gorm:"consistency_level"`
 	Status           int32              `gorm:"status"`
 	Properties       string             `gorm:"properties"`
-	Ts               typeutil.Timestamp `gorm:"ts"`
+	Ts               typeutil.Timestamp `gorm:"ts;uniqueIndex:uk_tenant_id_collection_id_ts,priority:3"`
 	IsDeleted        bool               `gorm:"is_deleted"`
 	CreatedAt        time.Time          `gorm:"created_at"`
 	UpdatedAt        time.Time          `gorm:"updated_at"`
@@ -8,10 +8,10 @@ import (
 
 type CollectionAlias struct {
 	ID              int64              `gorm:"id"`
-	TenantID        string             `gorm:"tenant_id"`
+	TenantID        string             `gorm:"tenant_id;uniqueIndex:uk_tenant_id_collection_alias_ts,priority:1"`
 	CollectionID    int64              `gorm:"collection_id"`
-	CollectionAlias string             `gorm:"collection_alias"`
-	Ts              typeutil.Timestamp `gorm:"ts"`
+	CollectionAlias string             `gorm:"collection_alias;uniqueIndex:uk_tenant_id_collection_alias_ts,priority:2"`
+	Ts              typeutil.Timestamp `gorm:"ts;uniqueIndex:uk_tenant_id_collection_alias_ts,priority:3"`
 	IsDeleted       bool               `gorm:"is_deleted"`
 	CreatedAt       time.Time          `gorm:"created_at"`
 	UpdatedAt       time.Time          `gorm:"updated_at"`
@@ -8,12 +8,12 @@ import (
 
 type CollectionChannel struct {
 	ID                  int64              `gorm:"id"`
-	TenantID            string             `gorm:"tenant_id"`
-	CollectionID        int64              `gorm:"collection_id"`
-	VirtualChannelName  string             `gorm:"virtual_channel_name"`
+	TenantID            string             `gorm:"tenant_id;uniqueIndex:uk_tenant_id_collection_id_virtual_channel_name_ts,priority:1"`
+	CollectionID        int64              `gorm:"collection_id;uniqueIndex:uk_tenant_id_collection_id_virtual_channel_name_ts,priority:2"`
+	VirtualChannelName  string             `gorm:"virtual_channel_name;uniqueIndex:uk_tenant_id_collection_id_virtual_channel_name_ts,priority:3"`
 	PhysicalChannelName string             `gorm:"physical_channel_name"`
 	Removed             bool               `gorm:"removed"`
-	Ts                  typeutil.Timestamp `gorm:"ts"`
+	Ts                  typeutil.Timestamp `gorm:"ts;uniqueIndex:uk_tenant_id_collection_id_virtual_channel_name_ts,priority:4"`
 	IsDeleted           bool               `gorm:"is_deleted"`
 	CreatedAt           time.Time          `gorm:"created_at"`
 	UpdatedAt           time.Time          `gorm:"updated_at"`
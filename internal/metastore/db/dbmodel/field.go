@@ -15,17 +15,17 @@ import (
 
 type Field struct {
 	ID           int64              `gorm:"id"`
-	TenantID     string             `gorm:"tenant_id"`
+	TenantID     string             `gorm:"tenant_id;uniqueIndex:uk_tenant_id_collection_id_field_name_ts,priority:1"`
 	FieldID      int64              `gorm:"field_id"`
-	FieldName    string             `gorm:"field_name"`
+	FieldName    string             `gorm:"field_name;uniqueIndex:uk_tenant_id_collection_id_field_name_ts,priority:3"`
 	IsPrimaryKey bool               `gorm:"is_primary_key"`
 	Description  string             `gorm:"description"`
 	DataType     schemapb.DataType  `gorm:"data_type"`
 	TypeParams   string             `gorm:"type_params"`
 	IndexParams  string             `gorm:"index_params"`
 	AutoID       bool               `gorm:"auto_id"`
-	CollectionID int64              `gorm:"collection_id"`
-	Ts           typeutil.Timestamp `gorm:"ts"`
+	CollectionID int64              `gorm:"collection_id;uniqueIndex:uk_tenant_id_collection_id_field_name_ts,priority:2"`
+	Ts           typeutil.Timestamp `gorm:"ts;uniqueIndex:uk_tenant_id_collection_id_field_name_ts,priority:4"`
 	IsDeleted    bool               `gorm:"is_deleted"`
 	CreatedAt    time.Time          `gorm:"created_at"`
 	UpdatedAt    time.Time          `gorm:"updated_at"`
@@ -0,0 +1,75 @@
+package dbmodel
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type CollectionLoadInfo struct {
+	ID                 int64     `gorm:"id"`
+	TenantID           string    `gorm:"tenant_id;uniqueIndex:uk_tenant_id_collection_id,priority:1"`
+	CollectionID       int64     `gorm:"collection_id;uniqueIndex:uk_tenant_id_collection_id,priority:2"`
+	ReleasedPartitions string    `gorm:"released_partitions"`
+	ReplicaNumber      int32     `gorm:"replica_number"`
+	Status             int32     `gorm:"status"`
+	FieldIndexID       string    `gorm:"field_index_id"`
+	CreatedAt          time.Time `gorm:"created_at"`
+	UpdatedAt          time.Time `gorm:"updated_at"`
+}
+
+func (v CollectionLoadInfo) TableName() string {
+	return "collection_load_infos"
+}
+
+//go:generate mockery --name=ICollectionLoadInfoDb
+type ICollectionLoadInfoDb interface {
+	List(tenantID string) ([]*CollectionLoadInfo, error)
+	Upsert(in *CollectionLoadInfo) error
+	Delete(tenantID string, collectionID typeutil.UniqueID) error
+}
+
+type PartitionLoadInfo struct {
+	ID            int64     `gorm:"id"`
+	TenantID      string    `gorm:"tenant_id;uniqueIndex:uk_tenant_id_collection_id_partition_id,priority:1"`
+	CollectionID  int64     `gorm:"collection_id;uniqueIndex:uk_tenant_id_collection_id_partition_id,priority:2"`
+	PartitionID   int64     `gorm:"partition_id;uniqueIndex:uk_tenant_id_collection_id_partition_id,priority:3"`
+	ReplicaNumber int32     `gorm:"replica_number"`
+	Status        int32     `gorm:"status"`
+	FieldIndexID  string    `gorm:"field_index_id"`
+	CreatedAt     time.Time `gorm:"created_at"`
+	UpdatedAt     time.Time `gorm:"updated_at"`
+}
+
+func (v PartitionLoadInfo) TableName() string {
+	return "partition_load_infos"
+}
+
+//go:generate mockery --name=IPartitionLoadInfoDb
+type IPartitionLoadInfoDb interface {
+	List(tenantID string) ([]*PartitionLoadInfo, error)
+	Upsert(in []*PartitionLoadInfo) error
+	Delete(tenantID string, collectionID typeutil.UniqueID, partitionIDs []typeutil.UniqueID) error
+}
+
+type Replica struct {
+	ID           int64     `gorm:"id"`
+	TenantID     string    `gorm:"tenant_id;uniqueIndex:uk_tenant_id_collection_id_replica_id,priority:1"`
+	ReplicaID    int64     `gorm:"replica_id;uniqueIndex:uk_tenant_id_collection_id_replica_id,priority:3"`
+	CollectionID int64     `gorm:"collection_id;uniqueIndex:uk_tenant_id_collection_id_replica_id,priority:2"`
+	Nodes        string    `gorm:"nodes"`
+	CreatedAt    time.Time `gorm:"created_at"`
+	UpdatedAt    time.Time `gorm:"updated_at"`
+}
+
+func (v Replica) TableName() string {
+	return "replicas"
+}
+
+//go:generate mockery --name=IReplicaDb
+type IReplicaDb interface {
+	List(tenantID string) ([]*Replica, error)
+	Upsert(in *Replica) error
+	Delete(tenantID string, collectionID typeutil.UniqueID, replicaID typeutil.UniqueID) error
+	DeleteByCollectionID(tenantID string, collectionID typeutil.UniqueID) error
+}
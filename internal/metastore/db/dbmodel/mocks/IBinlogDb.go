@@ -0,0 +1,79 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IBinlogDb is an autogenerated mock type for the IBinlogDb type
+type IBinlogDb struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: tenantID, segmentIDs
+func (_m *IBinlogDb) Delete(tenantID string, segmentIDs []int64) error {
+	ret := _m.Called(tenantID, segmentIDs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []int64) error); ok {
+		r0 = rf(tenantID, segmentIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Insert provides a mock function with given fields: in
+func (_m *IBinlogDb) Insert(in []*dbmodel.Binlog) error {
+	ret := _m.Called(in)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*dbmodel.Binlog) error); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: tenantID
+func (_m *IBinlogDb) List(tenantID string) ([]*dbmodel.Binlog, error) {
+	ret := _m.Called(tenantID)
+
+	var r0 []*dbmodel.Binlog
+	if rf, ok := ret.Get(0).(func(string) []*dbmodel.Binlog); ok {
+		r0 = rf(tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Binlog)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewIBinlogDb interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewIBinlogDb creates a new instance of IBinlogDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewIBinlogDb(t mockConstructorTestingTNewIBinlogDb) *IBinlogDb {
+	mock := &IBinlogDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,79 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IChannelCheckpointDb is an autogenerated mock type for the IChannelCheckpointDb type
+type IChannelCheckpointDb struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: tenantID, vChannel
+func (_m *IChannelCheckpointDb) Delete(tenantID string, vChannel string) error {
+	ret := _m.Called(tenantID, vChannel)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(tenantID, vChannel)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: tenantID
+func (_m *IChannelCheckpointDb) List(tenantID string) ([]*dbmodel.ChannelCheckpoint, error) {
+	ret := _m.Called(tenantID)
+
+	var r0 []*dbmodel.ChannelCheckpoint
+	if rf, ok := ret.Get(0).(func(string) []*dbmodel.ChannelCheckpoint); ok {
+		r0 = rf(tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.ChannelCheckpoint)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: in
+func (_m *IChannelCheckpointDb) Upsert(in *dbmodel.ChannelCheckpoint) error {
+	ret := _m.Called(in)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.ChannelCheckpoint) error); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewIChannelCheckpointDb interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewIChannelCheckpointDb creates a new instance of IChannelCheckpointDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewIChannelCheckpointDb(t mockConstructorTestingTNewIChannelCheckpointDb) *IChannelCheckpointDb {
+	mock := &IChannelCheckpointDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,79 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ICollectionLoadInfoDb is an autogenerated mock type for the ICollectionLoadInfoDb type
+type ICollectionLoadInfoDb struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: tenantID, collectionID
+func (_m *ICollectionLoadInfoDb) Delete(tenantID string, collectionID int64) error {
+	ret := _m.Called(tenantID, collectionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(tenantID, collectionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: tenantID
+func (_m *ICollectionLoadInfoDb) List(tenantID string) ([]*dbmodel.CollectionLoadInfo, error) {
+	ret := _m.Called(tenantID)
+
+	var r0 []*dbmodel.CollectionLoadInfo
+	if rf, ok := ret.Get(0).(func(string) []*dbmodel.CollectionLoadInfo); ok {
+		r0 = rf(tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionLoadInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: in
+func (_m *ICollectionLoadInfoDb) Upsert(in *dbmodel.CollectionLoadInfo) error {
+	ret := _m.Called(in)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.CollectionLoadInfo) error); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewICollectionLoadInfoDb interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewICollectionLoadInfoDb creates a new instance of ICollectionLoadInfoDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewICollectionLoadInfoDb(t mockConstructorTestingTNewICollectionLoadInfoDb) *ICollectionLoadInfoDb {
+	mock := &ICollectionLoadInfoDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,77 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IDroppedChannelDb is an autogenerated mock type for the IDroppedChannelDb type
+type IDroppedChannelDb struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: tenantID, channel
+func (_m *IDroppedChannelDb) Delete(tenantID string, channel string) error {
+	ret := _m.Called(tenantID, channel)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(tenantID, channel)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Has provides a mock function with given fields: tenantID, channel
+func (_m *IDroppedChannelDb) Has(tenantID string, channel string) (bool, error) {
+	ret := _m.Called(tenantID, channel)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(tenantID, channel)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(tenantID, channel)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: in
+func (_m *IDroppedChannelDb) Insert(in *dbmodel.DroppedChannel) error {
+	ret := _m.Called(in)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.DroppedChannel) error); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewIDroppedChannelDb interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewIDroppedChannelDb creates a new instance of IDroppedChannelDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewIDroppedChannelDb(t mockConstructorTestingTNewIDroppedChannelDb) *IDroppedChannelDb {
+	mock := &IDroppedChannelDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
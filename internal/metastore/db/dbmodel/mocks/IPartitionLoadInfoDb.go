@@ -0,0 +1,79 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IPartitionLoadInfoDb is an autogenerated mock type for the IPartitionLoadInfoDb type
+type IPartitionLoadInfoDb struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: tenantID, collectionID, partitionIDs
+func (_m *IPartitionLoadInfoDb) Delete(tenantID string, collectionID int64, partitionIDs []int64) error {
+	ret := _m.Called(tenantID, collectionID, partitionIDs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64, []int64) error); ok {
+		r0 = rf(tenantID, collectionID, partitionIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: tenantID
+func (_m *IPartitionLoadInfoDb) List(tenantID string) ([]*dbmodel.PartitionLoadInfo, error) {
+	ret := _m.Called(tenantID)
+
+	var r0 []*dbmodel.PartitionLoadInfo
+	if rf, ok := ret.Get(0).(func(string) []*dbmodel.PartitionLoadInfo); ok {
+		r0 = rf(tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.PartitionLoadInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: in
+func (_m *IPartitionLoadInfoDb) Upsert(in []*dbmodel.PartitionLoadInfo) error {
+	ret := _m.Called(in)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*dbmodel.PartitionLoadInfo) error); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewIPartitionLoadInfoDb interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewIPartitionLoadInfoDb creates a new instance of IPartitionLoadInfoDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewIPartitionLoadInfoDb(t mockConstructorTestingTNewIPartitionLoadInfoDb) *IPartitionLoadInfoDb {
+	mock := &IPartitionLoadInfoDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,93 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IReplicaDb is an autogenerated mock type for the IReplicaDb type
+type IReplicaDb struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: tenantID, collectionID, replicaID
+func (_m *IReplicaDb) Delete(tenantID string, collectionID int64, replicaID int64) error {
+	ret := _m.Called(tenantID, collectionID, replicaID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64, int64) error); ok {
+		r0 = rf(tenantID, collectionID, replicaID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteByCollectionID provides a mock function with given fields: tenantID, collectionID
+func (_m *IReplicaDb) DeleteByCollectionID(tenantID string, collectionID int64) error {
+	ret := _m.Called(tenantID, collectionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(tenantID, collectionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: tenantID
+func (_m *IReplicaDb) List(tenantID string) ([]*dbmodel.Replica, error) {
+	ret := _m.Called(tenantID)
+
+	var r0 []*dbmodel.Replica
+	if rf, ok := ret.Get(0).(func(string) []*dbmodel.Replica); ok {
+		r0 = rf(tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Replica)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: in
+func (_m *IReplicaDb) Upsert(in *dbmodel.Replica) error {
+	ret := _m.Called(in)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.Replica) error); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewIReplicaDb interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewIReplicaDb creates a new instance of IReplicaDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewIReplicaDb(t mockConstructorTestingTNewIReplicaDb) *IReplicaDb {
+	mock := &IReplicaDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
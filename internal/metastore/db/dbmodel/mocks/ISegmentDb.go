@@ -0,0 +1,79 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ISegmentDb is an autogenerated mock type for the ISegmentDb type
+type ISegmentDb struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: tenantID, segmentIDs
+func (_m *ISegmentDb) Delete(tenantID string, segmentIDs []int64) error {
+	ret := _m.Called(tenantID, segmentIDs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []int64) error); ok {
+		r0 = rf(tenantID, segmentIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: tenantID
+func (_m *ISegmentDb) List(tenantID string) ([]*dbmodel.Segment, error) {
+	ret := _m.Called(tenantID)
+
+	var r0 []*dbmodel.Segment
+	if rf, ok := ret.Get(0).(func(string) []*dbmodel.Segment); ok {
+		r0 = rf(tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Segment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: in
+func (_m *ISegmentDb) Upsert(in []*dbmodel.Segment) error {
+	ret := _m.Called(in)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*dbmodel.Segment) error); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewISegmentDb interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewISegmentDb creates a new instance of ISegmentDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewISegmentDb(t mockConstructorTestingTNewISegmentDb) *ISegmentDb {
+	mock := &ISegmentDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -9,13 +9,13 @@ import (
 
 type Partition struct {
 	ID                        int64              `gorm:"id"`
-	TenantID                  string             `gorm:"tenant_id"`
+	TenantID                  string             `gorm:"tenant_id;uniqueIndex:uk_tenant_id_collection_id_partition_name_ts,priority:1"`
 	PartitionID               int64              `gorm:"partition_id"`
-	PartitionName             string             `gorm:"partition_name"`
+	PartitionName             string             `gorm:"partition_name;uniqueIndex:uk_tenant_id_collection_id_partition_name_ts,priority:3"`
 	PartitionCreatedTimestamp uint64             `gorm:"partition_created_timestamp"`
-	CollectionID              int64              `gorm:"collection_id"`
+	CollectionID              int64              `gorm:"collection_id;uniqueIndex:uk_tenant_id_collection_id_partition_name_ts,priority:2"`
 	Status                    int32              `gorm:"status"`
-	Ts                        typeutil.Timestamp `gorm:"ts"`
+	Ts                        typeutil.Timestamp `gorm:"ts;uniqueIndex:uk_tenant_id_collection_id_partition_name_ts,priority:4"`
 	IsDeleted                 bool               `gorm:"is_deleted"`
 	CreatedAt                 time.Time          `gorm:"created_at"`
 	UpdatedAt                 time.Time          `gorm:"updated_at"`
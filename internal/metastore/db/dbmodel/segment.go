@@ -0,0 +1,69 @@
+package dbmodel
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type Segment struct {
+	ID                  int64     `gorm:"id"`
+	TenantID            string    `gorm:"tenant_id;uniqueIndex:uk_tenant_id_segment_id,priority:1"`
+	SegmentID           int64     `gorm:"segment_id;uniqueIndex:uk_tenant_id_segment_id,priority:2"`
+	CollectionID        int64     `gorm:"collection_id"`
+	PartitionID         int64     `gorm:"partition_id"`
+	NumRows             int64     `gorm:"num_rows"`
+	MaxRowNum           int64     `gorm:"max_row_num"`
+	DmChannel           string    `gorm:"dm_channel"`
+	DmlPosition         string    `gorm:"dml_position"`
+	StartPosition       string    `gorm:"start_position"`
+	CompactionFrom      string    `gorm:"compaction_from"`
+	CreatedByCompaction bool      `gorm:"created_by_compaction"`
+	SegmentState        int32     `gorm:"segment_state"`
+	LastExpireTime      uint64    `gorm:"last_expire_time"`
+	DroppedAt           uint64    `gorm:"dropped_at"`
+	IsImporting         bool      `gorm:"is_importing"`
+	IsFake              bool      `gorm:"is_fake"`
+	CreatedAt           time.Time `gorm:"created_at"`
+	UpdatedAt           time.Time `gorm:"updated_at"`
+}
+
+func (v Segment) TableName() string {
+	return "segments"
+}
+
+//go:generate mockery --name=ISegmentDb
+type ISegmentDb interface {
+	List(tenantID string) ([]*Segment, error)
+	Upsert(in []*Segment) error
+	Delete(tenantID string, segmentIDs []typeutil.UniqueID) error
+}
+
+type Binlog struct {
+	ID            int64     `gorm:"id"`
+	TenantID      string    `gorm:"tenant_id"`
+	FieldID       int64     `gorm:"field_id"`
+	SegmentID     int64     `gorm:"segment_id"`
+	CollectionID  int64     `gorm:"collection_id"`
+	PartitionID   int64     `gorm:"partition_id"`
+	LogType       int32     `gorm:"log_type"`
+	LogID         int64     `gorm:"log_id"`
+	NumEntries    int64     `gorm:"num_entries"`
+	TimestampFrom uint64    `gorm:"timestamp_from"`
+	TimestampTo   uint64    `gorm:"timestamp_to"`
+	LogPath       string    `gorm:"log_path"`
+	LogSize       int64     `gorm:"log_size"`
+	CreatedAt     time.Time `gorm:"created_at"`
+	UpdatedAt     time.Time `gorm:"updated_at"`
+}
+
+func (v Binlog) TableName() string {
+	return "binlogs"
+}
+
+//go:generate mockery --name=IBinlogDb
+type IBinlogDb interface {
+	List(tenantID string) ([]*Binlog, error)
+	Insert(in []*Binlog) error
+	Delete(tenantID string, segmentIDs []typeutil.UniqueID) error
+}
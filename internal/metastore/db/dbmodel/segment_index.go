@@ -8,14 +8,14 @@ import (
 
 type SegmentIndex struct {
 	ID       int64  `gorm:"id"`
-	TenantID string `gorm:"tenant_id"`
+	TenantID string `gorm:"tenant_id;uniqueIndex:uk_tenant_id_segment_id_index_id,priority:1"`
 	// SegmentIndexInfo (CollectionID & PartitionID & SegmentID & FieldID & IndexID & BuildID & EnableIndex)
 	CollectionID int64 `gorm:"collection_id"`
 	PartitionID  int64 `gorm:"partition_id"`
-	SegmentID    int64 `gorm:"segment_id"`
+	SegmentID    int64 `gorm:"segment_id;uniqueIndex:uk_tenant_id_segment_id_index_id,priority:2"`
 	NumRows      int64 `gorm:"num_rows"`
 	// IndexInfo (IndexID & IndexName & IndexParams)
-	IndexID       int64     `gorm:"index_id"`
+	IndexID       int64     `gorm:"index_id;uniqueIndex:uk_tenant_id_segment_id_index_id,priority:3"`
 	BuildID       int64     `gorm:"build_id"`
 	NodeID        int64     `gorm:"node_id"`
 	IndexVersion  int64     `gorm:"index_version"`
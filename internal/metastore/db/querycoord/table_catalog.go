@@ -0,0 +1,262 @@
+package querycoord
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/contextutil"
+	"go.uber.org/zap"
+)
+
+// Catalog implements metastore.QueryCoordCatalog on the meta database.
+// QueryCoordCatalog methods carry no context, so the default tenant is used.
+type Catalog struct {
+	metaDomain dbmodel.IMetaDomain
+	txImpl     dbmodel.ITransaction
+}
+
+func NewTableCatalog(txImpl dbmodel.ITransaction, metaDomain dbmodel.IMetaDomain) *Catalog {
+	return &Catalog{
+		txImpl:     txImpl,
+		metaDomain: metaDomain,
+	}
+}
+
+func (tc *Catalog) SaveCollection(info *querypb.CollectionLoadInfo) error {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	releasedPartitions, err := marshalInt64s(info.GetReleasedPartitions())
+	if err != nil {
+		return err
+	}
+
+	fieldIndexID, err := marshalFieldIndexID(info.GetFieldIndexID())
+	if err != nil {
+		return err
+	}
+
+	return tc.metaDomain.CollectionLoadInfoDb(ctx).Upsert(&dbmodel.CollectionLoadInfo{
+		TenantID:           tenantID,
+		CollectionID:       info.GetCollectionID(),
+		ReleasedPartitions: releasedPartitions,
+		ReplicaNumber:      info.GetReplicaNumber(),
+		Status:             int32(info.GetStatus()),
+		FieldIndexID:       fieldIndexID,
+	})
+}
+
+func (tc *Catalog) SavePartition(info ...*querypb.PartitionLoadInfo) error {
+	if len(info) == 0 {
+		return nil
+	}
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	partitions := make([]*dbmodel.PartitionLoadInfo, 0, len(info))
+	for _, partition := range info {
+		fieldIndexID, err := marshalFieldIndexID(partition.GetFieldIndexID())
+		if err != nil {
+			return err
+		}
+
+		partitions = append(partitions, &dbmodel.PartitionLoadInfo{
+			TenantID:      tenantID,
+			CollectionID:  partition.GetCollectionID(),
+			PartitionID:   partition.GetPartitionID(),
+			ReplicaNumber: partition.GetReplicaNumber(),
+			Status:        int32(partition.GetStatus()),
+			FieldIndexID:  fieldIndexID,
+		})
+	}
+
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		return tc.metaDomain.PartitionLoadInfoDb(txCtx).Upsert(partitions)
+	})
+}
+
+func (tc *Catalog) SaveReplica(replica *querypb.Replica) error {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	nodes, err := marshalInt64s(replica.GetNodes())
+	if err != nil {
+		return err
+	}
+
+	return tc.metaDomain.ReplicaDb(ctx).Upsert(&dbmodel.Replica{
+		TenantID:     tenantID,
+		ReplicaID:    replica.GetID(),
+		CollectionID: replica.GetCollectionID(),
+		Nodes:        nodes,
+	})
+}
+
+func (tc *Catalog) GetCollections() ([]*querypb.CollectionLoadInfo, error) {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	collections, err := tc.metaDomain.CollectionLoadInfoDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*querypb.CollectionLoadInfo, 0, len(collections))
+	for _, c := range collections {
+		releasedPartitions, err := unmarshalInt64s(c.ReleasedPartitions)
+		if err != nil {
+			log.Error("unmarshal released partitions failed", zap.Int64("collID", c.CollectionID), zap.Error(err))
+			return nil, err
+		}
+
+		fieldIndexID, err := unmarshalFieldIndexID(c.FieldIndexID)
+		if err != nil {
+			log.Error("unmarshal field index ids of collection failed", zap.Int64("collID", c.CollectionID), zap.Error(err))
+			return nil, err
+		}
+
+		ret = append(ret, &querypb.CollectionLoadInfo{
+			CollectionID:       c.CollectionID,
+			ReleasedPartitions: releasedPartitions,
+			ReplicaNumber:      c.ReplicaNumber,
+			Status:             querypb.LoadStatus(c.Status),
+			FieldIndexID:       fieldIndexID,
+		})
+	}
+
+	return ret, nil
+}
+
+func (tc *Catalog) GetPartitions() (map[int64][]*querypb.PartitionLoadInfo, error) {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	partitions, err := tc.metaDomain.PartitionLoadInfoDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(map[int64][]*querypb.PartitionLoadInfo)
+	for _, p := range partitions {
+		fieldIndexID, err := unmarshalFieldIndexID(p.FieldIndexID)
+		if err != nil {
+			log.Error("unmarshal field index ids of partition failed", zap.Int64("collID", p.CollectionID), zap.Int64("partitionID", p.PartitionID), zap.Error(err))
+			return nil, err
+		}
+
+		ret[p.CollectionID] = append(ret[p.CollectionID], &querypb.PartitionLoadInfo{
+			CollectionID:  p.CollectionID,
+			PartitionID:   p.PartitionID,
+			ReplicaNumber: p.ReplicaNumber,
+			Status:        querypb.LoadStatus(p.Status),
+			FieldIndexID:  fieldIndexID,
+		})
+	}
+
+	return ret, nil
+}
+
+func (tc *Catalog) GetReplicas() ([]*querypb.Replica, error) {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	replicas, err := tc.metaDomain.ReplicaDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*querypb.Replica, 0, len(replicas))
+	for _, r := range replicas {
+		nodes, err := unmarshalInt64s(r.Nodes)
+		if err != nil {
+			log.Error("unmarshal replica nodes failed", zap.Int64("collID", r.CollectionID), zap.Int64("replicaID", r.ReplicaID), zap.Error(err))
+			return nil, err
+		}
+
+		ret = append(ret, &querypb.Replica{
+			ID:           r.ReplicaID,
+			CollectionID: r.CollectionID,
+			Nodes:        nodes,
+		})
+	}
+
+	return ret, nil
+}
+
+func (tc *Catalog) ReleaseCollection(id int64) error {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.CollectionLoadInfoDb(ctx).Delete(tenantID, id)
+}
+
+func (tc *Catalog) ReleasePartition(collection int64, partitions ...int64) error {
+	if len(partitions) == 0 {
+		return nil
+	}
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.PartitionLoadInfoDb(ctx).Delete(tenantID, collection, partitions)
+}
+
+func (tc *Catalog) ReleaseReplicas(collectionID int64) error {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.ReplicaDb(ctx).DeleteByCollectionID(tenantID, collectionID)
+}
+
+func (tc *Catalog) ReleaseReplica(collection, replica int64) error {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.ReplicaDb(ctx).Delete(tenantID, collection, replica)
+}
+
+func marshalInt64s(in []int64) (string, error) {
+	if len(in) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(in)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func unmarshalInt64s(s string) ([]int64, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var out []int64
+	if err := json.Unmarshal([]byte(s), &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func marshalFieldIndexID(in map[int64]int64) (string, error) {
+	if len(in) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(in)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func unmarshalFieldIndexID(s string) (map[int64]int64, error) {
+	if s == "" {
+		return nil, nil
+	}
+	out := make(map[int64]int64)
+	if err := json.Unmarshal([]byte(s), &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
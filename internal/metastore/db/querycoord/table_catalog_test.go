@@ -0,0 +1,266 @@
+package querycoord
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel/mocks"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	collID1      = typeutil.UniqueID(101)
+	partitionID1 = typeutil.UniqueID(500)
+	partitionID2 = typeutil.UniqueID(501)
+	replicaID1   = typeutil.UniqueID(1000)
+)
+
+var (
+	ctx            context.Context
+	metaDomainMock *mocks.IMetaDomain
+	collLoadDbMock *mocks.ICollectionLoadInfoDb
+	partLoadDbMock *mocks.IPartitionLoadInfoDb
+	replicaDbMock  *mocks.IReplicaDb
+
+	mockCatalog *Catalog
+
+	// QueryCoordCatalog methods carry no context, so the default tenant is used
+	tenantID = ""
+	errTest  = errors.New("test error")
+
+	collLoadInfoRow1 = &dbmodel.CollectionLoadInfo{
+		CollectionID:       collID1,
+		ReleasedPartitions: "[501]",
+		ReplicaNumber:      1,
+		Status:             int32(querypb.LoadStatus_Loaded),
+		FieldIndexID:       `{"100":1000}`,
+	}
+)
+
+var _ metastore.QueryCoordCatalog = &Catalog{}
+
+// TestMain is the first function executed in current package, we will do some initial here
+func TestMain(m *testing.M) {
+	ctx = context.TODO()
+
+	collLoadDbMock = &mocks.ICollectionLoadInfoDb{}
+	partLoadDbMock = &mocks.IPartitionLoadInfoDb{}
+	replicaDbMock = &mocks.IReplicaDb{}
+
+	metaDomainMock = &mocks.IMetaDomain{}
+	metaDomainMock.On("CollectionLoadInfoDb", ctx).Return(collLoadDbMock)
+	metaDomainMock.On("PartitionLoadInfoDb", ctx).Return(partLoadDbMock)
+	metaDomainMock.On("ReplicaDb", ctx).Return(replicaDbMock)
+
+	mockCatalog = mockMetaCatalog(metaDomainMock)
+
+	// m.Run entry for executing tests
+	os.Exit(m.Run())
+}
+
+type NoopTransaction struct{}
+
+func (*NoopTransaction) Transaction(ctx context.Context, fn func(txctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func mockMetaCatalog(petDomain dbmodel.IMetaDomain) *Catalog {
+	return NewTableCatalog(&NoopTransaction{}, petDomain)
+}
+
+func TestTableCatalog_SaveCollection(t *testing.T) {
+	info := &querypb.CollectionLoadInfo{
+		CollectionID:       collID1,
+		ReleasedPartitions: []int64{partitionID2},
+		ReplicaNumber:      1,
+		Status:             querypb.LoadStatus_Loaded,
+		FieldIndexID:       map[int64]int64{100: 1000},
+	}
+
+	// expectation
+	collLoadDbMock.On("Upsert", collLoadInfoRow1).Return(nil).Once()
+
+	// actual
+	gotErr := mockCatalog.SaveCollection(info)
+	require.NoError(t, gotErr)
+}
+
+func TestTableCatalog_SaveCollection_UpsertError(t *testing.T) {
+	// expectation
+	collLoadDbMock.On("Upsert", mock.Anything).Return(errTest).Once()
+
+	// actual
+	gotErr := mockCatalog.SaveCollection(&querypb.CollectionLoadInfo{CollectionID: collID1})
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_GetCollections(t *testing.T) {
+	// expectation
+	collLoadDbMock.On("List", tenantID).Return([]*dbmodel.CollectionLoadInfo{collLoadInfoRow1}, nil).Once()
+
+	// actual
+	res, gotErr := mockCatalog.GetCollections()
+	require.NoError(t, gotErr)
+	require.Equal(t, 1, len(res))
+	require.Equal(t, collID1, res[0].GetCollectionID())
+	require.Equal(t, []int64{partitionID2}, res[0].GetReleasedPartitions())
+	require.Equal(t, querypb.LoadStatus_Loaded, res[0].GetStatus())
+	require.Equal(t, map[int64]int64{100: 1000}, res[0].GetFieldIndexID())
+}
+
+func TestTableCatalog_GetCollections_Error(t *testing.T) {
+	// expectation
+	collLoadDbMock.On("List", tenantID).Return(nil, errTest).Once()
+
+	// actual
+	res, gotErr := mockCatalog.GetCollections()
+	require.Nil(t, res)
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_GetCollections_UnmarshalError(t *testing.T) {
+	// expectation
+	collLoadDbMock.On("List", tenantID).Return([]*dbmodel.CollectionLoadInfo{{CollectionID: collID1, ReleasedPartitions: "invalid"}}, nil).Once()
+
+	// actual
+	res, gotErr := mockCatalog.GetCollections()
+	require.Nil(t, res)
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_SavePartition(t *testing.T) {
+	infos := []*querypb.PartitionLoadInfo{
+		{CollectionID: collID1, PartitionID: partitionID1, ReplicaNumber: 1, Status: querypb.LoadStatus_Loading},
+		{CollectionID: collID1, PartitionID: partitionID2, ReplicaNumber: 1, Status: querypb.LoadStatus_Loaded},
+	}
+
+	// expectation
+	partLoadDbMock.On("Upsert", mock.MatchedBy(func(in []*dbmodel.PartitionLoadInfo) bool {
+		return len(in) == 2 && in[0].PartitionID == partitionID1 && in[1].Status == int32(querypb.LoadStatus_Loaded)
+	})).Return(nil).Once()
+
+	// actual
+	gotErr := mockCatalog.SavePartition(infos...)
+	require.NoError(t, gotErr)
+
+	// empty input is a no-op
+	gotErr = mockCatalog.SavePartition()
+	require.NoError(t, gotErr)
+}
+
+func TestTableCatalog_SavePartition_UpsertError(t *testing.T) {
+	// expectation
+	partLoadDbMock.On("Upsert", mock.Anything).Return(errTest).Once()
+
+	// actual
+	gotErr := mockCatalog.SavePartition(&querypb.PartitionLoadInfo{CollectionID: collID1, PartitionID: partitionID1})
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_GetPartitions(t *testing.T) {
+	// expectation
+	partLoadDbMock.On("List", tenantID).Return([]*dbmodel.PartitionLoadInfo{
+		{CollectionID: collID1, PartitionID: partitionID1},
+		{CollectionID: collID1, PartitionID: partitionID2, FieldIndexID: `{"100":1000}`},
+	}, nil).Once()
+
+	// actual
+	res, gotErr := mockCatalog.GetPartitions()
+	require.NoError(t, gotErr)
+	require.Equal(t, 2, len(res[collID1]))
+	require.Equal(t, map[int64]int64{100: 1000}, res[collID1][1].GetFieldIndexID())
+}
+
+func TestTableCatalog_GetPartitions_Error(t *testing.T) {
+	// expectation
+	partLoadDbMock.On("List", tenantID).Return(nil, errTest).Once()
+
+	// actual
+	res, gotErr := mockCatalog.GetPartitions()
+	require.Nil(t, res)
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_SaveReplica(t *testing.T) {
+	// expectation
+	replicaDbMock.On("Upsert", &dbmodel.Replica{
+		ReplicaID:    replicaID1,
+		CollectionID: collID1,
+		Nodes:        "[1,2]",
+	}).Return(nil).Once()
+
+	// actual
+	gotErr := mockCatalog.SaveReplica(&querypb.Replica{ID: replicaID1, CollectionID: collID1, Nodes: []int64{1, 2}})
+	require.NoError(t, gotErr)
+}
+
+func TestTableCatalog_GetReplicas(t *testing.T) {
+	// expectation
+	replicaDbMock.On("List", tenantID).Return([]*dbmodel.Replica{
+		{ReplicaID: replicaID1, CollectionID: collID1, Nodes: "[1,2]"},
+	}, nil).Once()
+
+	// actual
+	res, gotErr := mockCatalog.GetReplicas()
+	require.NoError(t, gotErr)
+	require.Equal(t, 1, len(res))
+	require.Equal(t, replicaID1, res[0].GetID())
+	require.Equal(t, []int64{1, 2}, res[0].GetNodes())
+}
+
+func TestTableCatalog_GetReplicas_Error(t *testing.T) {
+	// expectation
+	replicaDbMock.On("List", tenantID).Return(nil, errTest).Once()
+
+	// actual
+	res, gotErr := mockCatalog.GetReplicas()
+	require.Nil(t, res)
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_ReleaseCollection(t *testing.T) {
+	// expectation
+	collLoadDbMock.On("Delete", tenantID, collID1).Return(nil).Once()
+
+	// actual
+	gotErr := mockCatalog.ReleaseCollection(collID1)
+	require.NoError(t, gotErr)
+}
+
+func TestTableCatalog_ReleasePartition(t *testing.T) {
+	// expectation
+	partLoadDbMock.On("Delete", tenantID, collID1, []int64{partitionID1, partitionID2}).Return(nil).Once()
+
+	// actual
+	gotErr := mockCatalog.ReleasePartition(collID1, partitionID1, partitionID2)
+	require.NoError(t, gotErr)
+
+	// empty input is a no-op
+	gotErr = mockCatalog.ReleasePartition(collID1)
+	require.NoError(t, gotErr)
+}
+
+func TestTableCatalog_ReleaseReplicas(t *testing.T) {
+	// expectation
+	replicaDbMock.On("DeleteByCollectionID", tenantID, collID1).Return(errTest).Once()
+
+	// actual
+	gotErr := mockCatalog.ReleaseReplicas(collID1)
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_ReleaseReplica(t *testing.T) {
+	// expectation
+	replicaDbMock.On("Delete", tenantID, collID1, replicaID1).Return(nil).Once()
+
+	// actual
+	gotErr := mockCatalog.ReleaseReplica(collID1, replicaID1)
+	require.NoError(t, gotErr)
+}
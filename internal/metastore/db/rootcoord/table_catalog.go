@@ -75,37 +75,9 @@ func (tc *Catalog) CreateCollection(ctx context.Context, collection *model.Colle
 		}
 
 		// insert field
-		var fields = make([]*dbmodel.Field, 0, len(collection.Fields))
-		for _, field := range collection.Fields {
-			typeParamsBytes, err := json.Marshal(field.TypeParams)
-			if err != nil {
-				log.Error("marshal TypeParams of field failed", zap.Error(err))
-				return err
-			}
-			typeParamsStr := string(typeParamsBytes)
-
-			indexParamsBytes, err := json.Marshal(field.IndexParams)
-			if err != nil {
-				log.Error("marshal IndexParams of field failed", zap.Error(err))
-				return err
-			}
-			indexParamsStr := string(indexParamsBytes)
-
-			f := &dbmodel.Field{
-				TenantID:     collection.TenantID,
-				FieldID:      field.FieldID,
-				FieldName:    field.Name,
-				IsPrimaryKey: field.IsPrimaryKey,
-				Description:  field.Description,
-				DataType:     field.DataType,
-				TypeParams:   typeParamsStr,
-				IndexParams:  indexParamsStr,
-				AutoID:       field.AutoID,
-				CollectionID: collection.CollectionID,
-				Ts:           ts,
-			}
-
-			fields = append(fields, f)
+		fields, err := marshalFields(collection.TenantID, collection.CollectionID, collection.Fields, ts)
+		if err != nil {
+			return err
 		}
 
 		err = tc.metaDomain.FieldDb(txCtx).Insert(fields)
@@ -424,7 +396,58 @@ func (tc *Catalog) alterModifyCollection(ctx context.Context, oldColl *model.Col
 		Properties:       properties,
 	}
 
-	return tc.metaDomain.CollectionDb(ctx).Update(coll)
+	addedFields := model.GetAddedFields(oldColl, newColl)
+	if len(addedFields) == 0 {
+		return tc.metaDomain.CollectionDb(ctx).Update(coll)
+	}
+
+	// insert the fields appended to the collection together with the collection
+	fields, err := marshalFields(tenantID, newColl.CollectionID, addedFields, ts)
+	if err != nil {
+		return err
+	}
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		if err := tc.metaDomain.CollectionDb(txCtx).Update(coll); err != nil {
+			return err
+		}
+		return tc.metaDomain.FieldDb(txCtx).Insert(fields)
+	})
+}
+
+func marshalFields(tenantID string, collectionID typeutil.UniqueID, fields []*model.Field, ts typeutil.Timestamp) ([]*dbmodel.Field, error) {
+	var dbFields = make([]*dbmodel.Field, 0, len(fields))
+	for _, field := range fields {
+		typeParamsBytes, err := json.Marshal(field.TypeParams)
+		if err != nil {
+			log.Error("marshal TypeParams of field failed", zap.Error(err))
+			return nil, err
+		}
+		typeParamsStr := string(typeParamsBytes)
+
+		indexParamsBytes, err := json.Marshal(field.IndexParams)
+		if err != nil {
+			log.Error("marshal IndexParams of field failed", zap.Error(err))
+			return nil, err
+		}
+		indexParamsStr := string(indexParamsBytes)
+
+		f := &dbmodel.Field{
+			TenantID:     tenantID,
+			FieldID:      field.FieldID,
+			FieldName:    field.Name,
+			IsPrimaryKey: field.IsPrimaryKey,
+			Description:  field.Description,
+			DataType:     field.DataType,
+			TypeParams:   typeParamsStr,
+			IndexParams:  indexParamsStr,
+			AutoID:       field.AutoID,
+			CollectionID: collectionID,
+			Ts:           ts,
+		}
+
+		dbFields = append(dbFields, f)
+	}
+	return dbFields, nil
 }
 
 func (tc *Catalog) AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, alterType metastore.AlterType, ts typeutil.Timestamp) error {
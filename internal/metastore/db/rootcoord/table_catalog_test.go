@@ -791,6 +791,33 @@ func TestCatalog_AlterCollection(t *testing.T) {
 	require.NoError(t, gotErr)
 }
 
+func TestCatalog_AlterCollection_AddField(t *testing.T) {
+	coll := &model.Collection{
+		TenantID:     tenantID,
+		CollectionID: collID1,
+		Name:         collName1,
+		State:        pb.CollectionState_CollectionCreated,
+		Fields:       []*model.Field{{FieldID: fieldID1, Name: "pk"}},
+	}
+	newColl := coll.Clone()
+	newColl.Fields = append(newColl.Fields, &model.Field{FieldID: fieldID1 + 1, Name: "added"})
+
+	collDbMock.On("Update", mock.Anything).Return(nil).Once()
+	fieldDbMock.On("Insert", mock.MatchedBy(func(fields []*dbmodel.Field) bool {
+		return len(fields) == 1 && fields[0].FieldName == "added" && fields[0].Ts == ts
+	})).Return(nil).Once()
+
+	gotErr := mockCatalog.AlterCollection(ctx, coll, newColl, metastore.MODIFY, ts)
+	require.NoError(t, gotErr)
+
+	errTest := errors.New("test error")
+	collDbMock.On("Update", mock.Anything).Return(nil).Once()
+	fieldDbMock.On("Insert", mock.Anything).Return(errTest).Once()
+
+	gotErr = mockCatalog.AlterCollection(ctx, coll, newColl, metastore.MODIFY, ts)
+	require.Error(t, gotErr)
+}
+
 func TestTableCatalog_AlterCollection_TsNot0_AlterTypeError(t *testing.T) {
 	coll := &model.Collection{
 		TenantID:     tenantID,
@@ -374,12 +374,28 @@ func (kc *Catalog) alterModifyCollection(oldColl *model.Collection, newColl *mod
 	oldCollClone.CreateTime = newColl.CreateTime
 	oldCollClone.ConsistencyLevel = newColl.ConsistencyLevel
 	oldCollClone.State = newColl.State
+	oldCollClone.Properties = newColl.Properties
 	key := BuildCollectionKey(oldColl.CollectionID)
 	value, err := proto.Marshal(model.MarshalCollectionModel(oldCollClone))
 	if err != nil {
 		return err
 	}
-	return kc.Snapshot.Save(key, string(value), ts)
+
+	addedFields := model.GetAddedFields(oldColl, newColl)
+	if len(addedFields) == 0 {
+		return kc.Snapshot.Save(key, string(value), ts)
+	}
+
+	// save the fields appended to the collection together with the collection
+	kvs := map[string]string{key: string(value)}
+	for _, field := range addedFields {
+		fieldInfo, err := proto.Marshal(model.MarshalFieldModel(field))
+		if err != nil {
+			return err
+		}
+		kvs[BuildFieldKey(oldColl.CollectionID, field.FieldID)] = string(fieldInfo)
+	}
+	return kc.Snapshot.MultiSave(kvs, ts)
 }
 
 func (kc *Catalog) AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, alterType metastore.AlterType, ts typeutil.Timestamp) error {
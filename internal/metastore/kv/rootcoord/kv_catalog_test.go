@@ -780,6 +780,37 @@ func TestCatalog_AlterCollection(t *testing.T) {
 		assert.Equal(t, pb.CollectionState_CollectionCreated, got.State)
 	})
 
+	t.Run("modify, add field", func(t *testing.T) {
+		snapshot := kv.NewMockSnapshotKV()
+		kvs := map[string]string{}
+		snapshot.MultiSaveFunc = func(saves map[string]string, ts typeutil.Timestamp) error {
+			for k, v := range saves {
+				kvs[k] = v
+			}
+			return nil
+		}
+		kc := &Catalog{Snapshot: snapshot}
+		ctx := context.Background()
+		var collectionID int64 = 1
+		oldC := &model.Collection{CollectionID: collectionID, Fields: []*model.Field{{FieldID: 100, Name: "pk"}}}
+		newC := oldC.Clone()
+		newC.Fields = append(newC.Fields, &model.Field{FieldID: 101, Name: "added"})
+		newC.Properties = []*commonpb.KeyValuePair{{Key: "k", Value: "v"}}
+		err := kc.AlterCollection(ctx, oldC, newC, metastore.MODIFY, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(kvs))
+
+		var collPb pb.CollectionInfo
+		err = proto.Unmarshal([]byte(kvs[BuildCollectionKey(collectionID)]), &collPb)
+		assert.NoError(t, err)
+		assert.Equal(t, "v", collPb.GetProperties()[0].GetValue())
+
+		var fieldPb schemapb.FieldSchema
+		err = proto.Unmarshal([]byte(kvs[BuildFieldKey(collectionID, 101)]), &fieldPb)
+		assert.NoError(t, err)
+		assert.Equal(t, "added", fieldPb.GetName())
+	})
+
 	t.Run("modify, tenant id changed", func(t *testing.T) {
 		kc := &Catalog{}
 		ctx := context.Background()
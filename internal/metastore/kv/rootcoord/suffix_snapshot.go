@@ -18,6 +18,7 @@ package rootcoord
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"path"
@@ -543,3 +544,187 @@ func (ss *SuffixSnapshot) MultiSaveAndRemoveWithPrefix(saves map[string]string,
 	}
 	return err
 }
+
+// snapshotGCBatchSize bounds the keys removed or loaded in one txn, etcd rejects txn with more than 128 operations by default
+const snapshotGCBatchSize = 64
+
+// snapshotGCPageSize is the number of ts-keys fetched by one request when scanning the snapshots
+const snapshotGCPageSize = 1000
+
+// SnapshotGCResult summarizes one garbage collection round of SuffixSnapshot
+type SnapshotGCResult struct {
+	RetentionTs       typeutil.Timestamp `json:"retention_ts"`
+	ScannedKeys       int                `json:"scanned_keys"`
+	RemovedVersions   int                `json:"removed_versions"`
+	RemovedTombstones int                `json:"removed_tombstones"`
+}
+
+// splitTSKey parses a ts-key into the original key and ts
+func (ss *SuffixSnapshot) splitTSKey(key string) (string, typeutil.Timestamp, bool) {
+	if !strings.HasPrefix(key, ss.snapshotPrefix) {
+		return "", 0, false
+	}
+	matches := ss.exp.FindStringSubmatch(key[ss.snapshotLen:])
+	if len(matches) < 3 {
+		return "", 0, false
+	}
+	// err ignores since it's protected by the regexp
+	ts, _ := strconv.ParseUint(matches[2], 10, 64)
+	return matches[1], ts, true
+}
+
+// walkSnapshotKeys calls fn with the ts-keys, page by page and without the values if the kv supports it
+func (ss *SuffixSnapshot) walkSnapshotKeys(fn func(keys []string) error) error {
+	if walker, ok := ss.TxnKV.(kv.KeysWalker); ok {
+		return walker.WalkKeysWithPrefix(ss.snapshotPrefix, snapshotGCPageSize, fn)
+	}
+	keys, _, err := ss.TxnKV.LoadWithPrefix(ss.snapshotPrefix)
+	if err != nil {
+		return err
+	}
+	return fn(keys)
+}
+
+// GarbageCollect removes the ts-keys no read at or after retentionTs can see.
+// For each key, the newest version at or before retentionTs is kept and all the older versions are removed.
+// If the kept version is a tombstone and nothing was saved after it, the key is removed entirely,
+// including the tombstone stored in the original key.
+// Only the keys are scanned, the values are loaded for the kept versions which are also the latest ones.
+func (ss *SuffixSnapshot) GarbageCollect(ctx context.Context, retentionTs typeutil.Timestamp) (*SnapshotGCResult, error) {
+	result := &SnapshotGCResult{RetentionTs: retentionTs}
+
+	type version struct {
+		tsKey string
+		ts    typeutil.Timestamp
+	}
+	// scan without lock, saves only append newer versions which are never removed here
+	groups := make(map[string][]version)
+	err := ss.walkSnapshotKeys(func(keys []string) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		result.ScannedKeys += len(keys)
+		for _, key := range keys {
+			tsKey := ss.hideRootPrefix(key)
+			original, ts, ok := ss.splitTSKey(tsKey)
+			if !ok {
+				continue
+			}
+			groups[original] = append(groups[original], version{tsKey: tsKey, ts: ts})
+		}
+		return nil
+	})
+	if err != nil {
+		log.Warn("SuffixSnapshot gc failed to scan snapshots", zap.Error(err))
+		return result, err
+	}
+
+	removals := make([]string, 0, snapshotGCBatchSize)
+	flush := func() error {
+		if len(removals) == 0 {
+			return nil
+		}
+		if err := ss.TxnKV.MultiRemove(removals); err != nil {
+			log.Warn("SuffixSnapshot gc failed to remove expired versions", zap.Int("num", len(removals)), zap.Error(err))
+			return err
+		}
+		result.RemovedVersions += len(removals)
+		removals = removals[:0]
+		return nil
+	}
+
+	// candidates are the keys whose latest version is kept, they are dropped if it is a tombstone
+	candidates := make([]string, 0)
+	for original, versions := range groups {
+		sort.Slice(versions, func(i, j int) bool {
+			return versions[i].ts < versions[j].ts
+		})
+		// kept is the newest version at or before retentionTs
+		kept := sort.Search(len(versions), func(i int) bool {
+			return versions[i].ts > retentionTs
+		}) - 1
+		if kept < 0 {
+			continue
+		}
+		for _, v := range versions[:kept] {
+			removals = append(removals, v.tsKey)
+			if len(removals) < snapshotGCBatchSize {
+				continue
+			}
+			if err := ctx.Err(); err != nil {
+				return result, err
+			}
+			if err := flush(); err != nil {
+				return result, err
+			}
+		}
+		if kept == len(versions)-1 {
+			candidates = append(candidates, original)
+		}
+	}
+	if err := flush(); err != nil {
+		return result, err
+	}
+
+	for start := 0; start < len(candidates); start += snapshotGCBatchSize {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		end := start + snapshotGCBatchSize
+		if end > len(candidates) {
+			end = len(candidates)
+		}
+		tsKeys := make([]string, 0, end-start)
+		for _, original := range candidates[start:end] {
+			versions := groups[original]
+			tsKeys = append(tsKeys, versions[len(versions)-1].tsKey)
+		}
+		values, err := ss.TxnKV.MultiLoad(tsKeys)
+		if err != nil {
+			log.Warn("SuffixSnapshot gc failed to load latest versions", zap.Int("num", len(tsKeys)), zap.Error(err))
+			return result, err
+		}
+		for i, original := range candidates[start:end] {
+			if !ss.isTombstone(values[i]) {
+				continue
+			}
+			versions := groups[original]
+			dropped, err := ss.dropTombstone(original, tsKeys[i], versions[len(versions)-1].ts)
+			if err != nil {
+				return result, err
+			}
+			if dropped {
+				result.RemovedTombstones++
+			}
+		}
+	}
+
+	log.Info("SuffixSnapshot gc done", zap.Uint64("retentionTs", retentionTs), zap.Int("scanned", result.ScannedKeys),
+		zap.Int("removedVersions", result.RemovedVersions), zap.Int("removedTombstones", result.RemovedTombstones))
+	return result, nil
+}
+
+// dropTombstone removes the key and its last ts-key if the key is still removed at ts
+// the check and removal happen under lock so that a concurrent save is not lost
+func (ss *SuffixSnapshot) dropTombstone(key string, tsKey string, ts typeutil.Timestamp) (bool, error) {
+	ss.Lock()
+	defer ss.Unlock()
+
+	if err := ss.loadLatestTS(key); err != nil {
+		return false, err
+	}
+	if ss.lastestTS[key] != ts {
+		return false, nil
+	}
+	value, err := ss.TxnKV.Load(key)
+	if err != nil || !ss.isTombstone(value) {
+		// the original key is missing or was saved again without ts, keep the history as it is
+		return false, nil
+	}
+	if err := ss.TxnKV.MultiRemove([]string{key, tsKey}); err != nil {
+		log.Warn("SuffixSnapshot gc failed to remove tombstone", zap.String("key", key), zap.Error(err))
+		return false, err
+	}
+	delete(ss.lastestTS, key)
+	return true, nil
+}
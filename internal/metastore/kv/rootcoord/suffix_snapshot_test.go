@@ -17,12 +17,15 @@
 package rootcoord
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"testing"
 	"time"
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
@@ -471,3 +474,135 @@ func Test_SuffixSnapshotMultiSaveAndRemoveWithPrefix(t *testing.T) {
 	// cleanup
 	ss.MultiSaveAndRemoveWithPrefix(map[string]string{}, []string{""}, 0)
 }
+
+func Test_SuffixSnapshotGarbageCollect(t *testing.T) {
+	sep := "_ts"
+	ss, err := NewSuffixSnapshot(memkv.NewMemoryKV(), sep, "", snapshotPrefix)
+	require.NoError(t, err)
+
+	// ka: plain history
+	for _, ts := range []typeutil.Timestamp{100, 200, 300} {
+		require.NoError(t, ss.Save("ka", fmt.Sprintf("value-%d", ts), ts))
+	}
+	// kb: removed before retention
+	require.NoError(t, ss.Save("kb", "value-100", 100))
+	require.NoError(t, ss.MultiSaveAndRemoveWithPrefix(nil, []string{"kb"}, 150))
+	// kc: removed then created again after retention
+	require.NoError(t, ss.Save("kc", "value-100", 100))
+	require.NoError(t, ss.MultiSaveAndRemoveWithPrefix(nil, []string{"kc"}, 150))
+	require.NoError(t, ss.Save("kc", "value-300", 300))
+
+	result, err := ss.GarbageCollect(context.Background(), 250)
+	require.NoError(t, err)
+	assert.Equal(t, 8, result.ScannedKeys)
+	// ka_ts100, kb_ts100, kc_ts100
+	assert.Equal(t, 3, result.RemovedVersions)
+	assert.Equal(t, 1, result.RemovedTombstones)
+
+	// reads at or after the retention ts are unchanged
+	val, err := ss.Load("ka", 250)
+	assert.NoError(t, err)
+	assert.Equal(t, "value-200", val)
+	val, err = ss.Load("ka", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "value-300", val)
+	_, err = ss.Load("ka", 150)
+	assert.Error(t, err)
+
+	_, err = ss.Load("kb", 250)
+	assert.Error(t, err)
+	_, err = ss.Load("kc", 250)
+	assert.Error(t, err)
+	val, err = ss.Load("kc", 300)
+	assert.NoError(t, err)
+	assert.Equal(t, "value-300", val)
+
+	keys, _, err := ss.LoadWithPrefix("k", 250)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"ka"}, keys)
+	keys, _, err = ss.LoadWithPrefix("k", 0)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"ka", "kc"}, keys)
+
+	// a dropped key can be created again
+	require.NoError(t, ss.Save("kb", "value-400", 400))
+	val, err = ss.Load("kb", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "value-400", val)
+
+	// nothing left to collect
+	result, err = ss.GarbageCollect(context.Background(), 250)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.RemovedVersions)
+	assert.Equal(t, 0, result.RemovedTombstones)
+}
+
+func Test_SuffixSnapshotGarbageCollectSkipsResaved(t *testing.T) {
+	ss, err := NewSuffixSnapshot(memkv.NewMemoryKV(), "_ts", "", snapshotPrefix)
+	require.NoError(t, err)
+
+	require.NoError(t, ss.Save("k", "value-100", 100))
+	require.NoError(t, ss.MultiSaveAndRemoveWithPrefix(nil, []string{"k"}, 150))
+	// saved again between the scan and the drop
+	require.NoError(t, ss.Save("k", "value-200", 200))
+
+	dropped, err := ss.dropTombstone("k", ss.composeTSKey("k", 150), 150)
+	assert.NoError(t, err)
+	assert.False(t, dropped)
+	val, err := ss.Load("k", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "value-200", val)
+}
+
+// keysOnlyKV fails the value scans of all the snapshots and records the values loaded by key
+type keysOnlyKV struct {
+	*memkv.MemoryKV
+	snapshotPrefix string
+	loaded         []string
+}
+
+func (kv *keysOnlyKV) LoadWithPrefix(key string) ([]string, []string, error) {
+	if key == kv.snapshotPrefix {
+		return nil, nil, errors.New("values scanned")
+	}
+	return kv.MemoryKV.LoadWithPrefix(key)
+}
+
+func (kv *keysOnlyKV) MultiLoad(keys []string) ([]string, error) {
+	kv.loaded = append(kv.loaded, keys...)
+	return kv.MemoryKV.MultiLoad(keys)
+}
+
+func Test_SuffixSnapshotGarbageCollectKeysOnly(t *testing.T) {
+	txnKV := &keysOnlyKV{MemoryKV: memkv.NewMemoryKV()}
+	ss, err := NewSuffixSnapshot(txnKV, "_ts", "", snapshotPrefix)
+	require.NoError(t, err)
+	txnKV.snapshotPrefix = ss.snapshotPrefix
+
+	for i := 0; i < snapshotGCPageSize; i++ {
+		require.NoError(t, ss.Save(fmt.Sprintf("a%d", i), "value-100", 100))
+		require.NoError(t, ss.Save(fmt.Sprintf("a%d", i), "value-200", 200))
+	}
+	require.NoError(t, ss.Save("k", "value-100", 100))
+	require.NoError(t, ss.MultiSaveAndRemoveWithPrefix(nil, []string{"k"}, 150))
+	require.NoError(t, ss.Save("k", "value-300", 300))
+	require.NoError(t, ss.Save("r", "value-100", 100))
+	require.NoError(t, ss.MultiSaveAndRemoveWithPrefix(nil, []string{"r"}, 150))
+
+	result, err := ss.GarbageCollect(context.Background(), 250)
+	require.NoError(t, err)
+	assert.Equal(t, 2*snapshotGCPageSize+5, result.ScannedKeys)
+	assert.Equal(t, snapshotGCPageSize+2, result.RemovedVersions)
+	assert.Equal(t, 1, result.RemovedTombstones)
+
+	// only the kept latest versions are loaded, k is saved after the retention ts
+	assert.Len(t, txnKV.loaded, snapshotGCPageSize+1)
+	assert.NotContains(t, txnKV.loaded, ss.composeTSKey("k", 150))
+	assert.Contains(t, txnKV.loaded, ss.composeTSKey("r", 150))
+
+	val, err := ss.Load("a0", 250)
+	assert.NoError(t, err)
+	assert.Equal(t, "value-200", val)
+	_, err = ss.Load("r", 250)
+	assert.Error(t, err)
+}
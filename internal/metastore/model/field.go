@@ -45,6 +45,21 @@ func CloneFields(fields []*Field) []*Field {
 	return clone
 }
 
+// GetAddedFields returns the fields of newColl which are absent from oldColl.
+func GetAddedFields(oldColl *Collection, newColl *Collection) []*Field {
+	exist := make(map[int64]struct{}, len(oldColl.Fields))
+	for _, field := range oldColl.Fields {
+		exist[field.FieldID] = struct{}{}
+	}
+	var added []*Field
+	for _, field := range newColl.Fields {
+		if _, ok := exist[field.FieldID]; !ok {
+			added = append(added, field)
+		}
+	}
+	return added
+}
+
 func checkParamsEqual(paramsA, paramsB []*commonpb.KeyValuePair) bool {
 	var A common.KeyValuePairs = paramsA
 	return A.Equal(paramsB)
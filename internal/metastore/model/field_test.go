@@ -138,3 +138,13 @@ func TestField_Available(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAddedFields(t *testing.T) {
+	oldColl := &Collection{Fields: []*Field{{FieldID: 100}, {FieldID: 101}}}
+	newColl := &Collection{Fields: []*Field{{FieldID: 100}, {FieldID: 101}, {FieldID: 102, Name: "added"}}}
+	added := GetAddedFields(oldColl, newColl)
+	assert.Equal(t, 1, len(added))
+	assert.Equal(t, "added", added[0].Name)
+
+	assert.Equal(t, 0, len(GetAddedFields(newColl, newColl)))
+}
@@ -58,6 +58,9 @@ const (
 	Leader     = "OnLeader"
 	FromLeader = "FromLeader"
 
+	SnapshotVersionLabel   = "version"
+	SnapshotTombstoneLabel = "tombstone"
+
 	nodeIDLabelName          = "node_id"
 	statusLabelName          = "status"
 	indexTaskStatusLabelName = "index_task_status"
@@ -73,6 +76,7 @@ const (
 	cacheNameLabelName       = "cache_name"
 	cacheStateLabelName      = "cache_state"
 	requestScope             = "scope"
+	snapshotKeyTypeLabelName = "key_type"
 )
 
 var (
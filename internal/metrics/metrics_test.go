@@ -34,5 +34,8 @@ func TestRegisterMetrics(t *testing.T) {
 	RegisterQueryNode(r)
 	RegisterQueryCoord(r)
 	RegisterEtcdMetrics(r)
+	RegisterReplicator(r)
+	RegisterMsgStream(r)
+	RegisterRocksmq(r)
 	Register(r)
 }
@@ -0,0 +1,55 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	msgStreamSubsystem    = "msgstream"
+	poisonPolicyLabelName = "policy"
+
+	DeadLetterReplaySuccessLabel = "success"
+	DeadLetterReplayFailLabel    = "fail"
+)
+
+var (
+	// MsgStreamPoisonMsgCount counts the consumed messages which can't be unmarshalled.
+	MsgStreamPoisonMsgCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: msgStreamSubsystem,
+			Name:      "poison_msg_count",
+			Help:      "count of consumed messages which can't be unmarshalled",
+		}, []string{channelNameLabelName, poisonPolicyLabelName})
+
+	// MsgStreamDeadLetterReplayCount counts the replays of quarantined messages.
+	MsgStreamDeadLetterReplayCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: msgStreamSubsystem,
+			Name:      "dead_letter_replay_count",
+			Help:      "count of quarantined messages replayed to their channels",
+		}, []string{statusLabelName})
+)
+
+// RegisterMsgStream registers MsgStream metrics
+func RegisterMsgStream(registry *prometheus.Registry) {
+	registry.MustRegister(MsgStreamPoisonMsgCount)
+	registry.MustRegister(MsgStreamDeadLetterReplayCount)
+}
@@ -0,0 +1,69 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+var (
+	// ReplicationLag records the lag between now and the last time tick of a source channel replicated to the target.
+	ReplicationLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ReplicatorRole,
+			Name:      "lag_ms",
+			Help:      "now time minus the last replicated time tick per source virtual channel",
+		}, []string{channelNameLabelName})
+
+	// ReplicationMsgCount counts the messages replicated to the target cluster.
+	ReplicationMsgCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ReplicatorRole,
+			Name:      "msg_count",
+			Help:      "count of messages replicated to the target cluster",
+		}, []string{msgTypeLabelName, collectionIDLabelName})
+
+	// ReplicationRowCount counts the rows inserted or deleted by the replicated messages.
+	ReplicationRowCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ReplicatorRole,
+			Name:      "row_count",
+			Help:      "count of rows replicated to the target cluster",
+		}, []string{msgTypeLabelName, collectionIDLabelName})
+
+	// ReplicationChannelState records whether the replication of a source channel is running or paused.
+	ReplicationChannelState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ReplicatorRole,
+			Name:      "channel_state",
+			Help:      "number of source channels in each replication state",
+		}, []string{statusLabelName})
+)
+
+// RegisterReplicator registers Replicator metrics
+func RegisterReplicator(registry *prometheus.Registry) {
+	registry.MustRegister(ReplicationLag)
+	registry.MustRegister(ReplicationMsgCount)
+	registry.MustRegister(ReplicationRowCount)
+	registry.MustRegister(ReplicationChannelState)
+}
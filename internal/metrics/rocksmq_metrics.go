@@ -0,0 +1,72 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	rocksmqSubsystem       = "rocksmq"
+	consumerGroupLabelName = "consumer_group"
+)
+
+var (
+	// RocksmqTopicMsgNum records the number of messages retained by each topic.
+	RocksmqTopicMsgNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: rocksmqSubsystem,
+			Name:      "topic_msg_num",
+			Help:      "number of messages retained by the topic",
+		}, []string{channelNameLabelName})
+
+	// RocksmqTopicSize records the payload bytes retained by each topic.
+	RocksmqTopicSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: rocksmqSubsystem,
+			Name:      "topic_size",
+			Help:      "payload bytes retained by the topic",
+		}, []string{channelNameLabelName})
+
+	// RocksmqTopicPageNum records the number of full pages of each topic.
+	RocksmqTopicPageNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: rocksmqSubsystem,
+			Name:      "topic_page_num",
+			Help:      "number of full pages of the topic",
+		}, []string{channelNameLabelName})
+
+	// RocksmqConsumerGroupLag records the number of messages each consumer group hasn't consumed yet.
+	RocksmqConsumerGroupLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: rocksmqSubsystem,
+			Name:      "consumer_group_lag",
+			Help:      "number of messages of the topic the consumer group hasn't consumed",
+		}, []string{channelNameLabelName, consumerGroupLabelName})
+)
+
+// RegisterRocksmq registers Rocksmq metrics
+func RegisterRocksmq(registry *prometheus.Registry) {
+	registry.MustRegister(RocksmqTopicMsgNum)
+	registry.MustRegister(RocksmqTopicSize)
+	registry.MustRegister(RocksmqTopicPageNum)
+	registry.MustRegister(RocksmqConsumerGroupLag)
+}
@@ -145,6 +145,43 @@ var (
 			roleNameLabelName,
 			nodeIDLabelName,
 		})
+
+	// RootCoordSnapshotGCCounter counts the garbage collection rounds of the meta snapshots.
+	RootCoordSnapshotGCCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.RootCoordRole,
+			Name:      "snapshot_gc_count",
+			Help:      "count of meta snapshot garbage collection rounds",
+		}, []string{statusLabelName})
+
+	// RootCoordSnapshotGCLatency records the latency of a garbage collection round of the meta snapshots.
+	RootCoordSnapshotGCLatency = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.RootCoordRole,
+			Name:      "snapshot_gc_latency",
+			Help:      "latency of meta snapshot garbage collection",
+			Buckets:   buckets, // unit: ms
+		})
+
+	// RootCoordSnapshotGCRemovedKeys counts the meta snapshot keys removed by garbage collection.
+	RootCoordSnapshotGCRemovedKeys = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.RootCoordRole,
+			Name:      "snapshot_gc_removed_keys",
+			Help:      "number of meta snapshot keys removed by garbage collection",
+		}, []string{snapshotKeyTypeLabelName})
+
+	// RootCoordNumOfSnapshotKeys records the number of meta snapshot keys seen by the last garbage collection.
+	RootCoordNumOfSnapshotKeys = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.RootCoordRole,
+			Name:      "snapshot_key_num",
+			Help:      "number of meta snapshot keys before the last garbage collection",
+		})
 )
 
 //RegisterRootCoord registers RootCoord metrics
@@ -176,4 +213,10 @@ func RegisterRootCoord(registry *prometheus.Registry) {
 
 	registry.MustRegister(RootCoordNumOfRoles)
 	registry.MustRegister(RootCoordTtDelay)
+
+	// for meta snapshot gc
+	registry.MustRegister(RootCoordSnapshotGCCounter)
+	registry.MustRegister(RootCoordSnapshotGCLatency)
+	registry.MustRegister(RootCoordSnapshotGCRemovedKeys)
+	registry.MustRegister(RootCoordNumOfSnapshotKeys)
 }
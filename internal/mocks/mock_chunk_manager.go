@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	io "io"
+
 	mmap "golang.org/x/exp/mmap"
 
 	mock "github.com/stretchr/testify/mock"
@@ -739,6 +741,46 @@ func (_c *ChunkManager_Write_Call) Return(_a0 error) *ChunkManager_Write_Call {
 	return _c
 }
 
+// WriteFrom provides a mock function with given fields: ctx, filePath, reader, size
+func (_m *ChunkManager) WriteFrom(ctx context.Context, filePath string, reader io.Reader, size int64) error {
+	ret := _m.Called(ctx, filePath, reader, size)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader, int64) error); ok {
+		r0 = rf(ctx, filePath, reader, size)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ChunkManager_WriteFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteFrom'
+type ChunkManager_WriteFrom_Call struct {
+	*mock.Call
+}
+
+// WriteFrom is a helper method to define mock.On call
+//  - ctx context.Context
+//  - filePath string
+//  - reader io.Reader
+//  - size int64
+func (_e *ChunkManager_Expecter) WriteFrom(ctx interface{}, filePath interface{}, reader interface{}, size interface{}) *ChunkManager_WriteFrom_Call {
+	return &ChunkManager_WriteFrom_Call{Call: _e.mock.On("WriteFrom", ctx, filePath, reader, size)}
+}
+
+func (_c *ChunkManager_WriteFrom_Call) Run(run func(ctx context.Context, filePath string, reader io.Reader, size int64)) *ChunkManager_WriteFrom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(io.Reader), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ChunkManager_WriteFrom_Call) Return(_a0 error) *ChunkManager_WriteFrom_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 type mockConstructorTestingTNewChunkManager interface {
 	mock.TestingT
 	Cleanup(func())
@@ -170,6 +170,53 @@ func (_c *DataCoord_BroadcastAlteredCollection_Call) Return(_a0 *commonpb.Status
 	return _c
 }
 
+// CancelImport provides a mock function with given fields: ctx, req
+func (_m *DataCoord) CancelImport(ctx context.Context, req *datapb.CancelImportTaskRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelImportTaskRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CancelImportTaskRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoord_CancelImport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelImport'
+type DataCoord_CancelImport_Call struct {
+	*mock.Call
+}
+
+// CancelImport is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.CancelImportTaskRequest
+func (_e *DataCoord_Expecter) CancelImport(ctx interface{}, req interface{}) *DataCoord_CancelImport_Call {
+	return &DataCoord_CancelImport_Call{Call: _e.mock.On("CancelImport", ctx, req)}
+}
+
+func (_c *DataCoord_CancelImport_Call) Run(run func(ctx context.Context, req *datapb.CancelImportTaskRequest)) *DataCoord_CancelImport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CancelImportTaskRequest))
+	})
+	return _c
+}
+
+func (_c *DataCoord_CancelImport_Call) Return(_a0 *commonpb.Status, _a1 error) *DataCoord_CancelImport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // CheckHealth provides a mock function with given fields: ctx, req
 func (_m *DataCoord) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -264,6 +311,53 @@ func (_c *DataCoord_DropVirtualChannel_Call) Return(_a0 *datapb.DropVirtualChann
 	return _c
 }
 
+// Export provides a mock function with given fields: ctx, req
+func (_m *DataCoord) Export(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.ExportTaskResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExportTaskRequest) *datapb.ExportTaskResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ExportTaskResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ExportTaskRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoord_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type DataCoord_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.ExportTaskRequest
+func (_e *DataCoord_Expecter) Export(ctx interface{}, req interface{}) *DataCoord_Export_Call {
+	return &DataCoord_Export_Call{Call: _e.mock.On("Export", ctx, req)}
+}
+
+func (_c *DataCoord_Export_Call) Run(run func(ctx context.Context, req *datapb.ExportTaskRequest)) *DataCoord_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ExportTaskRequest))
+	})
+	return _c
+}
+
+func (_c *DataCoord_Export_Call) Return(_a0 *datapb.ExportTaskResponse, _a1 error) *DataCoord_Export_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // Flush provides a mock function with given fields: ctx, req
 func (_m *DataCoord) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -219,6 +219,53 @@ func (_c *RootCoord_AlterCollection_Call) Return(_a0 *commonpb.Status, _a1 error
 	return _c
 }
 
+// CancelImport provides a mock function with given fields: ctx, req
+func (_m *RootCoord) CancelImport(ctx context.Context, req *rootcoordpb.CancelImportRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CancelImportRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.CancelImportRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_CancelImport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelImport'
+type RootCoord_CancelImport_Call struct {
+	*mock.Call
+}
+
+// CancelImport is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.CancelImportRequest
+func (_e *RootCoord_Expecter) CancelImport(ctx interface{}, req interface{}) *RootCoord_CancelImport_Call {
+	return &RootCoord_CancelImport_Call{Call: _e.mock.On("CancelImport", ctx, req)}
+}
+
+func (_c *RootCoord_CancelImport_Call) Run(run func(ctx context.Context, req *rootcoordpb.CancelImportRequest)) *RootCoord_CancelImport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.CancelImportRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_CancelImport_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_CancelImport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // CheckHealth provides a mock function with given fields: ctx, req
 func (_m *RootCoord) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -783,6 +830,53 @@ func (_c *RootCoord_DropRole_Call) Return(_a0 *commonpb.Status, _a1 error) *Root
 	return _c
 }
 
+// Export provides a mock function with given fields: ctx, req
+func (_m *RootCoord) Export(ctx context.Context, req *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *rootcoordpb.ExportResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ExportRequest) *rootcoordpb.ExportResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.ExportResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ExportRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type RootCoord_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.ExportRequest
+func (_e *RootCoord_Expecter) Export(ctx interface{}, req interface{}) *RootCoord_Export_Call {
+	return &RootCoord_Export_Call{Call: _e.mock.On("Export", ctx, req)}
+}
+
+func (_c *RootCoord_Export_Call) Run(run func(ctx context.Context, req *rootcoordpb.ExportRequest)) *RootCoord_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ExportRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_Export_Call) Return(_a0 *rootcoordpb.ExportResponse, _a1 error) *RootCoord_Export_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetComponentStates provides a mock function with given fields: ctx
 func (_m *RootCoord) GetComponentStates(ctx context.Context) (*milvuspb.ComponentStates, error) {
 	ret := _m.Called(ctx)
@@ -876,6 +970,53 @@ func (_c *RootCoord_GetCredential_Call) Return(_a0 *rootcoordpb.GetCredentialRes
 	return _c
 }
 
+// GetExportState provides a mock function with given fields: ctx, req
+func (_m *RootCoord) GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *rootcoordpb.GetExportStateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetExportStateRequest) *rootcoordpb.GetExportStateResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.GetExportStateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.GetExportStateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_GetExportState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExportState'
+type RootCoord_GetExportState_Call struct {
+	*mock.Call
+}
+
+// GetExportState is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.GetExportStateRequest
+func (_e *RootCoord_Expecter) GetExportState(ctx interface{}, req interface{}) *RootCoord_GetExportState_Call {
+	return &RootCoord_GetExportState_Call{Call: _e.mock.On("GetExportState", ctx, req)}
+}
+
+func (_c *RootCoord_GetExportState_Call) Run(run func(ctx context.Context, req *rootcoordpb.GetExportStateRequest)) *RootCoord_GetExportState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.GetExportStateRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_GetExportState_Call) Return(_a0 *rootcoordpb.GetExportStateResponse, _a1 error) *RootCoord_GetExportState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetImportState provides a mock function with given fields: ctx, req
 func (_m *RootCoord) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -1333,6 +1474,53 @@ func (_c *RootCoord_ListCredUsers_Call) Return(_a0 *milvuspb.ListCredUsersRespon
 	return _c
 }
 
+// ListExportTasks provides a mock function with given fields: ctx, req
+func (_m *RootCoord) ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *rootcoordpb.ListExportTasksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListExportTasksRequest) *rootcoordpb.ListExportTasksResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.ListExportTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ListExportTasksRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ListExportTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExportTasks'
+type RootCoord_ListExportTasks_Call struct {
+	*mock.Call
+}
+
+// ListExportTasks is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.ListExportTasksRequest
+func (_e *RootCoord_Expecter) ListExportTasks(ctx interface{}, req interface{}) *RootCoord_ListExportTasks_Call {
+	return &RootCoord_ListExportTasks_Call{Call: _e.mock.On("ListExportTasks", ctx, req)}
+}
+
+func (_c *RootCoord_ListExportTasks_Call) Run(run func(ctx context.Context, req *rootcoordpb.ListExportTasksRequest)) *RootCoord_ListExportTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ListExportTasksRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ListExportTasks_Call) Return(_a0 *rootcoordpb.ListExportTasksResponse, _a1 error) *RootCoord_ListExportTasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // ListImportTasks provides a mock function with given fields: ctx, req
 func (_m *RootCoord) ListImportTasks(ctx context.Context, req *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -1557,6 +1745,53 @@ func (_c *RootCoord_Register_Call) Return(_a0 error) *RootCoord_Register_Call {
 	return _c
 }
 
+// ReportExport provides a mock function with given fields: ctx, req
+func (_m *RootCoord) ReportExport(ctx context.Context, req *rootcoordpb.ExportResult) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ExportResult) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ExportResult) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ReportExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportExport'
+type RootCoord_ReportExport_Call struct {
+	*mock.Call
+}
+
+// ReportExport is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.ExportResult
+func (_e *RootCoord_Expecter) ReportExport(ctx interface{}, req interface{}) *RootCoord_ReportExport_Call {
+	return &RootCoord_ReportExport_Call{Call: _e.mock.On("ReportExport", ctx, req)}
+}
+
+func (_c *RootCoord_ReportExport_Call) Run(run func(ctx context.Context, req *rootcoordpb.ExportResult)) *RootCoord_ReportExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ExportResult))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ReportExport_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_ReportExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // ReportImport provides a mock function with given fields: ctx, req
 func (_m *RootCoord) ReportImport(ctx context.Context, req *rootcoordpb.ImportResult) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
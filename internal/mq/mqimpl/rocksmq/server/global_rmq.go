@@ -79,6 +79,9 @@ func InitRocksMQ(path string) error {
 		}
 
 		Rmq, finalErr = NewRocksMQ(params, path, nil)
+		if finalErr == nil {
+			registerHTTPHandlers(Rmq)
+		}
 	})
 	return finalErr
 }
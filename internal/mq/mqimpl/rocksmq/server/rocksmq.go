@@ -48,4 +48,10 @@ type RocksMQ interface {
 	ExistConsumerGroup(topicName string, groupName string) (bool, *Consumer, error)
 
 	Notify(topicName, groupName string)
+
+	SetTopicRetention(topicName string, policy RetentionPolicy) error
+	RemoveTopicRetention(topicName string) error
+	GetTopicRetention(topicName string) (RetentionPolicy, bool)
+	Stats(topicName string) (*TopicStats, error)
+	AllStats() ([]*TopicStats, error)
 }
@@ -0,0 +1,116 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/management"
+	"github.com/milvus-io/milvus/internal/management/healthz"
+)
+
+// TopicRetention is the retention policy of a topic returned by the management endpoint
+type TopicRetention struct {
+	Topic     string          `json:"topic"`
+	Retention RetentionPolicy `json:"retention"`
+	// Overridden is false if the topic uses the global retention policy
+	Overridden bool `json:"overridden"`
+}
+
+// registerHTTPHandlers registers the management endpoints of the stats and the retention policies of rmq
+func registerHTTPHandlers(rmq RocksMQ) {
+	management.Register(&management.HTTPHandler{
+		Path:        management.RocksmqStatsRouterPath,
+		HandlerFunc: handleStats(rmq),
+	})
+	management.Register(&management.HTTPHandler{
+		Path:        management.RocksmqRetentionRouterPath,
+		HandlerFunc: handleRetention(rmq),
+	})
+}
+
+func handleStats(rmq RocksMQ) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			management.WriteError(w, http.StatusMethodNotAllowed, "only GET is allowed")
+			return
+		}
+		var stats interface{}
+		var err error
+		if topic := req.URL.Query().Get("topic"); topic != "" {
+			stats, err = rmq.Stats(topic)
+		} else {
+			stats, err = rmq.AllStats()
+		}
+		if err != nil {
+			management.WriteError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeJSON(w, stats)
+	}
+}
+
+func handleRetention(rmq RocksMQ) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		topic := req.URL.Query().Get("topic")
+		if topic == "" {
+			management.WriteError(w, http.StatusBadRequest, "topic is required")
+			return
+		}
+		switch req.Method {
+		case http.MethodGet:
+			policy, overridden := rmq.GetTopicRetention(topic)
+			writeJSON(w, &TopicRetention{Topic: topic, Retention: policy, Overridden: overridden})
+		case http.MethodPost:
+			policy := RetentionPolicy{}
+			if err := json.NewDecoder(req.Body).Decode(&policy); err != nil {
+				management.WriteError(w, http.StatusBadRequest, "invalid retention policy: "+err.Error())
+				return
+			}
+			if err := rmq.SetTopicRetention(topic, policy); err != nil {
+				log.Warn("failed to set rocksmq topic retention", zap.String("topic", topic), zap.Error(err))
+				management.WriteError(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case http.MethodDelete:
+			if err := rmq.RemoveTopicRetention(topic); err != nil {
+				log.Warn("failed to remove rocksmq topic retention", zap.String("topic", topic), zap.Error(err))
+				management.WriteError(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			management.WriteError(w, http.StatusMethodNotAllowed, "only GET, POST and DELETE are allowed")
+		}
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		management.WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set(healthz.ContentTypeHeader, healthz.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write(bs)
+}
@@ -0,0 +1,123 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestRocksmq_HTTPHandlers(t *testing.T) {
+	suffix := "_http"
+	kvPath := rmqPath + kvPathSuffix + suffix
+	defer os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+
+	rocksdbPath := rmqPath + suffix
+	defer os.RemoveAll(rocksdbPath + kvSuffix)
+	defer os.RemoveAll(rocksdbPath)
+
+	var params paramtable.BaseTable
+	params.Init()
+	rmq, err := NewRocksMQ(params, rocksdbPath, idAllocator)
+	require.NoError(t, err)
+	defer rmq.Close()
+
+	topicName := "topic_http"
+	require.NoError(t, rmq.CreateTopic(topicName))
+	defer rmq.DestroyTopic(topicName)
+	_, err = rmq.Produce(topicName, []ProducerMessage{{Payload: []byte("message")}})
+	require.NoError(t, err)
+
+	t.Run("stats", func(t *testing.T) {
+		handler := handleStats(rmq)
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodGet, "/rocksmq/stats?topic="+topicName, nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		stats := &TopicStats{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), stats))
+		assert.Equal(t, int64(1), stats.MsgCount)
+		assert.Equal(t, int64(len("message")), stats.Bytes)
+
+		w = httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodGet, "/rocksmq/stats", nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		var allStats []*TopicStats
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &allStats))
+		assert.Len(t, allStats, 1)
+
+		w = httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodGet, "/rocksmq/stats?topic=topic_not_exist", nil))
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		w = httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodPost, "/rocksmq/stats", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("retention", func(t *testing.T) {
+		handler := handleRetention(rmq)
+		get := func() *TopicRetention {
+			w := httptest.NewRecorder()
+			handler(w, httptest.NewRequest(http.MethodGet, "/rocksmq/retention?topic="+topicName, nil))
+			require.Equal(t, http.StatusOK, w.Code)
+			retention := &TopicRetention{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), retention))
+			return retention
+		}
+		assert.False(t, get().Overridden)
+
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodPost, "/rocksmq/retention?topic="+topicName,
+			strings.NewReader(`{"time_in_secs": 60, "size_in_mb": 1}`)))
+		require.Equal(t, http.StatusOK, w.Code)
+		retention := get()
+		assert.True(t, retention.Overridden)
+		assert.Equal(t, RetentionPolicy{TimeInSecs: 60, SizeInMB: 1}, retention.Retention)
+
+		w = httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodDelete, "/rocksmq/retention?topic="+topicName, nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.False(t, get().Overridden)
+
+		w = httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodPost, "/rocksmq/retention?topic="+topicName, strings.NewReader("{")))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodPost, "/rocksmq/retention?topic=topic_not_exist",
+			strings.NewReader(`{"time_in_secs": 60, "size_in_mb": 1}`)))
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		w = httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodGet, "/rocksmq/retention", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodPut, "/rocksmq/retention?topic="+topicName, nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
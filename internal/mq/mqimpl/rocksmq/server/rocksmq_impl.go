@@ -12,6 +12,7 @@
 package server
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"path"
@@ -66,12 +67,21 @@ const (
 	// page_message_size/topicName/pageId record the endId of each page, it will be purged either in retention or the destroy of topic
 	PageMsgSizeTitle = "page_message_size/"
 
+	// message_num/topicName record the number of messages of the current page, reset with message_size when a new page is opened
+	MessageNumTitle = "message_num/"
+
+	// page_message_num/topicName/pageId record the number of messages of each page, purged with page_message_size
+	PageMsgNumTitle = "page_message_num/"
+
 	// page_ts/topicName/pageId, record the page last ts, used for TTL functionality
 	PageTsTitle = "page_ts/"
 
 	// acked_ts/topicName/pageId, record the latest ack ts of each page, will be purged on retention or destroy of the topic
 	AckedTsTitle = "acked_ts/"
 
+	// retention_policy/topicName, record the retention policy overriding the global one, cleaned up on destroy topic
+	RetentionPolicyTitle = "retention_policy/"
+
 	RmqNotServingErrMsg = "Rocksmq is not serving"
 )
 
@@ -105,10 +115,6 @@ func parsePageID(key string) (int64, error) {
 	return strconv.ParseInt(stringSlice[2], 10, 64)
 }
 
-func checkRetention() bool {
-	return RocksmqRetentionTimeInSecs != -1 || RocksmqRetentionSizeInMB != -1
-}
-
 var topicMu = sync.Map{}
 
 type rocksmq struct {
@@ -122,6 +128,9 @@ type rocksmq struct {
 	retentionInfo *retentionInfo
 	readers       sync.Map
 	state         RmqState
+
+	// exportedGroups is the consumer groups of each topic exported by the last exportStats
+	exportedGroups map[string][]string
 }
 
 // NewRocksMQ step:
@@ -215,14 +224,16 @@ func NewRocksMQ(params paramtable.BaseTable, name string, idAllocator allocator.
 	}
 	rmq.retentionInfo = ri
 
-	if checkRetention() {
+	if rmq.retentionInfo.hasEnabledPolicy() {
 		rmq.retentionInfo.startRetentionInfo()
 	}
 	atomic.StoreInt64(&rmq.state, RmqStateHealthy)
-	// TODO add this to monitor metrics
 	go func() {
 		for {
 			time.Sleep(10 * time.Minute)
+			if rmq.isClosed() {
+				return
+			}
 
 			log.Info("Rocksmq stats",
 				zap.String("cache", kv.DB.GetProperty("rocksdb.block-cache-usage")),
@@ -241,6 +252,10 @@ func NewRocksMQ(params paramtable.BaseTable, name string, idAllocator allocator.
 			rmq.Info()
 		}
 	}()
+	statsInterval := params.ParseInt64WithDefault("rocksmq.statsInterval", DefaultRocksmqStatsIntervalInSecs)
+	if statsInterval > 0 {
+		go rmq.exportStatsLoop(time.Duration(statsInterval) * time.Second)
+	}
 
 	return rmq, nil
 }
@@ -366,6 +381,7 @@ func (rmq *rocksmq) CreateTopic(topicName string) error {
 	// Initialize topic message size to 0
 	msgSizeKey := MessageSizeTitle + topicName
 	kvs[msgSizeKey] = "0"
+	kvs[MessageNumTitle+topicName] = "0"
 
 	// Initialize topic id to its creating time, we don't really use it for now
 	nowTs := strconv.FormatInt(time.Now().Unix(), 10)
@@ -411,6 +427,13 @@ func (rmq *rocksmq) DestroyTopic(topicName string) error {
 		return err
 	}
 
+	// clean page message num info
+	pageMsgNumKey := constructKey(PageMsgNumTitle, topicName)
+	err = rmq.kv.RemoveWithPrefix(pageMsgNumKey)
+	if err != nil {
+		return err
+	}
+
 	// clean page ts info
 	pageMsgTsKey := constructKey(PageTsTitle, topicName)
 	err = rmq.kv.RemoveWithPrefix(pageMsgTsKey)
@@ -429,8 +452,12 @@ func (rmq *rocksmq) DestroyTopic(topicName string) error {
 	topicIDKey := TopicIDTitle + topicName
 	// message size of this topic
 	msgSizeKey := MessageSizeTitle + topicName
+	// message num of this topic
+	msgNumKey := MessageNumTitle + topicName
+	// retention policy override of this topic
+	retentionPolicyKey := RetentionPolicyTitle + topicName
 	var removedKeys []string
-	removedKeys = append(removedKeys, topicIDKey, msgSizeKey)
+	removedKeys = append(removedKeys, topicIDKey, msgSizeKey, msgNumKey, retentionPolicyKey)
 	// Batch remove, atomic operation
 	err = rmq.kv.MultiRemove(removedKeys)
 	if err != nil {
@@ -440,11 +467,58 @@ func (rmq *rocksmq) DestroyTopic(topicName string) error {
 	// clean up retention info
 	topicMu.Delete(topicName)
 	rmq.retentionInfo.topicRetetionTime.Delete(topicName)
+	rmq.retentionInfo.topicRetentionPolicy.Delete(topicName)
 
 	log.Debug("Rocksmq destroy topic successfully ", zap.String("topic", topicName), zap.Int64("elapsed", time.Since(start).Milliseconds()))
 	return nil
 }
 
+// SetTopicRetention overrides the global retention policy for topic, it's kept until the topic is destroyed.
+// The retention goroutine is started if the policy enables retention while the global one doesn't.
+func (rmq *rocksmq) SetTopicRetention(topicName string, policy RetentionPolicy) error {
+	if rmq.isClosed() {
+		return errors.New(RmqNotServingErrMsg)
+	}
+	if _, ok := rmq.retentionInfo.topicRetetionTime.Load(topicName); !ok {
+		return fmt.Errorf("topic name = %s not exist", topicName)
+	}
+	value, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	if err := rmq.kv.Save(RetentionPolicyTitle+topicName, string(value)); err != nil {
+		return err
+	}
+	rmq.retentionInfo.topicRetentionPolicy.Store(topicName, policy)
+	if policy.enabled() {
+		rmq.retentionInfo.startRetentionInfo()
+	}
+	log.Info("Rocksmq set topic retention", zap.String("topic", topicName),
+		zap.Int64("timeInSecs", policy.TimeInSecs), zap.Int64("sizeInMB", policy.SizeInMB))
+	return nil
+}
+
+// RemoveTopicRetention removes the retention override of topic, the global retention policy applies again
+func (rmq *rocksmq) RemoveTopicRetention(topicName string) error {
+	if rmq.isClosed() {
+		return errors.New(RmqNotServingErrMsg)
+	}
+	if err := rmq.kv.Remove(RetentionPolicyTitle + topicName); err != nil {
+		return err
+	}
+	rmq.retentionInfo.topicRetentionPolicy.Delete(topicName)
+	return nil
+}
+
+// GetTopicRetention returns the retention policy applied to topic, and whether it overrides the global one
+func (rmq *rocksmq) GetTopicRetention(topicName string) (RetentionPolicy, bool) {
+	v, ok := rmq.retentionInfo.topicRetentionPolicy.Load(topicName)
+	if !ok {
+		return globalRetentionPolicy(), false
+	}
+	return v.(RetentionPolicy), true
+}
+
 // ExistConsumerGroup check if a consumer exists and return the existed consumer
 func (rmq *rocksmq) ExistConsumerGroup(topicName, groupName string) (bool, *Consumer, error) {
 	key := constructCurrentID(topicName, groupName)
@@ -646,7 +720,14 @@ func (rmq *rocksmq) updatePageInfo(topicName string, msgIDs []UniqueID, msgSizes
 	if err != nil {
 		return err
 	}
+	// topics created before the message num was recorded start counting from 0
+	msgNumKey := MessageNumTitle + topicName
+	curMsgNum, err := loadInt64(rmq.kv, msgNumKey)
+	if err != nil {
+		return err
+	}
 	fixedPageSizeKey := constructKey(PageMsgSizeTitle, topicName)
+	fixedPageNumKey := constructKey(PageMsgNumTitle, topicName)
 	fixedPageTsKey := constructKey(PageTsTitle, topicName)
 	nowTs := strconv.FormatInt(time.Now().Unix(), 10)
 	mutateBuffer := make(map[string]string)
@@ -659,14 +740,19 @@ func (rmq *rocksmq) updatePageInfo(topicName string, msgIDs []UniqueID, msgSizes
 			// Update page message size for current page. key is page end ID
 			pageMsgSizeKey := fixedPageSizeKey + "/" + strconv.FormatInt(pageEndID, 10)
 			mutateBuffer[pageMsgSizeKey] = strconv.FormatInt(newPageSize, 10)
+			pageMsgNumKey := fixedPageNumKey + "/" + strconv.FormatInt(pageEndID, 10)
+			mutateBuffer[pageMsgNumKey] = strconv.FormatInt(curMsgNum+1, 10)
 			pageTsKey := fixedPageTsKey + "/" + strconv.FormatInt(pageEndID, 10)
 			mutateBuffer[pageTsKey] = nowTs
 			curMsgSize = 0
+			curMsgNum = 0
 		} else {
 			curMsgSize += msgSize
+			curMsgNum++
 		}
 	}
 	mutateBuffer[msgSizeKey] = strconv.FormatInt(curMsgSize, 10)
+	mutateBuffer[msgNumKey] = strconv.FormatInt(curMsgNum, 10)
 	err = rmq.kv.MultiSave(mutateBuffer)
 	return err
 }
@@ -12,6 +12,7 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
 	"path"
 	"strconv"
@@ -47,16 +48,38 @@ const (
 // TickerTimeInSeconds is the time of expired check, default 10 minutes
 var TickerTimeInSeconds int64 = 600
 
+// RetentionPolicy is the retention of a topic, -1 means unlimited like the global settings
+type RetentionPolicy struct {
+	TimeInSecs int64 `json:"time_in_secs"`
+	SizeInMB   int64 `json:"size_in_mb"`
+}
+
+// enabled returns whether messages can be expired by the policy
+func (p RetentionPolicy) enabled() bool {
+	return p.TimeInSecs != -1 || p.SizeInMB != -1
+}
+
+// globalRetentionPolicy returns the policy of the topics without override
+func globalRetentionPolicy() RetentionPolicy {
+	return RetentionPolicy{
+		TimeInSecs: atomic.LoadInt64(&RocksmqRetentionTimeInSecs),
+		SizeInMB:   atomic.LoadInt64(&RocksmqRetentionSizeInMB),
+	}
+}
+
 type retentionInfo struct {
 	// key is topic name, value is last retention time
 	topicRetetionTime sync.Map
-	mutex             sync.RWMutex
+	// key is topic name, value is the RetentionPolicy overriding the global one
+	topicRetentionPolicy sync.Map
+	mutex                sync.RWMutex
 
 	kv *rocksdbkv.RocksdbKV
 	db *gorocksdb.DB
 
 	closeCh   chan struct{}
 	closeWg   sync.WaitGroup
+	startOnce sync.Once
 	closeOnce sync.Once
 }
 
@@ -83,15 +106,50 @@ func initRetentionInfo(params paramtable.BaseTable, kv *rocksdbkv.RocksdbKV, db
 		ri.topicRetetionTime.Store(topic, time.Now().Unix())
 		topicMu.Store(topic, new(sync.Mutex))
 	}
+	// Get retention overrides of topics
+	policyKeys, policyVals, err := ri.kv.LoadWithPrefix(RetentionPolicyTitle)
+	if err != nil {
+		return nil, err
+	}
+	for i, key := range policyKeys {
+		policy := RetentionPolicy{}
+		if err := json.Unmarshal([]byte(policyVals[i]), &policy); err != nil {
+			return nil, err
+		}
+		ri.topicRetentionPolicy.Store(key[len(RetentionPolicyTitle):], policy)
+	}
 	return ri, nil
 }
 
+// getPolicy returns the retention policy of topic, the global one if it's not overridden
+func (ri *retentionInfo) getPolicy(topic string) RetentionPolicy {
+	if v, ok := ri.topicRetentionPolicy.Load(topic); ok {
+		return v.(RetentionPolicy)
+	}
+	return globalRetentionPolicy()
+}
+
+// hasEnabledPolicy returns whether any topic has retention, either global or overridden
+func (ri *retentionInfo) hasEnabledPolicy() bool {
+	if globalRetentionPolicy().enabled() {
+		return true
+	}
+	enabled := false
+	ri.topicRetentionPolicy.Range(func(k, v interface{}) bool {
+		enabled = v.(RetentionPolicy).enabled()
+		return !enabled
+	})
+	return enabled
+}
+
 // Before do retention, load retention info from rocksdb to retention info structure in goroutines.
 // Because loadRetentionInfo may need some time, so do this asynchronously. Finally start retention goroutine.
+// It's safe to call it many times, the retention goroutine is started only once.
 func (ri *retentionInfo) startRetentionInfo() {
-	// var wg sync.WaitGroup
-	ri.closeWg.Add(1)
-	go ri.retention()
+	ri.startOnce.Do(func() {
+		ri.closeWg.Add(1)
+		go ri.retention()
+	})
 }
 
 // retention do time ticker and trigger retention check and operation for each topic
@@ -115,7 +173,6 @@ func (ri *retentionInfo) retention() error {
 			go ri.kv.DB.CompactRange(gorocksdb.Range{Start: nil, Limit: nil})
 		case t := <-ticker.C:
 			timeNow := t.Unix()
-			checkTime := atomic.LoadInt64(&RocksmqRetentionTimeInSecs) / 10
 			ri.mutex.RLock()
 			ri.topicRetetionTime.Range(func(k, v interface{}) bool {
 				topic, _ := k.(string)
@@ -124,6 +181,7 @@ func (ri *retentionInfo) retention() error {
 					log.Warn("Can't parse lastRetention to int64", zap.String("topic", topic), zap.Any("value", v))
 					return true
 				}
+				checkTime := ri.getPolicy(topic).TimeInSecs / 10
 				if lastRetentionTs+checkTime < timeNow {
 					err := ri.expiredCleanUp(topic)
 					if err != nil {
@@ -158,6 +216,10 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 	var pageEndID UniqueID
 	var err error
 
+	policy := ri.getPolicy(topic)
+	if !policy.enabled() {
+		return nil
+	}
 	fixedAckedTsKey := constructKey(AckedTsTitle, topic)
 	// calculate total acked size, simply add all page info
 	totalAckedSize, err := ri.calculateTopicAckedSize(topic)
@@ -199,7 +261,7 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 		if err != nil {
 			return err
 		}
-		if msgTimeExpiredCheck(ackedTs) {
+		if msgTimeExpiredCheck(ackedTs, policy.TimeInSecs) {
 			pageEndID = pageID
 			pValue := pageIter.Value()
 			size, err := strconv.ParseInt(string(pValue.Data()), 10, 64)
@@ -238,7 +300,7 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 			return err
 		}
 		curDeleteSize := deletedAckedSize + size
-		if msgSizeExpiredCheck(curDeleteSize, totalAckedSize) {
+		if msgSizeExpiredCheck(curDeleteSize, totalAckedSize, policy.SizeInMB) {
 			pageEndID, err = parsePageID(pKeyStr)
 			if err != nil {
 				return err
@@ -324,6 +386,11 @@ func (ri *retentionInfo) cleanData(topic string, pageEndID UniqueID) error {
 	pageEndIDKey := pageMsgPrefix + "/" + strconv.FormatInt(pageEndID+1, 10)
 	writeBatch.DeleteRange([]byte(pageStartIDKey), []byte(pageEndIDKey))
 
+	pageNumPrefix := constructKey(PageMsgNumTitle, topic)
+	pageNumStartIDKey := pageNumPrefix + "/"
+	pageNumEndIDKey := pageNumPrefix + "/" + strconv.FormatInt(pageEndID+1, 10)
+	writeBatch.DeleteRange([]byte(pageNumStartIDKey), []byte(pageNumEndIDKey))
+
 	pageTsPrefix := constructKey(PageTsTitle, topic)
 	pageTsStartIDKey := pageTsPrefix + "/"
 	pageTsEndIDKey := pageTsPrefix + "/" + strconv.FormatInt(pageEndID+1, 10)
@@ -376,16 +443,16 @@ func DeleteMessages(db *gorocksdb.DB, topic string, startID, endID UniqueID) err
 	return nil
 }
 
-func msgTimeExpiredCheck(ackedTs int64) bool {
-	if RocksmqRetentionTimeInSecs < 0 {
+func msgTimeExpiredCheck(ackedTs int64, retentionTimeInSecs int64) bool {
+	if retentionTimeInSecs < 0 {
 		return false
 	}
-	return ackedTs+atomic.LoadInt64(&RocksmqRetentionTimeInSecs) < time.Now().Unix()
+	return ackedTs+retentionTimeInSecs < time.Now().Unix()
 }
 
-func msgSizeExpiredCheck(deletedAckedSize, ackedSize int64) bool {
-	if RocksmqRetentionSizeInMB < 0 {
+func msgSizeExpiredCheck(deletedAckedSize, ackedSize int64, retentionSizeInMB int64) bool {
+	if retentionSizeInMB < 0 {
 		return false
 	}
-	return ackedSize-deletedAckedSize > atomic.LoadInt64(&RocksmqRetentionSizeInMB)*MB
+	return ackedSize-deletedAckedSize > retentionSizeInMB*MB
 }
@@ -18,6 +18,7 @@ import (
 	"testing"
 	"time"
 
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/stretchr/testify/assert"
@@ -651,3 +652,115 @@ func TestRmqRetention_PageSizeExpire(t *testing.T) {
 	// make sure clean up happens
 	assert.True(t, newRes[0].MsgID > ids[0])
 }
+
+func TestRmqRetention_TopicPolicy(t *testing.T) {
+	err := os.MkdirAll(retentionPath, os.ModePerm)
+	if err != nil {
+		log.Error("MkdirALl error for path", zap.Any("path", retentionPath))
+		return
+	}
+	defer os.RemoveAll(retentionPath)
+
+	kvPath := retentionPath + "kv_topic_policy"
+	os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+
+	rocksdbPath := retentionPath + "db_topic_policy"
+	os.RemoveAll(rocksdbPath)
+	metaPath := retentionPath + "meta_topic_policy"
+	os.RemoveAll(metaPath)
+
+	var params paramtable.BaseTable
+	params.Init()
+	atomic.StoreInt64(&RocksmqPageSize, 10)
+	atomic.StoreInt64(&TickerTimeInSeconds, 1)
+	rmq, err := NewRocksMQ(params, rocksdbPath, idAllocator)
+	assert.Nil(t, err)
+	defer rmq.Close()
+
+	// only topic_a has retention
+	atomic.StoreInt64(&RocksmqRetentionSizeInMB, -1)
+	atomic.StoreInt64(&RocksmqRetentionTimeInSecs, -1)
+
+	err = rmq.SetTopicRetention("topic_not_exist", RetentionPolicy{TimeInSecs: 0, SizeInMB: 0})
+	assert.Error(t, err)
+
+	topics := []string{"topic_a", "topic_b"}
+	groupName := "test_group"
+	msgNum := 100
+	cMsgs := make(map[string][]ConsumerMessage)
+	for _, topicName := range topics {
+		err = rmq.CreateTopic(topicName)
+		assert.Nil(t, err)
+		defer rmq.DestroyTopic(topicName)
+
+		pMsgs := make([]ProducerMessage, msgNum)
+		for i := 0; i < msgNum; i++ {
+			pMsgs[i] = ProducerMessage{Payload: []byte("message_" + strconv.Itoa(i))}
+		}
+		ids, err := rmq.Produce(topicName, pMsgs)
+		assert.Nil(t, err)
+		assert.Equal(t, len(pMsgs), len(ids))
+
+		err = rmq.CreateConsumerGroup(topicName, groupName)
+		assert.Nil(t, err)
+		err = rmq.RegisterConsumer(&Consumer{Topic: topicName, GroupName: groupName})
+		assert.Nil(t, err)
+		for i := 0; i < msgNum; i++ {
+			cMsg, err := rmq.Consume(topicName, groupName, 1)
+			assert.Nil(t, err)
+			cMsgs[topicName] = append(cMsgs[topicName], cMsg[0])
+		}
+	}
+
+	policy := RetentionPolicy{TimeInSecs: 0, SizeInMB: 0}
+	err = rmq.SetTopicRetention("topic_a", policy)
+	assert.Nil(t, err)
+	got, overridden := rmq.GetTopicRetention("topic_a")
+	assert.True(t, overridden)
+	assert.Equal(t, policy, got)
+	got, overridden = rmq.GetTopicRetention("topic_b")
+	assert.False(t, overridden)
+	assert.Equal(t, RetentionPolicy{TimeInSecs: -1, SizeInMB: -1}, got)
+
+	time.Sleep(3 * time.Second)
+
+	// messages of topic_a are cleaned up, topic_b keeps all of them
+	err = rmq.ForceSeek("topic_a", groupName, cMsgs["topic_a"][msgNum/2].MsgID)
+	assert.Nil(t, err)
+	newRes, err := rmq.Consume("topic_a", groupName, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(newRes))
+
+	err = rmq.ForceSeek("topic_b", groupName, cMsgs["topic_b"][msgNum/2].MsgID)
+	assert.Nil(t, err)
+	newRes, err = rmq.Consume("topic_b", groupName, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(newRes))
+
+	// the override is persisted
+	val, err := rmq.kv.Load(RetentionPolicyTitle + "topic_a")
+	assert.Nil(t, err)
+	assert.NotEqual(t, "", val)
+	ri, err := initRetentionInfo(params, rmq.kv.(*rocksdbkv.RocksdbKV), rmq.store)
+	assert.Nil(t, err)
+	assert.Equal(t, policy, ri.getPolicy("topic_a"))
+
+	err = rmq.RemoveTopicRetention("topic_a")
+	assert.Nil(t, err)
+	_, overridden = rmq.GetTopicRetention("topic_a")
+	assert.False(t, overridden)
+	val, err = rmq.kv.Load(RetentionPolicyTitle + "topic_a")
+	assert.Nil(t, err)
+	assert.Equal(t, "", val)
+}
+
+func TestRmqRetention_ExpiredCheck(t *testing.T) {
+	assert.False(t, msgTimeExpiredCheck(0, -1))
+	assert.True(t, msgTimeExpiredCheck(0, 10))
+	assert.False(t, msgTimeExpiredCheck(time.Now().Unix(), 10))
+
+	assert.False(t, msgSizeExpiredCheck(0, 10*MB, -1))
+	assert.True(t, msgSizeExpiredCheck(0, 10*MB, 1))
+	assert.False(t, msgSizeExpiredCheck(9*MB, 10*MB, 1))
+}
@@ -0,0 +1,340 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/tecbot/gorocksdb"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// DefaultRocksmqStatsIntervalInSecs is the default interval the rocksmq metrics are exported at
+var DefaultRocksmqStatsIntervalInSecs int64 = 60
+
+// ConsumerGroupStats is the position of a consumer group in its topic
+type ConsumerGroupStats struct {
+	GroupName string `json:"group_name"`
+	// Position is the id of the next message to consume, DefaultMessageID if it consumes from the beginning
+	Position UniqueID `json:"position"`
+	// Lag is the number of retained messages the group hasn't consumed
+	Lag int64 `json:"lag"`
+}
+
+// TopicStats is the usage of a topic
+type TopicStats struct {
+	Topic    string `json:"topic"`
+	MsgCount int64  `json:"msg_count"`
+	// Bytes is the size of the retained payloads
+	Bytes int64 `json:"bytes"`
+	// PageCount is the number of full pages, which are the unit of retention
+	PageCount int `json:"page_count"`
+	// OldestMsgID and LatestMsgID are DefaultMessageID if the topic has no message
+	OldestMsgID    UniqueID              `json:"oldest_msg_id"`
+	LatestMsgID    UniqueID              `json:"latest_msg_id"`
+	Retention      RetentionPolicy       `json:"retention"`
+	ConsumerGroups []*ConsumerGroupStats `json:"consumer_groups"`
+}
+
+// pageInfo is the metadata of a full page
+type pageInfo struct {
+	endID UniqueID
+	size  int64
+	num   int64
+}
+
+// Stats returns the usage of topic.
+// The message count and size are summed from the page metadata, only the messages from the position of each
+// consumer group to the end of its page are iterated to count its lag.
+// The messages produced before the message count was recorded in the page metadata aren't counted.
+func (rmq *rocksmq) Stats(topicName string) (*TopicStats, error) {
+	if rmq.isClosed() {
+		return nil, errors.New(RmqNotServingErrMsg)
+	}
+	if _, ok := rmq.retentionInfo.topicRetetionTime.Load(topicName); !ok {
+		return nil, fmt.Errorf("topic name = %s not exist", topicName)
+	}
+	policy, _ := rmq.GetTopicRetention(topicName)
+	stats := &TopicStats{
+		Topic:          topicName,
+		Retention:      policy,
+		ConsumerGroups: rmq.consumerGroupStats(topicName),
+	}
+
+	pages, err := rmq.loadPages(topicName)
+	if err != nil {
+		return nil, err
+	}
+	for _, page := range pages {
+		stats.Bytes += page.size
+		stats.MsgCount += page.num
+	}
+	stats.PageCount = len(pages)
+	curSize, err := loadInt64(rmq.kv, MessageSizeTitle+topicName)
+	if err != nil {
+		return nil, err
+	}
+	curNum, err := loadInt64(rmq.kv, MessageNumTitle+topicName)
+	if err != nil {
+		return nil, err
+	}
+	stats.Bytes += curSize
+	stats.MsgCount += curNum
+
+	stats.OldestMsgID, err = rmq.getOldestMsg(topicName)
+	if err != nil {
+		return nil, err
+	}
+	stats.LatestMsgID, err = rmq.getLatestMsg(topicName)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range stats.ConsumerGroups {
+		switch {
+		case stats.LatestMsgID == DefaultMessageID || group.Position > stats.LatestMsgID:
+			group.Lag = 0
+		case group.Position <= stats.OldestMsgID:
+			group.Lag = stats.MsgCount
+		default:
+			group.Lag, err = rmq.countLag(topicName, pages, curNum, group.Position, stats.LatestMsgID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return stats, nil
+}
+
+// loadPages returns the full pages of topic sorted by end id
+func (rmq *rocksmq) loadPages(topicName string) ([]pageInfo, error) {
+	numKeys, numVals, err := rmq.kv.LoadWithPrefix(constructKey(PageMsgNumTitle, topicName) + "/")
+	if err != nil {
+		return nil, err
+	}
+	nums := make(map[UniqueID]int64, len(numKeys))
+	for i, key := range numKeys {
+		pageID, err := parsePageID(key)
+		if err != nil {
+			return nil, err
+		}
+		num, err := strconv.ParseInt(numVals[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		nums[pageID] = num
+	}
+
+	sizeKeys, sizeVals, err := rmq.kv.LoadWithPrefix(constructKey(PageMsgSizeTitle, topicName) + "/")
+	if err != nil {
+		return nil, err
+	}
+	pages := make([]pageInfo, 0, len(sizeKeys))
+	for i, key := range sizeKeys {
+		pageID, err := parsePageID(key)
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.ParseInt(sizeVals[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		pages = append(pages, pageInfo{endID: pageID, size: size, num: nums[pageID]})
+	}
+	sort.Slice(pages, func(i, j int) bool {
+		return pages[i].endID < pages[j].endID
+	})
+	return pages, nil
+}
+
+// countLag returns the number of messages of topic from position to latestID, the messages of the page position
+// is in are iterated, the following pages are counted from their metadata
+func (rmq *rocksmq) countLag(topicName string, pages []pageInfo, curNum int64, position UniqueID, latestID UniqueID) (int64, error) {
+	idx := sort.Search(len(pages), func(i int) bool {
+		return pages[i].endID >= position
+	})
+	if idx == len(pages) {
+		// position is in the current page
+		return rmq.countMessages(topicName, position, latestID)
+	}
+	lag, err := rmq.countMessages(topicName, position, pages[idx].endID)
+	if err != nil {
+		return 0, err
+	}
+	for _, page := range pages[idx+1:] {
+		lag += page.num
+	}
+	return lag + curNum, nil
+}
+
+// countMessages returns the number of messages of topic in [startID, endID], iterating their keys
+func (rmq *rocksmq) countMessages(topicName string, startID UniqueID, endID UniqueID) (int64, error) {
+	readOpts := gorocksdb.NewDefaultReadOptions()
+	defer readOpts.Destroy()
+	// values are not needed, don't fill the block cache with them
+	readOpts.SetFillCache(false)
+	startKey := path.Join(topicName, strconv.FormatInt(startID, 10))
+	endKey := path.Join(topicName, strconv.FormatInt(endID+1, 10))
+	iter := rocksdbkv.NewRocksIteratorWithUpperBound(rmq.store, endKey, readOpts)
+	defer iter.Close()
+	var count int64
+	for iter.Seek([]byte(startKey)); iter.Valid(); iter.Next() {
+		count++
+	}
+	if err := iter.Err(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// getOldestMsg returns the id of the first retained message of topic, DefaultMessageID if there is none
+func (rmq *rocksmq) getOldestMsg(topicName string) (UniqueID, error) {
+	readOpts := gorocksdb.NewDefaultReadOptions()
+	defer readOpts.Destroy()
+	prefix := topicName + "/"
+	iter := rocksdbkv.NewRocksIteratorWithUpperBound(rmq.store, typeutil.AddOne(prefix), readOpts)
+	defer iter.Close()
+	iter.Seek([]byte(prefix))
+	if err := iter.Err(); err != nil {
+		return DefaultMessageID, err
+	}
+	if !iter.Valid() {
+		return DefaultMessageID, nil
+	}
+	key := iter.Key()
+	msgID, err := strconv.ParseInt(string(key.Data())[len(prefix):], 10, 64)
+	key.Free()
+	if err != nil {
+		return DefaultMessageID, err
+	}
+	return msgID, nil
+}
+
+// loadInt64 returns the value of key parsed as an int64, 0 if key doesn't exist
+func loadInt64(kv kv.BaseKV, key string) (int64, error) {
+	val, err := kv.Load(key)
+	if err != nil {
+		return 0, err
+	}
+	if val == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(val, 10, 64)
+}
+
+// consumerGroupStats returns the positions of the consumer groups of topic sorted by position, without lag
+func (rmq *rocksmq) consumerGroupStats(topicName string) []*ConsumerGroupStats {
+	groups := make([]*ConsumerGroupStats, 0)
+	vals, ok := rmq.consumers.Load(topicName)
+	if !ok {
+		return groups
+	}
+	for _, consumer := range vals.([]*Consumer) {
+		position, ok := rmq.consumersID.Load(constructCurrentID(consumer.Topic, consumer.GroupName))
+		if !ok {
+			continue
+		}
+		groups = append(groups, &ConsumerGroupStats{
+			GroupName: consumer.GroupName,
+			Position:  position.(UniqueID),
+		})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Position < groups[j].Position
+	})
+	return groups
+}
+
+// AllStats returns the usage of all topics
+func (rmq *rocksmq) AllStats() ([]*TopicStats, error) {
+	topics := make([]string, 0)
+	rmq.retentionInfo.topicRetetionTime.Range(func(k, v interface{}) bool {
+		topics = append(topics, k.(string))
+		return true
+	})
+	sort.Strings(topics)
+
+	allStats := make([]*TopicStats, 0, len(topics))
+	for _, topic := range topics {
+		stats, err := rmq.Stats(topic)
+		if err != nil {
+			return nil, err
+		}
+		allStats = append(allStats, stats)
+	}
+	return allStats, nil
+}
+
+// exportStatsLoop exports the rocksmq metrics every interval until rocksmq is closed
+func (rmq *rocksmq) exportStatsLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if rmq.isClosed() {
+			return
+		}
+		rmq.exportStats()
+	}
+}
+
+// exportStats sets the rocksmq metrics to the usage of all topics, the series of the topics and consumer groups
+// exported last time but gone now are deleted
+func (rmq *rocksmq) exportStats() {
+	allStats, err := rmq.AllStats()
+	if err != nil {
+		log.Warn("Rocksmq get stats failed", zap.Error(err))
+		return
+	}
+	exported := make(map[string][]string, len(allStats))
+	for _, stats := range allStats {
+		metrics.RocksmqTopicMsgNum.WithLabelValues(stats.Topic).Set(float64(stats.MsgCount))
+		metrics.RocksmqTopicSize.WithLabelValues(stats.Topic).Set(float64(stats.Bytes))
+		metrics.RocksmqTopicPageNum.WithLabelValues(stats.Topic).Set(float64(stats.PageCount))
+		groups := make([]string, 0, len(stats.ConsumerGroups))
+		for _, group := range stats.ConsumerGroups {
+			metrics.RocksmqConsumerGroupLag.WithLabelValues(stats.Topic, group.GroupName).Set(float64(group.Lag))
+			groups = append(groups, group.GroupName)
+		}
+		exported[stats.Topic] = groups
+	}
+
+	for topic, groups := range rmq.exportedGroups {
+		current, ok := exported[topic]
+		if !ok {
+			metrics.RocksmqTopicMsgNum.DeleteLabelValues(topic)
+			metrics.RocksmqTopicSize.DeleteLabelValues(topic)
+			metrics.RocksmqTopicPageNum.DeleteLabelValues(topic)
+		}
+		for _, group := range groups {
+			if !funcutil.SliceContain(current, group) {
+				metrics.RocksmqConsumerGroupLag.DeleteLabelValues(topic, group)
+			}
+		}
+	}
+	rmq.exportedGroups = exported
+}
@@ -0,0 +1,137 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"os"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestRocksmq_Stats(t *testing.T) {
+	suffix := "_stats"
+	kvPath := rmqPath + kvPathSuffix + suffix
+	defer os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+
+	rocksdbPath := rmqPath + suffix
+	os.RemoveAll(rocksdbPath + kvSuffix)
+	os.RemoveAll(rocksdbPath)
+	defer os.RemoveAll(rocksdbPath + kvSuffix)
+	defer os.RemoveAll(rocksdbPath)
+
+	var params paramtable.BaseTable
+	params.Init()
+	atomic.StoreInt64(&RocksmqPageSize, 100)
+	rmq, err := NewRocksMQ(params, rocksdbPath, idAllocator)
+	require.NoError(t, err)
+	defer rmq.Close()
+
+	_, err = rmq.Stats("topic_not_exist")
+	assert.Error(t, err)
+
+	topicName := "topic_stats"
+	err = rmq.CreateTopic(topicName)
+	require.NoError(t, err)
+	defer rmq.DestroyTopic(topicName)
+
+	// empty topic
+	stats, err := rmq.Stats(topicName)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), stats.MsgCount)
+	assert.Equal(t, DefaultMessageID, stats.OldestMsgID)
+	assert.Equal(t, DefaultMessageID, stats.LatestMsgID)
+
+	msgNum := 20
+	pMsgs := make([]ProducerMessage, msgNum)
+	var bytes int64
+	for i := 0; i < msgNum; i++ {
+		pMsgs[i] = ProducerMessage{Payload: []byte("message_" + strconv.Itoa(i))}
+		bytes += int64(len(pMsgs[i].Payload))
+	}
+	ids, err := rmq.Produce(topicName, pMsgs)
+	require.NoError(t, err)
+
+	groups := []string{"group_a", "group_b"}
+	for _, groupName := range groups {
+		err = rmq.CreateConsumerGroup(topicName, groupName)
+		require.NoError(t, err)
+		err = rmq.RegisterConsumer(&Consumer{Topic: topicName, GroupName: groupName, MsgMutex: make(chan struct{}, 1)})
+		require.NoError(t, err)
+	}
+	// group_a consumes 5 messages, group_b none
+	_, err = rmq.Consume(topicName, "group_a", 5)
+	require.NoError(t, err)
+
+	stats, err = rmq.Stats(topicName)
+	require.NoError(t, err)
+	assert.Equal(t, topicName, stats.Topic)
+	assert.Equal(t, int64(msgNum), stats.MsgCount)
+	assert.Equal(t, bytes, stats.Bytes)
+	assert.Equal(t, ids[0], stats.OldestMsgID)
+	assert.Equal(t, ids[msgNum-1], stats.LatestMsgID)
+	assert.Greater(t, stats.PageCount, 0)
+	require.Len(t, stats.ConsumerGroups, 2)
+	assert.Equal(t, "group_b", stats.ConsumerGroups[0].GroupName)
+	assert.Equal(t, DefaultMessageID, stats.ConsumerGroups[0].Position)
+	assert.Equal(t, int64(msgNum), stats.ConsumerGroups[0].Lag)
+	assert.Equal(t, "group_a", stats.ConsumerGroups[1].GroupName)
+	assert.Equal(t, ids[5], stats.ConsumerGroups[1].Position)
+	assert.Equal(t, int64(msgNum-5), stats.ConsumerGroups[1].Lag)
+
+	// lag counted across the pages, and in the current page
+	for _, n := range []int{10, 4} {
+		_, err = rmq.Consume(topicName, "group_b", n)
+		require.NoError(t, err)
+	}
+	_, err = rmq.Consume(topicName, "group_a", 14)
+	require.NoError(t, err)
+	stats, err = rmq.Stats(topicName)
+	require.NoError(t, err)
+	assert.Equal(t, "group_b", stats.ConsumerGroups[0].GroupName)
+	assert.Equal(t, int64(msgNum-14), stats.ConsumerGroups[0].Lag)
+	assert.Equal(t, "group_a", stats.ConsumerGroups[1].GroupName)
+	assert.Equal(t, int64(1), stats.ConsumerGroups[1].Lag)
+	_, err = rmq.Consume(topicName, "group_a", 1)
+	require.NoError(t, err)
+	stats, err = rmq.Stats(topicName)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), stats.ConsumerGroups[1].Lag)
+
+	policy := RetentionPolicy{TimeInSecs: 60, SizeInMB: 1}
+	err = rmq.SetTopicRetention(topicName, policy)
+	require.NoError(t, err)
+
+	allStats, err := rmq.AllStats()
+	require.NoError(t, err)
+	require.Len(t, allStats, 1)
+	assert.Equal(t, policy, allStats[0].Retention)
+
+	rmq.exportStats()
+	assert.ElementsMatch(t, []string{"group_a", "group_b"}, rmq.exportedGroups[topicName])
+	// the series of a destroyed consumer group is deleted at the next export
+	err = rmq.DestroyConsumerGroup(topicName, "group_a")
+	require.NoError(t, err)
+	rmq.exportStats()
+	assert.Equal(t, []string{"group_b"}, rmq.exportedGroups[topicName])
+}
@@ -0,0 +1,88 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/util/compressor"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// CompressionPropertyKey is the message property naming the algorithm the payload is compressed with,
+// payloads of messages without it are not compressed.
+const CompressionPropertyKey = "Compression"
+
+// CompressionConfig decides which produced messages are compressed, only insert and delete messages are.
+type CompressionConfig struct {
+	// Type is the compression algorithm, empty means no compression
+	Type compressor.CompressType
+	// MinSize is the size in bytes under which payloads are not compressed
+	MinSize int
+}
+
+// NewCompressionConfig returns the compression configured by mq.compression
+func NewCompressionConfig(cfg *paramtable.MQConfig) (CompressionConfig, error) {
+	compression := CompressionConfig{
+		Type:    compressor.CompressType(cfg.CompressionType.GetValue()),
+		MinSize: cfg.CompressionMinSize.GetAsInt(),
+	}
+	switch compression.Type {
+	case "", compressor.CompressTypeZstd:
+		return compression, nil
+	default:
+		return CompressionConfig{}, fmt.Errorf("unsupported mq compression type '%s', must be zstd or empty", compression.Type)
+	}
+}
+
+// Compressible is implemented by the msgstreams which can compress the payloads they produce
+type Compressible interface {
+	SetCompression(cfg CompressionConfig)
+}
+
+// compressPayload compresses the payload of a DML message and records the algorithm in the properties.
+// The payload is returned as it is if it's small or doesn't shrink.
+func compressPayload(cfg CompressionConfig, msgType commonpb.MsgType, payload []byte, properties map[string]string) []byte {
+	if cfg.Type == "" || len(payload) < cfg.MinSize {
+		return payload
+	}
+	if msgType != commonpb.MsgType_Insert && msgType != commonpb.MsgType_Delete {
+		return payload
+	}
+	compressed := compressor.ZstdCompressBytes(payload, nil)
+	if len(compressed) >= len(payload) {
+		return payload
+	}
+	properties[CompressionPropertyKey] = string(cfg.Type)
+	return compressed
+}
+
+// DecompressPayload returns the original payload of a consumed message.
+// Messages produced without compression, by older versions for example, are returned as they are.
+func DecompressPayload(payload []byte, properties map[string]string) ([]byte, error) {
+	compression, ok := properties[CompressionPropertyKey]
+	if !ok {
+		return payload, nil
+	}
+	switch compressor.CompressType(compression) {
+	case compressor.CompressTypeZstd:
+		return compressor.ZstdDecompressBytes(payload, nil)
+	default:
+		return nil, fmt.Errorf("unsupported compression '%s' of message payload", compression)
+	}
+}
@@ -0,0 +1,154 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/compressor"
+)
+
+// genLargeInsertMsg generates an insert message of repetitive float vectors, which compresses well
+func genLargeInsertMsg(numRows int) *InsertMsg {
+	rowIDs := make([]int64, numRows)
+	timestamps := make([]Timestamp, numRows)
+	vectors := make([]float32, 0, numRows*8)
+	for i := 0; i < numRows; i++ {
+		rowIDs[i] = int64(i)
+		timestamps[i] = 10
+		vectors = append(vectors, 1, 2, 3, 4, 5, 6, 7, 8)
+	}
+	return &InsertMsg{
+		BaseMsg: BaseMsg{HashValues: make([]uint32, numRows)},
+		InsertRequest: internalpb.InsertRequest{
+			Base:       &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert, MsgID: 1, Timestamp: 10},
+			ShardName:  "0",
+			Timestamps: timestamps,
+			RowIDs:     rowIDs,
+			NumRows:    uint64(numRows),
+			Version:    internalpb.InsertDataVersion_ColumnBased,
+			FieldsData: []*schemapb.FieldData{{
+				Type:    schemapb.DataType_FloatVector,
+				FieldId: 100,
+				Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+					Dim:  8,
+					Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: vectors}},
+				}},
+			}},
+		},
+	}
+}
+
+func marshalPayload(t *testing.T, msg TsMsg) []byte {
+	mb, err := msg.Marshal(msg)
+	require.NoError(t, err)
+	payload, err := convertToByteArray(mb)
+	require.NoError(t, err)
+	return payload
+}
+
+func TestCompressPayload(t *testing.T) {
+	zstd := CompressionConfig{Type: compressor.CompressTypeZstd, MinSize: 1024}
+	payload := marshalPayload(t, genLargeInsertMsg(1000))
+
+	t.Run("compressed", func(t *testing.T) {
+		properties := map[string]string{}
+		compressed := compressPayload(zstd, commonpb.MsgType_Insert, payload, properties)
+		assert.Less(t, len(compressed), len(payload))
+		assert.Equal(t, "zstd", properties[CompressionPropertyKey])
+
+		decompressed, err := DecompressPayload(compressed, properties)
+		require.NoError(t, err)
+		assert.True(t, bytes.Equal(payload, decompressed))
+	})
+
+	t.Run("not compressed", func(t *testing.T) {
+		properties := map[string]string{}
+		assert.Equal(t, payload, compressPayload(CompressionConfig{}, commonpb.MsgType_Insert, payload, properties))
+		assert.Equal(t, payload, compressPayload(CompressionConfig{Type: compressor.CompressTypeZstd, MinSize: len(payload) + 1},
+			commonpb.MsgType_Insert, payload, properties))
+		assert.Equal(t, payload, compressPayload(zstd, commonpb.MsgType_TimeTick, payload, properties))
+		assert.Empty(t, properties)
+
+		// payloads without the property are left as they are
+		decompressed, err := DecompressPayload(payload, nil)
+		require.NoError(t, err)
+		assert.Equal(t, payload, decompressed)
+	})
+
+	t.Run("unsupported compression", func(t *testing.T) {
+		_, err := DecompressPayload(payload, map[string]string{CompressionPropertyKey: "lz4"})
+		assert.Error(t, err)
+		_, err = DecompressPayload(payload, map[string]string{CompressionPropertyKey: "zstd"})
+		assert.Error(t, err)
+	})
+}
+
+func TestNewCompressionConfig(t *testing.T) {
+	Params.Save("mq.compression.type", "zstd")
+	Params.Save("mq.compression.minSize", "10")
+	defer Params.Remove("mq.compression.type")
+	defer Params.Remove("mq.compression.minSize")
+	cfg, err := NewCompressionConfig(&Params.MQCfg)
+	require.NoError(t, err)
+	assert.Equal(t, CompressionConfig{Type: compressor.CompressTypeZstd, MinSize: 10}, cfg)
+
+	Params.Save("mq.compression.type", "lz4")
+	_, err = NewCompressionConfig(&Params.MQCfg)
+	assert.Error(t, err)
+}
+
+func TestUnmarshalPayload(t *testing.T) {
+	dispatcher := (&ProtoUDFactory{}).NewUnmarshalDispatcher()
+	msg := genLargeInsertMsg(1000)
+	payload := marshalPayload(t, msg)
+
+	stream := &mqMsgStream{}
+	stream.SetCompression(CompressionConfig{Type: compressor.CompressTypeZstd, MinSize: 1024})
+	producerMsg := stream.newProducerMessage(msg, payload)
+	require.Equal(t, "zstd", producerMsg.Properties[CompressionPropertyKey])
+
+	// compressed and uncompressed messages are both readable during rolling upgrade
+	for _, m := range []struct {
+		payload    []byte
+		properties map[string]string
+	}{
+		{producerMsg.Payload, producerMsg.Properties},
+		{payload, nil},
+	} {
+		tsMsg, err := dispatcher.UnmarshalPayload(m.payload, m.properties)
+		require.NoError(t, err)
+		insertMsg := tsMsg.(*InsertMsg)
+		assert.Equal(t, msg.GetNumRows(), insertMsg.GetNumRows())
+		assert.Equal(t, msg.GetFieldsData()[0].GetVectors().GetFloatVector().GetData(),
+			insertMsg.GetFieldsData()[0].GetVectors().GetFloatVector().GetData())
+	}
+
+	_, err := dispatcher.UnmarshalPayload(nil, nil)
+	assert.Error(t, err)
+	_, err = dispatcher.UnmarshalPayload(payload, map[string]string{CompressionPropertyKey: "zstd"})
+	assert.Error(t, err)
+	_, err = dispatcher.UnmarshalPayload([]byte{1, 2, 3}, nil)
+	assert.Error(t, err)
+}
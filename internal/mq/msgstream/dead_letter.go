@@ -0,0 +1,245 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const (
+	// PoisonPolicySkip drops the messages which can't be unmarshalled
+	PoisonPolicySkip = "skip"
+	// PoisonPolicyQuarantine records the messages which can't be unmarshalled in the DeadLetterStore before dropping them
+	PoisonPolicyQuarantine = "quarantine"
+
+	deadLetterPrefix = "msgstream/dead-letter"
+
+	// replaySubscriptionKey is the property of the replayed messages naming the only subscription they are delivered to
+	replaySubscriptionKey = "dead_letter_subscription"
+)
+
+// CheckPoisonPolicy returns the poison message policy configured by mq.poisonMessagePolicy
+func CheckPoisonPolicy(cfg *paramtable.MQConfig) (string, error) {
+	policy := cfg.PoisonPolicy.GetValue()
+	switch policy {
+	case PoisonPolicySkip, PoisonPolicyQuarantine:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unsupported mq poison message policy '%s', must be skip or quarantine", policy)
+	}
+}
+
+// DeadLetter is a quarantined message, with its raw bytes and the position it was consumed at
+type DeadLetter struct {
+	Channel      string            `json:"channel"`
+	MsgID        []byte            `json:"msg_id"`
+	Subscription string            `json:"subscription"`
+	Payload      []byte            `json:"payload"`
+	Properties   map[string]string `json:"properties,omitempty"`
+	Error        string            `json:"error"`
+	Time         time.Time         `json:"time"`
+	// TimeTick is set if the subscription consumes the channel by time tick, such letters can't be replayed
+	TimeTick bool `json:"time_tick,omitempty"`
+}
+
+// DeadLetterStore persists the quarantined messages, keyed by channel, subscription and message id
+type DeadLetterStore struct {
+	kv kv.BaseKV
+}
+
+// NewDeadLetterStore returns a DeadLetterStore saving the quarantined messages in kv
+func NewDeadLetterStore(kv kv.BaseKV) *DeadLetterStore {
+	return &DeadLetterStore{kv: kv}
+}
+
+func (s *DeadLetterStore) key(channel string, subscription string, msgID []byte) string {
+	return path.Join(deadLetterPrefix, channel, subscription, hex.EncodeToString(msgID))
+}
+
+// Save records a quarantined message, saving the same message twice for a subscription keeps the last one
+func (s *DeadLetterStore) Save(letter *DeadLetter) error {
+	bs, err := json.Marshal(letter)
+	if err != nil {
+		return err
+	}
+	return s.kv.Save(s.key(letter.Channel, letter.Subscription, letter.MsgID), string(bs))
+}
+
+// Load returns the message of channel at msgID quarantined by subscription
+func (s *DeadLetterStore) Load(channel string, subscription string, msgID []byte) (*DeadLetter, error) {
+	value, err := s.kv.Load(s.key(channel, subscription, msgID))
+	if err != nil {
+		return nil, err
+	}
+	letter := &DeadLetter{}
+	if err := json.Unmarshal([]byte(value), letter); err != nil {
+		return nil, err
+	}
+	return letter, nil
+}
+
+// List returns the quarantined messages of channel, or of all channels if channel is empty
+func (s *DeadLetterStore) List(channel string) ([]*DeadLetter, error) {
+	prefix := deadLetterPrefix + "/"
+	if channel != "" {
+		prefix = path.Join(deadLetterPrefix, channel) + "/"
+	}
+	_, values, err := s.kv.LoadWithPrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+	letters := make([]*DeadLetter, 0, len(values))
+	for _, value := range values {
+		letter := &DeadLetter{}
+		if err := json.Unmarshal([]byte(value), letter); err != nil {
+			return nil, err
+		}
+		letters = append(letters, letter)
+	}
+	return letters, nil
+}
+
+// Remove deletes the message of channel at msgID quarantined by subscription
+func (s *DeadLetterStore) Remove(channel string, subscription string, msgID []byte) error {
+	return s.kv.Remove(s.key(channel, subscription, msgID))
+}
+
+// Replay produces the message of channel at msgID quarantined by subscription to its channel again and removes it
+// from the store. It fails if the message still can't be unmarshalled, a message is worth replaying once the node
+// which couldn't read it is fixed or upgraded.
+// The replayed message is only delivered to subscription, the other subscriptions have already consumed it.
+// The letters of time tick subscriptions are refused, the subscription has passed their timestamp and delivering them
+// would break the order of the msg packs. The factory must keep the message properties, rocksmq doesn't.
+func (s *DeadLetterStore) Replay(ctx context.Context, factory Factory, channel string, subscription string, msgID []byte) error {
+	err := s.replay(ctx, factory, channel, subscription, msgID)
+	if err != nil {
+		metrics.MsgStreamDeadLetterReplayCount.WithLabelValues(metrics.DeadLetterReplayFailLabel).Inc()
+		return err
+	}
+	metrics.MsgStreamDeadLetterReplayCount.WithLabelValues(metrics.DeadLetterReplaySuccessLabel).Inc()
+	return nil
+}
+
+func (s *DeadLetterStore) replay(ctx context.Context, factory Factory, channel string, subscription string, msgID []byte) error {
+	letter, err := s.Load(channel, subscription, msgID)
+	if err != nil {
+		return err
+	}
+	if letter.TimeTick {
+		return fmt.Errorf("dead letter of channel %s at %s was quarantined by time tick subscription %s, its timestamp is passed",
+			channel, hex.EncodeToString(msgID), subscription)
+	}
+	tsMsg, err := (&ProtoUDFactory{}).NewUnmarshalDispatcher().UnmarshalPayload(letter.Payload, letter.Properties)
+	if err != nil {
+		return fmt.Errorf("dead letter of channel %s at %s still can't be unmarshalled: %w", channel, hex.EncodeToString(msgID), err)
+	}
+
+	stream, err := factory.NewMsgStream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	replayer, ok := stream.(deadLetterReplayer)
+	if !ok {
+		return fmt.Errorf("msgstream %T can't replay dead letters", stream)
+	}
+	replayer.setReplaySubscription(subscription)
+	stream.AsProducer([]string{channel})
+	stream.SetRepackFunc(func(msgs []TsMsg, hashKeys [][]int32) (map[int32]*MsgPack, error) {
+		return map[int32]*MsgPack{0: {Msgs: msgs}}, nil
+	})
+	if err := stream.Produce(&MsgPack{Msgs: []TsMsg{tsMsg}}); err != nil {
+		return err
+	}
+	log.Info("dead letter replayed", zap.String("channel", channel), zap.String("subscription", subscription),
+		zap.String("msgID", hex.EncodeToString(msgID)))
+	return s.Remove(channel, subscription, msgID)
+}
+
+// deadLetterReplayer is implemented by the msgstreams which can produce messages delivered to a single subscription
+type deadLetterReplayer interface {
+	setReplaySubscription(subscription string)
+}
+
+// setReplaySubscription marks the produced messages as replayed dead letters of subscription
+func (ms *mqMsgStream) setReplaySubscription(subscription string) {
+	ms.replaySubscription = subscription
+}
+
+// deliveredTo returns whether the consumed message is delivered to subscription,
+// a replayed dead letter is only delivered to the subscription which quarantined it.
+func deliveredTo(msg mqwrapper.Message, subscription string) bool {
+	target, ok := msg.Properties()[replaySubscriptionKey]
+	return !ok || target == subscription
+}
+
+// DeadLetterRecorder is implemented by the msgstreams which can quarantine the messages they can't unmarshal
+type DeadLetterRecorder interface {
+	SetDeadLetterStore(store *DeadLetterStore)
+}
+
+// SetDeadLetterStore quarantines the messages which can't be unmarshalled into store, nil skips them
+func (ms *mqMsgStream) SetDeadLetterStore(store *DeadLetterStore) {
+	ms.deadLetters = store
+}
+
+// handlePoisonMessage is called on the consumed messages which can't be unmarshalled or delivered,
+// they are dropped and also quarantined if the stream has a DeadLetterStore.
+func (ms *mqMsgStream) handlePoisonMessage(msg mqwrapper.Message, subscription string, err error) {
+	channel := filepath.Base(msg.Topic())
+	policy := PoisonPolicySkip
+	if ms.deadLetters != nil {
+		policy = PoisonPolicyQuarantine
+	}
+	log.Error("failed to consume message",
+		zap.String("channel", channel),
+		zap.String("subscription", subscription),
+		zap.String("policy", policy),
+		zap.Error(err))
+	metrics.MsgStreamPoisonMsgCount.WithLabelValues(channel, policy).Inc()
+	if ms.deadLetters == nil {
+		return
+	}
+
+	letter := &DeadLetter{
+		Channel:      channel,
+		MsgID:        msg.ID().Serialize(),
+		Subscription: subscription,
+		Payload:      msg.Payload(),
+		Properties:   msg.Properties(),
+		Error:        err.Error(),
+		Time:         time.Now(),
+		TimeTick:     ms.timeTick,
+	}
+	if err := ms.deadLetters.Save(letter); err != nil {
+		log.Warn("failed to quarantine message", zap.String("channel", channel), zap.Error(err))
+	}
+}
@@ -0,0 +1,126 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/management"
+	"github.com/milvus-io/milvus/internal/management/healthz"
+)
+
+var registerDeadLetterOnce sync.Once
+
+// DeadLetterInfo is the summary of a quarantined message listed by the management endpoint
+type DeadLetterInfo struct {
+	Channel      string    `json:"channel"`
+	MsgID        string    `json:"msg_id"`
+	Subscription string    `json:"subscription"`
+	Error        string    `json:"error"`
+	Time         time.Time `json:"time"`
+	PayloadSize  int       `json:"payload_size"`
+}
+
+// RegisterDeadLetterHandlers registers the management endpoints listing and replaying the messages of store.
+// Only the first call registers them, all the msgstream factories of a process share the same meta root.
+// Replay is refused if factory is nil, for the mqs which can't deliver a replayed message to a single subscription.
+func RegisterDeadLetterHandlers(store *DeadLetterStore, factory Factory) {
+	registerDeadLetterOnce.Do(func() {
+		management.Register(&management.HTTPHandler{
+			Path:        management.DeadLetterRouterPath,
+			HandlerFunc: handleListDeadLetters(store),
+		})
+		management.Register(&management.HTTPHandler{
+			Path:        management.DeadLetterReplayRouterPath,
+			HandlerFunc: handleReplayDeadLetter(store, factory),
+		})
+	})
+}
+
+func handleListDeadLetters(store *DeadLetterStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			management.WriteError(w, http.StatusMethodNotAllowed, "only GET is allowed")
+			return
+		}
+		letters, err := store.List(req.URL.Query().Get("channel"))
+		if err != nil {
+			management.WriteError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		infos := make([]DeadLetterInfo, 0, len(letters))
+		for _, letter := range letters {
+			infos = append(infos, DeadLetterInfo{
+				Channel:      letter.Channel,
+				MsgID:        hex.EncodeToString(letter.MsgID),
+				Subscription: letter.Subscription,
+				Error:        letter.Error,
+				Time:         letter.Time,
+				PayloadSize:  len(letter.Payload),
+			})
+		}
+		bs, err := json.Marshal(infos)
+		if err != nil {
+			management.WriteError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		w.Header().Set(healthz.ContentTypeHeader, healthz.ContentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		w.Write(bs)
+	}
+}
+
+func handleReplayDeadLetter(store *DeadLetterStore, factory Factory) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			management.WriteError(w, http.StatusMethodNotAllowed, "only POST is allowed")
+			return
+		}
+		channel := req.URL.Query().Get("channel")
+		if channel == "" {
+			management.WriteError(w, http.StatusBadRequest, "channel is required")
+			return
+		}
+		subscription := req.URL.Query().Get("subscription")
+		if subscription == "" {
+			management.WriteError(w, http.StatusBadRequest, "subscription is required")
+			return
+		}
+		msgID, err := hex.DecodeString(req.URL.Query().Get("msg_id"))
+		if err != nil || len(msgID) == 0 {
+			management.WriteError(w, http.StatusBadRequest, "invalid msg_id: "+req.URL.Query().Get("msg_id"))
+			return
+		}
+		if factory == nil {
+			management.WriteError(w, http.StatusNotImplemented, "dead letter replay is not supported by the mq")
+			return
+		}
+		if err := store.Replay(req.Context(), factory, channel, subscription, msgID); err != nil {
+			log.Warn("failed to replay dead letter", zap.String("channel", channel), zap.String("subscription", subscription), zap.Error(err))
+			management.WriteError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
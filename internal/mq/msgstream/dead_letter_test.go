@@ -0,0 +1,232 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+type poisonMsgID struct {
+	mqwrapper.MessageID
+	id []byte
+}
+
+func (m *poisonMsgID) Serialize() []byte {
+	return m.id
+}
+
+type poisonMessage struct {
+	topic      string
+	id         []byte
+	payload    []byte
+	properties map[string]string
+}
+
+func (m *poisonMessage) Topic() string                 { return m.topic }
+func (m *poisonMessage) Properties() map[string]string { return m.properties }
+func (m *poisonMessage) Payload() []byte               { return m.payload }
+func (m *poisonMessage) ID() mqwrapper.MessageID       { return &poisonMsgID{id: m.id} }
+
+func TestCheckPoisonPolicy(t *testing.T) {
+	policy, err := CheckPoisonPolicy(&Params.MQCfg)
+	assert.NoError(t, err)
+	assert.Equal(t, PoisonPolicySkip, policy)
+
+	Params.Save(Params.MQCfg.PoisonPolicy.Key, PoisonPolicyQuarantine)
+	defer Params.Remove(Params.MQCfg.PoisonPolicy.Key)
+	policy, err = CheckPoisonPolicy(&Params.MQCfg)
+	assert.NoError(t, err)
+	assert.Equal(t, PoisonPolicyQuarantine, policy)
+
+	Params.Save(Params.MQCfg.PoisonPolicy.Key, "retry")
+	_, err = CheckPoisonPolicy(&Params.MQCfg)
+	assert.Error(t, err)
+}
+
+func TestDeadLetterStore(t *testing.T) {
+	store := NewDeadLetterStore(memkv.NewMemoryKV())
+	letters := []*DeadLetter{
+		{Channel: "ch-1", Subscription: "sub-1", MsgID: []byte{1}, Payload: []byte("a"), Error: "err"},
+		{Channel: "ch-1", Subscription: "sub-2", MsgID: []byte{1}, Payload: []byte("a"), Error: "err"},
+		{Channel: "ch-1", Subscription: "sub-1", MsgID: []byte{2}, Payload: []byte("b"), Error: "err"},
+		{Channel: "ch-10", Subscription: "sub-1", MsgID: []byte{1}, Payload: []byte("c"), Error: "err"},
+	}
+	for _, letter := range letters {
+		require.NoError(t, store.Save(letter))
+	}
+
+	all, err := store.List("")
+	assert.NoError(t, err)
+	assert.Len(t, all, 4)
+	ch1, err := store.List("ch-1")
+	assert.NoError(t, err)
+	assert.Len(t, ch1, 3)
+
+	letter, err := store.Load("ch-10", "sub-1", []byte{1})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("c"), letter.Payload)
+	_, err = store.Load("ch-10", "sub-2", []byte{1})
+	assert.Error(t, err)
+
+	assert.NoError(t, store.Remove("ch-1", "sub-1", []byte{1}))
+	ch1, err = store.List("ch-1")
+	assert.NoError(t, err)
+	assert.Len(t, ch1, 2)
+	_, err = store.Load("ch-1", "sub-1", []byte{1})
+	assert.Error(t, err)
+	letter, err = store.Load("ch-1", "sub-2", []byte{1})
+	assert.NoError(t, err)
+	assert.Equal(t, "sub-2", letter.Subscription)
+}
+
+func TestDeadLetterStore_Replay(t *testing.T) {
+	store := NewDeadLetterStore(memkv.NewMemoryKV())
+	ctx := context.Background()
+
+	// not quarantined
+	err := store.Replay(ctx, nil, "ch", "sub", []byte{1})
+	assert.Error(t, err)
+
+	// still unreadable
+	require.NoError(t, store.Save(&DeadLetter{Channel: "ch", Subscription: "sub", MsgID: []byte{1}, Payload: []byte("garbage")}))
+	err = store.Replay(ctx, nil, "ch", "sub", []byte{1})
+	assert.Error(t, err)
+	_, err = store.Load("ch", "sub", []byte{1})
+	assert.NoError(t, err)
+
+	// quarantined by a time tick subscription
+	require.NoError(t, store.Save(&DeadLetter{Channel: "ch", Subscription: "tt-sub", MsgID: []byte{1}, Payload: []byte("garbage"), TimeTick: true}))
+	err = store.Replay(ctx, nil, "ch", "tt-sub", []byte{1})
+	assert.Error(t, err)
+	_, err = store.Load("ch", "tt-sub", []byte{1})
+	assert.NoError(t, err)
+}
+
+func TestMqMsgStream_ReplaySubscription(t *testing.T) {
+	ms := &mqMsgStream{}
+	var replayer deadLetterReplayer = ms
+	replayer.setReplaySubscription("sub-1")
+	tsMsg := &TimeTickMsg{
+		BaseMsg: BaseMsg{BeginTimestamp: 10, EndTimestamp: 10},
+		TimeTickMsg: internalpb.TimeTickMsg{
+			Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_TimeTick, Timestamp: 10},
+		},
+	}
+	producerMsg := ms.newProducerMessage(tsMsg, []byte("payload"))
+	assert.Equal(t, "sub-1", producerMsg.Properties[replaySubscriptionKey])
+
+	// only delivered to the subscription which quarantined it
+	msg := &poisonMessage{topic: "ch", id: []byte{1}, properties: producerMsg.Properties}
+	assert.True(t, deliveredTo(msg, "sub-1"))
+	assert.False(t, deliveredTo(msg, "sub-2"))
+	assert.True(t, deliveredTo(&poisonMessage{topic: "ch", id: []byte{2}}, "sub-2"))
+
+}
+
+func TestMqMsgStream_HandlePoisonMessage(t *testing.T) {
+	ms := &mqMsgStream{}
+	msg := &poisonMessage{topic: "persistent://public/default/ch", id: []byte{1, 2}, payload: []byte("garbage"),
+		properties: map[string]string{"key": "value"}}
+
+	// skip
+	ms.handlePoisonMessage(msg, "sub", assert.AnError)
+
+	// quarantine
+	store := NewDeadLetterStore(memkv.NewMemoryKV())
+	var recorder DeadLetterRecorder = ms
+	recorder.SetDeadLetterStore(store)
+	ms.handlePoisonMessage(msg, "sub", assert.AnError)
+
+	letter, err := store.Load("ch", "sub", []byte{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, "sub", letter.Subscription)
+	assert.Equal(t, []byte("garbage"), letter.Payload)
+	assert.Equal(t, "value", letter.Properties["key"])
+	assert.Equal(t, assert.AnError.Error(), letter.Error)
+	assert.False(t, letter.TimeTick)
+
+	// quarantined by a time tick subscription
+	ms.timeTick = true
+	ms.handlePoisonMessage(msg, "tt-sub", assert.AnError)
+	letter, err = store.Load("ch", "tt-sub", []byte{1, 2})
+	require.NoError(t, err)
+	assert.True(t, letter.TimeTick)
+}
+
+func TestDeadLetterHandlers(t *testing.T) {
+	store := NewDeadLetterStore(memkv.NewMemoryKV())
+	require.NoError(t, store.Save(&DeadLetter{Channel: "ch", Subscription: "sub", MsgID: []byte{1, 2}, Payload: []byte("garbage"), Error: "err"}))
+
+	t.Run("list", func(t *testing.T) {
+		handler := handleListDeadLetters(store)
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodGet, "/msgstream/dead-letters?channel=ch", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		var infos []DeadLetterInfo
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &infos))
+		require.Len(t, infos, 1)
+		assert.Equal(t, hex.EncodeToString([]byte{1, 2}), infos[0].MsgID)
+		assert.Equal(t, 7, infos[0].PayloadSize)
+
+		w = httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodPost, "/msgstream/dead-letters", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		handler := handleReplayDeadLetter(store, nil)
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodGet, "/msgstream/dead-letters/replay", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+
+		w = httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodPost, "/msgstream/dead-letters/replay?msg_id=0102", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodPost, "/msgstream/dead-letters/replay?channel=ch&msg_id=0102", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodPost, "/msgstream/dead-letters/replay?channel=ch&subscription=sub&msg_id=xyz", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		// no replay factory
+		w = httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodPost, "/msgstream/dead-letters/replay?channel=ch&subscription=sub&msg_id=0102", nil))
+		assert.Equal(t, http.StatusNotImplemented, w.Code)
+
+		// still unreadable
+		handler = handleReplayDeadLetter(store, &PmsFactory{})
+		w = httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodPost, "/msgstream/dead-letters/replay?channel=ch&subscription=sub&msg_id=0102", nil))
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -26,6 +26,7 @@ import (
 	rmqimplserver "github.com/milvus-io/milvus/internal/mq/mqimpl/rocksmq/server"
 	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
 	kafkawrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/kafka"
+	nmqwrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/nmq"
 	pulsarmqwrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/pulsar"
 	rmqwrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/rmq"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -258,3 +259,55 @@ func NewKmsFactory(config *paramtable.KafkaConfig) Factory {
 	}
 	return f
 }
+
+// NmsFactory is a NATS JetStream msgstream factory that implemented Factory interface(msgstream.go)
+type NmsFactory struct {
+	dispatcherFactory ProtoUDFactory
+	config            *paramtable.NatsmqConfig
+	ReceiveBufSize    int64
+}
+
+// NewMsgStream is used to generate a new Msgstream object
+func (f *NmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
+	nmqClient, err := nmqwrapper.NewClientWithConfig(f.config)
+	if err != nil {
+		return nil, err
+	}
+	return NewMqMsgStream(ctx, f.ReceiveBufSize, f.ReceiveBufSize, nmqClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+}
+
+// NewTtMsgStream is used to generate a new TtMsgstream object
+func (f *NmsFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
+	nmqClient, err := nmqwrapper.NewClientWithConfig(f.config)
+	if err != nil {
+		return nil, err
+	}
+	return NewMqTtMsgStream(ctx, f.ReceiveBufSize, f.ReceiveBufSize, nmqClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+}
+
+// NewQueryMsgStream is used to generate a new QueryMsgstream object
+func (f *NmsFactory) NewQueryMsgStream(ctx context.Context) (MsgStream, error) {
+	return f.NewMsgStream(ctx)
+}
+
+func (f *NmsFactory) NewMsgStreamDisposer(ctx context.Context) func([]string, string) error {
+	return func(channels []string, subname string) error {
+		msgstream, err := f.NewMsgStream(ctx)
+		if err != nil {
+			return err
+		}
+		msgstream.AsConsumer(channels, subname, mqwrapper.SubscriptionPositionUnknown)
+		msgstream.Close()
+		return nil
+	}
+}
+
+// NewNmsFactory is used to generate a new NmsFactory object
+func NewNmsFactory(config *paramtable.NatsmqConfig) Factory {
+	f := &NmsFactory{
+		dispatcherFactory: ProtoUDFactory{},
+		ReceiveBufSize:    1024,
+		config:            config,
+	}
+	return f
+}
@@ -20,8 +20,12 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/nats-io/nats-server/v2/server"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
 func TestPmsFactory(t *testing.T) {
@@ -113,3 +117,34 @@ func TestKafkaFactory(t *testing.T) {
 	// err = kmsFactory.NewMsgStreamDisposer(ctx)([]string{"hello"}, "xx")
 	// assert.Nil(t, err)
 }
+
+func TestNatsFactory(t *testing.T) {
+	s, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      server.RANDOM_PORT,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoSigs:    true,
+	})
+	assert.Nil(t, err)
+	go s.Start()
+	assert.True(t, s.ReadyForConnections(10*time.Second))
+	defer s.Shutdown()
+
+	config := Params.NatsmqCfg
+	config.Address = paramtable.ParamItem{Formatter: func(originValue string) string { return s.ClientURL() }}
+	nmsFactory := NewNmsFactory(&config)
+
+	ctx := context.Background()
+	_, err = nmsFactory.NewMsgStream(ctx)
+	assert.Nil(t, err)
+
+	_, err = nmsFactory.NewTtMsgStream(ctx)
+	assert.Nil(t, err)
+
+	_, err = nmsFactory.NewQueryMsgStream(ctx)
+	assert.Nil(t, err)
+
+	err = nmsFactory.NewMsgStreamDisposer(ctx)([]string{"hello"}, "xx")
+	assert.Nil(t, err)
+}
@@ -58,6 +58,14 @@ type mqMsgStream struct {
 	consumerLock *sync.Mutex
 	closed       int32
 	onceChan     sync.Once
+	compression  CompressionConfig
+	deadLetters  *DeadLetterStore
+	txnLock      sync.Mutex
+
+	// replaySubscription is the subscription the produced messages are delivered to when replaying a dead letter
+	replaySubscription string
+	// timeTick is set for the time tick msgstreams
+	timeTick bool
 }
 
 // NewMqMsgStream is used to generate a new mqMsgStream object
@@ -95,6 +103,21 @@ func NewMqMsgStream(ctx context.Context,
 	return stream, nil
 }
 
+// SetCompression sets how the payloads of produced DML messages are compressed
+func (ms *mqMsgStream) SetCompression(cfg CompressionConfig) {
+	ms.compression = cfg
+}
+
+// newProducerMessage compresses the payload according to the compression of the stream
+func (ms *mqMsgStream) newProducerMessage(tsMsg TsMsg, payload []byte) *mqwrapper.ProducerMessage {
+	properties := map[string]string{}
+	payload = compressPayload(ms.compression, tsMsg.Type(), payload, properties)
+	if ms.replaySubscription != "" {
+		properties[replaySubscriptionKey] = ms.replaySubscription
+	}
+	return &mqwrapper.ProducerMessage{Payload: payload, Properties: properties}
+}
+
 // AsProducer create producer to send message to channels
 func (ms *mqMsgStream) AsProducer(channels []string) {
 	for _, channel := range channels {
@@ -230,7 +253,39 @@ func (ms *mqMsgStream) GetProduceChannels() []string {
 	return ms.producerChannels
 }
 
+// produceInTransaction runs produce in a transaction of the client if it's transactional,
+// so read committed consumers see all the messages it sends or none of them.
+func (ms *mqMsgStream) produceInTransaction(produce func() error) error {
+	client, ok := ms.client.(mqwrapper.TransactionalClient)
+	if !ok {
+		return produce()
+	}
+	ms.txnLock.Lock()
+	defer ms.txnLock.Unlock()
+	txn, err := client.BeginTransaction(ms.ctx)
+	if err != nil {
+		return err
+	}
+	if txn == nil {
+		return produce()
+	}
+	if err := produce(); err != nil {
+		if abortErr := txn.Abort(ms.ctx); abortErr != nil {
+			log.Warn("failed to abort transaction", zap.Error(abortErr))
+		}
+		return err
+	}
+	return txn.Commit(ms.ctx)
+}
+
+// Produce sends the msgPack to the producer channels, in a transaction if the client is transactional
 func (ms *mqMsgStream) Produce(msgPack *MsgPack) error {
+	return ms.produceInTransaction(func() error {
+		return ms.produce(msgPack)
+	})
+}
+
+func (ms *mqMsgStream) produce(msgPack *MsgPack) error {
 	if msgPack == nil || len(msgPack.Msgs) <= 0 {
 		log.Debug("Warning: Receive empty msgPack")
 		return nil
@@ -273,7 +328,7 @@ func (ms *mqMsgStream) Produce(msgPack *MsgPack) error {
 				return err
 			}
 
-			msg := &mqwrapper.ProducerMessage{Payload: m, Properties: map[string]string{}}
+			msg := ms.newProducerMessage(v.Msgs[i], m)
 
 			trace.InjectContextToPulsarMsgProperties(sp.Context(), msg.Properties)
 
@@ -297,6 +352,19 @@ func (ms *mqMsgStream) Produce(msgPack *MsgPack) error {
 // ProduceMark send msg pack to all producers and returns corresponding msg id
 // the returned message id serves as marking
 func (ms *mqMsgStream) ProduceMark(msgPack *MsgPack) (map[string][]MessageID, error) {
+	var ids map[string][]MessageID
+	err := ms.produceInTransaction(func() error {
+		var err error
+		ids, err = ms.produceMark(msgPack)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (ms *mqMsgStream) produceMark(msgPack *MsgPack) (map[string][]MessageID, error) {
 	ids := make(map[string][]MessageID)
 	if msgPack == nil || len(msgPack.Msgs) <= 0 {
 		return ids, errors.New("empty msgs")
@@ -339,7 +407,7 @@ func (ms *mqMsgStream) ProduceMark(msgPack *MsgPack) (map[string][]MessageID, er
 				return ids, err
 			}
 
-			msg := &mqwrapper.ProducerMessage{Payload: m, Properties: map[string]string{}}
+			msg := ms.newProducerMessage(tsMsg, m)
 
 			trace.InjectContextToPulsarMsgProperties(sp.Context(), msg.Properties)
 
@@ -365,6 +433,12 @@ func (ms *mqMsgStream) ProduceMark(msgPack *MsgPack) (map[string][]MessageID, er
 // Broadcast put msgPack to all producer in current msgstream
 // which ignores repackFunc logic
 func (ms *mqMsgStream) Broadcast(msgPack *MsgPack) error {
+	return ms.produceInTransaction(func() error {
+		return ms.broadcast(msgPack)
+	})
+}
+
+func (ms *mqMsgStream) broadcast(msgPack *MsgPack) error {
 	if msgPack == nil || len(msgPack.Msgs) <= 0 {
 		log.Debug("Warning: Receive empty msgPack")
 		return nil
@@ -382,7 +456,7 @@ func (ms *mqMsgStream) Broadcast(msgPack *MsgPack) error {
 			return err
 		}
 
-		msg := &mqwrapper.ProducerMessage{Payload: m, Properties: map[string]string{}}
+		msg := ms.newProducerMessage(v, m)
 
 		trace.InjectContextToPulsarMsgProperties(sp.Context(), msg.Properties)
 
@@ -407,6 +481,19 @@ func (ms *mqMsgStream) Broadcast(msgPack *MsgPack) error {
 // BroadcastMark broadcast msg pack to all producers and returns corresponding msg id
 // the returned message id serves as marking
 func (ms *mqMsgStream) BroadcastMark(msgPack *MsgPack) (map[string][]MessageID, error) {
+	var ids map[string][]MessageID
+	err := ms.produceInTransaction(func() error {
+		var err error
+		ids, err = ms.broadcastMark(msgPack)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (ms *mqMsgStream) broadcastMark(msgPack *MsgPack) (map[string][]MessageID, error) {
 	ids := make(map[string][]MessageID)
 	if msgPack == nil || len(msgPack.Msgs) <= 0 {
 		return ids, errors.New("empty msgs")
@@ -424,7 +511,7 @@ func (ms *mqMsgStream) BroadcastMark(msgPack *MsgPack) (map[string][]MessageID,
 			return ids, err
 		}
 
-		msg := &mqwrapper.ProducerMessage{Payload: m, Properties: map[string]string{}}
+		msg := ms.newProducerMessage(v, m)
 
 		trace.InjectContextToPulsarMsgProperties(sp.Context(), msg.Properties)
 
@@ -446,20 +533,9 @@ func (ms *mqMsgStream) BroadcastMark(msgPack *MsgPack) (map[string][]MessageID,
 }
 
 func (ms *mqMsgStream) getTsMsgFromConsumerMsg(msg mqwrapper.Message) (TsMsg, error) {
-	header := commonpb.MsgHeader{}
-	if msg.Payload() == nil {
-		return nil, fmt.Errorf("failed to unmarshal message header, payload is empty")
-	}
-	err := proto.Unmarshal(msg.Payload(), &header)
+	tsMsg, err := ms.unmarshal.UnmarshalPayload(msg.Payload(), msg.Properties())
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal message header, err %s", err.Error())
-	}
-	if header.Base == nil {
-		return nil, fmt.Errorf("failed to unmarshal message, header is uncomplete")
-	}
-	tsMsg, err := ms.unmarshal.Unmarshal(msg.Payload(), header.Base.MsgType)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal tsMsg, err %s", err.Error())
+		return nil, err
 	}
 
 	// set msg info to tsMsg
@@ -491,9 +567,12 @@ func (ms *mqMsgStream) receiveMsg(consumer mqwrapper.Consumer) {
 				log.Warn("MqMsgStream get msg whose payload is nil")
 				continue
 			}
+			if !deliveredTo(msg, consumer.Subscription()) {
+				continue
+			}
 			tsMsg, err := ms.getTsMsgFromConsumerMsg(msg)
 			if err != nil {
-				log.Error("Failed to getTsMsgFromConsumerMsg", zap.Error(err))
+				ms.handlePoisonMessage(msg, consumer.Subscription(), err)
 				continue
 			}
 			pos := tsMsg.Position()
@@ -585,6 +664,7 @@ func NewMqTtMsgStream(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	msgStream.timeTick = true
 	chanMsgBuf := make(map[mqwrapper.Consumer][]TsMsg)
 	chanMsgPos := make(map[mqwrapper.Consumer]*internalpb.MsgPosition)
 	chanStopChan := make(map[mqwrapper.Consumer]chan bool)
@@ -804,9 +884,12 @@ func (ms *MqTtMsgStream) consumeToTtMsg(consumer mqwrapper.Consumer) {
 				log.Warn("MqTtMsgStream get msg whose payload is nil")
 				continue
 			}
+			if !deliveredTo(msg, consumer.Subscription()) {
+				continue
+			}
 			tsMsg, err := ms.getTsMsgFromConsumerMsg(msg)
 			if err != nil {
-				log.Error("Failed to getTsMsgFromConsumerMsg", zap.Error(err))
+				ms.handlePoisonMessage(msg, consumer.Subscription(), err)
 				continue
 			}
 
@@ -918,15 +1001,14 @@ func (ms *MqTtMsgStream) Seek(msgPositions []*internalpb.MsgPosition) error {
 					return fmt.Errorf("consumer closed")
 				}
 				consumer.Ack(msg)
-
-				headerMsg := commonpb.MsgHeader{}
-				err := proto.Unmarshal(msg.Payload(), &headerMsg)
-				if err != nil {
-					return fmt.Errorf("failed to unmarshal message header, err %s", err.Error())
+				if !deliveredTo(msg, consumer.Subscription()) {
+					continue
 				}
-				tsMsg, err := ms.unmarshal.Unmarshal(msg.Payload(), headerMsg.Base.MsgType)
+
+				tsMsg, err := ms.unmarshal.UnmarshalPayload(msg.Payload(), msg.Properties())
 				if err != nil {
-					return fmt.Errorf("failed to unmarshal tsMsg, err %s", err.Error())
+					ms.handlePoisonMessage(msg, consumer.Subscription(), err)
+					continue
 				}
 				if tsMsg.Type() == commonpb.MsgType_TimeTick && tsMsg.BeginTs() >= mp.Timestamp {
 					runLoop = false
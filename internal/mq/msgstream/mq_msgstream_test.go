@@ -2185,3 +2185,52 @@ type messageID struct {
 type iface struct {
 	Type, Data unsafe.Pointer
 }
+
+type mockTransaction struct {
+	committed bool
+	aborted   bool
+}
+
+func (txn *mockTransaction) Commit(ctx context.Context) error {
+	txn.committed = true
+	return nil
+}
+
+func (txn *mockTransaction) Abort(ctx context.Context) error {
+	txn.aborted = true
+	return nil
+}
+
+type mockTransactionalClient struct {
+	mqwrapper.Client
+	txns []*mockTransaction
+}
+
+func (c *mockTransactionalClient) BeginTransaction(ctx context.Context) (mqwrapper.Transaction, error) {
+	txn := &mockTransaction{}
+	c.txns = append(c.txns, txn)
+	return txn, nil
+}
+
+func TestMqMsgStream_ProduceInTransaction(t *testing.T) {
+	client := &mockTransactionalClient{}
+	ms := &mqMsgStream{ctx: context.Background(), client: client}
+
+	err := ms.produceInTransaction(func() error { return nil })
+	assert.NoError(t, err)
+	err = ms.produceInTransaction(func() error { return errors.New("send failed") })
+	assert.Error(t, err)
+
+	require.Len(t, client.txns, 2)
+	assert.True(t, client.txns[0].committed)
+	assert.False(t, client.txns[0].aborted)
+	assert.False(t, client.txns[1].committed)
+	assert.True(t, client.txns[1].aborted)
+
+	// not transactional
+	ms = &mqMsgStream{ctx: context.Background()}
+	called := false
+	err = ms.produceInTransaction(func() error { called = true; return nil })
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
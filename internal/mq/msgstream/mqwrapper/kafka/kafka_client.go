@@ -21,6 +21,12 @@ type kafkaClient struct {
 	basicConfig    kafka.ConfigMap
 	consumerConfig kafka.ConfigMap
 	producerConfig kafka.ConfigMap
+
+	// the producers of a transactional client share a transactional kafka producer, instead of the global one
+	transactional bool
+	txnIDPrefix   string
+	txnMutex      sync.Mutex
+	txnProducer   *transactionalProducer
 }
 
 func getBasicConfig(address string) kafka.ConfigMap {
@@ -69,7 +75,10 @@ func NewKafkaClientInstanceWithConfig(config *paramtable.KafkaConfig) *kafkaClie
 		return kafkaConfigMap
 	}
 
-	return NewKafkaClientInstanceWithConfigMap(kafkaConfig, specExtraConfig(config.ConsumerExtraConfig.GetValue()), specExtraConfig(config.ProducerExtraConfig.GetValue()))
+	kc := NewKafkaClientInstanceWithConfigMap(kafkaConfig, specExtraConfig(config.ConsumerExtraConfig.GetValue()), specExtraConfig(config.ProducerExtraConfig.GetValue()))
+	kc.transactional = config.TransactionEnabled.GetAsBool()
+	kc.txnIDPrefix = config.TransactionIDPrefix.GetValue()
+	return kc
 
 }
 
@@ -86,24 +95,10 @@ func (kc *kafkaClient) getKafkaProducer() (*kafka.Producer, error) {
 	once.Do(func() {
 		config := kc.newProducerConfig()
 		Producer, err = kafka.NewProducer(config)
-
-		go func() {
-			for e := range Producer.Events() {
-				switch ev := e.(type) {
-				case kafka.Error:
-					// Generic client instance-level errors, such as broker connection failures,
-					// authentication issues, etc.
-					// After a fatal error has been raised, any subsequent Produce*() calls will fail with
-					// the original error code.
-					log.Error("kafka error", zap.Any("error msg", ev.Error()))
-					if ev.IsFatal() {
-						panic(ev)
-					}
-				default:
-					log.Debug("kafka producer event", zap.Any("event", ev))
-				}
-			}
-		}()
+		if err != nil {
+			return
+		}
+		go watchProducerEvents(Producer)
 	})
 
 	if err != nil {
@@ -114,6 +109,24 @@ func (kc *kafkaClient) getKafkaProducer() (*kafka.Producer, error) {
 	return Producer, nil
 }
 
+func watchProducerEvents(p *kafka.Producer) {
+	for e := range p.Events() {
+		switch ev := e.(type) {
+		case kafka.Error:
+			// Generic client instance-level errors, such as broker connection failures,
+			// authentication issues, etc.
+			// After a fatal error has been raised, any subsequent Produce*() calls will fail with
+			// the original error code.
+			log.Error("kafka error", zap.Any("error msg", ev.Error()))
+			if ev.IsFatal() {
+				panic(ev)
+			}
+		default:
+			log.Debug("kafka producer event", zap.Any("event", ev))
+		}
+	}
+}
+
 func (kc *kafkaClient) newProducerConfig() *kafka.ConfigMap {
 	newConf := cloneKafkaConfig(kc.basicConfig)
 	// default max message size 5M
@@ -137,18 +150,29 @@ func (kc *kafkaClient) newConsumerConfig(group string, offset mqwrapper.Subscrip
 	//In order to compatible with other MQ, we need to enable the following configuration,
 	//meanwhile, some implementation also try to consume a non-exist topic, such as dataCoordTimeTick.
 	newConf.SetKey("allow.auto.create.topics", true)
+	if kc.transactional {
+		// messages of aborted and ongoing transactions must never be consumed
+		newConf.SetKey("isolation.level", "read_committed")
+	}
 	kc.specialExtraConfig(newConf, kc.consumerConfig)
 
 	return newConf
 }
 
 func (kc *kafkaClient) CreateProducer(options mqwrapper.ProducerOptions) (mqwrapper.Producer, error) {
+	deliveryChan := make(chan kafka.Event, 128)
+	if kc.transactional {
+		tp, err := kc.getTransactionalProducer(options.Topic)
+		if err != nil {
+			return nil, err
+		}
+		return &kafkaProducer{tp: tp, deliveryChan: deliveryChan, topic: options.Topic}, nil
+	}
+
 	pp, err := kc.getKafkaProducer()
 	if err != nil {
 		return nil, err
 	}
-
-	deliveryChan := make(chan kafka.Event, 128)
 	producer := &kafkaProducer{p: pp, deliveryChan: deliveryChan, topic: options.Topic}
 	return producer, nil
 }
@@ -191,4 +215,5 @@ func (kc *kafkaClient) BytesToMsgID(id []byte) (mqwrapper.MessageID, error) {
 }
 
 func (kc *kafkaClient) Close() {
+	kc.closeTransactionalProducer()
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
 
@@ -397,3 +398,74 @@ func produceData(ctx context.Context, t *testing.T, producer mqwrapper.Producer,
 	producer.(*kafkaProducer).p.Flush(500)
 	return msgIDs
 }
+
+func TestKafkaClient_Transactional(t *testing.T) {
+	kc := NewKafkaClientInstance("addr")
+	txn, err := kc.BeginTransaction(context.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, txn)
+	isolation, err := kc.newConsumerConfig("test", 0).Get("isolation.level", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "", isolation)
+
+	kc.transactional = true
+	// no producer created yet
+	txn, err = kc.BeginTransaction(context.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, txn)
+	isolation, err = kc.newConsumerConfig("test", 0).Get("isolation.level", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "read_committed", isolation)
+
+	kc.Close()
+}
+
+func TestKafkaClient_TransactionalProducer(t *testing.T) {
+	txnID := transactionalID("milvus", "proxy", "dml_0")
+	assert.Equal(t, "milvus-proxy-dml_0", txnID)
+
+	p, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": "addr"})
+	require.NoError(t, err)
+	tp := &transactionalProducer{id: txnID, p: p, refs: 1}
+	transactionalProducers.Lock()
+	transactionalProducers.producers[txnID] = tp
+	transactionalProducers.Unlock()
+
+	// the clients of the role publishing first to the same channel share the producer, whatever the node id
+	paramtable.SetRole("proxy")
+	paramtable.SetNodeID(2)
+	kc1 := NewKafkaClientInstance("addr")
+	kc1.transactional = true
+	kc1.txnIDPrefix = "milvus"
+	kc2 := NewKafkaClientInstance("addr")
+	kc2.transactional = true
+	kc2.txnIDPrefix = "milvus"
+	tp1, err := kc1.getTransactionalProducer("dml_0")
+	require.NoError(t, err)
+	tp2, err := kc2.getTransactionalProducer("dml_0")
+	require.NoError(t, err)
+	assert.Same(t, tp, tp1)
+	assert.Same(t, tp, tp2)
+	assert.Equal(t, 3, tp.refs)
+
+	// transactions can't begin on a producer which isn't initialized, the next one isn't blocked
+	_, err = kc1.BeginTransaction(context.Background())
+	assert.Error(t, err)
+	_, err = kc2.BeginTransaction(context.Background())
+	assert.Error(t, err)
+
+	kc1.Close()
+	kc2.Close()
+	assert.Equal(t, 1, tp.refs)
+	releaseTransactionalProducer(tp)
+	transactionalProducers.Lock()
+	assert.Empty(t, transactionalProducers.producers)
+	transactionalProducers.Unlock()
+
+	// a closed producer can't be used until it's recreated
+	assert.Nil(t, tp.p)
+	err = tp.do(func(p *kafka.Producer) error { return nil })
+	assert.Error(t, err)
+	assert.False(t, isFatalKafkaError(assert.AnError))
+	assert.True(t, isFatalKafkaError(kafka.NewError(kafka.ErrFenced, "fenced", true)))
+}
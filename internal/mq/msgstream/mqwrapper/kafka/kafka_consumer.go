@@ -17,6 +17,9 @@ type Consumer struct {
 	msgChannel chan mqwrapper.Message
 	hasAssign  bool
 	skipMsg    bool
+	// skipOffset is the offset of the message to skip, the first consumed message is skipped only if it's there.
+	// The offset may be a transaction marker, which is never consumed.
+	skipOffset kafka.Offset
 	topic      string
 	groupID    string
 	chanOnce   sync.Once
@@ -71,6 +74,7 @@ func newKafkaConsumer(config *kafka.ConfigMap, topic string, groupID string, pos
 			} else {
 				offset = kafka.Offset(latestMsgID.(*kafkaID).messageID)
 				kc.skipMsg = true
+				kc.skipOffset = offset
 			}
 		}
 
@@ -142,7 +146,9 @@ func (kc *Consumer) Chan() <-chan mqwrapper.Message {
 					} else {
 						if kc.skipMsg {
 							kc.skipMsg = false
-							continue
+							if e.TopicPartition.Offset == kc.skipOffset {
+								continue
+							}
 						}
 						kc.msgChannel <- &kafkaMessage{msg: e}
 					}
@@ -183,6 +189,7 @@ func (kc *Consumer) internalSeek(offset kafka.Offset, inclusive bool) error {
 	// If seek timeout is not 0 the call twice will return error isStarted RD_KAFKA_RESP_ERR__STATE.
 	// if the timeout is 0 it will initiate the seek  but return immediately without any error reporting
 	kc.skipMsg = !inclusive
+	kc.skipOffset = offset
 	if err := kc.c.Seek(kafka.TopicPartition{
 		Topic:     &kc.topic,
 		Partition: mqwrapper.DefaultPartitionIdx,
@@ -13,8 +13,16 @@ func (km *kafkaMessage) Topic() string {
 	return *km.msg.TopicPartition.Topic
 }
 
+// Properties returns the properties of the message, which are stored in the headers
 func (km *kafkaMessage) Properties() map[string]string {
-	return nil
+	if len(km.msg.Headers) == 0 {
+		return nil
+	}
+	properties := make(map[string]string, len(km.msg.Headers))
+	for _, header := range km.msg.Headers {
+		properties[header.Key] = string(header.Value)
+	}
+	return properties
 }
 
 func (km *kafkaMessage) Payload() []byte {
@@ -16,3 +16,13 @@ func TestKafkaMessage_All(t *testing.T) {
 	assert.Nil(t, km.Payload())
 	assert.Nil(t, km.Properties())
 }
+
+func TestKafkaMessage_Properties(t *testing.T) {
+	topic := "t"
+	msg := &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 0, Offset: 0},
+		Headers:        []kafka.Header{{Key: "Compression", Value: []byte("zstd")}},
+	}
+	km := &kafkaMessage{msg: msg}
+	assert.Equal(t, map[string]string{"Compression": "zstd"}, km.Properties())
+}
@@ -15,7 +15,9 @@ import (
 )
 
 type kafkaProducer struct {
-	p            *kafka.Producer
+	p *kafka.Producer
+	// tp is the shared producer of a transactional client, used instead of p
+	tp           *transactionalProducer
 	topic        string
 	deliveryChan chan kafka.Event
 	closeOnce    sync.Once
@@ -25,10 +27,33 @@ func (kp *kafkaProducer) Topic() string {
 	return kp.topic
 }
 
+// do calls fn with the kafka producer
+func (kp *kafkaProducer) do(fn func(p *kafka.Producer) error) error {
+	if kp.tp != nil {
+		return kp.tp.do(fn)
+	}
+	return fn(kp.p)
+}
+
 func (kp *kafkaProducer) Send(ctx context.Context, message *mqwrapper.ProducerMessage) (mqwrapper.MessageID, error) {
-	err := kp.p.Produce(&kafka.Message{
+	var id mqwrapper.MessageID
+	err := kp.do(func(p *kafka.Producer) error {
+		var err error
+		id, err = kp.send(p, message)
+		return err
+	})
+	return id, err
+}
+
+func (kp *kafkaProducer) send(p *kafka.Producer, message *mqwrapper.ProducerMessage) (mqwrapper.MessageID, error) {
+	var headers []kafka.Header
+	for key, value := range message.Properties {
+		headers = append(headers, kafka.Header{Key: key, Value: []byte(value)})
+	}
+	err := p.Produce(&kafka.Message{
 		TopicPartition: kafka.TopicPartition{Topic: &kp.topic, Partition: mqwrapper.DefaultPartitionIdx},
 		Value:          message.Payload,
+		Headers:        headers,
 	}, kp.deliveryChan)
 
 	if err != nil {
@@ -53,7 +78,10 @@ func (kp *kafkaProducer) Close() {
 	kp.closeOnce.Do(func() {
 		start := time.Now()
 		//flush in-flight msg within queue.
-		kp.p.Flush(10000)
+		kp.do(func(p *kafka.Producer) error {
+			p.Flush(10000)
+			return nil
+		})
 
 		close(kp.deliveryChan)
 
@@ -0,0 +1,305 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+var _ mqwrapper.TransactionalClient = (*kafkaClient)(nil)
+
+// initTransactionsTimeout bounds the registration of a transactional producer to the transaction coordinator
+var initTransactionsTimeout = 30 * time.Second
+
+// commitTransactionTimeout bounds the retries of a transaction commit when ctx has no earlier deadline
+var commitTransactionTimeout = 30 * time.Second
+
+// transactionalProducer is a transactional kafka producer shared by the clients of a process with the same transactional id.
+// Two producers with the same id fence each other, so the clients share it and their transactions take turns.
+type transactionalProducer struct {
+	id     string
+	config *kafka.ConfigMap
+	refs   int
+	// txnMutex is held from the beginning to the end of a transaction
+	txnMutex sync.Mutex
+	// pMutex guards p, which is closed after a fatal error and recreated
+	pMutex sync.RWMutex
+	p      *kafka.Producer
+}
+
+// transactionalProducers are the transactional producers of this process by transactional id
+var transactionalProducers = struct {
+	sync.Mutex
+	producers map[string]*transactionalProducer
+}{producers: make(map[string]*transactionalProducer)}
+
+// transactionalID returns the transactional id of the clients of the role publishing first to channel.
+// It doesn't depend on the node id, which changes on every start, so the producer of a restarted node fences
+// the one before the restart and aborts its pending transaction.
+func transactionalID(prefix string, role string, channel string) string {
+	return fmt.Sprintf("%s-%s-%s", prefix, role, channel)
+}
+
+// newTransactionalKafkaProducer creates a kafka producer with the transactional config and registers it
+// to the transaction coordinator, fencing the other producers with the same transactional id
+func newTransactionalKafkaProducer(txnID string, config *kafka.ConfigMap) (*kafka.Producer, error) {
+	p, err := kafka.NewProducer(config)
+	if err != nil {
+		log.Error("create transactional kafka producer failed", zap.String("transactionalID", txnID), zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), initTransactionsTimeout)
+	defer cancel()
+	if err := p.InitTransactions(ctx); err != nil {
+		p.Close()
+		log.Error("init kafka transactions failed", zap.String("transactionalID", txnID), zap.Error(err))
+		return nil, err
+	}
+	go watchTransactionalProducerEvents(txnID, p)
+
+	log.Info("transactional kafka producer created", zap.String("transactionalID", txnID))
+	return p, nil
+}
+
+// watchTransactionalProducerEvents logs the errors of a transactional producer. Unlike watchProducerEvents
+// it doesn't panic on fatal errors, such as being fenced, the producer is recreated by the failed transaction.
+func watchTransactionalProducerEvents(txnID string, p *kafka.Producer) {
+	for e := range p.Events() {
+		switch ev := e.(type) {
+		case kafka.Error:
+			log.Error("transactional kafka producer error", zap.String("transactionalID", txnID),
+				zap.Bool("fatal", ev.IsFatal()), zap.Error(ev))
+		default:
+			log.Debug("kafka producer event", zap.Any("event", ev))
+		}
+	}
+}
+
+func isFatalKafkaError(err error) bool {
+	kerr, ok := err.(kafka.Error)
+	return ok && kerr.IsFatal()
+}
+
+// acquireTransactionalProducer returns the transactional producer of txnID, creating it with config if it doesn't exist
+func acquireTransactionalProducer(txnID string, config *kafka.ConfigMap) (*transactionalProducer, error) {
+	transactionalProducers.Lock()
+	defer transactionalProducers.Unlock()
+	if tp, ok := transactionalProducers.producers[txnID]; ok {
+		tp.refs++
+		return tp, nil
+	}
+
+	config.SetKey("enable.idempotence", true)
+	config.SetKey("transactional.id", txnID)
+	p, err := newTransactionalKafkaProducer(txnID, config)
+	if err != nil {
+		return nil, err
+	}
+	tp := &transactionalProducer{id: txnID, config: config, p: p, refs: 1}
+	transactionalProducers.producers[txnID] = tp
+	return tp, nil
+}
+
+// releaseTransactionalProducer closes tp once none of the clients uses it
+func releaseTransactionalProducer(tp *transactionalProducer) {
+	transactionalProducers.Lock()
+	defer transactionalProducers.Unlock()
+	tp.refs--
+	if tp.refs > 0 {
+		return
+	}
+	delete(transactionalProducers.producers, tp.id)
+	tp.pMutex.Lock()
+	defer tp.pMutex.Unlock()
+	if tp.p != nil {
+		tp.p.Close()
+		tp.p = nil
+	}
+}
+
+// do calls fn with the kafka producer, it fails if the producer is closed after a fatal error and not recreated yet
+func (tp *transactionalProducer) do(fn func(p *kafka.Producer) error) error {
+	tp.pMutex.RLock()
+	defer tp.pMutex.RUnlock()
+	if tp.p == nil {
+		return fmt.Errorf("transactional kafka producer %s is closed after a fatal error", tp.id)
+	}
+	return fn(tp.p)
+}
+
+// ensure recreates the kafka producer if the last one failed to be recreated
+func (tp *transactionalProducer) ensure() error {
+	tp.pMutex.Lock()
+	defer tp.pMutex.Unlock()
+	if tp.p != nil {
+		return nil
+	}
+	p, err := newTransactionalKafkaProducer(tp.id, tp.config)
+	if err != nil {
+		return err
+	}
+	tp.p = p
+	return nil
+}
+
+// reset closes the kafka producer after the fatal error cause and creates a new one with the same transactional id,
+// which aborts the pending transaction. If the creation fails, it's retried by the next transaction.
+func (tp *transactionalProducer) reset(cause error) {
+	tp.pMutex.Lock()
+	defer tp.pMutex.Unlock()
+	log.Warn("recreate transactional kafka producer after fatal error", zap.String("transactionalID", tp.id), zap.Error(cause))
+	if tp.p != nil {
+		tp.p.Close()
+		tp.p = nil
+	}
+	if p, err := newTransactionalKafkaProducer(tp.id, tp.config); err == nil {
+		tp.p = p
+	}
+}
+
+// getTransactionalProducer returns the transactional producer of the client, it's acquired with the first producer.
+// The transactional id is derived from the role and the first channel the client publishes to.
+func (kc *kafkaClient) getTransactionalProducer(topic string) (*transactionalProducer, error) {
+	kc.txnMutex.Lock()
+	defer kc.txnMutex.Unlock()
+	if kc.txnProducer != nil {
+		return kc.txnProducer, nil
+	}
+
+	txnID := transactionalID(kc.txnIDPrefix, paramtable.GetRole(), topic)
+	tp, err := acquireTransactionalProducer(txnID, kc.newProducerConfig())
+	if err != nil {
+		return nil, err
+	}
+	kc.txnProducer = tp
+	return tp, nil
+}
+
+func (kc *kafkaClient) closeTransactionalProducer() {
+	kc.txnMutex.Lock()
+	defer kc.txnMutex.Unlock()
+	if kc.txnProducer == nil {
+		return
+	}
+	releaseTransactionalProducer(kc.txnProducer)
+	kc.txnProducer = nil
+}
+
+// BeginTransaction starts a kafka transaction if the client is transactional,
+// it waits for the transaction of the other clients sharing the producer to end.
+// The producer is recreated first if it was closed after a fatal error.
+func (kc *kafkaClient) BeginTransaction(ctx context.Context) (mqwrapper.Transaction, error) {
+	if !kc.transactional {
+		return nil, nil
+	}
+	kc.txnMutex.Lock()
+	tp := kc.txnProducer
+	kc.txnMutex.Unlock()
+	if tp == nil {
+		return nil, nil
+	}
+	tp.txnMutex.Lock()
+	if err := tp.ensure(); err != nil {
+		tp.txnMutex.Unlock()
+		return nil, err
+	}
+	if err := tp.do(func(p *kafka.Producer) error { return p.BeginTransaction() }); err != nil {
+		if isFatalKafkaError(err) {
+			tp.reset(err)
+		}
+		tp.txnMutex.Unlock()
+		return nil, err
+	}
+	return &kafkaTransaction{tp: tp}, nil
+}
+
+type kafkaTransaction struct {
+	tp      *transactionalProducer
+	endOnce sync.Once
+}
+
+// end lets the next transaction of the producer begin
+func (t *kafkaTransaction) end() {
+	t.endOnce.Do(t.tp.txnMutex.Unlock)
+}
+
+// Commit commits the transaction, retrying on retriable errors with backoff until ctx is done or
+// commitTransactionTimeout elapses. The transaction is aborted if the commit fails, or the producer is recreated
+// if the failure is fatal, e.g. it's fenced by a newer producer with the same transactional id.
+func (t *kafkaTransaction) Commit(ctx context.Context) error {
+	defer t.end()
+	ctx, cancel := context.WithTimeout(ctx, commitTransactionTimeout)
+	defer cancel()
+
+	var lastErr error
+	err := retry.Do(ctx, func() error {
+		lastErr = t.tp.do(func(p *kafka.Producer) error { return p.CommitTransaction(ctx) })
+		if lastErr == nil {
+			return nil
+		}
+		if kerr, ok := lastErr.(kafka.Error); ok && kerr.IsRetriable() {
+			log.Warn("commit kafka transaction failed, retry", zap.String("transactionalID", t.tp.id), zap.Error(lastErr))
+			return lastErr
+		}
+		return retry.Unrecoverable(lastErr)
+	}, retry.Attempts(math.MaxUint32), retry.Sleep(100*time.Millisecond), retry.MaxSleepTime(2*time.Second))
+	if err == nil {
+		return nil
+	}
+	if isFatalKafkaError(lastErr) {
+		t.tp.reset(lastErr)
+		return err
+	}
+	if abortErr := t.abort(); abortErr != nil {
+		log.Warn("abort kafka transaction failed", zap.String("transactionalID", t.tp.id), zap.Error(abortErr))
+	}
+	return err
+}
+
+// Abort aborts the transaction, its messages are never seen by read committed consumers
+func (t *kafkaTransaction) Abort(ctx context.Context) error {
+	defer t.end()
+	return t.abortWithContext(ctx)
+}
+
+// abort aborts the transaction after a failed commit, whose context may be done already
+func (t *kafkaTransaction) abort() error {
+	ctx, cancel := context.WithTimeout(context.Background(), commitTransactionTimeout)
+	defer cancel()
+	return t.abortWithContext(ctx)
+}
+
+// abortWithContext aborts the transaction, recreating the producer if it can't abort because of a fatal error
+func (t *kafkaTransaction) abortWithContext(ctx context.Context) error {
+	err := t.tp.do(func(p *kafka.Producer) error { return p.AbortTransaction(ctx) })
+	if isFatalKafkaError(err) {
+		t.tp.reset(err)
+	}
+	return err
+}
@@ -0,0 +1,166 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nmq
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// Check nmqClient implements Client
+var _ mqwrapper.Client = (*nmqClient)(nil)
+
+// StreamConfig is the config of the JetStream streams created by the client, each topic has a stream
+type StreamConfig struct {
+	Storage  nats.StorageType
+	Replicas int
+	MaxAge   time.Duration // zero means unlimited
+	MaxBytes int64         // -1 means unlimited
+}
+
+// nmqClient implements mqwrapper.Client by NATS JetStream.
+// Each topic is stored in a stream with the same name, the subject of the stream is the topic name.
+type nmqClient struct {
+	conn         *nats.Conn
+	js           nats.JetStreamContext
+	streamConfig StreamConfig
+}
+
+// NewClient connects to the nats server and creates a JetStream client
+func NewClient(url string, streamConfig StreamConfig, opts ...nats.Option) (*nmqClient, error) {
+	conn, err := nats.Connect(url, opts...)
+	if err != nil {
+		log.Error("failed to connect nats server", zap.String("url", url), zap.Error(err))
+		return nil, err
+	}
+
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		log.Error("failed to create JetStream context", zap.String("url", url), zap.Error(err))
+		return nil, err
+	}
+	return &nmqClient{conn: conn, js: js, streamConfig: streamConfig}, nil
+}
+
+// NewClientWithConfig creates a JetStream client by the natsmq config
+func NewClientWithConfig(config *paramtable.NatsmqConfig) (*nmqClient, error) {
+	storage := nats.FileStorage
+	switch config.Storage.GetValue() {
+	case "file":
+	case "memory":
+		storage = nats.MemoryStorage
+	default:
+		return nil, fmt.Errorf("invalid natsmq storage type '%s', should be file or memory", config.Storage.GetValue())
+	}
+
+	streamConfig := StreamConfig{
+		Storage:  storage,
+		Replicas: config.Replicas.GetAsInt(),
+		MaxAge:   time.Duration(config.RetentionTimeInMinutes.GetAsInt()) * time.Minute,
+		MaxBytes: -1,
+	}
+	if sizeInMB := config.RetentionSizeInMB.GetAsInt(); sizeInMB > 0 {
+		streamConfig.MaxBytes = int64(sizeInMB) * 1024 * 1024
+	}
+	return NewClient(config.Address.GetValue(), streamConfig, nats.Name("milvus"))
+}
+
+// ensureStream creates the stream of a topic if it doesn't exist
+func (nc *nmqClient) ensureStream(topic string) error {
+	if topic == "" || strings.ContainsAny(topic, ".*> \t\r\n/\\") {
+		return fmt.Errorf("invalid topic name '%s' for natsmq", topic)
+	}
+
+	_, err := nc.js.StreamInfo(topic)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, nats.ErrStreamNotFound) {
+		log.Error("failed to get stream info", zap.String("topic", topic), zap.Error(err))
+		return err
+	}
+
+	_, err = nc.js.AddStream(&nats.StreamConfig{
+		Name:      topic,
+		Subjects:  []string{topic},
+		Storage:   nc.streamConfig.Storage,
+		Replicas:  nc.streamConfig.Replicas,
+		MaxAge:    nc.streamConfig.MaxAge,
+		MaxBytes:  nc.streamConfig.MaxBytes,
+		Retention: nats.LimitsPolicy,
+	})
+	// the stream might be created by another client concurrently
+	if err != nil && !errors.Is(err, nats.ErrStreamNameAlreadyInUse) {
+		log.Error("failed to create stream", zap.String("topic", topic), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// CreateProducer creates a producer of the topic, the stream is created if it doesn't exist
+func (nc *nmqClient) CreateProducer(options mqwrapper.ProducerOptions) (mqwrapper.Producer, error) {
+	if err := nc.ensureStream(options.Topic); err != nil {
+		return nil, err
+	}
+	return &nmqProducer{js: nc.js, topic: options.Topic}, nil
+}
+
+// Subscribe creates a consumer of the topic, the stream is created if it doesn't exist
+func (nc *nmqClient) Subscribe(options mqwrapper.ConsumerOptions) (mqwrapper.Consumer, error) {
+	if err := nc.ensureStream(options.Topic); err != nil {
+		return nil, err
+	}
+	return newNmqConsumer(nc.js, options)
+}
+
+// EarliestMessageID returns the id before the first message
+func (nc *nmqClient) EarliestMessageID() mqwrapper.MessageID {
+	return &nmqID{messageID: 0}
+}
+
+// StringToMsgID converts a string to a message id
+func (nc *nmqClient) StringToMsgID(id string) (mqwrapper.MessageID, error) {
+	seq, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &nmqID{messageID: seq}, nil
+}
+
+// BytesToMsgID converts a byte slice to a message id
+func (nc *nmqClient) BytesToMsgID(id []byte) (mqwrapper.MessageID, error) {
+	if len(id) != 8 {
+		return nil, fmt.Errorf("invalid natsmq message id length %d", len(id))
+	}
+	return &nmqID{messageID: DeserializeNmqID(id)}, nil
+}
+
+// Close closes the connection
+func (nc *nmqClient) Close() {
+	nc.conn.Close()
+}
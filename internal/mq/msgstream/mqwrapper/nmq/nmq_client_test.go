@@ -0,0 +1,315 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nmq
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/config"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// runServer starts an embedded nats server with JetStream enabled
+func runServer(t *testing.T) *server.Server {
+	opts := &server.Options{
+		Host:      "127.0.0.1",
+		Port:      server.RANDOM_PORT,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	}
+	s, err := server.NewServer(opts)
+	assert.NoError(t, err)
+	go s.Start()
+	if !s.ReadyForConnections(10 * time.Second) {
+		t.Fatal("nats server is not ready")
+	}
+	t.Cleanup(s.Shutdown)
+	return s
+}
+
+func newTestClient(t *testing.T) *nmqClient {
+	s := runServer(t)
+	client, err := NewClient(s.ClientURL(), StreamConfig{Storage: nats.FileStorage, Replicas: 1, MaxBytes: -1})
+	assert.NoError(t, err)
+	t.Cleanup(client.Close)
+	return client
+}
+
+func randomTopic() string {
+	return fmt.Sprintf("test-topic-%d", rand.Int())
+}
+
+func produceData(t *testing.T, client *nmqClient, topic string, data []string) []mqwrapper.MessageID {
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: topic})
+	assert.NoError(t, err)
+	defer producer.Close()
+
+	ids := make([]mqwrapper.MessageID, 0, len(data))
+	for _, d := range data {
+		id, err := producer.Send(context.Background(), &mqwrapper.ProducerMessage{
+			Payload:    []byte(d),
+			Properties: map[string]string{"key": d},
+		})
+		assert.NoError(t, err)
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func consumeData(t *testing.T, consumer mqwrapper.Consumer, count int) []string {
+	data := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		select {
+		case msg := <-consumer.Chan():
+			consumer.Ack(msg)
+			data = append(data, string(msg.Payload()))
+		case <-time.After(10 * time.Second):
+			t.Fatal("timeout to consume messages")
+		}
+	}
+	return data
+}
+
+func TestNmqClient_MsgID(t *testing.T) {
+	client := newTestClient(t)
+
+	assert.True(t, client.EarliestMessageID().AtEarliestPosition())
+
+	id, err := client.StringToMsgID("123")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(123), id.(*nmqID).messageID)
+	_, err = client.StringToMsgID("dummy")
+	assert.Error(t, err)
+
+	id, err = client.BytesToMsgID(SerializeNmqID(456))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(456), id.(*nmqID).messageID)
+	_, err = client.BytesToMsgID([]byte{1})
+	assert.Error(t, err)
+}
+
+func TestNmqClient_InvalidTopic(t *testing.T) {
+	client := newTestClient(t)
+
+	_, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: "a.b"})
+	assert.Error(t, err)
+	_, err = client.Subscribe(mqwrapper.ConsumerOptions{Topic: ""})
+	assert.Error(t, err)
+}
+
+func TestNmqClient_ProduceConsume(t *testing.T) {
+	client := newTestClient(t)
+	topic := randomTopic()
+
+	ids := produceData(t, client, topic, []string{"a", "b", "c"})
+	assert.Equal(t, uint64(1), ids[0].(*nmqID).messageID)
+	assert.Equal(t, uint64(3), ids[2].(*nmqID).messageID)
+
+	// earliest position consumes all the messages
+	consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "sub1",
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionEarliest,
+	})
+	assert.NoError(t, err)
+	defer consumer.Close()
+	assert.Equal(t, "sub1", consumer.Subscription())
+
+	msg := <-consumer.Chan()
+	consumer.Ack(msg)
+	assert.Equal(t, topic, msg.Topic())
+	assert.Equal(t, "a", string(msg.Payload()))
+	assert.Equal(t, map[string]string{"key": "a"}, msg.Properties())
+	assert.Equal(t, uint64(1), msg.ID().(*nmqID).messageID)
+	assert.Equal(t, []string{"b", "c"}, consumeData(t, consumer, 2))
+
+	latest, err := consumer.GetLatestMsgID()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), latest.(*nmqID).messageID)
+
+	// acknowledged messages move the ack floor
+	assert.Eventually(t, func() bool {
+		info, err := consumer.(*Consumer).sub.ConsumerInfo()
+		return err == nil && info.AckFloor.Stream == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// already subscribed
+	err = consumer.Seek(ids[0], true)
+	assert.Error(t, err)
+
+	// latest position only consumes the messages sent after subscribed
+	consumer2, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "sub2",
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionLatest,
+	})
+	assert.NoError(t, err)
+	defer consumer2.Close()
+
+	produceData(t, client, topic, []string{"d", "e"})
+	assert.Equal(t, []string{"d", "e"}, consumeData(t, consumer2, 2))
+	assert.Equal(t, []string{"d", "e"}, consumeData(t, consumer, 2))
+}
+
+func TestNmqClient_Seek(t *testing.T) {
+	client := newTestClient(t)
+	topic := randomTopic()
+	ids := produceData(t, client, topic, []string{"a", "b", "c"})
+
+	newConsumer := func() mqwrapper.Consumer {
+		consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "sub",
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+		})
+		assert.NoError(t, err)
+		return consumer
+	}
+
+	// not subscribed yet
+	consumer := newConsumer()
+	assert.Panics(t, func() { consumer.Chan() })
+	consumer.Close()
+
+	// inclusive
+	consumer = newConsumer()
+	err := consumer.Seek(ids[1], true)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b", "c"}, consumeData(t, consumer, 2))
+	consumer.Close()
+
+	// exclusive
+	consumer = newConsumer()
+	err = consumer.Seek(ids[1], false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c"}, consumeData(t, consumer, 1))
+	consumer.Close()
+
+	// earliest
+	consumer = newConsumer()
+	err = consumer.Seek(client.EarliestMessageID(), true)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, consumeData(t, consumer, 3))
+	consumer.Close()
+
+	// close twice
+	consumer.Close()
+}
+
+func TestNmqClient_WithConfig(t *testing.T) {
+	s := runServer(t)
+
+	manager := config.NewManager()
+	value := func(key string, v string) paramtable.ParamItem {
+		item := paramtable.ParamItem{Key: key}
+		item.Init(manager)
+		manager.SetConfig(key, v)
+		return item
+	}
+	params := &paramtable.NatsmqConfig{
+		Address:                value("natsmq.address", s.ClientURL()),
+		Storage:                value("natsmq.storage", "memory"),
+		Replicas:               value("natsmq.replicas", "1"),
+		RetentionTimeInMinutes: value("natsmq.retentionTimeInMinutes", "60"),
+		RetentionSizeInMB:      value("natsmq.retentionSizeInMB", "10"),
+	}
+	client, err := NewClientWithConfig(params)
+	assert.NoError(t, err)
+	defer client.Close()
+
+	topic := randomTopic()
+	produceData(t, client, topic, []string{"a"})
+	info, err := client.js.StreamInfo(topic)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, info.Config.MaxAge)
+	assert.Equal(t, int64(10*1024*1024), info.Config.MaxBytes)
+	assert.Equal(t, nats.MemoryStorage, info.Config.Storage)
+
+	manager.SetConfig("natsmq.storage", "dummy")
+	_, err = NewClientWithConfig(params)
+	assert.Error(t, err)
+
+	manager.SetConfig("natsmq.storage", "file")
+	manager.SetConfig("natsmq.address", "nats://127.0.0.1:1")
+	_, err = NewClientWithConfig(params)
+	assert.Error(t, err)
+}
+
+func TestNmqClient_ConsumerLifecycle(t *testing.T) {
+	client := newTestClient(t)
+	topic := randomTopic()
+	ids := produceData(t, client, topic, []string{"a", "b"})
+
+	subName := "by-dev-querynode.1"
+	name := consumerName(subName)
+	assert.Equal(t, "by-dev-querynode_1", name)
+
+	// a consumer left by a crashed process is reused from its position
+	leaveConsumer := func() {
+		_, err := client.js.AddConsumer(topic, &nats.ConsumerConfig{
+			Durable:       name,
+			AckPolicy:     nats.AckExplicitPolicy,
+			DeliverPolicy: nats.DeliverByStartSequencePolicy,
+			OptStartSeq:   2,
+		})
+		assert.NoError(t, err)
+	}
+	leaveConsumer()
+	consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            subName,
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionLatest,
+	})
+	assert.NoError(t, err)
+	produceData(t, client, topic, []string{"c"})
+	assert.Equal(t, []string{"b", "c"}, consumeData(t, consumer, 2))
+
+	// the JetStream consumer is deleted when the consumer is closed, even if it's reused
+	consumer.Close()
+	_, err = client.js.ConsumerInfo(topic, name)
+	assert.ErrorIs(t, err, nats.ErrConsumerNotFound)
+
+	// seek recreates the consumer left by a crashed process
+	leaveConsumer()
+	consumer, err = client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            subName,
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+	})
+	assert.NoError(t, err)
+	defer consumer.Close()
+	err = consumer.Seek(ids[0], true)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, consumeData(t, consumer, 3))
+
+	// the JetStream consumer is named after the subscription, and the messages can be redelivered
+	info, err := client.js.ConsumerInfo(topic, name)
+	assert.NoError(t, err)
+	assert.NotEqual(t, 1, info.Config.MaxDeliver)
+	assert.Equal(t, consumerInactiveThreshold, info.Config.InactiveThreshold)
+}
@@ -0,0 +1,267 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nmq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+)
+
+const (
+	fetchBatchSize      = 256
+	fetchTimeout        = 500 * time.Millisecond
+	defaultConsumerBuf  = 1024
+	fetchErrorBackoff   = 100 * time.Millisecond
+	consumerDescription = "milvus subscription %s"
+
+	// a JetStream consumer left by a crashed process is deleted by the server after the threshold,
+	// a working consumer fetches messages far more often than that
+	consumerInactiveThreshold = 30 * time.Minute
+)
+
+// Check Consumer implements Consumer
+var _ mqwrapper.Consumer = (*Consumer)(nil)
+
+// Consumer pulls messages of a topic by a durable JetStream pull consumer named after the subscription, which is
+// deleted when the Consumer is closed. The start position is decided when the consumer is created, or by Seek if
+// the initial position is unknown. An existing consumer of the subscription, e.g. left by a crashed process, is
+// reused from its ack floor, it's only recreated by Seek. An acknowledged message moves the ack floor of the consumer, a message which is
+// not acknowledged in time is redelivered by the server, the redelivered messages already passed are skipped to keep
+// the order.
+type Consumer struct {
+	js         nats.JetStreamContext
+	topic      string
+	subName    string
+	name       string // name of the JetStream consumer
+	lastSeq    uint64 // stream sequence of the last message passed to msgChannel
+	sub        *nats.Subscription
+	msgChannel chan mqwrapper.Message
+	ctx        context.Context
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+	chanOnce   sync.Once
+	closeOnce  sync.Once
+}
+
+func newNmqConsumer(js nats.JetStreamContext, options mqwrapper.ConsumerOptions) (*Consumer, error) {
+	bufSize := options.BufSize
+	if bufSize <= 0 {
+		bufSize = defaultConsumerBuf
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	nc := &Consumer{
+		js:         js,
+		topic:      options.Topic,
+		subName:    options.SubscriptionName,
+		name:       consumerName(options.SubscriptionName),
+		msgChannel: make(chan mqwrapper.Message, bufSize),
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+
+	var err error
+	switch options.SubscriptionInitialPosition {
+	case mqwrapper.SubscriptionPositionEarliest:
+		err = nc.subscribe(0, false)
+	case mqwrapper.SubscriptionPositionLatest:
+		// the messages sent after the consumer is created are consumed
+		var info *nats.StreamInfo
+		info, err = js.StreamInfo(nc.topic)
+		if err == nil {
+			err = nc.subscribe(info.State.LastSeq+1, false)
+		}
+	}
+	// if it's unknown, we leave the subscribe to seek
+	if err != nil {
+		log.Error("natsmq consumer failed to subscribe", zap.String("topic", nc.topic),
+			zap.Any("position", options.SubscriptionInitialPosition), zap.Error(err))
+		cancel()
+		return nil, err
+	}
+
+	return nc, nil
+}
+
+// consumerName converts the subscription name to a valid JetStream consumer name
+func consumerName(subName string) string {
+	name := []byte(subName)
+	for i, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			name[i] = '_'
+		}
+	}
+	return string(name)
+}
+
+// subscribe binds to the JetStream consumer of the subscription, the consumer is created to deliver messages from the
+// start sequence if it doesn't exist. The existing consumer is deleted first if recreate is true.
+func (nc *Consumer) subscribe(startSeq uint64, recreate bool) error {
+	if recreate {
+		if err := nc.js.DeleteConsumer(nc.topic, nc.name); err != nil && !errors.Is(err, nats.ErrConsumerNotFound) {
+			return err
+		}
+	} else if _, err := nc.js.ConsumerInfo(nc.topic, nc.name); err == nil {
+		sub, err := nc.js.PullSubscribe(nc.topic, nc.name, nats.Bind(nc.topic, nc.name))
+		if err != nil {
+			return err
+		}
+		nc.sub = sub
+		log.Info("natsmq consumer subscribed by the existing consumer", zap.String("topic", nc.topic),
+			zap.String("subName", nc.subName))
+		return nil
+	} else if !errors.Is(err, nats.ErrConsumerNotFound) {
+		return err
+	}
+
+	opts := []nats.SubOpt{
+		nats.BindStream(nc.topic),
+		nats.AckExplicit(),
+		nats.MaxAckPending(-1),
+		nats.InactiveThreshold(consumerInactiveThreshold),
+		nats.Description(fmt.Sprintf(consumerDescription, nc.subName)),
+	}
+	if startSeq <= 1 {
+		opts = append(opts, nats.DeliverAll())
+	} else {
+		opts = append(opts, nats.StartSequence(startSeq))
+	}
+	sub, err := nc.js.PullSubscribe(nc.topic, nc.name, opts...)
+	if err != nil {
+		return err
+	}
+	nc.sub = sub
+	log.Info("natsmq consumer subscribed", zap.String("topic", nc.topic), zap.String("subName", nc.subName),
+		zap.Uint64("startSeq", startSeq))
+	return nil
+}
+
+// Subscription returns the subscription name
+func (nc *Consumer) Subscription() string {
+	return nc.subName
+}
+
+// Chan provides a channel to read consumed message.
+func (nc *Consumer) Chan() <-chan mqwrapper.Message {
+	if nc.sub == nil {
+		log.Error("can not chan with not subscribed consumer", zap.String("topic", nc.topic), zap.String("subName", nc.subName))
+		panic("failed to chan a natsmq consumer without subscribe")
+	}
+	nc.chanOnce.Do(func() {
+		nc.wg.Add(1)
+		go nc.fetchLoop()
+	})
+	return nc.msgChannel
+}
+
+// fetchLoop pulls messages from the server until the consumer is closed
+func (nc *Consumer) fetchLoop() {
+	defer nc.wg.Done()
+	for {
+		ctx, cancel := context.WithTimeout(nc.ctx, fetchTimeout)
+		msgs, err := nc.sub.Fetch(fetchBatchSize, nats.Context(ctx))
+		cancel()
+		if nc.ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			if !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, nats.ErrTimeout) {
+				log.Warn("natsmq consumer failed to fetch messages", zap.String("topic", nc.topic), zap.Error(err))
+				time.Sleep(fetchErrorBackoff)
+			}
+			continue
+		}
+
+		for _, msg := range msgs {
+			meta, err := msg.Metadata()
+			if err != nil {
+				log.Warn("natsmq consumer received a message without metadata", zap.String("topic", nc.topic), zap.Error(err))
+				continue
+			}
+			if meta.Sequence.Stream <= nc.lastSeq {
+				continue
+			}
+			nc.lastSeq = meta.Sequence.Stream
+			select {
+			case nc.msgChannel <- &nmqMessage{msg: msg, id: meta.Sequence.Stream}:
+			case <-nc.ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// Seek sets the start position of the consumer, it's only allowed if the initial position is unknown.
+// The message of the id is consumed if inclusive is true, the existing consumer of the subscription is recreated.
+func (nc *Consumer) Seek(id mqwrapper.MessageID, inclusive bool) error {
+	if nc.sub != nil {
+		return errors.New("natsmq consumer is already subscribed, can not seek again")
+	}
+
+	startSeq := id.(*nmqID).messageID
+	if !inclusive {
+		startSeq++
+	}
+	return nc.subscribe(startSeq, true)
+}
+
+// Ack acknowledges the message to the server
+func (nc *Consumer) Ack(message mqwrapper.Message) {
+	msg, ok := message.(*nmqMessage)
+	if !ok {
+		return
+	}
+	if err := msg.msg.Ack(); err != nil {
+		log.Warn("natsmq consumer failed to ack message", zap.String("topic", nc.topic), zap.Error(err))
+	}
+}
+
+// GetLatestMsgID returns the id of the last message in the stream
+func (nc *Consumer) GetLatestMsgID() (mqwrapper.MessageID, error) {
+	info, err := nc.js.StreamInfo(nc.topic)
+	if err != nil {
+		return nil, err
+	}
+	return &nmqID{messageID: info.State.LastSeq}, nil
+}
+
+// Close stops fetching messages and deletes the JetStream consumer
+func (nc *Consumer) Close() {
+	nc.closeOnce.Do(func() {
+		nc.cancel()
+		nc.wg.Wait()
+		if nc.sub != nil {
+			if err := nc.sub.Unsubscribe(); err != nil {
+				log.Warn("natsmq consumer failed to unsubscribe", zap.String("topic", nc.topic), zap.Error(err))
+			}
+			// Unsubscribe only deletes the JetStream consumer created by the subscription, not a reused one
+			err := nc.js.DeleteConsumer(nc.topic, nc.name)
+			if err != nil && !errors.Is(err, nats.ErrConsumerNotFound) {
+				log.Warn("natsmq consumer failed to delete the consumer", zap.String("topic", nc.topic), zap.Error(err))
+			}
+		}
+		close(nc.msgChannel)
+	})
+}
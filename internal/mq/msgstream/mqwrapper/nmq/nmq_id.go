@@ -0,0 +1,62 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nmq
+
+import (
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+)
+
+// nmqID wraps the stream sequence of a JetStream message, the sequence of the first message is 1
+type nmqID struct {
+	messageID uint64
+}
+
+// Check if nmqID implements MessageID interface
+var _ mqwrapper.MessageID = &nmqID{}
+
+// Serialize converts the sequence to a byte slice
+func (nid *nmqID) Serialize() []byte {
+	return SerializeNmqID(nid.messageID)
+}
+
+// AtEarliestPosition returns true if the id is not after the first message
+func (nid *nmqID) AtEarliestPosition() bool {
+	return nid.messageID <= 1
+}
+
+// LessOrEqualThan compares with another serialized id
+func (nid *nmqID) LessOrEqualThan(msgID []byte) (bool, error) {
+	return nid.messageID <= DeserializeNmqID(msgID), nil
+}
+
+// Equal checks whether the id equals to another serialized id
+func (nid *nmqID) Equal(msgID []byte) (bool, error) {
+	return nid.messageID == DeserializeNmqID(msgID), nil
+}
+
+// SerializeNmqID converts a stream sequence to a byte slice
+func SerializeNmqID(messageID uint64) []byte {
+	b := make([]byte, 8)
+	common.Endian.PutUint64(b, messageID)
+	return b
+}
+
+// DeserializeNmqID converts a byte slice to a stream sequence
+func DeserializeNmqID(messageID []byte) uint64 {
+	return common.Endian.Uint64(messageID)
+}
@@ -0,0 +1,59 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nmq
+
+import (
+	"github.com/nats-io/nats.go"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+)
+
+// Check nmqMessage implements Message
+var _ mqwrapper.Message = (*nmqMessage)(nil)
+
+// nmqMessage wraps the message for NATS JetStream
+type nmqMessage struct {
+	msg *nats.Msg
+	id  uint64 // stream sequence of the message
+}
+
+// Topic returns the topic name of the message, which is the subject of the JetStream message
+func (nm *nmqMessage) Topic() string {
+	return nm.msg.Subject
+}
+
+// Properties returns the properties of the message, which are stored in the headers
+func (nm *nmqMessage) Properties() map[string]string {
+	if len(nm.msg.Header) == 0 {
+		return nil
+	}
+	properties := make(map[string]string, len(nm.msg.Header))
+	for key := range nm.msg.Header {
+		properties[key] = nm.msg.Header.Get(key)
+	}
+	return properties
+}
+
+// Payload returns the payload of the message
+func (nm *nmqMessage) Payload() []byte {
+	return nm.msg.Data
+}
+
+// ID returns the id of the message
+func (nm *nmqMessage) ID() mqwrapper.MessageID {
+	return &nmqID{messageID: nm.id}
+}
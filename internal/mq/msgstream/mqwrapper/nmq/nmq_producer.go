@@ -0,0 +1,58 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nmq
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+)
+
+// Check nmqProducer implements Producer
+var _ mqwrapper.Producer = (*nmqProducer)(nil)
+
+// nmqProducer publishes messages to the subject of a topic, the stream of the topic persists the messages
+type nmqProducer struct {
+	js    nats.JetStreamContext
+	topic string
+}
+
+// Topic returns the topic of the producer
+func (np *nmqProducer) Topic() string {
+	return np.topic
+}
+
+// Send publishes a message and waits for the acknowledgement of the stream
+func (np *nmqProducer) Send(ctx context.Context, message *mqwrapper.ProducerMessage) (mqwrapper.MessageID, error) {
+	msg := nats.NewMsg(np.topic)
+	msg.Data = message.Payload
+	for key, value := range message.Properties {
+		msg.Header.Set(key, value)
+	}
+
+	ack, err := np.js.PublishMsg(msg, nats.Context(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &nmqID{messageID: ack.Sequence}, nil
+}
+
+// Close does nothing, the connection is owned by the client
+func (np *nmqProducer) Close() {
+}
@@ -0,0 +1,37 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqwrapper
+
+import "context"
+
+// Transaction makes the messages sent by the producers of a client during it visible
+// to read committed consumers all at once, or never if it's aborted
+type Transaction interface {
+	// Commit makes the messages sent in the transaction visible
+	Commit(ctx context.Context) error
+
+	// Abort discards the messages sent in the transaction
+	Abort(ctx context.Context) error
+}
+
+// TransactionalClient is implemented by the clients which can publish to several topics atomically
+type TransactionalClient interface {
+	// BeginTransaction starts a transaction covering the messages sent by the producers of the client until it ends.
+	// It returns nil if transactions are disabled, or no producer is created yet.
+	// Transactions of a client must not overlap.
+	BeginTransaction(ctx context.Context) (Transaction, error)
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 )
 
 // MsgType is an alias of commonpb.MsgType
@@ -204,6 +205,12 @@ func (it *InsertMsg) CheckAligned() error {
 				return numRowsOfFieldDataMismatch(field.FieldName, fieldNumRows, rowNums)
 			}
 		}
+		for _, validData := range it.GetValidData() {
+			if uint64(len(validData.GetValidData())) != rowNums {
+				return fmt.Errorf("the num_rows(%d) of valid data of field %d is not equal to passed NumRows(%d)",
+					len(validData.GetValidData()), validData.GetFieldId(), rowNums)
+			}
+		}
 	}
 
 	if len(it.GetRowIDs()) != len(it.GetTimestamps()) {
@@ -243,6 +250,11 @@ func (it *InsertMsg) columnBasedIndexRequest(index int) internalpb.InsertRequest
 	colNum := len(it.GetFieldsData())
 	fieldsData := make([]*schemapb.FieldData, colNum)
 	typeutil.AppendFieldData(fieldsData, it.GetFieldsData(), int64(index))
+	var validData []*segcorepb.FieldValidData
+	if len(it.GetValidData()) > 0 {
+		validData = make([]*segcorepb.FieldValidData, len(it.GetValidData()))
+		typeutil.AppendValidData(validData, it.GetValidData(), int64(index))
+	}
 	return internalpb.InsertRequest{
 		Base: commonpbutil.NewMsgBase(
 			commonpbutil.WithMsgType(commonpb.MsgType_Insert),
@@ -260,6 +272,7 @@ func (it *InsertMsg) columnBasedIndexRequest(index int) internalpb.InsertRequest
 		Timestamps:     []uint64{it.Timestamps[index]},
 		RowIDs:         []int64{it.RowIDs[index]},
 		FieldsData:     fieldsData,
+		ValidData:      validData,
 		NumRows:        1,
 		Version:        internalpb.InsertDataVersion_ColumnBased,
 	}
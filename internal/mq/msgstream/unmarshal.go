@@ -18,6 +18,9 @@ package msgstream
 
 import (
 	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 )
@@ -28,6 +31,9 @@ type UnmarshalFunc func(interface{}) (TsMsg, error)
 // UnmarshalDispatcher is an interface contains method Unmarshal
 type UnmarshalDispatcher interface {
 	Unmarshal(input interface{}, msgType commonpb.MsgType) (TsMsg, error)
+	// UnmarshalPayload unmarshals the payload of a consumed message by the msg type in its header,
+	// the payload is decompressed first if the properties say it's compressed.
+	UnmarshalPayload(payload []byte, properties map[string]string) (TsMsg, error)
 }
 
 // UnmarshalDispatcherFactory is a factory to generate an object which implement interface UnmarshalDispatcher
@@ -49,6 +55,29 @@ func (p *ProtoUnmarshalDispatcher) Unmarshal(input interface{}, msgType commonpb
 	return unmarshalFunc(input)
 }
 
+// UnmarshalPayload decompresses the payload if needed and forwards it to the unmarshal function of its msg type
+func (p *ProtoUnmarshalDispatcher) UnmarshalPayload(payload []byte, properties map[string]string) (TsMsg, error) {
+	if payload == nil {
+		return nil, fmt.Errorf("failed to unmarshal message header, payload is empty")
+	}
+	payload, err := DecompressPayload(payload, properties)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress message payload, err %s", err.Error())
+	}
+	header := commonpb.MsgHeader{}
+	if err := proto.Unmarshal(payload, &header); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal message header, err %s", err.Error())
+	}
+	if header.Base == nil {
+		return nil, fmt.Errorf("failed to unmarshal message, header is uncomplete")
+	}
+	tsMsg, err := p.Unmarshal(payload, header.Base.MsgType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tsMsg, err %s", err.Error())
+	}
+	return tsMsg, nil
+}
+
 // ProtoUDFactory is a factory to generate ProtoUnmarshalDispatcher object
 type ProtoUDFactory struct{}
 
@@ -7,7 +7,10 @@ expr:
 	| StringLiteral											                # String
 	| Identifier											                # Identifier
 	| '(' expr ')'											                # Parens
-	| expr LIKE StringLiteral                                               # Like
+	| TEXTMATCH '(' Identifier ',' StringLiteral ')'                        # TextMatch
+	| REGEXMATCH '(' Identifier ',' StringLiteral ')'                       # RegexMatch
+	| expr op = (ISNULL | ISNOTNULL)                                        # IsNull
+	| expr op = (LIKE | ILIKE) StringLiteral                                # Like
 	| expr POW expr											                # Power
 	| op = (ADD | SUB | BNOT | NOT) expr					                # Unary
 //	| '(' typeName ')' expr									                # Cast
@@ -44,6 +47,9 @@ EQ: '==';
 NE: '!=';
 
 LIKE: 'like' | 'LIKE';
+ILIKE: 'ilike' | 'ILIKE';
+TEXTMATCH: 'text_match' | 'TEXT_MATCH';
+REGEXMATCH: 'regex_match' | 'REGEX_MATCH';
 
 ADD: '+';
 SUB: '-';
@@ -65,6 +71,8 @@ NOT: '!' | 'not';
 
 IN: 'in';
 NIN: 'not in';
+ISNULL: 'is null' | 'IS NULL';
+ISNOTNULL: 'is not null' | 'IS NOT NULL';
 EmptyTerm: '[' (Whitespace | Newline)* ']';
 
 BooleanConstant: 'true' | 'True' | 'TRUE' | 'false' | 'False' | 'FALSE';
@@ -14,211 +14,258 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 39, 444,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 44, 552,
 	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
 	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
-	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
-	18, 9, 18, 4, 19, 9, 19, 4, 20, 9, 20, 4, 21, 9, 21, 4, 22, 9, 22, 4, 23,
-	9, 23, 4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 4, 27, 9, 27, 4, 28, 9,
-	28, 4, 29, 9, 29, 4, 30, 9, 30, 4, 31, 9, 31, 4, 32, 9, 32, 4, 33, 9, 33,
-	4, 34, 9, 34, 4, 35, 9, 35, 4, 36, 9, 36, 4, 37, 9, 37, 4, 38, 9, 38, 4,
-	39, 9, 39, 4, 40, 9, 40, 4, 41, 9, 41, 4, 42, 9, 42, 4, 43, 9, 43, 4, 44,
-	9, 44, 4, 45, 9, 45, 4, 46, 9, 46, 4, 47, 9, 47, 4, 48, 9, 48, 4, 49, 9,
-	49, 4, 50, 9, 50, 4, 51, 9, 51, 4, 52, 9, 52, 4, 53, 9, 53, 4, 54, 9, 54,
-	4, 55, 9, 55, 4, 56, 9, 56, 4, 57, 9, 57, 4, 58, 9, 58, 4, 59, 9, 59, 4,
-	60, 9, 60, 4, 61, 9, 61, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3, 5, 3, 5,
+	4, 13, 9, 13, 4, 17, 9, 17, 4, 18, 9, 18, 4, 19, 9, 19, 4, 20, 9, 20, 4,
+	21, 9, 21, 4, 22, 9, 22, 4, 23, 9, 23, 4, 24, 9, 24, 4, 25, 9, 25, 4, 26,
+	9, 26, 4, 27, 9, 27, 4, 28, 9, 28, 4, 29, 9, 29, 4, 30, 9, 30, 4, 31, 9,
+	31, 4, 32, 9, 32, 4, 33, 9, 33, 4, 36, 9, 36, 4, 37, 9, 37, 4, 38, 9, 38,
+	4, 39, 9, 39, 4, 40, 9, 40, 4, 41, 9, 41, 4, 42, 9, 42, 4, 43, 9, 43, 4,
+	44, 9, 44, 4, 45, 9, 45, 4, 46, 9, 46, 4, 47, 9, 47, 4, 48, 9, 48, 4, 49,
+	9, 49, 4, 50, 9, 50, 4, 51, 9, 51, 4, 52, 9, 52, 4, 53, 9, 53, 4, 54, 9,
+	54, 4, 55, 9, 55, 4, 56, 9, 56, 4, 57, 9, 57, 4, 58, 9, 58, 4, 59, 9, 59,
+	4, 60, 9, 60, 4, 61, 9, 61, 4, 62, 9, 62, 4, 63, 9, 63, 4, 64, 9, 64, 4,
+	65, 9, 65, 4, 66, 9, 66, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3, 5, 3, 5,
 	3, 6, 3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3, 10, 3,
 	10, 3, 11, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 13,
-	3, 13, 3, 13, 3, 13, 3, 13, 5, 13, 158, 10, 13, 3, 14, 3, 14, 3, 15, 3,
-	15, 3, 16, 3, 16, 3, 17, 3, 17, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3, 20,
-	3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3, 23, 3, 23, 3, 24, 3,
-	24, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 5, 25, 190, 10, 25, 3, 26, 3, 26,
-	3, 26, 3, 26, 5, 26, 196, 10, 26, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28, 3,
-	28, 5, 28, 204, 10, 28, 3, 29, 3, 29, 3, 29, 3, 30, 3, 30, 3, 30, 3, 30,
-	3, 30, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 7, 31, 219, 10, 31, 12, 31, 14,
-	31, 222, 11, 31, 3, 31, 3, 31, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32,
-	3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3,
-	32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32,
-	5, 32, 253, 10, 32, 3, 33, 3, 33, 3, 33, 3, 33, 5, 33, 259, 10, 33, 3,
-	34, 3, 34, 5, 34, 263, 10, 34, 3, 35, 3, 35, 3, 35, 7, 35, 268, 10, 35,
-	12, 35, 14, 35, 271, 11, 35, 3, 36, 5, 36, 274, 10, 36, 3, 36, 3, 36, 5,
-	36, 278, 10, 36, 3, 36, 3, 36, 3, 37, 3, 37, 3, 37, 5, 37, 285, 10, 37,
-	3, 38, 6, 38, 288, 10, 38, 13, 38, 14, 38, 289, 3, 39, 3, 39, 3, 39, 3,
-	39, 3, 39, 3, 39, 3, 39, 5, 39, 299, 10, 39, 3, 40, 3, 40, 3, 41, 3, 41,
-	3, 42, 3, 42, 3, 42, 6, 42, 308, 10, 42, 13, 42, 14, 42, 309, 3, 43, 3,
-	43, 7, 43, 314, 10, 43, 12, 43, 14, 43, 317, 11, 43, 3, 44, 3, 44, 7, 44,
-	321, 10, 44, 12, 44, 14, 44, 324, 11, 44, 3, 45, 3, 45, 3, 45, 3, 45, 3,
-	46, 3, 46, 3, 47, 3, 47, 3, 48, 3, 48, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49,
-	3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 5,
-	50, 351, 10, 50, 3, 51, 3, 51, 5, 51, 355, 10, 51, 3, 51, 3, 51, 3, 51,
-	5, 51, 360, 10, 51, 3, 52, 3, 52, 3, 52, 3, 52, 5, 52, 366, 10, 52, 3,
-	52, 3, 52, 3, 53, 5, 53, 371, 10, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53,
-	5, 53, 378, 10, 53, 3, 54, 3, 54, 5, 54, 382, 10, 54, 3, 54, 3, 54, 3,
-	55, 6, 55, 387, 10, 55, 13, 55, 14, 55, 388, 3, 56, 5, 56, 392, 10, 56,
-	3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 5, 56, 399, 10, 56, 3, 57, 6, 57, 402,
-	10, 57, 13, 57, 14, 57, 403, 3, 58, 3, 58, 5, 58, 408, 10, 58, 3, 58, 3,
-	58, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 5, 59, 417, 10, 59, 3, 59, 5, 59,
-	420, 10, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 5, 59, 427, 10, 59, 3,
-	60, 6, 60, 430, 10, 60, 13, 60, 14, 60, 431, 3, 60, 3, 60, 3, 61, 3, 61,
-	5, 61, 438, 10, 61, 3, 61, 5, 61, 441, 10, 61, 3, 61, 3, 61, 2, 2, 62,
-	3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23,
-	13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19, 37, 20, 39, 21, 41,
-	22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28, 55, 29, 57, 30, 59,
-	31, 61, 32, 63, 33, 65, 34, 67, 35, 69, 36, 71, 37, 73, 2, 75, 2, 77, 2,
-	79, 2, 81, 2, 83, 2, 85, 2, 87, 2, 89, 2, 91, 2, 93, 2, 95, 2, 97, 2, 99,
-	2, 101, 2, 103, 2, 105, 2, 107, 2, 109, 2, 111, 2, 113, 2, 115, 2, 117,
-	2, 119, 38, 121, 39, 3, 2, 17, 5, 2, 78, 78, 87, 87, 119, 119, 6, 2, 12,
-	12, 15, 15, 36, 36, 94, 94, 5, 2, 67, 92, 97, 97, 99, 124, 3, 2, 50, 59,
-	4, 2, 68, 68, 100, 100, 3, 2, 50, 51, 4, 2, 90, 90, 122, 122, 3, 2, 51,
-	59, 3, 2, 50, 57, 5, 2, 50, 59, 67, 72, 99, 104, 4, 2, 71, 71, 103, 103,
-	4, 2, 45, 45, 47, 47, 4, 2, 82, 82, 114, 114, 12, 2, 36, 36, 41, 41, 65,
-	65, 94, 94, 99, 100, 104, 104, 112, 112, 116, 116, 118, 118, 120, 120,
-	4, 2, 11, 11, 34, 34, 2, 467, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7,
-	3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2,
-	15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2,
-	2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2,
-	2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2,
-	2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3,
-	2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53,
-	3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2,
-	61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2, 2,
-	2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 119, 3, 2, 2, 2, 2, 121, 3, 2,
-	2, 2, 3, 123, 3, 2, 2, 2, 5, 125, 3, 2, 2, 2, 7, 127, 3, 2, 2, 2, 9, 129,
-	3, 2, 2, 2, 11, 131, 3, 2, 2, 2, 13, 133, 3, 2, 2, 2, 15, 135, 3, 2, 2,
-	2, 17, 138, 3, 2, 2, 2, 19, 140, 3, 2, 2, 2, 21, 143, 3, 2, 2, 2, 23, 146,
-	3, 2, 2, 2, 25, 157, 3, 2, 2, 2, 27, 159, 3, 2, 2, 2, 29, 161, 3, 2, 2,
-	2, 31, 163, 3, 2, 2, 2, 33, 165, 3, 2, 2, 2, 35, 167, 3, 2, 2, 2, 37, 169,
-	3, 2, 2, 2, 39, 172, 3, 2, 2, 2, 41, 175, 3, 2, 2, 2, 43, 178, 3, 2, 2,
-	2, 45, 180, 3, 2, 2, 2, 47, 182, 3, 2, 2, 2, 49, 189, 3, 2, 2, 2, 51, 195,
-	3, 2, 2, 2, 53, 197, 3, 2, 2, 2, 55, 203, 3, 2, 2, 2, 57, 205, 3, 2, 2,
-	2, 59, 208, 3, 2, 2, 2, 61, 215, 3, 2, 2, 2, 63, 252, 3, 2, 2, 2, 65, 258,
-	3, 2, 2, 2, 67, 262, 3, 2, 2, 2, 69, 264, 3, 2, 2, 2, 71, 273, 3, 2, 2,
-	2, 73, 284, 3, 2, 2, 2, 75, 287, 3, 2, 2, 2, 77, 298, 3, 2, 2, 2, 79, 300,
-	3, 2, 2, 2, 81, 302, 3, 2, 2, 2, 83, 304, 3, 2, 2, 2, 85, 311, 3, 2, 2,
-	2, 87, 318, 3, 2, 2, 2, 89, 325, 3, 2, 2, 2, 91, 329, 3, 2, 2, 2, 93, 331,
-	3, 2, 2, 2, 95, 333, 3, 2, 2, 2, 97, 335, 3, 2, 2, 2, 99, 350, 3, 2, 2,
-	2, 101, 359, 3, 2, 2, 2, 103, 361, 3, 2, 2, 2, 105, 377, 3, 2, 2, 2, 107,
-	379, 3, 2, 2, 2, 109, 386, 3, 2, 2, 2, 111, 398, 3, 2, 2, 2, 113, 401,
-	3, 2, 2, 2, 115, 405, 3, 2, 2, 2, 117, 426, 3, 2, 2, 2, 119, 429, 3, 2,
-	2, 2, 121, 440, 3, 2, 2, 2, 123, 124, 7, 42, 2, 2, 124, 4, 3, 2, 2, 2,
-	125, 126, 7, 43, 2, 2, 126, 6, 3, 2, 2, 2, 127, 128, 7, 93, 2, 2, 128,
-	8, 3, 2, 2, 2, 129, 130, 7, 46, 2, 2, 130, 10, 3, 2, 2, 2, 131, 132, 7,
-	95, 2, 2, 132, 12, 3, 2, 2, 2, 133, 134, 7, 62, 2, 2, 134, 14, 3, 2, 2,
-	2, 135, 136, 7, 62, 2, 2, 136, 137, 7, 63, 2, 2, 137, 16, 3, 2, 2, 2, 138,
-	139, 7, 64, 2, 2, 139, 18, 3, 2, 2, 2, 140, 141, 7, 64, 2, 2, 141, 142,
-	7, 63, 2, 2, 142, 20, 3, 2, 2, 2, 143, 144, 7, 63, 2, 2, 144, 145, 7, 63,
-	2, 2, 145, 22, 3, 2, 2, 2, 146, 147, 7, 35, 2, 2, 147, 148, 7, 63, 2, 2,
-	148, 24, 3, 2, 2, 2, 149, 150, 7, 110, 2, 2, 150, 151, 7, 107, 2, 2, 151,
-	152, 7, 109, 2, 2, 152, 158, 7, 103, 2, 2, 153, 154, 7, 78, 2, 2, 154,
-	155, 7, 75, 2, 2, 155, 156, 7, 77, 2, 2, 156, 158, 7, 71, 2, 2, 157, 149,
-	3, 2, 2, 2, 157, 153, 3, 2, 2, 2, 158, 26, 3, 2, 2, 2, 159, 160, 7, 45,
-	2, 2, 160, 28, 3, 2, 2, 2, 161, 162, 7, 47, 2, 2, 162, 30, 3, 2, 2, 2,
-	163, 164, 7, 44, 2, 2, 164, 32, 3, 2, 2, 2, 165, 166, 7, 49, 2, 2, 166,
-	34, 3, 2, 2, 2, 167, 168, 7, 39, 2, 2, 168, 36, 3, 2, 2, 2, 169, 170, 7,
-	44, 2, 2, 170, 171, 7, 44, 2, 2, 171, 38, 3, 2, 2, 2, 172, 173, 7, 62,
-	2, 2, 173, 174, 7, 62, 2, 2, 174, 40, 3, 2, 2, 2, 175, 176, 7, 64, 2, 2,
-	176, 177, 7, 64, 2, 2, 177, 42, 3, 2, 2, 2, 178, 179, 7, 40, 2, 2, 179,
-	44, 3, 2, 2, 2, 180, 181, 7, 126, 2, 2, 181, 46, 3, 2, 2, 2, 182, 183,
-	7, 96, 2, 2, 183, 48, 3, 2, 2, 2, 184, 185, 7, 40, 2, 2, 185, 190, 7, 40,
-	2, 2, 186, 187, 7, 99, 2, 2, 187, 188, 7, 112, 2, 2, 188, 190, 7, 102,
-	2, 2, 189, 184, 3, 2, 2, 2, 189, 186, 3, 2, 2, 2, 190, 50, 3, 2, 2, 2,
-	191, 192, 7, 126, 2, 2, 192, 196, 7, 126, 2, 2, 193, 194, 7, 113, 2, 2,
-	194, 196, 7, 116, 2, 2, 195, 191, 3, 2, 2, 2, 195, 193, 3, 2, 2, 2, 196,
-	52, 3, 2, 2, 2, 197, 198, 7, 128, 2, 2, 198, 54, 3, 2, 2, 2, 199, 204,
-	7, 35, 2, 2, 200, 201, 7, 112, 2, 2, 201, 202, 7, 113, 2, 2, 202, 204,
-	7, 118, 2, 2, 203, 199, 3, 2, 2, 2, 203, 200, 3, 2, 2, 2, 204, 56, 3, 2,
-	2, 2, 205, 206, 7, 107, 2, 2, 206, 207, 7, 112, 2, 2, 207, 58, 3, 2, 2,
-	2, 208, 209, 7, 112, 2, 2, 209, 210, 7, 113, 2, 2, 210, 211, 7, 118, 2,
-	2, 211, 212, 7, 34, 2, 2, 212, 213, 7, 107, 2, 2, 213, 214, 7, 112, 2,
-	2, 214, 60, 3, 2, 2, 2, 215, 220, 7, 93, 2, 2, 216, 219, 5, 119, 60, 2,
-	217, 219, 5, 121, 61, 2, 218, 216, 3, 2, 2, 2, 218, 217, 3, 2, 2, 2, 219,
-	222, 3, 2, 2, 2, 220, 218, 3, 2, 2, 2, 220, 221, 3, 2, 2, 2, 221, 223,
-	3, 2, 2, 2, 222, 220, 3, 2, 2, 2, 223, 224, 7, 95, 2, 2, 224, 62, 3, 2,
-	2, 2, 225, 226, 7, 118, 2, 2, 226, 227, 7, 116, 2, 2, 227, 228, 7, 119,
-	2, 2, 228, 253, 7, 103, 2, 2, 229, 230, 7, 86, 2, 2, 230, 231, 7, 116,
-	2, 2, 231, 232, 7, 119, 2, 2, 232, 253, 7, 103, 2, 2, 233, 234, 7, 86,
-	2, 2, 234, 235, 7, 84, 2, 2, 235, 236, 7, 87, 2, 2, 236, 253, 7, 71, 2,
-	2, 237, 238, 7, 104, 2, 2, 238, 239, 7, 99, 2, 2, 239, 240, 7, 110, 2,
-	2, 240, 241, 7, 117, 2, 2, 241, 253, 7, 103, 2, 2, 242, 243, 7, 72, 2,
-	2, 243, 244, 7, 99, 2, 2, 244, 245, 7, 110, 2, 2, 245, 246, 7, 117, 2,
-	2, 246, 253, 7, 103, 2, 2, 247, 248, 7, 72, 2, 2, 248, 249, 7, 67, 2, 2,
-	249, 250, 7, 78, 2, 2, 250, 251, 7, 85, 2, 2, 251, 253, 7, 71, 2, 2, 252,
-	225, 3, 2, 2, 2, 252, 229, 3, 2, 2, 2, 252, 233, 3, 2, 2, 2, 252, 237,
-	3, 2, 2, 2, 252, 242, 3, 2, 2, 2, 252, 247, 3, 2, 2, 2, 253, 64, 3, 2,
-	2, 2, 254, 259, 5, 85, 43, 2, 255, 259, 5, 87, 44, 2, 256, 259, 5, 89,
-	45, 2, 257, 259, 5, 83, 42, 2, 258, 254, 3, 2, 2, 2, 258, 255, 3, 2, 2,
-	2, 258, 256, 3, 2, 2, 2, 258, 257, 3, 2, 2, 2, 259, 66, 3, 2, 2, 2, 260,
-	263, 5, 101, 51, 2, 261, 263, 5, 103, 52, 2, 262, 260, 3, 2, 2, 2, 262,
-	261, 3, 2, 2, 2, 263, 68, 3, 2, 2, 2, 264, 269, 5, 79, 40, 2, 265, 268,
-	5, 79, 40, 2, 266, 268, 5, 81, 41, 2, 267, 265, 3, 2, 2, 2, 267, 266, 3,
-	2, 2, 2, 268, 271, 3, 2, 2, 2, 269, 267, 3, 2, 2, 2, 269, 270, 3, 2, 2,
-	2, 270, 70, 3, 2, 2, 2, 271, 269, 3, 2, 2, 2, 272, 274, 5, 73, 37, 2, 273,
-	272, 3, 2, 2, 2, 273, 274, 3, 2, 2, 2, 274, 275, 3, 2, 2, 2, 275, 277,
-	7, 36, 2, 2, 276, 278, 5, 75, 38, 2, 277, 276, 3, 2, 2, 2, 277, 278, 3,
-	2, 2, 2, 278, 279, 3, 2, 2, 2, 279, 280, 7, 36, 2, 2, 280, 72, 3, 2, 2,
-	2, 281, 282, 7, 119, 2, 2, 282, 285, 7, 58, 2, 2, 283, 285, 9, 2, 2, 2,
-	284, 281, 3, 2, 2, 2, 284, 283, 3, 2, 2, 2, 285, 74, 3, 2, 2, 2, 286, 288,
-	5, 77, 39, 2, 287, 286, 3, 2, 2, 2, 288, 289, 3, 2, 2, 2, 289, 287, 3,
-	2, 2, 2, 289, 290, 3, 2, 2, 2, 290, 76, 3, 2, 2, 2, 291, 299, 10, 3, 2,
-	2, 292, 299, 5, 117, 59, 2, 293, 294, 7, 94, 2, 2, 294, 299, 7, 12, 2,
-	2, 295, 296, 7, 94, 2, 2, 296, 297, 7, 15, 2, 2, 297, 299, 7, 12, 2, 2,
-	298, 291, 3, 2, 2, 2, 298, 292, 3, 2, 2, 2, 298, 293, 3, 2, 2, 2, 298,
-	295, 3, 2, 2, 2, 299, 78, 3, 2, 2, 2, 300, 301, 9, 4, 2, 2, 301, 80, 3,
-	2, 2, 2, 302, 303, 9, 5, 2, 2, 303, 82, 3, 2, 2, 2, 304, 305, 7, 50, 2,
-	2, 305, 307, 9, 6, 2, 2, 306, 308, 9, 7, 2, 2, 307, 306, 3, 2, 2, 2, 308,
-	309, 3, 2, 2, 2, 309, 307, 3, 2, 2, 2, 309, 310, 3, 2, 2, 2, 310, 84, 3,
-	2, 2, 2, 311, 315, 5, 91, 46, 2, 312, 314, 5, 81, 41, 2, 313, 312, 3, 2,
-	2, 2, 314, 317, 3, 2, 2, 2, 315, 313, 3, 2, 2, 2, 315, 316, 3, 2, 2, 2,
-	316, 86, 3, 2, 2, 2, 317, 315, 3, 2, 2, 2, 318, 322, 7, 50, 2, 2, 319,
-	321, 5, 93, 47, 2, 320, 319, 3, 2, 2, 2, 321, 324, 3, 2, 2, 2, 322, 320,
-	3, 2, 2, 2, 322, 323, 3, 2, 2, 2, 323, 88, 3, 2, 2, 2, 324, 322, 3, 2,
-	2, 2, 325, 326, 7, 50, 2, 2, 326, 327, 9, 8, 2, 2, 327, 328, 5, 113, 57,
-	2, 328, 90, 3, 2, 2, 2, 329, 330, 9, 9, 2, 2, 330, 92, 3, 2, 2, 2, 331,
-	332, 9, 10, 2, 2, 332, 94, 3, 2, 2, 2, 333, 334, 9, 11, 2, 2, 334, 96,
-	3, 2, 2, 2, 335, 336, 5, 95, 48, 2, 336, 337, 5, 95, 48, 2, 337, 338, 5,
-	95, 48, 2, 338, 339, 5, 95, 48, 2, 339, 98, 3, 2, 2, 2, 340, 341, 7, 94,
-	2, 2, 341, 342, 7, 119, 2, 2, 342, 343, 3, 2, 2, 2, 343, 351, 5, 97, 49,
-	2, 344, 345, 7, 94, 2, 2, 345, 346, 7, 87, 2, 2, 346, 347, 3, 2, 2, 2,
-	347, 348, 5, 97, 49, 2, 348, 349, 5, 97, 49, 2, 349, 351, 3, 2, 2, 2, 350,
-	340, 3, 2, 2, 2, 350, 344, 3, 2, 2, 2, 351, 100, 3, 2, 2, 2, 352, 354,
-	5, 105, 53, 2, 353, 355, 5, 107, 54, 2, 354, 353, 3, 2, 2, 2, 354, 355,
-	3, 2, 2, 2, 355, 360, 3, 2, 2, 2, 356, 357, 5, 109, 55, 2, 357, 358, 5,
-	107, 54, 2, 358, 360, 3, 2, 2, 2, 359, 352, 3, 2, 2, 2, 359, 356, 3, 2,
-	2, 2, 360, 102, 3, 2, 2, 2, 361, 362, 7, 50, 2, 2, 362, 365, 9, 8, 2, 2,
-	363, 366, 5, 111, 56, 2, 364, 366, 5, 113, 57, 2, 365, 363, 3, 2, 2, 2,
-	365, 364, 3, 2, 2, 2, 366, 367, 3, 2, 2, 2, 367, 368, 5, 115, 58, 2, 368,
-	104, 3, 2, 2, 2, 369, 371, 5, 109, 55, 2, 370, 369, 3, 2, 2, 2, 370, 371,
-	3, 2, 2, 2, 371, 372, 3, 2, 2, 2, 372, 373, 7, 48, 2, 2, 373, 378, 5, 109,
-	55, 2, 374, 375, 5, 109, 55, 2, 375, 376, 7, 48, 2, 2, 376, 378, 3, 2,
-	2, 2, 377, 370, 3, 2, 2, 2, 377, 374, 3, 2, 2, 2, 378, 106, 3, 2, 2, 2,
-	379, 381, 9, 12, 2, 2, 380, 382, 9, 13, 2, 2, 381, 380, 3, 2, 2, 2, 381,
-	382, 3, 2, 2, 2, 382, 383, 3, 2, 2, 2, 383, 384, 5, 109, 55, 2, 384, 108,
-	3, 2, 2, 2, 385, 387, 5, 81, 41, 2, 386, 385, 3, 2, 2, 2, 387, 388, 3,
-	2, 2, 2, 388, 386, 3, 2, 2, 2, 388, 389, 3, 2, 2, 2, 389, 110, 3, 2, 2,
-	2, 390, 392, 5, 113, 57, 2, 391, 390, 3, 2, 2, 2, 391, 392, 3, 2, 2, 2,
-	392, 393, 3, 2, 2, 2, 393, 394, 7, 48, 2, 2, 394, 399, 5, 113, 57, 2, 395,
-	396, 5, 113, 57, 2, 396, 397, 7, 48, 2, 2, 397, 399, 3, 2, 2, 2, 398, 391,
-	3, 2, 2, 2, 398, 395, 3, 2, 2, 2, 399, 112, 3, 2, 2, 2, 400, 402, 5, 95,
-	48, 2, 401, 400, 3, 2, 2, 2, 402, 403, 3, 2, 2, 2, 403, 401, 3, 2, 2, 2,
-	403, 404, 3, 2, 2, 2, 404, 114, 3, 2, 2, 2, 405, 407, 9, 14, 2, 2, 406,
-	408, 9, 13, 2, 2, 407, 406, 3, 2, 2, 2, 407, 408, 3, 2, 2, 2, 408, 409,
-	3, 2, 2, 2, 409, 410, 5, 109, 55, 2, 410, 116, 3, 2, 2, 2, 411, 412, 7,
-	94, 2, 2, 412, 427, 9, 15, 2, 2, 413, 414, 7, 94, 2, 2, 414, 416, 5, 93,
-	47, 2, 415, 417, 5, 93, 47, 2, 416, 415, 3, 2, 2, 2, 416, 417, 3, 2, 2,
-	2, 417, 419, 3, 2, 2, 2, 418, 420, 5, 93, 47, 2, 419, 418, 3, 2, 2, 2,
-	419, 420, 3, 2, 2, 2, 420, 427, 3, 2, 2, 2, 421, 422, 7, 94, 2, 2, 422,
-	423, 7, 122, 2, 2, 423, 424, 3, 2, 2, 2, 424, 427, 5, 113, 57, 2, 425,
-	427, 5, 99, 50, 2, 426, 411, 3, 2, 2, 2, 426, 413, 3, 2, 2, 2, 426, 421,
-	3, 2, 2, 2, 426, 425, 3, 2, 2, 2, 427, 118, 3, 2, 2, 2, 428, 430, 9, 16,
-	2, 2, 429, 428, 3, 2, 2, 2, 430, 431, 3, 2, 2, 2, 431, 429, 3, 2, 2, 2,
-	431, 432, 3, 2, 2, 2, 432, 433, 3, 2, 2, 2, 433, 434, 8, 60, 2, 2, 434,
-	120, 3, 2, 2, 2, 435, 437, 7, 15, 2, 2, 436, 438, 7, 12, 2, 2, 437, 436,
-	3, 2, 2, 2, 437, 438, 3, 2, 2, 2, 438, 441, 3, 2, 2, 2, 439, 441, 7, 12,
-	2, 2, 440, 435, 3, 2, 2, 2, 440, 439, 3, 2, 2, 2, 441, 442, 3, 2, 2, 2,
-	442, 443, 8, 61, 2, 2, 443, 122, 3, 2, 2, 2, 40, 2, 157, 189, 195, 203,
-	218, 220, 252, 258, 262, 267, 269, 273, 277, 284, 289, 298, 309, 315, 322,
-	350, 354, 359, 365, 370, 377, 381, 388, 391, 398, 403, 407, 416, 419, 426,
-	431, 437, 440, 3, 8, 2, 2,
+	3, 13, 3, 13, 3, 13, 3, 13, 5, 13, 158, 10, 13, 3, 17, 3, 17, 3, 18, 3,
+	18, 3, 19, 3, 19, 3, 20, 3, 20, 3, 21, 3, 21, 3, 22, 3, 22, 3, 22, 3, 23,
+	3, 23, 3, 23, 3, 24, 3, 24, 3, 24, 3, 25, 3, 25, 3, 26, 3, 26, 3, 27, 3,
+	27, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 5, 28, 190, 10, 28, 3, 29, 3, 29,
+	3, 29, 3, 29, 5, 29, 196, 10, 29, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 3,
+	31, 5, 31, 204, 10, 31, 3, 32, 3, 32, 3, 32, 3, 33, 3, 33, 3, 33, 3, 33,
+	3, 33, 3, 33, 3, 33, 3, 36, 3, 36, 3, 36, 7, 36, 219, 10, 36, 12, 36, 14,
+	36, 222, 11, 36, 3, 36, 3, 36, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37,
+	3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3,
+	37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37,
+	5, 37, 253, 10, 37, 3, 38, 3, 38, 3, 38, 3, 38, 5, 38, 259, 10, 38, 3,
+	39, 3, 39, 5, 39, 263, 10, 39, 3, 40, 3, 40, 3, 40, 7, 40, 268, 10, 40,
+	12, 40, 14, 40, 271, 11, 40, 3, 41, 5, 41, 274, 10, 41, 3, 41, 3, 41, 5,
+	41, 278, 10, 41, 3, 41, 3, 41, 3, 42, 3, 42, 3, 42, 5, 42, 285, 10, 42,
+	3, 43, 6, 43, 288, 10, 43, 13, 43, 14, 43, 289, 3, 44, 3, 44, 3, 44, 3,
+	44, 3, 44, 3, 44, 3, 44, 5, 44, 299, 10, 44, 3, 45, 3, 45, 3, 46, 3, 46,
+	3, 47, 3, 47, 3, 47, 6, 47, 308, 10, 47, 13, 47, 14, 47, 309, 3, 48, 3,
+	48, 7, 48, 314, 10, 48, 12, 48, 14, 48, 317, 11, 48, 3, 49, 3, 49, 7, 49,
+	321, 10, 49, 12, 49, 14, 49, 324, 11, 49, 3, 50, 3, 50, 3, 50, 3, 50, 3,
+	51, 3, 51, 3, 52, 3, 52, 3, 53, 3, 53, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54,
+	3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 5,
+	55, 351, 10, 55, 3, 56, 3, 56, 5, 56, 355, 10, 56, 3, 56, 3, 56, 3, 56,
+	5, 56, 360, 10, 56, 3, 57, 3, 57, 3, 57, 3, 57, 5, 57, 366, 10, 57, 3,
+	57, 3, 57, 3, 58, 5, 58, 371, 10, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58,
+	5, 58, 378, 10, 58, 3, 59, 3, 59, 5, 59, 382, 10, 59, 3, 59, 3, 59, 3,
+	60, 6, 60, 387, 10, 60, 13, 60, 14, 60, 388, 3, 61, 5, 61, 392, 10, 61,
+	3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 5, 61, 399, 10, 61, 3, 62, 6, 62, 402,
+	10, 62, 13, 62, 14, 62, 403, 3, 63, 3, 63, 5, 63, 408, 10, 63, 3, 63, 3,
+	63, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 5, 64, 417, 10, 64, 3, 64, 5, 64,
+	420, 10, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 5, 64, 427, 10, 64, 3,
+	65, 6, 65, 430, 10, 65, 13, 65, 14, 65, 431, 3, 65, 3, 65, 3, 66, 3, 66,
+	5, 66, 438, 10, 66, 3, 66, 5, 66, 441, 10, 66, 3, 66, 3, 66, 4, 14, 9,
+	14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14,
+	5, 14, 457, 10, 14, 4, 15, 9, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3,
+	15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15,
+	3, 15, 3, 15, 3, 15, 3, 15, 5, 15, 481, 10, 15, 4, 16, 9, 16, 3, 16, 3,
+	16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16,
+	3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 5,
+	16, 507, 10, 16, 4, 34, 9, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34,
+	3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 5, 34, 525, 10,
+	34, 4, 35, 9, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35,
+	3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3,
+	35, 3, 35, 3, 35, 3, 35, 5, 35, 551, 10, 35, 2, 2, 67, 3, 3, 5, 4, 7, 5,
+	9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23, 13, 25, 14, 444,
+	15, 458, 16, 482, 17, 27, 18, 29, 19, 31, 20, 33, 21, 35, 22, 37, 23, 39,
+	24, 41, 25, 43, 26, 45, 27, 47, 28, 49, 29, 51, 30, 53, 31, 55, 32, 57,
+	33, 59, 34, 508, 35, 526, 36, 61, 37, 63, 38, 65, 39, 67, 40, 69, 41, 71,
+	42, 73, 2, 75, 2, 77, 2, 79, 2, 81, 2, 83, 2, 85, 2, 87, 2, 89, 2, 91,
+	2, 93, 2, 95, 2, 97, 2, 99, 2, 101, 2, 103, 2, 105, 2, 107, 2, 109, 2,
+	111, 2, 113, 2, 115, 2, 117, 2, 119, 43, 121, 44, 3, 2, 17, 5, 2, 78, 78,
+	87, 87, 119, 119, 6, 2, 12, 12, 15, 15, 36, 36, 94, 94, 5, 2, 67, 92, 97,
+	97, 99, 124, 3, 2, 50, 59, 4, 2, 68, 68, 100, 100, 3, 2, 50, 51, 4, 2,
+	90, 90, 122, 122, 3, 2, 51, 59, 3, 2, 50, 57, 5, 2, 50, 59, 67, 72, 99,
+	104, 4, 2, 71, 71, 103, 103, 4, 2, 45, 45, 47, 47, 4, 2, 82, 82, 114, 114,
+	12, 2, 36, 36, 41, 41, 65, 65, 94, 94, 99, 100, 104, 104, 112, 112, 116,
+	116, 118, 118, 120, 120, 4, 2, 11, 11, 34, 34, 2, 580, 2, 3, 3, 2, 2, 2,
+	2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2,
+	2, 13, 3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2,
+	2, 2, 21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 444, 3,
+	2, 2, 2, 2, 458, 3, 2, 2, 2, 2, 482, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2,
+	29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2,
+	2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2,
+	2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2,
+	2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3,
+	2, 2, 2, 2, 508, 3, 2, 2, 2, 2, 526, 3, 2, 2, 2, 2, 61, 3, 2, 2, 2, 2,
+	63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2,
+	2, 71, 3, 2, 2, 2, 2, 119, 3, 2, 2, 2, 2, 121, 3, 2, 2, 2, 3, 123, 3, 2,
+	2, 2, 5, 125, 3, 2, 2, 2, 7, 127, 3, 2, 2, 2, 9, 129, 3, 2, 2, 2, 11, 131,
+	3, 2, 2, 2, 13, 133, 3, 2, 2, 2, 15, 135, 3, 2, 2, 2, 17, 138, 3, 2, 2,
+	2, 19, 140, 3, 2, 2, 2, 21, 143, 3, 2, 2, 2, 23, 146, 3, 2, 2, 2, 25, 157,
+	3, 2, 2, 2, 27, 159, 3, 2, 2, 2, 29, 161, 3, 2, 2, 2, 31, 163, 3, 2, 2,
+	2, 33, 165, 3, 2, 2, 2, 35, 167, 3, 2, 2, 2, 37, 169, 3, 2, 2, 2, 39, 172,
+	3, 2, 2, 2, 41, 175, 3, 2, 2, 2, 43, 178, 3, 2, 2, 2, 45, 180, 3, 2, 2,
+	2, 47, 182, 3, 2, 2, 2, 49, 189, 3, 2, 2, 2, 51, 195, 3, 2, 2, 2, 53, 197,
+	3, 2, 2, 2, 55, 203, 3, 2, 2, 2, 57, 205, 3, 2, 2, 2, 59, 208, 3, 2, 2,
+	2, 61, 215, 3, 2, 2, 2, 63, 252, 3, 2, 2, 2, 65, 258, 3, 2, 2, 2, 67, 262,
+	3, 2, 2, 2, 69, 264, 3, 2, 2, 2, 71, 273, 3, 2, 2, 2, 73, 284, 3, 2, 2,
+	2, 75, 287, 3, 2, 2, 2, 77, 298, 3, 2, 2, 2, 79, 300, 3, 2, 2, 2, 81, 302,
+	3, 2, 2, 2, 83, 304, 3, 2, 2, 2, 85, 311, 3, 2, 2, 2, 87, 318, 3, 2, 2,
+	2, 89, 325, 3, 2, 2, 2, 91, 329, 3, 2, 2, 2, 93, 331, 3, 2, 2, 2, 95, 333,
+	3, 2, 2, 2, 97, 335, 3, 2, 2, 2, 99, 350, 3, 2, 2, 2, 101, 359, 3, 2, 2,
+	2, 103, 361, 3, 2, 2, 2, 105, 377, 3, 2, 2, 2, 107, 379, 3, 2, 2, 2, 109,
+	386, 3, 2, 2, 2, 111, 398, 3, 2, 2, 2, 113, 401, 3, 2, 2, 2, 115, 405,
+	3, 2, 2, 2, 117, 426, 3, 2, 2, 2, 119, 429, 3, 2, 2, 2, 121, 440, 3, 2,
+	2, 2, 123, 124, 7, 42, 2, 2, 124, 4, 3, 2, 2, 2, 125, 126, 7, 43, 2, 2,
+	126, 6, 3, 2, 2, 2, 127, 128, 7, 93, 2, 2, 128, 8, 3, 2, 2, 2, 129, 130,
+	7, 46, 2, 2, 130, 10, 3, 2, 2, 2, 131, 132, 7, 95, 2, 2, 132, 12, 3, 2,
+	2, 2, 133, 134, 7, 62, 2, 2, 134, 14, 3, 2, 2, 2, 135, 136, 7, 62, 2, 2,
+	136, 137, 7, 63, 2, 2, 137, 16, 3, 2, 2, 2, 138, 139, 7, 64, 2, 2, 139,
+	18, 3, 2, 2, 2, 140, 141, 7, 64, 2, 2, 141, 142, 7, 63, 2, 2, 142, 20,
+	3, 2, 2, 2, 143, 144, 7, 63, 2, 2, 144, 145, 7, 63, 2, 2, 145, 22, 3, 2,
+	2, 2, 146, 147, 7, 35, 2, 2, 147, 148, 7, 63, 2, 2, 148, 24, 3, 2, 2, 2,
+	149, 150, 7, 110, 2, 2, 150, 151, 7, 107, 2, 2, 151, 152, 7, 109, 2, 2,
+	152, 158, 7, 103, 2, 2, 153, 154, 7, 78, 2, 2, 154, 155, 7, 75, 2, 2, 155,
+	156, 7, 77, 2, 2, 156, 158, 7, 71, 2, 2, 157, 149, 3, 2, 2, 2, 157, 153,
+	3, 2, 2, 2, 158, 26, 3, 2, 2, 2, 159, 160, 7, 45, 2, 2, 160, 28, 3, 2,
+	2, 2, 161, 162, 7, 47, 2, 2, 162, 30, 3, 2, 2, 2, 163, 164, 7, 44, 2, 2,
+	164, 32, 3, 2, 2, 2, 165, 166, 7, 49, 2, 2, 166, 34, 3, 2, 2, 2, 167, 168,
+	7, 39, 2, 2, 168, 36, 3, 2, 2, 2, 169, 170, 7, 44, 2, 2, 170, 171, 7, 44,
+	2, 2, 171, 38, 3, 2, 2, 2, 172, 173, 7, 62, 2, 2, 173, 174, 7, 62, 2, 2,
+	174, 40, 3, 2, 2, 2, 175, 176, 7, 64, 2, 2, 176, 177, 7, 64, 2, 2, 177,
+	42, 3, 2, 2, 2, 178, 179, 7, 40, 2, 2, 179, 44, 3, 2, 2, 2, 180, 181, 7,
+	126, 2, 2, 181, 46, 3, 2, 2, 2, 182, 183, 7, 96, 2, 2, 183, 48, 3, 2, 2,
+	2, 184, 185, 7, 40, 2, 2, 185, 190, 7, 40, 2, 2, 186, 187, 7, 99, 2, 2,
+	187, 188, 7, 112, 2, 2, 188, 190, 7, 102, 2, 2, 189, 184, 3, 2, 2, 2, 189,
+	186, 3, 2, 2, 2, 190, 50, 3, 2, 2, 2, 191, 192, 7, 126, 2, 2, 192, 196,
+	7, 126, 2, 2, 193, 194, 7, 113, 2, 2, 194, 196, 7, 116, 2, 2, 195, 191,
+	3, 2, 2, 2, 195, 193, 3, 2, 2, 2, 196, 52, 3, 2, 2, 2, 197, 198, 7, 128,
+	2, 2, 198, 54, 3, 2, 2, 2, 199, 204, 7, 35, 2, 2, 200, 201, 7, 112, 2,
+	2, 201, 202, 7, 113, 2, 2, 202, 204, 7, 118, 2, 2, 203, 199, 3, 2, 2, 2,
+	203, 200, 3, 2, 2, 2, 204, 56, 3, 2, 2, 2, 205, 206, 7, 107, 2, 2, 206,
+	207, 7, 112, 2, 2, 207, 58, 3, 2, 2, 2, 208, 209, 7, 112, 2, 2, 209, 210,
+	7, 113, 2, 2, 210, 211, 7, 118, 2, 2, 211, 212, 7, 34, 2, 2, 212, 213,
+	7, 107, 2, 2, 213, 214, 7, 112, 2, 2, 214, 60, 3, 2, 2, 2, 215, 220, 7,
+	93, 2, 2, 216, 219, 5, 119, 65, 2, 217, 219, 5, 121, 66, 2, 218, 216, 3,
+	2, 2, 2, 218, 217, 3, 2, 2, 2, 219, 222, 3, 2, 2, 2, 220, 218, 3, 2, 2,
+	2, 220, 221, 3, 2, 2, 2, 221, 223, 3, 2, 2, 2, 222, 220, 3, 2, 2, 2, 223,
+	224, 7, 95, 2, 2, 224, 62, 3, 2, 2, 2, 225, 226, 7, 118, 2, 2, 226, 227,
+	7, 116, 2, 2, 227, 228, 7, 119, 2, 2, 228, 253, 7, 103, 2, 2, 229, 230,
+	7, 86, 2, 2, 230, 231, 7, 116, 2, 2, 231, 232, 7, 119, 2, 2, 232, 253,
+	7, 103, 2, 2, 233, 234, 7, 86, 2, 2, 234, 235, 7, 84, 2, 2, 235, 236, 7,
+	87, 2, 2, 236, 253, 7, 71, 2, 2, 237, 238, 7, 104, 2, 2, 238, 239, 7, 99,
+	2, 2, 239, 240, 7, 110, 2, 2, 240, 241, 7, 117, 2, 2, 241, 253, 7, 103,
+	2, 2, 242, 243, 7, 72, 2, 2, 243, 244, 7, 99, 2, 2, 244, 245, 7, 110, 2,
+	2, 245, 246, 7, 117, 2, 2, 246, 253, 7, 103, 2, 2, 247, 248, 7, 72, 2,
+	2, 248, 249, 7, 67, 2, 2, 249, 250, 7, 78, 2, 2, 250, 251, 7, 85, 2, 2,
+	251, 253, 7, 71, 2, 2, 252, 225, 3, 2, 2, 2, 252, 229, 3, 2, 2, 2, 252,
+	233, 3, 2, 2, 2, 252, 237, 3, 2, 2, 2, 252, 242, 3, 2, 2, 2, 252, 247,
+	3, 2, 2, 2, 253, 64, 3, 2, 2, 2, 254, 259, 5, 85, 48, 2, 255, 259, 5, 87,
+	49, 2, 256, 259, 5, 89, 50, 2, 257, 259, 5, 83, 47, 2, 258, 254, 3, 2,
+	2, 2, 258, 255, 3, 2, 2, 2, 258, 256, 3, 2, 2, 2, 258, 257, 3, 2, 2, 2,
+	259, 66, 3, 2, 2, 2, 260, 263, 5, 101, 56, 2, 261, 263, 5, 103, 57, 2,
+	262, 260, 3, 2, 2, 2, 262, 261, 3, 2, 2, 2, 263, 68, 3, 2, 2, 2, 264, 269,
+	5, 79, 45, 2, 265, 268, 5, 79, 45, 2, 266, 268, 5, 81, 46, 2, 267, 265,
+	3, 2, 2, 2, 267, 266, 3, 2, 2, 2, 268, 271, 3, 2, 2, 2, 269, 267, 3, 2,
+	2, 2, 269, 270, 3, 2, 2, 2, 270, 70, 3, 2, 2, 2, 271, 269, 3, 2, 2, 2,
+	272, 274, 5, 73, 42, 2, 273, 272, 3, 2, 2, 2, 273, 274, 3, 2, 2, 2, 274,
+	275, 3, 2, 2, 2, 275, 277, 7, 36, 2, 2, 276, 278, 5, 75, 43, 2, 277, 276,
+	3, 2, 2, 2, 277, 278, 3, 2, 2, 2, 278, 279, 3, 2, 2, 2, 279, 280, 7, 36,
+	2, 2, 280, 72, 3, 2, 2, 2, 281, 282, 7, 119, 2, 2, 282, 285, 7, 58, 2,
+	2, 283, 285, 9, 2, 2, 2, 284, 281, 3, 2, 2, 2, 284, 283, 3, 2, 2, 2, 285,
+	74, 3, 2, 2, 2, 286, 288, 5, 77, 44, 2, 287, 286, 3, 2, 2, 2, 288, 289,
+	3, 2, 2, 2, 289, 287, 3, 2, 2, 2, 289, 290, 3, 2, 2, 2, 290, 76, 3, 2,
+	2, 2, 291, 299, 10, 3, 2, 2, 292, 299, 5, 117, 64, 2, 293, 294, 7, 94,
+	2, 2, 294, 299, 7, 12, 2, 2, 295, 296, 7, 94, 2, 2, 296, 297, 7, 15, 2,
+	2, 297, 299, 7, 12, 2, 2, 298, 291, 3, 2, 2, 2, 298, 292, 3, 2, 2, 2, 298,
+	293, 3, 2, 2, 2, 298, 295, 3, 2, 2, 2, 299, 78, 3, 2, 2, 2, 300, 301, 9,
+	4, 2, 2, 301, 80, 3, 2, 2, 2, 302, 303, 9, 5, 2, 2, 303, 82, 3, 2, 2, 2,
+	304, 305, 7, 50, 2, 2, 305, 307, 9, 6, 2, 2, 306, 308, 9, 7, 2, 2, 307,
+	306, 3, 2, 2, 2, 308, 309, 3, 2, 2, 2, 309, 307, 3, 2, 2, 2, 309, 310,
+	3, 2, 2, 2, 310, 84, 3, 2, 2, 2, 311, 315, 5, 91, 51, 2, 312, 314, 5, 81,
+	46, 2, 313, 312, 3, 2, 2, 2, 314, 317, 3, 2, 2, 2, 315, 313, 3, 2, 2, 2,
+	315, 316, 3, 2, 2, 2, 316, 86, 3, 2, 2, 2, 317, 315, 3, 2, 2, 2, 318, 322,
+	7, 50, 2, 2, 319, 321, 5, 93, 52, 2, 320, 319, 3, 2, 2, 2, 321, 324, 3,
+	2, 2, 2, 322, 320, 3, 2, 2, 2, 322, 323, 3, 2, 2, 2, 323, 88, 3, 2, 2,
+	2, 324, 322, 3, 2, 2, 2, 325, 326, 7, 50, 2, 2, 326, 327, 9, 8, 2, 2, 327,
+	328, 5, 113, 62, 2, 328, 90, 3, 2, 2, 2, 329, 330, 9, 9, 2, 2, 330, 92,
+	3, 2, 2, 2, 331, 332, 9, 10, 2, 2, 332, 94, 3, 2, 2, 2, 333, 334, 9, 11,
+	2, 2, 334, 96, 3, 2, 2, 2, 335, 336, 5, 95, 53, 2, 336, 337, 5, 95, 53,
+	2, 337, 338, 5, 95, 53, 2, 338, 339, 5, 95, 53, 2, 339, 98, 3, 2, 2, 2,
+	340, 341, 7, 94, 2, 2, 341, 342, 7, 119, 2, 2, 342, 343, 3, 2, 2, 2, 343,
+	351, 5, 97, 54, 2, 344, 345, 7, 94, 2, 2, 345, 346, 7, 87, 2, 2, 346, 347,
+	3, 2, 2, 2, 347, 348, 5, 97, 54, 2, 348, 349, 5, 97, 54, 2, 349, 351, 3,
+	2, 2, 2, 350, 340, 3, 2, 2, 2, 350, 344, 3, 2, 2, 2, 351, 100, 3, 2, 2,
+	2, 352, 354, 5, 105, 58, 2, 353, 355, 5, 107, 59, 2, 354, 353, 3, 2, 2,
+	2, 354, 355, 3, 2, 2, 2, 355, 360, 3, 2, 2, 2, 356, 357, 5, 109, 60, 2,
+	357, 358, 5, 107, 59, 2, 358, 360, 3, 2, 2, 2, 359, 352, 3, 2, 2, 2, 359,
+	356, 3, 2, 2, 2, 360, 102, 3, 2, 2, 2, 361, 362, 7, 50, 2, 2, 362, 365,
+	9, 8, 2, 2, 363, 366, 5, 111, 61, 2, 364, 366, 5, 113, 62, 2, 365, 363,
+	3, 2, 2, 2, 365, 364, 3, 2, 2, 2, 366, 367, 3, 2, 2, 2, 367, 368, 5, 115,
+	63, 2, 368, 104, 3, 2, 2, 2, 369, 371, 5, 109, 60, 2, 370, 369, 3, 2, 2,
+	2, 370, 371, 3, 2, 2, 2, 371, 372, 3, 2, 2, 2, 372, 373, 7, 48, 2, 2, 373,
+	378, 5, 109, 60, 2, 374, 375, 5, 109, 60, 2, 375, 376, 7, 48, 2, 2, 376,
+	378, 3, 2, 2, 2, 377, 370, 3, 2, 2, 2, 377, 374, 3, 2, 2, 2, 378, 106,
+	3, 2, 2, 2, 379, 381, 9, 12, 2, 2, 380, 382, 9, 13, 2, 2, 381, 380, 3,
+	2, 2, 2, 381, 382, 3, 2, 2, 2, 382, 383, 3, 2, 2, 2, 383, 384, 5, 109,
+	60, 2, 384, 108, 3, 2, 2, 2, 385, 387, 5, 81, 46, 2, 386, 385, 3, 2, 2,
+	2, 387, 388, 3, 2, 2, 2, 388, 386, 3, 2, 2, 2, 388, 389, 3, 2, 2, 2, 389,
+	110, 3, 2, 2, 2, 390, 392, 5, 113, 62, 2, 391, 390, 3, 2, 2, 2, 391, 392,
+	3, 2, 2, 2, 392, 393, 3, 2, 2, 2, 393, 394, 7, 48, 2, 2, 394, 399, 5, 113,
+	62, 2, 395, 396, 5, 113, 62, 2, 396, 397, 7, 48, 2, 2, 397, 399, 3, 2,
+	2, 2, 398, 391, 3, 2, 2, 2, 398, 395, 3, 2, 2, 2, 399, 112, 3, 2, 2, 2,
+	400, 402, 5, 95, 53, 2, 401, 400, 3, 2, 2, 2, 402, 403, 3, 2, 2, 2, 403,
+	401, 3, 2, 2, 2, 403, 404, 3, 2, 2, 2, 404, 114, 3, 2, 2, 2, 405, 407,
+	9, 14, 2, 2, 406, 408, 9, 13, 2, 2, 407, 406, 3, 2, 2, 2, 407, 408, 3,
+	2, 2, 2, 408, 409, 3, 2, 2, 2, 409, 410, 5, 109, 60, 2, 410, 116, 3, 2,
+	2, 2, 411, 412, 7, 94, 2, 2, 412, 427, 9, 15, 2, 2, 413, 414, 7, 94, 2,
+	2, 414, 416, 5, 93, 52, 2, 415, 417, 5, 93, 52, 2, 416, 415, 3, 2, 2, 2,
+	416, 417, 3, 2, 2, 2, 417, 419, 3, 2, 2, 2, 418, 420, 5, 93, 52, 2, 419,
+	418, 3, 2, 2, 2, 419, 420, 3, 2, 2, 2, 420, 427, 3, 2, 2, 2, 421, 422,
+	7, 94, 2, 2, 422, 423, 7, 122, 2, 2, 423, 424, 3, 2, 2, 2, 424, 427, 5,
+	113, 62, 2, 425, 427, 5, 99, 55, 2, 426, 411, 3, 2, 2, 2, 426, 413, 3,
+	2, 2, 2, 426, 421, 3, 2, 2, 2, 426, 425, 3, 2, 2, 2, 427, 118, 3, 2, 2,
+	2, 428, 430, 9, 16, 2, 2, 429, 428, 3, 2, 2, 2, 430, 431, 3, 2, 2, 2, 431,
+	429, 3, 2, 2, 2, 431, 432, 3, 2, 2, 2, 432, 433, 3, 2, 2, 2, 433, 434,
+	8, 65, 2, 2, 434, 120, 3, 2, 2, 2, 435, 437, 7, 15, 2, 2, 436, 438, 7,
+	12, 2, 2, 437, 436, 3, 2, 2, 2, 437, 438, 3, 2, 2, 2, 438, 441, 3, 2, 2,
+	2, 439, 441, 7, 12, 2, 2, 440, 435, 3, 2, 2, 2, 440, 439, 3, 2, 2, 2, 441,
+	442, 3, 2, 2, 2, 442, 443, 8, 66, 2, 2, 443, 122, 3, 2, 2, 2, 444, 456,
+	3, 2, 2, 2, 456, 446, 3, 2, 2, 2, 456, 451, 3, 2, 2, 2, 446, 447, 7, 107,
+	2, 2, 447, 448, 7, 110, 2, 2, 448, 449, 7, 107, 2, 2, 449, 450, 7, 109,
+	2, 2, 450, 457, 7, 103, 2, 2, 451, 452, 7, 75, 2, 2, 452, 453, 7, 78, 2,
+	2, 453, 454, 7, 75, 2, 2, 454, 455, 7, 77, 2, 2, 455, 457, 7, 71, 2, 2,
+	457, 445, 3, 2, 2, 2, 458, 480, 3, 2, 2, 2, 480, 460, 3, 2, 2, 2, 480,
+	470, 3, 2, 2, 2, 460, 461, 7, 118, 2, 2, 461, 462, 7, 103, 2, 2, 462, 463,
+	7, 122, 2, 2, 463, 464, 7, 118, 2, 2, 464, 465, 7, 97, 2, 2, 465, 466,
+	7, 111, 2, 2, 466, 467, 7, 99, 2, 2, 467, 468, 7, 118, 2, 2, 468, 469,
+	7, 101, 2, 2, 469, 481, 7, 106, 2, 2, 470, 471, 7, 86, 2, 2, 471, 472,
+	7, 71, 2, 2, 472, 473, 7, 90, 2, 2, 473, 474, 7, 86, 2, 2, 474, 475, 7,
+	97, 2, 2, 475, 476, 7, 79, 2, 2, 476, 477, 7, 67, 2, 2, 477, 478, 7, 86,
+	2, 2, 478, 479, 7, 69, 2, 2, 479, 481, 7, 74, 2, 2, 481, 459, 3, 2, 2,
+	2, 482, 506, 3, 2, 2, 2, 506, 484, 3, 2, 2, 2, 506, 495, 3, 2, 2, 2, 484,
+	485, 7, 116, 2, 2, 485, 486, 7, 103, 2, 2, 486, 487, 7, 105, 2, 2, 487,
+	488, 7, 103, 2, 2, 488, 489, 7, 122, 2, 2, 489, 490, 7, 97, 2, 2, 490,
+	491, 7, 111, 2, 2, 491, 492, 7, 99, 2, 2, 492, 493, 7, 118, 2, 2, 493,
+	494, 7, 101, 2, 2, 494, 507, 7, 106, 2, 2, 495, 496, 7, 84, 2, 2, 496,
+	497, 7, 71, 2, 2, 497, 498, 7, 73, 2, 2, 498, 499, 7, 71, 2, 2, 499, 500,
+	7, 90, 2, 2, 500, 501, 7, 97, 2, 2, 501, 502, 7, 79, 2, 2, 502, 503, 7,
+	67, 2, 2, 503, 504, 7, 86, 2, 2, 504, 505, 7, 69, 2, 2, 505, 507, 7, 74,
+	2, 2, 507, 483, 3, 2, 2, 2, 508, 524, 3, 2, 2, 2, 524, 510, 3, 2, 2, 2,
+	524, 517, 3, 2, 2, 2, 510, 511, 7, 107, 2, 2, 511, 512, 7, 117, 2, 2, 512,
+	513, 7, 34, 2, 2, 513, 514, 7, 112, 2, 2, 514, 515, 7, 119, 2, 2, 515,
+	516, 7, 110, 2, 2, 516, 525, 7, 110, 2, 2, 517, 518, 7, 75, 2, 2, 518,
+	519, 7, 85, 2, 2, 519, 520, 7, 34, 2, 2, 520, 521, 7, 80, 2, 2, 521, 522,
+	7, 87, 2, 2, 522, 523, 7, 78, 2, 2, 523, 525, 7, 78, 2, 2, 525, 509, 3,
+	2, 2, 2, 526, 550, 3, 2, 2, 2, 550, 528, 3, 2, 2, 2, 550, 539, 3, 2, 2,
+	2, 528, 529, 7, 107, 2, 2, 529, 530, 7, 117, 2, 2, 530, 531, 7, 34, 2,
+	2, 531, 532, 7, 112, 2, 2, 532, 533, 7, 113, 2, 2, 533, 534, 7, 118, 2,
+	2, 534, 535, 7, 34, 2, 2, 535, 536, 7, 112, 2, 2, 536, 537, 7, 119, 2,
+	2, 537, 538, 7, 110, 2, 2, 538, 551, 7, 110, 2, 2, 539, 540, 7, 75, 2,
+	2, 540, 541, 7, 85, 2, 2, 541, 542, 7, 34, 2, 2, 542, 543, 7, 80, 2, 2,
+	543, 544, 7, 81, 2, 2, 544, 545, 7, 86, 2, 2, 545, 546, 7, 34, 2, 2, 546,
+	547, 7, 80, 2, 2, 547, 548, 7, 87, 2, 2, 548, 549, 7, 78, 2, 2, 549, 551,
+	7, 78, 2, 2, 551, 527, 3, 2, 2, 2, 45, 2, 157, 189, 195, 203, 218, 220,
+	252, 258, 262, 267, 269, 273, 277, 284, 289, 298, 309, 315, 322, 350, 354,
+	359, 365, 370, 377, 381, 388, 391, 398, 403, 407, 416, 419, 426, 431, 437,
+	440, 456, 480, 506, 524, 550, 3, 8, 2, 2,
 }
 
 var lexerChannelNames = []string{
@@ -231,22 +278,23 @@ var lexerModeNames = []string{
 
 var lexerLiteralNames = []string{
 	"", "'('", "')'", "'['", "','", "']'", "'<'", "'<='", "'>'", "'>='", "'=='",
-	"'!='", "", "'+'", "'-'", "'*'", "'/'", "'%'", "'**'", "'<<'", "'>>'",
-	"'&'", "'|'", "'^'", "", "", "'~'", "", "'in'", "'not in'",
+	"'!='", "", "", "", "", "'+'", "'-'", "'*'", "'/'", "'%'", "'**'", "'<<'",
+	"'>>'", "'&'", "'|'", "'^'", "", "", "'~'", "", "'in'", "'not in'",
 }
 
 var lexerSymbolicNames = []string{
-	"", "", "", "", "", "", "LT", "LE", "GT", "GE", "EQ", "NE", "LIKE", "ADD",
-	"SUB", "MUL", "DIV", "MOD", "POW", "SHL", "SHR", "BAND", "BOR", "BXOR",
-	"AND", "OR", "BNOT", "NOT", "IN", "NIN", "EmptyTerm", "BooleanConstant",
-	"IntegerConstant", "FloatingConstant", "Identifier", "StringLiteral", "Whitespace",
-	"Newline",
+	"", "", "", "", "", "", "LT", "LE", "GT", "GE", "EQ", "NE", "LIKE", "ILIKE",
+	"TEXTMATCH", "REGEXMATCH", "ADD", "SUB", "MUL", "DIV", "MOD", "POW", "SHL",
+	"SHR", "BAND", "BOR", "BXOR", "AND", "OR", "BNOT", "NOT", "IN", "NIN",
+	"ISNULL", "ISNOTNULL", "EmptyTerm", "BooleanConstant", "IntegerConstant",
+	"FloatingConstant", "Identifier", "StringLiteral", "Whitespace", "Newline",
 }
 
 var lexerRuleNames = []string{
 	"T__0", "T__1", "T__2", "T__3", "T__4", "LT", "LE", "GT", "GE", "EQ", "NE",
-	"LIKE", "ADD", "SUB", "MUL", "DIV", "MOD", "POW", "SHL", "SHR", "BAND",
-	"BOR", "BXOR", "AND", "OR", "BNOT", "NOT", "IN", "NIN", "EmptyTerm", "BooleanConstant",
+	"LIKE", "ILIKE", "TEXTMATCH", "REGEXMATCH", "ADD", "SUB", "MUL", "DIV",
+	"MOD", "POW", "SHL", "SHR", "BAND", "BOR", "BXOR", "AND", "OR", "BNOT",
+	"NOT", "IN", "NIN", "ISNULL", "ISNOTNULL", "EmptyTerm", "BooleanConstant",
 	"IntegerConstant", "FloatingConstant", "Identifier", "StringLiteral", "EncodingPrefix",
 	"SCharSequence", "SChar", "Nondigit", "Digit", "BinaryConstant", "DecimalConstant",
 	"OctalConstant", "HexadecimalConstant", "NonzeroDigit", "OctalDigit", "HexadecimalDigit",
@@ -305,29 +353,34 @@ const (
 	PlanLexerEQ               = 10
 	PlanLexerNE               = 11
 	PlanLexerLIKE             = 12
-	PlanLexerADD              = 13
-	PlanLexerSUB              = 14
-	PlanLexerMUL              = 15
-	PlanLexerDIV              = 16
-	PlanLexerMOD              = 17
-	PlanLexerPOW              = 18
-	PlanLexerSHL              = 19
-	PlanLexerSHR              = 20
-	PlanLexerBAND             = 21
-	PlanLexerBOR              = 22
-	PlanLexerBXOR             = 23
-	PlanLexerAND              = 24
-	PlanLexerOR               = 25
-	PlanLexerBNOT             = 26
-	PlanLexerNOT              = 27
-	PlanLexerIN               = 28
-	PlanLexerNIN              = 29
-	PlanLexerEmptyTerm        = 30
-	PlanLexerBooleanConstant  = 31
-	PlanLexerIntegerConstant  = 32
-	PlanLexerFloatingConstant = 33
-	PlanLexerIdentifier       = 34
-	PlanLexerStringLiteral    = 35
-	PlanLexerWhitespace       = 36
-	PlanLexerNewline          = 37
+	PlanLexerILIKE            = 13
+	PlanLexerTEXTMATCH        = 14
+	PlanLexerREGEXMATCH       = 15
+	PlanLexerADD              = 16
+	PlanLexerSUB              = 17
+	PlanLexerMUL              = 18
+	PlanLexerDIV              = 19
+	PlanLexerMOD              = 20
+	PlanLexerPOW              = 21
+	PlanLexerSHL              = 22
+	PlanLexerSHR              = 23
+	PlanLexerBAND             = 24
+	PlanLexerBOR              = 25
+	PlanLexerBXOR             = 26
+	PlanLexerAND              = 27
+	PlanLexerOR               = 28
+	PlanLexerBNOT             = 29
+	PlanLexerNOT              = 30
+	PlanLexerIN               = 31
+	PlanLexerNIN              = 32
+	PlanLexerISNULL           = 33
+	PlanLexerISNOTNULL        = 34
+	PlanLexerEmptyTerm        = 35
+	PlanLexerBooleanConstant  = 36
+	PlanLexerIntegerConstant  = 37
+	PlanLexerFloatingConstant = 38
+	PlanLexerIdentifier       = 39
+	PlanLexerStringLiteral    = 40
+	PlanLexerWhitespace       = 41
+	PlanLexerNewline          = 42
 )
@@ -15,60 +15,68 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 39, 89, 4,
-	2, 9, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 3, 2, 5, 2, 17, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 71, 10, 2, 12,
-	2, 14, 2, 74, 11, 2, 3, 2, 5, 2, 77, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 7, 2, 84, 10, 2, 12, 2, 14, 2, 87, 11, 2, 3, 2, 2, 3, 2, 3, 2, 2, 11,
-	4, 2, 15, 16, 28, 29, 3, 2, 17, 19, 3, 2, 15, 16, 3, 2, 21, 22, 3, 2, 8,
-	9, 3, 2, 10, 11, 3, 2, 8, 11, 3, 2, 12, 13, 3, 2, 30, 31, 2, 111, 2, 16,
-	3, 2, 2, 2, 4, 5, 8, 2, 1, 2, 5, 17, 7, 34, 2, 2, 6, 17, 7, 35, 2, 2, 7,
-	17, 7, 33, 2, 2, 8, 17, 7, 37, 2, 2, 9, 17, 7, 36, 2, 2, 10, 11, 7, 3,
-	2, 2, 11, 12, 5, 2, 2, 2, 12, 13, 7, 4, 2, 2, 13, 17, 3, 2, 2, 2, 14, 15,
-	9, 2, 2, 2, 15, 17, 5, 2, 2, 17, 16, 4, 3, 2, 2, 2, 16, 6, 3, 2, 2, 2,
-	16, 7, 3, 2, 2, 2, 16, 8, 3, 2, 2, 2, 16, 9, 3, 2, 2, 2, 16, 10, 3, 2,
-	2, 2, 16, 14, 3, 2, 2, 2, 17, 85, 3, 2, 2, 2, 18, 19, 12, 18, 2, 2, 19,
-	20, 7, 20, 2, 2, 20, 84, 5, 2, 2, 19, 21, 22, 12, 16, 2, 2, 22, 23, 9,
-	3, 2, 2, 23, 84, 5, 2, 2, 17, 24, 25, 12, 15, 2, 2, 25, 26, 9, 4, 2, 2,
-	26, 84, 5, 2, 2, 16, 27, 28, 12, 14, 2, 2, 28, 29, 9, 5, 2, 2, 29, 84,
-	5, 2, 2, 15, 30, 31, 12, 11, 2, 2, 31, 32, 9, 6, 2, 2, 32, 33, 7, 36, 2,
-	2, 33, 34, 9, 6, 2, 2, 34, 84, 5, 2, 2, 12, 35, 36, 12, 10, 2, 2, 36, 37,
-	9, 7, 2, 2, 37, 38, 7, 36, 2, 2, 38, 39, 9, 7, 2, 2, 39, 84, 5, 2, 2, 11,
-	40, 41, 12, 9, 2, 2, 41, 42, 9, 8, 2, 2, 42, 84, 5, 2, 2, 10, 43, 44, 12,
-	8, 2, 2, 44, 45, 9, 9, 2, 2, 45, 84, 5, 2, 2, 9, 46, 47, 12, 7, 2, 2, 47,
-	48, 7, 23, 2, 2, 48, 84, 5, 2, 2, 8, 49, 50, 12, 6, 2, 2, 50, 51, 7, 25,
-	2, 2, 51, 84, 5, 2, 2, 7, 52, 53, 12, 5, 2, 2, 53, 54, 7, 24, 2, 2, 54,
-	84, 5, 2, 2, 6, 55, 56, 12, 4, 2, 2, 56, 57, 7, 26, 2, 2, 57, 84, 5, 2,
-	2, 5, 58, 59, 12, 3, 2, 2, 59, 60, 7, 27, 2, 2, 60, 84, 5, 2, 2, 4, 61,
-	62, 12, 19, 2, 2, 62, 63, 7, 14, 2, 2, 63, 84, 7, 37, 2, 2, 64, 65, 12,
-	13, 2, 2, 65, 66, 9, 10, 2, 2, 66, 67, 7, 5, 2, 2, 67, 72, 5, 2, 2, 2,
-	68, 69, 7, 6, 2, 2, 69, 71, 5, 2, 2, 2, 70, 68, 3, 2, 2, 2, 71, 74, 3,
-	2, 2, 2, 72, 70, 3, 2, 2, 2, 72, 73, 3, 2, 2, 2, 73, 76, 3, 2, 2, 2, 74,
-	72, 3, 2, 2, 2, 75, 77, 7, 6, 2, 2, 76, 75, 3, 2, 2, 2, 76, 77, 3, 2, 2,
-	2, 77, 78, 3, 2, 2, 2, 78, 79, 7, 7, 2, 2, 79, 84, 3, 2, 2, 2, 80, 81,
-	12, 12, 2, 2, 81, 82, 9, 10, 2, 2, 82, 84, 7, 32, 2, 2, 83, 18, 3, 2, 2,
-	2, 83, 21, 3, 2, 2, 2, 83, 24, 3, 2, 2, 2, 83, 27, 3, 2, 2, 2, 83, 30,
-	3, 2, 2, 2, 83, 35, 3, 2, 2, 2, 83, 40, 3, 2, 2, 2, 83, 43, 3, 2, 2, 2,
-	83, 46, 3, 2, 2, 2, 83, 49, 3, 2, 2, 2, 83, 52, 3, 2, 2, 2, 83, 55, 3,
-	2, 2, 2, 83, 58, 3, 2, 2, 2, 83, 61, 3, 2, 2, 2, 83, 64, 3, 2, 2, 2, 83,
-	80, 3, 2, 2, 2, 84, 87, 3, 2, 2, 2, 85, 83, 3, 2, 2, 2, 85, 86, 3, 2, 2,
-	2, 86, 3, 3, 2, 2, 2, 87, 85, 3, 2, 2, 2, 7, 16, 72, 76, 83, 85,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 44, 105,
+	4, 2, 9, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 5, 2, 17, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 71, 10, 2,
+	12, 2, 14, 2, 74, 11, 2, 3, 2, 5, 2, 77, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 7, 2, 84, 10, 2, 12, 2, 14, 2, 87, 11, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 2, 3, 2, 3, 2, 2, 13, 4, 2, 18, 19, 31, 32, 3, 2, 20, 22, 3, 2, 18,
+	19, 3, 2, 24, 25, 3, 2, 8, 9, 3, 2, 10, 11, 3, 2, 8, 11, 3, 2, 12, 13,
+	3, 2, 33, 34, 3, 2, 14, 15, 3, 2, 35, 36, 2, 130, 2, 16, 3, 2, 2, 2, 4,
+	5, 8, 2, 1, 2, 5, 17, 7, 39, 2, 2, 6, 17, 7, 40, 2, 2, 7, 17, 7, 38, 2,
+	2, 8, 17, 7, 42, 2, 2, 9, 17, 7, 41, 2, 2, 10, 11, 7, 3, 2, 2, 11, 12,
+	5, 2, 2, 2, 12, 13, 7, 4, 2, 2, 13, 17, 3, 2, 2, 2, 14, 15, 9, 2, 2, 2,
+	15, 17, 5, 2, 2, 17, 16, 4, 3, 2, 2, 2, 16, 6, 3, 2, 2, 2, 16, 7, 3, 2,
+	2, 2, 16, 8, 3, 2, 2, 2, 16, 9, 3, 2, 2, 2, 16, 10, 3, 2, 2, 2, 16, 89,
+	3, 2, 2, 2, 16, 96, 3, 2, 2, 2, 16, 14, 3, 2, 2, 2, 17, 85, 3, 2, 2, 2,
+	18, 19, 12, 18, 2, 2, 19, 20, 7, 23, 2, 2, 20, 84, 5, 2, 2, 19, 21, 22,
+	12, 16, 2, 2, 22, 23, 9, 3, 2, 2, 23, 84, 5, 2, 2, 17, 24, 25, 12, 15,
+	2, 2, 25, 26, 9, 4, 2, 2, 26, 84, 5, 2, 2, 16, 27, 28, 12, 14, 2, 2, 28,
+	29, 9, 5, 2, 2, 29, 84, 5, 2, 2, 15, 30, 31, 12, 11, 2, 2, 31, 32, 9, 6,
+	2, 2, 32, 33, 7, 41, 2, 2, 33, 34, 9, 6, 2, 2, 34, 84, 5, 2, 2, 12, 35,
+	36, 12, 10, 2, 2, 36, 37, 9, 7, 2, 2, 37, 38, 7, 41, 2, 2, 38, 39, 9, 7,
+	2, 2, 39, 84, 5, 2, 2, 11, 40, 41, 12, 9, 2, 2, 41, 42, 9, 8, 2, 2, 42,
+	84, 5, 2, 2, 10, 43, 44, 12, 8, 2, 2, 44, 45, 9, 9, 2, 2, 45, 84, 5, 2,
+	2, 9, 46, 47, 12, 7, 2, 2, 47, 48, 7, 26, 2, 2, 48, 84, 5, 2, 2, 8, 49,
+	50, 12, 6, 2, 2, 50, 51, 7, 28, 2, 2, 51, 84, 5, 2, 2, 7, 52, 53, 12, 5,
+	2, 2, 53, 54, 7, 27, 2, 2, 54, 84, 5, 2, 2, 6, 55, 56, 12, 4, 2, 2, 56,
+	57, 7, 29, 2, 2, 57, 84, 5, 2, 2, 5, 58, 59, 12, 3, 2, 2, 59, 60, 7, 30,
+	2, 2, 60, 84, 5, 2, 2, 4, 61, 62, 12, 19, 2, 2, 62, 63, 9, 11, 2, 2, 63,
+	84, 7, 42, 2, 2, 64, 65, 12, 13, 2, 2, 65, 66, 9, 10, 2, 2, 66, 67, 7,
+	5, 2, 2, 67, 72, 5, 2, 2, 2, 68, 69, 7, 6, 2, 2, 69, 71, 5, 2, 2, 2, 70,
+	68, 3, 2, 2, 2, 71, 74, 3, 2, 2, 2, 72, 70, 3, 2, 2, 2, 72, 73, 3, 2, 2,
+	2, 73, 76, 3, 2, 2, 2, 74, 72, 3, 2, 2, 2, 75, 77, 7, 6, 2, 2, 76, 75,
+	3, 2, 2, 2, 76, 77, 3, 2, 2, 2, 77, 78, 3, 2, 2, 2, 78, 79, 7, 7, 2, 2,
+	79, 84, 3, 2, 2, 2, 80, 81, 12, 12, 2, 2, 81, 82, 9, 10, 2, 2, 82, 84,
+	7, 37, 2, 2, 83, 18, 3, 2, 2, 2, 83, 21, 3, 2, 2, 2, 83, 24, 3, 2, 2, 2,
+	83, 27, 3, 2, 2, 2, 83, 30, 3, 2, 2, 2, 83, 35, 3, 2, 2, 2, 83, 40, 3,
+	2, 2, 2, 83, 43, 3, 2, 2, 2, 83, 46, 3, 2, 2, 2, 83, 49, 3, 2, 2, 2, 83,
+	52, 3, 2, 2, 2, 83, 55, 3, 2, 2, 2, 83, 58, 3, 2, 2, 2, 83, 103, 3, 2,
+	2, 2, 83, 61, 3, 2, 2, 2, 83, 64, 3, 2, 2, 2, 83, 80, 3, 2, 2, 2, 84, 87,
+	3, 2, 2, 2, 85, 83, 3, 2, 2, 2, 85, 86, 3, 2, 2, 2, 86, 3, 3, 2, 2, 2,
+	87, 85, 3, 2, 2, 2, 89, 90, 7, 16, 2, 2, 90, 91, 7, 3, 2, 2, 91, 92, 7,
+	41, 2, 2, 92, 93, 7, 6, 2, 2, 93, 94, 7, 42, 2, 2, 94, 95, 7, 4, 2, 2,
+	95, 17, 3, 2, 2, 2, 96, 97, 7, 17, 2, 2, 97, 98, 7, 3, 2, 2, 98, 99, 7,
+	41, 2, 2, 99, 100, 7, 6, 2, 2, 100, 101, 7, 42, 2, 2, 101, 102, 7, 4, 2,
+	2, 102, 17, 3, 2, 2, 2, 103, 104, 12, 20, 2, 2, 104, 84, 9, 12, 2, 2, 7,
+	16, 72, 76, 83, 85,
 }
 var literalNames = []string{
 	"", "'('", "')'", "'['", "','", "']'", "'<'", "'<='", "'>'", "'>='", "'=='",
-	"'!='", "", "'+'", "'-'", "'*'", "'/'", "'%'", "'**'", "'<<'", "'>>'",
-	"'&'", "'|'", "'^'", "", "", "'~'", "", "'in'", "'not in'",
+	"'!='", "", "", "", "", "'+'", "'-'", "'*'", "'/'", "'%'", "'**'", "'<<'",
+	"'>>'", "'&'", "'|'", "'^'", "", "", "'~'", "", "'in'", "'not in'",
 }
 var symbolicNames = []string{
-	"", "", "", "", "", "", "LT", "LE", "GT", "GE", "EQ", "NE", "LIKE", "ADD",
-	"SUB", "MUL", "DIV", "MOD", "POW", "SHL", "SHR", "BAND", "BOR", "BXOR",
-	"AND", "OR", "BNOT", "NOT", "IN", "NIN", "EmptyTerm", "BooleanConstant",
-	"IntegerConstant", "FloatingConstant", "Identifier", "StringLiteral", "Whitespace",
-	"Newline",
+	"", "", "", "", "", "", "LT", "LE", "GT", "GE", "EQ", "NE", "LIKE", "ILIKE",
+	"TEXTMATCH", "REGEXMATCH", "ADD", "SUB", "MUL", "DIV", "MOD", "POW", "SHL",
+	"SHR", "BAND", "BOR", "BXOR", "AND", "OR", "BNOT", "NOT", "IN", "NIN",
+	"ISNULL", "ISNOTNULL", "EmptyTerm", "BooleanConstant", "IntegerConstant",
+	"FloatingConstant", "Identifier", "StringLiteral", "Whitespace", "Newline",
 }
 
 var ruleNames = []string{
@@ -119,31 +127,36 @@ const (
 	PlanParserEQ               = 10
 	PlanParserNE               = 11
 	PlanParserLIKE             = 12
-	PlanParserADD              = 13
-	PlanParserSUB              = 14
-	PlanParserMUL              = 15
-	PlanParserDIV              = 16
-	PlanParserMOD              = 17
-	PlanParserPOW              = 18
-	PlanParserSHL              = 19
-	PlanParserSHR              = 20
-	PlanParserBAND             = 21
-	PlanParserBOR              = 22
-	PlanParserBXOR             = 23
-	PlanParserAND              = 24
-	PlanParserOR               = 25
-	PlanParserBNOT             = 26
-	PlanParserNOT              = 27
-	PlanParserIN               = 28
-	PlanParserNIN              = 29
-	PlanParserEmptyTerm        = 30
-	PlanParserBooleanConstant  = 31
-	PlanParserIntegerConstant  = 32
-	PlanParserFloatingConstant = 33
-	PlanParserIdentifier       = 34
-	PlanParserStringLiteral    = 35
-	PlanParserWhitespace       = 36
-	PlanParserNewline          = 37
+	PlanParserILIKE            = 13
+	PlanParserTEXTMATCH        = 14
+	PlanParserREGEXMATCH       = 15
+	PlanParserADD              = 16
+	PlanParserSUB              = 17
+	PlanParserMUL              = 18
+	PlanParserDIV              = 19
+	PlanParserMOD              = 20
+	PlanParserPOW              = 21
+	PlanParserSHL              = 22
+	PlanParserSHR              = 23
+	PlanParserBAND             = 24
+	PlanParserBOR              = 25
+	PlanParserBXOR             = 26
+	PlanParserAND              = 27
+	PlanParserOR               = 28
+	PlanParserBNOT             = 29
+	PlanParserNOT              = 30
+	PlanParserIN               = 31
+	PlanParserNIN              = 32
+	PlanParserISNULL           = 33
+	PlanParserISNOTNULL        = 34
+	PlanParserEmptyTerm        = 35
+	PlanParserBooleanConstant  = 36
+	PlanParserIntegerConstant  = 37
+	PlanParserFloatingConstant = 38
+	PlanParserIdentifier       = 39
+	PlanParserStringLiteral    = 40
+	PlanParserWhitespace       = 41
+	PlanParserNewline          = 42
 )
 
 // PlanParserRULE_expr is the PlanParser rule.
@@ -701,6 +714,46 @@ func (s *FloatingContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	}
 }
 
+type TextMatchContext struct {
+	*ExprContext
+}
+
+func NewTextMatchContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TextMatchContext {
+	var p = new(TextMatchContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *TextMatchContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TextMatchContext) TEXTMATCH() antlr.TerminalNode {
+	return s.GetToken(PlanParserTEXTMATCH, 0)
+}
+
+func (s *TextMatchContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(PlanParserIdentifier, 0)
+}
+
+func (s *TextMatchContext) StringLiteral() antlr.TerminalNode {
+	return s.GetToken(PlanParserStringLiteral, 0)
+}
+
+func (s *TextMatchContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitTextMatch(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
 type RangeContext struct {
 	*ExprContext
 	op1 antlr.Token
@@ -1028,6 +1081,46 @@ func (s *IdentifierContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	}
 }
 
+type RegexMatchContext struct {
+	*ExprContext
+}
+
+func NewRegexMatchContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *RegexMatchContext {
+	var p = new(RegexMatchContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *RegexMatchContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RegexMatchContext) REGEXMATCH() antlr.TerminalNode {
+	return s.GetToken(PlanParserREGEXMATCH, 0)
+}
+
+func (s *RegexMatchContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(PlanParserIdentifier, 0)
+}
+
+func (s *RegexMatchContext) StringLiteral() antlr.TerminalNode {
+	return s.GetToken(PlanParserStringLiteral, 0)
+}
+
+func (s *RegexMatchContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitRegexMatch(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
 type BitXorContext struct {
 	*ExprContext
 }
@@ -1085,6 +1178,7 @@ func (s *BitXorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 type LikeContext struct {
 	*ExprContext
+	op antlr.Token
 }
 
 func NewLikeContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LikeContext {
@@ -1097,6 +1191,10 @@ func NewLikeContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LikeConte
 	return p
 }
 
+func (s *LikeContext) GetOp() antlr.Token { return s.op }
+
+func (s *LikeContext) SetOp(v antlr.Token) { s.op = v }
+
 func (s *LikeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1115,6 +1213,10 @@ func (s *LikeContext) LIKE() antlr.TerminalNode {
 	return s.GetToken(PlanParserLIKE, 0)
 }
 
+func (s *LikeContext) ILIKE() antlr.TerminalNode {
+	return s.GetToken(PlanParserILIKE, 0)
+}
+
 func (s *LikeContext) StringLiteral() antlr.TerminalNode {
 	return s.GetToken(PlanParserStringLiteral, 0)
 }
@@ -1184,6 +1286,57 @@ func (s *BitAndContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	}
 }
 
+type IsNullContext struct {
+	*ExprContext
+	op antlr.Token
+}
+
+func NewIsNullContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IsNullContext {
+	var p = new(IsNullContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *IsNullContext) GetOp() antlr.Token { return s.op }
+
+func (s *IsNullContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *IsNullContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *IsNullContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *IsNullContext) ISNULL() antlr.TerminalNode {
+	return s.GetToken(PlanParserISNULL, 0)
+}
+
+func (s *IsNullContext) ISNOTNULL() antlr.TerminalNode {
+	return s.GetToken(PlanParserISNOTNULL, 0)
+}
+
+func (s *IsNullContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitIsNull(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
 type LogicalAndContext struct {
 	*ExprContext
 }
@@ -1545,6 +1698,64 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			p.Match(PlanParserT__1)
 		}
 
+	case PlanParserTEXTMATCH:
+		localctx = NewTextMatchContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(87)
+			p.Match(PlanParserTEXTMATCH)
+		}
+		{
+			p.SetState(88)
+			p.Match(PlanParserT__0)
+		}
+		{
+			p.SetState(89)
+			p.Match(PlanParserIdentifier)
+		}
+		{
+			p.SetState(90)
+			p.Match(PlanParserT__3)
+		}
+		{
+			p.SetState(91)
+			p.Match(PlanParserStringLiteral)
+		}
+		{
+			p.SetState(92)
+			p.Match(PlanParserT__1)
+		}
+
+	case PlanParserREGEXMATCH:
+		localctx = NewRegexMatchContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(94)
+			p.Match(PlanParserREGEXMATCH)
+		}
+		{
+			p.SetState(95)
+			p.Match(PlanParserT__0)
+		}
+		{
+			p.SetState(96)
+			p.Match(PlanParserIdentifier)
+		}
+		{
+			p.SetState(97)
+			p.Match(PlanParserT__3)
+		}
+		{
+			p.SetState(98)
+			p.Match(PlanParserStringLiteral)
+		}
+		{
+			p.SetState(99)
+			p.Match(PlanParserT__1)
+		}
+
 	case PlanParserADD, PlanParserSUB, PlanParserBNOT, PlanParserNOT:
 		localctx = NewUnaryContext(p, localctx)
 		p.SetParserRuleContext(localctx)
@@ -1953,6 +2164,33 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 				}
 
 			case 14:
+				localctx = NewIsNullContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
+				p.SetState(101)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 18)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 18)", ""))
+				}
+				{
+					p.SetState(102)
+
+					var _lt = p.GetTokenStream().LT(1)
+
+					localctx.(*IsNullContext).op = _lt
+
+					_la = p.GetTokenStream().LA(1)
+
+					if !(_la == PlanParserISNULL || _la == PlanParserISNOTNULL) {
+						var _ri = p.GetErrorHandler().RecoverInline(p)
+
+						localctx.(*IsNullContext).op = _ri
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+
+			case 15:
 				localctx = NewLikeContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
 				p.SetState(59)
@@ -1962,14 +2200,28 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 				}
 				{
 					p.SetState(60)
-					p.Match(PlanParserLIKE)
+
+					var _lt = p.GetTokenStream().LT(1)
+
+					localctx.(*LikeContext).op = _lt
+
+					_la = p.GetTokenStream().LA(1)
+
+					if !(_la == PlanParserLIKE || _la == PlanParserILIKE) {
+						var _ri = p.GetErrorHandler().RecoverInline(p)
+
+						localctx.(*LikeContext).op = _ri
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
 				}
 				{
 					p.SetState(61)
 					p.Match(PlanParserStringLiteral)
 				}
 
-			case 15:
+			case 16:
 				localctx = NewTermContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
 				p.SetState(62)
@@ -2040,7 +2292,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					p.Match(PlanParserT__4)
 				}
 
-			case 16:
+			case 17:
 				localctx = NewEmptyTermContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
 				p.SetState(78)
@@ -2138,12 +2390,15 @@ func (p *PlanParser) Expr_Sempred(localctx antlr.RuleContext, predIndex int) boo
 		return p.Precpred(p.GetParserRuleContext(), 1)
 
 	case 13:
-		return p.Precpred(p.GetParserRuleContext(), 17)
+		return p.Precpred(p.GetParserRuleContext(), 18)
 
 	case 14:
-		return p.Precpred(p.GetParserRuleContext(), 11)
+		return p.Precpred(p.GetParserRuleContext(), 17)
 
 	case 15:
+		return p.Precpred(p.GetParserRuleContext(), 11)
+
+	case 16:
 		return p.Precpred(p.GetParserRuleContext(), 10)
 
 	default:
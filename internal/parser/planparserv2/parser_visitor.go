@@ -409,16 +409,111 @@ func (v *ParserVisitor) VisitLike(ctx *parser.LikeContext) interface{} {
 		return err
 	}
 
-	op, operand, err := translatePatternMatch(pattern)
+	var op planpb.OpType
+	var operand string
+	if ctx.GetOp().GetTokenType() == parser.PlanParserILIKE {
+		op, operand = planpb.OpType_RegexMatch, translateCaseInsensitivePattern(pattern)
+	} else {
+		op, operand, err = translatePatternMatch(pattern)
+		if err != nil {
+			return err
+		}
+	}
+
+	return &ExprWithType{
+		expr: &planpb.Expr{
+			Expr: &planpb.Expr_UnaryRangeExpr{
+				UnaryRangeExpr: &planpb.UnaryRangeExpr{
+					ColumnInfo: column,
+					Op:         op,
+					Value:      NewString(operand),
+				},
+			},
+		},
+		dataType: schemapb.DataType_Bool,
+	}
+}
+
+// VisitIsNull handles `is null` and `is not null` on scalar field.
+func (v *ParserVisitor) VisitIsNull(ctx *parser.IsNullContext) interface{} {
+	child := ctx.Expr().Accept(v)
+	if err := getError(child); err != nil {
+		return err
+	}
+
+	childExpr := getExpr(child)
+	if childExpr == nil {
+		return fmt.Errorf("the operand of null check is invalid")
+	}
+
+	column := toColumnInfo(childExpr)
+	if column == nil {
+		return fmt.Errorf("null check on complicated expr is unsupported")
+	}
+
+	if typeutil.IsVectorType(column.GetDataType()) {
+		return fmt.Errorf("null check on vector field is unsupported")
+	}
+
+	op := planpb.NullExpr_IsNull
+	if ctx.GetOp().GetTokenType() == parser.PlanParserISNOTNULL {
+		op = planpb.NullExpr_IsNotNull
+	}
+
+	return &ExprWithType{
+		expr: &planpb.Expr{
+			Expr: &planpb.Expr_NullExpr{
+				NullExpr: &planpb.NullExpr{
+					ColumnInfo: column,
+					Op:         op,
+				},
+			},
+		},
+		dataType: schemapb.DataType_Bool,
+	}
+}
+
+// VisitTextMatch handles token-level text match on string field.
+func (v *ParserVisitor) VisitTextMatch(ctx *parser.TextMatchContext) interface{} {
+	return v.translateStringMatch("text_match", planpb.OpType_TextMatch, ctx.Identifier().GetText(), ctx.StringLiteral().GetText())
+}
+
+// VisitRegexMatch handles regular expression match on string field.
+func (v *ParserVisitor) VisitRegexMatch(ctx *parser.RegexMatchContext) interface{} {
+	return v.translateStringMatch("regex_match", planpb.OpType_RegexMatch, ctx.Identifier().GetText(), ctx.StringLiteral().GetText())
+}
+
+func (v *ParserVisitor) translateStringMatch(name string, op planpb.OpType, identifier string, literal string) interface{} {
+	expr, err := v.translateIdentifier(identifier)
 	if err != nil {
 		return err
 	}
 
+	if !typeutil.IsStringType(expr.dataType) {
+		return fmt.Errorf("%s operation on non-string field is unsupported", name)
+	}
+
+	operand, err := strconv.Unquote(literal)
+	if err != nil {
+		return err
+	}
+
+	switch op {
+	case planpb.OpType_RegexMatch:
+		if err := validateRegexPattern(operand); err != nil {
+			return fmt.Errorf("invalid regular expression of %s: %s", name, err.Error())
+		}
+	case planpb.OpType_TextMatch:
+		if len(tokenizeText(operand)) == 0 {
+			return fmt.Errorf("%s requires at least one alphanumeric token", name)
+		}
+	}
+
 	return &ExprWithType{
 		expr: &planpb.Expr{
 			Expr: &planpb.Expr_UnaryRangeExpr{
 				UnaryRangeExpr: &planpb.UnaryRangeExpr{
-					ColumnInfo: column,
+					ColumnInfo: toColumnInfo(expr),
 					Op:         op,
 					Value:      NewString(operand),
 				},
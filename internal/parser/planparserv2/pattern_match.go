@@ -2,6 +2,9 @@ package planparserv2
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 )
@@ -73,3 +76,148 @@ func translatePatternMatch(pattern string) (op planpb.OpType, operand string, er
 			"and equal match like %s(no wildcards) are supported",
 		pattern, "ab%", "ab")
 }
+
+// caseInsensitiveFlag is the only flag group accepted in regular expressions, segcore strips it
+// from the start of the pattern and compiles the rest with the icase option.
+const caseInsensitiveFlag = "(?i)"
+
+// translateCaseInsensitivePattern translates a like pattern to a case-insensitive regular expression,
+// which is used by ilike. Leading and trailing wildcards drop the anchor instead of matching `.*`,
+// which keeps the regular expression cheap for segcore to search.
+func translateCaseInsensitivePattern(pattern string) string {
+	var builder strings.Builder
+	builder.WriteString(caseInsensitiveFlag)
+	l := len(pattern)
+	start := 0
+	for start < l {
+		if _, ok := wildcards[pattern[start]]; !ok {
+			break
+		}
+		start++
+	}
+	if start == 0 {
+		builder.WriteString("^")
+	}
+	end := findLastNotOfWildcards(pattern) + 1
+	trailingWildcard := end < l
+	if end < start {
+		end = start
+	}
+	for i := start; i < end; i++ {
+		c := pattern[i]
+		if c == escapeCharacter && i+1 < l {
+			if _, ok := wildcards[pattern[i+1]]; ok {
+				builder.WriteString(regexp.QuoteMeta(pattern[start:i]))
+				builder.WriteString(regexp.QuoteMeta(pattern[i+1 : i+2]))
+				i++
+				start = i + 1
+			}
+			continue
+		}
+		if _, ok := wildcards[c]; ok {
+			builder.WriteString(regexp.QuoteMeta(pattern[start:i]))
+			builder.WriteString(".*")
+			start = i + 1
+		}
+	}
+	builder.WriteString(regexp.QuoteMeta(pattern[start:end]))
+	if !trailingWildcard {
+		builder.WriteString("$")
+	}
+	return builder.String()
+}
+
+// validateRegexPattern checks a regular expression against both RE2 and the ECMAScript grammar of
+// std::regex used by segcore, only the syntax both of them accept with the same meaning is allowed.
+func validateRegexPattern(pattern string) error {
+	if _, err := regexp.Compile(pattern); err != nil {
+		return err
+	}
+	pattern = strings.TrimPrefix(pattern, caseInsensitiveFlag)
+	l := len(pattern)
+	inClass := false
+	for i := 0; i < l; i++ {
+		c := pattern[i]
+		switch {
+		case c >= utf8.RuneSelf && inClass:
+			return fmt.Errorf("non-ASCII character in character class is unsupported")
+		case c == escapeCharacter:
+			if i+1 >= l {
+				return fmt.Errorf("trailing backslash")
+			}
+			i++
+			e := pattern[i]
+			switch {
+			case strings.IndexByte(`dDwWsSbBnrtfv`, e) >= 0:
+			case e == 'x':
+				if i+2 >= l || !isHexDigit(pattern[i+1]) || !isHexDigit(pattern[i+2]) {
+					return fmt.Errorf(`only \xHH hexadecimal escape is supported`)
+				}
+				i += 2
+			case e < utf8.RuneSelf && !isAlphaNumeric(e):
+			default:
+				return fmt.Errorf(`unsupported escape sequence \%c`, e)
+			}
+		case inClass:
+			if c == ']' {
+				inClass = false
+			}
+		case c == '[':
+			inClass = true
+			// a leading ] or ^] is a literal in both dialects
+			if i+1 < l && pattern[i+1] == '^' {
+				i++
+			}
+			if i+1 < l && pattern[i+1] == ']' {
+				i++
+			}
+			// [:class:] is kept as a whole so its ] doesn't close the class
+			for i+2 < l && pattern[i+1] == '[' && pattern[i+2] == ':' {
+				closing := strings.Index(pattern[i+3:], ":]")
+				if closing < 0 {
+					break
+				}
+				i += closing + 4
+			}
+		case c == '(' && i+1 < l && pattern[i+1] == '?':
+			if i+2 >= l || pattern[i+2] != ':' {
+				return fmt.Errorf("only non-capturing group (?:...) and a leading %s are supported", caseInsensitiveFlag)
+			}
+		case c == '{':
+			if !repetitionPattern.MatchString(pattern[i:]) {
+				return fmt.Errorf("'{' must be a repetition like {n}, {n,} or {n,m}, escape it to match the character")
+			}
+		}
+	}
+	return nil
+}
+
+var repetitionPattern = regexp.MustCompile(`^\{[0-9]+(,[0-9]*)?\}`)
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+func isAlphaNumeric(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// tokenizeText splits text into lower-cased ASCII alphanumeric tokens, it must stay the same as
+// Tokenize in segcore (query/Utils.h) which evaluates text match.
+func tokenizeText(text string) []string {
+	var tokens []string
+	start := -1
+	for i := 0; i <= len(text); i++ {
+		if i < len(text) && isAlphaNumeric(text[i]) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			tokens = append(tokens, strings.ToLower(text[start:i]))
+			start = -1
+		}
+	}
+	return tokens
+}
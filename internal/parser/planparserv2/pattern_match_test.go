@@ -1,6 +1,7 @@
 package planparserv2
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/proto/planpb"
@@ -138,3 +139,89 @@ func Test_translatePatternMatch(t *testing.T) {
 		})
 	}
 }
+
+func Test_translateCaseInsensitivePattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{pattern: "equal", want: "(?i)^equal$"},
+		{pattern: "prefix%", want: "(?i)^prefix"},
+		{pattern: "%infix%", want: "(?i)infix"},
+		{pattern: "%%postfix", want: "(?i)postfix$"},
+		{pattern: "a%b", want: "(?i)^a.*b$"},
+		{pattern: "a.b+c%", want: "(?i)^a\\.b\\+c"},
+		{pattern: "100\\%", want: "(?i)^100%$"},
+		{pattern: "%", want: "(?i)"},
+		{pattern: "", want: "(?i)^$"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			if got := translateCaseInsensitivePattern(tt.pattern); got != tt.want {
+				t.Errorf("translateCaseInsensitivePattern(%s) = %v, want %v", tt.pattern, got, tt.want)
+			}
+			if err := validateRegexPattern(translateCaseInsensitivePattern(tt.pattern)); err != nil {
+				t.Errorf("validateRegexPattern(%s) = %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func Test_validateRegexPattern(t *testing.T) {
+	valid := []string{
+		"^error: .*timeout$",
+		"[0-9]+ms",
+		"(?i)^warn",
+		"(?:ab|cd){2,}",
+		"a{3}b{1,2}",
+		`\d+\.\d+`,
+		`\x41\/`,
+		"[[:alpha:]_]+",
+		"[]a]",
+		"[^]a]",
+		"连接",
+	}
+	for _, pattern := range valid {
+		if err := validateRegexPattern(pattern); err != nil {
+			t.Errorf("validateRegexPattern(%s) = %v, want nil", pattern, err)
+		}
+	}
+
+	invalid := []string{
+		"[0-9",
+		"(?s)a.b",
+		"a(?i)b",
+		"(?P<name>a)",
+		`\pL+`,
+		`a\z`,
+		`\Aa`,
+		`\Qa.b\E`,
+		`\x{41}`,
+		"x{,3}",
+		"a{b",
+		"[é]",
+	}
+	for _, pattern := range invalid {
+		if err := validateRegexPattern(pattern); err == nil {
+			t.Errorf("validateRegexPattern(%s) = nil, want error", pattern)
+		}
+	}
+}
+
+func Test_tokenizeText(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{text: "Connection refused", want: []string{"connection", "refused"}},
+		{text: "dial tcp:10.0.0.1", want: []string{"dial", "tcp", "10", "0", "0", "1"}},
+		{text: "snake_case-word", want: []string{"snake", "case", "word"}},
+		{text: "!!!", want: nil},
+		{text: "连接 ok", want: []string{"ok"}},
+	}
+	for _, tt := range tests {
+		if got := tokenizeText(tt.text); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("tokenizeText(%s) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+}
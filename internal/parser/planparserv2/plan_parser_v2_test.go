@@ -133,6 +133,133 @@ func TestExpr_Like(t *testing.T) {
 	}
 }
 
+func TestExpr_ILike(t *testing.T) {
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	exprStrs := []string{
+		`VarCharField ilike "prefix%"`,
+		`VarCharField ILIKE "%infix%"`,
+		`VarCharField ilike "equal"`,
+	}
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+
+	expr, err := ParseExpr(helper, `VarCharField ilike "a.b%"`)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.OpType_RegexMatch, expr.GetUnaryRangeExpr().GetOp())
+	assert.Equal(t, `(?i)^a\.b`, expr.GetUnaryRangeExpr().GetValue().GetStringVal())
+
+	invalidExprs := []string{
+		`Int64Field ilike "prefix%"`,
+		`VarCharField ilike 1`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, helper, exprStr)
+	}
+}
+
+func TestExpr_RegexMatch(t *testing.T) {
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	exprStrs := []string{
+		`regex_match(VarCharField, "^error: .*timeout$")`,
+		`REGEX_MATCH(StringField, "[0-9]+")`,
+		`regex_match(VarCharField, "a|b") && Int64Field > 10`,
+	}
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+
+	expr, err := ParseExpr(helper, `regex_match(VarCharField, "[0-9]+")`)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.OpType_RegexMatch, expr.GetUnaryRangeExpr().GetOp())
+	assert.Equal(t, "[0-9]+", expr.GetUnaryRangeExpr().GetValue().GetStringVal())
+
+	invalidExprs := []string{
+		`regex_match(Int64Field, "[0-9]+")`,
+		`regex_match(NotExistField, "[0-9]+")`,
+		`regex_match(VarCharField, "[0-9")`,
+		`regex_match(VarCharField, 1)`,
+		`regex_match("str", "[0-9]+")`,
+		`regex_match(VarCharField, "(?s)a.b")`,
+		`regex_match(VarCharField, "(?P<n>a)")`,
+		`regex_match(VarCharField, "\\pL+")`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, helper, exprStr)
+	}
+}
+
+func TestExpr_TextMatch(t *testing.T) {
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	exprStrs := []string{
+		`text_match(VarCharField, "connection refused")`,
+		`TEXT_MATCH(StringField, "timeout")`,
+		`not text_match(VarCharField, "debug")`,
+	}
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+
+	expr, err := ParseExpr(helper, `text_match(VarCharField, "connection refused")`)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.OpType_TextMatch, expr.GetUnaryRangeExpr().GetOp())
+	assert.Equal(t, "connection refused", expr.GetUnaryRangeExpr().GetValue().GetStringVal())
+
+	invalidExprs := []string{
+		`text_match(Int64Field, "timeout")`,
+		`text_match(VarCharField, "  ")`,
+		`text_match(VarCharField, "!!!")`,
+		`text_match(VarCharField)`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, helper, exprStr)
+	}
+}
+
+func TestExpr_IsNull(t *testing.T) {
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	exprStrs := []string{
+		`Int64Field is null`,
+		`VarCharField IS NOT NULL`,
+		`FloatField is not null and Int64Field > 10`,
+		`not (BoolField is null)`,
+	}
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+
+	expr, err := ParseExpr(helper, `Int64Field is null`)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.NullExpr_IsNull, expr.GetNullExpr().GetOp())
+	assert.Equal(t, schemapb.DataType_Int64, expr.GetNullExpr().GetColumnInfo().GetDataType())
+
+	expr, err = ParseExpr(helper, `VarCharField is not null`)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.NullExpr_IsNotNull, expr.GetNullExpr().GetOp())
+
+	invalidExprs := []string{
+		`FloatVectorField is null`,
+		`Int64Field + 1 is null`,
+		`10 is not null`,
+		`Int64Field is`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, helper, exprStr)
+	}
+}
+
 func TestExpr_BinaryRange(t *testing.T) {
 	schema := newTestSchema()
 	helper, err := typeutil.CreateSchemaHelper(schema)
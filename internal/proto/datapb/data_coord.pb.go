@@ -3818,17 +3818,18 @@ func (m *DropVirtualChannelResponse) GetStatus() *commonpb.Status {
 }
 
 type ImportTask struct {
-	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	CollectionId         int64                    `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
-	PartitionId          int64                    `protobuf:"varint,3,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
-	ChannelNames         []string                 `protobuf:"bytes,4,rep,name=channel_names,json=channelNames,proto3" json:"channel_names,omitempty"`
-	RowBased             bool                     `protobuf:"varint,5,opt,name=row_based,json=rowBased,proto3" json:"row_based,omitempty"`
-	TaskId               int64                    `protobuf:"varint,6,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	Files                []string                 `protobuf:"bytes,7,rep,name=files,proto3" json:"files,omitempty"`
-	Infos                []*commonpb.KeyValuePair `protobuf:"bytes,8,rep,name=infos,proto3" json:"infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	Status               *commonpb.Status               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CollectionId         int64                          `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PartitionId          int64                          `protobuf:"varint,3,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	ChannelNames         []string                       `protobuf:"bytes,4,rep,name=channel_names,json=channelNames,proto3" json:"channel_names,omitempty"`
+	RowBased             bool                           `protobuf:"varint,5,opt,name=row_based,json=rowBased,proto3" json:"row_based,omitempty"`
+	TaskId               int64                          `protobuf:"varint,6,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Files                []string                       `protobuf:"bytes,7,rep,name=files,proto3" json:"files,omitempty"`
+	Infos                []*commonpb.KeyValuePair       `protobuf:"bytes,8,rep,name=infos,proto3" json:"infos,omitempty"`
+	Checkpoints          []*internalpb.ImportCheckpoint `protobuf:"bytes,9,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
 func (m *ImportTask) Reset()         { *m = ImportTask{} }
@@ -3912,15 +3913,24 @@ func (m *ImportTask) GetInfos() []*commonpb.KeyValuePair {
 	return nil
 }
 
+func (m *ImportTask) GetCheckpoints() []*internalpb.ImportCheckpoint {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
 type ImportTaskState struct {
-	StateCode            commonpb.ImportState `protobuf:"varint,1,opt,name=stateCode,proto3,enum=milvus.proto.common.ImportState" json:"stateCode,omitempty"`
-	Segments             []int64              `protobuf:"varint,2,rep,packed,name=segments,proto3" json:"segments,omitempty"`
-	RowIds               []int64              `protobuf:"varint,3,rep,packed,name=row_ids,json=rowIds,proto3" json:"row_ids,omitempty"`
-	RowCount             int64                `protobuf:"varint,4,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
-	ErrorMessage         string               `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	StateCode            commonpb.ImportState           `protobuf:"varint,1,opt,name=stateCode,proto3,enum=milvus.proto.common.ImportState" json:"stateCode,omitempty"`
+	Segments             []int64                        `protobuf:"varint,2,rep,packed,name=segments,proto3" json:"segments,omitempty"`
+	RowIds               []int64                        `protobuf:"varint,3,rep,packed,name=row_ids,json=rowIds,proto3" json:"row_ids,omitempty"`
+	RowCount             int64                          `protobuf:"varint,4,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	ErrorMessage         string                         `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	ValidationReport     string                         `protobuf:"bytes,6,opt,name=validation_report,json=validationReport,proto3" json:"validation_report,omitempty"`
+	Checkpoints          []*internalpb.ImportCheckpoint `protobuf:"bytes,7,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
 func (m *ImportTaskState) Reset()         { *m = ImportTaskState{} }
@@ -3983,6 +3993,20 @@ func (m *ImportTaskState) GetErrorMessage() string {
 	return ""
 }
 
+func (m *ImportTaskState) GetValidationReport() string {
+	if m != nil {
+		return m.ValidationReport
+	}
+	return ""
+}
+
+func (m *ImportTaskState) GetCheckpoints() []*internalpb.ImportCheckpoint {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
 type ImportTaskInfo struct {
 	Id                   int64                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	RequestId            int64                    `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"` // Deprecated: Do not use.
@@ -3999,6 +4023,9 @@ type ImportTaskInfo struct {
 	PartitionName        string                   `protobuf:"bytes,13,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
 	Infos                []*commonpb.KeyValuePair `protobuf:"bytes,14,rep,name=infos,proto3" json:"infos,omitempty"`
 	StartTs              int64                    `protobuf:"varint,15,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	ActiveTs             int64                    `protobuf:"varint,16,opt,name=active_ts,json=activeTs,proto3" json:"active_ts,omitempty"`
+	RetryCount           int32                    `protobuf:"varint,17,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	Priority             int32                    `protobuf:"varint,18,opt,name=priority,proto3" json:"priority,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -4135,6 +4162,27 @@ func (m *ImportTaskInfo) GetStartTs() int64 {
 	return 0
 }
 
+func (m *ImportTaskInfo) GetActiveTs() int64 {
+	if m != nil {
+		return m.ActiveTs
+	}
+	return 0
+}
+
+func (m *ImportTaskInfo) GetRetryCount() int32 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+func (m *ImportTaskInfo) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 type ImportTaskResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	DatanodeId           int64            `protobuf:"varint,2,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
@@ -4237,6 +4285,274 @@ func (m *ImportTaskRequest) GetWorkingNodes() []int64 {
 	return nil
 }
 
+type CancelImportTaskRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskId               int64             `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	DatanodeId           int64             `protobuf:"varint,3,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CancelImportTaskRequest) Reset()         { *m = CancelImportTaskRequest{} }
+func (m *CancelImportTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelImportTaskRequest) ProtoMessage()    {}
+func (*CancelImportTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{65}
+}
+
+func (m *CancelImportTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelImportTaskRequest.Unmarshal(m, b)
+}
+func (m *CancelImportTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelImportTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelImportTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelImportTaskRequest.Merge(m, src)
+}
+func (m *CancelImportTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelImportTaskRequest.Size(m)
+}
+func (m *CancelImportTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelImportTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelImportTaskRequest proto.InternalMessageInfo
+
+func (m *CancelImportTaskRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CancelImportTaskRequest) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *CancelImportTaskRequest) GetDatanodeId() int64 {
+	if m != nil {
+		return m.DatanodeId
+	}
+	return 0
+}
+
+type ExportTask struct {
+	TaskId               int64                    `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	CollectionId         int64                    `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PartitionIds         []int64                  `protobuf:"varint,3,rep,packed,name=partition_ids,json=partitionIds,proto3" json:"partition_ids,omitempty"`
+	Timestamp            uint64                   `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	FileType             string                   `protobuf:"bytes,5,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`
+	Bucket               string                   `protobuf:"bytes,6,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Path                 string                   `protobuf:"bytes,7,opt,name=path,proto3" json:"path,omitempty"`
+	Infos                []*commonpb.KeyValuePair `protobuf:"bytes,8,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ExportTask) Reset()         { *m = ExportTask{} }
+func (m *ExportTask) String() string { return proto.CompactTextString(m) }
+func (*ExportTask) ProtoMessage()    {}
+func (*ExportTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{66}
+}
+
+func (m *ExportTask) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTask.Unmarshal(m, b)
+}
+func (m *ExportTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTask.Marshal(b, m, deterministic)
+}
+func (m *ExportTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTask.Merge(m, src)
+}
+func (m *ExportTask) XXX_Size() int {
+	return xxx_messageInfo_ExportTask.Size(m)
+}
+func (m *ExportTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTask proto.InternalMessageInfo
+
+func (m *ExportTask) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *ExportTask) GetCollectionId() int64 {
+	if m != nil {
+		return m.CollectionId
+	}
+	return 0
+}
+
+func (m *ExportTask) GetPartitionIds() []int64 {
+	if m != nil {
+		return m.PartitionIds
+	}
+	return nil
+}
+
+func (m *ExportTask) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ExportTask) GetFileType() string {
+	if m != nil {
+		return m.FileType
+	}
+	return ""
+}
+
+func (m *ExportTask) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *ExportTask) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ExportTask) GetInfos() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type ExportTaskRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ExportTask           *ExportTask       `protobuf:"bytes,2,opt,name=export_task,json=exportTask,proto3" json:"export_task,omitempty"`
+	WorkingNodes         []int64           `protobuf:"varint,3,rep,packed,name=working_nodes,json=workingNodes,proto3" json:"working_nodes,omitempty"`
+	Segments             []*SegmentInfo    `protobuf:"bytes,4,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ExportTaskRequest) Reset()         { *m = ExportTaskRequest{} }
+func (m *ExportTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportTaskRequest) ProtoMessage()    {}
+func (*ExportTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{67}
+}
+
+func (m *ExportTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTaskRequest.Unmarshal(m, b)
+}
+func (m *ExportTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTaskRequest.Merge(m, src)
+}
+func (m *ExportTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportTaskRequest.Size(m)
+}
+func (m *ExportTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTaskRequest proto.InternalMessageInfo
+
+func (m *ExportTaskRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ExportTaskRequest) GetExportTask() *ExportTask {
+	if m != nil {
+		return m.ExportTask
+	}
+	return nil
+}
+
+func (m *ExportTaskRequest) GetWorkingNodes() []int64 {
+	if m != nil {
+		return m.WorkingNodes
+	}
+	return nil
+}
+
+func (m *ExportTaskRequest) GetSegments() []*SegmentInfo {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type ExportTaskResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DatanodeId           int64            `protobuf:"varint,2,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
+	TotalSegments        int64            `protobuf:"varint,3,opt,name=total_segments,json=totalSegments,proto3" json:"total_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ExportTaskResponse) Reset()         { *m = ExportTaskResponse{} }
+func (m *ExportTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportTaskResponse) ProtoMessage()    {}
+func (*ExportTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{68}
+}
+
+func (m *ExportTaskResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTaskResponse.Unmarshal(m, b)
+}
+func (m *ExportTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTaskResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTaskResponse.Merge(m, src)
+}
+func (m *ExportTaskResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportTaskResponse.Size(m)
+}
+func (m *ExportTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTaskResponse proto.InternalMessageInfo
+
+func (m *ExportTaskResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ExportTaskResponse) GetDatanodeId() int64 {
+	if m != nil {
+		return m.DatanodeId
+	}
+	return 0
+}
+
+func (m *ExportTaskResponse) GetTotalSegments() int64 {
+	if m != nil {
+		return m.TotalSegments
+	}
+	return 0
+}
+
 type UpdateSegmentStatisticsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Stats                []*SegmentStats   `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
@@ -4249,7 +4565,7 @@ func (m *UpdateSegmentStatisticsRequest) Reset()         { *m = UpdateSegmentSta
 func (m *UpdateSegmentStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateSegmentStatisticsRequest) ProtoMessage()    {}
 func (*UpdateSegmentStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{65}
+	return fileDescriptor_82cd95f524594f49, []int{69}
 }
 
 func (m *UpdateSegmentStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -4297,7 +4613,7 @@ func (m *UpdateChannelCheckpointRequest) Reset()         { *m = UpdateChannelChe
 func (m *UpdateChannelCheckpointRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateChannelCheckpointRequest) ProtoMessage()    {}
 func (*UpdateChannelCheckpointRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{66}
+	return fileDescriptor_82cd95f524594f49, []int{70}
 }
 
 func (m *UpdateChannelCheckpointRequest) XXX_Unmarshal(b []byte) error {
@@ -4350,7 +4666,7 @@ func (m *ResendSegmentStatsRequest) Reset()         { *m = ResendSegmentStatsReq
 func (m *ResendSegmentStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*ResendSegmentStatsRequest) ProtoMessage()    {}
 func (*ResendSegmentStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{67}
+	return fileDescriptor_82cd95f524594f49, []int{71}
 }
 
 func (m *ResendSegmentStatsRequest) XXX_Unmarshal(b []byte) error {
@@ -4390,7 +4706,7 @@ func (m *ResendSegmentStatsResponse) Reset()         { *m = ResendSegmentStatsRe
 func (m *ResendSegmentStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*ResendSegmentStatsResponse) ProtoMessage()    {}
 func (*ResendSegmentStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{68}
+	return fileDescriptor_82cd95f524594f49, []int{72}
 }
 
 func (m *ResendSegmentStatsResponse) XXX_Unmarshal(b []byte) error {
@@ -4442,7 +4758,7 @@ func (m *AddImportSegmentRequest) Reset()         { *m = AddImportSegmentRequest
 func (m *AddImportSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*AddImportSegmentRequest) ProtoMessage()    {}
 func (*AddImportSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{69}
+	return fileDescriptor_82cd95f524594f49, []int{73}
 }
 
 func (m *AddImportSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -4524,7 +4840,7 @@ func (m *AddImportSegmentResponse) Reset()         { *m = AddImportSegmentRespon
 func (m *AddImportSegmentResponse) String() string { return proto.CompactTextString(m) }
 func (*AddImportSegmentResponse) ProtoMessage()    {}
 func (*AddImportSegmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{70}
+	return fileDescriptor_82cd95f524594f49, []int{74}
 }
 
 func (m *AddImportSegmentResponse) XXX_Unmarshal(b []byte) error {
@@ -4577,7 +4893,7 @@ func (m *SaveImportSegmentRequest) Reset()         { *m = SaveImportSegmentReque
 func (m *SaveImportSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*SaveImportSegmentRequest) ProtoMessage()    {}
 func (*SaveImportSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{71}
+	return fileDescriptor_82cd95f524594f49, []int{75}
 }
 
 func (m *SaveImportSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -4666,7 +4982,7 @@ func (m *UnsetIsImportingStateRequest) Reset()         { *m = UnsetIsImportingSt
 func (m *UnsetIsImportingStateRequest) String() string { return proto.CompactTextString(m) }
 func (*UnsetIsImportingStateRequest) ProtoMessage()    {}
 func (*UnsetIsImportingStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{72}
+	return fileDescriptor_82cd95f524594f49, []int{76}
 }
 
 func (m *UnsetIsImportingStateRequest) XXX_Unmarshal(b []byte) error {
@@ -4713,7 +5029,7 @@ func (m *MarkSegmentsDroppedRequest) Reset()         { *m = MarkSegmentsDroppedR
 func (m *MarkSegmentsDroppedRequest) String() string { return proto.CompactTextString(m) }
 func (*MarkSegmentsDroppedRequest) ProtoMessage()    {}
 func (*MarkSegmentsDroppedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{73}
+	return fileDescriptor_82cd95f524594f49, []int{77}
 }
 
 func (m *MarkSegmentsDroppedRequest) XXX_Unmarshal(b []byte) error {
@@ -4761,7 +5077,7 @@ func (m *SegmentReferenceLock) Reset()         { *m = SegmentReferenceLock{} }
 func (m *SegmentReferenceLock) String() string { return proto.CompactTextString(m) }
 func (*SegmentReferenceLock) ProtoMessage()    {}
 func (*SegmentReferenceLock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{74}
+	return fileDescriptor_82cd95f524594f49, []int{78}
 }
 
 func (m *SegmentReferenceLock) XXX_Unmarshal(b []byte) error {
@@ -4818,7 +5134,7 @@ func (m *AlterCollectionRequest) Reset()         { *m = AlterCollectionRequest{}
 func (m *AlterCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*AlterCollectionRequest) ProtoMessage()    {}
 func (*AlterCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{75}
+	return fileDescriptor_82cd95f524594f49, []int{79}
 }
 
 func (m *AlterCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -4943,6 +5259,10 @@ func init() {
 	proto.RegisterType((*ImportTaskInfo)(nil), "milvus.proto.data.ImportTaskInfo")
 	proto.RegisterType((*ImportTaskResponse)(nil), "milvus.proto.data.ImportTaskResponse")
 	proto.RegisterType((*ImportTaskRequest)(nil), "milvus.proto.data.ImportTaskRequest")
+	proto.RegisterType((*CancelImportTaskRequest)(nil), "milvus.proto.data.CancelImportTaskRequest")
+	proto.RegisterType((*ExportTask)(nil), "milvus.proto.data.ExportTask")
+	proto.RegisterType((*ExportTaskRequest)(nil), "milvus.proto.data.ExportTaskRequest")
+	proto.RegisterType((*ExportTaskResponse)(nil), "milvus.proto.data.ExportTaskResponse")
 	proto.RegisterType((*UpdateSegmentStatisticsRequest)(nil), "milvus.proto.data.UpdateSegmentStatisticsRequest")
 	proto.RegisterType((*UpdateChannelCheckpointRequest)(nil), "milvus.proto.data.UpdateChannelCheckpointRequest")
 	proto.RegisterType((*ResendSegmentStatsRequest)(nil), "milvus.proto.data.ResendSegmentStatsRequest")
@@ -4959,283 +5279,300 @@ func init() {
 func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
 
 var fileDescriptor_82cd95f524594f49 = []byte{
-	// 4414 bytes of a gzipped FileDescriptorProto
+	// 4683 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x4b, 0x8f, 0x1c, 0x49,
-	0x5a, 0xce, 0x7a, 0x75, 0xd5, 0x57, 0x8f, 0xae, 0x0e, 0x7b, 0xda, 0xe5, 0x1a, 0xbf, 0x26, 0x3d,
-	0x9e, 0xf1, 0x78, 0x3c, 0xf6, 0x8c, 0x87, 0xd1, 0x0e, 0x78, 0x67, 0x56, 0x6e, 0xf7, 0xb4, 0xa7,
-	0xa0, 0xbb, 0xb7, 0x37, 0xbb, 0x3d, 0x96, 0x76, 0x91, 0x4a, 0xd9, 0x95, 0xd1, 0xd5, 0xb9, 0x5d,
-	0x99, 0x59, 0xce, 0xcc, 0xea, 0x76, 0x2f, 0x87, 0x1d, 0xb1, 0x12, 0x68, 0x11, 0x62, 0x11, 0x12,
-	0x02, 0x0e, 0x48, 0x88, 0xd3, 0xb2, 0x68, 0x11, 0xd2, 0x8a, 0x0b, 0x17, 0xae, 0x23, 0x38, 0xac,
-	0x10, 0x12, 0x3f, 0x80, 0x03, 0x70, 0xe7, 0xca, 0x01, 0xc5, 0x23, 0x23, 0x5f, 0x91, 0x55, 0xd9,
-	0x55, 0xf6, 0x18, 0xc1, 0xad, 0xe2, 0xcb, 0x2f, 0xe2, 0x8b, 0xc7, 0xf7, 0x8e, 0x2f, 0x0a, 0xda,
-	0x86, 0xee, 0xeb, 0xfd, 0x81, 0xe3, 0xb8, 0xc6, 0xdd, 0xb1, 0xeb, 0xf8, 0x0e, 0x5a, 0xb1, 0xcc,
-	0xd1, 0xf1, 0xc4, 0x63, 0xad, 0xbb, 0xe4, 0x73, 0xb7, 0x31, 0x70, 0x2c, 0xcb, 0xb1, 0x19, 0xa8,
-	0xdb, 0x32, 0x6d, 0x1f, 0xbb, 0xb6, 0x3e, 0xe2, 0xed, 0x46, 0xb4, 0x43, 0xb7, 0xe1, 0x0d, 0x0e,
-	0xb1, 0xa5, 0xb3, 0x96, 0xba, 0x04, 0xe5, 0xcf, 0xac, 0xb1, 0x7f, 0xaa, 0xfe, 0xa9, 0x02, 0x8d,
-	0x8d, 0xd1, 0xc4, 0x3b, 0xd4, 0xf0, 0xb3, 0x09, 0xf6, 0x7c, 0xf4, 0x3e, 0x94, 0xf6, 0x75, 0x0f,
-	0x77, 0x94, 0xeb, 0xca, 0xad, 0xfa, 0xfd, 0xcb, 0x77, 0x63, 0x54, 0x39, 0xbd, 0x2d, 0x6f, 0xb8,
-	0xa6, 0x7b, 0x58, 0xa3, 0x98, 0x08, 0x41, 0xc9, 0xd8, 0xef, 0xad, 0x77, 0x0a, 0xd7, 0x95, 0x5b,
-	0x45, 0x8d, 0xfe, 0x46, 0x57, 0x01, 0x3c, 0x3c, 0xb4, 0xb0, 0xed, 0xf7, 0xd6, 0xbd, 0x4e, 0xf1,
-	0x7a, 0xf1, 0x56, 0x51, 0x8b, 0x40, 0x90, 0x0a, 0x8d, 0x81, 0x33, 0x1a, 0xe1, 0x81, 0x6f, 0x3a,
-	0x76, 0x6f, 0xbd, 0x53, 0xa2, 0x7d, 0x63, 0x30, 0xf5, 0xdf, 0x15, 0x68, 0xf2, 0xa9, 0x79, 0x63,
-	0xc7, 0xf6, 0x30, 0xfa, 0x10, 0x2a, 0x9e, 0xaf, 0xfb, 0x13, 0x8f, 0xcf, 0xee, 0x75, 0xe9, 0xec,
-	0x76, 0x29, 0x8a, 0xc6, 0x51, 0xa5, 0xd3, 0x4b, 0x92, 0x2f, 0xa6, 0xc9, 0x27, 0x96, 0x50, 0x4a,
-	0x2d, 0xe1, 0x16, 0x2c, 0x1f, 0x90, 0xd9, 0xed, 0x86, 0x48, 0x65, 0x8a, 0x94, 0x04, 0x93, 0x91,
-	0x7c, 0xd3, 0xc2, 0xdf, 0x3e, 0xd8, 0xc5, 0xfa, 0xa8, 0x53, 0xa1, 0xb4, 0x22, 0x10, 0xf5, 0x9f,
-	0x15, 0x68, 0x0b, 0xf4, 0xe0, 0x1c, 0x2e, 0x40, 0x79, 0xe0, 0x4c, 0x6c, 0x9f, 0x2e, 0xb5, 0xa9,
-	0xb1, 0x06, 0x7a, 0x03, 0x1a, 0x83, 0x43, 0xdd, 0xb6, 0xf1, 0xa8, 0x6f, 0xeb, 0x16, 0xa6, 0x8b,
-	0xaa, 0x69, 0x75, 0x0e, 0xdb, 0xd6, 0x2d, 0x9c, 0x6b, 0x6d, 0xd7, 0xa1, 0x3e, 0xd6, 0x5d, 0xdf,
-	0x8c, 0xed, 0x7e, 0x14, 0x84, 0xba, 0x50, 0x35, 0xbd, 0x9e, 0x35, 0x76, 0x5c, 0xbf, 0x53, 0xbe,
-	0xae, 0xdc, 0xaa, 0x6a, 0xa2, 0x4d, 0x28, 0x98, 0xf4, 0xd7, 0x9e, 0xee, 0x1d, 0xf5, 0xd6, 0xf9,
-	0x8a, 0x62, 0x30, 0xf5, 0x2f, 0x14, 0x58, 0x7d, 0xe8, 0x79, 0xe6, 0xd0, 0x4e, 0xad, 0x6c, 0x15,
-	0x2a, 0xb6, 0x63, 0xe0, 0xde, 0x3a, 0x5d, 0x5a, 0x51, 0xe3, 0x2d, 0xf4, 0x3a, 0xd4, 0xc6, 0x18,
-	0xbb, 0x7d, 0xd7, 0x19, 0x05, 0x0b, 0xab, 0x12, 0x80, 0xe6, 0x8c, 0x30, 0xfa, 0x0e, 0xac, 0x78,
-	0x89, 0x81, 0x18, 0x5f, 0xd5, 0xef, 0xdf, 0xb8, 0x9b, 0x92, 0x8c, 0xbb, 0x49, 0xa2, 0x5a, 0xba,
-	0xb7, 0xfa, 0x65, 0x01, 0xce, 0x0b, 0x3c, 0x36, 0x57, 0xf2, 0x9b, 0xec, 0xbc, 0x87, 0x87, 0x62,
-	0x7a, 0xac, 0x91, 0x67, 0xe7, 0xc5, 0x91, 0x15, 0xa3, 0x47, 0x96, 0x83, 0xd5, 0x93, 0xe7, 0x51,
-	0x4e, 0x9f, 0xc7, 0x35, 0xa8, 0xe3, 0xe7, 0x63, 0xd3, 0xc5, 0x7d, 0xc2, 0x38, 0x74, 0xcb, 0x4b,
-	0x1a, 0x30, 0xd0, 0x9e, 0x69, 0x45, 0x65, 0x63, 0x29, 0xb7, 0x6c, 0xa8, 0x7f, 0xa9, 0xc0, 0xc5,
-	0xd4, 0x29, 0x71, 0x61, 0xd3, 0xa0, 0x4d, 0x57, 0x1e, 0xee, 0x0c, 0x11, 0x3b, 0xb2, 0xe1, 0x6f,
-	0x4d, 0xdb, 0xf0, 0x10, 0x5d, 0x4b, 0xf5, 0x8f, 0x4c, 0xb2, 0x90, 0x7f, 0x92, 0x47, 0x70, 0xf1,
-	0x31, 0xf6, 0x39, 0x01, 0xf2, 0x0d, 0x7b, 0xf3, 0x2b, 0xab, 0xb8, 0x54, 0x17, 0x92, 0x52, 0xad,
-	0xfe, 0x6d, 0x41, 0xc8, 0x22, 0x25, 0xd5, 0xb3, 0x0f, 0x1c, 0x74, 0x19, 0x6a, 0x02, 0x85, 0x73,
-	0x45, 0x08, 0x40, 0xdf, 0x80, 0x32, 0x99, 0x29, 0x63, 0x89, 0xd6, 0xfd, 0x37, 0xe4, 0x6b, 0x8a,
-	0x8c, 0xa9, 0x31, 0x7c, 0xd4, 0x83, 0x96, 0xe7, 0xeb, 0xae, 0xdf, 0x1f, 0x3b, 0x1e, 0x3d, 0x67,
-	0xca, 0x38, 0xf5, 0xfb, 0x6a, 0x7c, 0x04, 0xa1, 0xd6, 0xb7, 0xbc, 0xe1, 0x0e, 0xc7, 0xd4, 0x9a,
-	0xb4, 0x67, 0xd0, 0x44, 0x9f, 0x41, 0x03, 0xdb, 0x46, 0x38, 0x50, 0x29, 0xf7, 0x40, 0x75, 0x6c,
-	0x1b, 0x62, 0x98, 0xf0, 0x7c, 0xca, 0xf9, 0xcf, 0xe7, 0xf7, 0x15, 0xe8, 0xa4, 0x0f, 0x68, 0x11,
-	0x95, 0xfd, 0x80, 0x75, 0xc2, 0xec, 0x80, 0xa6, 0x4a, 0xb8, 0x38, 0x24, 0x8d, 0x77, 0x51, 0xff,
-	0x58, 0x81, 0xd7, 0xc2, 0xe9, 0xd0, 0x4f, 0x2f, 0x8b, 0x5b, 0xd0, 0x6d, 0x68, 0x9b, 0xf6, 0x60,
-	0x34, 0x31, 0xf0, 0x13, 0xfb, 0x73, 0xac, 0x8f, 0xfc, 0xc3, 0x53, 0x7a, 0x86, 0x55, 0x2d, 0x05,
-	0x57, 0x7f, 0xa4, 0xc0, 0x6a, 0x72, 0x5e, 0x8b, 0x6c, 0xd2, 0xaf, 0x40, 0xd9, 0xb4, 0x0f, 0x9c,
-	0x60, 0x8f, 0xae, 0x4e, 0x11, 0x4a, 0x42, 0x8b, 0x21, 0xab, 0x16, 0xbc, 0xfe, 0x18, 0xfb, 0x3d,
-	0xdb, 0xc3, 0xae, 0xbf, 0x66, 0xda, 0x23, 0x67, 0xb8, 0xa3, 0xfb, 0x87, 0x0b, 0x08, 0x54, 0x4c,
-	0x36, 0x0a, 0x09, 0xd9, 0x50, 0x7f, 0xaa, 0xc0, 0x65, 0x39, 0x3d, 0xbe, 0xf4, 0x2e, 0x54, 0x0f,
-	0x4c, 0x3c, 0x32, 0xc8, 0xfe, 0x2a, 0x74, 0x7f, 0x45, 0x9b, 0x08, 0xd6, 0x98, 0x20, 0xf3, 0x15,
-	0xbe, 0x91, 0xc1, 0xcd, 0xbb, 0xbe, 0x6b, 0xda, 0xc3, 0x4d, 0xd3, 0xf3, 0x35, 0x86, 0x1f, 0xd9,
-	0xcf, 0x62, 0x7e, 0x36, 0xfe, 0x3d, 0x05, 0xae, 0x3e, 0xc6, 0xfe, 0x23, 0xa1, 0x97, 0xc9, 0x77,
-	0xd3, 0xf3, 0xcd, 0x81, 0xf7, 0x62, 0x7d, 0xa3, 0x1c, 0x06, 0x5a, 0xfd, 0x89, 0x02, 0xd7, 0x32,
-	0x27, 0xc3, 0xb7, 0x8e, 0xeb, 0x9d, 0x40, 0x2b, 0xcb, 0xf5, 0xce, 0x6f, 0xe0, 0xd3, 0x2f, 0xf4,
-	0xd1, 0x04, 0xef, 0xe8, 0xa6, 0xcb, 0xf4, 0xce, 0x9c, 0x5a, 0xf8, 0xe7, 0x0a, 0x5c, 0x79, 0x8c,
-	0xfd, 0x9d, 0xc0, 0x26, 0xbd, 0xc2, 0xdd, 0x21, 0x38, 0x11, 0xdb, 0x18, 0x38, 0x67, 0x31, 0x98,
-	0xfa, 0x07, 0xec, 0x38, 0xa5, 0xf3, 0x7d, 0x25, 0x1b, 0x78, 0x95, 0x4a, 0x42, 0x44, 0x24, 0x1f,
-	0x31, 0xd7, 0x81, 0x6f, 0x9f, 0xfa, 0xe7, 0x0a, 0x5c, 0x7a, 0x38, 0x78, 0x36, 0x31, 0x5d, 0xcc,
-	0x91, 0x36, 0x9d, 0xc1, 0xd1, 0xfc, 0x9b, 0x1b, 0xba, 0x59, 0x85, 0x98, 0x9b, 0x35, 0xcb, 0x35,
-	0x5f, 0x85, 0x8a, 0xcf, 0xfc, 0x3a, 0xe6, 0xa9, 0xf0, 0x16, 0x9d, 0x9f, 0x86, 0x47, 0x58, 0xf7,
-	0xfe, 0x77, 0xce, 0xef, 0x27, 0x25, 0x68, 0x7c, 0xc1, 0xdd, 0x31, 0x6a, 0xb5, 0x93, 0x9c, 0xa4,
-	0xc8, 0x1d, 0xaf, 0x88, 0x07, 0x27, 0x73, 0xea, 0x1e, 0x43, 0xd3, 0xc3, 0xf8, 0x68, 0x1e, 0x1b,
-	0xdd, 0x20, 0x1d, 0x85, 0x6d, 0xdd, 0x84, 0x95, 0x89, 0x4d, 0x43, 0x03, 0x6c, 0xf0, 0x0d, 0x64,
-	0x9c, 0x3b, 0x5b, 0x77, 0xa7, 0x3b, 0xa2, 0xcf, 0x79, 0xf4, 0x11, 0x19, 0xab, 0x9c, 0x6b, 0xac,
-	0x64, 0x37, 0xd4, 0x83, 0xb6, 0xe1, 0x3a, 0xe3, 0x31, 0x36, 0xfa, 0x5e, 0x30, 0x54, 0x25, 0xdf,
-	0x50, 0xbc, 0x9f, 0x18, 0xea, 0x7d, 0x38, 0x9f, 0x9c, 0x69, 0xcf, 0x20, 0x0e, 0x29, 0x39, 0x43,
-	0xd9, 0x27, 0x74, 0x07, 0x56, 0xd2, 0xf8, 0x55, 0x8a, 0x9f, 0xfe, 0x80, 0xde, 0x03, 0x94, 0x98,
-	0x2a, 0x41, 0xaf, 0x31, 0xf4, 0xf8, 0x64, 0x7a, 0x86, 0xa7, 0xfe, 0x58, 0x81, 0xd5, 0xa7, 0xba,
-	0x3f, 0x38, 0x5c, 0xb7, 0xb8, 0xac, 0x2d, 0xa0, 0xab, 0x3e, 0x81, 0xda, 0x31, 0xe7, 0x8b, 0xc0,
-	0x20, 0x5d, 0x93, 0xec, 0x4f, 0x94, 0x03, 0xb5, 0xb0, 0x07, 0x89, 0x87, 0x2e, 0x6c, 0x44, 0xe2,
-	0xc2, 0x57, 0xa0, 0x35, 0x67, 0x04, 0xb4, 0xea, 0x73, 0x00, 0x3e, 0xb9, 0x2d, 0x6f, 0x38, 0xc7,
-	0xbc, 0x3e, 0x86, 0x25, 0x3e, 0x1a, 0x57, 0x8b, 0xb3, 0xf8, 0x27, 0x40, 0x57, 0x7f, 0x56, 0x81,
-	0x7a, 0xe4, 0x03, 0x6a, 0x41, 0x41, 0xc8, 0x6b, 0x41, 0xb2, 0xba, 0xc2, 0xec, 0x10, 0xaa, 0x98,
-	0x0e, 0xa1, 0x6e, 0x42, 0xcb, 0xa4, 0x7e, 0x48, 0x9f, 0x9f, 0x0a, 0x55, 0x20, 0x35, 0xad, 0xc9,
-	0xa0, 0x9c, 0x45, 0xd0, 0x55, 0xa8, 0xdb, 0x13, 0xab, 0xef, 0x1c, 0xf4, 0x5d, 0xe7, 0xc4, 0xe3,
-	0xb1, 0x58, 0xcd, 0x9e, 0x58, 0xdf, 0x3e, 0xd0, 0x9c, 0x13, 0x2f, 0x74, 0xf7, 0x2b, 0x67, 0x74,
-	0xf7, 0xaf, 0x42, 0xdd, 0xd2, 0x9f, 0x93, 0x51, 0xfb, 0xf6, 0xc4, 0xa2, 0x61, 0x5a, 0x51, 0xab,
-	0x59, 0xfa, 0x73, 0xcd, 0x39, 0xd9, 0x9e, 0x58, 0xe8, 0x16, 0xb4, 0x47, 0xba, 0xe7, 0xf7, 0xa3,
-	0x71, 0x5e, 0x95, 0xc6, 0x79, 0x2d, 0x02, 0xff, 0x2c, 0x8c, 0xf5, 0xd2, 0x81, 0x43, 0x6d, 0x81,
-	0xc0, 0xc1, 0xb0, 0x46, 0xe1, 0x40, 0x90, 0x3f, 0x70, 0x30, 0xac, 0x91, 0x18, 0xe6, 0x63, 0x58,
-	0xda, 0xa7, 0xde, 0x9d, 0xd7, 0xa9, 0x67, 0xea, 0x8e, 0x0d, 0xe2, 0xd8, 0x31, 0x27, 0x50, 0x0b,
-	0xd0, 0xd1, 0x37, 0xa1, 0x46, 0x8d, 0x2a, 0xed, 0xdb, 0xc8, 0xd5, 0x37, 0xec, 0x40, 0x7a, 0x1b,
-	0x78, 0xe4, 0xeb, 0xb4, 0x77, 0x33, 0x5f, 0x6f, 0xd1, 0x81, 0xe8, 0xab, 0x81, 0x8b, 0x75, 0x1f,
-	0x1b, 0x6b, 0xa7, 0x8f, 0x1c, 0x6b, 0xac, 0x53, 0x66, 0xea, 0xb4, 0xa8, 0x07, 0x2f, 0xfb, 0x84,
-	0xde, 0x82, 0xd6, 0x40, 0xb4, 0x36, 0x5c, 0xc7, 0xea, 0x2c, 0x53, 0x39, 0x4a, 0x40, 0xd1, 0x15,
-	0x80, 0x40, 0x53, 0xe9, 0x7e, 0xa7, 0x4d, 0x4f, 0xb1, 0xc6, 0x21, 0x0f, 0x69, 0x1a, 0xc7, 0xf4,
-	0xfa, 0x2c, 0x61, 0x62, 0xda, 0xc3, 0xce, 0x0a, 0xa5, 0x58, 0x0f, 0x32, 0x2c, 0xa6, 0x3d, 0x44,
-	0x17, 0x61, 0xc9, 0xf4, 0xfa, 0x07, 0xfa, 0x11, 0xee, 0x20, 0xfa, 0xb5, 0x62, 0x7a, 0x1b, 0xfa,
-	0x11, 0x56, 0x7f, 0x08, 0x17, 0x42, 0xee, 0x8a, 0x9c, 0x64, 0x9a, 0x29, 0x94, 0x79, 0x99, 0x62,
-	0xba, 0x4f, 0xff, 0xcb, 0x12, 0xac, 0xee, 0xea, 0xc7, 0xf8, 0xe5, 0x87, 0x0f, 0xb9, 0xd4, 0xda,
-	0x26, 0xac, 0xd0, 0x88, 0xe1, 0x7e, 0x64, 0x3e, 0x53, 0xec, 0x6a, 0x94, 0x15, 0xd2, 0x1d, 0xd1,
-	0xb7, 0x88, 0x43, 0x80, 0x07, 0x47, 0x3b, 0x8e, 0x19, 0xda, 0xd4, 0x2b, 0x92, 0x71, 0x1e, 0x09,
-	0x2c, 0x2d, 0xda, 0x03, 0xed, 0xc0, 0x72, 0xfc, 0x18, 0x02, 0x6b, 0xfa, 0xf6, 0xd4, 0x20, 0x36,
-	0xdc, 0x7d, 0xad, 0x15, 0x3b, 0x0c, 0x0f, 0x75, 0x60, 0x89, 0x9b, 0x42, 0xaa, 0x33, 0xaa, 0x5a,
-	0xd0, 0x44, 0x3b, 0x70, 0x9e, 0xad, 0x60, 0x97, 0x0b, 0x04, 0x5b, 0x7c, 0x35, 0xd7, 0xe2, 0x65,
-	0x5d, 0xe3, 0xf2, 0x54, 0x3b, 0xab, 0x3c, 0x75, 0x60, 0x89, 0xf3, 0x38, 0xd5, 0x23, 0x55, 0x2d,
-	0x68, 0x92, 0x63, 0x0e, 0xb9, 0xbd, 0x4e, 0xbf, 0x85, 0x00, 0x12, 0x7a, 0x41, 0xb8, 0x9f, 0x33,
-	0xd2, 0x2d, 0x9f, 0x42, 0x55, 0x70, 0x78, 0x21, 0x37, 0x87, 0x8b, 0x3e, 0x49, 0xfd, 0x5e, 0x4c,
-	0xe8, 0x77, 0xf5, 0x9f, 0x14, 0x68, 0xac, 0x93, 0x25, 0x6d, 0x3a, 0x43, 0x6a, 0x8d, 0x6e, 0x42,
-	0xcb, 0xc5, 0x03, 0xc7, 0x35, 0xfa, 0xd8, 0xf6, 0x5d, 0x13, 0xb3, 0x28, 0xbd, 0xa4, 0x35, 0x19,
-	0xf4, 0x33, 0x06, 0x24, 0x68, 0x44, 0x65, 0x7b, 0xbe, 0x6e, 0x8d, 0xfb, 0x07, 0x44, 0x35, 0x14,
-	0x18, 0x9a, 0x80, 0x52, 0xcd, 0xf0, 0x06, 0x34, 0x42, 0x34, 0xdf, 0xa1, 0xf4, 0x4b, 0x5a, 0x5d,
-	0xc0, 0xf6, 0x1c, 0xf4, 0x26, 0xb4, 0xe8, 0x9e, 0xf6, 0x47, 0xce, 0xb0, 0x4f, 0x22, 0x5a, 0x6e,
-	0xa8, 0x1a, 0x06, 0x9f, 0x16, 0x39, 0xab, 0x38, 0x96, 0x67, 0xfe, 0x00, 0x73, 0x53, 0x25, 0xb0,
-	0x76, 0xcd, 0x1f, 0x60, 0xf5, 0x1f, 0x15, 0x68, 0xae, 0xeb, 0xbe, 0xbe, 0xed, 0x18, 0x78, 0x6f,
-	0x4e, 0xc3, 0x9e, 0x23, 0xf5, 0x79, 0x19, 0x6a, 0x62, 0x05, 0x7c, 0x49, 0x21, 0x00, 0x6d, 0x40,
-	0x2b, 0x70, 0x2d, 0xfb, 0x2c, 0xe2, 0x2a, 0x65, 0x3a, 0x50, 0x11, 0xcb, 0xe9, 0x69, 0xcd, 0xa0,
-	0x1b, 0x6d, 0xaa, 0x1b, 0xd0, 0x88, 0x7e, 0x26, 0x54, 0x77, 0x93, 0x8c, 0x22, 0x00, 0x84, 0x1b,
-	0xb7, 0x27, 0x16, 0x39, 0x53, 0xae, 0x58, 0x82, 0xa6, 0xfa, 0x23, 0x05, 0x9a, 0xdc, 0xdc, 0xef,
-	0x8a, 0x4b, 0x02, 0xba, 0x34, 0x85, 0x2e, 0x8d, 0xfe, 0x46, 0xbf, 0x16, 0xcf, 0xeb, 0xbd, 0x29,
-	0x55, 0x02, 0x74, 0x10, 0xea, 0x64, 0xc6, 0x6c, 0x7d, 0x9e, 0x18, 0xff, 0x4b, 0xc2, 0x68, 0xfc,
-	0x68, 0x28, 0xa3, 0x75, 0x60, 0x49, 0x37, 0x0c, 0x17, 0x7b, 0x1e, 0x9f, 0x47, 0xd0, 0x24, 0x5f,
-	0x8e, 0xb1, 0xeb, 0x05, 0x2c, 0x5f, 0xd4, 0x82, 0x26, 0xfa, 0x26, 0x54, 0x85, 0x57, 0xca, 0xd2,
-	0xe1, 0xd7, 0xb3, 0xe7, 0xc9, 0x23, 0x52, 0xd1, 0x43, 0xfd, 0xbb, 0x02, 0xb4, 0xf8, 0x86, 0xad,
-	0x71, 0x7b, 0x3c, 0x5d, 0xf8, 0xd6, 0xa0, 0x71, 0x10, 0xca, 0xfe, 0xb4, 0xdc, 0x53, 0x54, 0x45,
-	0xc4, 0xfa, 0xcc, 0x12, 0xc0, 0xb8, 0x47, 0x50, 0x5a, 0xc8, 0x23, 0x28, 0x9f, 0x55, 0x83, 0xa5,
-	0x7d, 0xc4, 0x8a, 0xc4, 0x47, 0x54, 0x7f, 0x13, 0xea, 0x91, 0x01, 0xa8, 0x86, 0x66, 0x49, 0x2b,
-	0xbe, 0x63, 0x41, 0x13, 0x7d, 0x18, 0xfa, 0x45, 0x6c, 0xab, 0x2e, 0x49, 0xe6, 0x92, 0x70, 0x89,
-	0xd4, 0x7f, 0x50, 0xa0, 0xc2, 0x47, 0xbe, 0x06, 0x75, 0xae, 0x74, 0xa8, 0xcf, 0xc8, 0x46, 0x07,
-	0x0e, 0x22, 0x4e, 0xe3, 0x8b, 0xd3, 0x3a, 0x97, 0xa0, 0x9a, 0xd0, 0x37, 0x4b, 0xdc, 0x2c, 0x04,
-	0x9f, 0x22, 0x4a, 0x86, 0x7c, 0x22, 0xfa, 0x05, 0x5d, 0x80, 0xf2, 0xc8, 0x19, 0x8a, 0x4b, 0x20,
-	0xd6, 0x50, 0xbf, 0x52, 0x68, 0xce, 0x5e, 0xc3, 0x03, 0xe7, 0x18, 0xbb, 0xa7, 0x8b, 0x27, 0x3b,
-	0x1f, 0x44, 0xd8, 0x3c, 0x67, 0xf0, 0x25, 0x3a, 0xa0, 0x07, 0xe1, 0x21, 0x14, 0x65, 0x99, 0x9e,
-	0xa8, 0xde, 0xe1, 0x4c, 0x1a, 0x1e, 0xc6, 0x1f, 0xb2, 0xb4, 0x6d, 0x7c, 0x29, 0xf3, 0x7a, 0x3b,
-	0x2f, 0x24, 0x90, 0x51, 0x7f, 0xa9, 0x40, 0x37, 0x4c, 0x25, 0x79, 0x6b, 0xa7, 0x8b, 0x5e, 0x8a,
-	0xbc, 0x98, 0xf8, 0xea, 0x57, 0x45, 0xd6, 0x9e, 0x08, 0x6d, 0xae, 0xc8, 0x28, 0xc8, 0xd9, 0xdb,
-	0x34, 0x2b, 0x9d, 0x5e, 0xd0, 0x22, 0x2c, 0xd3, 0x85, 0xaa, 0xc8, 0x67, 0xb0, 0xcc, 0xbd, 0x68,
-	0x13, 0x09, 0xbb, 0xf4, 0x18, 0xfb, 0x1b, 0xf1, 0x54, 0xc8, 0xab, 0xde, 0xc0, 0xe8, 0x6d, 0xc2,
-	0x21, 0xbf, 0x4d, 0x28, 0x25, 0x6e, 0x13, 0x38, 0x5c, 0xb5, 0x28, 0x0b, 0xa4, 0x16, 0xf0, 0xb2,
-	0x36, 0xec, 0x77, 0x14, 0xe8, 0x70, 0x2a, 0x94, 0x26, 0x09, 0x89, 0x46, 0xd8, 0xc7, 0xc6, 0xd7,
-	0x9d, 0x2a, 0xf8, 0x6f, 0x05, 0xda, 0x51, 0xab, 0x4b, 0x0d, 0xe7, 0x47, 0x50, 0xa6, 0x99, 0x16,
-	0x3e, 0x83, 0x99, 0xaa, 0x81, 0x61, 0x13, 0xb5, 0x4d, 0x5d, 0xed, 0x3d, 0xe1, 0x20, 0xf0, 0x66,
-	0x68, 0xfa, 0x8b, 0x67, 0x37, 0xfd, 0xdc, 0x15, 0x72, 0x26, 0x64, 0x5c, 0x96, 0xa2, 0x0c, 0x01,
-	0xe8, 0x13, 0xa8, 0xb0, 0x42, 0x0c, 0x7e, 0xc3, 0x76, 0x33, 0x3e, 0x34, 0x2f, 0xd2, 0x88, 0xe4,
-	0xfd, 0x29, 0x40, 0xe3, 0x9d, 0xd4, 0x5f, 0x87, 0xd5, 0x30, 0x1a, 0x65, 0x64, 0xe7, 0x65, 0x5a,
-	0xf5, 0x5f, 0x15, 0x38, 0xbf, 0x7b, 0x6a, 0x0f, 0x92, 0xec, 0xbf, 0x0a, 0x95, 0xf1, 0x48, 0x0f,
-	0x33, 0xa6, 0xbc, 0x45, 0xdd, 0x40, 0x46, 0x1b, 0x1b, 0xc4, 0x86, 0xb0, 0x3d, 0xab, 0x0b, 0xd8,
-	0x9e, 0x33, 0xd3, 0xb4, 0xdf, 0x14, 0xe1, 0x33, 0x36, 0x98, 0xb5, 0x62, 0x69, 0xa8, 0xa6, 0x80,
-	0x52, 0x6b, 0xf5, 0x09, 0x00, 0x35, 0xe8, 0xfd, 0xb3, 0x18, 0x71, 0xda, 0x63, 0x93, 0xa8, 0xec,
-	0x5f, 0x14, 0xa0, 0x13, 0xd9, 0xa5, 0xaf, 0xdb, 0xbf, 0xc9, 0x88, 0xca, 0x8a, 0x2f, 0x28, 0x2a,
-	0x2b, 0x2d, 0xee, 0xd3, 0x94, 0x65, 0x3e, 0xcd, 0xbf, 0x15, 0xa0, 0x15, 0xee, 0xda, 0xce, 0x48,
-	0xb7, 0x33, 0x39, 0x61, 0x57, 0xf8, 0xf3, 0xf1, 0x7d, 0x7a, 0x57, 0x26, 0x27, 0x19, 0x07, 0xa1,
-	0x25, 0x86, 0x40, 0x57, 0xe8, 0xa1, 0xbb, 0x3e, 0x4b, 0x7c, 0xf1, 0x18, 0x82, 0x09, 0xa4, 0x69,
-	0x61, 0x74, 0x07, 0x10, 0x97, 0xa2, 0xbe, 0x69, 0xf7, 0x3d, 0x3c, 0x70, 0x6c, 0x83, 0xc9, 0x57,
-	0x59, 0x6b, 0xf3, 0x2f, 0x3d, 0x7b, 0x97, 0xc1, 0xd1, 0x47, 0x50, 0xf2, 0x4f, 0xc7, 0xcc, 0x5b,
-	0x69, 0x49, 0xed, 0x7d, 0x38, 0xaf, 0xbd, 0xd3, 0x31, 0xd6, 0x28, 0x7a, 0x50, 0xa9, 0xe3, 0xbb,
-	0xfa, 0x31, 0x77, 0xfd, 0x4a, 0x5a, 0x04, 0x42, 0x34, 0x46, 0xb0, 0x87, 0x4b, 0xcc, 0x45, 0xe2,
-	0x4d, 0xc6, 0xd9, 0x81, 0xd0, 0xf6, 0x7d, 0x7f, 0x44, 0x53, 0x77, 0x94, 0xb3, 0x03, 0xe8, 0x9e,
-	0x3f, 0x52, 0xff, 0xa5, 0x00, 0xed, 0x90, 0xb2, 0x86, 0xbd, 0xc9, 0x28, 0x5b, 0xe0, 0xa6, 0xe7,
-	0x46, 0x66, 0xc9, 0xda, 0xb7, 0xa0, 0xce, 0x8f, 0xfd, 0x0c, 0x6c, 0x03, 0xac, 0xcb, 0xe6, 0x14,
-	0x3e, 0x2e, 0xbf, 0x20, 0x3e, 0xae, 0xcc, 0x91, 0x5d, 0x90, 0x6f, 0xbe, 0xfa, 0x53, 0x05, 0x5e,
-	0x4b, 0xa9, 0xc5, 0xa9, 0x5b, 0x3b, 0x3d, 0xb6, 0xe3, 0xea, 0x32, 0x39, 0x24, 0x57, 0xf0, 0x0f,
-	0xa0, 0xe2, 0xd2, 0xd1, 0xf9, 0x55, 0xd0, 0x8d, 0xa9, 0xdc, 0xc5, 0x26, 0xa2, 0xf1, 0x2e, 0xea,
-	0x1f, 0x29, 0x70, 0x31, 0x3d, 0xd5, 0x05, 0xac, 0xf6, 0x1a, 0x2c, 0xb1, 0xa1, 0x03, 0x21, 0xbc,
-	0x35, 0x5d, 0x08, 0xc3, 0xcd, 0xd1, 0x82, 0x8e, 0xea, 0x2e, 0xac, 0x06, 0xc6, 0x3d, 0xdc, 0xfa,
-	0x2d, 0xec, 0xeb, 0x53, 0x22, 0x9b, 0x6b, 0x50, 0x67, 0x2e, 0x32, 0x8b, 0x18, 0x58, 0x4e, 0x00,
-	0xf6, 0x45, 0x2a, 0x4d, 0xfd, 0x4f, 0x05, 0x2e, 0x50, 0xeb, 0x98, 0xbc, 0x7b, 0xc9, 0x73, 0x2f,
-	0xa7, 0x8a, 0x94, 0xc3, 0xb6, 0x6e, 0xf1, 0x3a, 0x90, 0x9a, 0x16, 0x83, 0xa1, 0x5e, 0x3a, 0xd3,
-	0x26, 0x8d, 0x80, 0xc3, 0x8b, 0x5c, 0x12, 0x6d, 0xd3, 0x7b, 0xdc, 0x64, 0x8a, 0x2d, 0xb4, 0xca,
-	0xa5, 0x79, 0xac, 0xf2, 0x26, 0xbc, 0x96, 0x58, 0xe9, 0x02, 0x27, 0xaa, 0xfe, 0x95, 0x42, 0x8e,
-	0x23, 0x56, 0x4f, 0x33, 0xbf, 0x67, 0x7a, 0x45, 0x5c, 0xfa, 0xf4, 0x4d, 0x23, 0xa9, 0x44, 0x0c,
-	0xf4, 0x29, 0xd4, 0x6c, 0x7c, 0xd2, 0x8f, 0x3a, 0x3b, 0x39, 0xdc, 0xf6, 0xaa, 0x8d, 0x4f, 0xe8,
-	0x2f, 0x75, 0x1b, 0x2e, 0xa6, 0xa6, 0xba, 0xc8, 0xda, 0xff, 0x5e, 0x81, 0x4b, 0xeb, 0xae, 0x33,
-	0xfe, 0xc2, 0x74, 0xfd, 0x89, 0x3e, 0x8a, 0x5f, 0x91, 0xbf, 0x9c, 0xd4, 0xd5, 0xe7, 0x11, 0xb7,
-	0x97, 0xf1, 0xcf, 0x1d, 0x89, 0x04, 0xa5, 0x27, 0xc5, 0x17, 0x1d, 0x71, 0x92, 0xff, 0xa3, 0x28,
-	0x9b, 0x3c, 0xc7, 0x9b, 0xe1, 0x78, 0xe4, 0x89, 0x20, 0xa4, 0x99, 0xee, 0xe2, 0xbc, 0x99, 0xee,
-	0x0c, 0xf5, 0x5e, 0x7a, 0x41, 0xea, 0xfd, 0xcc, 0xa9, 0x97, 0xcf, 0x21, 0x7e, 0x0b, 0x41, 0xcd,
-	0xef, 0x5c, 0xd7, 0x17, 0x6b, 0x00, 0x61, 0x46, 0x9e, 0x97, 0x43, 0xe6, 0x19, 0x26, 0xd2, 0x8b,
-	0x9c, 0x96, 0x30, 0xa5, 0xdc, 0x94, 0x47, 0x72, 0xc4, 0xdf, 0x81, 0xae, 0x8c, 0x4b, 0x17, 0xe1,
-	0xfc, 0x5f, 0x14, 0x00, 0x7a, 0xa2, 0x82, 0x76, 0x3e, 0x5b, 0x70, 0x03, 0x22, 0xee, 0x46, 0x28,
-	0xef, 0x51, 0x2e, 0x32, 0x88, 0x48, 0x88, 0xa0, 0x93, 0xe0, 0xa4, 0x02, 0x51, 0x83, 0x8e, 0x13,
-	0x91, 0x1a, 0xc6, 0x14, 0x49, 0xf5, 0xfb, 0x3a, 0xd4, 0x5c, 0xe7, 0xa4, 0x4f, 0xc4, 0xcc, 0x08,
-	0x4a, 0x84, 0x5d, 0xe7, 0x84, 0x08, 0x9f, 0x81, 0x2e, 0xc2, 0x92, 0xaf, 0x7b, 0x47, 0x64, 0xfc,
-	0x4a, 0xa4, 0x4a, 0xc3, 0x40, 0x17, 0xa0, 0x7c, 0x60, 0x8e, 0x30, 0x2b, 0x0a, 0xa8, 0x69, 0xac,
-	0x81, 0xbe, 0x11, 0xd4, 0xb2, 0x55, 0x73, 0x57, 0xe2, 0xb0, 0x72, 0xb6, 0xaf, 0x14, 0x58, 0x0e,
-	0x77, 0x8d, 0x2a, 0x20, 0xa2, 0xd3, 0xa8, 0x3e, 0x7b, 0xe4, 0x18, 0x4c, 0x55, 0xb4, 0x32, 0x2c,
-	0x02, 0xeb, 0xc8, 0xb4, 0x56, 0xd8, 0x65, 0x5a, 0x1c, 0x4c, 0xd6, 0x45, 0x16, 0x6d, 0x1a, 0x41,
-	0x65, 0x4a, 0xc5, 0x75, 0x4e, 0x7a, 0x86, 0xd8, 0x0d, 0x56, 0xff, 0xcb, 0xa2, 0x3e, 0xb2, 0x1b,
-	0x8f, 0x68, 0x09, 0xf0, 0x0d, 0x68, 0x62, 0xd7, 0x75, 0xdc, 0xbe, 0x85, 0x3d, 0x4f, 0x1f, 0x62,
-	0xee, 0x80, 0x37, 0x28, 0x70, 0x8b, 0xc1, 0xd4, 0x3f, 0x29, 0x41, 0x2b, 0x5c, 0x4a, 0x70, 0x0f,
-	0x6e, 0x1a, 0xc1, 0x3d, 0xb8, 0x49, 0x8e, 0x0e, 0x5c, 0xa6, 0x0a, 0xc5, 0xe1, 0xae, 0x15, 0x3a,
-	0x8a, 0x56, 0xe3, 0xd0, 0x9e, 0x41, 0xcc, 0x32, 0x11, 0x32, 0xdb, 0x31, 0x70, 0x78, 0xb8, 0x10,
-	0x80, 0xf8, 0xd9, 0xc6, 0x78, 0xa4, 0x94, 0x83, 0x47, 0xca, 0x39, 0x78, 0xa4, 0x22, 0xe1, 0x91,
-	0x55, 0xa8, 0xec, 0x4f, 0x06, 0x47, 0xd8, 0xe7, 0x1e, 0x1b, 0x6f, 0xc5, 0x79, 0xa7, 0x9a, 0xe0,
-	0x1d, 0xc1, 0x22, 0xb5, 0x28, 0x8b, 0xbc, 0x0e, 0x35, 0x76, 0x21, 0xdb, 0xf7, 0x3d, 0x7a, 0xbb,
-	0x54, 0xd4, 0xaa, 0x0c, 0xb0, 0xe7, 0xa1, 0x8f, 0x03, 0x77, 0xae, 0x2e, 0x13, 0x76, 0xaa, 0x75,
-	0x12, 0x5c, 0x12, 0x38, 0x73, 0x6f, 0xc3, 0x72, 0x64, 0x3b, 0xa8, 0x8d, 0x68, 0xd0, 0xa9, 0x46,
-	0xdc, 0x79, 0x6a, 0x26, 0x6e, 0x42, 0x2b, 0xdc, 0x12, 0x8a, 0xd7, 0x64, 0x51, 0x94, 0x80, 0x52,
-	0x34, 0xc1, 0xc9, 0xad, 0xb3, 0x71, 0x32, 0xba, 0x04, 0x55, 0x1e, 0xfe, 0x78, 0x9d, 0xe5, 0x58,
-	0x36, 0x42, 0xfd, 0x3e, 0xa0, 0x70, 0xf6, 0x8b, 0x79, 0x8b, 0x09, 0xf6, 0x28, 0x24, 0xd9, 0x43,
-	0xfd, 0x99, 0x02, 0x2b, 0x51, 0x62, 0xf3, 0x1a, 0xde, 0x4f, 0xa1, 0xce, 0xee, 0xf7, 0xfa, 0x44,
-	0xf0, 0x79, 0x96, 0xe7, 0xca, 0xd4, 0x73, 0xd1, 0x20, 0x7c, 0x41, 0x40, 0xd8, 0xeb, 0xc4, 0x71,
-	0x8f, 0x4c, 0x7b, 0xd8, 0x27, 0x33, 0x0b, 0xc4, 0xad, 0xc1, 0x81, 0xdb, 0x04, 0xa6, 0xfe, 0x58,
-	0x81, 0xab, 0x4f, 0xc6, 0x86, 0xee, 0xe3, 0x88, 0x07, 0xb2, 0x68, 0x51, 0xe2, 0x47, 0x41, 0x55,
-	0x60, 0x21, 0xdf, 0x1d, 0x15, 0xc3, 0x56, 0xff, 0x46, 0xcc, 0x85, 0x9b, 0x03, 0x7a, 0xa1, 0x39,
-	0xa6, 0x17, 0xc4, 0x73, 0xcf, 0xa5, 0x0b, 0xd5, 0x63, 0x3e, 0x5c, 0xf0, 0x22, 0x22, 0x68, 0xc7,
-	0xee, 0x41, 0x8b, 0x67, 0xbf, 0x07, 0x55, 0xb7, 0xe0, 0x92, 0x86, 0x3d, 0x6c, 0x1b, 0xb1, 0xd5,
-	0xcc, 0x9d, 0x4d, 0x1a, 0x43, 0x57, 0x36, 0xdc, 0x22, 0xcc, 0xca, 0x7c, 0xd7, 0xbe, 0x4b, 0x86,
-	0xf5, 0xb9, 0x2a, 0x26, 0x2e, 0x13, 0xa5, 0xe3, 0xab, 0x7f, 0x5d, 0x80, 0x8b, 0x0f, 0x0d, 0x83,
-	0x6b, 0x71, 0xee, 0x8d, 0xbd, 0x2c, 0x47, 0x39, 0xe9, 0x48, 0x16, 0xd3, 0x8e, 0xe4, 0x8b, 0xd2,
-	0xac, 0xdc, 0xc6, 0xd8, 0x13, 0x2b, 0xb0, 0x9d, 0x2e, 0x2b, 0x10, 0x7a, 0xc0, 0x2f, 0xc6, 0x48,
-	0x40, 0x4f, 0xed, 0xe7, 0x6c, 0xff, 0xaa, 0x1a, 0x64, 0xc5, 0xd4, 0x31, 0x74, 0xd2, 0x9b, 0xb5,
-	0xa0, 0x2a, 0x09, 0x76, 0x64, 0xec, 0xb0, 0x0c, 0x6a, 0x83, 0xb8, 0x50, 0x14, 0xb4, 0xe3, 0x78,
-	0xea, 0x7f, 0x15, 0xa0, 0xb3, 0xab, 0x1f, 0xe3, 0xff, 0x3f, 0x07, 0xf4, 0x5d, 0xb8, 0xe0, 0xe9,
-	0xc7, 0xb8, 0x1f, 0x09, 0x8c, 0xfb, 0x2e, 0x7e, 0xc6, 0x5d, 0xd0, 0x77, 0x64, 0x9a, 0x44, 0x5a,
-	0x47, 0xa3, 0xad, 0x78, 0x31, 0xb8, 0x86, 0x9f, 0xa1, 0xb7, 0x60, 0x39, 0x5a, 0xa8, 0x45, 0xa6,
-	0x56, 0xa5, 0x5b, 0xde, 0x8c, 0xd4, 0x61, 0xf5, 0x0c, 0xf5, 0x19, 0x5c, 0x7e, 0x62, 0x7b, 0xd8,
-	0xef, 0x85, 0xb5, 0x44, 0x0b, 0x86, 0x90, 0xd7, 0xa0, 0x1e, 0x6e, 0x7c, 0xea, 0x15, 0x84, 0xe1,
-	0xa9, 0x0e, 0x74, 0xb7, 0x74, 0xf7, 0x28, 0xc8, 0x23, 0xaf, 0xb3, 0x9a, 0x8f, 0x97, 0x48, 0xf0,
-	0x40, 0x94, 0x40, 0x69, 0xf8, 0x00, 0xbb, 0xd8, 0x1e, 0xe0, 0x4d, 0x67, 0x70, 0x14, 0x29, 0x0d,
-	0x56, 0xa2, 0xa5, 0xc1, 0xf3, 0x96, 0x1a, 0xab, 0x3f, 0x2f, 0xc0, 0xea, 0xc3, 0x91, 0x8f, 0xdd,
-	0x30, 0xf2, 0x3f, 0x4b, 0x12, 0x23, 0xcc, 0x2a, 0x14, 0xe6, 0xc8, 0x2a, 0xa4, 0xaa, 0xdc, 0x8b,
-	0xe9, 0x2a, 0x77, 0x59, 0x0e, 0xa4, 0x34, 0x67, 0x0e, 0xe4, 0x21, 0xc0, 0xd8, 0x75, 0xc6, 0xd8,
-	0xf5, 0x4d, 0x1c, 0x84, 0x6f, 0x39, 0xdc, 0x97, 0x48, 0xa7, 0xdb, 0x9f, 0x8a, 0x32, 0xce, 0xbd,
-	0xd3, 0x31, 0x46, 0x4b, 0x50, 0xdc, 0xc6, 0x27, 0xed, 0x73, 0x08, 0xa0, 0xb2, 0xed, 0xb8, 0x96,
-	0x3e, 0x6a, 0x2b, 0xa8, 0x0e, 0x4b, 0xfc, 0xd6, 0xaa, 0x5d, 0x40, 0x4d, 0xa8, 0x3d, 0x0a, 0x32,
-	0xff, 0xed, 0xe2, 0xed, 0x3f, 0x53, 0x60, 0x25, 0x75, 0xaf, 0x82, 0x5a, 0x00, 0x4f, 0xec, 0x01,
-	0xbf, 0x70, 0x6a, 0x9f, 0x43, 0x0d, 0xa8, 0x06, 0xd7, 0x4f, 0x6c, 0xbc, 0x3d, 0x87, 0x62, 0xb7,
-	0x0b, 0xa8, 0x0d, 0x0d, 0xd6, 0x71, 0x32, 0x18, 0x60, 0xcf, 0x6b, 0x17, 0x05, 0x64, 0x43, 0x37,
-	0x47, 0x13, 0x17, 0xb7, 0x4b, 0x84, 0xe6, 0x9e, 0xc3, 0x0b, 0xd9, 0xdb, 0x65, 0x84, 0xa0, 0x15,
-	0x54, 0xb5, 0xf3, 0x4e, 0x95, 0x08, 0x2c, 0xe8, 0xb6, 0x74, 0xfb, 0x69, 0x34, 0x3b, 0x4e, 0x97,
-	0x77, 0x11, 0xce, 0x3f, 0xb1, 0x0d, 0x7c, 0x60, 0xda, 0xd8, 0x08, 0x3f, 0xb5, 0xcf, 0xa1, 0xf3,
-	0xb0, 0xbc, 0x85, 0xdd, 0x21, 0x8e, 0x00, 0x0b, 0x68, 0x05, 0x9a, 0x5b, 0xe6, 0xf3, 0x08, 0xa8,
-	0xa8, 0x96, 0xaa, 0x4a, 0x5b, 0xb9, 0xff, 0xbb, 0x57, 0xa0, 0x46, 0x0e, 0xe5, 0x91, 0xe3, 0xb8,
-	0x06, 0x1a, 0x01, 0xa2, 0xef, 0x3e, 0xac, 0xb1, 0x63, 0x8b, 0xd7, 0x54, 0xe8, 0x6e, 0xfc, 0x1c,
-	0x78, 0x23, 0x8d, 0xc8, 0xb9, 0xb3, 0xfb, 0xa6, 0x14, 0x3f, 0x81, 0xac, 0x9e, 0x43, 0x16, 0xa5,
-	0xb6, 0x67, 0x5a, 0x78, 0xcf, 0x1c, 0x1c, 0x05, 0x9e, 0xc5, 0xfb, 0x19, 0x7e, 0x44, 0x1a, 0x35,
-	0xa0, 0x77, 0x43, 0x4a, 0x8f, 0x3d, 0xcc, 0x09, 0xac, 0x8c, 0x7a, 0x0e, 0x3d, 0x83, 0x0b, 0x8f,
-	0x71, 0xc4, 0x49, 0x0b, 0x08, 0xde, 0xcf, 0x26, 0x98, 0x42, 0x3e, 0x23, 0xc9, 0x4d, 0x28, 0x53,
-	0x76, 0x43, 0x32, 0x3f, 0x2e, 0xfa, 0xf0, 0xb9, 0x7b, 0x3d, 0x1b, 0x41, 0x8c, 0xf6, 0x7d, 0x58,
-	0x4e, 0x3c, 0x97, 0x44, 0x32, 0xad, 0x2e, 0x7f, 0xf8, 0xda, 0xbd, 0x9d, 0x07, 0x55, 0xd0, 0x1a,
-	0x42, 0x2b, 0xfe, 0x5e, 0x04, 0xc9, 0x32, 0xbb, 0xd2, 0x97, 0x6e, 0xdd, 0x77, 0x72, 0x60, 0x0a,
-	0x42, 0x16, 0xb4, 0x93, 0xcf, 0xf7, 0xd0, 0xed, 0xa9, 0x03, 0xc4, 0x99, 0xed, 0xdd, 0x5c, 0xb8,
-	0x82, 0xdc, 0x29, 0x65, 0x82, 0xd4, 0x8b, 0xb0, 0x24, 0x8f, 0x07, 0xc3, 0x64, 0x3d, 0x55, 0xeb,
-	0xde, 0xcb, 0x8d, 0x2f, 0x48, 0xff, 0x36, 0x2b, 0x4b, 0x91, 0xbd, 0xaa, 0x42, 0x1f, 0xc8, 0x87,
-	0x9b, 0xf2, 0x1c, 0xac, 0x7b, 0xff, 0x2c, 0x5d, 0xc4, 0x24, 0x7e, 0x48, 0xeb, 0x49, 0x24, 0xef,
-	0x92, 0x92, 0x72, 0x17, 0x8c, 0x97, 0xfd, 0xe4, 0xaa, 0xfb, 0xc1, 0x19, 0x7a, 0x88, 0x09, 0x38,
-	0xc9, 0xf7, 0x91, 0x81, 0x18, 0xde, 0x9b, 0xc9, 0x35, 0xf3, 0xc9, 0xe0, 0xf7, 0x60, 0x39, 0xe1,
-	0xe7, 0xa0, 0xfc, 0xbe, 0x50, 0x77, 0x9a, 0x33, 0xca, 0x44, 0x32, 0x51, 0x9e, 0x83, 0x32, 0xb8,
-	0x5f, 0x52, 0xc2, 0xd3, 0xbd, 0x9d, 0x07, 0x55, 0x2c, 0xc4, 0xa3, 0xea, 0x32, 0x51, 0x74, 0x81,
-	0xee, 0xc8, 0xc7, 0x90, 0x17, 0x97, 0x74, 0xdf, 0xcb, 0x89, 0x2d, 0x88, 0x1e, 0xc3, 0x79, 0x49,
-	0x6d, 0x0c, 0x7a, 0x6f, 0xea, 0x61, 0x25, 0x8b, 0x82, 0xba, 0x77, 0xf3, 0xa2, 0x0b, 0xba, 0xbf,
-	0x05, 0x68, 0xf7, 0xd0, 0x39, 0x79, 0xe4, 0xd8, 0x07, 0xe6, 0x70, 0xe2, 0xea, 0xcc, 0x4b, 0xc8,
-	0xb2, 0x0d, 0x69, 0xd4, 0x0c, 0x1e, 0x9d, 0xda, 0x43, 0x10, 0xef, 0x03, 0x3c, 0xc6, 0xfe, 0x16,
-	0xf6, 0x5d, 0x22, 0x18, 0x6f, 0x65, 0x99, 0x3f, 0x8e, 0x10, 0x90, 0x7a, 0x7b, 0x26, 0x5e, 0xc4,
-	0x14, 0xb5, 0xb7, 0x74, 0x7b, 0xa2, 0x8f, 0x22, 0xc5, 0xfd, 0x77, 0xa4, 0xdd, 0x93, 0x68, 0x19,
-	0x07, 0x99, 0x89, 0x2d, 0x48, 0x9e, 0x08, 0xd3, 0x1e, 0xb9, 0x8a, 0x9b, 0x6e, 0xda, 0xd3, 0x75,
-	0x1e, 0x49, 0xb5, 0x37, 0x05, 0x5f, 0x10, 0xfe, 0x52, 0xa1, 0xe5, 0x55, 0x09, 0x84, 0xa7, 0xa6,
-	0x7f, 0xb8, 0x33, 0xd2, 0x6d, 0x2f, 0xcf, 0x14, 0x28, 0xe2, 0x19, 0xa6, 0xc0, 0xf1, 0xc5, 0x14,
-	0x0c, 0x68, 0xc6, 0x6e, 0xc8, 0x90, 0xac, 0x1a, 0x5e, 0x76, 0x5b, 0xd8, 0xbd, 0x35, 0x1b, 0x51,
-	0x50, 0x39, 0x84, 0x66, 0x20, 0x4a, 0x6c, 0x73, 0xdf, 0xc9, 0x9a, 0x69, 0x88, 0x93, 0xa1, 0x09,
-	0xe4, 0xa8, 0x51, 0x4d, 0x90, 0xbe, 0x00, 0x40, 0xf9, 0x2e, 0x8e, 0xa6, 0x69, 0x82, 0xec, 0x5b,
-	0x05, 0xa6, 0xea, 0x12, 0x97, 0x6d, 0x72, 0x3d, 0x2a, 0xbd, 0x3b, 0x94, 0xaa, 0xba, 0x8c, 0xbb,
-	0x3b, 0xf5, 0x1c, 0x7a, 0x0a, 0x15, 0xfe, 0x6f, 0x1f, 0x6f, 0x4e, 0x4f, 0xda, 0xf1, 0xd1, 0x6f,
-	0xce, 0xc0, 0x12, 0x03, 0x1f, 0xc1, 0xc5, 0x8c, 0x94, 0x9d, 0xd4, 0x04, 0x4f, 0x4f, 0xef, 0xcd,
-	0x32, 0x0e, 0x82, 0x58, 0x2a, 0x27, 0x37, 0x85, 0x58, 0x56, 0xfe, 0x6e, 0x16, 0x31, 0x1d, 0x50,
-	0xfa, 0xfd, 0xae, 0x94, 0x27, 0x32, 0x9f, 0xf9, 0xe6, 0x20, 0x91, 0x7e, 0x82, 0x2b, 0x25, 0x91,
-	0xf9, 0x52, 0x77, 0x16, 0x89, 0x3e, 0xac, 0xa4, 0x92, 0x36, 0xe8, 0xdd, 0x0c, 0x73, 0x2d, 0x4b,
-	0xed, 0xcc, 0x22, 0x30, 0x84, 0xd7, 0xa4, 0x09, 0x0a, 0xa9, 0xfb, 0x31, 0x2d, 0x95, 0x31, 0x8b,
-	0xd0, 0x00, 0xce, 0x4b, 0xd2, 0x12, 0x52, 0xc3, 0x99, 0x9d, 0xbe, 0x98, 0x45, 0xe4, 0x00, 0xba,
-	0x6b, 0xae, 0xa3, 0x1b, 0x03, 0xdd, 0xf3, 0x69, 0xaa, 0x80, 0xc4, 0x82, 0x81, 0xff, 0x27, 0x0f,
-	0x0e, 0xa4, 0x09, 0x85, 0x59, 0x74, 0xf6, 0xa1, 0x4e, 0x19, 0x92, 0xfd, 0x9b, 0x04, 0x92, 0x5b,
-	0xba, 0x08, 0x46, 0x86, 0xfa, 0x94, 0x21, 0x06, 0xa2, 0x79, 0xff, 0xab, 0x1a, 0x54, 0x83, 0x07,
-	0x09, 0x5f, 0x73, 0x20, 0xfa, 0x0a, 0x22, 0xc3, 0xef, 0xc1, 0x72, 0xe2, 0x71, 0xb0, 0xf4, 0xb8,
-	0xe4, 0x0f, 0x88, 0x67, 0x1d, 0xd7, 0x53, 0xfe, 0xd7, 0x55, 0xc2, 0x49, 0x7c, 0x3b, 0x2b, 0xba,
-	0x4c, 0xfa, 0x87, 0x33, 0x06, 0xfe, 0xbf, 0xed, 0x95, 0x6d, 0x03, 0x44, 0xfc, 0xb1, 0xe9, 0x65,
-	0x7b, 0xc4, 0xc5, 0x98, 0xb5, 0x5b, 0x96, 0xd4, 0xe5, 0x7a, 0x27, 0x4f, 0x85, 0x54, 0xb6, 0xd1,
-	0xcc, 0x76, 0xb4, 0x9e, 0x40, 0x23, 0x5a, 0x50, 0x8b, 0xa4, 0x7f, 0x94, 0x94, 0xae, 0xb8, 0x9d,
-	0xb5, 0x8a, 0xad, 0x33, 0xda, 0xe2, 0x19, 0xc3, 0x79, 0xc4, 0x88, 0x24, 0x6f, 0x6a, 0x32, 0x8c,
-	0x48, 0xc6, 0xfd, 0x90, 0xd4, 0x77, 0xc9, 0xbe, 0xfe, 0x61, 0x49, 0x86, 0xe4, 0xf5, 0x83, 0x34,
-	0xc9, 0x90, 0x71, 0xa1, 0x23, 0x4d, 0x32, 0x64, 0xdd, 0x67, 0xa8, 0xe7, 0xd6, 0x3e, 0xfc, 0xee,
-	0x07, 0x43, 0xd3, 0x3f, 0x9c, 0xec, 0x93, 0xd5, 0xdf, 0x63, 0x5d, 0xdf, 0x33, 0x1d, 0xfe, 0xeb,
-	0x5e, 0xc0, 0xee, 0xf7, 0xe8, 0x68, 0xf7, 0xc8, 0x68, 0xe3, 0xfd, 0xfd, 0x0a, 0x6d, 0x7d, 0xf8,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x75, 0x90, 0x7f, 0x7c, 0x4f, 0x00, 0x00,
+	0x5a, 0xce, 0x7a, 0x75, 0xd5, 0x57, 0x8f, 0xae, 0x0e, 0x7b, 0xda, 0xe5, 0xf2, 0xf8, 0x31, 0xe9,
+	0xf1, 0x8c, 0xc7, 0xe3, 0xb1, 0x77, 0x3c, 0x8c, 0x76, 0x58, 0xef, 0xcc, 0xca, 0xdd, 0x6d, 0x7b,
+	0x0a, 0xba, 0x7b, 0x7b, 0xb3, 0xdb, 0x63, 0xb1, 0x8b, 0x54, 0x4a, 0x57, 0x46, 0x57, 0xe7, 0x76,
+	0x56, 0x66, 0x39, 0x33, 0xab, 0xdb, 0xbd, 0x1c, 0x76, 0xc4, 0x0a, 0xa4, 0x45, 0x88, 0x01, 0x24,
+	0x24, 0x38, 0x20, 0x21, 0x4e, 0xcb, 0xa2, 0x45, 0x48, 0x88, 0x0b, 0x1c, 0xe0, 0x88, 0xe0, 0xb0,
+	0x42, 0x48, 0xfc, 0x00, 0x0e, 0x0b, 0x57, 0xe0, 0x8a, 0x10, 0x8a, 0x47, 0x46, 0x46, 0xbe, 0xaa,
+	0xb2, 0xab, 0xec, 0x31, 0x82, 0x5b, 0xc5, 0x97, 0x5f, 0xbc, 0xbe, 0xf8, 0xde, 0x5f, 0x44, 0x41,
+	0xdb, 0xd0, 0x7d, 0xbd, 0x3f, 0x70, 0x1c, 0xd7, 0xb8, 0x3d, 0x76, 0x1d, 0xdf, 0x41, 0x2b, 0x23,
+	0xd3, 0x3a, 0x9a, 0x78, 0xac, 0x75, 0x9b, 0x7c, 0xee, 0x36, 0x06, 0xce, 0x68, 0xe4, 0xd8, 0x0c,
+	0xd4, 0x6d, 0x99, 0xb6, 0x8f, 0x5d, 0x5b, 0xb7, 0x78, 0xbb, 0x21, 0x77, 0xe8, 0x36, 0xbc, 0xc1,
+	0x01, 0x1e, 0xe9, 0xac, 0xa5, 0x2e, 0x41, 0xf9, 0xc1, 0x68, 0xec, 0x9f, 0xa8, 0xbf, 0xaf, 0x40,
+	0xe3, 0xa1, 0x35, 0xf1, 0x0e, 0x34, 0xfc, 0x6c, 0x82, 0x3d, 0x1f, 0x7d, 0x05, 0x4a, 0x4f, 0x75,
+	0x0f, 0x77, 0x94, 0xab, 0xca, 0x8d, 0xfa, 0xdd, 0xd7, 0x6f, 0x47, 0x66, 0xe5, 0xf3, 0x6d, 0x79,
+	0xc3, 0x35, 0xdd, 0xc3, 0x1a, 0xc5, 0x44, 0x08, 0x4a, 0xc6, 0xd3, 0xde, 0x46, 0xa7, 0x70, 0x55,
+	0xb9, 0x51, 0xd4, 0xe8, 0x6f, 0x74, 0x19, 0xc0, 0xc3, 0xc3, 0x11, 0xb6, 0xfd, 0xde, 0x86, 0xd7,
+	0x29, 0x5e, 0x2d, 0xde, 0x28, 0x6a, 0x12, 0x04, 0xa9, 0xd0, 0x18, 0x38, 0x96, 0x85, 0x07, 0xbe,
+	0xe9, 0xd8, 0xbd, 0x8d, 0x4e, 0x89, 0xf6, 0x8d, 0xc0, 0xd4, 0x9f, 0x29, 0xd0, 0xe4, 0x4b, 0xf3,
+	0xc6, 0x8e, 0xed, 0x61, 0xf4, 0x01, 0x54, 0x3c, 0x5f, 0xf7, 0x27, 0x1e, 0x5f, 0xdd, 0xc5, 0xd4,
+	0xd5, 0xed, 0x52, 0x14, 0x8d, 0xa3, 0xa6, 0x2e, 0x2f, 0x3e, 0x7d, 0x31, 0x39, 0x7d, 0x6c, 0x0b,
+	0xa5, 0xc4, 0x16, 0x6e, 0xc0, 0xf2, 0x3e, 0x59, 0xdd, 0x6e, 0x88, 0x54, 0xa6, 0x48, 0x71, 0x30,
+	0x19, 0xc9, 0x37, 0x47, 0xf8, 0x9b, 0xfb, 0xbb, 0x58, 0xb7, 0x3a, 0x15, 0x3a, 0x97, 0x04, 0x51,
+	0xff, 0x51, 0x81, 0xb6, 0x40, 0x0f, 0xce, 0xe1, 0x1c, 0x94, 0x07, 0xce, 0xc4, 0xf6, 0xe9, 0x56,
+	0x9b, 0x1a, 0x6b, 0xa0, 0x37, 0xa0, 0x31, 0x38, 0xd0, 0x6d, 0x1b, 0x5b, 0x7d, 0x5b, 0x1f, 0x61,
+	0xba, 0xa9, 0x9a, 0x56, 0xe7, 0xb0, 0x6d, 0x7d, 0x84, 0x73, 0xed, 0xed, 0x2a, 0xd4, 0xc7, 0xba,
+	0xeb, 0x9b, 0x11, 0xea, 0xcb, 0x20, 0xd4, 0x85, 0xaa, 0xe9, 0xf5, 0x46, 0x63, 0xc7, 0xf5, 0x3b,
+	0xe5, 0xab, 0xca, 0x8d, 0xaa, 0x26, 0xda, 0x64, 0x06, 0x93, 0xfe, 0xda, 0xd3, 0xbd, 0xc3, 0xde,
+	0x06, 0xdf, 0x51, 0x04, 0xa6, 0xfe, 0x91, 0x02, 0xab, 0xf7, 0x3d, 0xcf, 0x1c, 0xda, 0x89, 0x9d,
+	0xad, 0x42, 0xc5, 0x76, 0x0c, 0xdc, 0xdb, 0xa0, 0x5b, 0x2b, 0x6a, 0xbc, 0x85, 0x2e, 0x42, 0x6d,
+	0x8c, 0xb1, 0xdb, 0x77, 0x1d, 0x2b, 0xd8, 0x58, 0x95, 0x00, 0x34, 0xc7, 0xc2, 0xe8, 0x5b, 0xb0,
+	0xe2, 0xc5, 0x06, 0x62, 0x7c, 0x55, 0xbf, 0x7b, 0xed, 0x76, 0x42, 0x32, 0x6e, 0xc7, 0x27, 0xd5,
+	0x92, 0xbd, 0xd5, 0xcf, 0x0b, 0x70, 0x56, 0xe0, 0xb1, 0xb5, 0x92, 0xdf, 0x84, 0xf2, 0x1e, 0x1e,
+	0x8a, 0xe5, 0xb1, 0x46, 0x1e, 0xca, 0x8b, 0x23, 0x2b, 0xca, 0x47, 0x96, 0x83, 0xd5, 0xe3, 0xe7,
+	0x51, 0x4e, 0x9e, 0xc7, 0x15, 0xa8, 0xe3, 0xe7, 0x63, 0xd3, 0xc5, 0x7d, 0xc2, 0x38, 0x94, 0xe4,
+	0x25, 0x0d, 0x18, 0x68, 0xcf, 0x1c, 0xc9, 0xb2, 0xb1, 0x94, 0x5b, 0x36, 0xd4, 0x3f, 0x56, 0xe0,
+	0x7c, 0xe2, 0x94, 0xb8, 0xb0, 0x69, 0xd0, 0xa6, 0x3b, 0x0f, 0x29, 0x43, 0xc4, 0x8e, 0x10, 0xfc,
+	0xad, 0x69, 0x04, 0x0f, 0xd1, 0xb5, 0x44, 0x7f, 0x69, 0x91, 0x85, 0xfc, 0x8b, 0x3c, 0x84, 0xf3,
+	0x8f, 0xb0, 0xcf, 0x27, 0x20, 0xdf, 0xb0, 0x37, 0xbf, 0xb2, 0x8a, 0x4a, 0x75, 0x21, 0x2e, 0xd5,
+	0xea, 0x9f, 0x17, 0x84, 0x2c, 0xd2, 0xa9, 0x7a, 0xf6, 0xbe, 0x83, 0x5e, 0x87, 0x9a, 0x40, 0xe1,
+	0x5c, 0x11, 0x02, 0xd0, 0x57, 0xa1, 0x4c, 0x56, 0xca, 0x58, 0xa2, 0x75, 0xf7, 0x8d, 0xf4, 0x3d,
+	0x49, 0x63, 0x6a, 0x0c, 0x1f, 0xf5, 0xa0, 0xe5, 0xf9, 0xba, 0xeb, 0xf7, 0xc7, 0x8e, 0x47, 0xcf,
+	0x99, 0x32, 0x4e, 0xfd, 0xae, 0x1a, 0x1d, 0x41, 0xa8, 0xf5, 0x2d, 0x6f, 0xb8, 0xc3, 0x31, 0xb5,
+	0x26, 0xed, 0x19, 0x34, 0xd1, 0x03, 0x68, 0x60, 0xdb, 0x08, 0x07, 0x2a, 0xe5, 0x1e, 0xa8, 0x8e,
+	0x6d, 0x43, 0x0c, 0x13, 0x9e, 0x4f, 0x39, 0xff, 0xf9, 0xfc, 0xa6, 0x02, 0x9d, 0xe4, 0x01, 0x2d,
+	0xa2, 0xb2, 0xef, 0xb1, 0x4e, 0x98, 0x1d, 0xd0, 0x54, 0x09, 0x17, 0x87, 0xa4, 0xf1, 0x2e, 0xea,
+	0xef, 0x29, 0xf0, 0x5a, 0xb8, 0x1c, 0xfa, 0xe9, 0x65, 0x71, 0x0b, 0xba, 0x09, 0x6d, 0xd3, 0x1e,
+	0x58, 0x13, 0x03, 0x3f, 0xb6, 0x3f, 0xc5, 0xba, 0xe5, 0x1f, 0x9c, 0xd0, 0x33, 0xac, 0x6a, 0x09,
+	0xb8, 0xfa, 0x03, 0x05, 0x56, 0xe3, 0xeb, 0x5a, 0x84, 0x48, 0x3f, 0x07, 0x65, 0xd3, 0xde, 0x77,
+	0x02, 0x1a, 0x5d, 0x9e, 0x22, 0x94, 0x64, 0x2e, 0x86, 0xac, 0x8e, 0xe0, 0xe2, 0x23, 0xec, 0xf7,
+	0x6c, 0x0f, 0xbb, 0xfe, 0x9a, 0x69, 0x5b, 0xce, 0x70, 0x47, 0xf7, 0x0f, 0x16, 0x10, 0xa8, 0x88,
+	0x6c, 0x14, 0x62, 0xb2, 0xa1, 0xfe, 0x48, 0x81, 0xd7, 0xd3, 0xe7, 0xe3, 0x5b, 0xef, 0x42, 0x75,
+	0xdf, 0xc4, 0x96, 0x41, 0xe8, 0xab, 0x50, 0xfa, 0x8a, 0x36, 0x11, 0xac, 0x31, 0x41, 0xe6, 0x3b,
+	0x7c, 0x23, 0x83, 0x9b, 0x77, 0x7d, 0xd7, 0xb4, 0x87, 0x9b, 0xa6, 0xe7, 0x6b, 0x0c, 0x5f, 0xa2,
+	0x67, 0x31, 0x3f, 0x1b, 0xff, 0x86, 0x02, 0x97, 0x1f, 0x61, 0x7f, 0x5d, 0xe8, 0x65, 0xf2, 0xdd,
+	0xf4, 0x7c, 0x73, 0xe0, 0xbd, 0x58, 0xdf, 0x28, 0x87, 0x81, 0x56, 0xbf, 0x50, 0xe0, 0x4a, 0xe6,
+	0x62, 0x38, 0xe9, 0xb8, 0xde, 0x09, 0xb4, 0x72, 0xba, 0xde, 0xf9, 0x45, 0x7c, 0xf2, 0x99, 0x6e,
+	0x4d, 0xf0, 0x8e, 0x6e, 0xba, 0x4c, 0xef, 0xcc, 0xa9, 0x85, 0x7f, 0xa2, 0xc0, 0xa5, 0x47, 0xd8,
+	0xdf, 0x09, 0x6c, 0xd2, 0x2b, 0xa4, 0x0e, 0xc1, 0x91, 0x6c, 0x63, 0xe0, 0x9c, 0x45, 0x60, 0xea,
+	0x6f, 0xb1, 0xe3, 0x4c, 0x5d, 0xef, 0x2b, 0x21, 0xe0, 0x65, 0x2a, 0x09, 0x92, 0x48, 0xae, 0x33,
+	0xd7, 0x81, 0x93, 0x4f, 0xfd, 0x43, 0x05, 0x2e, 0xdc, 0x1f, 0x3c, 0x9b, 0x98, 0x2e, 0xe6, 0x48,
+	0x9b, 0xce, 0xe0, 0x70, 0x7e, 0xe2, 0x86, 0x6e, 0x56, 0x21, 0xe2, 0x66, 0xcd, 0x72, 0xcd, 0x57,
+	0xa1, 0xe2, 0x33, 0xbf, 0x8e, 0x79, 0x2a, 0xbc, 0x45, 0xd7, 0xa7, 0x61, 0x0b, 0xeb, 0xde, 0xff,
+	0xce, 0xf5, 0x7d, 0x51, 0x82, 0xc6, 0x67, 0xdc, 0x1d, 0xa3, 0x56, 0x3b, 0xce, 0x49, 0x4a, 0xba,
+	0xe3, 0x25, 0x79, 0x70, 0x69, 0x4e, 0xdd, 0x23, 0x68, 0x7a, 0x18, 0x1f, 0xce, 0x63, 0xa3, 0x1b,
+	0xa4, 0xa3, 0xb0, 0xad, 0x9b, 0xb0, 0x32, 0xb1, 0x69, 0x68, 0x80, 0x0d, 0x4e, 0x40, 0xc6, 0xb9,
+	0xb3, 0x75, 0x77, 0xb2, 0x23, 0xfa, 0x94, 0x47, 0x1f, 0xd2, 0x58, 0xe5, 0x5c, 0x63, 0xc5, 0xbb,
+	0xa1, 0x1e, 0xb4, 0x0d, 0xd7, 0x19, 0x8f, 0xb1, 0xd1, 0xf7, 0x82, 0xa1, 0x2a, 0xf9, 0x86, 0xe2,
+	0xfd, 0xc4, 0x50, 0x5f, 0x81, 0xb3, 0xf1, 0x95, 0xf6, 0x0c, 0xe2, 0x90, 0x92, 0x33, 0x4c, 0xfb,
+	0x84, 0x6e, 0xc1, 0x4a, 0x12, 0xbf, 0x4a, 0xf1, 0x93, 0x1f, 0xd0, 0x7b, 0x80, 0x62, 0x4b, 0x25,
+	0xe8, 0x35, 0x86, 0x1e, 0x5d, 0x4c, 0xcf, 0xf0, 0xd4, 0x1f, 0x2a, 0xb0, 0xfa, 0x44, 0xf7, 0x07,
+	0x07, 0x1b, 0x23, 0x2e, 0x6b, 0x0b, 0xe8, 0xaa, 0x8f, 0xa1, 0x76, 0xc4, 0xf9, 0x22, 0x30, 0x48,
+	0x57, 0x52, 0xe8, 0x23, 0x73, 0xa0, 0x16, 0xf6, 0x20, 0xf1, 0xd0, 0xb9, 0x87, 0x52, 0x5c, 0xf8,
+	0x0a, 0xb4, 0xe6, 0x8c, 0x80, 0x56, 0x7d, 0x0e, 0xc0, 0x17, 0xb7, 0xe5, 0x0d, 0xe7, 0x58, 0xd7,
+	0x47, 0xb0, 0xc4, 0x47, 0xe3, 0x6a, 0x71, 0x16, 0xff, 0x04, 0xe8, 0xea, 0x8f, 0x2b, 0x50, 0x97,
+	0x3e, 0xa0, 0x16, 0x14, 0x84, 0xbc, 0x16, 0x52, 0x76, 0x57, 0x98, 0x1d, 0x42, 0x15, 0x93, 0x21,
+	0xd4, 0x75, 0x68, 0x99, 0xd4, 0x0f, 0xe9, 0xf3, 0x53, 0xa1, 0x0a, 0xa4, 0xa6, 0x35, 0x19, 0x94,
+	0xb3, 0x08, 0xba, 0x0c, 0x75, 0x7b, 0x32, 0xea, 0x3b, 0xfb, 0x7d, 0xd7, 0x39, 0xf6, 0x78, 0x2c,
+	0x56, 0xb3, 0x27, 0xa3, 0x6f, 0xee, 0x6b, 0xce, 0xb1, 0x17, 0xba, 0xfb, 0x95, 0x53, 0xba, 0xfb,
+	0x97, 0xa1, 0x3e, 0xd2, 0x9f, 0x93, 0x51, 0xfb, 0xf6, 0x64, 0x44, 0xc3, 0xb4, 0xa2, 0x56, 0x1b,
+	0xe9, 0xcf, 0x35, 0xe7, 0x78, 0x7b, 0x32, 0x42, 0x37, 0xa0, 0x6d, 0xe9, 0x9e, 0xdf, 0x97, 0xe3,
+	0xbc, 0x2a, 0x8d, 0xf3, 0x5a, 0x04, 0xfe, 0x20, 0x8c, 0xf5, 0x92, 0x81, 0x43, 0x6d, 0x81, 0xc0,
+	0xc1, 0x18, 0x59, 0xe1, 0x40, 0x90, 0x3f, 0x70, 0x30, 0x46, 0x96, 0x18, 0xe6, 0x23, 0x58, 0x7a,
+	0x4a, 0xbd, 0x3b, 0xaf, 0x53, 0xcf, 0xd4, 0x1d, 0x0f, 0x89, 0x63, 0xc7, 0x9c, 0x40, 0x2d, 0x40,
+	0x47, 0x5f, 0x87, 0x1a, 0x35, 0xaa, 0xb4, 0x6f, 0x23, 0x57, 0xdf, 0xb0, 0x03, 0xe9, 0x6d, 0x60,
+	0xcb, 0xd7, 0x69, 0xef, 0x66, 0xbe, 0xde, 0xa2, 0x03, 0xd1, 0x57, 0x03, 0x17, 0xeb, 0x3e, 0x36,
+	0xd6, 0x4e, 0xd6, 0x9d, 0xd1, 0x58, 0xa7, 0xcc, 0xd4, 0x69, 0x51, 0x0f, 0x3e, 0xed, 0x13, 0x7a,
+	0x0b, 0x5a, 0x03, 0xd1, 0x7a, 0xe8, 0x3a, 0xa3, 0xce, 0x32, 0x95, 0xa3, 0x18, 0x14, 0x5d, 0x02,
+	0x08, 0x34, 0x95, 0xee, 0x77, 0xda, 0xf4, 0x14, 0x6b, 0x1c, 0x72, 0x9f, 0xa6, 0x71, 0x4c, 0xaf,
+	0xcf, 0x12, 0x26, 0xa6, 0x3d, 0xec, 0xac, 0xd0, 0x19, 0xeb, 0x41, 0x86, 0xc5, 0xb4, 0x87, 0xe8,
+	0x3c, 0x2c, 0x99, 0x5e, 0x7f, 0x5f, 0x3f, 0xc4, 0x1d, 0x44, 0xbf, 0x56, 0x4c, 0xef, 0xa1, 0x7e,
+	0x88, 0xd5, 0xef, 0xc3, 0xb9, 0x90, 0xbb, 0xa4, 0x93, 0x4c, 0x32, 0x85, 0x32, 0x2f, 0x53, 0x4c,
+	0xf7, 0xe9, 0x7f, 0x5a, 0x82, 0xd5, 0x5d, 0xfd, 0x08, 0xbf, 0xfc, 0xf0, 0x21, 0x97, 0x5a, 0xdb,
+	0x84, 0x15, 0x1a, 0x31, 0xdc, 0x95, 0xd6, 0x33, 0xc5, 0xae, 0xca, 0xac, 0x90, 0xec, 0x88, 0xbe,
+	0x41, 0x1c, 0x02, 0x3c, 0x38, 0xdc, 0x71, 0xcc, 0xd0, 0xa6, 0x5e, 0x4a, 0x19, 0x67, 0x5d, 0x60,
+	0x69, 0x72, 0x0f, 0xb4, 0x03, 0xcb, 0xd1, 0x63, 0x08, 0xac, 0xe9, 0xdb, 0x53, 0x83, 0xd8, 0x90,
+	0xfa, 0x5a, 0x2b, 0x72, 0x18, 0x1e, 0xea, 0xc0, 0x12, 0x37, 0x85, 0x54, 0x67, 0x54, 0xb5, 0xa0,
+	0x89, 0x76, 0xe0, 0x2c, 0xdb, 0xc1, 0x2e, 0x17, 0x08, 0xb6, 0xf9, 0x6a, 0xae, 0xcd, 0xa7, 0x75,
+	0x8d, 0xca, 0x53, 0xed, 0xb4, 0xf2, 0xd4, 0x81, 0x25, 0xce, 0xe3, 0x54, 0x8f, 0x54, 0xb5, 0xa0,
+	0x49, 0x8e, 0x39, 0xe4, 0xf6, 0x3a, 0xfd, 0x16, 0x02, 0x48, 0xe8, 0x05, 0x21, 0x3d, 0x67, 0xa4,
+	0x5b, 0x3e, 0x81, 0xaa, 0xe0, 0xf0, 0x42, 0x6e, 0x0e, 0x17, 0x7d, 0xe2, 0xfa, 0xbd, 0x18, 0xd3,
+	0xef, 0xea, 0x3f, 0x28, 0xd0, 0xd8, 0x20, 0x5b, 0xda, 0x74, 0x86, 0xd4, 0x1a, 0x5d, 0x87, 0x96,
+	0x8b, 0x07, 0x8e, 0x6b, 0xf4, 0xb1, 0xed, 0xbb, 0x26, 0x66, 0x51, 0x7a, 0x49, 0x6b, 0x32, 0xe8,
+	0x03, 0x06, 0x24, 0x68, 0x44, 0x65, 0x7b, 0xbe, 0x3e, 0x1a, 0xf7, 0xf7, 0x89, 0x6a, 0x28, 0x30,
+	0x34, 0x01, 0xa5, 0x9a, 0xe1, 0x0d, 0x68, 0x84, 0x68, 0xbe, 0x43, 0xe7, 0x2f, 0x69, 0x75, 0x01,
+	0xdb, 0x73, 0xd0, 0x9b, 0xd0, 0xa2, 0x34, 0xed, 0x5b, 0xce, 0xb0, 0x4f, 0x22, 0x5a, 0x6e, 0xa8,
+	0x1a, 0x06, 0x5f, 0x16, 0x39, 0xab, 0x28, 0x96, 0x67, 0x7e, 0x0f, 0x73, 0x53, 0x25, 0xb0, 0x76,
+	0xcd, 0xef, 0x61, 0xf5, 0xef, 0x15, 0x68, 0x6e, 0xe8, 0xbe, 0xbe, 0xed, 0x18, 0x78, 0x6f, 0x4e,
+	0xc3, 0x9e, 0x23, 0xf5, 0xf9, 0x3a, 0xd4, 0xc4, 0x0e, 0xf8, 0x96, 0x42, 0x00, 0x7a, 0x08, 0xad,
+	0xc0, 0xb5, 0xec, 0xb3, 0x88, 0xab, 0x94, 0xe9, 0x40, 0x49, 0x96, 0xd3, 0xd3, 0x9a, 0x41, 0x37,
+	0xda, 0x54, 0x1f, 0x42, 0x43, 0xfe, 0x4c, 0x66, 0xdd, 0x8d, 0x33, 0x8a, 0x00, 0x10, 0x6e, 0xdc,
+	0x9e, 0x8c, 0xc8, 0x99, 0x72, 0xc5, 0x12, 0x34, 0xd5, 0x1f, 0x28, 0xd0, 0xe4, 0xe6, 0x7e, 0x57,
+	0x14, 0x09, 0xe8, 0xd6, 0x14, 0xba, 0x35, 0xfa, 0x1b, 0x7d, 0x2d, 0x9a, 0xd7, 0x7b, 0x33, 0x55,
+	0x09, 0xd0, 0x41, 0xa8, 0x93, 0x19, 0xb1, 0xf5, 0x79, 0x62, 0xfc, 0xcf, 0x09, 0xa3, 0xf1, 0xa3,
+	0xa1, 0x8c, 0xd6, 0x81, 0x25, 0xdd, 0x30, 0x5c, 0xec, 0x79, 0x7c, 0x1d, 0x41, 0x93, 0x7c, 0x39,
+	0xc2, 0xae, 0x17, 0xb0, 0x7c, 0x51, 0x0b, 0x9a, 0xe8, 0xeb, 0x50, 0x15, 0x5e, 0x29, 0x4b, 0x87,
+	0x5f, 0xcd, 0x5e, 0x27, 0x8f, 0x48, 0x45, 0x0f, 0xf5, 0x2f, 0x0b, 0xd0, 0xe2, 0x04, 0x5b, 0xe3,
+	0xf6, 0x78, 0xba, 0xf0, 0xad, 0x41, 0x63, 0x3f, 0x94, 0xfd, 0x69, 0xb9, 0x27, 0x59, 0x45, 0x44,
+	0xfa, 0xcc, 0x12, 0xc0, 0xa8, 0x47, 0x50, 0x5a, 0xc8, 0x23, 0x28, 0x9f, 0x56, 0x83, 0x25, 0x7d,
+	0xc4, 0x4a, 0x8a, 0x8f, 0xa8, 0xfe, 0x32, 0xd4, 0xa5, 0x01, 0xa8, 0x86, 0x66, 0x49, 0x2b, 0x4e,
+	0xb1, 0xa0, 0x89, 0x3e, 0x08, 0xfd, 0x22, 0x46, 0xaa, 0x0b, 0x29, 0x6b, 0x89, 0xb9, 0x44, 0xea,
+	0xdf, 0x28, 0x50, 0xe1, 0x23, 0x5f, 0x81, 0x3a, 0x57, 0x3a, 0xd4, 0x67, 0x64, 0xa3, 0x03, 0x07,
+	0x11, 0xa7, 0xf1, 0xc5, 0x69, 0x9d, 0x0b, 0x50, 0x8d, 0xe9, 0x9b, 0x25, 0x6e, 0x16, 0x82, 0x4f,
+	0x92, 0x92, 0x21, 0x9f, 0x88, 0x7e, 0x41, 0xe7, 0xa0, 0x6c, 0x39, 0x43, 0x51, 0x04, 0x62, 0x0d,
+	0xf5, 0xef, 0x14, 0x9a, 0xb3, 0xd7, 0xf0, 0xc0, 0x39, 0xc2, 0xee, 0xc9, 0xe2, 0xc9, 0xce, 0x7b,
+	0x12, 0x9b, 0xe7, 0x0c, 0xbe, 0x44, 0x07, 0x74, 0x2f, 0x3c, 0x84, 0x62, 0x5a, 0xa6, 0x47, 0xd6,
+	0x3b, 0x9c, 0x49, 0xc3, 0xc3, 0xf8, 0x6d, 0x96, 0xb6, 0x8d, 0x6e, 0x65, 0x5e, 0x6f, 0xe7, 0x85,
+	0x04, 0x32, 0xea, 0x4f, 0x15, 0xe8, 0x86, 0xa9, 0x24, 0x6f, 0xed, 0x64, 0xd1, 0xa2, 0xc8, 0x8b,
+	0x89, 0xaf, 0x7e, 0x5e, 0x64, 0xed, 0x89, 0xd0, 0xe6, 0x8a, 0x8c, 0x82, 0x9c, 0xbd, 0x4d, 0xb3,
+	0xd2, 0xc9, 0x0d, 0x2d, 0xc2, 0x32, 0x5d, 0xa8, 0x8a, 0x7c, 0x06, 0xcb, 0xdc, 0x8b, 0x36, 0x91,
+	0xb0, 0x0b, 0x8f, 0xb0, 0xff, 0x30, 0x9a, 0x0a, 0x79, 0xd5, 0x04, 0x94, 0xab, 0x09, 0x07, 0xbc,
+	0x9a, 0x50, 0x8a, 0x55, 0x13, 0x38, 0x5c, 0x1d, 0x51, 0x16, 0x48, 0x6c, 0xe0, 0x65, 0x11, 0xec,
+	0xd7, 0x15, 0xe8, 0xf0, 0x59, 0xe8, 0x9c, 0x24, 0x24, 0xb2, 0xb0, 0x8f, 0x8d, 0x2f, 0x3b, 0x55,
+	0xf0, 0x5f, 0x0a, 0xb4, 0x65, 0xab, 0x4b, 0x0d, 0xe7, 0x87, 0x50, 0xa6, 0x99, 0x16, 0xbe, 0x82,
+	0x99, 0xaa, 0x81, 0x61, 0x13, 0xb5, 0x4d, 0x5d, 0xed, 0x3d, 0xe1, 0x20, 0xf0, 0x66, 0x68, 0xfa,
+	0x8b, 0xa7, 0x37, 0xfd, 0xdc, 0x15, 0x72, 0x26, 0x64, 0x5c, 0x96, 0xa2, 0x0c, 0x01, 0xe8, 0x63,
+	0xa8, 0xb0, 0x8b, 0x18, 0xbc, 0xc2, 0x76, 0x3d, 0x3a, 0x34, 0xbf, 0xa4, 0x21, 0xe5, 0xfd, 0x29,
+	0x40, 0xe3, 0x9d, 0xd4, 0x5f, 0x80, 0xd5, 0x30, 0x1a, 0x65, 0xd3, 0xce, 0xcb, 0xb4, 0xea, 0x3f,
+	0x2b, 0x70, 0x76, 0xf7, 0xc4, 0x1e, 0xc4, 0xd9, 0x7f, 0x15, 0x2a, 0x63, 0x4b, 0x0f, 0x33, 0xa6,
+	0xbc, 0x45, 0xdd, 0x40, 0x36, 0x37, 0x36, 0x88, 0x0d, 0x61, 0x34, 0xab, 0x0b, 0xd8, 0x9e, 0x33,
+	0xd3, 0xb4, 0x5f, 0x17, 0xe1, 0x33, 0x36, 0x98, 0xb5, 0x62, 0x69, 0xa8, 0xa6, 0x80, 0x52, 0x6b,
+	0xf5, 0x31, 0x00, 0x35, 0xe8, 0xfd, 0xd3, 0x18, 0x71, 0xda, 0x63, 0x93, 0xa8, 0xec, 0xbf, 0x28,
+	0x40, 0x47, 0xa2, 0xd2, 0x97, 0xed, 0xdf, 0x64, 0x44, 0x65, 0xc5, 0x17, 0x14, 0x95, 0x95, 0x16,
+	0xf7, 0x69, 0xca, 0x69, 0x3e, 0xcd, 0xbf, 0x14, 0xa0, 0x15, 0x52, 0x6d, 0xc7, 0xd2, 0xed, 0x4c,
+	0x4e, 0xd8, 0x15, 0xfe, 0x7c, 0x94, 0x4e, 0xef, 0xa6, 0xc9, 0x49, 0xc6, 0x41, 0x68, 0xb1, 0x21,
+	0xd0, 0x25, 0x7a, 0xe8, 0xae, 0xcf, 0x12, 0x5f, 0x3c, 0x86, 0x60, 0x02, 0x69, 0x8e, 0x30, 0xba,
+	0x05, 0x88, 0x4b, 0x51, 0xdf, 0xb4, 0xfb, 0x1e, 0x1e, 0x38, 0xb6, 0xc1, 0xe4, 0xab, 0xac, 0xb5,
+	0xf9, 0x97, 0x9e, 0xbd, 0xcb, 0xe0, 0xe8, 0x43, 0x28, 0xf9, 0x27, 0x63, 0xe6, 0xad, 0xb4, 0x52,
+	0xed, 0x7d, 0xb8, 0xae, 0xbd, 0x93, 0x31, 0xd6, 0x28, 0x7a, 0x70, 0x53, 0xc7, 0x77, 0xf5, 0x23,
+	0xee, 0xfa, 0x95, 0x34, 0x09, 0x42, 0x34, 0x46, 0x40, 0xc3, 0x25, 0xe6, 0x22, 0xf1, 0x26, 0xe3,
+	0xec, 0x40, 0x68, 0xfb, 0xbe, 0x6f, 0xd1, 0xd4, 0x1d, 0xe5, 0xec, 0x00, 0xba, 0xe7, 0x5b, 0xea,
+	0x3f, 0x15, 0xa0, 0x1d, 0xce, 0xac, 0x61, 0x6f, 0x62, 0x65, 0x0b, 0xdc, 0xf4, 0xdc, 0xc8, 0x2c,
+	0x59, 0xfb, 0x06, 0xd4, 0xf9, 0xb1, 0x9f, 0x82, 0x6d, 0x80, 0x75, 0xd9, 0x9c, 0xc2, 0xc7, 0xe5,
+	0x17, 0xc4, 0xc7, 0x95, 0x39, 0xb2, 0x0b, 0xe9, 0xc4, 0x57, 0x7f, 0xa4, 0xc0, 0x6b, 0x09, 0xb5,
+	0x38, 0x95, 0xb4, 0xd3, 0x63, 0x3b, 0xae, 0x2e, 0xe3, 0x43, 0x72, 0x05, 0x7f, 0x0f, 0x2a, 0x2e,
+	0x1d, 0x9d, 0x97, 0x82, 0xae, 0x4d, 0xe5, 0x2e, 0xb6, 0x10, 0x8d, 0x77, 0x51, 0x7f, 0x57, 0x81,
+	0xf3, 0xc9, 0xa5, 0x2e, 0x60, 0xb5, 0xd7, 0x60, 0x89, 0x0d, 0x1d, 0x08, 0xe1, 0x8d, 0xe9, 0x42,
+	0x18, 0x12, 0x47, 0x0b, 0x3a, 0xaa, 0xbb, 0xb0, 0x1a, 0x18, 0xf7, 0x90, 0xf4, 0x5b, 0xd8, 0xd7,
+	0xa7, 0x44, 0x36, 0x57, 0xa0, 0xce, 0x5c, 0x64, 0x16, 0x31, 0xb0, 0x9c, 0x00, 0x3c, 0x15, 0xa9,
+	0x34, 0xf5, 0xdf, 0x14, 0x38, 0x47, 0xad, 0x63, 0xbc, 0xf6, 0x92, 0xa7, 0x2e, 0xa7, 0x8a, 0x94,
+	0xc3, 0xb6, 0x3e, 0xe2, 0xf7, 0x40, 0x6a, 0x5a, 0x04, 0x86, 0x7a, 0xc9, 0x4c, 0x5b, 0x6a, 0x04,
+	0x1c, 0x16, 0x72, 0x49, 0xb4, 0x4d, 0xeb, 0xb8, 0xf1, 0x14, 0x5b, 0x68, 0x95, 0x4b, 0xf3, 0x58,
+	0xe5, 0x4d, 0x78, 0x2d, 0xb6, 0xd3, 0x05, 0x4e, 0x54, 0xfd, 0x13, 0x85, 0x1c, 0x47, 0xe4, 0x3e,
+	0xcd, 0xfc, 0x9e, 0xe9, 0x25, 0x51, 0xf4, 0xe9, 0x9b, 0x46, 0x5c, 0x89, 0x18, 0xe8, 0x13, 0xa8,
+	0xd9, 0xf8, 0xb8, 0x2f, 0x3b, 0x3b, 0x39, 0xdc, 0xf6, 0xaa, 0x8d, 0x8f, 0xe9, 0x2f, 0x75, 0x1b,
+	0xce, 0x27, 0x96, 0xba, 0xc8, 0xde, 0xff, 0x4a, 0x81, 0x0b, 0x1b, 0xae, 0x33, 0xfe, 0xcc, 0x74,
+	0xfd, 0x89, 0x6e, 0x45, 0x4b, 0xe4, 0x2f, 0x27, 0x75, 0xf5, 0xa9, 0xe4, 0xf6, 0x32, 0xfe, 0xb9,
+	0x95, 0x22, 0x41, 0xc9, 0x45, 0xf1, 0x4d, 0x4b, 0x4e, 0xf2, 0xbf, 0x16, 0xd3, 0x16, 0xcf, 0xf1,
+	0x66, 0x38, 0x1e, 0x79, 0x22, 0x88, 0xd4, 0x4c, 0x77, 0x71, 0xde, 0x4c, 0x77, 0x86, 0x7a, 0x2f,
+	0xbd, 0x20, 0xf5, 0x7e, 0xea, 0xd4, 0xcb, 0xa7, 0x10, 0xad, 0x42, 0x50, 0xf3, 0x3b, 0x57, 0xf9,
+	0x62, 0x0d, 0x20, 0xcc, 0xc8, 0xf3, 0xeb, 0x90, 0x79, 0x86, 0x91, 0x7a, 0x91, 0xd3, 0x12, 0xa6,
+	0x94, 0x9b, 0x72, 0x29, 0x47, 0xfc, 0x2d, 0xe8, 0xa6, 0x71, 0xe9, 0x22, 0x9c, 0xff, 0xdf, 0x05,
+	0x80, 0x9e, 0xb8, 0x41, 0x3b, 0x9f, 0x2d, 0xb8, 0x06, 0x92, 0xbb, 0x11, 0xca, 0xbb, 0xcc, 0x45,
+	0x06, 0x11, 0x09, 0x11, 0x74, 0x12, 0x9c, 0x44, 0x20, 0x6a, 0xd0, 0x71, 0x24, 0xa9, 0x61, 0x4c,
+	0x11, 0x57, 0xbf, 0x17, 0xa1, 0xe6, 0x3a, 0xc7, 0x7d, 0x22, 0x66, 0x46, 0x70, 0x45, 0xd8, 0x75,
+	0x8e, 0x89, 0xf0, 0x19, 0xe8, 0x3c, 0x2c, 0xf9, 0xba, 0x77, 0x48, 0xc6, 0xaf, 0x48, 0xb7, 0x34,
+	0x0c, 0x74, 0x0e, 0xca, 0xfb, 0xa6, 0x85, 0xd9, 0xa5, 0x80, 0x9a, 0xc6, 0x1a, 0xe8, 0xab, 0xc1,
+	0x5d, 0xb6, 0x6a, 0xee, 0x9b, 0x38, 0x14, 0x1f, 0xf5, 0x78, 0xb9, 0x66, 0xcc, 0xca, 0x35, 0xb5,
+	0xb4, 0x4a, 0x8b, 0x38, 0x6b, 0x46, 0xde, 0x75, 0x81, 0xaf, 0xc9, 0x7d, 0xd5, 0xbf, 0x2d, 0xc0,
+	0x72, 0x78, 0x00, 0x54, 0x97, 0x11, 0xf5, 0x48, 0x55, 0xe3, 0xba, 0x63, 0x30, 0xad, 0xd3, 0xca,
+	0x30, 0x2e, 0xac, 0x23, 0x53, 0x80, 0x61, 0x97, 0x69, 0x21, 0x35, 0x21, 0x11, 0xa1, 0x9f, 0x69,
+	0x04, 0x97, 0x5c, 0x2a, 0xae, 0x73, 0xdc, 0x33, 0x04, 0x61, 0xd9, 0x55, 0x62, 0x16, 0x40, 0x12,
+	0xc2, 0xae, 0xd3, 0xdb, 0xc4, 0xd7, 0xa0, 0x89, 0x5d, 0xd7, 0x71, 0xfb, 0x23, 0xec, 0x79, 0xfa,
+	0x10, 0x73, 0x5f, 0xbe, 0x41, 0x81, 0x5b, 0x0c, 0x86, 0xde, 0x85, 0x95, 0x23, 0xdd, 0x32, 0x0d,
+	0x9d, 0x9e, 0xb1, 0x8b, 0xe9, 0x2d, 0x6e, 0x96, 0xc8, 0x6c, 0x87, 0x1f, 0x34, 0x0a, 0x8f, 0x93,
+	0x70, 0x69, 0x01, 0x12, 0xfe, 0x7b, 0x09, 0x5a, 0x21, 0x09, 0x83, 0x52, 0xbe, 0x69, 0x04, 0xa5,
+	0x7c, 0x93, 0x70, 0x1f, 0xb8, 0x4c, 0x9b, 0x0b, 0xfe, 0x5c, 0x2b, 0x74, 0x14, 0xad, 0xc6, 0xa1,
+	0x3d, 0x83, 0x78, 0x16, 0x44, 0x4f, 0xd8, 0x8e, 0x81, 0x43, 0xfe, 0x84, 0x00, 0xc4, 0xd9, 0x33,
+	0xc2, 0xe6, 0xa5, 0x1c, 0x6c, 0x5e, 0xce, 0xc1, 0xe6, 0x95, 0x14, 0x36, 0x5f, 0x85, 0xca, 0xd3,
+	0xc9, 0xe0, 0x10, 0xfb, 0xdc, 0xe9, 0xe4, 0xad, 0x28, 0xfb, 0x57, 0x63, 0xec, 0x2f, 0xb8, 0xbc,
+	0x26, 0x73, 0xf9, 0x45, 0xa8, 0xb1, 0x9a, 0x72, 0xdf, 0xf7, 0x68, 0x81, 0xac, 0xa8, 0x55, 0x19,
+	0x60, 0xcf, 0x43, 0x1f, 0x05, 0x1e, 0x69, 0x3d, 0x4d, 0x5f, 0x51, 0xc5, 0x19, 0xe3, 0xce, 0xc0,
+	0x1f, 0x7d, 0x1b, 0x96, 0x25, 0x72, 0x50, 0x33, 0xd7, 0xa0, 0x4b, 0x95, 0x22, 0x12, 0x6a, 0xe9,
+	0xae, 0x43, 0x2b, 0x24, 0x09, 0xc5, 0x6b, 0xb2, 0x40, 0x50, 0x40, 0x29, 0x9a, 0x10, 0xc6, 0xd6,
+	0x29, 0x85, 0xf1, 0x02, 0x54, 0x79, 0x04, 0xe7, 0x75, 0x96, 0xa3, 0x09, 0x95, 0x8b, 0x50, 0x23,
+	0xfe, 0xe7, 0x11, 0xdd, 0x7a, 0x9b, 0x6d, 0x9d, 0x01, 0xf6, 0x3c, 0x72, 0xe0, 0x2e, 0xf6, 0xdd,
+	0x13, 0xce, 0xf2, 0x2b, 0x34, 0xa6, 0x03, 0x0a, 0x62, 0x4c, 0xdf, 0x85, 0xea, 0xd8, 0x35, 0x1d,
+	0xd7, 0xf4, 0x4f, 0x68, 0x31, 0xbc, 0xac, 0x89, 0xb6, 0xfa, 0x5d, 0x40, 0x21, 0x5d, 0x16, 0x73,
+	0xa5, 0x63, 0x8c, 0x57, 0x88, 0x33, 0x9e, 0xfa, 0x63, 0x05, 0x56, 0xe4, 0xc9, 0xe6, 0xf5, 0x4a,
+	0x3e, 0x81, 0x3a, 0x2b, 0x7e, 0xf6, 0x89, 0x56, 0xe4, 0x29, 0xb0, 0x4b, 0x53, 0x4f, 0x5c, 0x83,
+	0xf0, 0x79, 0x05, 0x61, 0xdc, 0x63, 0xc7, 0x3d, 0x34, 0xed, 0x61, 0x9f, 0xac, 0x2c, 0x50, 0x20,
+	0x0d, 0x0e, 0xdc, 0x26, 0x30, 0xf5, 0xd7, 0x48, 0xa4, 0xa1, 0xdb, 0x03, 0x6c, 0xbd, 0x88, 0x25,
+	0x4b, 0x0a, 0xbd, 0x10, 0x51, 0xe8, 0xb3, 0xa4, 0x55, 0xfd, 0xa2, 0x00, 0xf0, 0xe0, 0xb9, 0x58,
+	0xbb, 0x34, 0x90, 0x12, 0x19, 0x28, 0x97, 0xf1, 0xba, 0x06, 0x4d, 0x59, 0xaa, 0xc5, 0xce, 0x25,
+	0xb1, 0xf6, 0xa2, 0xc5, 0xc8, 0x52, 0xbc, 0x18, 0x79, 0x11, 0x6a, 0x44, 0x1c, 0xfb, 0x22, 0x3f,
+	0x50, 0xd3, 0xaa, 0x04, 0xb0, 0x77, 0x32, 0xc6, 0x92, 0xb4, 0x57, 0x22, 0xd2, 0x8e, 0xa0, 0x44,
+	0xc3, 0x1c, 0xa6, 0x03, 0xe8, 0xef, 0xb9, 0x8d, 0x96, 0xfa, 0x33, 0x05, 0x56, 0x42, 0x8a, 0x2c,
+	0xc4, 0x46, 0xf8, 0x79, 0x1e, 0x36, 0x92, 0x26, 0x03, 0xfc, 0xfc, 0x54, 0x6c, 0x84, 0xbe, 0x26,
+	0x99, 0xb0, 0x7c, 0xb7, 0x15, 0x43, 0x87, 0xf8, 0x77, 0x14, 0x40, 0xf2, 0x46, 0x5f, 0xa6, 0x70,
+	0xd2, 0x4a, 0x98, 0xe3, 0xeb, 0x56, 0x5f, 0xf2, 0xe6, 0x69, 0x06, 0x86, 0x42, 0x83, 0x24, 0xa7,
+	0xfa, 0x43, 0x05, 0x2e, 0x3f, 0x1e, 0x1b, 0xba, 0x8f, 0xa5, 0xa8, 0x65, 0xd1, 0x8b, 0xcc, 0x1f,
+	0x06, 0x37, 0x89, 0x0b, 0xf9, 0xea, 0xda, 0x0c, 0x5b, 0xfd, 0x33, 0xb1, 0x16, 0xee, 0x42, 0x4a,
+	0x86, 0x75, 0xee, 0xb5, 0x74, 0xa1, 0x7a, 0xc4, 0x87, 0x0b, 0x5e, 0x51, 0x05, 0xed, 0xc8, 0xdd,
+	0x89, 0xe2, 0xe9, 0xef, 0x4e, 0xa8, 0x5b, 0x70, 0x41, 0xc3, 0x1e, 0xb6, 0x8d, 0xc8, 0x6e, 0xe6,
+	0xce, 0x40, 0x8f, 0xa1, 0x9b, 0x36, 0xdc, 0x22, 0x6c, 0xc2, 0xe2, 0xdd, 0xbe, 0x4b, 0x86, 0xf5,
+	0xb9, 0xcf, 0x45, 0xc2, 0x2c, 0x3a, 0x8f, 0xaf, 0xfe, 0x69, 0x01, 0xce, 0xdf, 0x37, 0x0c, 0xee,
+	0xae, 0xf1, 0x08, 0xee, 0x65, 0x05, 0xd7, 0xf1, 0xe0, 0xb3, 0x98, 0x0c, 0x3e, 0x5f, 0x94, 0x2b,
+	0xc3, 0x9d, 0x49, 0x7b, 0x32, 0x0a, 0xfc, 0x6d, 0x97, 0x5d, 0x2a, 0xbc, 0xc7, 0x8b, 0xe9, 0x7d,
+	0xcb, 0x19, 0x72, 0xdf, 0x6e, 0x56, 0x4c, 0x56, 0x0d, 0x32, 0xe9, 0xea, 0x18, 0x3a, 0x49, 0x62,
+	0x2d, 0x28, 0xc4, 0x01, 0x45, 0xc6, 0x0e, 0xab, 0xba, 0x34, 0x48, 0xd8, 0x45, 0x41, 0x3b, 0x8e,
+	0xa7, 0xfe, 0x67, 0x01, 0x3a, 0xbb, 0xfa, 0x11, 0xfe, 0xff, 0x73, 0x40, 0xdf, 0x86, 0x73, 0x9e,
+	0x7e, 0x84, 0xfb, 0x52, 0x32, 0xad, 0xef, 0xe2, 0x67, 0x3c, 0x6c, 0x7d, 0x27, 0x4d, 0x93, 0xa4,
+	0xde, 0xbd, 0xd3, 0x56, 0xbc, 0x08, 0x5c, 0xc3, 0xcf, 0xd0, 0x5b, 0xb0, 0x2c, 0x5f, 0xee, 0x24,
+	0x4b, 0xab, 0x52, 0x92, 0x37, 0xa5, 0xbb, 0x9b, 0x3d, 0x43, 0x7d, 0x06, 0xaf, 0x3f, 0xb6, 0x3d,
+	0xec, 0xf7, 0xc2, 0xfb, 0x87, 0x0b, 0xa6, 0x9d, 0xae, 0x40, 0x3d, 0x24, 0x7c, 0xe2, 0xe5, 0x94,
+	0xe1, 0xa9, 0x0e, 0x74, 0xb7, 0x74, 0xf7, 0x30, 0x50, 0xcb, 0x1b, 0xec, 0x9e, 0xd8, 0x4b, 0x9c,
+	0x70, 0x5f, 0x5c, 0x9b, 0xd4, 0xf0, 0x3e, 0x76, 0xb1, 0x3d, 0xc0, 0x9b, 0xce, 0xe0, 0x50, 0x7a,
+	0x4e, 0x20, 0xbb, 0x23, 0x1b, 0xf3, 0x3e, 0x4f, 0x50, 0x7f, 0x52, 0x80, 0xd5, 0xfb, 0x96, 0x8f,
+	0xdd, 0x30, 0x5b, 0x78, 0x9a, 0xc4, 0x67, 0x98, 0x89, 0x2c, 0xcc, 0x91, 0x89, 0x4c, 0xbc, 0x8c,
+	0x29, 0x26, 0x5f, 0xc6, 0xa4, 0xe5, 0x4d, 0x4b, 0x73, 0xe6, 0x4d, 0xef, 0x03, 0x8c, 0x5d, 0x67,
+	0x8c, 0x5d, 0xdf, 0xc4, 0x41, 0xca, 0x27, 0x87, 0x1f, 0x24, 0x75, 0xba, 0xf9, 0x89, 0xb8, 0xfa,
+	0x4d, 0x1d, 0xb0, 0x25, 0x28, 0x6e, 0xe3, 0xe3, 0xf6, 0x19, 0x04, 0x50, 0xd9, 0x76, 0xdc, 0x91,
+	0x6e, 0xb5, 0x15, 0x54, 0x87, 0x25, 0x5e, 0xe9, 0x6e, 0x17, 0x50, 0x13, 0x6a, 0xeb, 0x41, 0xb5,
+	0xb0, 0x5d, 0xbc, 0xf9, 0x07, 0x0a, 0xac, 0x24, 0x6a, 0xb1, 0xa8, 0x05, 0xf0, 0xd8, 0x1e, 0xf0,
+	0x22, 0x75, 0xfb, 0x0c, 0x6a, 0x40, 0x35, 0x28, 0x59, 0xb3, 0xf1, 0xf6, 0x1c, 0x8a, 0xdd, 0x2e,
+	0xa0, 0x36, 0x34, 0x58, 0xc7, 0xc9, 0x60, 0x80, 0x3d, 0xaf, 0x5d, 0x14, 0x90, 0x87, 0xba, 0x69,
+	0x4d, 0x5c, 0xdc, 0x2e, 0x91, 0x39, 0xf7, 0x1c, 0xfe, 0xf8, 0xa5, 0x5d, 0x46, 0x08, 0x5a, 0xc1,
+	0x4b, 0x18, 0xde, 0xa9, 0x22, 0xc1, 0x82, 0x6e, 0x4b, 0x37, 0x9f, 0xc8, 0x15, 0x35, 0xba, 0xbd,
+	0xf3, 0x70, 0xf6, 0xb1, 0x6d, 0xe0, 0x7d, 0xd3, 0xc6, 0x46, 0xf8, 0xa9, 0x7d, 0x06, 0x9d, 0x85,
+	0xe5, 0x2d, 0xec, 0x0e, 0xb1, 0x04, 0x2c, 0xa0, 0x15, 0x68, 0x6e, 0x99, 0xcf, 0x25, 0x50, 0x51,
+	0x2d, 0x55, 0x95, 0xb6, 0x72, 0xf7, 0xaf, 0x2f, 0x43, 0x8d, 0x1c, 0xca, 0xba, 0xe3, 0xb8, 0x06,
+	0xb2, 0x00, 0xd1, 0xb7, 0x62, 0xa3, 0xb1, 0x63, 0x8b, 0x17, 0x98, 0xe8, 0x76, 0xf4, 0x1c, 0x78,
+	0x23, 0x89, 0xc8, 0xb9, 0xb3, 0xfb, 0x66, 0x2a, 0x7e, 0x0c, 0x59, 0x3d, 0x83, 0x46, 0x74, 0xb6,
+	0x3d, 0x73, 0x84, 0xf7, 0xcc, 0xc1, 0x61, 0xe0, 0x59, 0x7c, 0x25, 0xc3, 0x8f, 0x48, 0xa2, 0x06,
+	0xf3, 0x5d, 0x4b, 0x9d, 0x8f, 0x3d, 0xe6, 0x0b, 0xac, 0x8c, 0x7a, 0x06, 0x3d, 0x83, 0x73, 0x8f,
+	0xb0, 0xe4, 0xa4, 0x05, 0x13, 0xde, 0xcd, 0x9e, 0x30, 0x81, 0x7c, 0xca, 0x29, 0x37, 0xa1, 0x4c,
+	0xd9, 0x0d, 0xa5, 0xf9, 0x71, 0xf2, 0x9f, 0x25, 0x74, 0xaf, 0x66, 0x23, 0x88, 0xd1, 0xbe, 0x0b,
+	0xcb, 0xb1, 0x27, 0xd6, 0x28, 0x4d, 0xab, 0xa7, 0x3f, 0x96, 0xef, 0xde, 0xcc, 0x83, 0x2a, 0xe6,
+	0x1a, 0x42, 0x2b, 0xfa, 0xc6, 0x0c, 0xa5, 0x55, 0x83, 0x52, 0x5f, 0xc7, 0x76, 0xdf, 0xc9, 0x81,
+	0x29, 0x26, 0x1a, 0x41, 0x3b, 0xfe, 0xe4, 0x17, 0xdd, 0x9c, 0x3a, 0x40, 0x94, 0xd9, 0xde, 0xcd,
+	0x85, 0x2b, 0xa6, 0x3b, 0xa1, 0x4c, 0x90, 0x78, 0x45, 0x1a, 0xe7, 0xf1, 0x60, 0x98, 0xac, 0xe7,
+	0xad, 0xdd, 0x3b, 0xb9, 0xf1, 0xc5, 0xd4, 0xbf, 0xca, 0xae, 0xb2, 0xa5, 0xbd, 0xc4, 0x44, 0xef,
+	0xa7, 0x0f, 0x37, 0xe5, 0x09, 0x69, 0xf7, 0xee, 0x69, 0xba, 0x88, 0x45, 0x7c, 0x9f, 0xde, 0x41,
+	0x4b, 0x79, 0xcb, 0x18, 0x97, 0xbb, 0x60, 0xbc, 0xec, 0x67, 0x9a, 0xdd, 0xf7, 0x4f, 0xd1, 0x43,
+	0x2c, 0xc0, 0x89, 0xbf, 0xa9, 0x0e, 0xc4, 0xf0, 0xce, 0x4c, 0xae, 0x99, 0x4f, 0x06, 0xbf, 0x03,
+	0xcb, 0x31, 0x3f, 0x07, 0xe5, 0xf7, 0x85, 0xba, 0xd3, 0x9c, 0x51, 0x26, 0x92, 0xb1, 0x2b, 0x7d,
+	0x28, 0x83, 0xfb, 0x53, 0xae, 0xfd, 0x75, 0x6f, 0xe6, 0x41, 0x15, 0x1b, 0xf1, 0xa8, 0xba, 0x8c,
+	0x5d, 0xd4, 0x42, 0xb7, 0xd2, 0xc7, 0x48, 0xbf, 0x90, 0xd6, 0x7d, 0x2f, 0x27, 0xb6, 0x98, 0xf4,
+	0x08, 0xce, 0xa6, 0xdc, 0xa7, 0x43, 0xef, 0x4d, 0x3d, 0xac, 0xf8, 0x45, 0xc2, 0xee, 0xed, 0xbc,
+	0xe8, 0x62, 0xde, 0x5f, 0x01, 0xb4, 0x7b, 0xe0, 0x1c, 0xaf, 0x3b, 0xf6, 0xbe, 0x39, 0x9c, 0xb8,
+	0x3a, 0xf3, 0x12, 0xb2, 0x6c, 0x43, 0x12, 0x35, 0x83, 0x47, 0xa7, 0xf6, 0x10, 0x93, 0xf7, 0x01,
+	0x1e, 0x61, 0x7f, 0x0b, 0xfb, 0x2e, 0x11, 0x8c, 0xb7, 0xb2, 0xcc, 0x1f, 0x47, 0x08, 0xa6, 0x7a,
+	0x7b, 0x26, 0x9e, 0x64, 0x8a, 0xda, 0x5b, 0xba, 0x3d, 0xd1, 0x2d, 0xe9, 0x41, 0xd0, 0xad, 0xd4,
+	0xee, 0x71, 0xb4, 0x8c, 0x83, 0xcc, 0xc4, 0x16, 0x53, 0x1e, 0x0b, 0xd3, 0x2e, 0x95, 0xef, 0xa7,
+	0x9b, 0xf6, 0xe4, 0xdd, 0xb0, 0xb8, 0xda, 0x9b, 0x82, 0x2f, 0x26, 0xfe, 0x5c, 0xa1, 0x57, 0x32,
+	0x63, 0x08, 0x4f, 0x4c, 0xff, 0x60, 0xc7, 0xd2, 0x6d, 0x2f, 0xcf, 0x12, 0x28, 0xe2, 0x29, 0x96,
+	0xc0, 0xf1, 0xc5, 0x12, 0x0c, 0x68, 0x46, 0xaa, 0xea, 0x28, 0xed, 0x05, 0x4d, 0xda, 0x0d, 0x83,
+	0xee, 0x8d, 0xd9, 0x88, 0x62, 0x96, 0x03, 0x68, 0x06, 0xa2, 0xc4, 0x88, 0xfb, 0x4e, 0xd6, 0x4a,
+	0x43, 0x9c, 0x0c, 0x4d, 0x90, 0x8e, 0x2a, 0x6b, 0x82, 0x64, 0xd1, 0x10, 0xe5, 0x2b, 0x36, 0x4f,
+	0xd3, 0x04, 0xd9, 0x95, 0x48, 0xa6, 0xea, 0x62, 0x05, 0xfa, 0x74, 0x3d, 0x9a, 0x7a, 0xdf, 0x20,
+	0x55, 0xd5, 0x65, 0xd4, 0xfb, 0xd5, 0x33, 0xe8, 0x09, 0x54, 0xf8, 0x3f, 0x04, 0xbd, 0x39, 0x3d,
+	0x97, 0xcd, 0x47, 0xbf, 0x3e, 0x03, 0x4b, 0x1e, 0x98, 0xa5, 0x11, 0x53, 0x07, 0x4e, 0xa4, 0x52,
+	0x53, 0x07, 0x4e, 0xe6, 0x21, 0xd5, 0x33, 0xe8, 0x97, 0xa0, 0x21, 0xa7, 0xc8, 0x53, 0x5d, 0x98,
+	0x8c, 0x1c, 0xfa, 0x2c, 0x1b, 0x73, 0x08, 0xe7, 0x33, 0xd2, 0x8c, 0xa9, 0x6e, 0xc3, 0xf4, 0x94,
+	0x64, 0xee, 0xc9, 0x12, 0x79, 0xc4, 0x29, 0x93, 0x65, 0xe5, 0x1c, 0x67, 0x4d, 0xa6, 0x03, 0x4a,
+	0xfe, 0x4f, 0x41, 0x2a, 0x1f, 0x67, 0xfe, 0x9d, 0x41, 0x8e, 0x29, 0x92, 0x7f, 0x35, 0x90, 0x3a,
+	0x45, 0xe6, 0x3f, 0x12, 0xcc, 0x9a, 0xa2, 0x0f, 0x2b, 0x89, 0x44, 0x13, 0x7a, 0x37, 0xc3, 0xc5,
+	0x48, 0x4b, 0x47, 0xcd, 0x9a, 0x60, 0x08, 0xaf, 0xa5, 0x26, 0x55, 0x52, 0x5d, 0xa6, 0x69, 0xe9,
+	0x97, 0x59, 0x13, 0x0d, 0xe0, 0x6c, 0x4a, 0x2a, 0x25, 0xd5, 0xd8, 0x67, 0xa7, 0x5c, 0x66, 0x4d,
+	0xb2, 0x0f, 0xdd, 0x35, 0xd7, 0xd1, 0x8d, 0x81, 0xee, 0xf9, 0x34, 0xbd, 0x41, 0xe2, 0xd7, 0xc0,
+	0x67, 0x4d, 0x0f, 0x68, 0x52, 0x93, 0x20, 0xb3, 0xe6, 0x79, 0x0a, 0x75, 0xca, 0x90, 0xec, 0x5f,
+	0x73, 0x50, 0xba, 0x75, 0x96, 0x30, 0x32, 0x54, 0x7e, 0x1a, 0x62, 0x20, 0xf5, 0x77, 0xff, 0x03,
+	0xa0, 0x1a, 0x3c, 0xbc, 0xfa, 0x92, 0x83, 0xe7, 0x57, 0x10, 0xcd, 0x7e, 0x07, 0x96, 0x63, 0x7f,
+	0x82, 0x90, 0x7a, 0x5c, 0xe9, 0x7f, 0x94, 0x30, 0xeb, 0xb8, 0x9e, 0xf0, 0xbf, 0xe8, 0x13, 0x8e,
+	0xed, 0xdb, 0x59, 0x11, 0x71, 0xdc, 0xa7, 0x9d, 0x31, 0xf0, 0xff, 0x6d, 0x4f, 0x72, 0x1b, 0x40,
+	0xf2, 0x21, 0xa7, 0x5f, 0x4f, 0x26, 0x6e, 0xd1, 0x2c, 0x6a, 0x8d, 0x52, 0xdd, 0xc4, 0x77, 0xf2,
+	0xdc, 0x04, 0xcd, 0x36, 0xf4, 0xd9, 0xce, 0xe1, 0x63, 0x68, 0xc8, 0x0f, 0x07, 0x50, 0xea, 0x1f,
+	0xc2, 0x25, 0x5f, 0x16, 0xcc, 0xda, 0xc5, 0xd6, 0x29, 0xfd, 0x87, 0xd9, 0xc3, 0x9d, 0xca, 0x6b,
+	0x98, 0x31, 0xdc, 0x4b, 0xf4, 0x15, 0x3c, 0x62, 0xee, 0xe2, 0x75, 0xb0, 0x0c, 0x73, 0x97, 0x51,
+	0x7d, 0x4b, 0xf5, 0x0c, 0xb3, 0x8b, 0x6b, 0x2c, 0x85, 0x13, 0x2f, 0xee, 0xa4, 0xee, 0x29, 0xa3,
+	0x5c, 0x96, 0x9a, 0xc2, 0xc9, 0xaa, 0x16, 0xa9, 0x67, 0xd6, 0x3e, 0xf8, 0xf6, 0xfb, 0x43, 0xd3,
+	0x3f, 0x98, 0x3c, 0x25, 0xbb, 0xbf, 0xc3, 0xba, 0xbe, 0x67, 0x3a, 0xfc, 0xd7, 0x9d, 0x40, 0x30,
+	0xef, 0xd0, 0xd1, 0xee, 0x90, 0xd1, 0xc6, 0x4f, 0x9f, 0x56, 0x68, 0xeb, 0x83, 0xff, 0x09, 0x00,
+	0x00, 0xff, 0xff, 0x79, 0x4c, 0x43, 0xbb, 0x0e, 0x55, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5277,6 +5614,8 @@ type DataCoordClient interface {
 	SetSegmentState(ctx context.Context, in *SetSegmentStateRequest, opts ...grpc.CallOption) (*SetSegmentStateResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*ImportTaskResponse, error)
+	Export(ctx context.Context, in *ExportTaskRequest, opts ...grpc.CallOption) (*ExportTaskResponse, error)
+	CancelImport(ctx context.Context, in *CancelImportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	UpdateSegmentStatistics(ctx context.Context, in *UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	UpdateChannelCheckpoint(ctx context.Context, in *UpdateChannelCheckpointRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AcquireSegmentLock(ctx context.Context, in *AcquireSegmentLockRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
@@ -5521,6 +5860,24 @@ func (c *dataCoordClient) Import(ctx context.Context, in *ImportTaskRequest, opt
 	return out, nil
 }
 
+func (c *dataCoordClient) Export(ctx context.Context, in *ExportTaskRequest, opts ...grpc.CallOption) (*ExportTaskResponse, error) {
+	out := new(ExportTaskResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CancelImport(ctx context.Context, in *CancelImportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CancelImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataCoordClient) UpdateSegmentStatistics(ctx context.Context, in *UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UpdateSegmentStatistics", in, out, opts...)
@@ -5631,6 +5988,8 @@ type DataCoordServer interface {
 	SetSegmentState(context.Context, *SetSegmentStateRequest) (*SetSegmentStateResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(context.Context, *ImportTaskRequest) (*ImportTaskResponse, error)
+	Export(context.Context, *ExportTaskRequest) (*ExportTaskResponse, error)
+	CancelImport(context.Context, *CancelImportTaskRequest) (*commonpb.Status, error)
 	UpdateSegmentStatistics(context.Context, *UpdateSegmentStatisticsRequest) (*commonpb.Status, error)
 	UpdateChannelCheckpoint(context.Context, *UpdateChannelCheckpointRequest) (*commonpb.Status, error)
 	AcquireSegmentLock(context.Context, *AcquireSegmentLockRequest) (*commonpb.Status, error)
@@ -5721,6 +6080,12 @@ func (*UnimplementedDataCoordServer) SetSegmentState(ctx context.Context, req *S
 func (*UnimplementedDataCoordServer) Import(ctx context.Context, req *ImportTaskRequest) (*ImportTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
 }
+func (*UnimplementedDataCoordServer) Export(ctx context.Context, req *ExportTaskRequest) (*ExportTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedDataCoordServer) CancelImport(ctx context.Context, req *CancelImportTaskRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelImport not implemented")
+}
 func (*UnimplementedDataCoordServer) UpdateSegmentStatistics(ctx context.Context, req *UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSegmentStatistics not implemented")
 }
@@ -6203,6 +6568,42 @@ func _DataCoord_Import_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).Export(ctx, req.(*ExportTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_CancelImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelImportTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).CancelImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/CancelImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).CancelImport(ctx, req.(*CancelImportTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataCoord_UpdateSegmentStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateSegmentStatisticsRequest)
 	if err := dec(in); err != nil {
@@ -6469,6 +6870,14 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Import",
 			Handler:    _DataCoord_Import_Handler,
 		},
+		{
+			MethodName: "Export",
+			Handler:    _DataCoord_Export_Handler,
+		},
+		{
+			MethodName: "CancelImport",
+			Handler:    _DataCoord_CancelImport_Handler,
+		},
 		{
 			MethodName: "UpdateSegmentStatistics",
 			Handler:    _DataCoord_UpdateSegmentStatistics_Handler,
@@ -6526,6 +6935,8 @@ type DataNodeClient interface {
 	SyncSegments(ctx context.Context, in *SyncSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	Export(ctx context.Context, in *ExportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CancelImport(ctx context.Context, in *CancelImportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ResendSegmentStats(ctx context.Context, in *ResendSegmentStatsRequest, opts ...grpc.CallOption) (*ResendSegmentStatsResponse, error)
 	AddImportSegment(ctx context.Context, in *AddImportSegmentRequest, opts ...grpc.CallOption) (*AddImportSegmentResponse, error)
 }
@@ -6628,6 +7039,24 @@ func (c *dataNodeClient) Import(ctx context.Context, in *ImportTaskRequest, opts
 	return out, nil
 }
 
+func (c *dataNodeClient) Export(ctx context.Context, in *ExportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataNodeClient) CancelImport(ctx context.Context, in *CancelImportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/CancelImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataNodeClient) ResendSegmentStats(ctx context.Context, in *ResendSegmentStatsRequest, opts ...grpc.CallOption) (*ResendSegmentStatsResponse, error) {
 	out := new(ResendSegmentStatsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/ResendSegmentStats", in, out, opts...)
@@ -6660,6 +7089,8 @@ type DataNodeServer interface {
 	SyncSegments(context.Context, *SyncSegmentsRequest) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(context.Context, *ImportTaskRequest) (*commonpb.Status, error)
+	Export(context.Context, *ExportTaskRequest) (*commonpb.Status, error)
+	CancelImport(context.Context, *CancelImportTaskRequest) (*commonpb.Status, error)
 	ResendSegmentStats(context.Context, *ResendSegmentStatsRequest) (*ResendSegmentStatsResponse, error)
 	AddImportSegment(context.Context, *AddImportSegmentRequest) (*AddImportSegmentResponse, error)
 }
@@ -6698,6 +7129,12 @@ func (*UnimplementedDataNodeServer) SyncSegments(ctx context.Context, req *SyncS
 func (*UnimplementedDataNodeServer) Import(ctx context.Context, req *ImportTaskRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
 }
+func (*UnimplementedDataNodeServer) Export(ctx context.Context, req *ExportTaskRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedDataNodeServer) CancelImport(ctx context.Context, req *CancelImportTaskRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelImport not implemented")
+}
 func (*UnimplementedDataNodeServer) ResendSegmentStats(ctx context.Context, req *ResendSegmentStatsRequest) (*ResendSegmentStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResendSegmentStats not implemented")
 }
@@ -6889,6 +7326,42 @@ func _DataNode_Import_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataNode_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).Export(ctx, req.(*ExportTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataNode_CancelImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelImportTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).CancelImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/CancelImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).CancelImport(ctx, req.(*CancelImportTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataNode_ResendSegmentStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResendSegmentStatsRequest)
 	if err := dec(in); err != nil {
@@ -6969,6 +7442,14 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Import",
 			Handler:    _DataNode_Import_Handler,
 		},
+		{
+			MethodName: "Export",
+			Handler:    _DataNode_Export_Handler,
+		},
+		{
+			MethodName: "CancelImport",
+			Handler:    _DataNode_CancelImport_Handler,
+		},
 		{
 			MethodName: "ResendSegmentStats",
 			Handler:    _DataNode_ResendSegmentStats_Handler,
@@ -8,6 +8,7 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	commonpb "github.com/milvus-io/milvus-proto/go-api/commonpb"
 	schemapb "github.com/milvus-io/milvus-proto/go-api/schemapb"
+	segcorepb "github.com/milvus-io/milvus/internal/proto/segcorepb"
 	math "math"
 )
 
@@ -1164,13 +1165,14 @@ type InsertRequest struct {
 	Timestamps     []uint64          `protobuf:"varint,10,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`
 	RowIDs         []int64           `protobuf:"varint,11,rep,packed,name=rowIDs,proto3" json:"rowIDs,omitempty"`
 	// row_data was reserved for compatibility
-	RowData              []*commonpb.Blob      `protobuf:"bytes,12,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
-	FieldsData           []*schemapb.FieldData `protobuf:"bytes,13,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
-	NumRows              uint64                `protobuf:"varint,14,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	Version              InsertDataVersion     `protobuf:"varint,15,opt,name=version,proto3,enum=milvus.proto.internal.InsertDataVersion" json:"version,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	RowData              []*commonpb.Blob            `protobuf:"bytes,12,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
+	FieldsData           []*schemapb.FieldData       `protobuf:"bytes,13,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	NumRows              uint64                      `protobuf:"varint,14,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	Version              InsertDataVersion           `protobuf:"varint,15,opt,name=version,proto3,enum=milvus.proto.internal.InsertDataVersion" json:"version,omitempty"`
+	ValidData            []*segcorepb.FieldValidData `protobuf:"bytes,16,rep,name=valid_data,json=validData,proto3" json:"valid_data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
 func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
@@ -1303,6 +1305,13 @@ func (m *InsertRequest) GetVersion() InsertDataVersion {
 	return InsertDataVersion_RowBased
 }
 
+func (m *InsertRequest) GetValidData() []*segcorepb.FieldValidData {
+	if m != nil {
+		return m.ValidData
+	}
+	return nil
+}
+
 type SearchRequest struct {
 	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	ReqID        int64             `protobuf:"varint,2,opt,name=reqID,proto3" json:"reqID,omitempty"`
@@ -2612,6 +2621,87 @@ func (m *Rate) GetR() float64 {
 	return 0
 }
 
+// ImportCheckpoint is the progress of a row-based file in an import task. The segments of persisted rows are
+// committed, a resumed task skips the finished files and row groups, and keeps the committed segments.
+type ImportCheckpoint struct {
+	File                 string   `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Finished             bool     `protobuf:"varint,2,opt,name=finished,proto3" json:"finished,omitempty"`
+	RowGroups            int64    `protobuf:"varint,3,opt,name=row_groups,json=rowGroups,proto3" json:"row_groups,omitempty"`
+	Segments             []int64  `protobuf:"varint,4,rep,packed,name=segments,proto3" json:"segments,omitempty"`
+	AutoIds              []int64  `protobuf:"varint,5,rep,packed,name=auto_ids,json=autoIds,proto3" json:"auto_ids,omitempty"`
+	RowCount             int64    `protobuf:"varint,6,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportCheckpoint) Reset()         { *m = ImportCheckpoint{} }
+func (m *ImportCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*ImportCheckpoint) ProtoMessage()    {}
+func (*ImportCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{35}
+}
+
+func (m *ImportCheckpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportCheckpoint.Unmarshal(m, b)
+}
+func (m *ImportCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportCheckpoint.Marshal(b, m, deterministic)
+}
+func (m *ImportCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportCheckpoint.Merge(m, src)
+}
+func (m *ImportCheckpoint) XXX_Size() int {
+	return xxx_messageInfo_ImportCheckpoint.Size(m)
+}
+func (m *ImportCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportCheckpoint proto.InternalMessageInfo
+
+func (m *ImportCheckpoint) GetFile() string {
+	if m != nil {
+		return m.File
+	}
+	return ""
+}
+
+func (m *ImportCheckpoint) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
+func (m *ImportCheckpoint) GetRowGroups() int64 {
+	if m != nil {
+		return m.RowGroups
+	}
+	return 0
+}
+
+func (m *ImportCheckpoint) GetSegments() []int64 {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ImportCheckpoint) GetAutoIds() []int64 {
+	if m != nil {
+		return m.AutoIds
+	}
+	return nil
+}
+
+func (m *ImportCheckpoint) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.internal.InsertDataVersion", InsertDataVersion_name, InsertDataVersion_value)
 	proto.RegisterEnum("milvus.proto.internal.RateType", RateType_name, RateType_value)
@@ -2650,148 +2740,157 @@ func init() {
 	proto.RegisterType((*ShowConfigurationsRequest)(nil), "milvus.proto.internal.ShowConfigurationsRequest")
 	proto.RegisterType((*ShowConfigurationsResponse)(nil), "milvus.proto.internal.ShowConfigurationsResponse")
 	proto.RegisterType((*Rate)(nil), "milvus.proto.internal.Rate")
+	proto.RegisterType((*ImportCheckpoint)(nil), "milvus.proto.internal.ImportCheckpoint")
 }
 
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 2194 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0xdb, 0xc8,
-	0x15, 0x5f, 0x8a, 0x92, 0x25, 0x3d, 0xc9, 0x0a, 0x3d, 0x71, 0xb2, 0x8c, 0x93, 0x6c, 0x1c, 0xf6,
-	0xcb, 0x4d, 0xba, 0x49, 0xea, 0xdd, 0x4d, 0x0a, 0xb4, 0xe8, 0x22, 0xb6, 0xb2, 0x81, 0x11, 0x3b,
-	0x75, 0xe8, 0x20, 0x40, 0x7b, 0x21, 0x46, 0xe2, 0x58, 0x9a, 0x86, 0xe4, 0x30, 0x33, 0x43, 0x3b,
-	0xca, 0xa9, 0x87, 0x9e, 0xba, 0x68, 0x6f, 0xbd, 0x14, 0x68, 0xcf, 0x45, 0x81, 0x02, 0xbd, 0xed,
-	0xb1, 0x40, 0x4f, 0x3d, 0xf5, 0xd4, 0xbf, 0xa6, 0xe8, 0xa1, 0x98, 0x19, 0x92, 0xfa, 0xb0, 0xe2,
-	0xd8, 0x0e, 0x76, 0x37, 0x05, 0xf6, 0xc6, 0x79, 0xef, 0xcd, 0xd7, 0xef, 0xfd, 0xde, 0xe3, 0x7b,
-	0x24, 0x74, 0x68, 0x22, 0x09, 0x4f, 0x70, 0x74, 0x2b, 0xe5, 0x4c, 0x32, 0x74, 0x21, 0xa6, 0xd1,
-	0x41, 0x26, 0xcc, 0xe8, 0x56, 0xa1, 0x5c, 0x69, 0xf7, 0x59, 0x1c, 0xb3, 0xc4, 0x88, 0x57, 0xda,
-	0xa2, 0x3f, 0x24, 0x31, 0x36, 0x23, 0xef, 0x32, 0x5c, 0x7a, 0x48, 0xe4, 0x53, 0x1a, 0x93, 0xa7,
-	0xb4, 0xff, 0x7c, 0x73, 0x88, 0x93, 0x84, 0x44, 0x3e, 0x79, 0x91, 0x11, 0x21, 0xbd, 0xab, 0x70,
-	0xf9, 0x21, 0x91, 0x7b, 0x12, 0x4b, 0x2a, 0x24, 0xed, 0x8b, 0x19, 0xf5, 0x05, 0x38, 0xff, 0x90,
-	0xc8, 0x6e, 0x38, 0x23, 0x7e, 0x06, 0x8d, 0xc7, 0x2c, 0x24, 0x5b, 0xc9, 0x3e, 0x43, 0x77, 0xa1,
-	0x8e, 0xc3, 0x90, 0x13, 0x21, 0x5c, 0x6b, 0xd5, 0x5a, 0x6b, 0xad, 0x5f, 0xb9, 0x35, 0x75, 0xc6,
-	0xfc, 0x64, 0xf7, 0x8d, 0x8d, 0x5f, 0x18, 0x23, 0x04, 0x55, 0xce, 0x22, 0xe2, 0x56, 0x56, 0xad,
-	0xb5, 0xa6, 0xaf, 0x9f, 0xbd, 0x5f, 0x02, 0x6c, 0x25, 0x54, 0xee, 0x62, 0x8e, 0x63, 0x81, 0x2e,
-	0xc2, 0x42, 0xa2, 0x76, 0xe9, 0xea, 0x85, 0x6d, 0x3f, 0x1f, 0xa1, 0x2e, 0xb4, 0x85, 0xc4, 0x5c,
-	0x06, 0xa9, 0xb6, 0x73, 0x2b, 0xab, 0xf6, 0x5a, 0x6b, 0xfd, 0xfa, 0xdc, 0x6d, 0x1f, 0x91, 0xd1,
-	0x33, 0x1c, 0x65, 0x64, 0x17, 0x53, 0xee, 0xb7, 0xf4, 0x34, 0xb3, 0xba, 0xf7, 0x73, 0x80, 0x3d,
-	0xc9, 0x69, 0x32, 0xd8, 0xa6, 0x42, 0xaa, 0xbd, 0x0e, 0x94, 0x9d, 0xba, 0x84, 0xbd, 0xd6, 0xf4,
-	0xf3, 0x11, 0xfa, 0x08, 0x16, 0x84, 0xc4, 0x32, 0x13, 0xfa, 0x9c, 0xad, 0xf5, 0xcb, 0x73, 0x77,
-	0xd9, 0xd3, 0x26, 0x7e, 0x6e, 0xea, 0x7d, 0x0a, 0xad, 0x02, 0xee, 0x1d, 0x31, 0x40, 0x77, 0xa0,
-	0xda, 0xc3, 0x82, 0x1c, 0x0b, 0xcf, 0x8e, 0x18, 0x6c, 0x60, 0x41, 0x7c, 0x6d, 0xe9, 0xfd, 0xb5,
-	0x02, 0xcb, 0x53, 0x6e, 0xc9, 0x81, 0x3f, 0xfd, 0x52, 0x0a, 0xe6, 0xb0, 0xb7, 0xd5, 0xd5, 0xc7,
-	0xb7, 0x7d, 0xfd, 0x8c, 0x3c, 0x68, 0xf7, 0x59, 0x14, 0x91, 0xbe, 0xa4, 0x2c, 0xd9, 0xea, 0xba,
-	0xb6, 0xd6, 0x4d, 0xc9, 0x94, 0x4d, 0x8a, 0xb9, 0xa4, 0x66, 0x28, 0xdc, 0xea, 0xaa, 0xad, 0x6c,
-	0x26, 0x65, 0xe8, 0xfb, 0xe0, 0x48, 0x8e, 0x0f, 0x48, 0x14, 0x48, 0x1a, 0x13, 0x21, 0x71, 0x9c,
-	0xba, 0xb5, 0x55, 0x6b, 0xad, 0xea, 0x9f, 0x33, 0xf2, 0xa7, 0x85, 0x18, 0xdd, 0x86, 0xf3, 0x83,
-	0x0c, 0x73, 0x9c, 0x48, 0x42, 0x26, 0xac, 0x17, 0xb4, 0x35, 0x2a, 0x55, 0xe3, 0x09, 0x37, 0x61,
-	0x49, 0x99, 0xb1, 0x4c, 0x4e, 0x98, 0xd7, 0xb5, 0xb9, 0x93, 0x2b, 0x4a, 0x63, 0xef, 0x0b, 0x0b,
-	0x2e, 0xcc, 0xe0, 0x25, 0x52, 0x96, 0x08, 0x72, 0x06, 0xc0, 0xce, 0xe2, 0x71, 0x74, 0x0f, 0x6a,
-	0xea, 0x49, 0xb8, 0xf6, 0x49, 0xb9, 0x68, 0xec, 0xbd, 0xdf, 0xd8, 0xf0, 0xfe, 0x26, 0x27, 0x58,
-	0x92, 0xcd, 0x12, 0xfd, 0xb3, 0x3b, 0xfb, 0x7d, 0xa8, 0x87, 0xbd, 0x20, 0xc1, 0x71, 0x11, 0x56,
-	0x0b, 0x61, 0xef, 0x31, 0x8e, 0x09, 0xfa, 0x2e, 0x74, 0xc6, 0xde, 0x55, 0x12, 0xed, 0xf3, 0xa6,
-	0x3f, 0x23, 0x45, 0xdf, 0x86, 0xc5, 0xd2, 0xc3, 0xda, 0xac, 0xaa, 0xcd, 0xa6, 0x85, 0x25, 0xa7,
-	0x6a, 0xc7, 0x70, 0x6a, 0x61, 0x0e, 0xa7, 0x56, 0xa1, 0x35, 0xc1, 0x1f, 0xed, 0x4d, 0xdb, 0x9f,
-	0x14, 0xa9, 0x30, 0x34, 0xb9, 0xcb, 0x6d, 0xac, 0x5a, 0x6b, 0x6d, 0x3f, 0x1f, 0xa1, 0x3b, 0x70,
-	0xfe, 0x80, 0x72, 0x99, 0xe1, 0x28, 0xcf, 0x44, 0xea, 0x1c, 0xc2, 0x6d, 0xea, 0x58, 0x9d, 0xa7,
-	0x42, 0xeb, 0xb0, 0x9c, 0x0e, 0x47, 0x82, 0xf6, 0x67, 0xa6, 0x80, 0x9e, 0x32, 0x57, 0xe7, 0xfd,
-	0xc3, 0x82, 0x0b, 0x5d, 0xce, 0xd2, 0x77, 0xc2, 0x15, 0x05, 0xc8, 0xd5, 0x63, 0x40, 0xae, 0x1d,
-	0x05, 0xd9, 0xfb, 0x6d, 0x05, 0x2e, 0x1a, 0x46, 0xed, 0x16, 0xc0, 0x7e, 0x09, 0xb7, 0xf8, 0x1e,
-	0x9c, 0x1b, 0xef, 0x6a, 0x0c, 0xe6, 0x5f, 0xe3, 0x3b, 0xd0, 0x29, 0x1d, 0x6c, 0xec, 0xbe, 0x5a,
-	0x4a, 0x79, 0x9f, 0x57, 0x60, 0x59, 0x39, 0xf5, 0x1b, 0x34, 0x14, 0x1a, 0x7f, 0xb2, 0x00, 0x19,
-	0x76, 0xdc, 0x8f, 0x28, 0x16, 0x5f, 0x27, 0x16, 0xcb, 0x50, 0xc3, 0xea, 0x0c, 0x39, 0x04, 0x66,
-	0xe0, 0x09, 0x70, 0x94, 0xb7, 0xbe, 0xac, 0xd3, 0x95, 0x9b, 0xda, 0x93, 0x9b, 0xfe, 0xd1, 0x82,
-	0xa5, 0xfb, 0x91, 0x24, 0xfc, 0x1d, 0x05, 0xe5, 0xef, 0x95, 0xc2, 0x6b, 0x5b, 0x49, 0x48, 0x5e,
-	0x7e, 0x9d, 0x07, 0xbc, 0x0a, 0xb0, 0x4f, 0x49, 0x14, 0x4e, 0xb2, 0xb7, 0xa9, 0x25, 0x6f, 0xc5,
-	0x5c, 0x17, 0xea, 0x7a, 0x91, 0x92, 0xb5, 0xc5, 0x50, 0x55, 0x7b, 0xe4, 0xa5, 0xe4, 0xb8, 0xa8,
-	0xf6, 0x1a, 0x27, 0xae, 0xf6, 0xf4, 0xb4, 0xbc, 0xda, 0xfb, 0x57, 0x15, 0x16, 0xb7, 0x12, 0x41,
-	0xb8, 0x3c, 0x3b, 0x78, 0x57, 0xa0, 0x29, 0x86, 0x98, 0xeb, 0x8b, 0xe6, 0xf0, 0x8d, 0x05, 0x93,
-	0xd0, 0xda, 0x6f, 0x82, 0xb6, 0x7a, 0xc2, 0xe4, 0x50, 0x3b, 0x2e, 0x39, 0x2c, 0x1c, 0x03, 0x71,
-	0xfd, 0xcd, 0xc9, 0xa1, 0x71, 0xf4, 0xed, 0xab, 0x2e, 0x48, 0x06, 0x31, 0x49, 0xe4, 0x56, 0xd7,
-	0x6d, 0x6a, 0xfd, 0x58, 0x80, 0x3e, 0x00, 0x28, 0x2b, 0x31, 0xf3, 0x1e, 0xad, 0xfa, 0x13, 0x12,
-	0xf5, 0xee, 0xe6, 0xec, 0x50, 0xd5, 0x8a, 0x2d, 0x5d, 0x2b, 0xe6, 0x23, 0xf4, 0x31, 0x34, 0x38,
-	0x3b, 0x0c, 0x42, 0x2c, 0xb1, 0xdb, 0xd6, 0xce, 0xbb, 0x34, 0x17, 0xec, 0x8d, 0x88, 0xf5, 0xfc,
-	0x3a, 0x67, 0x87, 0x5d, 0x2c, 0x31, 0xfa, 0x14, 0x5a, 0x9a, 0x01, 0xc2, 0x4c, 0x5c, 0xd4, 0x13,
-	0x3f, 0x98, 0x9e, 0x98, 0xb7, 0x39, 0x9f, 0x29, 0x3b, 0x35, 0xc9, 0x37, 0xd4, 0x14, 0x7a, 0x81,
-	0x4b, 0xd0, 0x48, 0xb2, 0x38, 0xe0, 0xec, 0x50, 0xb8, 0x1d, 0x5d, 0x37, 0xd6, 0x93, 0x2c, 0xf6,
-	0xd9, 0xa1, 0x40, 0x1b, 0x50, 0x3f, 0x20, 0x5c, 0x50, 0x96, 0xb8, 0xe7, 0x56, 0xad, 0xb5, 0xce,
-	0xfa, 0xda, 0xad, 0xb9, 0x6d, 0xd5, 0x2d, 0xc3, 0x18, 0xb5, 0xdc, 0x33, 0x63, 0xef, 0x17, 0x13,
-	0xbd, 0x7f, 0x57, 0x61, 0x71, 0x8f, 0x60, 0xde, 0x1f, 0x9e, 0x9d, 0x50, 0xcb, 0x50, 0xe3, 0xe4,
-	0x45, 0x59, 0x9c, 0x9b, 0x41, 0xe9, 0x5f, 0xfb, 0x18, 0xff, 0x56, 0x4f, 0x50, 0xb1, 0xd7, 0xe6,
-	0x54, 0xec, 0x0e, 0xd8, 0xa1, 0x88, 0x34, 0x75, 0x9a, 0xbe, 0x7a, 0x54, 0x75, 0x76, 0x1a, 0xe1,
-	0x3e, 0x19, 0xb2, 0x28, 0x24, 0x3c, 0x18, 0x70, 0x96, 0x99, 0x3a, 0xbb, 0xed, 0x3b, 0x13, 0x8a,
-	0x87, 0x4a, 0x8e, 0xee, 0x41, 0x23, 0x14, 0x51, 0x20, 0x47, 0x29, 0xd1, 0xfc, 0xe9, 0xbc, 0xe6,
-	0x9a, 0x5d, 0x11, 0x3d, 0x1d, 0xa5, 0xc4, 0xaf, 0x87, 0xe6, 0x01, 0xdd, 0x81, 0x65, 0x41, 0x38,
-	0xc5, 0x11, 0x7d, 0x45, 0xc2, 0x80, 0xbc, 0x4c, 0x79, 0x90, 0x46, 0x38, 0xd1, 0x24, 0x6b, 0xfb,
-	0x68, 0xac, 0x7b, 0xf0, 0x32, 0xe5, 0xbb, 0x11, 0x4e, 0xd0, 0x1a, 0x38, 0x2c, 0x93, 0x69, 0x26,
-	0x83, 0x9c, 0x06, 0x34, 0xd4, 0x9c, 0xb3, 0xfd, 0x8e, 0x91, 0x6b, 0xaf, 0x8b, 0xad, 0x70, 0x6e,
-	0x17, 0xd2, 0x3a, 0x55, 0x17, 0xd2, 0x3e, 0x5d, 0x17, 0xb2, 0x38, 0xbf, 0x0b, 0x41, 0x1d, 0xa8,
-	0x24, 0x2f, 0x34, 0xd7, 0x6c, 0xbf, 0x92, 0xbc, 0x50, 0x8e, 0x94, 0x2c, 0x7d, 0xae, 0x39, 0x66,
-	0xfb, 0xfa, 0x59, 0x05, 0x51, 0x4c, 0x24, 0xa7, 0x7d, 0x05, 0x8b, 0xeb, 0x68, 0x3f, 0x4c, 0x48,
-	0xbc, 0xff, 0xda, 0x63, 0x5a, 0x89, 0x2c, 0x92, 0xe2, 0xab, 0xea, 0x60, 0x4a, 0x2e, 0xda, 0x93,
-	0x5c, 0xbc, 0x06, 0x2d, 0x73, 0x38, 0xe3, 0xf3, 0xea, 0xec, 0x79, 0x95, 0x81, 0x8a, 0xb2, 0x17,
-	0x19, 0xe1, 0x94, 0x88, 0x3c, 0xed, 0x43, 0x92, 0xc5, 0x4f, 0x8c, 0x04, 0x9d, 0x87, 0x9a, 0x64,
-	0x69, 0xf0, 0xbc, 0x48, 0x57, 0x92, 0xa5, 0x8f, 0xd0, 0x4f, 0x60, 0x45, 0x10, 0x1c, 0x91, 0x30,
-	0x28, 0xd3, 0x8b, 0x08, 0x84, 0xbe, 0x36, 0x09, 0xdd, 0xba, 0x76, 0xb3, 0x6b, 0x2c, 0xf6, 0x4a,
-	0x83, 0xbd, 0x5c, 0xaf, 0xbc, 0xd8, 0x37, 0x65, 0xfb, 0xd4, 0xb4, 0x86, 0xae, 0xec, 0xd1, 0x58,
-	0x55, 0x4e, 0xf8, 0x11, 0xb8, 0x83, 0x88, 0xf5, 0x70, 0x14, 0x1c, 0xd9, 0x55, 0xb7, 0x10, 0xb6,
-	0x7f, 0xd1, 0xe8, 0xf7, 0x66, 0xb6, 0x54, 0xd7, 0x13, 0x11, 0xed, 0x93, 0x30, 0xe8, 0x45, 0xac,
-	0xe7, 0x82, 0xa6, 0x2b, 0x18, 0x91, 0xca, 0x57, 0x8a, 0xa6, 0xb9, 0x81, 0x82, 0xa1, 0xcf, 0xb2,
-	0x44, 0x6a, 0xf2, 0xd9, 0x7e, 0xc7, 0xc8, 0x1f, 0x67, 0xf1, 0xa6, 0x92, 0xa2, 0x6f, 0xc1, 0x62,
-	0x6e, 0xc9, 0xf6, 0xf7, 0x05, 0x91, 0x9a, 0x75, 0xb6, 0xdf, 0x36, 0xc2, 0x9f, 0x69, 0x99, 0xf7,
-	0x37, 0x1b, 0xce, 0xf9, 0x0a, 0x5d, 0x72, 0x40, 0xfe, 0x9f, 0xf2, 0xca, 0xeb, 0xe2, 0x7b, 0xe1,
-	0x54, 0xf1, 0x5d, 0x3f, 0x71, 0x7c, 0x37, 0x4e, 0x15, 0xdf, 0xcd, 0xd3, 0xc5, 0x37, 0xbc, 0x26,
-	0xbe, 0x97, 0xa1, 0x16, 0xd1, 0x98, 0x16, 0x0e, 0x36, 0x03, 0xef, 0xcf, 0x53, 0x2e, 0x7b, 0x07,
-	0x62, 0xf6, 0x06, 0xd8, 0x34, 0x34, 0x05, 0x64, 0x6b, 0xdd, 0x9d, 0xfb, 0xc6, 0xdc, 0xea, 0x0a,
-	0x5f, 0x19, 0xcd, 0xbe, 0x65, 0x6b, 0xa7, 0x7e, 0xcb, 0xfe, 0x14, 0x2e, 0x1f, 0x8d, 0x64, 0x9e,
-	0xc3, 0x11, 0xba, 0x0b, 0xda, 0xa3, 0x97, 0x66, 0x43, 0xb9, 0xc0, 0x2b, 0x44, 0x3f, 0x84, 0xe5,
-	0x89, 0x58, 0x1e, 0x4f, 0xac, 0x9b, 0xce, 0x7e, 0xac, 0x1b, 0x4f, 0x39, 0x2e, 0x9a, 0x1b, 0xc7,
-	0x45, 0xb3, 0xf7, 0x4f, 0x1b, 0x16, 0xbb, 0x24, 0x22, 0x92, 0x7c, 0x53, 0x04, 0xbe, 0xb6, 0x08,
-	0xfc, 0x01, 0x20, 0x9a, 0xc8, 0xbb, 0x1f, 0x07, 0x29, 0xa7, 0x31, 0xe6, 0xa3, 0xe0, 0x39, 0x19,
-	0x15, 0x69, 0xd2, 0xd1, 0x9a, 0x5d, 0xa3, 0x78, 0x44, 0x46, 0xe2, 0x8d, 0x45, 0xe1, 0x64, 0x15,
-	0x66, 0xc2, 0xa6, 0xac, 0xc2, 0x7e, 0x0c, 0xed, 0xa9, 0x2d, 0xda, 0x6f, 0x20, 0x6c, 0x2b, 0x1d,
-	0xef, 0xeb, 0xfd, 0xc7, 0x82, 0xe6, 0x36, 0xc3, 0xa1, 0xee, 0x87, 0xce, 0xe8, 0xc6, 0xb2, 0xd4,
-	0xad, 0xcc, 0x96, 0xba, 0x57, 0x60, 0xdc, 0xd2, 0xe4, 0x8e, 0x9c, 0xe8, 0x71, 0x26, 0x7a, 0x95,
-	0xea, 0x74, 0xaf, 0x72, 0x0d, 0x5a, 0x54, 0x1d, 0x28, 0x48, 0xb1, 0x1c, 0x9a, 0x4c, 0xd9, 0xf4,
-	0x41, 0x8b, 0x76, 0x95, 0x44, 0x35, 0x33, 0x85, 0x81, 0x6e, 0x66, 0x16, 0x4e, 0xdc, 0xcc, 0xe4,
-	0x8b, 0xe8, 0x66, 0xe6, 0xd7, 0x16, 0x80, 0xbe, 0xb8, 0xca, 0x07, 0x47, 0x17, 0xb5, 0xce, 0xb2,
-	0xa8, 0x4a, 0xe1, 0xda, 0x53, 0x24, 0xc2, 0x72, 0x1c, 0x54, 0x22, 0x07, 0x07, 0x29, 0xaf, 0x19,
-	0x55, 0x1e, 0x50, 0xc2, 0xfb, 0x9d, 0x05, 0xa0, 0xb3, 0x82, 0x39, 0xc6, 0x2c, 0xfd, 0xac, 0xe3,
-	0xdb, 0xbc, 0xca, 0x34, 0x74, 0x1b, 0x05, 0x74, 0xc7, 0x7c, 0x47, 0x9d, 0xa8, 0xcb, 0x8b, 0xcb,
-	0xe7, 0xe8, 0xea, 0x67, 0xef, 0xf7, 0x16, 0xb4, 0xf3, 0xd3, 0x99, 0x23, 0x4d, 0x79, 0xd9, 0x9a,
-	0xf5, 0xb2, 0x2e, 0x6e, 0x62, 0xc6, 0x47, 0x81, 0xa0, 0xaf, 0x48, 0x7e, 0x20, 0x30, 0xa2, 0x3d,
-	0xfa, 0x8a, 0x4c, 0x91, 0xd7, 0x9e, 0x26, 0xef, 0x4d, 0x58, 0xe2, 0xa4, 0x4f, 0x12, 0x19, 0x8d,
-	0x82, 0x98, 0x85, 0x74, 0x9f, 0x92, 0x50, 0xb3, 0xa1, 0xe1, 0x3b, 0x85, 0x62, 0x27, 0x97, 0x7b,
-	0xbf, 0xb2, 0xa0, 0xb5, 0x23, 0x06, 0xbb, 0x4c, 0xe8, 0x20, 0x43, 0xd7, 0xa1, 0x9d, 0x27, 0x36,
-	0x13, 0xe1, 0x96, 0x66, 0x58, 0xab, 0x3f, 0xfe, 0x16, 0xa9, 0x52, 0x7b, 0x2c, 0x06, 0x39, 0x4c,
-	0x6d, 0xdf, 0x0c, 0xd0, 0x0a, 0x34, 0x62, 0x31, 0xd0, 0xb5, 0x78, 0x4e, 0xcb, 0x72, 0xac, 0xee,
-	0x3a, 0x7e, 0x85, 0x55, 0xf5, 0x2b, 0x6c, 0x2c, 0xf0, 0xbe, 0xb0, 0x00, 0xe5, 0xdf, 0x3a, 0xdf,
-	0xea, 0xd7, 0x84, 0xf6, 0xf2, 0xe4, 0xf7, 0xd4, 0x8a, 0xe6, 0xf8, 0x94, 0x6c, 0x26, 0x29, 0xd8,
-	0x47, 0x92, 0xc2, 0x4d, 0x58, 0x0a, 0xc9, 0x3e, 0xce, 0xa2, 0xc9, 0xb7, 0xae, 0x39, 0xb2, 0x93,
-	0x2b, 0xa6, 0xbe, 0xed, 0x77, 0x36, 0x39, 0x09, 0x49, 0x22, 0x29, 0x8e, 0xf4, 0x2f, 0xa7, 0x15,
-	0x68, 0x64, 0x42, 0x31, 0xa1, 0xc4, 0xae, 0x1c, 0xa3, 0x0f, 0x01, 0x91, 0xa4, 0xcf, 0x47, 0xa9,
-	0x22, 0x71, 0x8a, 0x85, 0x38, 0x64, 0x3c, 0xcc, 0x13, 0xf5, 0x52, 0xa9, 0xd9, 0xcd, 0x15, 0xaa,
-	0x69, 0x95, 0x24, 0xc1, 0x89, 0x2c, 0xf2, 0xb5, 0x19, 0x29, 0xd7, 0x53, 0x11, 0x88, 0x2c, 0x25,
-	0x3c, 0x77, 0x6b, 0x9d, 0x8a, 0x3d, 0x35, 0x54, 0xa9, 0x5c, 0x0c, 0xf1, 0xfa, 0x27, 0x77, 0xc7,
-	0xcb, 0x9b, 0x14, 0xdd, 0x31, 0xe2, 0x62, 0x6d, 0xef, 0x01, 0x2c, 0x6d, 0x53, 0x21, 0x77, 0x59,
-	0x44, 0xfb, 0xa3, 0x33, 0xbf, 0x71, 0xbc, 0xcf, 0x2d, 0x40, 0x93, 0xeb, 0xe4, 0x7f, 0x36, 0xc6,
-	0x15, 0x83, 0x75, 0xf2, 0x8a, 0xe1, 0x3a, 0xb4, 0x53, 0xbd, 0x4c, 0x40, 0x93, 0x7d, 0x56, 0x78,
-	0xaf, 0x65, 0x64, 0x0a, 0x5b, 0x81, 0xae, 0x02, 0x28, 0x30, 0x03, 0xce, 0x22, 0x62, 0x9c, 0xd7,
-	0xf4, 0x9b, 0x4a, 0xe2, 0x2b, 0x81, 0x37, 0x80, 0x4b, 0x7b, 0x43, 0x76, 0xb8, 0xc9, 0x92, 0x7d,
-	0x3a, 0xc8, 0x38, 0x56, 0x84, 0x7e, 0x8b, 0x2f, 0x66, 0x2e, 0xd4, 0x53, 0x2c, 0x55, 0x58, 0xe7,
-	0x3e, 0x2a, 0x86, 0xde, 0x1f, 0x2c, 0x58, 0x99, 0xb7, 0xd3, 0xdb, 0x5c, 0xff, 0x21, 0x2c, 0xf6,
-	0xcd, 0x72, 0x66, 0xb5, 0x93, 0xff, 0x3a, 0x9c, 0x9e, 0xe7, 0x3d, 0x80, 0xaa, 0x8f, 0x25, 0x41,
-	0xb7, 0xa1, 0xc2, 0xa5, 0x3e, 0x41, 0x67, 0xfd, 0xda, 0x6b, 0x92, 0x95, 0x32, 0xd4, 0xdd, 0x70,
-	0x85, 0x4b, 0xd4, 0x06, 0x8b, 0xeb, 0x9b, 0x5a, 0xbe, 0xc5, 0x6f, 0xac, 0xc3, 0xd2, 0x91, 0x4f,
-	0x0c, 0xa8, 0x0d, 0x0d, 0x9f, 0x1d, 0x2a, 0x8c, 0x42, 0xe7, 0x3d, 0x74, 0x0e, 0x5a, 0x9b, 0x2c,
-	0xca, 0xe2, 0xc4, 0x08, 0xac, 0x1b, 0x7f, 0xb1, 0xa0, 0x51, 0x2c, 0x89, 0x96, 0x60, 0xb1, 0xdb,
-	0xdd, 0x1e, 0xff, 0xaf, 0x70, 0xde, 0x43, 0x0e, 0xb4, 0xbb, 0xdd, 0xed, 0xf2, 0x6b, 0xb7, 0x63,
-	0xa9, 0x05, 0xbb, 0xdd, 0x6d, 0x9d, 0x33, 0x9d, 0x4a, 0x3e, 0xfa, 0x2c, 0xca, 0xc4, 0xd0, 0xb1,
-	0xcb, 0x05, 0xe2, 0x14, 0x9b, 0x05, 0xaa, 0x68, 0x11, 0x9a, 0xdd, 0x9d, 0x6d, 0x73, 0x2e, 0xa7,
-	0x96, 0x0f, 0x4d, 0xd9, 0xe4, 0x2c, 0xa8, 0xf3, 0x74, 0x77, 0xb6, 0x37, 0xb2, 0xe8, 0xb9, 0x7a,
-	0xfd, 0x3a, 0x75, 0xad, 0x7f, 0xb2, 0x6d, 0x7a, 0x2d, 0xa7, 0xa1, 0x97, 0x7f, 0xb2, 0xad, 0xba,
-	0xbf, 0x91, 0xd3, 0xdc, 0xb8, 0xf7, 0x8b, 0x4f, 0x06, 0x54, 0x0e, 0xb3, 0x9e, 0x02, 0xf5, 0xb6,
-	0xc1, 0xe7, 0x43, 0xca, 0xf2, 0xa7, 0xdb, 0x05, 0x46, 0xb7, 0x35, 0x64, 0xe5, 0x30, 0xed, 0xf5,
-	0x16, 0xb4, 0xe4, 0xa3, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xad, 0xe9, 0xa6, 0xf9, 0x00, 0x1f,
+	// 2322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x6f, 0xdc, 0xc6,
+	0x15, 0x0f, 0x97, 0xbb, 0xda, 0xdd, 0xb7, 0xab, 0x35, 0x35, 0x96, 0x13, 0x5a, 0x4e, 0x62, 0x99,
+	0x4d, 0x5b, 0xd5, 0x6e, 0x6c, 0x57, 0x49, 0xec, 0x02, 0x2d, 0x1a, 0x58, 0x5a, 0xc7, 0x10, 0x2c,
+	0xb9, 0x32, 0x65, 0x18, 0x68, 0x2f, 0xc4, 0xec, 0x72, 0xb4, 0x3b, 0x15, 0xc9, 0xa1, 0x67, 0x86,
+	0x92, 0xd7, 0xa7, 0x1e, 0x7a, 0x6a, 0xd0, 0x5e, 0x8a, 0x5e, 0x0a, 0xb4, 0xe7, 0xa2, 0x40, 0x81,
+	0x5e, 0x8a, 0x1c, 0x0b, 0xf4, 0xd4, 0x0f, 0xd0, 0x4f, 0x53, 0xf4, 0x50, 0xcc, 0x0c, 0xc9, 0xfd,
+	0xa3, 0xb5, 0x2c, 0xc9, 0x48, 0xe2, 0x02, 0xb9, 0xf1, 0xfd, 0x99, 0xe1, 0x9b, 0xf7, 0x7e, 0xef,
+	0xcd, 0x7b, 0x24, 0x74, 0x68, 0x22, 0x09, 0x4f, 0x70, 0x74, 0x33, 0xe5, 0x4c, 0x32, 0x74, 0x29,
+	0xa6, 0xd1, 0x61, 0x26, 0x0c, 0x75, 0xb3, 0x10, 0xae, 0xb4, 0xfb, 0x2c, 0x8e, 0x59, 0x62, 0xd8,
+	0x2b, 0x6d, 0xd1, 0x1f, 0x92, 0x18, 0xe7, 0xd4, 0xa2, 0x20, 0x83, 0x3e, 0xe3, 0xc4, 0x90, 0xde,
+	0x15, 0xb8, 0xfc, 0x80, 0xc8, 0x27, 0x34, 0x26, 0x4f, 0x68, 0xff, 0x60, 0x73, 0x88, 0x93, 0x84,
+	0x44, 0x3e, 0x79, 0x96, 0x11, 0x21, 0xbd, 0xf7, 0xe0, 0xca, 0x03, 0x22, 0xf7, 0x24, 0x96, 0x54,
+	0x48, 0xda, 0x17, 0x33, 0xe2, 0x4b, 0x70, 0xf1, 0x01, 0x91, 0xdd, 0x70, 0x86, 0xfd, 0x14, 0x1a,
+	0x8f, 0x58, 0x48, 0xb6, 0x92, 0x7d, 0x86, 0xee, 0x40, 0x1d, 0x87, 0x21, 0x27, 0x42, 0xb8, 0xd6,
+	0xaa, 0xb5, 0xd6, 0x5a, 0x7f, 0xf7, 0xe6, 0x94, 0xc9, 0xb9, 0xa1, 0xf7, 0x8c, 0x8e, 0x5f, 0x28,
+	0x23, 0x04, 0x55, 0xce, 0x22, 0xe2, 0x56, 0x56, 0xad, 0xb5, 0xa6, 0xaf, 0x9f, 0xbd, 0x5f, 0x00,
+	0x6c, 0x25, 0x54, 0xee, 0x62, 0x8e, 0x63, 0x81, 0xde, 0x86, 0x85, 0x44, 0xbd, 0xa5, 0xab, 0x37,
+	0xb6, 0xfd, 0x9c, 0x42, 0x5d, 0x68, 0x0b, 0x89, 0xb9, 0x0c, 0x52, 0xad, 0xe7, 0x56, 0x56, 0xed,
+	0xb5, 0xd6, 0xfa, 0xb5, 0xb9, 0xaf, 0x7d, 0x48, 0x46, 0x4f, 0x71, 0x94, 0x91, 0x5d, 0x4c, 0xb9,
+	0xdf, 0xd2, 0xcb, 0xcc, 0xee, 0xde, 0xcf, 0x00, 0xf6, 0x24, 0xa7, 0xc9, 0x60, 0x9b, 0x0a, 0xa9,
+	0xde, 0x75, 0xa8, 0xf4, 0xd4, 0x21, 0xec, 0xb5, 0xa6, 0x9f, 0x53, 0xe8, 0x23, 0x58, 0x10, 0x12,
+	0xcb, 0x4c, 0x68, 0x3b, 0x5b, 0xeb, 0x57, 0xe6, 0xbe, 0x65, 0x4f, 0xab, 0xf8, 0xb9, 0xaa, 0xf7,
+	0x29, 0xb4, 0x0a, 0x77, 0xef, 0x88, 0x01, 0xba, 0x0d, 0xd5, 0x1e, 0x16, 0xe4, 0x44, 0xf7, 0xec,
+	0x88, 0xc1, 0x06, 0x16, 0xc4, 0xd7, 0x9a, 0xde, 0x5f, 0x2b, 0xb0, 0x3c, 0x15, 0x96, 0xdc, 0xf1,
+	0x67, 0xdf, 0x4a, 0xb9, 0x39, 0xec, 0x6d, 0x75, 0xb5, 0xf9, 0xb6, 0xaf, 0x9f, 0x91, 0x07, 0xed,
+	0x3e, 0x8b, 0x22, 0xd2, 0x97, 0x94, 0x25, 0x5b, 0x5d, 0xd7, 0xd6, 0xb2, 0x29, 0x9e, 0xd2, 0x49,
+	0x31, 0x97, 0xd4, 0x90, 0xc2, 0xad, 0xae, 0xda, 0x4a, 0x67, 0x92, 0x87, 0xbe, 0x07, 0x8e, 0xe4,
+	0xf8, 0x90, 0x44, 0x81, 0xa4, 0x31, 0x11, 0x12, 0xc7, 0xa9, 0x5b, 0x5b, 0xb5, 0xd6, 0xaa, 0xfe,
+	0x05, 0xc3, 0x7f, 0x52, 0xb0, 0xd1, 0x2d, 0xb8, 0x38, 0xc8, 0x30, 0xc7, 0x89, 0x24, 0x64, 0x42,
+	0x7b, 0x41, 0x6b, 0xa3, 0x52, 0x34, 0x5e, 0x70, 0x03, 0x96, 0x94, 0x1a, 0xcb, 0xe4, 0x84, 0x7a,
+	0x5d, 0xab, 0x3b, 0xb9, 0xa0, 0x54, 0xf6, 0xbe, 0xb0, 0xe0, 0xd2, 0x8c, 0xbf, 0x44, 0xca, 0x12,
+	0x41, 0xce, 0xe1, 0xb0, 0xf3, 0x44, 0x1c, 0xdd, 0x85, 0x9a, 0x7a, 0x12, 0xae, 0x7d, 0x5a, 0x2c,
+	0x1a, 0x7d, 0xef, 0xd7, 0x36, 0xbc, 0xb3, 0xc9, 0x09, 0x96, 0x64, 0xb3, 0xf4, 0xfe, 0xf9, 0x83,
+	0xfd, 0x0e, 0xd4, 0xc3, 0x5e, 0x90, 0xe0, 0xb8, 0x48, 0xab, 0x85, 0xb0, 0xf7, 0x08, 0xc7, 0x04,
+	0x7d, 0x07, 0x3a, 0xe3, 0xe8, 0x2a, 0x8e, 0x8e, 0x79, 0xd3, 0x9f, 0xe1, 0xa2, 0x0f, 0x60, 0xb1,
+	0x8c, 0xb0, 0x56, 0xab, 0x6a, 0xb5, 0x69, 0x66, 0x89, 0xa9, 0xda, 0x09, 0x98, 0x5a, 0x98, 0x83,
+	0xa9, 0x55, 0x68, 0x4d, 0xe0, 0x47, 0x47, 0xd3, 0xf6, 0x27, 0x59, 0x2a, 0x0d, 0x4d, 0x29, 0x73,
+	0x1b, 0xab, 0xd6, 0x5a, 0xdb, 0xcf, 0x29, 0x74, 0x1b, 0x2e, 0x1e, 0x52, 0x2e, 0x33, 0x1c, 0xe5,
+	0x95, 0x48, 0xd9, 0x21, 0xdc, 0xa6, 0xce, 0xd5, 0x79, 0x22, 0xb4, 0x0e, 0xcb, 0xe9, 0x70, 0x24,
+	0x68, 0x7f, 0x66, 0x09, 0xe8, 0x25, 0x73, 0x65, 0xde, 0x3f, 0x2d, 0xb8, 0xd4, 0xe5, 0x2c, 0x7d,
+	0x23, 0x42, 0x51, 0x38, 0xb9, 0x7a, 0x82, 0x93, 0x6b, 0xc7, 0x9d, 0xec, 0xfd, 0xa6, 0x02, 0x6f,
+	0x1b, 0x44, 0xed, 0x16, 0x8e, 0xfd, 0x12, 0x4e, 0xf1, 0x5d, 0xb8, 0x30, 0x7e, 0xab, 0x51, 0x98,
+	0x7f, 0x8c, 0x6f, 0x43, 0xa7, 0x0c, 0xb0, 0xd1, 0xfb, 0x6a, 0x21, 0xe5, 0x7d, 0x5e, 0x81, 0x65,
+	0x15, 0xd4, 0x6f, 0xbc, 0xa1, 0xbc, 0xf1, 0x27, 0x0b, 0x90, 0x41, 0xc7, 0xbd, 0x88, 0x62, 0xf1,
+	0x75, 0xfa, 0x62, 0x19, 0x6a, 0x58, 0xd9, 0x90, 0xbb, 0xc0, 0x10, 0x9e, 0x00, 0x47, 0x45, 0xeb,
+	0xcb, 0xb2, 0xae, 0x7c, 0xa9, 0x3d, 0xf9, 0xd2, 0x3f, 0x5a, 0xb0, 0x74, 0x2f, 0x92, 0x84, 0xbf,
+	0xa1, 0x4e, 0xf9, 0x47, 0xa5, 0x88, 0xda, 0x56, 0x12, 0x92, 0xe7, 0x5f, 0xa7, 0x81, 0xef, 0x01,
+	0xec, 0x53, 0x12, 0x85, 0x93, 0xe8, 0x6d, 0x6a, 0xce, 0x6b, 0x21, 0xd7, 0x85, 0xba, 0xde, 0xa4,
+	0x44, 0x6d, 0x41, 0xaa, 0x6e, 0x8f, 0x3c, 0x97, 0x1c, 0x17, 0xdd, 0x5e, 0xe3, 0xd4, 0xdd, 0x9e,
+	0x5e, 0x96, 0x77, 0x7b, 0xbf, 0xab, 0xc1, 0xe2, 0x56, 0x22, 0x08, 0x97, 0xe7, 0x77, 0xde, 0xbb,
+	0xd0, 0x14, 0x43, 0xcc, 0xf5, 0x41, 0x73, 0xf7, 0x8d, 0x19, 0x93, 0xae, 0xb5, 0x5f, 0xe5, 0xda,
+	0xea, 0x29, 0x8b, 0x43, 0xed, 0xa4, 0xe2, 0xb0, 0x70, 0x82, 0x8b, 0xeb, 0xaf, 0x2e, 0x0e, 0x8d,
+	0xe3, 0xb7, 0xaf, 0x3a, 0x20, 0x19, 0xc4, 0x24, 0x91, 0x5b, 0x5d, 0xb7, 0xa9, 0xe5, 0x63, 0x06,
+	0x7a, 0x1f, 0xa0, 0xec, 0xc4, 0xcc, 0x3d, 0x5a, 0xf5, 0x27, 0x38, 0xea, 0xee, 0xe6, 0xec, 0x48,
+	0xf5, 0x8a, 0x2d, 0xdd, 0x2b, 0xe6, 0x14, 0xfa, 0x18, 0x1a, 0x9c, 0x1d, 0x05, 0x21, 0x96, 0xd8,
+	0x6d, 0xeb, 0xe0, 0x5d, 0x9e, 0xeb, 0xec, 0x8d, 0x88, 0xf5, 0xfc, 0x3a, 0x67, 0x47, 0x5d, 0x2c,
+	0x31, 0xfa, 0x14, 0x5a, 0x1a, 0x01, 0xc2, 0x2c, 0x5c, 0xd4, 0x0b, 0xdf, 0x9f, 0x5e, 0x98, 0x4f,
+	0x3d, 0x9f, 0x29, 0x3d, 0xb5, 0xc8, 0x37, 0xd0, 0x14, 0x7a, 0x83, 0xcb, 0xd0, 0x48, 0xb2, 0x38,
+	0xe0, 0xec, 0x48, 0xb8, 0x1d, 0xdd, 0x37, 0xd6, 0x93, 0x2c, 0xf6, 0xd9, 0x91, 0x40, 0x1b, 0x50,
+	0x3f, 0x24, 0x5c, 0x50, 0x96, 0xb8, 0x17, 0x56, 0xad, 0xb5, 0xce, 0xfa, 0xda, 0xcd, 0xb9, 0x53,
+	0xd6, 0x4d, 0x83, 0x18, 0xb5, 0xdd, 0x53, 0xa3, 0xef, 0x17, 0x0b, 0xd1, 0x26, 0xc0, 0x21, 0x8e,
+	0x68, 0x68, 0xcc, 0x73, 0xb4, 0x79, 0x1f, 0xcc, 0x98, 0x97, 0x8f, 0x61, 0xda, 0xbe, 0xa7, 0x4a,
+	0x59, 0x1b, 0xd9, 0x3c, 0x2c, 0x1e, 0xbd, 0x7f, 0x57, 0x61, 0x71, 0x8f, 0x60, 0xde, 0x1f, 0x9e,
+	0x1f, 0x95, 0xcb, 0x50, 0xe3, 0xe4, 0x59, 0xd9, 0xe1, 0x1b, 0xa2, 0x04, 0x89, 0x7d, 0x02, 0x48,
+	0xaa, 0xa7, 0x68, 0xfb, 0x6b, 0x73, 0xda, 0x7e, 0x07, 0xec, 0x50, 0x44, 0x1a, 0x7f, 0x4d, 0x5f,
+	0x3d, 0xaa, 0x66, 0x3d, 0x8d, 0x70, 0x9f, 0x0c, 0x59, 0x14, 0x12, 0x1e, 0x0c, 0x38, 0xcb, 0x4c,
+	0xb3, 0xde, 0xf6, 0x9d, 0x09, 0xc1, 0x03, 0xc5, 0x47, 0x77, 0xa1, 0x11, 0x8a, 0x28, 0x90, 0xa3,
+	0x94, 0x68, 0x10, 0x76, 0x5e, 0x72, 0xcc, 0xae, 0x88, 0x9e, 0x8c, 0x52, 0xe2, 0xd7, 0x43, 0xf3,
+	0x80, 0x6e, 0xc3, 0xb2, 0x20, 0x9c, 0xe2, 0x88, 0xbe, 0x20, 0x61, 0x40, 0x9e, 0xa7, 0x3c, 0x48,
+	0x23, 0x9c, 0x68, 0xa4, 0xb6, 0x7d, 0x34, 0x96, 0xdd, 0x7f, 0x9e, 0xf2, 0xdd, 0x08, 0x27, 0x68,
+	0x0d, 0x1c, 0x96, 0xc9, 0x34, 0x93, 0x41, 0x8e, 0x25, 0x1a, 0x6a, 0xe0, 0xda, 0x7e, 0xc7, 0xf0,
+	0x75, 0x68, 0xc4, 0x56, 0x38, 0x77, 0x94, 0x69, 0x9d, 0x69, 0x94, 0x69, 0x9f, 0x6d, 0x94, 0x59,
+	0x9c, 0x3f, 0xca, 0xa0, 0x0e, 0x54, 0x92, 0x67, 0x1a, 0xb0, 0xb6, 0x5f, 0x49, 0x9e, 0xa9, 0x40,
+	0x4a, 0x96, 0x1e, 0x68, 0xa0, 0xda, 0xbe, 0x7e, 0x56, 0x99, 0x18, 0x13, 0xc9, 0x69, 0x5f, 0xb9,
+	0xc5, 0x75, 0x74, 0x1c, 0x26, 0x38, 0xde, 0x7f, 0xed, 0x31, 0xac, 0x44, 0x16, 0x49, 0xf1, 0x55,
+	0x8d, 0x41, 0x25, 0x16, 0xed, 0x49, 0x2c, 0x5e, 0x85, 0x96, 0x31, 0xce, 0xc4, 0xbc, 0x3a, 0x6b,
+	0xaf, 0x52, 0x50, 0xa9, 0xfa, 0x2c, 0x23, 0x9c, 0x12, 0x91, 0xdf, 0x1d, 0x90, 0x64, 0xf1, 0x63,
+	0xc3, 0x41, 0x17, 0xa1, 0x26, 0x59, 0x1a, 0x1c, 0x14, 0x35, 0x4f, 0xb2, 0xf4, 0x21, 0xfa, 0x31,
+	0xac, 0x08, 0x82, 0x23, 0x12, 0x06, 0x65, 0x8d, 0x12, 0x81, 0xd0, 0xc7, 0x26, 0xa1, 0x5b, 0xd7,
+	0x61, 0x76, 0x8d, 0xc6, 0x5e, 0xa9, 0xb0, 0x97, 0xcb, 0x55, 0x14, 0xfb, 0xa6, 0xf7, 0x9f, 0x5a,
+	0xd6, 0xd0, 0xe3, 0x01, 0x1a, 0x8b, 0xca, 0x05, 0x3f, 0x04, 0x77, 0x10, 0xb1, 0x1e, 0x8e, 0x82,
+	0x63, 0x6f, 0xd5, 0x73, 0x88, 0xed, 0xbf, 0x6d, 0xe4, 0x7b, 0x33, 0xaf, 0x54, 0xc7, 0x13, 0x11,
+	0xed, 0x93, 0x30, 0xe8, 0x45, 0xac, 0xe7, 0x82, 0x86, 0x2b, 0x18, 0x96, 0x2a, 0x7a, 0x0a, 0xa6,
+	0xb9, 0x82, 0x72, 0x43, 0x9f, 0x65, 0x89, 0xd4, 0xe0, 0xb3, 0xfd, 0x8e, 0xe1, 0x3f, 0xca, 0xe2,
+	0x4d, 0xc5, 0x45, 0xdf, 0x82, 0xc5, 0x5c, 0x93, 0xed, 0xef, 0x0b, 0x22, 0x35, 0xea, 0x6c, 0xbf,
+	0x6d, 0x98, 0x3f, 0xd5, 0x3c, 0xef, 0x6f, 0x36, 0x5c, 0xf0, 0x95, 0x77, 0xc9, 0x21, 0xf9, 0x7f,
+	0xaa, 0x2b, 0x2f, 0xcb, 0xef, 0x85, 0x33, 0xe5, 0x77, 0xfd, 0xd4, 0xf9, 0xdd, 0x38, 0x53, 0x7e,
+	0x37, 0xcf, 0x96, 0xdf, 0xf0, 0x92, 0xfc, 0x5e, 0x86, 0x5a, 0x44, 0x63, 0x5a, 0x04, 0xd8, 0x10,
+	0xde, 0x9f, 0xa7, 0x42, 0xf6, 0x06, 0xe4, 0xec, 0x75, 0xb0, 0x69, 0x68, 0xba, 0xd0, 0xd6, 0xba,
+	0x3b, 0xf7, 0xda, 0xdd, 0xea, 0x0a, 0x5f, 0x29, 0xcd, 0x5e, 0xd5, 0xb5, 0x33, 0x5f, 0xd5, 0x3f,
+	0x81, 0x2b, 0xc7, 0x33, 0x99, 0xe7, 0xee, 0x08, 0xdd, 0x05, 0x1d, 0xd1, 0xcb, 0xb3, 0xa9, 0x5c,
+	0xf8, 0x2b, 0x44, 0x3f, 0x80, 0xe5, 0x89, 0x5c, 0x1e, 0x2f, 0xac, 0x9b, 0xcf, 0x03, 0x63, 0xd9,
+	0x78, 0xc9, 0x49, 0xd9, 0xdc, 0x38, 0x29, 0x9b, 0xbd, 0x7f, 0xd9, 0xb0, 0xd8, 0x25, 0x11, 0x91,
+	0xe4, 0x9b, 0x4e, 0xf2, 0xa5, 0x9d, 0xe4, 0xf7, 0x01, 0xd1, 0x44, 0xde, 0xf9, 0x38, 0x48, 0x39,
+	0x8d, 0x31, 0x1f, 0x05, 0x07, 0x64, 0x54, 0x94, 0x49, 0x47, 0x4b, 0x76, 0x8d, 0xe0, 0x21, 0x19,
+	0x89, 0x57, 0x76, 0x96, 0x93, 0xad, 0x9c, 0x49, 0x9b, 0xb2, 0x95, 0xfb, 0x11, 0xb4, 0xa7, 0x5e,
+	0xd1, 0x7e, 0x05, 0x60, 0x5b, 0xe9, 0xf8, 0xbd, 0xde, 0x7f, 0x2c, 0x68, 0x6e, 0x33, 0x1c, 0xea,
+	0xa1, 0xea, 0x9c, 0x61, 0x2c, 0xfb, 0xe5, 0xca, 0x6c, 0xbf, 0xfc, 0x2e, 0x8c, 0xe7, 0xa2, 0x3c,
+	0x90, 0x13, 0x83, 0xd2, 0xc4, 0xc0, 0x53, 0x9d, 0x1e, 0x78, 0xae, 0x42, 0x8b, 0x2a, 0x83, 0x82,
+	0x14, 0xcb, 0xa1, 0xa9, 0x94, 0x4d, 0x1f, 0x34, 0x6b, 0x57, 0x71, 0xd4, 0x44, 0x54, 0x28, 0xe8,
+	0x89, 0x68, 0xe1, 0xd4, 0x13, 0x51, 0xbe, 0x89, 0x9e, 0x88, 0x7e, 0x65, 0x01, 0xe8, 0x83, 0xab,
+	0x7a, 0x70, 0x7c, 0x53, 0xeb, 0x3c, 0x9b, 0xaa, 0x12, 0xae, 0x23, 0x45, 0x22, 0x2c, 0xc7, 0x49,
+	0x25, 0x72, 0xe7, 0x20, 0x15, 0x35, 0x23, 0xca, 0x13, 0x4a, 0x78, 0xbf, 0xb5, 0x00, 0x74, 0x55,
+	0x30, 0x66, 0xcc, 0xc2, 0xcf, 0x3a, 0x79, 0x56, 0xac, 0x4c, 0xbb, 0x6e, 0xa3, 0x70, 0xdd, 0x09,
+	0x1f, 0x63, 0x27, 0x9a, 0xfb, 0xe2, 0xf0, 0xb9, 0x77, 0xf5, 0xb3, 0xf7, 0x7b, 0x0b, 0xda, 0xb9,
+	0x75, 0xc6, 0xa4, 0xa9, 0x28, 0x5b, 0xb3, 0x51, 0xd6, 0xcd, 0x4d, 0xcc, 0xf8, 0x28, 0x10, 0xf4,
+	0x05, 0xc9, 0x0d, 0x02, 0xc3, 0xda, 0xa3, 0x2f, 0xc8, 0x14, 0x78, 0xed, 0x69, 0xf0, 0xde, 0x80,
+	0x25, 0x4e, 0xfa, 0x24, 0x91, 0xd1, 0x28, 0x88, 0x59, 0x48, 0xf7, 0x29, 0x09, 0x35, 0x1a, 0x1a,
+	0xbe, 0x53, 0x08, 0x76, 0x72, 0xbe, 0xf7, 0x4b, 0x0b, 0x5a, 0x3b, 0x62, 0xb0, 0xcb, 0x84, 0x4e,
+	0x32, 0x74, 0x0d, 0xda, 0x79, 0x61, 0x33, 0x19, 0x6e, 0x69, 0x84, 0xb5, 0xfa, 0xe3, 0x0f, 0x9a,
+	0xaa, 0xb4, 0xc7, 0x62, 0x90, 0xbb, 0xa9, 0xed, 0x1b, 0x02, 0xad, 0x40, 0x23, 0x16, 0x03, 0xdd,
+	0x8b, 0xe7, 0xb0, 0x2c, 0x69, 0x75, 0xd6, 0xf1, 0x15, 0x56, 0xd5, 0x57, 0xd8, 0x98, 0xe1, 0x7d,
+	0x61, 0x01, 0xca, 0x3f, 0x98, 0xbe, 0xd6, 0xff, 0x0d, 0x1d, 0xe5, 0xc9, 0x8f, 0xb2, 0x15, 0x8d,
+	0xf1, 0x29, 0xde, 0x4c, 0x51, 0xb0, 0x8f, 0x15, 0x85, 0x1b, 0xb0, 0x14, 0x92, 0x7d, 0x9c, 0x45,
+	0x93, 0xb7, 0xae, 0x31, 0xd9, 0xc9, 0x05, 0x53, 0x3f, 0x08, 0x3a, 0x9b, 0x9c, 0x84, 0x24, 0x91,
+	0x14, 0x47, 0xfa, 0xbf, 0xd5, 0x0a, 0x34, 0x32, 0xa1, 0x90, 0x50, 0xfa, 0xae, 0xa4, 0xd1, 0x87,
+	0x80, 0x48, 0xd2, 0xe7, 0xa3, 0x54, 0x81, 0x38, 0xc5, 0x42, 0x1c, 0x31, 0x1e, 0xe6, 0x85, 0x7a,
+	0xa9, 0x94, 0xec, 0xe6, 0x02, 0x35, 0xf9, 0x4a, 0x92, 0xe0, 0x44, 0x16, 0xf5, 0xda, 0x50, 0x2a,
+	0xf4, 0x54, 0x04, 0x22, 0x4b, 0x09, 0xcf, 0xc3, 0x5a, 0xa7, 0x62, 0x4f, 0x91, 0xaa, 0x94, 0x8b,
+	0x21, 0x5e, 0xff, 0xe4, 0xce, 0x78, 0x7b, 0x53, 0xa2, 0x3b, 0x86, 0x5d, 0xec, 0xed, 0xdd, 0x87,
+	0xa5, 0x6d, 0x2a, 0xe4, 0x2e, 0x8b, 0x68, 0x7f, 0x74, 0xee, 0x1b, 0xc7, 0xfb, 0xdc, 0x02, 0x34,
+	0xb9, 0x4f, 0xfe, 0x7b, 0x64, 0xdc, 0x31, 0x58, 0xa7, 0xef, 0x18, 0xae, 0x41, 0x3b, 0xd5, 0xdb,
+	0x04, 0x34, 0xd9, 0x67, 0x45, 0xf4, 0x5a, 0x86, 0xa7, 0x7c, 0x2b, 0xd0, 0x7b, 0x00, 0xca, 0x99,
+	0x01, 0x67, 0x11, 0x31, 0xc1, 0x6b, 0xfa, 0x4d, 0xc5, 0xf1, 0x15, 0xc3, 0x1b, 0xc0, 0xe5, 0xbd,
+	0x21, 0x3b, 0xda, 0x64, 0xc9, 0x3e, 0x1d, 0x64, 0x1c, 0x2b, 0x40, 0xbf, 0xc6, 0x67, 0x37, 0x17,
+	0xea, 0x29, 0x96, 0x2a, 0xad, 0xf3, 0x18, 0x15, 0xa4, 0xf7, 0x07, 0x0b, 0x56, 0xe6, 0xbd, 0xe9,
+	0x75, 0x8e, 0xff, 0x00, 0x16, 0xfb, 0x66, 0x3b, 0xb3, 0xdb, 0xe9, 0xff, 0x3f, 0x4e, 0xaf, 0xf3,
+	0xee, 0x43, 0xd5, 0xc7, 0x92, 0xa0, 0x5b, 0x50, 0xe1, 0x52, 0x5b, 0xd0, 0x59, 0xbf, 0xfa, 0x92,
+	0x62, 0xa5, 0x14, 0xf5, 0x34, 0x5c, 0xe1, 0x12, 0xb5, 0xc1, 0xe2, 0xfa, 0xa4, 0x96, 0x6f, 0x71,
+	0xef, 0xef, 0x16, 0x38, 0x5b, 0x71, 0xca, 0xb8, 0xdc, 0x1c, 0x92, 0xfe, 0x41, 0xca, 0x68, 0x22,
+	0xd5, 0xd5, 0xbe, 0x4f, 0xa3, 0x02, 0xd9, 0xfa, 0x59, 0x21, 0x7e, 0x9f, 0x26, 0x54, 0xa8, 0x39,
+	0xa7, 0xa2, 0xe1, 0x58, 0xd2, 0x2a, 0x60, 0x9c, 0x1d, 0x99, 0xc9, 0xbd, 0xa8, 0x53, 0x4d, 0xce,
+	0x8e, 0x74, 0x59, 0x10, 0x6a, 0x69, 0x59, 0xcb, 0xcd, 0x9f, 0xc0, 0x92, 0x56, 0x28, 0xc7, 0x99,
+	0x64, 0x81, 0xea, 0x17, 0x4d, 0x5b, 0x5f, 0x57, 0xf4, 0x56, 0x28, 0xd0, 0x15, 0x50, 0x7b, 0xe4,
+	0x13, 0x8d, 0xe9, 0x32, 0x1a, 0x5c, 0x45, 0x23, 0x4b, 0xe4, 0xf5, 0x75, 0x58, 0x3a, 0xf6, 0x7d,
+	0x05, 0xb5, 0xa1, 0xe1, 0xb3, 0x23, 0x15, 0xdb, 0xd0, 0x79, 0x0b, 0x5d, 0x80, 0xd6, 0x26, 0x8b,
+	0xb2, 0x38, 0x31, 0x0c, 0xeb, 0xfa, 0x5f, 0x2c, 0x68, 0x14, 0xae, 0x40, 0x4b, 0xb0, 0xd8, 0xed,
+	0x6e, 0x8f, 0x7f, 0xd6, 0x38, 0x6f, 0x21, 0x07, 0xda, 0xdd, 0xee, 0x76, 0xf9, 0xa9, 0xdf, 0xb1,
+	0xd4, 0x86, 0xdd, 0xee, 0xb6, 0xae, 0xf5, 0x4e, 0x25, 0xa7, 0x3e, 0x8b, 0x32, 0x31, 0x74, 0xec,
+	0x72, 0x83, 0x38, 0xc5, 0x66, 0x83, 0x2a, 0x5a, 0x84, 0x66, 0x77, 0x67, 0xdb, 0xd8, 0xe5, 0xd4,
+	0x72, 0xd2, 0xb4, 0x7b, 0xce, 0x82, 0xb2, 0xa7, 0xbb, 0xb3, 0xbd, 0x91, 0x45, 0x07, 0xaa, 0x6d,
+	0x70, 0xea, 0x5a, 0xfe, 0x78, 0xdb, 0xcc, 0x88, 0x4e, 0x43, 0x6f, 0xff, 0x78, 0x5b, 0x4d, 0xad,
+	0x23, 0xa7, 0xb9, 0x71, 0xf7, 0xe7, 0x9f, 0x0c, 0xa8, 0x1c, 0x66, 0x3d, 0x05, 0x86, 0x5b, 0x26,
+	0xae, 0x1f, 0x52, 0x96, 0x3f, 0xdd, 0x2a, 0x62, 0x7b, 0x4b, 0x87, 0xba, 0x24, 0xd3, 0x5e, 0x6f,
+	0x41, 0x73, 0x3e, 0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x12, 0xb2, 0x2d, 0x67, 0x0c, 0x20,
 	0x00, 0x00,
 }
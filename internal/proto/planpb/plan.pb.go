@@ -37,6 +37,8 @@ const (
 	OpType_Range        OpType = 10
 	OpType_In           OpType = 11
 	OpType_NotIn        OpType = 12
+	OpType_RegexMatch   OpType = 13
+	OpType_TextMatch    OpType = 14
 )
 
 var OpType_name = map[int32]string{
@@ -53,6 +55,8 @@ var OpType_name = map[int32]string{
 	10: "Range",
 	11: "In",
 	12: "NotIn",
+	13: "RegexMatch",
+	14: "TextMatch",
 }
 
 var OpType_value = map[string]int32{
@@ -69,6 +73,8 @@ var OpType_value = map[string]int32{
 	"Range":        10,
 	"In":           11,
 	"NotIn":        12,
+	"RegexMatch":   13,
+	"TextMatch":    14,
 }
 
 func (x OpType) String() string {
@@ -116,6 +122,34 @@ func (ArithOpType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{1}
 }
 
+type NullExpr_NullOp int32
+
+const (
+	NullExpr_Invalid   NullExpr_NullOp = 0
+	NullExpr_IsNull    NullExpr_NullOp = 1
+	NullExpr_IsNotNull NullExpr_NullOp = 2
+)
+
+var NullExpr_NullOp_name = map[int32]string{
+	0: "Invalid",
+	1: "IsNull",
+	2: "IsNotNull",
+}
+
+var NullExpr_NullOp_value = map[string]int32{
+	"Invalid":   0,
+	"IsNull":    1,
+	"IsNotNull": 2,
+}
+
+func (x NullExpr_NullOp) String() string {
+	return proto.EnumName(NullExpr_NullOp_name, int32(x))
+}
+
+func (NullExpr_NullOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{6, 0}
+}
+
 type UnaryExpr_UnaryOp int32
 
 const (
@@ -138,7 +172,7 @@ func (x UnaryExpr_UnaryOp) String() string {
 }
 
 func (UnaryExpr_UnaryOp) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{9, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{10, 0}
 }
 
 type BinaryExpr_BinaryOp int32
@@ -166,7 +200,7 @@ func (x BinaryExpr_BinaryOp) String() string {
 }
 
 func (BinaryExpr_BinaryOp) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{10, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{11, 0}
 }
 
 type GenericValue struct {
@@ -538,6 +572,53 @@ func (m *UnaryRangeExpr) GetValue() *GenericValue {
 	return nil
 }
 
+type NullExpr struct {
+	ColumnInfo           *ColumnInfo     `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	Op                   NullExpr_NullOp `protobuf:"varint,2,opt,name=op,proto3,enum=milvus.proto.plan.NullExpr_NullOp" json:"op,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *NullExpr) Reset()         { *m = NullExpr{} }
+func (m *NullExpr) String() string { return proto.CompactTextString(m) }
+func (*NullExpr) ProtoMessage()    {}
+func (*NullExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{6}
+}
+
+func (m *NullExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NullExpr.Unmarshal(m, b)
+}
+func (m *NullExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NullExpr.Marshal(b, m, deterministic)
+}
+func (m *NullExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NullExpr.Merge(m, src)
+}
+func (m *NullExpr) XXX_Size() int {
+	return xxx_messageInfo_NullExpr.Size(m)
+}
+func (m *NullExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_NullExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NullExpr proto.InternalMessageInfo
+
+func (m *NullExpr) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *NullExpr) GetOp() NullExpr_NullOp {
+	if m != nil {
+		return m.Op
+	}
+	return NullExpr_Invalid
+}
+
 type BinaryRangeExpr struct {
 	ColumnInfo           *ColumnInfo   `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
 	LowerInclusive       bool          `protobuf:"varint,2,opt,name=lower_inclusive,json=lowerInclusive,proto3" json:"lower_inclusive,omitempty"`
@@ -553,7 +634,7 @@ func (m *BinaryRangeExpr) Reset()         { *m = BinaryRangeExpr{} }
 func (m *BinaryRangeExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryRangeExpr) ProtoMessage()    {}
 func (*BinaryRangeExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{6}
+	return fileDescriptor_2d655ab2f7683c23, []int{7}
 }
 
 func (m *BinaryRangeExpr) XXX_Unmarshal(b []byte) error {
@@ -622,7 +703,7 @@ func (m *CompareExpr) Reset()         { *m = CompareExpr{} }
 func (m *CompareExpr) String() string { return proto.CompactTextString(m) }
 func (*CompareExpr) ProtoMessage()    {}
 func (*CompareExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{7}
+	return fileDescriptor_2d655ab2f7683c23, []int{8}
 }
 
 func (m *CompareExpr) XXX_Unmarshal(b []byte) error {
@@ -676,7 +757,7 @@ func (m *TermExpr) Reset()         { *m = TermExpr{} }
 func (m *TermExpr) String() string { return proto.CompactTextString(m) }
 func (*TermExpr) ProtoMessage()    {}
 func (*TermExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{8}
+	return fileDescriptor_2d655ab2f7683c23, []int{9}
 }
 
 func (m *TermExpr) XXX_Unmarshal(b []byte) error {
@@ -723,7 +804,7 @@ func (m *UnaryExpr) Reset()         { *m = UnaryExpr{} }
 func (m *UnaryExpr) String() string { return proto.CompactTextString(m) }
 func (*UnaryExpr) ProtoMessage()    {}
 func (*UnaryExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{9}
+	return fileDescriptor_2d655ab2f7683c23, []int{10}
 }
 
 func (m *UnaryExpr) XXX_Unmarshal(b []byte) error {
@@ -771,7 +852,7 @@ func (m *BinaryExpr) Reset()         { *m = BinaryExpr{} }
 func (m *BinaryExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryExpr) ProtoMessage()    {}
 func (*BinaryExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{10}
+	return fileDescriptor_2d655ab2f7683c23, []int{11}
 }
 
 func (m *BinaryExpr) XXX_Unmarshal(b []byte) error {
@@ -826,7 +907,7 @@ func (m *BinaryArithOp) Reset()         { *m = BinaryArithOp{} }
 func (m *BinaryArithOp) String() string { return proto.CompactTextString(m) }
 func (*BinaryArithOp) ProtoMessage()    {}
 func (*BinaryArithOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{11}
+	return fileDescriptor_2d655ab2f7683c23, []int{12}
 }
 
 func (m *BinaryArithOp) XXX_Unmarshal(b []byte) error {
@@ -881,7 +962,7 @@ func (m *BinaryArithExpr) Reset()         { *m = BinaryArithExpr{} }
 func (m *BinaryArithExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryArithExpr) ProtoMessage()    {}
 func (*BinaryArithExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{12}
+	return fileDescriptor_2d655ab2f7683c23, []int{13}
 }
 
 func (m *BinaryArithExpr) XXX_Unmarshal(b []byte) error {
@@ -938,7 +1019,7 @@ func (m *BinaryArithOpEvalRangeExpr) Reset()         { *m = BinaryArithOpEvalRan
 func (m *BinaryArithOpEvalRangeExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryArithOpEvalRangeExpr) ProtoMessage()    {}
 func (*BinaryArithOpEvalRangeExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{13}
+	return fileDescriptor_2d655ab2f7683c23, []int{14}
 }
 
 func (m *BinaryArithOpEvalRangeExpr) XXX_Unmarshal(b []byte) error {
@@ -1006,6 +1087,7 @@ type Expr struct {
 	//	*Expr_BinaryArithExpr
 	//	*Expr_ValueExpr
 	//	*Expr_ColumnExpr
+	//	*Expr_NullExpr
 	Expr                 isExpr_Expr `protobuf_oneof:"expr"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
@@ -1016,7 +1098,7 @@ func (m *Expr) Reset()         { *m = Expr{} }
 func (m *Expr) String() string { return proto.CompactTextString(m) }
 func (*Expr) ProtoMessage()    {}
 func (*Expr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{14}
+	return fileDescriptor_2d655ab2f7683c23, []int{15}
 }
 
 func (m *Expr) XXX_Unmarshal(b []byte) error {
@@ -1081,6 +1163,10 @@ type Expr_ColumnExpr struct {
 	ColumnExpr *ColumnExpr `protobuf:"bytes,10,opt,name=column_expr,json=columnExpr,proto3,oneof"`
 }
 
+type Expr_NullExpr struct {
+	NullExpr *NullExpr `protobuf:"bytes,11,opt,name=null_expr,json=nullExpr,proto3,oneof"`
+}
+
 func (*Expr_TermExpr) isExpr_Expr() {}
 
 func (*Expr_UnaryExpr) isExpr_Expr() {}
@@ -1101,6 +1187,8 @@ func (*Expr_ValueExpr) isExpr_Expr() {}
 
 func (*Expr_ColumnExpr) isExpr_Expr() {}
 
+func (*Expr_NullExpr) isExpr_Expr() {}
+
 func (m *Expr) GetExpr() isExpr_Expr {
 	if m != nil {
 		return m.Expr
@@ -1178,6 +1266,13 @@ func (m *Expr) GetColumnExpr() *ColumnExpr {
 	return nil
 }
 
+func (m *Expr) GetNullExpr() *NullExpr {
+	if x, ok := m.GetExpr().(*Expr_NullExpr); ok {
+		return x.NullExpr
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Expr) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1191,6 +1286,7 @@ func (*Expr) XXX_OneofWrappers() []interface{} {
 		(*Expr_BinaryArithExpr)(nil),
 		(*Expr_ValueExpr)(nil),
 		(*Expr_ColumnExpr)(nil),
+		(*Expr_NullExpr)(nil),
 	}
 }
 
@@ -1209,7 +1305,7 @@ func (m *VectorANNS) Reset()         { *m = VectorANNS{} }
 func (m *VectorANNS) String() string { return proto.CompactTextString(m) }
 func (*VectorANNS) ProtoMessage()    {}
 func (*VectorANNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{15}
+	return fileDescriptor_2d655ab2f7683c23, []int{16}
 }
 
 func (m *VectorANNS) XXX_Unmarshal(b []byte) error {
@@ -1280,7 +1376,7 @@ func (m *PlanNode) Reset()         { *m = PlanNode{} }
 func (m *PlanNode) String() string { return proto.CompactTextString(m) }
 func (*PlanNode) ProtoMessage()    {}
 func (*PlanNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{16}
+	return fileDescriptor_2d655ab2f7683c23, []int{17}
 }
 
 func (m *PlanNode) XXX_Unmarshal(b []byte) error {
@@ -1356,6 +1452,7 @@ func (*PlanNode) XXX_OneofWrappers() []interface{} {
 func init() {
 	proto.RegisterEnum("milvus.proto.plan.OpType", OpType_name, OpType_value)
 	proto.RegisterEnum("milvus.proto.plan.ArithOpType", ArithOpType_name, ArithOpType_value)
+	proto.RegisterEnum("milvus.proto.plan.NullExpr_NullOp", NullExpr_NullOp_name, NullExpr_NullOp_value)
 	proto.RegisterEnum("milvus.proto.plan.UnaryExpr_UnaryOp", UnaryExpr_UnaryOp_name, UnaryExpr_UnaryOp_value)
 	proto.RegisterEnum("milvus.proto.plan.BinaryExpr_BinaryOp", BinaryExpr_BinaryOp_name, BinaryExpr_BinaryOp_value)
 	proto.RegisterType((*GenericValue)(nil), "milvus.proto.plan.GenericValue")
@@ -1364,6 +1461,7 @@ func init() {
 	proto.RegisterType((*ColumnExpr)(nil), "milvus.proto.plan.ColumnExpr")
 	proto.RegisterType((*ValueExpr)(nil), "milvus.proto.plan.ValueExpr")
 	proto.RegisterType((*UnaryRangeExpr)(nil), "milvus.proto.plan.UnaryRangeExpr")
+	proto.RegisterType((*NullExpr)(nil), "milvus.proto.plan.NullExpr")
 	proto.RegisterType((*BinaryRangeExpr)(nil), "milvus.proto.plan.BinaryRangeExpr")
 	proto.RegisterType((*CompareExpr)(nil), "milvus.proto.plan.CompareExpr")
 	proto.RegisterType((*TermExpr)(nil), "milvus.proto.plan.TermExpr")
@@ -1380,93 +1478,99 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 1407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x72, 0xdc, 0x44,
-	0x10, 0x5e, 0xad, 0x76, 0xbd, 0x52, 0xef, 0x7a, 0xad, 0xe8, 0x42, 0x7e, 0x48, 0x6c, 0x44, 0x8a,
-	0x98, 0x50, 0xb1, 0x2b, 0x24, 0x24, 0x95, 0x50, 0x01, 0xff, 0x05, 0x7b, 0x8b, 0xc4, 0x36, 0x8a,
-	0xe3, 0x03, 0x17, 0xd5, 0xac, 0x34, 0xf6, 0x4e, 0x45, 0xab, 0x51, 0x46, 0xa3, 0x4d, 0xf6, 0xcc,
-	0x8d, 0x1b, 0x0f, 0xc0, 0x15, 0xee, 0xdc, 0xe0, 0xc2, 0x0b, 0x70, 0xe0, 0xc8, 0x9d, 0xb7, 0xe0,
-	0x44, 0x4d, 0x8f, 0xf6, 0x2f, 0xb5, 0x1b, 0xaf, 0x8b, 0x54, 0x71, 0x1b, 0xf5, 0x74, 0x7f, 0xd3,
-	0xfd, 0x4d, 0x77, 0x4f, 0x0b, 0x20, 0x8d, 0x49, 0xb2, 0x96, 0x0a, 0x2e, 0xb9, 0x7b, 0xa1, 0xcb,
-	0xe2, 0x5e, 0x9e, 0xe9, 0xaf, 0x35, 0xb5, 0x71, 0xb9, 0x91, 0x85, 0x1d, 0xda, 0x25, 0x5a, 0xe4,
-	0xfd, 0x60, 0x40, 0x63, 0x97, 0x26, 0x54, 0xb0, 0xf0, 0x98, 0xc4, 0x39, 0x75, 0xaf, 0x80, 0xd5,
-	0xe6, 0x3c, 0x0e, 0x7a, 0x24, 0xbe, 0x68, 0xac, 0x18, 0xab, 0xd6, 0x5e, 0xc9, 0xaf, 0x29, 0xc9,
-	0x31, 0x89, 0xdd, 0xab, 0x60, 0xb3, 0x44, 0xde, 0xbb, 0x8b, 0xbb, 0xe5, 0x15, 0x63, 0xd5, 0xdc,
-	0x2b, 0xf9, 0x16, 0x8a, 0x8a, 0xed, 0x93, 0x98, 0x13, 0x89, 0xdb, 0xe6, 0x8a, 0xb1, 0x6a, 0xa8,
-	0x6d, 0x14, 0xa9, 0xed, 0x65, 0x80, 0x4c, 0x0a, 0x96, 0x9c, 0xe2, 0x7e, 0x65, 0xc5, 0x58, 0xb5,
-	0xf7, 0x4a, 0xbe, 0xad, 0x65, 0xc7, 0x24, 0xde, 0xaa, 0x82, 0xd9, 0x23, 0xb1, 0xf7, 0xbd, 0x01,
-	0xf6, 0x37, 0x39, 0x15, 0xfd, 0x56, 0x72, 0xc2, 0x5d, 0x17, 0x2a, 0x92, 0xa7, 0x2f, 0xd0, 0x19,
-	0xd3, 0xc7, 0xb5, 0xbb, 0x0c, 0xf5, 0x2e, 0x95, 0x82, 0x85, 0x81, 0xec, 0xa7, 0x14, 0x8f, 0xb2,
-	0x7d, 0xd0, 0xa2, 0xa3, 0x7e, 0x4a, 0xdd, 0x0f, 0x61, 0x31, 0xa3, 0x44, 0x84, 0x9d, 0x20, 0x25,
-	0x82, 0x74, 0x33, 0x7d, 0x9a, 0xdf, 0xd0, 0xc2, 0x43, 0x94, 0x29, 0x25, 0xc1, 0xf3, 0x24, 0x0a,
-	0x22, 0x1a, 0xb2, 0x2e, 0x89, 0x2f, 0x56, 0xf1, 0x88, 0x06, 0x0a, 0x77, 0xb4, 0xcc, 0xfb, 0xc9,
-	0x00, 0xd8, 0xe6, 0x71, 0xde, 0x4d, 0xd0, 0x9b, 0x4b, 0x60, 0x9d, 0x30, 0x1a, 0x47, 0x01, 0x8b,
-	0x0a, 0x8f, 0x6a, 0xf8, 0xdd, 0x8a, 0xdc, 0x87, 0x60, 0x47, 0x44, 0x12, 0xed, 0x92, 0x22, 0xa7,
-	0xf9, 0xe9, 0xd5, 0xb5, 0x09, 0xfe, 0x0b, 0xe6, 0x77, 0x88, 0x24, 0xca, 0x4b, 0xdf, 0x8a, 0x8a,
-	0x95, 0x7b, 0x1d, 0x9a, 0x2c, 0x0b, 0x52, 0xc1, 0xba, 0x44, 0xf4, 0x83, 0x17, 0xb4, 0x8f, 0x31,
-	0x59, 0x7e, 0x83, 0x65, 0x87, 0x5a, 0xf8, 0x35, 0xed, 0xbb, 0x57, 0xc0, 0x66, 0x59, 0x40, 0x72,
-	0xc9, 0x5b, 0x3b, 0x18, 0x91, 0xe5, 0x5b, 0x2c, 0xdb, 0xc4, 0x6f, 0xef, 0xcb, 0x81, 0x9f, 0x8f,
-	0x5f, 0xa7, 0xc2, 0xbd, 0x0d, 0x15, 0x96, 0x9c, 0x70, 0xf4, 0xb1, 0xfe, 0xa6, 0x1f, 0x98, 0x20,
-	0xa3, 0xa0, 0x7c, 0x54, 0xf5, 0xb6, 0xc0, 0xc6, 0x14, 0x40, 0xfb, 0xcf, 0xa0, 0xda, 0x53, 0x1f,
-	0x05, 0xc0, 0xf2, 0x14, 0x80, 0xf1, 0xb4, 0xf1, 0xb5, 0xb6, 0xf7, 0x8b, 0x01, 0xcd, 0xe7, 0x09,
-	0x11, 0x7d, 0x9f, 0x24, 0xa7, 0x1a, 0xe9, 0x0b, 0xa8, 0x87, 0x78, 0x54, 0x30, 0xbf, 0x43, 0x10,
-	0x8e, 0x18, 0xff, 0x18, 0xca, 0x3c, 0x2d, 0xf8, 0xbc, 0x34, 0xc5, 0xec, 0x20, 0x45, 0x2e, 0xcb,
-	0x3c, 0x1d, 0x39, 0x6d, 0x9e, 0xcb, 0xe9, 0x9f, 0xcb, 0xb0, 0xb4, 0xc5, 0xde, 0xad, 0xd7, 0x37,
-	0x60, 0x29, 0xe6, 0xaf, 0xa8, 0x08, 0x58, 0x12, 0xc6, 0x79, 0xc6, 0x7a, 0x3a, 0x25, 0x2c, 0xbf,
-	0x89, 0xe2, 0xd6, 0x40, 0xaa, 0x14, 0xf3, 0x34, 0x9d, 0x50, 0xd4, 0x57, 0xdf, 0x44, 0xf1, 0x48,
-	0x71, 0x03, 0xea, 0x1a, 0x51, 0x87, 0x58, 0x99, 0x2f, 0x44, 0x40, 0x1b, 0x5d, 0xda, 0x1b, 0x50,
-	0xd7, 0x47, 0x69, 0x84, 0xea, 0x9c, 0x08, 0x68, 0x83, 0x6b, 0xef, 0x0f, 0x03, 0xea, 0xdb, 0xbc,
-	0x9b, 0x12, 0xa1, 0x59, 0xda, 0x05, 0x27, 0xa6, 0x27, 0x32, 0x38, 0x37, 0x55, 0x4d, 0x65, 0x36,
-	0x56, 0x56, 0x2d, 0xb8, 0x20, 0xd8, 0x69, 0x67, 0x12, 0xa9, 0x3c, 0x0f, 0xd2, 0x12, 0xda, 0x6d,
-	0xbf, 0x99, 0x2f, 0xe6, 0x1c, 0xf9, 0xe2, 0x7d, 0x67, 0x80, 0x75, 0x44, 0x45, 0xf7, 0x9d, 0xdc,
-	0xf8, 0x7d, 0x58, 0x40, 0x5e, 0xb3, 0x8b, 0xe5, 0x15, 0x73, 0x1e, 0x62, 0x0b, 0x75, 0xd5, 0x82,
-	0x6d, 0xac, 0x19, 0x74, 0xe3, 0x2e, 0xba, 0x6f, 0xa0, 0xfb, 0xd7, 0xa7, 0x40, 0x0c, 0x35, 0xf5,
-	0xea, 0x20, 0xc5, 0xcc, 0xbf, 0x05, 0xd5, 0xb0, 0xc3, 0xe2, 0xa8, 0xe0, 0xec, 0xbd, 0x29, 0x86,
-	0xca, 0xc6, 0xd7, 0x5a, 0xde, 0x32, 0xd4, 0x0a, 0x6b, 0xb7, 0x0e, 0xb5, 0x56, 0xd2, 0x23, 0x31,
-	0x8b, 0x9c, 0x92, 0x5b, 0x03, 0x73, 0x9f, 0x4b, 0xc7, 0xf0, 0xfe, 0x32, 0x00, 0x74, 0x49, 0xa0,
-	0x53, 0xf7, 0xc6, 0x9c, 0xfa, 0x68, 0x0a, 0xf6, 0x48, 0xb5, 0x58, 0x16, 0x6e, 0x7d, 0x02, 0x15,
-	0x75, 0xd1, 0x67, 0x79, 0x85, 0x4a, 0x2a, 0x06, 0xbc, 0xcb, 0xa2, 0x7a, 0x67, 0xc7, 0x80, 0x5a,
-	0xde, 0x3d, 0xb0, 0x06, 0x67, 0x4d, 0x06, 0xd1, 0x04, 0x78, 0xc2, 0x4f, 0x59, 0x48, 0xe2, 0xcd,
-	0x24, 0x72, 0x0c, 0x77, 0x11, 0xec, 0xe2, 0xfb, 0x40, 0x38, 0x65, 0xef, 0x4f, 0x03, 0x16, 0xb5,
-	0xe1, 0xa6, 0x60, 0xb2, 0x73, 0x90, 0xfe, 0xe7, 0x9b, 0x7f, 0x00, 0x16, 0x51, 0x50, 0xc1, 0xb0,
-	0x4f, 0x5d, 0x9b, 0x62, 0x5c, 0x9c, 0x86, 0xc9, 0x57, 0x23, 0xc5, 0xd1, 0x3b, 0xb0, 0xa8, 0xf3,
-	0x9e, 0xa7, 0x54, 0x90, 0x24, 0x9a, 0xb7, 0x73, 0x35, 0xd0, 0xea, 0x40, 0x1b, 0x79, 0x3f, 0x1a,
-	0x83, 0x06, 0x86, 0x87, 0xe0, 0x95, 0x0d, 0xa8, 0x37, 0xce, 0x45, 0x7d, 0x79, 0x1e, 0xea, 0xdd,
-	0xb5, 0xb1, 0x12, 0x3b, 0x2b, 0x54, 0x55, 0x67, 0xbf, 0x97, 0xe1, 0xf2, 0x04, 0xe5, 0x8f, 0x7b,
-	0x24, 0x7e, 0x77, 0xbd, 0xf6, 0xff, 0xe6, 0xbf, 0x68, 0x39, 0x95, 0x73, 0x3d, 0x51, 0xd5, 0x73,
-	0x3d, 0x51, 0xff, 0x54, 0xa1, 0x82, 0x5c, 0x3d, 0x04, 0x5b, 0x52, 0xd1, 0x0d, 0xe8, 0xeb, 0x54,
-	0x14, 0x4c, 0x5d, 0x99, 0x82, 0x31, 0xe8, 0x6a, 0x6a, 0xfe, 0x92, 0x83, 0x0e, 0xf7, 0x08, 0x20,
-	0x57, 0x97, 0xa0, 0x8d, 0xf5, 0x55, 0xbf, 0xff, 0xb6, 0x16, 0xa3, 0xa6, 0xb3, 0x7c, 0xd8, 0x04,
-	0x36, 0xa0, 0xde, 0x66, 0x23, 0x7b, 0x73, 0xe6, 0x35, 0x8d, 0xba, 0xc1, 0x5e, 0xc9, 0x87, 0xf6,
-	0xa8, 0x8d, 0x6c, 0x43, 0x23, 0xd4, 0xaf, 0x87, 0x86, 0xd0, 0x6f, 0xd8, 0xb5, 0xa9, 0x37, 0x3d,
-	0x7c, 0x64, 0xf6, 0x4a, 0x7e, 0x3d, 0x1c, 0x7b, 0x73, 0x9e, 0x82, 0xa3, 0xa3, 0x10, 0x2a, 0x81,
-	0x34, 0x90, 0x26, 0xf3, 0x83, 0x59, 0xb1, 0x0c, 0x53, 0x6d, 0xaf, 0xe4, 0x37, 0xf3, 0xc9, 0x87,
-	0xfe, 0x10, 0x2e, 0x14, 0x51, 0x8d, 0xe1, 0x2d, 0x20, 0x9e, 0x37, 0x33, 0xb6, 0x71, 0xc0, 0xa5,
-	0xf6, 0x1b, 0xa3, 0x83, 0x84, 0xe5, 0x02, 0x71, 0x90, 0x95, 0x01, 0xed, 0x91, 0x78, 0x1c, 0xbf,
-	0x86, 0xf8, 0xb7, 0x66, 0xe2, 0x4f, 0x2b, 0x93, 0xbd, 0x92, 0x7f, 0xb9, 0x3d, 0xbb, 0x88, 0x46,
-	0x71, 0xe8, 0x53, 0xf1, 0x1c, 0xeb, 0x8c, 0x38, 0x86, 0xed, 0x62, 0x14, 0xc7, 0xa8, 0x83, 0x3c,
-	0x02, 0xc0, 0xe4, 0xd3, 0x50, 0xf6, 0xcc, 0x74, 0x19, 0x0e, 0x8d, 0x2a, 0x5d, 0x7a, 0xc3, 0x09,
-	0x72, 0x63, 0x58, 0xd5, 0x68, 0x0f, 0x67, 0x54, 0xf5, 0x20, 0x5d, 0xc2, 0xe1, 0xd7, 0xd6, 0x02,
-	0x54, 0x94, 0xa9, 0xf7, 0xb7, 0x01, 0x70, 0x4c, 0x43, 0xc9, 0xc5, 0xe6, 0xfe, 0xfe, 0xb3, 0x62,
-	0x0a, 0xd6, 0xde, 0xea, 0x5f, 0x14, 0x35, 0x05, 0xeb, 0x80, 0x26, 0xe6, 0xf3, 0xf2, 0xe4, 0x7c,
-	0x7e, 0x1f, 0x20, 0x15, 0x34, 0x62, 0x21, 0x91, 0x34, 0x3b, 0xeb, 0x91, 0x19, 0x53, 0x75, 0x3f,
-	0x07, 0x78, 0xa9, 0x7e, 0x47, 0x74, 0x7b, 0xaa, 0xcc, 0x24, 0x62, 0xf8, 0xcf, 0xe2, 0xdb, 0x2f,
-	0x87, 0xbf, 0x2f, 0x37, 0x60, 0x29, 0x8d, 0x49, 0x48, 0x3b, 0x3c, 0x8e, 0xa8, 0x08, 0x24, 0x39,
-	0xc5, 0x6c, 0xb5, 0xfd, 0xe6, 0x98, 0xf8, 0x88, 0x9c, 0x7a, 0xbf, 0x1a, 0x60, 0x1d, 0xc6, 0x24,
-	0xd9, 0xe7, 0x11, 0x8e, 0x6a, 0x3d, 0x8c, 0x38, 0x20, 0x49, 0x92, 0xbd, 0xa5, 0x25, 0x8e, 0x78,
-	0x51, 0xe4, 0x69, 0x9b, 0xcd, 0x24, 0xc9, 0xdc, 0x07, 0x13, 0xd1, 0xbe, 0xbd, 0xaf, 0x2b, 0xd3,
-	0xb1, 0x78, 0x57, 0xc1, 0xe1, 0xb9, 0x4c, 0x73, 0x19, 0x0c, 0xa8, 0x54, 0x74, 0x99, 0xab, 0xa6,
-	0xdf, 0xd4, 0xf2, 0xaf, 0x34, 0xa3, 0x99, 0xba, 0xa1, 0x84, 0x47, 0xf4, 0xe6, 0x6f, 0x06, 0x2c,
-	0xe8, 0x26, 0x37, 0xf9, 0x14, 0x2f, 0x41, 0x7d, 0x57, 0x50, 0x22, 0xa9, 0x38, 0xea, 0x90, 0xc4,
-	0x31, 0x5c, 0x07, 0x1a, 0x85, 0xe0, 0xf1, 0xcb, 0x9c, 0xc4, 0x4e, 0xd9, 0x6d, 0x80, 0xf5, 0x84,
-	0x66, 0x19, 0xee, 0x9b, 0xf8, 0x56, 0xd3, 0x2c, 0xd3, 0x9b, 0x15, 0xd7, 0x86, 0xaa, 0x5e, 0x56,
-	0x95, 0xde, 0x3e, 0x97, 0xfa, 0x6b, 0x41, 0x01, 0x1f, 0x0a, 0x7a, 0xc2, 0x5e, 0x3f, 0x25, 0x32,
-	0xec, 0x38, 0x35, 0x05, 0x7c, 0xc8, 0x33, 0x39, 0x94, 0x58, 0xca, 0x56, 0x2f, 0x6d, 0xb5, 0xc4,
-	0x42, 0x71, 0xc0, 0x5d, 0x80, 0x72, 0x2b, 0x71, 0xea, 0x4a, 0xb4, 0xcf, 0x65, 0x2b, 0x71, 0x1a,
-	0x37, 0x77, 0xa1, 0x3e, 0xf6, 0x36, 0xa8, 0x00, 0x9e, 0x27, 0x2f, 0x12, 0xfe, 0x2a, 0xd1, 0x03,
-	0xd1, 0x66, 0xa4, 0x86, 0x88, 0x1a, 0x98, 0xcf, 0xf2, 0xb6, 0x53, 0x56, 0x8b, 0xa7, 0x79, 0xec,
-	0x98, 0x6a, 0xb1, 0xc3, 0x7a, 0x4e, 0x05, 0x25, 0x3c, 0x72, 0xaa, 0x5b, 0x77, 0xbe, 0xbd, 0x7d,
-	0xca, 0x64, 0x27, 0x6f, 0xaf, 0x85, 0xbc, 0xbb, 0xae, 0xa9, 0xbe, 0xc5, 0x78, 0xb1, 0x5a, 0x67,
-	0x89, 0xa4, 0x22, 0x21, 0xf1, 0x3a, 0xb2, 0xbf, 0xae, 0xd8, 0x4f, 0xdb, 0xed, 0x05, 0xfc, 0xba,
-	0xf3, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x32, 0x23, 0x5b, 0x87, 0xb6, 0x0f, 0x00, 0x00,
+	// 1489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4b, 0x73, 0xdb, 0x46,
+	0x12, 0x26, 0xf8, 0x04, 0x9a, 0x14, 0x05, 0xe3, 0xb2, 0x7e, 0xac, 0x2d, 0x2d, 0xd6, 0xb5, 0xd6,
+	0x7a, 0xcb, 0xd2, 0xfa, 0xb1, 0x76, 0xd9, 0x5b, 0xde, 0xd5, 0xcb, 0x2b, 0xb1, 0xd6, 0xa6, 0x14,
+	0x58, 0xd6, 0x21, 0x17, 0xd4, 0x10, 0x18, 0x91, 0x28, 0x0f, 0x67, 0x60, 0x60, 0x40, 0x4b, 0xe7,
+	0xdc, 0x72, 0xcb, 0x0f, 0xc8, 0x35, 0xb9, 0xa6, 0x72, 0x48, 0x55, 0x4e, 0xf9, 0x03, 0x39, 0xe4,
+	0x98, 0x9c, 0xf3, 0x47, 0x52, 0xd3, 0x03, 0xbe, 0x5c, 0xa4, 0x45, 0x55, 0x54, 0x95, 0xdb, 0x4c,
+	0x4f, 0x77, 0x4f, 0xf7, 0xd7, 0x3d, 0xdd, 0x3d, 0x00, 0x31, 0x23, 0x7c, 0x3d, 0x4e, 0x84, 0x14,
+	0xce, 0x95, 0x7e, 0xc4, 0x06, 0x59, 0xaa, 0x77, 0xeb, 0xea, 0xe0, 0x7a, 0x23, 0x0d, 0x7a, 0xb4,
+	0x4f, 0x34, 0xc9, 0xfd, 0xc2, 0x80, 0xc6, 0x1e, 0xe5, 0x34, 0x89, 0x82, 0x63, 0xc2, 0x32, 0xea,
+	0xdc, 0x00, 0xb3, 0x23, 0x04, 0xf3, 0x07, 0x84, 0x5d, 0x35, 0x56, 0x8d, 0x35, 0x73, 0xbf, 0xe0,
+	0xd5, 0x14, 0xe5, 0x98, 0x30, 0xe7, 0x26, 0x58, 0x11, 0x97, 0x8f, 0x1f, 0xe1, 0x69, 0x71, 0xd5,
+	0x58, 0x2b, 0xed, 0x17, 0x3c, 0x13, 0x49, 0xf9, 0xf1, 0x09, 0x13, 0x44, 0xe2, 0x71, 0x69, 0xd5,
+	0x58, 0x33, 0xd4, 0x31, 0x92, 0xd4, 0xf1, 0x0a, 0x40, 0x2a, 0x93, 0x88, 0x77, 0xf1, 0xbc, 0xbc,
+	0x6a, 0xac, 0x59, 0xfb, 0x05, 0xcf, 0xd2, 0xb4, 0x63, 0xc2, 0xb6, 0x2b, 0x50, 0x1a, 0x10, 0xe6,
+	0x7e, 0x6e, 0x80, 0xf5, 0x49, 0x46, 0x93, 0xb3, 0x16, 0x3f, 0x11, 0x8e, 0x03, 0x65, 0x29, 0xe2,
+	0xb7, 0x68, 0x4c, 0xc9, 0xc3, 0xb5, 0xb3, 0x02, 0xf5, 0x3e, 0x95, 0x49, 0x14, 0xf8, 0xf2, 0x2c,
+	0xa6, 0x78, 0x95, 0xe5, 0x81, 0x26, 0x1d, 0x9d, 0xc5, 0xd4, 0xf9, 0x2b, 0x2c, 0xa5, 0x94, 0x24,
+	0x41, 0xcf, 0x8f, 0x49, 0x42, 0xfa, 0xa9, 0xbe, 0xcd, 0x6b, 0x68, 0xe2, 0x21, 0xd2, 0x14, 0x53,
+	0x22, 0x32, 0x1e, 0xfa, 0x21, 0x0d, 0xa2, 0x3e, 0x61, 0x57, 0x2b, 0x78, 0x45, 0x03, 0x89, 0xbb,
+	0x9a, 0xe6, 0x7e, 0x65, 0x00, 0xec, 0x08, 0x96, 0xf5, 0x39, 0x5a, 0x73, 0x0d, 0xcc, 0x93, 0x88,
+	0xb2, 0xd0, 0x8f, 0xc2, 0xdc, 0xa2, 0x1a, 0xee, 0x5b, 0xa1, 0xf3, 0x0c, 0xac, 0x90, 0x48, 0xa2,
+	0x4d, 0x52, 0xe0, 0x34, 0x1f, 0xdc, 0x5c, 0x9f, 0xc2, 0x3f, 0x47, 0x7e, 0x97, 0x48, 0xa2, 0xac,
+	0xf4, 0xcc, 0x30, 0x5f, 0x39, 0xb7, 0xa1, 0x19, 0xa5, 0x7e, 0x9c, 0x44, 0x7d, 0x92, 0x9c, 0xf9,
+	0x6f, 0xe9, 0x19, 0xfa, 0x64, 0x7a, 0x8d, 0x28, 0x3d, 0xd4, 0xc4, 0xff, 0xd3, 0x33, 0xe7, 0x06,
+	0x58, 0x51, 0xea, 0x93, 0x4c, 0x8a, 0xd6, 0x2e, 0x7a, 0x64, 0x7a, 0x66, 0x94, 0x6e, 0xe1, 0xde,
+	0xfd, 0xef, 0xd0, 0xce, 0x17, 0xa7, 0x71, 0xe2, 0xdc, 0x87, 0x72, 0xc4, 0x4f, 0x04, 0xda, 0x58,
+	0xff, 0xd0, 0x0e, 0x4c, 0x90, 0xb1, 0x53, 0x1e, 0xb2, 0xba, 0xdb, 0x60, 0x61, 0x0a, 0xa0, 0xfc,
+	0xbf, 0xa0, 0x32, 0x50, 0x9b, 0x5c, 0xc1, 0xca, 0x0c, 0x05, 0x93, 0x69, 0xe3, 0x69, 0x6e, 0xf7,
+	0x5b, 0x03, 0x9a, 0x6f, 0x38, 0x49, 0xce, 0x3c, 0xc2, 0xbb, 0x5a, 0xd3, 0x7f, 0xa0, 0x1e, 0xe0,
+	0x55, 0xfe, 0xe2, 0x06, 0x41, 0x30, 0x46, 0xfc, 0xef, 0x50, 0x14, 0x71, 0x8e, 0xe7, 0xb5, 0x19,
+	0x62, 0x07, 0x31, 0x62, 0x59, 0x14, 0xf1, 0xd8, 0xe8, 0xd2, 0x85, 0x8c, 0xfe, 0xc6, 0x00, 0xb3,
+	0x9d, 0x31, 0x76, 0x29, 0xe6, 0x3e, 0x98, 0x30, 0xd7, 0x9d, 0x21, 0x36, 0xbc, 0x08, 0x17, 0x07,
+	0xb1, 0xb2, 0xdb, 0xfd, 0x27, 0x54, 0xf5, 0xce, 0xa9, 0x43, 0xad, 0xc5, 0x07, 0x84, 0x45, 0xa1,
+	0x5d, 0x70, 0x00, 0xaa, 0xad, 0x54, 0x1d, 0xd8, 0x86, 0xb3, 0x04, 0x56, 0x2b, 0x6d, 0x0b, 0x89,
+	0xdb, 0xa2, 0xfb, 0x75, 0x11, 0x96, 0xb7, 0xa3, 0xcb, 0x05, 0xfa, 0x0e, 0x2c, 0x33, 0xf1, 0x9e,
+	0x26, 0x7e, 0xc4, 0x03, 0x96, 0xa5, 0xd1, 0x40, 0x67, 0xb1, 0xe9, 0x35, 0x91, 0xdc, 0x1a, 0x52,
+	0x15, 0x63, 0x16, 0xc7, 0x53, 0x8c, 0x3a, 0x5b, 0x9b, 0x48, 0x1e, 0x33, 0x6e, 0x42, 0x5d, 0x6b,
+	0xd4, 0x51, 0x29, 0x2f, 0x16, 0x15, 0x40, 0x19, 0x5d, 0x8d, 0x36, 0xa1, 0xae, 0xaf, 0xd2, 0x1a,
+	0x2a, 0x0b, 0x6a, 0x40, 0x19, 0x5c, 0xbb, 0x3f, 0x1a, 0x50, 0xdf, 0x11, 0xfd, 0x98, 0x24, 0x1a,
+	0xa5, 0x3d, 0xb0, 0x19, 0x3d, 0x91, 0xfe, 0x85, 0xa1, 0x6a, 0x2a, 0xb1, 0x89, 0x4a, 0xd0, 0x82,
+	0x2b, 0x49, 0xd4, 0xed, 0x4d, 0x6b, 0x2a, 0x2e, 0xa2, 0x69, 0x19, 0xe5, 0x76, 0x3e, 0x4c, 0xf1,
+	0xd2, 0x02, 0x29, 0xee, 0x7e, 0x66, 0x80, 0x79, 0x44, 0x93, 0xfe, 0xa5, 0x44, 0xfc, 0x09, 0x54,
+	0x11, 0xd7, 0xf4, 0x6a, 0x71, 0xb5, 0xb4, 0x08, 0xb0, 0x39, 0xbb, 0xea, 0x1a, 0x16, 0x3e, 0x73,
+	0x34, 0xe3, 0x11, 0x9a, 0x6f, 0xa0, 0xf9, 0xb7, 0x67, 0xa8, 0x18, 0x71, 0xea, 0x95, 0x4e, 0x7a,
+	0xe7, 0x1e, 0x54, 0x82, 0x5e, 0xc4, 0xc2, 0x1c, 0xb3, 0x3f, 0xcd, 0x10, 0x54, 0x32, 0x9e, 0xe6,
+	0x72, 0x57, 0xa0, 0x96, 0x4b, 0x4f, 0x3f, 0x92, 0x1a, 0x94, 0xda, 0x42, 0xda, 0x86, 0xfb, 0xb3,
+	0x01, 0xa0, 0x9f, 0x04, 0x1a, 0xf5, 0x78, 0xc2, 0xa8, 0xbf, 0xcd, 0xd0, 0x3d, 0x66, 0xcd, 0x97,
+	0xb9, 0x59, 0xff, 0x80, 0xb2, 0x0a, 0xf4, 0x79, 0x56, 0x21, 0x93, 0xf2, 0x01, 0x63, 0x99, 0x17,
+	0x9c, 0xf9, 0x3e, 0x20, 0x97, 0xfb, 0x18, 0xcc, 0xe1, 0x5d, 0xd3, 0x4e, 0x34, 0x01, 0x5e, 0x8a,
+	0x6e, 0x14, 0x10, 0xb6, 0xc5, 0x43, 0xfd, 0xda, 0xf3, 0xfd, 0x41, 0x62, 0x17, 0xdd, 0x9f, 0x0c,
+	0x58, 0xd2, 0x82, 0x5b, 0x49, 0x24, 0x7b, 0x07, 0xf1, 0xef, 0x8e, 0xfc, 0x53, 0x30, 0x89, 0x52,
+	0xe5, 0x8f, 0x6a, 0xd5, 0xad, 0x19, 0xc2, 0xf9, 0x6d, 0x98, 0x7c, 0x35, 0x92, 0x5f, 0xbd, 0x0b,
+	0x4b, 0x3a, 0xef, 0x45, 0x4c, 0x13, 0xc2, 0xc3, 0x45, 0x8b, 0x6d, 0x03, 0xa5, 0x0e, 0xb4, 0x90,
+	0xfb, 0xa5, 0x31, 0x2c, 0x60, 0x78, 0x09, 0x86, 0x6c, 0x08, 0xbd, 0x71, 0x21, 0xe8, 0x8b, 0x8b,
+	0x40, 0xef, 0xac, 0x4f, 0x3c, 0xb1, 0xf3, 0x5c, 0x55, 0xef, 0xec, 0x87, 0x22, 0x5c, 0x9f, 0x82,
+	0xfc, 0xc5, 0x80, 0xb0, 0xcb, 0xab, 0xb5, 0x7f, 0x34, 0xfe, 0x79, 0xc9, 0x29, 0x5f, 0xa8, 0xab,
+	0x56, 0x2e, 0xd4, 0x55, 0xbf, 0xab, 0x42, 0x19, 0xb1, 0x7a, 0x06, 0x96, 0xa4, 0x49, 0xdf, 0xa7,
+	0xa7, 0x71, 0x92, 0x23, 0x75, 0x63, 0x86, 0x8e, 0x61, 0x55, 0x53, 0x23, 0xa3, 0x1c, 0x56, 0xb8,
+	0xe7, 0x00, 0x99, 0x0a, 0x82, 0x16, 0xd6, 0xa1, 0xfe, 0xf3, 0xc7, 0x4a, 0x8c, 0x1a, 0x28, 0xb3,
+	0x51, 0x11, 0xd8, 0x84, 0x7a, 0x27, 0x1a, 0xcb, 0x97, 0xe6, 0x86, 0x69, 0x5c, 0x0d, 0xf6, 0x0b,
+	0x1e, 0x74, 0xc6, 0x65, 0x64, 0x07, 0x1a, 0x81, 0xee, 0x1e, 0x5a, 0x85, 0xee, 0x61, 0xb7, 0x66,
+	0x46, 0x7a, 0xd4, 0x64, 0xf6, 0x0b, 0x5e, 0x3d, 0x98, 0xe8, 0x39, 0xaf, 0xc0, 0xd6, 0x5e, 0x24,
+	0x2a, 0x81, 0xb4, 0x22, 0x0d, 0xe6, 0x5f, 0xe6, 0xf9, 0x32, 0x4a, 0xb5, 0xfd, 0x82, 0xd7, 0xcc,
+	0xa6, 0x1b, 0xfd, 0x21, 0x5c, 0xc9, 0xbd, 0x9a, 0xd0, 0x57, 0x45, 0x7d, 0xee, 0x5c, 0xdf, 0x26,
+	0x15, 0x2e, 0x77, 0x3e, 0x18, 0x1d, 0x24, 0xac, 0xe4, 0x1a, 0x87, 0x59, 0xe9, 0xd3, 0x01, 0x61,
+	0x93, 0xfa, 0x6b, 0xa8, 0xff, 0xde, 0x5c, 0xfd, 0xb3, 0x9e, 0xc9, 0x7e, 0xc1, 0xbb, 0xde, 0x99,
+	0xff, 0x88, 0xc6, 0x7e, 0xe8, 0x5b, 0xf1, 0x1e, 0xf3, 0x1c, 0x3f, 0x46, 0xe5, 0x62, 0xec, 0xc7,
+	0xb8, 0x82, 0x3c, 0x07, 0xc0, 0xe4, 0xd3, 0xaa, 0xac, 0xb9, 0xe9, 0x32, 0x9a, 0x73, 0x55, 0xba,
+	0x0c, 0x46, 0x43, 0xef, 0xe6, 0xe8, 0x55, 0xa3, 0x3c, 0x9c, 0xf3, 0xaa, 0x87, 0xe9, 0x12, 0x8c,
+	0xc7, 0xee, 0x67, 0x60, 0xf1, 0x8c, 0x31, 0x2d, 0x5f, 0x9f, 0x9b, 0xeb, 0xc3, 0x21, 0x50, 0xe5,
+	0x3a, 0xcf, 0xd7, 0xdb, 0x55, 0x28, 0x2b, 0x31, 0xf7, 0x57, 0x03, 0xe0, 0x98, 0x06, 0x52, 0x24,
+	0x5b, 0xed, 0xf6, 0xeb, 0x7c, 0xe8, 0xd7, 0x9e, 0xea, 0x1f, 0x99, 0x1a, 0xfa, 0x35, 0x18, 0x53,
+	0xdf, 0x91, 0xe2, 0xf4, 0x77, 0xe4, 0x09, 0x40, 0x9c, 0xd0, 0x30, 0x0a, 0x88, 0xa4, 0xe9, 0x79,
+	0x0d, 0x6a, 0x82, 0xd5, 0xf9, 0x37, 0xc0, 0x3b, 0xf5, 0xfb, 0xd2, 0xa5, 0xad, 0x3c, 0x17, 0xc4,
+	0xd1, 0x17, 0xcd, 0xb3, 0xde, 0x8d, 0x7e, 0x6b, 0x77, 0x60, 0x39, 0x66, 0x24, 0xa0, 0x3d, 0xc1,
+	0x42, 0x9a, 0xf8, 0x92, 0x74, 0x31, 0xd3, 0x2d, 0xaf, 0x39, 0x41, 0x3e, 0x22, 0x5d, 0xf7, 0x7b,
+	0x03, 0xcc, 0x43, 0x46, 0x78, 0x5b, 0x84, 0x38, 0xe6, 0x0d, 0xd0, 0x63, 0x9f, 0x70, 0x9e, 0x7e,
+	0xa4, 0x9c, 0x8e, 0x71, 0x51, 0xc0, 0x6b, 0x99, 0x2d, 0xce, 0x53, 0xe7, 0xe9, 0x94, 0xb7, 0x1f,
+	0xef, 0x09, 0x4a, 0x74, 0xc2, 0xdf, 0x35, 0xb0, 0x45, 0x26, 0xe3, 0x4c, 0xfa, 0x43, 0x28, 0x15,
+	0x5c, 0xa5, 0xb5, 0x92, 0xd7, 0xd4, 0xf4, 0xff, 0x69, 0x44, 0x53, 0x15, 0x21, 0x2e, 0x42, 0x7a,
+	0xf7, 0x17, 0x03, 0xaa, 0xba, 0x40, 0x4e, 0xb7, 0xf1, 0x65, 0xa8, 0xef, 0x25, 0x94, 0x48, 0x9a,
+	0x1c, 0xf5, 0x08, 0xb7, 0x0d, 0xc7, 0x86, 0x46, 0x4e, 0x78, 0xf1, 0x2e, 0x23, 0xcc, 0x2e, 0x3a,
+	0x0d, 0x30, 0x5f, 0xd2, 0x34, 0xc5, 0xf3, 0x12, 0xf6, 0x79, 0x9a, 0xa6, 0xfa, 0xb0, 0xec, 0x58,
+	0x50, 0xd1, 0xcb, 0x8a, 0xe2, 0x6b, 0x0b, 0xa9, 0x77, 0x55, 0xa5, 0xf8, 0x30, 0xa1, 0x27, 0xd1,
+	0xe9, 0x2b, 0x22, 0x83, 0x9e, 0x5d, 0x53, 0x8a, 0x0f, 0x45, 0x2a, 0x47, 0x14, 0x53, 0xc9, 0xea,
+	0xa5, 0xa5, 0x96, 0xf8, 0xc8, 0x6c, 0x70, 0xaa, 0x50, 0x6c, 0x71, 0xbb, 0xae, 0x48, 0x6d, 0x21,
+	0x5b, 0xdc, 0x6e, 0xa8, 0x59, 0xc3, 0xa3, 0x5d, 0x9a, 0x0b, 0x2e, 0x29, 0x1b, 0x8e, 0xe8, 0xa9,
+	0xd4, 0xdb, 0xe6, 0xdd, 0x3d, 0xa8, 0x4f, 0xb4, 0x1d, 0xe5, 0xdf, 0x1b, 0xfe, 0x96, 0x8b, 0xf7,
+	0x5c, 0xcf, 0x5a, 0x5b, 0xa1, 0x9a, 0x4f, 0x6a, 0x50, 0x7a, 0x9d, 0x75, 0xec, 0xa2, 0x5a, 0xbc,
+	0xca, 0x98, 0x5d, 0x52, 0x8b, 0xdd, 0x68, 0x60, 0x97, 0x91, 0x22, 0x42, 0xbb, 0xb2, 0xfd, 0xf0,
+	0xd3, 0xfb, 0xdd, 0x48, 0xf6, 0xb2, 0xce, 0x7a, 0x20, 0xfa, 0x1b, 0x3a, 0x12, 0xf7, 0x22, 0x91,
+	0xaf, 0x36, 0x22, 0x2e, 0x69, 0xc2, 0x09, 0xdb, 0xc0, 0xe0, 0x6c, 0xa8, 0xe0, 0xc4, 0x9d, 0x4e,
+	0x15, 0x77, 0x0f, 0x7f, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x78, 0x51, 0x4a, 0xe3, 0xc4, 0x10, 0x00,
+	0x00,
 }
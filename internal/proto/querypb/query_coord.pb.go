@@ -596,6 +596,62 @@ func (m *ReleaseCollectionRequest) GetNodeID() int64 {
 	return 0
 }
 
+// UpdateCollectionSchemaRequest carries the schema with fields appended to the collection
+type UpdateCollectionSchemaRequest struct {
+	Base                 *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64                      `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Schema               *schemapb.CollectionSchema `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *UpdateCollectionSchemaRequest) Reset()         { *m = UpdateCollectionSchemaRequest{} }
+func (m *UpdateCollectionSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateCollectionSchemaRequest) ProtoMessage()    {}
+func (*UpdateCollectionSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{6}
+}
+
+func (m *UpdateCollectionSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCollectionSchemaRequest.Unmarshal(m, b)
+}
+func (m *UpdateCollectionSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCollectionSchemaRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateCollectionSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCollectionSchemaRequest.Merge(m, src)
+}
+func (m *UpdateCollectionSchemaRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateCollectionSchemaRequest.Size(m)
+}
+func (m *UpdateCollectionSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCollectionSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCollectionSchemaRequest proto.InternalMessageInfo
+
+func (m *UpdateCollectionSchemaRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UpdateCollectionSchemaRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *UpdateCollectionSchemaRequest) GetSchema() *schemapb.CollectionSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
 type GetStatisticsRequest struct {
 	Req                  *internalpb.GetStatisticsRequest `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
 	DmlChannels          []string                         `protobuf:"bytes,2,rep,name=dml_channels,json=dmlChannels,proto3" json:"dml_channels,omitempty"`
@@ -611,7 +667,7 @@ func (m *GetStatisticsRequest) Reset()         { *m = GetStatisticsRequest{} }
 func (m *GetStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetStatisticsRequest) ProtoMessage()    {}
 func (*GetStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{6}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{7}
 }
 
 func (m *GetStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -685,7 +741,7 @@ func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
 func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadPartitionsRequest) ProtoMessage()    {}
 func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{7}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{8}
 }
 
 func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -770,7 +826,7 @@ func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsReque
 func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleasePartitionsRequest) ProtoMessage()    {}
 func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{8}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{9}
 }
 
 func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -840,7 +896,7 @@ func (m *GetPartitionStatesRequest) Reset()         { *m = GetPartitionStatesReq
 func (m *GetPartitionStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatesRequest) ProtoMessage()    {}
 func (*GetPartitionStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{9}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{10}
 }
 
 func (m *GetPartitionStatesRequest) XXX_Unmarshal(b []byte) error {
@@ -901,7 +957,7 @@ func (m *GetPartitionStatesResponse) Reset()         { *m = GetPartitionStatesRe
 func (m *GetPartitionStatesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatesResponse) ProtoMessage()    {}
 func (*GetPartitionStatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{10}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{11}
 }
 
 func (m *GetPartitionStatesResponse) XXX_Unmarshal(b []byte) error {
@@ -949,7 +1005,7 @@ func (m *GetSegmentInfoRequest) Reset()         { *m = GetSegmentInfoRequest{} }
 func (m *GetSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSegmentInfoRequest) ProtoMessage()    {}
 func (*GetSegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{11}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{12}
 }
 
 func (m *GetSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -1003,7 +1059,7 @@ func (m *GetSegmentInfoResponse) Reset()         { *m = GetSegmentInfoResponse{}
 func (m *GetSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSegmentInfoResponse) ProtoMessage()    {}
 func (*GetSegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{12}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{13}
 }
 
 func (m *GetSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -1050,7 +1106,7 @@ func (m *GetShardLeadersRequest) Reset()         { *m = GetShardLeadersRequest{}
 func (m *GetShardLeadersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetShardLeadersRequest) ProtoMessage()    {}
 func (*GetShardLeadersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{13}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{14}
 }
 
 func (m *GetShardLeadersRequest) XXX_Unmarshal(b []byte) error {
@@ -1097,7 +1153,7 @@ func (m *GetShardLeadersResponse) Reset()         { *m = GetShardLeadersResponse
 func (m *GetShardLeadersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetShardLeadersResponse) ProtoMessage()    {}
 func (*GetShardLeadersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{14}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{15}
 }
 
 func (m *GetShardLeadersResponse) XXX_Unmarshal(b []byte) error {
@@ -1145,7 +1201,7 @@ func (m *ShardLeadersList) Reset()         { *m = ShardLeadersList{} }
 func (m *ShardLeadersList) String() string { return proto.CompactTextString(m) }
 func (*ShardLeadersList) ProtoMessage()    {}
 func (*ShardLeadersList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{15}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{16}
 }
 
 func (m *ShardLeadersList) XXX_Unmarshal(b []byte) error {
@@ -1201,7 +1257,7 @@ func (m *LoadMetaInfo) Reset()         { *m = LoadMetaInfo{} }
 func (m *LoadMetaInfo) String() string { return proto.CompactTextString(m) }
 func (*LoadMetaInfo) ProtoMessage()    {}
 func (*LoadMetaInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{16}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{17}
 }
 
 func (m *LoadMetaInfo) XXX_Unmarshal(b []byte) error {
@@ -1267,7 +1323,7 @@ func (m *WatchDmChannelsRequest) Reset()         { *m = WatchDmChannelsRequest{}
 func (m *WatchDmChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchDmChannelsRequest) ProtoMessage()    {}
 func (*WatchDmChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{17}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{18}
 }
 
 func (m *WatchDmChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -1386,7 +1442,7 @@ func (m *UnsubDmChannelRequest) Reset()         { *m = UnsubDmChannelRequest{} }
 func (m *UnsubDmChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*UnsubDmChannelRequest) ProtoMessage()    {}
 func (*UnsubDmChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{18}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{19}
 }
 
 func (m *UnsubDmChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -1459,7 +1515,7 @@ func (m *SegmentLoadInfo) Reset()         { *m = SegmentLoadInfo{} }
 func (m *SegmentLoadInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentLoadInfo) ProtoMessage()    {}
 func (*SegmentLoadInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{19}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{20}
 }
 
 func (m *SegmentLoadInfo) XXX_Unmarshal(b []byte) error {
@@ -1599,7 +1655,7 @@ func (m *FieldIndexInfo) Reset()         { *m = FieldIndexInfo{} }
 func (m *FieldIndexInfo) String() string { return proto.CompactTextString(m) }
 func (*FieldIndexInfo) ProtoMessage()    {}
 func (*FieldIndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{20}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{21}
 }
 
 func (m *FieldIndexInfo) XXX_Unmarshal(b []byte) error {
@@ -1711,7 +1767,7 @@ func (m *LoadSegmentsRequest) Reset()         { *m = LoadSegmentsRequest{} }
 func (m *LoadSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadSegmentsRequest) ProtoMessage()    {}
 func (*LoadSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{21}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{22}
 }
 
 func (m *LoadSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1829,7 +1885,7 @@ func (m *ReleaseSegmentsRequest) Reset()         { *m = ReleaseSegmentsRequest{}
 func (m *ReleaseSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleaseSegmentsRequest) ProtoMessage()    {}
 func (*ReleaseSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{22}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{23}
 }
 
 func (m *ReleaseSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1928,7 +1984,7 @@ func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
 func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()    {}
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{23}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{24}
 }
 
 func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
@@ -1999,7 +2055,7 @@ func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{24}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{25}
 }
 
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
@@ -2068,7 +2124,7 @@ func (m *SyncReplicaSegmentsRequest) Reset()         { *m = SyncReplicaSegmentsR
 func (m *SyncReplicaSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncReplicaSegmentsRequest) ProtoMessage()    {}
 func (*SyncReplicaSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{25}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
 }
 
 func (m *SyncReplicaSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2124,7 +2180,7 @@ func (m *ReplicaSegmentsInfo) Reset()         { *m = ReplicaSegmentsInfo{} }
 func (m *ReplicaSegmentsInfo) String() string { return proto.CompactTextString(m) }
 func (*ReplicaSegmentsInfo) ProtoMessage()    {}
 func (*ReplicaSegmentsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
 }
 
 func (m *ReplicaSegmentsInfo) XXX_Unmarshal(b []byte) error {
@@ -2187,7 +2243,7 @@ func (m *HandoffSegmentsRequest) Reset()         { *m = HandoffSegmentsRequest{}
 func (m *HandoffSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*HandoffSegmentsRequest) ProtoMessage()    {}
 func (*HandoffSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{28}
 }
 
 func (m *HandoffSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2245,7 +2301,7 @@ func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
 func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceRequest) ProtoMessage()    {}
 func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{28}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{29}
 }
 
 func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -2323,7 +2379,7 @@ func (m *DmChannelWatchInfo) Reset()         { *m = DmChannelWatchInfo{} }
 func (m *DmChannelWatchInfo) String() string { return proto.CompactTextString(m) }
 func (*DmChannelWatchInfo) ProtoMessage()    {}
 func (*DmChannelWatchInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{29}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{30}
 }
 
 func (m *DmChannelWatchInfo) XXX_Unmarshal(b []byte) error {
@@ -2394,7 +2450,7 @@ func (m *QueryChannelInfo) Reset()         { *m = QueryChannelInfo{} }
 func (m *QueryChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*QueryChannelInfo) ProtoMessage()    {}
 func (*QueryChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{30}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{31}
 }
 
 func (m *QueryChannelInfo) XXX_Unmarshal(b []byte) error {
@@ -2463,7 +2519,7 @@ func (m *PartitionStates) Reset()         { *m = PartitionStates{} }
 func (m *PartitionStates) String() string { return proto.CompactTextString(m) }
 func (*PartitionStates) ProtoMessage()    {}
 func (*PartitionStates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{31}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{32}
 }
 
 func (m *PartitionStates) XXX_Unmarshal(b []byte) error {
@@ -2533,7 +2589,7 @@ func (m *SegmentInfo) Reset()         { *m = SegmentInfo{} }
 func (m *SegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentInfo) ProtoMessage()    {}
 func (*SegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{32}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{33}
 }
 
 func (m *SegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -2692,7 +2748,7 @@ func (m *CollectionInfo) Reset()         { *m = CollectionInfo{} }
 func (m *CollectionInfo) String() string { return proto.CompactTextString(m) }
 func (*CollectionInfo) ProtoMessage()    {}
 func (*CollectionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{33}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{34}
 }
 
 func (m *CollectionInfo) XXX_Unmarshal(b []byte) error {
@@ -2788,7 +2844,7 @@ func (m *UnsubscribeChannels) Reset()         { *m = UnsubscribeChannels{} }
 func (m *UnsubscribeChannels) String() string { return proto.CompactTextString(m) }
 func (*UnsubscribeChannels) ProtoMessage()    {}
 func (*UnsubscribeChannels) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{34}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{35}
 }
 
 func (m *UnsubscribeChannels) XXX_Unmarshal(b []byte) error {
@@ -2835,7 +2891,7 @@ func (m *UnsubscribeChannelInfo) Reset()         { *m = UnsubscribeChannelInfo{}
 func (m *UnsubscribeChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*UnsubscribeChannelInfo) ProtoMessage()    {}
 func (*UnsubscribeChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{35}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{36}
 }
 
 func (m *UnsubscribeChannelInfo) XXX_Unmarshal(b []byte) error {
@@ -2885,7 +2941,7 @@ func (m *SegmentChangeInfo) Reset()         { *m = SegmentChangeInfo{} }
 func (m *SegmentChangeInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentChangeInfo) ProtoMessage()    {}
 func (*SegmentChangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{36}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{37}
 }
 
 func (m *SegmentChangeInfo) XXX_Unmarshal(b []byte) error {
@@ -2946,7 +3002,7 @@ func (m *SealedSegmentsChangeInfo) Reset()         { *m = SealedSegmentsChangeIn
 func (m *SealedSegmentsChangeInfo) String() string { return proto.CompactTextString(m) }
 func (*SealedSegmentsChangeInfo) ProtoMessage()    {}
 func (*SealedSegmentsChangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{37}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{38}
 }
 
 func (m *SealedSegmentsChangeInfo) XXX_Unmarshal(b []byte) error {
@@ -2992,7 +3048,7 @@ func (m *GetDataDistributionRequest) Reset()         { *m = GetDataDistributionR
 func (m *GetDataDistributionRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDataDistributionRequest) ProtoMessage()    {}
 func (*GetDataDistributionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{38}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{39}
 }
 
 func (m *GetDataDistributionRequest) XXX_Unmarshal(b []byte) error {
@@ -3035,7 +3091,7 @@ func (m *GetDataDistributionResponse) Reset()         { *m = GetDataDistribution
 func (m *GetDataDistributionResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDataDistributionResponse) ProtoMessage()    {}
 func (*GetDataDistributionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{39}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{40}
 }
 
 func (m *GetDataDistributionResponse) XXX_Unmarshal(b []byte) error {
@@ -3106,7 +3162,7 @@ func (m *LeaderView) Reset()         { *m = LeaderView{} }
 func (m *LeaderView) String() string { return proto.CompactTextString(m) }
 func (*LeaderView) ProtoMessage()    {}
 func (*LeaderView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{40}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{41}
 }
 
 func (m *LeaderView) XXX_Unmarshal(b []byte) error {
@@ -3174,7 +3230,7 @@ func (m *SegmentDist) Reset()         { *m = SegmentDist{} }
 func (m *SegmentDist) String() string { return proto.CompactTextString(m) }
 func (*SegmentDist) ProtoMessage()    {}
 func (*SegmentDist) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{41}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{42}
 }
 
 func (m *SegmentDist) XXX_Unmarshal(b []byte) error {
@@ -3224,7 +3280,7 @@ func (m *SegmentVersionInfo) Reset()         { *m = SegmentVersionInfo{} }
 func (m *SegmentVersionInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentVersionInfo) ProtoMessage()    {}
 func (*SegmentVersionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{42}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{43}
 }
 
 func (m *SegmentVersionInfo) XXX_Unmarshal(b []byte) error {
@@ -3293,7 +3349,7 @@ func (m *ChannelVersionInfo) Reset()         { *m = ChannelVersionInfo{} }
 func (m *ChannelVersionInfo) String() string { return proto.CompactTextString(m) }
 func (*ChannelVersionInfo) ProtoMessage()    {}
 func (*ChannelVersionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{43}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{44}
 }
 
 func (m *ChannelVersionInfo) XXX_Unmarshal(b []byte) error {
@@ -3350,7 +3406,7 @@ func (m *CollectionLoadInfo) Reset()         { *m = CollectionLoadInfo{} }
 func (m *CollectionLoadInfo) String() string { return proto.CompactTextString(m) }
 func (*CollectionLoadInfo) ProtoMessage()    {}
 func (*CollectionLoadInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{44}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{45}
 }
 
 func (m *CollectionLoadInfo) XXX_Unmarshal(b []byte) error {
@@ -3421,7 +3477,7 @@ func (m *PartitionLoadInfo) Reset()         { *m = PartitionLoadInfo{} }
 func (m *PartitionLoadInfo) String() string { return proto.CompactTextString(m) }
 func (*PartitionLoadInfo) ProtoMessage()    {}
 func (*PartitionLoadInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{45}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{46}
 }
 
 func (m *PartitionLoadInfo) XXX_Unmarshal(b []byte) error {
@@ -3490,7 +3546,7 @@ func (m *Replica) Reset()         { *m = Replica{} }
 func (m *Replica) String() string { return proto.CompactTextString(m) }
 func (*Replica) ProtoMessage()    {}
 func (*Replica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{46}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{47}
 }
 
 func (m *Replica) XXX_Unmarshal(b []byte) error {
@@ -3547,7 +3603,7 @@ func (m *SyncAction) Reset()         { *m = SyncAction{} }
 func (m *SyncAction) String() string { return proto.CompactTextString(m) }
 func (*SyncAction) ProtoMessage()    {}
 func (*SyncAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{47}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{48}
 }
 
 func (m *SyncAction) XXX_Unmarshal(b []byte) error {
@@ -3617,7 +3673,7 @@ func (m *SyncDistributionRequest) Reset()         { *m = SyncDistributionRequest
 func (m *SyncDistributionRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncDistributionRequest) ProtoMessage()    {}
 func (*SyncDistributionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{48}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{49}
 }
 
 func (m *SyncDistributionRequest) XXX_Unmarshal(b []byte) error {
@@ -3680,6 +3736,7 @@ func init() {
 	proto.RegisterType((*LoadCollectionRequest)(nil), "milvus.proto.query.LoadCollectionRequest")
 	proto.RegisterMapType((map[int64]int64)(nil), "milvus.proto.query.LoadCollectionRequest.FieldIndexIDEntry")
 	proto.RegisterType((*ReleaseCollectionRequest)(nil), "milvus.proto.query.ReleaseCollectionRequest")
+	proto.RegisterType((*UpdateCollectionSchemaRequest)(nil), "milvus.proto.query.UpdateCollectionSchemaRequest")
 	proto.RegisterType((*GetStatisticsRequest)(nil), "milvus.proto.query.GetStatisticsRequest")
 	proto.RegisterType((*LoadPartitionsRequest)(nil), "milvus.proto.query.LoadPartitionsRequest")
 	proto.RegisterMapType((map[int64]int64)(nil), "milvus.proto.query.LoadPartitionsRequest.FieldIndexIDEntry")
@@ -3734,241 +3791,244 @@ func init() {
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 3736 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x49, 0x6c, 0x24, 0x59,
-	0x56, 0x15, 0xb9, 0xd8, 0x99, 0x2f, 0x17, 0x87, 0xbf, 0xab, 0x5c, 0x39, 0x39, 0xd5, 0xd5, 0xee,
-	0xa8, 0xae, 0x6e, 0xe3, 0x9e, 0xb6, 0x7b, 0x5c, 0x33, 0x4d, 0x0d, 0x33, 0xa3, 0xa1, 0xca, 0x9e,
-	0x72, 0x9b, 0xee, 0xf2, 0x98, 0xc8, 0xaa, 0x02, 0xb5, 0x9a, 0xc9, 0x89, 0xcc, 0xf8, 0x99, 0x0e,
-	0x55, 0x2c, 0x59, 0x11, 0x91, 0xae, 0x76, 0x73, 0xe5, 0x32, 0x23, 0x40, 0x82, 0x03, 0x27, 0xc4,
-	0x09, 0x24, 0x90, 0x68, 0xc4, 0x01, 0x6e, 0x1c, 0x90, 0x90, 0xe0, 0x86, 0xb8, 0x71, 0xe4, 0x8a,
-	0x04, 0x12, 0x12, 0xd2, 0x1c, 0x38, 0x20, 0xa1, 0xbf, 0xc5, 0xfa, 0xc3, 0x19, 0x65, 0x77, 0xf5,
-	0x82, 0xe6, 0x96, 0xf1, 0xfe, 0xf2, 0xde, 0x7f, 0xfb, 0x7b, 0xff, 0x27, 0xac, 0x3e, 0x9b, 0x63,
-	0xff, 0x6c, 0x38, 0xf6, 0x3c, 0xdf, 0xdc, 0x9e, 0xf9, 0x5e, 0xe8, 0x21, 0xe4, 0x58, 0xf6, 0xe9,
-	0x3c, 0x60, 0x5f, 0xdb, 0x74, 0xbc, 0xdf, 0x1e, 0x7b, 0x8e, 0xe3, 0xb9, 0x0c, 0xd6, 0x6f, 0x27,
-	0x67, 0xf4, 0xbb, 0x96, 0x1b, 0x62, 0xdf, 0x35, 0x6c, 0x31, 0x1a, 0x8c, 0x4f, 0xb0, 0x63, 0xf0,
-	0x2f, 0xd5, 0x34, 0x42, 0x23, 0xb9, 0xbf, 0xf6, 0x3b, 0x0a, 0xac, 0x0f, 0x4e, 0xbc, 0xe7, 0x7b,
-	0x9e, 0x6d, 0xe3, 0x71, 0x68, 0x79, 0x6e, 0xa0, 0xe3, 0x67, 0x73, 0x1c, 0x84, 0xe8, 0x1d, 0xa8,
-	0x8d, 0x8c, 0x00, 0xf7, 0x94, 0x0d, 0x65, 0xb3, 0xb5, 0x7b, 0x63, 0x3b, 0x45, 0x09, 0x27, 0xe1,
-	0x61, 0x30, 0xbd, 0x6f, 0x04, 0x58, 0xa7, 0x33, 0x11, 0x82, 0x9a, 0x39, 0x3a, 0xdc, 0xef, 0x55,
-	0x36, 0x94, 0xcd, 0xaa, 0x4e, 0x7f, 0xa3, 0xd7, 0xa1, 0x33, 0x8e, 0xf6, 0x3e, 0xdc, 0x0f, 0x7a,
-	0xd5, 0x8d, 0xea, 0x66, 0x55, 0x4f, 0x03, 0xb5, 0x7f, 0x53, 0xe0, 0x7a, 0x8e, 0x8c, 0x60, 0xe6,
-	0xb9, 0x01, 0x46, 0x77, 0x60, 0x29, 0x08, 0x8d, 0x70, 0x1e, 0x70, 0x4a, 0xbe, 0x2e, 0xa5, 0x64,
-	0x40, 0xa7, 0xe8, 0x7c, 0x6a, 0x1e, 0x6d, 0x45, 0x82, 0x16, 0x7d, 0x13, 0xae, 0x5a, 0xee, 0x43,
-	0xec, 0x78, 0xfe, 0xd9, 0x70, 0x86, 0xfd, 0x31, 0x76, 0x43, 0x63, 0x8a, 0x05, 0x8d, 0x6b, 0x62,
-	0xec, 0x38, 0x1e, 0x42, 0xef, 0xc2, 0x75, 0x26, 0xa5, 0x00, 0xfb, 0xa7, 0xd6, 0x18, 0x0f, 0x8d,
-	0x53, 0xc3, 0xb2, 0x8d, 0x91, 0x8d, 0x7b, 0xb5, 0x8d, 0xea, 0x66, 0x43, 0xbf, 0x46, 0x87, 0x07,
-	0x6c, 0xf4, 0x9e, 0x18, 0xd4, 0xfe, 0x4c, 0x81, 0x6b, 0xe4, 0x84, 0xc7, 0x86, 0x1f, 0x5a, 0x2f,
-	0x81, 0xcf, 0x1a, 0xb4, 0x93, 0x67, 0xeb, 0x55, 0xe9, 0x58, 0x0a, 0x46, 0xe6, 0xcc, 0x04, 0x7a,
-	0xc2, 0x93, 0x1a, 0x3d, 0x66, 0x0a, 0xa6, 0xfd, 0x29, 0x57, 0x88, 0x24, 0x9d, 0x97, 0x11, 0x44,
-	0x16, 0x67, 0x25, 0x8f, 0xf3, 0x02, 0x62, 0xd0, 0x7e, 0x56, 0x85, 0x6b, 0x1f, 0x78, 0x86, 0x19,
-	0x2b, 0xcc, 0xe7, 0xcf, 0xce, 0xef, 0xc3, 0x12, 0xb3, 0xae, 0x5e, 0x8d, 0xe2, 0xba, 0x9d, 0xc6,
-	0xc5, 0x2d, 0x2f, 0xa6, 0x70, 0x40, 0x01, 0x3a, 0x5f, 0x84, 0x6e, 0x43, 0xd7, 0xc7, 0x33, 0xdb,
-	0x1a, 0x1b, 0x43, 0x77, 0xee, 0x8c, 0xb0, 0xdf, 0xab, 0x6f, 0x28, 0x9b, 0x75, 0xbd, 0xc3, 0xa1,
-	0x47, 0x14, 0x88, 0x7e, 0x02, 0x9d, 0x89, 0x85, 0x6d, 0x73, 0x68, 0xb9, 0x26, 0xfe, 0xf8, 0x70,
-	0xbf, 0xb7, 0xb4, 0x51, 0xdd, 0x6c, 0xed, 0x7e, 0x77, 0x3b, 0xef, 0x19, 0xb6, 0xa5, 0x1c, 0xd9,
-	0x7e, 0x40, 0x96, 0x1f, 0xb2, 0xd5, 0x3f, 0x74, 0x43, 0xff, 0x4c, 0x6f, 0x4f, 0x12, 0xa0, 0xfe,
-	0x0f, 0x60, 0x35, 0x37, 0x05, 0xa9, 0x50, 0x7d, 0x8a, 0xcf, 0x28, 0x17, 0xab, 0x3a, 0xf9, 0x89,
-	0xae, 0x42, 0xfd, 0xd4, 0xb0, 0xe7, 0x98, 0xf3, 0x89, 0x7d, 0xfc, 0x4a, 0xe5, 0xae, 0xa2, 0xfd,
-	0xb1, 0x02, 0x3d, 0x1d, 0xdb, 0xd8, 0x08, 0xf0, 0x17, 0x29, 0x8f, 0x75, 0x58, 0x72, 0x3d, 0x13,
-	0x1f, 0xee, 0x53, 0x79, 0x54, 0x75, 0xfe, 0xa5, 0xfd, 0x8f, 0x02, 0x57, 0x0f, 0x70, 0x48, 0x14,
-	0xd3, 0x0a, 0x42, 0x6b, 0x1c, 0x59, 0xde, 0xf7, 0xa1, 0xea, 0xe3, 0x67, 0x9c, 0xb2, 0xb7, 0xd2,
-	0x94, 0x45, 0x7e, 0x54, 0xb6, 0x52, 0x27, 0xeb, 0xd0, 0x6b, 0xd0, 0x36, 0x1d, 0x7b, 0x38, 0x3e,
-	0x31, 0x5c, 0x17, 0xdb, 0x4c, 0xb5, 0x9b, 0x7a, 0xcb, 0x74, 0xec, 0x3d, 0x0e, 0x42, 0x37, 0x01,
-	0x02, 0x3c, 0x75, 0xb0, 0x1b, 0xc6, 0xae, 0x2f, 0x01, 0x41, 0x5b, 0xb0, 0x3a, 0xf1, 0x3d, 0x67,
-	0x18, 0x9c, 0x18, 0xbe, 0x39, 0xb4, 0xb1, 0x61, 0x62, 0x9f, 0x52, 0xdf, 0xd0, 0x57, 0xc8, 0xc0,
-	0x80, 0xc0, 0x3f, 0xa0, 0x60, 0x74, 0x07, 0xea, 0xc1, 0xd8, 0x9b, 0x61, 0xaa, 0x26, 0xdd, 0xdd,
-	0x57, 0x64, 0x0a, 0xb0, 0x6f, 0x84, 0xc6, 0x80, 0x4c, 0xd2, 0xd9, 0x5c, 0xed, 0xaf, 0xb8, 0x9d,
-	0x7c, 0xc9, 0xdd, 0x4e, 0xc2, 0x96, 0xea, 0x9f, 0x8d, 0x2d, 0x2d, 0x95, 0xb2, 0xa5, 0xe5, 0xf3,
-	0x6d, 0x29, 0xc7, 0xb5, 0x97, 0x6f, 0x4b, 0x7f, 0x1f, 0xdb, 0xd2, 0x97, 0x5d, 0x66, 0xb1, 0xbd,
-	0xd5, 0x53, 0xf6, 0xf6, 0x17, 0x0a, 0x7c, 0xed, 0x00, 0x87, 0x11, 0xf9, 0xc4, 0x7c, 0xf0, 0x97,
-	0x34, 0xdc, 0x7d, 0xaa, 0x40, 0x5f, 0x46, 0xeb, 0x65, 0x42, 0xde, 0x87, 0xb0, 0x1e, 0xe1, 0x18,
-	0x9a, 0x38, 0x18, 0xfb, 0xd6, 0x8c, 0x8a, 0x91, 0x7a, 0x88, 0xd6, 0xee, 0x2d, 0x99, 0xba, 0x65,
-	0x29, 0xb8, 0x16, 0x6d, 0xb1, 0x9f, 0xd8, 0x41, 0xfb, 0x3d, 0x05, 0xae, 0x11, 0x8f, 0xc4, 0x5d,
-	0x88, 0x3b, 0xf1, 0x2e, 0xce, 0xd7, 0xb4, 0x73, 0xaa, 0xe4, 0x9c, 0x53, 0x09, 0x1e, 0xd3, 0xfc,
-	0x31, 0x4b, 0xcf, 0x65, 0x78, 0xf7, 0x6d, 0xa8, 0x5b, 0xee, 0xc4, 0x13, 0xac, 0x7a, 0x55, 0xc6,
-	0xaa, 0x24, 0x32, 0x36, 0x5b, 0x73, 0x19, 0x15, 0xb1, 0xb7, 0xbc, 0x84, 0xba, 0x65, 0x8f, 0x5d,
-	0x91, 0x1c, 0xfb, 0x77, 0x15, 0xb8, 0x9e, 0x43, 0x78, 0x99, 0x73, 0x7f, 0x0f, 0x96, 0x68, 0x0c,
-	0x10, 0x07, 0x7f, 0x5d, 0x7a, 0xf0, 0x04, 0xba, 0x0f, 0xac, 0x20, 0xd4, 0xf9, 0x1a, 0xcd, 0x03,
-	0x35, 0x3b, 0x46, 0xa2, 0x13, 0x8f, 0x4c, 0x43, 0xd7, 0x70, 0x18, 0x03, 0x9a, 0x7a, 0x8b, 0xc3,
-	0x8e, 0x0c, 0x07, 0xa3, 0xaf, 0x41, 0x83, 0x98, 0xec, 0xd0, 0x32, 0x85, 0xf8, 0x97, 0xa9, 0x09,
-	0x9b, 0x01, 0x7a, 0x05, 0x80, 0x0e, 0x19, 0xa6, 0xe9, 0xb3, 0xc0, 0xd5, 0xd4, 0x9b, 0x04, 0x72,
-	0x8f, 0x00, 0xb4, 0x3f, 0x50, 0xa0, 0x4d, 0x1c, 0xe4, 0x43, 0x1c, 0x1a, 0x44, 0x0e, 0xe8, 0x3b,
-	0xd0, 0xb4, 0x3d, 0xc3, 0x1c, 0x86, 0x67, 0x33, 0x86, 0xaa, 0x9b, 0xe5, 0x75, 0xec, 0x55, 0x1f,
-	0x9d, 0xcd, 0xb0, 0xde, 0xb0, 0xf9, 0xaf, 0x32, 0xfc, 0xce, 0x99, 0x72, 0x55, 0x62, 0xca, 0xff,
-	0x58, 0x87, 0xf5, 0xdf, 0x30, 0xc2, 0xf1, 0xc9, 0xbe, 0x23, 0xe2, 0xef, 0xc5, 0x95, 0x20, 0xf6,
-	0x6d, 0x95, 0xa4, 0x6f, 0xfb, 0xcc, 0x7c, 0x67, 0xa4, 0xe7, 0x75, 0x99, 0x9e, 0x93, 0x32, 0x6d,
-	0xfb, 0x09, 0x17, 0x55, 0x42, 0xcf, 0x13, 0x61, 0x72, 0xe9, 0x22, 0x61, 0x72, 0x0f, 0x3a, 0xf8,
-	0xe3, 0xb1, 0x3d, 0x27, 0x32, 0xa7, 0xd8, 0x59, 0xfc, 0xbb, 0x29, 0xc1, 0x9e, 0x34, 0xb2, 0x36,
-	0x5f, 0x74, 0xc8, 0x69, 0x60, 0xa2, 0x76, 0x70, 0x68, 0xf4, 0x1a, 0x94, 0x8c, 0x8d, 0x22, 0x51,
-	0x0b, 0xfd, 0x60, 0xe2, 0x26, 0x5f, 0xe8, 0x06, 0x34, 0x79, 0x50, 0x3e, 0xdc, 0xef, 0x35, 0x29,
-	0xfb, 0x62, 0x00, 0x32, 0xa0, 0xc3, 0x3d, 0x10, 0xa7, 0x10, 0x28, 0x85, 0xdf, 0x93, 0x21, 0x90,
-	0x0b, 0x3b, 0x49, 0x79, 0xc0, 0x43, 0x74, 0x90, 0x00, 0x91, 0xd2, 0xd0, 0x9b, 0x4c, 0x6c, 0xcb,
-	0xc5, 0x47, 0x4c, 0xc2, 0x2d, 0x4a, 0x44, 0x1a, 0x88, 0x7a, 0xb0, 0x7c, 0x8a, 0xfd, 0xc0, 0xf2,
-	0xdc, 0x5e, 0x9b, 0x8e, 0x8b, 0xcf, 0xfe, 0x10, 0x56, 0x73, 0x28, 0x24, 0x21, 0xfe, 0x5b, 0xc9,
-	0x10, 0xbf, 0x98, 0xc7, 0x89, 0x14, 0xe0, 0xcf, 0x15, 0xb8, 0xf6, 0xd8, 0x0d, 0xe6, 0xa3, 0xe8,
-	0x6c, 0x5f, 0x8c, 0x1e, 0x67, 0x3d, 0x48, 0x2d, 0xe7, 0x41, 0xb4, 0x9f, 0xd6, 0x61, 0x85, 0x9f,
-	0x82, 0x88, 0x9b, 0xba, 0x82, 0x1b, 0xd0, 0x8c, 0x82, 0x08, 0x67, 0x48, 0x0c, 0x40, 0x1b, 0xd0,
-	0x4a, 0x18, 0x02, 0xa7, 0x2a, 0x09, 0x2a, 0x45, 0x9a, 0x48, 0x09, 0x6a, 0x89, 0x94, 0xe0, 0x15,
-	0x80, 0x89, 0x3d, 0x0f, 0x4e, 0x86, 0xa1, 0xe5, 0x60, 0x9e, 0x92, 0x34, 0x29, 0xe4, 0x91, 0xe5,
-	0x60, 0x74, 0x0f, 0xda, 0x23, 0xcb, 0xb5, 0xbd, 0xe9, 0x70, 0x66, 0x84, 0x27, 0x01, 0x2f, 0xa3,
-	0x64, 0x62, 0xa1, 0x09, 0xdc, 0x7d, 0x3a, 0x57, 0x6f, 0xb1, 0x35, 0xc7, 0x64, 0x09, 0xba, 0x09,
-	0x2d, 0x77, 0xee, 0x0c, 0xbd, 0xc9, 0xd0, 0xf7, 0x9e, 0x13, 0xe3, 0xa1, 0x28, 0xdc, 0xb9, 0xf3,
-	0xa3, 0x89, 0xee, 0x3d, 0x27, 0x4e, 0xbc, 0x49, 0xdc, 0x79, 0x60, 0x7b, 0xd3, 0xa0, 0xd7, 0x28,
-	0xb5, 0x7f, 0xbc, 0x80, 0xac, 0x36, 0xb1, 0x1d, 0x1a, 0x74, 0x75, 0xb3, 0xdc, 0xea, 0x68, 0x01,
-	0x7a, 0x03, 0xba, 0x63, 0xcf, 0x99, 0x19, 0x94, 0x43, 0x0f, 0x7c, 0xcf, 0xa1, 0x96, 0x53, 0xd5,
-	0x33, 0x50, 0xb4, 0x07, 0x2d, 0x9a, 0xfc, 0x72, 0xf3, 0x6a, 0x51, 0x3c, 0x9a, 0xcc, 0xbc, 0x12,
-	0x79, 0x2c, 0x51, 0x50, 0xb0, 0xc4, 0xcf, 0x80, 0x68, 0x86, 0xb0, 0xd2, 0xc0, 0xfa, 0x04, 0x73,
-	0x0b, 0x69, 0x71, 0xd8, 0xc0, 0xfa, 0x04, 0x93, 0x8c, 0xdc, 0x72, 0x03, 0xec, 0x87, 0xa2, 0x3e,
-	0xea, 0x75, 0xa8, 0xfa, 0x74, 0x18, 0x94, 0x2b, 0x36, 0x3a, 0x84, 0x6e, 0x10, 0x1a, 0x7e, 0x38,
-	0x9c, 0x79, 0x01, 0x55, 0x80, 0x5e, 0x97, 0xea, 0xb6, 0x56, 0x50, 0x8d, 0x3d, 0x0c, 0xa6, 0xc7,
-	0x7c, 0xa6, 0xde, 0xa1, 0x2b, 0xc5, 0xa7, 0xf6, 0x5f, 0x15, 0xe8, 0xa6, 0x69, 0x26, 0x46, 0xcc,
-	0xb2, 0x73, 0xa1, 0x88, 0xe2, 0x93, 0x9c, 0x00, 0xbb, 0xc6, 0xc8, 0xc6, 0xac, 0x14, 0xa0, 0x7a,
-	0xd8, 0xd0, 0x5b, 0x0c, 0x46, 0x37, 0x20, 0xfa, 0xc4, 0x38, 0x45, 0x95, 0xbf, 0x4a, 0xa9, 0x6f,
-	0x52, 0x08, 0x0d, 0x9e, 0x3d, 0x58, 0x16, 0x55, 0x04, 0xd3, 0x42, 0xf1, 0x49, 0x46, 0x46, 0x73,
-	0x8b, 0x62, 0x65, 0x5a, 0x28, 0x3e, 0xd1, 0x3e, 0xb4, 0xd9, 0x96, 0x33, 0xc3, 0x37, 0x1c, 0xa1,
-	0x83, 0xaf, 0x49, 0xed, 0xf8, 0x7d, 0x7c, 0xf6, 0x84, 0xb8, 0x84, 0x63, 0xc3, 0xf2, 0x75, 0x26,
-	0xb3, 0x63, 0xba, 0x0a, 0x6d, 0x82, 0xca, 0x76, 0x99, 0x58, 0x36, 0xe6, 0xda, 0xbc, 0x4c, 0x23,
-	0x74, 0x97, 0xc2, 0x1f, 0x58, 0x36, 0x66, 0x0a, 0x1b, 0x1d, 0x81, 0x4a, 0xa9, 0xc1, 0xf4, 0x95,
-	0x42, 0xa8, 0x8c, 0x6e, 0x41, 0x87, 0x0d, 0x0b, 0x4f, 0xc7, 0xdc, 0x31, 0xa3, 0xf1, 0x09, 0x83,
-	0xd1, 0x24, 0x61, 0xee, 0x30, 0x8d, 0x07, 0x76, 0x1c, 0x77, 0xee, 0x10, 0x7d, 0xd7, 0xfe, 0xb0,
-	0x06, 0x6b, 0xc4, 0xec, 0xb9, 0x07, 0xb8, 0x44, 0xb8, 0x7d, 0x05, 0xc0, 0x0c, 0xc2, 0x61, 0xca,
-	0x55, 0x35, 0xcd, 0x20, 0xe4, 0xce, 0xf8, 0x3b, 0x22, 0x5a, 0x56, 0x8b, 0x13, 0xe8, 0x8c, 0x1b,
-	0xca, 0x47, 0xcc, 0x0b, 0x35, 0x69, 0x6e, 0x41, 0x27, 0xf0, 0xe6, 0xfe, 0x18, 0x0f, 0x53, 0xa5,
-	0x4e, 0x9b, 0x01, 0x8f, 0xe4, 0xce, 0x74, 0x49, 0xda, 0x2c, 0x4a, 0x44, 0xcd, 0xe5, 0xcb, 0x45,
-	0xcd, 0x46, 0x36, 0x6a, 0xbe, 0x0f, 0x2b, 0xd4, 0x13, 0x44, 0x56, 0x24, 0x1c, 0x48, 0x19, 0x33,
-	0xea, 0xd2, 0xa5, 0xe2, 0x33, 0x48, 0x46, 0x3e, 0x48, 0x45, 0x3e, 0xc2, 0x0c, 0x17, 0x63, 0x73,
-	0x18, 0xfa, 0x86, 0x1b, 0x4c, 0xb0, 0x4f, 0x23, 0x67, 0x43, 0x6f, 0x13, 0xe0, 0x23, 0x0e, 0xd3,
-	0xfe, 0xb9, 0x02, 0xeb, 0xbc, 0x80, 0xbd, 0xbc, 0x5e, 0x14, 0x85, 0x2f, 0xe1, 0xff, 0xab, 0xe7,
-	0x94, 0x84, 0xb5, 0x12, 0xa9, 0x59, 0x5d, 0x92, 0x9a, 0xa5, 0xcb, 0xa2, 0xa5, 0x5c, 0x59, 0x14,
-	0xf5, 0x61, 0x96, 0xcb, 0xf7, 0x61, 0x48, 0xc1, 0x4f, 0x73, 0x75, 0x2a, 0xbb, 0xa6, 0xce, 0x3e,
-	0xca, 0x31, 0xf4, 0x3f, 0x14, 0xe8, 0x0c, 0xb0, 0xe1, 0x8f, 0x4f, 0x04, 0x1f, 0xdf, 0x4d, 0xf6,
-	0xad, 0x5e, 0x2f, 0x10, 0x71, 0x6a, 0xc9, 0x57, 0xa7, 0x61, 0xf5, 0x9f, 0x0a, 0xb4, 0x7f, 0x9d,
-	0x0c, 0x89, 0xc3, 0xde, 0x4d, 0x1e, 0xf6, 0x8d, 0x82, 0xc3, 0xea, 0x38, 0xf4, 0x2d, 0x7c, 0x8a,
-	0xbf, 0x72, 0xc7, 0xfd, 0x27, 0x05, 0xfa, 0x83, 0x33, 0x77, 0xac, 0x33, 0x5b, 0xbe, 0xbc, 0xc5,
-	0xdc, 0x82, 0xce, 0x69, 0x2a, 0x6b, 0xab, 0x50, 0x85, 0x6b, 0x9f, 0x26, 0x0b, 0x3f, 0x1d, 0x54,
-	0xd1, 0x2e, 0xe3, 0x87, 0x15, 0xae, 0xf5, 0x4d, 0x19, 0xd5, 0x19, 0xe2, 0xa8, 0x6b, 0x5a, 0xf1,
-	0xd3, 0x40, 0xed, 0xf7, 0x15, 0x58, 0x93, 0x4c, 0x44, 0xd7, 0x61, 0x99, 0x17, 0x99, 0x3c, 0x06,
-	0x33, 0x1b, 0x36, 0x89, 0x78, 0xe2, 0x36, 0x89, 0x65, 0xe6, 0x53, 0x41, 0x13, 0xbd, 0x0a, 0xad,
-	0xa8, 0x1a, 0x30, 0x73, 0xf2, 0x31, 0x03, 0xd4, 0x87, 0x06, 0x77, 0x4e, 0xa2, 0xcc, 0x8a, 0xbe,
-	0xb5, 0xbf, 0x53, 0x60, 0xfd, 0x3d, 0xc3, 0x35, 0xbd, 0xc9, 0xe4, 0xf2, 0x6c, 0xdd, 0x83, 0x54,
-	0x11, 0x51, 0xb6, 0x3d, 0x91, 0xae, 0x3c, 0xde, 0x82, 0x55, 0x9f, 0x79, 0x46, 0x33, 0xcd, 0xf7,
-	0xaa, 0xae, 0x8a, 0x81, 0x88, 0x9f, 0x7f, 0x59, 0x01, 0x44, 0x82, 0xc1, 0x7d, 0xc3, 0x36, 0xdc,
-	0x31, 0xbe, 0x38, 0xe9, 0xb7, 0xa1, 0x9b, 0x0a, 0x61, 0xd1, 0x5d, 0x58, 0x32, 0x86, 0x05, 0xe8,
-	0x7d, 0xe8, 0x8e, 0x18, 0xaa, 0xa1, 0x8f, 0x8d, 0xc0, 0x73, 0xa9, 0x73, 0xed, 0xca, 0x3b, 0x11,
-	0x8f, 0x7c, 0x6b, 0x3a, 0xc5, 0xfe, 0x9e, 0xe7, 0x9a, 0x3c, 0x17, 0x1b, 0x09, 0x32, 0xc9, 0x52,
-	0x22, 0xb8, 0x38, 0x9e, 0x0b, 0xd1, 0x40, 0x14, 0xd0, 0x29, 0x2b, 0x02, 0x6c, 0xd8, 0x31, 0x23,
-	0x62, 0x6f, 0xac, 0xb2, 0x81, 0x41, 0x71, 0x23, 0x4a, 0x12, 0x5f, 0xb5, 0xbf, 0x51, 0x00, 0x45,
-	0xf5, 0x12, 0xad, 0x0c, 0xa9, 0xf6, 0x65, 0x97, 0x2a, 0x92, 0xa0, 0x70, 0x03, 0x9a, 0xa6, 0x58,
-	0xc9, 0xcd, 0x25, 0x06, 0x50, 0x1f, 0x4d, 0x89, 0x1e, 0x92, 0x60, 0x8c, 0x4d, 0x51, 0x8f, 0x30,
-	0xe0, 0x07, 0x14, 0x96, 0x0e, 0xcf, 0xb5, 0x6c, 0x78, 0x4e, 0xf6, 0x59, 0xea, 0xa9, 0x3e, 0x8b,
-	0xf6, 0x69, 0x05, 0x54, 0xea, 0xee, 0xf6, 0xe2, 0x62, 0xbf, 0x14, 0xd1, 0xb7, 0xa0, 0xc3, 0x6f,
-	0x8b, 0x53, 0x84, 0xb7, 0x9f, 0x25, 0x36, 0x43, 0xef, 0xc0, 0x55, 0x36, 0xc9, 0xc7, 0xc1, 0xdc,
-	0x8e, 0x53, 0x71, 0x96, 0xcc, 0xa2, 0x67, 0xcc, 0xcf, 0x92, 0x21, 0xb1, 0xe2, 0x31, 0xac, 0x4f,
-	0x6d, 0x6f, 0x64, 0xd8, 0xc3, 0xb4, 0x78, 0x98, 0x0c, 0x4b, 0x68, 0xfc, 0x55, 0xb6, 0x7c, 0x90,
-	0x94, 0x61, 0x80, 0x0e, 0x48, 0x59, 0x8f, 0x9f, 0xc6, 0x59, 0x7e, 0xbd, 0x74, 0x96, 0xdf, 0x26,
-	0x0b, 0xa3, 0x24, 0xff, 0x4f, 0x14, 0x58, 0xc9, 0xb4, 0x4a, 0xb3, 0x25, 0xa5, 0x92, 0x2f, 0x29,
-	0xef, 0x42, 0x9d, 0xd4, 0x59, 0xcc, 0x19, 0x76, 0xe5, 0xe5, 0x4e, 0x7a, 0x57, 0x9d, 0x2d, 0x40,
-	0x3b, 0xb0, 0x26, 0xb9, 0x9a, 0xe4, 0x3a, 0x80, 0xf2, 0x37, 0x93, 0xda, 0xcf, 0x6b, 0xd0, 0x4a,
-	0xf0, 0x63, 0x41, 0x35, 0x5c, 0xa6, 0xf7, 0x95, 0x39, 0x5e, 0x35, 0x7f, 0xbc, 0x82, 0x8b, 0x2f,
-	0xa2, 0x77, 0x0e, 0x76, 0x58, 0xf2, 0xcf, 0x2b, 0x11, 0x07, 0x3b, 0x34, 0xf5, 0x4f, 0x66, 0xf5,
-	0x4b, 0xa9, 0xac, 0x3e, 0x53, 0xf7, 0x2c, 0x9f, 0x53, 0xf7, 0x34, 0xd2, 0x75, 0x4f, 0xca, 0x8e,
-	0x9a, 0x59, 0x3b, 0x2a, 0x5b, 0xa0, 0xbe, 0x03, 0x6b, 0x63, 0x1f, 0x1b, 0x21, 0x36, 0xef, 0x9f,
-	0xed, 0x45, 0x43, 0x3c, 0x33, 0x92, 0x0d, 0xa1, 0x07, 0x71, 0xcf, 0x88, 0x49, 0xb9, 0x4d, 0xa5,
-	0x2c, 0x2f, 0xab, 0xb8, 0x6c, 0x98, 0x90, 0x85, 0x7b, 0xa6, 0x5f, 0xd9, 0xd2, 0xb8, 0x73, 0xa1,
-	0xd2, 0xf8, 0x55, 0x68, 0x89, 0xd0, 0x4a, 0xcc, 0xbd, 0xcb, 0x3c, 0x9f, 0xf0, 0x05, 0x66, 0x90,
-	0x72, 0x06, 0x2b, 0xe9, 0xa6, 0x6b, 0xb6, 0x28, 0x55, 0xf3, 0x45, 0xe9, 0x75, 0x58, 0xb6, 0x82,
-	0xe1, 0xc4, 0x78, 0x8a, 0x7b, 0xab, 0x74, 0x74, 0xc9, 0x0a, 0x1e, 0x18, 0x4f, 0xb1, 0xf6, 0x2f,
-	0x55, 0xe8, 0xc6, 0x55, 0x4c, 0x69, 0x37, 0x52, 0xe6, 0x7a, 0xfe, 0x08, 0xd4, 0x38, 0x50, 0x53,
-	0x0e, 0x9f, 0x5b, 0x88, 0x65, 0x6f, 0x32, 0x56, 0x66, 0x19, 0x7b, 0x4d, 0xf5, 0x8a, 0x6b, 0x2f,
-	0xd4, 0x2b, 0xbe, 0xe4, 0x35, 0xe1, 0x1d, 0xb8, 0x16, 0x05, 0xe0, 0xd4, 0xb1, 0x59, 0x96, 0x7f,
-	0x55, 0x0c, 0x1e, 0x27, 0x8f, 0x5f, 0xe0, 0x02, 0x96, 0x8b, 0x5c, 0x40, 0x56, 0x05, 0x1a, 0x39,
-	0x15, 0xc8, 0xdf, 0x56, 0x36, 0x25, 0xb7, 0x95, 0xda, 0x63, 0x58, 0xa3, 0x6d, 0xc0, 0x60, 0xec,
-	0x5b, 0x23, 0x1c, 0xe5, 0xac, 0x65, 0xc4, 0xda, 0x87, 0x46, 0x26, 0xed, 0x8d, 0xbe, 0xb5, 0x9f,
-	0x29, 0xb0, 0x9e, 0xdf, 0x97, 0x6a, 0x4c, 0xec, 0x48, 0x94, 0x94, 0x23, 0xf9, 0x4d, 0x58, 0x8b,
-	0xb7, 0x4f, 0x27, 0xd4, 0x05, 0x29, 0xa3, 0x84, 0x70, 0x1d, 0xc5, 0x7b, 0x08, 0x98, 0xf6, 0x73,
-	0x25, 0xea, 0xa6, 0x12, 0xd8, 0x94, 0xf6, 0x98, 0x49, 0x70, 0xf3, 0x5c, 0xdb, 0x72, 0xa3, 0xaa,
-	0x9b, 0x9f, 0x91, 0x01, 0x79, 0xd5, 0xfd, 0x1e, 0xac, 0xf0, 0x49, 0x51, 0x8c, 0x2a, 0x99, 0x95,
-	0x75, 0xd9, 0xba, 0x28, 0x3a, 0xdd, 0x86, 0x2e, 0x6f, 0xfe, 0x0a, 0x7c, 0x55, 0x59, 0x4b, 0xf8,
-	0xd7, 0x40, 0x15, 0xd3, 0x5e, 0x34, 0x2a, 0xae, 0xf0, 0x85, 0x51, 0x76, 0xf7, 0x53, 0x05, 0x7a,
-	0xe9, 0x18, 0x99, 0x38, 0xfe, 0x8b, 0xe7, 0x78, 0xdf, 0x4d, 0x5f, 0x9b, 0xdd, 0x3e, 0x87, 0x9e,
-	0x18, 0x8f, 0xb8, 0x3c, 0x3b, 0xa2, 0x57, 0xa0, 0xa4, 0x34, 0xd9, 0xb7, 0x82, 0xd0, 0xb7, 0x46,
-	0xf3, 0x4b, 0xbd, 0xdf, 0xd0, 0xfe, 0xb6, 0x02, 0x5f, 0x97, 0x6e, 0x78, 0x99, 0x0b, 0xb2, 0xa2,
-	0x4e, 0xc0, 0x7d, 0x68, 0x64, 0x4a, 0x98, 0x37, 0xce, 0x39, 0x3c, 0x6f, 0x6a, 0xb1, 0xe6, 0x8a,
-	0x58, 0x47, 0xf6, 0x88, 0x74, 0xba, 0x56, 0xbc, 0x07, 0x57, 0xda, 0xd4, 0x1e, 0x62, 0x1d, 0xba,
-	0x07, 0x6d, 0x56, 0x1e, 0x0e, 0x4f, 0x2d, 0xfc, 0x5c, 0xdc, 0xeb, 0xdc, 0x94, 0xfa, 0x35, 0x3a,
-	0xef, 0x89, 0x85, 0x9f, 0xeb, 0x2d, 0x3b, 0xfa, 0x1d, 0x68, 0xff, 0x5d, 0x05, 0x88, 0xc7, 0x48,
-	0x6d, 0x1a, 0x1b, 0x0c, 0xb7, 0x80, 0x04, 0x84, 0x04, 0xe2, 0x74, 0xee, 0x27, 0x3e, 0x91, 0x1e,
-	0xb7, 0x67, 0x4d, 0x2b, 0x08, 0x39, 0x5f, 0x76, 0xce, 0xa7, 0x45, 0xb0, 0x88, 0x88, 0x8c, 0x5d,
-	0x9b, 0x88, 0xda, 0x8b, 0x40, 0xd0, 0xdb, 0x80, 0xa6, 0xbe, 0xf7, 0xdc, 0x72, 0xa7, 0xc9, 0x8c,
-	0x9d, 0x25, 0xf6, 0xab, 0x7c, 0x24, 0x91, 0xb2, 0xff, 0x18, 0xd4, 0xcc, 0x74, 0xc1, 0x92, 0x3b,
-	0x0b, 0xc8, 0x38, 0x48, 0xed, 0xc5, 0x6f, 0x70, 0x56, 0xd2, 0x18, 0x82, 0xfe, 0x10, 0xd4, 0x2c,
-	0xbd, 0x92, 0x3b, 0x98, 0x6f, 0xa7, 0xef, 0x60, 0xce, 0x33, 0x53, 0xb2, 0x4d, 0xe2, 0x12, 0xa6,
-	0x3f, 0x81, 0xab, 0x32, 0x4a, 0x24, 0x48, 0xee, 0xa6, 0x91, 0x94, 0xc9, 0x69, 0x13, 0x97, 0x3d,
-	0x3f, 0x88, 0xd2, 0x45, 0xca, 0xe6, 0x22, 0x0f, 0x9c, 0x68, 0xca, 0x55, 0x52, 0x4d, 0x39, 0xed,
-	0x8f, 0x14, 0x40, 0x79, 0xed, 0x46, 0x5d, 0xa8, 0x44, 0x9b, 0x54, 0x0e, 0xf7, 0x33, 0xda, 0x54,
-	0xc9, 0x69, 0xd3, 0x0d, 0x68, 0x46, 0x11, 0x91, 0xbb, 0xbf, 0x18, 0x90, 0xd4, 0xb5, 0x5a, 0x5a,
-	0xd7, 0x12, 0x84, 0xd5, 0xd3, 0x84, 0x9d, 0x00, 0xca, 0x5b, 0x4c, 0x72, 0x27, 0x25, 0xbd, 0xd3,
-	0x22, 0x0a, 0x13, 0x98, 0xaa, 0x69, 0x4c, 0xff, 0x5e, 0x01, 0x14, 0xc7, 0xfc, 0xe8, 0x22, 0xaa,
-	0x4c, 0xa0, 0xdc, 0x81, 0xb5, 0x7c, 0x46, 0x20, 0xd2, 0x20, 0x94, 0xcb, 0x07, 0x64, 0xb1, 0xbb,
-	0x2a, 0x7b, 0x69, 0xf4, 0x6e, 0xe4, 0xe3, 0x58, 0x82, 0x73, 0xb3, 0x28, 0xc1, 0xc9, 0xb8, 0xb9,
-	0xdf, 0xca, 0xbe, 0x50, 0x62, 0x46, 0x73, 0x57, 0xea, 0x8f, 0x72, 0x47, 0x7e, 0xf9, 0xcf, 0x93,
-	0xfe, 0xb5, 0x02, 0xab, 0x11, 0x37, 0x5e, 0x88, 0xd3, 0x8b, 0x2f, 0xfe, 0x5e, 0x32, 0x6b, 0x3f,
-	0x92, 0xb3, 0xf6, 0x97, 0xcf, 0xcd, 0x61, 0x3f, 0x3f, 0xce, 0x0e, 0x60, 0x99, 0xb7, 0xcf, 0x72,
-	0xb6, 0x5b, 0xa6, 0x4a, 0xbc, 0x0a, 0x75, 0xe2, 0x2a, 0x44, 0x3f, 0x89, 0x7d, 0x68, 0x7f, 0xad,
-	0x00, 0x0c, 0xce, 0xdc, 0xf1, 0x3d, 0x66, 0x42, 0xef, 0x40, 0x6d, 0xd1, 0x03, 0x0d, 0x32, 0x9b,
-	0x26, 0xdd, 0x74, 0x66, 0x09, 0xa9, 0xa5, 0x0a, 0xdc, 0x6a, 0xb6, 0xc0, 0x2d, 0x2a, 0x4d, 0x8b,
-	0xdd, 0xc6, 0x3f, 0x28, 0x70, 0x9d, 0x10, 0xf1, 0x99, 0xe4, 0x22, 0xa5, 0x58, 0x97, 0x70, 0x49,
-	0xd5, 0xb4, 0x4b, 0xba, 0x0b, 0xcb, 0xac, 0xc6, 0x14, 0x79, 0xc1, 0xcd, 0x22, 0x96, 0x31, 0x06,
-	0xeb, 0x62, 0xfa, 0xd6, 0xaf, 0x42, 0x33, 0xea, 0xf5, 0xa2, 0x16, 0x2c, 0x3f, 0x76, 0xdf, 0x77,
-	0xbd, 0xe7, 0xae, 0x7a, 0x05, 0x2d, 0x43, 0xf5, 0x9e, 0x6d, 0xab, 0x0a, 0xea, 0x40, 0x73, 0x10,
-	0xfa, 0xd8, 0x70, 0x2c, 0x77, 0xaa, 0x56, 0x50, 0x17, 0xe0, 0x3d, 0x2b, 0x08, 0x3d, 0xdf, 0x1a,
-	0x1b, 0xb6, 0x5a, 0xdd, 0xfa, 0x04, 0xba, 0xe9, 0x4a, 0x0a, 0xb5, 0xa1, 0x71, 0xe4, 0x85, 0x3f,
-	0xfc, 0xd8, 0x0a, 0x42, 0xf5, 0x0a, 0x99, 0x7f, 0xe4, 0x85, 0xc7, 0x3e, 0x0e, 0xb0, 0x1b, 0xaa,
-	0x0a, 0x02, 0x58, 0xfa, 0x91, 0xbb, 0x6f, 0x05, 0x4f, 0xd5, 0x0a, 0x5a, 0xe3, 0x4d, 0x12, 0xc3,
-	0x3e, 0xe4, 0xe5, 0x89, 0x5a, 0x25, 0xcb, 0xa3, 0xaf, 0x1a, 0x52, 0xa1, 0x1d, 0x4d, 0x39, 0x38,
-	0x7e, 0xac, 0xd6, 0x51, 0x13, 0xea, 0xec, 0xe7, 0xd2, 0x96, 0x09, 0x6a, 0xb6, 0xc3, 0x47, 0xf6,
-	0x64, 0x87, 0x88, 0x40, 0xea, 0x15, 0x72, 0x32, 0xde, 0x62, 0x55, 0x15, 0xb4, 0x02, 0xad, 0x44,
-	0xc3, 0x52, 0xad, 0x10, 0xc0, 0x81, 0x3f, 0x1b, 0x73, 0xe9, 0x31, 0x12, 0x48, 0x2e, 0xbd, 0x4f,
-	0x38, 0x51, 0xdb, 0xba, 0x0f, 0x0d, 0x51, 0xe2, 0x91, 0xa9, 0x9c, 0x45, 0xe4, 0x53, 0xbd, 0x82,
-	0x56, 0xa1, 0x93, 0x7a, 0x81, 0xa9, 0x2a, 0x08, 0x41, 0x37, 0xfd, 0xc0, 0x59, 0xad, 0x6c, 0xed,
-	0x02, 0xc4, 0xa6, 0x4e, 0xc8, 0x39, 0x74, 0x4f, 0x0d, 0xdb, 0x32, 0x19, 0x6d, 0x64, 0x88, 0x70,
-	0x97, 0x72, 0x87, 0xb5, 0xea, 0xd4, 0xca, 0xd6, 0xab, 0xd0, 0x10, 0x5a, 0x4e, 0xe0, 0x3a, 0x76,
-	0xbc, 0x53, 0xcc, 0x24, 0x33, 0xc0, 0xa1, 0xaa, 0xec, 0xfe, 0x6f, 0x07, 0x80, 0x35, 0xe5, 0x3c,
-	0xcf, 0x37, 0x91, 0x0d, 0xe8, 0x00, 0x87, 0x7b, 0x9e, 0x33, 0xf3, 0x5c, 0xd1, 0x2c, 0x08, 0xd0,
-	0x76, 0x5a, 0x15, 0xf8, 0x47, 0x7e, 0x22, 0x3f, 0x7d, 0xff, 0x75, 0xe9, 0xfc, 0xcc, 0x64, 0xed,
-	0x0a, 0x72, 0x28, 0xb6, 0x47, 0x96, 0x83, 0x1f, 0x59, 0xe3, 0xa7, 0x51, 0x27, 0xaf, 0xf8, 0x75,
-	0x72, 0x66, 0xaa, 0xc0, 0x77, 0x4b, 0x8a, 0x6f, 0x10, 0xfa, 0x96, 0x3b, 0x15, 0xa9, 0xb8, 0x76,
-	0x05, 0x3d, 0xcb, 0xbc, 0x8d, 0x16, 0x08, 0x77, 0xcb, 0x3c, 0x87, 0xbe, 0x18, 0x4a, 0x1b, 0x56,
-	0x32, 0xff, 0xf5, 0x40, 0x5b, 0xf2, 0xe7, 0x6e, 0xb2, 0xff, 0xa5, 0xf4, 0xdf, 0x2a, 0x35, 0x37,
-	0xc2, 0x66, 0x41, 0x37, 0xfd, 0x7f, 0x06, 0xf4, 0x4b, 0x45, 0x1b, 0xe4, 0x1e, 0xdc, 0xf6, 0xb7,
-	0xca, 0x4c, 0x8d, 0x50, 0x7d, 0xc8, 0x14, 0x74, 0x11, 0x2a, 0xe9, 0xcb, 0xe2, 0xfe, 0x79, 0x55,
-	0x90, 0x76, 0x05, 0xfd, 0x04, 0x56, 0x73, 0xcf, 0x82, 0xd1, 0x37, 0xe4, 0xb7, 0x35, 0xf2, 0xd7,
-	0xc3, 0x8b, 0x30, 0x7c, 0x98, 0x35, 0xaf, 0x62, 0xea, 0x73, 0xaf, 0xfc, 0xcb, 0x53, 0x9f, 0xd8,
-	0xfe, 0x3c, 0xea, 0x5f, 0x18, 0xc3, 0x9c, 0x9a, 0x4d, 0xb6, 0x35, 0xfc, 0xb6, 0x0c, 0x45, 0xe1,
-	0xdb, 0xe4, 0xfe, 0x76, 0xd9, 0xe9, 0x49, 0xed, 0x4a, 0x3f, 0x7f, 0x95, 0x33, 0x4d, 0xfa, 0x64,
-	0x57, 0xae, 0x5d, 0xf2, 0xd7, 0xb4, 0xda, 0x15, 0xf4, 0x28, 0xe5, 0x5e, 0xd1, 0x1b, 0x45, 0xc2,
-	0x49, 0x5f, 0x18, 0x2d, 0xe2, 0xdb, 0x6f, 0x03, 0x62, 0xb6, 0xe3, 0x4e, 0xac, 0xe9, 0xdc, 0x37,
-	0x98, 0x62, 0x15, 0xb9, 0x9b, 0xfc, 0x54, 0x81, 0xe6, 0x9b, 0x2f, 0xb0, 0x22, 0x3a, 0xd2, 0x10,
-	0xe0, 0x00, 0x87, 0x0f, 0x71, 0xe8, 0x5b, 0xe3, 0x20, 0x7b, 0xa2, 0xd8, 0xa3, 0xf2, 0x09, 0x02,
-	0xd5, 0x9b, 0x0b, 0xe7, 0x45, 0x08, 0x46, 0xd0, 0x3a, 0xc0, 0x21, 0xcf, 0xab, 0x02, 0x54, 0xb8,
-	0x52, 0xcc, 0x10, 0x28, 0x36, 0x17, 0x4f, 0x4c, 0xba, 0xb3, 0xcc, 0x53, 0x60, 0x54, 0x28, 0xd8,
-	0xfc, 0x03, 0x65, 0xb9, 0x3b, 0x2b, 0x78, 0x5b, 0xcc, 0x4e, 0xb4, 0x77, 0x82, 0xc7, 0x4f, 0xdf,
-	0xc3, 0x86, 0x1d, 0x9e, 0x14, 0x9c, 0x28, 0x31, 0xe3, 0xfc, 0x13, 0xa5, 0x26, 0x0a, 0x1c, 0xbb,
-	0x9f, 0x76, 0xa1, 0x49, 0xe3, 0x1f, 0x09, 0xd6, 0xbf, 0x08, 0x7f, 0x9f, 0x71, 0xf8, 0xfb, 0x08,
-	0x56, 0x32, 0x2f, 0x57, 0xe5, 0xfa, 0x22, 0x7f, 0xde, 0x5a, 0xc2, 0x8b, 0xa7, 0xdf, 0x8e, 0xca,
-	0x1d, 0x92, 0xf4, 0x7d, 0xe9, 0xa2, 0xbd, 0x9f, 0xb0, 0x47, 0xdf, 0x51, 0xdf, 0xf4, 0xcd, 0xc2,
-	0xca, 0x2b, 0x7d, 0xdf, 0xfe, 0xc5, 0x47, 0x87, 0x97, 0x1f, 0x3d, 0x3f, 0x82, 0x95, 0xcc, 0xab,
-	0x27, 0xb9, 0x54, 0xe5, 0x4f, 0xa3, 0x16, 0xed, 0xfe, 0x39, 0x86, 0x19, 0x13, 0xd6, 0x24, 0x0f,
-	0x52, 0xd0, 0x76, 0x51, 0xe5, 0x23, 0x7f, 0xb9, 0xb2, 0xf8, 0x40, 0x9d, 0x94, 0x29, 0xa1, 0xcd,
-	0x22, 0x22, 0xb3, 0xff, 0xbd, 0xeb, 0x7f, 0xa3, 0xdc, 0x1f, 0xf5, 0xa2, 0x03, 0x0d, 0x60, 0x89,
-	0xbd, 0x85, 0x42, 0xaf, 0xc9, 0xfb, 0x7f, 0x89, 0x77, 0x52, 0xfd, 0x45, 0xaf, 0xa9, 0x82, 0xb9,
-	0x1d, 0x06, 0x74, 0xd3, 0x3a, 0xf5, 0x90, 0x48, 0xfa, 0x88, 0x2f, 0xf9, 0x80, 0xa9, 0xbf, 0xf8,
-	0xcd, 0x92, 0xd8, 0xf4, 0xff, 0x77, 0x2c, 0xfe, 0x18, 0xd6, 0x24, 0xb7, 0x02, 0xa8, 0x28, 0xe7,
-	0x2a, 0xb8, 0x8f, 0xe8, 0xef, 0x94, 0x9e, 0x1f, 0x61, 0xfe, 0x31, 0xa8, 0xd9, 0x8e, 0x02, 0x7a,
-	0xab, 0x48, 0x9f, 0x65, 0x38, 0xcf, 0x57, 0xe6, 0xfb, 0xdf, 0xfa, 0x70, 0x77, 0x6a, 0x85, 0x27,
-	0xf3, 0x11, 0x19, 0xd9, 0x61, 0x53, 0xdf, 0xb6, 0x3c, 0xfe, 0x6b, 0x47, 0xf0, 0x7f, 0x87, 0xae,
-	0xde, 0xa1, 0xa8, 0x66, 0xa3, 0xd1, 0x12, 0xfd, 0xbc, 0xf3, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x37, 0x34, 0x97, 0xee, 0xf6, 0x3f, 0x00, 0x00,
+	// 3780 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4d, 0x6c, 0x1c, 0x59,
+	0x5a, 0xa9, 0xfe, 0xb1, 0xbb, 0xbf, 0xfe, 0x71, 0xf9, 0x39, 0x71, 0x7a, 0x7b, 0x93, 0x4c, 0xa6,
+	0x32, 0x99, 0x31, 0x9e, 0x1d, 0x67, 0xc6, 0xd9, 0x1d, 0xb2, 0xec, 0xae, 0x96, 0xc4, 0xde, 0x78,
+	0xcc, 0x4c, 0xb2, 0xa6, 0x3a, 0x09, 0x68, 0x34, 0x6c, 0x6f, 0x75, 0xd7, 0x6b, 0xbb, 0x48, 0x75,
+	0x55, 0xa7, 0x5e, 0xb5, 0x33, 0x1e, 0xae, 0x5c, 0x76, 0x05, 0x48, 0x70, 0x40, 0x42, 0x42, 0x9c,
+	0x40, 0x02, 0x89, 0x45, 0x1c, 0x96, 0x1b, 0x07, 0x24, 0x24, 0xb8, 0x21, 0x6e, 0x1c, 0xb9, 0x82,
+	0x40, 0x42, 0x42, 0xda, 0x03, 0x37, 0xf4, 0xfe, 0xea, 0xf7, 0x95, 0xbb, 0x62, 0x4f, 0x32, 0xb3,
+	0x88, 0x5b, 0xd7, 0xf7, 0x7e, 0xbe, 0xef, 0x7d, 0xff, 0xdf, 0xf7, 0x5e, 0xc3, 0xea, 0xb3, 0x39,
+	0x0e, 0x4e, 0x86, 0x63, 0xdf, 0x0f, 0xec, 0xad, 0x59, 0xe0, 0x87, 0x3e, 0x42, 0x53, 0xc7, 0x3d,
+	0x9e, 0x13, 0xfe, 0xb5, 0xc5, 0xc6, 0xfb, 0xed, 0xb1, 0x3f, 0x9d, 0xfa, 0x1e, 0x87, 0xf5, 0xdb,
+	0xc9, 0x19, 0xfd, 0xae, 0xe3, 0x85, 0x38, 0xf0, 0x2c, 0x57, 0x8e, 0x92, 0xf1, 0x11, 0x9e, 0x5a,
+	0xe2, 0x4b, 0xb7, 0xad, 0xd0, 0x4a, 0xee, 0x6f, 0xfc, 0xb6, 0x06, 0xeb, 0x83, 0x23, 0xff, 0xf9,
+	0x8e, 0xef, 0xba, 0x78, 0x1c, 0x3a, 0xbe, 0x47, 0x4c, 0xfc, 0x6c, 0x8e, 0x49, 0x88, 0xde, 0x85,
+	0xda, 0xc8, 0x22, 0xb8, 0xa7, 0x5d, 0xd7, 0x36, 0x5a, 0xdb, 0x57, 0xb6, 0x52, 0x94, 0x08, 0x12,
+	0x1e, 0x90, 0xc3, 0x7b, 0x16, 0xc1, 0x26, 0x9b, 0x89, 0x10, 0xd4, 0xec, 0xd1, 0xfe, 0x6e, 0xaf,
+	0x72, 0x5d, 0xdb, 0xa8, 0x9a, 0xec, 0x37, 0x7a, 0x03, 0x3a, 0xe3, 0x68, 0xef, 0xfd, 0x5d, 0xd2,
+	0xab, 0x5e, 0xaf, 0x6e, 0x54, 0xcd, 0x34, 0xd0, 0xf8, 0x57, 0x0d, 0x2e, 0xe7, 0xc8, 0x20, 0x33,
+	0xdf, 0x23, 0x18, 0xdd, 0x86, 0x25, 0x12, 0x5a, 0xe1, 0x9c, 0x08, 0x4a, 0xbe, 0xaa, 0xa4, 0x64,
+	0xc0, 0xa6, 0x98, 0x62, 0x6a, 0x1e, 0x6d, 0x45, 0x81, 0x16, 0xbd, 0x07, 0x17, 0x1d, 0xef, 0x01,
+	0x9e, 0xfa, 0xc1, 0xc9, 0x70, 0x86, 0x83, 0x31, 0xf6, 0x42, 0xeb, 0x10, 0x4b, 0x1a, 0xd7, 0xe4,
+	0xd8, 0x41, 0x3c, 0x84, 0xde, 0x87, 0xcb, 0x5c, 0x4a, 0x04, 0x07, 0xc7, 0xce, 0x18, 0x0f, 0xad,
+	0x63, 0xcb, 0x71, 0xad, 0x91, 0x8b, 0x7b, 0xb5, 0xeb, 0xd5, 0x8d, 0x86, 0x79, 0x89, 0x0d, 0x0f,
+	0xf8, 0xe8, 0x5d, 0x39, 0x68, 0xfc, 0x99, 0x06, 0x97, 0xe8, 0x09, 0x0f, 0xac, 0x20, 0x74, 0x5e,
+	0x02, 0x9f, 0x0d, 0x68, 0x27, 0xcf, 0xd6, 0xab, 0xb2, 0xb1, 0x14, 0x8c, 0xce, 0x99, 0x49, 0xf4,
+	0x94, 0x27, 0x35, 0x76, 0xcc, 0x14, 0xcc, 0xf8, 0x53, 0xa1, 0x10, 0x49, 0x3a, 0xcf, 0x23, 0x88,
+	0x2c, 0xce, 0x4a, 0x1e, 0xe7, 0x19, 0xc4, 0x60, 0xfc, 0xb8, 0x0a, 0x97, 0x3e, 0xf2, 0x2d, 0x3b,
+	0x56, 0x98, 0x57, 0xcf, 0xce, 0xef, 0xc0, 0x12, 0xb7, 0xae, 0x5e, 0x8d, 0xe1, 0xba, 0x99, 0xc6,
+	0x25, 0x2c, 0x2f, 0xa6, 0x70, 0xc0, 0x00, 0xa6, 0x58, 0x84, 0x6e, 0x42, 0x37, 0xc0, 0x33, 0xd7,
+	0x19, 0x5b, 0x43, 0x6f, 0x3e, 0x1d, 0xe1, 0xa0, 0x57, 0xbf, 0xae, 0x6d, 0xd4, 0xcd, 0x8e, 0x80,
+	0x3e, 0x64, 0x40, 0xf4, 0x43, 0xe8, 0x4c, 0x1c, 0xec, 0xda, 0x43, 0xc7, 0xb3, 0xf1, 0xa7, 0xfb,
+	0xbb, 0xbd, 0xa5, 0xeb, 0xd5, 0x8d, 0xd6, 0xf6, 0xb7, 0xb6, 0xf2, 0x9e, 0x61, 0x4b, 0xc9, 0x91,
+	0xad, 0xfb, 0x74, 0xf9, 0x3e, 0x5f, 0xfd, 0x3d, 0x2f, 0x0c, 0x4e, 0xcc, 0xf6, 0x24, 0x01, 0xea,
+	0x7f, 0x17, 0x56, 0x73, 0x53, 0x90, 0x0e, 0xd5, 0xa7, 0xf8, 0x84, 0x71, 0xb1, 0x6a, 0xd2, 0x9f,
+	0xe8, 0x22, 0xd4, 0x8f, 0x2d, 0x77, 0x8e, 0x05, 0x9f, 0xf8, 0xc7, 0x2f, 0x55, 0xee, 0x68, 0xc6,
+	0x1f, 0x6b, 0xd0, 0x33, 0xb1, 0x8b, 0x2d, 0x82, 0xbf, 0x48, 0x79, 0xac, 0xc3, 0x92, 0xe7, 0xdb,
+	0x78, 0x7f, 0x97, 0xc9, 0xa3, 0x6a, 0x8a, 0x2f, 0xe3, 0xa7, 0x1a, 0x5c, 0x7d, 0x3c, 0xb3, 0xad,
+	0x10, 0xe7, 0x64, 0x71, 0x66, 0x1a, 0xb3, 0xf4, 0x54, 0x4e, 0xd5, 0x8f, 0xea, 0x19, 0xf4, 0xc3,
+	0xf8, 0x1f, 0x0d, 0x2e, 0xee, 0xe1, 0x90, 0xda, 0x93, 0x43, 0x42, 0x67, 0x1c, 0x39, 0x8c, 0xef,
+	0x40, 0x35, 0xc0, 0xcf, 0x04, 0xb1, 0x6f, 0xa7, 0x37, 0x8d, 0xdc, 0xbf, 0x6a, 0xa5, 0x49, 0xd7,
+	0xa1, 0xd7, 0xa1, 0x6d, 0x4f, 0xdd, 0xe1, 0xf8, 0xc8, 0xf2, 0x3c, 0xec, 0x72, 0x8b, 0x6c, 0x9a,
+	0x2d, 0x7b, 0xea, 0xee, 0x08, 0x10, 0xba, 0x06, 0x40, 0xf0, 0xe1, 0x14, 0x7b, 0x61, 0xec, 0xb1,
+	0x13, 0x10, 0xb4, 0x09, 0xab, 0x93, 0xc0, 0x9f, 0x0e, 0xc9, 0x91, 0x15, 0xd8, 0x43, 0x17, 0x5b,
+	0x36, 0x0e, 0x18, 0xd3, 0x1b, 0xe6, 0x0a, 0x1d, 0x18, 0x50, 0xf8, 0x47, 0x0c, 0x8c, 0x6e, 0x43,
+	0x9d, 0x8c, 0xfd, 0x19, 0x66, 0xda, 0xdd, 0xdd, 0xbe, 0xaa, 0xd2, 0xdb, 0x5d, 0x2b, 0xb4, 0x06,
+	0x74, 0x92, 0xc9, 0xe7, 0x1a, 0x7f, 0x25, 0xcc, 0xfb, 0x4b, 0xee, 0x2d, 0x13, 0x22, 0xae, 0x7f,
+	0x3e, 0x2e, 0x60, 0xa9, 0x94, 0x0b, 0x58, 0x3e, 0xdd, 0x05, 0xe4, 0xb8, 0xf6, 0xf2, 0x5d, 0xc0,
+	0xdf, 0xc5, 0x2e, 0xe0, 0xcb, 0x2e, 0xb3, 0xd8, 0x4d, 0xd4, 0x53, 0x6e, 0xe2, 0x2f, 0x34, 0xf8,
+	0xca, 0x1e, 0x0e, 0x23, 0xf2, 0xa9, 0xf9, 0xe0, 0x2f, 0x69, 0x94, 0xfe, 0x89, 0x06, 0x7d, 0x15,
+	0xad, 0xe7, 0x89, 0xd4, 0x1f, 0xc3, 0x7a, 0x84, 0x63, 0x68, 0x63, 0x32, 0x0e, 0x9c, 0x19, 0x13,
+	0x23, 0xf3, 0x10, 0xad, 0xed, 0x1b, 0x2a, 0x75, 0xcb, 0x52, 0x70, 0x29, 0xda, 0x62, 0x37, 0xb1,
+	0x83, 0xf1, 0xbb, 0x1a, 0x5c, 0xa2, 0x1e, 0x49, 0xb8, 0x10, 0x6f, 0xe2, 0x9f, 0x9d, 0xaf, 0x69,
+	0xe7, 0x54, 0xc9, 0x39, 0xa7, 0x12, 0x3c, 0x66, 0x69, 0x6f, 0x96, 0x9e, 0xf3, 0xf0, 0xee, 0x1b,
+	0x50, 0x77, 0xbc, 0x89, 0x2f, 0x59, 0xf5, 0x9a, 0x8a, 0x55, 0x49, 0x64, 0x7c, 0xb6, 0xe1, 0x71,
+	0x2a, 0x62, 0x6f, 0x49, 0x5e, 0x6a, 0x44, 0x32, 0x7e, 0x47, 0x83, 0xcb, 0x39, 0x84, 0xe7, 0x39,
+	0xf7, 0xb7, 0x61, 0x89, 0xc5, 0x00, 0x79, 0xf0, 0x37, 0x94, 0x07, 0x4f, 0xa0, 0xfb, 0xc8, 0x21,
+	0xa1, 0x29, 0xd6, 0x18, 0x3e, 0xe8, 0xd9, 0x31, 0x1a, 0x9d, 0x44, 0x64, 0x1a, 0x7a, 0xd6, 0x94,
+	0x33, 0xa0, 0x69, 0xb6, 0x04, 0xec, 0xa1, 0x35, 0xc5, 0xe8, 0x2b, 0xd0, 0xa0, 0x26, 0x3b, 0x74,
+	0x6c, 0x29, 0xfe, 0x65, 0x66, 0xc2, 0x36, 0x41, 0x57, 0x01, 0xd8, 0x90, 0x65, 0xdb, 0x01, 0x0f,
+	0x5c, 0x4d, 0xb3, 0x49, 0x21, 0x77, 0x29, 0xc0, 0xf8, 0x7d, 0x0d, 0xda, 0xd4, 0x41, 0x3e, 0xc0,
+	0xa1, 0x45, 0xe5, 0x80, 0xbe, 0x09, 0x4d, 0xd7, 0xb7, 0xec, 0x61, 0x78, 0x32, 0xe3, 0xa8, 0xba,
+	0x59, 0x5e, 0xc7, 0x5e, 0xf5, 0xd1, 0xc9, 0x0c, 0x9b, 0x0d, 0x57, 0xfc, 0x2a, 0x95, 0x01, 0x64,
+	0x4d, 0xb9, 0xaa, 0x30, 0xe5, 0x7f, 0xa8, 0xc3, 0xfa, 0xaf, 0x59, 0xe1, 0xf8, 0x68, 0x77, 0x2a,
+	0xe3, 0xef, 0xd9, 0x95, 0x20, 0xf6, 0x6d, 0x95, 0xa4, 0x6f, 0xfb, 0xdc, 0x7c, 0x67, 0xa4, 0xe7,
+	0x75, 0x95, 0x9e, 0xd3, 0xea, 0x72, 0xeb, 0x89, 0x10, 0x55, 0x42, 0xcf, 0x13, 0x61, 0x72, 0xe9,
+	0x2c, 0x61, 0x72, 0x07, 0x3a, 0xf8, 0xd3, 0xb1, 0x3b, 0xa7, 0x32, 0x67, 0xd8, 0x79, 0xfc, 0xbb,
+	0xa6, 0xc0, 0x9e, 0x34, 0xb2, 0xb6, 0x58, 0xb4, 0x2f, 0x68, 0xe0, 0xa2, 0x9e, 0xe2, 0xd0, 0xea,
+	0x35, 0x18, 0x19, 0xd7, 0x8b, 0x44, 0x2d, 0xf5, 0x83, 0x8b, 0x9b, 0x7e, 0xa1, 0x2b, 0xd0, 0x14,
+	0x41, 0x79, 0x7f, 0xb7, 0xd7, 0x64, 0xec, 0x8b, 0x01, 0xc8, 0x82, 0x8e, 0xf0, 0x40, 0x82, 0x42,
+	0x60, 0x14, 0x7e, 0x5b, 0x85, 0x40, 0x2d, 0xec, 0x24, 0xe5, 0x44, 0x84, 0x68, 0x92, 0x00, 0xd1,
+	0x8a, 0xd6, 0x9f, 0x4c, 0x5c, 0xc7, 0xc3, 0x0f, 0xb9, 0x84, 0x5b, 0x8c, 0x88, 0x34, 0x10, 0xf5,
+	0x60, 0xf9, 0x18, 0x07, 0xc4, 0xf1, 0xbd, 0x5e, 0x9b, 0x8d, 0xcb, 0xcf, 0xfe, 0x10, 0x56, 0x73,
+	0x28, 0x14, 0x21, 0xfe, 0xeb, 0xc9, 0x10, 0xbf, 0x98, 0xc7, 0x89, 0x14, 0xe0, 0xcf, 0x35, 0xb8,
+	0xf4, 0xd8, 0x23, 0xf3, 0x51, 0x74, 0xb6, 0x2f, 0x46, 0x8f, 0xb3, 0x1e, 0xa4, 0x96, 0xf3, 0x20,
+	0xc6, 0x8f, 0xea, 0xb0, 0x22, 0x4e, 0x41, 0xc5, 0xcd, 0x5c, 0xc1, 0x15, 0x68, 0x46, 0x41, 0x44,
+	0x30, 0x24, 0x06, 0xa0, 0xeb, 0xd0, 0x4a, 0x18, 0x82, 0xa0, 0x2a, 0x09, 0x2a, 0x45, 0x9a, 0x4c,
+	0x09, 0x6a, 0x89, 0x94, 0xe0, 0x2a, 0xc0, 0xc4, 0x9d, 0x93, 0xa3, 0x61, 0xe8, 0x4c, 0xb1, 0x48,
+	0x49, 0x9a, 0x0c, 0xf2, 0xc8, 0x99, 0x62, 0x74, 0x17, 0xda, 0x23, 0xc7, 0x73, 0xfd, 0xc3, 0xe1,
+	0xcc, 0x0a, 0x8f, 0x88, 0xa8, 0xfe, 0x54, 0x62, 0x61, 0x09, 0xdc, 0x3d, 0x36, 0xd7, 0x6c, 0xf1,
+	0x35, 0x07, 0x74, 0x09, 0xba, 0x06, 0x2d, 0x6f, 0x3e, 0x1d, 0xfa, 0x93, 0x61, 0xe0, 0x3f, 0xa7,
+	0xc6, 0xc3, 0x50, 0x78, 0xf3, 0xe9, 0xf7, 0x27, 0xa6, 0xff, 0x9c, 0x3a, 0xf1, 0x26, 0x75, 0xe7,
+	0xc4, 0xf5, 0x0f, 0x49, 0xaf, 0x51, 0x6a, 0xff, 0x78, 0x01, 0x5d, 0x6d, 0x63, 0x37, 0xb4, 0xd8,
+	0xea, 0x66, 0xb9, 0xd5, 0xd1, 0x02, 0xf4, 0x26, 0x74, 0xc7, 0xfe, 0x74, 0x66, 0x31, 0x0e, 0xdd,
+	0x0f, 0xfc, 0x29, 0xb3, 0x9c, 0xaa, 0x99, 0x81, 0xa2, 0x1d, 0x68, 0xb1, 0xe4, 0x57, 0x98, 0x57,
+	0x8b, 0xe1, 0x31, 0x54, 0xe6, 0x95, 0xc8, 0x63, 0xa9, 0x82, 0x82, 0x23, 0x7f, 0x12, 0xaa, 0x19,
+	0xd2, 0x4a, 0x89, 0xf3, 0x19, 0x16, 0x16, 0xd2, 0x12, 0xb0, 0x81, 0xf3, 0x19, 0xa6, 0x19, 0xb9,
+	0xe3, 0x11, 0x1c, 0x84, 0xb2, 0x3e, 0xea, 0x75, 0x98, 0xfa, 0x74, 0x38, 0x54, 0x28, 0x36, 0xda,
+	0x87, 0x2e, 0x09, 0xad, 0x20, 0x1c, 0xce, 0x7c, 0xc2, 0x14, 0xa0, 0xd7, 0x65, 0xba, 0x6d, 0x14,
+	0x54, 0x63, 0x0f, 0xc8, 0xe1, 0x81, 0x98, 0x69, 0x76, 0xd8, 0x4a, 0xf9, 0x69, 0xfc, 0x57, 0x05,
+	0xba, 0x69, 0x9a, 0xa9, 0x11, 0xf3, 0xec, 0x5c, 0x2a, 0xa2, 0xfc, 0xa4, 0x27, 0xc0, 0x9e, 0x35,
+	0x72, 0x31, 0x2f, 0x05, 0x98, 0x1e, 0x36, 0xcc, 0x16, 0x87, 0xb1, 0x0d, 0xa8, 0x3e, 0x71, 0x4e,
+	0x31, 0xe5, 0xaf, 0x32, 0xea, 0x9b, 0x0c, 0xc2, 0x82, 0x67, 0x0f, 0x96, 0x65, 0x15, 0xc1, 0xb5,
+	0x50, 0x7e, 0xd2, 0x91, 0xd1, 0xdc, 0x61, 0x58, 0xb9, 0x16, 0xca, 0x4f, 0xb4, 0x0b, 0x6d, 0xbe,
+	0xe5, 0xcc, 0x0a, 0xac, 0xa9, 0xd4, 0xc1, 0xd7, 0x95, 0x76, 0xfc, 0x21, 0x3e, 0x79, 0x42, 0x5d,
+	0xc2, 0x81, 0xe5, 0x04, 0x26, 0x97, 0xd9, 0x01, 0x5b, 0x85, 0x36, 0x40, 0xe7, 0xbb, 0x4c, 0x1c,
+	0x17, 0x0b, 0x6d, 0x5e, 0x66, 0x11, 0xba, 0xcb, 0xe0, 0xf7, 0x1d, 0x17, 0x73, 0x85, 0x8d, 0x8e,
+	0xc0, 0xa4, 0xd4, 0xe0, 0xfa, 0xca, 0x20, 0x4c, 0x46, 0x37, 0xa0, 0xc3, 0x87, 0xa5, 0xa7, 0xe3,
+	0xee, 0x98, 0xd3, 0xf8, 0x84, 0xc3, 0x58, 0x92, 0x30, 0x9f, 0x72, 0x8d, 0x07, 0x7e, 0x1c, 0x6f,
+	0x3e, 0xa5, 0xfa, 0x6e, 0xfc, 0x41, 0x0d, 0xd6, 0xa8, 0xd9, 0x0b, 0x0f, 0x70, 0x8e, 0x70, 0x7b,
+	0x15, 0xc0, 0x26, 0xe1, 0x30, 0xe5, 0xaa, 0x9a, 0x36, 0x09, 0x85, 0x33, 0xfe, 0xa6, 0x8c, 0x96,
+	0xd5, 0xe2, 0x04, 0x3a, 0xe3, 0x86, 0xf2, 0x11, 0xf3, 0x4c, 0xbd, 0xa5, 0x1b, 0xd0, 0x21, 0xfe,
+	0x3c, 0x18, 0xe3, 0x61, 0xaa, 0xd4, 0x69, 0x73, 0xe0, 0x43, 0xb5, 0x33, 0x5d, 0x52, 0xf6, 0x30,
+	0x12, 0x51, 0x73, 0xf9, 0x7c, 0x51, 0xb3, 0x91, 0x8d, 0x9a, 0x1f, 0xc2, 0x0a, 0xf3, 0x04, 0x91,
+	0x15, 0x49, 0x07, 0x52, 0xc6, 0x8c, 0xba, 0x6c, 0xa9, 0xfc, 0x24, 0xc9, 0xc8, 0x07, 0xa9, 0xc8,
+	0x47, 0x99, 0xe1, 0x61, 0x6c, 0x0f, 0xc3, 0xc0, 0xf2, 0xc8, 0x04, 0x07, 0x2c, 0x72, 0x36, 0xcc,
+	0x36, 0x05, 0x3e, 0x12, 0x30, 0xe3, 0x9f, 0x2a, 0xb0, 0x2e, 0x0a, 0xd8, 0xf3, 0xeb, 0x45, 0x51,
+	0xf8, 0x92, 0xfe, 0xbf, 0x7a, 0x4a, 0x49, 0x58, 0x2b, 0x91, 0x9a, 0xd5, 0x15, 0xa9, 0x59, 0xba,
+	0x2c, 0x5a, 0xca, 0x95, 0x45, 0x51, 0x1f, 0x66, 0xb9, 0x7c, 0x1f, 0x86, 0x16, 0xfc, 0x2c, 0x57,
+	0x67, 0xb2, 0x6b, 0x9a, 0xfc, 0xa3, 0x1c, 0x43, 0xff, 0x43, 0x83, 0xce, 0x00, 0x5b, 0xc1, 0xf8,
+	0x48, 0xf2, 0xf1, 0xfd, 0x64, 0xdf, 0xea, 0x8d, 0x02, 0x11, 0xa7, 0x96, 0xfc, 0xfc, 0x34, 0xac,
+	0xfe, 0x53, 0x83, 0xf6, 0xaf, 0xd2, 0x21, 0x79, 0xd8, 0x3b, 0xc9, 0xc3, 0xbe, 0x59, 0x70, 0x58,
+	0x13, 0x87, 0x81, 0x83, 0x8f, 0xf1, 0xcf, 0xdd, 0x71, 0xff, 0x51, 0x83, 0xfe, 0xe0, 0xc4, 0x1b,
+	0x9b, 0xdc, 0x96, 0xcf, 0x6f, 0x31, 0x37, 0xa0, 0x73, 0x9c, 0xca, 0xda, 0x2a, 0x4c, 0xe1, 0xda,
+	0xc7, 0xc9, 0xc2, 0xcf, 0x04, 0x5d, 0xb6, 0xcb, 0xc4, 0x61, 0xa5, 0x6b, 0x7d, 0x4b, 0x45, 0x75,
+	0x86, 0x38, 0xe6, 0x9a, 0x56, 0x82, 0x34, 0xd0, 0xf8, 0x3d, 0x0d, 0xd6, 0x14, 0x13, 0xd1, 0x65,
+	0x58, 0x16, 0x45, 0xa6, 0x88, 0xc1, 0xdc, 0x86, 0x6d, 0x2a, 0x9e, 0xb8, 0x4d, 0xe2, 0xd8, 0xf9,
+	0x54, 0xd0, 0x46, 0xaf, 0x41, 0x2b, 0xaa, 0x06, 0xec, 0x9c, 0x7c, 0x6c, 0x82, 0xfa, 0xd0, 0x10,
+	0xce, 0x49, 0x96, 0x59, 0xd1, 0xb7, 0xf1, 0xb7, 0x1a, 0xac, 0x7f, 0x60, 0x79, 0xb6, 0x3f, 0x99,
+	0x9c, 0x9f, 0xad, 0x3b, 0x90, 0x2a, 0x22, 0xca, 0xb6, 0x27, 0xd2, 0x95, 0xc7, 0xdb, 0xb0, 0x1a,
+	0x70, 0xcf, 0x68, 0xa7, 0xf9, 0x5e, 0x35, 0x75, 0x39, 0x10, 0xf1, 0xf3, 0x2f, 0x2b, 0x80, 0x68,
+	0x30, 0xb8, 0x67, 0xb9, 0x96, 0x37, 0xc6, 0x67, 0x27, 0xfd, 0x26, 0x74, 0x53, 0x21, 0x2c, 0xba,
+	0xc2, 0x4b, 0xc6, 0x30, 0x82, 0x3e, 0x84, 0xee, 0x88, 0xa3, 0x1a, 0x06, 0xd8, 0x22, 0xbe, 0xc7,
+	0x9c, 0x6b, 0x57, 0xdd, 0x89, 0x78, 0x14, 0x38, 0x87, 0x87, 0x38, 0xd8, 0xf1, 0x3d, 0x5b, 0xe4,
+	0x62, 0x23, 0x49, 0x26, 0x5d, 0x4a, 0x05, 0x17, 0xc7, 0x73, 0x29, 0x1a, 0x88, 0x02, 0x3a, 0x63,
+	0x05, 0xc1, 0x96, 0x1b, 0x33, 0x22, 0xf6, 0xc6, 0x3a, 0x1f, 0x18, 0x14, 0x37, 0xa2, 0x14, 0xf1,
+	0xd5, 0xf8, 0xa9, 0x06, 0x28, 0xaa, 0x97, 0x58, 0x65, 0xc8, 0xb4, 0x2f, 0xbb, 0x54, 0x53, 0x04,
+	0x85, 0x2b, 0xd0, 0xb4, 0xe5, 0x4a, 0x61, 0x2e, 0x31, 0x80, 0xf9, 0x68, 0x46, 0xf4, 0x90, 0x06,
+	0x63, 0x6c, 0xcb, 0x7a, 0x84, 0x03, 0x3f, 0x62, 0xb0, 0x74, 0x78, 0xae, 0x65, 0xc3, 0x73, 0xb2,
+	0xcf, 0x52, 0x4f, 0xf5, 0x59, 0x8c, 0x9f, 0x54, 0x40, 0x67, 0xee, 0x6e, 0x27, 0x2e, 0xf6, 0x4b,
+	0x11, 0x7d, 0x03, 0x3a, 0xe2, 0x92, 0x3b, 0x45, 0x78, 0xfb, 0x59, 0x62, 0x33, 0xf4, 0x2e, 0x5c,
+	0xe4, 0x93, 0x02, 0x4c, 0xe6, 0x6e, 0x9c, 0x8a, 0xf3, 0x64, 0x16, 0x3d, 0xe3, 0x7e, 0x96, 0x0e,
+	0xc9, 0x15, 0x8f, 0x61, 0xfd, 0xd0, 0xf5, 0x47, 0x96, 0x3b, 0x4c, 0x8b, 0x87, 0xcb, 0xb0, 0x84,
+	0xc6, 0x5f, 0xe4, 0xcb, 0x07, 0x49, 0x19, 0x12, 0xb4, 0x47, 0xcb, 0x7a, 0xfc, 0x34, 0xce, 0xf2,
+	0xeb, 0xa5, 0xb3, 0xfc, 0x36, 0x5d, 0x18, 0x25, 0xf9, 0x7f, 0xa2, 0xc1, 0x4a, 0xa6, 0x55, 0x9a,
+	0x2d, 0x29, 0xb5, 0x7c, 0x49, 0x79, 0x07, 0xea, 0xb4, 0xce, 0xe2, 0xce, 0xb0, 0xab, 0x2e, 0x77,
+	0xd2, 0xbb, 0x9a, 0x7c, 0x01, 0xba, 0x05, 0x6b, 0x8a, 0x1b, 0x55, 0xa1, 0x03, 0x28, 0x7f, 0xa1,
+	0x6a, 0xfc, 0xac, 0x06, 0xad, 0x04, 0x3f, 0x16, 0x54, 0xc3, 0x65, 0x7a, 0x5f, 0x99, 0xe3, 0x55,
+	0xf3, 0xc7, 0x2b, 0xb8, 0xaf, 0xa3, 0x7a, 0x37, 0xc5, 0x53, 0x9e, 0xfc, 0x8b, 0x4a, 0x64, 0x8a,
+	0xa7, 0x2c, 0xf5, 0x4f, 0x66, 0xf5, 0x4b, 0xa9, 0xac, 0x3e, 0x53, 0xf7, 0x2c, 0x9f, 0x52, 0xf7,
+	0x34, 0xd2, 0x75, 0x4f, 0xca, 0x8e, 0x9a, 0x59, 0x3b, 0x2a, 0x5b, 0xa0, 0xbe, 0x0b, 0x6b, 0xe3,
+	0x00, 0x5b, 0x21, 0xb6, 0xef, 0x9d, 0xec, 0x44, 0x43, 0x22, 0x33, 0x52, 0x0d, 0xa1, 0xfb, 0x71,
+	0xcf, 0x88, 0x4b, 0xb9, 0xcd, 0xa4, 0xac, 0x2e, 0xab, 0x84, 0x6c, 0xb8, 0x90, 0xa5, 0x7b, 0x66,
+	0x5f, 0xd9, 0xd2, 0xb8, 0x73, 0xa6, 0xd2, 0xf8, 0x35, 0x68, 0xc9, 0xd0, 0x4a, 0xcd, 0xbd, 0xcb,
+	0x3d, 0x9f, 0xf4, 0x05, 0x36, 0x49, 0x39, 0x83, 0x95, 0x74, 0xd3, 0x35, 0x5b, 0x94, 0xea, 0xf9,
+	0xa2, 0xf4, 0x32, 0x2c, 0x3b, 0x64, 0x38, 0xb1, 0x9e, 0xe2, 0xde, 0x2a, 0x1b, 0x5d, 0x72, 0xc8,
+	0x7d, 0xeb, 0x29, 0x36, 0xfe, 0xb9, 0x0a, 0xdd, 0xb8, 0x8a, 0x29, 0xed, 0x46, 0xca, 0xbc, 0x2a,
+	0x78, 0x08, 0x7a, 0x1c, 0xa8, 0x19, 0x87, 0x4f, 0x2d, 0xc4, 0xb2, 0x37, 0x19, 0x2b, 0xb3, 0x8c,
+	0xbd, 0xa6, 0x7a, 0xc5, 0xb5, 0x17, 0xea, 0x15, 0x9f, 0xf3, 0x9a, 0xf0, 0x36, 0x5c, 0x8a, 0x02,
+	0x70, 0xea, 0xd8, 0x3c, 0xcb, 0xbf, 0x28, 0x07, 0x0f, 0x92, 0xc7, 0x2f, 0x70, 0x01, 0xcb, 0x45,
+	0x2e, 0x20, 0xab, 0x02, 0x8d, 0x9c, 0x0a, 0xe4, 0x6f, 0x2b, 0x9b, 0x8a, 0xdb, 0x4a, 0xe3, 0x31,
+	0xac, 0xb1, 0x36, 0x20, 0x19, 0x07, 0xce, 0x08, 0x47, 0x39, 0x6b, 0x19, 0xb1, 0xf6, 0xa1, 0x91,
+	0x49, 0x7b, 0xa3, 0x6f, 0xe3, 0xc7, 0x1a, 0xac, 0xe7, 0xf7, 0x65, 0x1a, 0x13, 0x3b, 0x12, 0x2d,
+	0xe5, 0x48, 0x7e, 0x1d, 0xd6, 0xe2, 0xed, 0xd3, 0x09, 0x75, 0x41, 0xca, 0xa8, 0x20, 0xdc, 0x44,
+	0xf1, 0x1e, 0x12, 0x66, 0xfc, 0x4c, 0x8b, 0xba, 0xa9, 0x14, 0x76, 0xc8, 0x7a, 0xcc, 0x34, 0xb8,
+	0xf9, 0x9e, 0xeb, 0x78, 0x51, 0xd5, 0x2d, 0xce, 0xc8, 0x81, 0xa2, 0xea, 0xfe, 0x00, 0x56, 0xc4,
+	0xa4, 0x28, 0x46, 0x95, 0xcc, 0xca, 0xba, 0x7c, 0x5d, 0x14, 0x9d, 0x6e, 0x42, 0x57, 0x34, 0x7f,
+	0x25, 0xbe, 0xaa, 0xaa, 0x25, 0xfc, 0x2b, 0xa0, 0xcb, 0x69, 0x2f, 0x1a, 0x15, 0x57, 0xc4, 0xc2,
+	0x28, 0xbb, 0xfb, 0x91, 0x06, 0xbd, 0x74, 0x8c, 0x4c, 0x1c, 0xff, 0xc5, 0x73, 0xbc, 0x6f, 0xa5,
+	0xaf, 0xcd, 0x6e, 0x9e, 0x42, 0x4f, 0x8c, 0x47, 0x5e, 0x9e, 0x3d, 0x64, 0x57, 0xa0, 0xb4, 0x34,
+	0xd9, 0x75, 0x48, 0x18, 0x38, 0xa3, 0xf9, 0xb9, 0x9e, 0x9d, 0x18, 0x7f, 0x53, 0x81, 0xaf, 0x2a,
+	0x37, 0x3c, 0xcf, 0x05, 0x59, 0x51, 0x27, 0xe0, 0x1e, 0x34, 0x32, 0x25, 0xcc, 0x9b, 0xa7, 0x1c,
+	0x5e, 0x34, 0xb5, 0x78, 0x73, 0x45, 0xae, 0xa3, 0x7b, 0x44, 0x3a, 0x5d, 0x2b, 0xde, 0x43, 0x28,
+	0x6d, 0x6a, 0x0f, 0xb9, 0x0e, 0xdd, 0x85, 0x36, 0x2f, 0x0f, 0x87, 0xc7, 0x0e, 0x7e, 0x2e, 0xef,
+	0x75, 0xae, 0x29, 0xfd, 0x1a, 0x9b, 0xf7, 0xc4, 0xc1, 0xcf, 0xcd, 0x96, 0x1b, 0xfd, 0x26, 0xc6,
+	0x7f, 0x57, 0x01, 0xe2, 0x31, 0x5a, 0x9b, 0xc6, 0x06, 0x23, 0x2c, 0x20, 0x01, 0xa1, 0x81, 0x38,
+	0x9d, 0xfb, 0xc9, 0x4f, 0x64, 0xc6, 0xed, 0x59, 0xdb, 0x21, 0xa1, 0xe0, 0xcb, 0xad, 0xd3, 0x69,
+	0x91, 0x2c, 0xa2, 0x22, 0xe3, 0xd7, 0x26, 0xb2, 0xf6, 0xa2, 0x10, 0xf4, 0x0e, 0xa0, 0xc3, 0xc0,
+	0x7f, 0xee, 0x78, 0x87, 0xc9, 0x8c, 0x9d, 0x27, 0xf6, 0xab, 0x62, 0x24, 0x91, 0xb2, 0xff, 0x00,
+	0xf4, 0xcc, 0x74, 0xc9, 0x92, 0xdb, 0x0b, 0xc8, 0xd8, 0x4b, 0xed, 0x25, 0x6e, 0x70, 0x56, 0xd2,
+	0x18, 0x48, 0x7f, 0x08, 0x7a, 0x96, 0x5e, 0xc5, 0x1d, 0xcc, 0x37, 0xd2, 0x77, 0x30, 0xa7, 0x99,
+	0x29, 0xdd, 0x26, 0x71, 0x09, 0xd3, 0x9f, 0xc0, 0x45, 0x15, 0x25, 0x0a, 0x24, 0x77, 0xd2, 0x48,
+	0xca, 0xe4, 0xb4, 0x89, 0xcb, 0x9e, 0xef, 0x46, 0xe9, 0x22, 0x63, 0x73, 0x91, 0x07, 0x4e, 0x34,
+	0xe5, 0x2a, 0xa9, 0xa6, 0x9c, 0xf1, 0x87, 0x1a, 0xa0, 0xbc, 0x76, 0xa3, 0x2e, 0x54, 0xa2, 0x4d,
+	0x2a, 0xfb, 0xbb, 0x19, 0x6d, 0xaa, 0xe4, 0xb4, 0xe9, 0x0a, 0x34, 0xa3, 0x88, 0x28, 0xdc, 0x5f,
+	0x0c, 0x48, 0xea, 0x5a, 0x2d, 0xad, 0x6b, 0x09, 0xc2, 0xea, 0x69, 0xc2, 0x8e, 0x00, 0xe5, 0x2d,
+	0x26, 0xb9, 0x93, 0x96, 0xde, 0x69, 0x11, 0x85, 0x09, 0x4c, 0xd5, 0x34, 0xa6, 0x7f, 0xab, 0x00,
+	0x8a, 0x63, 0x7e, 0x74, 0x11, 0x55, 0x26, 0x50, 0xde, 0x82, 0xb5, 0x7c, 0x46, 0x20, 0xd3, 0x20,
+	0x94, 0xcb, 0x07, 0x54, 0xb1, 0xbb, 0xaa, 0x7a, 0x69, 0xf4, 0x7e, 0xe4, 0xe3, 0x78, 0x82, 0x73,
+	0xad, 0x28, 0xc1, 0xc9, 0xb8, 0xb9, 0xdf, 0xc8, 0xbe, 0x50, 0xe2, 0x46, 0x73, 0x47, 0xe9, 0x8f,
+	0x72, 0x47, 0x7e, 0xf9, 0xcf, 0x93, 0xfe, 0xa5, 0x02, 0xab, 0x11, 0x37, 0x5e, 0x88, 0xd3, 0x8b,
+	0x2f, 0xfe, 0x5e, 0x32, 0x6b, 0x3f, 0x51, 0xb3, 0xf6, 0x17, 0x4f, 0xcd, 0x61, 0x5f, 0x1d, 0x67,
+	0x07, 0xb0, 0x2c, 0xda, 0x67, 0x39, 0xdb, 0x2d, 0x53, 0x25, 0x5e, 0x84, 0x3a, 0x75, 0x15, 0xb2,
+	0x9f, 0xc4, 0x3f, 0x8c, 0xbf, 0xd6, 0x00, 0x06, 0x27, 0xde, 0xf8, 0x2e, 0x37, 0xa1, 0x77, 0xa1,
+	0xb6, 0xe8, 0x81, 0x06, 0x9d, 0xcd, 0x92, 0x6e, 0x36, 0xb3, 0x84, 0xd4, 0x52, 0x05, 0x6e, 0x35,
+	0x5b, 0xe0, 0x16, 0x95, 0xa6, 0xc5, 0x6e, 0xe3, 0xef, 0x35, 0xb8, 0x4c, 0x89, 0xf8, 0x5c, 0x72,
+	0x91, 0x52, 0xac, 0x4b, 0xb8, 0xa4, 0x6a, 0xda, 0x25, 0xdd, 0x81, 0x65, 0x5e, 0x63, 0xca, 0xbc,
+	0xe0, 0x5a, 0x11, 0xcb, 0x38, 0x83, 0x4d, 0x39, 0x7d, 0xf3, 0x97, 0xa1, 0x19, 0xf5, 0x7a, 0x51,
+	0x0b, 0x96, 0x1f, 0x7b, 0x1f, 0x7a, 0xfe, 0x73, 0x4f, 0xbf, 0x80, 0x96, 0xa1, 0x7a, 0xd7, 0x75,
+	0x75, 0x0d, 0x75, 0xa0, 0x39, 0x08, 0x03, 0x6c, 0x4d, 0x1d, 0xef, 0x50, 0xaf, 0xa0, 0x2e, 0xc0,
+	0x07, 0x0e, 0x09, 0xfd, 0xc0, 0x19, 0x5b, 0xae, 0x5e, 0xdd, 0xfc, 0x0c, 0xba, 0xe9, 0x4a, 0x0a,
+	0xb5, 0xa1, 0xf1, 0xd0, 0x0f, 0xbf, 0xf7, 0xa9, 0x43, 0x42, 0xfd, 0x02, 0x9d, 0xff, 0xd0, 0x0f,
+	0x0f, 0x02, 0x4c, 0xb0, 0x17, 0xea, 0x1a, 0x02, 0x58, 0xfa, 0xbe, 0xb7, 0xeb, 0x90, 0xa7, 0x7a,
+	0x05, 0xad, 0x89, 0x26, 0x89, 0xe5, 0xee, 0x8b, 0xf2, 0x44, 0xaf, 0xd2, 0xe5, 0xd1, 0x57, 0x0d,
+	0xe9, 0xd0, 0x8e, 0xa6, 0xec, 0x1d, 0x3c, 0xd6, 0xeb, 0xa8, 0x09, 0x75, 0xfe, 0x73, 0x69, 0xd3,
+	0x06, 0x3d, 0xdb, 0xe1, 0xa3, 0x7b, 0xf2, 0x43, 0x44, 0x20, 0xfd, 0x02, 0x3d, 0x99, 0x68, 0xb1,
+	0xea, 0x1a, 0x5a, 0x81, 0x56, 0xa2, 0x61, 0xa9, 0x57, 0x28, 0x60, 0x2f, 0x98, 0x8d, 0x85, 0xf4,
+	0x38, 0x09, 0x34, 0x97, 0xde, 0xa5, 0x9c, 0xa8, 0x6d, 0xde, 0x83, 0x86, 0x2c, 0xf1, 0xe8, 0x54,
+	0xc1, 0x22, 0xfa, 0xa9, 0x5f, 0x40, 0xab, 0xd0, 0x49, 0xbd, 0xc0, 0xd4, 0x35, 0x84, 0xa0, 0x9b,
+	0x7e, 0x97, 0xad, 0x57, 0x36, 0xb7, 0x01, 0x62, 0x53, 0xa7, 0xe4, 0xec, 0x7b, 0xc7, 0x96, 0xeb,
+	0xd8, 0x9c, 0x36, 0x3a, 0x44, 0xb9, 0xcb, 0xb8, 0xc3, 0x5b, 0x75, 0x7a, 0x65, 0xf3, 0x35, 0x68,
+	0x48, 0x2d, 0xa7, 0x70, 0x13, 0x4f, 0xfd, 0x63, 0xcc, 0x25, 0x33, 0xc0, 0xa1, 0xae, 0x6d, 0xff,
+	0x7b, 0x17, 0x80, 0x37, 0xe5, 0x7c, 0x3f, 0xb0, 0x91, 0x0b, 0x68, 0x0f, 0x87, 0x3b, 0xfe, 0x74,
+	0xe6, 0x7b, 0xb2, 0x59, 0x40, 0xd0, 0x56, 0x5a, 0x15, 0xc4, 0x47, 0x7e, 0xa2, 0x38, 0x7d, 0xff,
+	0x0d, 0xe5, 0xfc, 0xcc, 0x64, 0xe3, 0x02, 0x9a, 0x32, 0x6c, 0x8f, 0x9c, 0x29, 0x7e, 0xe4, 0x8c,
+	0x9f, 0x46, 0x9d, 0xbc, 0xe2, 0xd7, 0xc9, 0x99, 0xa9, 0x12, 0xdf, 0x0d, 0x25, 0xbe, 0x41, 0x18,
+	0x38, 0xde, 0xa1, 0x4c, 0xc5, 0x8d, 0x0b, 0xe8, 0x59, 0xe6, 0x6d, 0xb4, 0x44, 0xb8, 0x5d, 0xe6,
+	0x39, 0xf4, 0xd9, 0x50, 0xba, 0xb0, 0x92, 0xf9, 0x8b, 0x0a, 0xda, 0x54, 0x3f, 0x77, 0x53, 0xfd,
+	0x9d, 0xa6, 0xff, 0x76, 0xa9, 0xb9, 0x11, 0x36, 0x07, 0xba, 0xe9, 0xbf, 0x61, 0xa0, 0x5f, 0x28,
+	0xda, 0x20, 0xf7, 0xe0, 0xb6, 0xbf, 0x59, 0x66, 0x6a, 0x84, 0xea, 0x63, 0xae, 0xa0, 0x8b, 0x50,
+	0x29, 0x5f, 0x16, 0xf7, 0x4f, 0xab, 0x82, 0x8c, 0x0b, 0xe8, 0x87, 0xb0, 0x9a, 0x7b, 0x16, 0x8c,
+	0xbe, 0xa6, 0xbe, 0xad, 0x51, 0xbf, 0x1e, 0x5e, 0x84, 0xe1, 0xe3, 0xac, 0x79, 0x15, 0x53, 0x9f,
+	0xfb, 0x73, 0x42, 0x79, 0xea, 0x13, 0xdb, 0x9f, 0x46, 0xfd, 0x0b, 0x63, 0x98, 0x33, 0xb3, 0xc9,
+	0xb6, 0x86, 0xdf, 0x51, 0xa1, 0x28, 0x7c, 0x9b, 0xdc, 0xdf, 0x2a, 0x3b, 0x3d, 0xa9, 0x5d, 0xe9,
+	0xe7, 0xaf, 0x6a, 0xa6, 0x29, 0x9f, 0xec, 0xaa, 0xb5, 0x4b, 0xfd, 0x9a, 0xd6, 0xb8, 0x80, 0x1e,
+	0xa5, 0xdc, 0x2b, 0x7a, 0xb3, 0x48, 0x38, 0xe9, 0x0b, 0xa3, 0x45, 0x7c, 0xfb, 0x2d, 0x40, 0xdc,
+	0x76, 0xbc, 0x89, 0x73, 0x38, 0x0f, 0x2c, 0xae, 0x58, 0x45, 0xee, 0x26, 0x3f, 0x55, 0xa2, 0x79,
+	0xef, 0x05, 0x56, 0x44, 0x47, 0x1a, 0x02, 0xec, 0xe1, 0xf0, 0x01, 0x0e, 0x03, 0x67, 0x4c, 0xb2,
+	0x27, 0x8a, 0x3d, 0xaa, 0x98, 0x20, 0x51, 0xbd, 0xb5, 0x70, 0x5e, 0x84, 0x60, 0x04, 0xad, 0x3d,
+	0x1c, 0x8a, 0xbc, 0x8a, 0xa0, 0xc2, 0x95, 0x72, 0x86, 0x44, 0xb1, 0xb1, 0x78, 0x62, 0xd2, 0x9d,
+	0x65, 0x9e, 0x02, 0xa3, 0x42, 0xc1, 0xe6, 0x1f, 0x28, 0xab, 0xdd, 0x59, 0xc1, 0xdb, 0x62, 0x7e,
+	0xa2, 0x9d, 0x23, 0x3c, 0x7e, 0xfa, 0x01, 0xb6, 0xdc, 0xf0, 0xa8, 0xe0, 0x44, 0x89, 0x19, 0xa7,
+	0x9f, 0x28, 0x35, 0x31, 0xc2, 0xf1, 0x9b, 0xb0, 0xae, 0xfe, 0x9b, 0x0f, 0x7a, 0x4f, 0xd9, 0xeb,
+	0x3b, 0xed, 0x2f, 0x41, 0x0b, 0xf4, 0x6f, 0xfb, 0x8f, 0x56, 0xa0, 0xc9, 0x62, 0x2d, 0x4d, 0x0c,
+	0xfe, 0x3f, 0xd4, 0x7e, 0xce, 0xa1, 0xf6, 0x13, 0x58, 0xc9, 0xbc, 0x92, 0x55, 0xeb, 0xa6, 0xfa,
+	0x29, 0x6d, 0x89, 0x88, 0x91, 0x7e, 0xa7, 0xaa, 0x76, 0x7e, 0xca, 0xb7, 0xac, 0x8b, 0xf6, 0x7e,
+	0xc2, 0x1f, 0x98, 0x47, 0x3d, 0xda, 0xb7, 0x0a, 0xab, 0xbc, 0xf4, 0xdd, 0xfe, 0x17, 0x1f, 0x89,
+	0x5e, 0x7e, 0xa4, 0xfe, 0x04, 0x56, 0x32, 0x2f, 0xac, 0xd4, 0x52, 0x55, 0x3f, 0xc3, 0x5a, 0xb4,
+	0xfb, 0x2b, 0x0c, 0x69, 0x36, 0xac, 0x29, 0x1e, 0xbf, 0xa0, 0xad, 0xa2, 0x2a, 0x4b, 0xfd, 0x4a,
+	0x66, 0xf1, 0x81, 0x3a, 0x29, 0x53, 0x42, 0x1b, 0x45, 0x44, 0x66, 0xff, 0xe7, 0xd7, 0xff, 0x5a,
+	0xb9, 0x3f, 0x05, 0x46, 0x07, 0x1a, 0xc0, 0x12, 0x7f, 0x77, 0x85, 0x5e, 0x57, 0xf7, 0x1a, 0x13,
+	0x6f, 0xb2, 0xfa, 0x8b, 0x5e, 0x6e, 0x91, 0xb9, 0x1b, 0x12, 0xb6, 0x69, 0x9d, 0x79, 0x48, 0xa4,
+	0x7c, 0x30, 0x98, 0x7c, 0x2c, 0xd5, 0x5f, 0xfc, 0x3e, 0x4a, 0x6e, 0xfa, 0x7f, 0x3b, 0xee, 0x7f,
+	0x0a, 0x6b, 0x8a, 0x1b, 0x08, 0x54, 0x94, 0xdf, 0x15, 0xdc, 0x7d, 0xf4, 0x6f, 0x95, 0x9e, 0x1f,
+	0x61, 0xfe, 0x01, 0xe8, 0xd9, 0xee, 0x05, 0x7a, 0xbb, 0x48, 0x9f, 0x55, 0x38, 0x17, 0x28, 0xf3,
+	0x2b, 0x8c, 0xcd, 0xf7, 0xbe, 0xfe, 0xf1, 0xf6, 0xa1, 0x13, 0x1e, 0xcd, 0x47, 0x74, 0xe4, 0x16,
+	0x9f, 0xfa, 0x8e, 0xe3, 0x8b, 0x5f, 0xb7, 0xa4, 0xac, 0x6f, 0xb1, 0xd5, 0xb7, 0x18, 0xc2, 0xd9,
+	0x68, 0xb4, 0xc4, 0x3e, 0x6f, 0xff, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x99, 0x43, 0xb6, 0x80,
+	0x85, 0x41, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4002,6 +4062,7 @@ type QueryCoordClient interface {
 	GetReplicas(ctx context.Context, in *milvuspb.GetReplicasRequest, opts ...grpc.CallOption) (*milvuspb.GetReplicasResponse, error)
 	GetShardLeaders(ctx context.Context, in *GetShardLeadersRequest, opts ...grpc.CallOption) (*GetShardLeadersResponse, error)
 	CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)
+	UpdateCollectionSchema(ctx context.Context, in *UpdateCollectionSchemaRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type queryCoordClient struct {
@@ -4165,6 +4226,15 @@ func (c *queryCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHe
 	return out, nil
 }
 
+func (c *queryCoordClient) UpdateCollectionSchema(ctx context.Context, in *UpdateCollectionSchemaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/UpdateCollectionSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryCoordServer is the server API for QueryCoord service.
 type QueryCoordServer interface {
 	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
@@ -4186,6 +4256,7 @@ type QueryCoordServer interface {
 	GetReplicas(context.Context, *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error)
 	GetShardLeaders(context.Context, *GetShardLeadersRequest) (*GetShardLeadersResponse, error)
 	CheckHealth(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
+	UpdateCollectionSchema(context.Context, *UpdateCollectionSchemaRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedQueryCoordServer can be embedded to have forward compatible implementations.
@@ -4243,6 +4314,9 @@ func (*UnimplementedQueryCoordServer) GetShardLeaders(ctx context.Context, req *
 func (*UnimplementedQueryCoordServer) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckHealth not implemented")
 }
+func (*UnimplementedQueryCoordServer) UpdateCollectionSchema(ctx context.Context, req *UpdateCollectionSchemaRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollectionSchema not implemented")
+}
 
 func RegisterQueryCoordServer(s *grpc.Server, srv QueryCoordServer) {
 	s.RegisterService(&_QueryCoord_serviceDesc, srv)
@@ -4554,6 +4628,24 @@ func _QueryCoord_CheckHealth_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryCoord_UpdateCollectionSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).UpdateCollectionSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/UpdateCollectionSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).UpdateCollectionSchema(ctx, req.(*UpdateCollectionSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.query.QueryCoord",
 	HandlerType: (*QueryCoordServer)(nil),
@@ -4626,6 +4718,10 @@ var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CheckHealth",
 			Handler:    _QueryCoord_CheckHealth_Handler,
 		},
+		{
+			MethodName: "UpdateCollectionSchema",
+			Handler:    _QueryCoord_UpdateCollectionSchema_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "query_coord.proto",
@@ -4654,6 +4750,7 @@ type QueryNodeClient interface {
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
 	GetDataDistribution(ctx context.Context, in *GetDataDistributionRequest, opts ...grpc.CallOption) (*GetDataDistributionResponse, error)
 	SyncDistribution(ctx context.Context, in *SyncDistributionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UpdateCollectionSchema(ctx context.Context, in *UpdateCollectionSchemaRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type queryNodeClient struct {
@@ -4826,6 +4923,15 @@ func (c *queryNodeClient) SyncDistribution(ctx context.Context, in *SyncDistribu
 	return out, nil
 }
 
+func (c *queryNodeClient) UpdateCollectionSchema(ctx context.Context, in *UpdateCollectionSchemaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/UpdateCollectionSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryNodeServer is the server API for QueryNode service.
 type QueryNodeServer interface {
 	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
@@ -4847,6 +4953,7 @@ type QueryNodeServer interface {
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 	GetDataDistribution(context.Context, *GetDataDistributionRequest) (*GetDataDistributionResponse, error)
 	SyncDistribution(context.Context, *SyncDistributionRequest) (*commonpb.Status, error)
+	UpdateCollectionSchema(context.Context, *UpdateCollectionSchemaRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedQueryNodeServer can be embedded to have forward compatible implementations.
@@ -4907,6 +5014,9 @@ func (*UnimplementedQueryNodeServer) GetDataDistribution(ctx context.Context, re
 func (*UnimplementedQueryNodeServer) SyncDistribution(ctx context.Context, req *SyncDistributionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncDistribution not implemented")
 }
+func (*UnimplementedQueryNodeServer) UpdateCollectionSchema(ctx context.Context, req *UpdateCollectionSchemaRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollectionSchema not implemented")
+}
 
 func RegisterQueryNodeServer(s *grpc.Server, srv QueryNodeServer) {
 	s.RegisterService(&_QueryNode_serviceDesc, srv)
@@ -5236,6 +5346,24 @@ func _QueryNode_SyncDistribution_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryNode_UpdateCollectionSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).UpdateCollectionSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/UpdateCollectionSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).UpdateCollectionSchema(ctx, req.(*UpdateCollectionSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryNode_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.query.QueryNode",
 	HandlerType: (*QueryNodeServer)(nil),
@@ -5312,6 +5440,10 @@ var _QueryNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SyncDistribution",
 			Handler:    _QueryNode_SyncDistribution_Handler,
 		},
+		{
+			MethodName: "UpdateCollectionSchema",
+			Handler:    _QueryNode_UpdateCollectionSchema_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "query_coord.proto",
@@ -29,6 +29,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type ExportState int32
+
+const (
+	ExportState_ExportPending   ExportState = 0
+	ExportState_ExportFailed    ExportState = 1
+	ExportState_ExportStarted   ExportState = 2
+	ExportState_ExportCompleted ExportState = 3
+)
+
+var ExportState_name = map[int32]string{
+	0: "ExportPending",
+	1: "ExportFailed",
+	2: "ExportStarted",
+	3: "ExportCompleted",
+}
+
+var ExportState_value = map[string]int32{
+	"ExportPending":   0,
+	"ExportFailed":    1,
+	"ExportStarted":   2,
+	"ExportCompleted": 3,
+}
+
+func (x ExportState) String() string {
+	return proto.EnumName(ExportState_name, int32(x))
+}
+
+func (ExportState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{0}
+}
+
 type AllocTimestampRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Count                uint32            `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
@@ -178,150 +209,972 @@ func (m *AllocIDRequest) GetCount() uint32 {
 	return 0
 }
 
-type AllocIDResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	ID                   int64            `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
-	Count                uint32           `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type AllocIDResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ID                   int64            `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Count                uint32           `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *AllocIDResponse) Reset()         { *m = AllocIDResponse{} }
+func (m *AllocIDResponse) String() string { return proto.CompactTextString(m) }
+func (*AllocIDResponse) ProtoMessage()    {}
+func (*AllocIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{3}
+}
+
+func (m *AllocIDResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AllocIDResponse.Unmarshal(m, b)
+}
+func (m *AllocIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AllocIDResponse.Marshal(b, m, deterministic)
+}
+func (m *AllocIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocIDResponse.Merge(m, src)
+}
+func (m *AllocIDResponse) XXX_Size() int {
+	return xxx_messageInfo_AllocIDResponse.Size(m)
+}
+func (m *AllocIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllocIDResponse proto.InternalMessageInfo
+
+func (m *AllocIDResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *AllocIDResponse) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *AllocIDResponse) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ImportResult struct {
+	Status               *commonpb.Status               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TaskId               int64                          `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	DatanodeId           int64                          `protobuf:"varint,3,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
+	State                commonpb.ImportState           `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.common.ImportState" json:"state,omitempty"`
+	Segments             []int64                        `protobuf:"varint,5,rep,packed,name=segments,proto3" json:"segments,omitempty"`
+	AutoIds              []int64                        `protobuf:"varint,6,rep,packed,name=auto_ids,json=autoIds,proto3" json:"auto_ids,omitempty"`
+	RowCount             int64                          `protobuf:"varint,7,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	Infos                []*commonpb.KeyValuePair       `protobuf:"bytes,8,rep,name=infos,proto3" json:"infos,omitempty"`
+	Checkpoints          []*internalpb.ImportCheckpoint `protobuf:"bytes,9,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *ImportResult) Reset()         { *m = ImportResult{} }
+func (m *ImportResult) String() string { return proto.CompactTextString(m) }
+func (*ImportResult) ProtoMessage()    {}
+func (*ImportResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{4}
+}
+
+func (m *ImportResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResult.Unmarshal(m, b)
+}
+func (m *ImportResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResult.Marshal(b, m, deterministic)
+}
+func (m *ImportResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResult.Merge(m, src)
+}
+func (m *ImportResult) XXX_Size() int {
+	return xxx_messageInfo_ImportResult.Size(m)
+}
+func (m *ImportResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportResult proto.InternalMessageInfo
+
+func (m *ImportResult) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ImportResult) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *ImportResult) GetDatanodeId() int64 {
+	if m != nil {
+		return m.DatanodeId
+	}
+	return 0
+}
+
+func (m *ImportResult) GetState() commonpb.ImportState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.ImportState_ImportPending
+}
+
+func (m *ImportResult) GetSegments() []int64 {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ImportResult) GetAutoIds() []int64 {
+	if m != nil {
+		return m.AutoIds
+	}
+	return nil
+}
+
+func (m *ImportResult) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *ImportResult) GetInfos() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+func (m *ImportResult) GetCheckpoints() []*internalpb.ImportCheckpoint {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
+type CancelImportRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskId               int64             `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CancelImportRequest) Reset()         { *m = CancelImportRequest{} }
+func (m *CancelImportRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelImportRequest) ProtoMessage()    {}
+func (*CancelImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{5}
+}
+
+func (m *CancelImportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelImportRequest.Unmarshal(m, b)
+}
+func (m *CancelImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelImportRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelImportRequest.Merge(m, src)
+}
+func (m *CancelImportRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelImportRequest.Size(m)
+}
+func (m *CancelImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelImportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelImportRequest proto.InternalMessageInfo
+
+func (m *CancelImportRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CancelImportRequest) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+type ExportRequest struct {
+	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionName       string                   `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames       []string                 `protobuf:"bytes,3,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	Timestamp            uint64                   `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	FileType             string                   `protobuf:"bytes,5,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`
+	Bucket               string                   `protobuf:"bytes,6,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Path                 string                   `protobuf:"bytes,7,opt,name=path,proto3" json:"path,omitempty"`
+	Options              []*commonpb.KeyValuePair `protobuf:"bytes,8,rep,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ExportRequest) Reset()         { *m = ExportRequest{} }
+func (m *ExportRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportRequest) ProtoMessage()    {}
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{6}
+}
+
+func (m *ExportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportRequest.Unmarshal(m, b)
+}
+func (m *ExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportRequest.Merge(m, src)
+}
+func (m *ExportRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportRequest.Size(m)
+}
+func (m *ExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportRequest proto.InternalMessageInfo
+
+func (m *ExportRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ExportRequest) GetFileType() string {
+	if m != nil {
+		return m.FileType
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetOptions() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type ExportResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TaskId               int64            `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ExportResponse) Reset()         { *m = ExportResponse{} }
+func (m *ExportResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportResponse) ProtoMessage()    {}
+func (*ExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{7}
+}
+
+func (m *ExportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportResponse.Unmarshal(m, b)
+}
+func (m *ExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportResponse.Merge(m, src)
+}
+func (m *ExportResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportResponse.Size(m)
+}
+func (m *ExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportResponse proto.InternalMessageInfo
+
+func (m *ExportResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ExportResponse) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+type GetExportStateRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskId               int64             `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetExportStateRequest) Reset()         { *m = GetExportStateRequest{} }
+func (m *GetExportStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExportStateRequest) ProtoMessage()    {}
+func (*GetExportStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{8}
+}
+
+func (m *GetExportStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExportStateRequest.Unmarshal(m, b)
+}
+func (m *GetExportStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExportStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExportStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExportStateRequest.Merge(m, src)
+}
+func (m *GetExportStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExportStateRequest.Size(m)
+}
+func (m *GetExportStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExportStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExportStateRequest proto.InternalMessageInfo
+
+func (m *GetExportStateRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetExportStateRequest) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+type GetExportStateResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Id                   int64            `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	State                ExportState      `protobuf:"varint,3,opt,name=state,proto3,enum=milvus.proto.rootcoord.ExportState" json:"state,omitempty"`
+	CollectionId         int64            `protobuf:"varint,4,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	CollectionName       string           `protobuf:"bytes,5,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames       []string         `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	Timestamp            uint64           `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	FileType             string           `protobuf:"bytes,8,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`
+	Bucket               string           `protobuf:"bytes,9,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Path                 string           `protobuf:"bytes,10,opt,name=path,proto3" json:"path,omitempty"`
+	Files                []string         `protobuf:"bytes,11,rep,name=files,proto3" json:"files,omitempty"`
+	RowCount             int64            `protobuf:"varint,12,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	Progress             int64            `protobuf:"varint,13,opt,name=progress,proto3" json:"progress,omitempty"`
+	CreateTs             int64            `protobuf:"varint,14,opt,name=create_ts,json=createTs,proto3" json:"create_ts,omitempty"`
+	ErrorMessage         string           `protobuf:"bytes,15,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetExportStateResponse) Reset()         { *m = GetExportStateResponse{} }
+func (m *GetExportStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExportStateResponse) ProtoMessage()    {}
+func (*GetExportStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{9}
+}
+
+func (m *GetExportStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExportStateResponse.Unmarshal(m, b)
+}
+func (m *GetExportStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExportStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetExportStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExportStateResponse.Merge(m, src)
+}
+func (m *GetExportStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExportStateResponse.Size(m)
+}
+func (m *GetExportStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExportStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExportStateResponse proto.InternalMessageInfo
+
+func (m *GetExportStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetExportStateResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetExportStateResponse) GetState() ExportState {
+	if m != nil {
+		return m.State
+	}
+	return ExportState_ExportPending
+}
+
+func (m *GetExportStateResponse) GetCollectionId() int64 {
+	if m != nil {
+		return m.CollectionId
+	}
+	return 0
+}
+
+func (m *GetExportStateResponse) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *GetExportStateResponse) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *GetExportStateResponse) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *GetExportStateResponse) GetFileType() string {
+	if m != nil {
+		return m.FileType
+	}
+	return ""
+}
+
+func (m *GetExportStateResponse) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *GetExportStateResponse) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *GetExportStateResponse) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *GetExportStateResponse) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *GetExportStateResponse) GetProgress() int64 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *GetExportStateResponse) GetCreateTs() int64 {
+	if m != nil {
+		return m.CreateTs
+	}
+	return 0
+}
+
+func (m *GetExportStateResponse) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+type ListExportTasksRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionName       string            `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Limit                int64             `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListExportTasksRequest) Reset()         { *m = ListExportTasksRequest{} }
+func (m *ListExportTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListExportTasksRequest) ProtoMessage()    {}
+func (*ListExportTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{10}
+}
+
+func (m *ListExportTasksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListExportTasksRequest.Unmarshal(m, b)
+}
+func (m *ListExportTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListExportTasksRequest.Marshal(b, m, deterministic)
+}
+func (m *ListExportTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListExportTasksRequest.Merge(m, src)
+}
+func (m *ListExportTasksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListExportTasksRequest.Size(m)
+}
+func (m *ListExportTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListExportTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListExportTasksRequest proto.InternalMessageInfo
+
+func (m *ListExportTasksRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ListExportTasksRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ListExportTasksRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListExportTasksResponse struct {
+	Status               *commonpb.Status          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Tasks                []*GetExportStateResponse `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ListExportTasksResponse) Reset()         { *m = ListExportTasksResponse{} }
+func (m *ListExportTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*ListExportTasksResponse) ProtoMessage()    {}
+func (*ListExportTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{11}
+}
+
+func (m *ListExportTasksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListExportTasksResponse.Unmarshal(m, b)
+}
+func (m *ListExportTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListExportTasksResponse.Marshal(b, m, deterministic)
+}
+func (m *ListExportTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListExportTasksResponse.Merge(m, src)
+}
+func (m *ListExportTasksResponse) XXX_Size() int {
+	return xxx_messageInfo_ListExportTasksResponse.Size(m)
+}
+func (m *ListExportTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListExportTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListExportTasksResponse proto.InternalMessageInfo
+
+func (m *ListExportTasksResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListExportTasksResponse) GetTasks() []*GetExportStateResponse {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+type ExportResult struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TaskId               int64                    `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	DatanodeId           int64                    `protobuf:"varint,3,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
+	State                ExportState              `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.rootcoord.ExportState" json:"state,omitempty"`
+	Segments             []int64                  `protobuf:"varint,5,rep,packed,name=segments,proto3" json:"segments,omitempty"`
+	TotalSegments        int64                    `protobuf:"varint,6,opt,name=total_segments,json=totalSegments,proto3" json:"total_segments,omitempty"`
+	Files                []string                 `protobuf:"bytes,7,rep,name=files,proto3" json:"files,omitempty"`
+	RowCount             int64                    `protobuf:"varint,8,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	Infos                []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ExportResult) Reset()         { *m = ExportResult{} }
+func (m *ExportResult) String() string { return proto.CompactTextString(m) }
+func (*ExportResult) ProtoMessage()    {}
+func (*ExportResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{12}
+}
+
+func (m *ExportResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportResult.Unmarshal(m, b)
+}
+func (m *ExportResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportResult.Marshal(b, m, deterministic)
+}
+func (m *ExportResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportResult.Merge(m, src)
+}
+func (m *ExportResult) XXX_Size() int {
+	return xxx_messageInfo_ExportResult.Size(m)
+}
+func (m *ExportResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportResult proto.InternalMessageInfo
+
+func (m *ExportResult) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ExportResult) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *ExportResult) GetDatanodeId() int64 {
+	if m != nil {
+		return m.DatanodeId
+	}
+	return 0
+}
+
+func (m *ExportResult) GetState() ExportState {
+	if m != nil {
+		return m.State
+	}
+	return ExportState_ExportPending
+}
+
+func (m *ExportResult) GetSegments() []int64 {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ExportResult) GetTotalSegments() int64 {
+	if m != nil {
+		return m.TotalSegments
+	}
+	return 0
+}
+
+func (m *ExportResult) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ExportResult) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *ExportResult) GetInfos() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type ExportTaskState struct {
+	StateCode            ExportState `protobuf:"varint,1,opt,name=state_code,json=stateCode,proto3,enum=milvus.proto.rootcoord.ExportState" json:"state_code,omitempty"`
+	Segments             []int64     `protobuf:"varint,2,rep,packed,name=segments,proto3" json:"segments,omitempty"`
+	TotalSegments        int64       `protobuf:"varint,3,opt,name=total_segments,json=totalSegments,proto3" json:"total_segments,omitempty"`
+	Files                []string    `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"`
+	RowCount             int64       `protobuf:"varint,5,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	ErrorMessage         string      `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *AllocIDResponse) Reset()         { *m = AllocIDResponse{} }
-func (m *AllocIDResponse) String() string { return proto.CompactTextString(m) }
-func (*AllocIDResponse) ProtoMessage()    {}
-func (*AllocIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{3}
+func (m *ExportTaskState) Reset()         { *m = ExportTaskState{} }
+func (m *ExportTaskState) String() string { return proto.CompactTextString(m) }
+func (*ExportTaskState) ProtoMessage()    {}
+func (*ExportTaskState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{13}
 }
 
-func (m *AllocIDResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AllocIDResponse.Unmarshal(m, b)
+func (m *ExportTaskState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTaskState.Unmarshal(m, b)
 }
-func (m *AllocIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AllocIDResponse.Marshal(b, m, deterministic)
+func (m *ExportTaskState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTaskState.Marshal(b, m, deterministic)
 }
-func (m *AllocIDResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AllocIDResponse.Merge(m, src)
+func (m *ExportTaskState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTaskState.Merge(m, src)
 }
-func (m *AllocIDResponse) XXX_Size() int {
-	return xxx_messageInfo_AllocIDResponse.Size(m)
+func (m *ExportTaskState) XXX_Size() int {
+	return xxx_messageInfo_ExportTaskState.Size(m)
 }
-func (m *AllocIDResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AllocIDResponse.DiscardUnknown(m)
+func (m *ExportTaskState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTaskState.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AllocIDResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExportTaskState proto.InternalMessageInfo
 
-func (m *AllocIDResponse) GetStatus() *commonpb.Status {
+func (m *ExportTaskState) GetStateCode() ExportState {
 	if m != nil {
-		return m.Status
+		return m.StateCode
+	}
+	return ExportState_ExportPending
+}
+
+func (m *ExportTaskState) GetSegments() []int64 {
+	if m != nil {
+		return m.Segments
 	}
 	return nil
 }
 
-func (m *AllocIDResponse) GetID() int64 {
+func (m *ExportTaskState) GetTotalSegments() int64 {
 	if m != nil {
-		return m.ID
+		return m.TotalSegments
 	}
 	return 0
 }
 
-func (m *AllocIDResponse) GetCount() uint32 {
+func (m *ExportTaskState) GetFiles() []string {
 	if m != nil {
-		return m.Count
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ExportTaskState) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
 	}
 	return 0
 }
 
-type ImportResult struct {
-	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	TaskId               int64                    `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	DatanodeId           int64                    `protobuf:"varint,3,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
-	State                commonpb.ImportState     `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.common.ImportState" json:"state,omitempty"`
-	Segments             []int64                  `protobuf:"varint,5,rep,packed,name=segments,proto3" json:"segments,omitempty"`
-	AutoIds              []int64                  `protobuf:"varint,6,rep,packed,name=auto_ids,json=autoIds,proto3" json:"auto_ids,omitempty"`
-	RowCount             int64                    `protobuf:"varint,7,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
-	Infos                []*commonpb.KeyValuePair `protobuf:"bytes,8,rep,name=infos,proto3" json:"infos,omitempty"`
+func (m *ExportTaskState) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+type ExportTaskInfo struct {
+	Id                   int64                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DatanodeId           int64                    `protobuf:"varint,2,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
+	CollectionId         int64                    `protobuf:"varint,3,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PartitionIds         []int64                  `protobuf:"varint,4,rep,packed,name=partition_ids,json=partitionIds,proto3" json:"partition_ids,omitempty"`
+	CollectionName       string                   `protobuf:"bytes,5,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames       []string                 `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	Timestamp            uint64                   `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	FileType             string                   `protobuf:"bytes,8,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`
+	Bucket               string                   `protobuf:"bytes,9,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Path                 string                   `protobuf:"bytes,10,opt,name=path,proto3" json:"path,omitempty"`
+	CreateTs             int64                    `protobuf:"varint,11,opt,name=create_ts,json=createTs,proto3" json:"create_ts,omitempty"`
+	StartTs              int64                    `protobuf:"varint,12,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	State                *ExportTaskState         `protobuf:"bytes,13,opt,name=state,proto3" json:"state,omitempty"`
+	Infos                []*commonpb.KeyValuePair `protobuf:"bytes,14,rep,name=infos,proto3" json:"infos,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *ImportResult) Reset()         { *m = ImportResult{} }
-func (m *ImportResult) String() string { return proto.CompactTextString(m) }
-func (*ImportResult) ProtoMessage()    {}
-func (*ImportResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{4}
+func (m *ExportTaskInfo) Reset()         { *m = ExportTaskInfo{} }
+func (m *ExportTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*ExportTaskInfo) ProtoMessage()    {}
+func (*ExportTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{14}
 }
 
-func (m *ImportResult) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ImportResult.Unmarshal(m, b)
+func (m *ExportTaskInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTaskInfo.Unmarshal(m, b)
 }
-func (m *ImportResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ImportResult.Marshal(b, m, deterministic)
+func (m *ExportTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTaskInfo.Marshal(b, m, deterministic)
 }
-func (m *ImportResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImportResult.Merge(m, src)
+func (m *ExportTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTaskInfo.Merge(m, src)
 }
-func (m *ImportResult) XXX_Size() int {
-	return xxx_messageInfo_ImportResult.Size(m)
+func (m *ExportTaskInfo) XXX_Size() int {
+	return xxx_messageInfo_ExportTaskInfo.Size(m)
 }
-func (m *ImportResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImportResult.DiscardUnknown(m)
+func (m *ExportTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTaskInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ImportResult proto.InternalMessageInfo
+var xxx_messageInfo_ExportTaskInfo proto.InternalMessageInfo
 
-func (m *ImportResult) GetStatus() *commonpb.Status {
+func (m *ExportTaskInfo) GetId() int64 {
 	if m != nil {
-		return m.Status
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
-func (m *ImportResult) GetTaskId() int64 {
+func (m *ExportTaskInfo) GetDatanodeId() int64 {
 	if m != nil {
-		return m.TaskId
+		return m.DatanodeId
 	}
 	return 0
 }
 
-func (m *ImportResult) GetDatanodeId() int64 {
+func (m *ExportTaskInfo) GetCollectionId() int64 {
 	if m != nil {
-		return m.DatanodeId
+		return m.CollectionId
 	}
 	return 0
 }
 
-func (m *ImportResult) GetState() commonpb.ImportState {
+func (m *ExportTaskInfo) GetPartitionIds() []int64 {
 	if m != nil {
-		return m.State
+		return m.PartitionIds
 	}
-	return commonpb.ImportState_ImportPending
+	return nil
 }
 
-func (m *ImportResult) GetSegments() []int64 {
+func (m *ExportTaskInfo) GetCollectionName() string {
 	if m != nil {
-		return m.Segments
+		return m.CollectionName
 	}
-	return nil
+	return ""
 }
 
-func (m *ImportResult) GetAutoIds() []int64 {
+func (m *ExportTaskInfo) GetPartitionNames() []string {
 	if m != nil {
-		return m.AutoIds
+		return m.PartitionNames
 	}
 	return nil
 }
 
-func (m *ImportResult) GetRowCount() int64 {
+func (m *ExportTaskInfo) GetTimestamp() uint64 {
 	if m != nil {
-		return m.RowCount
+		return m.Timestamp
 	}
 	return 0
 }
 
-func (m *ImportResult) GetInfos() []*commonpb.KeyValuePair {
+func (m *ExportTaskInfo) GetFileType() string {
+	if m != nil {
+		return m.FileType
+	}
+	return ""
+}
+
+func (m *ExportTaskInfo) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *ExportTaskInfo) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ExportTaskInfo) GetCreateTs() int64 {
+	if m != nil {
+		return m.CreateTs
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetStartTs() int64 {
+	if m != nil {
+		return m.StartTs
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetState() *ExportTaskState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *ExportTaskInfo) GetInfos() []*commonpb.KeyValuePair {
 	if m != nil {
 		return m.Infos
 	}
@@ -341,7 +1194,7 @@ func (m *DescribeSegmentsRequest) Reset()         { *m = DescribeSegmentsRequest
 func (m *DescribeSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentsRequest) ProtoMessage()    {}
 func (*DescribeSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{5}
+	return fileDescriptor_4513485a144f6b06, []int{15}
 }
 
 func (m *DescribeSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -396,7 +1249,7 @@ func (m *SegmentBaseInfo) Reset()         { *m = SegmentBaseInfo{} }
 func (m *SegmentBaseInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentBaseInfo) ProtoMessage()    {}
 func (*SegmentBaseInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{6}
+	return fileDescriptor_4513485a144f6b06, []int{16}
 }
 
 func (m *SegmentBaseInfo) XXX_Unmarshal(b []byte) error {
@@ -451,7 +1304,7 @@ func (m *SegmentInfos) Reset()         { *m = SegmentInfos{} }
 func (m *SegmentInfos) String() string { return proto.CompactTextString(m) }
 func (*SegmentInfos) ProtoMessage()    {}
 func (*SegmentInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{7}
+	return fileDescriptor_4513485a144f6b06, []int{17}
 }
 
 func (m *SegmentInfos) XXX_Unmarshal(b []byte) error {
@@ -506,7 +1359,7 @@ func (m *DescribeSegmentsResponse) Reset()         { *m = DescribeSegmentsRespon
 func (m *DescribeSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentsResponse) ProtoMessage()    {}
 func (*DescribeSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{8}
+	return fileDescriptor_4513485a144f6b06, []int{18}
 }
 
 func (m *DescribeSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -562,7 +1415,7 @@ func (m *GetCredentialRequest) Reset()         { *m = GetCredentialRequest{} }
 func (m *GetCredentialRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCredentialRequest) ProtoMessage()    {}
 func (*GetCredentialRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{9}
+	return fileDescriptor_4513485a144f6b06, []int{19}
 }
 
 func (m *GetCredentialRequest) XXX_Unmarshal(b []byte) error {
@@ -613,7 +1466,7 @@ func (m *GetCredentialResponse) Reset()         { *m = GetCredentialResponse{} }
 func (m *GetCredentialResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCredentialResponse) ProtoMessage()    {}
 func (*GetCredentialResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{10}
+	return fileDescriptor_4513485a144f6b06, []int{20}
 }
 
 func (m *GetCredentialResponse) XXX_Unmarshal(b []byte) error {
@@ -656,11 +1509,22 @@ func (m *GetCredentialResponse) GetPassword() string {
 }
 
 func init() {
+	proto.RegisterEnum("milvus.proto.rootcoord.ExportState", ExportState_name, ExportState_value)
 	proto.RegisterType((*AllocTimestampRequest)(nil), "milvus.proto.rootcoord.AllocTimestampRequest")
 	proto.RegisterType((*AllocTimestampResponse)(nil), "milvus.proto.rootcoord.AllocTimestampResponse")
 	proto.RegisterType((*AllocIDRequest)(nil), "milvus.proto.rootcoord.AllocIDRequest")
 	proto.RegisterType((*AllocIDResponse)(nil), "milvus.proto.rootcoord.AllocIDResponse")
 	proto.RegisterType((*ImportResult)(nil), "milvus.proto.rootcoord.ImportResult")
+	proto.RegisterType((*CancelImportRequest)(nil), "milvus.proto.rootcoord.CancelImportRequest")
+	proto.RegisterType((*ExportRequest)(nil), "milvus.proto.rootcoord.ExportRequest")
+	proto.RegisterType((*ExportResponse)(nil), "milvus.proto.rootcoord.ExportResponse")
+	proto.RegisterType((*GetExportStateRequest)(nil), "milvus.proto.rootcoord.GetExportStateRequest")
+	proto.RegisterType((*GetExportStateResponse)(nil), "milvus.proto.rootcoord.GetExportStateResponse")
+	proto.RegisterType((*ListExportTasksRequest)(nil), "milvus.proto.rootcoord.ListExportTasksRequest")
+	proto.RegisterType((*ListExportTasksResponse)(nil), "milvus.proto.rootcoord.ListExportTasksResponse")
+	proto.RegisterType((*ExportResult)(nil), "milvus.proto.rootcoord.ExportResult")
+	proto.RegisterType((*ExportTaskState)(nil), "milvus.proto.rootcoord.ExportTaskState")
+	proto.RegisterType((*ExportTaskInfo)(nil), "milvus.proto.rootcoord.ExportTaskInfo")
 	proto.RegisterType((*DescribeSegmentsRequest)(nil), "milvus.proto.rootcoord.DescribeSegmentsRequest")
 	proto.RegisterType((*SegmentBaseInfo)(nil), "milvus.proto.rootcoord.SegmentBaseInfo")
 	proto.RegisterType((*SegmentInfos)(nil), "milvus.proto.rootcoord.SegmentInfos")
@@ -674,103 +1538,145 @@ func init() {
 func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
 
 var fileDescriptor_4513485a144f6b06 = []byte{
-	// 1535 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x6b, 0x93, 0xda, 0x36,
-	0x17, 0x0e, 0x90, 0xbd, 0x70, 0x60, 0x61, 0xa3, 0xc9, 0x85, 0x97, 0xe4, 0x7d, 0x43, 0x48, 0xde,
-	0x86, 0xdc, 0xd8, 0x74, 0x33, 0x93, 0xa6, 0xf9, 0x96, 0x85, 0xcc, 0x86, 0x69, 0x77, 0xb2, 0x35,
-	0x49, 0x27, 0xbd, 0xec, 0x50, 0x61, 0x2b, 0xe0, 0x59, 0x63, 0x11, 0x49, 0xec, 0x65, 0xfa, 0xa9,
-	0x33, 0xfd, 0xde, 0xff, 0xd4, 0xfe, 0x94, 0xfe, 0x90, 0x76, 0x64, 0xd9, 0xc2, 0x36, 0x36, 0xeb,
-	0x4d, 0xf2, 0x0d, 0x49, 0x8f, 0x9f, 0xe7, 0xe8, 0x1c, 0x9d, 0x73, 0x24, 0x60, 0x93, 0x51, 0x2a,
-	0x06, 0x26, 0xa5, 0xcc, 0x6a, 0x4f, 0x19, 0x15, 0x14, 0x5d, 0x9d, 0xd8, 0xce, 0xd1, 0x8c, 0xab,
-	0x51, 0x5b, 0x2e, 0x7b, 0xab, 0xf5, 0xb2, 0x49, 0x27, 0x13, 0xea, 0xaa, 0xf9, 0x7a, 0x39, 0x8c,
-	0xaa, 0x57, 0x6c, 0x57, 0x10, 0xe6, 0x62, 0xc7, 0x1f, 0x97, 0xa6, 0x8c, 0x9e, 0x9c, 0xfa, 0x83,
-	0x2a, 0x11, 0xa6, 0x35, 0x98, 0x10, 0x81, 0xd5, 0x44, 0x73, 0x00, 0x57, 0x5e, 0x38, 0x0e, 0x35,
-	0xdf, 0xd8, 0x13, 0xc2, 0x05, 0x9e, 0x4c, 0x0d, 0xf2, 0x61, 0x46, 0xb8, 0x40, 0x8f, 0xe1, 0xe2,
-	0x10, 0x73, 0x52, 0xcb, 0x35, 0x72, 0xad, 0xd2, 0xf6, 0x8d, 0x76, 0xc4, 0x12, 0x5f, 0x7e, 0x8f,
-	0x8f, 0x76, 0x30, 0x27, 0x86, 0x87, 0x44, 0x97, 0x61, 0xc5, 0xa4, 0x33, 0x57, 0xd4, 0x0a, 0x8d,
-	0x5c, 0x6b, 0xc3, 0x50, 0x83, 0xe6, 0x6f, 0x39, 0xb8, 0x1a, 0x57, 0xe0, 0x53, 0xea, 0x72, 0x82,
-	0x9e, 0xc0, 0x2a, 0x17, 0x58, 0xcc, 0xb8, 0x2f, 0x72, 0x3d, 0x51, 0xa4, 0xef, 0x41, 0x0c, 0x1f,
-	0x8a, 0x6e, 0x40, 0x51, 0x04, 0x4c, 0xb5, 0x7c, 0x23, 0xd7, 0xba, 0x68, 0xcc, 0x27, 0x52, 0x6c,
-	0x78, 0x07, 0x15, 0xcf, 0x84, 0x5e, 0xf7, 0x33, 0xec, 0x2e, 0x1f, 0x66, 0x76, 0xa0, 0xaa, 0x99,
-	0x3f, 0x65, 0x57, 0x15, 0xc8, 0xf7, 0xba, 0x1e, 0x75, 0xc1, 0xc8, 0xf7, 0xba, 0x29, 0xfb, 0xf8,
-	0x33, 0x0f, 0xe5, 0xde, 0x64, 0x4a, 0x99, 0x30, 0x08, 0x9f, 0x39, 0xe2, 0xe3, 0xb4, 0xae, 0xc1,
-	0x9a, 0xc0, 0xfc, 0x70, 0x60, 0x5b, 0xbe, 0xe0, 0xaa, 0x1c, 0xf6, 0x2c, 0x74, 0x13, 0x4a, 0x16,
-	0x16, 0xd8, 0xa5, 0x16, 0x91, 0x8b, 0x05, 0x6f, 0x11, 0x82, 0xa9, 0x9e, 0x85, 0x9e, 0xc2, 0x8a,
-	0xe4, 0x20, 0xb5, 0x8b, 0x8d, 0x5c, 0xab, 0xb2, 0xdd, 0x48, 0x54, 0x53, 0x06, 0x4a, 0x4d, 0x62,
-	0x28, 0x38, 0xaa, 0xc3, 0x3a, 0x27, 0xa3, 0x09, 0x71, 0x05, 0xaf, 0xad, 0x34, 0x0a, 0xad, 0x82,
-	0xa1, 0xc7, 0xe8, 0x3f, 0xb0, 0x8e, 0x67, 0x82, 0x0e, 0x6c, 0x8b, 0xd7, 0x56, 0xbd, 0xb5, 0x35,
-	0x39, 0xee, 0x59, 0x1c, 0x5d, 0x87, 0x22, 0xa3, 0xc7, 0x03, 0xe5, 0x88, 0x35, 0xcf, 0x9a, 0x75,
-	0x46, 0x8f, 0x3b, 0x72, 0x8c, 0xbe, 0x82, 0x15, 0xdb, 0x7d, 0x4f, 0x79, 0x6d, 0xbd, 0x51, 0x68,
-	0x95, 0xb6, 0x6f, 0x25, 0xda, 0xf2, 0x0d, 0x39, 0xfd, 0x1e, 0x3b, 0x33, 0xb2, 0x8f, 0x6d, 0x66,
-	0x28, 0x7c, 0xf3, 0x8f, 0x1c, 0x5c, 0xeb, 0x12, 0x6e, 0x32, 0x7b, 0x48, 0xfa, 0xbe, 0x15, 0x1f,
-	0x7f, 0x2c, 0x9a, 0x50, 0x36, 0xa9, 0xe3, 0x10, 0x53, 0xd8, 0xd4, 0xd5, 0x21, 0x8c, 0xcc, 0xa1,
-	0xff, 0x01, 0xf8, 0xdb, 0xed, 0x75, 0x79, 0xad, 0xe0, 0x6d, 0x32, 0x34, 0xd3, 0x9c, 0x41, 0xd5,
-	0x37, 0x44, 0x12, 0xf7, 0xdc, 0xf7, 0x74, 0x81, 0x36, 0x97, 0x40, 0xdb, 0x80, 0xd2, 0x14, 0x33,
-	0x61, 0x47, 0x94, 0xc3, 0x53, 0x32, 0x57, 0xb4, 0x8c, 0x1f, 0xce, 0xf9, 0x44, 0xf3, 0xef, 0x3c,
-	0x94, 0x7d, 0x5d, 0xa9, 0xc9, 0x51, 0x17, 0x8a, 0x72, 0x4f, 0x03, 0xe9, 0x27, 0xdf, 0x05, 0x77,
-	0xdb, 0xc9, 0x15, 0xa8, 0x1d, 0x33, 0xd8, 0x58, 0x1f, 0x06, 0xa6, 0x77, 0xa1, 0x64, 0xbb, 0x16,
-	0x39, 0x19, 0xa8, 0xf0, 0xe4, 0xbd, 0xf0, 0xdc, 0x8e, 0xf2, 0xc8, 0x2a, 0xd4, 0xd6, 0xda, 0x16,
-	0x39, 0xf1, 0x38, 0xc0, 0x0e, 0x7e, 0x72, 0x44, 0xe0, 0x12, 0x39, 0x11, 0x0c, 0x0f, 0xc2, 0x5c,
-	0x05, 0x8f, 0xeb, 0xeb, 0x33, 0x6c, 0xf2, 0x08, 0xda, 0x2f, 0xe5, 0xd7, 0x9a, 0x9b, 0xbf, 0x74,
-	0x05, 0x3b, 0x35, 0xaa, 0x24, 0x3a, 0x5b, 0xff, 0x05, 0x2e, 0x27, 0x01, 0xd1, 0x26, 0x14, 0x0e,
-	0xc9, 0xa9, 0xef, 0x76, 0xf9, 0x13, 0x6d, 0xc3, 0xca, 0x91, 0x3c, 0x4a, 0x9e, 0x9f, 0x17, 0xce,
-	0x86, 0xb7, 0xa1, 0xf9, 0x4e, 0x14, 0xf4, 0x79, 0xfe, 0x59, 0xae, 0xf9, 0x57, 0x1e, 0x6a, 0x8b,
-	0xc7, 0xed, 0x53, 0x6a, 0x45, 0x96, 0x23, 0x37, 0x82, 0x0d, 0x3f, 0xd0, 0x11, 0xd7, 0xed, 0xa4,
-	0xb9, 0x2e, 0xcd, 0xc2, 0x88, 0x4f, 0x95, 0x0f, 0xcb, 0x3c, 0x34, 0x55, 0x27, 0x70, 0x69, 0x01,
-	0x92, 0xe0, 0xbd, 0xe7, 0x51, 0xef, 0xdd, 0xc9, 0x12, 0xc2, 0xb0, 0x17, 0x2d, 0xb8, 0xbc, 0x4b,
-	0x44, 0x87, 0x11, 0x8b, 0xb8, 0xc2, 0xc6, 0xce, 0xc7, 0x27, 0x6c, 0x1d, 0xd6, 0x67, 0x5c, 0xf6,
-	0xc7, 0x89, 0x32, 0xa6, 0x68, 0xe8, 0x71, 0xf3, 0xf7, 0x1c, 0x5c, 0x89, 0xc9, 0x7c, 0x4a, 0xa0,
-	0x96, 0x48, 0xc9, 0xb5, 0x29, 0xe6, 0xfc, 0x98, 0x32, 0x55, 0x68, 0x8b, 0x86, 0x1e, 0x6f, 0xff,
-	0x73, 0x13, 0x8a, 0x06, 0xa5, 0xa2, 0x23, 0x5d, 0x82, 0x1c, 0x40, 0xd2, 0x26, 0x3a, 0x99, 0x52,
-	0x97, 0xb8, 0xaa, 0xb0, 0x72, 0xd4, 0x8e, 0x1a, 0xe0, 0x0f, 0x16, 0x81, 0xbe, 0xa3, 0xea, 0x77,
-	0x12, 0xf1, 0x31, 0x70, 0xf3, 0x02, 0x9a, 0x78, 0x6a, 0xb2, 0x57, 0xbf, 0xb1, 0xcd, 0xc3, 0xce,
-	0x18, 0xbb, 0x2e, 0x71, 0xd0, 0xe3, 0xe8, 0xd7, 0xfa, 0x86, 0xb1, 0x08, 0x0d, 0xf4, 0x6e, 0x27,
-	0xea, 0xf5, 0x05, 0xb3, 0xdd, 0x51, 0xe0, 0xd5, 0xe6, 0x05, 0xf4, 0xc1, 0x8b, 0xab, 0x54, 0xb7,
-	0xb9, 0xb0, 0x4d, 0x1e, 0x08, 0x6e, 0xa7, 0x0b, 0x2e, 0x80, 0xcf, 0x29, 0x39, 0x80, 0xcd, 0x0e,
-	0x23, 0x58, 0x90, 0x8e, 0x4e, 0x18, 0xf4, 0x30, 0xd9, 0x3b, 0x31, 0x58, 0x20, 0xb4, 0x2c, 0xf8,
-	0xcd, 0x0b, 0xe8, 0x27, 0xa8, 0x74, 0x19, 0x9d, 0x86, 0xe8, 0xef, 0x27, 0xd2, 0x47, 0x41, 0x19,
-	0xc9, 0x07, 0xb0, 0xf1, 0x0a, 0xf3, 0x10, 0xf7, 0xbd, 0x44, 0xee, 0x08, 0x26, 0xa0, 0xbe, 0x95,
-	0x08, 0xdd, 0xa1, 0xd4, 0x09, 0xb9, 0xe7, 0x18, 0x50, 0x50, 0x0c, 0x42, 0x2a, 0xc9, 0xc7, 0x6d,
-	0x11, 0x18, 0x48, 0x6d, 0x65, 0xc6, 0x6b, 0xe1, 0xb7, 0x50, 0x52, 0x0e, 0x7f, 0xe1, 0xd8, 0x98,
-	0xa3, 0xbb, 0x4b, 0x42, 0xe2, 0x21, 0x32, 0x3a, 0xec, 0x3b, 0x28, 0x4a, 0x47, 0x2b, 0xd2, 0xff,
-	0xa7, 0x06, 0xe2, 0x3c, 0x94, 0x7d, 0x80, 0x17, 0x8e, 0x20, 0x4c, 0x71, 0x7e, 0x91, 0xc8, 0x39,
-	0x07, 0x64, 0x24, 0x75, 0xa1, 0xda, 0x1f, 0xcb, 0xcb, 0x4d, 0xe0, 0x1a, 0x8e, 0x1e, 0x24, 0x1f,
-	0xe8, 0x28, 0x2a, 0xa0, 0x7f, 0x98, 0x0d, 0xac, 0xdd, 0x7d, 0x20, 0x6f, 0xae, 0x82, 0xb0, 0x50,
-	0x90, 0x1f, 0xa4, 0xef, 0xe4, 0xdc, 0xe7, 0xf4, 0x00, 0xaa, 0x2a, 0x56, 0xfb, 0xc1, 0x7d, 0x24,
-	0x85, 0x3e, 0x86, 0xca, 0x48, 0xff, 0x03, 0x6c, 0xc8, 0xa8, 0xcd, 0xc9, 0xef, 0xa5, 0x46, 0xf6,
-	0xbc, 0xd4, 0x07, 0x50, 0x7e, 0x85, 0xf9, 0x9c, 0xb9, 0x95, 0x96, 0x60, 0x0b, 0xc4, 0x99, 0xf2,
-	0xeb, 0x10, 0x2a, 0x32, 0x28, 0xfa, 0x63, 0x9e, 0x52, 0x1d, 0xa2, 0xa0, 0x40, 0xe2, 0x41, 0x26,
-	0xac, 0x16, 0x23, 0x50, 0x96, 0x6b, 0x41, 0x57, 0x4f, 0xd9, 0x4b, 0x18, 0x12, 0x08, 0xdd, 0xcb,
-	0x80, 0x0c, 0x55, 0xf1, 0x4a, 0xf4, 0x89, 0x87, 0x1e, 0xa5, 0x35, 0xf8, 0xc4, 0xc7, 0x66, 0xbd,
-	0x9d, 0x15, 0xae, 0x25, 0x7f, 0x86, 0x35, 0xff, 0xe1, 0x15, 0x4f, 0xc0, 0xd8, 0xc7, 0xfa, 0xcd,
-	0x57, 0xbf, 0x7b, 0x26, 0x4e, 0xb3, 0x63, 0xb8, 0xf2, 0x76, 0x6a, 0xc9, 0xe2, 0xaf, 0x5a, 0x4c,
-	0xd0, 0xe4, 0xe2, 0xc7, 0x4c, 0xf7, 0xa5, 0x18, 0x6e, 0x8f, 0x8f, 0xce, 0x3a, 0x66, 0x0c, 0xfe,
-	0xdb, 0x73, 0x8f, 0xb0, 0x63, 0x5b, 0x91, 0x1e, 0xb3, 0x47, 0x04, 0xee, 0x60, 0x73, 0x4c, 0xe2,
-	0x2d, 0x50, 0xbd, 0xe2, 0xa3, 0x9f, 0x68, 0x70, 0xc6, 0xa3, 0xfd, 0x2b, 0x20, 0x55, 0x10, 0xdc,
-	0xf7, 0xf6, 0x68, 0xc6, 0xb0, 0x3a, 0x7f, 0x69, 0xcd, 0x7d, 0x11, 0x1a, 0xc8, 0x7c, 0x79, 0x8e,
-	0x2f, 0x42, 0x7d, 0x17, 0x76, 0x89, 0xd8, 0x23, 0x82, 0xd9, 0x66, 0x5a, 0xd5, 0x9c, 0x03, 0x52,
-	0x82, 0x96, 0x80, 0xd3, 0x02, 0x7d, 0x58, 0x55, 0x6f, 0x4f, 0xd4, 0x4c, 0xfc, 0x28, 0x78, 0x39,
-	0x2f, 0xbb, 0x2d, 0xe8, 0xd7, 0x75, 0x28, 0x5d, 0x77, 0x89, 0x08, 0xbd, 0x69, 0x53, 0xd2, 0x35,
-	0x0a, 0x5a, 0x9e, 0xae, 0x71, 0xac, 0x16, 0x73, 0xa1, 0xfa, 0xad, 0xcd, 0xfd, 0xc5, 0x37, 0x98,
-	0x1f, 0xa6, 0xf5, 0x80, 0x18, 0x6a, 0x79, 0x0f, 0x58, 0x00, 0x87, 0x3c, 0x56, 0x36, 0x88, 0x5c,
-	0xf0, 0xfd, 0x96, 0x7a, 0x2d, 0x0f, 0xff, 0xe9, 0x70, 0xd6, 0x21, 0x7b, 0xa7, 0xef, 0x57, 0xfa,
-	0x1a, 0x1d, 0xef, 0xbb, 0xf3, 0xb4, 0xd1, 0x10, 0x79, 0xe3, 0xcf, 0xc0, 0xec, 0x67, 0xe5, 0xe7,
-	0x66, 0x1e, 0xc0, 0x66, 0x97, 0x38, 0x24, 0xc2, 0xfc, 0x30, 0xe5, 0x0a, 0x13, 0x85, 0x65, 0xcc,
-	0xbc, 0x31, 0x6c, 0xc8, 0x30, 0xc8, 0xef, 0xde, 0x72, 0xc2, 0x78, 0x4a, 0xbf, 0x8a, 0x60, 0x02,
-	0xea, 0xfb, 0x59, 0xa0, 0xa1, 0x33, 0xb4, 0x11, 0x79, 0xc2, 0xc4, 0xf7, 0x31, 0x0f, 0x6a, 0xd2,
-	0x83, 0xaa, 0xfe, 0x28, 0x23, 0x3a, 0x74, 0x86, 0x40, 0x85, 0xdb, 0xa0, 0x0e, 0x49, 0x49, 0xeb,
-	0x39, 0x20, 0xa3, 0xbb, 0x5e, 0xc3, 0xba, 0x6c, 0xdd, 0x1e, 0xe5, 0x9d, 0xd4, 0xce, 0x7e, 0x0e,
-	0xc2, 0x03, 0xa8, 0xbe, 0x9e, 0x12, 0x86, 0x05, 0x91, 0xfe, 0xf2, 0x78, 0x93, 0x33, 0x2b, 0x86,
-	0xca, 0x7c, 0x2b, 0x87, 0x3e, 0x91, 0x15, 0x7c, 0x89, 0x13, 0xe6, 0x80, 0xe5, 0xb5, 0x2d, 0x8c,
-	0x0b, 0x17, 0x4f, 0x35, 0x2f, 0x0d, 0x5b, 0x2a, 0xe0, 0x59, 0x9e, 0x41, 0x40, 0xe1, 0xc2, 0xaf,
-	0x22, 0x7f, 0xeb, 0xfb, 0xcc, 0x3e, 0xb2, 0x1d, 0x32, 0x22, 0x29, 0x19, 0x10, 0x87, 0x65, 0x74,
-	0xd1, 0x10, 0x4a, 0x4a, 0x78, 0x97, 0x61, 0x57, 0xa0, 0x65, 0xa6, 0x79, 0x88, 0x80, 0xb6, 0x75,
-	0x36, 0x50, 0x6f, 0xc2, 0x04, 0x90, 0x69, 0xb1, 0x4f, 0x1d, 0xdb, 0x3c, 0x8d, 0x5f, 0x76, 0x74,
-	0x69, 0x98, 0x43, 0x52, 0x2e, 0x3b, 0x89, 0x48, 0x2d, 0x32, 0x84, 0x52, 0x67, 0x4c, 0xcc, 0xc3,
-	0x57, 0x04, 0x3b, 0x62, 0x9c, 0xf6, 0x4e, 0x99, 0x23, 0x96, 0x6f, 0x24, 0x02, 0x0c, 0x34, 0x76,
-	0x9e, 0xfd, 0xf8, 0x74, 0x64, 0x8b, 0xf1, 0x6c, 0x28, 0xdd, 0xb8, 0xa5, 0xa0, 0x8f, 0x6c, 0xea,
-	0xff, 0xda, 0x0a, 0x0c, 0xdc, 0xf2, 0xa8, 0xb6, 0x74, 0x92, 0x4e, 0x87, 0xc3, 0x55, 0x6f, 0xea,
-	0xc9, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x94, 0x82, 0xd4, 0x6e, 0x4c, 0x18, 0x00, 0x00,
+	// 2204 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xdd, 0x72, 0x1b, 0xb7,
+	0x15, 0x36, 0x49, 0x91, 0x22, 0x0f, 0xff, 0x64, 0xc4, 0x3f, 0x0c, 0x9d, 0xb6, 0x0a, 0xe5, 0x44,
+	0xf2, 0x9f, 0x94, 0x2a, 0x33, 0x69, 0x9c, 0x4e, 0x2f, 0x2c, 0xd2, 0x95, 0x39, 0x8d, 0x1a, 0x75,
+	0x25, 0x77, 0x52, 0xb7, 0x2e, 0xb3, 0xdc, 0x85, 0xa9, 0x1d, 0x2d, 0x17, 0xcc, 0x02, 0xb4, 0xa5,
+	0xe9, 0x55, 0x67, 0x3a, 0xd3, 0xe9, 0x45, 0xa7, 0x37, 0x7d, 0x97, 0x3e, 0x40, 0x1f, 0xa5, 0x17,
+	0xbd, 0xed, 0x45, 0x1e, 0xa0, 0x03, 0x60, 0x17, 0xdc, 0x5d, 0x2e, 0xc8, 0xa5, 0xe5, 0x69, 0x27,
+	0x77, 0x02, 0xf6, 0xc3, 0x39, 0xc0, 0x39, 0xdf, 0xf9, 0x01, 0x21, 0xd8, 0xf0, 0x09, 0x61, 0x03,
+	0x8b, 0x10, 0xdf, 0xde, 0x9d, 0xf8, 0x84, 0x11, 0x74, 0x6b, 0xec, 0xb8, 0xaf, 0xa7, 0x54, 0x8e,
+	0x76, 0xf9, 0x67, 0xf1, 0xb5, 0x5d, 0xb3, 0xc8, 0x78, 0x4c, 0x3c, 0x39, 0xdf, 0xae, 0x45, 0x51,
+	0xed, 0x86, 0xe3, 0x31, 0xec, 0x7b, 0xa6, 0x1b, 0x8c, 0xab, 0x13, 0x9f, 0x5c, 0x5c, 0x06, 0x83,
+	0x26, 0x66, 0x96, 0x3d, 0x18, 0x63, 0x66, 0xca, 0x89, 0xce, 0x00, 0x6e, 0x3e, 0x71, 0x5d, 0x62,
+	0x9d, 0x3a, 0x63, 0x4c, 0x99, 0x39, 0x9e, 0x18, 0xf8, 0xdb, 0x29, 0xa6, 0x0c, 0x7d, 0x02, 0x6b,
+	0x43, 0x93, 0xe2, 0x56, 0x6e, 0x33, 0xb7, 0x53, 0xdd, 0xff, 0x60, 0x37, 0xb6, 0x93, 0x40, 0xfd,
+	0x11, 0x1d, 0x1d, 0x98, 0x14, 0x1b, 0x02, 0x89, 0x6e, 0x40, 0xd1, 0x22, 0x53, 0x8f, 0xb5, 0x0a,
+	0x9b, 0xb9, 0x9d, 0xba, 0x21, 0x07, 0x9d, 0x3f, 0xe6, 0xe0, 0x56, 0x52, 0x03, 0x9d, 0x10, 0x8f,
+	0x62, 0xf4, 0x29, 0x94, 0x28, 0x33, 0xd9, 0x94, 0x06, 0x4a, 0xee, 0xa4, 0x2a, 0x39, 0x11, 0x10,
+	0x23, 0x80, 0xa2, 0x0f, 0xa0, 0xc2, 0x42, 0x49, 0xad, 0xfc, 0x66, 0x6e, 0x67, 0xcd, 0x98, 0x4d,
+	0x68, 0xf6, 0xf0, 0x35, 0x34, 0xc4, 0x16, 0xfa, 0xbd, 0x77, 0x70, 0xba, 0x7c, 0x54, 0xb2, 0x0b,
+	0x4d, 0x25, 0xf9, 0x2a, 0xa7, 0x6a, 0x40, 0xbe, 0xdf, 0x13, 0xa2, 0x0b, 0x46, 0xbe, 0xdf, 0xd3,
+	0x9c, 0xe3, 0xaf, 0x05, 0xa8, 0xf5, 0xc7, 0x13, 0xe2, 0x33, 0x03, 0xd3, 0xa9, 0xcb, 0xde, 0x4e,
+	0xd7, 0x6d, 0x58, 0x67, 0x26, 0x3d, 0x1f, 0x38, 0x76, 0xa0, 0xb0, 0xc4, 0x87, 0x7d, 0x1b, 0xfd,
+	0x08, 0xaa, 0xb6, 0xc9, 0x4c, 0x8f, 0xd8, 0x98, 0x7f, 0x2c, 0x88, 0x8f, 0x10, 0x4e, 0xf5, 0x6d,
+	0xf4, 0x19, 0x14, 0xb9, 0x0c, 0xdc, 0x5a, 0xdb, 0xcc, 0xed, 0x34, 0xf6, 0x37, 0x53, 0xb5, 0xc9,
+	0x0d, 0x72, 0x9d, 0xd8, 0x90, 0x70, 0xd4, 0x86, 0x32, 0xc5, 0xa3, 0x31, 0xf6, 0x18, 0x6d, 0x15,
+	0x37, 0x0b, 0x3b, 0x05, 0x43, 0x8d, 0xd1, 0xfb, 0x50, 0x36, 0xa7, 0x8c, 0x0c, 0x1c, 0x9b, 0xb6,
+	0x4a, 0xe2, 0xdb, 0x3a, 0x1f, 0xf7, 0x6d, 0x8a, 0xee, 0x40, 0xc5, 0x27, 0x6f, 0x06, 0xd2, 0x10,
+	0xeb, 0x62, 0x37, 0x65, 0x9f, 0xbc, 0xe9, 0xf2, 0x31, 0xfa, 0x09, 0x14, 0x1d, 0xef, 0x15, 0xa1,
+	0xad, 0xf2, 0x66, 0x61, 0xa7, 0xba, 0xff, 0x61, 0xea, 0x5e, 0x7e, 0x81, 0x2f, 0x7f, 0x6d, 0xba,
+	0x53, 0x7c, 0x6c, 0x3a, 0xbe, 0x21, 0xf1, 0xa8, 0x0f, 0x55, 0xeb, 0x0c, 0x5b, 0xe7, 0x13, 0xe2,
+	0xf0, 0xfd, 0x54, 0xc4, 0xf2, 0xed, 0xf8, 0x72, 0x15, 0x42, 0xf2, 0x30, 0x5d, 0x85, 0x37, 0xa2,
+	0x6b, 0x3b, 0xdf, 0xc0, 0x7b, 0x5d, 0xd3, 0xb3, 0xb0, 0x1b, 0x3a, 0xe5, 0x6d, 0xc9, 0xa5, 0x73,
+	0x49, 0xe7, 0x1f, 0x79, 0xa8, 0x3f, 0xbd, 0xb8, 0x9a, 0xf0, 0x6d, 0x68, 0x5a, 0xc4, 0x75, 0xb1,
+	0xc5, 0x1c, 0xe2, 0x0d, 0x3c, 0x73, 0x8c, 0x85, 0x92, 0x8a, 0xd1, 0x98, 0x4d, 0xff, 0xd2, 0x1c,
+	0x0b, 0xe0, 0xc4, 0xf4, 0x99, 0xa3, 0x70, 0xb4, 0x55, 0xd8, 0x2c, 0x70, 0xa0, 0x9a, 0xe6, 0xb8,
+	0x44, 0x0c, 0xae, 0x25, 0x63, 0xf0, 0x0e, 0x54, 0x5e, 0x39, 0x2e, 0x1e, 0xb0, 0xcb, 0x09, 0x6e,
+	0x15, 0x85, 0xa6, 0x32, 0x9f, 0x38, 0xbd, 0x9c, 0x60, 0x74, 0x0b, 0x4a, 0xc3, 0xa9, 0x75, 0x8e,
+	0x59, 0xab, 0x24, 0xbe, 0x04, 0x23, 0x84, 0x60, 0x6d, 0x62, 0xb2, 0x33, 0xe1, 0xe6, 0x8a, 0x21,
+	0xfe, 0x46, 0x3f, 0x85, 0x75, 0x32, 0xe1, 0x5a, 0x57, 0x70, 0x72, 0xb8, 0xa2, 0xf3, 0x7b, 0x68,
+	0x84, 0x86, 0xbb, 0x4a, 0x60, 0x6a, 0x3d, 0x33, 0x84, 0x9b, 0x87, 0x98, 0x49, 0x15, 0x92, 0xec,
+	0xef, 0xde, 0xfb, 0x7f, 0x5e, 0x83, 0x5b, 0x49, 0x25, 0x57, 0xcc, 0x32, 0x4a, 0x47, 0xde, 0xb1,
+	0xd1, 0xe3, 0x30, 0x9e, 0x0b, 0x22, 0x9e, 0xb7, 0x76, 0xd3, 0xcb, 0xcd, 0x6e, 0x74, 0x03, 0x41,
+	0x48, 0x6f, 0x41, 0x3d, 0x42, 0x2a, 0xc7, 0x16, 0x34, 0x28, 0x18, 0xb5, 0xd9, 0x64, 0xdf, 0x4e,
+	0x63, 0x5e, 0x31, 0x2b, 0xf3, 0x4a, 0xcb, 0x99, 0xb7, 0xbe, 0x90, 0x79, 0x65, 0x2d, 0xf3, 0x2a,
+	0xa9, 0xcc, 0x83, 0x08, 0xf3, 0x6e, 0x40, 0x91, 0xaf, 0xa3, 0xad, 0xaa, 0xd8, 0x85, 0x1c, 0xc4,
+	0xf3, 0x51, 0x2d, 0x91, 0x8f, 0xda, 0x50, 0x9e, 0xf8, 0x64, 0xe4, 0x63, 0x4a, 0x5b, 0x75, 0xf9,
+	0x2d, 0x1c, 0xf3, 0x85, 0x96, 0x8f, 0x4d, 0x86, 0x07, 0x8c, 0xb6, 0x1a, 0xf2, 0xa3, 0x9c, 0x38,
+	0xa5, 0xdc, 0x92, 0xd8, 0xf7, 0x89, 0x3f, 0x18, 0x63, 0x4a, 0xcd, 0x11, 0x6e, 0x35, 0xc5, 0x46,
+	0x6a, 0x62, 0xf2, 0x48, 0xce, 0x75, 0xfe, 0x92, 0x83, 0x5b, 0x5f, 0x3a, 0x34, 0xa0, 0xc2, 0xa9,
+	0x49, 0xcf, 0xe9, 0xff, 0x20, 0x21, 0xdc, 0x80, 0xa2, 0xeb, 0x8c, 0x1d, 0x16, 0x94, 0x02, 0x39,
+	0xe8, 0xfc, 0x3d, 0x07, 0xb7, 0xe7, 0xf6, 0x72, 0x15, 0x5a, 0xf6, 0xa0, 0xc8, 0x09, 0x4f, 0x5b,
+	0x79, 0x11, 0xe5, 0xbb, 0x3a, 0x1a, 0xa6, 0x87, 0x82, 0x21, 0x17, 0x77, 0xfe, 0x9d, 0x87, 0x9a,
+	0x8a, 0xf8, 0xff, 0x43, 0x71, 0x7c, 0x1c, 0x2f, 0x8e, 0xab, 0x04, 0xd3, 0xa2, 0xfa, 0xf8, 0x11,
+	0x34, 0x18, 0x61, 0xa6, 0x3b, 0x50, 0x88, 0x92, 0x50, 0x5d, 0x17, 0xb3, 0x27, 0x21, 0x4c, 0x31,
+	0x76, 0x5d, 0xcb, 0xd8, 0xb2, 0xae, 0x82, 0x56, 0x56, 0xab, 0xa0, 0x9d, 0xff, 0xe4, 0xa0, 0x39,
+	0x73, 0xbe, 0x38, 0x09, 0x3a, 0x00, 0x10, 0x67, 0x19, 0x58, 0xc4, 0x96, 0x5c, 0xcc, 0x68, 0x82,
+	0x8a, 0x58, 0xd6, 0x25, 0x76, 0xdc, 0x0c, 0xf9, 0xa5, 0x66, 0x28, 0x2c, 0x34, 0xc3, 0x9a, 0xd6,
+	0x0c, 0xc5, 0x84, 0x19, 0xe6, 0xe2, 0xaf, 0x94, 0x12, 0x7f, 0xdf, 0x15, 0xc2, 0x72, 0xc2, 0x8f,
+	0xdc, 0xf7, 0x5e, 0x91, 0x20, 0x99, 0xe6, 0x54, 0x32, 0x4d, 0x10, 0x24, 0x3f, 0x47, 0x90, 0xb9,
+	0x94, 0x59, 0x48, 0x49, 0x99, 0x5b, 0x50, 0x9f, 0x65, 0x42, 0xde, 0x13, 0xad, 0x09, 0x43, 0xd4,
+	0xd4, 0x24, 0x6f, 0x8c, 0xbe, 0xb7, 0x79, 0x35, 0x96, 0x08, 0xab, 0x89, 0x44, 0xf8, 0x3e, 0x94,
+	0x29, 0x33, 0x7d, 0xc6, 0xbf, 0xc9, 0xec, 0xba, 0x2e, 0xc6, 0xa7, 0x14, 0xfd, 0x2c, 0x8c, 0xad,
+	0xba, 0x88, 0xe4, 0xed, 0xc5, 0xc4, 0x52, 0xac, 0x0c, 0xe3, 0x4b, 0x31, 0xbd, 0xb1, 0x22, 0xd3,
+	0xff, 0x96, 0x83, 0xdb, 0x3d, 0x4c, 0x2d, 0xdf, 0x19, 0xe2, 0x90, 0x63, 0x6f, 0x9f, 0x77, 0x3b,
+	0x10, 0xf5, 0x75, 0xd8, 0xee, 0xc7, 0xe6, 0xd0, 0x0f, 0x01, 0x02, 0x86, 0xf7, 0x7b, 0xb2, 0xfd,
+	0x2a, 0x18, 0x91, 0x99, 0xce, 0x14, 0x9a, 0xc1, 0x46, 0xb8, 0x60, 0x41, 0xc4, 0xa4, 0xd8, 0x5c,
+	0x8a, 0xd8, 0x4d, 0xa8, 0xce, 0x18, 0x14, 0x6a, 0x8e, 0x4e, 0x71, 0x06, 0x28, 0x35, 0x01, 0x33,
+	0x67, 0x13, 0x9d, 0x7f, 0xe5, 0xa1, 0x16, 0xe8, 0xed, 0x8b, 0x2e, 0xba, 0x07, 0x15, 0x7e, 0xa6,
+	0x01, 0xb7, 0x53, 0x60, 0x02, 0xad, 0x57, 0x12, 0x1b, 0x36, 0xca, 0xc3, 0x70, 0xeb, 0x3d, 0xa8,
+	0x3a, 0x9e, 0x8d, 0x2f, 0x06, 0xd2, 0x3d, 0x32, 0xff, 0x27, 0xd2, 0x06, 0xbf, 0xb1, 0xee, 0x2a,
+	0xdd, 0x36, 0xbe, 0x10, 0x32, 0xc0, 0x09, 0xff, 0xa4, 0x08, 0xc3, 0x75, 0x7c, 0xc1, 0x7c, 0x73,
+	0x10, 0x95, 0x55, 0x10, 0xb2, 0x1e, 0x2f, 0xd9, 0x93, 0x10, 0xb0, 0xfb, 0x94, 0xaf, 0x56, 0xb2,
+	0xe9, 0x53, 0x8f, 0xf9, 0x97, 0x46, 0x13, 0xc7, 0x67, 0xdb, 0xdf, 0xc0, 0x8d, 0x34, 0x20, 0xda,
+	0x80, 0xc2, 0x39, 0xbe, 0x0c, 0xcc, 0xce, 0xff, 0x44, 0xfb, 0x50, 0x7c, 0xcd, 0xa9, 0x24, 0xec,
+	0x3c, 0xc7, 0x0d, 0x71, 0xa0, 0xd9, 0x49, 0x24, 0xf4, 0x8b, 0xfc, 0xe7, 0xb9, 0xce, 0x3f, 0xf3,
+	0xd0, 0x9a, 0xa7, 0xdb, 0x55, 0x4a, 0x6b, 0x16, 0xca, 0x8d, 0xa0, 0x1e, 0x38, 0x3a, 0x66, 0xba,
+	0x03, 0x9d, 0xe9, 0x74, 0x3b, 0x8c, 0xd9, 0x54, 0xda, 0xb0, 0x46, 0x23, 0x53, 0x6d, 0x0c, 0xd7,
+	0xe7, 0x20, 0x29, 0xd6, 0xfb, 0x22, 0x6e, 0xbd, 0xbb, 0x59, 0x5c, 0x18, 0xb5, 0xa2, 0x0d, 0x37,
+	0x0e, 0x31, 0xeb, 0xfa, 0xd8, 0xc6, 0x1e, 0x73, 0x4c, 0xf7, 0xed, 0x03, 0xb6, 0x0d, 0xe5, 0x29,
+	0xe5, 0x17, 0x41, 0xd5, 0x21, 0xa9, 0x71, 0xe7, 0x4f, 0x39, 0x71, 0x01, 0x88, 0xaa, 0xb9, 0x8a,
+	0xa3, 0x16, 0xa8, 0x12, 0xad, 0xa5, 0x49, 0xe9, 0x1b, 0xe2, 0xcb, 0x9a, 0x51, 0x31, 0xd4, 0xf8,
+	0xfe, 0x0b, 0xa8, 0x46, 0xaa, 0x29, 0xba, 0x1e, 0x5e, 0x17, 0x8f, 0xb1, 0x67, 0x3b, 0xde, 0x68,
+	0xe3, 0x1a, 0xda, 0x08, 0xdb, 0xa2, 0x9f, 0x9b, 0x8e, 0x8b, 0xed, 0x8d, 0xdc, 0x0c, 0x74, 0xc2,
+	0xd3, 0x2b, 0xb6, 0x37, 0xf2, 0xe8, 0xbd, 0xb0, 0xa2, 0x77, 0xc9, 0x78, 0xe2, 0x62, 0x3e, 0x59,
+	0xd8, 0xff, 0x6e, 0x0b, 0x2a, 0x06, 0x21, 0xac, 0xcb, 0xcd, 0x8d, 0x5c, 0x40, 0xfc, 0xbc, 0x64,
+	0x3c, 0x21, 0x1e, 0xf6, 0xa4, 0x3e, 0x8a, 0x12, 0xcd, 0x5a, 0x30, 0x98, 0x07, 0x06, 0x4e, 0x68,
+	0xdf, 0x4d, 0xc5, 0x27, 0xc0, 0x9d, 0x6b, 0x68, 0x2c, 0xb4, 0x9d, 0x3a, 0x63, 0x7c, 0xea, 0x58,
+	0xe7, 0xdd, 0x33, 0xd3, 0xf3, 0xb0, 0x8b, 0x3e, 0xd1, 0x5c, 0xd3, 0xe7, 0xa1, 0xa1, 0xbe, 0xad,
+	0x54, 0x7d, 0x27, 0xcc, 0x77, 0xbc, 0x51, 0xe8, 0xb1, 0xce, 0x35, 0xf4, 0xad, 0xe0, 0x0c, 0xd7,
+	0xee, 0x50, 0xe6, 0x58, 0x34, 0x54, 0xb8, 0xaf, 0x57, 0x38, 0x07, 0x5e, 0x51, 0xe5, 0x00, 0x36,
+	0xba, 0xa2, 0xf4, 0x75, 0x55, 0x30, 0xa2, 0x87, 0xe9, 0xd6, 0x49, 0xc0, 0x42, 0x45, 0x8b, 0x88,
+	0xd5, 0xb9, 0x86, 0x7e, 0x0b, 0x8d, 0x9e, 0x4f, 0x26, 0x11, 0xf1, 0xf7, 0x53, 0xc5, 0xc7, 0x41,
+	0x19, 0x85, 0x0f, 0xa0, 0xfe, 0xcc, 0xa4, 0x11, 0xd9, 0xf7, 0x52, 0x65, 0xc7, 0x30, 0xa1, 0xe8,
+	0x0f, 0x53, 0xa1, 0x07, 0x84, 0xb8, 0x11, 0xf3, 0xbc, 0x01, 0x14, 0x26, 0x9a, 0x88, 0x96, 0x74,
+	0xba, 0xcd, 0x03, 0x43, 0x55, 0x7b, 0x99, 0xf1, 0x4a, 0xf1, 0x73, 0xa8, 0x4a, 0x83, 0x3f, 0x71,
+	0x1d, 0x93, 0xa2, 0xed, 0x05, 0x2e, 0x11, 0x88, 0x8c, 0x06, 0xfb, 0x15, 0x54, 0xb8, 0xa1, 0xa5,
+	0xd0, 0x8f, 0xb4, 0x8e, 0x58, 0x45, 0xe4, 0x09, 0xc0, 0x13, 0x97, 0x61, 0x5f, 0xca, 0xfc, 0x38,
+	0x55, 0xe6, 0x0c, 0x90, 0x51, 0xa8, 0x07, 0xcd, 0x93, 0x33, 0xde, 0x1b, 0x87, 0xa6, 0xa1, 0xe8,
+	0x41, 0x3a, 0xa1, 0xe3, 0xa8, 0x50, 0xfc, 0xc3, 0x6c, 0x60, 0x65, 0xee, 0x97, 0xd0, 0x14, 0x7b,
+	0x8c, 0x38, 0xf9, 0x81, 0xfe, 0x24, 0x2b, 0xf3, 0xf4, 0x25, 0x34, 0xa5, 0xaf, 0x8e, 0xc3, 0x5e,
+	0x47, 0x23, 0x3e, 0x81, 0xca, 0x28, 0xfe, 0x37, 0x50, 0xe7, 0x5e, 0x9b, 0x09, 0xbf, 0xa7, 0xf5,
+	0xec, 0xaa, 0xa2, 0x5f, 0x42, 0xed, 0x99, 0x49, 0x67, 0x92, 0x77, 0x74, 0x01, 0x36, 0x27, 0x38,
+	0x53, 0x7c, 0x9d, 0x43, 0x83, 0x3b, 0x45, 0x2d, 0xa6, 0x9a, 0xec, 0x10, 0x07, 0x85, 0x2a, 0x1e,
+	0x64, 0xc2, 0x2a, 0x65, 0x18, 0x6a, 0xfc, 0x9b, 0xba, 0xa6, 0xed, 0x68, 0x97, 0x27, 0xba, 0xec,
+	0xf6, 0xbd, 0x0c, 0xc8, 0x48, 0x16, 0x6f, 0xc4, 0x9f, 0x1a, 0xd0, 0x23, 0x5d, 0xf3, 0x90, 0xfa,
+	0xe8, 0xd1, 0xde, 0xcd, 0x0a, 0x57, 0x2a, 0x7f, 0x07, 0xeb, 0xc1, 0x03, 0x40, 0x32, 0x00, 0x13,
+	0x8b, 0xd5, 0xdb, 0x43, 0x7b, 0x7b, 0x29, 0x4e, 0x49, 0x37, 0xe1, 0xe6, 0xf3, 0x89, 0xcd, 0x93,
+	0xbf, 0x2c, 0x31, 0x61, 0x91, 0x4b, 0xd2, 0x4c, 0xd5, 0xa5, 0x04, 0xee, 0x88, 0x8e, 0x96, 0xd1,
+	0xcc, 0x87, 0x1f, 0xf4, 0xbd, 0xd7, 0xa6, 0xeb, 0xd8, 0xb1, 0x1a, 0x73, 0x84, 0x99, 0xd9, 0x35,
+	0xad, 0x33, 0x9c, 0x2c, 0x81, 0xf2, 0x35, 0x29, 0xbe, 0x44, 0x81, 0x33, 0x52, 0xfb, 0x0f, 0x80,
+	0x64, 0x42, 0xf0, 0x5e, 0x39, 0xa3, 0xa9, 0x6f, 0x4a, 0xfe, 0xe9, 0x8a, 0xfb, 0x3c, 0x34, 0x54,
+	0xf3, 0xe3, 0x15, 0x56, 0x44, 0xea, 0x2e, 0x1c, 0x62, 0x76, 0x84, 0x99, 0xef, 0x58, 0xba, 0xac,
+	0x39, 0x03, 0x68, 0x9c, 0x96, 0x82, 0x53, 0x0a, 0x4e, 0xa0, 0x24, 0xdf, 0x03, 0x50, 0x27, 0x75,
+	0x51, 0xec, 0xb1, 0x40, 0xd3, 0x2d, 0xa8, 0x57, 0x9e, 0x48, 0xb8, 0x1e, 0x62, 0x16, 0x79, 0x5b,
+	0xd1, 0x84, 0x6b, 0x1c, 0xb4, 0x38, 0x5c, 0x93, 0x58, 0xa5, 0xcc, 0x83, 0xe6, 0x97, 0x0e, 0x0d,
+	0x3e, 0x8a, 0x1f, 0xf8, 0x34, 0x49, 0x33, 0x81, 0x5a, 0x5c, 0x03, 0xe6, 0xc0, 0x11, 0x8b, 0xd5,
+	0x0c, 0xcc, 0x3f, 0x04, 0x76, 0xd3, 0xb6, 0xfc, 0xd1, 0xc7, 0xaf, 0x65, 0x24, 0x7b, 0x01, 0xb5,
+	0xe8, 0xe3, 0x4c, 0xf2, 0x04, 0x33, 0xa1, 0x29, 0x4f, 0x38, 0xcb, 0xd3, 0x7e, 0x49, 0xb6, 0xcb,
+	0xc9, 0x4a, 0x9e, 0xfc, 0x29, 0x22, 0x94, 0xf7, 0xf1, 0x32, 0x58, 0x34, 0x87, 0xc5, 0x7f, 0xe7,
+	0xd4, 0xe7, 0xb0, 0xd4, 0xf7, 0x87, 0xf6, 0x8a, 0x3f, 0x9f, 0x76, 0xae, 0x21, 0x26, 0xdd, 0x1d,
+	0xf9, 0x3d, 0x17, 0x69, 0x85, 0xa4, 0xff, 0x08, 0x9d, 0xec, 0xb3, 0x16, 0xe0, 0xe7, 0x9d, 0x1e,
+	0x58, 0xf2, 0xee, 0x52, 0x13, 0x65, 0x70, 0xfa, 0xd7, 0xaa, 0xa9, 0x56, 0xf7, 0xb2, 0xa4, 0x8b,
+	0x66, 0xb9, 0x52, 0x41, 0xf8, 0x15, 0x32, 0x83, 0xe4, 0x20, 0x15, 0xbf, 0x6b, 0xc9, 0x03, 0xd8,
+	0xe8, 0x61, 0x7e, 0xe7, 0x8a, 0x48, 0x7e, 0xa8, 0xe9, 0x5b, 0xe3, 0xb0, 0x8c, 0x6c, 0x3d, 0x83,
+	0x3a, 0x77, 0x03, 0x5f, 0xf7, 0x9c, 0x62, 0x9f, 0x6a, 0x9a, 0x94, 0x18, 0x26, 0x14, 0x7d, 0x3f,
+	0x0b, 0x34, 0x92, 0x38, 0xea, 0xb1, 0x3b, 0x71, 0xf2, 0x1c, 0x31, 0x32, 0xce, 0x9f, 0xe3, 0x51,
+	0x46, 0x74, 0x84, 0x43, 0x20, 0xdd, 0x6d, 0x10, 0x17, 0x6b, 0x72, 0xf9, 0x0c, 0x90, 0xd1, 0x5c,
+	0x5f, 0x41, 0x99, 0xf7, 0x6b, 0x42, 0xe4, 0x5d, 0x6d, 0x3b, 0xb7, 0x82, 0xc0, 0x97, 0xd0, 0xfc,
+	0x6a, 0x82, 0x7d, 0x93, 0x61, 0x6e, 0x2f, 0x21, 0x37, 0x3d, 0x9d, 0x26, 0x50, 0x99, 0xaf, 0x62,
+	0x70, 0x82, 0x79, 0xd9, 0x5e, 0x60, 0x84, 0x19, 0x60, 0x71, 0x41, 0x8b, 0xe2, 0xa2, 0x15, 0x53,
+	0xce, 0xf3, 0x8d, 0x2d, 0x54, 0x20, 0x76, 0x9e, 0x41, 0x81, 0xc4, 0x45, 0xaf, 0xc2, 0xc1, 0xd1,
+	0x8f, 0x7d, 0xe7, 0xb5, 0xe3, 0xe2, 0x11, 0xd6, 0x44, 0x40, 0x12, 0x96, 0xd1, 0x44, 0x43, 0xa8,
+	0x4a, 0xc5, 0x87, 0xbe, 0xe9, 0x31, 0xb4, 0x68, 0x6b, 0x02, 0x11, 0x8a, 0xdd, 0x59, 0x0e, 0x54,
+	0x87, 0xb0, 0x00, 0x78, 0x58, 0x1c, 0x13, 0xd7, 0xb1, 0x2e, 0x93, 0x1d, 0xae, 0x4a, 0x0d, 0x33,
+	0x88, 0xa6, 0xc3, 0x4d, 0x45, 0x2a, 0x25, 0x43, 0xa8, 0x8a, 0x7f, 0x46, 0x78, 0x86, 0x4d, 0x97,
+	0x9d, 0xe9, 0x2e, 0xa7, 0x33, 0xc4, 0xe2, 0x83, 0xc4, 0x80, 0xa1, 0x8e, 0x83, 0xcf, 0x5f, 0x7c,
+	0x36, 0x72, 0xd8, 0xd9, 0x74, 0xc8, 0xcd, 0xb8, 0x27, 0xa1, 0x8f, 0x1c, 0x12, 0xfc, 0xb5, 0x17,
+	0x6e, 0x70, 0x4f, 0x88, 0xda, 0x53, 0x41, 0x3a, 0x19, 0x0e, 0x4b, 0x62, 0xea, 0xd3, 0xff, 0x06,
+	0x00, 0x00, 0xff, 0xff, 0xff, 0x77, 0x8b, 0x79, 0xc9, 0x24, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -788,28 +1694,28 @@ type RootCoordClient interface {
 	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
 	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
 	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	//*
+	//
 	// @brief This method is used to create collection
 	//
 	// @param CreateCollectionRequest, use to provide collection information to be created.
 	//
 	// @return Status
 	CreateCollection(ctx context.Context, in *milvuspb.CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to delete collection.
 	//
 	// @param DropCollectionRequest, collection name is going to be deleted.
 	//
 	// @return Status
 	DropCollection(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to test collection existence.
 	//
 	// @param HasCollectionRequest, collection name is going to be tested.
 	//
 	// @return BoolResponse
 	HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error)
-	//*
+	//
 	// @brief This method is used to get collection schema.
 	//
 	// @param DescribeCollectionRequest, target collection name.
@@ -819,28 +1725,28 @@ type RootCoordClient interface {
 	CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to list all collections.
 	//
 	// @return StringListResponse, collection name list
 	ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error)
 	AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to create partition
 	//
 	// @return Status
 	CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to drop partition
 	//
 	// @return Status
 	DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to test partition existence.
 	//
 	// @return BoolResponse
 	HasPartition(ctx context.Context, in *milvuspb.HasPartitionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error)
-	//*
+	//
 	// @brief This method is used to show partition information
 	//
 	// @param ShowPartitionRequest, target collection name.
@@ -861,6 +1767,11 @@ type RootCoordClient interface {
 	GetImportState(ctx context.Context, in *milvuspb.GetImportStateRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error)
 	ListImportTasks(ctx context.Context, in *milvuspb.ListImportTasksRequest, opts ...grpc.CallOption) (*milvuspb.ListImportTasksResponse, error)
 	ReportImport(ctx context.Context, in *ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CancelImport(ctx context.Context, in *CancelImportRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	GetExportState(ctx context.Context, in *GetExportStateRequest, opts ...grpc.CallOption) (*GetExportStateResponse, error)
+	ListExportTasks(ctx context.Context, in *ListExportTasksRequest, opts ...grpc.CallOption) (*ListExportTasksResponse, error)
+	ReportExport(ctx context.Context, in *ExportResult, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+27+--+Support+Basic+Authentication
 	CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error)
 	UpdateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error)
@@ -1131,6 +2042,51 @@ func (c *rootCoordClient) ReportImport(ctx context.Context, in *ImportResult, op
 	return out, nil
 }
 
+func (c *rootCoordClient) CancelImport(ctx context.Context, in *CancelImportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CancelImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
+	out := new(ExportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) GetExportState(ctx context.Context, in *GetExportStateRequest, opts ...grpc.CallOption) (*GetExportStateResponse, error) {
+	out := new(GetExportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetExportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListExportTasks(ctx context.Context, in *ListExportTasksRequest, opts ...grpc.CallOption) (*ListExportTasksResponse, error) {
+	out := new(ListExportTasksResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListExportTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ReportExport(ctx context.Context, in *ExportResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ReportExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateCredential", in, out, opts...)
@@ -1262,28 +2218,28 @@ type RootCoordServer interface {
 	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
 	GetTimeTickChannel(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)
 	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
-	//*
+	//
 	// @brief This method is used to create collection
 	//
 	// @param CreateCollectionRequest, use to provide collection information to be created.
 	//
 	// @return Status
 	CreateCollection(context.Context, *milvuspb.CreateCollectionRequest) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to delete collection.
 	//
 	// @param DropCollectionRequest, collection name is going to be deleted.
 	//
 	// @return Status
 	DropCollection(context.Context, *milvuspb.DropCollectionRequest) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to test collection existence.
 	//
 	// @param HasCollectionRequest, collection name is going to be tested.
 	//
 	// @return BoolResponse
 	HasCollection(context.Context, *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error)
-	//*
+	//
 	// @brief This method is used to get collection schema.
 	//
 	// @param DescribeCollectionRequest, target collection name.
@@ -1293,28 +2249,28 @@ type RootCoordServer interface {
 	CreateAlias(context.Context, *milvuspb.CreateAliasRequest) (*commonpb.Status, error)
 	DropAlias(context.Context, *milvuspb.DropAliasRequest) (*commonpb.Status, error)
 	AlterAlias(context.Context, *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to list all collections.
 	//
 	// @return StringListResponse, collection name list
 	ShowCollections(context.Context, *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error)
 	AlterCollection(context.Context, *milvuspb.AlterCollectionRequest) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to create partition
 	//
 	// @return Status
 	CreatePartition(context.Context, *milvuspb.CreatePartitionRequest) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to drop partition
 	//
 	// @return Status
 	DropPartition(context.Context, *milvuspb.DropPartitionRequest) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to test partition existence.
 	//
 	// @return BoolResponse
 	HasPartition(context.Context, *milvuspb.HasPartitionRequest) (*milvuspb.BoolResponse, error)
-	//*
+	//
 	// @brief This method is used to show partition information
 	//
 	// @param ShowPartitionRequest, target collection name.
@@ -1335,6 +2291,11 @@ type RootCoordServer interface {
 	GetImportState(context.Context, *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error)
 	ListImportTasks(context.Context, *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error)
 	ReportImport(context.Context, *ImportResult) (*commonpb.Status, error)
+	CancelImport(context.Context, *CancelImportRequest) (*commonpb.Status, error)
+	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	GetExportState(context.Context, *GetExportStateRequest) (*GetExportStateResponse, error)
+	ListExportTasks(context.Context, *ListExportTasksRequest) (*ListExportTasksResponse, error)
+	ReportExport(context.Context, *ExportResult) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+27+--+Support+Basic+Authentication
 	CreateCredential(context.Context, *internalpb.CredentialInfo) (*commonpb.Status, error)
 	UpdateCredential(context.Context, *internalpb.CredentialInfo) (*commonpb.Status, error)
@@ -1439,6 +2400,21 @@ func (*UnimplementedRootCoordServer) ListImportTasks(ctx context.Context, req *m
 func (*UnimplementedRootCoordServer) ReportImport(ctx context.Context, req *ImportResult) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportImport not implemented")
 }
+func (*UnimplementedRootCoordServer) CancelImport(ctx context.Context, req *CancelImportRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelImport not implemented")
+}
+func (*UnimplementedRootCoordServer) Export(ctx context.Context, req *ExportRequest) (*ExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedRootCoordServer) GetExportState(ctx context.Context, req *GetExportStateRequest) (*GetExportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExportState not implemented")
+}
+func (*UnimplementedRootCoordServer) ListExportTasks(ctx context.Context, req *ListExportTasksRequest) (*ListExportTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExportTasks not implemented")
+}
+func (*UnimplementedRootCoordServer) ReportExport(ctx context.Context, req *ExportResult) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportExport not implemented")
+}
 func (*UnimplementedRootCoordServer) CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
 }
@@ -1972,6 +2948,96 @@ func _RootCoord_ReportImport_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_CancelImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CancelImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CancelImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CancelImport(ctx, req.(*CancelImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).Export(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_GetExportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).GetExportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetExportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).GetExportState(ctx, req.(*GetExportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListExportTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExportTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListExportTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListExportTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListExportTasks(ctx, req.(*ListExportTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ReportExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportResult)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ReportExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ReportExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ReportExport(ctx, req.(*ExportResult))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(internalpb.CredentialInfo)
 	if err := dec(in); err != nil {
@@ -2336,6 +3402,26 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReportImport",
 			Handler:    _RootCoord_ReportImport_Handler,
 		},
+		{
+			MethodName: "CancelImport",
+			Handler:    _RootCoord_CancelImport_Handler,
+		},
+		{
+			MethodName: "Export",
+			Handler:    _RootCoord_Export_Handler,
+		},
+		{
+			MethodName: "GetExportState",
+			Handler:    _RootCoord_GetExportState_Handler,
+		},
+		{
+			MethodName: "ListExportTasks",
+			Handler:    _RootCoord_ListExportTasks_Handler,
+		},
+		{
+			MethodName: "ReportExport",
+			Handler:    _RootCoord_ReportExport_Handler,
+		},
 		{
 			MethodName: "CreateCredential",
 			Handler:    _RootCoord_CreateCredential_Handler,
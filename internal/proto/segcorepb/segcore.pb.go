@@ -76,6 +76,54 @@ func (m *RetrieveResults) GetFieldsData() []*schemapb.FieldData {
 	return nil
 }
 
+// FieldValidData marks which rows of a nullable field hold a value, false means null.
+type FieldValidData struct {
+	FieldId              int64    `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	ValidData            []bool   `protobuf:"varint,2,rep,packed,name=valid_data,json=validData,proto3" json:"valid_data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldValidData) Reset()         { *m = FieldValidData{} }
+func (m *FieldValidData) String() string { return proto.CompactTextString(m) }
+func (*FieldValidData) ProtoMessage()    {}
+func (*FieldValidData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d79fce784797357, []int{1}
+}
+
+func (m *FieldValidData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldValidData.Unmarshal(m, b)
+}
+func (m *FieldValidData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldValidData.Marshal(b, m, deterministic)
+}
+func (m *FieldValidData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldValidData.Merge(m, src)
+}
+func (m *FieldValidData) XXX_Size() int {
+	return xxx_messageInfo_FieldValidData.Size(m)
+}
+func (m *FieldValidData) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldValidData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldValidData proto.InternalMessageInfo
+
+func (m *FieldValidData) GetFieldId() int64 {
+	if m != nil {
+		return m.FieldId
+	}
+	return 0
+}
+
+func (m *FieldValidData) GetValidData() []bool {
+	if m != nil {
+		return m.ValidData
+	}
+	return nil
+}
+
 type LoadFieldMeta struct {
 	MinTimestamp         int64    `protobuf:"varint,1,opt,name=min_timestamp,json=minTimestamp,proto3" json:"min_timestamp,omitempty"`
 	MaxTimestamp         int64    `protobuf:"varint,2,opt,name=max_timestamp,json=maxTimestamp,proto3" json:"max_timestamp,omitempty"`
@@ -89,7 +137,7 @@ func (m *LoadFieldMeta) Reset()         { *m = LoadFieldMeta{} }
 func (m *LoadFieldMeta) String() string { return proto.CompactTextString(m) }
 func (*LoadFieldMeta) ProtoMessage()    {}
 func (*LoadFieldMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1d79fce784797357, []int{1}
+	return fileDescriptor_1d79fce784797357, []int{2}
 }
 
 func (m *LoadFieldMeta) XXX_Unmarshal(b []byte) error {
@@ -144,7 +192,7 @@ func (m *LoadSegmentMeta) Reset()         { *m = LoadSegmentMeta{} }
 func (m *LoadSegmentMeta) String() string { return proto.CompactTextString(m) }
 func (*LoadSegmentMeta) ProtoMessage()    {}
 func (*LoadSegmentMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1d79fce784797357, []int{2}
+	return fileDescriptor_1d79fce784797357, []int{3}
 }
 
 func (m *LoadSegmentMeta) XXX_Unmarshal(b []byte) error {
@@ -182,6 +230,7 @@ func (m *LoadSegmentMeta) GetTotalSize() int64 {
 type InsertRecord struct {
 	FieldsData           []*schemapb.FieldData `protobuf:"bytes,1,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
 	NumRows              int64                 `protobuf:"varint,2,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	ValidData            []*FieldValidData     `protobuf:"bytes,3,rep,name=valid_data,json=validData,proto3" json:"valid_data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -191,7 +240,7 @@ func (m *InsertRecord) Reset()         { *m = InsertRecord{} }
 func (m *InsertRecord) String() string { return proto.CompactTextString(m) }
 func (*InsertRecord) ProtoMessage()    {}
 func (*InsertRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1d79fce784797357, []int{3}
+	return fileDescriptor_1d79fce784797357, []int{4}
 }
 
 func (m *InsertRecord) XXX_Unmarshal(b []byte) error {
@@ -226,8 +275,16 @@ func (m *InsertRecord) GetNumRows() int64 {
 	return 0
 }
 
+func (m *InsertRecord) GetValidData() []*FieldValidData {
+	if m != nil {
+		return m.ValidData
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RetrieveResults)(nil), "milvus.proto.segcore.RetrieveResults")
+	proto.RegisterType((*FieldValidData)(nil), "milvus.proto.segcore.FieldValidData")
 	proto.RegisterType((*LoadFieldMeta)(nil), "milvus.proto.segcore.LoadFieldMeta")
 	proto.RegisterType((*LoadSegmentMeta)(nil), "milvus.proto.segcore.LoadSegmentMeta")
 	proto.RegisterType((*InsertRecord)(nil), "milvus.proto.segcore.InsertRecord")
@@ -236,28 +293,32 @@ func init() {
 func init() { proto.RegisterFile("segcore.proto", fileDescriptor_1d79fce784797357) }
 
 var fileDescriptor_1d79fce784797357 = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0xc1, 0x4b, 0xeb, 0x40,
-	0x10, 0xc6, 0x49, 0xc3, 0xeb, 0x6b, 0xb7, 0x2d, 0x85, 0xf0, 0x78, 0x44, 0x45, 0x29, 0xe9, 0xa5,
-	0x08, 0x26, 0x50, 0x45, 0xf0, 0x24, 0x68, 0x11, 0x0a, 0x7a, 0xd9, 0x7a, 0xf2, 0x12, 0xb6, 0xc9,
-	0xb4, 0x5d, 0xcd, 0xee, 0x96, 0xdd, 0x49, 0x53, 0xfa, 0x87, 0xf8, 0xf7, 0x4a, 0x36, 0x11, 0xad,
-	0xf4, 0xe2, 0x6d, 0xe7, 0xdb, 0xdf, 0xcc, 0x37, 0xf3, 0x91, 0x9e, 0x81, 0x65, 0xa2, 0x34, 0x84,
-	0x6b, 0xad, 0x50, 0x79, 0xff, 0x04, 0xcf, 0x36, 0xb9, 0xa9, 0xaa, 0xb0, 0xfe, 0x3b, 0xee, 0x9a,
-	0x64, 0x05, 0x82, 0x55, 0x6a, 0xf0, 0xee, 0x90, 0x3e, 0x05, 0xd4, 0x1c, 0x36, 0x40, 0xc1, 0xe4,
-	0x19, 0x1a, 0xef, 0x9c, 0xb8, 0x3c, 0x35, 0xbe, 0x33, 0x70, 0x46, 0x9d, 0xb1, 0x1f, 0xee, 0x4f,
-	0xa9, 0x9a, 0xa7, 0x13, 0x43, 0x4b, 0xc8, 0xfb, 0x4f, 0x9a, 0x6a, 0xb1, 0x30, 0x80, 0x7e, 0x63,
-	0xe0, 0x8e, 0x5c, 0x5a, 0x57, 0xde, 0x2d, 0xe9, 0x2c, 0x38, 0x64, 0xa9, 0x89, 0x53, 0x86, 0xcc,
-	0x77, 0x07, 0xee, 0xa8, 0x33, 0x3e, 0x3b, 0x38, 0xeb, 0xa1, 0xe4, 0x26, 0x0c, 0x19, 0x25, 0x55,
-	0x4b, 0xf9, 0x0e, 0x36, 0xa4, 0xf7, 0xa8, 0x58, 0x6a, 0x3f, 0x9f, 0x00, 0x99, 0x37, 0x24, 0x3d,
-	0xc1, 0x65, 0x8c, 0x5c, 0x80, 0x41, 0x26, 0xd6, 0x76, 0x3f, 0x97, 0x76, 0x05, 0x97, 0xcf, 0x9f,
-	0x9a, 0x85, 0xd8, 0xf6, 0x1b, 0xd4, 0xa8, 0x21, 0xb6, 0xfd, 0x82, 0x4e, 0x48, 0x5b, 0xab, 0x22,
-	0x4e, 0x54, 0x2e, 0xd1, 0x77, 0x2d, 0xd0, 0xd2, 0xaa, 0xb8, 0x2f, 0xeb, 0xe0, 0x8d, 0xf4, 0x4b,
-	0xdf, 0x19, 0x2c, 0x05, 0x48, 0xb4, 0xce, 0x37, 0xe4, 0x8f, 0x00, 0x64, 0x65, 0x22, 0xe5, 0x15,
-	0xc3, 0xf0, 0x50, 0xae, 0xe1, 0xde, 0xb6, 0xb4, 0xea, 0xf0, 0x4e, 0x09, 0x41, 0x85, 0x2c, 0x8b,
-	0x0d, 0xdf, 0x41, 0xbd, 0x4c, 0xdb, 0x2a, 0x33, 0xbe, 0x83, 0xe0, 0x95, 0x74, 0xa7, 0xd2, 0x80,
-	0x46, 0x0a, 0x89, 0xd2, 0xe9, 0xcf, 0xd4, 0x9c, 0xdf, 0xa6, 0xe6, 0x1d, 0x91, 0x96, 0xcc, 0x45,
-	0xac, 0x55, 0x61, 0x6a, 0xb7, 0xbf, 0x32, 0x17, 0x54, 0x15, 0xe6, 0xee, 0xfa, 0xe5, 0x6a, 0xc9,
-	0x71, 0x95, 0xcf, 0xc3, 0x44, 0x89, 0xa8, 0x1a, 0x79, 0xc1, 0x55, 0xfd, 0x8a, 0xb8, 0x44, 0xd0,
-	0x92, 0x65, 0x91, 0x75, 0x89, 0xea, 0xab, 0xd6, 0xf3, 0x79, 0xd3, 0x0a, 0x97, 0x1f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xd6, 0x48, 0x04, 0x17, 0x5d, 0x02, 0x00, 0x00,
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0x4d, 0x8b, 0x13, 0x41,
+	0x10, 0x65, 0x76, 0x70, 0x37, 0xa9, 0x24, 0x2e, 0x0c, 0x22, 0xa3, 0xa2, 0x84, 0x59, 0x0f, 0x41,
+	0x70, 0x02, 0xab, 0x08, 0x9e, 0x04, 0x77, 0x11, 0x22, 0x7a, 0xe9, 0x15, 0x0f, 0x5e, 0x86, 0xce,
+	0x4c, 0x25, 0xdb, 0x38, 0xdd, 0x1d, 0xba, 0x6b, 0x26, 0xcb, 0xfe, 0x10, 0xff, 0x86, 0x7f, 0x51,
+	0xfa, 0x63, 0x71, 0x47, 0x72, 0xf1, 0xd6, 0x55, 0xf5, 0xde, 0xab, 0xea, 0xf7, 0x60, 0x66, 0x71,
+	0x5b, 0x6b, 0x83, 0xe5, 0xce, 0x68, 0xd2, 0xd9, 0x23, 0x29, 0xda, 0xbe, 0xb3, 0xa1, 0x2a, 0xe3,
+	0xec, 0xe9, 0xd4, 0xd6, 0xd7, 0x28, 0x79, 0xe8, 0x16, 0xbf, 0x12, 0x38, 0x65, 0x48, 0x46, 0x60,
+	0x8f, 0x0c, 0x6d, 0xd7, 0x92, 0xcd, 0x5e, 0x41, 0x2a, 0x1a, 0x9b, 0x27, 0xf3, 0x64, 0x31, 0x39,
+	0xcf, 0xcb, 0xa1, 0x4a, 0x20, 0xaf, 0x2e, 0x2d, 0x73, 0xa0, 0xec, 0x31, 0x1c, 0xeb, 0xcd, 0xc6,
+	0x22, 0xe5, 0x47, 0xf3, 0x74, 0x91, 0xb2, 0x58, 0x65, 0x1f, 0x60, 0xb2, 0x11, 0xd8, 0x36, 0xb6,
+	0x6a, 0x38, 0xf1, 0x3c, 0x9d, 0xa7, 0x8b, 0xc9, 0xf9, 0x8b, 0x83, 0x5a, 0x9f, 0x1c, 0xee, 0x92,
+	0x13, 0x67, 0x10, 0x28, 0xee, 0x5d, 0x7c, 0x86, 0x87, 0x7e, 0xf0, 0x9d, 0xb7, 0xc2, 0x4f, 0xb3,
+	0x27, 0x30, 0xf2, 0xf3, 0x4a, 0x34, 0xfe, 0xb6, 0x94, 0x9d, 0xf8, 0x7a, 0xd5, 0x64, 0xcf, 0x01,
+	0x7a, 0x87, 0x0b, 0xcb, 0xdc, 0x25, 0x23, 0x36, 0xee, 0xef, 0x98, 0x45, 0x0f, 0xb3, 0x2f, 0x9a,
+	0x37, 0x5e, 0xef, 0x2b, 0x12, 0xcf, 0xce, 0x60, 0x26, 0x85, 0xaa, 0x48, 0x48, 0xb4, 0xc4, 0xe5,
+	0x2e, 0xea, 0x4d, 0xa5, 0x50, 0xdf, 0xee, 0x7a, 0x1e, 0xc4, 0x6f, 0xee, 0x81, 0x8e, 0x22, 0x88,
+	0xdf, 0xfc, 0x05, 0x3d, 0x83, 0xb1, 0xd1, 0xfb, 0xaa, 0xd6, 0x9d, 0xa2, 0x3c, 0xf5, 0x80, 0x91,
+	0xd1, 0xfb, 0x0b, 0x57, 0x17, 0x3f, 0xe1, 0xd4, 0xed, 0xbd, 0xc2, 0xad, 0x44, 0x45, 0x7e, 0xf3,
+	0x7b, 0x78, 0x20, 0x91, 0xb8, 0x73, 0xd7, 0x39, 0x72, 0x56, 0x1e, 0xca, 0xa8, 0x1c, 0x5c, 0xcb,
+	0x02, 0xc3, 0x7d, 0x92, 0x34, 0xf1, 0xb6, 0xb2, 0xe2, 0x16, 0xe3, 0x31, 0x63, 0xdf, 0xb9, 0x12,
+	0xb7, 0x58, 0xfc, 0x4e, 0x60, 0xba, 0x52, 0x16, 0x0d, 0x31, 0xac, 0xb5, 0x69, 0xfe, 0x8d, 0x20,
+	0xf9, 0xdf, 0x08, 0x9c, 0xe1, 0xaa, 0x93, 0x95, 0xd1, 0x7b, 0x1b, 0xd7, 0x9d, 0xa8, 0x4e, 0x32,
+	0xbd, 0xb7, 0xd9, 0xc5, 0xc0, 0xf0, 0x90, 0xee, 0xcb, 0xc3, 0x7f, 0x19, 0xa6, 0x78, 0x2f, 0x96,
+	0x8f, 0xef, 0x7e, 0xbc, 0xdd, 0x0a, 0xba, 0xee, 0xd6, 0x65, 0xad, 0xe5, 0x32, 0x90, 0x5f, 0x0b,
+	0x1d, 0x5f, 0x4b, 0xa1, 0x08, 0x8d, 0xe2, 0xed, 0xd2, 0xeb, 0x2d, 0xa3, 0xde, 0x6e, 0xbd, 0x3e,
+	0xf6, 0x8d, 0x37, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2d, 0xac, 0x37, 0xf6, 0xef, 0x02, 0x00,
+	0x00,
 }
@@ -264,6 +264,14 @@ func (coord *DataCoordMock) Import(ctx context.Context, req *datapb.ImportTaskRe
 	return &datapb.ImportTaskResponse{}, nil
 }
 
+func (coord *DataCoordMock) Export(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+	return &datapb.ExportTaskResponse{}, nil
+}
+
+func (coord *DataCoordMock) CancelImport(ctx context.Context, req *datapb.CancelImportTaskRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
 func (coord *DataCoordMock) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	return &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
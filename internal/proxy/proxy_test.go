@@ -3948,6 +3948,47 @@ func TestProxy_ListImportTasks(t *testing.T) {
 	})
 }
 
+func TestProxy_Export(t *testing.T) {
+	rootCoord := &RootCoordMock{}
+	rootCoord.state.Store(commonpb.StateCode_Healthy)
+	t.Run("test export", func(t *testing.T) {
+		proxy := &Proxy{rootCoord: rootCoord}
+		proxy.stateCode.Store(commonpb.StateCode_Healthy)
+
+		resp, err := proxy.Export(context.TODO(), &rootcoordpb.ExportRequest{CollectionName: "dummy"})
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Nil(t, err)
+
+		resp, err = proxy.Export(context.TODO(), &rootcoordpb.ExportRequest{CollectionName: "1bad name"})
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Nil(t, err)
+
+		state, err := proxy.GetExportState(context.TODO(), &rootcoordpb.GetExportStateRequest{TaskId: 1})
+		assert.EqualValues(t, commonpb.ErrorCode_Success, state.GetStatus().GetErrorCode())
+		assert.Nil(t, err)
+
+		tasks, err := proxy.ListExportTasks(context.TODO(), &rootcoordpb.ListExportTasksRequest{})
+		assert.EqualValues(t, commonpb.ErrorCode_Success, tasks.GetStatus().GetErrorCode())
+		assert.Nil(t, err)
+	})
+	t.Run("test export with unhealthy", func(t *testing.T) {
+		proxy := &Proxy{rootCoord: rootCoord}
+		proxy.stateCode.Store(commonpb.StateCode_Abnormal)
+
+		resp, err := proxy.Export(context.TODO(), &rootcoordpb.ExportRequest{CollectionName: "dummy"})
+		assert.EqualValues(t, unhealthyStatus(), resp.GetStatus())
+		assert.Nil(t, err)
+
+		state, err := proxy.GetExportState(context.TODO(), &rootcoordpb.GetExportStateRequest{TaskId: 1})
+		assert.EqualValues(t, unhealthyStatus(), state.GetStatus())
+		assert.Nil(t, err)
+
+		tasks, err := proxy.ListExportTasks(context.TODO(), &rootcoordpb.ListExportTasksRequest{})
+		assert.EqualValues(t, unhealthyStatus(), tasks.GetStatus())
+		assert.Nil(t, err)
+	})
+}
+
 func TestProxy_GetStatistics(t *testing.T) {
 
 }
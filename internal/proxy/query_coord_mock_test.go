@@ -423,6 +423,19 @@ func (coord *QueryCoordMock) GetShardLeaders(ctx context.Context, req *querypb.G
 	}, nil
 }
 
+func (coord *QueryCoordMock) UpdateCollectionSchema(ctx context.Context, req *querypb.UpdateCollectionSchemaRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "unhealthy",
+		}, nil
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 func NewQueryCoordMock(opts ...QueryCoordMockOption) *QueryCoordMock {
 	coord := &QueryCoordMock{
 		nodeID:              UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt()),
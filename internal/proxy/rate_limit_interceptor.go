@@ -132,7 +132,8 @@ func getFailedResponse(req interface{}, code commonpb.ErrorCode, reason string)
 		*milvuspb.LoadCollectionRequest, *milvuspb.ReleaseCollectionRequest,
 		*milvuspb.CreatePartitionRequest, *milvuspb.DropPartitionRequest,
 		*milvuspb.LoadPartitionsRequest, *milvuspb.ReleasePartitionsRequest,
-		*milvuspb.CreateIndexRequest, *milvuspb.DropIndexRequest:
+		*milvuspb.CreateIndexRequest, *milvuspb.DropIndexRequest,
+		*milvuspb.CreateAliasRequest, *milvuspb.DropAliasRequest, *milvuspb.AlterAliasRequest:
 		return failedStatus(code, reason), nil
 	case *milvuspb.FlushRequest:
 		return &milvuspb.FlushResponse{
@@ -104,6 +104,7 @@ func TestRateLimitInterceptor(t *testing.T) {
 		testGetFailedResponse(&milvuspb.CreateCollectionRequest{})
 		testGetFailedResponse(&milvuspb.FlushRequest{})
 		testGetFailedResponse(&milvuspb.ManualCompactionRequest{})
+		testGetFailedResponse(&milvuspb.CreateAliasRequest{})
 
 		// test illegal
 		_, err := getFailedResponse(&milvuspb.SearchResults{}, commonpb.ErrorCode_UnexpectedError, "mock")
@@ -0,0 +1,57 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+)
+
+// ReadOnlyInterceptor returns a new unary server interceptor that rejects all writes
+// while the cluster is the read-only standby of a replication.
+func ReadOnlyInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if isWriteRequest(req) && Params.ReplicationCfg.ReadOnly.GetAsBool() {
+			res, err := getFailedResponse(req, commonpb.ErrorCode_ForceDeny,
+				fmt.Sprintf("%s is rejected, the cluster is a read-only replication standby.", info.FullMethod))
+			if err == nil {
+				return res, nil
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// isWriteRequest returns true if the request changes data or collection definitions,
+// which can only come from the primary cluster through replication on a standby.
+func isWriteRequest(req interface{}) bool {
+	switch req.(type) {
+	case *milvuspb.InsertRequest, *milvuspb.DeleteRequest, *milvuspb.ImportRequest,
+		*milvuspb.CreateCollectionRequest, *milvuspb.DropCollectionRequest,
+		*milvuspb.CreatePartitionRequest, *milvuspb.DropPartitionRequest,
+		*milvuspb.CreateIndexRequest, *milvuspb.DropIndexRequest,
+		*milvuspb.CreateAliasRequest, *milvuspb.DropAliasRequest, *milvuspb.AlterAliasRequest,
+		*milvuspb.FlushRequest, *milvuspb.ManualCompactionRequest:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+)
+
+func TestReadOnlyInterceptor(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+		}, nil
+	}
+	serverInfo := &grpc.UnaryServerInfo{FullMethod: "MockFullMethod"}
+	interceptorFun := ReadOnlyInterceptor()
+
+	rsp, err := interceptorFun(context.Background(), &milvuspb.InsertRequest{}, serverInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, rsp.(*milvuspb.MutationResult).GetStatus().GetErrorCode())
+
+	Params.Save("replication.readOnly", "true")
+	defer Params.Remove("replication.readOnly")
+
+	rsp, err = interceptorFun(context.Background(), &milvuspb.InsertRequest{}, serverInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_ForceDeny, rsp.(*milvuspb.MutationResult).GetStatus().GetErrorCode())
+
+	rsp, err = interceptorFun(context.Background(), &milvuspb.DropAliasRequest{}, serverInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_ForceDeny, rsp.(*commonpb.Status).GetErrorCode())
+
+	// reads are still served by the standby
+	rsp, err = interceptorFun(context.Background(), &milvuspb.SearchRequest{}, serverInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, rsp.(*milvuspb.MutationResult).GetStatus().GetErrorCode())
+}
+
+func TestIsWriteRequest(t *testing.T) {
+	assert.True(t, isWriteRequest(&milvuspb.InsertRequest{}))
+	assert.True(t, isWriteRequest(&milvuspb.DropCollectionRequest{}))
+	assert.True(t, isWriteRequest(&milvuspb.AlterAliasRequest{}))
+	assert.False(t, isWriteRequest(&milvuspb.QueryRequest{}))
+	assert.False(t, isWriteRequest(&milvuspb.LoadCollectionRequest{}))
+	assert.False(t, isWriteRequest(nil))
+}
@@ -198,7 +198,7 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 			return err
 		}
 		// validate vector field type parameters
-		if field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector {
+		if typeutil.IsDenseVectorType(field.DataType) {
 			err = validateDimension(field)
 			if err != nil {
 				return err
@@ -212,6 +212,10 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 				return err
 			}
 		}
+		// validate nullable and default value parameters
+		if err := validateNullableAndDefaultValue(field); err != nil {
+			return err
+		}
 	}
 
 	if err := validateMultipleVectorFields(cct.schema); err != nil {
@@ -708,6 +712,13 @@ func (act *alterCollectionTask) PreExecute(ctx context.Context) error {
 	act.Base.MsgType = commonpb.MsgType_AlterCollection
 	act.Base.SourceID = paramtable.GetNodeID()
 
+	addedField, _, err := typeutil.SplitAddedField(act.GetProperties())
+	if err != nil {
+		return err
+	}
+	if addedField != nil {
+		return validateAddedField(act.GetCollectionName(), addedField)
+	}
 	return nil
 }
 
@@ -1339,7 +1350,7 @@ func (lct *loadCollectionTask) Execute(ctx context.Context) (err error) {
 	for _, index := range indexResponse.IndexInfos {
 		fieldIndexIDs[index.FieldID] = index.IndexID
 		for _, field := range collSchema.Fields {
-			if index.FieldID == field.FieldID && (field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector) {
+			if index.FieldID == field.FieldID && typeutil.IsVectorType(field.DataType) {
 				hasVecIndex = true
 			}
 		}
@@ -1557,7 +1568,7 @@ func (lpt *loadPartitionsTask) Execute(ctx context.Context) error {
 	for _, index := range indexResponse.IndexInfos {
 		fieldIndexIDs[index.FieldID] = index.IndexID
 		for _, field := range collSchema.Fields {
-			if index.FieldID == field.FieldID && (field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector) {
+			if index.FieldID == field.FieldID && typeutil.IsVectorType(field.DataType) {
 				hasVecIndex = true
 			}
 		}
@@ -120,6 +120,57 @@ func (t *calcDistanceTask) arrangeVectorsByStrID(inputIds []string, sequence map
 	return nil, errors.New("unsupported vector type")
 }
 
+// arrangeSparseFloatVectors re-arranges the retrieved sparse float vector rows by the order of input ids,
+// sparse float vectors are retrieved as bytes data since the VectorField has no room for them.
+func (t *calcDistanceTask) arrangeSparseFloatVectors(ids *milvuspb.VectorIDs, retrievedFields []*schemapb.FieldData) ([][]byte, error) {
+	var retrievedRows [][]byte
+	sequence := make(map[interface{}]int)
+	for _, fieldData := range retrievedFields {
+		if fieldData.FieldName == ids.FieldName {
+			retrievedRows = typeutil.GetSparseFloatVectorRows(fieldData)
+		}
+		switch fieldData.Type {
+		case schemapb.DataType_Int64:
+			for index, id := range fieldData.GetScalars().GetLongData().GetData() {
+				sequence[id] = index
+			}
+		case schemapb.DataType_VarChar, schemapb.DataType_String:
+			for index, id := range fieldData.GetScalars().GetStringData().GetData() {
+				sequence[id] = index
+			}
+		}
+	}
+
+	var inputIds []interface{}
+	for _, id := range ids.IdArray.GetIntId().GetData() {
+		inputIds = append(inputIds, id)
+	}
+	for _, id := range ids.IdArray.GetStrId().GetData() {
+		inputIds = append(inputIds, id)
+	}
+
+	result := make([][]byte, 0, len(inputIds))
+	for _, id := range inputIds {
+		index, ok := sequence[id]
+		if !ok || index >= len(retrievedRows) {
+			log.Error("id not found in CalcDistance", zap.Any("id", id))
+			return nil, errors.New("failed to fetch vectors by id: " + fmt.Sprintln(id))
+		}
+		result = append(result, retrievedRows[index])
+	}
+	return result, nil
+}
+
+// isSparseFloatVectorField returns true if the retrieved field with the given name is a sparse float vector field.
+func isSparseFloatVectorField(fieldName string, retrievedFields []*schemapb.FieldData) bool {
+	for _, fieldData := range retrievedFields {
+		if fieldData.FieldName == fieldName {
+			return typeutil.IsSparseFloatVectorType(fieldData.Type)
+		}
+	}
+	return false
+}
+
 func (t *calcDistanceTask) Execute(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	param, _ := funcutil.GetAttrByKeyFromRepeatedKV("metric", request.GetParams())
 	metric, err := distance.ValidateMetricType(param)
@@ -140,6 +191,21 @@ func (t *calcDistanceTask) Execute(ctx context.Context, request *milvuspb.CalcDi
 		for _, fieldData := range retrievedFields {
 			if fieldData.FieldName == ids.FieldName {
 				retrievedVectors = fieldData.GetVectors()
+				// half precision vectors are calculated as float vectors
+				if typeutil.IsHalfFloatVectorType(fieldData.Type) {
+					floatArr, err := typeutil.DecodeHalfFloatVector(fieldData.Type, retrievedVectors.GetBinaryVector())
+					if err != nil {
+						return nil, err
+					}
+					retrievedVectors = &schemapb.VectorField{
+						Dim: retrievedVectors.GetDim(),
+						Data: &schemapb.VectorField_FloatVector{
+							FloatVector: &schemapb.FloatArray{
+								Data: floatArr,
+							},
+						},
+					}
+				}
 			}
 			if fieldData.Type == schemapb.DataType_Int64 ||
 				fieldData.Type == schemapb.DataType_VarChar ||
@@ -181,6 +247,7 @@ func (t *calcDistanceTask) Execute(ctx context.Context, request *milvuspb.CalcDi
 		zap.String("metric", metric))
 
 	vectorsLeft := request.GetOpLeft().GetDataArray()
+	var sparseLeft [][]byte
 	opLeft := request.GetOpLeft().GetIdArray()
 	if opLeft != nil {
 		log.Debug("OpLeft IdArray not empty, Get vectors by id",
@@ -206,7 +273,11 @@ func (t *calcDistanceTask) Execute(ctx context.Context, request *milvuspb.CalcDi
 			zap.String("traceID", t.traceID),
 			zap.String("role", typeutil.ProxyRole))
 
-		vectorsLeft, err = arrangeFunc(opLeft, result.FieldsData)
+		if isSparseFloatVectorField(opLeft.FieldName, result.FieldsData) {
+			sparseLeft, err = t.arrangeSparseFloatVectors(opLeft, result.FieldsData)
+		} else {
+			vectorsLeft, err = arrangeFunc(opLeft, result.FieldsData)
+		}
 		if err != nil {
 			log.Debug("Failed to re-arrange left vectors",
 				zap.Error(err),
@@ -226,7 +297,7 @@ func (t *calcDistanceTask) Execute(ctx context.Context, request *milvuspb.CalcDi
 			zap.String("role", typeutil.ProxyRole))
 	}
 
-	if vectorsLeft == nil {
+	if vectorsLeft == nil && sparseLeft == nil {
 		msg := "Left vectors array is empty"
 		log.Debug(msg,
 			zap.String("traceID", t.traceID),
@@ -241,6 +312,7 @@ func (t *calcDistanceTask) Execute(ctx context.Context, request *milvuspb.CalcDi
 	}
 
 	vectorsRight := request.GetOpRight().GetDataArray()
+	var sparseRight [][]byte
 	opRight := request.GetOpRight().GetIdArray()
 	if opRight != nil {
 		log.Debug("OpRight IdArray not empty, Get vectors by id",
@@ -266,7 +338,11 @@ func (t *calcDistanceTask) Execute(ctx context.Context, request *milvuspb.CalcDi
 			zap.String("traceID", t.traceID),
 			zap.String("role", typeutil.ProxyRole))
 
-		vectorsRight, err = arrangeFunc(opRight, result.FieldsData)
+		if isSparseFloatVectorField(opRight.FieldName, result.FieldsData) {
+			sparseRight, err = t.arrangeSparseFloatVectors(opRight, result.FieldsData)
+		} else {
+			vectorsRight, err = arrangeFunc(opRight, result.FieldsData)
+		}
 		if err != nil {
 			log.Debug("Failed to re-arrange right vectors",
 				zap.Error(err),
@@ -286,7 +362,7 @@ func (t *calcDistanceTask) Execute(ctx context.Context, request *milvuspb.CalcDi
 			zap.String("role", typeutil.ProxyRole))
 	}
 
-	if vectorsRight == nil {
+	if vectorsRight == nil && sparseRight == nil {
 		msg := "Right vectors array is empty"
 		log.Debug(msg,
 			zap.String("traceID", t.traceID),
@@ -300,6 +376,52 @@ func (t *calcDistanceTask) Execute(ctx context.Context, request *milvuspb.CalcDi
 		}, nil
 	}
 
+	if sparseLeft != nil || sparseRight != nil {
+		if sparseLeft == nil || sparseRight == nil {
+			msg := "cannot calculate distance between sparse float vectors and dense vectors"
+			log.Debug(msg,
+				zap.String("traceID", t.traceID),
+				zap.String("role", typeutil.ProxyRole))
+
+			return &milvuspb.CalcDistanceResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    msg,
+				},
+			}, nil
+		}
+
+		distances, err := distance.CalcSparseFloatDistance(sparseLeft, sparseRight, metric)
+		if err != nil {
+			log.Debug("Failed to CalcSparseFloatDistance",
+				zap.Error(err),
+				zap.Int("leftNum", len(sparseLeft)),
+				zap.Int("rightNum", len(sparseRight)),
+				zap.String("traceID", t.traceID),
+				zap.String("role", typeutil.ProxyRole))
+
+			return &milvuspb.CalcDistanceResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+			}, nil
+		}
+
+		log.Debug("CalcSparseFloatDistance done",
+			zap.String("traceID", t.traceID),
+			zap.String("role", typeutil.ProxyRole))
+
+		return &milvuspb.CalcDistanceResults{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success, Reason: ""},
+			Array: &milvuspb.CalcDistanceResults_FloatDist{
+				FloatDist: &schemapb.FloatArray{
+					Data: distances,
+				},
+			},
+		}, nil
+	}
+
 	if vectorsLeft.GetDim() != vectorsRight.GetDim() {
 		msg := "Vectors dimension is not equal"
 		log.Debug(msg,
@@ -8,6 +8,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -488,3 +489,179 @@ func TestCalcDistanceTask_ExecuteBinary(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, calcResult.Status.ErrorCode)
 }
+
+func TestCalcDistanceTask_ExecuteSparseFloat(t *testing.T) {
+	ctx := context.Background()
+
+	fieldIds := []int64{2, 0, 1}
+	rows := [][]byte{
+		typeutil.CreateSparseFloatRow([]uint32{1}, []float32{1}),
+		typeutil.CreateSparseFloatRow([]uint32{1, 5}, []float32{2, 3}),
+		typeutil.CreateSparseFloatRow([]uint32{5}, []float32{4}),
+	}
+
+	queryFunc := func(ids *milvuspb.VectorIDs) (*milvuspb.QueryResults, error) {
+		return &milvuspb.QueryResults{
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_Int64,
+					FieldName: "id",
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{
+								LongData: &schemapb.LongArray{
+									Data: fieldIds,
+								},
+							},
+						},
+					},
+				},
+				typeutil.GenSparseFloatVectorFieldData("sparse", 101, rows),
+			},
+		}, nil
+	}
+
+	idArray := func(ids ...int64) *milvuspb.VectorsArray {
+		return &milvuspb.VectorsArray{
+			Array: &milvuspb.VectorsArray_IdArray{
+				IdArray: &milvuspb.VectorIDs{
+					FieldName: "sparse",
+					IdArray: &schemapb.IDs{
+						IdField: &schemapb.IDs_IntId{
+							IntId: &schemapb.LongArray{
+								Data: ids,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	request := &milvuspb.CalcDistanceRequest{
+		OpLeft:  idArray(0),
+		OpRight: idArray(2, 0, 1),
+		Params: []*commonpb.KeyValuePair{
+			{Key: "metric", Value: "IP"},
+		},
+	}
+
+	task := &calcDistanceTask{
+		traceID:   "dummy",
+		queryFunc: queryFunc,
+	}
+
+	// success, id 0 is the row {1: 2, 5: 3}
+	calcResult, err := task.Execute(ctx, request)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, calcResult.Status.ErrorCode)
+	assert.Equal(t, []float32{2, 13, 12}, calcResult.GetFloatDist().GetData())
+
+	// metric not supported by sparse float vector
+	request.Params = []*commonpb.KeyValuePair{{Key: "metric", Value: "L2"}}
+	calcResult, err = task.Execute(ctx, request)
+	assert.Nil(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, calcResult.Status.ErrorCode)
+
+	// id not found
+	request.Params = []*commonpb.KeyValuePair{{Key: "metric", Value: "IP"}}
+	request.OpLeft = idArray(10)
+	calcResult, err = task.Execute(ctx, request)
+	assert.Nil(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, calcResult.Status.ErrorCode)
+
+	// sparse float vectors against dense vectors
+	request.OpLeft = &milvuspb.VectorsArray{
+		Array: &milvuspb.VectorsArray_DataArray{
+			DataArray: &schemapb.VectorField{
+				Dim: 8,
+				Data: &schemapb.VectorField_FloatVector{
+					FloatVector: &schemapb.FloatArray{Data: make([]float32, 8)},
+				},
+			},
+		},
+	}
+	calcResult, err = task.Execute(ctx, request)
+	assert.Nil(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, calcResult.Status.ErrorCode)
+}
+
+func TestCalcDistanceTask_ExecuteHalfFloat(t *testing.T) {
+	ctx := context.Background()
+
+	for _, dataType := range []schemapb.DataType{typeutil.Float16VectorType, typeutil.BFloat16VectorType} {
+		vectors, err := typeutil.EncodeHalfFloatVector(dataType, []float32{1, 0, 0, 2, 3, 4})
+		assert.Nil(t, err)
+
+		queryFunc := func(ids *milvuspb.VectorIDs) (*milvuspb.QueryResults, error) {
+			return &milvuspb.QueryResults{
+				FieldsData: []*schemapb.FieldData{
+					{
+						Type:      schemapb.DataType_Int64,
+						FieldName: "id",
+						Field: &schemapb.FieldData_Scalars{
+							Scalars: &schemapb.ScalarField{
+								Data: &schemapb.ScalarField_LongData{
+									LongData: &schemapb.LongArray{
+										Data: []int64{0, 1, 2},
+									},
+								},
+							},
+						},
+					},
+					{
+						Type:      dataType,
+						FieldName: "half",
+						Field: &schemapb.FieldData_Vectors{
+							Vectors: &schemapb.VectorField{
+								Dim: 2,
+								Data: &schemapb.VectorField_BinaryVector{
+									BinaryVector: vectors,
+								},
+							},
+						},
+					},
+				},
+			}, nil
+		}
+
+		request := &milvuspb.CalcDistanceRequest{
+			OpLeft: &milvuspb.VectorsArray{
+				Array: &milvuspb.VectorsArray_DataArray{
+					DataArray: &schemapb.VectorField{
+						Dim: 2,
+						Data: &schemapb.VectorField_FloatVector{
+							FloatVector: &schemapb.FloatArray{Data: []float32{1, 1}},
+						},
+					},
+				},
+			},
+			OpRight: &milvuspb.VectorsArray{
+				Array: &milvuspb.VectorsArray_IdArray{
+					IdArray: &milvuspb.VectorIDs{
+						FieldName: "half",
+						IdArray: &schemapb.IDs{
+							IdField: &schemapb.IDs_IntId{
+								IntId: &schemapb.LongArray{
+									Data: []int64{2, 0},
+								},
+							},
+						},
+					},
+				},
+			},
+			Params: []*commonpb.KeyValuePair{
+				{Key: "metric", Value: "IP"},
+			},
+		}
+
+		task := &calcDistanceTask{
+			traceID:   "dummy",
+			queryFunc: queryFunc,
+		}
+
+		calcResult, err := task.Execute(ctx, request)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, calcResult.Status.ErrorCode)
+		assert.Equal(t, []float32{7, 1}, calcResult.GetFloatDist().GetData())
+	}
+}
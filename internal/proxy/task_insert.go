@@ -13,6 +13,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/retry"
@@ -119,6 +120,69 @@ func (it *insertTask) checkLengthOfFieldsData() error {
 	return nil
 }
 
+// fillMissingFields generates the columns of nullable or default-valued fields which are absent from the request,
+// the rows of a nullable field without default value are marked as null. The per-row validity given along with
+// the columns of nullable fields is applied as well.
+func (it *insertTask) fillMissingFields() error {
+	existFields := make(map[string]*schemapb.FieldData)
+	for _, fieldData := range it.GetFieldsData() {
+		existFields[fieldData.GetFieldName()] = fieldData
+	}
+
+	numRows := int(it.NRows())
+	for _, field := range it.schema.GetFields() {
+		if field.GetAutoID() {
+			continue
+		}
+		nullable := typeutil.IsFieldNullable(field)
+		if fieldData, ok := existFields[field.GetName()]; ok {
+			valid := typeutil.GetFieldValidData(fieldData)
+			if valid == nil {
+				if nullable {
+					it.ValidData = append(it.ValidData, typeutil.GenValidData(field.GetFieldID(), numRows, true))
+				}
+				continue
+			}
+			typeutil.SetFieldValidData(fieldData, nil)
+			if len(valid) != numRows {
+				return fmt.Errorf("the number of validity %d of field %s mismatches the number of rows %d", len(valid), field.GetName(), numRows)
+			}
+			if !nullable {
+				for _, v := range valid {
+					if !v {
+						return fmt.Errorf("field %s is not nullable, but null values are given", field.GetName())
+					}
+				}
+				continue
+			}
+			valid, err := typeutil.FillNullRows(field, fieldData, valid)
+			if err != nil {
+				return err
+			}
+			it.ValidData = append(it.ValidData, &segcorepb.FieldValidData{
+				FieldId:   field.GetFieldID(),
+				ValidData: valid,
+			})
+			continue
+		}
+		_, hasDefault := typeutil.GetFieldDefaultValue(field)
+		if !nullable && !hasDefault {
+			// reported by checkLengthOfFieldsData
+			continue
+		}
+		fieldData, err := typeutil.GenDefaultFieldData(field, numRows)
+		if err != nil {
+			return err
+		}
+		it.FieldsData = append(it.FieldsData, fieldData)
+		if nullable {
+			it.ValidData = append(it.ValidData, typeutil.GenValidData(field.GetFieldID(), numRows, hasDefault))
+		}
+	}
+
+	return nil
+}
+
 func (it *insertTask) checkPrimaryFieldData() error {
 	rowNums := uint32(it.NRows())
 	// TODO(dragondriver): in fact, NumRows is not trustable, we should check all input fields
@@ -229,6 +293,12 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 	}
 	it.result.SuccIndex = sliceIndex
 
+	// fill the absent nullable and default-valued fields
+	if err = it.fillMissingFields(); err != nil {
+		log.Error("fill missing fields failed", zap.String("collectionName", collectionName), zap.Error(err))
+		return err
+	}
+
 	// check primaryFieldData whether autoID is true or not
 	// set rowIDs as primary data if autoID == true
 	err = it.checkPrimaryFieldData()
@@ -247,6 +317,13 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	// convert and check the vectors of float16 and bfloat16 vector fields
+	if err = convertHalfFloatVectorData(it.GetFieldsData(), collSchema); err != nil {
+		log.Error("invalid half precision vector data",
+			zap.Error(err))
+		return err
+	}
+
 	// check that all field's number rows are equal
 	if err = it.CheckAligned(); err != nil {
 		log.Error("field data is not aligned",
@@ -254,6 +331,13 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	// check the encoding of sparse float vectors
+	if err = validateSparseFloatVectorData(it.GetFieldsData()); err != nil {
+		log.Error("invalid sparse float vector data",
+			zap.Error(err))
+		return err
+	}
+
 	log.Debug("Proxy Insert PreExecute done")
 
 	return nil
@@ -334,6 +418,9 @@ func (it *insertTask) assignSegmentID(channelNames []string) (*msgstream.MsgPack
 			Version:        internalpb.InsertDataVersion_ColumnBased,
 		}
 		insertReq.FieldsData = make([]*schemapb.FieldData, len(it.GetFieldsData()))
+		if len(it.GetValidData()) > 0 {
+			insertReq.ValidData = make([]*segcorepb.FieldValidData, len(it.GetValidData()))
+		}
 
 		insertMsg := &msgstream.InsertMsg{
 			BaseMsg: msgstream.BaseMsg{
@@ -372,6 +459,7 @@ func (it *insertTask) assignSegmentID(channelNames []string) (*msgstream.MsgPack
 			}
 
 			typeutil.AppendFieldData(insertMsg.FieldsData, it.GetFieldsData(), int64(offset))
+			typeutil.AppendValidData(insertMsg.ValidData, it.GetValidData(), int64(offset))
 			insertMsg.HashValues = append(insertMsg.HashValues, it.HashValues[offset])
 			insertMsg.Timestamps = append(insertMsg.Timestamps, it.Timestamps[offset])
 			insertMsg.RowIDs = append(insertMsg.RowIDs, it.RowIDs[offset])
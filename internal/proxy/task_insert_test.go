@@ -5,7 +5,9 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -346,3 +348,162 @@ func TestInsertTask_CheckAligned(t *testing.T) {
 	err = case2.CheckAligned()
 	assert.NoError(t, err)
 }
+
+func TestInsertTask_fillMissingFields(t *testing.T) {
+	it := insertTask{
+		schema: &schemapb.CollectionSchema{
+			Name: "TestInsertTask_fillMissingFields",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, AutoID: true, DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+				{
+					FieldID:    102,
+					Name:       "nickname",
+					DataType:   schemapb.DataType_VarChar,
+					TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+				},
+				{
+					FieldID:    103,
+					Name:       "level",
+					DataType:   schemapb.DataType_Int32,
+					TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "1"}},
+				},
+				{
+					FieldID:    104,
+					Name:       "score",
+					DataType:   schemapb.DataType_Float,
+					TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+				},
+			},
+		},
+		BaseInsertTask: BaseInsertTask{
+			InsertRequest: internalpb.InsertRequest{
+				NumRows: 2,
+				Version: internalpb.InsertDataVersion_ColumnBased,
+				FieldsData: []*schemapb.FieldData{
+					newScalarFieldData(&schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int64}, "age", 2),
+					newScalarFieldData(&schemapb.FieldSchema{Name: "score", DataType: schemapb.DataType_Float}, "score", 2),
+				},
+			},
+		},
+	}
+
+	err := it.fillMissingFields()
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(it.GetFieldsData()))
+	assert.NoError(t, it.checkLengthOfFieldsData())
+
+	fieldsData := make(map[string]*schemapb.FieldData)
+	for _, fieldData := range it.GetFieldsData() {
+		fieldsData[fieldData.GetFieldName()] = fieldData
+	}
+	assert.Equal(t, []string{"", ""}, fieldsData["nickname"].GetScalars().GetStringData().GetData())
+	assert.Equal(t, []int32{1, 1}, fieldsData["level"].GetScalars().GetIntData().GetData())
+
+	validData := make(map[int64][]bool)
+	for _, data := range it.GetValidData() {
+		validData[data.GetFieldId()] = data.GetValidData()
+	}
+	assert.Equal(t, 2, len(validData))
+	assert.Equal(t, []bool{false, false}, validData[102])
+	assert.Equal(t, []bool{true, true}, validData[104])
+}
+
+func TestInsertTask_fillMissingFieldsWithValidData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "TestInsertTask_fillMissingFieldsWithValidData",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, AutoID: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+			{
+				FieldID:    102,
+				Name:       "nickname",
+				DataType:   schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+			},
+			{
+				FieldID:  103,
+				Name:     "level",
+				DataType: schemapb.DataType_Int32,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: common.NullableKey, Value: "true"},
+					{Key: common.DefaultValueKey, Value: "1"},
+				},
+			},
+		},
+	}
+	newTask := func(fieldsData ...*schemapb.FieldData) *insertTask {
+		return &insertTask{
+			schema: schema,
+			BaseInsertTask: BaseInsertTask{
+				InsertRequest: internalpb.InsertRequest{
+					NumRows:    3,
+					Version:    internalpb.InsertDataVersion_ColumnBased,
+					FieldsData: fieldsData,
+				},
+			},
+		}
+	}
+	withValidity := func(fieldData *schemapb.FieldData, valid ...bool) *schemapb.FieldData {
+		typeutil.SetFieldValidData(fieldData, valid)
+		return fieldData
+	}
+	stringData := func(name string, data ...string) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:      schemapb.DataType_VarChar,
+			FieldName: name,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}},
+			}},
+		}
+	}
+	intData := func(name string, data ...int32) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:      schemapb.DataType_Int32,
+			FieldName: name,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}},
+			}},
+		}
+	}
+	age := func() *schemapb.FieldData {
+		return newScalarFieldData(&schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int64}, "age", 3)
+	}
+
+	t.Run("full and compact columns", func(t *testing.T) {
+		it := newTask(age(),
+			withValidity(stringData("nickname", "a", "", "c"), true, false, true),
+			withValidity(intData("level", 5), false, true, false))
+		assert.NoError(t, it.fillMissingFields())
+
+		fieldsData := make(map[string]*schemapb.FieldData)
+		for _, fieldData := range it.GetFieldsData() {
+			fieldsData[fieldData.GetFieldName()] = fieldData
+			assert.Nil(t, typeutil.GetFieldValidData(fieldData))
+		}
+		assert.Equal(t, []string{"a", "", "c"}, fieldsData["nickname"].GetScalars().GetStringData().GetData())
+		assert.Equal(t, []int32{1, 5, 1}, fieldsData["level"].GetScalars().GetIntData().GetData())
+
+		validData := make(map[int64][]bool)
+		for _, data := range it.GetValidData() {
+			validData[data.GetFieldId()] = data.GetValidData()
+		}
+		assert.Equal(t, []bool{true, false, true}, validData[102])
+		assert.Equal(t, []bool{true, true, true}, validData[103])
+	})
+
+	t.Run("null values of not nullable field", func(t *testing.T) {
+		it := newTask(withValidity(age(), true, false, true))
+		assert.Error(t, it.fillMissingFields())
+	})
+
+	t.Run("validity length mismatch", func(t *testing.T) {
+		it := newTask(age(), withValidity(stringData("nickname", "a"), true, false))
+		assert.Error(t, it.fillMissingFields())
+	})
+
+	t.Run("data length mismatch", func(t *testing.T) {
+		it := newTask(age(), withValidity(stringData("nickname", "a", "b"), true, false, true))
+		assert.Error(t, it.fillMissingFields())
+	})
+}
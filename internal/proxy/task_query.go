@@ -472,6 +472,11 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 	}
 
 	ret.FieldsData = make([]*schemapb.FieldData, len(validRetrieveResults[0].GetFieldsData()))
+	srcFieldsData := make([][]*schemapb.FieldData, len(validRetrieveResults))
+	for i, r := range validRetrieveResults {
+		srcFieldsData[i] = r.GetFieldsData()
+	}
+	validMerger := typeutil.NewFieldValidDataMerger(srcFieldsData...)
 	idSet := make(map[interface{}]struct{})
 	cursors := make([]int64, len(validRetrieveResults))
 
@@ -498,6 +503,7 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 		pk := typeutil.GetPK(validRetrieveResults[sel].GetIds(), cursors[sel])
 		if _, ok := idSet[pk]; !ok {
 			typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
+			validMerger.Append(sel, cursors[sel])
 			idSet[pk] = struct{}{}
 		} else {
 			// primary keys duplicate
@@ -505,6 +511,7 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 		}
 		cursors[sel]++
 	}
+	validMerger.Apply(ret.FieldsData)
 
 	if skipDupCnt > 0 {
 		log.Ctx(ctx).Debug("skip duplicated query result while reducing QueryResults", zap.Int64("count", skipDupCnt))
@@ -223,7 +223,7 @@ func getOutputFieldIDs(schema *schemapb.CollectionSchema, outputFields []string)
 		hitField := false
 		for _, field := range schema.GetFields() {
 			if field.Name == name {
-				if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
+				if typeutil.IsVectorType(field.DataType) {
 					return nil, errors.New("search doesn't support vector field as output_fields")
 				}
 				outputFieldIDs = append(outputFieldIDs, field.GetFieldID())
@@ -727,6 +727,12 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 		}
 	}
 
+	srcFieldsData := make([][]*schemapb.FieldData, subSearchNum)
+	for i, data := range subSearchResultData {
+		srcFieldsData[i] = data.GetFieldsData()
+	}
+	validMerger := typeutil.NewFieldValidDataMerger(srcFieldsData...)
+
 	var (
 		skipDupCnt int64
 		realTopK   int64 = -1
@@ -770,6 +776,7 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 			// remove duplicates
 			if _, ok := idSet[id]; !ok {
 				typeutil.AppendFieldData(ret.Results.FieldsData, subSearchResultData[subSearchIdx].FieldsData, resultDataIdx)
+				validMerger.Append(subSearchIdx, resultDataIdx)
 				typeutil.AppendPKs(ret.Results.Ids, id)
 				ret.Results.Scores = append(ret.Results.Scores, score)
 				idSet[id] = struct{}{}
@@ -787,6 +794,7 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 		realTopK = j
 		ret.Results.Topks = append(ret.Results.Topks, realTopK)
 	}
+	validMerger.Apply(ret.Results.FieldsData)
 	log.Ctx(ctx).Debug("skip duplicated search result", zap.Int64("count", skipDupCnt))
 
 	if skipDupCnt > 0 {
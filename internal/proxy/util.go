@@ -33,9 +33,11 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/crypto"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -223,9 +225,66 @@ func validateDimension(field *schemapb.FieldSchema) error {
 	return nil
 }
 
+// validateSparseFloatVectorData checks the rows of sparse float vector fields in the inserted data.
+func validateSparseFloatVectorData(fieldsData []*schemapb.FieldData) error {
+	for _, fieldData := range fieldsData {
+		if !typeutil.IsSparseFloatVectorType(fieldData.GetType()) {
+			continue
+		}
+		if fieldData.GetScalars().GetBytesData() == nil {
+			return fmt.Errorf("sparse float vector field %s should be filled with bytes data", fieldData.GetFieldName())
+		}
+		if err := typeutil.ValidateSparseFloatRows(typeutil.GetSparseFloatVectorRows(fieldData)...); err != nil {
+			return fmt.Errorf("invalid data of field %s: %s", fieldData.GetFieldName(), err.Error())
+		}
+	}
+	return nil
+}
+
+// convertHalfFloatVectorData converts the float32 vectors of float16 and bfloat16 vector fields in the inserted data
+// to their encoding, and checks the dimension and byte length of the encoded vectors against the schema.
+func convertHalfFloatVectorData(fieldsData []*schemapb.FieldData, schema *schemapb.CollectionSchema) error {
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return err
+	}
+	for _, fieldData := range fieldsData {
+		if !typeutil.IsHalfFloatVectorType(fieldData.GetType()) {
+			continue
+		}
+		dim, err := helper.GetVectorDimFromID(fieldData.GetFieldId())
+		if err != nil {
+			return err
+		}
+
+		vectors := fieldData.GetVectors()
+		if vectors == nil {
+			return fmt.Errorf("%s field %s should be filled with vectors", fieldData.GetType().String(), fieldData.GetFieldName())
+		}
+		if floatVector := vectors.GetFloatVector(); floatVector != nil {
+			encoded, err := typeutil.EncodeHalfFloatVector(fieldData.GetType(), floatVector.GetData())
+			if err != nil {
+				return err
+			}
+			vectors.Data = &schemapb.VectorField_BinaryVector{BinaryVector: encoded}
+		}
+		if vectors.GetDim() != int64(dim) {
+			return fmt.Errorf("the dim (%d) of field %s is not equal to schema dim (%d)", vectors.GetDim(), fieldData.GetFieldName(), dim)
+		}
+		rowBytes := typeutil.GetBinaryVectorRowBytes(fieldData.GetType(), int64(dim))
+		if length := int64(len(vectors.GetBinaryVector())); length == 0 || length%rowBytes != 0 {
+			return fmt.Errorf("invalid data length %d of field %s, should be multiple of %d", length, fieldData.GetFieldName(), rowBytes)
+		}
+	}
+	return nil
+}
+
 func validateMaxLengthPerRow(collectionName string, field *schemapb.FieldSchema) error {
 	exist := false
 	for _, param := range field.TypeParams {
+		if param.Key == common.NullableKey || param.Key == common.DefaultValueKey {
+			continue
+		}
 		if param.Key != maxVarCharLengthKey {
 			return fmt.Errorf("type param key(max_length) should be specified for varChar field, not %s", param.Key)
 		}
@@ -247,6 +306,67 @@ func validateMaxLengthPerRow(collectionName string, field *schemapb.FieldSchema)
 	return nil
 }
 
+// validateNullableAndDefaultValue checks the nullable and default_value type params of a field,
+// only scalar fields except the primary key could be nullable or have a default value.
+func validateNullableAndDefaultValue(field *schemapb.FieldSchema) error {
+	typeParams := funcutil.KeyValuePair2Map(field.GetTypeParams())
+	nullable, hasNullable := typeParams[common.NullableKey]
+	defaultValue, hasDefault := typeParams[common.DefaultValueKey]
+	if !hasNullable && !hasDefault {
+		return nil
+	}
+	if hasNullable {
+		if _, err := strconv.ParseBool(nullable); err != nil {
+			return fmt.Errorf("invalid nullable value %s of field %s", nullable, field.GetName())
+		}
+	}
+	if field.GetIsPrimaryKey() {
+		return fmt.Errorf("primary field %s can not be nullable or have a default value", field.GetName())
+	}
+	if typeutil.IsVectorType(field.GetDataType()) {
+		return fmt.Errorf("vector field %s can not be nullable or have a default value", field.GetName())
+	}
+	if !hasDefault {
+		return nil
+	}
+	if _, err := typeutil.ParseFieldDefaultValue(field); err != nil {
+		return fmt.Errorf("invalid default value %s of field %s: %s", defaultValue, field.GetName(), err.Error())
+	}
+	if field.GetDataType() == schemapb.DataType_VarChar {
+		maxLength, err := strconv.Atoi(typeParams[maxVarCharLengthKey])
+		if err == nil && len(defaultValue) > maxLength {
+			return fmt.Errorf("the length of default value of field %s exceeds max_length %d", field.GetName(), maxLength)
+		}
+	}
+	return nil
+}
+
+// validateAddedField checks the field appended to the collection by the collection.add_field property,
+// only nullable or default-valued scalar field could be appended.
+func validateAddedField(collectionName string, field *schemapb.FieldSchema) error {
+	if err := validateFieldName(field.GetName()); err != nil {
+		return err
+	}
+	if field.GetIsPrimaryKey() || field.GetAutoID() {
+		return fmt.Errorf("added field %s can not be primary key or auto id", field.GetName())
+	}
+	if err := validateFieldType(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{field}}); err != nil {
+		return err
+	}
+	if typeutil.IsVectorType(field.GetDataType()) {
+		return fmt.Errorf("added field %s must be a scalar field", field.GetName())
+	}
+	if !typeutil.IsFieldOptional(field) {
+		return fmt.Errorf("added field %s must be nullable or have a default value", field.GetName())
+	}
+	if field.GetDataType() == schemapb.DataType_VarChar {
+		if err := validateMaxLengthPerRow(collectionName, field); err != nil {
+			return err
+		}
+	}
+	return validateNullableAndDefaultValue(field)
+}
+
 func validateVectorFieldMetricType(field *schemapb.FieldSchema) error {
 	if (field.DataType != schemapb.DataType_FloatVector) && (field.DataType != schemapb.DataType_BinaryVector) {
 		return nil
@@ -278,6 +398,11 @@ func validateFieldType(schema *schemapb.CollectionSchema) error {
 			return errors.New("string data type not supported yet, please use VarChar type instead")
 		case schemapb.DataType_None:
 			return errors.New("data type None is not valid")
+		case typeutil.SparseFloatVectorType:
+			// segcore can't load, index or search them, the collection could never be loaded
+			return fmt.Errorf("sparse float vector data type of field %s not supported yet", field.GetName())
+		case typeutil.Float16VectorType, typeutil.BFloat16VectorType:
+			return fmt.Errorf("half float vector data type of field %s not supported yet", field.GetName())
 		}
 	}
 	return nil
@@ -352,7 +477,8 @@ func isVector(dataType schemapb.DataType) (bool, error) {
 		schemapb.DataType_Float, schemapb.DataType_Double:
 		return false, nil
 
-	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
+	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector, typeutil.SparseFloatVectorType,
+		typeutil.Float16VectorType, typeutil.BFloat16VectorType:
 		return true, nil
 	}
 
@@ -363,7 +489,10 @@ func validateMetricType(dataType schemapb.DataType, metricTypeStrRaw string) err
 	metricTypeStr := strings.ToUpper(metricTypeStrRaw)
 	switch metricTypeStr {
 	case "L2", "IP":
-		if dataType == schemapb.DataType_FloatVector {
+		if dataType == schemapb.DataType_FloatVector || typeutil.IsHalfFloatVectorType(dataType) {
+			return nil
+		}
+		if metricTypeStr == "IP" && typeutil.IsSparseFloatVectorType(dataType) {
 			return nil
 		}
 	case "JACCARD", "HAMMING", "TANIMOTO", "SUBSTRUCTURE", "SUBPERSTURCTURE":
@@ -424,13 +553,15 @@ func validateSchema(coll *schemapb.CollectionSchema) error {
 			if err2 != nil {
 				return err2
 			}
-			dimStr, ok := typeKv["dim"]
-			if !ok {
-				return fmt.Errorf("dim not found in type_params for vector field %s(%d)", field.Name, field.FieldID)
-			}
-			dim, err := strconv.Atoi(dimStr)
-			if err != nil || dim < 0 {
-				return fmt.Errorf("invalid dim; %s", dimStr)
+			if !typeutil.IsSparseFloatVectorType(field.DataType) {
+				dimStr, ok := typeKv["dim"]
+				if !ok {
+					return fmt.Errorf("dim not found in type_params for vector field %s(%d)", field.Name, field.FieldID)
+				}
+				dim, err := strconv.Atoi(dimStr)
+				if err != nil || dim < 0 {
+					return fmt.Errorf("invalid dim; %s", dimStr)
+				}
 			}
 
 			metricTypeStr, ok := indexKv["metric_type"]
@@ -468,7 +599,7 @@ func validateMultipleVectorFields(schema *schemapb.CollectionSchema) error {
 	for i := range schema.Fields {
 		name := schema.Fields[i].Name
 		dType := schema.Fields[i].DataType
-		isVec := dType == schemapb.DataType_BinaryVector || dType == schemapb.DataType_FloatVector
+		isVec := typeutil.IsVectorType(dType)
 		if isVec && vecExist && !enableMultipleVectorFields {
 			return fmt.Errorf(
 				"multiple vector fields is not supported, fields name: %s, %s",
@@ -774,7 +905,7 @@ func translateOutputFields(outputFields []string, schema *schemapb.CollectionSch
 		if field.IsPrimaryKey {
 			primaryFieldName = field.Name
 		}
-		if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
+		if typeutil.IsVectorType(field.DataType) {
 			vectorFieldNameMap[field.Name] = true
 		} else {
 			scalarFieldNameMap[field.Name] = true
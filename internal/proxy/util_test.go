@@ -30,6 +30,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
@@ -216,6 +217,139 @@ func TestValidateDuplicatedFieldName(t *testing.T) {
 	assert.NotNil(t, validateDuplicatedFieldName(fields))
 }
 
+func TestValidateNullableAndDefaultValue(t *testing.T) {
+	kvs := func(pairs ...string) []*commonpb.KeyValuePair {
+		var ret []*commonpb.KeyValuePair
+		for i := 0; i+1 < len(pairs); i += 2 {
+			ret = append(ret, &commonpb.KeyValuePair{Key: pairs[i], Value: pairs[i+1]})
+		}
+		return ret
+	}
+
+	assert.NoError(t, validateNullableAndDefaultValue(&schemapb.FieldSchema{
+		Name: "age", DataType: schemapb.DataType_Int64,
+	}))
+	assert.NoError(t, validateNullableAndDefaultValue(&schemapb.FieldSchema{
+		Name: "age", DataType: schemapb.DataType_Int32, TypeParams: kvs(common.NullableKey, "true", common.DefaultValueKey, "18"),
+	}))
+	assert.NoError(t, validateNullableAndDefaultValue(&schemapb.FieldSchema{
+		Name: "status", DataType: schemapb.DataType_VarChar, TypeParams: kvs(maxVarCharLengthKey, "8", common.DefaultValueKey, "new"),
+	}))
+
+	assert.Error(t, validateNullableAndDefaultValue(&schemapb.FieldSchema{
+		Name: "age", DataType: schemapb.DataType_Int32, TypeParams: kvs(common.NullableKey, "yes"),
+	}))
+	assert.Error(t, validateNullableAndDefaultValue(&schemapb.FieldSchema{
+		Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, TypeParams: kvs(common.NullableKey, "true"),
+	}))
+	assert.Error(t, validateNullableAndDefaultValue(&schemapb.FieldSchema{
+		Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: kvs("dim", "8", common.NullableKey, "true"),
+	}))
+	assert.Error(t, validateNullableAndDefaultValue(&schemapb.FieldSchema{
+		Name: "age", DataType: schemapb.DataType_Int8, TypeParams: kvs(common.DefaultValueKey, "1000"),
+	}))
+	assert.Error(t, validateNullableAndDefaultValue(&schemapb.FieldSchema{
+		Name: "status", DataType: schemapb.DataType_VarChar, TypeParams: kvs(maxVarCharLengthKey, "2", common.DefaultValueKey, "new"),
+	}))
+}
+
+func TestValidateSparseFloatVectorData(t *testing.T) {
+	rows := [][]byte{
+		typeutil.CreateSparseFloatRow([]uint32{3, 1}, []float32{0.3, 0.1}),
+		{},
+	}
+	fieldsData := []*schemapb.FieldData{
+		{Type: schemapb.DataType_Int64, FieldName: "pk"},
+		typeutil.GenSparseFloatVectorFieldData("sparse", 101, rows),
+	}
+	assert.NoError(t, validateSparseFloatVectorData(fieldsData))
+
+	fieldsData[1] = typeutil.GenSparseFloatVectorFieldData("sparse", 101, [][]byte{{1, 2, 3}})
+	assert.Error(t, validateSparseFloatVectorData(fieldsData))
+
+	fieldsData[1] = &schemapb.FieldData{Type: typeutil.SparseFloatVectorType, FieldName: "sparse"}
+	assert.Error(t, validateSparseFloatVectorData(fieldsData))
+}
+
+func TestConvertHalfFloatVectorData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "half", DataType: typeutil.Float16VectorType,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+		},
+	}
+	floatVectors := func(dim int64, data ...float32) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:      typeutil.Float16VectorType,
+			FieldName: "half",
+			FieldId:   101,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim:  dim,
+					Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: data}},
+				},
+			},
+		}
+	}
+
+	fieldsData := []*schemapb.FieldData{{Type: schemapb.DataType_Int64, FieldName: "pk", FieldId: 100}, floatVectors(2, 1, 2, 3, 4)}
+	assert.NoError(t, convertHalfFloatVectorData(fieldsData, schema))
+	expected, err := typeutil.EncodeHalfFloatVector(typeutil.Float16VectorType, []float32{1, 2, 3, 4})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, fieldsData[1].GetVectors().GetBinaryVector())
+
+	// encoded vectors are accepted as is
+	assert.NoError(t, convertHalfFloatVectorData(fieldsData, schema))
+
+	// dim mismatch
+	fieldsData[1] = floatVectors(4, 1, 2, 3, 4)
+	assert.Error(t, convertHalfFloatVectorData(fieldsData, schema))
+
+	// byte length is not a multiple of dim * 2
+	fieldsData[1] = floatVectors(2, 1, 2, 3)
+	assert.Error(t, convertHalfFloatVectorData(fieldsData, schema))
+
+	// no vectors
+	fieldsData[1] = &schemapb.FieldData{Type: typeutil.Float16VectorType, FieldName: "half", FieldId: 101}
+	assert.Error(t, convertHalfFloatVectorData(fieldsData, schema))
+
+	assert.NoError(t, validateMetricType(typeutil.BFloat16VectorType, "L2"))
+	assert.Error(t, validateMetricType(typeutil.BFloat16VectorType, "HAMMING"))
+}
+
+func TestValidateAddedField(t *testing.T) {
+	kvs := func(pairs ...string) []*commonpb.KeyValuePair {
+		var ret []*commonpb.KeyValuePair
+		for i := 0; i+1 < len(pairs); i += 2 {
+			ret = append(ret, &commonpb.KeyValuePair{Key: pairs[i], Value: pairs[i+1]})
+		}
+		return ret
+	}
+
+	assert.NoError(t, validateAddedField("coll", &schemapb.FieldSchema{
+		Name: "age", DataType: schemapb.DataType_Int64, TypeParams: kvs(common.NullableKey, "true"),
+	}))
+	assert.NoError(t, validateAddedField("coll", &schemapb.FieldSchema{
+		Name: "status", DataType: schemapb.DataType_VarChar, TypeParams: kvs(maxVarCharLengthKey, "16", common.DefaultValueKey, "new"),
+	}))
+	assert.Error(t, validateAddedField("coll", &schemapb.FieldSchema{
+		Name: "age", DataType: schemapb.DataType_Int64,
+	}))
+	assert.Error(t, validateAddedField("coll", &schemapb.FieldSchema{
+		Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, TypeParams: kvs(common.NullableKey, "true"),
+	}))
+	assert.Error(t, validateAddedField("coll", &schemapb.FieldSchema{
+		Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: kvs("dim", "8", common.NullableKey, "true"),
+	}))
+	assert.Error(t, validateAddedField("coll", &schemapb.FieldSchema{
+		Name: "1age", DataType: schemapb.DataType_Int64, TypeParams: kvs(common.NullableKey, "true"),
+	}))
+	assert.Error(t, validateAddedField("coll", &schemapb.FieldSchema{
+		Name: "status", DataType: schemapb.DataType_VarChar, TypeParams: kvs(common.DefaultValueKey, "new"),
+	}))
+}
+
 func TestValidatePrimaryKey(t *testing.T) {
 	boolField := &schemapb.FieldSchema{
 		Name:         "boolField",
@@ -335,6 +469,18 @@ func TestValidateFieldType(t *testing.T) {
 			dt:       schemapb.DataType_VarChar,
 			validate: true,
 		},
+		{
+			dt:       typeutil.SparseFloatVectorType,
+			validate: false,
+		},
+		{
+			dt:       typeutil.Float16VectorType,
+			validate: false,
+		},
+		{
+			dt:       typeutil.BFloat16VectorType,
+			validate: false,
+		},
 	}
 
 	for _, tc := range cases {
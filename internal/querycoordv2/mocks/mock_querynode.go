@@ -828,6 +828,53 @@ func (_c *MockQueryNodeServer_UnsubDmChannel_Call) Return(_a0 *commonpb.Status,
 	return _c
 }
 
+// UpdateCollectionSchema provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryNodeServer) UpdateCollectionSchema(_a0 context.Context, _a1 *querypb.UpdateCollectionSchemaRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.UpdateCollectionSchemaRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.UpdateCollectionSchemaRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryNodeServer_UpdateCollectionSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCollectionSchema'
+type MockQueryNodeServer_UpdateCollectionSchema_Call struct {
+	*mock.Call
+}
+
+// UpdateCollectionSchema is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *querypb.UpdateCollectionSchemaRequest
+func (_e *MockQueryNodeServer_Expecter) UpdateCollectionSchema(_a0 interface{}, _a1 interface{}) *MockQueryNodeServer_UpdateCollectionSchema_Call {
+	return &MockQueryNodeServer_UpdateCollectionSchema_Call{Call: _e.mock.On("UpdateCollectionSchema", _a0, _a1)}
+}
+
+func (_c *MockQueryNodeServer_UpdateCollectionSchema_Call) Run(run func(_a0 context.Context, _a1 *querypb.UpdateCollectionSchemaRequest)) *MockQueryNodeServer_UpdateCollectionSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.UpdateCollectionSchemaRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryNodeServer_UpdateCollectionSchema_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryNodeServer_UpdateCollectionSchema_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // WatchDmChannels provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryNodeServer) WatchDmChannels(_a0 context.Context, _a1 *querypb.WatchDmChannelsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
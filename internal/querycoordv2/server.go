@@ -37,6 +37,9 @@ import (
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dao"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
+	"github.com/milvus-io/milvus/internal/metastore/db/querycoord"
 	"github.com/milvus-io/milvus/internal/querycoordv2/balance"
 	"github.com/milvus-io/milvus/internal/querycoordv2/checkers"
 	"github.com/milvus-io/milvus/internal/querycoordv2/dist"
@@ -47,6 +50,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
@@ -243,7 +247,19 @@ func (s *Server) initMeta() error {
 	record := timerecord.NewTimeRecorder("querycoord")
 
 	log.Info("init meta")
-	s.store = meta.NewMetaStore(s.kv)
+	switch Params.MetaStoreCfg.MetaStoreType {
+	case util.MetaStoreTypeEtcd:
+		s.store = meta.NewMetaStore(s.kv)
+	case util.MetaStoreTypeMysql:
+		// connect to database
+		if err := dbcore.Connect(&Params.DBCfg); err != nil {
+			log.Error("failed to connect meta database", zap.Error(err))
+			return err
+		}
+		s.store = querycoord.NewTableCatalog(dbcore.NewTxImpl(), dao.NewMetaDomain())
+	default:
+		return fmt.Errorf("not supported meta store: %s", Params.MetaStoreCfg.MetaStoreType)
+	}
 	s.meta = meta.NewMeta(s.idAllocator, s.store)
 
 	log.Info("recover meta...")
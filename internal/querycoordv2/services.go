@@ -722,3 +722,55 @@ func (s *Server) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthReque
 
 	return &milvuspb.CheckHealthResponse{IsHealthy: true, Reasons: errReasons}, nil
 }
+
+// UpdateCollectionSchema pushes the schema with appended fields to the QueryNodes which load the collection,
+// the segments loaded later get the schema from RootCoord as usual.
+func (s *Server) UpdateCollectionSchema(ctx context.Context, req *querypb.UpdateCollectionSchemaRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+
+	log.Info("update collection schema request received", zap.Int("numFields", len(req.GetSchema().GetFields())))
+	if s.status.Load() != commonpb.StateCode_Healthy {
+		msg := "failed to update collection schema"
+		log.Warn(msg, zap.Error(ErrNotHealthy))
+		return utils.WrapStatus(commonpb.ErrorCode_UnexpectedError, msg, ErrNotHealthy), nil
+	}
+
+	if !s.meta.CollectionManager.Exist(req.GetCollectionID()) {
+		log.Info("collection not loaded, skip updating schema")
+		return successStatus, nil
+	}
+
+	nodes := typeutil.NewUniqueSet()
+	for _, replica := range s.meta.ReplicaManager.GetByCollection(req.GetCollectionID()) {
+		for _, node := range replica.GetNodes() {
+			if s.nodeMgr.Get(node) != nil {
+				nodes.Insert(node)
+			}
+		}
+	}
+
+	group, ctx := errgroup.WithContext(ctx)
+	for _, node := range nodes.Collect() {
+		node := node
+		group.Go(func() error {
+			status, err := s.cluster.UpdateCollectionSchema(ctx, node, req)
+			if err != nil {
+				return err
+			}
+			if status.GetErrorCode() != commonpb.ErrorCode_Success {
+				return fmt.Errorf("failed to update collection schema on node %d: %s", node, status.GetReason())
+			}
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		msg := "failed to update collection schema"
+		log.Warn(msg, zap.Error(err))
+		return utils.WrapStatus(commonpb.ErrorCode_UnexpectedError, msg, err), nil
+	}
+
+	log.Info("collection schema updated", zap.Int("numNodes", nodes.Len()))
+	return successStatus, nil
+}
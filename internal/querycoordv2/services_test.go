@@ -813,6 +813,53 @@ func (suite *ServiceSuite) TestCheckHealth() {
 	suite.Empty(resp.Reasons)
 }
 
+func (suite *ServiceSuite) TestUpdateCollectionSchema() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	for _, collection := range suite.collections {
+		req := &querypb.UpdateCollectionSchemaRequest{
+			Base:         &commonpb.MsgBase{},
+			CollectionID: collection,
+		}
+		nodes := typeutil.NewUniqueSet()
+		for _, replica := range suite.meta.ReplicaManager.GetByCollection(collection) {
+			nodes.Insert(replica.GetNodes()...)
+		}
+		for _, node := range nodes.Collect() {
+			suite.cluster.EXPECT().UpdateCollectionSchema(mock.Anything, node, req).Return(successStatus, nil).Once()
+		}
+		resp, err := server.UpdateCollectionSchema(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_Success, resp.ErrorCode)
+	}
+
+	// Test for failed to update schema on nodes
+	collection := suite.collections[0]
+	req := &querypb.UpdateCollectionSchemaRequest{
+		Base:         &commonpb.MsgBase{},
+		CollectionID: collection,
+	}
+	suite.cluster.EXPECT().UpdateCollectionSchema(mock.Anything, mock.Anything, req).Return(&commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}, nil)
+	resp, err := server.UpdateCollectionSchema(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
+
+	// Test for collection not loaded
+	resp, err = server.UpdateCollectionSchema(ctx, &querypb.UpdateCollectionSchemaRequest{CollectionID: 10000})
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.ErrorCode)
+
+	// Test for server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.UpdateCollectionSchema(ctx, req)
+	suite.NoError(err)
+	suite.Contains(resp.Reason, ErrNotHealthy.Error())
+}
+
 func (suite *ServiceSuite) TestGetShardLeaders() {
 	suite.loadAll()
 	ctx := context.Background()
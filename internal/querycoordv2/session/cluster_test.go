@@ -136,6 +136,10 @@ func (suite *ClusterTestSuite) createDefaultMockServer() querypb.QueryNodeServer
 		mock.Anything,
 		mock.AnythingOfType("*querypb.SyncDistributionRequest"),
 	).Maybe().Return(succStatus, nil)
+	svr.EXPECT().UpdateCollectionSchema(
+		mock.Anything,
+		mock.AnythingOfType("*querypb.UpdateCollectionSchemaRequest"),
+	).Maybe().Return(succStatus, nil)
 	svr.EXPECT().GetComponentStates(
 		mock.Anything,
 		mock.AnythingOfType("*milvuspb.GetComponentStatesRequest"),
@@ -181,6 +185,10 @@ func (suite *ClusterTestSuite) createFailedMockServer() querypb.QueryNodeServer
 		mock.Anything,
 		mock.AnythingOfType("*querypb.SyncDistributionRequest"),
 	).Maybe().Return(failStatus, nil)
+	svr.EXPECT().UpdateCollectionSchema(
+		mock.Anything,
+		mock.AnythingOfType("*querypb.UpdateCollectionSchemaRequest"),
+	).Maybe().Return(failStatus, nil)
 	svr.EXPECT().GetComponentStates(
 		mock.Anything,
 		mock.AnythingOfType("*milvuspb.GetComponentStatesRequest"),
@@ -344,6 +352,27 @@ func (suite *ClusterTestSuite) TestSyncDistribution() {
 	}, status)
 }
 
+func (suite *ClusterTestSuite) TestUpdateCollectionSchema() {
+	ctx := context.TODO()
+	status, err := suite.cluster.UpdateCollectionSchema(ctx, 0, &querypb.UpdateCollectionSchemaRequest{
+		Base: &commonpb.MsgBase{},
+	})
+	suite.NoError(err)
+	suite.Equal(&commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, status)
+
+	status, err = suite.cluster.UpdateCollectionSchema(ctx, 1, &querypb.UpdateCollectionSchemaRequest{
+		Base: &commonpb.MsgBase{},
+	})
+	suite.NoError(err)
+	suite.Equal(&commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		Reason:    "unexpected error",
+	}, status)
+}
+
 func (suite *ClusterTestSuite) TestGetComponentStates() {
 	ctx := context.TODO()
 	status, err := suite.cluster.GetComponentStates(ctx, 0)
@@ -417,6 +417,54 @@ func (_c *MockCluster_UnsubDmChannel_Call) Return(_a0 *commonpb.Status, _a1 erro
 	return _c
 }
 
+// UpdateCollectionSchema provides a mock function with given fields: ctx, nodeID, req
+func (_m *MockCluster) UpdateCollectionSchema(ctx context.Context, nodeID int64, req *querypb.UpdateCollectionSchemaRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, nodeID, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *querypb.UpdateCollectionSchemaRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, nodeID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *querypb.UpdateCollectionSchemaRequest) error); ok {
+		r1 = rf(ctx, nodeID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCluster_UpdateCollectionSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCollectionSchema'
+type MockCluster_UpdateCollectionSchema_Call struct {
+	*mock.Call
+}
+
+// UpdateCollectionSchema is a helper method to define mock.On call
+//  - ctx context.Context
+//  - nodeID int64
+//  - req *querypb.UpdateCollectionSchemaRequest
+func (_e *MockCluster_Expecter) UpdateCollectionSchema(ctx interface{}, nodeID interface{}, req interface{}) *MockCluster_UpdateCollectionSchema_Call {
+	return &MockCluster_UpdateCollectionSchema_Call{Call: _e.mock.On("UpdateCollectionSchema", ctx, nodeID, req)}
+}
+
+func (_c *MockCluster_UpdateCollectionSchema_Call) Run(run func(ctx context.Context, nodeID int64, req *querypb.UpdateCollectionSchemaRequest)) *MockCluster_UpdateCollectionSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*querypb.UpdateCollectionSchemaRequest))
+	})
+	return _c
+}
+
+func (_c *MockCluster_UpdateCollectionSchema_Call) Return(_a0 *commonpb.Status, _a1 error) *MockCluster_UpdateCollectionSchema_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // WatchDmChannels provides a mock function with given fields: ctx, nodeID, req
 func (_m *MockCluster) WatchDmChannels(ctx context.Context, nodeID int64, req *querypb.WatchDmChannelsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, nodeID, req)
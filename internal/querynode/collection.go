@@ -24,6 +24,7 @@ package querynode
 */
 import "C"
 import (
+	"errors"
 	"fmt"
 	"math"
 	"sync"
@@ -49,7 +50,12 @@ type Collection struct {
 	collectionPtr C.CCollection
 	id            UniqueID
 	partitionIDs  []UniqueID
-	schema        *schemapb.CollectionSchema
+
+	schemaMu sync.RWMutex // guards schema
+	schema   *schemapb.CollectionSchema
+	// insertMu is held by insertNode while inserting, so that the schema
+	// of collection and growing segments stays unchanged during an insertion
+	insertMu sync.RWMutex
 
 	// TODO, remove delta channels
 	channelMu      sync.RWMutex
@@ -73,9 +79,54 @@ func (c *Collection) ID() UniqueID {
 
 // Schema returns the schema of collection
 func (c *Collection) Schema() *schemapb.CollectionSchema {
+	c.schemaMu.RLock()
+	defer c.schemaMu.RUnlock()
 	return c.schema
 }
 
+// updateSchema replaces the schema of collection if fields are added in the new one,
+// segments created afterwards use the new schema while the existing ones catch up by addFieldsToSegments.
+func (c *Collection) updateSchema(schema *schemapb.CollectionSchema) {
+	c.insertMu.Lock()
+	defer c.insertMu.Unlock()
+	c.schemaMu.Lock()
+	defer c.schemaMu.Unlock()
+	if len(schema.GetFields()) <= len(c.schema.GetFields()) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	/*
+		void
+		UpdateCollectionSchema(CCollection collection, const char* schema_proto_blob);
+	*/
+	cSchemaBlob := C.CString(proto.MarshalTextString(schema))
+	defer C.free(unsafe.Pointer(cSchemaBlob))
+	C.UpdateCollectionSchema(c.collectionPtr, cSchemaBlob)
+	c.schema = schema
+
+	log.Info("update collection schema", zap.Int64("collectionID", c.id),
+		zap.Int("numFields", len(schema.GetFields())))
+}
+
+// addFieldsToSegments appends the fields added to collection to the segments, insertion is blocked meanwhile.
+func (c *Collection) addFieldsToSegments(segments ...*Segment) error {
+	c.insertMu.Lock()
+	defer c.insertMu.Unlock()
+	for _, segment := range segments {
+		err := segment.addFields(c)
+		// segment released meanwhile
+		if errors.Is(err, ErrSegmentUnhealthy) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // getPartitionIDs return partitionIDs of collection
 func (c *Collection) getPartitionIDs() []UniqueID {
 	dst := make([]UniqueID, len(c.partitionIDs))
@@ -368,7 +419,7 @@ func (c *Collection) getLoadType() loadType {
 
 // getFieldType get the field type according to the field id.
 func (c *Collection) getFieldType(fieldID FieldID) (schemapb.DataType, error) {
-	helper, err := typeutil.CreateSchemaHelper(c.schema)
+	helper, err := typeutil.CreateSchemaHelper(c.Schema())
 	if err != nil {
 		return schemapb.DataType_None, err
 	}
@@ -55,6 +55,7 @@ type insertData struct {
 	insertIDs        map[UniqueID][]int64 // rowIDs
 	insertTimestamps map[UniqueID][]Timestamp
 	insertRecords    map[UniqueID][]*schemapb.FieldData
+	insertValidData  map[UniqueID][]*segcorepb.FieldValidData
 	insertOffset     map[UniqueID]int64
 	insertPKs        map[UniqueID][]primaryKey // pks
 }
@@ -93,6 +94,7 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		insertIDs:        make(map[UniqueID][]int64),
 		insertTimestamps: make(map[UniqueID][]Timestamp),
 		insertRecords:    make(map[UniqueID][]*schemapb.FieldData),
+		insertValidData:  make(map[UniqueID][]*segcorepb.FieldValidData),
 		insertOffset:     make(map[UniqueID]int64),
 		insertPKs:        make(map[UniqueID][]primaryKey),
 	}
@@ -109,6 +111,8 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		// QueryNode should add collection before start flow graph
 		panic(fmt.Errorf("%s getCollectionByID failed, collectionID = %d, vchannel: %s", iNode.Name(), iNode.collectionID, iNode.vchannel))
 	}
+	// keep the schema unchanged until the insertion finishes
+	collection.insertMu.RLock()
 
 	// 1. hash insertMessages to insertData
 	// sort timestamps ensures that the data in iData.insertRecords is sorted in ascending order of timestamp
@@ -153,7 +157,7 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			}
 		}
 
-		insertRecord, err := storage.TransferInsertMsgToInsertRecord(collection.schema, insertMsg)
+		insertRecord, err := storage.TransferInsertMsgToInsertRecord(collection.Schema(), insertMsg)
 		if err != nil {
 			// occurs only when schema doesn't have dim param, this should not happen
 			err = fmt.Errorf("failed to transfer msgStream.insertMsg to storage.InsertRecord, err = %s", err)
@@ -168,6 +172,7 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		} else {
 			typeutil.MergeFieldData(iData.insertRecords[insertMsg.SegmentID], insertRecord.FieldsData)
 		}
+		iData.insertValidData[insertMsg.SegmentID] = typeutil.MergeValidData(iData.insertValidData[insertMsg.SegmentID], insertRecord.ValidData)
 		pks, err := getPrimaryKeys(insertMsg, iNode.metaReplica)
 		if err != nil {
 			// error occurs when cannot find collection or data is misaligned, should not happen
@@ -229,6 +234,7 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		}()
 	}
 	wg.Wait()
+	collection.insertMu.RUnlock()
 
 	delData := &deleteData{
 		deleteIDs:        make(map[UniqueID][]primaryKey),
@@ -396,6 +402,7 @@ func (iNode *insertNode) insert(iData *insertData, segmentID UniqueID) error {
 	insertRecord := &segcorepb.InsertRecord{
 		FieldsData: iData.insertRecords[segmentID],
 		NumRows:    int64(len(ids)),
+		ValidData:  iData.insertValidData[segmentID],
 	}
 
 	err = targetSegment.segmentInsert(offsets, ids, timestamps, insertRecord)
@@ -464,7 +471,7 @@ func getPrimaryKeys(msg *msgstream.InsertMsg, metaReplica ReplicaInterface) ([]p
 		return nil, err
 	}
 
-	return getPKs(msg, collection.schema)
+	return getPKs(msg, collection.Schema())
 }
 
 func getPKs(msg *msgstream.InsertMsg, schema *schemapb.CollectionSchema) ([]primaryKey, error) {
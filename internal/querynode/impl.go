@@ -1384,3 +1384,73 @@ func (node *QueryNode) SyncDistribution(ctx context.Context, req *querypb.SyncDi
 		Reason:    "",
 	}, nil
 }
+
+// UpdateCollectionSchema applies the schema with appended fields to the loaded collection and its segments
+func (node *QueryNode) UpdateCollectionSchema(ctx context.Context, req *querypb.UpdateCollectionSchemaRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
+	// check node healthy
+	code := node.stateCode.Load().(commonpb.StateCode)
+	if code != commonpb.StateCode_Healthy {
+		err := fmt.Errorf("query node %d is not ready", paramtable.GetNodeID())
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		return status, nil
+	}
+	// check target matches
+	if req.GetBase().GetTargetID() != paramtable.GetNodeID() {
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_NodeIDNotMatch,
+			Reason:    common.WrapNodeIDNotMatchMsg(req.GetBase().GetTargetID(), paramtable.GetNodeID()),
+		}
+		return status, nil
+	}
+
+	collection, err := node.metaReplica.getCollectionByID(req.GetCollectionID())
+	if err != nil {
+		// collection not loaded, the schema is applied when loading
+		log.Info("collection not loaded, skip updating schema")
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+	collection.updateSchema(req.GetSchema())
+
+	segments := make([]*Segment, 0)
+	partitionIDs, err := node.metaReplica.getPartitionIDs(req.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to get partitions", zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	for _, segType := range []segmentType{segmentTypeGrowing, segmentTypeSealed} {
+		for _, partitionID := range partitionIDs {
+			segmentIDs, err := node.metaReplica.getSegmentIDs(partitionID, segType)
+			if err != nil {
+				continue
+			}
+			for _, segmentID := range segmentIDs {
+				segment, err := node.metaReplica.getSegmentByID(segmentID, segType)
+				if err != nil {
+					continue
+				}
+				segments = append(segments, segment)
+			}
+		}
+	}
+	if err := collection.addFieldsToSegments(segments...); err != nil {
+		log.Warn("failed to add fields to segments", zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Info("update collection schema done",
+		zap.Int("numFields", len(req.GetSchema().GetFields())),
+		zap.Int("numSegments", len(segments)))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
@@ -28,8 +28,11 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -1048,3 +1051,105 @@ func TestGetDataDistribution(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_NodeIDNotMatch, resp.GetStatus().GetErrorCode())
 	})
 }
+
+func TestUpdateCollectionSchema(t *testing.T) {
+	t.Run("QueryNode not healthy", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		node, err := genSimpleQueryNode(ctx)
+		require.NoError(t, err)
+		defer node.Stop()
+
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+
+		resp, err := node.UpdateCollectionSchema(ctx, &querypb.UpdateCollectionSchemaRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+	})
+
+	t.Run("Target not match", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		node, err := genSimpleQueryNode(ctx)
+		require.NoError(t, err)
+		defer node.Stop()
+
+		resp, err := node.UpdateCollectionSchema(ctx, &querypb.UpdateCollectionSchemaRequest{
+			Base:         &commonpb.MsgBase{TargetID: -1},
+			CollectionID: defaultCollectionID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NodeIDNotMatch, resp.GetErrorCode())
+	})
+
+	t.Run("collection not loaded", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		node, err := genSimpleQueryNode(ctx)
+		require.NoError(t, err)
+		defer node.Stop()
+
+		resp, err := node.UpdateCollectionSchema(ctx, &querypb.UpdateCollectionSchemaRequest{
+			Base:         &commonpb.MsgBase{TargetID: node.session.ServerID},
+			CollectionID: defaultCollectionID + 1,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("add field to loaded segments", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		node, err := genSimpleQueryNode(ctx)
+		require.NoError(t, err)
+		defer node.Stop()
+
+		err = node.metaReplica.addSegment(defaultSegmentID+1, defaultPartitionID, defaultCollectionID, defaultDMLChannel,
+			defaultSegmentVersion, defaultSegmentStartPosition, segmentTypeGrowing)
+		require.NoError(t, err)
+
+		collection, err := node.metaReplica.getCollectionByID(defaultCollectionID)
+		require.NoError(t, err)
+		schema := proto.Clone(collection.Schema()).(*schemapb.CollectionSchema)
+		var maxFieldID int64
+		for _, field := range schema.GetFields() {
+			if field.GetFieldID() > maxFieldID {
+				maxFieldID = field.GetFieldID()
+			}
+		}
+		schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+			FieldID:    maxFieldID + 1,
+			Name:       "added",
+			DataType:   schemapb.DataType_Int64,
+			TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "7"}},
+		})
+
+		sealed, err := node.metaReplica.getSegmentByID(defaultSegmentID, segmentTypeSealed)
+		require.NoError(t, err)
+		rowCount := sealed.getRowCount()
+
+		resp, err := node.UpdateCollectionSchema(ctx, &querypb.UpdateCollectionSchemaRequest{
+			Base:         &commonpb.MsgBase{TargetID: node.session.ServerID},
+			CollectionID: defaultCollectionID,
+			Schema:       schema,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, len(schema.GetFields()), len(collection.Schema().GetFields()))
+
+		assert.Equal(t, len(schema.GetFields()), len(sealed.getSchema().GetFields()))
+		assert.Equal(t, rowCount, sealed.getRowCount())
+		growing, err := node.metaReplica.getSegmentByID(defaultSegmentID+1, segmentTypeGrowing)
+		require.NoError(t, err)
+		assert.Equal(t, len(schema.GetFields()), len(growing.getSchema().GetFields()))
+
+		// applying the same schema again changes nothing
+		resp, err = node.UpdateCollectionSchema(ctx, &querypb.UpdateCollectionSchemaRequest{
+			Base:         &commonpb.MsgBase{TargetID: node.session.ServerID},
+			CollectionID: defaultCollectionID,
+			Schema:       schema,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
@@ -201,11 +201,14 @@ func (replica *metaReplica) getCollectionIDs() []UniqueID {
 // addCollection creates a new collection and add it to collectionReplica
 func (replica *metaReplica) addCollection(collectionID UniqueID, schema *schemapb.CollectionSchema) *Collection {
 	replica.mu.Lock()
-	defer replica.mu.Unlock()
-
 	if col, ok := replica.collections[collectionID]; ok {
+		replica.mu.Unlock()
+		// fields may be added to the collection since it's loaded,
+		// update it without replica lock as it waits for the ongoing insertion
+		col.updateSchema(schema)
 		return col
 	}
+	defer replica.mu.Unlock()
 
 	var newC = newCollection(collectionID, schema)
 	replica.collections[collectionID] = newC
@@ -1264,7 +1264,7 @@ func genSealedSegment(schema *schemapb.CollectionSchema,
 	numRows := insertRecord.NumRows
 	for _, fieldData := range insertRecord.FieldsData {
 		fieldID := fieldData.FieldId
-		err := seg.segmentLoadFieldData(fieldID, numRows, fieldData)
+		err := seg.segmentLoadFieldData(fieldID, numRows, fieldData, nil)
 		if err != nil {
 			// TODO: return or continue?
 			return nil, err
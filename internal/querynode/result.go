@@ -142,6 +142,12 @@ func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 		}
 	}
 
+	srcFieldsData := make([][]*schemapb.FieldData, len(searchResultData))
+	for i, data := range searchResultData {
+		srcFieldsData[i] = data.GetFieldsData()
+	}
+	validMerger := typeutil.NewFieldValidDataMerger(srcFieldsData...)
+
 	var skipDupCnt int64
 	for i := int64(0); i < nq; i++ {
 		offsets := make([]int64, len(searchResultData))
@@ -161,6 +167,7 @@ func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 			// remove duplicates
 			if _, ok := idSet[id]; !ok {
 				typeutil.AppendFieldData(ret.FieldsData, searchResultData[sel].FieldsData, idx)
+				validMerger.Append(sel, idx)
 				typeutil.AppendPKs(ret.Ids, id)
 				ret.Scores = append(ret.Scores, score)
 				idSet[id] = struct{}{}
@@ -178,6 +185,7 @@ func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 		// }
 		ret.Topks = append(ret.Topks, j)
 	}
+	validMerger.Apply(ret.FieldsData)
 	log.Ctx(ctx).Debug("skip duplicated search result", zap.Int64("count", skipDupCnt))
 	return ret, nil
 }
@@ -284,6 +292,11 @@ func mergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 	}
 
 	ret.FieldsData = make([]*schemapb.FieldData, len(validRetrieveResults[0].GetFieldsData()))
+	srcFieldsData := make([][]*schemapb.FieldData, len(validRetrieveResults))
+	for i, r := range validRetrieveResults {
+		srcFieldsData[i] = r.GetFieldsData()
+	}
+	validMerger := typeutil.NewFieldValidDataMerger(srcFieldsData...)
 	idTsMap := make(map[interface{}]uint64)
 	cursors := make([]int64, len(validRetrieveResults))
 	for j := 0; j < loopEnd; j++ {
@@ -297,6 +310,7 @@ func mergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 		if _, ok := idTsMap[pk]; !ok {
 			typeutil.AppendPKs(ret.Ids, pk)
 			typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
+			validMerger.Append(sel, cursors[sel])
 			idTsMap[pk] = ts
 		} else {
 			// primary keys duplicate
@@ -304,11 +318,14 @@ func mergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 			if ts != 0 && ts > idTsMap[pk] {
 				idTsMap[pk] = ts
 				typeutil.DeleteFieldData(ret.FieldsData)
+				validMerger.DeleteLast()
 				typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
+				validMerger.Append(sel, cursors[sel])
 			}
 		}
 		cursors[sel]++
 	}
+	validMerger.Apply(ret.FieldsData)
 
 	if skipDupCnt > 0 {
 		log.Ctx(ctx).Debug("skip duplicated query result while reducing internal.RetrieveResults", zap.Int64("count", skipDupCnt))
@@ -350,6 +367,11 @@ func mergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcore
 	}
 
 	ret.FieldsData = make([]*schemapb.FieldData, len(validRetrieveResults[0].GetFieldsData()))
+	srcFieldsData := make([][]*schemapb.FieldData, len(validRetrieveResults))
+	for i, r := range validRetrieveResults {
+		srcFieldsData[i] = r.GetFieldsData()
+	}
+	validMerger := typeutil.NewFieldValidDataMerger(srcFieldsData...)
 	idSet := make(map[interface{}]struct{})
 	cursors := make([]int64, len(validRetrieveResults))
 	for j := 0; j < loopEnd; j++ {
@@ -362,6 +384,7 @@ func mergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcore
 		if _, ok := idSet[pk]; !ok {
 			typeutil.AppendPKs(ret.Ids, pk)
 			typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
+			validMerger.Append(sel, cursors[sel])
 			idSet[pk] = struct{}{}
 		} else {
 			// primary keys duplicate
@@ -369,6 +392,7 @@ func mergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcore
 		}
 		cursors[sel]++
 	}
+	validMerger.Apply(ret.FieldsData)
 
 	if skipDupCnt > 0 {
 		log.Ctx(ctx).Debug("skip duplicated query result while reducing segcore.RetrieveResults", zap.Int64("count", skipDupCnt))
@@ -84,7 +84,8 @@ type Segment struct {
 	partitionID   UniqueID
 	collectionID  UniqueID
 	version       UniqueID
-	startPosition *internalpb.MsgPosition // for growing segment release
+	startPosition *internalpb.MsgPosition    // for growing segment release
+	schema        *schemapb.CollectionSchema // fields held by segmentPtr, protected by mut
 
 	vChannelID   Channel
 	lastMemSize  int64
@@ -181,6 +182,9 @@ func newSegment(collection *Collection,
 		CSegmentInterface
 		NewSegment(CCollection collection, uint64_t segment_id, SegmentType seg_type);
 	*/
+	// segment is created with the current schema of collection
+	collection.schemaMu.RLock()
+	defer collection.schemaMu.RUnlock()
 	var segmentPtr C.CSegmentInterface
 	switch segType {
 	case segmentTypeSealed:
@@ -218,6 +222,7 @@ func newSegment(collection *Collection,
 		collectionID:      collectionID,
 		version:           version,
 		startPosition:     startPosition,
+		schema:            collection.schema,
 		vChannelID:        vChannelID,
 		indexedFieldInfos: typeutil.NewConcurrentMap[int64, *IndexedFieldInfo](),
 		recentlyModified:  atomic.NewBool(false),
@@ -261,6 +266,112 @@ func deleteSegment(segment *Segment) {
 		zap.String("segmentType", segment.getType().String()))
 }
 
+func (s *Segment) getSchema() *schemapb.CollectionSchema {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+	return s.schema
+}
+
+// addFields appends the fields added to the collection since the segment is created,
+// the existing rows of the segment take the default values of these fields.
+// Caller must hold collection.insertMu to keep insertion away from growing segments.
+func (s *Segment) addFields(collection *Collection) error {
+	collection.schemaMu.RLock()
+	defer collection.schemaMu.RUnlock()
+	collection.mu.RLock()
+	defer collection.mu.RUnlock()
+
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	if !s.healthy() {
+		return fmt.Errorf("%w(segmentID=%d)", ErrSegmentUnhealthy, s.segmentID)
+	}
+	if len(collection.schema.GetFields()) <= len(s.schema.GetFields()) {
+		return nil
+	}
+
+	existFields := make(map[FieldID]struct{}, len(s.schema.GetFields()))
+	for _, field := range s.schema.GetFields() {
+		existFields[field.GetFieldID()] = struct{}{}
+	}
+	var rowCount C.int64_t
+	s.pool.Submit(func() (interface{}, error) {
+		rowCount = C.GetRowCount(s.segmentPtr)
+		return nil, nil
+	}).Await()
+	defaultData := &storage.InsertData{
+		Data: make(map[FieldID]storage.FieldData),
+	}
+	for _, field := range collection.schema.GetFields() {
+		if _, ok := existFields[field.GetFieldID()]; ok {
+			continue
+		}
+		fieldData, err := storage.GenDefaultFieldData(field, int(rowCount))
+		if err != nil {
+			return err
+		}
+		defaultData.Data[field.GetFieldID()] = fieldData
+	}
+	defaultRecord, err := storage.TransferInsertDataToInsertRecord(defaultData)
+	if err != nil {
+		return err
+	}
+	defaultRecord.NumRows = int64(rowCount)
+
+	var status C.CStatus
+	switch s.getType() {
+	case segmentTypeGrowing:
+		/*
+			CStatus
+			AddGrowingSegmentFields(CSegmentInterface c_segment, CCollection c_collection, const uint8_t* data_info, const uint64_t data_info_len);
+		*/
+		defaultRecordBlob, err := proto.Marshal(defaultRecord)
+		if err != nil {
+			return fmt.Errorf("failed to marshal insert record: %s", err)
+		}
+		s.pool.Submit(func() (interface{}, error) {
+			status = C.AddGrowingSegmentFields(s.segmentPtr, collection.collectionPtr,
+				(*C.uint8_t)(unsafe.Pointer(&defaultRecordBlob[0])), (C.uint64_t)(len(defaultRecordBlob)))
+			return nil, nil
+		}).Await()
+		if err := HandleCStatus(&status, "AddGrowingSegmentFields failed"); err != nil {
+			return err
+		}
+	case segmentTypeSealed:
+		/*
+			CStatus
+			AddSealedSegmentFields(CSegmentInterface c_segment, CCollection c_collection);
+		*/
+		s.pool.Submit(func() (interface{}, error) {
+			status = C.AddSealedSegmentFields(s.segmentPtr, collection.collectionPtr)
+			return nil, nil
+		}).Await()
+		if err := HandleCStatus(&status, "AddSealedSegmentFields failed"); err != nil {
+			return err
+		}
+		// fields of the segment being loaded are filled by segmentLoader once the row count is known
+		if rowCount > 0 {
+			for _, fieldData := range defaultRecord.GetFieldsData() {
+				validData := defaultData.Data[fieldData.GetFieldId()].GetValidData()
+				if err := s.loadFieldDataPrivate(fieldData.GetFieldId(), int64(rowCount), fieldData, validData); err != nil {
+					return err
+				}
+			}
+		}
+	default:
+		return fmt.Errorf("illegal segment type %s when add fields to segment %d", s.getType().String(), s.segmentID)
+	}
+	s.schema = collection.schema
+
+	log.Info("add fields to segment",
+		zap.Int64("collectionID", s.collectionID),
+		zap.Int64("segmentID", s.segmentID),
+		zap.String("segmentType", s.getType().String()),
+		zap.Int("numFields", len(defaultData.Data)),
+		zap.Int64("rowCount", int64(rowCount)))
+	return nil
+}
+
 func (s *Segment) getRealCount() int64 {
 	/*
 		int64_t
@@ -838,7 +949,7 @@ func (s *Segment) segmentDelete(offset int64, entityIDs []primaryKey, timestamps
 }
 
 //-------------------------------------------------------------------------------------- interfaces for sealed segment
-func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int64, data *schemapb.FieldData) error {
+func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int64, data *schemapb.FieldData, validData []bool) error {
 	/*
 		CStatus
 		LoadFieldData(CSegmentInterface c_segment, CLoadFieldDataInfo load_field_data_info);
@@ -852,7 +963,11 @@ func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int64, data *sche
 	if !s.healthy() {
 		return fmt.Errorf("%w(segmentID=%d)", ErrSegmentUnhealthy, s.segmentID)
 	}
+	return s.loadFieldDataPrivate(fieldID, rowCount, data, validData)
+}
 
+// loadFieldDataPrivate loads the field data into segmentPtr, caller must hold mut.
+func (s *Segment) loadFieldDataPrivate(fieldID int64, rowCount int64, data *schemapb.FieldData, validData []bool) error {
 	dataBlob, err := proto.Marshal(data)
 	if err != nil {
 		return err
@@ -864,6 +979,9 @@ func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int64, data *sche
 		blob_size: C.uint64_t(len(dataBlob)),
 		row_count: C.int64_t(rowCount),
 	}
+	if len(validData) > 0 {
+		loadInfo.valid_data = (*C.bool)(unsafe.Pointer(&validData[0]))
+	}
 
 	var status C.CStatus
 	s.pool.Submit(func() (interface{}, error) {
@@ -201,6 +201,13 @@ func (loader *segmentLoader) LoadSegment(ctx context.Context, req *querypb.LoadS
 	for _, id := range loadDoneSegmentIDSet.Collect() {
 		segment := newSegments[id]
 		err = loader.metaReplica.setSegment(segment)
+		if err == nil {
+			// UpdateCollectionSchema skips the segments not set to meta replica yet
+			err = loader.addFieldsToSegment(segment)
+			if err != nil {
+				loader.metaReplica.removeSegment(segment.segmentID, segment.getType())
+			}
+		}
 		if err != nil {
 			log.Error("load segment failed, set segment to meta failed",
 				zap.Int64("collectionID", segment.collectionID),
@@ -277,6 +284,9 @@ func (loader *segmentLoader) loadFiles(ctx context.Context, segment *Segment,
 		if err := loader.loadSealedSegmentFields(ctx, segment, fieldBinlogs, loadInfo); err != nil {
 			return err
 		}
+		if err := loader.loadMissingFields(segment, loadInfo); err != nil {
+			return err
+		}
 	} else {
 		if err := loader.loadGrowingSegmentFields(ctx, segment, loadInfo.BinlogPaths); err != nil {
 			return err
@@ -347,6 +357,14 @@ func (loader *segmentLoader) loadGrowingSegmentFields(ctx context.Context, segme
 		log.Warn("failed to deserialize", zap.Int64("segment", segment.segmentID), zap.Error(err))
 		return err
 	}
+	collection, err := loader.metaReplica.getCollectionByID(segment.collectionID)
+	if err != nil {
+		return err
+	}
+	// the binlogs are flushed before fields are added to the collection
+	if err := storage.FillMissingFields(collection.Schema(), insertData); err != nil {
+		return err
+	}
 
 	switch segmentType {
 	case segmentTypeGrowing:
@@ -395,6 +413,56 @@ func (loader *segmentLoader) loadSealedSegmentFields(ctx context.Context, segmen
 	return nil
 }
 
+// loadMissingFields loads the nullable or default-valued fields without binlogs into the sealed segment,
+// the segment is flushed before these fields are added to the collection, the default value is materialised instead.
+// Fields added to the collection while loading are appended to the segment as well.
+func (loader *segmentLoader) loadMissingFields(segment *Segment, loadInfo *querypb.SegmentLoadInfo) error {
+	if len(loadInfo.GetBinlogPaths()) == 0 {
+		return nil
+	}
+	collection, err := loader.metaReplica.getCollectionByID(segment.collectionID)
+	if err != nil {
+		return err
+	}
+
+	existFields := make(map[FieldID]struct{}, len(loadInfo.GetBinlogPaths()))
+	for _, fieldBinlog := range loadInfo.GetBinlogPaths() {
+		existFields[fieldBinlog.GetFieldID()] = struct{}{}
+	}
+	insertData := &storage.InsertData{
+		Data: make(map[FieldID]storage.FieldData),
+	}
+	for _, field := range segment.getSchema().GetFields() {
+		if _, ok := existFields[field.GetFieldID()]; ok || !typeutil.IsFieldOptional(field) {
+			continue
+		}
+		fieldData, err := storage.GenDefaultFieldData(field, int(loadInfo.GetNumOfRows()))
+		if err != nil {
+			return err
+		}
+		insertData.Data[field.GetFieldID()] = fieldData
+	}
+	if len(insertData.Data) > 0 {
+		log.Info("load default value of missing fields for sealed segment",
+			zap.Int64("collection", segment.collectionID),
+			zap.Int64("segment", segment.segmentID),
+			zap.Int("len(field)", len(insertData.Data)))
+		if err := loader.loadSealedSegments(segment, insertData); err != nil {
+			return err
+		}
+	}
+	return collection.addFieldsToSegments(segment)
+}
+
+// addFieldsToSegment appends the fields added to the collection since the segment is created.
+func (loader *segmentLoader) addFieldsToSegment(segment *Segment) error {
+	collection, err := loader.metaReplica.getCollectionByID(segment.collectionID)
+	if err != nil {
+		return err
+	}
+	return collection.addFieldsToSegments(segment)
+}
+
 // async load field of sealed segment
 func (loader *segmentLoader) loadSealedField(ctx context.Context, segment *Segment, field *datapb.FieldBinlog, loadInfo *querypb.SegmentLoadInfo) error {
 	iCodec := storage.InsertCodec{}
@@ -633,7 +701,7 @@ func (loader *segmentLoader) loadSealedSegments(segment *Segment, insertData *st
 			segment.setIDBinlogRowSizes(timestampsData.NumRows)
 		}
 
-		err := segment.segmentLoadFieldData(fieldID, numRows, fieldData)
+		err := segment.segmentLoadFieldData(fieldID, numRows, fieldData, insertData.Data[fieldID].GetValidData())
 		if err != nil {
 			// TODO: return or continue?
 			return err
@@ -24,6 +24,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -82,6 +83,48 @@ func TestSegmentLoader_loadSegment(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("test load segment with added field", func(t *testing.T) {
+		node, err := genSimpleQueryNode(ctx)
+		require.NoError(t, err)
+		defer node.Stop()
+
+		node.metaReplica.removeSegment(defaultSegmentID, segmentTypeSealed)
+		loader := node.loader
+		assert.NotNil(t, loader)
+
+		// the binlogs are flushed before the field is added
+		newSchema := proto.Clone(schema).(*schemapb.CollectionSchema)
+		newSchema.Fields = append(newSchema.Fields, &schemapb.FieldSchema{
+			FieldID:    1000,
+			Name:       "added",
+			DataType:   schemapb.DataType_Int64,
+			TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "7"}},
+		})
+		req := &querypb.LoadSegmentsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_LoadSegments,
+				MsgID:   rand.Int63(),
+			},
+			DstNodeID: 0,
+			Schema:    newSchema,
+			Infos: []*querypb.SegmentLoadInfo{
+				{
+					SegmentID:    defaultSegmentID,
+					PartitionID:  defaultPartitionID,
+					CollectionID: defaultCollectionID,
+					BinlogPaths:  fieldBinlog,
+					Statslogs:    statsLog,
+					NumOfRows:    defaultMsgLength,
+				},
+			},
+		}
+		collection := node.metaReplica.addCollection(defaultCollectionID, newSchema)
+		assert.Equal(t, len(newSchema.GetFields()), len(collection.Schema().GetFields()))
+
+		_, err = loader.LoadSegment(ctx, req, segmentTypeSealed)
+		assert.NoError(t, err)
+	})
+
 	t.Run("test load segment error due to partial success", func(t *testing.T) {
 		node, err := genSimpleQueryNode(ctx)
 		assert.NoError(t, err)
@@ -0,0 +1,116 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"encoding/json"
+	"path"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/cdc"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/kv"
+)
+
+const (
+	// checkpointPrefix is the prefix of the cdc checkpoints of the replicated source collections in the target meta,
+	// the key of a checkpoint is ${checkpointPrefix}/${sourceCollectionID}.
+	checkpointPrefix = "replication/checkpoint"
+	// tsMappingPrefix is the prefix of the ts mappings of the replicated source collections in the target meta,
+	// the key of a mapping is ${tsMappingPrefix}/${sourceCollectionID}.
+	tsMappingPrefix = "replication/ts-mapping"
+
+	// readOnlyKey is the key of the read-only flag in the config path of the target etcd
+	readOnlyKey = "replication/readOnly"
+)
+
+// TsMapping maps the timestamps of a source collection to the timestamps of the target collection,
+// the replicated messages are stamped with timestamps of the target cluster. All the changes of the source collection
+// up to its time tick SourceTs are replicated with target timestamps up to TargetTs, so a read of the target collection
+// at TargetTs or later sees them, e.g. a query whose travel timestamp was a source timestamp before a failover.
+type TsMapping struct {
+	SourceTs Timestamp `json:"source_ts"`
+	TargetTs Timestamp `json:"target_ts"`
+}
+
+// GetTargetTs returns the target timestamp of the mapping, or zero if there is no mapping
+func (m *TsMapping) GetTargetTs() Timestamp {
+	if m == nil {
+		return 0
+	}
+	return m.TargetTs
+}
+
+// checkpointStore persists the cdc checkpoints of the replicated collections, so that replication resumes after restart,
+// with the ts mappings of the checkpoints
+type checkpointStore struct {
+	kv kv.BaseKV
+}
+
+func (s *checkpointStore) key(sourceCollectionID UniqueID) string {
+	return path.Join(checkpointPrefix, strconv.FormatInt(sourceCollectionID, 10))
+}
+
+func (s *checkpointStore) tsMappingKey(sourceCollectionID UniqueID) string {
+	return path.Join(tsMappingPrefix, strconv.FormatInt(sourceCollectionID, 10))
+}
+
+// load returns the checkpoint of the collection, or nil if the collection hasn't been replicated yet
+func (s *checkpointStore) load(sourceCollectionID UniqueID) (*cdc.Checkpoint, error) {
+	value, err := s.kv.Load(s.key(sourceCollectionID))
+	if common.IsKeyNotExistError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return cdc.UnmarshalCheckpoint([]byte(value))
+}
+
+// loadTsMapping returns the ts mapping of the collection, or nil if nothing was replicated yet
+func (s *checkpointStore) loadTsMapping(sourceCollectionID UniqueID) (*TsMapping, error) {
+	value, err := s.kv.Load(s.tsMappingKey(sourceCollectionID))
+	if common.IsKeyNotExistError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	mapping := &TsMapping{}
+	if err := json.Unmarshal([]byte(value), mapping); err != nil {
+		return nil, err
+	}
+	return mapping, nil
+}
+
+// save saves the checkpoint, and its ts mapping to targetTs, the timestamp of the last replicated message,
+// unless nothing was replicated yet
+func (s *checkpointStore) save(cp *cdc.Checkpoint, targetTs Timestamp) error {
+	value, err := cp.Marshal()
+	if err != nil {
+		return err
+	}
+	kvs := map[string]string{s.key(cp.CollectionID): string(value)}
+	if targetTs != 0 {
+		mapping, err := json.Marshal(&TsMapping{SourceTs: cp.Timestamp, TargetTs: targetTs})
+		if err != nil {
+			return err
+		}
+		kvs[s.tsMappingKey(cp.CollectionID)] = string(mapping)
+	}
+	return s.kv.MultiSave(kvs)
+}
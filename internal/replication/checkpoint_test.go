@@ -0,0 +1,68 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/cdc"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+func TestCheckpointStore(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	store := &checkpointStore{kv: kv}
+
+	pos := &internalpb.MsgPosition{ChannelName: "dml_0", MsgID: []byte{1}, Timestamp: 100}
+	require.NoError(t, store.save(&cdc.Checkpoint{
+		CollectionID: 1,
+		Timestamp:    100,
+		Positions:    map[string]*internalpb.MsgPosition{"dml_0_1v0": pos},
+	}, 1000))
+	require.NoError(t, store.save(&cdc.Checkpoint{CollectionID: 10, Timestamp: 200}, 0))
+
+	cp, err := store.load(1)
+	require.NoError(t, err)
+	assert.Equal(t, Timestamp(100), cp.Timestamp)
+	require.Len(t, cp.Positions, 1)
+	assert.True(t, proto.Equal(pos, cp.Positions["dml_0_1v0"]))
+
+	cp, err = store.load(2)
+	require.NoError(t, err)
+	assert.Nil(t, cp)
+
+	require.NoError(t, kv.Save(store.key(3), "invalid"))
+	_, err = store.load(3)
+	assert.Error(t, err)
+
+	// ts mappings
+	mapping, err := store.loadTsMapping(1)
+	require.NoError(t, err)
+	assert.Equal(t, &TsMapping{SourceTs: 100, TargetTs: 1000}, mapping)
+	mapping, err = store.loadTsMapping(10)
+	require.NoError(t, err)
+	assert.Nil(t, mapping)
+	assert.Equal(t, Timestamp(0), mapping.GetTargetTs())
+	require.NoError(t, kv.Save(store.tsMappingKey(3), "invalid"))
+	_, err = store.loadTsMapping(3)
+	assert.Error(t, err)
+}
@@ -0,0 +1,461 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func statusError(status *commonpb.Status) error {
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(status.GetReason())
+	}
+	return nil
+}
+
+// userFields returns the fields of the schema except the system fields
+func userFields(schema *schemapb.CollectionSchema) []*schemapb.FieldSchema {
+	fields := make([]*schemapb.FieldSchema, 0, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() >= common.StartOfUserFieldID {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// collectionMapping maps the IDs of a collection in the source cluster to the IDs of its replica in the target cluster.
+// Collections, partitions and fields are matched by name, virtual channels by shard index.
+type collectionMapping struct {
+	name      string
+	source    *milvuspb.DescribeCollectionResponse
+	target    *milvuspb.DescribeCollectionResponse
+	fieldIDs  map[UniqueID]UniqueID
+	channels  map[string]string
+	rootCoord RootCoord
+
+	mut        sync.Mutex
+	partitions map[UniqueID]UniqueID
+}
+
+// newCollectionMapping describes the collection in both clusters, the target collection is created if it doesn't exist
+func newCollectionMapping(ctx context.Context, source, target RootCoord, name string) (*collectionMapping, error) {
+	sourceColl, err := describeCollection(ctx, source, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe collection %s in source cluster, err: %w", name, err)
+	}
+
+	exist, err := hasCollection(ctx, target, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collections in target cluster, err: %w", err)
+	}
+	if !exist {
+		if err := createCollection(ctx, target, sourceColl); err != nil {
+			return nil, fmt.Errorf("failed to create collection %s in target cluster, err: %w", name, err)
+		}
+	}
+	targetColl, err := describeCollection(ctx, target, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe collection %s in target cluster, err: %w", name, err)
+	}
+
+	m := &collectionMapping{
+		name:       name,
+		source:     sourceColl,
+		target:     targetColl,
+		fieldIDs:   make(map[UniqueID]UniqueID),
+		channels:   make(map[string]string),
+		rootCoord:  target,
+		partitions: make(map[UniqueID]UniqueID),
+	}
+	if err := m.mapFields(); err != nil {
+		return nil, err
+	}
+	if err := m.mapChannels(); err != nil {
+		return nil, err
+	}
+	if err := m.mapPartitions(ctx, source); err != nil {
+		return nil, err
+	}
+	log.Info("replication collection mapped",
+		zap.String("collection", name),
+		zap.Int64("source collection ID", sourceColl.GetCollectionID()),
+		zap.Int64("target collection ID", targetColl.GetCollectionID()),
+		zap.Any("channels", m.channels),
+		zap.Bool("created", !exist))
+	return m, nil
+}
+
+func describeCollection(ctx context.Context, rc RootCoord, name string) (*milvuspb.DescribeCollectionResponse, error) {
+	resp, err := rc.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_DescribeCollection)),
+		CollectionName: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := statusError(resp.GetStatus()); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func hasCollection(ctx context.Context, rc RootCoord, name string) (bool, error) {
+	resp, err := rc.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
+		Base: commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_ShowCollections)),
+	})
+	if err != nil {
+		return false, err
+	}
+	if err := statusError(resp.GetStatus()); err != nil {
+		return false, err
+	}
+	for _, collName := range resp.GetCollectionNames() {
+		if collName == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// createCollection creates the replica with the same schema and number of shards as the source collection
+func createCollection(ctx context.Context, rc RootCoord, source *milvuspb.DescribeCollectionResponse) error {
+	schema := proto.Clone(source.GetSchema()).(*schemapb.CollectionSchema)
+	schema.Fields = userFields(schema)
+	marshaledSchema, err := proto.Marshal(schema)
+	if err != nil {
+		return err
+	}
+	status, err := rc.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		Base:             commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_CreateCollection)),
+		CollectionName:   source.GetSchema().GetName(),
+		Schema:           marshaledSchema,
+		ShardsNum:        int32(len(source.GetVirtualChannelNames())),
+		ConsistencyLevel: source.GetConsistencyLevel(),
+		Properties:       source.GetProperties(),
+	})
+	if err != nil {
+		return err
+	}
+	return statusError(status)
+}
+
+// mapFields requires the user fields of both collections to have the same names and types in the same order,
+// so that row based data can be replicated as it is.
+func (m *collectionMapping) mapFields() error {
+	sourceFields := userFields(m.source.GetSchema())
+	targetFields := userFields(m.target.GetSchema())
+	if len(sourceFields) != len(targetFields) {
+		return fmt.Errorf("collection %s has %d fields in source cluster but %d fields in target cluster",
+			m.name, len(sourceFields), len(targetFields))
+	}
+	for i, sourceField := range sourceFields {
+		targetField := targetFields[i]
+		if sourceField.GetName() != targetField.GetName() || sourceField.GetDataType() != targetField.GetDataType() {
+			return fmt.Errorf("field %d of collection %s is %s(%s) in source cluster but %s(%s) in target cluster",
+				i, m.name, sourceField.GetName(), sourceField.GetDataType(), targetField.GetName(), targetField.GetDataType())
+		}
+		m.fieldIDs[sourceField.GetFieldID()] = targetField.GetFieldID()
+	}
+	return nil
+}
+
+func (m *collectionMapping) mapChannels() error {
+	sourceChannels := m.source.GetVirtualChannelNames()
+	targetChannels := m.target.GetVirtualChannelNames()
+	if len(sourceChannels) != len(targetChannels) {
+		return fmt.Errorf("collection %s has %d shards in source cluster but %d shards in target cluster",
+			m.name, len(sourceChannels), len(targetChannels))
+	}
+	for i, vchannel := range sourceChannels {
+		m.channels[vchannel] = targetChannels[i]
+	}
+	return nil
+}
+
+func (m *collectionMapping) mapPartitions(ctx context.Context, source RootCoord) error {
+	sourcePartitions, err := showPartitions(ctx, source, m.name)
+	if err != nil {
+		return fmt.Errorf("failed to show partitions of collection %s in source cluster, err: %w", m.name, err)
+	}
+	for partitionName, partitionID := range sourcePartitions {
+		if _, err := m.targetPartitionID(ctx, partitionID, partitionName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func showPartitions(ctx context.Context, rc RootCoord, collectionName string) (map[string]UniqueID, error) {
+	resp, err := rc.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
+		Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_ShowPartitions)),
+		CollectionName: collectionName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := statusError(resp.GetStatus()); err != nil {
+		return nil, err
+	}
+	partitions := make(map[string]UniqueID, len(resp.GetPartitionNames()))
+	for i, partitionName := range resp.GetPartitionNames() {
+		partitions[partitionName] = resp.GetPartitionIDs()[i]
+	}
+	return partitions, nil
+}
+
+// targetPartitionID returns the ID of the partition in target cluster, the partition is created if it doesn't exist.
+// Partitions are created when their creation is replicated, or when they are first written
+// if they were created before the checkpoint of the collection.
+func (m *collectionMapping) targetPartitionID(ctx context.Context, sourcePartitionID UniqueID, partitionName string) (UniqueID, error) {
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	if partitionID, ok := m.partitions[sourcePartitionID]; ok {
+		return partitionID, nil
+	}
+
+	targetPartitions, err := showPartitions(ctx, m.rootCoord, m.name)
+	if err != nil {
+		return 0, fmt.Errorf("failed to show partitions of collection %s in target cluster, err: %w", m.name, err)
+	}
+	if _, ok := targetPartitions[partitionName]; !ok {
+		status, err := m.rootCoord.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+			Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_CreatePartition)),
+			CollectionName: m.name,
+			PartitionName:  partitionName,
+		})
+		if err == nil {
+			err = statusError(status)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to create partition %s of collection %s in target cluster, err: %w", partitionName, m.name, err)
+		}
+		if targetPartitions, err = showPartitions(ctx, m.rootCoord, m.name); err != nil {
+			return 0, fmt.Errorf("failed to show partitions of collection %s in target cluster, err: %w", m.name, err)
+		}
+	}
+	partitionID, ok := targetPartitions[partitionName]
+	if !ok {
+		return 0, fmt.Errorf("partition %s of collection %s not found in target cluster", partitionName, m.name)
+	}
+	m.partitions[sourcePartitionID] = partitionID
+	log.Info("replication partition mapped",
+		zap.String("collection", m.name),
+		zap.String("partition", partitionName),
+		zap.Int64("source partition ID", sourcePartitionID),
+		zap.Int64("target partition ID", partitionID))
+	return partitionID, nil
+}
+
+// dropPartition drops the partition in target cluster if it exists
+func (m *collectionMapping) dropPartition(ctx context.Context, sourcePartitionID UniqueID, partitionName string) error {
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	targetPartitions, err := showPartitions(ctx, m.rootCoord, m.name)
+	if err != nil {
+		return err
+	}
+	if _, ok := targetPartitions[partitionName]; ok {
+		status, err := m.rootCoord.DropPartition(ctx, &milvuspb.DropPartitionRequest{
+			Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_DropPartition)),
+			CollectionName: m.name,
+			PartitionName:  partitionName,
+		})
+		if err != nil {
+			return err
+		}
+		if err := statusError(status); err != nil {
+			return err
+		}
+	}
+	delete(m.partitions, sourcePartitionID)
+	return nil
+}
+
+// dropCollection drops the collection in target cluster if it exists
+func (m *collectionMapping) dropCollection(ctx context.Context) error {
+	exist, err := hasCollection(ctx, m.rootCoord, m.name)
+	if err != nil || !exist {
+		return err
+	}
+	status, err := m.rootCoord.DropCollection(ctx, &milvuspb.DropCollectionRequest{
+		Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_DropCollection)),
+		CollectionName: m.name,
+	})
+	if err != nil {
+		return err
+	}
+	return statusError(status)
+}
+
+// syncAliases makes the aliases of the target collection the same as the aliases of the source collection.
+// Aliases aren't broadcast to the dml channels, so they are synced periodically instead of replicated.
+func (m *collectionMapping) syncAliases(ctx context.Context, source RootCoord) error {
+	sourceColl, err := describeCollection(ctx, source, m.name)
+	if err != nil {
+		return fmt.Errorf("failed to describe collection %s in source cluster, err: %w", m.name, err)
+	}
+	targetColl, err := describeCollection(ctx, m.rootCoord, m.name)
+	if err != nil {
+		return fmt.Errorf("failed to describe collection %s in target cluster, err: %w", m.name, err)
+	}
+	sourceAliases := typeutil.NewSet(sourceColl.GetAliases()...)
+	targetAliases := typeutil.NewSet(targetColl.GetAliases()...)
+
+	for alias := range targetAliases.Complement(sourceAliases) {
+		status, err := m.rootCoord.DropAlias(ctx, &milvuspb.DropAliasRequest{
+			Base:  commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_DropAlias)),
+			Alias: alias,
+		})
+		if err == nil {
+			err = statusError(status)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to drop alias %s of collection %s in target cluster, err: %w", alias, m.name, err)
+		}
+		log.Info("replication dropped alias", zap.String("collection", m.name), zap.String("alias", alias))
+	}
+	for alias := range sourceAliases.Complement(targetAliases) {
+		status, err := m.rootCoord.CreateAlias(ctx, &milvuspb.CreateAliasRequest{
+			Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_CreateAlias)),
+			CollectionName: m.name,
+			Alias:          alias,
+		})
+		if err == nil {
+			err = statusError(status)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create alias %s of collection %s in target cluster, err: %w", alias, m.name, err)
+		}
+		log.Info("replication created alias", zap.String("collection", m.name), zap.String("alias", alias))
+	}
+	return nil
+}
+
+// syncIndexes makes the indexes of the target collection the same as the indexes of the source collection.
+// Indexes aren't broadcast to the dml channels, so they are synced periodically instead of replicated.
+func (m *collectionMapping) syncIndexes(ctx context.Context, source, target IndexCoord) error {
+	sourceIndexes, err := describeIndexes(ctx, source, m.source.GetCollectionID())
+	if err != nil {
+		return fmt.Errorf("failed to describe indexes of collection %s in source cluster, err: %w", m.name, err)
+	}
+	targetIndexes, err := describeIndexes(ctx, target, m.target.GetCollectionID())
+	if err != nil {
+		return fmt.Errorf("failed to describe indexes of collection %s in target cluster, err: %w", m.name, err)
+	}
+
+	for name := range targetIndexes {
+		if _, ok := sourceIndexes[name]; ok {
+			continue
+		}
+		status, err := target.DropIndex(ctx, &indexpb.DropIndexRequest{
+			CollectionID: m.target.GetCollectionID(),
+			IndexName:    name,
+		})
+		if err == nil {
+			err = statusError(status)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to drop index %s of collection %s in target cluster, err: %w", name, m.name, err)
+		}
+		log.Info("replication dropped index", zap.String("collection", m.name), zap.String("index", name))
+	}
+	for name, index := range sourceIndexes {
+		if _, ok := targetIndexes[name]; ok {
+			continue
+		}
+		fieldID, ok := m.fieldIDs[index.GetFieldID()]
+		if !ok {
+			return fmt.Errorf("field %d of index %s not found in collection %s", index.GetFieldID(), name, m.name)
+		}
+		ts, err := allocTimestamps(ctx, m.rootCoord, 1)
+		if err != nil {
+			return err
+		}
+		status, err := target.CreateIndex(ctx, &indexpb.CreateIndexRequest{
+			CollectionID:    m.target.GetCollectionID(),
+			FieldID:         fieldID,
+			IndexName:       name,
+			TypeParams:      index.GetTypeParams(),
+			IndexParams:     index.GetIndexParams(),
+			Timestamp:       ts,
+			IsAutoIndex:     index.GetIsAutoIndex(),
+			UserIndexParams: index.GetUserIndexParams(),
+		})
+		if err == nil {
+			err = statusError(status)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create index %s of collection %s in target cluster, err: %w", name, m.name, err)
+		}
+		log.Info("replication created index", zap.String("collection", m.name), zap.String("index", name))
+	}
+	return nil
+}
+
+// describeIndexes returns the indexes of the collection by name
+func describeIndexes(ctx context.Context, ic IndexCoord, collectionID UniqueID) (map[string]*indexpb.IndexInfo, error) {
+	resp, err := ic.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{CollectionID: collectionID})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() == commonpb.ErrorCode_IndexNotExist {
+		return map[string]*indexpb.IndexInfo{}, nil
+	}
+	if err := statusError(resp.GetStatus()); err != nil {
+		return nil, err
+	}
+	indexes := make(map[string]*indexpb.IndexInfo, len(resp.GetIndexInfos()))
+	for _, index := range resp.GetIndexInfos() {
+		indexes[index.GetIndexName()] = index
+	}
+	return indexes, nil
+}
+
+// allocTimestamps allocates count consecutive timestamps from the TSO of the cluster and returns the first one
+func allocTimestamps(ctx context.Context, rc RootCoord, count uint32) (Timestamp, error) {
+	resp, err := rc.AllocTimestamp(ctx, &rootcoordpb.AllocTimestampRequest{
+		Base:  commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_RequestTSO)),
+		Count: count,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := statusError(resp.GetStatus()); err != nil {
+		return 0, err
+	}
+	if resp.GetCount() < count {
+		return 0, fmt.Errorf("only %d of %d timestamps are allocated", resp.GetCount(), count)
+	}
+	return resp.GetTimestamp(), nil
+}
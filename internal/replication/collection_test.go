@@ -0,0 +1,161 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+func TestNewCollectionMapping(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("create target collection", func(t *testing.T) {
+		source, target := newMockRootCoord(1000, 0), newMockRootCoord(2000, 10)
+		sourceColl := source.addCollection("test", 2)
+		sourceColl.partitions["p1"] = 1
+
+		m, err := newCollectionMapping(ctx, source, target, "test")
+		require.NoError(t, err)
+		targetColl := target.collections["test"]
+		require.NotNil(t, targetColl)
+		assert.Equal(t, targetColl.id, m.target.GetCollectionID())
+		assert.Equal(t, map[UniqueID]UniqueID{100: 110, 101: 111}, m.fieldIDs)
+		assert.Equal(t, map[string]string{
+			sourceColl.vchannels[0]: targetColl.vchannels[0],
+			sourceColl.vchannels[1]: targetColl.vchannels[1],
+		}, m.channels)
+		assert.Equal(t, map[UniqueID]UniqueID{
+			sourceColl.partitions["_default"]: targetColl.partitions["_default"],
+			1:                                 targetColl.partitions["p1"],
+		}, m.partitions)
+	})
+
+	t.Run("existing target collection", func(t *testing.T) {
+		source, target := newMockRootCoord(1000, 0), newMockRootCoord(2000, 10)
+		source.addCollection("test", 2)
+		targetColl := target.addCollection("test", 2)
+
+		m, err := newCollectionMapping(ctx, source, target, "test")
+		require.NoError(t, err)
+		assert.Equal(t, targetColl.id, m.target.GetCollectionID())
+	})
+
+	t.Run("source collection not found", func(t *testing.T) {
+		_, err := newCollectionMapping(ctx, newMockRootCoord(1000, 0), newMockRootCoord(2000, 10), "test")
+		assert.Error(t, err)
+	})
+
+	t.Run("target cluster unavailable", func(t *testing.T) {
+		source, target := newMockRootCoord(1000, 0), newMockRootCoord(2000, 10)
+		source.addCollection("test", 2)
+		target.showErr = errors.New("mock error")
+		_, err := newCollectionMapping(ctx, source, target, "test")
+		assert.Error(t, err)
+	})
+
+	t.Run("shards mismatch", func(t *testing.T) {
+		source, target := newMockRootCoord(1000, 0), newMockRootCoord(2000, 10)
+		source.addCollection("test", 2)
+		target.addCollection("test", 1)
+		_, err := newCollectionMapping(ctx, source, target, "test")
+		assert.Error(t, err)
+	})
+
+	t.Run("fields mismatch", func(t *testing.T) {
+		source, target := newMockRootCoord(1000, 0), newMockRootCoord(2000, 10)
+		source.addCollection("test", 2)
+		target.addCollection("test", 2).schema.Fields[3].DataType = schemapb.DataType_BinaryVector
+		_, err := newCollectionMapping(ctx, source, target, "test")
+		assert.Error(t, err)
+
+		target.collections["test"].schema.Fields = target.collections["test"].schema.Fields[:3]
+		_, err = newCollectionMapping(ctx, source, target, "test")
+		assert.Error(t, err)
+	})
+}
+
+func TestCollectionMapping_Partitions(t *testing.T) {
+	ctx := context.Background()
+	source, target := newMockRootCoord(1000, 0), newMockRootCoord(2000, 10)
+	source.addCollection("test", 1)
+	m, err := newCollectionMapping(ctx, source, target, "test")
+	require.NoError(t, err)
+
+	// partitions created after the mapping are created when first written
+	partitionID, err := m.targetPartitionID(ctx, 1, "p1")
+	require.NoError(t, err)
+	assert.Equal(t, target.collections["test"].partitions["p1"], partitionID)
+	again, err := m.targetPartitionID(ctx, 1, "p1")
+	require.NoError(t, err)
+	assert.Equal(t, partitionID, again)
+
+	require.NoError(t, m.dropPartition(ctx, 1, "p1"))
+	assert.NotContains(t, target.collections["test"].partitions, "p1")
+	assert.NotContains(t, m.partitions, UniqueID(1))
+	// dropping twice is fine, the drop may be replayed after restart
+	assert.NoError(t, m.dropPartition(ctx, 1, "p1"))
+
+	require.NoError(t, m.dropCollection(ctx))
+	assert.NotContains(t, target.collections, "test")
+	assert.NoError(t, m.dropCollection(ctx))
+	assert.Equal(t, []string{"test"}, target.dropped)
+
+	_, err = m.targetPartitionID(ctx, 2, "p2")
+	assert.Error(t, err)
+}
+
+func TestCollectionMapping_SyncMeta(t *testing.T) {
+	ctx := context.Background()
+	source, target := newMockRootCoord(1000, 0), newMockRootCoord(2000, 10)
+	sourceColl := source.addCollection("test", 1)
+	m, err := newCollectionMapping(ctx, source, target, "test")
+	require.NoError(t, err)
+	targetColl := target.collections["test"]
+
+	sourceIndexCoord, targetIndexCoord := newMockIndexCoord(), newMockIndexCoord()
+	require.NoError(t, m.syncIndexes(ctx, sourceIndexCoord, targetIndexCoord))
+	assert.Empty(t, targetIndexCoord.indexes[targetColl.id])
+
+	indexParams := []*commonpb.KeyValuePair{{Key: "index_type", Value: "FLAT"}}
+	sourceIndexCoord.CreateIndex(ctx, &indexpb.CreateIndexRequest{CollectionID: sourceColl.id, FieldID: 101, IndexName: "vec_idx", IndexParams: indexParams})
+	targetIndexCoord.CreateIndex(ctx, &indexpb.CreateIndexRequest{CollectionID: targetColl.id, FieldID: 110, IndexName: "dropped_idx"})
+	sourceColl.aliases = []string{"a1", "a2"}
+	targetColl.aliases = []string{"a2", "a3"}
+
+	require.NoError(t, m.syncIndexes(ctx, sourceIndexCoord, targetIndexCoord))
+	index := targetIndexCoord.index(targetColl.id, "vec_idx")
+	require.NotNil(t, index)
+	assert.Equal(t, UniqueID(111), index.GetFieldID())
+	assert.Equal(t, indexParams, index.GetIndexParams())
+	assert.Nil(t, targetIndexCoord.index(targetColl.id, "dropped_idx"))
+
+	require.NoError(t, m.syncAliases(ctx, source))
+	assert.ElementsMatch(t, []string{"a1", "a2"}, targetColl.aliases)
+
+	// the field of the index must be mapped
+	sourceIndexCoord.CreateIndex(ctx, &indexpb.CreateIndexRequest{CollectionID: sourceColl.id, FieldID: 999, IndexName: "unknown_idx"})
+	assert.Error(t, m.syncIndexes(ctx, sourceIndexCoord, targetIndexCoord))
+}
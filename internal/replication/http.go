@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/management"
+	"github.com/milvus-io/milvus/internal/management/healthz"
+)
+
+// RegisterHTTPHandlers registers the management endpoints of the replicator
+func (r *Replicator) RegisterHTTPHandlers() {
+	management.Register(&management.HTTPHandler{
+		Path:        management.ReplicationStatusRouterPath,
+		HandlerFunc: r.handleStatus,
+	})
+	management.Register(&management.HTTPHandler{
+		Path:        management.ReplicationPauseRouterPath,
+		HandlerFunc: r.handlePause,
+	})
+	management.Register(&management.HTTPHandler{
+		Path:        management.ReplicationResumeRouterPath,
+		HandlerFunc: r.handleResume,
+	})
+	management.Register(&management.HTTPHandler{
+		Path:        management.ReplicationFailoverRouterPath,
+		HandlerFunc: r.handleFailover,
+	})
+}
+
+func (r *Replicator) handleStatus(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		management.WriteError(w, http.StatusMethodNotAllowed, "only GET is allowed")
+		return
+	}
+	bs, err := json.Marshal(r.Status())
+	if err != nil {
+		management.WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set(healthz.ContentTypeHeader, healthz.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write(bs)
+}
+
+func (r *Replicator) handlePause(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		management.WriteError(w, http.StatusMethodNotAllowed, "only POST is allowed")
+		return
+	}
+	r.Pause()
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *Replicator) handleResume(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		management.WriteError(w, http.StatusMethodNotAllowed, "only POST is allowed")
+		return
+	}
+	r.Resume()
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *Replicator) handleFailover(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		management.WriteError(w, http.StatusMethodNotAllowed, "only POST is allowed")
+		return
+	}
+	force := false
+	if value := req.URL.Query().Get("force"); value != "" {
+		var err error
+		if force, err = strconv.ParseBool(value); err != nil {
+			management.WriteError(w, http.StatusBadRequest, "invalid force: "+value)
+			return
+		}
+	}
+	if err := r.Failover(req.Context(), force); err != nil {
+		log.Warn("replication failover failed", zap.Error(err))
+		management.WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,478 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+)
+
+func successStatus() *commonpb.Status {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+}
+
+func failStatus(reason string) *commonpb.Status {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: reason}
+}
+
+type mockCollection struct {
+	id         UniqueID
+	schema     *schemapb.CollectionSchema
+	vchannels  []string
+	partitions map[string]UniqueID
+	aliases    []string
+}
+
+// mockRootCoord keeps the collections of a cluster in memory
+type mockRootCoord struct {
+	mut         sync.Mutex
+	nextID      UniqueID
+	channelBase int
+	collections map[string]*mockCollection
+	dropped     []string
+	showErr     error
+	nextTs      Timestamp
+	timeTicks   []*internalpb.ChannelTimeTickMsg
+}
+
+func newMockRootCoord(nextID UniqueID, channelBase int) *mockRootCoord {
+	return &mockRootCoord{
+		nextID:      nextID,
+		nextTs:      Timestamp(nextID),
+		channelBase: channelBase,
+		collections: make(map[string]*mockCollection),
+	}
+}
+
+func (rc *mockRootCoord) allocID() UniqueID {
+	rc.nextID++
+	return rc.nextID
+}
+
+// addCollection adds a collection with a pk and a vector field and the default partition
+func (rc *mockRootCoord) addCollection(name string, shards int) *mockCollection {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	schema := &schemapb.CollectionSchema{
+		Name: name,
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 0, Name: "RowID", DataType: schemapb.DataType_Int64},
+			{FieldID: 1, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+			{Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+		},
+	}
+	return rc.createCollection(schema, shards)
+}
+
+func (rc *mockRootCoord) createCollection(schema *schemapb.CollectionSchema, shards int) *mockCollection {
+	coll := &mockCollection{
+		id:         rc.allocID(),
+		schema:     proto.Clone(schema).(*schemapb.CollectionSchema),
+		partitions: map[string]UniqueID{"_default": rc.allocID()},
+	}
+	// field IDs differ between clusters of different channel bases
+	for i, field := range coll.schema.Fields[2:] {
+		field.FieldID = common.StartOfUserFieldID + UniqueID(rc.channelBase+i)
+	}
+	for i := 0; i < shards; i++ {
+		coll.vchannels = append(coll.vchannels, fmt.Sprintf("dml_%d_%dv%d", rc.channelBase+i, coll.id, i))
+	}
+	rc.collections[schema.GetName()] = coll
+	return coll
+}
+
+func (rc *mockRootCoord) ShowCollections(ctx context.Context, req *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	if rc.showErr != nil {
+		return nil, rc.showErr
+	}
+	resp := &milvuspb.ShowCollectionsResponse{Status: successStatus()}
+	for name := range rc.collections {
+		resp.CollectionNames = append(resp.CollectionNames, name)
+	}
+	return resp, nil
+}
+
+func (rc *mockRootCoord) DescribeCollection(ctx context.Context, req *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	coll, ok := rc.collections[req.GetCollectionName()]
+	if !ok {
+		return &milvuspb.DescribeCollectionResponse{Status: failStatus("collection not found")}, nil
+	}
+	resp := &milvuspb.DescribeCollectionResponse{
+		Status:              successStatus(),
+		Schema:              coll.schema,
+		CollectionID:        coll.id,
+		VirtualChannelNames: coll.vchannels,
+		Aliases:             coll.aliases,
+	}
+	for i := range coll.vchannels {
+		pchannel := fmt.Sprintf("dml_%d", rc.channelBase+i)
+		resp.PhysicalChannelNames = append(resp.PhysicalChannelNames, pchannel)
+		resp.StartPositions = append(resp.StartPositions, &commonpb.KeyDataPair{Key: pchannel, Data: msgID(1).Serialize()})
+	}
+	return resp, nil
+}
+
+func (rc *mockRootCoord) CreateCollection(ctx context.Context, req *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	schema := &schemapb.CollectionSchema{}
+	if err := proto.Unmarshal(req.GetSchema(), schema); err != nil {
+		return nil, err
+	}
+	if _, ok := rc.collections[req.GetCollectionName()]; ok {
+		return failStatus("collection already exists"), nil
+	}
+	schema.Fields = append([]*schemapb.FieldSchema{
+		{FieldID: 0, Name: "RowID", DataType: schemapb.DataType_Int64},
+		{FieldID: 1, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+	}, schema.Fields...)
+	rc.createCollection(schema, int(req.GetShardsNum()))
+	return successStatus(), nil
+}
+
+func (rc *mockRootCoord) DropCollection(ctx context.Context, req *milvuspb.DropCollectionRequest) (*commonpb.Status, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	delete(rc.collections, req.GetCollectionName())
+	rc.dropped = append(rc.dropped, req.GetCollectionName())
+	return successStatus(), nil
+}
+
+func (rc *mockRootCoord) ShowPartitions(ctx context.Context, req *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	coll, ok := rc.collections[req.GetCollectionName()]
+	if !ok {
+		return &milvuspb.ShowPartitionsResponse{Status: failStatus("collection not found")}, nil
+	}
+	resp := &milvuspb.ShowPartitionsResponse{Status: successStatus()}
+	for name, id := range coll.partitions {
+		resp.PartitionNames = append(resp.PartitionNames, name)
+		resp.PartitionIDs = append(resp.PartitionIDs, id)
+	}
+	return resp, nil
+}
+
+func (rc *mockRootCoord) CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	coll, ok := rc.collections[req.GetCollectionName()]
+	if !ok {
+		return failStatus("collection not found"), nil
+	}
+	coll.partitions[req.GetPartitionName()] = rc.allocID()
+	return successStatus(), nil
+}
+
+func (rc *mockRootCoord) DropPartition(ctx context.Context, req *milvuspb.DropPartitionRequest) (*commonpb.Status, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	coll, ok := rc.collections[req.GetCollectionName()]
+	if !ok {
+		return failStatus("collection not found"), nil
+	}
+	delete(coll.partitions, req.GetPartitionName())
+	return successStatus(), nil
+}
+
+func (rc *mockRootCoord) CreateAlias(ctx context.Context, req *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	coll, ok := rc.collections[req.GetCollectionName()]
+	if !ok {
+		return failStatus("collection not found"), nil
+	}
+	coll.aliases = append(coll.aliases, req.GetAlias())
+	return successStatus(), nil
+}
+
+func (rc *mockRootCoord) DropAlias(ctx context.Context, req *milvuspb.DropAliasRequest) (*commonpb.Status, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	for _, coll := range rc.collections {
+		for i, alias := range coll.aliases {
+			if alias == req.GetAlias() {
+				coll.aliases = append(coll.aliases[:i], coll.aliases[i+1:]...)
+				return successStatus(), nil
+			}
+		}
+	}
+	return failStatus("alias not found"), nil
+}
+
+func (rc *mockRootCoord) AllocTimestamp(ctx context.Context, req *rootcoordpb.AllocTimestampRequest) (*rootcoordpb.AllocTimestampResponse, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	resp := &rootcoordpb.AllocTimestampResponse{Status: successStatus(), Timestamp: rc.nextTs, Count: req.GetCount()}
+	rc.nextTs += Timestamp(req.GetCount())
+	return resp, nil
+}
+
+func (rc *mockRootCoord) UpdateChannelTimeTick(ctx context.Context, req *internalpb.ChannelTimeTickMsg) (*commonpb.Status, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+	rc.timeTicks = append(rc.timeTicks, req)
+	return successStatus(), nil
+}
+
+// mockIndexCoord keeps the indexes of a cluster in memory, keyed by collection ID and index name
+type mockIndexCoord struct {
+	mut     sync.Mutex
+	indexes map[UniqueID]map[string]*indexpb.IndexInfo
+}
+
+func newMockIndexCoord() *mockIndexCoord {
+	return &mockIndexCoord{indexes: make(map[UniqueID]map[string]*indexpb.IndexInfo)}
+}
+
+func (ic *mockIndexCoord) DescribeIndex(ctx context.Context, req *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+	ic.mut.Lock()
+	defer ic.mut.Unlock()
+	if len(ic.indexes[req.GetCollectionID()]) == 0 {
+		return &indexpb.DescribeIndexResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_IndexNotExist, Reason: "index not exist"},
+		}, nil
+	}
+	resp := &indexpb.DescribeIndexResponse{Status: successStatus()}
+	for _, index := range ic.indexes[req.GetCollectionID()] {
+		resp.IndexInfos = append(resp.IndexInfos, index)
+	}
+	return resp, nil
+}
+
+func (ic *mockIndexCoord) CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
+	ic.mut.Lock()
+	defer ic.mut.Unlock()
+	if ic.indexes[req.GetCollectionID()] == nil {
+		ic.indexes[req.GetCollectionID()] = make(map[string]*indexpb.IndexInfo)
+	}
+	ic.indexes[req.GetCollectionID()][req.GetIndexName()] = &indexpb.IndexInfo{
+		CollectionID: req.GetCollectionID(),
+		FieldID:      req.GetFieldID(),
+		IndexName:    req.GetIndexName(),
+		TypeParams:   req.GetTypeParams(),
+		IndexParams:  req.GetIndexParams(),
+	}
+	return successStatus(), nil
+}
+
+func (ic *mockIndexCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
+	ic.mut.Lock()
+	defer ic.mut.Unlock()
+	delete(ic.indexes[req.GetCollectionID()], req.GetIndexName())
+	return successStatus(), nil
+}
+
+func (ic *mockIndexCoord) index(collectionID UniqueID, name string) *indexpb.IndexInfo {
+	ic.mut.Lock()
+	defer ic.mut.Unlock()
+	return ic.indexes[collectionID][name]
+}
+
+// mockDataCoord assigns at most segmentSize rows per segment
+type mockDataCoord struct {
+	mut         sync.Mutex
+	nextID      UniqueID
+	segmentSize uint32
+	err         error
+	requests    []*datapb.SegmentIDRequest
+}
+
+func (dc *mockDataCoord) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentIDRequest) (*datapb.AssignSegmentIDResponse, error) {
+	dc.mut.Lock()
+	defer dc.mut.Unlock()
+	if dc.err != nil {
+		return nil, dc.err
+	}
+	resp := &datapb.AssignSegmentIDResponse{Status: successStatus()}
+	for _, r := range req.GetSegmentIDRequests() {
+		dc.requests = append(dc.requests, r)
+		for count := r.GetCount(); count > 0; {
+			n := count
+			if dc.segmentSize > 0 && n > dc.segmentSize {
+				n = dc.segmentSize
+			}
+			dc.nextID++
+			resp.SegIDAssignments = append(resp.SegIDAssignments, &datapb.SegmentIDAssignment{
+				SegID:        dc.nextID,
+				ChannelName:  r.GetChannelName(),
+				Count:        n,
+				CollectionID: r.GetCollectionID(),
+				PartitionID:  r.GetPartitionID(),
+				Status:       successStatus(),
+			})
+			count -= n
+		}
+	}
+	return resp, nil
+}
+
+// msgID is a MessageID ordered by its value
+type msgID uint64
+
+func (id msgID) Serialize() []byte {
+	bs := make([]byte, 8)
+	binary.BigEndian.PutUint64(bs, uint64(id))
+	return bs
+}
+
+func (id msgID) AtEarliestPosition() bool {
+	return id == 0
+}
+
+func (id msgID) LessOrEqualThan(other []byte) (bool, error) {
+	if len(other) != 8 {
+		return false, errors.New("invalid msg id")
+	}
+	return uint64(id) <= binary.BigEndian.Uint64(other), nil
+}
+
+func (id msgID) Equal(other []byte) (bool, error) {
+	if len(other) != 8 {
+		return false, errors.New("invalid msg id")
+	}
+	return uint64(id) == binary.BigEndian.Uint64(other), nil
+}
+
+// mockMsgStream is a consumer fed by the test or a producer recording the produced packs
+type mockMsgStream struct {
+	msgstream.MsgStream
+	mut        sync.Mutex
+	ch         chan *msgstream.MsgPack
+	subName    string
+	channels   []string
+	position   mqwrapper.SubscriptionInitialPosition
+	seekPos    []*internalpb.MsgPosition
+	produced   []*msgstream.MsgPack
+	produceErr error
+	latest     msgID
+	closed     bool
+}
+
+func newMockMsgStream() *mockMsgStream {
+	return &mockMsgStream{ch: make(chan *msgstream.MsgPack, 16)}
+}
+
+func (ms *mockMsgStream) Start() {}
+
+func (ms *mockMsgStream) AsConsumer(channels []string, subName string, position mqwrapper.SubscriptionInitialPosition) {
+	ms.channels = channels
+	ms.subName = subName
+	ms.position = position
+}
+
+func (ms *mockMsgStream) Seek(offset []*internalpb.MsgPosition) error {
+	ms.seekPos = offset
+	return nil
+}
+
+func (ms *mockMsgStream) Chan() <-chan *msgstream.MsgPack {
+	return ms.ch
+}
+
+func (ms *mockMsgStream) GetLatestMsgID(channel string) (msgstream.MessageID, error) {
+	ms.mut.Lock()
+	defer ms.mut.Unlock()
+	return ms.latest, nil
+}
+
+func (ms *mockMsgStream) AsProducer(channels []string) {
+	ms.channels = channels
+}
+
+func (ms *mockMsgStream) SetRepackFunc(repackFunc msgstream.RepackFunc) {}
+
+func (ms *mockMsgStream) Produce(pack *msgstream.MsgPack) error {
+	ms.mut.Lock()
+	defer ms.mut.Unlock()
+	if ms.produceErr != nil {
+		return ms.produceErr
+	}
+	ms.produced = append(ms.produced, pack)
+	return nil
+}
+
+func (ms *mockMsgStream) producedPacks() []*msgstream.MsgPack {
+	ms.mut.Lock()
+	defer ms.mut.Unlock()
+	return append([]*msgstream.MsgPack{}, ms.produced...)
+}
+
+func (ms *mockMsgStream) Close() {
+	ms.mut.Lock()
+	defer ms.mut.Unlock()
+	ms.closed = true
+}
+
+// mockFactory creates a msgstream per channel, keyed by the order of creation
+type mockFactory struct {
+	msgstream.Factory
+	mut     sync.Mutex
+	streams []*mockMsgStream
+}
+
+func (f *mockFactory) newStream() *mockMsgStream {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	ms := newMockMsgStream()
+	f.streams = append(f.streams, ms)
+	return ms
+}
+
+func (f *mockFactory) NewTtMsgStream(ctx context.Context) (msgstream.MsgStream, error) {
+	return f.newStream(), nil
+}
+
+func (f *mockFactory) NewMsgStream(ctx context.Context) (msgstream.MsgStream, error) {
+	return f.newStream(), nil
+}
+
+// stream returns the stream consuming or producing the channel
+func (f *mockFactory) stream(channel string) *mockMsgStream {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	for _, ms := range f.streams {
+		for _, c := range ms.channels {
+			if c == channel {
+				return ms
+			}
+		}
+	}
+	return nil
+}
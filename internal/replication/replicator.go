@@ -0,0 +1,321 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+// Params is the param table of the replicator
+var Params *paramtable.ComponentParam = paramtable.Get()
+
+const (
+	metricsInterval = 5 * time.Second
+	drainInterval   = 100 * time.Millisecond
+)
+
+// Config describes a replication from an active cluster to a standby cluster
+type Config struct {
+	Source *Cluster
+	Target *Cluster
+	// MetaKV stores the cdc checkpoints of the replicated collections, it is rooted at the meta path of the target cluster
+	MetaKV kv.BaseKV
+	// ConfigKV is rooted at the config path of the target cluster, which the components refresh their params from
+	ConfigKV kv.BaseKV
+	// Collections are the names of the replicated collections
+	Collections []string
+	// Session is the session of the replicator registered in the target cluster. The target RootCoord waits for
+	// the time ticks of the replicator sessions like those of the proxies, it's revoked once the replicator stops.
+	Session *sessionutil.Session
+}
+
+// ChannelStatus is the replication status of a source virtual channel
+type ChannelStatus struct {
+	Collection    string `json:"collection"`
+	SourceChannel string `json:"source_channel"`
+	TargetChannel string `json:"target_channel"`
+	State         string `json:"state"`
+	// Timestamp is the time tick of the source channel up to which all changes were replicated
+	Timestamp uint64 `json:"timestamp"`
+	// TargetTimestamp is the target timestamp of the last replicated message of the collection, see TsMapping
+	TargetTimestamp uint64 `json:"target_timestamp"`
+	LagMs           int64  `json:"lag_ms"`
+	Error           string `json:"error,omitempty"`
+}
+
+// Replicator replicates collections from the source cluster into the target cluster, which is kept read-only.
+//
+// The failover procedure is:
+//  1. stop writing to the source cluster, by setting replication.readOnly of the source cluster to true;
+//  2. call Failover, which waits for the replicator to drain the source channels,
+//     stops the replication and makes the target cluster writable;
+//  3. switch the clients to the target cluster.
+//
+// If the source cluster is lost, Failover with force skips draining, the messages not replicated yet are lost.
+type Replicator struct {
+	cfg         Config
+	checkpoints *checkpointStore
+	gate        *pauseGate
+	ticker      *timeTicker
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mut         sync.RWMutex
+	collections []*collectionReplicator
+	stopped     bool
+}
+
+// NewReplicator creates a Replicator of the config
+func NewReplicator(cfg Config) (*Replicator, error) {
+	if cfg.Source == nil || cfg.Target == nil {
+		return nil, errors.New("source and target clusters are required")
+	}
+	if cfg.Target.DataCoord == nil {
+		return nil, errors.New("data coord of target cluster is required")
+	}
+	if cfg.Source.IndexCoord == nil || cfg.Target.IndexCoord == nil {
+		return nil, errors.New("index coords of source and target clusters are required")
+	}
+	if cfg.MetaKV == nil || cfg.ConfigKV == nil {
+		return nil, errors.New("meta kv and config kv of target cluster are required")
+	}
+	if len(cfg.Collections) == 0 {
+		return nil, errors.New("no collection to replicate")
+	}
+	if cfg.Session == nil {
+		return nil, errors.New("session of the replicator in target cluster is required")
+	}
+	return &Replicator{
+		cfg:         cfg,
+		checkpoints: &checkpointStore{kv: cfg.MetaKV},
+		gate:        &pauseGate{},
+		ticker:      newTimeTicker(cfg.Target.RootCoord, cfg.Session.ServerID),
+	}, nil
+}
+
+// Start makes the target cluster read-only and starts replicating the collections,
+// each collection resumes from its checkpoint, or from its creation.
+func (r *Replicator) Start(ctx context.Context) error {
+	if err := r.cfg.ConfigKV.Save(readOnlyKey, "true"); err != nil {
+		return fmt.Errorf("failed to make target cluster read-only, err: %w", err)
+	}
+
+	r.ctx, r.cancel = context.WithCancel(ctx)
+	collections := make([]*collectionReplicator, 0, len(r.cfg.Collections))
+	for _, name := range r.cfg.Collections {
+		c, err := r.replicateCollection(name)
+		if err != nil {
+			for _, c := range collections {
+				c.close()
+			}
+			r.cancel()
+			return err
+		}
+		collections = append(collections, c)
+	}
+
+	r.mut.Lock()
+	r.collections = collections
+	r.mut.Unlock()
+	r.wg.Add(2)
+	go func() {
+		defer r.wg.Done()
+		r.ticker.run(r.ctx)
+	}()
+	for _, c := range collections {
+		c.start()
+	}
+	go r.reportMetrics()
+	log.Info("replicator started", zap.Strings("collections", r.cfg.Collections))
+	return nil
+}
+
+func (r *Replicator) replicateCollection(name string) (*collectionReplicator, error) {
+	coll, err := newCollectionMapping(r.ctx, r.cfg.Source.RootCoord, r.cfg.Target.RootCoord, name)
+	if err != nil {
+		return nil, err
+	}
+	checkpoint, err := r.checkpoints.load(coll.source.GetCollectionID())
+	if err != nil {
+		return nil, err
+	}
+	mapping, err := r.checkpoints.loadTsMapping(coll.source.GetCollectionID())
+	if err != nil {
+		return nil, err
+	}
+	return newCollectionReplicator(r.ctx, r.cfg.Source, r.cfg.Target, coll, checkpoint, mapping.GetTargetTs(),
+		r.checkpoints, r.gate, r.ticker)
+}
+
+// Pause stops consuming the source channels until Resume
+func (r *Replicator) Pause() {
+	r.gate.pause()
+	log.Info("replicator paused")
+}
+
+// Resume resumes the replication paused by Pause
+func (r *Replicator) Resume() {
+	r.gate.resume()
+	log.Info("replicator resumed")
+}
+
+// Status returns the status of the replicated channels
+func (r *Replicator) Status() []*ChannelStatus {
+	r.mut.RLock()
+	defer r.mut.RUnlock()
+	statuses := make([]*ChannelStatus, 0, len(r.collections))
+	for _, c := range r.collections {
+		statuses = append(statuses, c.status()...)
+	}
+	return statuses
+}
+
+// Failover stops the replication and makes the target cluster writable.
+// Unless force, it first waits for the messages produced to the source channels before the call to be replicated,
+// writes to the source cluster must be stopped beforehand.
+func (r *Replicator) Failover(ctx context.Context, force bool) error {
+	if !force {
+		if err := r.drain(ctx); err != nil {
+			return fmt.Errorf("failed to drain source channels, err: %w", err)
+		}
+	}
+	r.Stop()
+	if err := r.cfg.ConfigKV.Save(readOnlyKey, "false"); err != nil {
+		return fmt.Errorf("failed to make target cluster writable, err: %w", err)
+	}
+	log.Info("replicator failed over", zap.Bool("force", force))
+	return nil
+}
+
+// drain waits for the replicated positions to pass the latest messages of the source channels
+func (r *Replicator) drain(ctx context.Context) error {
+	if r.gate.isPaused() {
+		return errors.New("replication is paused")
+	}
+
+	r.mut.RLock()
+	stopped, collections := r.stopped, r.collections
+	r.mut.RUnlock()
+	if stopped {
+		return errors.New("replication is stopped")
+	}
+	latest := make(map[*collectionReplicator]map[string]msgstream.MessageID, len(collections))
+	for _, c := range collections {
+		switch c.getState() {
+		case ChannelDropped:
+			continue
+		case ChannelFailed:
+			return fmt.Errorf("replication of collection %s failed, err: %s", c.coll.name, c.status()[0].Error)
+		}
+		msgIDs, err := c.stream.LatestMsgIDs()
+		if err != nil {
+			return err
+		}
+		latest[c] = msgIDs
+	}
+
+	timeout := time.Duration(Params.ReplicationCfg.DrainTimeoutSeconds.GetAsInt()) * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(drainInterval)
+	defer ticker.Stop()
+	for {
+		for c, msgIDs := range latest {
+			if c.getState() == ChannelFailed {
+				return fmt.Errorf("replication of collection %s failed, err: %s", c.coll.name, c.status()[0].Error)
+			}
+			drained, err := c.drained(msgIDs)
+			if err != nil {
+				return err
+			}
+			if drained || c.getState() == ChannelDropped {
+				delete(latest, c)
+			}
+		}
+		if len(latest) == 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%d collections are not drained, err: %w", len(latest), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop stops the replication and saves the checkpoints of the channels
+func (r *Replicator) Stop() {
+	r.mut.Lock()
+	if r.stopped || r.cancel == nil {
+		r.mut.Unlock()
+		return
+	}
+	r.stopped = true
+	collections := r.collections
+	r.mut.Unlock()
+
+	// paused collections must not block stopping
+	r.cancel()
+	for _, c := range collections {
+		c.stop()
+		if err := c.saveCheckpoint(); err != nil {
+			log.Warn("failed to save replication checkpoint", zap.String("collection", c.coll.name), zap.Error(err))
+		}
+	}
+	r.wg.Wait()
+	// the target RootCoord stops waiting for the time ticks of the replicator
+	r.cfg.Session.Revoke(time.Second)
+	log.Info("replicator stopped")
+}
+
+// reportMetrics refreshes the lag and state metrics, the lag keeps growing while paused
+func (r *Replicator) reportMetrics() {
+	defer r.wg.Done()
+	ticker := time.NewTicker(metricsInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+			states := make(map[string]int)
+			for _, status := range r.Status() {
+				states[status.State]++
+				metrics.ReplicationLag.WithLabelValues(status.SourceChannel).Set(float64(status.LagMs))
+			}
+			metrics.ReplicationChannelState.Reset()
+			for state, count := range states {
+				metrics.ReplicationChannelState.WithLabelValues(state).Set(float64(count))
+			}
+		}
+	}
+}
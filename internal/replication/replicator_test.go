@@ -0,0 +1,506 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/kv"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/management"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	os.Exit(m.Run())
+}
+
+const waitFor = 5 * time.Second
+
+type testEnv struct {
+	source           *mockRootCoord
+	target           *mockRootCoord
+	dataCoord        *mockDataCoord
+	sourceIndexCoord *mockIndexCoord
+	targetIndexCoord *mockIndexCoord
+	sourceFactory    *mockFactory
+	targetFactory    *mockFactory
+	metaKV           kv.BaseKV
+	configKV         kv.BaseKV
+	sourceColl       *mockCollection
+}
+
+func newTestEnv() *testEnv {
+	env := &testEnv{
+		source:           newMockRootCoord(1000, 0),
+		target:           newMockRootCoord(2000, 10),
+		dataCoord:        &mockDataCoord{nextID: 3000},
+		sourceIndexCoord: newMockIndexCoord(),
+		targetIndexCoord: newMockIndexCoord(),
+		sourceFactory:    &mockFactory{},
+		targetFactory:    &mockFactory{},
+		metaKV:           memkv.NewMemoryKV(),
+		configKV:         memkv.NewMemoryKV(),
+	}
+	env.sourceColl = env.source.addCollection("test", 2)
+	return env
+}
+
+func (env *testEnv) sourceCluster() *Cluster {
+	return &Cluster{RootCoord: env.source, IndexCoord: env.sourceIndexCoord, Factory: env.sourceFactory}
+}
+
+func (env *testEnv) targetCluster() *Cluster {
+	return &Cluster{RootCoord: env.target, DataCoord: env.dataCoord, IndexCoord: env.targetIndexCoord, Factory: env.targetFactory}
+}
+
+func (env *testEnv) newReplicator(t *testing.T) *Replicator {
+	r, err := NewReplicator(Config{
+		Source:      env.sourceCluster(),
+		Target:      env.targetCluster(),
+		MetaKV:      env.metaKV,
+		ConfigKV:    env.configKV,
+		Collections: []string{"test"},
+		Session:     &sessionutil.Session{ServerID: 100},
+	})
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background()))
+	return r
+}
+
+func (env *testEnv) readOnly(t *testing.T) string {
+	value, err := env.configKV.Load(readOnlyKey)
+	require.NoError(t, err)
+	return value
+}
+
+// send feeds a pack ending at the time tick to the consumer of the source channel
+func (env *testEnv) send(shard int, ts Timestamp, id msgID, msgs ...msgstream.TsMsg) {
+	pchannel := fmt.Sprintf("dml_%d", shard)
+	env.sourceFactory.stream(pchannel).ch <- &msgstream.MsgPack{
+		BeginTs:      ts - 1,
+		EndTs:        ts,
+		Msgs:         msgs,
+		EndPositions: []*internalpb.MsgPosition{{ChannelName: pchannel, MsgID: id.Serialize(), Timestamp: ts}},
+	}
+}
+
+func (env *testEnv) produced(shard int) []*msgstream.MsgPack {
+	return env.targetFactory.stream(fmt.Sprintf("dml_%d", 10+shard)).producedPacks()
+}
+
+func (env *testEnv) status(r *Replicator, shard int) *ChannelStatus {
+	for _, status := range r.Status() {
+		if status.SourceChannel == env.sourceColl.vchannels[shard] {
+			return status
+		}
+	}
+	return nil
+}
+
+func genDeleteMsg(collectionID, partitionID UniqueID, vchannel string, ts Timestamp) *msgstream.DeleteMsg {
+	return &msgstream.DeleteMsg{
+		BaseMsg: msgstream.BaseMsg{BeginTimestamp: ts, EndTimestamp: ts, HashValues: []uint32{0}},
+		DeleteRequest: internalpb.DeleteRequest{
+			Base:         commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_Delete)),
+			CollectionID: collectionID,
+			PartitionID:  partitionID,
+			ShardName:    vchannel,
+			Timestamps:   []Timestamp{ts},
+			NumRows:      1,
+			PrimaryKeys:  &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1}}}},
+		},
+	}
+}
+
+func TestReplicator_Replicate(t *testing.T) {
+	env := newTestEnv()
+	r := env.newReplicator(t)
+	defer r.Stop()
+	assert.Equal(t, "true", env.readOnly(t))
+
+	sourceStream := env.sourceFactory.stream("dml_0")
+	require.NotNil(t, sourceStream)
+	assert.Equal(t, fmt.Sprintf("%s-%d-%s", Params.CommonCfg.ReplicatorSubName, env.sourceColl.id, env.sourceColl.vchannels[0]), sourceStream.subName)
+	assert.Equal(t, mqwrapper.SubscriptionPositionUnknown, sourceStream.position)
+	require.Len(t, sourceStream.seekPos, 1)
+	assert.Equal(t, msgID(1).Serialize(), sourceStream.seekPos[0].GetMsgID())
+
+	targetColl := env.target.collections["test"]
+	require.NotNil(t, targetColl)
+	vchannel := env.sourceColl.vchannels[0]
+	defaultPartition := env.sourceColl.partitions["_default"]
+	env.send(0, 20, 5,
+		genInsertMsg(env.sourceColl.id, defaultPartition, "_default", vchannel, 100, []Timestamp{11, 12, 13}),
+		// messages of other collections and other shards sharing the physical channel are skipped
+		genInsertMsg(env.sourceColl.id+100, defaultPartition, "_default", "dml_0_1101v0", 100, []Timestamp{14}),
+		genInsertMsg(env.sourceColl.id, defaultPartition, "_default", env.sourceColl.vchannels[1], 100, []Timestamp{15}),
+		genDeleteMsg(env.sourceColl.id, common.InvalidPartitionID, vchannel, 16),
+	)
+	// nothing is replicated until all channels of the collection pass the changes
+	time.Sleep(100 * time.Millisecond)
+	assert.Empty(t, env.produced(0))
+	env.send(1, 20, 5)
+
+	assert.Eventually(t, func() bool { return len(env.produced(0)) == 1 }, waitFor, 10*time.Millisecond)
+	pack := env.produced(0)[0]
+	require.Len(t, pack.Msgs, 2)
+	assert.Empty(t, env.produced(1))
+
+	// the messages are stamped with timestamps of the target cluster in their order
+	insertMsg := pack.Msgs[0].(*msgstream.InsertMsg)
+	ts := insertMsg.BeginTs()
+	assert.GreaterOrEqual(t, ts, Timestamp(2000))
+	assert.Equal(t, targetColl.id, insertMsg.GetCollectionID())
+	assert.Equal(t, targetColl.partitions["_default"], insertMsg.GetPartitionID())
+	assert.Equal(t, targetColl.vchannels[0], insertMsg.GetShardName())
+	assert.Equal(t, UniqueID(3001), insertMsg.GetSegmentID())
+	assert.Equal(t, []Timestamp{ts, ts, ts}, insertMsg.GetTimestamps())
+	assert.Equal(t, ts, insertMsg.EndTs())
+	assert.Equal(t, UniqueID(110), insertMsg.GetFieldsData()[0].GetFieldId())
+	assert.Equal(t, UniqueID(111), insertMsg.GetFieldsData()[1].GetFieldId())
+	assert.Len(t, insertMsg.HashKeys(), 3)
+
+	deleteMsg := pack.Msgs[1].(*msgstream.DeleteMsg)
+	assert.Equal(t, targetColl.id, deleteMsg.GetCollectionID())
+	assert.Equal(t, common.InvalidPartitionID, deleteMsg.GetPartitionID())
+	assert.Equal(t, targetColl.vchannels[0], deleteMsg.GetShardName())
+	assert.Equal(t, ts+1, deleteMsg.BeginTs())
+	assert.Equal(t, []Timestamp{ts + 1}, deleteMsg.GetTimestamps())
+	assert.Equal(t, ts, pack.BeginTs)
+	assert.Equal(t, ts+1, pack.EndTs)
+
+	// the checkpoint is saved right after producing
+	assert.Eventually(t, func() bool { return env.status(r, 0).Timestamp == 20 }, waitFor, 10*time.Millisecond)
+	cp, err := r.checkpoints.load(env.sourceColl.id)
+	require.NoError(t, err)
+	require.NotNil(t, cp)
+	assert.Equal(t, Timestamp(20), cp.Timestamp)
+	require.Contains(t, cp.Positions, vchannel)
+	assert.Equal(t, Timestamp(20), cp.Positions[vchannel].GetTimestamp())
+	assert.Equal(t, msgID(5).Serialize(), cp.Positions[vchannel].GetMsgID())
+	mapping, err := r.checkpoints.loadTsMapping(env.sourceColl.id)
+	require.NoError(t, err)
+	require.NotNil(t, mapping)
+	assert.Equal(t, Timestamp(20), mapping.SourceTs)
+	assert.GreaterOrEqual(t, mapping.TargetTs, ts+1)
+
+	status := env.status(r, 0)
+	require.NotNil(t, status)
+	assert.Equal(t, ChannelRunning, status.State)
+	assert.Equal(t, Timestamp(20), status.Timestamp)
+	assert.Equal(t, mapping.TargetTs, status.TargetTimestamp)
+	assert.Equal(t, targetColl.vchannels[0], status.TargetChannel)
+
+	// inserts into new partitions create the partitions in target cluster
+	env.send(1, 30, 6, genInsertMsg(env.sourceColl.id, 1, "p1", env.sourceColl.vchannels[1], 100, []Timestamp{21}))
+	env.send(0, 30, 6)
+	assert.Eventually(t, func() bool { return len(env.produced(1)) == 1 }, waitFor, 10*time.Millisecond)
+	assert.Equal(t, targetColl.partitions["p1"], env.produced(1)[0].Msgs[0].(*msgstream.InsertMsg).GetPartitionID())
+	assert.Eventually(t, func() bool { return env.status(r, 0).Timestamp == 30 }, waitFor, 10*time.Millisecond)
+
+	// the checkpoint is reused after restart
+	r.Stop()
+	assert.Equal(t, ChannelStopped, env.status(r, 0).State)
+	r = env.newReplicator(t)
+	assert.Len(t, env.sourceFactory.streams, 4)
+	sourceStream = env.sourceFactory.streams[2]
+	require.Len(t, sourceStream.seekPos, 1)
+	assert.Equal(t, msgID(6).Serialize(), sourceStream.seekPos[0].GetMsgID())
+	assert.Equal(t, "dml_0", sourceStream.seekPos[0].GetChannelName())
+}
+
+func TestReplicator_DDL(t *testing.T) {
+	env := newTestEnv()
+	env.sourceColl.partitions["p1"] = 1
+	env.sourceColl.aliases = []string{"alias"}
+	env.sourceIndexCoord.CreateIndex(context.Background(), &indexpb.CreateIndexRequest{
+		CollectionID: env.sourceColl.id,
+		FieldID:      101,
+		IndexName:    "vec_idx",
+	})
+	r := env.newReplicator(t)
+	defer r.Stop()
+	targetColl := env.target.collections["test"]
+	require.Contains(t, targetColl.partitions, "p1")
+
+	// indexes and aliases are synced
+	assert.Eventually(t, func() bool {
+		return env.targetIndexCoord.index(targetColl.id, "vec_idx") != nil
+	}, waitFor, 10*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		env.target.mut.Lock()
+		defer env.target.mut.Unlock()
+		return len(targetColl.aliases) == 1 && targetColl.aliases[0] == "alias"
+	}, waitFor, 10*time.Millisecond)
+
+	createPartition := func(ts Timestamp) msgstream.TsMsg {
+		return &msgstream.CreatePartitionMsg{
+			BaseMsg: msgstream.BaseMsg{BeginTimestamp: ts, EndTimestamp: ts},
+			CreatePartitionRequest: internalpb.CreatePartitionRequest{
+				Base:          commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_CreatePartition)),
+				CollectionID:  env.sourceColl.id,
+				PartitionID:   2,
+				PartitionName: "p2",
+			},
+		}
+	}
+
+	dropPartition := func(ts Timestamp) msgstream.TsMsg {
+		return &msgstream.DropPartitionMsg{
+			BaseMsg: msgstream.BaseMsg{BeginTimestamp: ts, EndTimestamp: ts},
+			DropPartitionRequest: internalpb.DropPartitionRequest{
+				Base:          commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_DropPartition)),
+				CollectionID:  env.sourceColl.id,
+				PartitionID:   1,
+				PartitionName: "p1",
+			},
+		}
+	}
+	dropCollection := func(ts Timestamp) msgstream.TsMsg {
+		return &msgstream.DropCollectionMsg{
+			BaseMsg: msgstream.BaseMsg{BeginTimestamp: ts, EndTimestamp: ts},
+			DropCollectionRequest: internalpb.DropCollectionRequest{
+				Base:         commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_DropCollection)),
+				CollectionID: env.sourceColl.id,
+			},
+		}
+	}
+
+	// the insert before the drop is produced first
+	env.send(0, 20, 5,
+		createPartition(10),
+		genInsertMsg(env.sourceColl.id, 1, "p1", env.sourceColl.vchannels[0], 100, []Timestamp{11}),
+		dropPartition(12))
+	env.send(1, 20, 5, createPartition(10), dropPartition(12))
+	assert.Eventually(t, func() bool {
+		_, ok := env.target.collections["test"].partitions["p1"]
+		return !ok
+	}, waitFor, 10*time.Millisecond)
+	assert.Len(t, env.produced(0), 1)
+	env.target.mut.Lock()
+	assert.Contains(t, targetColl.partitions, "p2")
+	env.target.mut.Unlock()
+
+	env.send(0, 30, 6, dropCollection(21))
+	env.send(1, 30, 6, dropCollection(21))
+	assert.Eventually(t, func() bool {
+		return env.status(r, 0).State == ChannelDropped && env.status(r, 1).State == ChannelDropped
+	}, waitFor, 10*time.Millisecond)
+	assert.NotContains(t, env.target.collections, "test")
+	assert.Equal(t, []string{"test"}, env.target.dropped)
+}
+
+func TestReplicator_Pause(t *testing.T) {
+	env := newTestEnv()
+	r := env.newReplicator(t)
+	defer r.Stop()
+
+	r.Pause()
+	assert.Eventually(t, func() bool { return env.status(r, 0).State == ChannelPaused }, waitFor, 10*time.Millisecond)
+	env.send(0, 20, 5, genInsertMsg(env.sourceColl.id, env.sourceColl.partitions["_default"], "_default", env.sourceColl.vchannels[0], 100, []Timestamp{11}))
+	env.send(1, 20, 5)
+	time.Sleep(100 * time.Millisecond)
+	assert.Empty(t, env.produced(0))
+
+	r.Resume()
+	assert.Eventually(t, func() bool { return len(env.produced(0)) == 1 }, waitFor, 10*time.Millisecond)
+	assert.Equal(t, ChannelRunning, env.status(r, 0).State)
+
+	// pausing doesn't block stopping
+	r.Pause()
+	r.Stop()
+}
+
+func TestReplicator_Failover(t *testing.T) {
+	t.Run("drain", func(t *testing.T) {
+		env := newTestEnv()
+		r := env.newReplicator(t)
+		env.sourceFactory.stream("dml_0").latest = 7
+		env.sourceFactory.stream("dml_1").latest = 7
+
+		done := make(chan error, 1)
+		go func() {
+			done <- r.Failover(context.Background(), false)
+		}()
+		env.send(0, 20, 6)
+		env.send(1, 20, 7)
+		time.Sleep(100 * time.Millisecond)
+		select {
+		case <-done:
+			t.Fatal("failover must wait for all channels to be drained")
+		default:
+		}
+		assert.Equal(t, "true", env.readOnly(t))
+
+		env.send(0, 30, 7)
+		env.send(1, 30, 8)
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(waitFor):
+			t.Fatal("failover timeout")
+		}
+		assert.Equal(t, "false", env.readOnly(t))
+		assert.Equal(t, ChannelStopped, env.status(r, 0).State)
+		cp, err := r.checkpoints.load(env.sourceColl.id)
+		require.NoError(t, err)
+		assert.Equal(t, Timestamp(30), cp.Positions[env.sourceColl.vchannels[0]].GetTimestamp())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		env := newTestEnv()
+		r := env.newReplicator(t)
+		defer r.Stop()
+		env.sourceFactory.stream("dml_0").latest = 7
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		assert.Error(t, r.Failover(ctx, false))
+		assert.Equal(t, "true", env.readOnly(t))
+
+		r.Pause()
+		assert.Error(t, r.Failover(context.Background(), false))
+	})
+
+	t.Run("force", func(t *testing.T) {
+		env := newTestEnv()
+		r := env.newReplicator(t)
+		env.sourceFactory.stream("dml_0").latest = 7
+		assert.NoError(t, r.Failover(context.Background(), true))
+		assert.Equal(t, "false", env.readOnly(t))
+		assert.Error(t, r.Failover(context.Background(), false))
+	})
+
+	t.Run("failed channel", func(t *testing.T) {
+		attempts := replicateRetryAttempts
+		replicateRetryAttempts = 1
+		defer func() { replicateRetryAttempts = attempts }()
+
+		env := newTestEnv()
+		r := env.newReplicator(t)
+		defer r.Stop()
+		env.targetFactory.stream("dml_10").produceErr = errors.New("mock error")
+		env.send(0, 20, 5, genInsertMsg(env.sourceColl.id, env.sourceColl.partitions["_default"], "_default", env.sourceColl.vchannels[0], 100, []Timestamp{11}))
+		env.send(1, 20, 5)
+		assert.Eventually(t, func() bool { return env.status(r, 0).State == ChannelFailed }, waitFor, 10*time.Millisecond)
+		assert.NotEmpty(t, env.status(r, 0).Error)
+		assert.Error(t, r.Failover(context.Background(), false))
+	})
+}
+
+func TestNewReplicator(t *testing.T) {
+	env := newTestEnv()
+	_, err := NewReplicator(Config{})
+	assert.Error(t, err)
+	_, err = NewReplicator(Config{
+		Source: &Cluster{RootCoord: env.source},
+		Target: &Cluster{RootCoord: env.target},
+	})
+	assert.Error(t, err)
+	_, err = NewReplicator(Config{
+		Source: &Cluster{RootCoord: env.source},
+		Target: &Cluster{RootCoord: env.target, DataCoord: env.dataCoord},
+	})
+	assert.Error(t, err)
+	_, err = NewReplicator(Config{
+		Source:   &Cluster{RootCoord: env.source},
+		Target:   &Cluster{RootCoord: env.target, DataCoord: env.dataCoord},
+		MetaKV:   env.metaKV,
+		ConfigKV: env.configKV,
+	})
+	assert.Error(t, err)
+	_, err = NewReplicator(Config{
+		Source:   env.sourceCluster(),
+		Target:   env.targetCluster(),
+		MetaKV:   env.metaKV,
+		ConfigKV: env.configKV,
+	})
+	assert.Error(t, err)
+	_, err = NewReplicator(Config{
+		Source:      env.sourceCluster(),
+		Target:      env.targetCluster(),
+		MetaKV:      env.metaKV,
+		ConfigKV:    env.configKV,
+		Collections: []string{"not_exist"},
+	})
+	assert.Error(t, err)
+
+	r, err := NewReplicator(Config{
+		Source:      env.sourceCluster(),
+		Target:      env.targetCluster(),
+		MetaKV:      env.metaKV,
+		ConfigKV:    env.configKV,
+		Collections: []string{"not_exist"},
+		Session:     &sessionutil.Session{ServerID: 100},
+	})
+	require.NoError(t, err)
+	assert.Error(t, r.Start(context.Background()))
+}
+
+func TestReplicator_HTTP(t *testing.T) {
+	env := newTestEnv()
+	r := env.newReplicator(t)
+	defer r.Stop()
+
+	serve := func(handler http.HandlerFunc, method, target string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest(method, target, nil))
+		return w
+	}
+
+	w := serve(r.handleStatus, http.MethodGet, management.ReplicationStatusRouterPath)
+	assert.Equal(t, http.StatusOK, w.Code)
+	var statuses []*ChannelStatus
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &statuses))
+	assert.Len(t, statuses, 2)
+	assert.Equal(t, http.StatusMethodNotAllowed, serve(r.handleStatus, http.MethodPost, management.ReplicationStatusRouterPath).Code)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, serve(r.handlePause, http.MethodGet, management.ReplicationPauseRouterPath).Code)
+	assert.Equal(t, http.StatusOK, serve(r.handlePause, http.MethodPost, management.ReplicationPauseRouterPath).Code)
+	assert.True(t, r.gate.isPaused())
+	assert.Equal(t, http.StatusMethodNotAllowed, serve(r.handleResume, http.MethodGet, management.ReplicationResumeRouterPath).Code)
+	assert.Equal(t, http.StatusOK, serve(r.handleResume, http.MethodPost, management.ReplicationResumeRouterPath).Code)
+	assert.False(t, r.gate.isPaused())
+
+	assert.Equal(t, http.StatusMethodNotAllowed, serve(r.handleFailover, http.MethodGet, management.ReplicationFailoverRouterPath).Code)
+	assert.Equal(t, http.StatusBadRequest, serve(r.handleFailover, http.MethodPost, management.ReplicationFailoverRouterPath+"?force=maybe").Code)
+	assert.Equal(t, http.StatusOK, serve(r.handleFailover, http.MethodPost, management.ReplicationFailoverRouterPath+"?force=true").Code)
+	assert.Equal(t, "false", env.readOnly(t))
+	assert.Equal(t, http.StatusInternalServerError, serve(r.handleFailover, http.MethodPost, management.ReplicationFailoverRouterPath).Code)
+}
@@ -0,0 +1,135 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// segmentAssigner assigns the rows of replicated inserts to segments of the target cluster,
+// the segment IDs of the source cluster are meaningless for the DataCoord of the target cluster.
+type segmentAssigner struct {
+	dataCoord DataCoord
+}
+
+func (a *segmentAssigner) assign(ctx context.Context, collectionID, partitionID UniqueID, vchannel string, count uint32) ([]*datapb.SegmentIDAssignment, error) {
+	resp, err := a.dataCoord.AssignSegmentID(ctx, &datapb.AssignSegmentIDRequest{
+		NodeID:   paramtable.GetNodeID(),
+		PeerRole: typeutil.ReplicatorRole,
+		SegmentIDRequests: []*datapb.SegmentIDRequest{
+			{
+				Count:        count,
+				ChannelName:  vchannel,
+				CollectionID: collectionID,
+				PartitionID:  partitionID,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := statusError(resp.GetStatus()); err != nil {
+		return nil, err
+	}
+
+	var assigned uint32
+	for _, assignment := range resp.GetSegIDAssignments() {
+		if err := statusError(assignment.GetStatus()); err != nil {
+			return nil, err
+		}
+		assigned += assignment.GetCount()
+	}
+	if assigned < count {
+		return nil, fmt.Errorf("only %d of %d rows are assigned to segments of channel %s", assigned, count, vchannel)
+	}
+	return resp.GetSegIDAssignments(), nil
+}
+
+// splitInsertMsg splits the insert message into one message per assigned segment
+func splitInsertMsg(msg *msgstream.InsertMsg, assignments []*datapb.SegmentIDAssignment) []*msgstream.InsertMsg {
+	numRows := int(msg.NRows())
+	if len(assignments) == 1 || numRows <= int(assignments[0].GetCount()) {
+		msg.SegmentID = assignments[0].GetSegID()
+		return []*msgstream.InsertMsg{msg}
+	}
+
+	msgs := make([]*msgstream.InsertMsg, 0, len(assignments))
+	start := 0
+	for _, assignment := range assignments {
+		if start >= numRows {
+			break
+		}
+		end := start + int(assignment.GetCount())
+		if end > numRows {
+			end = numRows
+		}
+		part := sliceInsertMsg(msg, start, end)
+		part.SegmentID = assignment.GetSegID()
+		msgs = append(msgs, part)
+		start = end
+	}
+	return msgs
+}
+
+// sliceInsertMsg returns a message of the rows in [start, end) of the insert message
+func sliceInsertMsg(msg *msgstream.InsertMsg, start, end int) *msgstream.InsertMsg {
+	part := &msgstream.InsertMsg{
+		BaseMsg: msgstream.BaseMsg{
+			Ctx:         msg.TraceCtx(),
+			MsgPosition: msg.Position(),
+		},
+		InsertRequest: msg.InsertRequest,
+	}
+	part.Timestamps = msg.Timestamps[start:end]
+	part.RowIDs = msg.RowIDs[start:end]
+	part.NumRows = uint64(end - start)
+	if msg.IsRowBased() {
+		part.RowData = msg.RowData[start:end]
+	} else {
+		part.FieldsData = make([]*schemapb.FieldData, len(msg.GetFieldsData()))
+		var validData []*segcorepb.FieldValidData
+		if len(msg.GetValidData()) > 0 {
+			validData = make([]*segcorepb.FieldValidData, len(msg.GetValidData()))
+		}
+		for idx := start; idx < end; idx++ {
+			typeutil.AppendFieldData(part.FieldsData, msg.GetFieldsData(), int64(idx))
+			if validData != nil {
+				typeutil.AppendValidData(validData, msg.GetValidData(), int64(idx))
+			}
+		}
+		part.ValidData = validData
+	}
+
+	part.BeginTimestamp, part.EndTimestamp = part.Timestamps[0], part.Timestamps[0]
+	for _, ts := range part.Timestamps {
+		if ts < part.BeginTimestamp {
+			part.BeginTimestamp = ts
+		}
+		if ts > part.EndTimestamp {
+			part.EndTimestamp = ts
+		}
+	}
+	return part
+}
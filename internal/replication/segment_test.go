@@ -0,0 +1,144 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+)
+
+// genInsertMsg generates an insert message of the mock collection with a pk and a vector field
+func genInsertMsg(collectionID, partitionID UniqueID, partitionName, vchannel string, pkFieldID UniqueID, timestamps []Timestamp) *msgstream.InsertMsg {
+	n := len(timestamps)
+	pks := make([]int64, n)
+	vectors := make([]float32, 0, 2*n)
+	for i := range pks {
+		pks[i] = int64(i)
+		vectors = append(vectors, float32(i), float32(i))
+	}
+	return &msgstream.InsertMsg{
+		BaseMsg: msgstream.BaseMsg{
+			BeginTimestamp: timestamps[0],
+			EndTimestamp:   timestamps[n-1],
+			HashValues:     make([]uint32, n),
+		},
+		InsertRequest: internalpb.InsertRequest{
+			Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_Insert)),
+			CollectionID:   collectionID,
+			PartitionID:    partitionID,
+			CollectionName: "test",
+			PartitionName:  partitionName,
+			ShardName:      vchannel,
+			SegmentID:      1,
+			Timestamps:     timestamps,
+			RowIDs:         pks,
+			NumRows:        uint64(n),
+			Version:        internalpb.InsertDataVersion_ColumnBased,
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:    schemapb.DataType_Int64,
+					FieldId: pkFieldID,
+					Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+					}},
+				},
+				{
+					Type:    schemapb.DataType_FloatVector,
+					FieldId: pkFieldID + 1,
+					Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+						Dim:  2,
+						Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: vectors}},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func TestSegmentAssigner(t *testing.T) {
+	ctx := context.Background()
+	dc := &mockDataCoord{segmentSize: 2}
+	assigner := &segmentAssigner{dataCoord: dc}
+
+	assignments, err := assigner.assign(ctx, 1, 2, "dml_0_1v0", 5)
+	require.NoError(t, err)
+	assert.Len(t, assignments, 3)
+	require.Len(t, dc.requests, 1)
+	assert.Equal(t, "dml_0_1v0", dc.requests[0].GetChannelName())
+
+	dc.err = errors.New("mock error")
+	_, err = assigner.assign(ctx, 1, 2, "dml_0_1v0", 5)
+	assert.Error(t, err)
+
+	// skipped assignments are reported as errors, rows must not be lost
+	_, err = (&segmentAssigner{dataCoord: &partialDataCoord{}}).assign(ctx, 1, 2, "dml_0_1v0", 5)
+	assert.Error(t, err)
+}
+
+// partialDataCoord assigns only one row
+type partialDataCoord struct{}
+
+func (dc *partialDataCoord) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentIDRequest) (*datapb.AssignSegmentIDResponse, error) {
+	return &datapb.AssignSegmentIDResponse{
+		Status:           successStatus(),
+		SegIDAssignments: []*datapb.SegmentIDAssignment{{SegID: 1, Count: 1, Status: successStatus()}},
+	}, nil
+}
+
+func TestSplitInsertMsg(t *testing.T) {
+	t.Run("single segment", func(t *testing.T) {
+		msg := genInsertMsg(1, 2, "_default", "dml_0_1v0", 100, []Timestamp{10, 11, 12})
+		msgs := splitInsertMsg(msg, []*datapb.SegmentIDAssignment{{SegID: 5, Count: 3}})
+		require.Len(t, msgs, 1)
+		assert.Equal(t, UniqueID(5), msgs[0].GetSegmentID())
+	})
+
+	t.Run("several segments", func(t *testing.T) {
+		msg := genInsertMsg(1, 2, "_default", "dml_0_1v0", 100, []Timestamp{12, 10, 11, 13, 14})
+		msgs := splitInsertMsg(msg, []*datapb.SegmentIDAssignment{
+			{SegID: 5, Count: 2},
+			{SegID: 6, Count: 2},
+			{SegID: 7, Count: 2},
+		})
+		require.Len(t, msgs, 3)
+		var rows uint64
+		for i, part := range msgs {
+			require.NoError(t, part.CheckAligned())
+			assert.Equal(t, UniqueID(5+i), part.GetSegmentID())
+			rows += part.NRows()
+		}
+		assert.Equal(t, uint64(5), rows)
+
+		assert.Equal(t, []int64{0, 1}, msgs[0].GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []float32{0, 0, 1, 1}, msgs[0].GetFieldsData()[1].GetVectors().GetFloatVector().GetData())
+		assert.Equal(t, Timestamp(10), msgs[0].BeginTs())
+		assert.Equal(t, Timestamp(12), msgs[0].EndTs())
+		assert.Equal(t, []int64{4}, msgs[2].GetRowIDs())
+		assert.Equal(t, Timestamp(14), msgs[2].BeginTs())
+	})
+}
@@ -0,0 +1,617 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/cdc"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+const (
+	// ChannelRunning means the channel is being replicated
+	ChannelRunning = "running"
+	// ChannelPaused means the replication of the channel is paused
+	ChannelPaused = "paused"
+	// ChannelStopped means the replication of the channel is stopped, by a failover for example
+	ChannelStopped = "stopped"
+	// ChannelDropped means the collection was dropped in source cluster and the drop was replicated
+	ChannelDropped = "dropped"
+	// ChannelFailed means the replication of the channel failed, see the error of the channel status
+	ChannelFailed = "failed"
+
+	// checkpointInterval is the max interval to save the checkpoint of a collection without replicated messages
+	checkpointInterval = time.Second
+	// metaSyncInterval is the interval to sync the indexes and aliases of a collection
+	metaSyncInterval = 10 * time.Second
+)
+
+// replicateRetryAttempts is the number of attempts to replay a message before the collection fails
+var replicateRetryAttempts uint = 10
+
+// pauseGate blocks the replication of all collections while paused
+type pauseGate struct {
+	mut     sync.Mutex
+	paused  bool
+	resumed chan struct{}
+}
+
+func (g *pauseGate) pause() {
+	g.mut.Lock()
+	defer g.mut.Unlock()
+	if !g.paused {
+		g.paused = true
+		g.resumed = make(chan struct{})
+	}
+}
+
+func (g *pauseGate) resume() {
+	g.mut.Lock()
+	defer g.mut.Unlock()
+	if g.paused {
+		g.paused = false
+		close(g.resumed)
+	}
+}
+
+func (g *pauseGate) isPaused() bool {
+	g.mut.Lock()
+	defer g.mut.Unlock()
+	return g.paused
+}
+
+// wait returns once the gate is not paused
+func (g *pauseGate) wait(ctx context.Context) error {
+	g.mut.Lock()
+	if !g.paused {
+		g.mut.Unlock()
+		return nil
+	}
+	resumed := g.resumed
+	g.mut.Unlock()
+
+	select {
+	case <-resumed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// collectionReplicator replays the cdc stream of a source collection into the target collection.
+// The stream delivers the changes of all virtual channels in timestamp order, DML is produced into the mapped
+// virtual channels of the target collection and DDL is replayed by the RootCoord of the target cluster.
+//
+// Time ticks aren't replayed, the RootCoord of the target cluster ticks the target channels with timestamps of
+// its own TSO, and the source timestamps are behind the time ticks already sent to the target channels.
+// The replicated messages are stamped with timestamps allocated from the target TSO right before they are produced,
+// the replicator is a time tick source of the target RootCoord which holds the time ticks of the channels before
+// them until they are produced. The source time tick of the checkpoint and the last target timestamp are saved
+// together as the ts mapping of the collection, see TsMapping.
+type collectionReplicator struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	source *Cluster
+	target *Cluster
+	coll   *collectionMapping
+
+	stream      *cdc.Stream
+	producers   map[string]msgstream.MsgStream
+	assigner    *segmentAssigner
+	checkpoints *checkpointStore
+	gate        *pauseGate
+	ticker      *timeTicker
+
+	mut        sync.RWMutex
+	state      string
+	err        error
+	checkpoint *cdc.Checkpoint
+	// targetTs is the timestamp of the last replicated message in the target cluster
+	targetTs  Timestamp
+	lastSaved time.Time
+}
+
+func newCollectionReplicator(ctx context.Context, source, target *Cluster, coll *collectionMapping, checkpoint *cdc.Checkpoint,
+	targetTs Timestamp, checkpoints *checkpointStore, gate *pauseGate, ticker *timeTicker) (*collectionReplicator, error) {
+	ctx1, cancel := context.WithCancel(ctx)
+	c := &collectionReplicator{
+		ctx:         ctx1,
+		cancel:      cancel,
+		source:      source,
+		target:      target,
+		coll:        coll,
+		producers:   make(map[string]msgstream.MsgStream),
+		assigner:    &segmentAssigner{dataCoord: target.DataCoord},
+		checkpoints: checkpoints,
+		gate:        gate,
+		ticker:      ticker,
+		state:       ChannelRunning,
+		checkpoint:  checkpoint,
+		targetTs:    targetTs,
+		lastSaved:   time.Now(),
+	}
+
+	// the subscriptions are kept while the replicator restarts, so that the messages are retained
+	opts := []cdc.Option{
+		cdc.WithMessages(),
+		cdc.WithSubscription(fmt.Sprintf("%s-%d", Params.CommonCfg.ReplicatorSubName, coll.source.GetCollectionID())),
+	}
+	if checkpoint != nil {
+		opts = append(opts, cdc.WithCheckpoint(checkpoint))
+	}
+	var err error
+	if c.stream, err = cdc.NewStream(ctx1, source.Factory, coll.source, opts...); err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to follow collection %s, err: %w", coll.name, err)
+	}
+
+	for _, vchannel := range coll.target.GetVirtualChannelNames() {
+		producer, err := target.Factory.NewMsgStream(ctx1)
+		if err != nil {
+			c.close()
+			return nil, err
+		}
+		producer.AsProducer([]string{funcutil.ToPhysicalChannel(vchannel)})
+		// keep the messages as they are, instead of splitting them by hash values
+		producer.SetRepackFunc(func(msgs []msgstream.TsMsg, hashKeys [][]int32) (map[int32]*msgstream.MsgPack, error) {
+			return map[int32]*msgstream.MsgPack{0: {Msgs: msgs}}, nil
+		})
+		c.producers[vchannel] = producer
+	}
+	return c, nil
+}
+
+func (c *collectionReplicator) start() {
+	c.wg.Add(2)
+	go c.run()
+	go c.syncMeta()
+}
+
+func (c *collectionReplicator) run() {
+	defer c.wg.Done()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case pack, ok := <-c.stream.Chan():
+			if !ok {
+				if c.ctx.Err() != nil {
+					return
+				}
+				err := c.stream.Err()
+				if err == nil {
+					err = fmt.Errorf("cdc stream of collection %s is closed", c.coll.name)
+				}
+				c.setState(ChannelFailed, err)
+				return
+			}
+			// the pack received before pausing is held until resumed
+			if err := c.gate.wait(c.ctx); err != nil {
+				return
+			}
+			dropped, err := c.replicate(pack)
+			if err != nil {
+				if c.ctx.Err() != nil {
+					return
+				}
+				log.Warn("failed to replicate collection", zap.String("collection", c.coll.name), zap.Error(err))
+				c.setState(ChannelFailed, err)
+				return
+			}
+			if dropped {
+				c.setState(ChannelDropped, nil)
+				return
+			}
+		}
+	}
+}
+
+// syncMeta syncs the indexes and aliases of the collection, which aren't carried by the cdc stream
+func (c *collectionReplicator) syncMeta() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(metaSyncInterval)
+	defer ticker.Stop()
+	for {
+		if err := c.gate.wait(c.ctx); err != nil {
+			return
+		}
+		if state := c.getState(); state == ChannelDropped || state == ChannelFailed {
+			return
+		}
+		if err := c.coll.syncIndexes(c.ctx, c.source.IndexCoord, c.target.IndexCoord); err != nil {
+			log.Warn("failed to sync indexes", zap.String("collection", c.coll.name), zap.Error(err))
+		}
+		if err := c.coll.syncAliases(c.ctx, c.source.RootCoord); err != nil {
+			log.Warn("failed to sync aliases", zap.String("collection", c.coll.name), zap.Error(err))
+		}
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// replicate replays the events of a pack, DML is produced in batches and DDL is replayed in between.
+// It returns true once the drop of the collection has been replicated.
+func (c *collectionReplicator) replicate(pack *cdc.EventPack) (bool, error) {
+	sourceCollectionID := strconv.FormatInt(c.coll.source.GetCollectionID(), 10)
+	batch := make([]msgstream.TsMsg, 0, len(pack.Events))
+	replicated := false
+	for _, event := range pack.Events {
+		switch event.Type {
+		case cdc.EventInsert:
+			msgs, err := c.rewriteInsert(event.Msg.(*msgstream.InsertMsg))
+			if err != nil {
+				return false, err
+			}
+			batch = append(batch, msgs...)
+		case cdc.EventDelete:
+			deleteMsg := event.Msg.(*msgstream.DeleteMsg)
+			if err := c.rewriteDelete(deleteMsg); err != nil {
+				return false, err
+			}
+			batch = append(batch, deleteMsg)
+		case cdc.EventCreatePartition:
+			err := c.retry(func() error {
+				_, err := c.coll.targetPartitionID(c.ctx, event.PartitionID, event.PartitionName)
+				return err
+			})
+			if err != nil {
+				return false, fmt.Errorf("failed to create partition %s, err: %w", event.PartitionName, err)
+			}
+			metrics.ReplicationMsgCount.WithLabelValues(commonpb.MsgType_CreatePartition.String(), sourceCollectionID).Inc()
+		case cdc.EventDropPartition:
+			// the partition must not be dropped before the preceding inserts are replicated
+			if err := c.produce(batch); err != nil {
+				return false, err
+			}
+			replicated = replicated || len(batch) > 0
+			batch = batch[:0]
+			err := c.retry(func() error {
+				return c.coll.dropPartition(c.ctx, event.PartitionID, event.PartitionName)
+			})
+			if err != nil {
+				return false, fmt.Errorf("failed to drop partition %s, err: %w", event.PartitionName, err)
+			}
+			metrics.ReplicationMsgCount.WithLabelValues(commonpb.MsgType_DropPartition.String(), sourceCollectionID).Inc()
+		case cdc.EventDropCollection:
+			if err := c.produce(batch); err != nil {
+				return false, err
+			}
+			if err := c.retry(func() error { return c.coll.dropCollection(c.ctx) }); err != nil {
+				return false, fmt.Errorf("failed to drop collection %s, err: %w", c.coll.name, err)
+			}
+			metrics.ReplicationMsgCount.WithLabelValues(commonpb.MsgType_DropCollection.String(), sourceCollectionID).Inc()
+			log.Info("replicator dropped collection", zap.String("collection", c.coll.name))
+			return true, nil
+		}
+	}
+
+	if err := c.produce(batch); err != nil {
+		return false, err
+	}
+	return false, c.advance(pack.Checkpoint, replicated || len(batch) > 0)
+}
+
+func (c *collectionReplicator) retry(fn func() error) error {
+	return retry.Do(c.ctx, fn, retry.Attempts(replicateRetryAttempts))
+}
+
+// rewriteInsert maps the IDs of the insert message to the target collection and assigns the rows to target segments
+func (c *collectionReplicator) rewriteInsert(msg *msgstream.InsertMsg) ([]msgstream.TsMsg, error) {
+	var partitionID UniqueID
+	err := c.retry(func() error {
+		var err error
+		partitionID, err = c.coll.targetPartitionID(c.ctx, msg.GetPartitionID(), msg.GetPartitionName())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vchannel := c.coll.channels[msg.GetShardName()]
+	msg.CollectionID = c.coll.target.GetCollectionID()
+	msg.PartitionID = partitionID
+	msg.ShardName = vchannel
+	for _, fieldData := range msg.GetFieldsData() {
+		if fieldID, ok := c.coll.fieldIDs[fieldData.GetFieldId()]; ok {
+			fieldData.FieldId = fieldID
+		}
+	}
+	for _, validData := range msg.GetValidData() {
+		if fieldID, ok := c.coll.fieldIDs[validData.GetFieldId()]; ok {
+			validData.FieldId = fieldID
+		}
+	}
+
+	var assignments []*datapb.SegmentIDAssignment
+	err = c.retry(func() error {
+		var err error
+		assignments, err = c.assigner.assign(c.ctx, msg.GetCollectionID(), partitionID, vchannel, uint32(msg.NRows()))
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign segments of channel %s, err: %w", vchannel, err)
+	}
+
+	parts := splitInsertMsg(msg, assignments)
+	msgs := make([]msgstream.TsMsg, 0, len(parts))
+	for _, part := range parts {
+		part.HashValues = make([]uint32, part.NRows())
+		msgs = append(msgs, part)
+	}
+	return msgs, nil
+}
+
+// rewriteDelete maps the IDs of the delete message to the target collection
+func (c *collectionReplicator) rewriteDelete(msg *msgstream.DeleteMsg) error {
+	// deleting without partition name is applied to all partitions
+	if msg.GetPartitionID() != common.InvalidPartitionID {
+		var partitionID UniqueID
+		err := c.retry(func() error {
+			var err error
+			partitionID, err = c.coll.targetPartitionID(c.ctx, msg.GetPartitionID(), msg.GetPartitionName())
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		msg.PartitionID = partitionID
+	}
+	msg.CollectionID = c.coll.target.GetCollectionID()
+	msg.ShardName = c.coll.channels[msg.GetShardName()]
+	msg.HashValues = make([]uint32, msg.GetNumRows())
+	return nil
+}
+
+// produce stamps the rewritten messages with target timestamps in their order and produces them
+// to their target channels, retrying on failure. The time ticks of the target channels are held until they are produced.
+// Replication is at-least-once, messages may be produced twice if the replicator restarts before saving the checkpoint.
+func (c *collectionReplicator) produce(msgs []msgstream.TsMsg) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	var ts Timestamp
+	var done func()
+	err := c.retry(func() error {
+		var err error
+		ts, done, err = c.ticker.alloc(c.ctx, uint32(len(msgs)))
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to allocate timestamps of collection %s, err: %w", c.coll.name, err)
+	}
+	defer done()
+
+	packs := make(map[string]*msgstream.MsgPack)
+	for i, msg := range msgs {
+		vchannel := restamp(msg, ts+Timestamp(i))
+		pack, ok := packs[vchannel]
+		if !ok {
+			pack = &msgstream.MsgPack{BeginTs: msg.BeginTs()}
+			packs[vchannel] = pack
+		}
+		pack.EndTs = msg.EndTs()
+		pack.Msgs = append(pack.Msgs, msg)
+	}
+	for vchannel, pack := range packs {
+		err := c.retry(func() error {
+			return c.producers[vchannel].Produce(pack)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to produce to channel %s, err: %w", vchannel, err)
+		}
+	}
+	c.mut.Lock()
+	c.targetTs = ts + Timestamp(len(msgs)-1)
+	c.mut.Unlock()
+
+	collectionID := strconv.FormatInt(c.coll.source.GetCollectionID(), 10)
+	for _, msg := range msgs {
+		msgType := msg.Type().String()
+		metrics.ReplicationMsgCount.WithLabelValues(msgType, collectionID).Inc()
+		switch m := msg.(type) {
+		case *msgstream.InsertMsg:
+			metrics.ReplicationRowCount.WithLabelValues(msgType, collectionID).Add(float64(m.NRows()))
+		case *msgstream.DeleteMsg:
+			metrics.ReplicationRowCount.WithLabelValues(msgType, collectionID).Add(float64(m.GetNumRows()))
+		}
+	}
+	return nil
+}
+
+// restamp sets the timestamp of the rewritten insert or delete message and returns its target channel
+func restamp(msg msgstream.TsMsg, ts Timestamp) string {
+	switch m := msg.(type) {
+	case *msgstream.InsertMsg:
+		m.BeginTimestamp, m.EndTimestamp = ts, ts
+		// the parts of a split message share the base
+		if m.Base != nil {
+			m.Base = proto.Clone(m.Base).(*commonpb.MsgBase)
+			m.Base.Timestamp = ts
+		}
+		for i := range m.Timestamps {
+			m.Timestamps[i] = ts
+		}
+		return m.GetShardName()
+	case *msgstream.DeleteMsg:
+		m.BeginTimestamp, m.EndTimestamp = ts, ts
+		if m.Base != nil {
+			m.Base.Timestamp = ts
+		}
+		for i := range m.Timestamps {
+			m.Timestamps[i] = ts
+		}
+		return m.GetShardName()
+	}
+	return ""
+}
+
+// advance records the checkpoint of the replicated pack, the checkpoint is saved if messages were replicated
+// or the last save is older than checkpointInterval.
+func (c *collectionReplicator) advance(checkpoint *cdc.Checkpoint, replicated bool) error {
+	c.mut.RLock()
+	save := replicated || time.Since(c.lastSaved) >= checkpointInterval
+	targetTs := c.targetTs
+	c.mut.RUnlock()
+	// the checkpoint is published after it is saved, so that a drained collection is resumed from the drained positions
+	if save {
+		err := c.retry(func() error {
+			return c.checkpoints.save(checkpoint, targetTs)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save checkpoint of collection %s, err: %w", c.coll.name, err)
+		}
+	}
+
+	c.mut.Lock()
+	c.checkpoint = checkpoint
+	if save {
+		c.lastSaved = time.Now()
+	}
+	c.mut.Unlock()
+	for vchannel, pos := range checkpoint.Positions {
+		metrics.ReplicationLag.WithLabelValues(vchannel).Set(float64(lagMs(pos.GetTimestamp())))
+	}
+	return nil
+}
+
+// lagMs returns the milliseconds between now and the physical time of the timestamp
+func lagMs(ts Timestamp) int64 {
+	if ts == 0 {
+		return 0
+	}
+	return time.Since(tsoutil.PhysicalTime(ts)).Milliseconds()
+}
+
+// saveCheckpoint saves the latest replicated checkpoint of the collection
+func (c *collectionReplicator) saveCheckpoint() error {
+	c.mut.Lock()
+	checkpoint, targetTs := c.checkpoint, c.targetTs
+	c.lastSaved = time.Now()
+	c.mut.Unlock()
+	if checkpoint == nil {
+		return nil
+	}
+	return c.checkpoints.save(checkpoint, targetTs)
+}
+
+// drained returns true once the replicated positions have passed the message IDs of the channels
+func (c *collectionReplicator) drained(msgIDs map[string]msgstream.MessageID) (bool, error) {
+	c.mut.RLock()
+	checkpoint := c.checkpoint
+	c.mut.RUnlock()
+	if checkpoint == nil {
+		return false, nil
+	}
+	for vchannel, msgID := range msgIDs {
+		pos, ok := checkpoint.Positions[vchannel]
+		if !ok {
+			return false, nil
+		}
+		drained, err := msgID.LessOrEqualThan(pos.GetMsgID())
+		if err != nil || !drained {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (c *collectionReplicator) setState(state string, err error) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	c.state = state
+	c.err = err
+}
+
+func (c *collectionReplicator) getState() string {
+	c.mut.RLock()
+	defer c.mut.RUnlock()
+	return c.state
+}
+
+// status returns the status of each source virtual channel of the collection
+func (c *collectionReplicator) status() []*ChannelStatus {
+	c.mut.RLock()
+	defer c.mut.RUnlock()
+	state := c.state
+	if state == ChannelRunning && c.gate.isPaused() {
+		state = ChannelPaused
+	}
+	statuses := make([]*ChannelStatus, 0, len(c.coll.source.GetVirtualChannelNames()))
+	for _, vchannel := range c.coll.source.GetVirtualChannelNames() {
+		status := &ChannelStatus{
+			Collection:    c.coll.name,
+			SourceChannel: vchannel,
+			TargetChannel: c.coll.channels[vchannel],
+			State:         state,
+		}
+		if c.checkpoint != nil {
+			if pos, ok := c.checkpoint.Positions[vchannel]; ok {
+				status.Timestamp = pos.GetTimestamp()
+				status.LagMs = lagMs(status.Timestamp)
+			}
+			status.TargetTimestamp = c.targetTs
+		}
+		if c.err != nil {
+			status.Error = c.err.Error()
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// stop stops the replication and waits for the pack being replicated
+func (c *collectionReplicator) stop() {
+	c.cancel()
+	c.wg.Wait()
+	c.mut.Lock()
+	if c.state == ChannelRunning {
+		c.state = ChannelStopped
+	}
+	c.mut.Unlock()
+	c.close()
+}
+
+func (c *collectionReplicator) close() {
+	c.cancel()
+	if c.stream != nil {
+		c.stream.Close()
+	}
+	for _, producer := range c.producers {
+		producer.Close()
+	}
+}
@@ -0,0 +1,124 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+)
+
+// timeTicker makes the replicator a time tick source of the target cluster, like a proxy.
+// The target RootCoord ticks the dml channels with the min time tick of its sources, so the channels aren't ticked
+// past the timestamps of the messages the replicator is producing, which would be behind the time ticks otherwise.
+type timeTicker struct {
+	rootCoord RootCoord
+	sourceID  UniqueID
+
+	mut sync.Mutex
+	// pending counts the messages being produced by their first timestamp
+	pending map[Timestamp]int
+}
+
+func newTimeTicker(rootCoord RootCoord, sourceID UniqueID) *timeTicker {
+	return &timeTicker{
+		rootCoord: rootCoord,
+		sourceID:  sourceID,
+		pending:   make(map[Timestamp]int),
+	}
+}
+
+// alloc allocates count consecutive timestamps and holds the time ticks before the first one until done is called
+func (t *timeTicker) alloc(ctx context.Context, count uint32) (Timestamp, func(), error) {
+	// the lock is held while allocating, so a time tick allocated later is held by the timestamps
+	t.mut.Lock()
+	defer t.mut.Unlock()
+	ts, err := allocTimestamps(ctx, t.rootCoord, count)
+	if err != nil {
+		return 0, nil, err
+	}
+	t.pending[ts]++
+	var once sync.Once
+	done := func() {
+		once.Do(func() {
+			t.mut.Lock()
+			defer t.mut.Unlock()
+			if t.pending[ts]--; t.pending[ts] <= 0 {
+				delete(t.pending, ts)
+			}
+		})
+	}
+	return ts, done, nil
+}
+
+// timeTick returns the time tick of the replicator, the latest timestamp before the pending messages
+func (t *timeTicker) timeTick(ctx context.Context) (Timestamp, error) {
+	ts, err := allocTimestamps(ctx, t.rootCoord, 1)
+	if err != nil {
+		return 0, err
+	}
+	t.mut.Lock()
+	defer t.mut.Unlock()
+	for pendingTs := range t.pending {
+		if pendingTs-1 < ts {
+			ts = pendingTs - 1
+		}
+	}
+	return ts, nil
+}
+
+// tick sends the time tick of the replicator to the target RootCoord, for all the dml channels
+func (t *timeTicker) tick(ctx context.Context) error {
+	ts, err := t.timeTick(ctx)
+	if err != nil {
+		return err
+	}
+	status, err := t.rootCoord.UpdateChannelTimeTick(ctx, &internalpb.ChannelTimeTickMsg{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_TimeTick),
+			commonpbutil.WithSourceID(t.sourceID),
+		),
+		DefaultTimestamp: ts,
+	})
+	if err != nil {
+		return err
+	}
+	return statusError(status)
+}
+
+// run sends the time ticks at the interval of the proxies until ctx is done
+func (t *timeTicker) run(ctx context.Context) {
+	ticker := time.NewTicker(Params.ProxyCfg.TimeTickInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := t.tick(ctx); err != nil && ctx.Err() == nil {
+				log.Warn("failed to send replicator time tick", zap.Int64("sourceID", t.sourceID), zap.Error(err))
+			}
+		}
+	}
+}
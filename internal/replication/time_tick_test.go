@@ -0,0 +1,63 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+)
+
+func TestTimeTicker(t *testing.T) {
+	ctx := context.Background()
+	rc := newMockRootCoord(1000, 0)
+	ticker := newTimeTicker(rc, 100)
+
+	// not holding
+	ts, err := ticker.timeTick(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, Timestamp(1000), ts)
+
+	// held before the pending messages
+	first, done1, err := ticker.alloc(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, Timestamp(1001), first)
+	second, done2, err := ticker.alloc(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, Timestamp(1004), second)
+	ts, err = ticker.timeTick(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, Timestamp(1000), ts)
+
+	done1()
+	done1()
+	ts, err = ticker.timeTick(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, Timestamp(1003), ts)
+
+	// released
+	done2()
+	require.NoError(t, ticker.tick(ctx))
+	require.Len(t, rc.timeTicks, 1)
+	assert.Equal(t, commonpb.MsgType_TimeTick, rc.timeTicks[0].GetBase().GetMsgType())
+	assert.Equal(t, UniqueID(100), rc.timeTicks[0].GetBase().GetSourceID())
+	assert.Equal(t, Timestamp(1007), rc.timeTicks[0].GetDefaultTimestamp())
+}
@@ -5,10 +5,14 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 )
 
 type alterCollectionTask struct {
@@ -25,11 +29,16 @@ func (a *alterCollectionTask) Prepare(ctx context.Context) error {
 }
 
 func (a *alterCollectionTask) Execute(ctx context.Context) error {
-	// Now we only support alter properties of collection
+	// Now we only support alter properties of collection, and append field by the collection.add_field property
 	if a.Req.GetProperties() == nil {
 		return errors.New("only support alter collection properties, but collection properties is empty")
 	}
 
+	addedField, properties, err := typeutil.SplitAddedField(a.Req.GetProperties())
+	if err != nil {
+		return err
+	}
+
 	oldColl, err := a.core.meta.GetCollectionByName(ctx, a.Req.GetCollectionName(), a.ts)
 	if err != nil {
 		log.Warn("get collection failed during changing collection state",
@@ -38,7 +47,19 @@ func (a *alterCollectionTask) Execute(ctx context.Context) error {
 	}
 
 	newColl := oldColl.Clone()
-	newColl.Properties = a.Req.GetProperties()
+	if addedField != nil {
+		if err := checkAddedField(oldColl, addedField); err != nil {
+			return err
+		}
+		addedField.FieldID = nextFieldID(oldColl)
+		newColl.Fields = append(newColl.Fields, model.UnmarshalFieldModel(addedField))
+		log.Info("append field to collection", zap.String("collectionName", oldColl.Name),
+			zap.String("fieldName", addedField.GetName()), zap.Int64("fieldID", addedField.GetFieldID()))
+	}
+	// keep the properties if only a field is appended
+	if addedField == nil || len(properties) > 0 {
+		newColl.Properties = properties
+	}
 
 	ts := a.GetTs()
 	redoTask := newBaseRedoTask(a.core.stepExecutor)
@@ -49,6 +70,14 @@ func (a *alterCollectionTask) Execute(ctx context.Context) error {
 		ts:       ts,
 	})
 
+	// QueryNodes must know the appended field before proxies insert the data of it
+	if addedField != nil {
+		redoTask.AddSyncStep(&updateCollectionSchemaStep{
+			baseStep:     baseStep{core: a.core},
+			collectionID: oldColl.CollectionID,
+		})
+	}
+
 	redoTask.AddSyncStep(&expireCacheStep{
 		baseStep:        baseStep{core: a.core},
 		collectionNames: []string{oldColl.Name},
@@ -65,3 +94,40 @@ func (a *alterCollectionTask) Execute(ctx context.Context) error {
 
 	return redoTask.Execute(ctx)
 }
+
+// checkAddedField checks the field appended to the collection, only nullable or default-valued scalar field
+// could be appended, since the segments flushed before lack the field.
+func checkAddedField(coll *model.Collection, field *schemapb.FieldSchema) error {
+	if field.GetName() == "" {
+		return errors.New("the name of added field is empty")
+	}
+	for _, f := range coll.Fields {
+		if f.Name == field.GetName() {
+			return fmt.Errorf("field %s already exists in collection %s", field.GetName(), coll.Name)
+		}
+	}
+	if field.GetIsPrimaryKey() || field.GetAutoID() {
+		return fmt.Errorf("added field %s can not be primary key or auto id", field.GetName())
+	}
+	if field.GetDataType() == schemapb.DataType_None || typeutil.IsVectorType(field.GetDataType()) {
+		return fmt.Errorf("added field %s must be a scalar field, but got %s", field.GetName(), field.GetDataType().String())
+	}
+	if !typeutil.IsFieldOptional(field) {
+		return fmt.Errorf("added field %s must be nullable or have a default value", field.GetName())
+	}
+	if _, err := typeutil.ParseFieldDefaultValue(field); err != nil {
+		return fmt.Errorf("invalid default value of added field %s: %s", field.GetName(), err.Error())
+	}
+	return nil
+}
+
+// nextFieldID returns the field id following the largest one of the collection.
+func nextFieldID(coll *model.Collection) int64 {
+	fieldID := int64(common.StartOfUserFieldID)
+	for _, f := range coll.Fields {
+		if f.FieldID >= fieldID {
+			fieldID = f.FieldID + 1
+		}
+	}
+	return fieldID
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 )
 
 func Test_alterCollectionTask_Prepare(t *testing.T) {
@@ -140,4 +141,131 @@ func Test_alterCollectionTask_Execute(t *testing.T) {
 		err := task.Execute(context.Background())
 		assert.NoError(t, err)
 	})
+
+	t.Run("add field successfully", func(t *testing.T) {
+		oldColl := &model.Collection{
+			CollectionID: int64(1),
+			Name:         "cn",
+			Fields: []*model.Field{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			},
+			Properties: properties,
+		}
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return oldColl, nil
+		}
+		var altered *model.Collection
+		meta.AlterCollectionFunc = func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
+			altered = newColl
+			return nil
+		}
+
+		broker := newMockBroker()
+		broker.BroadcastAlteredCollectionFunc = func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
+			return nil
+		}
+		var updatedCollection UniqueID
+		broker.UpdateCollectionSchemaFunc = func(ctx context.Context, collectionID UniqueID) error {
+			updatedCollection = collectionID
+			return nil
+		}
+
+		core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(broker))
+		task := &alterCollectionTask{
+			baseTask: baseTask{core: core},
+			Req: &milvuspb.AlterCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				CollectionName: "cn",
+				Properties: []*commonpb.KeyValuePair{
+					{
+						Key:   common.CollectionAddFieldKey,
+						Value: `{"name": "tag", "data_type": "Int64", "type_params": [{"key": "default_value", "value": "1"}]}`,
+					},
+				},
+			},
+		}
+
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), updatedCollection)
+		assert.Equal(t, 3, len(altered.Fields))
+		assert.Equal(t, int64(102), altered.Fields[2].FieldID)
+		assert.Equal(t, "tag", altered.Fields[2].Name)
+		assert.Equal(t, properties, altered.Properties)
+		assert.Equal(t, 2, len(oldColl.Fields))
+	})
+
+	t.Run("update schema step failed", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return &model.Collection{
+				CollectionID: int64(1),
+				Fields:       []*model.Field{{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true}},
+			}, nil
+		}
+		meta.AlterCollectionFunc = func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
+			return nil
+		}
+
+		broker := newMockBroker()
+		broker.BroadcastAlteredCollectionFunc = func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
+			return nil
+		}
+		broker.UpdateCollectionSchemaFunc = func(ctx context.Context, collectionID UniqueID) error {
+			return errors.New("err")
+		}
+
+		core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(broker))
+		task := &alterCollectionTask{
+			baseTask: baseTask{core: core},
+			Req: &milvuspb.AlterCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				CollectionName: "cn",
+				Properties: []*commonpb.KeyValuePair{
+					{
+						Key:   common.CollectionAddFieldKey,
+						Value: `{"name": "tag", "data_type": "Int64", "type_params": [{"key": "nullable", "value": "true"}]}`,
+					},
+				},
+			},
+		}
+
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("add invalid field", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return &model.Collection{
+				CollectionID: int64(1),
+				Fields:       []*model.Field{{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true}},
+			}, nil
+		}
+		core := newTestCore(withMeta(meta))
+
+		fields := []string{
+			`{"name": "pk", "data_type": "Int64", "type_params": [{"key": "nullable", "value": "true"}]}`,
+			`{"name": "tag", "data_type": "Int64"}`,
+			`{"name": "tag", "data_type": "FloatVector", "type_params": [{"key": "nullable", "value": "true"}]}`,
+			`{"name": "tag", "data_type": "Int8", "type_params": [{"key": "default_value", "value": "1000"}]}`,
+			`{"name": "tag", "data_type": "Int64", "is_primary_key": true, "type_params": [{"key": "nullable", "value": "true"}]}`,
+			`{"data_type": "Int64", "type_params": [{"key": "nullable", "value": "true"}]}`,
+			`invalid`,
+		}
+		for _, field := range fields {
+			task := &alterCollectionTask{
+				baseTask: baseTask{core: core},
+				Req: &milvuspb.AlterCollectionRequest{
+					Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+					CollectionName: "cn",
+					Properties:     []*commonpb.KeyValuePair{{Key: common.CollectionAddFieldKey, Value: field}},
+				},
+			}
+			err := task.Execute(context.Background())
+			assert.Error(t, err, field)
+		}
+	})
 }
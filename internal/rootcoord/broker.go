@@ -42,6 +42,8 @@ type Broker interface {
 	ReleaseSegRefLock(ctx context.Context, taskID int64, segIDs []int64) error
 	Flush(ctx context.Context, cID int64, segIDs []int64) error
 	Import(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error)
+	Export(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error)
+	CancelImport(ctx context.Context, req *datapb.CancelImportTaskRequest) (*commonpb.Status, error)
 	UnsetIsImportingState(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error)
 	MarkSegmentsDropped(context.Context, *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error)
 
@@ -50,6 +52,7 @@ type Broker interface {
 	DescribeIndex(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error)
 
 	BroadcastAlteredCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) error
+	UpdateCollectionSchema(ctx context.Context, collectionID UniqueID) error
 }
 
 type ServerBroker struct {
@@ -195,6 +198,14 @@ func (b *ServerBroker) Import(ctx context.Context, req *datapb.ImportTaskRequest
 	return b.s.dataCoord.Import(ctx, req)
 }
 
+func (b *ServerBroker) Export(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+	return b.s.dataCoord.Export(ctx, req)
+}
+
+func (b *ServerBroker) CancelImport(ctx context.Context, req *datapb.CancelImportTaskRequest) (*commonpb.Status, error) {
+	return b.s.dataCoord.CancelImport(ctx, req)
+}
+
 func (b *ServerBroker) UnsetIsImportingState(ctx context.Context, req *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error) {
 	return b.s.dataCoord.UnsetIsImportingState(ctx, req)
 }
@@ -257,7 +268,7 @@ func (b *ServerBroker) BroadcastAlteredCollection(ctx context.Context, req *milv
 		},
 		PartitionIDs:   partitionIDs,
 		StartPositions: colMeta.StartPositions,
-		Properties:     req.GetProperties(),
+		Properties:     colMeta.Properties,
 	}
 
 	resp, err := b.s.dataCoord.BroadcastAlteredCollection(ctx, dcReq)
@@ -272,6 +283,36 @@ func (b *ServerBroker) BroadcastAlteredCollection(ctx context.Context, req *milv
 	return nil
 }
 
+// UpdateCollectionSchema pushes the schema of the collection to QueryCoord after fields are appended.
+func (b *ServerBroker) UpdateCollectionSchema(ctx context.Context, collectionID UniqueID) error {
+	log.Info("updating collection schema of query nodes", zap.Int64("collection id", collectionID))
+
+	colMeta, err := b.s.meta.GetCollectionByID(ctx, collectionID, typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+
+	resp, err := b.s.queryCoord.UpdateCollectionSchema(ctx, &querypb.UpdateCollectionSchemaRequest{
+		Base:         commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_AlterCollection)),
+		CollectionID: collectionID,
+		Schema: &schemapb.CollectionSchema{
+			Name:        colMeta.Name,
+			Description: colMeta.Description,
+			AutoID:      colMeta.AutoID,
+			Fields:      model.MarshalFieldModels(colMeta.Fields),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp.GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(resp.GetReason())
+	}
+	log.Info("done to update collection schema of query nodes", zap.Int64("collection id", collectionID))
+	return nil
+}
+
 func (b *ServerBroker) DescribeIndex(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error) {
 	return b.s.indexCoord.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{
 		CollectionID: colID,
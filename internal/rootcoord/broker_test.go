@@ -12,7 +12,9 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -356,3 +358,65 @@ func TestServerBroker_BroadcastAlteredCollection(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestServerBroker_UpdateCollectionSchema(t *testing.T) {
+	collMeta := &model.Collection{
+		CollectionID: 1,
+		Name:         "test",
+		Fields: []*model.Field{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "added", DataType: schemapb.DataType_Int64},
+		},
+	}
+	withMeta := func(c *Core) {
+		c.meta = &mockMetaTable{
+			GetCollectionByIDFunc: func(ctx context.Context, collectionID UniqueID, ts Timestamp) (*model.Collection, error) {
+				return collMeta, nil
+			},
+		}
+	}
+
+	t.Run("get meta fail", func(t *testing.T) {
+		c := newTestCore(withValidQueryCoord())
+		c.meta = &mockMetaTable{
+			GetCollectionByIDFunc: func(ctx context.Context, collectionID UniqueID, ts Timestamp) (*model.Collection, error) {
+				return nil, errors.New("err")
+			},
+		}
+		b := newServerBroker(c)
+		err := b.UpdateCollectionSchema(context.Background(), 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withInvalidQueryCoord())
+		withMeta(c)
+		b := newServerBroker(c)
+		err := b.UpdateCollectionSchema(context.Background(), 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("non success error code on execute", func(t *testing.T) {
+		c := newTestCore(withFailedQueryCoord())
+		withMeta(c)
+		b := newServerBroker(c)
+		err := b.UpdateCollectionSchema(context.Background(), 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		qc := newMockQueryCoord()
+		var schema *schemapb.CollectionSchema
+		qc.UpdateCollectionSchemaFunc = func(ctx context.Context, req *querypb.UpdateCollectionSchemaRequest) (*commonpb.Status, error) {
+			schema = req.GetSchema()
+			return succStatus(), nil
+		}
+		c := newTestCore(withQueryCoord(qc))
+		withMeta(c)
+		b := newServerBroker(c)
+		err := b.UpdateCollectionSchema(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "test", schema.GetName())
+		assert.Equal(t, 2, len(schema.GetFields()))
+	})
+}
@@ -0,0 +1,522 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// exportManager manager for export tasks, an export task exports the flushed segments of a collection
+// into files by a DataNode, the states of the tasks are persisted the same way as import tasks, see task_store.go.
+type exportManager struct {
+	ctx       context.Context // reserved
+	taskStore kv.TxnKV        // Persistent task info storage.
+	busyNodes map[int64]int64 // Set of all current working DataNode IDs and related task start timestamp.
+
+	pendingTasks  []*rootcoordpb.ExportTaskInfo         // pending tasks
+	workingTasks  map[int64]*rootcoordpb.ExportTaskInfo // in-progress tasks
+	pendingLock   sync.RWMutex                          // lock pending task list
+	workingLock   sync.RWMutex                          // lock working task map
+	busyNodesLock sync.RWMutex                          // lock for working nodes.
+
+	startOnce sync.Once
+
+	idAllocator       func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error)
+	callExportService func(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error)
+}
+
+// newExportManager helper function to create an exportManager
+func newExportManager(ctx context.Context, client kv.TxnKV,
+	idAlloc func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error),
+	exportService func(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error)) *exportManager {
+	return &exportManager{
+		ctx:               ctx,
+		taskStore:         client,
+		pendingTasks:      make([]*rootcoordpb.ExportTaskInfo, 0, MaxPendingCount),
+		workingTasks:      make(map[int64]*rootcoordpb.ExportTaskInfo),
+		busyNodes:         make(map[int64]int64),
+		idAllocator:       idAlloc,
+		callExportService: exportService,
+	}
+}
+
+func (m *exportManager) init(ctx context.Context) {
+	m.startOnce.Do(func() {
+		// Read tasks from Etcd and save them as pending tasks and mark started tasks as failed.
+		if _, err := m.loadFromTaskStore(true); err != nil {
+			log.Error("exportManager init failed, read tasks from Etcd failed, about to panic")
+			panic(err)
+		}
+		// Send out tasks to dataCoord.
+		if err := m.sendOutTasks(ctx); err != nil {
+			log.Error("exportManager init failed, send out tasks to dataCoord failed")
+		}
+	})
+}
+
+// sendOutTasksLoop periodically calls `sendOutTasks` to process left over pending tasks.
+func (m *exportManager) sendOutTasksLoop(wg *sync.WaitGroup) {
+	runTaskLoop(m.ctx, wg, time.Duration(checkPendingTasksInterval)*time.Millisecond, "export sendOutTasksLoop", func() {
+		if err := m.sendOutTasks(m.ctx); err != nil {
+			log.Error("exportManager sendOutTasksLoop fail to send out tasks")
+		}
+	})
+}
+
+// cleanupLoop starts a loop that expires working tasks which existed for over `ExportTaskExpiration` seconds,
+// and removes tasks which are created over `ExportTaskRetention` seconds ago from Etcd.
+func (m *exportManager) cleanupLoop(wg *sync.WaitGroup) {
+	runTaskLoop(m.ctx, wg, time.Duration(cleanUpLoopInterval)*time.Millisecond, "export cleanupLoop", func() {
+		log.Debug("(in cleanupLoop) trying to expire old export tasks from memory and Etcd")
+		m.expireOldTasksFromMem()
+		m.expireOldTasksFromEtcd()
+	})
+}
+
+// sendOutTasks pushes all pending tasks to DataCoord, gets DataCoord response and re-add these tasks as working tasks.
+// A task rejected by DataCoord stays pending, e.g. no DataNode is idle or the segments are being flushed, unless
+// it is refused with ErrorCode_IllegalArgument, e.g. the rows visible at its timestamp are no longer kept.
+func (m *exportManager) sendOutTasks(ctx context.Context) error {
+	m.pendingLock.Lock()
+	m.busyNodesLock.Lock()
+	defer m.pendingLock.Unlock()
+	defer m.busyNodesLock.Unlock()
+
+	for len(m.pendingTasks) > 0 {
+		task := m.pendingTasks[0]
+		et := &datapb.ExportTask{
+			TaskId:       task.GetId(),
+			CollectionId: task.GetCollectionId(),
+			PartitionIds: task.GetPartitionIds(),
+			Timestamp:    task.GetTimestamp(),
+			FileType:     task.GetFileType(),
+			Bucket:       task.GetBucket(),
+			Path:         task.GetPath(),
+			Infos:        task.GetInfos(),
+		}
+
+		// Send export task to dataCoord, which will then distribute the export task to dataNode.
+		resp, err := m.callExportService(ctx, &datapb.ExportTaskRequest{
+			ExportTask:   et,
+			WorkingNodes: busyNodeList(m.busyNodes),
+		})
+		if err != nil {
+			log.Error("export task get error", zap.Error(err))
+			break
+		}
+		if resp.GetStatus().GetErrorCode() == commonpb.ErrorCode_IllegalArgument {
+			log.Warn("export task is refused and marked as failed",
+				zap.Int64("task ID", et.GetTaskId()),
+				zap.String("cause", resp.GetStatus().GetReason()))
+			toPersistTaskInfo := cloneExportTaskInfo(task)
+			toPersistTaskInfo.State.StateCode = rootcoordpb.ExportState_ExportFailed
+			tryUpdateExportErrMsg(resp.GetStatus().GetReason(), toPersistTaskInfo)
+			if err := m.persistTaskInfo(toPersistTaskInfo); err != nil {
+				return err
+			}
+			m.pendingTasks = append(m.pendingTasks[:0], m.pendingTasks[1:]...)
+			continue
+		}
+		if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			log.Warn("export task is rejected",
+				zap.Int64("task ID", et.GetTaskId()),
+				zap.Any("error code", resp.GetStatus().GetErrorCode()),
+				zap.String("cause", resp.GetStatus().GetReason()))
+			break
+		}
+
+		log.Debug("export task successfully assigned to dataNode",
+			zap.Int64("task ID", et.GetTaskId()),
+			zap.Int64("dataNode ID", resp.GetDatanodeId()))
+		m.busyNodes[resp.GetDatanodeId()] = time.Now().Unix()
+		err = func() error {
+			m.workingLock.Lock()
+			defer m.workingLock.Unlock()
+			toPersistTaskInfo := cloneExportTaskInfo(task)
+			toPersistTaskInfo.DatanodeId = resp.GetDatanodeId()
+			toPersistTaskInfo.StartTs = time.Now().Unix()
+			toPersistTaskInfo.State.StateCode = rootcoordpb.ExportState_ExportStarted
+			toPersistTaskInfo.State.TotalSegments = resp.GetTotalSegments()
+			// first update the export task into meta store and then put it into working tasks
+			if err := m.persistTaskInfo(toPersistTaskInfo); err != nil {
+				return err
+			}
+			m.workingTasks[task.GetId()] = toPersistTaskInfo
+			return nil
+		}()
+		if err != nil {
+			return err
+		}
+		// Remove this task from head of pending list.
+		m.pendingTasks = append(m.pendingTasks[:0], m.pendingTasks[1:]...)
+	}
+
+	return nil
+}
+
+// exportJob creates a pending export task for the request, and tries to send it out immediately.
+func (m *exportManager) exportJob(ctx context.Context, task *rootcoordpb.ExportTaskInfo) *rootcoordpb.ExportResponse {
+	returnErrorFunc := func(reason string) *rootcoordpb.ExportResponse {
+		return &rootcoordpb.ExportResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    reason,
+			},
+		}
+	}
+
+	if m.callExportService == nil {
+		return returnErrorFunc("export service is not available")
+	}
+
+	err := func() error {
+		m.pendingLock.Lock()
+		defer m.pendingLock.Unlock()
+
+		if len(m.pendingTasks) >= MaxPendingCount {
+			err := fmt.Errorf("export task queue max size is %v, currently there are %v tasks is pending", MaxPendingCount, len(m.pendingTasks))
+			log.Error(err.Error())
+			return err
+		}
+
+		tID, _, err := m.idAllocator(1)
+		if err != nil {
+			log.Error("failed to allocate ID for export task", zap.Error(err))
+			return err
+		}
+		task.Id = tID
+		task.CreateTs = time.Now().Unix()
+		task.State = &rootcoordpb.ExportTaskState{
+			StateCode: rootcoordpb.ExportState_ExportPending,
+		}
+		if err := m.persistTaskInfo(task); err != nil {
+			return err
+		}
+		m.pendingTasks = append(m.pendingTasks, task)
+		log.Info("new export task created as pending task", zap.Int64("task ID", task.GetId()))
+		return nil
+	}()
+	if err != nil {
+		return returnErrorFunc(err.Error())
+	}
+	if err := m.sendOutTasks(ctx); err != nil {
+		log.Error("fail to send out export tasks", zap.Error(err))
+	}
+	return &rootcoordpb.ExportResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId: task.GetId(),
+	}
+}
+
+// updateTaskInfo updates the state of a working task by the export result reported by DataNode,
+// the DataNode is marked idle and the pending tasks are sent out once the task is completed or failed.
+func (m *exportManager) updateTaskInfo(er *rootcoordpb.ExportResult) error {
+	if er == nil {
+		return errors.New("export result is nil")
+	}
+	log.Debug("export manager update task export result", zap.Int64("task ID", er.GetTaskId()))
+
+	finished := er.GetState() == rootcoordpb.ExportState_ExportCompleted ||
+		er.GetState() == rootcoordpb.ExportState_ExportFailed
+	err := func() error {
+		// DataNode may report before the task is moved to working tasks, hold the pending lock to wait for sendOutTasks
+		m.pendingLock.RLock()
+		defer m.pendingLock.RUnlock()
+		m.workingLock.Lock()
+		defer m.workingLock.Unlock()
+		v, ok := m.workingTasks[er.GetTaskId()]
+		if !ok {
+			return errors.New("failed to update export task, ID not found: " + strconv.FormatInt(er.GetTaskId(), 10))
+		}
+		// Meta persist should be done before memory objs change.
+		toPersistTaskInfo := cloneExportTaskInfo(v)
+		toPersistTaskInfo.State.StateCode = er.GetState()
+		toPersistTaskInfo.State.Segments = er.GetSegments()
+		toPersistTaskInfo.State.TotalSegments = er.GetTotalSegments()
+		toPersistTaskInfo.State.Files = er.GetFiles()
+		toPersistTaskInfo.State.RowCount = er.GetRowCount()
+		for _, info := range er.GetInfos() {
+			if info.GetKey() == FailedReason {
+				toPersistTaskInfo.State.ErrorMessage = info.GetValue()
+			}
+		}
+		if err := m.persistTaskInfo(toPersistTaskInfo); err != nil {
+			return err
+		}
+		if finished {
+			delete(m.workingTasks, er.GetTaskId())
+		} else {
+			m.workingTasks[er.GetTaskId()] = toPersistTaskInfo
+		}
+		return nil
+	}()
+	if err != nil {
+		log.Warn("export manager update task export result failed", zap.Int64("task ID", er.GetTaskId()), zap.Error(err))
+		return err
+	}
+
+	if finished {
+		m.busyNodesLock.Lock()
+		delete(m.busyNodes, er.GetDatanodeId())
+		m.busyNodesLock.Unlock()
+		log.Info("a DataNode is no longer busy after processing export task",
+			zap.Int64("dataNode ID", er.GetDatanodeId()),
+			zap.Int64("task ID", er.GetTaskId()),
+			zap.Any("export state", er.GetState()))
+		if err := m.sendOutTasks(m.ctx); err != nil {
+			log.Error("fail to send out export tasks to datanodes", zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (m *exportManager) copyTaskInfo(input *rootcoordpb.ExportTaskInfo, output *rootcoordpb.GetExportStateResponse) {
+	output.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+
+	output.Id = input.GetId()
+	output.State = input.GetState().GetStateCode()
+	output.CollectionId = input.GetCollectionId()
+	output.CollectionName = input.GetCollectionName()
+	output.PartitionNames = input.GetPartitionNames()
+	output.Timestamp = input.GetTimestamp()
+	output.FileType = input.GetFileType()
+	output.Bucket = input.GetBucket()
+	output.Path = input.GetPath()
+	output.Files = input.GetState().GetFiles()
+	output.RowCount = input.GetState().GetRowCount()
+	output.CreateTs = input.GetCreateTs()
+	output.ErrorMessage = input.GetState().GetErrorMessage()
+	if output.State == rootcoordpb.ExportState_ExportCompleted {
+		output.Progress = 100
+	} else if total := input.GetState().GetTotalSegments(); total > 0 {
+		output.Progress = int64(len(input.GetState().GetSegments())) * 100 / total
+	}
+}
+
+// getTaskState looks for task with the given ID and returns its export state.
+func (m *exportManager) getTaskState(tID int64) *rootcoordpb.GetExportStateResponse {
+	resp := &rootcoordpb.GetExportStateResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "export task id doesn't exist",
+		},
+	}
+	log.Debug("getting export task state", zap.Int64("task ID", tID))
+
+	// (1) Search in pending tasks list.
+	found := false
+	m.pendingLock.RLock()
+	for _, t := range m.pendingTasks {
+		if tID == t.GetId() {
+			m.copyTaskInfo(t, resp)
+			found = true
+			break
+		}
+	}
+	m.pendingLock.RUnlock()
+	if found {
+		return resp
+	}
+	// (2) Search in working tasks map.
+	m.workingLock.RLock()
+	if v, ok := m.workingTasks[tID]; ok {
+		m.copyTaskInfo(v, resp)
+		found = true
+	}
+	m.workingLock.RUnlock()
+	if found {
+		return resp
+	}
+	// (3) Search in Etcd.
+	ti := &rootcoordpb.ExportTaskInfo{}
+	if loadTaskInfo(m.taskStore, BuildExportTaskKey(tID), ti) {
+		m.copyTaskInfo(ti, resp)
+	}
+	return resp
+}
+
+// loadFromTaskStore loads task info from task store (Etcd).
+// loadFromTaskStore also adds the pending tasks back to pending list, and marks started tasks as failed,
+// when `load2Mem` is set to `true`, otherwise it returns a list of all export tasks.
+func (m *exportManager) loadFromTaskStore(load2Mem bool) ([]*rootcoordpb.ExportTaskInfo, error) {
+	tis, err := loadTaskInfos(m.taskStore, Params.RootCoordCfg.ExportTaskSubPath,
+		func() *rootcoordpb.ExportTaskInfo { return &rootcoordpb.ExportTaskInfo{} })
+	if err != nil {
+		log.Error("export manager failed to load from Etcd", zap.Error(err))
+		return nil, err
+	}
+	var taskList []*rootcoordpb.ExportTaskInfo
+
+	for _, ti := range tis {
+		if !load2Mem {
+			taskList = append(taskList, ti)
+			continue
+		}
+		switch ti.GetState().GetStateCode() {
+		case rootcoordpb.ExportState_ExportPending:
+			log.Info("export task has been reloaded as a pending task", zap.Int64("task ID", ti.GetId()))
+			m.pendingLock.Lock()
+			m.pendingTasks = append(m.pendingTasks, ti)
+			m.pendingLock.Unlock()
+		case rootcoordpb.ExportState_ExportStarted:
+			// the DataNode stops reporting to the new RootCoord, the files written so far are left in the target path
+			ti.State.StateCode = rootcoordpb.ExportState_ExportFailed
+			tryUpdateExportErrMsg("task marked failed as service restarted", ti)
+			if err := m.persistTaskInfo(ti); err != nil {
+				log.Error("failed to mark an old export task as failed",
+					zap.Int64("task ID", ti.GetId()),
+					zap.Error(err))
+			}
+			log.Info("export task has been marked failed while reloading", zap.Int64("task ID", ti.GetId()))
+		}
+	}
+	return taskList, nil
+}
+
+// persistTaskInfo stores or updates the export task info in Etcd.
+func (m *exportManager) persistTaskInfo(ti *rootcoordpb.ExportTaskInfo) error {
+	log.Info("updating export task info in Etcd", zap.Int64("task ID", ti.GetId()))
+	if err := saveTaskInfo(m.taskStore, BuildExportTaskKey(ti.GetId()), ti); err != nil {
+		log.Error("failed to update export task info in Etcd",
+			zap.Int64("task ID", ti.GetId()),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// yieldTaskInfo removes the task info from Etcd.
+func (m *exportManager) yieldTaskInfo(tID int64) error {
+	log.Info("removing export task info from Etcd", zap.Int64("task ID", tID))
+	if err := m.taskStore.Remove(BuildExportTaskKey(tID)); err != nil {
+		log.Error("failed to remove export task info from Etcd",
+			zap.Int64("task ID", tID),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// expireOldTasksFromMem marks the working tasks started over `ExportTaskExpiration` seconds ago as failed,
+// and releases their DataNodes.
+func (m *exportManager) expireOldTasksFromMem() {
+	m.workingLock.Lock()
+	defer m.workingLock.Unlock()
+	for taskID, v := range m.workingTasks {
+		if Params.RootCoordCfg.ExportTaskExpiration > float64(time.Now().Unix()-v.GetStartTs()) {
+			continue
+		}
+		log.Info("a working export task has expired and will be marked as failed",
+			zap.Int64("task ID", taskID),
+			zap.Int64("startTs", v.GetStartTs()),
+			zap.Float64("ExportTaskExpiration", Params.RootCoordCfg.ExportTaskExpiration))
+		toPersistTaskInfo := cloneExportTaskInfo(v)
+		toPersistTaskInfo.State.StateCode = rootcoordpb.ExportState_ExportFailed
+		tryUpdateExportErrMsg("the export task has timed out", toPersistTaskInfo)
+		if err := m.persistTaskInfo(toPersistTaskInfo); err != nil {
+			continue
+		}
+		delete(m.workingTasks, taskID)
+
+		// Remove DataNode from busy node list, so it can serve other tasks again.
+		m.busyNodesLock.Lock()
+		delete(m.busyNodes, v.GetDatanodeId())
+		m.busyNodesLock.Unlock()
+	}
+}
+
+// expireOldTasksFromEtcd removes tasks from Etcd that are over `ExportTaskRetention` seconds old.
+func (m *exportManager) expireOldTasksFromEtcd() {
+	tasks, err := m.loadFromTaskStore(false)
+	if err != nil {
+		log.Error("failed to load export tasks from Etcd during task cleanup")
+		return
+	}
+	for _, ti := range tasks {
+		if ti.GetState().GetStateCode() == rootcoordpb.ExportState_ExportPending ||
+			ti.GetState().GetStateCode() == rootcoordpb.ExportState_ExportStarted {
+			continue
+		}
+		if Params.RootCoordCfg.ExportTaskRetention <= float64(time.Now().Unix()-ti.GetCreateTs()) {
+			log.Info("an export task has passed retention period and will be removed from Etcd",
+				zap.Int64("task ID", ti.GetId()),
+				zap.Int64("createTs", ti.GetCreateTs()),
+				zap.Float64("ExportTaskRetention", Params.RootCoordCfg.ExportTaskRetention))
+			if err = m.yieldTaskInfo(ti.GetId()); err != nil {
+				log.Error("failed to remove export task from Etcd",
+					zap.Int64("task ID", ti.GetId()),
+					zap.Error(err))
+			}
+		}
+	}
+}
+
+// listAllTasks returns the latest `limit` tasks of the collection, all the collections if colID is negative.
+func (m *exportManager) listAllTasks(colID int64, limit int64) ([]*rootcoordpb.GetExportStateResponse, error) {
+	exportTasks, err := m.loadFromTaskStore(false)
+	if err != nil {
+		log.Error("failed to load from task store", zap.Error(err))
+		return nil, fmt.Errorf("failed to load task list from etcd, error: %w", err)
+	}
+
+	tasks := make([]*rootcoordpb.GetExportStateResponse, 0)
+	for _, task := range exportTasks {
+		if colID < 0 || colID == task.GetCollectionId() {
+			currTask := &rootcoordpb.GetExportStateResponse{}
+			m.copyTaskInfo(task, currTask)
+			tasks = append(tasks, currTask)
+		}
+	}
+
+	return latestTasks(tasks, limit), nil
+}
+
+// BuildExportTaskKey constructs and returns an Etcd key with given task ID.
+func BuildExportTaskKey(taskID int64) string {
+	return fmt.Sprintf("%s%s%d", Params.RootCoordCfg.ExportTaskSubPath, delimiter, taskID)
+}
+
+func tryUpdateExportErrMsg(errReason string, ti *rootcoordpb.ExportTaskInfo) {
+	if ti.GetState().GetErrorMessage() == "" {
+		ti.State.ErrorMessage = errReason
+	} else {
+		ti.State.ErrorMessage = fmt.Sprintf("%s; %s", ti.GetState().GetErrorMessage(), errReason)
+	}
+}
+
+func cloneExportTaskInfo(taskInfo *rootcoordpb.ExportTaskInfo) *rootcoordpb.ExportTaskInfo {
+	return proto.Clone(taskInfo).(*rootcoordpb.ExportTaskInfo)
+}
@@ -0,0 +1,259 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func newTestExportManager(ctx context.Context, callExportService func(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error)) *exportManager {
+	var countLock sync.Mutex
+	var globalCount = typeutil.UniqueID(0)
+	idAlloc := func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+		countLock.Lock()
+		defer countLock.Unlock()
+		globalCount++
+		return globalCount, 0, nil
+	}
+	Params.RootCoordCfg.ExportTaskSubPath = "test_export_task"
+	return newExportManager(ctx, memkv.NewMemoryKV(), idAlloc, callExportService)
+}
+
+func TestExportManager_ExportJob(t *testing.T) {
+	ctx := context.Background()
+	busy := false
+	callExportService := func(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+		if busy {
+			return &datapb.ExportTaskResponse{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError},
+			}, nil
+		}
+		return &datapb.ExportTaskResponse{
+			Status:        &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			DatanodeId:    int64(len(req.GetWorkingNodes())) + 1,
+			TotalSegments: 4,
+		}, nil
+	}
+	mgr := newTestExportManager(ctx, callExportService)
+
+	// the task is sent out immediately
+	resp := mgr.exportJob(ctx, &rootcoordpb.ExportTaskInfo{CollectionId: 10, Path: "a"})
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, int64(1), resp.GetTaskId())
+	assert.Equal(t, 0, len(mgr.pendingTasks))
+	assert.Equal(t, 1, len(mgr.workingTasks))
+	state := mgr.getTaskState(1)
+	assert.Equal(t, commonpb.ErrorCode_Success, state.GetStatus().GetErrorCode())
+	assert.Equal(t, rootcoordpb.ExportState_ExportStarted, state.GetState())
+	assert.Equal(t, "a", state.GetPath())
+	assert.Equal(t, int64(0), state.GetProgress())
+
+	// no idle datanode, the task is pending
+	busy = true
+	resp = mgr.exportJob(ctx, &rootcoordpb.ExportTaskInfo{CollectionId: 20, Path: "b"})
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, int64(2), resp.GetTaskId())
+	assert.Equal(t, 1, len(mgr.pendingTasks))
+	assert.Equal(t, rootcoordpb.ExportState_ExportPending, mgr.getTaskState(2).GetState())
+
+	// progress of the working task
+	err := mgr.updateTaskInfo(&rootcoordpb.ExportResult{
+		TaskId:        1,
+		DatanodeId:    1,
+		State:         rootcoordpb.ExportState_ExportStarted,
+		Segments:      []int64{100},
+		TotalSegments: 4,
+		Files:         []string{"a/100/0.json"},
+		RowCount:      10,
+	})
+	assert.NoError(t, err)
+	state = mgr.getTaskState(1)
+	assert.Equal(t, int64(25), state.GetProgress())
+	assert.Equal(t, int64(10), state.GetRowCount())
+	assert.Equal(t, []string{"a/100/0.json"}, state.GetFiles())
+
+	// the datanode is released after the task is completed, and the pending task is sent out
+	busy = false
+	err = mgr.updateTaskInfo(&rootcoordpb.ExportResult{
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      rootcoordpb.ExportState_ExportCompleted,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(mgr.pendingTasks))
+	assert.Equal(t, 1, len(mgr.workingTasks))
+	assert.Equal(t, 1, len(mgr.busyNodes))
+	state = mgr.getTaskState(1)
+	assert.Equal(t, rootcoordpb.ExportState_ExportCompleted, state.GetState())
+	assert.Equal(t, int64(100), state.GetProgress())
+	assert.Equal(t, rootcoordpb.ExportState_ExportStarted, mgr.getTaskState(2).GetState())
+
+	// failed reason is recorded
+	err = mgr.updateTaskInfo(&rootcoordpb.ExportResult{
+		TaskId:     2,
+		DatanodeId: 1,
+		State:      rootcoordpb.ExportState_ExportFailed,
+		Infos:      []*commonpb.KeyValuePair{{Key: FailedReason, Value: "mock error"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(mgr.busyNodes))
+	state = mgr.getTaskState(2)
+	assert.Equal(t, rootcoordpb.ExportState_ExportFailed, state.GetState())
+	assert.Equal(t, "mock error", state.GetErrorMessage())
+
+	// unknown task
+	assert.Error(t, mgr.updateTaskInfo(nil))
+	assert.Error(t, mgr.updateTaskInfo(&rootcoordpb.ExportResult{TaskId: 1}))
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, mgr.getTaskState(100).GetStatus().GetErrorCode())
+
+	// list tasks
+	tasks, err := mgr.listAllTasks(-1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(tasks))
+	tasks, err = mgr.listAllTasks(20, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(tasks))
+	assert.Equal(t, int64(2), tasks[0].GetId())
+	tasks, err = mgr.listAllTasks(-1, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(tasks))
+	assert.Equal(t, int64(2), tasks[0].GetId())
+}
+
+func TestExportManager_ExportJobRefused(t *testing.T) {
+	ctx := context.Background()
+	callExportService := func(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+		if req.GetExportTask().GetTimestamp() == 0 {
+			return &datapb.ExportTaskResponse{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_IllegalArgument, Reason: "mock refused"},
+			}, nil
+		}
+		return &datapb.ExportTaskResponse{
+			Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			DatanodeId: 1,
+		}, nil
+	}
+	mgr := newTestExportManager(ctx, callExportService)
+
+	// the refused task is marked as failed instead of blocking the pending tasks
+	mgr.pendingTasks = append(mgr.pendingTasks,
+		&rootcoordpb.ExportTaskInfo{Id: 1, State: &rootcoordpb.ExportTaskState{}},
+		&rootcoordpb.ExportTaskInfo{Id: 2, Timestamp: 100, State: &rootcoordpb.ExportTaskState{}})
+	assert.NoError(t, mgr.sendOutTasks(ctx))
+	assert.Equal(t, 0, len(mgr.pendingTasks))
+	assert.Equal(t, 1, len(mgr.workingTasks))
+	state := mgr.getTaskState(1)
+	assert.Equal(t, rootcoordpb.ExportState_ExportFailed, state.GetState())
+	assert.Equal(t, "mock refused", state.GetErrorMessage())
+	assert.Equal(t, rootcoordpb.ExportState_ExportStarted, mgr.getTaskState(2).GetState())
+}
+
+func TestExportManager_ExportJobFailed(t *testing.T) {
+	ctx := context.Background()
+	mgr := newTestExportManager(ctx, nil)
+	resp := mgr.exportJob(ctx, &rootcoordpb.ExportTaskInfo{CollectionId: 10})
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+	mgr = newTestExportManager(ctx, func(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+		return nil, errors.New("mock error")
+	})
+	mgr.idAllocator = func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+		return 0, 0, errors.New("mock error")
+	}
+	resp = mgr.exportJob(ctx, &rootcoordpb.ExportTaskInfo{CollectionId: 10})
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+	// the pending queue is full
+	mgr = newTestExportManager(ctx, func(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+		return nil, errors.New("mock error")
+	})
+	for i := 0; i < MaxPendingCount; i++ {
+		resp = mgr.exportJob(ctx, &rootcoordpb.ExportTaskInfo{CollectionId: 10})
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	}
+	resp = mgr.exportJob(ctx, &rootcoordpb.ExportTaskInfo{CollectionId: 10})
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+}
+
+func TestExportManager_LoadAndCleanUp(t *testing.T) {
+	ctx := context.Background()
+	mgr := newTestExportManager(ctx, func(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+		return nil, errors.New("mock error")
+	})
+
+	tasks := []*rootcoordpb.ExportTaskInfo{
+		{Id: 1, CreateTs: time.Now().Unix(), State: &rootcoordpb.ExportTaskState{StateCode: rootcoordpb.ExportState_ExportPending}},
+		{Id: 2, CreateTs: time.Now().Unix(), State: &rootcoordpb.ExportTaskState{StateCode: rootcoordpb.ExportState_ExportStarted}},
+		{Id: 3, CreateTs: time.Now().Unix() - 100, State: &rootcoordpb.ExportTaskState{StateCode: rootcoordpb.ExportState_ExportCompleted}},
+	}
+	for _, task := range tasks {
+		assert.NoError(t, mgr.persistTaskInfo(task))
+	}
+	mgr.taskStore.Save(BuildExportTaskKey(4), "bad value")
+
+	// the pending task is reloaded, the started task is marked failed
+	mgr.init(ctx)
+	assert.Equal(t, 1, len(mgr.pendingTasks))
+	assert.Equal(t, int64(1), mgr.pendingTasks[0].GetId())
+	state := mgr.getTaskState(2)
+	assert.Equal(t, rootcoordpb.ExportState_ExportFailed, state.GetState())
+	assert.Equal(t, "task marked failed as service restarted", state.GetErrorMessage())
+
+	// the working task is expired
+	Params.RootCoordCfg.ExportTaskExpiration = 10
+	mgr.workingTasks[5] = &rootcoordpb.ExportTaskInfo{
+		Id:         5,
+		DatanodeId: 1,
+		CreateTs:   time.Now().Unix() - 20,
+		StartTs:    time.Now().Unix() - 20,
+		State:      &rootcoordpb.ExportTaskState{StateCode: rootcoordpb.ExportState_ExportStarted},
+	}
+	mgr.busyNodes[1] = time.Now().Unix() - 20
+	mgr.expireOldTasksFromMem()
+	assert.Equal(t, 0, len(mgr.workingTasks))
+	assert.Equal(t, 0, len(mgr.busyNodes))
+	state = mgr.getTaskState(5)
+	assert.Equal(t, rootcoordpb.ExportState_ExportFailed, state.GetState())
+	assert.Equal(t, "the export task has timed out", state.GetErrorMessage())
+
+	// the finished task past retention is removed
+	Params.RootCoordCfg.ExportTaskRetention = 50
+	mgr.expireOldTasksFromEtcd()
+	loaded, err := mgr.loadFromTaskStore(false)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(loaded))
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, mgr.getTaskState(3).GetStatus().GetErrorCode())
+
+	ti := &rootcoordpb.ExportTaskInfo{}
+	v, err := mgr.taskStore.Load(BuildExportTaskKey(1))
+	assert.NoError(t, err)
+	assert.NoError(t, proto.Unmarshal([]byte(v), ti))
+	assert.Equal(t, rootcoordpb.ExportState_ExportPending, ti.GetState().GetStateCode())
+}
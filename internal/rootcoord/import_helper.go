@@ -7,6 +7,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 )
@@ -14,10 +15,13 @@ import (
 type GetCollectionNameFunc func(collID, partitionID UniqueID) (string, string, error)
 type IDAllocator func(count uint32) (UniqueID, UniqueID, error)
 type ImportFunc func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error)
+type ExportFunc func(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error)
 type MarkSegmentsDroppedFunc func(ctx context.Context, segIDs []int64) (*commonpb.Status, error)
 type DescribeIndexFunc func(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error)
 type GetSegmentIndexStateFunc func(ctx context.Context, collID UniqueID, indexName string, segIDs []UniqueID) ([]*indexpb.SegmentIndexState, error)
 type UnsetIsImportingStateFunc func(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error)
+type ListFilesFunc = importutil.ListFilesFunc
+type CancelImportFunc func(ctx context.Context, req *datapb.CancelImportTaskRequest) (*commonpb.Status, error)
 
 type ImportFactory interface {
 	NewGetCollectionNameFunc() GetCollectionNameFunc
@@ -27,6 +31,8 @@ type ImportFactory interface {
 	NewDescribeIndexFunc() DescribeIndexFunc
 	NewGetSegmentIndexStateFunc() GetSegmentIndexStateFunc
 	NewUnsetIsImportingStateFunc() UnsetIsImportingStateFunc
+	NewListFilesFunc() ListFilesFunc
+	NewCancelImportFunc() CancelImportFunc
 }
 
 type ImportFactoryImpl struct {
@@ -61,6 +67,14 @@ func (f ImportFactoryImpl) NewUnsetIsImportingStateFunc() UnsetIsImportingStateF
 	return UnsetIsImportingStateWithCore(f.c)
 }
 
+func (f ImportFactoryImpl) NewListFilesFunc() ListFilesFunc {
+	return ListFilesWithCore(f.c)
+}
+
+func (f ImportFactoryImpl) NewCancelImportFunc() CancelImportFunc {
+	return CancelImportWithCore(f.c)
+}
+
 func NewImportFactory(c *Core) ImportFactory {
 	return &ImportFactoryImpl{c: c}
 }
@@ -94,6 +108,18 @@ func ImportFuncWithCore(c *Core) ImportFunc {
 	}
 }
 
+func CancelImportWithCore(c *Core) CancelImportFunc {
+	return func(ctx context.Context, req *datapb.CancelImportTaskRequest) (*commonpb.Status, error) {
+		return c.broker.CancelImport(ctx, req)
+	}
+}
+
+func ExportFuncWithCore(c *Core) ExportFunc {
+	return func(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+		return c.broker.Export(ctx, req)
+	}
+}
+
 func MarkSegmentsDroppedWithCore(c *Core) MarkSegmentsDroppedFunc {
 	return func(ctx context.Context, segIDs []int64) (*commonpb.Status, error) {
 		return c.broker.MarkSegmentsDropped(ctx, &datapb.MarkSegmentsDroppedRequest{
@@ -119,3 +145,14 @@ func UnsetIsImportingStateWithCore(c *Core) UnsetIsImportingStateFunc {
 		return c.broker.UnsetIsImportingState(ctx, req)
 	}
 }
+
+func ListFilesWithCore(c *Core) ListFilesFunc {
+	return func(ctx context.Context, prefix string) ([]string, error) {
+		cm, err := c.factory.NewPersistentStorageChunkManager(ctx)
+		if err != nil {
+			return nil, err
+		}
+		files, _, err := cm.ListWithPrefix(ctx, prefix, true)
+		return files, err
+	}
+}
@@ -20,19 +20,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
-	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -47,6 +48,8 @@ const (
 	PartitionName   = "partition"
 	MaxPendingCount = 32
 	delimiter       = "/"
+
+	taskCancelledMsg = "the import task has been cancelled"
 )
 
 // checkPendingTasksInterval is the default interval to check and send out pending tasks,
@@ -67,7 +70,7 @@ var flipTaskStateInterval = 15 * 1000
 type importManager struct {
 	ctx       context.Context // reserved
 	taskStore kv.TxnKV        // Persistent task info storage.
-	busyNodes map[int64]int64 // Set of all current working DataNode IDs and related task create timestamp.
+	busyNodes map[int64]int64 // Set of all current working DataNode IDs and related task create or latest report timestamp.
 
 	// TODO: Make pendingTask a map to improve look up performance.
 	pendingTasks  []*datapb.ImportTaskInfo         // pending tasks
@@ -86,6 +89,8 @@ type importManager struct {
 	callDescribeIndex         func(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error)
 	callGetSegmentIndexState  func(ctx context.Context, collID UniqueID, indexName string, segIDs []UniqueID) ([]*indexpb.SegmentIndexState, error)
 	callUnsetIsImportingState func(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error)
+	callListFiles             func(ctx context.Context, prefix string) ([]string, error)
+	callCancelImport          func(ctx context.Context, req *datapb.CancelImportTaskRequest) (*commonpb.Status, error)
 }
 
 // newImportManager helper function to create a importManager
@@ -96,7 +101,9 @@ func newImportManager(ctx context.Context, client kv.TxnKV,
 	getCollectionName func(collID, partitionID typeutil.UniqueID) (string, string, error),
 	describeIndex func(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error),
 	getSegmentIndexState func(ctx context.Context, collID UniqueID, indexName string, segIDs []UniqueID) ([]*indexpb.SegmentIndexState, error),
-	unsetIsImportingState func(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error)) *importManager {
+	unsetIsImportingState func(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error),
+	listFiles func(ctx context.Context, prefix string) ([]string, error),
+	cancelImport func(ctx context.Context, req *datapb.CancelImportTaskRequest) (*commonpb.Status, error)) *importManager {
 	mgr := &importManager{
 		ctx:                       ctx,
 		taskStore:                 client,
@@ -114,6 +121,8 @@ func newImportManager(ctx context.Context, client kv.TxnKV,
 		callDescribeIndex:         describeIndex,
 		callGetSegmentIndexState:  getSegmentIndexState,
 		callUnsetIsImportingState: unsetIsImportingState,
+		callListFiles:             listFiles,
+		callCancelImport:          cancelImport,
 	}
 	return mgr
 }
@@ -134,39 +143,21 @@ func (m *importManager) init(ctx context.Context) {
 
 // sendOutTasksLoop periodically calls `sendOutTasks` to process left over pending tasks.
 func (m *importManager) sendOutTasksLoop(wg *sync.WaitGroup) {
-	defer wg.Done()
-	ticker := time.NewTicker(time.Duration(checkPendingTasksInterval) * time.Millisecond)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-m.ctx.Done():
-			log.Debug("import manager context done, exit check sendOutTasksLoop")
-			return
-		case <-ticker.C:
-			if err := m.sendOutTasks(m.ctx); err != nil {
-				log.Error("importManager sendOutTasksLoop fail to send out tasks")
-			}
+	runTaskLoop(m.ctx, wg, time.Duration(checkPendingTasksInterval)*time.Millisecond, "import sendOutTasksLoop", func() {
+		if err := m.sendOutTasks(m.ctx); err != nil {
+			log.Error("importManager sendOutTasksLoop fail to send out tasks")
 		}
-	}
+	})
 }
 
 // flipTaskStateLoop periodically calls `flipTaskState` to check if states of the tasks need to be updated.
 func (m *importManager) flipTaskStateLoop(wg *sync.WaitGroup) {
-	defer wg.Done()
-	ticker := time.NewTicker(time.Duration(flipTaskStateInterval) * time.Millisecond)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-m.ctx.Done():
-			log.Debug("import manager context done, exit check flipTaskStateLoop")
-			return
-		case <-ticker.C:
-			log.Debug("start trying to flip task state")
-			if err := m.flipTaskState(m.ctx); err != nil {
-				log.Error("failed to flip task state", zap.Error(err))
-			}
+	runTaskLoop(m.ctx, wg, time.Duration(flipTaskStateInterval)*time.Millisecond, "import flipTaskStateLoop", func() {
+		log.Debug("start trying to flip task state")
+		if err := m.flipTaskState(m.ctx); err != nil {
+			log.Error("failed to flip task state", zap.Error(err))
 		}
-	}
+	})
 }
 
 // cleanupLoop starts a loop that checks and expires old tasks every `cleanUpLoopInterval` seconds.
@@ -176,37 +167,38 @@ func (m *importManager) flipTaskStateLoop(wg *sync.WaitGroup) {
 // (2) any import tasks that has been created over `ImportTaskRetention` seconds ago, these tasks will be removed from Etcd.
 // cleanupLoop also periodically calls removeBadImportSegments to remove bad import segments.
 func (m *importManager) cleanupLoop(wg *sync.WaitGroup) {
-	defer wg.Done()
-	ticker := time.NewTicker(time.Duration(cleanUpLoopInterval) * time.Millisecond)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-m.ctx.Done():
-			log.Debug("(in cleanupLoop) import manager context done, exit cleanupLoop")
-			return
-		case <-ticker.C:
-			log.Debug("(in cleanupLoop) trying to expire old tasks from memory and Etcd")
-			m.expireOldTasksFromMem()
-			m.expireOldTasksFromEtcd()
-			log.Debug("(in cleanupLoop) start removing bad import segments")
-			m.removeBadImportSegments(m.ctx)
-			log.Debug("(in cleanupLoop) start cleaning hanging busy DataNode")
-			m.releaseHangingBusyDataNode()
-		}
-	}
+	runTaskLoop(m.ctx, wg, time.Duration(cleanUpLoopInterval)*time.Millisecond, "import cleanupLoop", func() {
+		log.Debug("(in cleanupLoop) trying to expire old tasks from memory and Etcd")
+		m.expireOldTasksFromMem()
+		m.expireOldTasksFromEtcd()
+		log.Debug("(in cleanupLoop) start removing bad import segments")
+		m.removeBadImportSegments(m.ctx)
+		log.Debug("(in cleanupLoop) start cleaning hanging busy DataNode")
+		m.releaseHangingBusyDataNode()
+	})
 }
 
 // sendOutTasks pushes all pending tasks to DataCoord, gets DataCoord response and re-add these tasks as working tasks.
+// The pending task with the largest priority is sent first, tasks with the same priority are sent in FIFO order.
+// A task stays in the pending list while its collection has reached the max number of working tasks.
 func (m *importManager) sendOutTasks(ctx context.Context) error {
 	m.pendingLock.Lock()
 	m.busyNodesLock.Lock()
 	defer m.pendingLock.Unlock()
 	defer m.busyNodesLock.Unlock()
 
+	collectionTasks := m.countStartedTasks()
+
 	// Trigger Import() action to DataCoord.
 	for len(m.pendingTasks) > 0 {
 		log.Debug("try to send out pending tasks", zap.Int("task_number", len(m.pendingTasks)))
-		task := m.pendingTasks[0]
+		taskIndex := m.pickPendingTask(collectionTasks)
+		if taskIndex < 0 {
+			log.Debug("collections of the pending tasks have reached the max number of working tasks",
+				zap.Int("max working tasks", Params.RootCoordCfg.ImportMaxTasksPerCollection))
+			break
+		}
+		task := m.pendingTasks[taskIndex]
 		// TODO: Use ImportTaskInfo directly.
 		it := &datapb.ImportTask{
 			CollectionId: task.GetCollectionId(),
@@ -215,18 +207,13 @@ func (m *importManager) sendOutTasks(ctx context.Context) error {
 			TaskId:       task.GetId(),
 			Files:        task.GetFiles(),
 			Infos:        task.GetInfos(),
-		}
-
-		// Get all busy dataNodes for reference.
-		var busyNodeList []int64
-		for k := range m.busyNodes {
-			busyNodeList = append(busyNodeList, k)
+			Checkpoints:  task.GetState().GetCheckpoints(),
 		}
 
 		// Send import task to dataCoord, which will then distribute the import task to dataNode.
 		resp, err := m.callImportService(ctx, &datapb.ImportTaskRequest{
 			ImportTask:   it,
-			WorkingNodes: busyNodeList,
+			WorkingNodes: busyNodeList(m.busyNodes),
 		})
 		if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
 			log.Warn("import task is rejected",
@@ -266,13 +253,44 @@ func (m *importManager) sendOutTasks(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
-		// Remove this task from head of pending list.
-		m.pendingTasks = append(m.pendingTasks[:0], m.pendingTasks[1:]...)
+		collectionTasks[task.GetCollectionId()]++
+		// Remove this task from pending list.
+		m.pendingTasks = append(m.pendingTasks[:taskIndex], m.pendingTasks[taskIndex+1:]...)
 	}
 
 	return nil
 }
 
+// countStartedTasks returns the number of working tasks of each collection that are being processed by DataNodes.
+func (m *importManager) countStartedTasks() map[int64]int {
+	m.workingLock.RLock()
+	defer m.workingLock.RUnlock()
+	counts := make(map[int64]int)
+	for _, task := range m.workingTasks {
+		if task.GetState().GetStateCode() == commonpb.ImportState_ImportStarted {
+			counts[task.GetCollectionId()]++
+		}
+	}
+	return counts
+}
+
+// pickPendingTask returns the index of the next pending task to be sent out, the earliest one of the tasks with the
+// largest priority is picked. Tasks whose collection has reached the max number of working tasks are skipped.
+// It returns -1 if no task can be sent out.
+func (m *importManager) pickPendingTask(collectionTasks map[int64]int) int {
+	maxTasks := Params.RootCoordCfg.ImportMaxTasksPerCollection
+	picked := -1
+	for i, task := range m.pendingTasks {
+		if maxTasks > 0 && collectionTasks[task.GetCollectionId()] >= maxTasks {
+			continue
+		}
+		if picked < 0 || task.GetPriority() > m.pendingTasks[picked].GetPriority() {
+			picked = i
+		}
+	}
+	return picked
+}
+
 // flipTaskState checks every import task and flips their import state if eligible.
 func (m *importManager) flipTaskState(ctx context.Context) error {
 	var importTasks []*datapb.ImportTaskInfo
@@ -409,6 +427,74 @@ func (m *importManager) isRowbased(files []string) (bool, error) {
 	return isRowBased, nil
 }
 
+// splitImportFiles groups the import files into tasks.
+// For the files listed explicitly, each row-based JSON file makes a task, other files make a single task.
+// For the files expanded from prefixes or glob patterns, the numpy files under the same directory make a task,
+// each npz file holds arrays of all the fields and makes a task, other files are split into tasks with at most
+// ImportMaxFilesPerTask files.
+func (m *importManager) splitImportFiles(files []string, expanded bool) ([][]string, error) {
+	if !expanded {
+		isRowBased, err := m.isRowbased(files)
+		if err != nil {
+			return nil, err
+		}
+		if !isRowBased {
+			return [][]string{files}, nil
+		}
+		taskFiles := make([][]string, 0, len(files))
+		for _, file := range files {
+			taskFiles = append(taskFiles, []string{file})
+		}
+		return taskFiles, nil
+	}
+
+	// each directory is supposed to contain numpy files of all the fields
+	numpyDirs := make([]string, 0)
+	numpyFiles := make(map[string][]string)
+	npzFiles := make([]string, 0)
+	rowBasedFiles := make([]string, 0)
+	for _, file := range files {
+		_, fileType := importutil.GetFileNameAndExt(file)
+		if fileType == importutil.NpzFileExt {
+			npzFiles = append(npzFiles, file)
+			continue
+		}
+		if fileType != importutil.NumpyFileExt {
+			rowBasedFiles = append(rowBasedFiles, file)
+			continue
+		}
+		dir := path.Dir(file)
+		if _, ok := numpyFiles[dir]; !ok {
+			numpyDirs = append(numpyDirs, dir)
+		}
+		numpyFiles[dir] = append(numpyFiles[dir], file)
+	}
+	if (len(numpyDirs) > 0 || len(npzFiles) > 0) && len(rowBasedFiles) > 0 {
+		log.Error("numpy files and row-based files are mixed in an import request", zap.Strings("files", files))
+		return nil, fmt.Errorf("numpy files and row-based files cannot be imported by the same request")
+	}
+
+	taskFiles := make([][]string, 0)
+	for _, dir := range numpyDirs {
+		taskFiles = append(taskFiles, numpyFiles[dir])
+	}
+	for _, file := range npzFiles {
+		taskFiles = append(taskFiles, []string{file})
+	}
+	maxFiles := Params.RootCoordCfg.ImportMaxFilesPerTask
+	if maxFiles <= 0 {
+		maxFiles = 1
+	}
+	for i := 0; i < len(rowBasedFiles); i += maxFiles {
+		end := i + maxFiles
+		if end > len(rowBasedFiles) {
+			end = len(rowBasedFiles)
+		}
+		taskFiles = append(taskFiles, rowBasedFiles[i:end])
+	}
+	return taskFiles, nil
+}
+
 // importJob processes the import request, generates import tasks, sends these tasks to DataCoord, and returns
 // immediately.
 func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportRequest, cID int64, pID int64) *milvuspb.ImportResponse {
@@ -429,6 +515,11 @@ func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportReque
 		return returnErrorFunc("import service is not available")
 	}
 
+	priority, err := importutil.ParsePriority(req.GetOptions())
+	if err != nil {
+		return returnErrorFunc(err.Error())
+	}
+
 	resp := &milvuspb.ImportResponse{
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_Success,
@@ -440,22 +531,30 @@ func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportReque
 		zap.String("collection name", req.GetCollectionName()),
 		zap.Int64("collection ID", cID),
 		zap.Int64("partition ID", pID))
-	err := func() error {
+
+	// expand the prefixes and glob patterns to data files before the tasks are created,
+	// the paths provided by the backup tool are binlog paths, they are not expanded
+	files, expanded := req.GetFiles(), false
+	if !importutil.IsBackup(req.GetOptions()) {
+		files, expanded, err = importutil.ExpandFilePaths(ctx, req.GetFiles(), m.callListFiles)
+		if err != nil {
+			log.Error("failed to expand import files", zap.Strings("files", req.GetFiles()), zap.Error(err))
+			return returnErrorFunc(err.Error())
+		}
+	}
+
+	err = func() error {
 		m.pendingLock.Lock()
 		defer m.pendingLock.Unlock()
 
 		capacity := cap(m.pendingTasks)
 		length := len(m.pendingTasks)
 
-		isRowBased, err := m.isRowbased(req.GetFiles())
+		taskFiles, err := m.splitImportFiles(files, expanded)
 		if err != nil {
 			return err
 		}
-
-		taskCount := 1
-		if isRowBased {
-			taskCount = len(req.Files)
-		}
+		taskCount := len(taskFiles)
 
 		// task queue size has a limit, return error if import request contains too many data files, and skip entire job
 		if capacity-length < taskCount {
@@ -464,52 +563,12 @@ func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportReque
 			return err
 		}
 
-		// convert import request to import tasks
-		if isRowBased {
-			// For row-based importing, each file makes a task.
-			taskList := make([]int64, len(req.Files))
-			for i := 0; i < len(req.Files); i++ {
-				tID, _, err := m.idAllocator(1)
-				if err != nil {
-					log.Error("failed to allocate ID for import task", zap.Error(err))
-					return err
-				}
-				newTask := &datapb.ImportTaskInfo{
-					Id:           tID,
-					CollectionId: cID,
-					PartitionId:  pID,
-					ChannelNames: req.ChannelNames,
-					Files:        []string{req.GetFiles()[i]},
-					CreateTs:     time.Now().Unix(),
-					State: &datapb.ImportTaskState{
-						StateCode: commonpb.ImportState_ImportPending,
-					},
-					Infos: req.Options,
-				}
-
-				// Here no need to check error returned by setCollectionPartitionName(),
-				// since here we always return task list to client no matter something missed.
-				// We make the method setCollectionPartitionName() returns error
-				// because we need to make sure coverage all the code branch in unittest case.
-				_ = m.setCollectionPartitionName(cID, pID, newTask)
-				resp.Tasks = append(resp.Tasks, newTask.GetId())
-				taskList[i] = newTask.GetId()
-				log.Info("new task created as pending task",
-					zap.Int64("task ID", newTask.GetId()))
-				if err := m.persistTaskInfo(newTask); err != nil {
-					log.Error("failed to update import task",
-						zap.Int64("task ID", newTask.GetId()),
-						zap.Error(err))
-					return err
-				}
-				m.pendingTasks = append(m.pendingTasks, newTask)
-			}
-			log.Info("row-based import request processed", zap.Any("task IDs", taskList))
-		} else {
-			// TODO: Merge duplicated code :(
-			// for column-based, all files is a task
+		// convert import request to import tasks, each group of files makes a task
+		taskList := make([]int64, 0, taskCount)
+		for _, taskFile := range taskFiles {
 			tID, _, err := m.idAllocator(1)
 			if err != nil {
+				log.Error("failed to allocate ID for import task", zap.Error(err))
 				return err
 			}
 			newTask := &datapb.ImportTaskInfo{
@@ -517,21 +576,25 @@ func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportReque
 				CollectionId: cID,
 				PartitionId:  pID,
 				ChannelNames: req.ChannelNames,
-				Files:        req.GetFiles(),
+				Files:        taskFile,
 				CreateTs:     time.Now().Unix(),
 				State: &datapb.ImportTaskState{
 					StateCode: commonpb.ImportState_ImportPending,
 				},
-				Infos: req.Options,
+				Infos:    req.Options,
+				Priority: priority,
 			}
+
 			// Here no need to check error returned by setCollectionPartitionName(),
 			// since here we always return task list to client no matter something missed.
 			// We make the method setCollectionPartitionName() returns error
 			// because we need to make sure coverage all the code branch in unittest case.
 			_ = m.setCollectionPartitionName(cID, pID, newTask)
 			resp.Tasks = append(resp.Tasks, newTask.GetId())
+			taskList = append(taskList, newTask.GetId())
 			log.Info("new task created as pending task",
-				zap.Int64("task ID", newTask.GetId()))
+				zap.Int64("task ID", newTask.GetId()),
+				zap.Int("file count", len(taskFile)))
 			if err := m.persistTaskInfo(newTask); err != nil {
 				log.Error("failed to update import task",
 					zap.Int64("task ID", newTask.GetId()),
@@ -539,9 +602,8 @@ func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportReque
 				return err
 			}
 			m.pendingTasks = append(m.pendingTasks, newTask)
-			log.Info("column-based import request processed",
-				zap.Int64("task ID", newTask.GetId()))
 		}
+		log.Info("import request processed", zap.Int64s("task IDs", taskList), zap.Bool("expanded", expanded))
 		return nil
 	}()
 	if err != nil {
@@ -568,23 +630,49 @@ func (m *importManager) updateTaskInfo(ir *rootcoordpb.ImportResult) (*datapb.Im
 	ok := false
 	var toPersistImportTaskInfo *datapb.ImportTaskInfo
 	if v, ok = m.workingTasks[ir.GetTaskId()]; ok {
-		// If the task has already been marked failed. Prevent further state updating and return an error.
+		// If the task has already been marked failed or cancelled. Prevent further state updating and return an error.
 		if v.GetState().GetStateCode() == commonpb.ImportState_ImportFailed ||
-			v.GetState().GetStateCode() == commonpb.ImportState_ImportFailedAndCleaned {
+			v.GetState().GetStateCode() == commonpb.ImportState_ImportFailedAndCleaned ||
+			typeutil.IsImportCancelledState(v.GetState().GetStateCode()) {
 			log.Warn("trying to update an already failed task which will end up being a no-op")
 			return nil, errors.New("trying to update an already failed task " + strconv.FormatInt(ir.GetTaskId(), 10))
 		}
+		// The task has been resumed and sent to another DataNode, ignore the report from the previous one.
+		if v.GetDatanodeId() != 0 && ir.GetDatanodeId() != 0 && v.GetDatanodeId() != ir.GetDatanodeId() {
+			log.Warn("trying to update a task from a DataNode which no longer owns the task",
+				zap.Int64("task ID", v.GetId()),
+				zap.Int64("task DataNode ID", v.GetDatanodeId()),
+				zap.Int64("reported DataNode ID", ir.GetDatanodeId()))
+			return nil, fmt.Errorf("import task %d is not owned by DataNode %d", ir.GetTaskId(), ir.GetDatanodeId())
+		}
 		found = true
 		// Meta persist should be done before memory objs change.
 		toPersistImportTaskInfo = cloneImportTaskInfo(v)
+		toPersistImportTaskInfo.ActiveTs = time.Now().Unix()
 		toPersistImportTaskInfo.State.StateCode = ir.GetState()
+		// A cancelling task is cancelled once its DataNode stops working on it, the task must not be flushed even if
+		// the DataNode has persisted all the segments.
+		if v.GetState().GetStateCode() == typeutil.ImportCancelling {
+			toPersistImportTaskInfo.State.StateCode = typeutil.ImportCancelled
+			if ir.GetState() == commonpb.ImportState_ImportStarted {
+				toPersistImportTaskInfo.State.StateCode = typeutil.ImportCancelling
+			}
+		}
 		toPersistImportTaskInfo.State.Segments = ir.GetSegments()
 		toPersistImportTaskInfo.State.RowCount = ir.GetRowCount()
 		toPersistImportTaskInfo.State.RowIds = ir.GetAutoIds()
+		if len(ir.GetCheckpoints()) > 0 {
+			toPersistImportTaskInfo.State.Checkpoints = ir.GetCheckpoints()
+		} else if len(toPersistImportTaskInfo.GetState().GetCheckpoints()) > 0 {
+			// DataNode failed before restoring the checkpoints, the committed segments still belong to the task.
+			committed, _, _ := checkpointsProgress(toPersistImportTaskInfo.GetState().GetCheckpoints())
+			toPersistImportTaskInfo.State.Segments = append(committed, ir.GetSegments()...)
+		}
 		for _, kv := range ir.GetInfos() {
-			if kv.GetKey() == FailedReason {
+			if kv.GetKey() == FailedReason && v.GetState().GetStateCode() != typeutil.ImportCancelling {
 				toPersistImportTaskInfo.State.ErrorMessage = kv.GetValue()
-				break
+			} else if kv.GetKey() == importutil.ValidationReport {
+				toPersistImportTaskInfo.State.ValidationReport = kv.GetValue()
 			}
 		}
 		// Update task in task store.
@@ -604,6 +692,14 @@ func (m *importManager) updateTaskInfo(ir *rootcoordpb.ImportResult) (*datapb.Im
 	return toPersistImportTaskInfo, nil
 }
 
+// isDryRunTask returns true if the working task only validates the files without importing any data.
+func (m *importManager) isDryRunTask(taskID int64) bool {
+	m.workingLock.Lock()
+	defer m.workingLock.Unlock()
+	v, ok := m.workingTasks[taskID]
+	return ok && importutil.IsDryRun(v.GetInfos())
+}
+
 // setImportTaskState sets the task state of an import task. Changes to the import task state will be persisted.
 func (m *importManager) setImportTaskState(taskID int64, targetState commonpb.ImportState) error {
 	return m.setImportTaskStateAndReason(taskID, targetState, "")
@@ -650,27 +746,17 @@ func (m *importManager) setImportTaskStateAndReason(taskID int64, targetState co
 	m.workingLock.Unlock()
 
 	// If task is not found in memory, try updating in Etcd.
-	var v string
-	var err error
 	if !found {
-		if v, err = m.taskStore.Load(BuildImportTaskKey(taskID)); err == nil && v != "" {
-			ti := &datapb.ImportTaskInfo{}
-			if err := proto.Unmarshal([]byte(v), ti); err != nil {
-				log.Error("failed to unmarshal proto", zap.String("taskInfo", v), zap.Error(err))
-			} else {
-				toPersistImportTaskInfo := cloneImportTaskInfo(ti)
-				toPersistImportTaskInfo.State.StateCode = targetState
-				tryUpdateErrMsg(errReason, toPersistImportTaskInfo)
-				// Update task in task store.
-				if err := m.persistTaskInfo(toPersistImportTaskInfo); err != nil {
-					return err
-				}
-				found = true
+		ti := &datapb.ImportTaskInfo{}
+		if loadTaskInfo(m.taskStore, BuildImportTaskKey(taskID), ti) {
+			toPersistImportTaskInfo := cloneImportTaskInfo(ti)
+			toPersistImportTaskInfo.State.StateCode = targetState
+			tryUpdateErrMsg(errReason, toPersistImportTaskInfo)
+			// Update task in task store.
+			if err := m.persistTaskInfo(toPersistImportTaskInfo); err != nil {
+				return err
 			}
-		} else {
-			log.Warn("failed to load task info from Etcd",
-				zap.String("value", v),
-				zap.Error(err))
+			found = true
 		}
 	}
 
@@ -715,6 +801,12 @@ func (m *importManager) copyTaskInfo(input *datapb.ImportTaskInfo, output *milvu
 		Key:   FailedReason,
 		Value: input.GetState().GetErrorMessage(),
 	})
+	if input.GetState().GetValidationReport() != "" {
+		output.Infos = append(output.Infos, &commonpb.KeyValuePair{
+			Key:   importutil.ValidationReport,
+			Value: input.GetState().GetValidationReport(),
+		})
+	}
 }
 
 // getTaskState looks for task with the given ID and returns its import state.
@@ -752,47 +844,114 @@ func (m *importManager) getTaskState(tID int64) *milvuspb.GetImportStateResponse
 		return resp
 	}
 	// (3) Search in Etcd.
-	if v, err := m.taskStore.Load(BuildImportTaskKey(tID)); err == nil && v != "" {
-		ti := &datapb.ImportTaskInfo{}
-		if err := proto.Unmarshal([]byte(v), ti); err != nil {
-			log.Error("failed to unmarshal proto", zap.String("taskInfo", v), zap.Error(err))
-		} else {
-			m.copyTaskInfo(ti, resp)
-			found = true
-		}
-	} else {
-		log.Warn("failed to load task info from Etcd",
-			zap.String("value", v),
-			zap.Error(err))
-	}
-	if found {
+	ti := &datapb.ImportTaskInfo{}
+	if loadTaskInfo(m.taskStore, BuildImportTaskKey(tID), ti) {
+		m.copyTaskInfo(ti, resp)
 		return resp
 	}
 	log.Debug("get import task state failed", zap.Int64("taskID", tID))
 	return resp
 }
 
+// cancelImportTask cancels an import task. A pending task is removed from the pending list and marked as cancelled.
+// A working task is marked as cancelling and stopped by its DataNode, the task is cancelled once the DataNode reports
+// the worker has stopped, and the segments of the task are dropped by the cleanup loop. The DataNode stays busy until
+// then, if it's not able to stop the task, the task is cancelled when it expires.
+// Tasks that are not pending or started can't be cancelled.
+func (m *importManager) cancelImportTask(ctx context.Context, taskID int64) error {
+	log.Info("trying to cancel an import task", zap.Int64("task ID", taskID))
+	// (1) Search in pending tasks list.
+	found, err := func() (bool, error) {
+		m.pendingLock.Lock()
+		defer m.pendingLock.Unlock()
+		for taskIndex, t := range m.pendingTasks {
+			if taskID != t.GetId() {
+				continue
+			}
+			// Meta persist should be done before memory objs change.
+			toPersistImportTaskInfo := cloneImportTaskInfo(t)
+			toPersistImportTaskInfo.State.StateCode = typeutil.ImportCancelled
+			toPersistImportTaskInfo.State.ErrorMessage = taskCancelledMsg
+			if err := m.persistTaskInfo(toPersistImportTaskInfo); err != nil {
+				return true, err
+			}
+			m.pendingTasks = append(m.pendingTasks[:taskIndex], m.pendingTasks[taskIndex+1:]...)
+			log.Info("a pending import task has been cancelled", zap.Int64("task ID", taskID))
+			return true, nil
+		}
+		return false, nil
+	}()
+	if found {
+		return err
+	}
+
+	// (2) Search in working tasks map.
+	nodeID, err := func() (int64, error) {
+		m.workingLock.Lock()
+		defer m.workingLock.Unlock()
+		v, ok := m.workingTasks[taskID]
+		if !ok {
+			return 0, fmt.Errorf("import task %d is not found in pending or working tasks", taskID)
+		}
+		if v.GetState().GetStateCode() != commonpb.ImportState_ImportStarted {
+			return 0, fmt.Errorf("import task %d can't be cancelled in state %s", taskID, v.GetState().GetStateCode())
+		}
+		// Meta persist should be done before memory objs change.
+		toPersistImportTaskInfo := cloneImportTaskInfo(v)
+		toPersistImportTaskInfo.State.StateCode = typeutil.ImportCancelling
+		toPersistImportTaskInfo.State.ErrorMessage = taskCancelledMsg
+		if err := m.persistTaskInfo(toPersistImportTaskInfo); err != nil {
+			return 0, err
+		}
+		m.workingTasks[taskID] = toPersistImportTaskInfo
+		return v.GetDatanodeId(), nil
+	}()
+	if err != nil {
+		log.Warn("failed to cancel import task", zap.Int64("task ID", taskID), zap.Error(err))
+		return err
+	}
+
+	// (3) Ask the DataNode to stop the task.
+	if m.callCancelImport == nil {
+		err = errors.New("cancel import service is not available")
+	} else {
+		var status *commonpb.Status
+		status, err = m.callCancelImport(ctx, &datapb.CancelImportTaskRequest{
+			TaskId:     taskID,
+			DatanodeId: nodeID,
+		})
+		if err == nil && status.GetErrorCode() != commonpb.ErrorCode_Success {
+			err = errors.New(status.GetReason())
+		}
+	}
+	if err != nil {
+		log.Warn("DataNode failed to stop the import task, the task will be cancelled when it expires",
+			zap.Int64("task ID", taskID),
+			zap.Int64("dataNode ID", nodeID),
+			zap.Error(err))
+		return nil
+	}
+	log.Info("a working import task is being cancelled",
+		zap.Int64("task ID", taskID),
+		zap.Int64("dataNode ID", nodeID))
+	return nil
+}
+
 // loadFromTaskStore loads task info from task store (Etcd).
 // loadFromTaskStore also adds these tasks as pending import tasks, and mark
 // other in-progress tasks as failed, when `load2Mem` is set to `true`.
 // loadFromTaskStore instead returns a list of all import tasks if `load2Mem` is set to `false`.
 func (m *importManager) loadFromTaskStore(load2Mem bool) ([]*datapb.ImportTaskInfo, error) {
 	log.Info("import manager starts loading from Etcd")
-	_, v, err := m.taskStore.LoadWithPrefix(Params.RootCoordCfg.ImportTaskSubPath)
+	tis, err := loadTaskInfos(m.taskStore, Params.RootCoordCfg.ImportTaskSubPath,
+		func() *datapb.ImportTaskInfo { return &datapb.ImportTaskInfo{} })
 	if err != nil {
 		log.Error("import manager failed to load from Etcd", zap.Error(err))
 		return nil, err
 	}
 	var taskList []*datapb.ImportTaskInfo
 
-	for i := range v {
-		ti := &datapb.ImportTaskInfo{}
-		if err := proto.Unmarshal([]byte(v[i]), ti); err != nil {
-			log.Error("failed to unmarshal proto", zap.String("taskInfo", v[i]), zap.Error(err))
-			// Ignore bad protos.
-			continue
-		}
-
+	for _, ti := range tis {
 		if load2Mem {
 			// Put pending tasks back to pending task list.
 			if ti.GetState().GetStateCode() == commonpb.ImportState_ImportPending {
@@ -803,9 +962,20 @@ func (m *importManager) loadFromTaskStore(load2Mem bool) ([]*datapb.ImportTaskIn
 			} else {
 				// other non-failed and non-completed tasks should be marked failed, so the bad s egments
 				// can be cleaned up in `removeBadImportSegmentsLoop`.
-				if ti.GetState().GetStateCode() != commonpb.ImportState_ImportFailed &&
+				// A cancelling task is cancelled, as its DataNode no longer works on it.
+				if ti.GetState().GetStateCode() == typeutil.ImportCancelling {
+					ti.State.StateCode = typeutil.ImportCancelled
+					if err := m.persistTaskInfo(ti); err != nil {
+						log.Error("failed to mark an old task as cancelled",
+							zap.Int64("task ID", ti.GetId()),
+							zap.Error(err))
+					}
+					log.Info("task has been marked cancelled while reloading",
+						zap.Int64("task ID", ti.GetId()))
+				} else if ti.GetState().GetStateCode() != commonpb.ImportState_ImportFailed &&
 					ti.GetState().GetStateCode() != commonpb.ImportState_ImportFailedAndCleaned &&
-					ti.GetState().GetStateCode() != commonpb.ImportState_ImportCompleted {
+					ti.GetState().GetStateCode() != commonpb.ImportState_ImportCompleted &&
+					!typeutil.IsImportCancelledState(ti.GetState().GetStateCode()) {
 					ti.State.StateCode = commonpb.ImportState_ImportFailed
 					if ti.GetState().GetErrorMessage() == "" {
 						ti.State.ErrorMessage = "task marked failed as service restarted"
@@ -832,15 +1002,7 @@ func (m *importManager) loadFromTaskStore(load2Mem bool) ([]*datapb.ImportTaskIn
 // persistTaskInfo stores or updates the import task info in Etcd.
 func (m *importManager) persistTaskInfo(ti *datapb.ImportTaskInfo) error {
 	log.Info("updating import task info in Etcd", zap.Int64("task ID", ti.GetId()))
-	var taskInfo []byte
-	var err error
-	if taskInfo, err = proto.Marshal(ti); err != nil {
-		log.Error("failed to marshall task info proto",
-			zap.Int64("task ID", ti.GetId()),
-			zap.Error(err))
-		return err
-	}
-	if err = m.taskStore.Save(BuildImportTaskKey(ti.GetId()), string(taskInfo)); err != nil {
+	if err := saveTaskInfo(m.taskStore, BuildImportTaskKey(ti.GetId()), ti); err != nil {
 		log.Error("failed to update import task info in Etcd",
 			zap.Int64("task ID", ti.GetId()),
 			zap.Error(err))
@@ -874,9 +1036,10 @@ func (m *importManager) expireOldTasksFromMem() {
 		for _, v := range m.workingTasks {
 			taskExpiredAndStateUpdated := false
 			if v.GetState().GetStateCode() != commonpb.ImportState_ImportCompleted && taskExpired(v) {
-				log.Info("a working task has expired and will be marked as failed",
+				log.Info("a working task has expired and will be resumed or marked as failed",
 					zap.Int64("task ID", v.GetId()),
 					zap.Int64("startTs", v.GetStartTs()),
+					zap.Int64("activeTs", v.GetActiveTs()),
 					zap.Float64("ImportTaskExpiration", Params.RootCoordCfg.ImportTaskExpiration))
 				taskID := v.GetId()
 				m.workingLock.Unlock()
@@ -885,11 +1048,23 @@ func (m *importManager) expireOldTasksFromMem() {
 				delete(m.busyNodes, v.GetDatanodeId())
 				m.busyNodesLock.Unlock()
 
-				if err := m.setImportTaskStateAndReason(taskID, commonpb.ImportState_ImportFailed,
-					"the import task has timed out"); err != nil {
+				// The DataNode of an expired cancelling task is considered stopped, a cancelled task stays cancelled.
+				targetState, reason := commonpb.ImportState_ImportFailed, "the import task has timed out"
+				if state := v.GetState().GetStateCode(); state == typeutil.ImportCancelling {
+					targetState, reason = typeutil.ImportCancelled, ""
+				} else if typeutil.IsImportCancelledState(state) {
+					targetState, reason = state, ""
+				}
+				if resumed, err := m.tryResumeTask(v); err != nil {
+					log.Error("failed to resume import task",
+						zap.Int64("task ID", taskID),
+						zap.Error(err))
+				} else if resumed {
+					taskExpiredAndStateUpdated = true
+				} else if err := m.setImportTaskStateAndReason(taskID, targetState, reason); err != nil {
 					log.Error("failed to set import task state",
 						zap.Int64("task ID", taskID),
-						zap.Any("target state", commonpb.ImportState_ImportFailed))
+						zap.Any("target state", targetState))
 				} else {
 					taskExpiredAndStateUpdated = true
 				}
@@ -905,21 +1080,15 @@ func (m *importManager) expireOldTasksFromMem() {
 
 // expireOldTasksFromEtcd removes tasks from Etcd that are over `ImportTaskRetention` seconds old.
 func (m *importManager) expireOldTasksFromEtcd() {
-	var vs []string
-	var err error
-	// Collect all import task records.
-	if _, vs, err = m.taskStore.LoadWithPrefix(Params.RootCoordCfg.ImportTaskSubPath); err != nil {
+	// Collect all import task records, bad protos are ignored as this is just a cleanup task.
+	tis, err := loadTaskInfos(m.taskStore, Params.RootCoordCfg.ImportTaskSubPath,
+		func() *datapb.ImportTaskInfo { return &datapb.ImportTaskInfo{} })
+	if err != nil {
 		log.Error("failed to load import tasks from Etcd during task cleanup")
 		return
 	}
 	// Loop through all import tasks in Etcd and look for the ones that have passed retention period.
-	for _, val := range vs {
-		ti := &datapb.ImportTaskInfo{}
-		if err := proto.Unmarshal([]byte(val), ti); err != nil {
-			log.Error("failed to unmarshal proto", zap.String("taskInfo", val), zap.Error(err))
-			// Ignore bad protos. This is just a cleanup task, so we are not panicking.
-			continue
-		}
+	for _, ti := range tis {
 		if taskPastRetention(ti) {
 			log.Info("an import task has passed retention period and will be removed from Etcd",
 				zap.Int64("task ID", ti.GetId()),
@@ -934,6 +1103,66 @@ func (m *importManager) expireOldTasksFromEtcd() {
 	}
 }
 
+// tryResumeTask puts an expired working task back to the pending list if it has checkpoints and hasn't reached
+// the retry limit. The resumed task skips the finished files and keeps the committed segments, the segments
+// not committed by checkpoints are marked as dropped.
+// It returns false if the task can't be resumed.
+func (m *importManager) tryResumeTask(task *datapb.ImportTaskInfo) (bool, error) {
+	if task.GetState().GetStateCode() != commonpb.ImportState_ImportStarted ||
+		len(task.GetState().GetCheckpoints()) == 0 ||
+		task.GetRetryCount() >= Params.RootCoordCfg.ImportTaskMaxRetries {
+		return false, nil
+	}
+
+	committed, rowIDs, rowCount := checkpointsProgress(task.GetState().GetCheckpoints())
+	committedSet := make(map[int64]struct{}, len(committed))
+	for _, segID := range committed {
+		committedSet[segID] = struct{}{}
+	}
+	uncommitted := make([]int64, 0)
+	for _, segID := range task.GetState().GetSegments() {
+		if _, ok := committedSet[segID]; !ok {
+			uncommitted = append(uncommitted, segID)
+		}
+	}
+	if len(uncommitted) > 0 {
+		log.Info("trying to mark uncommitted segments of a resumed task as dropped",
+			zap.Int64("task ID", task.GetId()),
+			zap.Int64s("segment IDs", uncommitted))
+		status, err := m.callMarkSegmentsDropped(m.ctx, uncommitted)
+		if err != nil {
+			return false, err
+		}
+		if status.GetErrorCode() != commonpb.ErrorCode_Success {
+			return false, errors.New(status.GetReason())
+		}
+	}
+
+	// Meta persist should be done before memory objs change.
+	resumed := cloneImportTaskInfo(task)
+	resumed.DatanodeId = 0
+	resumed.RetryCount = task.GetRetryCount() + 1
+	resumed.State = &datapb.ImportTaskState{
+		StateCode:   commonpb.ImportState_ImportPending,
+		Segments:    committed,
+		RowIds:      rowIDs,
+		RowCount:    rowCount,
+		Checkpoints: task.GetState().GetCheckpoints(),
+	}
+	if err := m.persistTaskInfo(resumed); err != nil {
+		return false, err
+	}
+	m.pendingLock.Lock()
+	m.pendingTasks = append(m.pendingTasks, resumed)
+	m.pendingLock.Unlock()
+	log.Info("an expired import task has been resumed as a pending task",
+		zap.Int64("task ID", task.GetId()),
+		zap.Int32("retry count", resumed.GetRetryCount()),
+		zap.Int64s("committed segments", committed),
+		zap.Int64("committed rows", rowCount))
+	return true, nil
+}
+
 // releaseHangingBusyDataNode checks if a busy DataNode has been 'busy' for an unexpected long time.
 // We will then remove these DataNodes from `busy list`.
 func (m *importManager) releaseHangingBusyDataNode() {
@@ -977,16 +1206,7 @@ func (m *importManager) listAllTasks(colID int64, limit int64) ([]*milvuspb.GetI
 		}
 	}
 
-	// arrange tasks by id in ascending order, actually, id is the create time of a task
-	rearrangeTasks(tasks)
-
-	// if limit is 0 or larger than length of tasks, return all tasks
-	if limit <= 0 || limit >= int64(len(tasks)) {
-		return tasks, nil
-	}
-
-	// return the newly tasks from the tail
-	return tasks[len(tasks)-int(limit):], nil
+	return latestTasks(tasks, limit), nil
 }
 
 // removeBadImportSegments marks segments of a failed import task as `dropped`.
@@ -999,8 +1219,11 @@ func (m *importManager) removeBadImportSegments(ctx context.Context) {
 		return
 	}
 	for _, t := range taskList {
-		// Only check newly failed tasks.
-		if t.GetState().GetStateCode() != commonpb.ImportState_ImportFailed {
+		// Only check newly failed or cancelled tasks.
+		cleanedState := commonpb.ImportState_ImportFailedAndCleaned
+		if t.GetState().GetStateCode() == typeutil.ImportCancelled {
+			cleanedState = typeutil.ImportCancelledAndCleaned
+		} else if t.GetState().GetStateCode() != commonpb.ImportState_ImportFailed {
 			continue
 		}
 		log.Info("trying to mark segments as dropped",
@@ -1020,7 +1243,7 @@ func (m *importManager) removeBadImportSegments(ctx context.Context) {
 				zap.Int64s("segments", t.GetState().GetSegments()),
 				zap.Error(errors.New(status.GetReason())))
 		}
-		if err = m.setImportTaskState(t.GetId(), commonpb.ImportState_ImportFailedAndCleaned); err != nil {
+		if err = m.setImportTaskState(t.GetId(), cleanedState); err != nil {
 			log.Error(errMsg,
 				zap.Int64("task ID", t.GetId()))
 		}
@@ -1033,8 +1256,26 @@ func BuildImportTaskKey(taskID int64) string {
 }
 
 // taskExpired returns true if the in-mem task is considered expired.
+// A task reporting checkpoints is considered alive, the expiration counts from the latest report.
 func taskExpired(ti *datapb.ImportTaskInfo) bool {
-	return Params.RootCoordCfg.ImportTaskExpiration <= float64(time.Now().Unix()-ti.GetStartTs())
+	activeTs := ti.GetStartTs()
+	if ti.GetActiveTs() > activeTs {
+		activeTs = ti.GetActiveTs()
+	}
+	return Params.RootCoordCfg.ImportTaskExpiration <= float64(time.Now().Unix()-activeTs)
+}
+
+// checkpointsProgress returns the committed segments, auto-generated id ranges and row count of the checkpoints.
+func checkpointsProgress(checkpoints []*internalpb.ImportCheckpoint) ([]int64, []int64, int64) {
+	segments := make([]int64, 0)
+	rowIDs := make([]int64, 0)
+	var rowCount int64
+	for _, checkpoint := range checkpoints {
+		segments = append(segments, checkpoint.GetSegments()...)
+		rowIDs = append(rowIDs, checkpoint.GetAutoIds()...)
+		rowCount += checkpoint.GetRowCount()
+	}
+	return segments, rowIDs, rowCount
 }
 
 // taskPastRetention returns true if the task is considered expired in Etcd.
@@ -1069,6 +1310,9 @@ func cloneImportTaskInfo(taskInfo *datapb.ImportTaskInfo) *datapb.ImportTaskInfo
 		PartitionName:  taskInfo.GetPartitionName(),
 		Infos:          taskInfo.GetInfos(),
 		StartTs:        taskInfo.GetStartTs(),
+		ActiveTs:       taskInfo.GetActiveTs(),
+		RetryCount:     taskInfo.GetRetryCount(),
+		Priority:       taskInfo.GetPriority(),
 	}
 	return cloned
 }
@@ -19,6 +19,7 @@ package rootcoord
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"sync"
 	"testing"
@@ -31,7 +32,9 @@ import (
 	"github.com/milvus-io/milvus/internal/kv/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
@@ -102,7 +105,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 		defer wg.Done()
 		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 		defer cancel()
-		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 		assert.NotNil(t, mgr)
 
 		// there are 2 tasks read from store, one is pending, the other is persisted.
@@ -134,7 +137,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 		defer wg.Done()
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Nanosecond)
 		defer cancel()
-		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		mgr.init(context.TODO())
 		var wgLoop sync.WaitGroup
@@ -153,7 +156,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Nanosecond)
 		defer cancel()
-		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		assert.Panics(t, func() {
 			mgr.init(context.TODO())
@@ -170,7 +173,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Nanosecond)
 		defer cancel()
-		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		mgr.init(context.TODO())
 	})
@@ -184,7 +187,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Nanosecond)
 		defer cancel()
-		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		mgr.init(context.TODO())
 		func() {
@@ -204,7 +207,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 		defer wg.Done()
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
-		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		mgr.init(ctx)
 		var wgLoop sync.WaitGroup
@@ -262,7 +265,7 @@ func TestImportManager_TestSetImportTaskState(t *testing.T) {
 		defer wg.Done()
 		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 		defer cancel()
-		mgr := newImportManager(ctx, mockKv, idAlloc, nil, nil, nil, nil, nil, nil)
+		mgr := newImportManager(ctx, mockKv, idAlloc, nil, nil, nil, nil, nil, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		_, err := mgr.loadFromTaskStore(true)
 		assert.NoError(t, err)
@@ -357,7 +360,7 @@ func TestImportManager_TestEtcdCleanUp(t *testing.T) {
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
-	mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 	assert.NotNil(t, mgr)
 	_, err = mgr.loadFromTaskStore(true)
 	assert.NoError(t, err)
@@ -475,7 +478,7 @@ func TestImportManager_TestFlipTaskStateLoop(t *testing.T) {
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
 		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped,
-			nil, callDescribeIndex, callGetSegmentIndexState, callUnsetIsImportingState)
+			nil, callDescribeIndex, callGetSegmentIndexState, callUnsetIsImportingState, nil, nil)
 		assert.NotNil(t, mgr)
 		var wgLoop sync.WaitGroup
 		wgLoop.Add(1)
@@ -497,7 +500,7 @@ func TestImportManager_TestFlipTaskStateLoop(t *testing.T) {
 			}, nil
 		}
 		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped,
-			nil, callDescribeIndex, callGetSegmentIndexState, callUnsetIsImportingState)
+			nil, callDescribeIndex, callGetSegmentIndexState, callUnsetIsImportingState, nil, nil)
 		assert.NotNil(t, mgr)
 		var wgLoop sync.WaitGroup
 		wgLoop.Add(1)
@@ -519,7 +522,7 @@ func TestImportManager_TestFlipTaskStateLoop(t *testing.T) {
 			}, nil
 		}
 		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped,
-			nil, callDescribeIndex, callGetSegmentIndexState, callUnsetIsImportingState)
+			nil, callDescribeIndex, callGetSegmentIndexState, callUnsetIsImportingState, nil, nil)
 		assert.NotNil(t, mgr)
 		var wgLoop sync.WaitGroup
 		wgLoop.Add(1)
@@ -550,7 +553,7 @@ func TestImportManager_ImportJob(t *testing.T) {
 	}
 
 	// nil request
-	mgr := newImportManager(context.TODO(), mockKv, idAlloc, nil, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, nil, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 	resp := mgr.importJob(context.TODO(), nil, colID, 0)
 	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 
@@ -579,7 +582,7 @@ func TestImportManager_ImportJob(t *testing.T) {
 	// row-based case, task count equal to file count
 	// since the importServiceFunc return error, tasks will be kept in pending list
 	rowReq.Files = []string{"f1.json"}
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.Equal(t, len(rowReq.Files), len(mgr.pendingTasks))
 	assert.Equal(t, 0, len(mgr.workingTasks))
@@ -592,7 +595,7 @@ func TestImportManager_ImportJob(t *testing.T) {
 
 	// column-based case, one quest one task
 	// since the importServiceFunc return error, tasks will be kept in pending list
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), colReq, colID, 0)
 	assert.Equal(t, 1, len(mgr.pendingTasks))
 	assert.Equal(t, 0, len(mgr.workingTasks))
@@ -606,13 +609,13 @@ func TestImportManager_ImportJob(t *testing.T) {
 	}
 
 	// row-based case, since the importServiceFunc return success, tasks will be sent to working list
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
 	assert.Equal(t, len(rowReq.Files), len(mgr.workingTasks))
 
 	// column-based case, since the importServiceFunc return success, tasks will be sent to working list
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), colReq, colID, 0)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
 	assert.Equal(t, 1, len(mgr.workingTasks))
@@ -636,7 +639,7 @@ func TestImportManager_ImportJob(t *testing.T) {
 
 	// row-based case, since the importServiceFunc return success for 1 task
 	// the first task is sent to working list, and 1 task left in pending list
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
 	assert.Equal(t, 1, len(mgr.workingTasks))
@@ -712,7 +715,7 @@ func TestImportManager_AllDataNodesBusy(t *testing.T) {
 	}
 
 	// each data node owns one task
-	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 	for i := 0; i < len(dnList); i++ {
 		resp := mgr.importJob(context.TODO(), rowReq, colID, 0)
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
@@ -721,7 +724,7 @@ func TestImportManager_AllDataNodesBusy(t *testing.T) {
 	}
 
 	// all data nodes are busy, new task waiting in pending list
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 	resp := mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	assert.Equal(t, len(rowReq.Files), len(mgr.pendingTasks))
@@ -729,7 +732,7 @@ func TestImportManager_AllDataNodesBusy(t *testing.T) {
 
 	// now all data nodes are free again, new task is executed instantly
 	count = 0
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), colReq, colID, 0)
 	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
@@ -786,7 +789,7 @@ func TestImportManager_TaskState(t *testing.T) {
 	}
 
 	// add 3 tasks, their ID is 10000, 10001, 10002, make sure updateTaskInfo() works correctly
-	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 	mgr.importJob(context.TODO(), rowReq, colID, 0)
 	rowReq.Files = []string{"f2.json"}
 	mgr.importJob(context.TODO(), rowReq, colID, 0)
@@ -856,6 +859,370 @@ func TestImportManager_TaskState(t *testing.T) {
 	newTaskInfo, err = mgr.updateTaskInfo(info)
 	assert.Error(t, err)
 	assert.Nil(t, newTaskInfo)
+
+	// the validation report of a dry-run task
+	info = &rootcoordpb.ImportResult{
+		TaskId:   3,
+		RowCount: 10,
+		State:    commonpb.ImportState_ImportCompleted,
+		Infos: []*commonpb.KeyValuePair{
+			{
+				Key:   importutil.ValidationReport,
+				Value: `[{"file":"f3.json","row_count":10,"bad_row_count":0}]`,
+			},
+		},
+	}
+	newTaskInfo, err = mgr.updateTaskInfo(info)
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"file":"f3.json","row_count":10,"bad_row_count":0}]`, newTaskInfo.GetState().GetValidationReport())
+	resp = mgr.getTaskState(3)
+	assert.Equal(t, commonpb.ImportState_ImportCompleted, resp.State)
+	assert.Equal(t, int64(10), resp.GetRowCount())
+	report, err := funcutil.GetAttrByKeyFromRepeatedKV(importutil.ValidationReport, resp.GetInfos())
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"file":"f3.json","row_count":10,"bad_row_count":0}]`, report)
+	_, err = funcutil.GetAttrByKeyFromRepeatedKV(importutil.ValidationReport, mgr.getTaskState(2).GetInfos())
+	assert.Error(t, err)
+}
+
+func TestImportManager_ResumeTask(t *testing.T) {
+	Params.RootCoordCfg.ImportTaskSubPath = "test_import_task"
+	Params.RootCoordCfg.ImportTaskExpiration = 10
+	Params.RootCoordCfg.ImportTaskMaxRetries = 1
+	defer func() {
+		Params.RootCoordCfg.ImportTaskMaxRetries = 3
+	}()
+
+	var sentTask *datapb.ImportTask
+	importServiceFunc := func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
+		sentTask = req.GetImportTask()
+		return &datapb.ImportTaskResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			DatanodeId: 2,
+		}, nil
+	}
+	var droppedSegments []int64
+	callMarkSegmentsDropped := func(ctx context.Context, segIDs []typeutil.UniqueID) (*commonpb.Status, error) {
+		droppedSegments = append(droppedSegments, segIDs...)
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		}, nil
+	}
+	mgr := newImportManager(context.TODO(), memkv.NewMemoryKV(), nil, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
+
+	checkpoints := []*internalpb.ImportCheckpoint{
+		{File: "f1.json", Finished: true, Segments: []int64{10}, AutoIds: []int64{1, 6}, RowCount: 5},
+		{File: "f2.parquet", RowGroups: 1, Segments: []int64{20}, AutoIds: []int64{6, 9}, RowCount: 3},
+		{File: "f3.json"},
+	}
+	mgr.workingTasks[1] = &datapb.ImportTaskInfo{
+		Id:         1,
+		DatanodeId: 1,
+		Files:      []string{"f1.json", "f2.parquet", "f3.json"},
+		CreateTs:   time.Now().Unix() - 20,
+		StartTs:    time.Now().Unix() - 20,
+		State: &datapb.ImportTaskState{
+			StateCode: commonpb.ImportState_ImportStarted,
+		},
+	}
+	mgr.busyNodes[1] = time.Now().Unix() - 20
+
+	// the report refreshes the active time and stores the checkpoints
+	ti, err := mgr.updateTaskInfo(&rootcoordpb.ImportResult{
+		TaskId:      1,
+		DatanodeId:  1,
+		State:       commonpb.ImportState_ImportStarted,
+		Segments:    []int64{10, 20, 30},
+		AutoIds:     []int64{1, 6, 6, 9, 9, 12},
+		RowCount:    11,
+		Checkpoints: checkpoints,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(ti.GetState().GetCheckpoints()))
+	assert.LessOrEqual(t, time.Now().Unix()-ti.GetActiveTs(), int64(1))
+	assert.False(t, taskExpired(ti))
+
+	// a report from another DataNode is rejected
+	_, err = mgr.updateTaskInfo(&rootcoordpb.ImportResult{
+		TaskId:     1,
+		DatanodeId: 3,
+		State:      commonpb.ImportState_ImportStarted,
+	})
+	assert.Error(t, err)
+
+	// the expired task is resumed, uncommitted segments are dropped
+	mgr.workingTasks[1].ActiveTs = time.Now().Unix() - 20
+	mgr.expireOldTasksFromMem()
+	assert.Equal(t, 0, len(mgr.workingTasks))
+	assert.Equal(t, 0, len(mgr.busyNodes))
+	assert.Equal(t, []int64{30}, droppedSegments)
+	assert.Equal(t, 1, len(mgr.pendingTasks))
+	resumed := mgr.pendingTasks[0]
+	assert.Equal(t, int64(0), resumed.GetDatanodeId())
+	assert.Equal(t, int32(1), resumed.GetRetryCount())
+	assert.Equal(t, commonpb.ImportState_ImportPending, resumed.GetState().GetStateCode())
+	assert.Equal(t, []int64{10, 20}, resumed.GetState().GetSegments())
+	assert.Equal(t, []int64{1, 6, 6, 9}, resumed.GetState().GetRowIds())
+	assert.Equal(t, int64(8), resumed.GetState().GetRowCount())
+	resp := mgr.getTaskState(1)
+	assert.Equal(t, commonpb.ImportState_ImportPending, resp.GetState())
+
+	// the checkpoints are sent out with the resumed task
+	err = mgr.sendOutTasks(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(sentTask.GetCheckpoints()))
+	assert.True(t, sentTask.GetCheckpoints()[0].GetFinished())
+	assert.Equal(t, int64(1), sentTask.GetCheckpoints()[1].GetRowGroups())
+	assert.Equal(t, int64(2), mgr.workingTasks[1].GetDatanodeId())
+
+	// the committed segments are kept if the DataNode fails before reporting checkpoints
+	ti, err = mgr.updateTaskInfo(&rootcoordpb.ImportResult{
+		TaskId:     1,
+		DatanodeId: 2,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   []int64{40},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{10, 20, 40}, ti.GetState().GetSegments())
+
+	// the retry count reaches the limit, the task is marked failed
+	mgr.workingTasks[1].StartTs = time.Now().Unix() - 20
+	mgr.workingTasks[1].ActiveTs = time.Now().Unix() - 20
+	mgr.expireOldTasksFromMem()
+	assert.Equal(t, 0, len(mgr.workingTasks))
+	assert.Equal(t, 0, len(mgr.pendingTasks))
+	resp = mgr.getTaskState(1)
+	assert.Equal(t, commonpb.ImportState_ImportFailed, resp.GetState())
+}
+
+func TestImportManager_TaskPriority(t *testing.T) {
+	Params.RootCoordCfg.ImportTaskSubPath = "test_import_task"
+	Params.RootCoordCfg.ImportMaxTasksPerCollection = 2
+	defer func() {
+		Params.RootCoordCfg.ImportMaxTasksPerCollection = 0
+	}()
+
+	var sentTasks []int64
+	reject := false
+	importServiceFunc := func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
+		if reject {
+			return &datapb.ImportTaskResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				},
+			}, nil
+		}
+		sentTasks = append(sentTasks, req.GetImportTask().GetTaskId())
+		return &datapb.ImportTaskResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			DatanodeId: int64(len(sentTasks)),
+		}, nil
+	}
+	idAlloc := func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+		return 10, 11, nil
+	}
+	mgr := newImportManager(context.TODO(), memkv.NewMemoryKV(), idAlloc, importServiceFunc, nil, nil, nil, nil, nil, nil, nil)
+
+	newTask := func(id int64, collID int64, priority int32) *datapb.ImportTaskInfo {
+		return &datapb.ImportTaskInfo{
+			Id:           id,
+			CollectionId: collID,
+			Priority:     priority,
+			State: &datapb.ImportTaskState{
+				StateCode: commonpb.ImportState_ImportPending,
+			},
+		}
+	}
+	mgr.workingTasks[9] = &datapb.ImportTaskInfo{
+		Id:           9,
+		CollectionId: 100,
+		State: &datapb.ImportTaskState{
+			StateCode: commonpb.ImportState_ImportStarted,
+		},
+	}
+	mgr.pendingTasks = append(mgr.pendingTasks, newTask(1, 100, 0), newTask(2, 100, 5), newTask(3, 200, 0), newTask(4, 100, 5))
+
+	// the task with larger priority is sent first, the collection 100 reaches the limit after task 2 is sent
+	err := mgr.sendOutTasks(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 3}, sentTasks)
+	assert.Equal(t, 2, len(mgr.pendingTasks))
+	assert.Equal(t, int64(1), mgr.pendingTasks[0].GetId())
+	assert.Equal(t, int64(4), mgr.pendingTasks[1].GetId())
+
+	// a working task of collection 100 is persisted, the next task of collection 100 is sent
+	mgr.workingTasks[9].State.StateCode = commonpb.ImportState_ImportPersisted
+	err = mgr.sendOutTasks(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 3, 4}, sentTasks)
+
+	// no limit
+	Params.RootCoordCfg.ImportMaxTasksPerCollection = 0
+	err = mgr.sendOutTasks(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 3, 4, 1}, sentTasks)
+	assert.Equal(t, 0, len(mgr.pendingTasks))
+
+	// the priority is passed by the import options
+	reject = true
+	resp := mgr.importJob(context.TODO(), &milvuspb.ImportRequest{
+		CollectionName: "c1",
+		Files:          []string{"f1.json"},
+		Options:        []*commonpb.KeyValuePair{{Key: importutil.Priority, Value: "3"}},
+	}, 100, 0)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, 1, len(mgr.pendingTasks))
+	assert.Equal(t, int32(3), mgr.pendingTasks[0].GetPriority())
+
+	resp = mgr.importJob(context.TODO(), &milvuspb.ImportRequest{
+		CollectionName: "c1",
+		Files:          []string{"f1.json"},
+		Options:        []*commonpb.KeyValuePair{{Key: importutil.Priority, Value: "-1"}},
+	}, 100, 0)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, 1, len(mgr.pendingTasks))
+}
+
+func TestImportManager_CancelTask(t *testing.T) {
+	Params.RootCoordCfg.ImportTaskSubPath = "test_import_task"
+	var cancelReq *datapb.CancelImportTaskRequest
+	cancelFailed := false
+	cancelImportFunc := func(ctx context.Context, req *datapb.CancelImportTaskRequest) (*commonpb.Status, error) {
+		cancelReq = req
+		if cancelFailed {
+			return &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "task not found",
+			}, nil
+		}
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		}, nil
+	}
+	mgr := newImportManager(context.TODO(), memkv.NewMemoryKV(), nil, nil, nil, nil, nil, nil, nil, nil, cancelImportFunc)
+
+	newTask := func(id int64, nodeID int64, state commonpb.ImportState) *datapb.ImportTaskInfo {
+		return &datapb.ImportTaskInfo{
+			Id:         id,
+			DatanodeId: nodeID,
+			State: &datapb.ImportTaskState{
+				StateCode: state,
+				Segments:  []int64{id * 10},
+			},
+		}
+	}
+	mgr.pendingTasks = append(mgr.pendingTasks, newTask(1, 0, commonpb.ImportState_ImportPending))
+	mgr.workingTasks[2] = newTask(2, 20, commonpb.ImportState_ImportStarted)
+	mgr.workingTasks[3] = newTask(3, 30, commonpb.ImportState_ImportStarted)
+	mgr.workingTasks[4] = newTask(4, 40, commonpb.ImportState_ImportPersisted)
+	mgr.busyNodes[20] = time.Now().Unix()
+	mgr.busyNodes[30] = time.Now().Unix()
+
+	// the pending task is removed and marked as cancelled
+	err := mgr.cancelImportTask(context.TODO(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(mgr.pendingTasks))
+	resp := mgr.getTaskState(1)
+	assert.Equal(t, typeutil.ImportCancelled, resp.GetState())
+	infos := funcutil.KeyValuePair2Map(resp.GetInfos())
+	assert.Equal(t, taskCancelledMsg, infos[FailedReason])
+	assert.Nil(t, cancelReq)
+
+	// the working task is cancelling until its DataNode stops it, the DataNode stays busy
+	err = mgr.cancelImportTask(context.TODO(), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), cancelReq.GetTaskId())
+	assert.Equal(t, int64(20), cancelReq.GetDatanodeId())
+	assert.Equal(t, typeutil.ImportCancelling, mgr.workingTasks[2].GetState().GetStateCode())
+	assert.Equal(t, 2, len(mgr.busyNodes))
+
+	// the cancelling task is not resumed
+	resumed, err := mgr.tryResumeTask(mgr.workingTasks[2])
+	assert.NoError(t, err)
+	assert.False(t, resumed)
+
+	// a checkpoint reported before the worker stops keeps the task cancelling
+	ti, err := mgr.updateTaskInfo(&rootcoordpb.ImportResult{
+		TaskId:     2,
+		DatanodeId: 20,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   []int64{20},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, typeutil.ImportCancelling, ti.GetState().GetStateCode())
+
+	// the task is cancelled but not flushed even if the DataNode has persisted it, the failed reason is kept
+	ti, err = mgr.updateTaskInfo(&rootcoordpb.ImportResult{
+		TaskId:     2,
+		DatanodeId: 20,
+		State:      commonpb.ImportState_ImportPersisted,
+		Segments:   []int64{20, 21},
+		Infos:      []*commonpb.KeyValuePair{{Key: FailedReason, Value: "dummy"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, typeutil.ImportCancelled, ti.GetState().GetStateCode())
+	assert.Equal(t, taskCancelledMsg, ti.GetState().GetErrorMessage())
+	assert.Equal(t, []int64{20, 21}, ti.GetState().GetSegments())
+
+	// the cancelled task takes no more reports
+	_, err = mgr.updateTaskInfo(&rootcoordpb.ImportResult{
+		TaskId:     2,
+		DatanodeId: 20,
+		State:      commonpb.ImportState_ImportFailed,
+	})
+	assert.Error(t, err)
+
+	// the DataNode is not able to stop the task, the task keeps cancelling and the DataNode stays busy
+	cancelFailed = true
+	err = mgr.cancelImportTask(context.TODO(), 3)
+	assert.NoError(t, err)
+	assert.Equal(t, typeutil.ImportCancelling, mgr.workingTasks[3].GetState().GetStateCode())
+	_, ok := mgr.busyNodes[30]
+	assert.True(t, ok)
+
+	// tasks in other states can't be cancelled
+	err = mgr.cancelImportTask(context.TODO(), 4)
+	assert.Error(t, err)
+	err = mgr.cancelImportTask(context.TODO(), 3)
+	assert.Error(t, err)
+	err = mgr.cancelImportTask(context.TODO(), 5)
+	assert.Error(t, err)
+
+	// no cancel function
+	mgr.callCancelImport = nil
+	mgr.workingTasks[6] = newTask(6, 60, commonpb.ImportState_ImportStarted)
+	err = mgr.cancelImportTask(context.TODO(), 6)
+	assert.NoError(t, err)
+	assert.Equal(t, typeutil.ImportCancelling, mgr.workingTasks[6].GetState().GetStateCode())
+
+	// the expired cancelling task is cancelled, and the DataNode is released
+	Params.RootCoordCfg.ImportTaskExpiration = 5
+	for _, task := range mgr.workingTasks {
+		task.StartTs = time.Now().Unix()
+	}
+	mgr.workingTasks[3].StartTs = time.Now().Unix() - 10
+	mgr.expireOldTasksFromMem()
+	_, ok = mgr.workingTasks[3]
+	assert.False(t, ok)
+	_, ok = mgr.busyNodes[30]
+	assert.False(t, ok)
+	assert.Equal(t, typeutil.ImportCancelled, mgr.getTaskState(3).GetState())
+
+	// the segments of the cancelled tasks are dropped
+	var dropped []int64
+	mgr.callMarkSegmentsDropped = func(ctx context.Context, segIDs []typeutil.UniqueID) (*commonpb.Status, error) {
+		dropped = append(dropped, segIDs...)
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+	mgr.removeBadImportSegments(context.TODO())
+	assert.ElementsMatch(t, []int64{10, 20, 21, 30}, dropped)
+	assert.Equal(t, typeutil.ImportCancelledAndCleaned, mgr.getTaskState(1).GetState())
+	assert.Equal(t, typeutil.ImportCancelledAndCleaned, mgr.getTaskState(2).GetState())
+	assert.Equal(t, typeutil.ImportCancelledAndCleaned, mgr.getTaskState(3).GetState())
 }
 
 func TestImportManager_AllocFail(t *testing.T) {
@@ -884,7 +1251,7 @@ func TestImportManager_AllocFail(t *testing.T) {
 			ErrorCode: commonpb.ErrorCode_Success,
 		}, nil
 	}
-	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 	resp := mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
@@ -945,7 +1312,7 @@ func TestImportManager_ListAllTasks(t *testing.T) {
 	}
 
 	mockKv := memkv.NewMemoryKV()
-	mgr := newImportManager(context.TODO(), mockKv, idAlloc, fn, callMarkSegmentsDropped, getCollectionName, nil, nil, nil)
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, fn, callMarkSegmentsDropped, getCollectionName, nil, nil, nil, nil, nil)
 
 	// add 10 tasks for collection1, id from 1 to 10
 	file1 := "f1.json"
@@ -1131,6 +1498,131 @@ func TestImportManager_isRowbased(t *testing.T) {
 	assert.False(t, rb)
 }
 
+func TestImportManager_splitImportFiles(t *testing.T) {
+	mgr := &importManager{}
+	Params.RootCoordCfg.ImportMaxFilesPerTask = 2
+	defer func() {
+		Params.RootCoordCfg.ImportMaxFilesPerTask = 100
+	}()
+
+	// explicit files
+	taskFiles, err := mgr.splitImportFiles([]string{"1.json"}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"1.json"}}, taskFiles)
+
+	taskFiles, err = mgr.splitImportFiles([]string{"1.npy", "2.npy"}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"1.npy", "2.npy"}}, taskFiles)
+
+	_, err = mgr.splitImportFiles([]string{"1.json", "2.json"}, false)
+	assert.Error(t, err)
+
+	// expanded row-based files are split by count
+	taskFiles, err = mgr.splitImportFiles([]string{"a/1.json.gz", "a/2.json.gz", "a/3.csv", "a/4.parquet", "a/5.json"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"a/1.json.gz", "a/2.json.gz"}, {"a/3.csv", "a/4.parquet"}, {"a/5.json"}}, taskFiles)
+
+	// expanded numpy files are split by directory
+	taskFiles, err = mgr.splitImportFiles([]string{"a/1/uid.npy", "a/1/vec.npy", "a/2/uid.npy", "a/2/vec.npy"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"a/1/uid.npy", "a/1/vec.npy"}, {"a/2/uid.npy", "a/2/vec.npy"}}, taskFiles)
+
+	// each expanded npz file makes a task
+	taskFiles, err = mgr.splitImportFiles([]string{"a/1/uid.npy", "a/1/vec.npy", "a/2.npz", "a/3.npz"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"a/1/uid.npy", "a/1/vec.npy"}, {"a/2.npz"}, {"a/3.npz"}}, taskFiles)
+
+	_, err = mgr.splitImportFiles([]string{"a/1/uid.npy", "a/1.json"}, true)
+	assert.Error(t, err)
+	_, err = mgr.splitImportFiles([]string{"a/1.npz", "a/1.json"}, true)
+	assert.Error(t, err)
+}
+
+func TestImportManager_ImportJobWithPattern(t *testing.T) {
+	var countLock sync.RWMutex
+	var globalCount = typeutil.UniqueID(0)
+
+	var idAlloc = func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+		countLock.Lock()
+		defer countLock.Unlock()
+		globalCount++
+		return globalCount, 0, nil
+	}
+	Params.RootCoordCfg.ImportTaskSubPath = "test_import_task"
+	Params.RootCoordCfg.ImportMaxFilesPerTask = 10
+	defer func() {
+		Params.RootCoordCfg.ImportMaxFilesPerTask = 100
+	}()
+	importServiceFunc := func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
+		return &datapb.ImportTaskResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			},
+		}, nil
+	}
+	objects := make([]string, 0)
+	for i := 0; i < 25; i++ {
+		objects = append(objects, fmt.Sprintf("shards/%02d.json.gz", i))
+	}
+	objects = append(objects, "shards/_SUCCESS")
+	listFiles := func(ctx context.Context, prefix string) ([]string, error) {
+		files := make([]string, 0)
+		for _, object := range objects {
+			if strings.HasPrefix(object, prefix) {
+				files = append(files, object)
+			}
+		}
+		return files, nil
+	}
+
+	// the prefix is expanded and split into 3 tasks
+	req := &milvuspb.ImportRequest{
+		CollectionName: "c1",
+		Files:          []string{"shards/"},
+	}
+	mgr := newImportManager(context.TODO(), memkv.NewMemoryKV(), idAlloc, importServiceFunc, nil, nil, nil, nil, nil, listFiles, nil)
+	resp := mgr.importJob(context.TODO(), req, 100, 0)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, 3, len(resp.GetTasks()))
+	assert.Equal(t, 3, len(mgr.pendingTasks))
+	assert.Equal(t, 10, len(mgr.pendingTasks[0].GetFiles()))
+	assert.Equal(t, "shards/00.json.gz", mgr.pendingTasks[0].GetFiles()[0])
+	assert.Equal(t, []string{"shards/20.json.gz", "shards/21.json.gz", "shards/22.json.gz",
+		"shards/23.json.gz", "shards/24.json.gz"}, mgr.pendingTasks[2].GetFiles())
+
+	// glob pattern
+	req.Files = []string{"shards/1*.json.gz"}
+	mgr = newImportManager(context.TODO(), memkv.NewMemoryKV(), idAlloc, importServiceFunc, nil, nil, nil, nil, nil, listFiles, nil)
+	resp = mgr.importJob(context.TODO(), req, 100, 0)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, 1, len(mgr.pendingTasks))
+	assert.Equal(t, 10, len(mgr.pendingTasks[0].GetFiles()))
+
+	// nothing matched
+	req.Files = []string{"shards/*.npy"}
+	resp = mgr.importJob(context.TODO(), req, 100, 0)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+	// the paths of backup tool are not expanded
+	req.Files = []string{"backup/insert_log/", "backup/delta_log/"}
+	req.Options = []*commonpb.KeyValuePair{{Key: importutil.BackupFlag, Value: "true"}}
+	resp = mgr.importJob(context.TODO(), req, 100, 0)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, 2, len(mgr.pendingTasks))
+	assert.Equal(t, req.Files, mgr.pendingTasks[1].GetFiles())
+
+	// too many tasks
+	for i := 25; i < 1000; i++ {
+		objects = append(objects, fmt.Sprintf("shards/%03d.json.gz", i))
+	}
+	req.Files = []string{"shards/"}
+	req.Options = nil
+	mgr = newImportManager(context.TODO(), memkv.NewMemoryKV(), idAlloc, importServiceFunc, nil, nil, nil, nil, nil, listFiles, nil)
+	resp = mgr.importJob(context.TODO(), req, 100, 0)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, 0, len(mgr.pendingTasks))
+}
+
 func TestImportManager_checkIndexingDone(t *testing.T) {
 	ctx := context.Background()
 
@@ -212,6 +212,8 @@ type mockQueryCoord struct {
 	GetSegmentInfoFunc     func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
 	GetComponentStatesFunc func(ctx context.Context) (*milvuspb.ComponentStates, error)
 	ReleaseCollectionFunc  func(ctx context.Context, req *querypb.ReleaseCollectionRequest) (*commonpb.Status, error)
+
+	UpdateCollectionSchemaFunc func(ctx context.Context, req *querypb.UpdateCollectionSchemaRequest) (*commonpb.Status, error)
 }
 
 func (m mockQueryCoord) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
@@ -226,6 +228,10 @@ func (m mockQueryCoord) ReleaseCollection(ctx context.Context, req *querypb.Rele
 	return m.ReleaseCollectionFunc(ctx, req)
 }
 
+func (m mockQueryCoord) UpdateCollectionSchema(ctx context.Context, req *querypb.UpdateCollectionSchemaRequest) (*commonpb.Status, error) {
+	return m.UpdateCollectionSchemaFunc(ctx, req)
+}
+
 func newMockQueryCoord() *mockQueryCoord {
 	return &mockQueryCoord{}
 }
@@ -434,6 +440,9 @@ func withInvalidQueryCoord() Opt {
 	qc.ReleaseCollectionFunc = func(ctx context.Context, req *querypb.ReleaseCollectionRequest) (*commonpb.Status, error) {
 		return nil, errors.New("error mock ReleaseCollection")
 	}
+	qc.UpdateCollectionSchemaFunc = func(ctx context.Context, req *querypb.UpdateCollectionSchemaRequest) (*commonpb.Status, error) {
+		return nil, errors.New("error mock UpdateCollectionSchema")
+	}
 	qc.GetSegmentInfoFunc = func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 		return nil, errors.New("error mock GetSegmentInfo")
 	}
@@ -451,6 +460,9 @@ func withFailedQueryCoord() Opt {
 	qc.ReleaseCollectionFunc = func(ctx context.Context, req *querypb.ReleaseCollectionRequest) (*commonpb.Status, error) {
 		return failStatus(commonpb.ErrorCode_UnexpectedError, "mock release collection error"), nil
 	}
+	qc.UpdateCollectionSchemaFunc = func(ctx context.Context, req *querypb.UpdateCollectionSchemaRequest) (*commonpb.Status, error) {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "mock update collection schema error"), nil
+	}
 	qc.GetSegmentInfoFunc = func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 		return &querypb.GetSegmentInfoResponse{
 			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "mock get segment info error"),
@@ -470,6 +482,9 @@ func withValidQueryCoord() Opt {
 	qc.ReleaseCollectionFunc = func(ctx context.Context, req *querypb.ReleaseCollectionRequest) (*commonpb.Status, error) {
 		return succStatus(), nil
 	}
+	qc.UpdateCollectionSchemaFunc = func(ctx context.Context, req *querypb.UpdateCollectionSchemaRequest) (*commonpb.Status, error) {
+		return succStatus(), nil
+	}
 	qc.GetSegmentInfoFunc = func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 		return &querypb.GetSegmentInfoResponse{
 			Status: succStatus(),
@@ -827,6 +842,7 @@ type mockBroker struct {
 	GetSegmentIndexStateFunc func(ctx context.Context, collID UniqueID, indexName string, segIDs []UniqueID) ([]*indexpb.SegmentIndexState, error)
 
 	BroadcastAlteredCollectionFunc func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error
+	UpdateCollectionSchemaFunc     func(ctx context.Context, collectionID UniqueID) error
 }
 
 func newMockBroker() *mockBroker {
@@ -861,6 +877,10 @@ func (b mockBroker) BroadcastAlteredCollection(ctx context.Context, req *milvusp
 	return b.BroadcastAlteredCollectionFunc(ctx, req)
 }
 
+func (b mockBroker) UpdateCollectionSchema(ctx context.Context, collectionID UniqueID) error {
+	return b.UpdateCollectionSchemaFunc(ctx, collectionID)
+}
+
 func withBroker(b Broker) Opt {
 	return func(c *Core) {
 		c.broker = b
@@ -41,6 +41,7 @@ type proxyManager struct {
 	cancel           context.CancelFunc
 	lock             sync.Mutex
 	etcdCli          *clientv3.Client
+	role             string
 	initSessionsFunc []func([]*sessionutil.Session)
 	addSessionsFunc  []func(*sessionutil.Session)
 	delSessionsFunc  []func(*sessionutil.Session)
@@ -50,12 +51,23 @@ type proxyManager struct {
 // etcdEndpoints is the address list of etcd
 // fns are the custom getSessions function list
 func newProxyManager(ctx context.Context, client *clientv3.Client, fns ...func([]*sessionutil.Session)) *proxyManager {
+	return newSessionManager(ctx, client, typeutil.ProxyRole, fns...)
+}
+
+// newReplicatorManager watches the sessions of the replicators writing to the dml channels,
+// which are time tick sources like the proxies
+func newReplicatorManager(ctx context.Context, client *clientv3.Client, fns ...func([]*sessionutil.Session)) *proxyManager {
+	return newSessionManager(ctx, client, typeutil.ReplicatorRole, fns...)
+}
+
+func newSessionManager(ctx context.Context, client *clientv3.Client, role string, fns ...func([]*sessionutil.Session)) *proxyManager {
 	ctx2, cancel2 := context.WithCancel(ctx)
 	p := &proxyManager{
 		ctx:     ctx2,
 		cancel:  cancel2,
 		lock:    sync.Mutex{},
 		etcdCli: client,
+		role:    role,
 	}
 	p.initSessionsFunc = append(p.initSessionsFunc, fns...)
 	return p
@@ -92,7 +104,7 @@ func (p *proxyManager) WatchProxy() error {
 
 	eventCh := p.etcdCli.Watch(
 		p.ctx,
-		path.Join(Params.EtcdCfg.MetaRootPath.GetValue(), sessionutil.DefaultServiceRoot, typeutil.ProxyRole),
+		path.Join(Params.EtcdCfg.MetaRootPath.GetValue(), sessionutil.DefaultServiceRoot, p.role),
 		clientv3.WithPrefix(),
 		clientv3.WithCreatedNotify(),
 		clientv3.WithPrevKV(),
@@ -149,11 +161,13 @@ func (p *proxyManager) handlePutEvent(e *clientv3.Event) error {
 	if err != nil {
 		return err
 	}
-	log.Debug("received proxy put event with session", zap.Any("session", session))
+	log.Debug("received put event with session", zap.String("role", p.role), zap.Any("session", session))
 	for _, f := range p.addSessionsFunc {
 		f(session)
 	}
-	metrics.RootCoordProxyCounter.WithLabelValues().Inc()
+	if p.role == typeutil.ProxyRole {
+		metrics.RootCoordProxyCounter.WithLabelValues().Inc()
+	}
 	return nil
 }
 
@@ -162,11 +176,13 @@ func (p *proxyManager) handleDeleteEvent(e *clientv3.Event) error {
 	if err != nil {
 		return err
 	}
-	log.Debug("received proxy delete event with session", zap.Any("session", session))
+	log.Debug("received delete event with session", zap.String("role", p.role), zap.Any("session", session))
 	for _, f := range p.delSessionsFunc {
 		f(session)
 	}
-	metrics.RootCoordProxyCounter.WithLabelValues().Dec()
+	if p.role == typeutil.ProxyRole {
+		metrics.RootCoordProxyCounter.WithLabelValues().Dec()
+	}
 	return nil
 }
 
@@ -182,12 +198,12 @@ func (p *proxyManager) parseSession(value []byte) (*sessionutil.Session, error)
 func (p *proxyManager) getSessionsOnEtcd(ctx context.Context) ([]*sessionutil.Session, int64, error) {
 	resp, err := p.etcdCli.Get(
 		ctx,
-		path.Join(Params.EtcdCfg.MetaRootPath.GetValue(), sessionutil.DefaultServiceRoot, typeutil.ProxyRole),
+		path.Join(Params.EtcdCfg.MetaRootPath.GetValue(), sessionutil.DefaultServiceRoot, p.role),
 		clientv3.WithPrefix(),
 		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend),
 	)
 	if err != nil {
-		return nil, 0, fmt.Errorf("proxy manager failed to watch proxy with error %w", err)
+		return nil, 0, fmt.Errorf("%s manager failed to watch %s with error %w", p.role, p.role, err)
 	}
 
 	var sessions []*sessionutil.Session
@@ -57,6 +57,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/errorutil"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/retry"
@@ -106,6 +107,7 @@ type Core struct {
 
 	proxyCreator       proxyCreator
 	proxyManager       *proxyManager
+	replicatorManager  *proxyManager
 	proxyClientManager *proxyClientManager
 
 	metricsCacheManager *metricsinfo.MetricsCacheManager
@@ -129,6 +131,8 @@ type Core struct {
 	factory dependency.Factory
 
 	importManager *importManager
+	exportManager *exportManager
+	snapshotGC    *snapshotGarbageCollector
 
 	enableActiveStandBy bool
 	activateFunc        func()
@@ -366,6 +370,10 @@ func (c *Core) initMetaTable() error {
 			}
 
 			catalog = &kvmetestore.Catalog{Txn: metaKV, Snapshot: ss}
+
+			c.snapshotGC = newSnapshotGarbageCollector(c.ctx, ss,
+				time.Duration(Params.RootCoordCfg.SnapshotGCInterval*float64(time.Second)),
+				time.Duration(Params.RootCoordCfg.SnapshotRetention*float64(time.Second)))
 		case util.MetaStoreTypeMysql:
 			// connect to database
 			err := dbcore.Connect(&Params.DBCfg)
@@ -426,12 +434,26 @@ func (c *Core) initImportManager() error {
 		f.NewDescribeIndexFunc(),
 		f.NewGetSegmentIndexStateFunc(),
 		f.NewUnsetIsImportingStateFunc(),
+		f.NewListFilesFunc(),
+		f.NewCancelImportFunc(),
 	)
 	c.importManager.init(c.ctx)
 
 	return nil
 }
 
+func (c *Core) initExportManager() error {
+	expTaskKv, err := c.metaKVCreator(Params.EtcdCfg.KvRootPath.GetValue())
+	if err != nil {
+		return err
+	}
+
+	c.exportManager = newExportManager(c.ctx, expTaskKv, IDAllocatorWithCore(c), ExportFuncWithCore(c))
+	c.exportManager.init(c.ctx)
+
+	return nil
+}
+
 func (c *Core) initInternal() error {
 	if err := c.initSession(); err != nil {
 		return err
@@ -472,6 +494,9 @@ func (c *Core) initInternal() error {
 	)
 	c.proxyManager.AddSessionFunc(c.chanTimeTick.addSession, c.proxyClientManager.AddProxyClient)
 	c.proxyManager.DelSessionFunc(c.chanTimeTick.delSession, c.proxyClientManager.DelProxyClient)
+	c.replicatorManager = newReplicatorManager(c.ctx, c.etcdCli, c.chanTimeTick.initReplicatorSessions)
+	c.replicatorManager.AddSessionFunc(c.chanTimeTick.addReplicatorSession)
+	c.replicatorManager.DelSessionFunc(c.chanTimeTick.delReplicatorSession)
 
 	c.metricsCacheManager = metricsinfo.NewMetricsCacheManager()
 
@@ -482,6 +507,10 @@ func (c *Core) initInternal() error {
 		return err
 	}
 
+	if err := c.initExportManager(); err != nil {
+		return err
+	}
+
 	if err := c.initCredentials(); err != nil {
 		return err
 	}
@@ -619,18 +648,31 @@ func (c *Core) startInternal() error {
 		// you can not just stuck here,
 		panic(err)
 	}
+	if err := c.replicatorManager.WatchProxy(); err != nil {
+		log.Fatal("rootcoord failed to watch replicator", zap.Error(err))
+		panic(err)
+	}
 
 	if err := c.restore(c.ctx); err != nil {
 		panic(err)
 	}
 
-	c.wg.Add(6)
+	c.wg.Add(8)
 	go c.startTimeTickLoop()
 	go c.tsLoop()
 	go c.chanTimeTick.startWatch(&c.wg)
 	go c.importManager.cleanupLoop(&c.wg)
 	go c.importManager.sendOutTasksLoop(&c.wg)
 	go c.importManager.flipTaskStateLoop(&c.wg)
+	go c.exportManager.cleanupLoop(&c.wg)
+	go c.exportManager.sendOutTasksLoop(&c.wg)
+	if c.snapshotGC != nil {
+		registerSnapshotGCHandler(c.snapshotGC)
+		if Params.RootCoordCfg.SnapshotGCEnabled && Params.RootCoordCfg.SnapshotGCInterval > 0 {
+			c.wg.Add(1)
+			go c.snapshotGC.collectLoop(&c.wg)
+		}
+	}
 	Params.RootCoordCfg.CreatedTime = time.Now()
 	Params.RootCoordCfg.UpdatedTime = time.Now()
 
@@ -1737,6 +1779,18 @@ func (c *Core) ListImportTasks(ctx context.Context, req *milvuspb.ListImportTask
 	return resp, nil
 }
 
+// CancelImport cancels an import task, the segments of the task are cleaned up.
+func (c *Core) CancelImport(ctx context.Context, req *rootcoordpb.CancelImportRequest) (*commonpb.Status, error) {
+	log.Info("RootCoord receive cancel import request", zap.Int64("task ID", req.GetTaskId()))
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]), nil
+	}
+	if err := c.importManager.cancelImportTask(ctx, req.GetTaskId()); err != nil {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+	return succStatus(), nil
+}
+
 // ReportImport reports import task state to RootCoord.
 func (c *Core) ReportImport(ctx context.Context, ir *rootcoordpb.ImportResult) (*commonpb.Status, error) {
 	log.Info("RootCoord receive import state report",
@@ -1745,8 +1799,9 @@ func (c *Core) ReportImport(ctx context.Context, ir *rootcoordpb.ImportResult) (
 	if code, ok := c.checkHealthy(); !ok {
 		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]), nil
 	}
-	// If setting ImportState_ImportCompleted, simply update the state and return directly.
-	if ir.GetState() == commonpb.ImportState_ImportCompleted {
+	// A dry-run task is completed by DataNode with a validation report, the report is persisted below.
+	// If setting ImportState_ImportCompleted for other tasks, simply update the state and return directly.
+	if ir.GetState() == commonpb.ImportState_ImportCompleted && !c.importManager.isDryRunTask(ir.GetTaskId()) {
 		if err := c.importManager.setImportTaskState(ir.GetTaskId(), commonpb.ImportState_ImportCompleted); err != nil {
 			errMsg := "failed to set import task as ImportState_ImportCompleted"
 			log.Error(errMsg, zap.Error(err))
@@ -1785,13 +1840,30 @@ func (c *Core) ReportImport(ctx context.Context, ir *rootcoordpb.ImportResult) (
 		}
 	}
 
-	// If task failed, send task to idle datanode
-	if ir.GetState() == commonpb.ImportState_ImportFailed {
+	// A cancelling task is turned cancelled by updateTaskInfo once its DataNode stops, so check the updated state here.
+	// If task failed or has been cancelled, send task to idle datanode
+	state := ti.GetState().GetStateCode()
+	if state == commonpb.ImportState_ImportFailed || state == typeutil.ImportCancelled {
 		// When a DataNode failed importing, remove this DataNode from the busy node list and send out import tasks again.
 		log.Info("an import task has failed, marking DataNode available and resending import task",
 			zap.Int64("task ID", ir.GetTaskId()))
 		resendTaskFunc()
-	} else if ir.GetState() != commonpb.ImportState_ImportPersisted {
+	} else if state == commonpb.ImportState_ImportStarted || state == typeutil.ImportCancelling {
+		// DataNode reports the checkpoints of an ongoing task, the DataNode is still busy.
+		log.Info("an import task has committed a checkpoint",
+			zap.Int64("task ID", ir.GetTaskId()),
+			zap.Int64("row count", ir.GetRowCount()))
+		c.importManager.busyNodesLock.Lock()
+		if _, ok := c.importManager.busyNodes[ir.GetDatanodeId()]; ok {
+			c.importManager.busyNodes[ir.GetDatanodeId()] = time.Now().Unix()
+		}
+		c.importManager.busyNodesLock.Unlock()
+	} else if state == commonpb.ImportState_ImportCompleted {
+		// A dry-run task has validated all the files, no segment to flush.
+		log.Info("an import task has finished validation, marking DataNode available",
+			zap.Int64("task ID", ir.GetTaskId()))
+		resendTaskFunc()
+	} else if state != commonpb.ImportState_ImportPersisted {
 		log.Debug("unexpected import task state reported, return immediately (this should not happen)",
 			zap.Any("task ID", ir.GetTaskId()),
 			zap.Any("import state", ir.GetState()))
@@ -1816,6 +1888,148 @@ func (c *Core) ReportImport(ctx context.Context, ir *rootcoordpb.ImportResult) (
 	}, nil
 }
 
+// Export exports the rows of a collection visible at the given timestamp into files (json, numpy, etc.) on MinIO/S3 storage.
+func (c *Core) Export(ctx context.Context, req *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.ExportResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	returnErrorFunc := func(err error) (*rootcoordpb.ExportResponse, error) {
+		log.Error("Export failed", zap.String("collection name", req.GetCollectionName()), zap.Error(err))
+		return &rootcoordpb.ExportResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+
+	if req.GetPath() == "" {
+		return returnErrorFunc(errors.New("export path is empty"))
+	}
+	ts, err := c.tsoAllocator.GenerateTSO(1)
+	if err != nil {
+		return returnErrorFunc(err)
+	}
+	if req.GetTimestamp() > ts {
+		return returnErrorFunc(fmt.Errorf("export timestamp %d is later than the current timestamp %d", req.GetTimestamp(), ts))
+	}
+	// compaction purges the rows deleted before the retention duration, they are not visible at an earlier timestamp
+	if req.GetTimestamp() != 0 {
+		retention := time.Duration(Params.CommonCfg.RetentionDuration) * time.Second
+		if tsoutil.PhysicalTime(req.GetTimestamp()).Before(tsoutil.PhysicalTime(ts).Add(-retention)) {
+			return returnErrorFunc(fmt.Errorf("export timestamp %d is earlier than the retention duration %d seconds",
+				req.GetTimestamp(), Params.CommonCfg.RetentionDuration))
+		}
+		ts = req.GetTimestamp()
+	}
+
+	// Get collection/partition ID from collection/partition name at the export timestamp.
+	colInfo, err := c.meta.GetCollectionByName(ctx, req.GetCollectionName(), ts)
+	if err != nil {
+		return returnErrorFunc(fmt.Errorf("failed to find collection ID from its name: '%s', error: %w", req.GetCollectionName(), err))
+	}
+	partIDs := make([]UniqueID, 0, len(req.GetPartitionNames()))
+	for _, partName := range req.GetPartitionNames() {
+		partID, err := c.meta.GetPartitionByName(colInfo.CollectionID, partName, ts)
+		if err != nil {
+			return returnErrorFunc(fmt.Errorf("failed to get partition ID from its name: '%s', error: %w", partName, err))
+		}
+		partIDs = append(partIDs, partID)
+	}
+	schema := &schemapb.CollectionSchema{
+		Name:        colInfo.Name,
+		Description: colInfo.Description,
+		AutoID:      colInfo.AutoID,
+		Fields:      model.MarshalFieldModels(colInfo.Fields),
+	}
+	if err := importutil.ValidateExport(schema, req.GetFileType(), req.GetOptions()); err != nil {
+		return returnErrorFunc(err)
+	}
+
+	log.Info("RootCoord receive export request",
+		zap.String("collection name", req.GetCollectionName()),
+		zap.Int64("collection ID", colInfo.CollectionID),
+		zap.Strings("partition names", req.GetPartitionNames()),
+		zap.Int64s("partition IDs", partIDs),
+		zap.Uint64("timestamp", ts),
+		zap.String("file type", req.GetFileType()),
+		zap.String("bucket", req.GetBucket()),
+		zap.String("path", req.GetPath()))
+	return c.exportManager.exportJob(ctx, &rootcoordpb.ExportTaskInfo{
+		CollectionId:   colInfo.CollectionID,
+		PartitionIds:   partIDs,
+		CollectionName: req.GetCollectionName(),
+		PartitionNames: req.GetPartitionNames(),
+		Timestamp:      ts,
+		FileType:       req.GetFileType(),
+		Bucket:         req.GetBucket(),
+		Path:           req.GetPath(),
+		Infos:          req.GetOptions(),
+	}), nil
+}
+
+// GetExportState returns the current state of an export task.
+func (c *Core) GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.GetExportStateResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	return c.exportManager.getTaskState(req.GetTaskId()), nil
+}
+
+// ListExportTasks returns the states of the latest export tasks.
+func (c *Core) ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.ListExportTasksResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	colID := int64(-1)
+	if len(req.GetCollectionName()) != 0 {
+		colInfo, err := c.meta.GetCollectionByName(ctx, req.GetCollectionName(), typeutil.MaxTimestamp)
+		if err != nil {
+			err = fmt.Errorf("failed to find collection ID from its name: '%s', error: %w", req.GetCollectionName(), err)
+			log.Error("ListExportTasks failed", zap.Error(err))
+			return &rootcoordpb.ListExportTasksResponse{
+				Status: failStatus(commonpb.ErrorCode_IllegalCollectionName, err.Error()),
+			}, nil
+		}
+		colID = colInfo.CollectionID
+	}
+
+	// if the collection name is not specified, the colID is -1, listAllTasks will return all tasks
+	tasks, err := c.exportManager.listAllTasks(colID, req.GetLimit())
+	if err != nil {
+		err = fmt.Errorf("failed to list export tasks, collection name: '%s', error: %w", req.GetCollectionName(), err)
+		log.Error("ListExportTasks failed", zap.Error(err))
+		return &rootcoordpb.ListExportTasksResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+
+	return &rootcoordpb.ListExportTasksResponse{
+		Status: succStatus(),
+		Tasks:  tasks,
+	}, nil
+}
+
+// ReportExport reports export task state to RootCoord.
+func (c *Core) ReportExport(ctx context.Context, er *rootcoordpb.ExportResult) (*commonpb.Status, error) {
+	log.Info("RootCoord receive export state report",
+		zap.Int64("task ID", er.GetTaskId()),
+		zap.Any("export state", er.GetState()),
+		zap.Int("exported segments", len(er.GetSegments())),
+		zap.Int64("total segments", er.GetTotalSegments()))
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]), nil
+	}
+	if err := c.exportManager.updateTaskInfo(er); err != nil {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+	return succStatus(), nil
+}
+
 // ExpireCredCache will call invalidate credential cache
 func (c *Core) ExpireCredCache(ctx context.Context, username string) error {
 	req := proxypb.InvalidateCredCacheRequest{
@@ -12,6 +12,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/allocator"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/kv/mocks"
@@ -24,9 +25,11 @@ import (
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/stretchr/testify/assert"
@@ -903,7 +906,7 @@ func TestCore_GetImportState(t *testing.T) {
 	t.Run("normal case", func(t *testing.T) {
 		ctx := context.Background()
 		c := newTestCore(withHealthyCode())
-		c.importManager = newImportManager(ctx, mockKv, nil, nil, nil, nil, nil, nil, nil)
+		c.importManager = newImportManager(ctx, mockKv, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		resp, err := c.GetImportState(ctx, &milvuspb.GetImportStateRequest{
 			Task: 100,
 		})
@@ -987,7 +990,7 @@ func TestCore_ListImportTasks(t *testing.T) {
 
 		ctx := context.Background()
 		c := newTestCore(withHealthyCode(), withMeta(meta))
-		c.importManager = newImportManager(ctx, mockKv, nil, nil, nil, nil, nil, nil, nil)
+		c.importManager = newImportManager(ctx, mockKv, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// list all tasks
 		resp, err := c.ListImportTasks(ctx, &milvuspb.ListImportTasksRequest{})
@@ -1138,7 +1141,7 @@ func TestCore_ReportImport(t *testing.T) {
 	t.Run("report complete import", func(t *testing.T) {
 		ctx := context.Background()
 		c := newTestCore(withHealthyCode())
-		c.importManager = newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+		c.importManager = newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 		resp, err := c.ReportImport(ctx, &rootcoordpb.ImportResult{
 			TaskId: 100,
 			State:  commonpb.ImportState_ImportCompleted,
@@ -1153,7 +1156,7 @@ func TestCore_ReportImport(t *testing.T) {
 	t.Run("report complete import with task not found", func(t *testing.T) {
 		ctx := context.Background()
 		c := newTestCore(withHealthyCode())
-		c.importManager = newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+		c.importManager = newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 		resp, err := c.ReportImport(ctx, &rootcoordpb.ImportResult{
 			TaskId: 101,
 			State:  commonpb.ImportState_ImportCompleted,
@@ -1165,7 +1168,7 @@ func TestCore_ReportImport(t *testing.T) {
 	t.Run("report import started state", func(t *testing.T) {
 		ctx := context.Background()
 		c := newTestCore(withHealthyCode())
-		c.importManager = newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+		c.importManager = newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 		c.importManager.loadFromTaskStore(true)
 		c.importManager.sendOutTasks(ctx)
 		resp, err := c.ReportImport(ctx, &rootcoordpb.ImportResult{
@@ -1179,6 +1182,59 @@ func TestCore_ReportImport(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("report dry-run import", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withHealthyCode())
+		dryRunKv := memkv.NewMemoryKV()
+		err := saveTaskInfo(dryRunKv, BuildImportTaskKey(300), &datapb.ImportTaskInfo{
+			Id: 300,
+			State: &datapb.ImportTaskState{
+				StateCode: commonpb.ImportState_ImportPending,
+			},
+			CreateTs: time.Now().Unix() - 100,
+			Infos:    []*commonpb.KeyValuePair{{Key: importutil.DryRun, Value: "true"}},
+		})
+		assert.NoError(t, err)
+		c.importManager = newImportManager(ctx, dryRunKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
+		c.importManager.loadFromTaskStore(true)
+		c.importManager.sendOutTasks(ctx)
+		resp, err := c.ReportImport(ctx, &rootcoordpb.ImportResult{
+			TaskId: 300,
+			State:  commonpb.ImportState_ImportCompleted,
+			Infos:  []*commonpb.KeyValuePair{{Key: importutil.ValidationReport, Value: "[]"}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		state := c.importManager.getTaskState(300)
+		assert.Equal(t, commonpb.ImportState_ImportCompleted, state.GetState())
+		report, err := funcutil.GetAttrByKeyFromRepeatedKV(importutil.ValidationReport, state.GetInfos())
+		assert.NoError(t, err)
+		assert.Equal(t, "[]", report)
+	})
+
+	t.Run("report completed import with validation report", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withHealthyCode())
+		c.importManager = newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
+		c.importManager.loadFromTaskStore(true)
+		c.importManager.sendOutTasks(ctx)
+		// the task is not a dry-run task, the state is set without persisting the report
+		resp, err := c.ReportImport(ctx, &rootcoordpb.ImportResult{
+			TaskId: 100,
+			State:  commonpb.ImportState_ImportCompleted,
+			Infos:  []*commonpb.KeyValuePair{{Key: importutil.ValidationReport, Value: "[]"}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		state := c.importManager.getTaskState(100)
+		assert.Equal(t, commonpb.ImportState_ImportCompleted, state.GetState())
+		_, err = funcutil.GetAttrByKeyFromRepeatedKV(importutil.ValidationReport, state.GetInfos())
+		assert.Error(t, err)
+		// Change the state back.
+		err = c.importManager.setImportTaskState(100, commonpb.ImportState_ImportPending)
+		assert.NoError(t, err)
+	})
+
 	t.Run("report persisted import", func(t *testing.T) {
 		ctx := context.Background()
 		c := newTestCore(
@@ -1188,7 +1244,7 @@ func TestCore_ReportImport(t *testing.T) {
 			withTtSynchronizer(ticker),
 			withDataCoord(dc))
 		c.broker = newServerBroker(c)
-		c.importManager = newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+		c.importManager = newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil, nil, nil)
 		c.importManager.loadFromTaskStore(true)
 		c.importManager.sendOutTasks(ctx)
 
@@ -1204,6 +1260,195 @@ func TestCore_ReportImport(t *testing.T) {
 	})
 }
 
+func TestCore_CancelImport(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.CancelImport(ctx, &rootcoordpb.CancelImportRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("task not found", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withHealthyCode())
+		c.importManager = newImportManager(ctx, memkv.NewMemoryKV(), nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resp, err := c.CancelImport(ctx, &rootcoordpb.CancelImportRequest{TaskId: 100})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withHealthyCode())
+		c.importManager = newImportManager(ctx, memkv.NewMemoryKV(), nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		c.importManager.pendingTasks = append(c.importManager.pendingTasks, &datapb.ImportTaskInfo{
+			Id: 100,
+			State: &datapb.ImportTaskState{
+				StateCode: commonpb.ImportState_ImportPending,
+			},
+		})
+		resp, err := c.CancelImport(ctx, &rootcoordpb.CancelImportRequest{TaskId: 100})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, 0, len(c.importManager.pendingTasks))
+	})
+}
+
+func TestCore_Export(t *testing.T) {
+	meta := newMockMetaTable()
+	meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		if collectionName != "a-good-name" {
+			return nil, errors.New("collection name not found")
+		}
+		return &model.Collection{
+			CollectionID: 100,
+			Name:         collectionName,
+			Fields: []*model.Field{
+				{FieldID: 101, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			},
+		}, nil
+	}
+	meta.GetPartitionByNameFunc = func(collID UniqueID, partitionName string, ts Timestamp) (UniqueID, error) {
+		if partitionName != "p1" {
+			return 0, errors.New("partition name not found")
+		}
+		return 101, nil
+	}
+	tsoAllocator := newMockTsoAllocator()
+	tsoAllocator.GenerateTSOF = func(count uint32) (uint64, error) {
+		return 1000, nil
+	}
+
+	t.Run("not healthy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.Export(ctx, &rootcoordpb.ExportRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("bad request", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withHealthyCode(), withMeta(meta), withTsoAllocator(tsoAllocator))
+		for _, req := range []*rootcoordpb.ExportRequest{
+			{CollectionName: "a-good-name", FileType: importutil.JSONFileType},
+			{CollectionName: "a-good-name", FileType: importutil.JSONFileType, Path: "a", Timestamp: 1001},
+			{CollectionName: "a-bad-name", FileType: importutil.JSONFileType, Path: "a"},
+			{CollectionName: "a-good-name", PartitionNames: []string{"p2"}, FileType: importutil.JSONFileType, Path: "a"},
+			{CollectionName: "a-good-name", FileType: "csv", Path: "a"},
+		} {
+			resp, err := c.Export(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		}
+
+		c = newTestCore(withHealthyCode(), withMeta(meta), withInvalidTsoAllocator())
+		resp, err := c.Export(ctx, &rootcoordpb.ExportRequest{CollectionName: "a-good-name", FileType: importutil.JSONFileType, Path: "a"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+		// the timestamp is earlier than the retention duration
+		now := time.Now()
+		nowTsoAllocator := newMockTsoAllocator()
+		nowTsoAllocator.GenerateTSOF = func(count uint32) (uint64, error) {
+			return tsoutil.ComposeTSByTime(now, 0), nil
+		}
+		c = newTestCore(withHealthyCode(), withMeta(meta), withTsoAllocator(nowTsoAllocator))
+		resp, err = c.Export(ctx, &rootcoordpb.ExportRequest{CollectionName: "a-good-name", FileType: importutil.JSONFileType, Path: "a",
+			Timestamp: tsoutil.ComposeTSByTime(now.Add(-time.Duration(Params.CommonCfg.RetentionDuration+60)*time.Second), 0)})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		ctx := context.Background()
+		Params.RootCoordCfg.ExportTaskSubPath = "exporttask"
+		var exportReq *datapb.ExportTaskRequest
+		c := newTestCore(withHealthyCode(), withMeta(meta), withTsoAllocator(tsoAllocator))
+		c.exportManager = newExportManager(ctx, memkv.NewMemoryKV(),
+			func(count uint32) (UniqueID, UniqueID, error) {
+				return 1, 0, nil
+			},
+			func(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+				exportReq = req
+				return &datapb.ExportTaskResponse{
+					Status:        succStatus(),
+					DatanodeId:    1,
+					TotalSegments: 2,
+				}, nil
+			})
+
+		resp, err := c.Export(ctx, &rootcoordpb.ExportRequest{
+			CollectionName: "a-good-name",
+			PartitionNames: []string{"p1"},
+			FileType:       importutil.ParquetFileType,
+			Path:           "a",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, int64(1), resp.GetTaskId())
+		assert.Equal(t, int64(100), exportReq.GetExportTask().GetCollectionId())
+		assert.Equal(t, []int64{101}, exportReq.GetExportTask().GetPartitionIds())
+		assert.Equal(t, uint64(1000), exportReq.GetExportTask().GetTimestamp())
+
+		status, err := c.ReportExport(ctx, &rootcoordpb.ExportResult{
+			TaskId:        1,
+			DatanodeId:    1,
+			State:         rootcoordpb.ExportState_ExportStarted,
+			Segments:      []int64{10},
+			TotalSegments: 2,
+			RowCount:      5,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		state, err := c.GetExportState(ctx, &rootcoordpb.GetExportStateRequest{TaskId: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, state.GetStatus().GetErrorCode())
+		assert.Equal(t, rootcoordpb.ExportState_ExportStarted, state.GetState())
+		assert.Equal(t, int64(50), state.GetProgress())
+		assert.Equal(t, int64(5), state.GetRowCount())
+		assert.Equal(t, []string{"p1"}, state.GetPartitionNames())
+
+		tasks, err := c.ListExportTasks(ctx, &rootcoordpb.ListExportTasksRequest{CollectionName: "a-good-name"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, tasks.GetStatus().GetErrorCode())
+		assert.Equal(t, 1, len(tasks.GetTasks()))
+
+		tasks, err = c.ListExportTasks(ctx, &rootcoordpb.ListExportTasksRequest{CollectionName: "a-bad-name"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalCollectionName, tasks.GetStatus().GetErrorCode())
+
+		// unknown task
+		status, err = c.ReportExport(ctx, &rootcoordpb.ExportResult{TaskId: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+
+		// failed to load tasks from store
+		mockTxnKV := &mocks.TxnKV{}
+		mockTxnKV.EXPECT().LoadWithPrefix(mock.Anything).Return(nil, nil, errors.New("mock error"))
+		c.exportManager.taskStore = mockTxnKV
+		tasks, err = c.ListExportTasks(ctx, &rootcoordpb.ListExportTasksRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, tasks.GetStatus().GetErrorCode())
+	})
+
+	t.Run("not healthy for state and report", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withAbnormalCode())
+		state, err := c.GetExportState(ctx, &rootcoordpb.GetExportStateRequest{TaskId: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, state.GetStatus().GetErrorCode())
+		tasks, err := c.ListExportTasks(ctx, &rootcoordpb.ListExportTasksRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, tasks.GetStatus().GetErrorCode())
+		status, err := c.ReportExport(ctx, &rootcoordpb.ExportResult{TaskId: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+}
+
 func TestCore_Rbac(t *testing.T) {
 	ctx := context.Background()
 	c := &Core{
@@ -0,0 +1,146 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/management"
+	"github.com/milvus-io/milvus/internal/management/healthz"
+	kvmetestore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+var (
+	errSnapshotGCRunning = errors.New("snapshot garbage collection is already running")
+
+	registerSnapshotGCOnce sync.Once
+)
+
+// snapshotCollector removes the historical versions of the meta snapshots, implemented by SuffixSnapshot.
+type snapshotCollector interface {
+	GarbageCollect(ctx context.Context, retentionTs typeutil.Timestamp) (*kvmetestore.SnapshotGCResult, error)
+}
+
+// snapshotGarbageCollector runs the garbage collection of the meta snapshots periodically and on demand,
+// only one round runs at a time.
+type snapshotGarbageCollector struct {
+	ctx       context.Context
+	snapshot  snapshotCollector
+	interval  time.Duration
+	retention time.Duration
+
+	running sync.Mutex
+}
+
+func newSnapshotGarbageCollector(ctx context.Context, snapshot snapshotCollector, interval, retention time.Duration) *snapshotGarbageCollector {
+	return &snapshotGarbageCollector{
+		ctx:       ctx,
+		snapshot:  snapshot,
+		interval:  interval,
+		retention: retention,
+	}
+}
+
+// collect runs one round, versions older than now minus retention are removed.
+func (gc *snapshotGarbageCollector) collect(ctx context.Context) (*kvmetestore.SnapshotGCResult, error) {
+	if !gc.running.TryLock() {
+		return nil, errSnapshotGCRunning
+	}
+	defer gc.running.Unlock()
+
+	start := time.Now()
+	retentionTs := tsoutil.ComposeTSByTime(start.Add(-gc.retention), 0)
+	result, err := gc.snapshot.GarbageCollect(ctx, retentionTs)
+	metrics.RootCoordSnapshotGCLatency.Observe(float64(time.Since(start).Milliseconds()))
+	if result != nil {
+		metrics.RootCoordNumOfSnapshotKeys.Set(float64(result.ScannedKeys))
+		metrics.RootCoordSnapshotGCRemovedKeys.WithLabelValues(metrics.SnapshotVersionLabel).Add(float64(result.RemovedVersions))
+		metrics.RootCoordSnapshotGCRemovedKeys.WithLabelValues(metrics.SnapshotTombstoneLabel).Add(float64(result.RemovedTombstones))
+	}
+	if err != nil {
+		metrics.RootCoordSnapshotGCCounter.WithLabelValues(metrics.FailLabel).Inc()
+		return result, err
+	}
+	metrics.RootCoordSnapshotGCCounter.WithLabelValues(metrics.SuccessLabel).Inc()
+	return result, nil
+}
+
+func (gc *snapshotGarbageCollector) collectLoop(wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(gc.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-gc.ctx.Done():
+			log.Debug("snapshot garbage collector context done, exit collectLoop")
+			return
+		case <-ticker.C:
+			if _, err := gc.collect(gc.ctx); err != nil {
+				log.Warn("failed to collect meta snapshots", zap.Error(err))
+			}
+		}
+	}
+}
+
+// registerSnapshotGCHandler registers the management endpoint triggering a garbage collection round of gc.
+// Only the first call registers it, there is one rootcoord per process.
+func registerSnapshotGCHandler(gc *snapshotGarbageCollector) {
+	registerSnapshotGCOnce.Do(func() {
+		management.Register(&management.HTTPHandler{
+			Path:        management.SnapshotGCRouterPath,
+			HandlerFunc: handleSnapshotGC(gc),
+		})
+	})
+}
+
+func handleSnapshotGC(gc *snapshotGarbageCollector) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			management.WriteError(w, http.StatusMethodNotAllowed, "only POST is allowed")
+			return
+		}
+		result, err := gc.collect(req.Context())
+		if errors.Is(err, errSnapshotGCRunning) {
+			management.WriteError(w, http.StatusConflict, err.Error())
+			return
+		}
+		if err != nil {
+			log.Warn("failed to collect meta snapshots on demand", zap.Error(err))
+			management.WriteError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		bs, err := json.Marshal(result)
+		if err != nil {
+			management.WriteError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		w.Header().Set(healthz.ContentTypeHeader, healthz.ContentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		w.Write(bs)
+	}
+}
@@ -0,0 +1,135 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kvmetestore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type mockSnapshotCollector struct {
+	collect func(ctx context.Context, retentionTs typeutil.Timestamp) (*kvmetestore.SnapshotGCResult, error)
+}
+
+func (m *mockSnapshotCollector) GarbageCollect(ctx context.Context, retentionTs typeutil.Timestamp) (*kvmetestore.SnapshotGCResult, error) {
+	return m.collect(ctx, retentionTs)
+}
+
+func TestSnapshotGarbageCollector_Collect(t *testing.T) {
+	var retentionTs typeutil.Timestamp
+	snapshot := &mockSnapshotCollector{
+		collect: func(ctx context.Context, ts typeutil.Timestamp) (*kvmetestore.SnapshotGCResult, error) {
+			retentionTs = ts
+			return &kvmetestore.SnapshotGCResult{RetentionTs: ts, ScannedKeys: 10, RemovedVersions: 3, RemovedTombstones: 1}, nil
+		},
+	}
+	gc := newSnapshotGarbageCollector(context.Background(), snapshot, time.Hour, time.Hour)
+
+	result, err := gc.collect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.RemovedVersions)
+	assert.WithinDuration(t, time.Now().Add(-time.Hour), tsoutil.PhysicalTime(retentionTs), time.Minute)
+
+	snapshot.collect = func(ctx context.Context, ts typeutil.Timestamp) (*kvmetestore.SnapshotGCResult, error) {
+		return &kvmetestore.SnapshotGCResult{RetentionTs: ts}, errors.New("mock")
+	}
+	_, err = gc.collect(context.Background())
+	assert.Error(t, err)
+}
+
+func TestSnapshotGarbageCollector_CollectLoop(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	called := make(chan struct{}, 1)
+	snapshot := &mockSnapshotCollector{
+		collect: func(ctx context.Context, ts typeutil.Timestamp) (*kvmetestore.SnapshotGCResult, error) {
+			select {
+			case called <- struct{}{}:
+			default:
+			}
+			return &kvmetestore.SnapshotGCResult{RetentionTs: ts}, nil
+		},
+	}
+	gc := newSnapshotGarbageCollector(ctx, snapshot, time.Millisecond, time.Hour)
+
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go gc.collectLoop(wg)
+	select {
+	case <-called:
+	case <-time.After(10 * time.Second):
+		t.Fatal("snapshot gc is not triggered")
+	}
+	cancel()
+	wg.Wait()
+}
+
+func TestSnapshotGarbageCollector_Handler(t *testing.T) {
+	block := make(chan struct{})
+	entered := make(chan struct{})
+	snapshot := &mockSnapshotCollector{
+		collect: func(ctx context.Context, ts typeutil.Timestamp) (*kvmetestore.SnapshotGCResult, error) {
+			return &kvmetestore.SnapshotGCResult{RetentionTs: ts, RemovedVersions: 2}, nil
+		},
+	}
+	gc := newSnapshotGarbageCollector(context.Background(), snapshot, time.Hour, time.Hour)
+	handler := handleSnapshotGC(gc)
+
+	w := httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodGet, "/rootcoord/snapshot/gc", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+
+	w = httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodPost, "/rootcoord/snapshot/gc", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	result := &kvmetestore.SnapshotGCResult{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), result))
+	assert.Equal(t, 2, result.RemovedVersions)
+
+	snapshot.collect = func(ctx context.Context, ts typeutil.Timestamp) (*kvmetestore.SnapshotGCResult, error) {
+		close(entered)
+		<-block
+		return &kvmetestore.SnapshotGCResult{RetentionTs: ts}, nil
+	}
+	go gc.collect(context.Background())
+	<-entered
+	w = httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodPost, "/rootcoord/snapshot/gc", nil))
+	assert.Equal(t, http.StatusConflict, w.Code)
+	close(block)
+
+	gc.running.Lock()
+	snapshot.collect = func(ctx context.Context, ts typeutil.Timestamp) (*kvmetestore.SnapshotGCResult, error) {
+		return nil, errors.New("mock")
+	}
+	gc.running.Unlock()
+	w = httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodPost, "/rootcoord/snapshot/gc", nil))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
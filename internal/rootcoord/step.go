@@ -365,8 +365,8 @@ type BroadcastAlteredCollectionStep struct {
 }
 
 func (b *BroadcastAlteredCollectionStep) Execute(ctx context.Context) ([]nestedStep, error) {
-	// TODO: support online schema change mechanism
-	// It only broadcast collection properties to DataCoord service
+	// It only broadcast collection properties and schema to DataCoord service,
+	// DataNodes catch up with the appended fields by themselves
 	err := b.core.broker.BroadcastAlteredCollection(ctx, b.req)
 	return nil, err
 }
@@ -374,3 +374,17 @@ func (b *BroadcastAlteredCollectionStep) Execute(ctx context.Context) ([]nestedS
 func (b *BroadcastAlteredCollectionStep) Desc() string {
 	return fmt.Sprintf("broadcast altered collection, collectionID: %d", b.req.CollectionID)
 }
+
+type updateCollectionSchemaStep struct {
+	baseStep
+	collectionID UniqueID
+}
+
+func (s *updateCollectionSchemaStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.broker.UpdateCollectionSchema(ctx, s.collectionID)
+	return nil, err
+}
+
+func (s *updateCollectionSchemaStep) Desc() string {
+	return fmt.Sprintf("update collection schema of query nodes, collectionID: %d", s.collectionID)
+}
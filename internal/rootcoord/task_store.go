@@ -0,0 +1,112 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// The helpers below are shared by the managers of bulk tasks(import and export), which keep the task infos
+// as protos in the meta store under a sub path, and hand the tasks to idle DataNodes from a pending list.
+
+// saveTaskInfo marshals the task info and saves it under the key.
+func saveTaskInfo(store kv.TxnKV, key string, info proto.Message) error {
+	value, err := proto.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return store.Save(key, string(value))
+}
+
+// loadTaskInfo loads the task info saved under the key, returns false if the key doesn't exist or the value
+// is not a valid task info.
+func loadTaskInfo(store kv.TxnKV, key string, info proto.Message) bool {
+	value, err := store.Load(key)
+	if err != nil || value == "" {
+		log.Warn("failed to load task info", zap.String("key", key), zap.Error(err))
+		return false
+	}
+	if err := proto.Unmarshal([]byte(value), info); err != nil {
+		log.Error("failed to unmarshal proto", zap.String("taskInfo", value), zap.Error(err))
+		return false
+	}
+	return true
+}
+
+// loadTaskInfos loads all the task infos saved under the prefix, bad protos are ignored.
+func loadTaskInfos[T proto.Message](store kv.TxnKV, prefix string, newInfo func() T) ([]T, error) {
+	_, values, err := store.LoadWithPrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]T, 0, len(values))
+	for _, value := range values {
+		info := newInfo()
+		if err := proto.Unmarshal([]byte(value), info); err != nil {
+			log.Error("failed to unmarshal proto", zap.String("taskInfo", value), zap.Error(err))
+			continue
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// latestTasks arranges tasks by id in ascending order, actually, id is the create time of a task,
+// and returns the latest `limit` tasks, all the tasks are returned if limit is 0 or larger than length of tasks.
+func latestTasks[T interface{ GetId() int64 }](tasks []T, limit int64) []T {
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].GetId() < tasks[j].GetId()
+	})
+	if limit <= 0 || limit >= int64(len(tasks)) {
+		return tasks
+	}
+	return tasks[len(tasks)-int(limit):]
+}
+
+// runTaskLoop calls f every interval until the context is done.
+func runTaskLoop(ctx context.Context, wg *sync.WaitGroup, interval time.Duration, name string, f func()) {
+	defer wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("task manager context done, exit loop", zap.String("loop", name))
+			return
+		case <-ticker.C:
+			f()
+		}
+	}
+}
+
+// busyNodeList returns IDs of the busy DataNodes, for reference of DataCoord to pick an idle one.
+func busyNodeList(busyNodes map[int64]int64) []int64 {
+	var nodes []int64
+	for k := range busyNodes {
+		nodes = append(nodes, k)
+	}
+	return nodes
+}
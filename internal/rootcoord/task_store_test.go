@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+)
+
+func TestTaskStore(t *testing.T) {
+	store := memkv.NewMemoryKV()
+	newInfo := func() *rootcoordpb.ExportTaskInfo { return &rootcoordpb.ExportTaskInfo{} }
+
+	for _, id := range []int64{3, 1, 2} {
+		err := saveTaskInfo(store, fmt.Sprintf("task/%d", id), &rootcoordpb.ExportTaskInfo{Id: id})
+		assert.NoError(t, err)
+	}
+	err := store.Save("task/bad", "bad value")
+	assert.NoError(t, err)
+
+	info := &rootcoordpb.ExportTaskInfo{}
+	assert.True(t, loadTaskInfo(store, "task/2", info))
+	assert.Equal(t, int64(2), info.GetId())
+	assert.False(t, loadTaskInfo(store, "task/4", info))
+	assert.False(t, loadTaskInfo(store, "task/bad", info))
+
+	// bad protos are ignored
+	infos, err := loadTaskInfos(store, "task", newInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(infos))
+
+	infos = latestTasks(infos, 0)
+	assert.Equal(t, []int64{1, 2, 3}, []int64{infos[0].GetId(), infos[1].GetId(), infos[2].GetId()})
+	infos = latestTasks(infos, 2)
+	assert.Equal(t, []int64{2, 3}, []int64{infos[0].GetId(), infos[1].GetId()})
+}
+
+func TestRunTaskLoop(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	called := make(chan struct{}, 1)
+	wg.Add(1)
+	go runTaskLoop(ctx, &wg, time.Millisecond, "test loop", func() {
+		select {
+		case called <- struct{}{}:
+		default:
+		}
+	})
+	<-called
+	cancel()
+	wg.Wait()
+}
@@ -79,6 +79,8 @@ type timetickSync struct {
 	lock           sync.Mutex
 	sess2ChanTsMap map[typeutil.UniqueID]*chanTsMsg
 	sendChan       chan map[typeutil.UniqueID]*chanTsMsg
+	// replicatorSessions are the sessions of sess2ChanTsMap which are replicators instead of proxies
+	replicatorSessions map[typeutil.UniqueID]struct{}
 
 	syncedTtHistogram *ttHistogram
 }
@@ -124,9 +126,10 @@ func newTimeTickSync(ctx context.Context, sourceID int64, factory msgstream.Fact
 
 		dmlChannels: dmlChannels,
 
-		lock:           sync.Mutex{},
-		sess2ChanTsMap: make(map[typeutil.UniqueID]*chanTsMsg),
-		sendChan:       make(chan map[typeutil.UniqueID]*chanTsMsg, 16),
+		lock:               sync.Mutex{},
+		sess2ChanTsMap:     make(map[typeutil.UniqueID]*chanTsMsg),
+		sendChan:           make(chan map[typeutil.UniqueID]*chanTsMsg, 16),
+		replicatorSessions: make(map[typeutil.UniqueID]struct{}),
 
 		syncedTtHistogram: newTtHistogram(),
 	}
@@ -219,12 +222,45 @@ func (t *timetickSync) initSessions(sess []*sessionutil.Session) {
 	t.sess2ChanTsMap = make(map[typeutil.UniqueID]*chanTsMsg)
 	// Init DDL source
 	t.sess2ChanTsMap[ddlSourceID] = nil
+	for id := range t.replicatorSessions {
+		t.sess2ChanTsMap[id] = nil
+	}
 	for _, s := range sess {
 		t.sess2ChanTsMap[s.ServerID] = nil
 		log.Info("Init proxy sessions for timeticksync", zap.Int64("serverID", s.ServerID))
 	}
 }
 
+// initReplicatorSessions replaces the replicator sessions, the replicators hold the time ticks
+// of the channels they write to like the proxies
+func (t *timetickSync) initReplicatorSessions(sess []*sessionutil.Session) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for id := range t.replicatorSessions {
+		delete(t.sess2ChanTsMap, id)
+	}
+	t.replicatorSessions = make(map[typeutil.UniqueID]struct{})
+	for _, s := range sess {
+		t.replicatorSessions[s.ServerID] = struct{}{}
+		t.sess2ChanTsMap[s.ServerID] = nil
+		log.Info("Init replicator sessions for timeticksync", zap.Int64("serverID", s.ServerID))
+	}
+}
+
+func (t *timetickSync) addReplicatorSession(sess *sessionutil.Session) {
+	t.lock.Lock()
+	t.replicatorSessions[sess.ServerID] = struct{}{}
+	t.lock.Unlock()
+	t.addSession(sess)
+}
+
+func (t *timetickSync) delReplicatorSession(sess *sessionutil.Session) {
+	t.lock.Lock()
+	delete(t.replicatorSessions, sess.ServerID)
+	t.lock.Unlock()
+	t.delSession(sess)
+}
+
 // StartWatch watches on session changes and processes timeTick messages of all channels.
 func (t *timetickSync) startWatch(wg *sync.WaitGroup) {
 	defer wg.Done()
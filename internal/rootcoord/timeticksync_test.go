@@ -176,3 +176,30 @@ func Test_ttHistogram_get(t *testing.T) {
 	assert.Equal(t, typeutil.ZeroTimestamp, h.get("ch1"))
 	assert.Equal(t, typeutil.ZeroTimestamp, h.get("ch2"))
 }
+
+func TestTimetickSync_ReplicatorSessions(t *testing.T) {
+	ttSync := &timetickSync{
+		sess2ChanTsMap:     make(map[typeutil.UniqueID]*chanTsMsg),
+		replicatorSessions: make(map[typeutil.UniqueID]struct{}),
+	}
+	ttSync.initSessions([]*sessionutil.Session{{ServerID: 1}})
+	ttSync.initReplicatorSessions([]*sessionutil.Session{{ServerID: 10}})
+	assert.Len(t, ttSync.sess2ChanTsMap, 3)
+
+	// the replicators are kept when the proxies are watched again
+	ttSync.addReplicatorSession(&sessionutil.Session{ServerID: 11})
+	ttSync.initSessions([]*sessionutil.Session{{ServerID: 2}})
+	assert.Contains(t, ttSync.sess2ChanTsMap, UniqueID(10))
+	assert.Contains(t, ttSync.sess2ChanTsMap, UniqueID(11))
+	assert.NotContains(t, ttSync.sess2ChanTsMap, UniqueID(1))
+
+	// and the proxies when the replicators are
+	ttSync.initReplicatorSessions([]*sessionutil.Session{{ServerID: 12}})
+	assert.Contains(t, ttSync.sess2ChanTsMap, UniqueID(2))
+	assert.Contains(t, ttSync.sess2ChanTsMap, UniqueID(12))
+	assert.NotContains(t, ttSync.sess2ChanTsMap, UniqueID(10))
+
+	ttSync.delReplicatorSession(&sessionutil.Session{ServerID: 12})
+	assert.NotContains(t, ttSync.sess2ChanTsMap, UniqueID(12))
+	assert.Empty(t, ttSync.replicatorSessions)
+}
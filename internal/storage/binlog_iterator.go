@@ -90,6 +90,11 @@ func (itr *InsertBinlogIterator) Next() (interface{}, error) {
 
 	m := make(map[FieldID]interface{})
 	for fieldID, fieldData := range itr.data.Data {
+		// null value of nullable field is returned as nil
+		if validData := fieldData.GetValidData(); len(validData) > itr.pos && !validData[itr.pos] {
+			m[fieldID] = nil
+			continue
+		}
 		m[fieldID] = fieldData.GetRow(itr.pos)
 	}
 	pk, err := GenPrimaryKeyByRawData(itr.data.Data[itr.PKfieldID].GetRow(itr.pos), itr.PkType)
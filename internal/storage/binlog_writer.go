@@ -156,7 +156,7 @@ func (writer *InsertBinlogWriter) NextInsertEventWriter(dim ...int) (*insertEven
 
 	var event *insertEventWriter
 	var err error
-	if typeutil.IsVectorType(writer.PayloadDataType) {
+	if typeutil.IsDenseVectorType(writer.PayloadDataType) {
 		if len(dim) != 1 {
 			return nil, fmt.Errorf("incorrect input numbers")
 		}
@@ -17,6 +17,7 @@
 package storage
 
 import (
+	"encoding/base64"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
@@ -106,39 +107,50 @@ type FieldData interface {
 	GetMemorySize() int
 	RowNum() int
 	GetRow(i int) interface{}
+	// GetValidData returns the validity of rows of a nullable field, false means null.
+	// It's empty if the field is not nullable.
+	GetValidData() []bool
 }
 
 type BoolFieldData struct {
-	NumRows []int64
-	Data    []bool
+	NumRows   []int64
+	Data      []bool
+	ValidData []bool
 }
 type Int8FieldData struct {
-	NumRows []int64
-	Data    []int8
+	NumRows   []int64
+	Data      []int8
+	ValidData []bool
 }
 type Int16FieldData struct {
-	NumRows []int64
-	Data    []int16
+	NumRows   []int64
+	Data      []int16
+	ValidData []bool
 }
 type Int32FieldData struct {
-	NumRows []int64
-	Data    []int32
+	NumRows   []int64
+	Data      []int32
+	ValidData []bool
 }
 type Int64FieldData struct {
-	NumRows []int64
-	Data    []int64
+	NumRows   []int64
+	Data      []int64
+	ValidData []bool
 }
 type FloatFieldData struct {
-	NumRows []int64
-	Data    []float32
+	NumRows   []int64
+	Data      []float32
+	ValidData []bool
 }
 type DoubleFieldData struct {
-	NumRows []int64
-	Data    []float64
+	NumRows   []int64
+	Data      []float64
+	ValidData []bool
 }
 type StringFieldData struct {
-	NumRows []int64
-	Data    []string
+	NumRows   []int64
+	Data      []string
+	ValidData []bool
 }
 type BinaryVectorFieldData struct {
 	NumRows []int64
@@ -151,17 +163,49 @@ type FloatVectorFieldData struct {
 	Dim     int
 }
 
+// Float16VectorFieldData and BFloat16VectorFieldData hold half precision vectors, each element takes two bytes,
+// see typeutil.Float16VectorType for the encoding.
+type Float16VectorFieldData struct {
+	NumRows []int64
+	Data    []byte
+	Dim     int
+}
+type BFloat16VectorFieldData struct {
+	NumRows []int64
+	Data    []byte
+	Dim     int
+}
+
+// SparseFloatVectorFieldData holds variable length sparse float vector rows, see typeutil.SparseFloatVectorType
+// for the encoding of a row. Dim is the max dimension of rows.
+type SparseFloatVectorFieldData struct {
+	NumRows []int64
+	Data    [][]byte
+	Dim     int64
+}
+
+// AppendAllRows appends the rows and updates the dimension.
+func (data *SparseFloatVectorFieldData) AppendAllRows(rows [][]byte) {
+	data.Data = append(data.Data, rows...)
+	if dim := typeutil.SparseFloatRowsDim(rows); dim > data.Dim {
+		data.Dim = dim
+	}
+}
+
 // RowNum implements FieldData.RowNum
-func (data *BoolFieldData) RowNum() int         { return len(data.Data) }
-func (data *Int8FieldData) RowNum() int         { return len(data.Data) }
-func (data *Int16FieldData) RowNum() int        { return len(data.Data) }
-func (data *Int32FieldData) RowNum() int        { return len(data.Data) }
-func (data *Int64FieldData) RowNum() int        { return len(data.Data) }
-func (data *FloatFieldData) RowNum() int        { return len(data.Data) }
-func (data *DoubleFieldData) RowNum() int       { return len(data.Data) }
-func (data *StringFieldData) RowNum() int       { return len(data.Data) }
-func (data *BinaryVectorFieldData) RowNum() int { return len(data.Data) * 8 / data.Dim }
-func (data *FloatVectorFieldData) RowNum() int  { return len(data.Data) / data.Dim }
+func (data *BoolFieldData) RowNum() int              { return len(data.Data) }
+func (data *Int8FieldData) RowNum() int              { return len(data.Data) }
+func (data *Int16FieldData) RowNum() int             { return len(data.Data) }
+func (data *Int32FieldData) RowNum() int             { return len(data.Data) }
+func (data *Int64FieldData) RowNum() int             { return len(data.Data) }
+func (data *FloatFieldData) RowNum() int             { return len(data.Data) }
+func (data *DoubleFieldData) RowNum() int            { return len(data.Data) }
+func (data *StringFieldData) RowNum() int            { return len(data.Data) }
+func (data *BinaryVectorFieldData) RowNum() int      { return len(data.Data) * 8 / data.Dim }
+func (data *FloatVectorFieldData) RowNum() int       { return len(data.Data) / data.Dim }
+func (data *Float16VectorFieldData) RowNum() int     { return len(data.Data) / 2 / data.Dim }
+func (data *BFloat16VectorFieldData) RowNum() int    { return len(data.Data) / 2 / data.Dim }
+func (data *SparseFloatVectorFieldData) RowNum() int { return len(data.Data) }
 
 // GetRow implements FieldData.GetRow
 func (data *BoolFieldData) GetRow(i int) interface{}   { return data.Data[i] }
@@ -178,6 +222,77 @@ func (data *BinaryVectorFieldData) GetRow(i int) interface{} {
 func (data *FloatVectorFieldData) GetRow(i int) interface{} {
 	return data.Data[i*data.Dim : (i+1)*data.Dim]
 }
+func (data *Float16VectorFieldData) GetRow(i int) interface{} {
+	return data.Data[i*data.Dim*2 : (i+1)*data.Dim*2]
+}
+func (data *BFloat16VectorFieldData) GetRow(i int) interface{} {
+	return data.Data[i*data.Dim*2 : (i+1)*data.Dim*2]
+}
+func (data *SparseFloatVectorFieldData) GetRow(i int) interface{} { return data.Data[i] }
+
+// GetValidData implements FieldData.GetValidData
+func (data *BoolFieldData) GetValidData() []bool              { return data.ValidData }
+func (data *Int8FieldData) GetValidData() []bool              { return data.ValidData }
+func (data *Int16FieldData) GetValidData() []bool             { return data.ValidData }
+func (data *Int32FieldData) GetValidData() []bool             { return data.ValidData }
+func (data *Int64FieldData) GetValidData() []bool             { return data.ValidData }
+func (data *FloatFieldData) GetValidData() []bool             { return data.ValidData }
+func (data *DoubleFieldData) GetValidData() []bool            { return data.ValidData }
+func (data *StringFieldData) GetValidData() []bool            { return data.ValidData }
+func (data *BinaryVectorFieldData) GetValidData() []bool      { return nil }
+func (data *FloatVectorFieldData) GetValidData() []bool       { return nil }
+func (data *Float16VectorFieldData) GetValidData() []bool     { return nil }
+func (data *BFloat16VectorFieldData) GetValidData() []bool    { return nil }
+func (data *SparseFloatVectorFieldData) GetValidData() []bool { return nil }
+
+// SetValidData sets the validity of a scalar field data, it's a no-op for vector field data.
+func SetValidData(fieldData FieldData, validData []bool) {
+	switch data := fieldData.(type) {
+	case *BoolFieldData:
+		data.ValidData = validData
+	case *Int8FieldData:
+		data.ValidData = validData
+	case *Int16FieldData:
+		data.ValidData = validData
+	case *Int32FieldData:
+		data.ValidData = validData
+	case *Int64FieldData:
+		data.ValidData = validData
+	case *FloatFieldData:
+		data.ValidData = validData
+	case *DoubleFieldData:
+		data.ValidData = validData
+	case *StringFieldData:
+		data.ValidData = validData
+	}
+}
+
+// encodeValidData packs the validity into a little-endian bitmap in base64 format.
+func encodeValidData(validData []bool) string {
+	bitmap := make([]byte, (len(validData)+7)/8)
+	for i, valid := range validData {
+		if valid {
+			bitmap[i/8] |= 1 << (i % 8)
+		}
+	}
+	return base64.StdEncoding.EncodeToString(bitmap)
+}
+
+// decodeValidData unpacks the validity of numRows rows encoded by encodeValidData.
+func decodeValidData(encoded string, numRows int) ([]bool, error) {
+	bitmap, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(bitmap) != (numRows+7)/8 {
+		return nil, fmt.Errorf("the size of valid data bitmap %d mismatches the row number %d", len(bitmap), numRows)
+	}
+	validData := make([]bool, numRows)
+	for i := range validData {
+		validData[i] = bitmap[i/8]&(1<<(i%8)) != 0
+	}
+	return validData, nil
+}
 
 // why not binary.Size(data) directly? binary.Size(data) return -1
 // binary.Size returns how many bytes Write would generate to encode the value v, which
@@ -186,39 +301,39 @@ func (data *FloatVectorFieldData) GetRow(i int) interface{} {
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *BoolFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *Int8FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *Int16FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *Int32FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *Int64FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *FloatFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *DoubleFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *StringFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *BinaryVectorFieldData) GetMemorySize() int {
@@ -229,6 +344,22 @@ func (data *FloatVectorFieldData) GetMemorySize() int {
 	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.Dim)
 }
 
+func (data *Float16VectorFieldData) GetMemorySize() int {
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.Dim)
+}
+
+func (data *BFloat16VectorFieldData) GetMemorySize() int {
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.Dim)
+}
+
+func (data *SparseFloatVectorFieldData) GetMemorySize() int {
+	size := binary.Size(data.NumRows) + binary.Size(data.Dim)
+	for _, row := range data.Data {
+		size += len(row)
+	}
+	return size
+}
+
 // system filed id:
 // 0: unique row id
 // 1: timestamp
@@ -296,12 +427,16 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		writer = NewInsertBinlogWriter(field.DataType, insertCodec.Schema.ID, partitionID, segmentID, field.FieldID)
 		var eventWriter *insertEventWriter
 		var err error
-		if typeutil.IsVectorType(field.DataType) {
+		if typeutil.IsDenseVectorType(field.DataType) {
 			switch field.DataType {
 			case schemapb.DataType_FloatVector:
 				eventWriter, err = writer.NextInsertEventWriter(singleData.(*FloatVectorFieldData).Dim)
 			case schemapb.DataType_BinaryVector:
 				eventWriter, err = writer.NextInsertEventWriter(singleData.(*BinaryVectorFieldData).Dim)
+			case typeutil.Float16VectorType:
+				eventWriter, err = writer.NextInsertEventWriter(singleData.(*Float16VectorFieldData).Dim)
+			case typeutil.BFloat16VectorType:
+				eventWriter, err = writer.NextInsertEventWriter(singleData.(*BFloat16VectorFieldData).Dim)
 			default:
 				return nil, nil, fmt.Errorf("undefined data type %d", field.DataType)
 			}
@@ -397,12 +532,39 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 				return nil, nil, err
 			}
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*FloatVectorFieldData).GetMemorySize()))
+		case typeutil.Float16VectorType:
+			err = eventWriter.AddFloat16VectorToPayload(singleData.(*Float16VectorFieldData).Data, singleData.(*Float16VectorFieldData).Dim)
+			if err != nil {
+				eventWriter.Close()
+				writer.Close()
+				return nil, nil, err
+			}
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Float16VectorFieldData).GetMemorySize()))
+		case typeutil.BFloat16VectorType:
+			err = eventWriter.AddBFloat16VectorToPayload(singleData.(*BFloat16VectorFieldData).Data, singleData.(*BFloat16VectorFieldData).Dim)
+			if err != nil {
+				eventWriter.Close()
+				writer.Close()
+				return nil, nil, err
+			}
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*BFloat16VectorFieldData).GetMemorySize()))
+		case typeutil.SparseFloatVectorType:
+			err = eventWriter.AddSparseFloatVectorToPayload(singleData.(*SparseFloatVectorFieldData).Data)
+			if err != nil {
+				eventWriter.Close()
+				writer.Close()
+				return nil, nil, err
+			}
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*SparseFloatVectorFieldData).GetMemorySize()))
 		default:
 			return nil, nil, fmt.Errorf("undefined data type %d", field.DataType)
 		}
 		if err != nil {
 			return nil, nil, err
 		}
+		if validData := singleData.GetValidData(); len(validData) > 0 {
+			writer.AddExtra(validDataKey, encodeValidData(validData))
+		}
 		writer.SetEventTimeStamp(typeutil.Timestamp(startTs), typeutil.Timestamp(endTs))
 
 		err = writer.Finish()
@@ -723,6 +885,85 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 				floatVectorFieldData.Dim = dim
 				insertData.Data[fieldID] = floatVectorFieldData
 
+			case typeutil.Float16VectorType:
+				var singleData []byte
+				singleData, dim, err = eventReader.GetFloat16VectorFromPayload()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+
+				if insertData.Data[fieldID] == nil {
+					insertData.Data[fieldID] = &Float16VectorFieldData{
+						NumRows: make([]int64, 0),
+						Data:    make([]byte, 0, rowNum*dim*2),
+					}
+				}
+				float16VectorFieldData := insertData.Data[fieldID].(*Float16VectorFieldData)
+
+				float16VectorFieldData.Data = append(float16VectorFieldData.Data, singleData...)
+				length, err := eventReader.GetPayloadLengthFromReader()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+				totalLength += length
+				float16VectorFieldData.NumRows = append(float16VectorFieldData.NumRows, int64(length))
+				float16VectorFieldData.Dim = dim
+				insertData.Data[fieldID] = float16VectorFieldData
+
+			case typeutil.BFloat16VectorType:
+				var singleData []byte
+				singleData, dim, err = eventReader.GetBFloat16VectorFromPayload()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+
+				if insertData.Data[fieldID] == nil {
+					insertData.Data[fieldID] = &BFloat16VectorFieldData{
+						NumRows: make([]int64, 0),
+						Data:    make([]byte, 0, rowNum*dim*2),
+					}
+				}
+				bfloat16VectorFieldData := insertData.Data[fieldID].(*BFloat16VectorFieldData)
+
+				bfloat16VectorFieldData.Data = append(bfloat16VectorFieldData.Data, singleData...)
+				length, err := eventReader.GetPayloadLengthFromReader()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+				totalLength += length
+				bfloat16VectorFieldData.NumRows = append(bfloat16VectorFieldData.NumRows, int64(length))
+				bfloat16VectorFieldData.Dim = dim
+				insertData.Data[fieldID] = bfloat16VectorFieldData
+
+			case typeutil.SparseFloatVectorType:
+				singleData, _, err := eventReader.GetSparseFloatVectorFromPayload()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+
+				if insertData.Data[fieldID] == nil {
+					insertData.Data[fieldID] = &SparseFloatVectorFieldData{
+						NumRows: make([]int64, 0),
+						Data:    make([][]byte, 0, rowNum),
+					}
+				}
+				sparseFloatVectorFieldData := insertData.Data[fieldID].(*SparseFloatVectorFieldData)
+
+				sparseFloatVectorFieldData.AppendAllRows(singleData)
+				totalLength += len(singleData)
+				sparseFloatVectorFieldData.NumRows = append(sparseFloatVectorFieldData.NumRows, int64(len(singleData)))
+				insertData.Data[fieldID] = sparseFloatVectorFieldData
+
 			default:
 				eventReader.Close()
 				binlogReader.Close()
@@ -731,6 +972,11 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 			eventReader.Close()
 		}
 
+		if err := appendBlobValidData(insertData.Data[fieldID], binlogReader.Extras, totalLength); err != nil {
+			binlogReader.Close()
+			return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+		}
+
 		if rowNum <= 0 {
 			rowNum = totalLength
 		}
@@ -747,6 +993,39 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 	return collectionID, partitionID, segmentID, nil
 }
 
+// appendBlobValidData appends the validity of numRows rows read from one binlog to the field data.
+// Binlogs written without validity are regarded as all valid once any binlog of the field has validity.
+func appendBlobValidData(fieldData FieldData, extras map[string]interface{}, numRows int) error {
+	if fieldData == nil {
+		return nil
+	}
+	validData := fieldData.GetValidData()
+	encoded, ok := extras[validDataKey]
+	if !ok {
+		if len(validData) > 0 {
+			for i := 0; i < numRows; i++ {
+				validData = append(validData, true)
+			}
+			SetValidData(fieldData, validData)
+		}
+		return nil
+	}
+	encodedStr, ok := encoded.(string)
+	if !ok {
+		return fmt.Errorf("value of %v must in string format", validDataKey)
+	}
+	blobValidData, err := decodeValidData(encodedStr, numRows)
+	if err != nil {
+		return err
+	}
+	// pad the rows read from previous binlogs without validity
+	for len(validData) < fieldData.RowNum()-numRows {
+		validData = append(validData, true)
+	}
+	SetValidData(fieldData, append(validData, blobValidData...))
+	return nil
+}
+
 // Deserialize transfer blob back to insert data.
 // From schema, it get all fields.
 // For each field, it will create a binlog reader, and read all event to the buffer.
@@ -24,6 +24,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 )
@@ -172,8 +173,9 @@ func TestInsertCodec(t *testing.T) {
 				Data:    []int32{3, 4},
 			},
 			Int64Field: &Int64FieldData{
-				NumRows: []int64{2},
-				Data:    []int64{3, 4},
+				NumRows:   []int64{2},
+				Data:      []int64{3, 4},
+				ValidData: []bool{false, true},
 			},
 			FloatField: &FloatFieldData{
 				NumRows: []int64{2},
@@ -239,8 +241,9 @@ func TestInsertCodec(t *testing.T) {
 				Data:    []float64{1, 2},
 			},
 			StringField: &StringFieldData{
-				NumRows: []int64{2},
-				Data:    []string{"1", "2"},
+				NumRows:   []int64{2},
+				Data:      []string{"1", "2"},
+				ValidData: []bool{true, false},
 			},
 			BinaryVectorField: &BinaryVectorFieldData{
 				NumRows: []int64{2},
@@ -257,16 +260,16 @@ func TestInsertCodec(t *testing.T) {
 
 	insertDataEmpty := &InsertData{
 		Data: map[int64]FieldData{
-			RowIDField:        &Int64FieldData{[]int64{}, []int64{}},
-			TimestampField:    &Int64FieldData{[]int64{}, []int64{}},
-			BoolField:         &BoolFieldData{[]int64{}, []bool{}},
-			Int8Field:         &Int8FieldData{[]int64{}, []int8{}},
-			Int16Field:        &Int16FieldData{[]int64{}, []int16{}},
-			Int32Field:        &Int32FieldData{[]int64{}, []int32{}},
-			Int64Field:        &Int64FieldData{[]int64{}, []int64{}},
-			FloatField:        &FloatFieldData{[]int64{}, []float32{}},
-			DoubleField:       &DoubleFieldData{[]int64{}, []float64{}},
-			StringField:       &StringFieldData{[]int64{}, []string{}},
+			RowIDField:        &Int64FieldData{[]int64{}, []int64{}, nil},
+			TimestampField:    &Int64FieldData{[]int64{}, []int64{}, nil},
+			BoolField:         &BoolFieldData{[]int64{}, []bool{}, nil},
+			Int8Field:         &Int8FieldData{[]int64{}, []int8{}, nil},
+			Int16Field:        &Int16FieldData{[]int64{}, []int16{}, nil},
+			Int32Field:        &Int32FieldData{[]int64{}, []int32{}, nil},
+			Int64Field:        &Int64FieldData{[]int64{}, []int64{}, nil},
+			FloatField:        &FloatFieldData{[]int64{}, []float32{}, nil},
+			DoubleField:       &DoubleFieldData{[]int64{}, []float64{}, nil},
+			StringField:       &StringFieldData{[]int64{}, []string{}, nil},
 			BinaryVectorField: &BinaryVectorFieldData{[]int64{}, []byte{}, 8},
 			FloatVectorField:  &FloatVectorFieldData{[]int64{}, []float32{}, 4},
 		},
@@ -316,6 +319,9 @@ func TestInsertCodec(t *testing.T) {
 	assert.Equal(t, []float32{1, 2, 3, 4}, resultData.Data[FloatField].(*FloatFieldData).Data)
 	assert.Equal(t, []float64{1, 2, 3, 4}, resultData.Data[DoubleField].(*DoubleFieldData).Data)
 	assert.Equal(t, []string{"1", "2", "3", "4"}, resultData.Data[StringField].(*StringFieldData).Data)
+	assert.Equal(t, []bool{true, true, false, true}, resultData.Data[Int64Field].GetValidData())
+	assert.Equal(t, []bool{true, false, true, true}, resultData.Data[StringField].GetValidData())
+	assert.Nil(t, resultData.Data[BoolField].GetValidData())
 	assert.Equal(t, []byte{0, 255, 0, 255}, resultData.Data[BinaryVectorField].(*BinaryVectorFieldData).Data)
 	assert.Equal(t, []float32{0, 1, 2, 3, 0, 1, 2, 3, 4, 5, 6, 7, 4, 5, 6, 7}, resultData.Data[FloatVectorField].(*FloatVectorFieldData).Data)
 	log.Debug("Data", zap.Any("Data", resultData.Data))
@@ -334,6 +340,113 @@ func TestInsertCodec(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestValidDataEncoding(t *testing.T) {
+	validData := []bool{true, false, false, true, true, false, true, false, true}
+	encoded := encodeValidData(validData)
+	decoded, err := decodeValidData(encoded, len(validData))
+	assert.Nil(t, err)
+	assert.Equal(t, validData, decoded)
+
+	_, err = decodeValidData(encoded, 20)
+	assert.NotNil(t, err)
+	_, err = decodeValidData("invalid base64", 1)
+	assert.NotNil(t, err)
+}
+
+func TestInsertCodecSparseFloatVector(t *testing.T) {
+	schema := &etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Name: "schema",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+				{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+				{FieldID: Int64Field, Name: "field_int64", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: FloatVectorField, Name: "field_sparse", DataType: typeutil.SparseFloatVectorType},
+			},
+		},
+	}
+	insertCodec := NewInsertCodec(schema)
+	rows := [][]byte{
+		typeutil.CreateSparseFloatRow([]uint32{2, 9}, []float32{0.2, 0.9}),
+		typeutil.CreateSparseFloatRow([]uint32{100}, []float32{1.0}),
+		{},
+	}
+	insertData := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:     &Int64FieldData{NumRows: []int64{3}, Data: []int64{3, 1, 2}},
+			TimestampField: &Int64FieldData{NumRows: []int64{3}, Data: []int64{3, 1, 2}},
+			Int64Field:     &Int64FieldData{NumRows: []int64{3}, Data: []int64{3, 1, 2}},
+			FloatVectorField: &SparseFloatVectorFieldData{
+				NumRows: []int64{3},
+				Data:    [][]byte{rows[2], rows[0], rows[1]},
+				Dim:     101,
+			},
+		},
+	}
+	blobs, _, err := insertCodec.Serialize(PartitionID, SegmentID, insertData)
+	assert.Nil(t, err)
+
+	_, _, resultData, err := insertCodec.Deserialize(blobs)
+	assert.Nil(t, err)
+	sparseData := resultData.Data[FloatVectorField].(*SparseFloatVectorFieldData)
+	assert.Equal(t, []int64{1, 2, 3}, resultData.Data[Int64Field].(*Int64FieldData).Data)
+	assert.Equal(t, rows, sparseData.Data)
+	assert.Equal(t, int64(101), sparseData.Dim)
+	assert.Equal(t, []int64{3}, sparseData.NumRows)
+
+	merged := MergeInsertData(resultData, resultData)
+	assert.Equal(t, 6, merged.Data[FloatVectorField].RowNum())
+	assert.Equal(t, int64(101), merged.Data[FloatVectorField].(*SparseFloatVectorFieldData).Dim)
+}
+
+func TestInsertCodecHalfFloatVector(t *testing.T) {
+	for _, dataType := range []schemapb.DataType{typeutil.Float16VectorType, typeutil.BFloat16VectorType} {
+		schema := &etcdpb.CollectionMeta{
+			ID: CollectionID,
+			Schema: &schemapb.CollectionSchema{
+				Name: "schema",
+				Fields: []*schemapb.FieldSchema{
+					{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+					{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+					{FieldID: Int64Field, Name: "field_int64", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+					{FieldID: FloatVectorField, Name: "field_half", DataType: dataType},
+				},
+			},
+		}
+		insertCodec := NewInsertCodec(schema)
+		vectors, err := typeutil.EncodeHalfFloatVector(dataType, []float32{0.5, 1.0, 1.5, 2.0, 2.5, 3.0})
+		assert.Nil(t, err)
+
+		var fieldData FieldData
+		if dataType == typeutil.Float16VectorType {
+			fieldData = &Float16VectorFieldData{NumRows: []int64{3}, Data: vectors, Dim: 2}
+		} else {
+			fieldData = &BFloat16VectorFieldData{NumRows: []int64{3}, Data: vectors, Dim: 2}
+		}
+		assert.Equal(t, 3, fieldData.RowNum())
+		assert.Equal(t, vectors[4:8], fieldData.GetRow(1))
+		insertData := &InsertData{
+			Data: map[int64]FieldData{
+				RowIDField:       &Int64FieldData{NumRows: []int64{3}, Data: []int64{1, 2, 3}},
+				TimestampField:   &Int64FieldData{NumRows: []int64{3}, Data: []int64{1, 2, 3}},
+				Int64Field:       &Int64FieldData{NumRows: []int64{3}, Data: []int64{1, 2, 3}},
+				FloatVectorField: fieldData,
+			},
+		}
+		blobs, _, err := insertCodec.Serialize(PartitionID, SegmentID, insertData)
+		assert.Nil(t, err)
+
+		_, _, resultData, err := insertCodec.Deserialize(blobs)
+		assert.Nil(t, err)
+		assert.Equal(t, fieldData, resultData.Data[FloatVectorField])
+
+		merged := MergeInsertData(resultData, resultData)
+		assert.Equal(t, 6, merged.Data[FloatVectorField].RowNum())
+		assert.Equal(t, vectors[:4], merged.Data[FloatVectorField].GetRow(3))
+	}
+}
+
 func TestDeleteCodec(t *testing.T) {
 	t.Run("int64 pk", func(t *testing.T) {
 		deleteCodec := NewDeleteCodec()
@@ -19,6 +19,7 @@ package storage
 import (
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // DataSorter sorts insert data
@@ -55,6 +56,9 @@ func (ds *DataSorter) Swap(i, j int) {
 		if !has {
 			continue
 		}
+		if validData := singleData.GetValidData(); len(validData) > 0 {
+			validData[i], validData[j] = validData[j], validData[i]
+		}
 		switch field.DataType {
 		case schemapb.DataType_Bool:
 			data := singleData.(*BoolFieldData).Data
@@ -94,6 +98,21 @@ func (ds *DataSorter) Swap(i, j int) {
 			for idx := 0; idx < dim; idx++ {
 				data[i*dim+idx], data[j*dim+idx] = data[j*dim+idx], data[i*dim+idx]
 			}
+		case typeutil.Float16VectorType:
+			data := singleData.(*Float16VectorFieldData).Data
+			steps := singleData.(*Float16VectorFieldData).Dim * 2
+			for idx := 0; idx < steps; idx++ {
+				data[i*steps+idx], data[j*steps+idx] = data[j*steps+idx], data[i*steps+idx]
+			}
+		case typeutil.BFloat16VectorType:
+			data := singleData.(*BFloat16VectorFieldData).Data
+			steps := singleData.(*BFloat16VectorFieldData).Dim * 2
+			for idx := 0; idx < steps; idx++ {
+				data[i*steps+idx], data[j*steps+idx] = data[j*steps+idx], data[i*steps+idx]
+			}
+		case typeutil.SparseFloatVectorType:
+			data := singleData.(*SparseFloatVectorFieldData).Data
+			data[i], data[j] = data[j], data[i]
 		default:
 			errMsg := "undefined data type " + string(field.DataType)
 			panic(errMsg)
@@ -31,6 +31,9 @@ import (
 
 const originalSizeKey = "original_size"
 
+// validDataKey is the extra key of the validity bitmap of a nullable field, see encodeValidData.
+const validDataKey = "valid_data"
+
 type descriptorEventData struct {
 	DescriptorEventDataFixPart
 	ExtraLength       int32
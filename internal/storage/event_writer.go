@@ -214,7 +214,7 @@ func newDescriptorEvent() *descriptorEvent {
 func newInsertEventWriter(dataType schemapb.DataType, dim ...int) (*insertEventWriter, error) {
 	var payloadWriter *PayloadWriter
 	var err error
-	if typeutil.IsVectorType(dataType) {
+	if typeutil.IsDenseVectorType(dataType) {
 		if len(dim) != 1 {
 			return nil, fmt.Errorf("incorrect input numbers")
 		}
@@ -12,11 +12,13 @@ type ChunkManagerFactory struct {
 	config            *config
 }
 
-func NewChunkManagerFactoryWithParam(params *paramtable.ComponentParam) *ChunkManagerFactory {
+// NewChunkManagerFactoryWithParam creates a ChunkManagerFactory by the storage config, the extra options
+// override the config, e.g. BucketName to access another bucket of the same storage.
+func NewChunkManagerFactoryWithParam(params *paramtable.ComponentParam, opts ...Option) *ChunkManagerFactory {
 	if params.CommonCfg.StorageType == "local" {
-		return NewChunkManagerFactory("local", RootPath(params.LocalStorageCfg.Path.GetValue()))
+		return NewChunkManagerFactory("local", append([]Option{RootPath(params.LocalStorageCfg.Path.GetValue())}, opts...)...)
 	}
-	return NewChunkManagerFactory("minio",
+	return NewChunkManagerFactory("minio", append([]Option{
 		RootPath(params.MinioCfg.RootPath.GetValue()),
 		Address(params.MinioCfg.Address.GetValue()),
 		AccessKeyID(params.MinioCfg.AccessKeyID.GetValue()),
@@ -26,7 +28,7 @@ func NewChunkManagerFactoryWithParam(params *paramtable.ComponentParam) *ChunkMa
 		UseIAM(params.MinioCfg.UseIAM.GetAsBool()),
 		CloudProvider(params.MinioCfg.CloudProvider.GetValue()),
 		IAMEndpoint(params.MinioCfg.IAMEndpoint.GetValue()),
-		CreateBucket(true))
+		CreateBucket(true)}, opts...)...)
 }
 
 func NewChunkManagerFactory(persistentStorage string, opts ...Option) *ChunkManagerFactory {
@@ -100,6 +100,30 @@ func (lcm *LocalChunkManager) Write(ctx context.Context, filePath string, conten
 	return ioutil.WriteFile(filePath, content, os.ModePerm)
 }
 
+// WriteFrom writes the data read from reader to local storage.
+func (lcm *LocalChunkManager) WriteFrom(ctx context.Context, filePath string, reader io.Reader, size int64) error {
+	dir := path.Dir(filePath)
+	exist, err := lcm.Exist(ctx, dir)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		err := os.MkdirAll(dir, os.ModePerm)
+		if err != nil {
+			return err
+		}
+	}
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.ModePerm)
+	if err != nil {
+		return err
+	}
+	_, err = io.CopyN(file, reader, size)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
 // MultiWrite writes the data to local storage.
 func (lcm *LocalChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
 	var el errorutil.ErrorList
@@ -17,6 +17,7 @@
 package storage
 
 import (
+	"bytes"
 	"context"
 	"path"
 	"path/filepath"
@@ -170,6 +171,31 @@ func TestLocalCM(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("test WriteFrom", func(t *testing.T) {
+		testWriteFromRoot := "test_write_from"
+
+		testCM := NewLocalChunkManager(RootPath(localPath))
+		defer testCM.RemoveWithPrefix(ctx, testCM.RootPath())
+
+		key := path.Join(localPath, testWriteFromRoot, "key_1")
+		err := testCM.WriteFrom(ctx, key, bytes.NewReader([]byte("111")), 3)
+		assert.Nil(t, err)
+		val, err := testCM.Read(ctx, key)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("111"), val)
+
+		// an existing file is overwritten
+		err = testCM.WriteFrom(ctx, key, bytes.NewReader([]byte("22")), 2)
+		assert.Nil(t, err)
+		val, err = testCM.Read(ctx, key)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("22"), val)
+
+		// the reader is shorter than the size
+		err = testCM.WriteFrom(ctx, key, bytes.NewReader([]byte("3")), 2)
+		assert.Error(t, err)
+	})
+
 	t.Run("test MultiSave", func(t *testing.T) {
 		testMultiSaveRoot := "test_multisave"
 
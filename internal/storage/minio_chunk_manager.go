@@ -196,6 +196,16 @@ func (mcm *MinioChunkManager) Write(ctx context.Context, filePath string, conten
 	return nil
 }
 
+// WriteFrom writes the data read from reader to minio storage, the object is uploaded in parts if it's large.
+func (mcm *MinioChunkManager) WriteFrom(ctx context.Context, filePath string, reader io.Reader, size int64) error {
+	_, err := mcm.Client.PutObject(ctx, mcm.bucketName, filePath, reader, size, minio.PutObjectOptions{})
+	if err != nil {
+		log.Warn("failed to put object", zap.String("path", filePath), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 // MultiWrite saves multiple objects, the path is the key of @kvs.
 // The object value is the value of @kvs.
 func (mcm *MinioChunkManager) MultiWrite(ctx context.Context, kvs map[string][]byte) error {
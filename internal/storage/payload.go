@@ -47,6 +47,9 @@ type PayloadWriterInterface interface {
 	AddOneStringToPayload(msgs string) error
 	AddBinaryVectorToPayload(binVec []byte, dim int) error
 	AddFloatVectorToPayload(binVec []float32, dim int) error
+	AddFloat16VectorToPayload(data []byte, dim int) error
+	AddBFloat16VectorToPayload(data []byte, dim int) error
+	AddSparseFloatVectorToPayload(rows [][]byte) error
 	FinishPayloadWriter() error
 	GetPayloadBufferFromWriter() ([]byte, error)
 	GetPayloadLengthFromWriter() (int, error)
@@ -68,6 +71,9 @@ type PayloadReaderInterface interface {
 	GetStringFromPayload() ([]string, error)
 	GetBinaryVectorFromPayload() ([]byte, int, error)
 	GetFloatVectorFromPayload() ([]float32, int, error)
+	GetFloat16VectorFromPayload() ([]byte, int, error)
+	GetBFloat16VectorFromPayload() ([]byte, int, error)
+	GetSparseFloatVectorFromPayload() ([][]byte, int, error)
 	GetPayloadLengthFromReader() (int, error)
 	ReleasePayloadReader()
 	Close()
@@ -82,7 +88,7 @@ type PayloadWriter struct {
 // NewPayloadWriter is constructor of PayloadWriter
 func NewPayloadWriter(colType schemapb.DataType, dim ...int) (*PayloadWriter, error) {
 	var w C.CPayloadWriter
-	if typeutil.IsVectorType(colType) {
+	if typeutil.IsDenseVectorType(colType) {
 		if len(dim) != 1 {
 			return nil, fmt.Errorf("incorrect input numbers")
 		}
@@ -149,6 +155,12 @@ func (w *PayloadWriter) AddDataToPayload(msgs interface{}, dim ...int) error {
 				return errors.New("incorrect data type")
 			}
 			return w.AddOneStringToPayload(val)
+		case typeutil.SparseFloatVectorType:
+			val, ok := msgs.([][]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddSparseFloatVectorToPayload(val)
 		default:
 			return errors.New("incorrect datatype")
 		}
@@ -166,6 +178,18 @@ func (w *PayloadWriter) AddDataToPayload(msgs interface{}, dim ...int) error {
 				return errors.New("incorrect data type")
 			}
 			return w.AddFloatVectorToPayload(val, dim[0])
+		case typeutil.Float16VectorType:
+			val, ok := msgs.([]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddFloat16VectorToPayload(val, dim[0])
+		case typeutil.BFloat16VectorType:
+			val, ok := msgs.([]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddBFloat16VectorToPayload(val, dim[0])
 		default:
 			return errors.New("incorrect datatype")
 		}
@@ -325,6 +349,64 @@ func (w *PayloadWriter) AddFloatVectorToPayload(floatVec []float32, dim int) err
 	return HandleCStatus(&status, "AddFloatVectorToPayload failed")
 }
 
+// AddFloat16VectorToPayload adds float16 vectors into payload, each element takes 2 bytes, dimension > 0
+func (w *PayloadWriter) AddFloat16VectorToPayload(data []byte, dim int) error {
+	length := len(data)
+	if length <= 0 {
+		return errors.New("can't add empty float16 vector into payload")
+	}
+	if dim <= 0 {
+		return errors.New("dimension should be greater than 0")
+	}
+
+	cVec := (*C.uint8_t)(&data[0])
+	cDim := C.int(dim)
+	cLength := C.int(length / (dim * 2))
+
+	status := C.AddFloat16VectorToPayload(w.payloadWriterPtr, cVec, cDim, cLength)
+	return HandleCStatus(&status, "AddFloat16VectorToPayload failed")
+}
+
+// AddBFloat16VectorToPayload adds bfloat16 vectors into payload, each element takes 2 bytes, dimension > 0
+func (w *PayloadWriter) AddBFloat16VectorToPayload(data []byte, dim int) error {
+	length := len(data)
+	if length <= 0 {
+		return errors.New("can't add empty bfloat16 vector into payload")
+	}
+	if dim <= 0 {
+		return errors.New("dimension should be greater than 0")
+	}
+
+	cVec := (*C.uint8_t)(&data[0])
+	cDim := C.int(dim)
+	cLength := C.int(length / (dim * 2))
+
+	status := C.AddBFloat16VectorToPayload(w.payloadWriterPtr, cVec, cDim, cLength)
+	return HandleCStatus(&status, "AddBFloat16VectorToPayload failed")
+}
+
+// AddSparseFloatVectorToPayload adds sparse float vector rows into payload, each row is a variable length binary
+func (w *PayloadWriter) AddSparseFloatVectorToPayload(rows [][]byte) error {
+	if len(rows) <= 0 {
+		return errors.New("can't add empty rows into payload")
+	}
+	if err := typeutil.ValidateSparseFloatRows(rows...); err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		var cRow *C.uint8_t
+		if len(row) > 0 {
+			cRow = (*C.uint8_t)(&row[0])
+		}
+		status := C.AddOneSparseFloatVectorToPayload(w.payloadWriterPtr, cRow, C.int(len(row)))
+		if err := HandleCStatus(&status, "AddOneSparseFloatVectorToPayload failed"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (w *PayloadWriter) FinishPayloadWriter() error {
 	status := C.FinishPayloadWriter(w.payloadWriterPtr)
 	return HandleCStatus(&status, "FinishPayloadWriter failed")
@@ -10,6 +10,7 @@ import (
 	"github.com/apache/arrow/go/v8/parquet/file"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // PayloadReader reads data from payload
@@ -63,9 +64,15 @@ func (r *PayloadReader) GetDataFromPayload() (interface{}, int, error) {
 		return r.GetBinaryVectorFromPayload()
 	case schemapb.DataType_FloatVector:
 		return r.GetFloatVectorFromPayload()
+	case typeutil.Float16VectorType:
+		return r.GetFloat16VectorFromPayload()
+	case typeutil.BFloat16VectorType:
+		return r.GetBFloat16VectorFromPayload()
 	case schemapb.DataType_String, schemapb.DataType_VarChar:
 		val, err := r.GetStringFromPayload()
 		return val, 0, err
+	case typeutil.SparseFloatVectorType:
+		return r.GetSparseFloatVectorFromPayload()
 	default:
 		return nil, 0, errors.New("unknown type")
 	}
@@ -302,6 +309,65 @@ func (r *PayloadReader) GetFloatVectorFromPayload() ([]float32, int, error) {
 	return ret, dim, nil
 }
 
+// GetFloat16VectorFromPayload returns vector, dimension, error
+func (r *PayloadReader) GetFloat16VectorFromPayload() ([]byte, int, error) {
+	if r.colType != typeutil.Float16VectorType {
+		return nil, -1, fmt.Errorf("failed to get float16 vector from datatype %v", r.colType.String())
+	}
+	return r.getHalfFloatVectorFromPayload()
+}
+
+// GetBFloat16VectorFromPayload returns vector, dimension, error
+func (r *PayloadReader) GetBFloat16VectorFromPayload() ([]byte, int, error) {
+	if r.colType != typeutil.BFloat16VectorType {
+		return nil, -1, fmt.Errorf("failed to get bfloat16 vector from datatype %v", r.colType.String())
+	}
+	return r.getHalfFloatVectorFromPayload()
+}
+
+func (r *PayloadReader) getHalfFloatVectorFromPayload() ([]byte, int, error) {
+	rowBytes := r.reader.RowGroup(0).Column(0).Descriptor().TypeLength()
+	values := make([]parquet.FixedLenByteArray, r.numRows)
+	valuesRead, err := ReadDataFromAllRowGroups[parquet.FixedLenByteArray, *file.FixedLenByteArrayColumnChunkReader](r.reader, values, 0, r.numRows)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	if valuesRead != r.numRows {
+		return nil, -1, fmt.Errorf("expect %d rows, but got valuesRead = %d", r.numRows, valuesRead)
+	}
+
+	ret := make([]byte, int64(rowBytes)*r.numRows)
+	for i := 0; i < int(r.numRows); i++ {
+		copy(ret[i*rowBytes:(i+1)*rowBytes], values[i])
+	}
+	return ret, rowBytes / 2, nil
+}
+
+// GetSparseFloatVectorFromPayload returns sparse float vector rows, the max dimension of rows, error
+func (r *PayloadReader) GetSparseFloatVectorFromPayload() ([][]byte, int, error) {
+	if !typeutil.IsSparseFloatVectorType(r.colType) {
+		return nil, -1, fmt.Errorf("failed to get sparse float vector from datatype %v", r.colType.String())
+	}
+
+	values := make([]parquet.ByteArray, r.numRows)
+	valuesRead, err := ReadDataFromAllRowGroups[parquet.ByteArray, *file.ByteArrayColumnChunkReader](r.reader, values, 0, r.numRows)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	if valuesRead != r.numRows {
+		return nil, -1, fmt.Errorf("expect %d rows, but got valuesRead = %d", r.numRows, valuesRead)
+	}
+
+	ret := make([][]byte, r.numRows)
+	for i := 0; i < int(r.numRows); i++ {
+		ret[i] = make([]byte, len(values[i]))
+		copy(ret[i], values[i])
+	}
+	return ret, int(typeutil.SparseFloatRowsDim(ret)), nil
+}
+
 func (r *PayloadReader) GetPayloadLengthFromReader() (int, error) {
 	return int(r.numRows), nil
 }
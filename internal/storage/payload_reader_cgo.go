@@ -15,6 +15,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // PayloadReaderCgo reads data from payload
@@ -68,9 +69,15 @@ func (r *PayloadReaderCgo) GetDataFromPayload() (interface{}, int, error) {
 		return r.GetBinaryVectorFromPayload()
 	case schemapb.DataType_FloatVector:
 		return r.GetFloatVectorFromPayload()
+	case typeutil.Float16VectorType:
+		return r.GetFloat16VectorFromPayload()
+	case typeutil.BFloat16VectorType:
+		return r.GetBFloat16VectorFromPayload()
 	case schemapb.DataType_String:
 		val, err := r.GetStringFromPayload()
 		return val, 0, err
+	case typeutil.SparseFloatVectorType:
+		return r.GetSparseFloatVectorFromPayload()
 	default:
 		return nil, 0, errors.New("unknown type")
 	}
@@ -293,6 +300,69 @@ func (r *PayloadReaderCgo) GetFloatVectorFromPayload() ([]float32, int, error) {
 	return slice, int(cDim), nil
 }
 
+// GetFloat16VectorFromPayload returns vector, dimension, error
+func (r *PayloadReaderCgo) GetFloat16VectorFromPayload() ([]byte, int, error) {
+	if r.colType != typeutil.Float16VectorType {
+		return nil, 0, errors.New("incorrect data type")
+	}
+
+	var cMsg *C.uint8_t
+	var cDim C.int
+	var cLen C.int
+
+	status := C.GetFloat16VectorFromPayload(r.payloadReaderPtr, &cMsg, &cDim, &cLen)
+	if err := HandleCStatus(&status, "GetFloat16VectorFromPayload failed"); err != nil {
+		return nil, 0, err
+	}
+	length := cDim * 2 * cLen
+
+	slice := (*[1 << 28]byte)(unsafe.Pointer(cMsg))[:length:length]
+	return slice, int(cDim), nil
+}
+
+// GetBFloat16VectorFromPayload returns vector, dimension, error
+func (r *PayloadReaderCgo) GetBFloat16VectorFromPayload() ([]byte, int, error) {
+	if r.colType != typeutil.BFloat16VectorType {
+		return nil, 0, errors.New("incorrect data type")
+	}
+
+	var cMsg *C.uint8_t
+	var cDim C.int
+	var cLen C.int
+
+	status := C.GetBFloat16VectorFromPayload(r.payloadReaderPtr, &cMsg, &cDim, &cLen)
+	if err := HandleCStatus(&status, "GetBFloat16VectorFromPayload failed"); err != nil {
+		return nil, 0, err
+	}
+	length := cDim * 2 * cLen
+
+	slice := (*[1 << 28]byte)(unsafe.Pointer(cMsg))[:length:length]
+	return slice, int(cDim), nil
+}
+
+// GetSparseFloatVectorFromPayload returns sparse float vector rows, the max dimension of rows, error
+func (r *PayloadReaderCgo) GetSparseFloatVectorFromPayload() ([][]byte, int, error) {
+	if !typeutil.IsSparseFloatVectorType(r.colType) {
+		return nil, 0, errors.New("incorrect data type")
+	}
+
+	length, err := r.GetPayloadLengthFromReader()
+	if err != nil {
+		return nil, 0, err
+	}
+	ret := make([][]byte, length)
+	for i := 0; i < length; i++ {
+		var cMsg *C.uint8_t
+		var cLen C.int
+		status := C.GetOneSparseFloatVectorFromPayload(r.payloadReaderPtr, C.int(i), &cMsg, &cLen)
+		if err := HandleCStatus(&status, "GetOneSparseFloatVectorFromPayload failed"); err != nil {
+			return nil, 0, err
+		}
+		ret[i] = C.GoBytes(unsafe.Pointer(cMsg), cLen)
+	}
+	return ret, int(typeutil.SparseFloatRowsDim(ret)), nil
+}
+
 func (r *PayloadReaderCgo) GetPayloadLengthFromReader() (int, error) {
 	length := C.GetPayloadLengthFromReader(r.payloadReaderPtr)
 	return int(length), nil
@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func TestPayload_ReaderAndWriter(t *testing.T) {
@@ -422,6 +423,109 @@ func TestPayload_ReaderAndWriter(t *testing.T) {
 		defer r.ReleasePayloadReader()
 	})
 
+	t.Run("TestHalfFloatVector", func(t *testing.T) {
+		for _, dataType := range []schemapb.DataType{typeutil.Float16VectorType, typeutil.BFloat16VectorType} {
+			w, err := NewPayloadWriter(dataType, 2)
+			require.Nil(t, err)
+			require.NotNil(t, w)
+
+			vectors, err := typeutil.EncodeHalfFloatVector(dataType, []float32{1.0, 2.0, 3.0, 4.0, 5.0, 6.0})
+			require.Nil(t, err)
+			if dataType == typeutil.Float16VectorType {
+				err = w.AddFloat16VectorToPayload(vectors[:8], 2)
+			} else {
+				err = w.AddBFloat16VectorToPayload(vectors[:8], 2)
+			}
+			assert.Nil(t, err)
+			err = w.AddDataToPayload(vectors[8:], 2)
+			assert.Nil(t, err)
+			err = w.AddDataToPayload([]float32{1.0, 2.0}, 2)
+			assert.NotNil(t, err)
+			err = w.FinishPayloadWriter()
+			assert.Nil(t, err)
+
+			length, err := w.GetPayloadLengthFromWriter()
+			assert.Nil(t, err)
+			assert.Equal(t, 3, length)
+
+			buffer, err := w.GetPayloadBufferFromWriter()
+			assert.Nil(t, err)
+
+			r, err := NewPayloadReader(dataType, buffer)
+			require.Nil(t, err)
+			length, err = r.GetPayloadLengthFromReader()
+			assert.Nil(t, err)
+			assert.Equal(t, 3, length)
+
+			var halfVecs []byte
+			var dim int
+			if dataType == typeutil.Float16VectorType {
+				halfVecs, dim, err = r.GetFloat16VectorFromPayload()
+				_, _, wrongErr := r.GetBFloat16VectorFromPayload()
+				assert.NotNil(t, wrongErr)
+			} else {
+				halfVecs, dim, err = r.GetBFloat16VectorFromPayload()
+				_, _, wrongErr := r.GetFloat16VectorFromPayload()
+				assert.NotNil(t, wrongErr)
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, 2, dim)
+			assert.Equal(t, vectors, halfVecs)
+
+			iHalfVecs, dim, err := r.GetDataFromPayload()
+			assert.Nil(t, err)
+			assert.Equal(t, 2, dim)
+			assert.Equal(t, vectors, iHalfVecs.([]byte))
+			r.ReleasePayloadReader()
+			w.ReleasePayloadWriter()
+		}
+	})
+
+	t.Run("TestSparseFloatVector", func(t *testing.T) {
+		w, err := NewPayloadWriter(typeutil.SparseFloatVectorType)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		rows := [][]byte{
+			typeutil.CreateSparseFloatRow([]uint32{1, 10}, []float32{0.1, 1.0}),
+			{},
+			typeutil.CreateSparseFloatRow([]uint32{3}, []float32{0.3}),
+		}
+		err = w.AddSparseFloatVectorToPayload(rows[:2])
+		assert.Nil(t, err)
+		err = w.AddDataToPayload(rows[2:])
+		assert.Nil(t, err)
+		err = w.AddSparseFloatVectorToPayload([][]byte{{1, 2, 3}})
+		assert.NotNil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.Nil(t, err)
+
+		length, err := w.GetPayloadLengthFromWriter()
+		assert.Nil(t, err)
+		assert.Equal(t, 3, length)
+		defer w.ReleasePayloadWriter()
+
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+
+		r, err := NewPayloadReader(typeutil.SparseFloatVectorType, buffer)
+		require.Nil(t, err)
+		length, err = r.GetPayloadLengthFromReader()
+		assert.Nil(t, err)
+		assert.Equal(t, 3, length)
+
+		sparseVecs, dim, err := r.GetSparseFloatVectorFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, 11, dim)
+		assert.Equal(t, rows, sparseVecs)
+
+		iSparseVecs, dim, err := r.GetDataFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, 11, dim)
+		assert.Equal(t, rows, iSparseVecs.([][]byte))
+		defer r.ReleasePayloadReader()
+	})
+
 	t.Run("TestAddDataToPayload", func(t *testing.T) {
 		w, err := NewPayloadWriter(schemapb.DataType_Bool)
 		w.colType = 999
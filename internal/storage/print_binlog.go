@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // PrintBinlogFiles call printBinlogFile in turn for the file list specified by parameter fileList.
@@ -86,6 +87,15 @@ func printBinlogFile(filename string) error {
 	physical, _ = tsoutil.ParseTS(r.descriptorEvent.descriptorEventData.EndTimestamp)
 	fmt.Printf("\tEndTimestamp: %v\n", physical)
 	dataTypeName, ok := schemapb.DataType_name[int32(r.descriptorEvent.descriptorEventData.PayloadDataType)]
+	if typeutil.IsSparseFloatVectorType(r.descriptorEvent.descriptorEventData.PayloadDataType) {
+		dataTypeName, ok = "SparseFloatVector", true
+	}
+	switch r.descriptorEvent.descriptorEventData.PayloadDataType {
+	case typeutil.Float16VectorType:
+		dataTypeName, ok = "Float16Vector", true
+	case typeutil.BFloat16VectorType:
+		dataTypeName, ok = "BFloat16Vector", true
+	}
 	if !ok {
 		return fmt.Errorf("undefine data type %d", r.descriptorEvent.descriptorEventData.PayloadDataType)
 	}
@@ -319,6 +329,43 @@ func printPayloadValues(colType schemapb.DataType, reader PayloadReaderInterface
 			}
 			fmt.Println()
 		}
+	case typeutil.Float16VectorType, typeutil.BFloat16VectorType:
+		var val []byte
+		var dim int
+		var err error
+		if colType == typeutil.Float16VectorType {
+			val, dim, err = reader.GetFloat16VectorFromPayload()
+		} else {
+			val, dim, err = reader.GetBFloat16VectorFromPayload()
+		}
+		if err != nil {
+			return err
+		}
+		vectors, err := typeutil.DecodeHalfFloatVector(colType, val)
+		if err != nil {
+			return err
+		}
+		length := len(vectors) / dim
+		for i := 0; i < length; i++ {
+			fmt.Printf("\t\t%d :", i)
+			for j := 0; j < dim; j++ {
+				idx := i*dim + j
+				fmt.Printf(" %f", vectors[idx])
+			}
+			fmt.Println()
+		}
+	case typeutil.SparseFloatVectorType:
+		val, _, err := reader.GetSparseFloatVectorFromPayload()
+		if err != nil {
+			return err
+		}
+		for i, row := range val {
+			fmt.Printf("\t\t%d :", i)
+			for j := 0; j < typeutil.SparseFloatRowElementCount(row); j++ {
+				fmt.Printf(" %d:%f", typeutil.SparseFloatRowIndexAt(row, j), typeutil.SparseFloatRowValueAt(row, j))
+			}
+			fmt.Println()
+		}
 	default:
 		return errors.New("undefined data type")
 	}
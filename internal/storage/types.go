@@ -35,6 +35,8 @@ type ChunkManager interface {
 	Size(ctx context.Context, filePath string) (int64, error)
 	// Write writes @content to @filePath.
 	Write(ctx context.Context, filePath string, content []byte) error
+	// WriteFrom writes @size bytes read from @reader to @filePath.
+	WriteFrom(ctx context.Context, filePath string, reader io.Reader, size int64) error
 	// MultiWrite writes multi @content to @filePath.
 	MultiWrite(ctx context.Context, contents map[string][]byte) error
 	// Exist returns true if @filePath exists.
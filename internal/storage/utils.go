@@ -306,6 +306,12 @@ func RowBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *schemap
 
 	for _, field := range collSchema.Fields {
 		switch field.DataType {
+		case typeutil.SparseFloatVectorType:
+			return nil, fmt.Errorf("sparse float vector field %s is not supported by row based insert", field.GetName())
+
+		case typeutil.Float16VectorType, typeutil.BFloat16VectorType:
+			return nil, fmt.Errorf("half precision vector field %s is not supported by row based insert", field.GetName())
+
 		case schemapb.DataType_FloatVector:
 			dim, err := GetDimFromParams(field.TypeParams)
 			if err != nil {
@@ -407,6 +413,16 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 	}
 
 	for _, field := range collSchema.Fields {
+		if _, ok := srcFields[field.FieldID]; !ok && field.FieldID >= common.StartOfUserFieldID && typeutil.IsFieldOptional(field) {
+			// the msg is produced before the field is added to the collection
+			fieldData, err := GenDefaultFieldData(field, int(msg.NRows()))
+			if err != nil {
+				return nil, err
+			}
+			idata.Data[field.FieldID] = fieldData
+			continue
+		}
+
 		switch field.DataType {
 		case schemapb.DataType_FloatVector:
 			dim, err := GetDimFromParams(field.TypeParams)
@@ -444,6 +460,42 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 
 			idata.Data[field.FieldID] = fieldData
 
+		case typeutil.Float16VectorType:
+			dim, err := GetDimFromParams(field.TypeParams)
+			if err != nil {
+				log.Error("failed to get dim", zap.Error(err))
+				return nil, err
+			}
+
+			srcData := srcFields[field.FieldID].GetVectors().GetBinaryVector()
+
+			fieldData := &Float16VectorFieldData{
+				NumRows: []int64{int64(msg.NRows())},
+				Data:    make([]byte, 0, len(srcData)),
+				Dim:     dim,
+			}
+			fieldData.Data = append(fieldData.Data, srcData...)
+
+			idata.Data[field.FieldID] = fieldData
+
+		case typeutil.BFloat16VectorType:
+			dim, err := GetDimFromParams(field.TypeParams)
+			if err != nil {
+				log.Error("failed to get dim", zap.Error(err))
+				return nil, err
+			}
+
+			srcData := srcFields[field.FieldID].GetVectors().GetBinaryVector()
+
+			fieldData := &BFloat16VectorFieldData{
+				NumRows: []int64{int64(msg.NRows())},
+				Data:    make([]byte, 0, len(srcData)),
+				Dim:     dim,
+			}
+			fieldData.Data = append(fieldData.Data, srcData...)
+
+			idata.Data[field.FieldID] = fieldData
+
 		case schemapb.DataType_Bool:
 			srcData := srcFields[field.FieldID].GetScalars().GetBoolData().GetData()
 
@@ -550,6 +602,19 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 
 			fieldData.Data = append(fieldData.Data, srcData...)
 			idata.Data[field.FieldID] = fieldData
+
+		case typeutil.SparseFloatVectorType:
+			fieldData := &SparseFloatVectorFieldData{
+				NumRows: []int64{int64(msg.NRows())},
+			}
+			fieldData.AppendAllRows(typeutil.GetSparseFloatVectorRows(srcFields[field.FieldID]))
+			idata.Data[field.FieldID] = fieldData
+		}
+	}
+
+	for _, validData := range msg.ValidData {
+		if fieldData, ok := idata.Data[validData.GetFieldId()]; ok {
+			SetValidData(fieldData, append([]bool{}, validData.GetValidData()...))
 		}
 	}
 
@@ -695,11 +760,80 @@ func mergeFloatVectorField(data *InsertData, fid FieldID, field *FloatVectorFiel
 	fieldData.NumRows[0] += int64(field.RowNum())
 }
 
+func mergeFloat16VectorField(data *InsertData, fid FieldID, field *Float16VectorFieldData) {
+	if _, ok := data.Data[fid]; !ok {
+		fieldData := &Float16VectorFieldData{
+			NumRows: []int64{0},
+			Data:    nil,
+			Dim:     field.Dim,
+		}
+		data.Data[fid] = fieldData
+	}
+	fieldData := data.Data[fid].(*Float16VectorFieldData)
+	fieldData.Data = append(fieldData.Data, field.Data...)
+	fieldData.NumRows[0] += int64(field.RowNum())
+}
+
+func mergeBFloat16VectorField(data *InsertData, fid FieldID, field *BFloat16VectorFieldData) {
+	if _, ok := data.Data[fid]; !ok {
+		fieldData := &BFloat16VectorFieldData{
+			NumRows: []int64{0},
+			Data:    nil,
+			Dim:     field.Dim,
+		}
+		data.Data[fid] = fieldData
+	}
+	fieldData := data.Data[fid].(*BFloat16VectorFieldData)
+	fieldData.Data = append(fieldData.Data, field.Data...)
+	fieldData.NumRows[0] += int64(field.RowNum())
+}
+
+func mergeSparseFloatVectorField(data *InsertData, fid FieldID, field *SparseFloatVectorFieldData) {
+	if _, ok := data.Data[fid]; !ok {
+		fieldData := &SparseFloatVectorFieldData{
+			NumRows: []int64{0},
+			Data:    nil,
+		}
+		data.Data[fid] = fieldData
+	}
+	fieldData := data.Data[fid].(*SparseFloatVectorFieldData)
+	fieldData.AppendAllRows(field.Data)
+	fieldData.NumRows[0] += int64(field.RowNum())
+}
+
+// mergeValidData appends the validity of field to dst which held preRows rows before merging,
+// rows without validity are regarded as valid.
+func mergeValidData(dst FieldData, preRows int, field FieldData) {
+	dstValidData := dst.GetValidData()
+	srcValidData := field.GetValidData()
+	if len(dstValidData) == 0 && len(srcValidData) == 0 {
+		return
+	}
+	for len(dstValidData) < preRows {
+		dstValidData = append(dstValidData, true)
+	}
+	if len(srcValidData) > 0 {
+		dstValidData = append(dstValidData, srcValidData...)
+	} else {
+		for i := 0; i < field.RowNum(); i++ {
+			dstValidData = append(dstValidData, true)
+		}
+	}
+	SetValidData(dst, dstValidData)
+}
+
 // MergeFieldData merge field into data.
 func MergeFieldData(data *InsertData, fid FieldID, field FieldData) {
 	if field == nil {
 		return
 	}
+	preRows := 0
+	if fieldData, ok := data.Data[fid]; ok {
+		preRows = fieldData.RowNum()
+	}
+	defer func() {
+		mergeValidData(data.Data[fid], preRows, field)
+	}()
 	switch field := field.(type) {
 	case *BoolFieldData:
 		mergeBoolField(data, fid, field)
@@ -721,6 +855,12 @@ func MergeFieldData(data *InsertData, fid FieldID, field FieldData) {
 		mergeBinaryVectorField(data, fid, field)
 	case *FloatVectorFieldData:
 		mergeFloatVectorField(data, fid, field)
+	case *Float16VectorFieldData:
+		mergeFloat16VectorField(data, fid, field)
+	case *BFloat16VectorFieldData:
+		mergeBFloat16VectorField(data, fid, field)
+	case *SparseFloatVectorFieldData:
+		mergeSparseFloatVectorField(data, fid, field)
 	}
 }
 
@@ -834,6 +974,10 @@ func FieldDataToBytes(endian binary.ByteOrder, fieldData FieldData) ([]byte, err
 		return field.Data, nil
 	case *FloatVectorFieldData:
 		return binaryWrite(endian, field.Data)
+	case *Float16VectorFieldData:
+		return field.Data, nil
+	case *BFloat16VectorFieldData:
+		return field.Data, nil
 	case *Int8FieldData:
 		return binaryWrite(endian, field.Data)
 	case *Int16FieldData:
@@ -1004,12 +1148,46 @@ func TransferInsertDataToInsertRecord(insertData *InsertData) (*segcorepb.Insert
 					},
 				},
 			}
+		case *Float16VectorFieldData:
+			fieldData = &schemapb.FieldData{
+				Type:    typeutil.Float16VectorType,
+				FieldId: fieldID,
+				Field: &schemapb.FieldData_Vectors{
+					Vectors: &schemapb.VectorField{
+						Data: &schemapb.VectorField_BinaryVector{
+							BinaryVector: rawData.Data,
+						},
+						Dim: int64(rawData.Dim),
+					},
+				},
+			}
+		case *BFloat16VectorFieldData:
+			fieldData = &schemapb.FieldData{
+				Type:    typeutil.BFloat16VectorType,
+				FieldId: fieldID,
+				Field: &schemapb.FieldData_Vectors{
+					Vectors: &schemapb.VectorField{
+						Data: &schemapb.VectorField_BinaryVector{
+							BinaryVector: rawData.Data,
+						},
+						Dim: int64(rawData.Dim),
+					},
+				},
+			}
+		case *SparseFloatVectorFieldData:
+			fieldData = typeutil.GenSparseFloatVectorFieldData("", fieldID, rawData.Data)
 		default:
 			return insertRecord, fmt.Errorf("unsupported data type when transter storage.InsertData to internalpb.InsertRecord")
 		}
 
 		insertRecord.FieldsData = append(insertRecord.FieldsData, fieldData)
 		insertRecord.NumRows = int64(rawData.RowNum())
+		if validData := rawData.GetValidData(); len(validData) > 0 {
+			insertRecord.ValidData = append(insertRecord.ValidData, &segcorepb.FieldValidData{
+				FieldId:   fieldID,
+				ValidData: validData,
+			})
+		}
 	}
 
 	return insertRecord, nil
@@ -1030,6 +1208,120 @@ func TransferInsertMsgToInsertRecord(schema *schemapb.CollectionSchema, msg *msg
 	}
 
 	insertRecord.FieldsData = append(insertRecord.FieldsData, msg.FieldsData...)
+	insertRecord.ValidData = append(insertRecord.ValidData, msg.ValidData...)
+
+	// fill the fields added to the collection after the msg is produced
+	existFields := make(map[FieldID]struct{}, len(msg.FieldsData))
+	for _, fieldData := range msg.FieldsData {
+		existFields[fieldData.GetFieldId()] = struct{}{}
+	}
+	for _, field := range schema.GetFields() {
+		if _, ok := existFields[field.GetFieldID()]; ok || field.GetFieldID() < common.StartOfUserFieldID || !typeutil.IsFieldOptional(field) {
+			continue
+		}
+		fieldData, err := typeutil.GenDefaultFieldData(field, int(msg.NumRows))
+		if err != nil {
+			return nil, err
+		}
+		insertRecord.FieldsData = append(insertRecord.FieldsData, fieldData)
+		if typeutil.IsFieldNullable(field) {
+			_, hasDefault := typeutil.GetFieldDefaultValue(field)
+			insertRecord.ValidData = append(insertRecord.ValidData, typeutil.GenValidData(field.GetFieldID(), int(msg.NumRows), hasDefault))
+		}
+	}
 
 	return insertRecord, nil
 }
+
+// GenDefaultFieldData generates a column of numRows rows for the nullable or default-valued field,
+// the rows hold the default value, and are marked null if the field has no default value.
+func GenDefaultFieldData(field *schemapb.FieldSchema, numRows int) (FieldData, error) {
+	if !typeutil.IsFieldOptional(field) {
+		return nil, fmt.Errorf("field %s is neither nullable nor has a default value", field.GetName())
+	}
+	value, err := typeutil.ParseFieldDefaultValue(field)
+	if err != nil {
+		return nil, err
+	}
+
+	numOfRows := []int64{int64(numRows)}
+	var fieldData FieldData
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		data := make([]bool, numRows)
+		for i := range data {
+			data[i] = value.(bool)
+		}
+		fieldData = &BoolFieldData{NumRows: numOfRows, Data: data}
+	case schemapb.DataType_Int8:
+		data := make([]int8, numRows)
+		for i := range data {
+			data[i] = int8(value.(int32))
+		}
+		fieldData = &Int8FieldData{NumRows: numOfRows, Data: data}
+	case schemapb.DataType_Int16:
+		data := make([]int16, numRows)
+		for i := range data {
+			data[i] = int16(value.(int32))
+		}
+		fieldData = &Int16FieldData{NumRows: numOfRows, Data: data}
+	case schemapb.DataType_Int32:
+		data := make([]int32, numRows)
+		for i := range data {
+			data[i] = value.(int32)
+		}
+		fieldData = &Int32FieldData{NumRows: numOfRows, Data: data}
+	case schemapb.DataType_Int64:
+		data := make([]int64, numRows)
+		for i := range data {
+			data[i] = value.(int64)
+		}
+		fieldData = &Int64FieldData{NumRows: numOfRows, Data: data}
+	case schemapb.DataType_Float:
+		data := make([]float32, numRows)
+		for i := range data {
+			data[i] = value.(float32)
+		}
+		fieldData = &FloatFieldData{NumRows: numOfRows, Data: data}
+	case schemapb.DataType_Double:
+		data := make([]float64, numRows)
+		for i := range data {
+			data[i] = value.(float64)
+		}
+		fieldData = &DoubleFieldData{NumRows: numOfRows, Data: data}
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		data := make([]string, numRows)
+		for i := range data {
+			data[i] = value.(string)
+		}
+		fieldData = &StringFieldData{NumRows: numOfRows, Data: data}
+	default:
+		return nil, fmt.Errorf("unsupported data type %s of field %s", field.GetDataType().String(), field.GetName())
+	}
+
+	if typeutil.IsFieldNullable(field) {
+		_, hasDefault := typeutil.GetFieldDefaultValue(field)
+		SetValidData(fieldData, typeutil.GenValidData(field.GetFieldID(), numRows, hasDefault).GetValidData())
+	}
+	return fieldData, nil
+}
+
+// FillMissingFields materialises the default value of the nullable or default-valued fields absent from the insert data,
+// which happens when the data is written before the fields are added to the collection.
+func FillMissingFields(collSchema *schemapb.CollectionSchema, data *InsertData) error {
+	rowIDData, ok := data.Data[common.RowIDField]
+	if !ok {
+		return nil
+	}
+	for _, field := range collSchema.GetFields() {
+		if _, ok := data.Data[field.GetFieldID()]; ok || !typeutil.IsFieldOptional(field) {
+			continue
+		}
+		fieldData, err := GenDefaultFieldData(field, rowIDData.RowNum())
+		if err != nil {
+			return err
+		}
+		data.Data[field.GetFieldID()] = fieldData
+	}
+	return nil
+}
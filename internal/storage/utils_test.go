@@ -1011,6 +1011,148 @@ func TestMergeInsertData(t *testing.T) {
 	assert.Equal(t, []float32{0, 0}, f.(*FloatVectorFieldData).Data)
 }
 
+func TestMergeInsertDataValidData(t *testing.T) {
+	d1 := &InsertData{
+		Data: map[int64]FieldData{
+			Int64Field: &Int64FieldData{
+				NumRows: []int64{2},
+				Data:    []int64{1, 2},
+			},
+			StringField: &StringFieldData{
+				NumRows:   []int64{1},
+				Data:      []string{""},
+				ValidData: []bool{false},
+			},
+		},
+	}
+	d2 := &InsertData{
+		Data: map[int64]FieldData{
+			Int64Field: &Int64FieldData{
+				NumRows:   []int64{1},
+				Data:      []int64{0},
+				ValidData: []bool{false},
+			},
+			StringField: &StringFieldData{
+				NumRows: []int64{1},
+				Data:    []string{"1"},
+			},
+		},
+	}
+
+	merged := MergeInsertData(d1, d2)
+	assert.Equal(t, []bool{true, true, false}, merged.Data[Int64Field].GetValidData())
+	assert.Equal(t, []bool{false, true}, merged.Data[StringField].GetValidData())
+}
+
+func TestGenDefaultFieldData(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		FieldID:    101,
+		Name:       "level",
+		DataType:   schemapb.DataType_Int16,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "3"}},
+	}
+	fieldData, err := GenDefaultFieldData(field, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int16{3, 3}, fieldData.(*Int16FieldData).Data)
+	assert.Nil(t, fieldData.GetValidData())
+
+	field.TypeParams = append(field.TypeParams, &commonpb.KeyValuePair{Key: common.NullableKey, Value: "true"})
+	fieldData, err = GenDefaultFieldData(field, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, true}, fieldData.GetValidData())
+
+	field = &schemapb.FieldSchema{
+		FieldID:    102,
+		Name:       "tag",
+		DataType:   schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+	}
+	fieldData, err = GenDefaultFieldData(field, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"", ""}, fieldData.(*StringFieldData).Data)
+	assert.Equal(t, []bool{false, false}, fieldData.GetValidData())
+
+	_, err = GenDefaultFieldData(&schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int64}, 2)
+	assert.Error(t, err)
+}
+
+func TestFillMissingFields(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{
+				FieldID:    101,
+				Name:       "score",
+				DataType:   schemapb.DataType_Double,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "0.5"}},
+			},
+		},
+	}
+	data := &InsertData{
+		Data: map[FieldID]FieldData{
+			common.RowIDField: &Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+			100:               &Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+		},
+	}
+	err := FillMissingFields(schema, data)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{0.5, 0.5}, data.Data[101].(*DoubleFieldData).Data)
+
+	// fill nothing without row id
+	data = &InsertData{Data: map[FieldID]FieldData{}}
+	err = FillMissingFields(schema, data)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(data.Data))
+}
+
+func TestInsertMsgWithAddedField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{
+				FieldID:    101,
+				Name:       "tag",
+				DataType:   schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+			},
+		},
+	}
+	msg := &msgstream.InsertMsg{
+		InsertRequest: internalpb.InsertRequest{
+			RowIDs:     []int64{1, 2},
+			Timestamps: []uint64{1, 1},
+			NumRows:    2,
+			Version:    internalpb.InsertDataVersion_ColumnBased,
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:    schemapb.DataType_Int64,
+					FieldId: 100,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	insertData, err := InsertMsgToInsertData(msg, schema)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"", ""}, insertData.Data[101].(*StringFieldData).Data)
+	assert.Equal(t, []bool{false, false}, insertData.Data[101].GetValidData())
+
+	insertRecord, err := TransferInsertMsgToInsertRecord(schema, msg)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(insertRecord.GetFieldsData()))
+	assert.Equal(t, int64(101), insertRecord.GetValidData()[0].GetFieldId())
+	assert.Equal(t, []bool{false, false}, insertRecord.GetValidData()[0].GetValidData())
+	assert.Equal(t, 1, len(msg.GetFieldsData()))
+}
+
 func TestGetPkFromInsertData(t *testing.T) {
 	var nilSchema *schemapb.CollectionSchema
 	_, err := GetPkFromInsertData(nilSchema, nil)
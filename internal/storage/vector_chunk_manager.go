@@ -137,6 +137,11 @@ func (vcm *VectorChunkManager) Write(ctx context.Context, filePath string, conte
 	return vcm.vectorStorage.Write(ctx, filePath, content)
 }
 
+// WriteFrom writes the vector data read from reader to vector storage.
+func (vcm *VectorChunkManager) WriteFrom(ctx context.Context, filePath string, reader io.Reader, size int64) error {
+	return vcm.vectorStorage.WriteFrom(ctx, filePath, reader, size)
+}
+
 // MultiWrite writes the vector data to local cache if cache enabled.
 func (vcm *VectorChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
 	return vcm.vectorStorage.MultiWrite(ctx, contents)
@@ -91,6 +91,24 @@ type DataNode interface {
 	// error is always nil
 	Import(ctx context.Context, req *datapb.ImportTaskRequest) (*commonpb.Status, error)
 
+	// Export rows of flushed segments at a timestamp into files(json, numpy, parquet) on MinIO/S3 storage
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the segments, the target path and file type
+	//
+	// Return status indicates if this operation is processed successfully or fail cause;
+	// error is always nil
+	Export(ctx context.Context, req *datapb.ExportTaskRequest) (*commonpb.Status, error)
+
+	// CancelImport stops the import worker of a task on the DataNode
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the task id
+	//
+	// Return status indicates if this operation is processed successfully or fail cause;
+	// error is always nil
+	CancelImport(ctx context.Context, req *datapb.CancelImportTaskRequest) (*commonpb.Status, error)
+
 	// ResendSegmentStats resend un-flushed segment stats back upstream to DataCoord by resending DataNode time tick message.
 	// It returns a list of segments to be sent.
 	ResendSegmentStats(ctx context.Context, req *datapb.ResendSegmentStatsRequest) (*datapb.ResendSegmentStatsResponse, error)
@@ -311,6 +329,25 @@ type DataCoord interface {
 	// error is always nil
 	Import(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error)
 
+	// Export picks an idle DataNode and sends the export task with the flushed segments of the collection to it
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the collection, partitions and timestamp
+	//
+	// The `Status` in response struct `ExportTaskResponse` indicates if this operation is processed successfully or fail cause;
+	// the `datanode_id` in `ExportTaskResponse` return the DataNode which takes the task.
+	// error is always nil
+	Export(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error)
+
+	// CancelImport forwards the cancel request of an import task to the DataNode which processes the task
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the task id and the DataNode id
+	//
+	// Return status indicates if this operation is processed successfully or fail cause;
+	// error is always nil
+	CancelImport(ctx context.Context, req *datapb.CancelImportTaskRequest) (*commonpb.Status, error)
+
 	// UpdateSegmentStatistics updates a segment's stats.
 	UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error)
 	// UpdateChannelCheckpoint updates channel checkpoint in dataCoord.
@@ -756,6 +793,53 @@ type RootCoord interface {
 	// error is always nil
 	ReportImport(ctx context.Context, req *rootcoordpb.ImportResult) (*commonpb.Status, error)
 
+	// CancelImport cancels an import task, the pending task is removed from the queue and the working task is stopped,
+	// the segments of the task are cleaned up
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including a task id
+	//
+	// response status contains the status/error code and failing reason if any error is returned
+	// error is always nil
+	CancelImport(ctx context.Context, req *rootcoordpb.CancelImportRequest) (*commonpb.Status, error)
+
+	// Export writes rows of a collection at a timestamp into files on MinIO/S3 storage
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including collection name, file type and target path
+	//
+	// The `Status` in response struct `ExportResponse` indicates if this operation is processed successfully or fail cause;
+	// the `task_id` in `ExportResponse` return the id of the export task.
+	// error is always nil
+	Export(ctx context.Context, req *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error)
+
+	// GetExportState returns the state and progress of an export task
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including a task id
+	//
+	// The `Status` in response struct `GetExportStateResponse` indicates if this operation is processed successfully or fail cause;
+	// error is always nil
+	GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error)
+
+	// ListExportTasks returns the states of the latest export tasks
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including an optional collection name and a limit
+	//
+	// The `Status` in response struct `ListExportTasksResponse` indicates if this operation is processed successfully or fail cause;
+	// error is always nil
+	ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error)
+
+	// ReportExport reports export task state to rootCoord
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the export results, including exported row count, segments and files
+	//
+	// response status contains the status/error code and failing reason if any error is returned
+	// error is always nil
+	ReportExport(ctx context.Context, req *rootcoordpb.ExportResult) (*commonpb.Status, error)
+
 	// CreateCredential create new user and password
 	CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error)
 	// UpdateCredential update password for a user
@@ -1285,6 +1369,34 @@ type ProxyComponent interface {
 	// error is always nil
 	ListImportTasks(ctx context.Context, req *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error)
 
+	// Export rows of a collection at a timestamp into files(json, numpy, parquet) on MinIO/S3 storage
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including collection name, file type and target path
+	//
+	// The `Status` in response struct `ExportResponse` indicates if this operation is processed successfully or fail cause;
+	// the `task_id` in `ExportResponse` return the id of the export task.
+	// error is always nil
+	Export(ctx context.Context, req *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error)
+
+	// GetExportState returns the state and progress of an export task
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including a task id
+	//
+	// The `Status` in response struct `GetExportStateResponse` indicates if this operation is processed successfully or fail cause;
+	// error is always nil
+	GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error)
+
+	// ListExportTasks returns the states of the latest export tasks
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including an optional collection name and a limit
+	//
+	// The `Status` in response struct `ListExportTasksResponse` indicates if this operation is processed successfully or fail cause;
+	// error is always nil
+	ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error)
+
 	GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error)
 
 	// CreateCredential create new user and password
@@ -1338,6 +1450,8 @@ type QueryNode interface {
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 	GetDataDistribution(context.Context, *querypb.GetDataDistributionRequest) (*querypb.GetDataDistributionResponse, error)
 	SyncDistribution(context.Context, *querypb.SyncDistributionRequest) (*commonpb.Status, error)
+	// UpdateCollectionSchema updates the schema of the loaded collection and its segments with the appended fields.
+	UpdateCollectionSchema(ctx context.Context, req *querypb.UpdateCollectionSchemaRequest) (*commonpb.Status, error)
 }
 
 // QueryNodeComponent is used by grpc server of QueryNode
@@ -1376,6 +1490,9 @@ type QueryCoord interface {
 	GetShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error)
 
 	CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
+
+	// UpdateCollectionSchema pushes the schema with appended fields to the QueryNodes which load the collection.
+	UpdateCollectionSchema(ctx context.Context, req *querypb.UpdateCollectionSchemaRequest) (*commonpb.Status, error)
 }
 
 // QueryCoordComponent is used by grpc server of QueryCoord
@@ -27,6 +27,12 @@ const (
 	MetaStoreTypeEtcd  = "etcd"
 	MetaStoreTypeMysql = "mysql"
 
+	MetaDBDriverMysql  = "mysql"
+	MetaDBDriverSqlite = "sqlite"
+
+	EmbedEtcdEngineEtcd = "etcd"
+	EmbedEtcdEngineBolt = "bolt"
+
 	SegmentMetaPrefix    = "queryCoord-segmentMeta"
 	ChangeInfoMetaPrefix = "queryCoord-sealedSegmentChangeInfo"
 
@@ -52,6 +58,14 @@ const (
 	AnyWord       = "*"
 )
 
+// MQ type consts, the values of mq.type
+const (
+	MQTypeRocksmq = "rocksmq"
+	MQTypePulsar  = "pulsar"
+	MQTypeKafka   = "kafka"
+	MQTypeNatsmq  = "natsmq"
+)
+
 const (
 	// ParamsKeyToParse is the key of the param to build index.
 	ParamsKeyToParse = "params"
@@ -2,9 +2,16 @@ package dependency
 
 import (
 	"context"
+	"fmt"
 
+	"go.uber.org/zap"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
@@ -12,6 +19,8 @@ type DefaultFactory struct {
 	standAlone          bool
 	chunkManagerFactory storage.Factory
 	msgStreamFactory    msgstream.Factory
+	compression         msgstream.CompressionConfig
+	deadLetters         *msgstream.DeadLetterStore
 }
 
 // Only for test
@@ -31,7 +40,8 @@ func NewFactory(standAlone bool) *DefaultFactory {
 // Init create a msg factory(TODO only support one mq at the same time.)
 // In order to guarantee backward compatibility of config file, we still support multiple mq configs.
 // 1. Rocksmq only run on local mode, and it has the highest priority
-// 2. Pulsar has higher priority than Kafka within remote msg
+// 2. Pulsar has higher priority than Kafka, Kafka has higher priority than NATS within remote msg
+// The priority is ignored if mq.type is specified.
 func (f *DefaultFactory) Init(params *paramtable.ComponentParam) {
 	// skip if using default factory
 	if f.msgStreamFactory != nil {
@@ -39,19 +49,108 @@ func (f *DefaultFactory) Init(params *paramtable.ComponentParam) {
 	}
 
 	f.chunkManagerFactory = storage.NewChunkManagerFactoryWithParam(params)
+	f.msgStreamFactory = f.initMQ(params)
+	f.initCompression(params)
+	f.initPoisonPolicy(params)
+}
+
+func (f *DefaultFactory) initMQ(params *paramtable.ComponentParam) msgstream.Factory {
+	if mqType := params.MQCfg.Type.GetValue(); mqType != "" {
+		return f.initMQByType(mqType, params)
+	}
 
 	// init mq storage
 	if f.standAlone {
-		f.msgStreamFactory = f.initMQLocalService(params)
-		if f.msgStreamFactory != nil {
-			return
+		if factory := f.initMQLocalService(params); factory != nil {
+			return factory
 		}
 	}
 
-	f.msgStreamFactory = f.initMQRemoteService(params)
-	if f.msgStreamFactory == nil {
-		panic("no available remote mq configuration, must config Pulsar or Kafka at least one of these!")
+	factory := f.initMQRemoteService(params)
+	if factory == nil {
+		panic("no available remote mq configuration, must config Pulsar, Kafka or NATS at least one of these!")
+	}
+	return factory
+}
+
+// initCompression enables the compression of DML payloads.
+// Rocksmq messages have no properties to tell whether they are compressed, so they are never compressed.
+func (f *DefaultFactory) initCompression(params *paramtable.ComponentParam) {
+	compression, err := msgstream.NewCompressionConfig(&params.MQCfg)
+	if err != nil {
+		panic(err)
+	}
+	if _, ok := f.msgStreamFactory.(*msgstream.RmsFactory); ok && compression.Type != "" {
+		log.Warn("mq compression is not supported by rocksmq, ignored", zap.String("type", string(compression.Type)))
+		return
+	}
+	f.compression = compression
+}
+
+// initPoisonPolicy decides what the consumers do with the messages they can't unmarshal.
+// With the quarantine policy they are recorded under the meta root, and can be listed and replayed by the management endpoints.
+// Rocksmq drops the message properties which restrict a replayed message to one subscription, so it can't replay them.
+func (f *DefaultFactory) initPoisonPolicy(params *paramtable.ComponentParam) {
+	policy, err := msgstream.CheckPoisonPolicy(&params.MQCfg)
+	if err != nil {
+		panic(err)
+	}
+	if policy != msgstream.PoisonPolicyQuarantine {
+		return
+	}
+	cli, err := etcd.GetEtcdClient(&params.EtcdCfg)
+	if err != nil {
+		panic(fmt.Sprintf("failed to connect etcd for dead letters, err = %s", err.Error()))
+	}
+	f.deadLetters = msgstream.NewDeadLetterStore(etcdkv.NewEtcdKV(cli, params.EtcdCfg.MetaRootPath.GetValue()))
+	var replayFactory msgstream.Factory = f
+	if _, ok := f.msgStreamFactory.(*msgstream.RmsFactory); ok {
+		log.Warn("dead letter replay is not supported by rocksmq, quarantined messages can only be listed")
+		replayFactory = nil
 	}
+	msgstream.RegisterDeadLetterHandlers(f.deadLetters, replayFactory)
+}
+
+// withStreamOptions sets the compression and the dead letter store of the created msgstream
+func (f *DefaultFactory) withStreamOptions(stream msgstream.MsgStream, err error) (msgstream.MsgStream, error) {
+	if err != nil {
+		return nil, err
+	}
+	if s, ok := stream.(msgstream.Compressible); ok {
+		s.SetCompression(f.compression)
+	}
+	if s, ok := stream.(msgstream.DeadLetterRecorder); ok && f.deadLetters != nil {
+		s.SetDeadLetterStore(f.deadLetters)
+	}
+	return stream, nil
+}
+
+// initMQByType creates the msg factory of the specified mq type
+func (f *DefaultFactory) initMQByType(mqType string, params *paramtable.ComponentParam) msgstream.Factory {
+	switch mqType {
+	case util.MQTypeRocksmq:
+		if !f.standAlone {
+			panic("rocksmq is only supported in standalone mode")
+		}
+		if factory := f.initMQLocalService(params); factory != nil {
+			return factory
+		}
+	case util.MQTypePulsar:
+		if params.PulsarEnable() {
+			return msgstream.NewPmsFactory(&params.PulsarCfg)
+		}
+	case util.MQTypeKafka:
+		if params.KafkaEnable() {
+			return msgstream.NewKmsFactory(&params.KafkaCfg)
+		}
+	case util.MQTypeNatsmq:
+		if params.NatsmqEnable() {
+			return msgstream.NewNmsFactory(&params.NatsmqCfg)
+		}
+	default:
+		panic(fmt.Sprintf("unsupported mq type '%s', must be one of rocksmq, pulsar, kafka and natsmq", mqType))
+	}
+	panic(fmt.Sprintf("mq type is %s but the %s configuration is not available", mqType, mqType))
 }
 
 func (f *DefaultFactory) initMQLocalService(params *paramtable.ComponentParam) msgstream.Factory {
@@ -65,7 +164,7 @@ func (f *DefaultFactory) initMQLocalService(params *paramtable.ComponentParam) m
 	return nil
 }
 
-// initRemoteService Pulsar has higher priority than Kafka.
+// initRemoteService Pulsar has higher priority than Kafka, Kafka has higher priority than NATS.
 func (f *DefaultFactory) initMQRemoteService(params *paramtable.ComponentParam) msgstream.Factory {
 	if params.PulsarEnable() {
 		return msgstream.NewPmsFactory(&params.PulsarCfg)
@@ -75,19 +174,23 @@ func (f *DefaultFactory) initMQRemoteService(params *paramtable.ComponentParam)
 		return msgstream.NewKmsFactory(&params.KafkaCfg)
 	}
 
+	if params.NatsmqEnable() {
+		return msgstream.NewNmsFactory(&params.NatsmqCfg)
+	}
+
 	return nil
 }
 
 func (f *DefaultFactory) NewMsgStream(ctx context.Context) (msgstream.MsgStream, error) {
-	return f.msgStreamFactory.NewMsgStream(ctx)
+	return f.withStreamOptions(f.msgStreamFactory.NewMsgStream(ctx))
 }
 
 func (f *DefaultFactory) NewTtMsgStream(ctx context.Context) (msgstream.MsgStream, error) {
-	return f.msgStreamFactory.NewTtMsgStream(ctx)
+	return f.withStreamOptions(f.msgStreamFactory.NewTtMsgStream(ctx))
 }
 
 func (f *DefaultFactory) NewQueryMsgStream(ctx context.Context) (msgstream.MsgStream, error) {
-	return f.msgStreamFactory.NewQueryMsgStream(ctx)
+	return f.withStreamOptions(f.msgStreamFactory.NewQueryMsgStream(ctx))
 }
 
 func (f *DefaultFactory) NewMsgStreamDisposer(ctx context.Context) func([]string, string) error {
@@ -20,6 +20,8 @@ import (
 	"errors"
 	"strings"
 	"sync"
+
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 const (
@@ -278,3 +280,45 @@ func CalcTanimotoCoefficient(dim int64, hamming []int32) ([]float32, error) {
 
 	return array, nil
 }
+
+////////////////////////////////////////////////////////////////////////////////
+
+// CalcSparseFloatDistance calculate the distance between sparse float vectors by given metric,
+// each vector is a row encoded as typeutil.CreateSparseFloatRow does, only IP is supported
+func CalcSparseFloatDistance(left, right [][]byte, metric string) ([]float32, error) {
+	if strings.ToUpper(metric) != IP {
+		err := errors.New("invalid metric type, only IP is supported for sparse float vector")
+		return nil, err
+	}
+
+	if len(left) == 0 || len(right) == 0 {
+		err := errors.New("invalid sparse float vector length")
+		return nil, err
+	}
+
+	if err := typeutil.ValidateSparseFloatRows(left...); err != nil {
+		return nil, err
+	}
+
+	if err := typeutil.ValidateSparseFloatRows(right...); err != nil {
+		return nil, err
+	}
+
+	distArray := make([]float32, len(left)*len(right))
+
+	// Multi-threads to calculate distance. TODO: avoid too many go routines
+	var waitGroup sync.WaitGroup
+	CalcWorker := func(index int) {
+		for i := range right {
+			distArray[index*len(right)+i] = typeutil.SparseFloatRowInnerProduct(left[index], right[i])
+		}
+		waitGroup.Done()
+	}
+	for i := range left {
+		waitGroup.Add(1)
+		go CalcWorker(i)
+	}
+	waitGroup.Wait()
+
+	return distArray, nil
+}
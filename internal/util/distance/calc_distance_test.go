@@ -23,6 +23,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 const PRECISION = 1e-6
@@ -289,3 +291,28 @@ func Test_CalcTanimotoCoefficient(t *testing.T) {
 	_, err = CalcTanimotoCoefficient(3, hamming)
 	assert.Error(t, err)
 }
+
+func Test_CalcSparseFloatDistance(t *testing.T) {
+	left := [][]byte{
+		typeutil.CreateSparseFloatRow([]uint32{1, 3}, []float32{1, 2}),
+		typeutil.CreateSparseFloatRow([]uint32{}, []float32{}),
+	}
+	right := [][]byte{
+		typeutil.CreateSparseFloatRow([]uint32{3, 7}, []float32{4, 5}),
+		typeutil.CreateSparseFloatRow([]uint32{1, 3, 7}, []float32{1, 1, 1}),
+		typeutil.CreateSparseFloatRow([]uint32{2}, []float32{6}),
+	}
+
+	distances, err := CalcSparseFloatDistance(left, right, "ip")
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{8, 3, 0, 0, 0, 0}, distances)
+
+	_, err = CalcSparseFloatDistance(left, right, L2)
+	assert.Error(t, err)
+
+	_, err = CalcSparseFloatDistance(nil, right, IP)
+	assert.Error(t, err)
+
+	_, err = CalcSparseFloatDistance(left, [][]byte{{1, 2, 3}}, IP)
+	assert.Error(t, err)
+}
@@ -3,7 +3,9 @@ package etcd
 import (
 	"sync"
 
+	boltkv "github.com/milvus-io/milvus/internal/kv/bolt"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/server/v3/embed"
@@ -16,10 +18,14 @@ var (
 	initOnce   sync.Once
 	closeOnce  sync.Once
 	etcdServer *embed.Etcd
+	localStore *boltkv.Store
 )
 
 // GetEmbedEtcdClient returns client of embed etcd server
 func GetEmbedEtcdClient() (*clientv3.Client, error) {
+	if localStore != nil {
+		return boltkv.NewClient(localStore), nil
+	}
 	client := v3client.New(etcdServer.Server)
 	return client, nil
 }
@@ -29,6 +35,18 @@ func InitEtcdServer(etcdCfg *paramtable.EtcdConfig) error {
 	if etcdCfg.UseEmbedEtcd.GetAsBool() {
 		var initError error
 		initOnce.Do(func() {
+			if etcdCfg.EmbedEngine.GetValue() == util.EmbedEtcdEngineBolt {
+				file := boltkv.FilePath(etcdCfg.DataDir.GetValue())
+				s, err := boltkv.OpenShared(file)
+				if err != nil {
+					log.Error("failed to open embedded meta store", zap.String("path", file), zap.Error(err))
+					initError = err
+					return
+				}
+				localStore = s
+				log.Info("finish init embedded meta store", zap.String("path", file))
+				return
+			}
 			path := etcdCfg.ConfigPath.GetValue()
 			var cfg *embed.Config
 			if len(path) > 0 {
@@ -58,6 +76,11 @@ func InitEtcdServer(etcdCfg *paramtable.EtcdConfig) error {
 
 // StopEtcdServer stops embedded etcd server singleton.
 func StopEtcdServer() {
+	if localStore != nil {
+		closeOnce.Do(func() {
+			localStore.Close()
+		})
+	}
 	if etcdServer != nil {
 		closeOnce.Do(func() {
 			etcdServer.Close()
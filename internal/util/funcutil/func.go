@@ -42,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // CheckGrpcReady wait for context timeout, or wait 100ms then send nil to targetCh
@@ -186,7 +187,7 @@ func CheckCtxValid(ctx context.Context) bool {
 func GetVecFieldIDs(schema *schemapb.CollectionSchema) []int64 {
 	var vecFieldIDs []int64
 	for _, field := range schema.Fields {
-		if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
+		if typeutil.IsDenseVectorType(field.DataType) {
 			vecFieldIDs = append(vecFieldIDs, field.FieldID)
 		}
 	}
@@ -300,6 +301,17 @@ func getNumRowsOfBinaryVectorField(bDatas []byte, dim int64) (uint64, error) {
 	return uint64((8 * int64(l)) / dim), nil
 }
 
+func getNumRowsOfHalfFloatVectorField(bDatas []byte, dim int64) (uint64, error) {
+	if dim <= 0 {
+		return 0, fmt.Errorf("dim(%d) should be greater than 0", dim)
+	}
+	l := len(bDatas)
+	if int64(l)%(2*dim) != 0 {
+		return 0, fmt.Errorf("the length(%d) of half float data should divide twice the dim(%d)", l, dim)
+	}
+	return uint64(int64(l) / (2 * dim)), nil
+}
+
 // GetNumRowOfFieldData return num rows of the field data
 func GetNumRowOfFieldData(fieldData *schemapb.FieldData) (uint64, error) {
 	var fieldNumRows uint64
@@ -320,6 +332,8 @@ func GetNumRowOfFieldData(fieldData *schemapb.FieldData) (uint64, error) {
 			fieldNumRows = getNumRowsOfScalarField(scalarField.GetDoubleData().Data)
 		case *schemapb.ScalarField_StringData:
 			fieldNumRows = getNumRowsOfScalarField(scalarField.GetStringData().Data)
+		case *schemapb.ScalarField_BytesData:
+			fieldNumRows = getNumRowsOfScalarField(scalarField.GetBytesData().Data)
 		default:
 			return 0, fmt.Errorf("%s is not supported now", scalarType)
 		}
@@ -334,7 +348,11 @@ func GetNumRowOfFieldData(fieldData *schemapb.FieldData) (uint64, error) {
 			}
 		case *schemapb.VectorField_BinaryVector:
 			dim := vectorField.GetDim()
-			fieldNumRows, err = getNumRowsOfBinaryVectorField(vectorField.GetBinaryVector(), dim)
+			if typeutil.IsHalfFloatVectorType(fieldData.GetType()) {
+				fieldNumRows, err = getNumRowsOfHalfFloatVectorField(vectorField.GetBinaryVector(), dim)
+			} else {
+				fieldNumRows, err = getNumRowsOfBinaryVectorField(vectorField.GetBinaryVector(), dim)
+			}
 			if err != nil {
 				return 0, err
 			}
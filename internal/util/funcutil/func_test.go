@@ -30,6 +30,8 @@ import (
 	"github.com/jarcoal/httpmock"
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
@@ -404,6 +406,44 @@ func TestGetNumRowsOfBinaryVectorField(t *testing.T) {
 	}
 }
 
+func TestGetNumRowsOfHalfFloatVectorField(t *testing.T) {
+	cases := []struct {
+		bDatas   []byte
+		dim      int64
+		want     uint64
+		errIsNil bool
+	}{
+		{[]byte{}, 0, 0, false},        // dim <= 0
+		{[]byte{1, 2, 3}, 1, 0, false}, // length % (2*dim) != 0
+		{[]byte{}, 128, 0, true},
+		{[]byte{1, 2, 3, 4}, 1, 2, true},
+		{[]byte{1, 2, 3, 4}, 2, 1, true},
+	}
+
+	for _, test := range cases {
+		got, err := getNumRowsOfHalfFloatVectorField(test.bDatas, test.dim)
+		if test.errIsNil {
+			assert.Equal(t, nil, err)
+			assert.Equal(t, test.want, got)
+		} else {
+			assert.NotEqual(t, nil, err)
+		}
+	}
+
+	fieldData := &schemapb.FieldData{
+		Type: typeutil.Float16VectorType,
+		Field: &schemapb.FieldData_Vectors{
+			Vectors: &schemapb.VectorField{
+				Dim:  2,
+				Data: &schemapb.VectorField_BinaryVector{BinaryVector: make([]byte, 12)},
+			},
+		},
+	}
+	numRows, err := GetNumRowOfFieldData(fieldData)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), numRows)
+}
+
 func Test_ReadBinary(t *testing.T) {
 	// TODO: test big endian.
 	// low byte in high address, high byte in low address.
@@ -0,0 +1,375 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// CSVReader reads records of a csv file, a quoted value could contain delimiters, line breaks and
+// quote characters written twice. Blank lines are ignored.
+type CSVReader struct {
+	reader    *bufio.Reader
+	delimiter rune
+	quote     rune  // zero means values are never quoted
+	line      int64 // current line number, for error messages
+}
+
+// NewCSVReader is helper function to create a CSVReader
+func NewCSVReader(r io.Reader, options CSVOptions) *CSVReader {
+	return &CSVReader{
+		reader:    bufio.NewReader(r),
+		delimiter: options.GetDelimiter(),
+		quote:     options.GetQuote(),
+		line:      1,
+	}
+}
+
+// Read reads a record, io.EOF is returned if there is no more record
+func (r *CSVReader) Read() ([]string, error) {
+	record := make([]string, 0)
+	var field bytes.Buffer
+	fieldStart := true // no character of the field has been read
+	quoted := false    // the field is quoted
+	inQuote := false   // inside the quotes
+
+	for {
+		c, _, err := r.reader.ReadRune()
+		if errors.Is(err, io.EOF) {
+			if inQuote {
+				return nil, fmt.Errorf("unterminated quoted value at line %d", r.line)
+			}
+			if len(record) == 0 && fieldStart && !quoted {
+				return nil, io.EOF
+			}
+			return append(record, field.String()), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if inQuote {
+			if c == r.quote {
+				next, _, err := r.reader.ReadRune()
+				if err == nil && next == r.quote {
+					// a quote character written twice is a literal quote character
+					field.WriteRune(c)
+					continue
+				}
+				if err == nil {
+					_ = r.reader.UnreadRune()
+				}
+				inQuote = false
+				continue
+			}
+			if c == '\n' {
+				r.line++
+			}
+			field.WriteRune(c)
+			continue
+		}
+
+		switch {
+		case c == r.delimiter:
+			record = append(record, field.String())
+			field.Reset()
+			fieldStart, quoted = true, false
+		case c == '\r' || c == '\n':
+			if c == '\r' {
+				if next, _, err := r.reader.ReadRune(); err == nil && next != '\n' {
+					_ = r.reader.UnreadRune()
+				}
+			}
+			r.line++
+			if len(record) == 0 && fieldStart && !quoted {
+				// blank line
+				continue
+			}
+			return append(record, field.String()), nil
+		case c == r.quote && r.quote != 0 && fieldStart:
+			inQuote, quoted, fieldStart = true, true, false
+		default:
+			field.WriteRune(c)
+			fieldStart = false
+		}
+	}
+}
+
+// Line returns the current line number
+func (r *CSVReader) Line() int64 {
+	return r.line
+}
+
+// CSVParser parses row-based csv files, the first record is the header. Scalar values are the text of numbers,
+// booleans and strings, vectors are json arrays or space separated numbers, sparse vectors are json objects.
+// The parsed rows are in the format of json decoder output so that they are consumed by the JSONRowConsumer.
+type CSVParser struct {
+	ctx          context.Context                              // for canceling parse process
+	bufSize      int64                                        // max rows in a buffer
+	options      CSVOptions                                   // dialect of the csv file
+	name2Field   map[string]*schemapb.FieldSchema             // fields need to be parsed
+	optional     map[storage.FieldID]bool                     // nullable fields and fields with default value can be omitted
+	convertFuncs map[storage.FieldID]func(string) interface{} // convert csv value to the format of json decoder output
+}
+
+// NewCSVParser helper function to create a CSVParser
+func NewCSVParser(ctx context.Context, collectionSchema *schemapb.CollectionSchema, options CSVOptions) *CSVParser {
+	name2Field := make(map[string]*schemapb.FieldSchema)
+	optional := make(map[storage.FieldID]bool)
+	convertFuncs := make(map[storage.FieldID]func(string) interface{})
+	for i := 0; i < len(collectionSchema.Fields); i++ {
+		schema := collectionSchema.Fields[i]
+		// RowIDField and TimeStampField is internal field, no need to parse
+		if schema.GetFieldID() == common.RowIDField || schema.GetFieldID() == common.TimeStampField {
+			continue
+		}
+		// if primary key field is auto-gernerated, no need to parse
+		if schema.GetAutoID() {
+			continue
+		}
+
+		name2Field[schema.GetName()] = schema
+		optional[schema.GetFieldID()] = typeutil.IsFieldOptional(schema)
+		convertFuncs[schema.GetFieldID()] = csvConvertFunc(schema.GetDataType())
+	}
+
+	return &CSVParser{
+		ctx:          ctx,
+		bufSize:      estimateBufSize(collectionSchema),
+		options:      options,
+		name2Field:   name2Field,
+		optional:     optional,
+		convertFuncs: convertFuncs,
+	}
+}
+
+// csvConvertFunc returns the function to convert a csv value to the format of json decoder output,
+// the value is checked by the validators later
+func csvConvertFunc(dataType schemapb.DataType) func(string) interface{} {
+	switch dataType {
+	case schemapb.DataType_Bool:
+		return func(value string) interface{} {
+			b, err := strconv.ParseBool(strings.TrimSpace(value))
+			if err != nil {
+				return value
+			}
+			return b
+		}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+		schemapb.DataType_Float, schemapb.DataType_Double:
+		return func(value string) interface{} {
+			return json.Number(strings.TrimSpace(value))
+		}
+	case schemapb.DataType_BinaryVector, schemapb.DataType_FloatVector, typeutil.Float16VectorType, typeutil.BFloat16VectorType:
+		return func(value string) interface{} {
+			value = strings.TrimSpace(value)
+			if strings.HasPrefix(value, "[") {
+				return decodeCSVJSONValue(value)
+			}
+			// space separated numbers
+			items := strings.Fields(value)
+			arr := make([]interface{}, 0, len(items))
+			for _, item := range items {
+				arr = append(arr, json.Number(item))
+			}
+			return arr
+		}
+	case typeutil.SparseFloatVectorType:
+		return func(value string) interface{} {
+			return decodeCSVJSONValue(strings.TrimSpace(value))
+		}
+	default:
+		return func(value string) interface{} {
+			return value
+		}
+	}
+}
+
+// decodeCSVJSONValue decodes a json value, the raw string is returned if it's not a valid json
+func decodeCSVJSONValue(value string) interface{} {
+	dec := json.NewDecoder(strings.NewReader(value))
+	dec.UseNumber()
+	var obj interface{}
+	if err := dec.Decode(&obj); err != nil || dec.More() {
+		return value
+	}
+	return obj
+}
+
+// parseHeader maps the csv columns to fields
+func (p *CSVParser) parseHeader(header []string) ([]*schemapb.FieldSchema, error) {
+	columns := make([]*schemapb.FieldSchema, 0, len(header))
+	provided := make(map[storage.FieldID]bool)
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if i == 0 {
+			// strip the utf-8 byte order mark written by some spreadsheet tools
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		fieldName := name
+		if mapped, ok := p.options.HeaderMapping[name]; ok {
+			fieldName = mapped
+		}
+
+		// if user provided redundant field, return error
+		schema, ok := p.name2Field[fieldName]
+		if !ok {
+			log.Error("CSV parser: the header is not defined in collection schema", zap.String("header", name),
+				zap.String("fieldName", fieldName))
+			return nil, fmt.Errorf("the header '%s' is not mapped to a field defined in collection schema", name)
+		}
+		if provided[schema.GetFieldID()] {
+			log.Error("CSV parser: duplicate field in header", zap.String("fieldName", fieldName))
+			return nil, fmt.Errorf("the field '%s' is duplicated in header", fieldName)
+		}
+		provided[schema.GetFieldID()] = true
+		columns = append(columns, schema)
+	}
+
+	// some fields not provided?
+	for name, schema := range p.name2Field {
+		if !provided[schema.GetFieldID()] && !p.optional[schema.GetFieldID()] {
+			log.Error("CSV parser: a field is missed in header", zap.String("fieldName", name))
+			return nil, fmt.Errorf("the field '%s' is missed in header", name)
+		}
+	}
+
+	return columns, nil
+}
+
+// ParseRows parses the csv file and sends rows to the handler batch by batch
+func (p *CSVParser) ParseRows(r io.Reader, handler JSONRowHandler) error {
+	if handler == nil {
+		log.Error("CSV parse handler is nil")
+		return errors.New("CSV parse handler is nil")
+	}
+
+	reader := NewCSVReader(r, p.options)
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		log.Error("CSV parser: the header is not found")
+		return errors.New("the header is not found")
+	}
+	if err != nil {
+		log.Error("CSV parser: failed to read the header", zap.Error(err))
+		return fmt.Errorf("failed to read the header, error: %w", err)
+	}
+
+	columns, err := p.parseHeader(header)
+	if err != nil {
+		return err
+	}
+
+	isEmpty := true
+	buf := make([]map[storage.FieldID]interface{}, 0, MinBufferSize)
+	for {
+		line := reader.Line()
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Error("CSV parser: failed to read the row", zap.Int64("line", line), zap.Error(err))
+			return fmt.Errorf("failed to read the row at line %d, error: %w", line, err)
+		}
+		if len(record) != len(columns) {
+			log.Error("CSV parser: column count of the row doesn't equal to the header", zap.Int64("line", line),
+				zap.Int("columnCount", len(record)), zap.Int("headerCount", len(columns)))
+			err = fmt.Errorf("column count %d of the row at line %d doesn't equal to column count %d of the header",
+				len(record), line, len(columns))
+			errHandler, ok := handler.(JSONRowErrorHandler)
+			if !ok {
+				return err
+			}
+			// keep the order of rows, handle the buffered rows before reporting the bad row
+			isEmpty = false
+			if len(buf) > 0 {
+				if err := handler.Handle(buf); err != nil {
+					log.Error("CSV parser: failed to convert row value to entity", zap.Error(err))
+					return fmt.Errorf("failed to convert row value to entity, error: %w", err)
+				}
+				buf = make([]map[storage.FieldID]interface{}, 0, MinBufferSize)
+			}
+			if err = errHandler.HandleBadRow(err); err != nil {
+				return err
+			}
+			continue
+		}
+
+		row := make(map[storage.FieldID]interface{}, len(columns))
+		for i, schema := range columns {
+			fieldID := schema.GetFieldID()
+			if p.optional[fieldID] && record[i] == p.options.NullValue {
+				// explicit null, the JSONRowConsumer decides to keep the null or take the default value
+				row[fieldID] = nil
+				continue
+			}
+			row[fieldID] = p.convertFuncs[fieldID](record[i])
+		}
+
+		buf = append(buf, row)
+		if len(buf) >= int(p.bufSize) {
+			isEmpty = false
+			if err = handler.Handle(buf); err != nil {
+				log.Error("CSV parser: failed to convert row value to entity", zap.Error(err))
+				return fmt.Errorf("failed to convert row value to entity, error: %w", err)
+			}
+
+			// clear the buffer
+			buf = make([]map[storage.FieldID]interface{}, 0, MinBufferSize)
+
+			// outside context might be canceled(service stop, or future enhancement for canceling import task)
+			if isCanceled(p.ctx) {
+				log.Error("CSV parser: import task was canceled")
+				return errors.New("import task was canceled")
+			}
+		}
+	}
+
+	// some rows in buffer not parsed, parse them
+	if len(buf) > 0 {
+		isEmpty = false
+		if err = handler.Handle(buf); err != nil {
+			log.Error("CSV parser: failed to convert row value to entity", zap.Error(err))
+			return fmt.Errorf("failed to convert row value to entity, error: %w", err)
+		}
+	}
+
+	if isEmpty {
+		log.Error("CSV parser: row count is 0")
+		return errors.New("row count is 0")
+	}
+
+	// send nil to notify the handler all have done
+	return handler.Handle(nil)
+}
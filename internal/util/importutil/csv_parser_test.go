@@ -0,0 +1,277 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func csvSampleSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      101,
+				Name:         "uid",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:  102,
+				Name:     "flag",
+				DataType: schemapb.DataType_Bool,
+			},
+			{
+				FieldID:    103,
+				Name:       "name",
+				DataType:   schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+			},
+			{
+				FieldID:    104,
+				Name:       "score",
+				DataType:   schemapb.DataType_Float,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "1.5"}},
+			},
+			{
+				FieldID:    105,
+				Name:       "vec",
+				DataType:   schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "4"}},
+			},
+			{
+				FieldID:    106,
+				Name:       "bvec",
+				DataType:   schemapb.DataType_BinaryVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "16"}},
+			},
+		},
+	}
+}
+
+func readAllCSVRecords(t *testing.T, content string, options CSVOptions) ([][]string, error) {
+	reader := NewCSVReader(strings.NewReader(content), options)
+	records := make([][]string, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return records, err
+		}
+		records = append(records, record)
+	}
+}
+
+func Test_CSVReader(t *testing.T) {
+	records, err := readAllCSVRecords(t, "a,b,c\n1,\"x,y\",\"say \"\"hi\"\"\"\r\n\n2,,\"line\nbreak\"", CSVOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"a", "b", "c"},
+		{"1", "x,y", "say \"hi\""},
+		{"2", "", "line\nbreak"},
+	}, records)
+
+	records, err = readAllCSVRecords(t, "a\tb\n'1\t2'\t3\n", CSVOptions{Delimiter: '\t', Quote: '\''})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"a", "b"}, {"1\t2", "3"}}, records)
+
+	records, err = readAllCSVRecords(t, "a|b\n\"1\"|2|\n", CSVOptions{Delimiter: '|', DisableQuote: true})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"a", "b"}, {"\"1\"", "2", ""}}, records)
+
+	records, err = readAllCSVRecords(t, "", CSVOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(records))
+
+	_, err = readAllCSVRecords(t, "a,b\n\"1,2\n", CSVOptions{})
+	assert.Error(t, err)
+}
+
+func Test_CSVParserParseRows(t *testing.T) {
+	ctx := context.Background()
+	parser := NewCSVParser(ctx, csvSampleSchema(), CSVOptions{
+		NullValue:     "NULL",
+		HeaderMapping: map[string]string{"id": "uid"},
+	})
+	assert.NotNil(t, parser)
+
+	content := "\ufeffid,flag,name,vec,bvec\n" +
+		"1,true,hello,\"[1.1, 1.2, 1.3, 1.4]\",\"[254, 0]\"\n" +
+		"2,False,NULL,2.1 2.2 2.3 2.4,253 1\n"
+	consumer := &mockJSONRowConsumer{}
+	err := parser.ParseRows(strings.NewReader(content), consumer)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, consumer.handleCount)
+	assert.Equal(t, 2, len(consumer.rows))
+
+	row := consumer.rows[0]
+	assert.Equal(t, json.Number("1"), row[101])
+	assert.Equal(t, true, row[102])
+	assert.Equal(t, "hello", row[103])
+	assert.Equal(t, []interface{}{json.Number("1.1"), json.Number("1.2"), json.Number("1.3"), json.Number("1.4")}, row[105])
+	assert.Equal(t, []interface{}{json.Number("254"), json.Number("0")}, row[106])
+	_, ok := row[104]
+	assert.False(t, ok)
+
+	row = consumer.rows[1]
+	assert.Equal(t, false, row[102])
+	value, ok := row[103]
+	assert.True(t, ok)
+	assert.Nil(t, value)
+	assert.Equal(t, []interface{}{json.Number("2.1"), json.Number("2.2"), json.Number("2.3"), json.Number("2.4")}, row[105])
+	assert.Equal(t, []interface{}{json.Number("253"), json.Number("1")}, row[106])
+
+	// consumed by the JSONRowConsumer
+	var shardsData []map[storage.FieldID]storage.FieldData
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+		shardsData = append(shardsData, fields)
+		return nil
+	}
+	rowConsumer, err := NewJSONRowConsumer(csvSampleSchema(), newIDAllocator(ctx, t, nil), 1, 1024, flushFunc)
+	assert.NoError(t, err)
+	err = parser.ParseRows(strings.NewReader(content), rowConsumer)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), rowConsumer.RowCount())
+	assert.Equal(t, 1, len(shardsData))
+	assert.Equal(t, []int64{1, 2}, shardsData[0][101].(*storage.Int64FieldData).Data)
+	assert.Equal(t, []string{"hello", ""}, shardsData[0][103].(*storage.StringFieldData).Data)
+	assert.Equal(t, []bool{true, false}, shardsData[0][103].GetValidData())
+	assert.Equal(t, []float32{1.5, 1.5}, shardsData[0][104].(*storage.FloatFieldData).Data)
+	assert.Equal(t, []byte{254, 0, 253, 1}, shardsData[0][106].(*storage.BinaryVectorFieldData).Data)
+}
+
+func Test_CSVParserParseRowsFailed(t *testing.T) {
+	ctx := context.Background()
+	parser := NewCSVParser(ctx, csvSampleSchema(), CSVOptions{})
+	assert.NotNil(t, parser)
+
+	// nil handler
+	err := parser.ParseRows(strings.NewReader("uid,flag,vec,bvec\n"), nil)
+	assert.Error(t, err)
+
+	contents := []string{
+		// empty file
+		"",
+		// no rows
+		"uid,flag,vec,bvec\n",
+		// the header is not defined in collection schema
+		"uid,flag,vec,bvec,dummy\n1,true,1 2 3 4,1 2,0\n",
+		// the field is missed
+		"uid,vec,bvec\n1,1 2 3 4,1 2\n",
+		// the field is duplicated
+		"uid,flag,vec,bvec,flag\n1,true,1 2 3 4,1 2,true\n",
+		// column count mismatch
+		"uid,flag,vec,bvec\n1,true,1 2 3 4\n",
+		// unterminated quote
+		"uid,flag,vec,bvec\n1,true,\"1 2 3 4,1 2\n",
+	}
+	for _, content := range contents {
+		err = parser.ParseRows(strings.NewReader(content), &mockJSONRowConsumer{})
+		assert.Error(t, err, content)
+	}
+
+	// handler failed
+	err = parser.ParseRows(strings.NewReader("uid,flag,vec,bvec\n1,true,1 2 3 4,1 2\n"), &mockJSONRowConsumer{
+		handleErr: errors.New("error"),
+	})
+	assert.Error(t, err)
+
+	// illegal values are checked by the validators
+	rowConsumer, err := NewJSONRowConsumer(csvSampleSchema(), newIDAllocator(ctx, t, nil), 1, 1024,
+		func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+			return nil
+		})
+	assert.NoError(t, err)
+	err = parser.ParseRows(strings.NewReader("uid,flag,vec,bvec\n1,yes,1 2 3 4,1 2\n"), rowConsumer)
+	assert.Error(t, err)
+	err = parser.ParseRows(strings.NewReader("uid,flag,vec,bvec\n1,true,[1 2 3 4],1 2\n"), rowConsumer)
+	assert.Error(t, err)
+	err = parser.ParseRows(strings.NewReader("uid,flag,vec,bvec\n1,true,1 2 3,1 2\n"), rowConsumer)
+	assert.Error(t, err)
+}
+
+func Test_ImportWrapperRowBasedCSV(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+
+	content := []byte("id;flag;vec;bvec\n" +
+		"1;true;1.1 1.2 1.3 1.4;254 0\n" +
+		"2;false;2.1 2.2 2.3 2.4;253 0\n" +
+		"3;true;3.1 3.2 3.3 3.4;252 0\n")
+	filePath := TempFilesPath + "rows.csv"
+	err = cm.Write(ctx, filePath, content)
+	assert.NoError(t, err)
+
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   make([]int64, 0),
+		AutoIds:    make([]int64, 0),
+		RowCount:   0,
+	}
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+
+	options := DefaultImportOptions()
+	options.CSV = CSVOptions{
+		Delimiter:     ';',
+		HeaderMapping: map[string]string{"id": "uid"},
+	}
+	wrapper := NewImportWrapper(ctx, csvSampleSchema(), 2, 1024*1024, newIDAllocator(ctx, t, nil), cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import([]string{filePath}, options)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, rowCounter.rowCount)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+
+	// wrong dialect
+	importResult.State = commonpb.ImportState_ImportStarted
+	wrapper = NewImportWrapper(ctx, csvSampleSchema(), 2, 1024*1024, newIDAllocator(ctx, t, nil), cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import([]string{filePath}, DefaultImportOptions())
+	assert.Error(t, err)
+	assert.NotEqual(t, commonpb.ImportState_ImportPersisted, importResult.State)
+}
@@ -0,0 +1,522 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/file"
+	"github.com/apache/arrow/go/v8/parquet/schema"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// File types of export
+const (
+	JSONFileType    = "json"
+	NumpyFileType   = "numpy"
+	ParquetFileType = "parquet"
+)
+
+// Extra option keys to pass through export API
+const (
+	ExportAutoID = "export_auto_id" // export the auto-generated primary key, default false
+)
+
+// numpyMagic is the magic string at the beginning of numpy files
+const numpyMagic = "\x93NUMPY"
+
+// ExportWriter writes fields data of a collection into files which can be imported again:
+//  1. a json file holds the rows in the format of {"rows": [{...}, {...}]}
+//  2. a numpy file is written for each field, the files of a block are put under the same directory
+//  3. a parquet file holds a row group, each field is a top-level column
+//
+// RowIDField and TimeStampField are never exported, the auto-generated primary key is exported only
+// if the export_auto_id option is true.
+type ExportWriter struct {
+	ctx          context.Context         // for canceling export process
+	chunkManager storage.ChunkManager    // storage interfaces to write files
+	fileType     string                  // json, numpy or parquet
+	fields       []*schemapb.FieldSchema // fields to be exported
+}
+
+// NewExportWriter is helper function to create an ExportWriter
+func NewExportWriter(ctx context.Context, collectionSchema *schemapb.CollectionSchema, chunkManager storage.ChunkManager,
+	fileType string, options []*commonpb.KeyValuePair) (*ExportWriter, error) {
+	if chunkManager == nil {
+		log.Error("Export writer: chunk manager pointer is nil")
+		return nil, errors.New("chunk manager pointer is nil")
+	}
+
+	fields, err := getExportFields(collectionSchema, fileType, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExportWriter{
+		ctx:          ctx,
+		chunkManager: chunkManager,
+		fileType:     fileType,
+		fields:       fields,
+	}, nil
+}
+
+// ValidateExport checks whether the collection can be exported into the file type with the options
+func ValidateExport(collectionSchema *schemapb.CollectionSchema, fileType string, options []*commonpb.KeyValuePair) error {
+	_, err := getExportFields(collectionSchema, fileType, options)
+	return err
+}
+
+// getExportFields returns the fields to be exported, returns error if a field can't be written into the file type
+func getExportFields(collectionSchema *schemapb.CollectionSchema, fileType string,
+	options []*commonpb.KeyValuePair) ([]*schemapb.FieldSchema, error) {
+	if collectionSchema == nil {
+		log.Error("Export writer: collection schema is nil")
+		return nil, errors.New("collection schema is nil")
+	}
+	if fileType != JSONFileType && fileType != NumpyFileType && fileType != ParquetFileType {
+		log.Error("Export writer: unsupported file type", zap.String("fileType", fileType))
+		return nil, fmt.Errorf("unsupported file type '%s', should be %s, %s or %s", fileType,
+			JSONFileType, NumpyFileType, ParquetFileType)
+	}
+
+	exportAutoID := false
+	if value, ok := funcutil.KeyValuePair2Map(options)[ExportAutoID]; ok {
+		var err error
+		exportAutoID, err = strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("illegal value '%s' for option %s, error: %w", value, ExportAutoID, err)
+		}
+	}
+
+	fields := make([]*schemapb.FieldSchema, 0, len(collectionSchema.GetFields()))
+	for _, field := range collectionSchema.GetFields() {
+		if field.GetFieldID() == common.RowIDField || field.GetFieldID() == common.TimeStampField {
+			continue
+		}
+		if field.GetIsPrimaryKey() && field.GetAutoID() && !exportAutoID {
+			continue
+		}
+		if typeutil.IsSparseFloatVectorType(field.GetDataType()) && fileType != JSONFileType {
+			return nil, fmt.Errorf("sparse float vector field '%s' can only be exported into %s file",
+				field.GetName(), JSONFileType)
+		}
+		if typeutil.IsFieldNullable(field) && fileType == NumpyFileType {
+			return nil, fmt.Errorf("nullable field '%s' can't be exported into %s file", field.GetName(), NumpyFileType)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// Write writes a block of fields data into files, the filePath has no extension, it's the prefix of a json or
+// parquet file, or the directory of numpy files. Returns the paths of the written files.
+func (w *ExportWriter) Write(filePath string, fieldsData map[storage.FieldID]storage.FieldData) ([]string, error) {
+	if isCanceled(w.ctx) {
+		log.Error("Export writer: export task was canceled")
+		return nil, errors.New("export task was canceled")
+	}
+
+	rowCount := -1
+	for _, field := range w.fields {
+		data, ok := fieldsData[field.GetFieldID()]
+		if !ok {
+			log.Error("Export writer: the field data is missed", zap.String("fieldName", field.GetName()))
+			return nil, fmt.Errorf("the data of field '%s' is missed", field.GetName())
+		}
+		if rowCount >= 0 && data.RowNum() != rowCount {
+			log.Error("Export writer: row count of fields is not equal", zap.String("fieldName", field.GetName()),
+				zap.Int("rowCount", data.RowNum()), zap.Int("expectedCount", rowCount))
+			return nil, fmt.Errorf("row count %d of field '%s' is not equal to other fields %d", data.RowNum(),
+				field.GetName(), rowCount)
+		}
+		rowCount = data.RowNum()
+	}
+
+	filePaths := make([]string, 0)
+	contents := make([][]byte, 0)
+	var content []byte
+	var err error
+	switch w.fileType {
+	case JSONFileType:
+		content, err = w.encodeJSON(fieldsData, rowCount)
+		filePaths, contents = append(filePaths, filePath+JSONFileExt), append(contents, content)
+	case ParquetFileType:
+		content, err = w.encodeParquet(fieldsData, rowCount)
+		filePaths, contents = append(filePaths, filePath+ParquetFileExt), append(contents, content)
+	case NumpyFileType:
+		for i := 0; i < len(w.fields) && err == nil; i++ {
+			field := w.fields[i]
+			content, err = encodeNumpy(field, fieldsData[field.GetFieldID()], rowCount)
+			filePaths = append(filePaths, path.Join(filePath, field.GetName()+NumpyFileExt))
+			contents = append(contents, content)
+		}
+	}
+	if err != nil {
+		log.Error("Export writer: failed to encode fields data", zap.String("filePath", filePath), zap.Error(err))
+		return nil, fmt.Errorf("failed to encode fields data into '%s', error: %w", filePath, err)
+	}
+
+	for i, p := range filePaths {
+		if err := w.chunkManager.Write(w.ctx, p, contents[i]); err != nil {
+			log.Error("Export writer: failed to write file", zap.String("filePath", p), zap.Error(err))
+			return nil, fmt.Errorf("failed to write file '%s', error: %w", p, err)
+		}
+	}
+	log.Info("Export writer: fields data exported", zap.Strings("files", filePaths), zap.Int("rowCount", rowCount))
+	return filePaths, nil
+}
+
+// isNullRow returns true if the row of a nullable field is null
+func isNullRow(data storage.FieldData, i int) bool {
+	validData := data.GetValidData()
+	return validData != nil && !validData[i]
+}
+
+// encodeJSON converts the rows into the format of json import
+func (w *ExportWriter) encodeJSON(fieldsData map[storage.FieldID]storage.FieldData, rowCount int) ([]byte, error) {
+	rows := make([]map[string]interface{}, 0, rowCount)
+	for i := 0; i < rowCount; i++ {
+		row := make(map[string]interface{}, len(w.fields))
+		for _, field := range w.fields {
+			data := fieldsData[field.GetFieldID()]
+			if isNullRow(data, i) {
+				row[field.GetName()] = nil
+				continue
+			}
+
+			value := data.GetRow(i)
+			switch field.GetDataType() {
+			case schemapb.DataType_BinaryVector:
+				// []byte is marshaled into base64 string, the binary vector is an array of uint8 in json file
+				bytesRow := value.([]byte)
+				arr := make([]int, 0, len(bytesRow))
+				for _, b := range bytesRow {
+					arr = append(arr, int(b))
+				}
+				value = arr
+			case typeutil.Float16VectorType, typeutil.BFloat16VectorType:
+				vector, err := typeutil.DecodeHalfFloatVector(field.GetDataType(), value.([]byte))
+				if err != nil {
+					return nil, err
+				}
+				value = vector
+			case typeutil.SparseFloatVectorType:
+				sparseRow := value.([]byte)
+				count := typeutil.SparseFloatRowElementCount(sparseRow)
+				indices := make([]uint32, 0, count)
+				values := make([]float32, 0, count)
+				for j := 0; j < count; j++ {
+					indices = append(indices, typeutil.SparseFloatRowIndexAt(sparseRow, j))
+					values = append(values, typeutil.SparseFloatRowValueAt(sparseRow, j))
+				}
+				value = map[string]interface{}{"indices": indices, "values": values}
+			}
+			row[field.GetName()] = value
+		}
+		rows = append(rows, row)
+	}
+
+	// json.Marshal returns error for NaN and Inf values
+	return json.Marshal(map[string]interface{}{"rows": rows})
+}
+
+// numpyHeader constructs the header of numpy file in version 1.0, the header is padded to be 64 bytes aligned
+func numpyHeader(descr string, shape []int) []byte {
+	shapeStr := fmt.Sprintf("(%d,)", shape[0])
+	if len(shape) > 1 {
+		shapeStr = fmt.Sprintf("(%d, %d)", shape[0], shape[1])
+	}
+	dict := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': %s, }", descr, shapeStr)
+
+	// magic string, 2 bytes version number, 2 bytes header length, the header ends with a newline
+	prefixLen := len(numpyMagic) + 4
+	padding := (64 - (prefixLen+len(dict)+1)%64) % 64
+	dict = dict + strings.Repeat(" ", padding) + "\n"
+
+	header := make([]byte, 0, prefixLen+len(dict))
+	header = append(header, numpyMagic...)
+	header = append(header, 1, 0)
+	header = append(header, byte(len(dict)), byte(len(dict)>>8))
+	return append(header, dict...)
+}
+
+// encodeNumpy converts the field data into the format of numpy import
+func encodeNumpy(field *schemapb.FieldSchema, data storage.FieldData, rowCount int) ([]byte, error) {
+	var descr string
+	var values interface{}
+	shape := []int{rowCount}
+	switch fieldData := data.(type) {
+	case *storage.BoolFieldData:
+		descr, values = "|b1", fieldData.Data
+	case *storage.Int8FieldData:
+		descr, values = "|i1", fieldData.Data
+	case *storage.Int16FieldData:
+		descr, values = "<i2", fieldData.Data
+	case *storage.Int32FieldData:
+		descr, values = "<i4", fieldData.Data
+	case *storage.Int64FieldData:
+		descr, values = "<i8", fieldData.Data
+	case *storage.FloatFieldData:
+		descr, values = "<f4", fieldData.Data
+	case *storage.DoubleFieldData:
+		descr, values = "<f8", fieldData.Data
+	case *storage.StringFieldData:
+		// strings are stored as fixed-length utf-32 characters
+		maxLen := 1
+		for _, str := range fieldData.Data {
+			if n := utf8.RuneCountInString(str); n > maxLen {
+				maxLen = n
+			}
+		}
+		descr = fmt.Sprintf("<U%d", maxLen)
+		encoded := make([]uint32, rowCount*maxLen)
+		for i, str := range fieldData.Data {
+			j := 0
+			for _, r := range str {
+				encoded[i*maxLen+j] = uint32(r)
+				j++
+			}
+		}
+		values = encoded
+	case *storage.BinaryVectorFieldData:
+		descr, values = "|u1", fieldData.Data
+		shape = append(shape, fieldData.Dim/8)
+	case *storage.FloatVectorFieldData:
+		descr, values = "<f4", fieldData.Data
+		shape = append(shape, fieldData.Dim)
+	case *storage.Float16VectorFieldData:
+		descr, values = "<f2", fieldData.Data
+		shape = append(shape, fieldData.Dim)
+	case *storage.BFloat16VectorFieldData:
+		// numpy has no bfloat16 type, the vectors are exported as float32
+		vectors, err := typeutil.DecodeHalfFloatVector(typeutil.BFloat16VectorType, fieldData.Data)
+		if err != nil {
+			return nil, err
+		}
+		descr, values = "<f4", vectors
+		shape = append(shape, fieldData.Dim)
+	default:
+		return nil, fmt.Errorf("%s field '%s' can't be exported into %s file", getTypeName(field.GetDataType()),
+			field.GetName(), NumpyFileType)
+	}
+
+	buf := bytes.NewBuffer(numpyHeader(descr, shape))
+	if err := binary.Write(buf, binary.LittleEndian, values); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// parquetNode returns the column of the field, nullable field is an optional column, vector fields are
+// lists of float32 except the binary vector which is a fixed-length binary column
+func parquetNode(field *schemapb.FieldSchema) (schema.Node, error) {
+	name := field.GetName()
+	repetition := parquet.Repetitions.Required
+	if typeutil.IsFieldNullable(field) {
+		repetition = parquet.Repetitions.Optional
+	}
+
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return schema.NewBooleanNode(name, repetition, -1), nil
+	case schemapb.DataType_Int8:
+		return schema.NewPrimitiveNodeLogical(name, repetition, schema.NewIntLogicalType(8, true), parquet.Types.Int32, -1, -1)
+	case schemapb.DataType_Int16:
+		return schema.NewPrimitiveNodeLogical(name, repetition, schema.NewIntLogicalType(16, true), parquet.Types.Int32, -1, -1)
+	case schemapb.DataType_Int32:
+		return schema.NewInt32Node(name, repetition, -1), nil
+	case schemapb.DataType_Int64:
+		return schema.NewInt64Node(name, repetition, -1), nil
+	case schemapb.DataType_Float:
+		return schema.NewFloat32Node(name, repetition, -1), nil
+	case schemapb.DataType_Double:
+		return schema.NewFloat64Node(name, repetition, -1), nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return schema.NewPrimitiveNodeLogical(name, repetition, schema.StringLogicalType{}, parquet.Types.ByteArray, -1, -1)
+	case schemapb.DataType_BinaryVector:
+		dim, err := getFieldDimension(field)
+		if err != nil {
+			return nil, err
+		}
+		return schema.NewPrimitiveNode(name, parquet.Repetitions.Required, parquet.Types.FixedLenByteArray, -1, int32(dim/8))
+	case schemapb.DataType_FloatVector, typeutil.Float16VectorType, typeutil.BFloat16VectorType:
+		return schema.ListOf(schema.NewFloat32Node(name, parquet.Repetitions.Required, -1), parquet.Repetitions.Required, -1)
+	default:
+		return nil, fmt.Errorf("%s field '%s' can't be exported into %s file", getTypeName(field.GetDataType()),
+			field.GetName(), ParquetFileType)
+	}
+}
+
+// encodeParquet converts the fields data into a parquet file with a row group
+func (w *ExportWriter) encodeParquet(fieldsData map[storage.FieldID]storage.FieldData, rowCount int) ([]byte, error) {
+	nodes := make(schema.FieldList, 0, len(w.fields))
+	for _, field := range w.fields {
+		node, err := parquetNode(field)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	root, err := schema.NewGroupNode("schema", parquet.Repetitions.Required, nodes, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	writer := file.NewParquetWriter(buf, root)
+	rgWriter := writer.AppendRowGroup()
+	for _, field := range w.fields {
+		cw, err := rgWriter.NextColumn()
+		if err != nil {
+			return nil, err
+		}
+		if err = writeParquetColumn(cw, fieldsData[field.GetFieldID()], rowCount); err != nil {
+			return nil, fmt.Errorf("failed to write column of field '%s', error: %w", field.GetName(), err)
+		}
+		if err = cw.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err = rgWriter.Close(); err != nil {
+		return nil, err
+	}
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeParquetColumn writes the field data into the column chunk, nulls are skipped from the values
+// and marked by the definition levels, vectors are marked by the repetition levels
+func writeParquetColumn(cw file.ColumnChunkWriter, data storage.FieldData, rowCount int) error {
+	var defLevels []int16
+	if data.GetValidData() != nil {
+		defLevels = make([]int16, 0, rowCount)
+		for i := 0; i < rowCount; i++ {
+			if isNullRow(data, i) {
+				defLevels = append(defLevels, 0)
+			} else {
+				defLevels = append(defLevels, 1)
+			}
+		}
+	}
+	validValues := func(i int) bool {
+		return !isNullRow(data, i)
+	}
+
+	var err error
+	switch fieldData := data.(type) {
+	case *storage.BoolFieldData:
+		_, err = cw.(*file.BooleanColumnChunkWriter).WriteBatch(filterValues(fieldData.Data, validValues), defLevels, nil)
+	case *storage.Int8FieldData:
+		values := make([]int32, 0, rowCount)
+		for i, v := range fieldData.Data {
+			if validValues(i) {
+				values = append(values, int32(v))
+			}
+		}
+		_, err = cw.(*file.Int32ColumnChunkWriter).WriteBatch(values, defLevels, nil)
+	case *storage.Int16FieldData:
+		values := make([]int32, 0, rowCount)
+		for i, v := range fieldData.Data {
+			if validValues(i) {
+				values = append(values, int32(v))
+			}
+		}
+		_, err = cw.(*file.Int32ColumnChunkWriter).WriteBatch(values, defLevels, nil)
+	case *storage.Int32FieldData:
+		_, err = cw.(*file.Int32ColumnChunkWriter).WriteBatch(filterValues(fieldData.Data, validValues), defLevels, nil)
+	case *storage.Int64FieldData:
+		_, err = cw.(*file.Int64ColumnChunkWriter).WriteBatch(filterValues(fieldData.Data, validValues), defLevels, nil)
+	case *storage.FloatFieldData:
+		_, err = cw.(*file.Float32ColumnChunkWriter).WriteBatch(filterValues(fieldData.Data, validValues), defLevels, nil)
+	case *storage.DoubleFieldData:
+		_, err = cw.(*file.Float64ColumnChunkWriter).WriteBatch(filterValues(fieldData.Data, validValues), defLevels, nil)
+	case *storage.StringFieldData:
+		values := make([]parquet.ByteArray, 0, rowCount)
+		for i, v := range fieldData.Data {
+			if validValues(i) {
+				values = append(values, parquet.ByteArray(v))
+			}
+		}
+		_, err = cw.(*file.ByteArrayColumnChunkWriter).WriteBatch(values, defLevels, nil)
+	case *storage.BinaryVectorFieldData:
+		values := make([]parquet.FixedLenByteArray, 0, rowCount)
+		for i := 0; i < rowCount; i++ {
+			values = append(values, fieldData.GetRow(i).([]byte))
+		}
+		_, err = cw.(*file.FixedLenByteArrayColumnChunkWriter).WriteBatch(values, nil, nil)
+	case *storage.FloatVectorFieldData:
+		err = writeParquetVectors(cw, fieldData.Data, fieldData.Dim)
+	case *storage.Float16VectorFieldData:
+		var vectors []float32
+		if vectors, err = typeutil.DecodeHalfFloatVector(typeutil.Float16VectorType, fieldData.Data); err == nil {
+			err = writeParquetVectors(cw, vectors, fieldData.Dim)
+		}
+	case *storage.BFloat16VectorFieldData:
+		var vectors []float32
+		if vectors, err = typeutil.DecodeHalfFloatVector(typeutil.BFloat16VectorType, fieldData.Data); err == nil {
+			err = writeParquetVectors(cw, vectors, fieldData.Dim)
+		}
+	default:
+		err = fmt.Errorf("unsupported field data type %T", data)
+	}
+	return err
+}
+
+// writeParquetVectors writes the vectors into a column of required list of required float32
+func writeParquetVectors(cw file.ColumnChunkWriter, vectors []float32, dim int) error {
+	defLevels := make([]int16, len(vectors))
+	repLevels := make([]int16, len(vectors))
+	for i := range vectors {
+		defLevels[i] = 1
+		if i%dim != 0 {
+			repLevels[i] = 1
+		}
+	}
+	_, err := cw.(*file.Float32ColumnChunkWriter).WriteBatch(vectors, defLevels, repLevels)
+	return err
+}
+
+// filterValues returns the values which are accepted by the filter
+func filterValues[T any](values []T, filter func(i int) bool) []T {
+	result := make([]T, 0, len(values))
+	for i, v := range values {
+		if filter(i) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,214 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// sampleExportData returns 3 rows for the csvSampleSchema, the "name" of the second row is null
+func sampleExportData() map[storage.FieldID]storage.FieldData {
+	return map[storage.FieldID]storage.FieldData{
+		101: &storage.Int64FieldData{NumRows: []int64{3}, Data: []int64{1, 2, 3}},
+		102: &storage.BoolFieldData{NumRows: []int64{3}, Data: []bool{true, false, true}},
+		103: &storage.StringFieldData{NumRows: []int64{3}, Data: []string{"a", "", "中文"}, ValidData: []bool{true, false, true}},
+		104: &storage.FloatFieldData{NumRows: []int64{3}, Data: []float32{1.5, 2.5, 3.5}},
+		105: &storage.FloatVectorFieldData{NumRows: []int64{3}, Dim: 4, Data: []float32{
+			1.1, 1.2, 1.3, 1.4, 2.1, 2.2, 2.3, 2.4, 3.1, 3.2, 3.3, 3.4}},
+		106: &storage.BinaryVectorFieldData{NumRows: []int64{3}, Dim: 16, Data: []byte{254, 0, 253, 1, 252, 2}},
+	}
+}
+
+func createExportChunkManager(t *testing.T) storage.ChunkManager {
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	cm, err := f.NewPersistentStorageChunkManager(context.Background())
+	assert.NoError(t, err)
+	return cm
+}
+
+func Test_NewExportWriter(t *testing.T) {
+	ctx := context.Background()
+	cm := createExportChunkManager(t)
+
+	writer, err := NewExportWriter(ctx, csvSampleSchema(), nil, JSONFileType, nil)
+	assert.Error(t, err)
+	assert.Nil(t, writer)
+
+	writer, err = NewExportWriter(ctx, nil, cm, JSONFileType, nil)
+	assert.Error(t, err)
+	assert.Nil(t, writer)
+
+	writer, err = NewExportWriter(ctx, csvSampleSchema(), cm, "csv", nil)
+	assert.Error(t, err)
+	assert.Nil(t, writer)
+
+	// nullable field can't be exported into numpy file
+	err = ValidateExport(csvSampleSchema(), NumpyFileType, nil)
+	assert.Error(t, err)
+
+	// sparse vector can only be exported into json file
+	sparseSchema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 101, Name: "uid", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "sparse", DataType: typeutil.SparseFloatVectorType},
+		},
+	}
+	assert.NoError(t, ValidateExport(sparseSchema, JSONFileType, nil))
+	assert.Error(t, ValidateExport(sparseSchema, NumpyFileType, nil))
+	assert.Error(t, ValidateExport(sparseSchema, ParquetFileType, nil))
+
+	// auto-generated primary key is skipped by default
+	schema := csvSampleSchema()
+	schema.Fields[0].AutoID = true
+	writer, err = NewExportWriter(ctx, schema, cm, ParquetFileType, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(writer.fields))
+
+	writer, err = NewExportWriter(ctx, schema, cm, ParquetFileType, []*commonpb.KeyValuePair{{Key: ExportAutoID, Value: "true"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 6, len(writer.fields))
+
+	writer, err = NewExportWriter(ctx, schema, cm, ParquetFileType, []*commonpb.KeyValuePair{{Key: ExportAutoID, Value: "yes"}})
+	assert.Error(t, err)
+	assert.Nil(t, writer)
+}
+
+func Test_ExportWriterJSON(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	ctx := context.Background()
+	cm := createExportChunkManager(t)
+	writer, err := NewExportWriter(ctx, csvSampleSchema(), cm, JSONFileType, nil)
+	assert.NoError(t, err)
+
+	files, err := writer.Write(TempFilesPath+"export/1/0", sampleExportData())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{TempFilesPath + "export/1/0.json"}, files)
+
+	// the exported file can be imported again
+	content, err := cm.Read(ctx, files[0])
+	assert.NoError(t, err)
+	consumer := &mockJSONRowConsumer{}
+	err = NewJSONParser(ctx, csvSampleSchema()).ParseRows(bytes.NewReader(content), consumer)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(consumer.rows))
+	assert.Equal(t, json.Number("2"), consumer.rows[1][101])
+	assert.Equal(t, false, consumer.rows[1][102])
+	assert.Nil(t, consumer.rows[1][103])
+	assert.Equal(t, "中文", consumer.rows[2][103])
+	assert.Equal(t, []interface{}{json.Number("253"), json.Number("1")}, consumer.rows[1][106])
+
+	// row count mismatch
+	data := sampleExportData()
+	data[102] = &storage.BoolFieldData{NumRows: []int64{1}, Data: []bool{true}}
+	_, err = writer.Write(TempFilesPath+"export/1/1", data)
+	assert.Error(t, err)
+
+	// field data missed
+	data = sampleExportData()
+	delete(data, 105)
+	_, err = writer.Write(TempFilesPath+"export/1/1", data)
+	assert.Error(t, err)
+}
+
+func Test_ExportWriterNumpy(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	ctx := context.Background()
+	cm := createExportChunkManager(t)
+	schema := csvSampleSchema()
+	schema.Fields[2].TypeParams = nil
+	writer, err := NewExportWriter(ctx, schema, cm, NumpyFileType, nil)
+	assert.NoError(t, err)
+
+	data := sampleExportData()
+	data[103].(*storage.StringFieldData).ValidData = nil
+	files, err := writer.Write(TempFilesPath+"export/1/0", data)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, len(files))
+	assert.Equal(t, TempFilesPath+"export/1/0/uid.npy", files[0])
+
+	// the exported files can be imported again
+	for _, field := range schema.GetFields() {
+		content, err := cm.Read(ctx, TempFilesPath+"export/1/0/"+field.GetName()+NumpyFileExt)
+		assert.NoError(t, err)
+
+		var parsed storage.FieldData
+		parser := NewNumpyParser(ctx, schema, func(fieldData storage.FieldData) error {
+			parsed = fieldData
+			return nil
+		})
+		err = parser.Parse(bytes.NewReader(content), field.GetName(), false)
+		assert.NoError(t, err, field.GetName())
+		assert.Equal(t, 3, parsed.RowNum())
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, data[field.GetFieldID()].GetRow(i), parsed.GetRow(i), field.GetName())
+		}
+	}
+}
+
+func Test_ExportWriterParquet(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	ctx := context.Background()
+	cm := createExportChunkManager(t)
+	writer, err := NewExportWriter(ctx, csvSampleSchema(), cm, ParquetFileType, nil)
+	assert.NoError(t, err)
+
+	files, err := writer.Write(TempFilesPath+"export/1/0", sampleExportData())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{TempFilesPath + "export/1/0.parquet"}, files)
+
+	// the exported file can be imported again
+	content, err := cm.Read(ctx, files[0])
+	assert.NoError(t, err)
+	flushed := make([]map[storage.FieldID]storage.FieldData, 0)
+	parser, err := NewParquetParser(ctx, csvSampleSchema(), func(fields map[storage.FieldID]storage.FieldData) error {
+		flushed = append(flushed, fields)
+		return nil
+	})
+	assert.NoError(t, err)
+	err = parser.Parse(bytes.NewReader(content), false)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(flushed))
+
+	data := sampleExportData()
+	for fieldID, fieldData := range data {
+		assert.Equal(t, fieldData.RowNum(), flushed[0][fieldID].RowNum())
+		for i := 0; i < fieldData.RowNum(); i++ {
+			assert.Equal(t, fieldData.GetRow(i), flushed[0][fieldID].GetRow(i))
+		}
+	}
+	assert.Equal(t, []bool{true, false, true}, flushed[0][103].GetValidData())
+}
@@ -0,0 +1,202 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+const (
+	GzipFileExt = ".gz"
+	ZstdFileExt = ".zst"
+
+	// globMetaChars are the special characters of a glob pattern, see path.Match()
+	globMetaChars = "*?[\\"
+)
+
+// ListFilesFunc lists all the files under a prefix recursively
+type ListFilesFunc func(ctx context.Context, prefix string) ([]string, error)
+
+// GetCompressionExt returns the compression extension of a file, or empty string if the file is not compressed
+// for example: "/a/b/c.json.gz" returns ".gz"
+func GetCompressionExt(filePath string) string {
+	ext := path.Ext(filePath)
+	if ext == GzipFileExt || ext == ZstdFileExt {
+		return ext
+	}
+	return ""
+}
+
+// IsFilePattern returns true if the import path is a prefix(ends with "/") or a glob pattern,
+// such paths are expanded to data files when the import task is created
+func IsFilePattern(filePath string) bool {
+	return strings.HasSuffix(filePath, "/") || strings.ContainsAny(filePath, globMetaChars)
+}
+
+// ExpandFilePaths expands the prefixes and glob patterns to data files, other paths are returned as they are.
+// A prefix includes all the supported data files under it recursively. A glob pattern is matched by path.Match(),
+// so the "*" doesn't match the separator "/".
+// The second return value is true if any path is expanded.
+func ExpandFilePaths(ctx context.Context, filePaths []string, listFunc ListFilesFunc) ([]string, bool, error) {
+	result := make([]string, 0, len(filePaths))
+	expanded := false
+	for _, filePath := range filePaths {
+		if !IsFilePattern(filePath) {
+			result = append(result, filePath)
+			continue
+		}
+		if listFunc == nil {
+			return nil, false, fmt.Errorf("not able to expand the import path '%s', list function is nil", filePath)
+		}
+		expanded = true
+
+		// list the files under the static part of the pattern
+		prefix := filePath
+		isGlob := strings.ContainsAny(filePath, globMetaChars)
+		if isGlob {
+			if _, err := path.Match(filePath, ""); err != nil {
+				return nil, false, fmt.Errorf("illegal glob pattern '%s', error: %w", filePath, err)
+			}
+			prefix = filePath[:strings.IndexAny(filePath, globMetaChars)]
+			prefix = prefix[:strings.LastIndex(prefix, "/")+1]
+		}
+
+		files, err := listFunc(ctx, prefix)
+		if err != nil {
+			log.Error("import util: failed to list files", zap.String("prefix", prefix), zap.Error(err))
+			return nil, false, fmt.Errorf("failed to list files under '%s', error: %w", prefix, err)
+		}
+
+		matched := make([]string, 0, len(files))
+		for _, file := range files {
+			if isGlob {
+				if ok, _ := path.Match(filePath, file); !ok {
+					continue
+				}
+			}
+			// ignore the files that are not data files, for example, the "_SUCCESS" flag file
+			if _, fileType := GetFileNameAndExt(file); !isSupportedFileType(fileType) {
+				continue
+			}
+			matched = append(matched, file)
+		}
+		if len(matched) == 0 {
+			return nil, false, fmt.Errorf("no data file is found by the import path '%s'", filePath)
+		}
+		sort.Strings(matched)
+		log.Info("import util: import path expanded", zap.String("filePath", filePath), zap.Int("fileCount", len(matched)))
+		result = append(result, matched...)
+	}
+
+	// a file might be matched by several patterns
+	deduplicated := make([]string, 0, len(result))
+	seen := make(map[string]struct{}, len(result))
+	for _, file := range result {
+		if _, ok := seen[file]; ok {
+			continue
+		}
+		seen[file] = struct{}{}
+		deduplicated = append(deduplicated, file)
+	}
+	return deduplicated, expanded, nil
+}
+
+func isSupportedFileType(fileType string) bool {
+	return fileType == JSONFileExt || fileType == CSVFileExt || fileType == ParquetFileExt || fileType == NumpyFileExt ||
+		fileType == NpzFileExt
+}
+
+// compressedFile closes both the decompressor and the underlying file
+type compressedFile struct {
+	io.Reader
+	closeFunc func() error
+}
+
+func (f *compressedFile) Close() error {
+	return f.closeFunc()
+}
+
+// sizeLimitedReader fails the read once more than limit bytes are read, the size limit of a compressed file
+// is checked against the decompressed data, otherwise a small compressed file could expand to any size
+type sizeLimitedReader struct {
+	reader   io.Reader
+	filePath string
+	limit    int64
+	read     int64
+}
+
+func newSizeLimitedReader(reader io.Reader, filePath string, limit int64) *sizeLimitedReader {
+	return &sizeLimitedReader{reader: reader, filePath: filePath, limit: limit}
+}
+
+func (r *sizeLimitedReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+	if r.read > r.limit {
+		log.Error("import util: decompressed size of file exceeds the maximum size", zap.String("filePath", r.filePath),
+			zap.Int64("maxFileSize", r.limit))
+		return n, fmt.Errorf("the decompressed size of file '%s' exceeds the maximum size: %d bytes", r.filePath, r.limit)
+	}
+	return n, err
+}
+
+// OpenImportFile opens a file to be read sequentially, the gzip or zstd file is decompressed transparently,
+// and the decompressed size is limited by MaxFileSize in the same way as the size of an uncompressed file
+func OpenImportFile(ctx context.Context, chunkManager storage.ChunkManager, filePath string) (io.ReadCloser, error) {
+	// for minio storage, chunkManager will download file into local memory
+	// for local storage, chunkManager open the file directly
+	file, err := chunkManager.Reader(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	switch GetCompressionExt(filePath) {
+	case GzipFileExt:
+		reader, err := gzip.NewReader(file)
+		if err != nil {
+			file.Close()
+			return nil, fmt.Errorf("failed to open gzip file '%s', error: %w", filePath, err)
+		}
+		return &compressedFile{Reader: newSizeLimitedReader(reader, filePath, MaxFileSize), closeFunc: func() error {
+			reader.Close()
+			return file.Close()
+		}}, nil
+	case ZstdFileExt:
+		reader, err := zstd.NewReader(file)
+		if err != nil {
+			file.Close()
+			return nil, fmt.Errorf("failed to open zstd file '%s', error: %w", filePath, err)
+		}
+		return &compressedFile{Reader: newSizeLimitedReader(reader, filePath, MaxFileSize), closeFunc: func() error {
+			reader.Close()
+			return file.Close()
+		}}, nil
+	}
+	return file, nil
+}
@@ -0,0 +1,220 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func Test_GetCompressionExt(t *testing.T) {
+	assert.Equal(t, GzipFileExt, GetCompressionExt("a/b/c.json.gz"))
+	assert.Equal(t, ZstdFileExt, GetCompressionExt("a/b/c.csv.zst"))
+	assert.Equal(t, "", GetCompressionExt("a/b/c.json"))
+	assert.Equal(t, "", GetCompressionExt("a/b.gz/c"))
+
+	name, ext := GetFileNameAndExt("a/b/c.json.gz")
+	assert.Equal(t, "c", name)
+	assert.Equal(t, JSONFileExt, ext)
+	name, ext = GetFileNameAndExt("a/b/c.gz")
+	assert.Equal(t, "c", name)
+	assert.Equal(t, "", ext)
+}
+
+func Test_ExpandFilePaths(t *testing.T) {
+	ctx := context.Background()
+	objects := []string{
+		"data/2.json.gz",
+		"data/1.json.gz",
+		"data/_SUCCESS",
+		"data/sub/3.json.zst",
+		"data/sub/4.csv",
+		"other/5.json",
+	}
+	listed := make([]string, 0)
+	listFunc := func(ctx context.Context, prefix string) ([]string, error) {
+		listed = append(listed, prefix)
+		files := make([]string, 0)
+		for _, object := range objects {
+			if strings.HasPrefix(object, prefix) {
+				files = append(files, object)
+			}
+		}
+		return files, nil
+	}
+
+	// explicit files are not expanded
+	files, expanded, err := ExpandFilePaths(ctx, []string{"a.json", "b/c.npy"}, nil)
+	assert.NoError(t, err)
+	assert.False(t, expanded)
+	assert.Equal(t, []string{"a.json", "b/c.npy"}, files)
+
+	// prefix includes all the data files recursively
+	files, expanded, err = ExpandFilePaths(ctx, []string{"data/"}, listFunc)
+	assert.NoError(t, err)
+	assert.True(t, expanded)
+	assert.Equal(t, []string{"data/1.json.gz", "data/2.json.gz", "data/sub/3.json.zst", "data/sub/4.csv"}, files)
+
+	// glob pattern, the "*" doesn't match the separator
+	listed = listed[:0]
+	files, _, err = ExpandFilePaths(ctx, []string{"data/*.json.gz", "data/sub/*.json.*"}, listFunc)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"data/", "data/sub/"}, listed)
+	assert.Equal(t, []string{"data/1.json.gz", "data/2.json.gz", "data/sub/3.json.zst"}, files)
+
+	listed = listed[:0]
+	files, _, err = ExpandFilePaths(ctx, []string{"*/5.json"}, listFunc)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{""}, listed)
+	assert.Equal(t, []string{"other/5.json"}, files)
+
+	// duplicate files are removed
+	files, _, err = ExpandFilePaths(ctx, []string{"data/1.json.gz", "data/*.gz"}, listFunc)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"data/1.json.gz", "data/2.json.gz"}, files)
+
+	// nothing matched
+	_, _, err = ExpandFilePaths(ctx, []string{"data/*.parquet"}, listFunc)
+	assert.Error(t, err)
+
+	// illegal pattern
+	_, _, err = ExpandFilePaths(ctx, []string{"data/[.json"}, listFunc)
+	assert.Error(t, err)
+
+	// no list function
+	_, _, err = ExpandFilePaths(ctx, []string{"data/"}, nil)
+	assert.Error(t, err)
+
+	// failed to list
+	_, _, err = ExpandFilePaths(ctx, []string{"data/"}, func(ctx context.Context, prefix string) ([]string, error) {
+		return nil, errors.New("error")
+	})
+	assert.Error(t, err)
+}
+
+func Test_OpenImportFile(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+
+	content := []byte("uid,flag,vec,bvec\n" +
+		"1,true,1.1 1.2 1.3 1.4,254 0\n" +
+		"2,false,2.1 2.2 2.3 2.4,253 0\n")
+	var gzipContent bytes.Buffer
+	gw := gzip.NewWriter(&gzipContent)
+	_, err = gw.Write(content)
+	assert.NoError(t, err)
+	assert.NoError(t, gw.Close())
+	zw, err := zstd.NewWriter(nil)
+	assert.NoError(t, err)
+	zstdContent := zw.EncodeAll(content, nil)
+
+	files := map[string][]byte{
+		TempFilesPath + "rows.csv":     content,
+		TempFilesPath + "rows.csv.gz":  gzipContent.Bytes(),
+		TempFilesPath + "rows.csv.zst": zstdContent,
+		TempFilesPath + "bad.csv.gz":   content,
+	}
+	for filePath, data := range files {
+		assert.NoError(t, cm.Write(ctx, filePath, data))
+	}
+
+	for _, filePath := range []string{"rows.csv", "rows.csv.gz", "rows.csv.zst"} {
+		file, err := OpenImportFile(ctx, cm, TempFilesPath+filePath)
+		assert.NoError(t, err)
+		data, err := io.ReadAll(file)
+		assert.NoError(t, err)
+		assert.Equal(t, content, data)
+		assert.NoError(t, file.Close())
+	}
+
+	_, err = OpenImportFile(ctx, cm, TempFilesPath+"bad.csv.gz")
+	assert.Error(t, err)
+	_, err = OpenImportFile(ctx, cm, TempFilesPath+"dummy.csv")
+	assert.Error(t, err)
+
+	// import compressed files
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   make([]int64, 0),
+		AutoIds:    make([]int64, 0),
+		RowCount:   0,
+	}
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+	wrapper := NewImportWrapper(ctx, csvSampleSchema(), 2, 1024*1024, newIDAllocator(ctx, t, nil), cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import([]string{TempFilesPath + "rows.csv.gz", TempFilesPath + "sub/more.csv.zst"}, DefaultImportOptions())
+	assert.Error(t, err)
+	assert.NoError(t, cm.Write(ctx, TempFilesPath+"sub/more.csv.zst", zstdContent))
+	err = wrapper.Import([]string{TempFilesPath + "rows.csv.gz", TempFilesPath + "sub/more.csv.zst"}, DefaultImportOptions())
+	assert.NoError(t, err)
+	assert.Equal(t, 4, rowCounter.rowCount)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+
+	// only json and csv files can be compressed
+	_, err = wrapper.fileValidation([]string{TempFilesPath + "rows.parquet.gz"}, DefaultImportOptions())
+	assert.Error(t, err)
+}
+
+func Test_SizeLimitedReader(t *testing.T) {
+	content := []byte(strings.Repeat("a", 100))
+	var gzipContent bytes.Buffer
+	gw := gzip.NewWriter(&gzipContent)
+	_, err := gw.Write(content)
+	assert.NoError(t, err)
+	assert.NoError(t, gw.Close())
+	assert.Less(t, gzipContent.Len(), 50)
+
+	// the limit applies to the decompressed data
+	gr, err := gzip.NewReader(bytes.NewReader(gzipContent.Bytes()))
+	assert.NoError(t, err)
+	_, err = io.ReadAll(newSizeLimitedReader(gr, "rows.csv.gz", 50))
+	assert.Error(t, err)
+
+	gr, err = gzip.NewReader(bytes.NewReader(gzipContent.Bytes()))
+	assert.NoError(t, err)
+	data, err := io.ReadAll(newSizeLimitedReader(gr, "rows.csv.gz", 100))
+	assert.NoError(t, err)
+	assert.Equal(t, content, data)
+}
@@ -18,30 +18,72 @@ package importutil
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 )
 
 // Extra option keys to pass through import API
 const (
-	Bucket       = "bucket"   // the source files' minio bucket
-	StartTs      = "start_ts" // start timestamp to filter data, only data between StartTs and EndTs will be imported
-	EndTs        = "end_ts"   // end timestamp to filter data, only data between StartTs and EndTs will be imported
+	Bucket       = "bucket"        // the source files' minio bucket
+	StartTs      = "start_ts"      // start timestamp to filter data, only data between StartTs and EndTs will be imported
+	EndTs        = "end_ts"        // end timestamp to filter data, only data between StartTs and EndTs will be imported
+	EndHybridTs  = "end_hybrid_ts" // end timestamp as a hybrid timestamp, takes precedence over EndTs, the backup tool filters data by it exactly
 	OptionFormat = "start_ts: 10-digit physical timestamp, e.g. 1665995420, default 0 \n" +
-		"end_ts: 10-digit physical timestamp, e.g. 1665995420, default math.MaxInt \n"
+		"end_ts: 10-digit physical timestamp, e.g. 1665995420, default math.MaxInt \n" +
+		"end_hybrid_ts: hybrid timestamp, e.g. 436733858807808, takes precedence over end_ts \n" +
+		"csv_delimiter: a single character to separate csv columns, \\t for tab, default ',' \n" +
+		"csv_quote: a single character to quote csv values, empty to disable quoting, default '\"' \n" +
+		"csv_null_value: the csv value means null for nullable fields and fields with default value, default empty \n" +
+		"csv_header_mapping: map csv headers to field names, e.g. header1:field1,header2:field2 \n" +
+		"dry_run: true to only validate the files without importing any data, default false \n" +
+		"dry_run_max_bad_rows: max number of bad rows recorded for each file in dry-run report, default 10 \n" +
+		"priority: non-negative integer, the pending task with larger priority is processed first, default 0 \n" +
+		"numpy_field_mapping: map numpy file names or array names of npz files to field names, e.g. emb:vector,item_id:id \n"
 	BackupFlag = "backup"
+
+	CSVDelimiter     = "csv_delimiter"      // the character to separate csv columns
+	CSVQuote         = "csv_quote"          // the character to quote csv values, empty value disables quoting
+	CSVNullValue     = "csv_null_value"     // the csv value means null
+	CSVHeaderMapping = "csv_header_mapping" // map csv headers to field names
+
+	DryRun           = "dry_run"              // only validate the files, no segment is allocated and no binlog is written
+	DryRunMaxBadRows = "dry_run_max_bad_rows" // max number of bad rows recorded for each file in dry-run report
+
+	DefaultDryRunMaxBadRows = 10
+
+	Priority = "priority" // the pending task with larger priority is sent to DataNode first
+
+	NumpyFieldMapping = "numpy_field_mapping" // map numpy file names or array names of npz files to field names
 )
 
 type ImportOptions struct {
 	OnlyValidate bool
 	TsStartPoint uint64
 	TsEndPoint   uint64
-	IsBackup     bool // whether is triggered by backup tool
+	IsBackup     bool                           // whether is triggered by backup tool
+	CSV          CSVOptions                     // dialect of csv files
+	DryRun       bool                           // validate all rows of the files and report bad rows, no data generated
+	MaxBadRows   int                            // max number of bad rows recorded for each file in dry-run report
+	Checkpoints  []*internalpb.ImportCheckpoint // progress of a resumed task, the finished files are skipped
+	NumpyFields  map[string]string              // numpy file name or npz array name to field name
+}
+
+// CSVOptions is the dialect of csv files, the zero value is the default dialect:
+// comma separated, double quoted, empty value is null and headers are field names.
+type CSVOptions struct {
+	Delimiter     rune              // column delimiter, ',' if it's zero
+	Quote         rune              // quote character, '"' if it's zero
+	DisableQuote  bool              // no quote character, values are never quoted
+	NullValue     string            // the value means null
+	HeaderMapping map[string]string // header to field name, headers not in the map are field names
 }
 
 func DefaultImportOptions() ImportOptions {
@@ -49,6 +91,7 @@ func DefaultImportOptions() ImportOptions {
 		OnlyValidate: false,
 		TsStartPoint: 0,
 		TsEndPoint:   math.MaxUint64,
+		MaxBadRows:   DefaultDryRunMaxBadRows,
 	}
 	return options
 }
@@ -57,6 +100,12 @@ func DefaultImportOptions() ImportOptions {
 // Illegal options:
 //     start_ts: 10-digit physical timestamp, e.g. 1665995420
 //     end_ts: 10-digit physical timestamp, e.g. 1665995420
+//     end_hybrid_ts: hybrid timestamp, e.g. 436733858807808
+//     csv options: see ParseCSVOptions
+//     dry_run: true or false
+//     dry_run_max_bad_rows: non-negative integer
+//     priority: non-negative integer
+//     numpy_field_mapping: see ParseNumpyFieldMapping
 func ValidateOptions(options []*commonpb.KeyValuePair) error {
 	optionMap := funcutil.KeyValuePair2Map(options)
 	// StartTs should be int
@@ -81,7 +130,133 @@ func ValidateOptions(options []*commonpb.KeyValuePair) error {
 	if startTs > endTs {
 		return errors.New("start_ts shouldn't be larger than end_ts")
 	}
-	return nil
+	if value, ok := optionMap[EndHybridTs]; ok {
+		endHybridTs, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("illegal value '%s' for %s, should be a hybrid timestamp", value, EndHybridTs)
+		}
+		if tsoutil.ComposeTS(int64(startTs), 0) > endHybridTs {
+			return errors.New("start_ts shouldn't be larger than end_hybrid_ts")
+		}
+	}
+	if value, ok := optionMap[DryRun]; ok {
+		if _, err = strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("illegal value '%s' for %s, should be true or false", value, DryRun)
+		}
+	}
+	if _, err = ParseDryRunMaxBadRows(options); err != nil {
+		return err
+	}
+	if _, err = ParsePriority(options); err != nil {
+		return err
+	}
+	if _, err = ParseNumpyFieldMapping(options); err != nil {
+		return err
+	}
+	_, err = ParseCSVOptions(options)
+	return err
+}
+
+// GetDelimiter returns the column delimiter of the dialect
+func (o CSVOptions) GetDelimiter() rune {
+	if o.Delimiter == 0 {
+		return ','
+	}
+	return o.Delimiter
+}
+
+// GetQuote returns the quote character of the dialect, zero if quoting is disabled
+func (o CSVOptions) GetQuote() rune {
+	if o.DisableQuote {
+		return 0
+	}
+	if o.Quote == 0 {
+		return '"'
+	}
+	return o.Quote
+}
+
+// parseCSVChar parses a single character option, escape sequence \t is for tab
+func parseCSVChar(key string, value string) (rune, error) {
+	if value == "\\t" {
+		return '\t', nil
+	}
+	if utf8.RuneCountInString(value) != 1 {
+		return 0, fmt.Errorf("%s should be a single character, but get '%s'", key, value)
+	}
+	c, _ := utf8.DecodeRuneInString(value)
+	if c == '\r' || c == '\n' || c == utf8.RuneError {
+		return 0, fmt.Errorf("illegal character '%s' for %s", value, key)
+	}
+	return c, nil
+}
+
+// ParseCSVOptions gets the dialect of csv files from input options
+func ParseCSVOptions(options []*commonpb.KeyValuePair) (CSVOptions, error) {
+	csvOptions := CSVOptions{}
+	optionMap := funcutil.KeyValuePair2Map(options)
+	var err error
+	if value, ok := optionMap[CSVDelimiter]; ok {
+		csvOptions.Delimiter, err = parseCSVChar(CSVDelimiter, value)
+		if err != nil {
+			return csvOptions, err
+		}
+	}
+	if value, ok := optionMap[CSVQuote]; ok {
+		if value == "" {
+			csvOptions.DisableQuote = true
+		} else {
+			csvOptions.Quote, err = parseCSVChar(CSVQuote, value)
+			if err != nil {
+				return csvOptions, err
+			}
+		}
+	}
+	if !csvOptions.DisableQuote && csvOptions.GetDelimiter() == csvOptions.GetQuote() {
+		return csvOptions, errors.New("csv_delimiter and csv_quote shouldn't be the same character")
+	}
+	csvOptions.NullValue = optionMap[CSVNullValue]
+
+	if value, ok := optionMap[CSVHeaderMapping]; ok && value != "" {
+		csvOptions.HeaderMapping, err = parseFieldMapping("csv header", value)
+		if err != nil {
+			return csvOptions, err
+		}
+	}
+	return csvOptions, nil
+}
+
+// ParseNumpyFieldMapping gets the mapping from numpy file names(without extension) or npz array names to field names,
+// the names not in the mapping are field names
+func ParseNumpyFieldMapping(options []*commonpb.KeyValuePair) (map[string]string, error) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(NumpyFieldMapping, options)
+	if err != nil || value == "" {
+		return nil, nil
+	}
+	return parseFieldMapping("numpy", value)
+}
+
+// parseFieldMapping parses a mapping in the format of name1:field1,name2:field2, each name and each field
+// can only appear once
+func parseFieldMapping(kind string, value string) (map[string]string, error) {
+	mapping := make(map[string]string)
+	fieldNames := make(map[string]struct{})
+	for _, pair := range strings.Split(value, ",") {
+		kv := strings.Split(pair, ":")
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" || strings.TrimSpace(kv[1]) == "" {
+			return nil, fmt.Errorf("illegal %s mapping '%s', should be in the format of name:field", kind, pair)
+		}
+		name, fieldName := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+		if _, ok := mapping[name]; ok {
+			return nil, fmt.Errorf("duplicate name '%s' in %s mapping", name, kind)
+		}
+		if _, ok := fieldNames[fieldName]; ok {
+			return nil, fmt.Errorf("duplicate field '%s' in %s mapping", fieldName, kind)
+		}
+		mapping[name] = fieldName
+		fieldNames[fieldName] = struct{}{}
+	}
+	return mapping, nil
 }
 
 // ParseTSFromOptions get (start_ts, end_ts, error) from input options.
@@ -101,6 +276,10 @@ func ParseTSFromOptions(options []*commonpb.KeyValuePair) (uint64, uint64, error
 	} else {
 		tsStart = 0
 	}
+	if value, ok := importOptions[EndHybridTs]; ok {
+		tsEnd, _ = strconv.ParseUint(value, 10, 64)
+		return tsStart, tsEnd, nil
+	}
 	value, ok = importOptions[EndTs]
 	if ok {
 		pTs, _ := strconv.ParseInt(value, 10, 64)
@@ -119,3 +298,39 @@ func IsBackup(options []*commonpb.KeyValuePair) bool {
 	}
 	return true
 }
+
+// IsDryRun returns if the request only validates the files without importing any data
+func IsDryRun(options []*commonpb.KeyValuePair) bool {
+	dryRun, err := funcutil.GetAttrByKeyFromRepeatedKV(DryRun, options)
+	if err != nil {
+		return false
+	}
+	value, err := strconv.ParseBool(dryRun)
+	return err == nil && value
+}
+
+// ParseDryRunMaxBadRows gets the max number of bad rows recorded for each file in dry-run report
+func ParseDryRunMaxBadRows(options []*commonpb.KeyValuePair) (int, error) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(DryRunMaxBadRows, options)
+	if err != nil {
+		return DefaultDryRunMaxBadRows, nil
+	}
+	maxBadRows, err := strconv.Atoi(value)
+	if err != nil || maxBadRows < 0 {
+		return 0, fmt.Errorf("illegal value '%s' for %s, should be a non-negative integer", value, DryRunMaxBadRows)
+	}
+	return maxBadRows, nil
+}
+
+// ParsePriority gets the priority of the import tasks, the default priority is 0
+func ParsePriority(options []*commonpb.KeyValuePair) (int32, error) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(Priority, options)
+	if err != nil {
+		return 0, nil
+	}
+	priority, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || priority < 0 {
+		return 0, fmt.Errorf("illegal value '%s' for %s, should be a non-negative integer", value, Priority)
+	}
+	return int32(priority), nil
+}
@@ -86,6 +86,27 @@ func TestParseTSFromOptions(t *testing.T) {
 	assert.Equal(t, uint64(0), tsStart)
 	assert.Equal(t, uint64(0), tsEnd)
 	assert.Error(t, err)
+
+	// the hybrid end ts is taken as it is and takes precedence over end_ts
+	tsStart, tsEnd, err = ParseTSFromOptions([]*commonpb.KeyValuePair{
+		{Key: "start_ts", Value: "0"},
+		{Key: "end_ts", Value: "1"},
+		{Key: "end_hybrid_ts", Value: "436733858807813"},
+	})
+	assert.Equal(t, uint64(0), tsStart)
+	assert.Equal(t, uint64(436733858807813), tsEnd)
+	assert.NoError(t, err)
+
+	_, _, err = ParseTSFromOptions([]*commonpb.KeyValuePair{
+		{Key: "start_ts", Value: "1666007457"},
+		{Key: "end_hybrid_ts", Value: "436733858807807"},
+	})
+	assert.Error(t, err)
+
+	_, _, err = ParseTSFromOptions([]*commonpb.KeyValuePair{
+		{Key: "end_hybrid_ts", Value: "ts"},
+	})
+	assert.Error(t, err)
 }
 
 func TestIsBackup(t *testing.T) {
@@ -106,3 +127,110 @@ func TestIsBackup(t *testing.T) {
 	})
 	assert.Equal(t, false, noBackup)
 }
+
+func TestParseCSVOptions(t *testing.T) {
+	csvOptions, err := ParseCSVOptions([]*commonpb.KeyValuePair{})
+	assert.NoError(t, err)
+	assert.Equal(t, ',', csvOptions.GetDelimiter())
+	assert.Equal(t, '"', csvOptions.GetQuote())
+	assert.Equal(t, "", csvOptions.NullValue)
+	assert.Nil(t, csvOptions.HeaderMapping)
+
+	csvOptions, err = ParseCSVOptions([]*commonpb.KeyValuePair{
+		{Key: CSVDelimiter, Value: "\\t"},
+		{Key: CSVQuote, Value: "'"},
+		{Key: CSVNullValue, Value: "NULL"},
+		{Key: CSVHeaderMapping, Value: "id:uid, Name : name"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, '\t', csvOptions.GetDelimiter())
+	assert.Equal(t, '\'', csvOptions.GetQuote())
+	assert.Equal(t, "NULL", csvOptions.NullValue)
+	assert.Equal(t, map[string]string{"id": "uid", "Name": "name"}, csvOptions.HeaderMapping)
+
+	csvOptions, err = ParseCSVOptions([]*commonpb.KeyValuePair{
+		{Key: CSVDelimiter, Value: "|"},
+		{Key: CSVQuote, Value: ""},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, '|', csvOptions.GetDelimiter())
+	assert.Equal(t, rune(0), csvOptions.GetQuote())
+
+	invalids := [][]*commonpb.KeyValuePair{
+		{{Key: CSVDelimiter, Value: ""}},
+		{{Key: CSVDelimiter, Value: ";;"}},
+		{{Key: CSVDelimiter, Value: "\n"}},
+		{{Key: CSVQuote, Value: ","}},
+		{{Key: CSVDelimiter, Value: "'"}, {Key: CSVQuote, Value: "'"}},
+		{{Key: CSVHeaderMapping, Value: "id"}},
+		{{Key: CSVHeaderMapping, Value: "id:uid:x"}},
+		{{Key: CSVHeaderMapping, Value: "id:uid,id:name"}},
+		{{Key: CSVHeaderMapping, Value: "id:uid,key:uid"}},
+	}
+	for _, options := range invalids {
+		_, err = ParseCSVOptions(options)
+		assert.Error(t, err)
+		assert.Error(t, ValidateOptions(options))
+	}
+}
+
+func TestDryRunOptions(t *testing.T) {
+	assert.False(t, IsDryRun([]*commonpb.KeyValuePair{}))
+	assert.False(t, IsDryRun([]*commonpb.KeyValuePair{{Key: DryRun, Value: "false"}}))
+	assert.False(t, IsDryRun([]*commonpb.KeyValuePair{{Key: DryRun, Value: "dummy"}}))
+	assert.True(t, IsDryRun([]*commonpb.KeyValuePair{{Key: DryRun, Value: "true"}}))
+	assert.True(t, IsDryRun([]*commonpb.KeyValuePair{{Key: DryRun, Value: "True"}}))
+
+	maxBadRows, err := ParseDryRunMaxBadRows([]*commonpb.KeyValuePair{})
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultDryRunMaxBadRows, maxBadRows)
+	maxBadRows, err = ParseDryRunMaxBadRows([]*commonpb.KeyValuePair{{Key: DryRunMaxBadRows, Value: "100"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 100, maxBadRows)
+	_, err = ParseDryRunMaxBadRows([]*commonpb.KeyValuePair{{Key: DryRunMaxBadRows, Value: "-1"}})
+	assert.Error(t, err)
+	_, err = ParseDryRunMaxBadRows([]*commonpb.KeyValuePair{{Key: DryRunMaxBadRows, Value: "a"}})
+	assert.Error(t, err)
+
+	assert.NoError(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: DryRun, Value: "true"},
+		{Key: DryRunMaxBadRows, Value: "0"},
+	}))
+	assert.Error(t, ValidateOptions([]*commonpb.KeyValuePair{{Key: DryRun, Value: "dummy"}}))
+	assert.Error(t, ValidateOptions([]*commonpb.KeyValuePair{{Key: DryRunMaxBadRows, Value: "dummy"}}))
+}
+
+func Test_ParsePriority(t *testing.T) {
+	priority, err := ParsePriority([]*commonpb.KeyValuePair{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), priority)
+	priority, err = ParsePriority([]*commonpb.KeyValuePair{{Key: Priority, Value: "10"}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(10), priority)
+	_, err = ParsePriority([]*commonpb.KeyValuePair{{Key: Priority, Value: "-1"}})
+	assert.Error(t, err)
+	_, err = ParsePriority([]*commonpb.KeyValuePair{{Key: Priority, Value: "a"}})
+	assert.Error(t, err)
+	_, err = ParsePriority([]*commonpb.KeyValuePair{{Key: Priority, Value: "4294967296"}})
+	assert.Error(t, err)
+
+	assert.NoError(t, ValidateOptions([]*commonpb.KeyValuePair{{Key: Priority, Value: "1"}}))
+	assert.Error(t, ValidateOptions([]*commonpb.KeyValuePair{{Key: Priority, Value: "dummy"}}))
+}
+
+func Test_ParseNumpyFieldMapping(t *testing.T) {
+	mapping, err := ParseNumpyFieldMapping([]*commonpb.KeyValuePair{})
+	assert.NoError(t, err)
+	assert.Nil(t, mapping)
+	mapping, err = ParseNumpyFieldMapping([]*commonpb.KeyValuePair{{Key: NumpyFieldMapping, Value: "emb:vector, item_id : id"}})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"emb": "vector", "item_id": "id"}, mapping)
+
+	invalids := []string{"emb", "emb:vector:x", "emb:vector,emb:id", "emb:vector,item_id:vector"}
+	for _, value := range invalids {
+		_, err = ParseNumpyFieldMapping([]*commonpb.KeyValuePair{{Key: NumpyFieldMapping, Value: value}})
+		assert.Error(t, err)
+		assert.Error(t, ValidateOptions([]*commonpb.KeyValuePair{{Key: NumpyFieldMapping, Value: value}}))
+	}
+	assert.NoError(t, ValidateOptions([]*commonpb.KeyValuePair{{Key: NumpyFieldMapping, Value: "emb:vector"}}))
+}
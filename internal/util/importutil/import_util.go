@@ -31,9 +31,11 @@ import (
 	"go.uber.org/zap/zapcore"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func isCanceled(ctx context.Context) bool {
@@ -108,6 +110,25 @@ func initSegmentData(collectionSchema *schemapb.CollectionSchema) map[storage.Fi
 				NumRows: []int64{0},
 				Dim:     dim,
 			}
+		case typeutil.Float16VectorType:
+			dim, _ := getFieldDimension(schema)
+			segmentData[schema.GetFieldID()] = &storage.Float16VectorFieldData{
+				Data:    make([]byte, 0),
+				NumRows: []int64{0},
+				Dim:     dim,
+			}
+		case typeutil.BFloat16VectorType:
+			dim, _ := getFieldDimension(schema)
+			segmentData[schema.GetFieldID()] = &storage.BFloat16VectorFieldData{
+				Data:    make([]byte, 0),
+				NumRows: []int64{0},
+				Dim:     dim,
+			}
+		case typeutil.SparseFloatVectorType:
+			segmentData[schema.GetFieldID()] = &storage.SparseFloatVectorFieldData{
+				Data:    make([][]byte, 0),
+				NumRows: []int64{0},
+			}
 		case schemapb.DataType_String, schemapb.DataType_VarChar:
 			segmentData[schema.GetFieldID()] = &storage.StringFieldData{
 				Data:    make([]string, 0),
@@ -137,6 +158,27 @@ func parseFloat(s string, bitsize int, fieldName string) (float64, error) {
 }
 
 // initValidators constructs valiator methods and data conversion methods
+// initDefaultValidator prepares the value to fill null or missing value of nullable field or field with default value.
+func initDefaultValidator(schema *schemapb.FieldSchema, validator *Validator) error {
+	_, validator.hasDefault = typeutil.GetFieldDefaultValue(schema)
+	validator.nullable = typeutil.IsFieldNullable(schema)
+	if !validator.hasDefault && !validator.nullable {
+		return nil
+	}
+
+	value, err := typeutil.ParseFieldDefaultValue(schema)
+	if err != nil {
+		return fmt.Errorf("failed to parse default value of field '%s', error: %w", schema.GetName(), err)
+	}
+	switch v := value.(type) {
+	case bool, string:
+		validator.defaultObj = v
+	default:
+		validator.defaultObj = json.Number(fmt.Sprintf("%v", v))
+	}
+	return nil
+}
+
 func initValidators(collectionSchema *schemapb.CollectionSchema, validators map[storage.FieldID]*Validator) error {
 	if collectionSchema == nil {
 		return errors.New("collection schema is nil")
@@ -150,6 +192,9 @@ func initValidators(collectionSchema *schemapb.CollectionSchema, validators map[
 		validators[schema.GetFieldID()].autoID = schema.GetAutoID()
 		validators[schema.GetFieldID()].fieldName = schema.GetName()
 		validators[schema.GetFieldID()].isString = false
+		if err := initDefaultValidator(schema, validators[schema.GetFieldID()]); err != nil {
+			return err
+		}
 
 		switch schema.DataType {
 		case schemapb.DataType_Bool:
@@ -311,6 +356,67 @@ func initValidators(collectionSchema *schemapb.CollectionSchema, validators map[
 				field.(*storage.FloatVectorFieldData).NumRows[0]++
 				return nil
 			}
+		case typeutil.Float16VectorType, typeutil.BFloat16VectorType:
+			dim, err := getFieldDimension(schema)
+			if err != nil {
+				return err
+			}
+			validators[schema.GetFieldID()].dimension = dim
+
+			// half precision vectors are float arrays in json file, converted to the encoding of the field type
+			dataType := schema.GetDataType()
+			validators[schema.GetFieldID()].convertFunc = func(obj interface{}, field storage.FieldData) error {
+				arr, ok := obj.([]interface{})
+				if !ok {
+					return fmt.Errorf("'%v' is not an array for %s field '%s'", obj, getTypeName(dataType), schema.GetName())
+				}
+				if len(arr) != dim {
+					return fmt.Errorf("array size %d doesn't equal to vector dimension %d of field '%s'", len(arr), dim, schema.GetName())
+				}
+
+				values := make([]float32, 0, dim)
+				for i := 0; i < len(arr); i++ {
+					if num, ok := arr[i].(json.Number); ok {
+						value, err := parseFloat(string(num), 32, schema.GetName())
+						if err != nil {
+							return err
+						}
+						values = append(values, float32(value))
+					} else {
+						return fmt.Errorf("illegal value '%v' for %s field '%s'", obj, getTypeName(dataType), schema.GetName())
+					}
+				}
+				encoded, err := typeutil.EncodeHalfFloatVector(dataType, values)
+				if err != nil {
+					return err
+				}
+
+				switch arr := field.(type) {
+				case *storage.Float16VectorFieldData:
+					arr.Data = append(arr.Data, encoded...)
+					arr.NumRows[0]++
+				case *storage.BFloat16VectorFieldData:
+					arr.Data = append(arr.Data, encoded...)
+					arr.NumRows[0]++
+				}
+				return nil
+			}
+		case typeutil.SparseFloatVectorType:
+			// a sparse float vector is either {"indices": [1, 5], "values": [0.1, 0.2]} or {"1": 0.1, "5": 0.2} in json file
+			validators[schema.GetFieldID()].convertFunc = func(obj interface{}, field storage.FieldData) error {
+				input, ok := obj.(map[string]interface{})
+				if !ok {
+					return fmt.Errorf("'%v' is not an object for sparse float vector field '%s'", obj, schema.GetName())
+				}
+				row, err := typeutil.CreateSparseFloatRowFromMap(input)
+				if err != nil {
+					return fmt.Errorf("illegal value '%v' for sparse float vector field '%s', error: %w", obj, schema.GetName(), err)
+				}
+
+				field.(*storage.SparseFloatVectorFieldData).AppendAllRows([][]byte{row})
+				field.(*storage.SparseFloatVectorFieldData).NumRows[0]++
+				return nil
+			}
 		case schemapb.DataType_String, schemapb.DataType_VarChar:
 			validators[schema.GetFieldID()].isString = true
 
@@ -343,15 +449,36 @@ func printFieldsDataInfo(fieldsData map[storage.FieldID]storage.FieldData, msg s
 	log.Info(msg, stats...)
 }
 
-// GetFileNameAndExt extracts file name and extension
-// for example: "/a/b/c.ttt" returns "c" and ".ttt"
+// GetFileNameAndExt extracts file name and extension, the compression extension is ignored
+// for example: "/a/b/c.ttt" returns "c" and ".ttt", "/a/b/c.json.gz" returns "c" and ".json"
 func GetFileNameAndExt(filePath string) (string, string) {
-	fileName := path.Base(filePath)
+	fileName := strings.TrimSuffix(path.Base(filePath), GetCompressionExt(filePath))
 	fileType := path.Ext(fileName)
 	fileNameWithoutExt := strings.TrimSuffix(fileName, fileType)
 	return fileNameWithoutExt, fileType
 }
 
+// allocAutoIDs allocates a range of ids for the rows, the ids are used as auto-generated primary keys and row ids
+// both row-based and column-based imports generate ids by this method, the returned range is [begin, end)
+func allocAutoIDs(idAllocator *allocator.IDAllocator, count int) (int64, int64, error) {
+	if idAllocator == nil {
+		log.Error("import util: primary keys is auto-generated but IDAllocator is nil")
+		return 0, 0, errors.New("primary keys is auto-generated but IDAllocator is nil")
+	}
+
+	begin, end, err := idAllocator.Alloc(uint32(count))
+	if err != nil {
+		log.Error("import util: failed to generate primary keys", zap.Int("count", count), zap.Error(err))
+		return 0, 0, fmt.Errorf("failed to generate %d primary keys, error: %w", count, err)
+	}
+	if end-begin != int64(count) {
+		log.Error("import util: try to generate primary keys but allocated ids are not enough",
+			zap.Int("count", count), zap.Int64("generated", end-begin))
+		return 0, 0, fmt.Errorf("try to generate %d primary keys but only %d keys were allocated", count, end-begin)
+	}
+	return begin, end, nil
+}
+
 // getFieldDimension gets dimension of vecotor field
 func getFieldDimension(schema *schemapb.FieldSchema) (int, error) {
 	for _, kvPair := range schema.GetTypeParams() {
@@ -515,6 +642,12 @@ func getTypeName(dt schemapb.DataType) string {
 		return "BinaryVector"
 	case schemapb.DataType_FloatVector:
 		return "FloatVector"
+	case typeutil.Float16VectorType:
+		return "Float16Vector"
+	case typeutil.BFloat16VectorType:
+		return "BFloat16Vector"
+	case typeutil.SparseFloatVectorType:
+		return "SparseFloatVector"
 	default:
 		return "InvalidType"
 	}
@@ -20,11 +20,13 @@ import (
 	"encoding/json"
 	"errors"
 	"math"
+	"strings"
 	"testing"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -447,6 +449,132 @@ func Test_InitValidators(t *testing.T) {
 	})
 }
 
+func Test_InitValidatorsSparseFloatVector(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name:   "schema",
+		AutoID: true,
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      101,
+				Name:         "uid",
+				IsPrimaryKey: true,
+				AutoID:       true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:  102,
+				Name:     "FieldSparseFloatVector",
+				DataType: typeutil.SparseFloatVectorType,
+			},
+		},
+	}
+
+	validators := make(map[storage.FieldID]*Validator)
+	err := initValidators(schema, validators)
+	assert.Nil(t, err)
+
+	fields := initSegmentData(schema)
+	assert.NotNil(t, fields)
+	fieldData := fields[102].(*storage.SparseFloatVectorFieldData)
+	convertFunc := validators[102].convertFunc
+
+	parse := func(s string) interface{} {
+		var obj interface{}
+		decoder := json.NewDecoder(strings.NewReader(s))
+		decoder.UseNumber()
+		assert.Nil(t, decoder.Decode(&obj))
+		return obj
+	}
+
+	err = convertFunc(parse(`{"indices": [8, 2], "values": [0.8, 0.2]}`), fieldData)
+	assert.Nil(t, err)
+	err = convertFunc(parse(`{"3": 0.3}`), fieldData)
+	assert.Nil(t, err)
+	err = convertFunc(parse(`{}`), fieldData)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, fieldData.RowNum())
+	assert.Equal(t, int64(3), fieldData.NumRows[0])
+	assert.Equal(t, int64(9), fieldData.Dim)
+	assert.Equal(t, typeutil.CreateSparseFloatRow([]uint32{2, 8}, []float32{0.2, 0.8}), fieldData.Data[0])
+
+	invalidVals := []string{
+		`[1, 2]`,
+		`{"indices": [1, 1], "values": [0.1, 0.2]}`,
+		`{"indices": [1], "values": [0.1, 0.2]}`,
+		`{"a": 0.1}`,
+		`{"1": "a"}`,
+	}
+	for _, val := range invalidVals {
+		err = convertFunc(parse(val), fieldData)
+		assert.NotNil(t, err, val)
+	}
+	assert.Equal(t, 3, fieldData.RowNum())
+}
+
+func Test_InitValidatorsHalfFloatVector(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name:   "schema",
+		AutoID: true,
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      101,
+				Name:         "uid",
+				IsPrimaryKey: true,
+				AutoID:       true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:    102,
+				Name:       "FieldFloat16Vector",
+				DataType:   typeutil.Float16VectorType,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}},
+			},
+			{
+				FieldID:    103,
+				Name:       "FieldBFloat16Vector",
+				DataType:   typeutil.BFloat16VectorType,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}},
+			},
+		},
+	}
+
+	validators := make(map[storage.FieldID]*Validator)
+	err := initValidators(schema, validators)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, validators[102].dimension)
+
+	fields := initSegmentData(schema)
+	assert.NotNil(t, fields)
+	float16Data := fields[102].(*storage.Float16VectorFieldData)
+	bfloat16Data := fields[103].(*storage.BFloat16VectorFieldData)
+
+	row := []interface{}{json.Number("0.5"), json.Number("-1")}
+	err = validators[102].convertFunc(row, float16Data)
+	assert.Nil(t, err)
+	err = validators[103].convertFunc(row, bfloat16Data)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, float16Data.RowNum())
+	assert.Equal(t, 1, bfloat16Data.RowNum())
+	expected, err := typeutil.EncodeHalfFloatVector(typeutil.Float16VectorType, []float32{0.5, -1})
+	assert.Nil(t, err)
+	assert.Equal(t, expected, float16Data.Data)
+	expected, err = typeutil.EncodeHalfFloatVector(typeutil.BFloat16VectorType, []float32{0.5, -1})
+	assert.Nil(t, err)
+	assert.Equal(t, expected, bfloat16Data.Data)
+
+	invalidVals := []interface{}{
+		json.Number("1"),
+		[]interface{}{json.Number("1")},
+		[]interface{}{json.Number("1"), "a"},
+		[]interface{}{json.Number("1"), json.Number("1e100")},
+	}
+	for _, val := range invalidVals {
+		err = validators[102].convertFunc(val, float16Data)
+		assert.NotNil(t, err, val)
+	}
+	assert.Equal(t, 1, float16Data.RowNum())
+}
+
 func Test_GetFileNameAndExt(t *testing.T) {
 	filePath := "aaa/bbb/ccc.txt"
 	name, ext := GetFileNameAndExt(filePath)
@@ -608,6 +736,12 @@ func Test_GetTypeName(t *testing.T) {
 	assert.NotEmpty(t, str)
 	str = getTypeName(schemapb.DataType_FloatVector)
 	assert.NotEmpty(t, str)
+	str = getTypeName(typeutil.SparseFloatVectorType)
+	assert.Equal(t, "SparseFloatVector", str)
+	str = getTypeName(typeutil.Float16VectorType)
+	assert.Equal(t, "Float16Vector", str)
+	str = getTypeName(typeutil.BFloat16VectorType)
+	assert.Equal(t, "BFloat16Vector", str)
 	str = getTypeName(schemapb.DataType_None)
 	assert.Equal(t, "InvalidType", str)
 }
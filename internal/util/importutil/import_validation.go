@@ -0,0 +1,428 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/apache/arrow/go/v8/parquet/file"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+)
+
+// ValidationReport is the key of dry-run report in the infos of import result
+const ValidationReport = "validation_report"
+
+// BadRow is a row failed to pass the validation
+type BadRow struct {
+	Row    int64  `json:"row"`    // row number in the file, starts from 0
+	Reason string `json:"reason"` // why the row is rejected
+}
+
+// FileValidationReport is the dry-run result of a file
+type FileValidationReport struct {
+	File        string   `json:"file"`
+	RowCount    int64    `json:"row_count"`          // number of rows in the file, bad rows included
+	BadRowCount int64    `json:"bad_row_count"`      // number of bad rows, only the first N bad rows are recorded
+	BadRows     []BadRow `json:"bad_rows,omitempty"` // the first N bad rows
+	Error       string   `json:"error,omitempty"`    // the reason why the file can't be parsed
+}
+
+// addBadRow records the next row of a row-based file as a bad row
+func (r *FileValidationReport) addBadRow(reason string, maxBadRows int) {
+	r.recordBadRow(r.RowCount, reason, maxBadRows)
+	r.RowCount++
+}
+
+// recordBadRow records a bad row of a column-based file, the row count is given by the file metadata
+func (r *FileValidationReport) recordBadRow(row int64, reason string, maxBadRows int) {
+	if len(r.BadRows) < maxBadRows {
+		r.BadRows = append(r.BadRows, BadRow{Row: row, Reason: reason})
+	}
+	r.BadRowCount++
+}
+
+// BadRowFunc is the bad row handler of column-based parsers for dry run, it's called with the row number
+// in the file and the first error of the row, the bad rows are passed in ascending order
+type BadRowFunc func(row int64, err error) error
+
+// handleBadRows passes the bad rows to the handler in ascending order
+func handleBadRows(badRows map[int64]error, handler BadRowFunc) error {
+	if len(badRows) == 0 {
+		return nil
+	}
+	rows := make([]int64, 0, len(badRows))
+	for row := range badRows {
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i] < rows[j]
+	})
+	for _, row := range rows {
+		if err := handler(row, badRows[row]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rowValidator is the row handler for dry-run of row-based files. It validates rows in the same way as
+// JSONRowConsumer, but the bad rows are recorded instead of aborting the parse process, and no data is kept.
+type rowValidator struct {
+	collectionSchema *schemapb.CollectionSchema     // collection schema
+	validators       map[storage.FieldID]*Validator // validators for each field
+	primaryKey       storage.FieldID                // field id of primary key
+	report           *FileValidationReport          // validation result of the file
+	maxBadRows       int                            // max number of bad rows recorded in the report
+}
+
+func newRowValidator(collectionSchema *schemapb.CollectionSchema, report *FileValidationReport, maxBadRows int) (*rowValidator, error) {
+	if collectionSchema == nil {
+		log.Error("row validator: collection schema is nil")
+		return nil, errors.New("collection schema is nil")
+	}
+
+	v := &rowValidator{
+		collectionSchema: collectionSchema,
+		validators:       make(map[storage.FieldID]*Validator),
+		primaryKey:       -1,
+		report:           report,
+		maxBadRows:       maxBadRows,
+	}
+
+	err := initValidators(collectionSchema, v.validators)
+	if err != nil {
+		log.Error("row validator: fail to initialize validators", zap.Error(err))
+		return nil, fmt.Errorf("fail to initialize validators, error: %w", err)
+	}
+
+	for _, schema := range collectionSchema.Fields {
+		if schema.GetIsPrimaryKey() {
+			v.primaryKey = schema.GetFieldID()
+			break
+		}
+	}
+	if v.primaryKey == -1 {
+		log.Error("row validator: collection schema has no primary key")
+		return nil, errors.New("collection schema has no primary key")
+	}
+	if v.validators[v.primaryKey].isString && v.validators[v.primaryKey].autoID {
+		log.Error("row validator: string type primary key cannot be auto-generated")
+		return nil, errors.New("string type primary key cannot be auto-generated")
+	}
+
+	return v, nil
+}
+
+func (v *rowValidator) Handle(rows []map[storage.FieldID]interface{}) error {
+	// all rows have been validated
+	if rows == nil {
+		return nil
+	}
+
+	// the converted values are dropped after the batch is validated
+	fieldsData := initSegmentData(v.collectionSchema)
+	if fieldsData == nil {
+		log.Error("row validator: fail to initialize in-memory segment data")
+		return errors.New("fail to initialize in-memory segment data")
+	}
+
+	for _, row := range rows {
+		if err := v.validateRow(row, fieldsData); err != nil {
+			v.report.addBadRow(err.Error(), v.maxBadRows)
+			continue
+		}
+		v.report.RowCount++
+	}
+	return nil
+}
+
+func (v *rowValidator) HandleBadRow(err error) error {
+	v.report.addBadRow(err.Error(), v.maxBadRows)
+	return nil
+}
+
+func (v *rowValidator) validateRow(row map[storage.FieldID]interface{}, fieldsData map[storage.FieldID]storage.FieldData) error {
+	primaryValidator := v.validators[v.primaryKey]
+	if !primaryValidator.autoID {
+		value := row[v.primaryKey]
+		if primaryValidator.isString {
+			if _, ok := value.(string); !ok {
+				return fmt.Errorf("illegal value '%v' for varchar type primary key '%s'", value, primaryValidator.fieldName)
+			}
+		} else {
+			num, ok := value.(json.Number)
+			if !ok {
+				return fmt.Errorf("illegal value '%v' for int64 type primary key '%s'", value, primaryValidator.fieldName)
+			}
+			if _, err := strconv.ParseInt(string(num), 10, 64); err != nil {
+				return fmt.Errorf("failed to parse primary key '%s', error: %w", string(num), err)
+			}
+		}
+	}
+
+	for fieldID, validator := range v.validators {
+		if validator.primaryKey {
+			continue
+		}
+		value, ok := row[fieldID]
+		if value == nil && (validator.nullable || validator.hasDefault) {
+			if ok && validator.nullable {
+				continue
+			}
+			value = validator.defaultObj
+		}
+		if err := validator.convertFunc(value, fieldsData[fieldID]); err != nil {
+			return fmt.Errorf("failed to convert value for field '%s', error: %w", validator.fieldName, err)
+		}
+	}
+	return nil
+}
+
+// dryRun validates all rows of the files without allocating segments or writing binlogs.
+// The per-file report is passed to rootcoord in the import result, the task is completed if
+// no bad row is found, otherwise an error is returned to mark the task failed.
+func (p *ImportWrapper) dryRun(filePaths []string, options ImportOptions) error {
+	if options.IsBackup && p.isBinlogImport(filePaths) {
+		log.Error("import wrapper: dry run is not supported for binlog import")
+		return errors.New("dry run is not supported for binlog import")
+	}
+
+	rowBased, err := p.fileValidation(filePaths, options)
+	if err != nil {
+		return err
+	}
+
+	reports := make([]*FileValidationReport, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		report := &FileValidationReport{File: filePath}
+		reports = append(reports, report)
+
+		_, fileType := GetFileNameAndExt(filePath)
+		switch fileType {
+		case JSONFileExt:
+			err = p.validateRowBasedFile(filePath, report, options, func(validator *rowValidator) error {
+				file, err := OpenImportFile(p.ctx, p.chunkManager, filePath)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				return NewJSONParser(p.ctx, p.collectionSchema).ParseRows(bufio.NewReader(file), validator)
+			})
+		case CSVFileExt:
+			err = p.validateRowBasedFile(filePath, report, options, func(validator *rowValidator) error {
+				file, err := OpenImportFile(p.ctx, p.chunkManager, filePath)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				return NewCSVParser(p.ctx, p.collectionSchema, options.CSV).ParseRows(file, validator)
+			})
+		case ParquetFileExt:
+			err = p.validateParquet(filePath, report, options.MaxBadRows)
+		case NumpyFileExt, NpzFileExt:
+			err = p.validateColumnBasedNumpy(filePath, report, options)
+		}
+		if err != nil {
+			log.Warn("import wrapper: dry run failed to parse file", zap.String("filePath", filePath), zap.Error(err))
+			report.Error = err.Error()
+		}
+
+		// trigger gc after each file finished
+		triggerGC()
+	}
+
+	// all the numpy files are columns of the same rows
+	if !rowBased {
+		rowCount := int64(-1)
+		for _, report := range reports {
+			if report.Error != "" || report.RowCount == 0 {
+				continue
+			}
+			if rowCount < 0 {
+				rowCount = report.RowCount
+			} else if report.RowCount != rowCount {
+				report.Error = fmt.Sprintf("the row count %d doesn't equal to row count %d of other files", report.RowCount, rowCount)
+			}
+		}
+	}
+
+	return p.reportValidation(reports)
+}
+
+// validateRowBasedFile validates a row-based file by the given parse function
+func (p *ImportWrapper) validateRowBasedFile(filePath string, report *FileValidationReport, options ImportOptions,
+	parseFunc func(validator *rowValidator) error) error {
+	tr := timerecord.NewTimeRecorder("row-based validation: " + filePath)
+	defer tr.Elapse("validated")
+
+	validator, err := newRowValidator(p.collectionSchema, report, options.MaxBadRows)
+	if err != nil {
+		return err
+	}
+	return parseFunc(validator)
+}
+
+// validateParquet reads all row groups of a parquet file, the bad rows are recorded and the data is dropped
+func (p *ImportWrapper) validateParquet(filePath string, report *FileValidationReport, maxBadRows int) error {
+	tr := timerecord.NewTimeRecorder("parquet validation: " + filePath)
+	defer tr.Elapse("validated")
+
+	reader, err := NewChunkManagerFileReader(p.ctx, p.chunkManager, filePath)
+	if err != nil {
+		return err
+	}
+
+	parser, err := NewParquetParser(p.ctx, p.collectionSchema, func(fields map[storage.FieldID]storage.FieldData) error {
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	parser.SetBadRowHandler(func(row int64, err error) error {
+		report.recordBadRow(row, err.Error(), maxBadRows)
+		return nil
+	})
+	if err = parser.Parse(reader, false); err != nil {
+		return err
+	}
+
+	// the row count is taken from the file metadata since the fields data of bad rows is incomplete
+	pqReader, err := file.NewParquetReader(reader)
+	if err != nil {
+		return fmt.Errorf("failed to open parquet file, error: %w", err)
+	}
+	defer pqReader.Close()
+	report.RowCount = pqReader.NumRows()
+	return nil
+}
+
+// validateColumnBasedNumpy reads all data of a numpy file, the bad rows are recorded and the data is dropped,
+// all the arrays of a .npz file must have the same row count
+func (p *ImportWrapper) validateColumnBasedNumpy(filePath string, report *FileValidationReport, options ImportOptions) error {
+	tr := timerecord.NewTimeRecorder("numpy validation: " + filePath)
+	defer tr.Elapse("validated")
+
+	columns, err := listNumpyColumns(p.ctx, p.chunkManager, []string{filePath}, options.NumpyFields)
+	if err != nil {
+		return err
+	}
+
+	// a row of a .npz file could be bad in several arrays, only the first error is recorded
+	badRows := make(map[int64]error)
+
+	for _, column := range columns {
+		// if the numpy array is not mapping to a field name, it's ignored in the same way as import
+		found := false
+		for _, field := range p.collectionSchema.Fields {
+			if field.GetName() == column.fieldName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			continue
+		}
+
+		rowCount, err := p.validateNumpyColumn(column, badRows)
+		if err != nil {
+			return err
+		}
+		if report.RowCount > 0 && rowCount != report.RowCount {
+			return fmt.Errorf("the row count %d of %s doesn't equal to row count %d of other arrays",
+				rowCount, column, report.RowCount)
+		}
+		report.RowCount = rowCount
+	}
+
+	return handleBadRows(badRows, func(row int64, err error) error {
+		report.recordBadRow(row, err.Error(), options.MaxBadRows)
+		return nil
+	})
+}
+
+// validateNumpyColumn reads all data of a numpy array and returns the row count, the bad rows are put into badRows
+func (p *ImportWrapper) validateNumpyColumn(column *numpyColumn, badRows map[int64]error) (int64, error) {
+	file, err := openNumpyColumn(p.ctx, p.chunkManager, column)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	rowCount := int64(0)
+	parser := NewNumpyParser(p.ctx, p.collectionSchema, func(field storage.FieldData) error {
+		rowCount = int64(field.RowNum())
+		return nil
+	})
+	parser.SetBadRowHandler(func(row int64, err error) error {
+		if _, ok := badRows[row]; !ok {
+			badRows[row] = err
+		}
+		return nil
+	})
+	err = parser.Parse(file, column.fieldName, false)
+	return rowCount, err
+}
+
+// reportValidation puts the report into import result, the task is marked completed if all the files are valid,
+// otherwise an error is returned and the caller marks the task failed
+func (p *ImportWrapper) reportValidation(reports []*FileValidationReport) error {
+	var rowCount, badRowCount int64
+	badFileCount := 0
+	for _, report := range reports {
+		rowCount += report.RowCount
+		badRowCount += report.BadRowCount
+		if report.Error != "" {
+			badFileCount++
+		}
+	}
+
+	bytes, err := json.Marshal(reports)
+	if err != nil {
+		log.Error("import wrapper: failed to encode validation report", zap.Error(err))
+		return fmt.Errorf("failed to encode validation report, error: %w", err)
+	}
+	p.importResult.RowCount = rowCount
+	p.importResult.Infos = append(p.importResult.Infos, &commonpb.KeyValuePair{Key: ValidationReport, Value: string(bytes)})
+	log.Info("import wrapper: dry run finished", zap.Int64("rowCount", rowCount), zap.Int64("badRowCount", badRowCount),
+		zap.Int("badFileCount", badFileCount))
+
+	if badRowCount > 0 || badFileCount > 0 {
+		return fmt.Errorf("dry run found %d bad rows and %d bad files", badRowCount, badFileCount)
+	}
+
+	p.importResult.State = commonpb.ImportState_ImportCompleted
+	reportErr := retry.Do(p.ctx, func() error {
+		return p.reportFunc(p.importResult)
+	}, retry.Attempts(p.reportImportAttempts))
+	if reportErr != nil {
+		log.Warn("import wrapper: fail to report validation result to RootCoord", zap.Error(reportErr))
+		return reportErr
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// dryRunWrapper creates an import wrapper whose callback functions fail, since no data should be generated in dry run
+func dryRunWrapper(ctx context.Context, t *testing.T, cm storage.ChunkManager, importResult *rootcoordpb.ImportResult,
+	reportFunc func(res *rootcoordpb.ImportResult) error) *ImportWrapper {
+	wrapper := NewImportWrapper(ctx, csvSampleSchema(), 2, 1024*1024, newIDAllocator(ctx, t, nil), cm, importResult, reportFunc)
+	err := wrapper.SetCallbackFunctions(func(shardID int) (int64, string, error) {
+		return 0, "", errors.New("segment should not be allocated in dry run")
+	}, func(fields map[storage.FieldID]storage.FieldData, segmentID int64) ([]*datapb.FieldBinlog, []*datapb.FieldBinlog, error) {
+		return nil, nil, errors.New("binlog should not be written in dry run")
+	}, func(fieldsInsert []*datapb.FieldBinlog, fieldsStats []*datapb.FieldBinlog, segmentID int64, targetChName string, rowCount int64) error {
+		return errors.New("segment should not be saved in dry run")
+	})
+	assert.NoError(t, err)
+	return wrapper
+}
+
+func getValidationReport(t *testing.T, importResult *rootcoordpb.ImportResult) []*FileValidationReport {
+	for _, kv := range importResult.GetInfos() {
+		if kv.GetKey() == ValidationReport {
+			reports := make([]*FileValidationReport, 0)
+			assert.NoError(t, json.Unmarshal([]byte(kv.GetValue()), &reports))
+			return reports
+		}
+	}
+	return nil
+}
+
+func Test_ImportWrapperDryRun(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+
+	goodCSV := path.Join(TempFilesPath, "good.csv")
+	err = cm.Write(ctx, goodCSV, []byte("uid,flag,vec,bvec\n"+
+		"1,true,1 2 3 4,1 2\n"+
+		"2,false,1 2 3 4,1 2\n"))
+	assert.NoError(t, err)
+	badCSV := path.Join(TempFilesPath, "bad.csv")
+	err = cm.Write(ctx, badCSV, []byte("uid,flag,vec,bvec\n"+
+		"1,true,1 2 3 4,1 2\n"+
+		"2,yes,1 2 3 4,1 2\n"+
+		"3,true,1 2 3 4\n"+
+		"4,true,1 2 3,1 2\n"+
+		"a,true,1 2 3 4,1 2\n"+
+		"6,true,1 2 3 4,1 2\n"))
+	assert.NoError(t, err)
+	badJSON := path.Join(TempFilesPath, "bad.json")
+	err = cm.Write(ctx, badJSON, []byte(`{"rows":[
+		{"uid": 1, "flag": true, "vec": [1, 2, 3, 4], "bvec": [1, 2]},
+		{"uid": 2, "flag": true, "vec": [1, 2, 3, 4], "bvec": [1, 2], "dummy": 1},
+		{"uid": 3, "flag": true, "vec": [1, 2, 3, 4]},
+		{"uid": 4, "flag": true, "vec": [1, 2, 3, 4], "bvec": [1, 2]}
+	]}`))
+	assert.NoError(t, err)
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	reported := 0
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		reported++
+		return nil
+	}
+
+	// all rows are valid, the task is completed without generating any data
+	importResult := &rootcoordpb.ImportResult{State: commonpb.ImportState_ImportStarted}
+	options := DefaultImportOptions()
+	options.DryRun = true
+	err = dryRunWrapper(ctx, t, cm, importResult, reportFunc).Import([]string{goodCSV}, options)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, reported)
+	assert.Equal(t, commonpb.ImportState_ImportCompleted, importResult.GetState())
+	assert.Equal(t, int64(2), importResult.GetRowCount())
+	assert.Empty(t, importResult.GetSegments())
+	reports := getValidationReport(t, importResult)
+	assert.Equal(t, 1, len(reports))
+	assert.Equal(t, goodCSV, reports[0].File)
+	assert.Equal(t, int64(2), reports[0].RowCount)
+	assert.Equal(t, int64(0), reports[0].BadRowCount)
+
+	// bad rows are recorded instead of aborting, only the first N bad rows are kept
+	importResult = &rootcoordpb.ImportResult{State: commonpb.ImportState_ImportStarted}
+	options.MaxBadRows = 3
+	err = dryRunWrapper(ctx, t, cm, importResult, reportFunc).Import([]string{badCSV}, options)
+	assert.Error(t, err)
+	assert.Equal(t, 1, reported)
+	assert.Equal(t, commonpb.ImportState_ImportStarted, importResult.GetState())
+	assert.Equal(t, int64(6), importResult.GetRowCount())
+	reports = getValidationReport(t, importResult)
+	assert.Equal(t, 1, len(reports))
+	assert.Equal(t, int64(6), reports[0].RowCount)
+	assert.Equal(t, int64(4), reports[0].BadRowCount)
+	assert.Equal(t, 3, len(reports[0].BadRows))
+	assert.Equal(t, int64(1), reports[0].BadRows[0].Row)
+	assert.Contains(t, reports[0].BadRows[0].Reason, "flag")
+	assert.Equal(t, int64(2), reports[0].BadRows[1].Row)
+	assert.Contains(t, reports[0].BadRows[1].Reason, "column count")
+	assert.Equal(t, int64(3), reports[0].BadRows[2].Row)
+	assert.Contains(t, reports[0].BadRows[2].Reason, "vec")
+
+	// json rows with redundant or missed fields, the report covers all files
+	importResult = &rootcoordpb.ImportResult{State: commonpb.ImportState_ImportStarted}
+	options.MaxBadRows = DefaultDryRunMaxBadRows
+	err = dryRunWrapper(ctx, t, cm, importResult, reportFunc).Import([]string{badJSON, goodCSV}, options)
+	assert.Error(t, err)
+	reports = getValidationReport(t, importResult)
+	assert.Equal(t, 2, len(reports))
+	assert.Equal(t, int64(4), reports[0].RowCount)
+	assert.Equal(t, int64(2), reports[0].BadRowCount)
+	assert.Equal(t, int64(1), reports[0].BadRows[0].Row)
+	assert.Contains(t, reports[0].BadRows[0].Reason, "dummy")
+	assert.Equal(t, int64(2), reports[0].BadRows[1].Row)
+	assert.Contains(t, reports[0].BadRows[1].Reason, "bvec")
+	assert.Equal(t, int64(2), reports[1].RowCount)
+	assert.Equal(t, int64(6), importResult.GetRowCount())
+
+	// the file can't be parsed
+	importResult = &rootcoordpb.ImportResult{State: commonpb.ImportState_ImportStarted}
+	brokenJSON := path.Join(TempFilesPath, "broken.json")
+	err = cm.Write(ctx, brokenJSON, []byte(`{"dummy":[]}`))
+	assert.NoError(t, err)
+	err = dryRunWrapper(ctx, t, cm, importResult, reportFunc).Import([]string{brokenJSON}, options)
+	assert.Error(t, err)
+	reports = getValidationReport(t, importResult)
+	assert.Equal(t, 1, len(reports))
+	assert.NotEmpty(t, reports[0].Error)
+
+	// failed to report
+	importResult = &rootcoordpb.ImportResult{State: commonpb.ImportState_ImportStarted}
+	wrapper := dryRunWrapper(ctx, t, cm, importResult, func(res *rootcoordpb.ImportResult) error {
+		return errors.New("error")
+	})
+	wrapper.reportImportAttempts = 1
+	err = wrapper.Import([]string{goodCSV}, options)
+	assert.Error(t, err)
+}
+
+func Test_ImportWrapperDryRunNumpy(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+
+	options := DefaultImportOptions()
+	options.DryRun = true
+	files := createSampleNumpyFiles(t, cm)
+	importResult := &rootcoordpb.ImportResult{State: commonpb.ImportState_ImportStarted}
+	wrapper := NewImportWrapper(ctx, sampleSchema(), 2, 1, newIDAllocator(ctx, t, nil), cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import(files, options)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, rowCounter.rowCount)
+	assert.Equal(t, commonpb.ImportState_ImportCompleted, importResult.GetState())
+	reports := getValidationReport(t, importResult)
+	assert.Equal(t, len(files), len(reports))
+	for _, report := range reports {
+		assert.Equal(t, int64(5), report.RowCount)
+	}
+
+	// not-a-number and infinity values are recorded as bad rows
+	filePath := path.Join(cm.RootPath(), "FieldDouble.npy")
+	content, err := CreateNumpyData([]float64{1, math.NaN(), 2, math.Inf(1), 3})
+	assert.Nil(t, err)
+	err = cm.Write(ctx, filePath, content)
+	assert.NoError(t, err)
+	importResult = &rootcoordpb.ImportResult{State: commonpb.ImportState_ImportStarted}
+	wrapper = NewImportWrapper(ctx, sampleSchema(), 2, 1, newIDAllocator(ctx, t, nil), cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import(files, options)
+	assert.Error(t, err)
+	reports = getValidationReport(t, importResult)
+	for _, report := range reports {
+		if report.File != filePath {
+			assert.Equal(t, int64(0), report.BadRowCount)
+			continue
+		}
+		assert.Empty(t, report.Error)
+		assert.Equal(t, int64(5), report.RowCount)
+		assert.Equal(t, int64(2), report.BadRowCount)
+		assert.Equal(t, int64(1), report.BadRows[0].Row)
+		assert.Equal(t, int64(3), report.BadRows[1].Row)
+	}
+	files = createSampleNumpyFiles(t, cm)
+
+	// row count of fields not equal
+	filePath = path.Join(cm.RootPath(), "FieldInt8.npy")
+	content, err = CreateNumpyData([]int8{10})
+	assert.Nil(t, err)
+	err = cm.Write(ctx, filePath, content)
+	assert.NoError(t, err)
+	files[1] = filePath
+
+	importResult = &rootcoordpb.ImportResult{State: commonpb.ImportState_ImportStarted}
+	wrapper = NewImportWrapper(ctx, sampleSchema(), 2, 1, newIDAllocator(ctx, t, nil), cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import(files, options)
+	assert.Error(t, err)
+	reports = getValidationReport(t, importResult)
+	assert.Empty(t, reports[0].Error)
+	assert.NotEmpty(t, reports[1].Error)
+}
@@ -19,6 +19,7 @@ package importutil
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
 
 	"go.uber.org/zap"
@@ -29,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/retry"
@@ -37,8 +39,11 @@ import (
 )
 
 const (
-	JSONFileExt  = ".json"
-	NumpyFileExt = ".npy"
+	JSONFileExt    = ".json"
+	NumpyFileExt   = ".npy"
+	NpzFileExt     = ".npz"
+	ParquetFileExt = ".parquet"
+	CSVFileExt     = ".csv"
 
 	// supposed size of a single block, to control a binlog file size, the max biglog file size is no more than 2*SingleBlockSize
 	SingleBlockSize = 16 * 1024 * 1024 // 16MB
@@ -57,6 +62,11 @@ const (
 	// if the shard number is a large number, although single segment size is small, but there are lot of in-memory segments,
 	// the total memory size might cause OOM.
 	MaxTotalSizeInMemory = 2 * 1024 * 1024 * 1024 // 2GB
+
+	// a checkpoint seals all the working segments, to avoid generating lots of small segments, the progress of
+	// a parquet file is committed when a segment is sealed by size, or this size of data is written since the
+	// last checkpoint.
+	CheckpointSize = 256 * 1024 * 1024 // 256MB
 )
 
 // ReportImportAttempts is the maximum # of attempts to retry when import fails.
@@ -95,6 +105,15 @@ type ImportWrapper struct {
 	reportImportAttempts uint                                      // attempts count if report function get error
 
 	workingSegments map[int]*WorkingSegment // a map shard id to working segments
+
+	// the segments, auto-ids and rows before these offsets of import result are committed by checkpoints
+	committedSegments int
+	committedAutoIDs  int
+	committedRows     int64
+
+	checkpointSize  int64 // the size of data written between two checkpoints, see CheckpointSize
+	uncommittedSize int64 // the size of data written since the last checkpoint
+	segmentSealed   bool  // a segment is sealed by size since the last checkpoint
 }
 
 func NewImportWrapper(ctx context.Context, collectionSchema *schemapb.CollectionSchema, shardNum int32, segmentSize int64,
@@ -134,6 +153,7 @@ func NewImportWrapper(ctx context.Context, collectionSchema *schemapb.Collection
 		reportFunc:           reportFunc,
 		reportImportAttempts: ReportImportAttempts,
 		workingSegments:      make(map[int]*WorkingSegment),
+		checkpointSize:       CheckpointSize,
 	}
 
 	return wrapper
@@ -167,33 +187,62 @@ func (p *ImportWrapper) Cancel() error {
 	return nil
 }
 
-func (p *ImportWrapper) validateColumnBasedFiles(filePaths []string, collectionSchema *schemapb.CollectionSchema) error {
+// validateColumnBasedFiles checks the arrays of numpy files, each required field must be provided by exactly one array
+// the fieldMapping maps file names or npz array names to field names
+func (p *ImportWrapper) validateColumnBasedFiles(filePaths []string, fieldMapping map[string]string) error {
+	columns, err := listNumpyColumns(p.ctx, p.chunkManager, filePaths, fieldMapping)
+	if err != nil {
+		log.Error("import wrapper: failed to list numpy arrays", zap.Error(err))
+		return err
+	}
+
 	requiredFieldNames := make(map[string]interface{})
+	autoIDFieldName := ""
 	for _, schema := range p.collectionSchema.Fields {
 		if schema.GetIsPrimaryKey() {
 			if !schema.GetAutoID() {
 				requiredFieldNames[schema.GetName()] = nil
+			} else {
+				autoIDFieldName = schema.GetName()
 			}
 		} else {
 			requiredFieldNames[schema.GetName()] = nil
 		}
 	}
 
-	// check redundant file
-	fileNames := make(map[string]interface{})
-	for _, filePath := range filePaths {
-		name, _ := GetFileNameAndExt(filePath)
-		fileNames[name] = nil
-		_, ok := requiredFieldNames[name]
+	// check redundant file and duplicate field
+	arrayNames := make(map[string]interface{})
+	fieldColumns := make(map[string]*numpyColumn)
+	for _, column := range columns {
+		arrayNames[column.arrayName] = nil
+		if column.fieldName == autoIDFieldName {
+			log.Error("import wrapper: the primary key is auto-generated", zap.String("fieldName", column.fieldName))
+			return fmt.Errorf("the %s is not allowed since the primary key '%s' is auto-generated", column, column.fieldName)
+		}
+		_, ok := requiredFieldNames[column.fieldName]
 		if !ok {
-			log.Error("import wrapper: the file has no corresponding field in collection", zap.String("fieldName", name))
-			return fmt.Errorf("the file '%s' has no corresponding field in collection", filePath)
+			log.Error("import wrapper: the file has no corresponding field in collection", zap.String("fieldName", column.fieldName))
+			return fmt.Errorf("the %s has no corresponding field in collection", column)
+		}
+		if prev, ok := fieldColumns[column.fieldName]; ok {
+			log.Error("import wrapper: duplicate data for field", zap.String("fieldName", column.fieldName))
+			return fmt.Errorf("the %s and the %s are imported into the same field '%s'", prev, column, column.fieldName)
+		}
+		fieldColumns[column.fieldName] = column
+	}
+
+	// check mapping, each name in the mapping must match a file or an array
+	for name := range fieldMapping {
+		_, ok := arrayNames[name]
+		if !ok {
+			log.Error("import wrapper: the mapped name matches no numpy array", zap.String("name", name))
+			return fmt.Errorf("the name '%s' in numpy field mapping matches no file or array", name)
 		}
 	}
 
 	// check missed file
 	for name := range requiredFieldNames {
-		_, ok := fileNames[name]
+		_, ok := fieldColumns[name]
 		if !ok {
 			log.Error("import wrapper: there is no file corresponding to field", zap.String("fieldName", name))
 			return fmt.Errorf("there is no file corresponding to field '%s'", name)
@@ -204,9 +253,9 @@ func (p *ImportWrapper) validateColumnBasedFiles(filePaths []string, collectionS
 }
 
 // fileValidation verify the input paths
-// if all the files are json type, return true
-// if all the files are numpy type, return false, and not allow duplicate file name
-func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
+// if all the files are json, csv or parquet type, return true
+// if all the files are numpy type(.npy or .npz), return false, and not allow duplicate file name
+func (p *ImportWrapper) fileValidation(filePaths []string, options ImportOptions) (bool, error) {
 	// use this map to check duplicate file name(only for numpy file)
 	fileNames := make(map[string]struct{})
 
@@ -216,26 +265,34 @@ func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 		filePath := filePaths[i]
 		name, fileType := GetFileNameAndExt(filePath)
 
-		// only allow json file or numpy file
-		if fileType != JSONFileExt && fileType != NumpyFileExt {
+		// only allow json file, csv file, parquet file or numpy file
+		if fileType != JSONFileExt && fileType != CSVFileExt && fileType != NumpyFileExt && fileType != NpzFileExt &&
+			fileType != ParquetFileExt {
 			log.Error("import wrapper: unsupported file type", zap.String("filePath", filePath))
 			return false, fmt.Errorf("unsupported file type: '%s'", filePath)
 		}
 
 		// we use the first file to determine row-based or column-based
-		if i == 0 && fileType == JSONFileExt {
+		// each csv or parquet file contains all the fields like the json file, so it's row-based
+		if i == 0 && (fileType == JSONFileExt || fileType == CSVFileExt || fileType == ParquetFileExt) {
 			rowBased = true
 		}
 
+		// only json and csv files are read sequentially, other files cannot be compressed
+		if GetCompressionExt(filePath) != "" && fileType != JSONFileExt && fileType != CSVFileExt {
+			log.Error("import wrapper: compressed file is only supported for json and csv", zap.String("filePath", filePath))
+			return rowBased, fmt.Errorf("compressed file is only supported for json and csv: '%s'", filePath)
+		}
+
 		// check file type
-		// row-based only support json type, column-based only support numpy type
+		// row-based only support json, csv and parquet type, column-based only support numpy type
 		if rowBased {
-			if fileType != JSONFileExt {
+			if fileType != JSONFileExt && fileType != CSVFileExt && fileType != ParquetFileExt {
 				log.Error("import wrapper: unsupported file type for row-based mode", zap.String("filePath", filePath))
 				return rowBased, fmt.Errorf("unsupported file type for row-based mode: '%s'", filePath)
 			}
 		} else {
-			if fileType != NumpyFileExt {
+			if fileType != NumpyFileExt && fileType != NpzFileExt {
 				log.Error("import wrapper: unsupported file type for column-based mode", zap.String("filePath", filePath))
 				return rowBased, fmt.Errorf("unsupported file type for column-based mode: '%s'", filePath)
 			}
@@ -270,8 +327,9 @@ func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 		totalSize += size
 	}
 
-	// especially for column-base, total size of files cannot exceed MaxTotalSizeInMemory
-	if totalSize > MaxTotalSizeInMemory {
+	// for column-base, all the files are read into memory, total size of files cannot exceed MaxTotalSizeInMemory
+	// for row-based, the files are parsed one by one, a task might contain many files expanded from a prefix
+	if !rowBased && totalSize > MaxTotalSizeInMemory {
 		log.Error("import wrapper: total size of files exceeds the maximum size", zap.Int64("totalSize", totalSize), zap.Int64("MaxTotalSize", MaxTotalSizeInMemory))
 		return rowBased, fmt.Errorf("total size(%d bytes) of all files exceeds the maximum size: %d bytes", totalSize, MaxTotalSizeInMemory)
 	}
@@ -280,7 +338,7 @@ func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 	// if the field is primary key and autoid is false, the file is required
 	// any redundant file is not allowed
 	if !rowBased {
-		err := p.validateColumnBasedFiles(filePaths, p.collectionSchema)
+		err := p.validateColumnBasedFiles(filePaths, options.NumpyFields)
 		if err != nil {
 			return rowBased, err
 		}
@@ -292,8 +350,13 @@ func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 // Import is the entry of import operation
 // filePath and rowBased are from ImportTask
 // if onlyValidate is true, this process only do validation, no data generated, flushFunc will not be called
+// if dryRun is true, all rows are validated and a per-file report is passed through the import result
 func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error {
 	log.Info("import wrapper: begin import", zap.Any("filePaths", filePaths), zap.Any("options", options))
+	if options.DryRun {
+		return p.dryRun(filePaths, options)
+	}
+
 	// data restore function to import milvus native binlog files(for backup/restore tools)
 	// the backup/restore tool provide two paths for a partition, the first path is binlog path, the second is deltalog path
 	if options.IsBackup && p.isBinlogImport(filePaths) {
@@ -301,7 +364,7 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 	}
 
 	// normal logic for import general data files
-	rowBased, err := p.fileValidation(filePaths)
+	rowBased, err := p.fileValidation(filePaths, options)
 	if err != nil {
 		return err
 	}
@@ -310,19 +373,55 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 		// parse and consume row-based files
 		// for row-based files, the JSONRowConsumer will generate autoid for primary key, and split rows into segments
 		// according to shard number, so the flushFunc will be called in the JSONRowConsumer
+		// for parquet files, each row group is split into segments by splitFieldsData()
+		// the progress of each file is committed by a checkpoint, a resumed task skips the finished files
+		checkpoints := p.restoreCheckpoints(filePaths, options.Checkpoints)
 		for i := 0; i < len(filePaths); i++ {
 			filePath := filePaths[i]
 			_, fileType := GetFileNameAndExt(filePath)
 			log.Info("import wrapper:  row-based file ", zap.Any("filePath", filePath), zap.Any("fileType", fileType))
 
+			if isCanceled(p.ctx) {
+				log.Error("import wrapper: import task was canceled")
+				return errors.New("import task was canceled")
+			}
+
+			checkpoint := checkpoints[filePath]
+			rowGroups := checkpoint.GetRowGroups()
+			if checkpoint.GetFinished() {
+				log.Info("import wrapper: file has been imported by a previous attempt", zap.String("filePath", filePath),
+					zap.Int64("rowCount", checkpoint.GetRowCount()), zap.Int64s("segments", checkpoint.GetSegments()))
+				continue
+			}
+
 			if fileType == JSONFileExt {
 				err = p.parseRowBasedJSON(filePath, options.OnlyValidate)
 				if err != nil {
 					log.Error("import wrapper: failed to parse row-based json file", zap.Error(err), zap.String("filePath", filePath))
 					return err
 				}
+			} else if fileType == CSVFileExt {
+				err = p.parseRowBasedCSV(filePath, options.OnlyValidate, options.CSV)
+				if err != nil {
+					log.Error("import wrapper: failed to parse row-based csv file", zap.Error(err), zap.String("filePath", filePath))
+					return err
+				}
+			} else if fileType == ParquetFileExt {
+				rowGroups, err = p.parseParquet(filePath, options.OnlyValidate, checkpoint)
+				if err != nil {
+					log.Error("import wrapper: failed to parse parquet file", zap.Error(err), zap.String("filePath", filePath))
+					return err
+				}
 			} // no need to check else, since the fileValidation() already do this
 
+			if !options.OnlyValidate {
+				err = p.commitCheckpoint(checkpoint, rowGroups, true)
+				if err != nil {
+					log.Error("import wrapper: failed to commit checkpoint", zap.Error(err), zap.String("filePath", filePath))
+					return err
+				}
+			}
+
 			// trigger gc after each file finished
 			triggerGC()
 		}
@@ -381,28 +480,32 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 			return nil
 		}
 
-		// parse/validate/consume data
-		for i := 0; i < len(filePaths); i++ {
-			filePath := filePaths[i]
-			_, fileType := GetFileNameAndExt(filePath)
-			log.Info("import wrapper:  column-based file ", zap.Any("filePath", filePath), zap.Any("fileType", fileType))
+		// parse/validate/consume data, a .npz file contains multiple arrays
+		columns, err := listNumpyColumns(p.ctx, p.chunkManager, filePaths, options.NumpyFields)
+		if err != nil {
+			return err
+		}
+		for _, column := range columns {
+			log.Info("import wrapper:  column-based file ", zap.String("filePath", column.filePath),
+				zap.String("arrayName", column.arrayName), zap.String("fieldName", column.fieldName))
 
-			if fileType == NumpyFileExt {
-				err = p.parseColumnBasedNumpy(filePath, options.OnlyValidate, combineFunc)
+			if isCanceled(p.ctx) {
+				log.Error("import wrapper: import task was canceled")
+				return errors.New("import task was canceled")
+			}
 
-				if err != nil {
-					log.Error("import wrapper: failed to parse column-based numpy file", zap.Error(err), zap.String("filePath", filePath))
-					return err
-				}
+			err = p.parseColumnBasedNumpy(column, options.OnlyValidate, combineFunc)
+			if err != nil {
+				log.Error("import wrapper: failed to parse column-based numpy file", zap.Error(err), zap.String("filePath", column.filePath))
+				return err
 			}
-			// no need to check else, since the fileValidation() already do this
 		}
 
 		// trigger after read finished
 		triggerGC()
 
 		// split fields data into segments
-		err := p.splitFieldsData(fieldsData, SingleBlockSize)
+		err = p.splitFieldsData(fieldsData, SingleBlockSize)
 		if err != nil {
 			return err
 		}
@@ -411,6 +514,12 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 		triggerGC()
 	}
 
+	// a canceled task must not be reported as persisted, the segments are dropped by the rootcoord
+	if isCanceled(p.ctx) {
+		log.Error("import wrapper: import task was canceled")
+		return errors.New("import task was canceled")
+	}
+
 	return p.reportPersisted(p.reportImportAttempts)
 }
 
@@ -435,6 +544,78 @@ func (p *ImportWrapper) reportPersisted(reportAttempts uint) error {
 	return nil
 }
 
+// restoreCheckpoints restores the import result from the checkpoints of a resumed task, and returns the checkpoint
+// of each file, the checkpoints are reported to rootcoord with the import result
+func (p *ImportWrapper) restoreCheckpoints(filePaths []string,
+	checkpoints []*internalpb.ImportCheckpoint) map[string]*internalpb.ImportCheckpoint {
+	result := make(map[string]*internalpb.ImportCheckpoint)
+	for _, checkpoint := range checkpoints {
+		result[checkpoint.GetFile()] = checkpoint
+	}
+
+	p.importResult.Checkpoints = make([]*internalpb.ImportCheckpoint, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		checkpoint, ok := result[filePath]
+		if !ok {
+			checkpoint = &internalpb.ImportCheckpoint{File: filePath}
+			result[filePath] = checkpoint
+		}
+		p.importResult.Checkpoints = append(p.importResult.Checkpoints, checkpoint)
+		p.importResult.Segments = append(p.importResult.Segments, checkpoint.GetSegments()...)
+		p.importResult.AutoIds = append(p.importResult.AutoIds, checkpoint.GetAutoIds()...)
+		p.importResult.RowCount += checkpoint.GetRowCount()
+	}
+	p.committedSegments = len(p.importResult.Segments)
+	p.committedAutoIDs = len(p.importResult.AutoIds)
+	p.committedRows = p.importResult.RowCount
+
+	if len(checkpoints) > 0 {
+		log.Info("import wrapper: resume import from checkpoints", zap.Int("checkpointCount", len(checkpoints)),
+			zap.Int64s("segments", p.importResult.Segments), zap.Int64("rowCount", p.importResult.RowCount))
+	}
+	return result
+}
+
+// checkpointDue returns true if a segment is sealed by size or enough data is written since the last checkpoint
+func (p *ImportWrapper) checkpointDue() bool {
+	return p.segmentSealed || p.uncommittedSize >= p.checkpointSize
+}
+
+// commitCheckpoint seals the working segments and reports the progress of the file to rootcoord,
+// the segments of the persisted rows are kept if the task is resumed later.
+// The report is the whole import result, the task fails if it can't be reported, since the rootcoord
+// would resume the task from a stale checkpoint and import the persisted rows again.
+func (p *ImportWrapper) commitCheckpoint(checkpoint *internalpb.ImportCheckpoint, rowGroups int64, finished bool) error {
+	err := p.closeAllWorkingSegments()
+	if err != nil {
+		return err
+	}
+
+	checkpoint.Segments = append(checkpoint.Segments, p.importResult.Segments[p.committedSegments:]...)
+	checkpoint.AutoIds = append(checkpoint.AutoIds, p.importResult.AutoIds[p.committedAutoIDs:]...)
+	checkpoint.RowCount += p.importResult.RowCount - p.committedRows
+	checkpoint.RowGroups = rowGroups
+	checkpoint.Finished = finished
+	p.committedSegments = len(p.importResult.Segments)
+	p.committedAutoIDs = len(p.importResult.AutoIds)
+	p.committedRows = p.importResult.RowCount
+	p.uncommittedSize = 0
+	p.segmentSealed = false
+
+	reportErr := retry.Do(p.ctx, func() error {
+		return p.reportFunc(p.importResult)
+	}, retry.Attempts(p.reportImportAttempts))
+	if reportErr != nil {
+		log.Warn("import wrapper: fail to report checkpoint to RootCoord", zap.String("filePath", checkpoint.GetFile()),
+			zap.Error(reportErr))
+		return fmt.Errorf("failed to report checkpoint of file '%s', error: %w", checkpoint.GetFile(), reportErr)
+	}
+	log.Info("import wrapper: checkpoint committed", zap.String("filePath", checkpoint.GetFile()),
+		zap.Bool("finished", finished), zap.Int64("rowGroups", checkpoint.GetRowGroups()),
+		zap.Int64("rowCount", checkpoint.GetRowCount()))
+	return nil
+}
+
 // isBinlogImport is to judge whether it is binlog import operation
 // For internal usage by the restore tool: https://github.com/zilliztech/milvus-backup
 // This tool exports data from a milvus service, and call bulkload interface to import native data into another milvus service.
@@ -502,9 +683,8 @@ func (p *ImportWrapper) doBinlogImport(filePaths []string, tsStartPoint uint64,
 func (p *ImportWrapper) parseRowBasedJSON(filePath string, onlyValidate bool) error {
 	tr := timerecord.NewTimeRecorder("json row-based parser: " + filePath)
 
-	// for minio storage, chunkManager will download file into local memory
-	// for local storage, chunkManager open the file directly
-	file, err := p.chunkManager.Reader(p.ctx, filePath)
+	// the gzip or zstd file is decompressed transparently
+	file, err := OpenImportFile(p.ctx, p.chunkManager, filePath)
 	if err != nil {
 		return err
 	}
@@ -547,16 +727,12 @@ func (p *ImportWrapper) parseRowBasedJSON(filePath string, onlyValidate bool) er
 	return nil
 }
 
-// parseColumnBasedNumpy is the entry of column-based numpy import operation
-func (p *ImportWrapper) parseColumnBasedNumpy(filePath string, onlyValidate bool,
+// parseColumnBasedNumpy is the entry of column-based numpy import operation, it parses an array of a .npy or .npz file
+func (p *ImportWrapper) parseColumnBasedNumpy(column *numpyColumn, onlyValidate bool,
 	combineFunc func(fields map[storage.FieldID]storage.FieldData) error) error {
-	tr := timerecord.NewTimeRecorder("numpy parser: " + filePath)
-
-	fileName, _ := GetFileNameAndExt(filePath)
+	tr := timerecord.NewTimeRecorder("numpy parser: " + column.String())
 
-	// for minio storage, chunkManager will download file into local memory
-	// for local storage, chunkManager open the file directly
-	file, err := p.chunkManager.Reader(p.ctx, filePath)
+	file, err := openNumpyColumn(p.ctx, p.chunkManager, column)
 	if err != nil {
 		return err
 	}
@@ -565,14 +741,14 @@ func (p *ImportWrapper) parseColumnBasedNumpy(filePath string, onlyValidate bool
 	var id storage.FieldID
 	var found = false
 	for _, field := range p.collectionSchema.Fields {
-		if field.GetName() == fileName {
+		if field.GetName() == column.fieldName {
 			id = field.GetFieldID()
 			found = true
 			break
 		}
 	}
 
-	// if the numpy file name is not mapping to a field name, ignore it
+	// if the numpy array is not mapping to a field name, ignore it
 	if !found {
 		return nil
 	}
@@ -584,17 +760,102 @@ func (p *ImportWrapper) parseColumnBasedNumpy(filePath string, onlyValidate bool
 		return combineFunc(fields)
 	}
 
-	// for numpy file, we say the file name(without extension) is the filed name
 	parser := NewNumpyParser(p.ctx, p.collectionSchema, flushFunc)
-	err = parser.Parse(file, fileName, onlyValidate)
+	err = parser.Parse(file, column.fieldName, onlyValidate)
+	if err != nil {
+		return err
+	}
+
+	tr.Elapse("parsed")
+	return nil
+}
+
+// parseRowBasedCSV is the entry of row-based csv import operation
+func (p *ImportWrapper) parseRowBasedCSV(filePath string, onlyValidate bool, csvOptions CSVOptions) error {
+	tr := timerecord.NewTimeRecorder("csv row-based parser: " + filePath)
+
+	// the gzip or zstd file is decompressed transparently
+	file, err := OpenImportFile(p.ctx, p.chunkManager, filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// parse file
+	parser := NewCSVParser(p.ctx, p.collectionSchema, csvOptions)
+
+	// if only validate, we input a empty flushFunc so that the consumer do nothing but only validation.
+	var flushFunc ImportFlushFunc
+	if onlyValidate {
+		flushFunc = func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+			return nil
+		}
+	} else {
+		flushFunc = func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+			printFieldsDataInfo(fields, "import wrapper: prepare to flush binlogs", []string{filePath})
+			return p.flushFunc(fields, shardID)
+		}
+	}
+
+	// the csv rows are consumed in the same way as json rows
+	consumer, err := NewJSONRowConsumer(p.collectionSchema, p.rowIDAllocator, p.shardNum, SingleBlockSize, flushFunc)
 	if err != nil {
 		return err
 	}
 
+	err = parser.ParseRows(file, consumer)
+	if err != nil {
+		return err
+	}
+
+	p.importResult.AutoIds = append(p.importResult.AutoIds, consumer.IDRange()...)
+
 	tr.Elapse("parsed")
 	return nil
 }
 
+// parseParquet is the entry of parquet import operation, the progress is committed by the checkpoint after the
+// row groups which fill a segment or a checkpoint size of data, it returns the number of parsed row groups
+func (p *ImportWrapper) parseParquet(filePath string, onlyValidate bool, checkpoint *internalpb.ImportCheckpoint) (int64, error) {
+	tr := timerecord.NewTimeRecorder("parquet parser: " + filePath)
+
+	// the parquet file is read by ranges, only the footer and the row group being parsed are in memory
+	file, err := NewChunkManagerFileReader(p.ctx, p.chunkManager, filePath)
+	if err != nil {
+		return 0, err
+	}
+
+	// the parser outputs fields data of a row group, split it into segments according to shard number
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData) error {
+		printFieldsDataInfo(fields, "import wrapper: prepare to split parquet row group", []string{filePath})
+		return p.splitFieldsData(fields, SingleBlockSize)
+	}
+
+	parser, err := NewParquetParser(p.ctx, p.collectionSchema, flushFunc)
+	if err != nil {
+		return 0, err
+	}
+	parsedRowGroups := checkpoint.GetRowGroups()
+	if !onlyValidate && checkpoint != nil {
+		// skip the row groups imported by a previous attempt
+		parser.SetCheckpoint(int(checkpoint.GetRowGroups()), func(rowGroups int) error {
+			parsedRowGroups = int64(rowGroups)
+			if !p.checkpointDue() {
+				return nil
+			}
+			return p.commitCheckpoint(checkpoint, parsedRowGroups, false)
+		})
+	}
+
+	err = parser.Parse(file, onlyValidate)
+	if err != nil {
+		return 0, err
+	}
+
+	tr.Elapse("parsed")
+	return parsedRowGroups, nil
+}
+
 // appendFunc defines the methods to append data to storage.FieldData
 func (p *ImportWrapper) appendFunc(schema *schemapb.FieldSchema) func(src storage.FieldData, n int, target storage.FieldData) error {
 	switch schema.DataType {
@@ -661,6 +922,27 @@ func (p *ImportWrapper) appendFunc(schema *schemapb.FieldSchema) func(src storag
 			arr.NumRows[0]++
 			return nil
 		}
+	case typeutil.Float16VectorType:
+		return func(src storage.FieldData, n int, target storage.FieldData) error {
+			arr := target.(*storage.Float16VectorFieldData)
+			arr.Data = append(arr.Data, src.GetRow(n).([]byte)...)
+			arr.NumRows[0]++
+			return nil
+		}
+	case typeutil.BFloat16VectorType:
+		return func(src storage.FieldData, n int, target storage.FieldData) error {
+			arr := target.(*storage.BFloat16VectorFieldData)
+			arr.Data = append(arr.Data, src.GetRow(n).([]byte)...)
+			arr.NumRows[0]++
+			return nil
+		}
+	case typeutil.SparseFloatVectorType:
+		return func(src storage.FieldData, n int, target storage.FieldData) error {
+			arr := target.(*storage.SparseFloatVectorFieldData)
+			arr.AppendAllRows([][]byte{src.GetRow(n).([]byte)})
+			arr.NumRows[0]++
+			return nil
+		}
 	case schemapb.DataType_String, schemapb.DataType_VarChar:
 		return func(src storage.FieldData, n int, target storage.FieldData) error {
 			arr := target.(*storage.StringFieldData)
@@ -726,11 +1008,16 @@ func (p *ImportWrapper) splitFieldsData(fieldsData map[storage.FieldID]storage.F
 		return fmt.Errorf("primary key field is not provided")
 	}
 
+	// only int64 primary key can be auto-generated, the same as row-based import
+	if primaryKey.GetAutoID() && primaryKey.GetDataType() != schemapb.DataType_Int64 {
+		log.Error("import wrapper: string type primary key cannot be auto-generated", zap.String("keyName", primaryKey.GetName()))
+		return errors.New("string type primary key cannot be auto-generated")
+	}
+
 	// generate auto id for primary key and rowid field
-	rowIDBegin, rowIDEnd, err := p.rowIDAllocator.Alloc(uint32(rowCount))
+	rowIDBegin, rowIDEnd, err := allocAutoIDs(p.rowIDAllocator, rowCount)
 	if err != nil {
-		log.Error("import wrapper: failed to alloc row ID", zap.Error(err))
-		return fmt.Errorf("failed to alloc row ID, error: %w", err)
+		return err
 	}
 
 	rowIDField := fieldsData[common.RowIDField]
@@ -742,7 +1029,7 @@ func (p *ImportWrapper) splitFieldsData(fieldsData map[storage.FieldID]storage.F
 	if primaryKey.GetAutoID() {
 		log.Info("import wrapper: generating auto-id", zap.Int("rowCount", rowCount), zap.Int64("rowIDBegin", rowIDBegin))
 
-		// reset the primary keys, as we know, only int64 pk can be auto-generated
+		// reset the primary keys, only int64 pk can be auto-generated
 		primaryDataArr := &storage.Int64FieldData{
 			NumRows: []int64{int64(rowCount)},
 			Data:    make([]int64, 0, rowCount),
@@ -817,6 +1104,10 @@ func (p *ImportWrapper) splitFieldsData(fieldsData map[storage.FieldID]storage.F
 			if err != nil {
 				return err
 			}
+			// keep the validity of nullable field
+			if validData := srcData.GetValidData(); len(validData) > 0 {
+				storage.SetValidData(targetData, append(targetData.GetValidData(), validData[i]))
+			}
 		}
 
 		// when the estimated size is close to blockSize, force flush
@@ -858,6 +1149,7 @@ func (p *ImportWrapper) flushFunc(fields map[storage.FieldID]storage.FieldData,
 			}
 			segment = nil
 			p.workingSegments[shardID] = nil
+			p.segmentSealed = true
 		}
 
 	}
@@ -895,6 +1187,7 @@ func (p *ImportWrapper) flushFunc(fields map[storage.FieldID]storage.FieldData,
 	segment.fieldsStats = append(segment.fieldsStats, fieldsStats...)
 	segment.rowCount += int64(rowNum)
 	segment.memSize += memSize
+	p.uncommittedSize += int64(memSize)
 
 	return nil
 }
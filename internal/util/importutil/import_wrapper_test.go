@@ -17,11 +17,13 @@
 package importutil
 
 import (
+	"archive/zip"
 	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"math"
 	"os"
 	"path"
@@ -70,6 +72,10 @@ func (mc *MockChunkManager) Write(ctx context.Context, filePath string, content
 	return nil
 }
 
+func (mc *MockChunkManager) WriteFrom(ctx context.Context, filePath string, reader io.Reader, size int64) error {
+	return nil
+}
+
 func (mc *MockChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
 	return nil
 }
@@ -113,7 +119,19 @@ func (mc *MockChunkManager) ReadWithPrefix(ctx context.Context, prefix string) (
 }
 
 func (mc *MockChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
-	return nil, nil
+	if mc.readErr != nil {
+		return nil, mc.readErr
+	}
+
+	val, ok := mc.readBuf[filePath]
+	if !ok {
+		return nil, errors.New("mock chunk manager: file path not found: " + filePath)
+	}
+	if off < 0 || length < 0 || off+length > int64(len(val)) {
+		return nil, errors.New("mock chunk manager: out of range: " + filePath)
+	}
+
+	return val[off : off+length], nil
 }
 
 func (mc *MockChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
@@ -453,6 +471,129 @@ func Test_ImportWrapperColumnBased_numpy(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+// createSampleNpzFile packs the sample numpy files into a npz archive, the arrays can be renamed by the names map
+func createSampleNpzFile(t *testing.T, cm storage.ChunkManager, filePath string, names map[string]string) {
+	ctx := context.Background()
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for _, file := range createSampleNumpyFiles(t, cm) {
+		name, _ := GetFileNameAndExt(file)
+		content, err := cm.Read(ctx, file)
+		assert.NoError(t, err)
+		err = cm.Remove(ctx, file)
+		assert.NoError(t, err)
+
+		newName, ok := names[name]
+		if ok {
+			name = newName
+		}
+		if name == "" {
+			continue
+		}
+		entry, err := writer.Create(name + NumpyFileExt)
+		assert.NoError(t, err)
+		_, err = entry.Write(content)
+		assert.NoError(t, err)
+	}
+	err := writer.Close()
+	assert.NoError(t, err)
+	err = cm.Write(ctx, filePath, buf.Bytes())
+	assert.NoError(t, err)
+}
+
+func Test_ImportWrapperColumnBased_npz(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	idAllocator := newIDAllocator(ctx, t, nil)
+
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   make([]int64, 0),
+		AutoIds:    make([]int64, 0),
+		RowCount:   0,
+	}
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+
+	// the arrays are renamed, mapping is required
+	filePath := path.Join(cm.RootPath(), "features.npz")
+	createSampleNpzFile(t, cm, filePath, map[string]string{"FieldInt64": "item_id", "FieldFloatVector": "emb"})
+	files := []string{filePath}
+
+	wrapper := NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import(files, DefaultImportOptions())
+	assert.NotNil(t, err)
+
+	options := DefaultImportOptions()
+	options.NumpyFields = map[string]string{"item_id": "FieldInt64", "emb": "FieldFloatVector"}
+	wrapper = NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import(files, options)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, rowCounter.rowCount)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+	assert.Empty(t, importResult.AutoIds)
+
+	// auto-generated primary keys, the same as row-based import
+	createSampleNpzFile(t, cm, filePath, map[string]string{"FieldInt64": "", "FieldFloatVector": "emb"})
+	schema := sampleSchema()
+	for _, field := range schema.Fields {
+		if field.GetIsPrimaryKey() {
+			field.AutoID = true
+		}
+	}
+	rowCounter.rowCount = 0
+	importResult.State = commonpb.ImportState_ImportStarted
+	options.NumpyFields = map[string]string{"emb": "FieldFloatVector"}
+	wrapper = NewImportWrapper(ctx, schema, 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import(files, options)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, rowCounter.rowCount)
+	assert.Equal(t, 2, len(importResult.AutoIds))
+	assert.Equal(t, int64(5), importResult.AutoIds[1]-importResult.AutoIds[0])
+
+	// dry run checks all arrays of the npz file
+	importResult.State = commonpb.ImportState_ImportStarted
+	options.DryRun = true
+	wrapper = NewImportWrapper(ctx, schema, 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import(files, options)
+	assert.Nil(t, err)
+
+	// no IDAllocator for auto-generated primary keys
+	wrapper = NewImportWrapper(ctx, schema, 2, 1, nil, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	options.DryRun = false
+	err = wrapper.Import(files, options)
+	assert.NotNil(t, err)
+
+	// not a zip archive
+	filePath = path.Join(cm.RootPath(), "dummy.npz")
+	err = cm.Write(ctx, filePath, []byte("dummy"))
+	assert.NoError(t, err)
+	err = wrapper.Import([]string{filePath}, options)
+	assert.NotNil(t, err)
+}
+
 func perfSchema(dim int) *schemapb.CollectionSchema {
 	schema := &schemapb.CollectionSchema{
 		Name:        "schema",
@@ -624,32 +765,54 @@ func Test_ImportWrapperValidateColumnBasedFiles(t *testing.T) {
 
 	// file for PK is redundant
 	files := []string{"ID.npy", "Age.npy", "Vector.npy"}
-	err := wrapper.validateColumnBasedFiles(files, schema)
+	err := wrapper.validateColumnBasedFiles(files, nil)
 	assert.NotNil(t, err)
 
 	// file for PK is not redundant
 	schema.Fields[0].AutoID = false
-	err = wrapper.validateColumnBasedFiles(files, schema)
+	err = wrapper.validateColumnBasedFiles(files, nil)
 	assert.Nil(t, err)
 
 	// file missed
 	files = []string{"Age.npy", "Vector.npy"}
-	err = wrapper.validateColumnBasedFiles(files, schema)
+	err = wrapper.validateColumnBasedFiles(files, nil)
 	assert.NotNil(t, err)
 
 	files = []string{"ID.npy", "Vector.npy"}
-	err = wrapper.validateColumnBasedFiles(files, schema)
+	err = wrapper.validateColumnBasedFiles(files, nil)
 	assert.NotNil(t, err)
 
 	// redundant file
 	files = []string{"ID.npy", "Age.npy", "Vector.npy", "dummy.npy"}
-	err = wrapper.validateColumnBasedFiles(files, schema)
+	err = wrapper.validateColumnBasedFiles(files, nil)
 	assert.NotNil(t, err)
 
 	// correct input
 	files = []string{"ID.npy", "Age.npy", "Vector.npy"}
-	err = wrapper.validateColumnBasedFiles(files, schema)
+	err = wrapper.validateColumnBasedFiles(files, nil)
 	assert.Nil(t, err)
+
+	// file names are mapped to field names
+	files = []string{"item_id.npy", "Age.npy", "emb.npy"}
+	err = wrapper.validateColumnBasedFiles(files, nil)
+	assert.NotNil(t, err)
+	err = wrapper.validateColumnBasedFiles(files, map[string]string{"item_id": "ID", "emb": "Vector"})
+	assert.Nil(t, err)
+
+	// mapped name matches no file
+	err = wrapper.validateColumnBasedFiles(files, map[string]string{"item_id": "ID", "emb": "Vector", "dummy": "Age"})
+	assert.NotNil(t, err)
+
+	// two files are mapped to the same field
+	files = []string{"ID.npy", "item_id.npy", "Age.npy", "Vector.npy"}
+	err = wrapper.validateColumnBasedFiles(files, map[string]string{"item_id": "ID"})
+	assert.NotNil(t, err)
+
+	// auto-generated PK is not allowed to be mapped
+	schema.Fields[0].AutoID = true
+	files = []string{"item_id.npy", "Age.npy", "Vector.npy"}
+	err = wrapper.validateColumnBasedFiles(files, map[string]string{"item_id": "ID"})
+	assert.NotNil(t, err)
 }
 
 func Test_ImportWrapperFileValidation(t *testing.T) {
@@ -686,80 +849,100 @@ func Test_ImportWrapperFileValidation(t *testing.T) {
 
 	// unsupported file type
 	files := []string{"uid.txt"}
-	rowBased, err := wrapper.fileValidation(files)
+	rowBased, err := wrapper.fileValidation(files, DefaultImportOptions())
 	assert.NotNil(t, err)
 	assert.False(t, rowBased)
 
 	// file missed
 	files = []string{"uid.npy"}
-	rowBased, err = wrapper.fileValidation(files)
+	rowBased, err = wrapper.fileValidation(files, DefaultImportOptions())
 	assert.NotNil(t, err)
 	assert.False(t, rowBased)
 
 	// redundant file
 	files = []string{"uid.npy", "b/bol.npy", "c/no.npy"}
-	rowBased, err = wrapper.fileValidation(files)
+	rowBased, err = wrapper.fileValidation(files, DefaultImportOptions())
 	assert.NotNil(t, err)
 	assert.False(t, rowBased)
 
 	// duplicate files
 	files = []string{"a/1.json", "b/1.json"}
-	rowBased, err = wrapper.fileValidation(files)
+	rowBased, err = wrapper.fileValidation(files, DefaultImportOptions())
 	assert.NotNil(t, err)
 	assert.True(t, rowBased)
 
 	files = []string{"a/uid.npy", "uid.npy", "b/bol.npy"}
-	rowBased, err = wrapper.fileValidation(files)
+	rowBased, err = wrapper.fileValidation(files, DefaultImportOptions())
 	assert.NotNil(t, err)
 	assert.False(t, rowBased)
 
 	// unsupported file for row-based
 	files = []string{"a/uid.json", "b/bol.npy"}
-	rowBased, err = wrapper.fileValidation(files)
+	rowBased, err = wrapper.fileValidation(files, DefaultImportOptions())
 	assert.NotNil(t, err)
 	assert.True(t, rowBased)
 
 	// unsupported file for column-based
 	files = []string{"a/uid.npy", "b/bol.json"}
-	rowBased, err = wrapper.fileValidation(files)
+	rowBased, err = wrapper.fileValidation(files, DefaultImportOptions())
+	assert.NotNil(t, err)
+	assert.False(t, rowBased)
+
+	files = []string{"a/uid.npy", "b/bol.parquet"}
+	rowBased, err = wrapper.fileValidation(files, DefaultImportOptions())
+	assert.NotNil(t, err)
+	assert.False(t, rowBased)
+
+	files = []string{"a/uid.npy", "b/bol.csv"}
+	rowBased, err = wrapper.fileValidation(files, DefaultImportOptions())
 	assert.NotNil(t, err)
 	assert.False(t, rowBased)
 
 	// valid cases
 	files = []string{"a/1.json", "b/2.json"}
-	rowBased, err = wrapper.fileValidation(files)
+	rowBased, err = wrapper.fileValidation(files, DefaultImportOptions())
+	assert.Nil(t, err)
+	assert.True(t, rowBased)
+
+	files = []string{"a/1.parquet", "b/2.parquet"}
+	rowBased, err = wrapper.fileValidation(files, DefaultImportOptions())
+	assert.Nil(t, err)
+	assert.True(t, rowBased)
+
+	files = []string{"a/1.csv", "b/2.csv"}
+	rowBased, err = wrapper.fileValidation(files, DefaultImportOptions())
 	assert.Nil(t, err)
 	assert.True(t, rowBased)
 
 	files = []string{"a/uid.npy", "b/bol.npy"}
-	rowBased, err = wrapper.fileValidation(files)
+	rowBased, err = wrapper.fileValidation(files, DefaultImportOptions())
 	assert.Nil(t, err)
 	assert.False(t, rowBased)
 
 	// empty file
 	cm.size = 0
 	wrapper = NewImportWrapper(ctx, schema, int32(shardNum), int64(segmentSize), idAllocator, cm, nil, nil)
-	rowBased, err = wrapper.fileValidation(files)
+	rowBased, err = wrapper.fileValidation(files, DefaultImportOptions())
 	assert.NotNil(t, err)
 	assert.False(t, rowBased)
 
 	// file size exceed MaxFileSize limit
 	cm.size = MaxFileSize + 1
 	wrapper = NewImportWrapper(ctx, schema, int32(shardNum), int64(segmentSize), idAllocator, cm, nil, nil)
-	rowBased, err = wrapper.fileValidation(files)
+	rowBased, err = wrapper.fileValidation(files, DefaultImportOptions())
 	assert.NotNil(t, err)
 	assert.False(t, rowBased)
 
 	// total files size exceed MaxTotalSizeInMemory limit
 	cm.size = MaxFileSize - 1
 	files = append(files, "3.npy")
-	rowBased, err = wrapper.fileValidation(files)
+	rowBased, err = wrapper.fileValidation(files, DefaultImportOptions())
 	assert.NotNil(t, err)
 	assert.False(t, rowBased)
 
 	// failed to get file size
 	cm.sizeErr = errors.New("error")
-	rowBased, err = wrapper.fileValidation(files)
+	rowBased, err = wrapper.fileValidation(files, DefaultImportOptions())
 	assert.NotNil(t, err)
 	assert.False(t, rowBased)
 }
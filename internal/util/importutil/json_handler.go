@@ -37,6 +37,12 @@ type JSONRowHandler interface {
 	Handle(rows []map[storage.FieldID]interface{}) error
 }
 
+// JSONRowErrorHandler is implemented by handlers which collect bad rows instead of aborting the parse process,
+// the rows before a bad row are always handled before the bad row is reported
+type JSONRowErrorHandler interface {
+	HandleBadRow(err error) error
+}
+
 // Validator is field value validator
 type Validator struct {
 	convertFunc func(obj interface{}, field storage.FieldData) error // convert data function
@@ -45,6 +51,9 @@ type Validator struct {
 	isString    bool                                                 // for string field
 	dimension   int                                                  // only for vector field
 	fieldName   string                                               // field name
+	nullable    bool                                                 // for nullable field
+	hasDefault  bool                                                 // the field has default value
+	defaultObj  interface{}                                          // default value in the format of json decoder output
 }
 
 // JSONRowConsumer is row-based json format consumer class
@@ -200,20 +209,10 @@ func (v *JSONRowConsumer) Handle(rows []map[storage.FieldID]interface{}) error {
 	var rowIDBegin typeutil.UniqueID
 	var rowIDEnd typeutil.UniqueID
 	if primaryValidator.autoID {
-		if v.rowIDAllocator == nil {
-			log.Error("JSON row consumer: primary keys is auto-generated but IDAllocator is nil")
-			return fmt.Errorf("primary keys is auto-generated but IDAllocator is nil")
-		}
 		var err error
-		rowIDBegin, rowIDEnd, err = v.rowIDAllocator.Alloc(uint32(len(rows)))
+		rowIDBegin, rowIDEnd, err = allocAutoIDs(v.rowIDAllocator, len(rows))
 		if err != nil {
-			log.Error("JSON row consumer: failed to generate primary keys", zap.Int("count", len(rows)), zap.Error(err))
-			return fmt.Errorf("failed to generate %d primary keys, error: %w", len(rows), err)
-		}
-		if rowIDEnd-rowIDBegin != int64(len(rows)) {
-			log.Error("JSON row consumer: try to generate primary keys but allocated ids are not enough",
-				zap.Int("count", len(rows)), zap.Int64("generated", rowIDEnd-rowIDBegin))
-			return fmt.Errorf("try to generate %d primary keys but only %d keys were allocated", len(rows), rowIDEnd-rowIDBegin)
+			return err
 		}
 		log.Info("JSON row consumer: auto-generate primary keys", zap.Int64("begin", rowIDBegin), zap.Int64("end", rowIDEnd))
 		if !primaryValidator.isString {
@@ -282,13 +281,23 @@ func (v *JSONRowConsumer) Handle(rows []map[storage.FieldID]interface{}) error {
 			if validator.primaryKey {
 				continue
 			}
-			value := row[name]
+			value, ok := row[name]
+			valid := true
+			if value == nil && (validator.nullable || validator.hasDefault) {
+				// explicit null is kept for nullable field, missing value takes the default value if any
+				valid = validator.hasDefault && (!ok || !validator.nullable)
+				value = validator.defaultObj
+			}
 			if err := validator.convertFunc(value, v.segmentsData[shard][name]); err != nil {
 				log.Error("JSON row consumer: failed to convert value for field at the row",
 					zap.String("fieldName", validator.fieldName), zap.Int64("rowNumber", v.rowCounter+int64(i)), zap.Error(err))
 				return fmt.Errorf("failed to convert value for field '%s' at the row %d,  error: %w",
 					validator.fieldName, v.rowCounter+int64(i), err)
 			}
+			if validator.nullable {
+				fieldData := v.segmentsData[shard][name]
+				storage.SetValidData(fieldData, append(fieldData.GetValidData(), valid))
+			}
 		}
 	}
 
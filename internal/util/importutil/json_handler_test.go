@@ -19,6 +19,7 @@ package importutil
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -26,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/storage"
 )
@@ -299,3 +301,80 @@ func Test_JSONRowConsumerHandle(t *testing.T) {
 		assert.Equal(t, 0, len(consumer.autoIDRange))
 	})
 }
+
+func Test_JSONRowConsumerNullable(t *testing.T) {
+	ctx := context.Background()
+	idAllocator := newIDAllocator(ctx, t, nil)
+
+	schema := &schemapb.CollectionSchema{
+		Name:   "schema",
+		AutoID: true,
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      101,
+				Name:         "uid",
+				IsPrimaryKey: true,
+				AutoID:       true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:    102,
+				Name:       "age",
+				DataType:   schemapb.DataType_Int64,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+			},
+			{
+				FieldID:  103,
+				Name:     "status",
+				DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "max_length", Value: "10"},
+					{Key: common.DefaultValueKey, Value: "new"},
+				},
+			},
+			{
+				FieldID:  104,
+				Name:     "score",
+				DataType: schemapb.DataType_Double,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: common.NullableKey, Value: "true"},
+					{Key: common.DefaultValueKey, Value: "1.5"},
+				},
+			},
+		},
+	}
+
+	parser := NewJSONParser(ctx, schema)
+	mockConsumer := &mockJSONRowConsumer{
+		rows: make([]map[int64]interface{}, 0),
+	}
+	reader := strings.NewReader(`{"rows":[{"age":1,"status":"old","score":2},{"age":null},{},{"score":null}]}`)
+	err := parser.ParseRows(reader, mockConsumer)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(mockConsumer.rows))
+
+	consumer, err := NewJSONRowConsumer(schema, idAllocator, 1, 1024*1024, func(fields map[storage.FieldID]storage.FieldData, shard int) error {
+		return nil
+	})
+	assert.NoError(t, err)
+	err = consumer.Handle(mockConsumer.rows)
+	assert.NoError(t, err)
+
+	age := consumer.segmentsData[0][102].(*storage.Int64FieldData)
+	assert.Equal(t, []int64{1, 0, 0, 0}, age.Data)
+	assert.Equal(t, []bool{true, false, false, false}, age.GetValidData())
+
+	status := consumer.segmentsData[0][103].(*storage.StringFieldData)
+	assert.Equal(t, []string{"old", "new", "new", "new"}, status.Data)
+	assert.Nil(t, status.GetValidData())
+
+	score := consumer.segmentsData[0][104].(*storage.DoubleFieldData)
+	assert.Equal(t, []float64{2, 1.5, 1.5, 1.5}, score.Data)
+	assert.Equal(t, []bool{true, true, true, false}, score.GetValidData())
+
+	// missing value of field without default value is still rejected
+	schema.Fields[1].TypeParams = nil
+	parser = NewJSONParser(ctx, schema)
+	err = parser.ParseRows(strings.NewReader(`{"rows":[{}]}`), mockConsumer)
+	assert.Error(t, err)
+}
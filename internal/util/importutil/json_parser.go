@@ -46,12 +46,14 @@ type JSONParser struct {
 	bufSize      int64            // max rows in a buffer
 	fields       map[string]int64 // fields need to be parsed
 	name2FieldID map[string]storage.FieldID
+	optional     map[storage.FieldID]bool // nullable fields and fields with default value can be omitted
 }
 
 // NewJSONParser helper function to create a JSONParser
 func NewJSONParser(ctx context.Context, collectionSchema *schemapb.CollectionSchema) *JSONParser {
 	fields := make(map[string]int64)
 	name2FieldID := make(map[string]storage.FieldID)
+	optional := make(map[storage.FieldID]bool)
 	for i := 0; i < len(collectionSchema.Fields); i++ {
 		schema := collectionSchema.Fields[i]
 		// RowIDField and TimeStampField is internal field, no need to parse
@@ -65,6 +67,9 @@ func NewJSONParser(ctx context.Context, collectionSchema *schemapb.CollectionSch
 
 		fields[schema.GetName()] = 0
 		name2FieldID[schema.GetName()] = schema.GetFieldID()
+		if _, hasDefault := typeutil.GetFieldDefaultValue(schema); hasDefault || typeutil.IsFieldNullable(schema) {
+			optional[schema.GetFieldID()] = true
+		}
 	}
 
 	parser := &JSONParser{
@@ -72,6 +77,7 @@ func NewJSONParser(ctx context.Context, collectionSchema *schemapb.CollectionSch
 		bufSize:      MinBufferSize,
 		fields:       fields,
 		name2FieldID: name2FieldID,
+		optional:     optional,
 	}
 	adjustBufSize(parser, collectionSchema)
 
@@ -79,9 +85,15 @@ func NewJSONParser(ctx context.Context, collectionSchema *schemapb.CollectionSch
 }
 
 func adjustBufSize(parser *JSONParser, collectionSchema *schemapb.CollectionSchema) {
+	parser.bufSize = estimateBufSize(collectionSchema)
+	log.Info("JSON parser: reset bufSize", zap.Int64("bufSize", parser.bufSize))
+}
+
+// estimateBufSize returns the max rows in a buffer for row-based files according to the size of a record
+func estimateBufSize(collectionSchema *schemapb.CollectionSchema) int64 {
 	sizePerRecord, _ := typeutil.EstimateSizePerRecord(collectionSchema)
 	if sizePerRecord <= 0 {
-		return
+		return MinBufferSize
 	}
 
 	// split the file into no more than MaxBatchCount batches to parse
@@ -94,9 +106,7 @@ func adjustBufSize(parser *JSONParser, collectionSchema *schemapb.CollectionSche
 	if bufSize < MinBufferSize {
 		bufSize = MinBufferSize
 	}
-
-	log.Info("JSON parser: reset bufSize", zap.Int("sizePerRecord", sizePerRecord), zap.Int("bufSize", bufSize))
-	parser.bufSize = int64(bufSize)
+	return int64(bufSize)
 }
 
 func (p *JSONParser) verifyRow(raw interface{}) (map[storage.FieldID]interface{}, error) {
@@ -121,7 +131,7 @@ func (p *JSONParser) verifyRow(raw interface{}) (map[storage.FieldID]interface{}
 	if len(row) != len(p.name2FieldID) {
 		for k, v := range p.name2FieldID {
 			_, ok := row[v]
-			if !ok {
+			if !ok && !p.optional[v] {
 				log.Error("JSON parser: a field value is missed", zap.String("fieldName", k))
 				return nil, fmt.Errorf("value of field '%s' is missed", k)
 			}
@@ -195,7 +205,23 @@ func (p *JSONParser) ParseRows(r io.Reader, handler JSONRowHandler) error {
 
 			row, err := p.verifyRow(value)
 			if err != nil {
-				return err
+				errHandler, ok := handler.(JSONRowErrorHandler)
+				if !ok {
+					return err
+				}
+				// keep the order of rows, handle the buffered rows before reporting the bad row
+				isEmpty = false
+				if len(buf) > 0 {
+					if err = handler.Handle(buf); err != nil {
+						log.Error("JSON parser: failed to convert row value to entity", zap.Error(err))
+						return fmt.Errorf("failed to convert row value to entity, error: %w", err)
+					}
+					buf = make([]map[storage.FieldID]interface{}, 0, MinBufferSize)
+				}
+				if err = errHandler.HandleBadRow(err); err != nil {
+					return err
+				}
+				continue
 			}
 
 			buf = append(buf, row)
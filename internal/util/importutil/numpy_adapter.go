@@ -31,6 +31,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/sbinet/npyio"
 	"github.com/sbinet/npyio/npy"
 	"go.uber.org/zap"
@@ -128,6 +129,8 @@ func convertNumpyType(typeStr string) (schemapb.DataType, error) {
 		return schemapb.DataType_Int32, nil
 	case "i8", "<i8", "|i8", ">i8", "int64":
 		return schemapb.DataType_Int64, nil
+	case "f2", "<f2", "|f2", ">f2", "float16": // float16 vector data type is float16
+		return typeutil.Float16VectorType, nil
 	case "f4", "<f4", "|f4", ">f4", "float32":
 		return schemapb.DataType_Float, nil
 	case "f8", "<f8", "|f8", ">f8", "float64":
@@ -456,6 +459,36 @@ func (n *NumpyAdapter) ReadFloat32(count int) ([]float32, error) {
 	return data, nil
 }
 
+// ReadFloat16 reads float16 data, each element is the IEEE 754 binary16 bits of a value
+func (n *NumpyAdapter) ReadFloat16(count int) ([]uint16, error) {
+	if count <= 0 {
+		return nil, errors.New("cannot read float16 data with a zero or nagative count")
+	}
+
+	// incorrect type
+	if n.dataType != typeutil.Float16VectorType {
+		return nil, errors.New("numpy data is not float16 type")
+	}
+
+	// avoid read overflow
+	readSize := n.checkCount(count)
+	if readSize <= 0 {
+		return nil, errors.New("end of float16 file, nothing to read")
+	}
+
+	// read data
+	data := make([]uint16, readSize)
+	err := binary.Read(n.reader, n.order, &data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read float16 data with count %d, error: %w", readSize, err)
+	}
+
+	// update read position after successfully read
+	n.readPosition += readSize
+
+	return data, nil
+}
+
 func (n *NumpyAdapter) ReadFloat64(count int) ([]float64, error) {
 	if count <= 0 {
 		return nil, errors.New("cannot read float64 data with a zero or nagative count")
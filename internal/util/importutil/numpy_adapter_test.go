@@ -25,6 +25,7 @@ import (
 	"testing"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/sbinet/npyio/npy"
 	"github.com/stretchr/testify/assert"
 )
@@ -76,6 +77,7 @@ func Test_ConvertNumpyType(t *testing.T) {
 	checkFunc([]string{"i2", "<i2", "|i2", ">i2", "int16"}, schemapb.DataType_Int16)
 	checkFunc([]string{"i4", "<i4", "|i4", ">i4", "int32"}, schemapb.DataType_Int32)
 	checkFunc([]string{"i8", "<i8", "|i8", ">i8", "int64"}, schemapb.DataType_Int64)
+	checkFunc([]string{"f2", "<f2", "|f2", ">f2", "float16"}, typeutil.Float16VectorType)
 	checkFunc([]string{"f4", "<f4", "|f4", ">f4", "float32"}, schemapb.DataType_Float)
 	checkFunc([]string{"f8", "<f8", "|f8", ">f8", "float64"}, schemapb.DataType_Double)
 
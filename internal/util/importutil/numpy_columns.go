@@ -0,0 +1,136 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// each read of a .npz archive is a ReadAt call of the chunk manager, use a buffer to reduce the calls
+const npzReadBufferSize = 1024 * 1024 // 1MB
+
+// numpyColumn is an array of column-based import, it is either a .npy file or an array stored in a .npz archive
+type numpyColumn struct {
+	filePath  string // path of the .npy file or the .npz archive
+	arrayName string // file name of the .npy file, or name of the array in the .npz archive
+	fieldName string // name of the target field
+}
+
+func (c *numpyColumn) String() string {
+	_, fileType := GetFileNameAndExt(c.filePath)
+	if fileType == NpzFileExt {
+		return fmt.Sprintf("array '%s' of file '%s'", c.arrayName, c.filePath)
+	}
+	return fmt.Sprintf("file '%s'", c.filePath)
+}
+
+// openNpzArchive opens a .npz file, which is a zip archive of .npy files
+func openNpzArchive(ctx context.Context, chunkManager storage.ChunkManager, filePath string) (*zip.Reader, error) {
+	file, err := NewChunkManagerFileReader(ctx, chunkManager, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := zip.NewReader(file, file.size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open npz file '%s', error: %w", filePath, err)
+	}
+	return archive, nil
+}
+
+// listNumpyColumns lists the arrays of the numpy files, a .npy file holds one array named after the file,
+// a .npz archive holds multiple arrays named after its entries.
+// an array is imported into the field with the same name, unless the fieldMapping maps it to another field
+func listNumpyColumns(ctx context.Context, chunkManager storage.ChunkManager, filePaths []string,
+	fieldMapping map[string]string) ([]*numpyColumn, error) {
+	columns := make([]*numpyColumn, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		name, fileType := GetFileNameAndExt(filePath)
+		arrayNames := []string{name}
+		if fileType == NpzFileExt {
+			archive, err := openNpzArchive(ctx, chunkManager, filePath)
+			if err != nil {
+				return nil, err
+			}
+
+			arrayNames = make([]string, 0, len(archive.File))
+			for _, entry := range archive.File {
+				if entry.FileInfo().IsDir() {
+					continue
+				}
+				arrayName, entryType := GetFileNameAndExt(entry.Name)
+				if entryType != NumpyFileExt || GetCompressionExt(entry.Name) != "" {
+					return nil, fmt.Errorf("the entry '%s' of npz file '%s' is not a numpy array", entry.Name, filePath)
+				}
+				arrayNames = append(arrayNames, arrayName)
+			}
+
+			if len(arrayNames) == 0 {
+				return nil, fmt.Errorf("the npz file '%s' has no array", filePath)
+			}
+		}
+
+		for _, arrayName := range arrayNames {
+			fieldName, ok := fieldMapping[arrayName]
+			if !ok {
+				fieldName = arrayName
+			}
+			columns = append(columns, &numpyColumn{
+				filePath:  filePath,
+				arrayName: arrayName,
+				fieldName: fieldName,
+			})
+		}
+	}
+
+	return columns, nil
+}
+
+// openNumpyColumn opens the array of a column to be read sequentially, the caller must close the reader
+func openNumpyColumn(ctx context.Context, chunkManager storage.ChunkManager, column *numpyColumn) (io.ReadCloser, error) {
+	_, fileType := GetFileNameAndExt(column.filePath)
+	if fileType != NpzFileExt {
+		// for minio storage, chunkManager will download file into local memory
+		// for local storage, chunkManager open the file directly
+		return chunkManager.Reader(ctx, column.filePath)
+	}
+
+	archive, err := openNpzArchive(ctx, chunkManager, column.filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range archive.File {
+		arrayName, _ := GetFileNameAndExt(entry.Name)
+		if entry.FileInfo().IsDir() || arrayName != column.arrayName {
+			continue
+		}
+		reader, err := entry.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s, error: %w", column, err)
+		}
+		return &compressedFile{Reader: bufio.NewReaderSize(reader, npzReadBufferSize), closeFunc: reader.Close}, nil
+	}
+
+	return nil, fmt.Errorf("the %s doesn't exist", column)
+}
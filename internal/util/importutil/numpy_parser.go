@@ -18,13 +18,16 @@ package importutil
 
 import (
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
+	"math"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 )
 
@@ -42,6 +45,7 @@ type NumpyParser struct {
 
 	columnData    storage.FieldData                   // in-memory column data
 	callFlushFunc func(field storage.FieldData) error // call back function to output column data
+	badRowFunc    BadRowFunc                          // call back function for bad rows, only for dry run
 }
 
 // NewNumpyParser is helper function to create a NumpyParser
@@ -61,6 +65,12 @@ func NewNumpyParser(ctx context.Context, collectionSchema *schemapb.CollectionSc
 	return parser
 }
 
+// SetBadRowHandler makes the parser pass the bad rows to the handler instead of aborting the parse process,
+// the column data output by the flush function still contains the bad rows, so it's only for dry run
+func (p *NumpyParser) SetBadRowHandler(handler BadRowFunc) {
+	p.badRowFunc = handler
+}
+
 func (p *NumpyParser) validate(adapter *NumpyAdapter, fieldName string) error {
 	if adapter == nil {
 		log.Error("Numpy parser: numpy adapter is nil")
@@ -120,6 +130,38 @@ func (p *NumpyParser) validate(adapter *NumpyAdapter, fieldName string) error {
 			return fmt.Errorf("illegal dimension %d of numpy file for float vector field '%s', dimension should be %d",
 				shape[1], schema.GetName(), p.columnDesc.dimension)
 		}
+	} else if typeutil.IsHalfFloatVectorType(schema.DataType) {
+		// float16/float32/float64 numpy file can be used for float16 and bfloat16 vector file, values are
+		// converted to the precision of the field, numpy has no bfloat16 dtype so float32 is the natural choice for it
+		if elementType != typeutil.Float16VectorType && elementType != schemapb.DataType_Float && elementType != schemapb.DataType_Double {
+			log.Error("Numpy parser: illegal data type of numpy file for half precision vector field", zap.Any("dataType", elementType),
+				zap.String("fieldName", fieldName))
+			return fmt.Errorf("illegal data type %s of numpy file for %s field '%s'", getTypeName(elementType),
+				getTypeName(schema.DataType), schema.GetName())
+		}
+
+		// vector field, the shape should be 2
+		if len(shape) != 2 {
+			log.Error("Numpy parser: illegal shape of numpy file for half precision vector field, shape should be 2", zap.Int("shape", len(shape)),
+				zap.String("fieldName", fieldName))
+			return fmt.Errorf("illegal shape %d of numpy file for %s field '%s', shape should be 2", shape,
+				getTypeName(schema.DataType), schema.GetName())
+		}
+
+		// shape[0] is row count, shape[1] is element count per row
+		p.columnDesc.elementCount = shape[0] * shape[1]
+
+		p.columnDesc.dimension, err = getFieldDimension(schema)
+		if err != nil {
+			return err
+		}
+
+		if shape[1] != p.columnDesc.dimension {
+			log.Error("Numpy parser: illegal dimension of numpy file for half precision vector field", zap.String("fieldName", fieldName),
+				zap.Int("numpyDimension", shape[1]), zap.Int("fieldDimension", p.columnDesc.dimension))
+			return fmt.Errorf("illegal dimension %d of numpy file for %s field '%s', dimension should be %d",
+				shape[1], getTypeName(schema.DataType), schema.GetName(), p.columnDesc.dimension)
+		}
 	} else if schemapb.DataType_BinaryVector == schema.DataType {
 		if elementType != schemapb.DataType_BinaryVector {
 			log.Error("Numpy parser: illegal data type of numpy file for binary vector field", zap.Any("dataType", elementType),
@@ -306,6 +348,26 @@ func (p *NumpyParser) consume(adapter *NumpyAdapter) error {
 			Data:    data,
 			Dim:     p.columnDesc.dimension,
 		}
+	case typeutil.Float16VectorType, typeutil.BFloat16VectorType:
+		data, err := p.readHalfFloatVector(adapter)
+		if err != nil {
+			log.Error("Numpy parser: failed to read half precision vector array", zap.Error(err))
+			return err
+		}
+
+		if p.columnDesc.dt == typeutil.Float16VectorType {
+			p.columnData = &storage.Float16VectorFieldData{
+				NumRows: []int64{int64(p.columnDesc.elementCount)},
+				Data:    data,
+				Dim:     p.columnDesc.dimension,
+			}
+		} else {
+			p.columnData = &storage.BFloat16VectorFieldData{
+				NumRows: []int64{int64(p.columnDesc.elementCount)},
+				Data:    data,
+				Dim:     p.columnDesc.dimension,
+			}
+		}
 	default:
 		log.Error("Numpy parser: unsupported data type of field", zap.Any("dataType", p.columnDesc.dt), zap.String("fieldName", p.columnDesc.name))
 		return fmt.Errorf("unsupported data type %s of field '%s'", getTypeName(p.columnDesc.dt), p.columnDesc.name)
@@ -314,6 +376,50 @@ func (p *NumpyParser) consume(adapter *NumpyAdapter) error {
 	return nil
 }
 
+// readHalfFloatVector reads the numpy data of a half precision vector field, and converts it to the encoding
+// of the field type. Float16 data of float16 vector field is taken as is, others are converted via float32.
+func (p *NumpyParser) readHalfFloatVector(adapter *NumpyAdapter) ([]byte, error) {
+	var data []float32
+	switch adapter.GetType() {
+	case typeutil.Float16VectorType:
+		data16, err := adapter.ReadFloat16(p.columnDesc.elementCount)
+		if err != nil {
+			return nil, err
+		}
+		if p.columnDesc.dt == typeutil.Float16VectorType {
+			encoded := make([]byte, len(data16)*2)
+			for i, v := range data16 {
+				binary.LittleEndian.PutUint16(encoded[i*2:], v)
+			}
+			return encoded, nil
+		}
+		data = make([]float32, 0, len(data16))
+		for _, f16 := range data16 {
+			data = append(data, typeutil.Float16ToFloat32(f16))
+		}
+	case schemapb.DataType_Float:
+		var err error
+		data, err = adapter.ReadFloat32(p.columnDesc.elementCount)
+		if err != nil {
+			return nil, err
+		}
+	case schemapb.DataType_Double:
+		data64, err := adapter.ReadFloat64(p.columnDesc.elementCount)
+		if err != nil {
+			return nil, err
+		}
+		data = make([]float32, 0, len(data64))
+		for _, f64 := range data64 {
+			data = append(data, float32(f64))
+		}
+	default:
+		return nil, fmt.Errorf("illegal data type %s of numpy file for %s field '%s'", getTypeName(adapter.GetType()),
+			getTypeName(p.columnDesc.dt), p.columnDesc.name)
+	}
+
+	return typeutil.EncodeHalfFloatVector(p.columnDesc.dt, data)
+}
+
 func (p *NumpyParser) Parse(reader io.Reader, fieldName string, onlyValidate bool) error {
 	adapter, err := NewNumpyAdapter(reader)
 	if err != nil {
@@ -336,5 +442,47 @@ func (p *NumpyParser) Parse(reader io.Reader, fieldName string, onlyValidate boo
 		return err
 	}
 
+	err = p.checkRows()
+	if err != nil {
+		return err
+	}
+
 	return p.callFlushFunc(p.columnData)
 }
+
+// checkRows verifies the float values row by row, not-a-number and infinity are not allowed as in row-based files
+func (p *NumpyParser) checkRows() error {
+	var badRows map[int64]error
+	switch data := p.columnData.(type) {
+	case *storage.FloatFieldData:
+		badRows = findIllegalFloatRows(data.Data, 1, p.columnDesc.name)
+	case *storage.DoubleFieldData:
+		badRows = findIllegalFloatRows(data.Data, 1, p.columnDesc.name)
+	case *storage.FloatVectorFieldData:
+		badRows = findIllegalFloatRows(data.Data, data.Dim, p.columnDesc.name)
+	}
+
+	if p.badRowFunc != nil {
+		return handleBadRows(badRows, p.badRowFunc)
+	}
+	// abort at the first bad row
+	return handleBadRows(badRows, func(row int64, err error) error {
+		log.Error("Numpy parser: illegal value at the row", zap.String("fieldName", p.columnDesc.name),
+			zap.Int64("rowNumber", row), zap.Error(err))
+		return err
+	})
+}
+
+// findIllegalFloatRows returns the rows with not-a-number or infinity values, dim is the count of values per row
+func findIllegalFloatRows[T float32 | float64](data []T, dim int, fieldName string) map[int64]error {
+	badRows := make(map[int64]error)
+	for i, v := range data {
+		row := int64(i / dim)
+		value := float64(v)
+		if _, ok := badRows[row]; ok || !(math.IsNaN(value) || math.IsInf(value, 0)) {
+			continue
+		}
+		badRows[row] = fmt.Errorf("value '%v' for field '%s' at the row %d is not a number or infinity", v, fieldName, row)
+	}
+	return badRows
+}
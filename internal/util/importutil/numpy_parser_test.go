@@ -17,16 +17,21 @@
 package importutil
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
+	"math"
 	"os"
 	"testing"
 
 	"github.com/sbinet/npyio/npy"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func Test_NewNumpyParser(t *testing.T) {
@@ -513,6 +518,130 @@ func Test_NumpyParserParse(t *testing.T) {
 	})
 }
 
+func Test_NumpyParserParseHalfFloatVector(t *testing.T) {
+	ctx := context.Background()
+	schema := &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:    101,
+				Name:       "FieldFloat16Vector",
+				DataType:   typeutil.Float16VectorType,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}},
+			},
+			{
+				FieldID:    102,
+				Name:       "FieldBFloat16Vector",
+				DataType:   typeutil.BFloat16VectorType,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}},
+			},
+		},
+	}
+	values := []float32{1.5, -2, 0.25, 100}
+	data := [][2]float32{{1.5, -2}, {0.25, 100}}
+
+	// numpy float16 file, the npyio lib can't write float16, so a float32 file is patched
+	createFloat16Data := func() []byte {
+		data32, err := CreateNumpyData(data)
+		assert.Nil(t, err)
+		headerLen := len(data32) - len(values)*4
+		header := bytes.Replace(data32[:headerLen], []byte("<f4"), []byte("<f2"), 1)
+		body := make([]byte, len(values)*2)
+		for i, v := range values {
+			binary.LittleEndian.PutUint16(body[i*2:], typeutil.Float32ToFloat16(v))
+		}
+		return append(header, body...)
+	}
+	data16 := createFloat16Data()
+	data32, err := CreateNumpyData(data)
+	assert.Nil(t, err)
+	data64, err := CreateNumpyData([][2]float64{{1.5, -2}, {0.25, 100}})
+	assert.Nil(t, err)
+
+	for _, fieldName := range []string{"FieldFloat16Vector", "FieldBFloat16Vector"} {
+		for _, content := range [][]byte{data16, data32, data64} {
+			var parsed storage.FieldData
+			flushFunc := func(field storage.FieldData) error {
+				parsed = field
+				return nil
+			}
+			parser := NewNumpyParser(ctx, schema, flushFunc)
+			err = parser.Parse(bytes.NewReader(content), fieldName, false)
+			assert.Nil(t, err)
+			assert.Equal(t, 2, parsed.RowNum())
+
+			var encoded []byte
+			var dataType schemapb.DataType
+			switch field := parsed.(type) {
+			case *storage.Float16VectorFieldData:
+				encoded, dataType = field.Data, typeutil.Float16VectorType
+			case *storage.BFloat16VectorFieldData:
+				encoded, dataType = field.Data, typeutil.BFloat16VectorType
+			}
+			decoded, err := typeutil.DecodeHalfFloatVector(dataType, encoded)
+			assert.Nil(t, err)
+			assert.Equal(t, values, decoded)
+		}
+	}
+
+	// dimension mismatch
+	data3, err := CreateNumpyData([][3]float32{{1, 2, 3}})
+	assert.Nil(t, err)
+	parser := NewNumpyParser(ctx, schema, func(field storage.FieldData) error { return nil })
+	err = parser.Parse(bytes.NewReader(data3), "FieldFloat16Vector", false)
+	assert.NotNil(t, err)
+
+	// illegal data type
+	dataInt, err := CreateNumpyData([][2]int32{{1, 2}})
+	assert.Nil(t, err)
+	err = parser.Parse(bytes.NewReader(dataInt), "FieldBFloat16Vector", false)
+	assert.NotNil(t, err)
+}
+
+func Test_NumpyParserParseIllegalFloat(t *testing.T) {
+	ctx := context.Background()
+	schema := &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:  101,
+				Name:     "FieldDouble",
+				DataType: schemapb.DataType_Double,
+			},
+			{
+				FieldID:    102,
+				Name:       "FieldFloatVector",
+				DataType:   schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}},
+			},
+		},
+	}
+	flushFunc := func(field storage.FieldData) error {
+		return nil
+	}
+
+	// not-a-number and infinity are rejected
+	doubles, err := CreateNumpyData([]float64{1, math.NaN(), 2, math.Inf(-1)})
+	assert.Nil(t, err)
+	parser := NewNumpyParser(ctx, schema, flushFunc)
+	err = parser.Parse(bytes.NewReader(doubles), "FieldDouble", false)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "row 1")
+
+	// the bad rows are passed to the handler in dry run
+	vectors, err := CreateNumpyData([][2]float32{{1, 2}, {float32(math.Inf(1)), float32(math.NaN())}, {3, 4}, {5, float32(math.NaN())}})
+	assert.Nil(t, err)
+	parser = NewNumpyParser(ctx, schema, flushFunc)
+	badRows := make([]int64, 0)
+	parser.SetBadRowHandler(func(row int64, err error) error {
+		badRows = append(badRows, row)
+		return nil
+	})
+	err = parser.Parse(bytes.NewReader(vectors), "FieldFloatVector", false)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 3}, badRows)
+}
+
 func Test_NumpyParserParse_perf(t *testing.T) {
 	ctx := context.Background()
 	err := os.MkdirAll(TempFilesPath, os.ModePerm)
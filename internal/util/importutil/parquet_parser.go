@@ -0,0 +1,700 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/file"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// ParquetBatchSize is the count of rows read from a row group each time
+const ParquetBatchSize = 4096
+
+// ParquetParser parses a parquet file whose top-level columns are the collection fields, the file is read as arrow
+// columns in batches of rows, each batch is converted into fields data and output by the flush function.
+//
+// Scalar fields are primitive columns, vector fields are list columns(or fixed-size-list columns), a binary vector
+// field could also be a binary column with dim/8 bytes per row.
+type ParquetParser struct {
+	ctx              context.Context                // for canceling parse process
+	collectionSchema *schemapb.CollectionSchema     // collection schema
+	validators       map[storage.FieldID]*Validator // validators for each field
+	startRowGroup    int                            // the row groups before it have been imported, they are skipped
+
+	callFlushFunc    func(fields map[storage.FieldID]storage.FieldData) error // call back function to output fields data of a batch
+	rowGroupDoneFunc func(rowGroups int) error                                // call back function after a row group is output
+	badRowFunc       BadRowFunc                                               // call back function for bad rows, only for dry run
+}
+
+// NewParquetParser is helper function to create a ParquetParser
+func NewParquetParser(ctx context.Context, collectionSchema *schemapb.CollectionSchema,
+	flushFunc func(fields map[storage.FieldID]storage.FieldData) error) (*ParquetParser, error) {
+	if collectionSchema == nil {
+		log.Error("Parquet parser: collection schema is nil")
+		return nil, errors.New("collection schema is nil")
+	}
+	if flushFunc == nil {
+		log.Error("Parquet parser: flush function is nil")
+		return nil, errors.New("flush function is nil")
+	}
+
+	validators := make(map[storage.FieldID]*Validator)
+	err := initValidators(collectionSchema, validators)
+	if err != nil {
+		log.Error("Parquet parser: failed to initialize validators", zap.Error(err))
+		return nil, fmt.Errorf("failed to initialize validators, error: %w", err)
+	}
+
+	return &ParquetParser{
+		ctx:              ctx,
+		collectionSchema: collectionSchema,
+		validators:       validators,
+		callFlushFunc:    flushFunc,
+	}, nil
+}
+
+// SetCheckpoint skips the row groups imported by a previous attempt, the doneFunc is called with the number of
+// imported row groups after a row group is output
+func (p *ParquetParser) SetCheckpoint(startRowGroup int, doneFunc func(rowGroups int) error) {
+	p.startRowGroup = startRowGroup
+	p.rowGroupDoneFunc = doneFunc
+}
+
+// SetBadRowHandler makes the parser pass the bad rows to the handler instead of aborting the parse process,
+// the fields data output by the flush function is incomplete if there are bad rows, so it's only for dry run
+func (p *ParquetParser) SetBadRowHandler(handler BadRowFunc) {
+	p.badRowFunc = handler
+}
+
+// mapColumns maps the fields to the index of their arrow columns, a field absent from the file is not in the result
+func (p *ParquetParser) mapColumns(arrowSchema *arrow.Schema) (map[storage.FieldID]int, error) {
+	name2Field := make(map[string]*schemapb.FieldSchema)
+	for _, field := range p.collectionSchema.Fields {
+		// RowIDField and TimeStampField is internal field, if primary key field is auto-gernerated, no need to parse
+		if field.GetFieldID() == common.RowIDField || field.GetFieldID() == common.TimeStampField || field.GetAutoID() {
+			continue
+		}
+		name2Field[field.GetName()] = field
+	}
+
+	columns := make(map[storage.FieldID]int)
+	for i, column := range arrowSchema.Fields() {
+		field, ok := name2Field[column.Name]
+		if !ok {
+			log.Error("Parquet parser: the column is not defined in collection schema", zap.String("column", column.Name))
+			return nil, fmt.Errorf("the column '%s' is not defined in collection schema", column.Name)
+		}
+		if _, ok := columns[field.GetFieldID()]; ok {
+			log.Error("Parquet parser: duplicated column", zap.String("column", column.Name))
+			return nil, fmt.Errorf("duplicated column '%s'", column.Name)
+		}
+		if err := validateParquetColumn(field, column); err != nil {
+			return nil, err
+		}
+		columns[field.GetFieldID()] = i
+	}
+
+	// some fields not provided?
+	for name, field := range name2Field {
+		if _, ok := columns[field.GetFieldID()]; !ok && !typeutil.IsFieldOptional(field) {
+			log.Error("Parquet parser: a field column is missed", zap.String("fieldName", name))
+			return nil, fmt.Errorf("column of field '%s' is missed", name)
+		}
+	}
+
+	return columns, nil
+}
+
+// validateParquetColumn checks the arrow type of the column fits the field, the values are checked while converting
+func validateParquetColumn(field *schemapb.FieldSchema, column arrow.Field) error {
+	var ok bool
+	switch dataType := field.GetDataType(); {
+	case typeutil.IsSparseFloatVectorType(dataType):
+		return fmt.Errorf("sparse float vector field '%s' is not supported in parquet file", field.GetName())
+	case dataType == schemapb.DataType_BinaryVector:
+		ok = isArrowBinary(column.Type) || isArrowList(column.Type, isArrowInt)
+	case typeutil.IsVectorType(dataType):
+		ok = isArrowList(column.Type, isArrowFloat)
+	case dataType == schemapb.DataType_Bool:
+		ok = column.Type.ID() == arrow.BOOL
+	case dataType == schemapb.DataType_Int8, dataType == schemapb.DataType_Int16,
+		dataType == schemapb.DataType_Int32, dataType == schemapb.DataType_Int64:
+		ok = isArrowInt(column.Type)
+	case dataType == schemapb.DataType_Float, dataType == schemapb.DataType_Double:
+		ok = isArrowFloat(column.Type)
+	case dataType == schemapb.DataType_String, dataType == schemapb.DataType_VarChar:
+		ok = column.Type.ID() == arrow.STRING || isArrowBinary(column.Type)
+	}
+	if !ok {
+		return fmt.Errorf("column '%s' of type %s doesn't fit %s field '%s'", column.Name, column.Type,
+			getTypeName(field.GetDataType()), field.GetName())
+	}
+	return nil
+}
+
+// isArrowInt returns true for the integer types whose values fit int64
+func isArrowInt(dt arrow.DataType) bool {
+	switch dt.ID() {
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64, arrow.UINT8, arrow.UINT16, arrow.UINT32:
+		return true
+	}
+	return false
+}
+
+// isArrowFloat returns true for the floating point types and the integer types
+func isArrowFloat(dt arrow.DataType) bool {
+	return dt.ID() == arrow.FLOAT32 || dt.ID() == arrow.FLOAT64 || isArrowInt(dt)
+}
+
+func isArrowBinary(dt arrow.DataType) bool {
+	return dt.ID() == arrow.BINARY || dt.ID() == arrow.FIXED_SIZE_BINARY
+}
+
+// isArrowList returns true for the list types whose element type is accepted by elem
+func isArrowList(dt arrow.DataType, elem func(arrow.DataType) bool) bool {
+	switch t := dt.(type) {
+	case *arrow.ListType:
+		return elem(t.Elem())
+	case *arrow.FixedSizeListType:
+		return elem(t.Elem())
+	}
+	return false
+}
+
+// Parse reads the parquet file, the validation only checks the file schema
+func (p *ParquetParser) Parse(reader parquet.ReaderAtSeeker, onlyValidate bool) error {
+	pqReader, err := file.NewParquetReader(reader)
+	if err != nil {
+		log.Error("Parquet parser: failed to open parquet file", zap.Error(err))
+		return fmt.Errorf("failed to open parquet file, error: %w", err)
+	}
+	defer pqReader.Close()
+
+	fileReader, err := pqarrow.NewFileReader(pqReader, pqarrow.ArrowReadProperties{BatchSize: ParquetBatchSize},
+		memory.DefaultAllocator)
+	if err != nil {
+		log.Error("Parquet parser: failed to read parquet file as arrow columns", zap.Error(err))
+		return fmt.Errorf("failed to read parquet file as arrow columns, error: %w", err)
+	}
+	arrowSchema, err := fileReader.Schema()
+	if err != nil {
+		log.Error("Parquet parser: failed to convert parquet schema", zap.Error(err))
+		return fmt.Errorf("failed to convert parquet schema, error: %w", err)
+	}
+	columns, err := p.mapColumns(arrowSchema)
+	if err != nil {
+		return err
+	}
+
+	if onlyValidate {
+		return nil
+	}
+
+	rowOffset := int64(0)
+	for i := 0; i < pqReader.NumRowGroups(); i++ {
+		if isCanceled(p.ctx) {
+			log.Error("Parquet parser: import task was canceled")
+			return errors.New("import task was canceled")
+		}
+
+		numRows := pqReader.MetaData().RowGroup(i).NumRows()
+		if i < p.startRowGroup || numRows == 0 {
+			rowOffset += numRows
+			continue
+		}
+		if err := p.readRowGroup(fileReader, i, columns, rowOffset); err != nil {
+			return err
+		}
+		rowOffset += numRows
+
+		if p.rowGroupDoneFunc != nil {
+			err = p.rowGroupDoneFunc(i + 1)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	log.Info("Parquet parser: parse finished", zap.Int("rowGroups", pqReader.NumRowGroups()), zap.Int64("rowCount", rowOffset))
+	return nil
+}
+
+// readRowGroup reads a row group in batches of rows, each batch is converted into fields data and output
+func (p *ParquetParser) readRowGroup(fileReader *pqarrow.FileReader, rowGroup int, columns map[storage.FieldID]int,
+	rowOffset int64) error {
+	recordReader, err := fileReader.GetRecordReader(p.ctx, nil, []int{rowGroup})
+	if err != nil {
+		log.Error("Parquet parser: failed to read row group", zap.Int("rowGroup", rowGroup), zap.Error(err))
+		return fmt.Errorf("failed to read row group %d, error: %w", rowGroup, err)
+	}
+	defer recordReader.Release()
+
+	for {
+		record, err := recordReader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			log.Error("Parquet parser: failed to read row group", zap.Int("rowGroup", rowGroup),
+				zap.Int64("rowOffset", rowOffset), zap.Error(err))
+			return fmt.Errorf("failed to read row group %d, error: %w", rowGroup, err)
+		}
+
+		fieldsData, err := p.convertRecord(record, columns, rowOffset)
+		if err != nil {
+			return err
+		}
+		rowOffset += record.NumRows()
+
+		err = p.callFlushFunc(fieldsData)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// convertRecord converts the arrow columns of a batch of rows into fields data, the fields absent from the file take
+// their default values
+func (p *ParquetParser) convertRecord(record arrow.Record, columns map[storage.FieldID]int,
+	rowOffset int64) (map[storage.FieldID]storage.FieldData, error) {
+	fieldsData := initSegmentData(p.collectionSchema)
+	if fieldsData == nil {
+		log.Error("Parquet parser: failed to initialize FieldData list")
+		return nil, errors.New("failed to initialize FieldData list")
+	}
+
+	// the first error of each bad row, only for dry run
+	badRows := make(map[int64]error)
+	for _, field := range p.collectionSchema.GetFields() {
+		validator := p.validators[field.GetFieldID()]
+		if validator.autoID {
+			continue
+		}
+		column := &parquetColumn{
+			field:     field,
+			validator: validator,
+			numRows:   int(record.NumRows()),
+			fail: func(row int, err error) error {
+				err = fmt.Errorf("failed to convert value for field '%s' at the row %d, error: %w",
+					validator.fieldName, rowOffset+int64(row), err)
+				if p.badRowFunc != nil {
+					if _, ok := badRows[rowOffset+int64(row)]; !ok {
+						badRows[rowOffset+int64(row)] = err
+					}
+					return nil
+				}
+				log.Error("Parquet parser: failed to convert value for field at the row",
+					zap.String("fieldName", validator.fieldName), zap.Int64("rowNumber", rowOffset+int64(row)), zap.Error(err))
+				return err
+			},
+		}
+		if idx, ok := columns[field.GetFieldID()]; ok {
+			column.values = record.Column(idx)
+		}
+		if err := column.appendTo(fieldsData[field.GetFieldID()]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := handleBadRows(badRows, p.badRowFunc); err != nil {
+		return nil, err
+	}
+	return fieldsData, nil
+}
+
+// parquetColumn is the arrow column of a field in a batch of rows, values is nil if the field is absent from the file
+type parquetColumn struct {
+	field     *schemapb.FieldSchema
+	validator *Validator
+	values    arrow.Array
+	numRows   int
+	fail      func(row int, err error) error // called with a bad row, the row is skipped if it returns nil
+}
+
+// appendTo appends the rows of the column to the field data
+func (c *parquetColumn) appendTo(fieldData storage.FieldData) error {
+	var fill interface{}
+	if c.validator.nullable || c.validator.hasDefault {
+		var err error
+		if fill, err = typeutil.ParseFieldDefaultValue(c.field); err != nil {
+			return fmt.Errorf("failed to parse default value of field '%s', error: %w", c.field.GetName(), err)
+		}
+	}
+
+	var err error
+	switch data := fieldData.(type) {
+	case *storage.BoolFieldData:
+		bools, _ := c.values.(*array.Boolean)
+		fillValue, _ := fill.(bool)
+		data.Data, data.ValidData, err = appendScalarRows(c, data.Data, data.ValidData, fillValue, func(i int) (bool, error) {
+			return bools.Value(i), nil
+		})
+		data.NumRows[0] = int64(len(data.Data))
+	case *storage.Int8FieldData:
+		fillValue, _ := fill.(int32)
+		data.Data, data.ValidData, err = appendScalarRows(c, data.Data, data.ValidData, int8(fillValue),
+			intValue[int8](arrowInts(c.values), math.MinInt8, math.MaxInt8))
+		data.NumRows[0] = int64(len(data.Data))
+	case *storage.Int16FieldData:
+		fillValue, _ := fill.(int32)
+		data.Data, data.ValidData, err = appendScalarRows(c, data.Data, data.ValidData, int16(fillValue),
+			intValue[int16](arrowInts(c.values), math.MinInt16, math.MaxInt16))
+		data.NumRows[0] = int64(len(data.Data))
+	case *storage.Int32FieldData:
+		fillValue, _ := fill.(int32)
+		data.Data, data.ValidData, err = appendScalarRows(c, data.Data, data.ValidData, fillValue,
+			intValue[int32](arrowInts(c.values), math.MinInt32, math.MaxInt32))
+		data.NumRows[0] = int64(len(data.Data))
+	case *storage.Int64FieldData:
+		fillValue, _ := fill.(int64)
+		data.Data, data.ValidData, err = appendScalarRows(c, data.Data, data.ValidData, fillValue,
+			intValue[int64](arrowInts(c.values), math.MinInt64, math.MaxInt64))
+		data.NumRows[0] = int64(len(data.Data))
+	case *storage.FloatFieldData:
+		fillValue, _ := fill.(float32)
+		data.Data, data.ValidData, err = appendScalarRows(c, data.Data, data.ValidData, fillValue,
+			floatValue[float32](arrowFloats(c.values)))
+		data.NumRows[0] = int64(len(data.Data))
+	case *storage.DoubleFieldData:
+		fillValue, _ := fill.(float64)
+		data.Data, data.ValidData, err = appendScalarRows(c, data.Data, data.ValidData, fillValue,
+			floatValue[float64](arrowFloats(c.values)))
+		data.NumRows[0] = int64(len(data.Data))
+	case *storage.StringFieldData:
+		strs := arrowStrings(c.values)
+		fillValue, _ := fill.(string)
+		data.Data, data.ValidData, err = appendScalarRows(c, data.Data, data.ValidData, fillValue, func(i int) (string, error) {
+			return strs(i), nil
+		})
+		data.NumRows[0] = int64(len(data.Data))
+	case *storage.FloatVectorFieldData:
+		data.Data, err = appendVectorRows(c, data.Data, c.validator.dimension, floatValue[float32](arrowFloats(arrowListValues(c.values))))
+		data.NumRows[0] = int64(len(data.Data) / data.Dim)
+	case *storage.Float16VectorFieldData:
+		data.Data, err = c.appendHalfFloatRows(data.Data)
+		data.NumRows[0] = int64(len(data.Data) / (data.Dim * 2))
+	case *storage.BFloat16VectorFieldData:
+		data.Data, err = c.appendHalfFloatRows(data.Data)
+		data.NumRows[0] = int64(len(data.Data) / (data.Dim * 2))
+	case *storage.BinaryVectorFieldData:
+		if isArrowBinary(c.values.DataType()) {
+			data.Data, err = c.appendBinaryRows(data.Data)
+		} else {
+			data.Data, err = appendVectorRows(c, data.Data, c.validator.dimension/8,
+				intValue[byte](arrowInts(arrowListValues(c.values)), 0, math.MaxUint8))
+		}
+		data.NumRows[0] = int64(len(data.Data) / (data.Dim / 8))
+	default:
+		return fmt.Errorf("unsupported data type %s of field '%s'", getTypeName(c.field.GetDataType()), c.field.GetName())
+	}
+	return err
+}
+
+// appendScalarRows appends the rows of a scalar column to data, value returns the value of a non-null row, the null
+// rows take the fill value. The validity of the rows is appended to valid if the field is nullable.
+func appendScalarRows[T any](c *parquetColumn, data []T, valid []bool, fill T,
+	value func(i int) (T, error)) ([]T, []bool, error) {
+	for i := 0; i < c.numRows; i++ {
+		if c.values == nil || c.values.IsNull(i) {
+			if !c.validator.nullable && !c.validator.hasDefault {
+				if err := c.fail(i, errors.New("null value")); err != nil {
+					return nil, nil, err
+				}
+				continue
+			}
+			// explicit null is kept for nullable field, missing column takes the default value if any
+			data = append(data, fill)
+			if c.validator.nullable {
+				valid = append(valid, c.validator.hasDefault && c.values == nil)
+			}
+			continue
+		}
+		v, err := value(i)
+		if err != nil {
+			if err := c.fail(i, err); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+		data = append(data, v)
+		if c.validator.nullable {
+			valid = append(valid, true)
+		}
+	}
+	return data, valid, nil
+}
+
+// appendVectorRows appends the rows of a list column to data, each row should have rowSize elements, element
+// returns the value of an element of the list values
+func appendVectorRows[T any](c *parquetColumn, data []T, rowSize int, element func(k int) (T, error)) ([]T, error) {
+	elements := arrowListElements(c.values)
+	values := arrowListValues(c.values)
+	for i := 0; i < c.numRows; i++ {
+		if c.values.IsNull(i) {
+			if err := c.fail(i, errors.New("null vector")); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		start, end := elements(i)
+		if end-start != rowSize {
+			if err := c.fail(i, fmt.Errorf("array size %d doesn't equal to the size %d of a vector of dimension %d",
+				end-start, rowSize, c.validator.dimension)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		rowStart := len(data)
+		for k := start; k < end; k++ {
+			var v T
+			err := errors.New("null element")
+			if !values.IsNull(k) {
+				v, err = element(k)
+			}
+			if err != nil {
+				data = data[:rowStart]
+				if err := c.fail(i, err); err != nil {
+					return nil, err
+				}
+				break
+			}
+			data = append(data, v)
+		}
+	}
+	return data, nil
+}
+
+// appendHalfFloatRows appends the rows of a float list column to the data of a half precision vector field
+func (c *parquetColumn) appendHalfFloatRows(data []byte) ([]byte, error) {
+	floats, err := appendVectorRows(c, nil, c.validator.dimension, floatValue[float32](arrowFloats(arrowListValues(c.values))))
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := typeutil.EncodeHalfFloatVector(c.field.GetDataType(), floats)
+	if err != nil {
+		return nil, err
+	}
+	return append(data, encoded...), nil
+}
+
+// appendBinaryRows appends the rows of a binary column to the data of a binary vector field, dim/8 bytes per row
+func (c *parquetColumn) appendBinaryRows(data []byte) ([]byte, error) {
+	var value func(i int) []byte
+	switch a := c.values.(type) {
+	case *array.Binary:
+		value = a.Value
+	case *array.FixedSizeBinary:
+		value = a.Value
+	}
+	for i := 0; i < c.numRows; i++ {
+		if c.values.IsNull(i) {
+			if err := c.fail(i, errors.New("null vector")); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		v := value(i)
+		if len(v)*8 != c.validator.dimension {
+			if err := c.fail(i, fmt.Errorf("bit size %d doesn't equal to vector dimension %d", len(v)*8, c.validator.dimension)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		data = append(data, v...)
+	}
+	return data, nil
+}
+
+// intValue converts the integers to T, the integers out of [min, max] are bad values
+func intValue[T int8 | int16 | int32 | int64 | byte](ints func(i int) int64, min, max int64) func(i int) (T, error) {
+	return func(i int) (T, error) {
+		v := ints(i)
+		if v < min || v > max {
+			return 0, fmt.Errorf("value %d is out of range [%d, %d]", v, min, max)
+		}
+		return T(v), nil
+	}
+}
+
+// floatValue converts the floating point numbers to T, not-a-number and infinity are bad values
+func floatValue[T float32 | float64](floats func(i int) float64) func(i int) (T, error) {
+	return func(i int) (T, error) {
+		v := floats(i)
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return 0, fmt.Errorf("value %v is not a number or infinity", v)
+		}
+		return T(v), nil
+	}
+}
+
+// arrowInts returns the accessor of the values of an integer array
+func arrowInts(arr arrow.Array) func(i int) int64 {
+	switch a := arr.(type) {
+	case *array.Int8:
+		return func(i int) int64 { return int64(a.Value(i)) }
+	case *array.Int16:
+		return func(i int) int64 { return int64(a.Value(i)) }
+	case *array.Int32:
+		return func(i int) int64 { return int64(a.Value(i)) }
+	case *array.Int64:
+		return a.Value
+	case *array.Uint8:
+		return func(i int) int64 { return int64(a.Value(i)) }
+	case *array.Uint16:
+		return func(i int) int64 { return int64(a.Value(i)) }
+	case *array.Uint32:
+		return func(i int) int64 { return int64(a.Value(i)) }
+	}
+	return nil
+}
+
+// arrowFloats returns the accessor of the values of a floating point or integer array
+func arrowFloats(arr arrow.Array) func(i int) float64 {
+	switch a := arr.(type) {
+	case *array.Float32:
+		return func(i int) float64 { return float64(a.Value(i)) }
+	case *array.Float64:
+		return a.Value
+	}
+	if ints := arrowInts(arr); ints != nil {
+		return func(i int) float64 { return float64(ints(i)) }
+	}
+	return nil
+}
+
+// arrowStrings returns the accessor of the values of a string or binary array
+func arrowStrings(arr arrow.Array) func(i int) string {
+	switch a := arr.(type) {
+	case *array.String:
+		return a.Value
+	case *array.Binary:
+		return a.ValueString
+	case *array.FixedSizeBinary:
+		return func(i int) string { return string(a.Value(i)) }
+	}
+	return nil
+}
+
+// arrowListValues returns the values of the elements of a list array
+func arrowListValues(arr arrow.Array) arrow.Array {
+	switch a := arr.(type) {
+	case *array.List:
+		return a.ListValues()
+	case *array.FixedSizeList:
+		return a.ListValues()
+	}
+	return nil
+}
+
+// arrowListElements returns the accessor of the element range of the rows of a list array, the range indexes the
+// list values
+func arrowListElements(arr arrow.Array) func(i int) (int, int) {
+	switch a := arr.(type) {
+	case *array.List:
+		offsets := a.Offsets()
+		offset := a.Data().Offset()
+		return func(i int) (int, int) { return int(offsets[offset+i]), int(offsets[offset+i+1]) }
+	case *array.FixedSizeList:
+		n := int(a.DataType().(*arrow.FixedSizeListType).Len())
+		offset := a.Data().Offset()
+		return func(i int) (int, int) { return (offset + i) * n, (offset + i + 1) * n }
+	}
+	return nil
+}
+
+// ChunkManagerFileReader reads a file of chunk manager by ranges, it implements parquet.ReaderAtSeeker
+// so that a parquet file is read on demand instead of loading the whole file.
+type ChunkManagerFileReader struct {
+	ctx          context.Context
+	chunkManager storage.ChunkManager
+	filePath     string
+	size         int64
+	offset       int64
+}
+
+// NewChunkManagerFileReader is helper function to create a ChunkManagerFileReader
+func NewChunkManagerFileReader(ctx context.Context, chunkManager storage.ChunkManager, filePath string) (*ChunkManagerFileReader, error) {
+	size, err := chunkManager.Size(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return &ChunkManagerFileReader{
+		ctx:          ctx,
+		chunkManager: chunkManager,
+		filePath:     filePath,
+		size:         size,
+	}, nil
+}
+
+// ReadAt implements io.ReaderAt
+func (r *ChunkManagerFileReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, fmt.Errorf("negative offset %d to read file '%s'", off, r.filePath)
+	}
+	if off >= r.size {
+		return 0, io.EOF
+	}
+
+	length := int64(len(p))
+	if off+length > r.size {
+		length = r.size - off
+	}
+	data, err := r.chunkManager.ReadAt(r.ctx, r.filePath, off, length)
+	if err != nil {
+		return 0, err
+	}
+	n := copy(p, data)
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Seek implements io.Seeker
+func (r *ChunkManagerFileReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, fmt.Errorf("invalid whence %d to seek file '%s'", whence, r.filePath)
+	}
+	if offset < 0 {
+		return 0, fmt.Errorf("negative position %d to seek file '%s'", offset, r.filePath)
+	}
+	r.offset = offset
+	return offset, nil
+}
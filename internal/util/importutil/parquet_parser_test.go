@@ -0,0 +1,563 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/file"
+	"github.com/apache/arrow/go/v8/parquet/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func parquetSampleSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      101,
+				Name:         "uid",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:  102,
+				Name:     "flag",
+				DataType: schemapb.DataType_Bool,
+			},
+			{
+				FieldID:    103,
+				Name:       "tag",
+				DataType:   schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+			},
+			{
+				FieldID:    104,
+				Name:       "score",
+				DataType:   schemapb.DataType_Float,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "1.5"}},
+			},
+			{
+				FieldID:    105,
+				Name:       "vec",
+				DataType:   schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "4"}},
+			},
+			{
+				FieldID:    106,
+				Name:       "bvec",
+				DataType:   schemapb.DataType_BinaryVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "16"}},
+			},
+		},
+	}
+}
+
+// createSampleParquetFile writes rowGroups row groups of 3 rows, the "score" column is absent, the "tag" of
+// the second row in each row group is null
+func createSampleParquetFile(t *testing.T, rowGroups int) []byte {
+	vec, err := schema.ListOf(schema.NewFloat32Node("vec", parquet.Repetitions.Optional, -1), parquet.Repetitions.Optional, -1)
+	assert.NoError(t, err)
+	bvec, err := schema.NewPrimitiveNode("bvec", parquet.Repetitions.Required, parquet.Types.FixedLenByteArray, -1, 2)
+	assert.NoError(t, err)
+	root, err := schema.NewGroupNode("schema", parquet.Repetitions.Required, schema.FieldList{
+		schema.NewInt64Node("uid", parquet.Repetitions.Required, -1),
+		schema.NewBooleanNode("flag", parquet.Repetitions.Required, -1),
+		schema.NewByteArrayNode("tag", parquet.Repetitions.Optional, -1),
+		vec,
+		bvec,
+	}, -1)
+	assert.NoError(t, err)
+
+	buf := new(bytes.Buffer)
+	writer := file.NewParquetWriter(buf, root)
+	for i := 0; i < rowGroups; i++ {
+		base := int64(i * 3)
+		rgWriter := writer.AppendRowGroup()
+
+		cw, _ := rgWriter.NextColumn()
+		_, err = cw.(*file.Int64ColumnChunkWriter).WriteBatch([]int64{base, base + 1, base + 2}, nil, nil)
+		assert.NoError(t, err)
+		assert.NoError(t, cw.Close())
+
+		cw, _ = rgWriter.NextColumn()
+		_, err = cw.(*file.BooleanColumnChunkWriter).WriteBatch([]bool{true, false, true}, nil, nil)
+		assert.NoError(t, err)
+		assert.NoError(t, cw.Close())
+
+		cw, _ = rgWriter.NextColumn()
+		_, err = cw.(*file.ByteArrayColumnChunkWriter).WriteBatch([]parquet.ByteArray{[]byte("a"), []byte("c")}, []int16{1, 0, 1}, nil)
+		assert.NoError(t, err)
+		assert.NoError(t, cw.Close())
+
+		cw, _ = rgWriter.NextColumn()
+		values := make([]float32, 0, 12)
+		defLevels := make([]int16, 0, 12)
+		repLevels := make([]int16, 0, 12)
+		for j := 0; j < 12; j++ {
+			values = append(values, float32(base)+float32(j)*0.5)
+			defLevels = append(defLevels, 3)
+			if j%4 == 0 {
+				repLevels = append(repLevels, 0)
+			} else {
+				repLevels = append(repLevels, 1)
+			}
+		}
+		_, err = cw.(*file.Float32ColumnChunkWriter).WriteBatch(values, defLevels, repLevels)
+		assert.NoError(t, err)
+		assert.NoError(t, cw.Close())
+
+		cw, _ = rgWriter.NextColumn()
+		_, err = cw.(*file.FixedLenByteArrayColumnChunkWriter).WriteBatch([]parquet.FixedLenByteArray{{1, 2}, {3, 4}, {5, 6}}, nil, nil)
+		assert.NoError(t, err)
+		assert.NoError(t, cw.Close())
+
+		assert.NoError(t, rgWriter.Close())
+	}
+	assert.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func Test_NewParquetParser(t *testing.T) {
+	ctx := context.Background()
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData) error {
+		return nil
+	}
+
+	parser, err := NewParquetParser(ctx, nil, flushFunc)
+	assert.Error(t, err)
+	assert.Nil(t, parser)
+
+	parser, err = NewParquetParser(ctx, parquetSampleSchema(), nil)
+	assert.Error(t, err)
+	assert.Nil(t, parser)
+
+	schema := parquetSampleSchema()
+	schema.Fields[1].DataType = schemapb.DataType_None
+	parser, err = NewParquetParser(ctx, schema, flushFunc)
+	assert.Error(t, err)
+	assert.Nil(t, parser)
+
+	parser, err = NewParquetParser(ctx, parquetSampleSchema(), flushFunc)
+	assert.NoError(t, err)
+	assert.NotNil(t, parser)
+}
+
+func Test_ParquetParserParse(t *testing.T) {
+	ctx := context.Background()
+	content := createSampleParquetFile(t, 2)
+
+	flushed := make([]map[storage.FieldID]storage.FieldData, 0)
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData) error {
+		flushed = append(flushed, fields)
+		return nil
+	}
+	parser, err := NewParquetParser(ctx, parquetSampleSchema(), flushFunc)
+	assert.NoError(t, err)
+
+	// only validate
+	err = parser.Parse(bytes.NewReader(content), true)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(flushed))
+
+	err = parser.Parse(bytes.NewReader(content), false)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(flushed))
+
+	fields := flushed[1]
+	assert.Equal(t, []int64{3, 4, 5}, fields[101].(*storage.Int64FieldData).Data)
+	assert.Equal(t, []bool{true, false, true}, fields[102].(*storage.BoolFieldData).Data)
+	assert.Equal(t, []string{"a", "", "c"}, fields[103].(*storage.StringFieldData).Data)
+	assert.Equal(t, []bool{true, false, true}, fields[103].GetValidData())
+	assert.Equal(t, []float32{1.5, 1.5, 1.5}, fields[104].(*storage.FloatFieldData).Data)
+	assert.Nil(t, fields[104].GetValidData())
+	assert.Equal(t, 3, fields[105].RowNum())
+	assert.Equal(t, []float32{3, 3.5, 4, 4.5}, fields[105].GetRow(0))
+	assert.Equal(t, []byte{1, 2, 3, 4, 5, 6}, fields[106].(*storage.BinaryVectorFieldData).Data)
+}
+
+func Test_ParquetParserParseFailed(t *testing.T) {
+	ctx := context.Background()
+	content := createSampleParquetFile(t, 1)
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData) error {
+		return nil
+	}
+
+	// not a parquet file
+	parser, err := NewParquetParser(ctx, parquetSampleSchema(), flushFunc)
+	assert.NoError(t, err)
+	err = parser.Parse(bytes.NewReader([]byte("dummy")), false)
+	assert.Error(t, err)
+
+	// the column is not defined in collection schema
+	schema := parquetSampleSchema()
+	schema.Fields = schema.Fields[:5]
+	parser, err = NewParquetParser(ctx, schema, flushFunc)
+	assert.NoError(t, err)
+	err = parser.Parse(bytes.NewReader(content), true)
+	assert.Error(t, err)
+
+	// auto-generated primary key should not be provided
+	schema = parquetSampleSchema()
+	schema.Fields[0].AutoID = true
+	parser, err = NewParquetParser(ctx, schema, flushFunc)
+	assert.NoError(t, err)
+	err = parser.Parse(bytes.NewReader(content), true)
+	assert.Error(t, err)
+
+	// column of field is missed
+	schema = parquetSampleSchema()
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID:  107,
+		Name:     "count",
+		DataType: schemapb.DataType_Int32,
+	})
+	parser, err = NewParquetParser(ctx, schema, flushFunc)
+	assert.NoError(t, err)
+	err = parser.Parse(bytes.NewReader(content), true)
+	assert.Error(t, err)
+
+	// list column for scalar field
+	schema = parquetSampleSchema()
+	schema.Fields[4].DataType = schemapb.DataType_Double
+	parser, err = NewParquetParser(ctx, schema, flushFunc)
+	assert.NoError(t, err)
+	err = parser.Parse(bytes.NewReader(content), true)
+	assert.Error(t, err)
+
+	// scalar column for float vector field
+	schema = parquetSampleSchema()
+	schema.Fields[1].DataType = schemapb.DataType_FloatVector
+	schema.Fields[1].TypeParams = []*commonpb.KeyValuePair{{Key: "dim", Value: "4"}}
+	parser, err = NewParquetParser(ctx, schema, flushFunc)
+	assert.NoError(t, err)
+	err = parser.Parse(bytes.NewReader(content), true)
+	assert.Error(t, err)
+
+	// the column type doesn't fit the field
+	schema = parquetSampleSchema()
+	schema.Fields[1].DataType = schemapb.DataType_Int8
+	parser, err = NewParquetParser(ctx, schema, flushFunc)
+	assert.NoError(t, err)
+	err = parser.Parse(bytes.NewReader(content), true)
+	assert.Error(t, err)
+
+	// dimension mismatch
+	schema = parquetSampleSchema()
+	schema.Fields[4].TypeParams = []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}}
+	parser, err = NewParquetParser(ctx, schema, flushFunc)
+	assert.NoError(t, err)
+	err = parser.Parse(bytes.NewReader(content), false)
+	assert.Error(t, err)
+
+	// flush failed
+	parser, err = NewParquetParser(ctx, parquetSampleSchema(), func(fields map[storage.FieldID]storage.FieldData) error {
+		return errors.New("error")
+	})
+	assert.NoError(t, err)
+	err = parser.Parse(bytes.NewReader(content), false)
+	assert.Error(t, err)
+
+	// canceled
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	parser, err = NewParquetParser(cancelCtx, parquetSampleSchema(), flushFunc)
+	assert.NoError(t, err)
+	err = parser.Parse(bytes.NewReader(content), false)
+	assert.Error(t, err)
+}
+
+func Test_ChunkManagerFileReader(t *testing.T) {
+	ctx := context.Background()
+	cm := &MockChunkManager{
+		size:    10,
+		readBuf: map[string][]byte{"a.parquet": []byte("0123456789")},
+	}
+
+	reader, err := NewChunkManagerFileReader(ctx, cm, "a.parquet")
+	assert.NoError(t, err)
+
+	buf := make([]byte, 4)
+	n, err := reader.ReadAt(buf, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, n)
+	assert.Equal(t, []byte("2345"), buf)
+
+	n, err = reader.ReadAt(buf, 8)
+	assert.ErrorIs(t, err, io.EOF)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []byte("89"), buf[:n])
+
+	_, err = reader.ReadAt(buf, 10)
+	assert.ErrorIs(t, err, io.EOF)
+	_, err = reader.ReadAt(buf, -1)
+	assert.Error(t, err)
+
+	pos, err := reader.Seek(-3, io.SeekEnd)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), pos)
+	pos, err = reader.Seek(1, io.SeekCurrent)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), pos)
+	_, err = reader.Seek(-1, io.SeekStart)
+	assert.Error(t, err)
+	_, err = reader.Seek(0, 100)
+	assert.Error(t, err)
+
+	cm.readErr = errors.New("error")
+	_, err = reader.ReadAt(buf, 0)
+	assert.Error(t, err)
+
+	cm.sizeErr = errors.New("error")
+	_, err = NewChunkManagerFileReader(ctx, cm, "a.parquet")
+	assert.Error(t, err)
+}
+
+func Test_ImportWrapperParquet(t *testing.T) {
+	ctx := context.Background()
+	content := createSampleParquetFile(t, 2)
+	cm := &MockChunkManager{
+		size:    int64(len(content)),
+		readBuf: map[string][]byte{"rows.parquet": content},
+	}
+
+	idAllocator := newIDAllocator(ctx, t, nil)
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   make([]int64, 0),
+		AutoIds:    make([]int64, 0),
+		RowCount:   0,
+	}
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+
+	wrapper := NewImportWrapper(ctx, parquetSampleSchema(), 2, 1024*1024, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+
+	err := wrapper.Import([]string{"rows.parquet"}, ImportOptions{OnlyValidate: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, rowCounter.rowCount)
+
+	err = wrapper.Import([]string{"rows.parquet"}, DefaultImportOptions())
+	assert.NoError(t, err)
+	assert.Equal(t, 6, rowCounter.rowCount)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+
+	// auto-generated primary key
+	schema := parquetSampleSchema()
+	schema.Fields[0].AutoID = true
+	wrapper = NewImportWrapper(ctx, schema, 2, 1024*1024, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import([]string{"rows.parquet"}, DefaultImportOptions())
+	assert.Error(t, err)
+
+	// read failed
+	cm.readErr = errors.New("error")
+	wrapper = NewImportWrapper(ctx, parquetSampleSchema(), 2, 1024*1024, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import([]string{"rows.parquet"}, DefaultImportOptions())
+	assert.Error(t, err)
+}
+
+func Test_ImportWrapperParquetDryRun(t *testing.T) {
+	ctx := context.Background()
+	content := createSampleParquetFile(t, 2)
+	cm := &MockChunkManager{
+		size:    int64(len(content)),
+		readBuf: map[string][]byte{"rows.parquet": content},
+	}
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+	options := DefaultImportOptions()
+	options.DryRun = true
+
+	// the columns don't match the schema, the file can't be parsed
+	importResult := &rootcoordpb.ImportResult{State: commonpb.ImportState_ImportStarted}
+	err := dryRunWrapper(ctx, t, cm, importResult, reportFunc).Import([]string{"rows.parquet"}, options)
+	assert.Error(t, err)
+	reports := getValidationReport(t, importResult)
+	assert.Equal(t, 1, len(reports))
+	assert.NotEmpty(t, reports[0].Error)
+
+	// the null "tag" of the second row in each row group is a bad row if the field is not nullable
+	schema := parquetSampleSchema()
+	schema.Fields[2].TypeParams = nil
+	importResult = &rootcoordpb.ImportResult{State: commonpb.ImportState_ImportStarted}
+	wrapper := NewImportWrapper(ctx, schema, 2, 1024*1024, newIDAllocator(ctx, t, nil), cm, importResult, reportFunc)
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import([]string{"rows.parquet"}, options)
+	assert.Error(t, err)
+	assert.Equal(t, 0, rowCounter.rowCount)
+	reports = getValidationReport(t, importResult)
+	assert.Equal(t, 1, len(reports))
+	assert.Empty(t, reports[0].Error)
+	assert.Equal(t, int64(6), reports[0].RowCount)
+	assert.Equal(t, int64(2), reports[0].BadRowCount)
+	assert.Equal(t, int64(1), reports[0].BadRows[0].Row)
+	assert.Contains(t, reports[0].BadRows[0].Reason, "tag")
+	assert.Equal(t, int64(4), reports[0].BadRows[1].Row)
+
+	// all rows are valid
+	importResult = &rootcoordpb.ImportResult{State: commonpb.ImportState_ImportStarted}
+	wrapper = NewImportWrapper(ctx, parquetSampleSchema(), 2, 1024*1024, newIDAllocator(ctx, t, nil), cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import([]string{"rows.parquet"}, options)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ImportState_ImportCompleted, importResult.GetState())
+	assert.Equal(t, int64(6), importResult.GetRowCount())
+}
+
+func Test_ImportWrapperParquetCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	content := createSampleParquetFile(t, 2)
+	cm := &MockChunkManager{
+		size:    int64(len(content)),
+		readBuf: map[string][]byte{"a.parquet": content, "b.parquet": content},
+	}
+
+	idAllocator := newIDAllocator(ctx, t, nil)
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, _ := createMockCallbackFunctions(t, rowCounter)
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   make([]int64, 0),
+		AutoIds:    make([]int64, 0),
+		RowCount:   0,
+	}
+	saveSegmentFunc := func(fieldsInsert []*datapb.FieldBinlog, fieldsStats []*datapb.FieldBinlog, segmentID int64, targetChName string, rowCount int64) error {
+		importResult.Segments = append(importResult.Segments, segmentID)
+		importResult.RowCount += rowCount
+		return nil
+	}
+	reported := make([]int64, 0)
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		reported = append(reported, res.GetCheckpoints()[1].GetRowGroups())
+		return nil
+	}
+
+	// the first file is finished, the first row group of the second file is persisted
+	options := DefaultImportOptions()
+	options.Checkpoints = []*internalpb.ImportCheckpoint{
+		{File: "a.parquet", Finished: true, RowGroups: 2, Segments: []int64{50}, RowCount: 6},
+		{File: "b.parquet", RowGroups: 1, Segments: []int64{60}, RowCount: 3},
+	}
+	wrapper := NewImportWrapper(ctx, parquetSampleSchema(), 1, 1024*1024, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err := wrapper.Import([]string{"a.parquet", "b.parquet"}, options)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, rowCounter.rowCount)
+	assert.Equal(t, []int64{50, 60, 100}, importResult.GetSegments())
+	assert.Equal(t, int64(12), importResult.GetRowCount())
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.GetState())
+	assert.Equal(t, []int64{2, 2}, reported[:2])
+
+	assert.Equal(t, 2, len(importResult.GetCheckpoints()))
+	checkpoint := importResult.GetCheckpoints()[1]
+	assert.Equal(t, "b.parquet", checkpoint.GetFile())
+	assert.True(t, checkpoint.GetFinished())
+	assert.Equal(t, int64(2), checkpoint.GetRowGroups())
+	assert.Equal(t, []int64{60, 100}, checkpoint.GetSegments())
+	assert.Equal(t, int64(6), checkpoint.GetRowCount())
+
+	// no checkpoint, all the files are imported, the small row groups are not committed one by one
+	rowCounter = &rowCounterTest{}
+	assignSegmentFunc, flushFunc, _ = createMockCallbackFunctions(t, rowCounter)
+	importResult.Segments = make([]int64, 0)
+	importResult.RowCount = 0
+	reported = make([]int64, 0)
+	wrapper = NewImportWrapper(ctx, parquetSampleSchema(), 1, 1024*1024, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import([]string{"a.parquet", "b.parquet"}, DefaultImportOptions())
+	assert.NoError(t, err)
+	assert.Equal(t, 12, rowCounter.rowCount)
+	assert.Equal(t, int64(12), importResult.GetRowCount())
+	assert.Equal(t, 2, len(importResult.GetSegments()))
+	assert.Equal(t, []int64{0, 2, 2}, reported)
+	for _, checkpoint := range importResult.GetCheckpoints() {
+		assert.True(t, checkpoint.GetFinished())
+		assert.Equal(t, int64(2), checkpoint.GetRowGroups())
+		assert.Equal(t, int64(6), checkpoint.GetRowCount())
+	}
+
+	// the progress is committed after a row group once the checkpoint size is reached
+	rowCounter = &rowCounterTest{}
+	assignSegmentFunc, flushFunc, _ = createMockCallbackFunctions(t, rowCounter)
+	importResult.Segments = make([]int64, 0)
+	importResult.RowCount = 0
+	reported = make([]int64, 0)
+	wrapper = NewImportWrapper(ctx, parquetSampleSchema(), 1, 1024*1024, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	wrapper.checkpointSize = 1
+	err = wrapper.Import([]string{"a.parquet", "b.parquet"}, DefaultImportOptions())
+	assert.NoError(t, err)
+	assert.Equal(t, 12, rowCounter.rowCount)
+	assert.Equal(t, 4, len(importResult.GetSegments()))
+	assert.Equal(t, []int64{0, 0, 0, 1, 2, 2, 2}, reported)
+
+	// the progress is committed after the row group in which a segment is sealed
+	rowCounter = &rowCounterTest{}
+	assignSegmentFunc, flushFunc, _ = createMockCallbackFunctions(t, rowCounter)
+	importResult.Segments = make([]int64, 0)
+	importResult.RowCount = 0
+	reported = make([]int64, 0)
+	wrapper = NewImportWrapper(ctx, parquetSampleSchema(), 1, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import([]string{"a.parquet", "b.parquet"}, DefaultImportOptions())
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(importResult.GetSegments()))
+	assert.Equal(t, []int64{0, 0, 2, 2, 2}, reported)
+
+	// the checkpoint can't be reported
+	importResult.Segments = make([]int64, 0)
+	importResult.RowCount = 0
+	wrapper = NewImportWrapper(ctx, parquetSampleSchema(), 1, 1024*1024, idAllocator, cm, importResult, func(res *rootcoordpb.ImportResult) error {
+		return errors.New("error")
+	})
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	wrapper.reportImportAttempts = 1
+	err = wrapper.Import([]string{"a.parquet", "b.parquet"}, DefaultImportOptions())
+	assert.Error(t, err)
+}
@@ -127,6 +127,14 @@ func (m *DataCoordClient) Import(ctx context.Context, req *datapb.ImportTaskRequ
 	return &datapb.ImportTaskResponse{}, m.Err
 }
 
+func (m *DataCoordClient) Export(ctx context.Context, req *datapb.ExportTaskRequest, opts ...grpc.CallOption) (*datapb.ExportTaskResponse, error) {
+	return &datapb.ExportTaskResponse{}, m.Err
+}
+
+func (m *DataCoordClient) CancelImport(ctx context.Context, req *datapb.CancelImportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *DataCoordClient) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
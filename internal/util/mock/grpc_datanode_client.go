@@ -69,6 +69,14 @@ func (m *GrpcDataNodeClient) Import(ctx context.Context, req *datapb.ImportTaskR
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *GrpcDataNodeClient) Export(ctx context.Context, req *datapb.ExportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataNodeClient) CancelImport(ctx context.Context, req *datapb.CancelImportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcDataNodeClient) ResendSegmentStats(ctx context.Context, req *datapb.ResendSegmentStatsRequest, opts ...grpc.CallOption) (*datapb.ResendSegmentStatsResponse, error) {
 	return &datapb.ResendSegmentStatsResponse{}, m.Err
 }
@@ -38,6 +38,10 @@ func (m *GrpcQueryCoordClient) CheckHealth(ctx context.Context, in *milvuspb.Che
 	return &milvuspb.CheckHealthResponse{}, m.Err
 }
 
+func (m *GrpcQueryCoordClient) UpdateCollectionSchema(ctx context.Context, in *querypb.UpdateCollectionSchemaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcQueryCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
 	return &milvuspb.ComponentStates{}, m.Err
 }
@@ -101,6 +101,10 @@ func (m *GrpcQueryNodeClient) SyncDistribution(ctx context.Context, in *querypb.
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *GrpcQueryNodeClient) UpdateCollectionSchema(ctx context.Context, in *querypb.UpdateCollectionSchemaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcQueryNodeClient) UnsubDmChannel(ctx context.Context, req *querypb.UnsubDmChannelRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
@@ -201,6 +201,26 @@ func (m *GrpcRootCoordClient) ReportImport(ctx context.Context, req *rootcoordpb
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *GrpcRootCoordClient) Export(ctx context.Context, req *rootcoordpb.ExportRequest, opts ...grpc.CallOption) (*rootcoordpb.ExportResponse, error) {
+	return &rootcoordpb.ExportResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) CancelImport(ctx context.Context, req *rootcoordpb.CancelImportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest, opts ...grpc.CallOption) (*rootcoordpb.GetExportStateResponse, error) {
+	return &rootcoordpb.GetExportStateResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest, opts ...grpc.CallOption) (*rootcoordpb.ListExportTasksResponse, error) {
+	return &rootcoordpb.ListExportTasksResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ReportExport(ctx context.Context, req *rootcoordpb.ExportResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcRootCoordClient) CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
@@ -22,7 +22,9 @@ import (
 	"time"
 
 	config "github.com/milvus-io/milvus/internal/config"
+	boltkv "github.com/milvus-io/milvus/internal/kv/bolt"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 )
@@ -153,23 +155,47 @@ func (gp *BaseTable) initConfigsFromRemote(formatter func(key string) string) {
 		return
 	}
 
+	etcdInfo := &config.EtcdInfo{
+		Endpoints:       strings.Split(endpoints, ","),
+		KeyPrefix:       rootPath,
+		RefreshInterval: 10 * time.Second,
+	}
+	if gp.useEmbedBoltStore() {
+		dataDir, err := gp.mgr.GetConfig("etcd.data.dir")
+		if err != nil {
+			dataDir = "default.etcd"
+		}
+		s, err := boltkv.OpenShared(boltkv.FilePath(dataDir))
+		if err != nil {
+			log.Warn("failed to open embedded meta store", zap.Error(err))
+			return
+		}
+		etcdInfo.Client = boltkv.NewClient(s)
+	}
+
 	configFilePath := gp.configDir + "/" + gp.YamlFile
 	gp.mgr, err = config.Init(config.WithEnvSource(formatter),
 		config.WithFilesSource(&config.FileInfo{
 			Filepath:        configFilePath,
 			RefreshInterval: 10 * time.Second,
 		}),
-		config.WithEtcdSource(&config.EtcdInfo{
-			Endpoints:       strings.Split(endpoints, ","),
-			KeyPrefix:       rootPath,
-			RefreshInterval: 10 * time.Second,
-		}))
+		config.WithEtcdSource(etcdInfo))
 	if err != nil {
 		log.Info("init with etcd failed", zap.Error(err))
 		return
 	}
 }
 
+// useEmbedBoltStore tells whether the embedded etcd is replaced by the local bbolt meta store.
+func (gp *BaseTable) useEmbedBoltStore() bool {
+	embed, err := gp.mgr.GetConfig("etcd.use.embed")
+	if err != nil || embed != "true" {
+		return false
+	}
+	engine, err := gp.mgr.GetConfig("etcd.embed.engine")
+	return err == nil && engine == util.EmbedEtcdEngineBolt
+}
+
 // GetConfigDir returns the config directory
 func (gp *BaseTable) GetConfigDir() string {
 	return gp.configDir
@@ -66,6 +66,8 @@ type ComponentParam struct {
 	IndexCoordCfg indexCoordConfig
 	IndexNodeCfg  indexNodeConfig
 	HookCfg       HookConfig
+
+	ReplicationCfg replicationConfig
 }
 
 // InitOnce initialize once
@@ -92,6 +94,8 @@ func (p *ComponentParam) Init() {
 	p.IndexCoordCfg.init(&p.BaseTable)
 	p.IndexNodeCfg.init(&p.BaseTable)
 	p.HookCfg.init()
+
+	p.ReplicationCfg.init(&p.BaseTable)
 }
 
 func (p *ComponentParam) RocksmqEnable() bool {
@@ -106,6 +110,10 @@ func (p *ComponentParam) KafkaEnable() bool {
 	return p.KafkaCfg.Address.GetValue() != ""
 }
 
+func (p *ComponentParam) NatsmqEnable() bool {
+	return p.NatsmqCfg.Address.GetValue() != ""
+}
+
 // /////////////////////////////////////////////////////////////////////////////
 // --- common ---
 type commonConfig struct {
@@ -132,6 +140,9 @@ type commonConfig struct {
 	DataCoordSubName     string
 	DataNodeSubName      string
 
+	CDCSubName        string
+	ReplicatorSubName string
+
 	DefaultPartitionName string
 	DefaultIndexName     string
 	RetentionDuration    int64
@@ -183,6 +194,9 @@ func (p *commonConfig) init(base *BaseTable) {
 	p.initDataCoordSubName()
 	p.initDataNodeSubName()
 
+	p.initCDCSubName()
+	p.initReplicatorSubName()
+
 	p.initDefaultPartitionName()
 	p.initDefaultIndexName()
 	p.initRetentionDuration()
@@ -359,6 +373,24 @@ func (p *commonConfig) initDataNodeSubName() {
 	p.DataNodeSubName = p.initChanNamePrefix(keys)
 }
 
+// --- cdc ---
+func (p *commonConfig) initCDCSubName() {
+	keys := []string{
+		"msgChannel.subNamePrefix.cdcSubNamePrefix",
+		"common.subNamePrefix.cdcSubNamePrefix",
+	}
+	p.CDCSubName = p.initChanNamePrefix(keys)
+}
+
+// --- replicator ---
+func (p *commonConfig) initReplicatorSubName() {
+	keys := []string{
+		"msgChannel.subNamePrefix.replicatorSubNamePrefix",
+		"common.subNamePrefix.replicatorSubNamePrefix",
+	}
+	p.ReplicatorSubName = p.initChanNamePrefix(keys)
+}
+
 func (p *commonConfig) initDefaultPartitionName() {
 	p.DefaultPartitionName = p.Base.LoadWithDefault("common.defaultPartitionName", "_default")
 }
@@ -464,9 +496,18 @@ type rootCoordConfig struct {
 	MinSegmentSizeToEnableIndex int64
 	ImportTaskExpiration        float64
 	ImportTaskRetention         float64
+	ImportTaskMaxRetries        int32
+	ImportMaxFilesPerTask       int
+	ImportMaxTasksPerCollection int
+	ExportTaskExpiration        float64
+	ExportTaskRetention         float64
+	SnapshotGCEnabled           bool
+	SnapshotGCInterval          float64
+	SnapshotRetention           float64
 
 	// --- ETCD Path ---
 	ImportTaskSubPath string
+	ExportTaskSubPath string
 
 	CreatedTime time.Time
 	UpdatedTime time.Time
@@ -481,7 +522,16 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 	p.MinSegmentSizeToEnableIndex = p.Base.ParseInt64WithDefault("rootCoord.minSegmentSizeToEnableIndex", 1024)
 	p.ImportTaskExpiration = p.Base.ParseFloatWithDefault("rootCoord.importTaskExpiration", 15*60)
 	p.ImportTaskRetention = p.Base.ParseFloatWithDefault("rootCoord.importTaskRetention", 24*60*60)
+	p.ImportTaskMaxRetries = int32(p.Base.ParseIntWithDefault("rootCoord.importTaskMaxRetries", 3))
+	p.ImportMaxFilesPerTask = p.Base.ParseIntWithDefault("rootCoord.importMaxFilesPerTask", 100)
+	p.ImportMaxTasksPerCollection = p.Base.ParseIntWithDefault("rootCoord.importMaxConcurrentTasksPerCollection", 0)
 	p.ImportTaskSubPath = "importtask"
+	p.ExportTaskExpiration = p.Base.ParseFloatWithDefault("rootCoord.exportTaskExpiration", 3*60*60)
+	p.ExportTaskRetention = p.Base.ParseFloatWithDefault("rootCoord.exportTaskRetention", 24*60*60)
+	p.ExportTaskSubPath = "exporttask"
+	p.SnapshotGCEnabled = p.Base.ParseBool("rootCoord.snapshotGCEnabled", true)
+	p.SnapshotGCInterval = p.Base.ParseFloatWithDefault("rootCoord.snapshotGCInterval", 60*60)
+	p.SnapshotRetention = p.Base.ParseFloatWithDefault("rootCoord.snapshotRetention", 24*60*60)
 	p.EnableActiveStandby = p.Base.ParseBool("rootCoord.enableActiveStandby", false)
 }
 
@@ -115,6 +115,9 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, Params.DataNodeSubName, "by-dev-dataNode")
 		t.Logf("datanode subname = %s", Params.DataNodeSubName)
 
+		assert.Equal(t, Params.CDCSubName, "by-dev-cdc")
+		assert.Equal(t, Params.ReplicatorSubName, "by-dev-replicator")
+
 		assert.Equal(t, Params.SessionTTL, int64(DefaultSessionTTL))
 		t.Logf("default session TTL time = %d", Params.SessionTTL)
 		assert.Equal(t, Params.SessionRetryTimes, int64(DefaultSessionRetryTimes))
@@ -130,6 +133,14 @@ func TestComponentParam(t *testing.T) {
 		t.Logf("master MinSegmentSizeToEnableIndex = %d", Params.MinSegmentSizeToEnableIndex)
 		assert.NotEqual(t, Params.ImportTaskExpiration, 0)
 		t.Logf("master ImportTaskRetention = %f", Params.ImportTaskRetention)
+		assert.Equal(t, int32(3), Params.ImportTaskMaxRetries)
+		assert.Equal(t, 100, Params.ImportMaxFilesPerTask)
+		assert.Equal(t, 0, Params.ImportMaxTasksPerCollection)
+		assert.Equal(t, float64(3*60*60), Params.ExportTaskExpiration)
+		assert.Equal(t, float64(24*60*60), Params.ExportTaskRetention)
+		assert.True(t, Params.SnapshotGCEnabled)
+		assert.Equal(t, float64(60*60), Params.SnapshotGCInterval)
+		assert.Equal(t, float64(24*60*60), Params.SnapshotRetention)
 		assert.Equal(t, Params.EnableActiveStandby, false)
 		t.Logf("rootCoord EnableActiveStandby = %t", Params.EnableActiveStandby)
 
@@ -361,4 +372,15 @@ func TestComponentParam(t *testing.T) {
 		Params.UpdatedTime = time.Now()
 		t.Logf("UpdatedTime: %v", Params.UpdatedTime)
 	})
+
+	t.Run("test replicationConfig", func(t *testing.T) {
+		Params := params.ReplicationCfg
+
+		assert.False(t, Params.ReadOnly.GetAsBool())
+		assert.Equal(t, 300, Params.DrainTimeoutSeconds.GetAsInt())
+
+		params.Save("replication.readOnly", "true")
+		assert.True(t, Params.ReadOnly.GetAsBool())
+		params.Remove("replication.readOnly")
+	})
 }
@@ -0,0 +1,43 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paramtable
+
+// /////////////////////////////////////////////////////////////////////////////
+// --- replication ---
+type replicationConfig struct {
+	// ReadOnly marks the cluster as the standby of a replication, proxy rejects all writes.
+	// It's refreshed from etcd, so that failover can switch the standby to active without restarting proxies.
+	ReadOnly ParamItem
+	// DrainTimeoutSeconds is how long failover waits for the replicator to catch up with the primary
+	DrainTimeoutSeconds ParamItem
+}
+
+func (p *replicationConfig) init(base *BaseTable) {
+	p.ReadOnly = ParamItem{
+		Key:          "replication.readOnly",
+		DefaultValue: "false",
+		Version:      "2.2.0",
+	}
+	p.ReadOnly.Init(base.mgr)
+
+	p.DrainTimeoutSeconds = ParamItem{
+		Key:          "replication.drainTimeoutSeconds",
+		DefaultValue: "300",
+		Version:      "2.2.0",
+	}
+	p.DrainTimeoutSeconds.Init(base.mgr)
+}
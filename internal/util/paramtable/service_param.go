@@ -18,6 +18,7 @@ package paramtable
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"os"
 	"path"
@@ -47,9 +48,11 @@ type ServiceParam struct {
 	MetaStoreCfg    MetaStoreConfig
 	EtcdCfg         EtcdConfig
 	DBCfg           MetaDBConfig
+	MQCfg           MQConfig
 	PulsarCfg       PulsarConfig
 	KafkaCfg        KafkaConfig
 	RocksmqCfg      RocksmqConfig
+	NatsmqCfg       NatsmqConfig
 	MinioCfg        MinioConfig
 }
 
@@ -63,9 +66,11 @@ func (p *ServiceParam) Init() {
 		log.Debug("Mysql protocol is used as meta store")
 		p.DBCfg.Init(&p.BaseTable)
 	}
+	p.MQCfg.Init(&p.BaseTable)
 	p.PulsarCfg.Init(&p.BaseTable)
 	p.KafkaCfg.Init(&p.BaseTable)
 	p.RocksmqCfg.Init(&p.BaseTable)
+	p.NatsmqCfg.Init(&p.BaseTable)
 	p.MinioCfg.Init(&p.BaseTable)
 }
 
@@ -89,6 +94,7 @@ type EtcdConfig struct {
 
 	// --- Embed ETCD ---
 	UseEmbedEtcd ParamItem
+	EmbedEngine  ParamItem
 	ConfigPath   ParamItem
 	DataDir      ParamItem
 }
@@ -113,6 +119,16 @@ func (p *EtcdConfig) Init(base *BaseTable) {
 	}
 
 	if p.UseEmbedEtcd.GetAsBool() {
+		p.EmbedEngine = ParamItem{
+			Key:          "etcd.embed.engine",
+			DefaultValue: util.EmbedEtcdEngineEtcd,
+			Version:      "2.2.0",
+		}
+		p.EmbedEngine.Init(base.mgr)
+		if engine := p.EmbedEngine.GetValue(); engine != util.EmbedEtcdEngineEtcd && engine != util.EmbedEtcdEngineBolt {
+			panic(fmt.Sprintf("unsupported embedded etcd engine: %s", engine))
+		}
+
 		p.ConfigPath = ParamItem{
 			Key:          "etcd.config.path",
 			DefaultValue: "",
@@ -254,6 +270,8 @@ func (p *MetaStoreConfig) initMetaStoreType() {
 type MetaDBConfig struct {
 	Base *BaseTable
 
+	Driver       string
+	SqlitePath   string
 	Username     string
 	Password     string
 	Address      string
@@ -269,6 +287,8 @@ func (p *MetaDBConfig) Init(base *BaseTable) {
 }
 
 func (p *MetaDBConfig) LoadCfgToMemory() {
+	p.initDriver()
+	p.initSqlitePath()
 	p.initUsername()
 	p.initPassword()
 	p.initAddress()
@@ -278,6 +298,18 @@ func (p *MetaDBConfig) LoadCfgToMemory() {
 	p.initMaxIdleConns()
 }
 
+func (p *MetaDBConfig) initDriver() {
+	driver := p.Base.LoadWithDefault("mysql.driverName", util.MetaDBDriverMysql)
+	if driver != util.MetaDBDriverMysql && driver != util.MetaDBDriverSqlite {
+		panic(fmt.Sprintf("unsupported meta db driver: %s", driver))
+	}
+	p.Driver = driver
+}
+
+func (p *MetaDBConfig) initSqlitePath() {
+	p.SqlitePath = p.Base.LoadWithDefault("mysql.sqlitePath", "/var/lib/milvus/data/milvus_meta.db")
+}
+
 func (p *MetaDBConfig) initUsername() {
 	username, err := p.Base.Load("mysql.username")
 	if err != nil {
@@ -450,6 +482,8 @@ type KafkaConfig struct {
 	SecurityProtocol    ParamItem
 	ConsumerExtraConfig ParamGroup
 	ProducerExtraConfig ParamGroup
+	TransactionEnabled  ParamItem
+	TransactionIDPrefix ParamItem
 }
 
 func (k *KafkaConfig) Init(base *BaseTable) {
@@ -499,6 +533,20 @@ func (k *KafkaConfig) Init(base *BaseTable) {
 		Version:   "2.2.0",
 	}
 	k.ProducerExtraConfig.Init(base.mgr)
+
+	k.TransactionEnabled = ParamItem{
+		Key:          "kafka.transaction.enabled",
+		DefaultValue: "false",
+		Version:      "2.2.0",
+	}
+	k.TransactionEnabled.Init(base.mgr)
+
+	k.TransactionIDPrefix = ParamItem{
+		Key:          "kafka.transaction.idPrefix",
+		DefaultValue: "milvus",
+		Version:      "2.2.0",
+	}
+	k.TransactionIDPrefix.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -516,6 +564,93 @@ func (r *RocksmqConfig) Init(base *BaseTable) {
 	r.Path.Init(base.mgr)
 }
 
+// /////////////////////////////////////////////////////////////////////////////
+// --- mq ---
+type MQConfig struct {
+	Type ParamItem
+
+	CompressionType    ParamItem
+	CompressionMinSize ParamItem
+	PoisonPolicy       ParamItem
+}
+
+func (m *MQConfig) Init(base *BaseTable) {
+	m.Type = ParamItem{
+		Key:          "mq.type",
+		DefaultValue: "",
+		Version:      "2.2.0",
+	}
+	m.Type.Init(base.mgr)
+
+	m.CompressionType = ParamItem{
+		Key:          "mq.compression.type",
+		DefaultValue: "",
+		Version:      "2.2.0",
+	}
+	m.CompressionType.Init(base.mgr)
+
+	m.CompressionMinSize = ParamItem{
+		Key:          "mq.compression.minSize",
+		DefaultValue: "1024",
+		Version:      "2.2.0",
+	}
+	m.CompressionMinSize.Init(base.mgr)
+
+	m.PoisonPolicy = ParamItem{
+		Key:          "mq.poisonMessagePolicy",
+		DefaultValue: "skip",
+		Version:      "2.2.0",
+	}
+	m.PoisonPolicy.Init(base.mgr)
+}
+
+// /////////////////////////////////////////////////////////////////////////////
+// --- natsmq ---
+type NatsmqConfig struct {
+	Address                ParamItem
+	Storage                ParamItem
+	Replicas               ParamItem
+	RetentionTimeInMinutes ParamItem
+	RetentionSizeInMB      ParamItem
+}
+
+func (n *NatsmqConfig) Init(base *BaseTable) {
+	n.Address = ParamItem{
+		Key:          "natsmq.address",
+		DefaultValue: "",
+		Version:      "2.2.0",
+	}
+	n.Address.Init(base.mgr)
+
+	n.Storage = ParamItem{
+		Key:          "natsmq.storage",
+		DefaultValue: "file",
+		Version:      "2.2.0",
+	}
+	n.Storage.Init(base.mgr)
+
+	n.Replicas = ParamItem{
+		Key:          "natsmq.replicas",
+		DefaultValue: "1",
+		Version:      "2.2.0",
+	}
+	n.Replicas.Init(base.mgr)
+
+	n.RetentionTimeInMinutes = ParamItem{
+		Key:          "natsmq.retentionTimeInMinutes",
+		DefaultValue: "7200",
+		Version:      "2.2.0",
+	}
+	n.RetentionTimeInMinutes.Init(base.mgr)
+
+	n.RetentionSizeInMB = ParamItem{
+		Key:          "natsmq.retentionSizeInMB",
+		DefaultValue: "-1",
+		Version:      "2.2.0",
+	}
+	n.RetentionSizeInMB.Init(base.mgr)
+}
+
 // /////////////////////////////////////////////////////////////////////////////
 // --- minio ---
 type MinioConfig struct {
@@ -14,6 +14,7 @@ package paramtable
 import (
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/stretchr/testify/assert"
 )
@@ -55,6 +56,10 @@ func TestServiceParam(t *testing.T) {
 		assert.Panics(t, func() { SParams.Init() })
 
 		t.Setenv(metricsinfo.DeployModeEnvKey, metricsinfo.StandaloneDeployMode)
+		t.Setenv("etcd.embed.engine", "unknown")
+		assert.Panics(t, func() { SParams.Init() })
+
+		t.Setenv("etcd.embed.engine", util.EmbedEtcdEngineEtcd)
 		t.Setenv("etcd.use.embed", "false")
 		SParams.Init()
 	})
@@ -118,6 +123,30 @@ func TestServiceParam(t *testing.T) {
 		t.Logf("rocksmq path = %s", Params.Path.GetValue())
 	})
 
+	t.Run("test mqConfig", func(t *testing.T) {
+		Params := &SParams.MQCfg
+
+		assert.Equal(t, "", Params.CompressionType.GetValue())
+		assert.Equal(t, 1024, Params.CompressionMinSize.GetAsInt())
+		assert.Equal(t, "skip", Params.PoisonPolicy.GetValue())
+	})
+
+	t.Run("test kafkaConfig", func(t *testing.T) {
+		Params := &SParams.KafkaCfg
+
+		assert.False(t, Params.TransactionEnabled.GetAsBool())
+		assert.Equal(t, "milvus", Params.TransactionIDPrefix.GetValue())
+	})
+
+	t.Run("test natsmqConfig", func(t *testing.T) {
+		Params := &SParams.NatsmqCfg
+
+		assert.Equal(t, "file", Params.Storage.GetValue())
+		assert.Equal(t, 1, Params.Replicas.GetAsInt())
+		assert.Equal(t, 7200, Params.RetentionTimeInMinutes.GetAsInt())
+		assert.Equal(t, -1, Params.RetentionSizeInMB.GetAsInt())
+	})
+
 	t.Run("test minioConfig", func(t *testing.T) {
 		Params := &SParams.MinioCfg
 
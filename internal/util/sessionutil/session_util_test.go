@@ -18,6 +18,7 @@ import (
 
 	"github.com/blang/semver/v4"
 	"github.com/milvus-io/milvus/internal/common"
+	boltkv "github.com/milvus-io/milvus/internal/kv/bolt"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/etcd"
@@ -396,6 +397,46 @@ func TestSessionRevoke(t *testing.T) {
 	})
 }
 
+func TestSessionWithLocalStore(t *testing.T) {
+	paramtable.Init()
+
+	store, err := boltkv.Open(path.Join(t.TempDir(), boltkv.FileName))
+	require.NoError(t, err)
+	defer store.Close()
+	etcdCli := boltkv.NewClient(store)
+	defer etcdCli.Close()
+
+	ctx := context.Background()
+	metaRoot := DefaultServiceRoot
+
+	s1 := NewSession(ctx, metaRoot, etcdCli, WithResueNodeID(false))
+	s1.Init("localtest", "testAddr1", false, false)
+	s1.Register()
+
+	sessions, revision, err := s1.GetSessions("localtest")
+	require.NoError(t, err)
+	assert.Len(t, sessions, 1)
+
+	eventCh := s1.WatchServices("localtest", revision+1, nil)
+	s2 := NewSession(ctx, metaRoot, etcdCli, WithResueNodeID(false))
+	s2.Init("localtest", "testAddr2", false, false)
+	s2.Register()
+	assert.NotEqual(t, s1.ServerID, s2.ServerID)
+
+	event := <-eventCh
+	assert.Equal(t, SessionAddEvent, event.EventType)
+	assert.Equal(t, s2.ServerID, event.Session.ServerID)
+
+	s2.Revoke(time.Second)
+	event = <-eventCh
+	assert.Equal(t, SessionDelEvent, event.EventType)
+	assert.Equal(t, s2.ServerID, event.Session.ServerID)
+
+	sessions, _, err = s1.GetSessions("localtest")
+	require.NoError(t, err)
+	assert.Len(t, sessions, 1)
+}
+
 func TestSession_Registered(t *testing.T) {
 	session := &Session{}
 	session.UpdateRegistered(false)
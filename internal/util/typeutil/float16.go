@@ -0,0 +1,176 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+)
+
+// Float16VectorType and BFloat16VectorType are the data types of half precision float vector fields, the
+// milvus-proto in use has no such enums yet, the values reserved for them are used.
+//
+// Each element of a half precision vector takes two bytes in little endian, IEEE 754 binary16 for
+// Float16VectorType and the upper half of float32 for BFloat16VectorType. Vectors are carried by the
+// BinaryVector of vector field data, the dim of the vector field is the number of elements per row.
+const (
+	Float16VectorType  schemapb.DataType = 102
+	BFloat16VectorType schemapb.DataType = 103
+)
+
+// halfFloatElementSize is the size of an element of half precision vector.
+const halfFloatElementSize = 2
+
+// IsHalfFloatVectorType returns true if input is the float16 or bfloat16 vector type.
+func IsHalfFloatVectorType(dataType schemapb.DataType) bool {
+	return dataType == Float16VectorType || dataType == BFloat16VectorType
+}
+
+// GetBinaryVectorRowBytes returns the bytes of a row of the vector carried by BinaryVector of vector field data,
+// that is dim/8 for binary vector and dim*2 for half precision vectors.
+func GetBinaryVectorRowBytes(dataType schemapb.DataType, dim int64) int64 {
+	if IsHalfFloatVectorType(dataType) {
+		return dim * halfFloatElementSize
+	}
+	return dim / 8
+}
+
+// Float32ToFloat16 converts a float32 to IEEE 754 binary16, rounding to nearest even.
+func Float32ToFloat16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int32(bits>>23&0xff) - 127 + 15
+	mant := bits & 0x7fffff
+
+	switch {
+	case bits&0x7fffffff == 0:
+		return sign
+	case bits>>23&0xff == 0xff:
+		// infinity keeps infinity, nan keeps nan
+		if mant != 0 {
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	case exp >= 0x1f:
+		// overflow
+		return sign | 0x7c00
+	case exp <= 0:
+		// subnormal, or underflow to zero
+		if exp < -10 {
+			return sign
+		}
+		mant |= 0x800000
+		shift := uint32(14 - exp)
+		half := uint16(mant >> shift)
+		rem, mid := mant&(1<<shift-1), uint32(1)<<(shift-1)
+		if rem > mid || (rem == mid && half&1 == 1) {
+			half++
+		}
+		return sign | half
+	default:
+		half := sign | uint16(exp)<<10 | uint16(mant>>13)
+		// a carry of rounding goes into the exponent, which is still correct
+		rem := mant & 0x1fff
+		if rem > 0x1000 || (rem == 0x1000 && half&1 == 1) {
+			half++
+		}
+		return half
+	}
+}
+
+// Float16ToFloat32 converts an IEEE 754 binary16 to float32.
+func Float16ToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch exp {
+	case 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	case 0:
+		if mant == 0 {
+			return math.Float32frombits(sign)
+		}
+		// normalize the subnormal
+		exp = 127 - 15 + 1
+		for mant&0x400 == 0 {
+			mant <<= 1
+			exp--
+		}
+		return math.Float32frombits(sign | exp<<23 | (mant&0x3ff)<<13)
+	default:
+		return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+	}
+}
+
+// Float32ToBFloat16 converts a float32 to bfloat16, rounding to nearest even.
+func Float32ToBFloat16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	if math.IsNaN(float64(f)) {
+		return uint16(bits>>16) | 0x40
+	}
+	return uint16((bits + 0x7fff + (bits>>16)&1) >> 16)
+}
+
+// BFloat16ToFloat32 converts a bfloat16 to float32.
+func BFloat16ToFloat32(b uint16) float32 {
+	return math.Float32frombits(uint32(b) << 16)
+}
+
+// EncodeHalfFloatVector converts the float32 vectors to the encoding of the half precision vector type.
+func EncodeHalfFloatVector(dataType schemapb.DataType, data []float32) ([]byte, error) {
+	var convert func(float32) uint16
+	switch dataType {
+	case Float16VectorType:
+		convert = Float32ToFloat16
+	case BFloat16VectorType:
+		convert = Float32ToBFloat16
+	default:
+		return nil, fmt.Errorf("%s is not a half precision vector type", dataType.String())
+	}
+
+	result := make([]byte, len(data)*halfFloatElementSize)
+	for i, v := range data {
+		binary.LittleEndian.PutUint16(result[i*halfFloatElementSize:], convert(v))
+	}
+	return result, nil
+}
+
+// DecodeHalfFloatVector converts the half precision vectors to float32 vectors.
+func DecodeHalfFloatVector(dataType schemapb.DataType, data []byte) ([]float32, error) {
+	var convert func(uint16) float32
+	switch dataType {
+	case Float16VectorType:
+		convert = Float16ToFloat32
+	case BFloat16VectorType:
+		convert = BFloat16ToFloat32
+	default:
+		return nil, fmt.Errorf("%s is not a half precision vector type", dataType.String())
+	}
+	if len(data)%halfFloatElementSize != 0 {
+		return nil, fmt.Errorf("invalid data length %d of half precision vector", len(data))
+	}
+
+	result := make([]float32, len(data)/halfFloatElementSize)
+	for i := range result {
+		result[i] = convert(binary.LittleEndian.Uint16(data[i*halfFloatElementSize:]))
+	}
+	return result, nil
+}
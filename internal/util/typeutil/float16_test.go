@@ -0,0 +1,133 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+)
+
+func TestFloat16(t *testing.T) {
+	cases := []struct {
+		f    float32
+		half uint16
+	}{
+		{0, 0x0000},
+		{float32(math.Copysign(0, -1)), 0x8000},
+		{1, 0x3c00},
+		{-2, 0xc000},
+		{0.5, 0x3800},
+		{65504, 0x7bff},
+		{float32(math.Inf(1)), 0x7c00},
+		{float32(math.Inf(-1)), 0xfc00},
+		// the smallest subnormal and the largest subnormal
+		{float32(math.Ldexp(1, -24)), 0x0001},
+		{float32(math.Ldexp(1023, -24)), 0x03ff},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.half, Float32ToFloat16(c.f), c.f)
+		assert.Equal(t, c.f, Float16ToFloat32(c.half), c.half)
+	}
+
+	// overflow, underflow and rounding
+	assert.Equal(t, uint16(0x7c00), Float32ToFloat16(1e6))
+	assert.Equal(t, uint16(0x0000), Float32ToFloat16(1e-10))
+	assert.Equal(t, uint16(0x3c00), Float32ToFloat16(1+float32(math.Ldexp(1, -11))))
+	assert.Equal(t, uint16(0x3c01), Float32ToFloat16(1+float32(math.Ldexp(3, -12))))
+	assert.Equal(t, uint16(0x3c02), Float32ToFloat16(1+float32(math.Ldexp(3, -11))))
+	assert.True(t, math.IsNaN(float64(Float16ToFloat32(Float32ToFloat16(float32(math.NaN()))))))
+}
+
+func TestBFloat16(t *testing.T) {
+	assert.Equal(t, uint16(0x3f80), Float32ToBFloat16(1))
+	assert.Equal(t, uint16(0xc000), Float32ToBFloat16(-2))
+	assert.Equal(t, float32(1), BFloat16ToFloat32(0x3f80))
+	assert.Equal(t, float32(-2), BFloat16ToFloat32(0xc000))
+	// rounding to nearest even
+	assert.Equal(t, uint16(0x3f80), Float32ToBFloat16(math.Float32frombits(0x3f808000)))
+	assert.Equal(t, uint16(0x3f82), Float32ToBFloat16(math.Float32frombits(0x3f818000)))
+	assert.Equal(t, uint16(0x3f81), Float32ToBFloat16(math.Float32frombits(0x3f808001)))
+	assert.True(t, math.IsNaN(float64(BFloat16ToFloat32(Float32ToBFloat16(float32(math.NaN()))))))
+}
+
+func TestHalfFloatVector(t *testing.T) {
+	data := []float32{1, -2, 0.5, 0}
+	for _, dataType := range []schemapb.DataType{Float16VectorType, BFloat16VectorType} {
+		assert.True(t, IsHalfFloatVectorType(dataType))
+		assert.True(t, IsVectorType(dataType))
+		assert.True(t, IsDenseVectorType(dataType))
+		assert.Equal(t, int64(8), GetBinaryVectorRowBytes(dataType, 4))
+
+		encoded, err := EncodeHalfFloatVector(dataType, data)
+		assert.NoError(t, err)
+		assert.Equal(t, 8, len(encoded))
+		decoded, err := DecodeHalfFloatVector(dataType, encoded)
+		assert.NoError(t, err)
+		assert.Equal(t, data, decoded)
+
+		_, err = DecodeHalfFloatVector(dataType, encoded[:3])
+		assert.Error(t, err)
+	}
+	assert.False(t, IsHalfFloatVectorType(schemapb.DataType_FloatVector))
+	assert.Equal(t, int64(2), GetBinaryVectorRowBytes(schemapb.DataType_BinaryVector, 16))
+
+	_, err := EncodeHalfFloatVector(schemapb.DataType_FloatVector, data)
+	assert.Error(t, err)
+	_, err = DecodeHalfFloatVector(schemapb.DataType_FloatVector, nil)
+	assert.Error(t, err)
+}
+
+func TestHalfFloatVectorFieldData(t *testing.T) {
+	encoded, err := EncodeHalfFloatVector(Float16VectorType, []float32{1, 2, 3, 4})
+	assert.NoError(t, err)
+	fieldData := &schemapb.FieldData{
+		Type:      Float16VectorType,
+		FieldName: "vec",
+		Field: &schemapb.FieldData_Vectors{
+			Vectors: &schemapb.VectorField{
+				Dim:  2,
+				Data: &schemapb.VectorField_BinaryVector{BinaryVector: encoded},
+			},
+		},
+	}
+
+	size, err := EstimateEntitySize([]*schemapb.FieldData{fieldData}, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, size)
+
+	size, err = EstimateSizePerRecord(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{{
+			Name:       "vec",
+			DataType:   BFloat16VectorType,
+			TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "128"}},
+		}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 256, size)
+
+	dst := make([]*schemapb.FieldData, 1)
+	AppendFieldData(dst, []*schemapb.FieldData{fieldData}, 1)
+	AppendFieldData(dst, []*schemapb.FieldData{fieldData}, 0)
+	assert.Equal(t, append(append([]byte{}, encoded[4:]...), encoded[:4]...), dst[0].GetVectors().GetBinaryVector())
+	DeleteFieldData(dst)
+	assert.Equal(t, encoded[4:], dst[0].GetVectors().GetBinaryVector())
+}
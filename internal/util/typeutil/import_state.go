@@ -0,0 +1,35 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import "github.com/milvus-io/milvus-proto/go-api/commonpb"
+
+// ImportCancelling, ImportCancelled and ImportCancelledAndCleaned are the states of import tasks cancelled by user,
+// the milvus-proto in use has no such enums yet, values far from the declared ones are used.
+//
+// A working task is cancelling until its DataNode stops it, then it's cancelled like a failed task, and turns
+// cancelled and cleaned once its segments are dropped.
+const (
+	ImportCancelling          commonpb.ImportState = 100
+	ImportCancelled           commonpb.ImportState = 101
+	ImportCancelledAndCleaned commonpb.ImportState = 102
+)
+
+// IsImportCancelledState returns true if the import task has been stopped by a cancellation.
+func IsImportCancelledState(state commonpb.ImportState) bool {
+	return state == ImportCancelled || state == ImportCancelledAndCleaned
+}
@@ -0,0 +1,457 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// IsFieldNullable returns true if the field is declared with type param nullable=true.
+func IsFieldNullable(field *schemapb.FieldSchema) bool {
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() == common.NullableKey {
+			nullable, err := strconv.ParseBool(kv.GetValue())
+			return err == nil && nullable
+		}
+	}
+	return false
+}
+
+// GetFieldDefaultValue returns the raw default value of the field and whether it's specified.
+func GetFieldDefaultValue(field *schemapb.FieldSchema) (string, bool) {
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() == common.DefaultValueKey {
+			return kv.GetValue(), true
+		}
+	}
+	return "", false
+}
+
+// IsFieldOptional returns true if the field could be absent from the inserted data,
+// that is the field is nullable or has a default value.
+func IsFieldOptional(field *schemapb.FieldSchema) bool {
+	_, hasDefault := GetFieldDefaultValue(field)
+	return hasDefault || IsFieldNullable(field)
+}
+
+// ParseFieldDefaultValue converts the default value of the field to the go type of its data type,
+// the zero value is returned if the field has no default value.
+func ParseFieldDefaultValue(field *schemapb.FieldSchema) (interface{}, error) {
+	raw, ok := GetFieldDefaultValue(field)
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		if !ok {
+			return false, nil
+		}
+		return strconv.ParseBool(raw)
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		if !ok {
+			return int32(0), nil
+		}
+		bitSize := map[schemapb.DataType]int{
+			schemapb.DataType_Int8:  8,
+			schemapb.DataType_Int16: 16,
+			schemapb.DataType_Int32: 32,
+		}[field.GetDataType()]
+		v, err := strconv.ParseInt(raw, 10, bitSize)
+		if err != nil {
+			return nil, err
+		}
+		return int32(v), nil
+	case schemapb.DataType_Int64:
+		if !ok {
+			return int64(0), nil
+		}
+		return strconv.ParseInt(raw, 10, 64)
+	case schemapb.DataType_Float:
+		if !ok {
+			return float32(0), nil
+		}
+		v, err := strconv.ParseFloat(raw, 32)
+		if err != nil {
+			return nil, err
+		}
+		return float32(v), nil
+	case schemapb.DataType_Double:
+		if !ok {
+			return float64(0), nil
+		}
+		return strconv.ParseFloat(raw, 64)
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return raw, nil
+	default:
+		return nil, fmt.Errorf("default value is not supported for field %s of type %s", field.GetName(), field.GetDataType().String())
+	}
+}
+
+// GenDefaultFieldData generates a column of numRows rows filled with the default value of the field.
+func GenDefaultFieldData(field *schemapb.FieldSchema, numRows int) (*schemapb.FieldData, error) {
+	value, err := ParseFieldDefaultValue(field)
+	if err != nil {
+		return nil, err
+	}
+
+	scalars := &schemapb.ScalarField{}
+	switch v := value.(type) {
+	case bool:
+		data := make([]bool, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}
+	case int32:
+		data := make([]int32, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}
+	case int64:
+		data := make([]int64, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}
+	case float32:
+		data := make([]float32, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}
+	case float64:
+		data := make([]float64, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}
+	case string:
+		data := make([]string, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}
+	}
+
+	return &schemapb.FieldData{
+		Type:      field.GetDataType(),
+		FieldName: field.GetName(),
+		FieldId:   field.GetFieldID(),
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: scalars,
+		},
+	}, nil
+}
+
+// GenValidData generates the validity of numRows rows of the field, all rows share the same validity.
+func GenValidData(fieldID int64, numRows int, valid bool) *segcorepb.FieldValidData {
+	data := make([]bool, numRows)
+	for i := range data {
+		data[i] = valid
+	}
+	return &segcorepb.FieldValidData{
+		FieldId:   fieldID,
+		ValidData: data,
+	}
+}
+
+// AppendValidData appends the validity of specified index from src to dst, works like AppendFieldData.
+func AppendValidData(dst []*segcorepb.FieldValidData, src []*segcorepb.FieldValidData, idx int64) {
+	for i, validData := range src {
+		if dst[i] == nil {
+			dst[i] = &segcorepb.FieldValidData{
+				FieldId: validData.GetFieldId(),
+			}
+		}
+		dst[i].ValidData = append(dst[i].ValidData, validData.GetValidData()[idx])
+	}
+}
+
+// MergeValidData appends the validity in src to the validity of the same field in dst.
+func MergeValidData(dst []*segcorepb.FieldValidData, src []*segcorepb.FieldValidData) []*segcorepb.FieldValidData {
+	for _, srcValidData := range src {
+		merged := false
+		for _, dstValidData := range dst {
+			if dstValidData.GetFieldId() == srcValidData.GetFieldId() {
+				dstValidData.ValidData = append(dstValidData.ValidData, srcValidData.GetValidData()...)
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			dst = append(dst, &segcorepb.FieldValidData{
+				FieldId:   srcValidData.GetFieldId(),
+				ValidData: append([]bool{}, srcValidData.GetValidData()...),
+			})
+		}
+	}
+	return dst
+}
+
+// SplitAddedField extracts the field carried by the collection.add_field property from the collection properties,
+// the field is nil if the property is absent, and the other properties are returned as well.
+func SplitAddedField(properties []*commonpb.KeyValuePair) (*schemapb.FieldSchema, []*commonpb.KeyValuePair, error) {
+	var field *schemapb.FieldSchema
+	rest := make([]*commonpb.KeyValuePair, 0, len(properties))
+	for _, kv := range properties {
+		if kv.GetKey() != common.CollectionAddFieldKey {
+			rest = append(rest, kv)
+			continue
+		}
+		if field != nil {
+			return nil, nil, fmt.Errorf("only one field could be added at a time")
+		}
+		field = &schemapb.FieldSchema{}
+		if err := jsonpb.UnmarshalString(kv.GetValue(), field); err != nil {
+			return nil, nil, fmt.Errorf("invalid field schema %s: %s", kv.GetValue(), err.Error())
+		}
+	}
+	return field, rest, nil
+}
+
+// FieldValidDataNumber is the field number of the per-row validity of schemapb.FieldData, it travels as an unknown
+// field since the FieldData of milvus-proto in use does not declare it yet. The number is the one of valid_data in
+// the upstream FieldData, where 6 is taken by is_dynamic, so the validity keeps its meaning once milvus-proto is bumped.
+const FieldValidDataNumber protowire.Number = 7
+
+// GetFieldValidData returns the per-row validity carried by the field data, nil if the field data carries none.
+func GetFieldValidData(fieldData *schemapb.FieldData) []bool {
+	if fieldData == nil {
+		return nil
+	}
+	var valid []bool
+	b := fieldData.XXX_unrecognized
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil
+		}
+		b = b[n:]
+		if num != FieldValidDataNumber {
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return nil
+			}
+			b = b[n:]
+			continue
+		}
+		switch typ {
+		case protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return nil
+			}
+			valid = append(valid, v != 0)
+			b = b[n:]
+		case protowire.BytesType:
+			packed, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return nil
+			}
+			for len(packed) > 0 {
+				v, m := protowire.ConsumeVarint(packed)
+				if m < 0 {
+					return nil
+				}
+				valid = append(valid, v != 0)
+				packed = packed[m:]
+			}
+			b = b[n:]
+		default:
+			return nil
+		}
+	}
+	return valid
+}
+
+// SetFieldValidData replaces the per-row validity carried by the field data, nil valid removes it.
+func SetFieldValidData(fieldData *schemapb.FieldData, valid []bool) {
+	var rest []byte
+	b := fieldData.XXX_unrecognized
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			break
+		}
+		m := protowire.ConsumeFieldValue(num, typ, b[n:])
+		if m < 0 {
+			break
+		}
+		if num != FieldValidDataNumber {
+			rest = append(rest, b[:n+m]...)
+		}
+		b = b[n+m:]
+	}
+	if valid != nil {
+		packed := make([]byte, 0, len(valid))
+		for _, v := range valid {
+			packed = protowire.AppendVarint(packed, protowire.EncodeBool(v))
+		}
+		rest = protowire.AppendTag(rest, FieldValidDataNumber, protowire.BytesType)
+		rest = protowire.AppendBytes(rest, packed)
+	}
+	fieldData.XXX_unrecognized = rest
+}
+
+// FieldValidDataMerger collects the per-row validity of the field data while they are reduced by AppendFieldData,
+// rows of the field data which carry no validity are treated as valid.
+type FieldValidDataMerger struct {
+	srcs   [][][]bool
+	merged [][]bool
+}
+
+// NewFieldValidDataMerger creates a merger of the validity carried by the columns of srcs,
+// the i-th column of every source is the same field.
+func NewFieldValidDataMerger(srcs ...[]*schemapb.FieldData) *FieldValidDataMerger {
+	m := &FieldValidDataMerger{srcs: make([][][]bool, len(srcs))}
+	for i, fieldsData := range srcs {
+		m.srcs[i] = make([][]bool, len(fieldsData))
+		for j, fieldData := range fieldsData {
+			m.srcs[i][j] = GetFieldValidData(fieldData)
+			if m.srcs[i][j] != nil && m.merged == nil {
+				m.merged = make([][]bool, len(fieldsData))
+			}
+		}
+	}
+	return m
+}
+
+// Append appends the validity of row idx of the source src, works like AppendFieldData.
+func (m *FieldValidDataMerger) Append(src int, idx int64) {
+	if m.merged == nil {
+		return
+	}
+	for j, valid := range m.srcs[src] {
+		if j >= len(m.merged) {
+			break
+		}
+		m.merged[j] = append(m.merged[j], valid == nil || valid[idx])
+	}
+}
+
+// DeleteLast removes the validity of the last appended row, works like DeleteFieldData.
+func (m *FieldValidDataMerger) DeleteLast() {
+	for j := range m.merged {
+		if len(m.merged[j]) > 0 {
+			m.merged[j] = m.merged[j][:len(m.merged[j])-1]
+		}
+	}
+}
+
+// Apply attaches the merged validity to the reduced field data, nothing is attached if no source carries validity.
+func (m *FieldValidDataMerger) Apply(dst []*schemapb.FieldData) {
+	for j, valid := range m.merged {
+		if j >= len(dst) || dst[j] == nil {
+			continue
+		}
+		hasValidity := false
+		for _, srcValid := range m.srcs {
+			if j < len(srcValid) && srcValid[j] != nil {
+				hasValidity = true
+				break
+			}
+		}
+		if hasValidity {
+			SetFieldValidData(dst[j], valid)
+		}
+	}
+}
+
+// fillNullRows rebuilds column data of numRows rows according to valid, data holds either a value for every row or
+// values of the valid rows only, null rows are filled with fill.
+func fillNullRows[T any](data []T, valid []bool, fill T) ([]T, error) {
+	numValid := 0
+	for _, v := range valid {
+		if v {
+			numValid++
+		}
+	}
+	compact := len(data) != len(valid)
+	if compact && len(data) != numValid {
+		return nil, fmt.Errorf("the number of values %d matches neither the number of rows %d nor the number of valid rows %d",
+			len(data), len(valid), numValid)
+	}
+	ret := make([]T, len(valid))
+	idx := 0
+	for i, v := range valid {
+		if !v {
+			ret[i] = fill
+			if !compact {
+				idx++
+			}
+			continue
+		}
+		ret[i] = data[idx]
+		idx++
+	}
+	return ret, nil
+}
+
+// FillNullRows applies the per-row validity carried by the field data of a nullable field: null rows are filled with
+// the default value of the field and turned valid if the field has one, or with a placeholder otherwise.
+// The values may be given for every row or for the valid rows only, the validity of the rows is returned.
+func FillNullRows(field *schemapb.FieldSchema, fieldData *schemapb.FieldData, valid []bool) ([]bool, error) {
+	var defaultValue interface{}
+	_, hasDefault := GetFieldDefaultValue(field)
+	if hasDefault {
+		var err error
+		if defaultValue, err = ParseFieldDefaultValue(field); err != nil {
+			return nil, err
+		}
+	}
+
+	var err error
+	scalars := fieldData.GetScalars()
+	switch data := scalars.GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		fill, _ := defaultValue.(bool)
+		data.BoolData.Data, err = fillNullRows(data.BoolData.GetData(), valid, fill)
+	case *schemapb.ScalarField_IntData:
+		fill, _ := defaultValue.(int32)
+		data.IntData.Data, err = fillNullRows(data.IntData.GetData(), valid, fill)
+	case *schemapb.ScalarField_LongData:
+		fill, _ := defaultValue.(int64)
+		data.LongData.Data, err = fillNullRows(data.LongData.GetData(), valid, fill)
+	case *schemapb.ScalarField_FloatData:
+		fill, _ := defaultValue.(float32)
+		data.FloatData.Data, err = fillNullRows(data.FloatData.GetData(), valid, fill)
+	case *schemapb.ScalarField_DoubleData:
+		fill, _ := defaultValue.(float64)
+		data.DoubleData.Data, err = fillNullRows(data.DoubleData.GetData(), valid, fill)
+	case *schemapb.ScalarField_StringData:
+		fill, _ := defaultValue.(string)
+		data.StringData.Data, err = fillNullRows(data.StringData.GetData(), valid, fill)
+	default:
+		return nil, fmt.Errorf("null values are not supported for field %s of type %s", field.GetName(), field.GetDataType().String())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid data of nullable field %s: %s", field.GetName(), err.Error())
+	}
+
+	ret := make([]bool, len(valid))
+	for i, v := range valid {
+		ret[i] = v || hasDefault
+	}
+	return ret, nil
+}
@@ -0,0 +1,259 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+)
+
+func TestIsFieldNullable(t *testing.T) {
+	field := &schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int64}
+	assert.False(t, IsFieldNullable(field))
+
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}}
+	assert.True(t, IsFieldNullable(field))
+
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "invalid"}}
+	assert.False(t, IsFieldNullable(field))
+}
+
+func TestIsFieldOptional(t *testing.T) {
+	field := &schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int64}
+	assert.False(t, IsFieldOptional(field))
+
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}}
+	assert.True(t, IsFieldOptional(field))
+
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "1"}}
+	assert.True(t, IsFieldOptional(field))
+}
+
+func TestParseFieldDefaultValue(t *testing.T) {
+	cases := []struct {
+		dataType schemapb.DataType
+		raw      string
+		want     interface{}
+		wantErr  bool
+	}{
+		{schemapb.DataType_Bool, "true", true, false},
+		{schemapb.DataType_Int8, "127", int32(127), false},
+		{schemapb.DataType_Int8, "128", nil, true},
+		{schemapb.DataType_Int16, "-3", int32(-3), false},
+		{schemapb.DataType_Int32, "abc", nil, true},
+		{schemapb.DataType_Int64, "10", int64(10), false},
+		{schemapb.DataType_Float, "1.5", float32(1.5), false},
+		{schemapb.DataType_Double, "2.5", float64(2.5), false},
+		{schemapb.DataType_VarChar, "unknown", "unknown", false},
+		{schemapb.DataType_FloatVector, "1", nil, true},
+	}
+	for _, c := range cases {
+		field := &schemapb.FieldSchema{
+			Name:       "field",
+			DataType:   c.dataType,
+			TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: c.raw}},
+		}
+		value, err := ParseFieldDefaultValue(field)
+		if c.wantErr {
+			assert.Error(t, err, c.dataType.String())
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, c.want, value)
+	}
+
+	value, err := ParseFieldDefaultValue(&schemapb.FieldSchema{DataType: schemapb.DataType_Int64})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), value)
+}
+
+func TestGenDefaultFieldData(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		FieldID:    101,
+		Name:       "status",
+		DataType:   schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "new"}},
+	}
+	data, err := GenDefaultFieldData(field, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), data.GetFieldId())
+	assert.Equal(t, []string{"new", "new", "new"}, data.GetScalars().GetStringData().GetData())
+
+	field = &schemapb.FieldSchema{FieldID: 102, Name: "count", DataType: schemapb.DataType_Int16}
+	data, err = GenDefaultFieldData(field, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{0, 0}, data.GetScalars().GetIntData().GetData())
+
+	field = &schemapb.FieldSchema{FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector}
+	_, err = GenDefaultFieldData(field, 2)
+	assert.Error(t, err)
+}
+
+func TestAppendValidData(t *testing.T) {
+	src := []*segcorepb.FieldValidData{
+		GenValidData(100, 3, false),
+		{FieldId: 101, ValidData: []bool{true, false, true}},
+	}
+	dst := make([]*segcorepb.FieldValidData, len(src))
+	AppendValidData(dst, src, 1)
+	AppendValidData(dst, src, 2)
+	assert.Equal(t, int64(100), dst[0].GetFieldId())
+	assert.Equal(t, []bool{false, false}, dst[0].GetValidData())
+	assert.Equal(t, int64(101), dst[1].GetFieldId())
+	assert.Equal(t, []bool{false, true}, dst[1].GetValidData())
+}
+
+func TestMergeValidData(t *testing.T) {
+	dst := MergeValidData(nil, []*segcorepb.FieldValidData{GenValidData(100, 2, true)})
+	dst = MergeValidData(dst, []*segcorepb.FieldValidData{
+		{FieldId: 100, ValidData: []bool{false}},
+		{FieldId: 101, ValidData: []bool{true}},
+	})
+	assert.Equal(t, 2, len(dst))
+	assert.Equal(t, []bool{true, true, false}, dst[0].GetValidData())
+	assert.Equal(t, int64(101), dst[1].GetFieldId())
+	assert.Equal(t, []bool{true}, dst[1].GetValidData())
+}
+
+func TestFieldValidData(t *testing.T) {
+	fieldData := &schemapb.FieldData{FieldId: 100}
+	assert.Nil(t, GetFieldValidData(fieldData))
+
+	SetFieldValidData(fieldData, []bool{true, false, true})
+	assert.Equal(t, []bool{true, false, true}, GetFieldValidData(fieldData))
+
+	bs, err := proto.Marshal(fieldData)
+	assert.NoError(t, err)
+	decoded := &schemapb.FieldData{}
+	assert.NoError(t, proto.Unmarshal(bs, decoded))
+	assert.Equal(t, int64(100), decoded.GetFieldId())
+	assert.Equal(t, []bool{true, false, true}, GetFieldValidData(decoded))
+
+	SetFieldValidData(decoded, []bool{false})
+	assert.Equal(t, []bool{false}, GetFieldValidData(decoded))
+	SetFieldValidData(decoded, nil)
+	assert.Nil(t, GetFieldValidData(decoded))
+
+	// is_dynamic of the upstream FieldData is neither taken as validity nor dropped
+	isDynamic := protowire.AppendVarint(protowire.AppendTag(nil, 6, protowire.VarintType), 1)
+	fieldData = &schemapb.FieldData{FieldId: 100, XXX_unrecognized: isDynamic}
+	assert.Nil(t, GetFieldValidData(fieldData))
+	SetFieldValidData(fieldData, []bool{true})
+	assert.Equal(t, []bool{true}, GetFieldValidData(fieldData))
+	SetFieldValidData(fieldData, nil)
+	assert.Equal(t, isDynamic, fieldData.XXX_unrecognized)
+}
+
+func TestFieldValidDataMerger(t *testing.T) {
+	withValidity := func(fieldData *schemapb.FieldData, valid []bool) *schemapb.FieldData {
+		SetFieldValidData(fieldData, valid)
+		return fieldData
+	}
+	src1 := []*schemapb.FieldData{{FieldId: 100}, withValidity(&schemapb.FieldData{FieldId: 101}, []bool{false, true})}
+	src2 := []*schemapb.FieldData{{FieldId: 100}, {FieldId: 101}}
+
+	merger := NewFieldValidDataMerger(src1, src2)
+	merger.Append(0, 0)
+	merger.Append(1, 0)
+	merger.Append(0, 1)
+	merger.DeleteLast()
+	merger.Append(1, 1)
+	dst := []*schemapb.FieldData{{FieldId: 100}, {FieldId: 101}}
+	merger.Apply(dst)
+	assert.Nil(t, GetFieldValidData(dst[0]))
+	assert.Equal(t, []bool{false, true, true}, GetFieldValidData(dst[1]))
+
+	merger = NewFieldValidDataMerger(src2)
+	merger.Append(0, 0)
+	dst = []*schemapb.FieldData{{FieldId: 100}, {FieldId: 101}}
+	merger.Apply(dst)
+	assert.Nil(t, GetFieldValidData(dst[1]))
+}
+
+func TestSplitAddedField(t *testing.T) {
+	field, rest, err := SplitAddedField([]*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "10"}})
+	assert.NoError(t, err)
+	assert.Nil(t, field)
+	assert.Equal(t, 1, len(rest))
+
+	field, rest, err = SplitAddedField([]*commonpb.KeyValuePair{
+		{Key: common.CollectionTTLConfigKey, Value: "10"},
+		{Key: common.CollectionAddFieldKey, Value: `{"name": "tag", "data_type": "VarChar", "type_params": [{"key": "nullable", "value": "true"}]}`},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "tag", field.GetName())
+	assert.Equal(t, schemapb.DataType_VarChar, field.GetDataType())
+	assert.True(t, IsFieldNullable(field))
+	assert.Equal(t, common.CollectionTTLConfigKey, rest[0].GetKey())
+	assert.Equal(t, 1, len(rest))
+
+	_, _, err = SplitAddedField([]*commonpb.KeyValuePair{{Key: common.CollectionAddFieldKey, Value: "{"}})
+	assert.Error(t, err)
+
+	_, _, err = SplitAddedField([]*commonpb.KeyValuePair{
+		{Key: common.CollectionAddFieldKey, Value: `{"name": "a"}`},
+		{Key: common.CollectionAddFieldKey, Value: `{"name": "b"}`},
+	})
+	assert.Error(t, err)
+}
+
+func TestFillNullRows(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		Name:       "f",
+		DataType:   schemapb.DataType_Int64,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+	}
+	longData := func(data ...int64) *schemapb.FieldData {
+		return &schemapb.FieldData{Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}},
+		}}}
+	}
+
+	fieldData := longData(1, 2, 3)
+	valid, err := FillNullRows(field, fieldData, []bool{true, false, true})
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, false, true}, valid)
+	assert.Equal(t, []int64{1, 0, 3}, fieldData.GetScalars().GetLongData().GetData())
+
+	fieldData = longData(1, 3)
+	valid, err = FillNullRows(field, fieldData, []bool{true, false, true})
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, false, true}, valid)
+	assert.Equal(t, []int64{1, 0, 3}, fieldData.GetScalars().GetLongData().GetData())
+
+	_, err = FillNullRows(field, longData(1), []bool{true, false, true})
+	assert.Error(t, err)
+
+	field.TypeParams = append(field.TypeParams, &commonpb.KeyValuePair{Key: common.DefaultValueKey, Value: "7"})
+	fieldData = longData(1, 3)
+	valid, err = FillNullRows(field, fieldData, []bool{true, false, true})
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, true, true}, valid)
+	assert.Equal(t, []int64{1, 7, 3}, fieldData.GetScalars().GetLongData().GetData())
+
+	_, err = FillNullRows(&schemapb.FieldSchema{Name: "v", DataType: schemapb.DataType_FloatVector},
+		&schemapb.FieldData{Field: &schemapb.FieldData_Vectors{}}, []bool{true})
+	assert.Error(t, err)
+}
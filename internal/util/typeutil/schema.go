@@ -103,6 +103,21 @@ func EstimateSizePerRecord(schema *schemapb.CollectionSchema) (int, error) {
 					break
 				}
 			}
+		case Float16VectorType, BFloat16VectorType:
+			for _, kv := range fs.TypeParams {
+				if kv.Key == "dim" {
+					v, err := strconv.Atoi(kv.Value)
+					if err != nil {
+						return -1, err
+					}
+					res += v * halfFloatElementSize
+					break
+				}
+			}
+		case SparseFloatVectorType:
+			// the size of a sparse row varies with its non-zeros, estimate it as a row of 150 non-zeros,
+			// which is the typical size of learned sparse embeddings.
+			res += 150 * sparseFloatElementSize
 		}
 	}
 	return res, nil
@@ -130,6 +145,14 @@ func EstimateEntitySize(fieldsData []*schemapb.FieldData, rowOffset int) (int, e
 			res += int(fs.GetVectors().GetDim())
 		case schemapb.DataType_FloatVector:
 			res += int(fs.GetVectors().GetDim() * 4)
+		case Float16VectorType, BFloat16VectorType:
+			res += int(fs.GetVectors().GetDim() * halfFloatElementSize)
+		case SparseFloatVectorType:
+			rows := GetSparseFloatVectorRows(fs)
+			if rowOffset >= len(rows) {
+				return 0, fmt.Errorf("offset out range of field datas")
+			}
+			res += len(rows[rowOffset])
 		}
 	}
 	return res, nil
@@ -218,7 +241,17 @@ func (helper *SchemaHelper) GetVectorDimFromID(fieldID int64) (int, error) {
 // IsVectorType returns true if input is a vector type, otherwise false
 func IsVectorType(dataType schemapb.DataType) bool {
 	switch dataType {
-	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
+	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector, Float16VectorType, BFloat16VectorType, SparseFloatVectorType:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsDenseVectorType returns true if input is a vector type of fixed dimension, otherwise false
+func IsDenseVectorType(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector, Float16VectorType, BFloat16VectorType:
 		return true
 	default:
 		return false
@@ -348,6 +381,16 @@ func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx i
 				} else {
 					dstScalar.GetStringData().Data = append(dstScalar.GetStringData().Data, srcScalar.StringData.Data[idx])
 				}
+			case *schemapb.ScalarField_BytesData:
+				if dstScalar.GetBytesData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_BytesData{
+						BytesData: &schemapb.BytesArray{
+							Data: [][]byte{srcScalar.BytesData.Data[idx]},
+						},
+					}
+				} else {
+					dstScalar.GetBytesData().Data = append(dstScalar.GetBytesData().Data, srcScalar.BytesData.Data[idx])
+				}
 			default:
 				log.Error("Not supported field type", zap.String("field type", fieldData.Type.String()))
 			}
@@ -368,15 +411,16 @@ func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx i
 			dstVector := dst[i].GetVectors()
 			switch srcVector := fieldType.Vectors.Data.(type) {
 			case *schemapb.VectorField_BinaryVector:
+				rowBytes := GetBinaryVectorRowBytes(fieldData.Type, dim)
 				if dstVector.GetBinaryVector() == nil {
-					srcToCopy := srcVector.BinaryVector[idx*(dim/8) : (idx+1)*(dim/8)]
+					srcToCopy := srcVector.BinaryVector[idx*rowBytes : (idx+1)*rowBytes]
 					dstVector.Data = &schemapb.VectorField_BinaryVector{
 						BinaryVector: make([]byte, len(srcToCopy)),
 					}
 					copy(dstVector.Data.(*schemapb.VectorField_BinaryVector).BinaryVector, srcToCopy)
 				} else {
 					dstBinaryVector := dstVector.Data.(*schemapb.VectorField_BinaryVector)
-					dstBinaryVector.BinaryVector = append(dstBinaryVector.BinaryVector, srcVector.BinaryVector[idx*(dim/8):(idx+1)*(dim/8)]...)
+					dstBinaryVector.BinaryVector = append(dstBinaryVector.BinaryVector, srcVector.BinaryVector[idx*rowBytes:(idx+1)*rowBytes]...)
 				}
 			case *schemapb.VectorField_FloatVector:
 				if dstVector.GetFloatVector() == nil {
@@ -420,6 +464,8 @@ func DeleteFieldData(dst []*schemapb.FieldData) {
 				dstScalar.GetDoubleData().Data = dstScalar.GetDoubleData().Data[:len(dstScalar.GetDoubleData().Data)-1]
 			case *schemapb.ScalarField_StringData:
 				dstScalar.GetStringData().Data = dstScalar.GetStringData().Data[:len(dstScalar.GetStringData().Data)-1]
+			case *schemapb.ScalarField_BytesData:
+				dstScalar.GetBytesData().Data = dstScalar.GetBytesData().Data[:len(dstScalar.GetBytesData().Data)-1]
 			default:
 				log.Error("wrong field type added", zap.String("field type", fieldData.Type.String()))
 			}
@@ -433,7 +479,7 @@ func DeleteFieldData(dst []*schemapb.FieldData) {
 			switch fieldType.Vectors.Data.(type) {
 			case *schemapb.VectorField_BinaryVector:
 				dstBinaryVector := dstVector.Data.(*schemapb.VectorField_BinaryVector)
-				dstBinaryVector.BinaryVector = dstBinaryVector.BinaryVector[:len(dstBinaryVector.BinaryVector)-int(dim/8)]
+				dstBinaryVector.BinaryVector = dstBinaryVector.BinaryVector[:len(dstBinaryVector.BinaryVector)-int(GetBinaryVectorRowBytes(fieldData.Type, dim))]
 			case *schemapb.VectorField_FloatVector:
 				dstVector.GetFloatVector().Data = dstVector.GetFloatVector().Data[:len(dstVector.GetFloatVector().Data)-int(dim)]
 			default:
@@ -526,6 +572,16 @@ func MergeFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData) {
 				} else {
 					dstScalar.GetStringData().Data = append(dstScalar.GetStringData().Data, srcScalar.StringData.Data...)
 				}
+			case *schemapb.ScalarField_BytesData:
+				if dstScalar.GetBytesData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_BytesData{
+						BytesData: &schemapb.BytesArray{
+							Data: srcScalar.BytesData.Data,
+						},
+					}
+				} else {
+					dstScalar.GetBytesData().Data = append(dstScalar.GetBytesData().Data, srcScalar.BytesData.Data...)
+				}
 			default:
 				log.Error("Not supported field type", zap.String("field type", srcFieldData.Type.String()))
 			}
@@ -0,0 +1,258 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+)
+
+// SparseFloatVectorType is the data type of sparse float vector field. The milvus-proto in use has no
+// such enum yet, the value reserved for it is used, proto3 enums keep unknown values when transmitted.
+//
+// A sparse float vector row is encoded as a sequence of (uint32 index, float32 value) pairs in little endian,
+// sorted by index in ascending order. Rows are carried by the BytesData of scalar field data.
+const SparseFloatVectorType schemapb.DataType = 104
+
+// sparseFloatElementSize is the size of an encoded (index, value) pair.
+const sparseFloatElementSize = 8
+
+// IsSparseFloatVectorType returns true if input is the sparse float vector type.
+func IsSparseFloatVectorType(dataType schemapb.DataType) bool {
+	return dataType == SparseFloatVectorType
+}
+
+// CreateSparseFloatRow encodes the indices and values into a sparse float vector row, pairs are sorted by index.
+func CreateSparseFloatRow(indices []uint32, values []float32) []byte {
+	order := make([]int, len(indices))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return indices[order[i]] < indices[order[j]]
+	})
+
+	row := make([]byte, len(indices)*sparseFloatElementSize)
+	for i, idx := range order {
+		binary.LittleEndian.PutUint32(row[i*sparseFloatElementSize:], indices[idx])
+		binary.LittleEndian.PutUint32(row[i*sparseFloatElementSize+4:], math.Float32bits(values[idx]))
+	}
+	return row
+}
+
+// SparseFloatRowElementCount returns the number of non-zero elements of the row.
+func SparseFloatRowElementCount(row []byte) int {
+	return len(row) / sparseFloatElementSize
+}
+
+// SparseFloatRowIndexAt returns the index of the i-th element of the row.
+func SparseFloatRowIndexAt(row []byte, i int) uint32 {
+	return binary.LittleEndian.Uint32(row[i*sparseFloatElementSize:])
+}
+
+// SparseFloatRowValueAt returns the value of the i-th element of the row.
+func SparseFloatRowValueAt(row []byte, i int) float32 {
+	return math.Float32frombits(binary.LittleEndian.Uint32(row[i*sparseFloatElementSize+4:]))
+}
+
+// SparseFloatRowDim returns the dimension of the row, that is the max index plus one.
+func SparseFloatRowDim(row []byte) int64 {
+	count := SparseFloatRowElementCount(row)
+	if count == 0 {
+		return 0
+	}
+	return int64(SparseFloatRowIndexAt(row, count-1)) + 1
+}
+
+// SparseFloatRowsDim returns the max dimension of the rows.
+func SparseFloatRowsDim(rows [][]byte) int64 {
+	var dim int64
+	for _, row := range rows {
+		if rowDim := SparseFloatRowDim(row); rowDim > dim {
+			dim = rowDim
+		}
+	}
+	return dim
+}
+
+// ValidateSparseFloatRows checks the encoding of the rows, indices must be strictly increasing and
+// less than math.MaxUint32, values must be finite numbers.
+func ValidateSparseFloatRows(rows ...[]byte) error {
+	for i, row := range rows {
+		if len(row)%sparseFloatElementSize != 0 {
+			return fmt.Errorf("invalid data length %d of sparse float vector row %d", len(row), i)
+		}
+		for j := 0; j < SparseFloatRowElementCount(row); j++ {
+			idx := SparseFloatRowIndexAt(row, j)
+			if idx == math.MaxUint32 {
+				return fmt.Errorf("index of sparse float vector row %d must be less than %d", i, uint32(math.MaxUint32))
+			}
+			if j > 0 && idx <= SparseFloatRowIndexAt(row, j-1) {
+				return fmt.Errorf("indices of sparse float vector row %d must be strictly increasing", i)
+			}
+			value := SparseFloatRowValueAt(row, j)
+			if math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) {
+				return fmt.Errorf("value of sparse float vector row %d must be a finite number", i)
+			}
+		}
+	}
+	return nil
+}
+
+// CreateSparseFloatRowFromMap parses a sparse float vector row from its json form, both
+// {"indices": [1, 5], "values": [0.1, 0.2]} and {"1": 0.1, "5": 0.2} are accepted.
+func CreateSparseFloatRowFromMap(input map[string]interface{}) ([]byte, error) {
+	var indices []uint32
+	var values []float32
+
+	rawIndices, hasIndices := input["indices"]
+	rawValues, hasValues := input["values"]
+	if hasIndices || hasValues {
+		if !hasIndices || !hasValues || len(input) != 2 {
+			return nil, fmt.Errorf("sparse float vector row must have both 'indices' and 'values'")
+		}
+		idxArr, ok := rawIndices.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("'indices' of sparse float vector row must be an array")
+		}
+		valArr, ok := rawValues.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("'values' of sparse float vector row must be an array")
+		}
+		if len(idxArr) != len(valArr) {
+			return nil, fmt.Errorf("'indices' and 'values' of sparse float vector row have different lengths")
+		}
+		for i := range idxArr {
+			idx, err := parseSparseIndex(idxArr[i])
+			if err != nil {
+				return nil, err
+			}
+			value, err := parseSparseValue(valArr[i])
+			if err != nil {
+				return nil, err
+			}
+			indices = append(indices, idx)
+			values = append(values, value)
+		}
+	} else {
+		for k, v := range input {
+			idx, err := parseSparseIndex(k)
+			if err != nil {
+				return nil, err
+			}
+			value, err := parseSparseValue(v)
+			if err != nil {
+				return nil, err
+			}
+			indices = append(indices, idx)
+			values = append(values, value)
+		}
+	}
+
+	row := CreateSparseFloatRow(indices, values)
+	if err := ValidateSparseFloatRows(row); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+func parseSparseIndex(raw interface{}) (uint32, error) {
+	var str string
+	switch v := raw.(type) {
+	case string:
+		str = v
+	case json.Number:
+		str = v.String()
+	case float64:
+		if v != math.Trunc(v) {
+			return 0, fmt.Errorf("index %v of sparse float vector is not an integer", v)
+		}
+		str = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return 0, fmt.Errorf("illegal index %v of sparse float vector", raw)
+	}
+	idx, err := strconv.ParseUint(str, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("illegal index %s of sparse float vector: %w", str, err)
+	}
+	return uint32(idx), nil
+}
+
+func parseSparseValue(raw interface{}) (float32, error) {
+	switch v := raw.(type) {
+	case json.Number:
+		value, err := strconv.ParseFloat(v.String(), 32)
+		if err != nil {
+			return 0, fmt.Errorf("illegal value %s of sparse float vector: %w", v.String(), err)
+		}
+		return float32(value), nil
+	case float64:
+		return float32(v), nil
+	default:
+		return 0, fmt.Errorf("illegal value %v of sparse float vector", raw)
+	}
+}
+
+// GetSparseFloatVectorRows returns the sparse float vector rows carried by the field data.
+func GetSparseFloatVectorRows(fieldData *schemapb.FieldData) [][]byte {
+	return fieldData.GetScalars().GetBytesData().GetData()
+}
+
+// GenSparseFloatVectorFieldData wraps the sparse float vector rows into field data.
+func GenSparseFloatVectorFieldData(fieldName string, fieldID int64, rows [][]byte) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type:      SparseFloatVectorType,
+		FieldName: fieldName,
+		FieldId:   fieldID,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_BytesData{
+					BytesData: &schemapb.BytesArray{
+						Data: rows,
+					},
+				},
+			},
+		},
+	}
+}
+
+// SparseFloatRowInnerProduct calculates the inner product of two sparse float vector rows.
+func SparseFloatRowInnerProduct(left, right []byte) float32 {
+	var sum float32
+	i, j := 0, 0
+	leftCount, rightCount := SparseFloatRowElementCount(left), SparseFloatRowElementCount(right)
+	for i < leftCount && j < rightCount {
+		leftIdx, rightIdx := SparseFloatRowIndexAt(left, i), SparseFloatRowIndexAt(right, j)
+		switch {
+		case leftIdx == rightIdx:
+			sum += SparseFloatRowValueAt(left, i) * SparseFloatRowValueAt(right, j)
+			i++
+			j++
+		case leftIdx < rightIdx:
+			i++
+		default:
+			j++
+		}
+	}
+	return sum
+}
@@ -0,0 +1,134 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+)
+
+func TestSparseFloatRow(t *testing.T) {
+	row := CreateSparseFloatRow([]uint32{30, 2, 7}, []float32{0.3, 0.1, 0.2})
+	assert.Equal(t, 3, SparseFloatRowElementCount(row))
+	assert.Equal(t, uint32(2), SparseFloatRowIndexAt(row, 0))
+	assert.Equal(t, float32(0.1), SparseFloatRowValueAt(row, 0))
+	assert.Equal(t, uint32(30), SparseFloatRowIndexAt(row, 2))
+	assert.Equal(t, float32(0.3), SparseFloatRowValueAt(row, 2))
+	assert.Equal(t, int64(31), SparseFloatRowDim(row))
+	assert.Equal(t, int64(0), SparseFloatRowDim(nil))
+	assert.Equal(t, int64(31), SparseFloatRowsDim([][]byte{nil, row, CreateSparseFloatRow([]uint32{5}, []float32{1})}))
+	assert.True(t, IsSparseFloatVectorType(SparseFloatVectorType))
+	assert.True(t, IsVectorType(SparseFloatVectorType))
+	assert.False(t, IsSparseFloatVectorType(schemapb.DataType_FloatVector))
+	assert.False(t, IsDenseVectorType(SparseFloatVectorType))
+	assert.True(t, IsDenseVectorType(schemapb.DataType_FloatVector))
+}
+
+func TestValidateSparseFloatRows(t *testing.T) {
+	assert.NoError(t, ValidateSparseFloatRows(nil, CreateSparseFloatRow([]uint32{1, 3}, []float32{0.5, 1.5})))
+	assert.Error(t, ValidateSparseFloatRows([]byte{1, 2, 3}))
+	assert.Error(t, ValidateSparseFloatRows(CreateSparseFloatRow([]uint32{1, 1}, []float32{0.5, 1.5})))
+	assert.Error(t, ValidateSparseFloatRows(CreateSparseFloatRow([]uint32{math.MaxUint32}, []float32{0.5})))
+	assert.Error(t, ValidateSparseFloatRows(CreateSparseFloatRow([]uint32{1}, []float32{float32(math.NaN())})))
+	assert.Error(t, ValidateSparseFloatRows(CreateSparseFloatRow([]uint32{1}, []float32{float32(math.Inf(1))})))
+}
+
+func TestCreateSparseFloatRowFromMap(t *testing.T) {
+	parse := func(s string) map[string]interface{} {
+		m := make(map[string]interface{})
+		assert.NoError(t, json.Unmarshal([]byte(s), &m))
+		return m
+	}
+	expected := CreateSparseFloatRow([]uint32{1, 5}, []float32{0.1, 0.2})
+
+	row, err := CreateSparseFloatRowFromMap(parse(`{"indices": [5, 1], "values": [0.2, 0.1]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, row)
+
+	row, err = CreateSparseFloatRowFromMap(parse(`{"1": 0.1, "5": 0.2}`))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, row)
+
+	row, err = CreateSparseFloatRowFromMap(map[string]interface{}{
+		"indices": []interface{}{json.Number("1"), json.Number("5")},
+		"values":  []interface{}{json.Number("0.1"), json.Number("0.2")},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, row)
+
+	row, err = CreateSparseFloatRowFromMap(parse(`{}`))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, SparseFloatRowElementCount(row))
+
+	invalids := []string{
+		`{"indices": [1, 5]}`,
+		`{"indices": [1, 5], "values": [0.1]}`,
+		`{"indices": 1, "values": [0.1]}`,
+		`{"indices": [1.5], "values": [0.1]}`,
+		`{"indices": [-1], "values": [0.1]}`,
+		`{"indices": [1, 1], "values": [0.1, 0.2]}`,
+		`{"indices": [1], "values": ["a"]}`,
+		`{"a": 0.1}`,
+		`{"4294967296": 0.1}`,
+	}
+	for _, s := range invalids {
+		_, err = CreateSparseFloatRowFromMap(parse(s))
+		assert.Error(t, err, s)
+	}
+}
+
+func TestSparseFloatVectorFieldData(t *testing.T) {
+	rows := [][]byte{
+		CreateSparseFloatRow([]uint32{1}, []float32{0.1}),
+		CreateSparseFloatRow([]uint32{2, 3}, []float32{0.2, 0.3}),
+	}
+	fieldData := GenSparseFloatVectorFieldData("sparse", 101, rows)
+	assert.Equal(t, SparseFloatVectorType, fieldData.GetType())
+	assert.Equal(t, rows, GetSparseFloatVectorRows(fieldData))
+
+	size, err := EstimateEntitySize([]*schemapb.FieldData{fieldData}, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 16, size)
+
+	size, err = EstimateSizePerRecord(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{{Name: "sparse", DataType: SparseFloatVectorType}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1200, size)
+
+	dst := make([]*schemapb.FieldData, 1)
+	AppendFieldData(dst, []*schemapb.FieldData{fieldData}, 1)
+	AppendFieldData(dst, []*schemapb.FieldData{fieldData}, 0)
+	assert.Equal(t, [][]byte{rows[1], rows[0]}, GetSparseFloatVectorRows(dst[0]))
+	DeleteFieldData(dst)
+	assert.Equal(t, [][]byte{rows[1]}, GetSparseFloatVectorRows(dst[0]))
+
+	MergeFieldData(dst, []*schemapb.FieldData{fieldData})
+	assert.Equal(t, [][]byte{rows[1], rows[0], rows[1]}, GetSparseFloatVectorRows(dst[0]))
+}
+
+func TestSparseFloatRowInnerProduct(t *testing.T) {
+	left := CreateSparseFloatRow([]uint32{1, 3, 5}, []float32{1, 2, 3})
+	right := CreateSparseFloatRow([]uint32{0, 3, 5, 9}, []float32{4, 5, 6, 7})
+	assert.Equal(t, float32(28), SparseFloatRowInnerProduct(left, right))
+	assert.Equal(t, float32(0), SparseFloatRowInnerProduct(left, nil))
+}
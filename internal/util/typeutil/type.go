@@ -46,6 +46,8 @@ const (
 	DataCoordRole = "datacoord"
 	// DataNodeRole is a constant represent DataNode
 	DataNodeRole = "datanode"
+	// ReplicatorRole is a constant represent the cross-cluster Replicator
+	ReplicatorRole = "replicator"
 )
 
 const Unlimited int64 = -1
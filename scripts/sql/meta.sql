@@ -142,19 +142,21 @@ CREATE TABLE if not exists milvus_meta.segments (
     collection_id BIGINT NOT NULL,
     partition_id BIGINT NOT NULL,
     num_rows BIGINT NOT NULL,
-    max_row_num INT COMMENT 'estimate max rows',
+    max_row_num BIGINT COMMENT 'estimate max rows',
     dm_channel VARCHAR(128) NOT NULL,
     dml_position TEXT COMMENT 'checkpoint',
     start_position TEXT,
-    compaction_from VARCHAR(4096) COMMENT 'old segment IDs',
+    compaction_from TEXT COMMENT 'old segment IDs',
     created_by_compaction BOOL,
     segment_state TINYINT UNSIGNED NOT NULL,
     last_expire_time bigint unsigned COMMENT 'segment assignment expiration time',
     dropped_at bigint unsigned,
-    is_deleted BOOL DEFAULT FALSE,
+    is_importing BOOL NOT NULL DEFAULT false,
+    is_fake BOOL NOT NULL DEFAULT false,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
     PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_segment_id (tenant_id, segment_id),
     INDEX idx_tenant_id_collection_id_segment_id (tenant_id, collection_id, segment_id)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
@@ -189,19 +191,86 @@ CREATE TABLE if not exists milvus_meta.binlogs (
     field_id BIGINT NOT NULL,
     segment_id BIGINT NOT NULL,
     collection_id BIGINT NOT NULL,
+    partition_id BIGINT NOT NULL,
     log_type SMALLINT UNSIGNED NOT NULL COMMENT 'binlog、stats binlog、delta binlog',
+    log_id BIGINT NOT NULL DEFAULT 0,
     num_entries BIGINT,
     timestamp_from BIGINT UNSIGNED,
     timestamp_to BIGINT UNSIGNED,
     log_path VARCHAR(256) NOT NULL,
     log_size BIGINT NOT NULL,
-    is_deleted BOOL DEFAULT FALSE,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
     PRIMARY KEY (id),
     INDEX idx_tenant_id_segment_id_log_type (tenant_id, segment_id, log_type)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
+-- channel checkpoints
+CREATE TABLE if not exists milvus_meta.channel_checkpoints (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    virtual_channel_name VARCHAR(256) NOT NULL,
+    position TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_virtual_channel_name (tenant_id, virtual_channel_name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- dropped channels
+CREATE TABLE if not exists milvus_meta.dropped_channels (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    channel_name VARCHAR(128) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_channel_name (tenant_id, channel_name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- collection load infos
+CREATE TABLE if not exists milvus_meta.collection_load_infos (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    released_partitions TEXT,
+    replica_number INTEGER NOT NULL,
+    status INTEGER NOT NULL,
+    field_index_id TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_collection_id (tenant_id, collection_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- partition load infos
+CREATE TABLE if not exists milvus_meta.partition_load_infos (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    partition_id BIGINT NOT NULL,
+    replica_number INTEGER NOT NULL,
+    status INTEGER NOT NULL,
+    field_index_id TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_collection_id_partition_id (tenant_id, collection_id, partition_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- replicas
+CREATE TABLE if not exists milvus_meta.replicas (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    replica_id BIGINT NOT NULL,
+    collection_id BIGINT NOT NULL,
+    nodes TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_collection_id_replica_id (tenant_id, collection_id, replica_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
 -- users
 CREATE TABLE if not exists milvus_meta.credential_users (
     id     BIGINT NOT NULL AUTO_INCREMENT,